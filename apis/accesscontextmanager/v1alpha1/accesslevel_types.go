@@ -0,0 +1,224 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Combining functions of the conditions of a basic access level.
+const (
+	CombiningFunctionAnd = "AND"
+	CombiningFunctionOr  = "OR"
+)
+
+// AccessLevelParameters define the desired state of an access level. The
+// external name of the resource is the short name of the level, which may
+// only contain letters, digits and underscores:
+// https://cloud.google.com/access-context-manager/docs/reference/rest/v1/accessPolicies.accessLevels
+type AccessLevelParameters struct {
+	// AccessPolicy is the ID of the access policy the level belongs to.
+	// +immutable
+	// +optional
+	AccessPolicy *string `json:"accessPolicy,omitempty"`
+
+	// AccessPolicyRef references an AccessPolicy and retrieves its ID.
+	// +immutable
+	// +optional
+	AccessPolicyRef *xpv1.Reference `json:"accessPolicyRef,omitempty"`
+
+	// AccessPolicySelector selects a reference to an AccessPolicy and
+	// retrieves its ID.
+	// +optional
+	AccessPolicySelector *xpv1.Selector `json:"accessPolicySelector,omitempty"`
+
+	// Title of the access level.
+	Title string `json:"title"`
+
+	// Description of the access level.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Basic is an access level defined by a list of conditions. Exactly one
+	// of Basic and Custom must be set.
+	// +optional
+	Basic *BasicLevel `json:"basic,omitempty"`
+
+	// Custom is an access level defined by a Common Expression Language
+	// expression. Exactly one of Basic and Custom must be set.
+	// +optional
+	Custom *CustomLevel `json:"custom,omitempty"`
+}
+
+// BasicLevel is an access level that is granted when its conditions are
+// met.
+type BasicLevel struct {
+	// CombiningFunction determines whether all (AND) or any (OR) of the
+	// conditions must be met.
+	// +kubebuilder:validation:Enum=AND;OR
+	// +optional
+	CombiningFunction *string `json:"combiningFunction,omitempty"`
+
+	// Conditions of the access level.
+	// +kubebuilder:validation:MinItems=1
+	Conditions []Condition `json:"conditions"`
+}
+
+// A Condition is met when all of its fields are satisfied.
+type Condition struct {
+	// IPSubnetworks in CIDR notation the request must originate from.
+	// +optional
+	IPSubnetworks []string `json:"ipSubnetworks,omitempty"`
+
+	// Members the request must be made by, in the form user:{email} or
+	// serviceAccount:{email}.
+	// +optional
+	Members []string `json:"members,omitempty"`
+
+	// Negate inverts the result of the condition.
+	// +optional
+	Negate *bool `json:"negate,omitempty"`
+
+	// Regions, as ISO 3166-1 alpha-2 codes, the request must originate from.
+	// +optional
+	Regions []string `json:"regions,omitempty"`
+
+	// RequiredAccessLevels that must be granted as well, in the form
+	// accessPolicies/{policy_id}/accessLevels/{short_name}.
+	// +optional
+	RequiredAccessLevels []string `json:"requiredAccessLevels,omitempty"`
+
+	// DevicePolicy the device of the request must satisfy.
+	// +optional
+	DevicePolicy *DevicePolicy `json:"devicePolicy,omitempty"`
+}
+
+// DevicePolicy describes the devices a condition applies to.
+type DevicePolicy struct {
+	// RequireScreenlock requires the device to have a screen lock.
+	// +optional
+	RequireScreenlock *bool `json:"requireScreenlock,omitempty"`
+
+	// AllowedEncryptionStatuses of the device, e.g. ENCRYPTED.
+	// +optional
+	AllowedEncryptionStatuses []string `json:"allowedEncryptionStatuses,omitempty"`
+
+	// AllowedDeviceManagementLevels of the device, e.g. COMPLETE.
+	// +optional
+	AllowedDeviceManagementLevels []string `json:"allowedDeviceManagementLevels,omitempty"`
+
+	// OsConstraints the operating system of the device must satisfy.
+	// +optional
+	OsConstraints []OsConstraint `json:"osConstraints,omitempty"`
+
+	// RequireAdminApproval requires the device to be approved by an
+	// administrator.
+	// +optional
+	RequireAdminApproval *bool `json:"requireAdminApproval,omitempty"`
+
+	// RequireCorpOwned requires the device to be owned by the organization.
+	// +optional
+	RequireCorpOwned *bool `json:"requireCorpOwned,omitempty"`
+}
+
+// OsConstraint restricts the operating system of a device.
+type OsConstraint struct {
+	// OsType of the device, e.g. DESKTOP_CHROME_OS.
+	OsType string `json:"osType"`
+
+	// MinimumVersion of the operating system, e.g. 10.5.301.
+	// +optional
+	MinimumVersion *string `json:"minimumVersion,omitempty"`
+
+	// RequireVerifiedChromeOs requires a verified Chrome OS device.
+	// +optional
+	RequireVerifiedChromeOs *bool `json:"requireVerifiedChromeOs,omitempty"`
+}
+
+// CustomLevel is an access level that is granted when its expression
+// evaluates to true.
+type CustomLevel struct {
+	// Expr in Common Expression Language syntax, e.g.
+	// device.os_type == OsType.DESKTOP_MAC.
+	Expr Expr `json:"expr"`
+}
+
+// Expr is a Common Expression Language expression.
+type Expr struct {
+	// Expression in Common Expression Language syntax.
+	Expression string `json:"expression"`
+
+	// Title of the expression.
+	// +optional
+	Title *string `json:"title,omitempty"`
+
+	// Description of the expression.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Location of the expression for error reporting.
+	// +optional
+	Location *string `json:"location,omitempty"`
+}
+
+// AccessLevelObservation is used to show the observed state of an
+// AccessLevel.
+type AccessLevelObservation struct {
+	// Name of the access level, in the form
+	// accessPolicies/{policy_id}/accessLevels/{short_name}.
+	Name string `json:"name,omitempty"`
+}
+
+// A AccessLevelSpec defines the desired state of a AccessLevel.
+type AccessLevelSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AccessLevelParameters `json:"forProvider"`
+}
+
+// A AccessLevelStatus represents the observed state of a AccessLevel.
+type AccessLevelStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AccessLevelObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AccessLevel is a managed resource that represents a Google Cloud Access Context Manager access level.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TITLE",type="string",JSONPath=".spec.forProvider.title"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type AccessLevel struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccessLevelSpec   `json:"spec"`
+	Status AccessLevelStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccessLevelList contains a list of AccessLevel
+type AccessLevelList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessLevel `json:"items"`
+}
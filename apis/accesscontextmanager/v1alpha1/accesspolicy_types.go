@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// AccessPolicyParameters define the desired state of an access policy, the
+// container for access levels and service perimeters. Policy IDs are assigned
+// by Access Context Manager; the external name of the resource is the numeric
+// policy ID:
+// https://cloud.google.com/access-context-manager/docs/reference/rest/v1/accessPolicies
+type AccessPolicyParameters struct {
+	// Parent is the organization the policy belongs to, in the form
+	// organizations/{organization_id}.
+	// +kubebuilder:validation:Pattern=`^organizations/[0-9]+$`
+	// +immutable
+	Parent string `json:"parent"`
+
+	// Title of the policy. It is used to find the policy before its ID is
+	// known, so it should be unique within the organization.
+	Title string `json:"title"`
+
+	// Scopes the policy applies to, in the form projects/{project_number}
+	// or folders/{folder_id}. A scoped policy can only contain a single
+	// project or folder; an organization has at most one unscoped policy.
+	// +kubebuilder:validation:MaxItems=1
+	// +optional
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+// AccessPolicyObservation is used to show the observed state of an
+// AccessPolicy.
+type AccessPolicyObservation struct {
+	// Name of the policy, in the form accessPolicies/{policy_id}.
+	Name string `json:"name,omitempty"`
+
+	// Etag of the policy.
+	Etag string `json:"etag,omitempty"`
+}
+
+// A AccessPolicySpec defines the desired state of a AccessPolicy.
+type AccessPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AccessPolicyParameters `json:"forProvider"`
+}
+
+// A AccessPolicyStatus represents the observed state of a AccessPolicy.
+type AccessPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AccessPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AccessPolicy is a managed resource that represents a Google Cloud Access Context Manager access policy.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TITLE",type="string",JSONPath=".spec.forProvider.title"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type AccessPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccessPolicySpec   `json:"spec"`
+	Status AccessPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccessPolicyList contains a list of AccessPolicy
+type AccessPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessPolicy `json:"items"`
+}
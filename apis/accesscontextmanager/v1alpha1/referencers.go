@@ -0,0 +1,125 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	resourcemanagerv1alpha1 "github.com/crossplane/provider-gcp/apis/resourcemanager/v1alpha1"
+)
+
+// AccessLevelName extracts the name of an AccessLevel.
+func AccessLevelName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		l, ok := mg.(*AccessLevel)
+		if !ok {
+			return ""
+		}
+		return l.Status.AtProvider.Name
+	}
+}
+
+// ResolveReferences of this AccessLevel
+func (in *AccessLevel) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.accessPolicy
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.AccessPolicy),
+		Reference:    in.Spec.ForProvider.AccessPolicyRef,
+		Selector:     in.Spec.ForProvider.AccessPolicySelector,
+		To:           reference.To{Managed: &AccessPolicy{}, List: &AccessPolicyList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.accessPolicy")
+	}
+	in.Spec.ForProvider.AccessPolicy = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.AccessPolicyRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this ServicePerimeter
+func (in *ServicePerimeter) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.accessPolicy
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.AccessPolicy),
+		Reference:    in.Spec.ForProvider.AccessPolicyRef,
+		Selector:     in.Spec.ForProvider.AccessPolicySelector,
+		To:           reference.To{Managed: &AccessPolicy{}, List: &AccessPolicyList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.accessPolicy")
+	}
+	in.Spec.ForProvider.AccessPolicy = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.AccessPolicyRef = rsp.ResolvedReference
+
+	if in.Spec.ForProvider.Status != nil {
+		if err := resolveServicePerimeterConfig(ctx, r, in.Spec.ForProvider.Status); err != nil {
+			return errors.Wrap(err, "spec.forProvider.status")
+		}
+	}
+	if in.Spec.ForProvider.Spec != nil {
+		if err := resolveServicePerimeterConfig(ctx, r, in.Spec.ForProvider.Spec); err != nil {
+			return errors.Wrap(err, "spec.forProvider.spec")
+		}
+	}
+
+	return nil
+}
+
+func resolveServicePerimeterConfig(ctx context.Context, r *reference.APIResolver, cfg *ServicePerimeterConfig) error {
+	// Resolve resources
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: cfg.Resources,
+		References:    cfg.ResourceRefs,
+		Selector:      cfg.ResourceSelector,
+		To:            reference.To{Managed: &resourcemanagerv1alpha1.Project{}, List: &resourcemanagerv1alpha1.ProjectList{}},
+		Extract:       resourcemanagerv1alpha1.ProjectName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "resources")
+	}
+	cfg.Resources = mrsp.ResolvedValues
+	cfg.ResourceRefs = mrsp.ResolvedReferences
+
+	// Resolve accessLevels
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: cfg.AccessLevels,
+		References:    cfg.AccessLevelRefs,
+		Selector:      cfg.AccessLevelSelector,
+		To:            reference.To{Managed: &AccessLevel{}, List: &AccessLevelList{}},
+		Extract:       AccessLevelName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "accessLevels")
+	}
+	cfg.AccessLevels = mrsp.ResolvedValues
+	cfg.AccessLevelRefs = mrsp.ResolvedReferences
+
+	return nil
+}
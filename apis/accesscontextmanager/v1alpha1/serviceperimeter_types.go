@@ -0,0 +1,185 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Perimeter types.
+const (
+	PerimeterTypeRegular = "PERIMETER_TYPE_REGULAR"
+	PerimeterTypeBridge  = "PERIMETER_TYPE_BRIDGE"
+)
+
+// ServicePerimeterParameters define the desired state of a VPC Service
+// Controls perimeter. The external name of the resource is the short name of
+// the perimeter, which may only contain letters, digits and underscores:
+// https://cloud.google.com/access-context-manager/docs/reference/rest/v1/accessPolicies.servicePerimeters
+type ServicePerimeterParameters struct {
+	// AccessPolicy is the ID of the access policy the perimeter belongs to.
+	// +immutable
+	// +optional
+	AccessPolicy *string `json:"accessPolicy,omitempty"`
+
+	// AccessPolicyRef references an AccessPolicy and retrieves its ID.
+	// +immutable
+	// +optional
+	AccessPolicyRef *xpv1.Reference `json:"accessPolicyRef,omitempty"`
+
+	// AccessPolicySelector selects a reference to an AccessPolicy and
+	// retrieves its ID.
+	// +optional
+	AccessPolicySelector *xpv1.Selector `json:"accessPolicySelector,omitempty"`
+
+	// Title of the perimeter.
+	Title string `json:"title"`
+
+	// Description of the perimeter.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// PerimeterType is either PERIMETER_TYPE_REGULAR or
+	// PERIMETER_TYPE_BRIDGE. A bridge perimeter allows the projects of
+	// several regular perimeters to communicate; it only lists resources.
+	// +kubebuilder:validation:Enum=PERIMETER_TYPE_REGULAR;PERIMETER_TYPE_BRIDGE
+	// +kubebuilder:default=PERIMETER_TYPE_REGULAR
+	// +immutable
+	// +optional
+	PerimeterType *string `json:"perimeterType,omitempty"`
+
+	// Status is the enforced configuration of the perimeter.
+	// +optional
+	Status *ServicePerimeterConfig `json:"status,omitempty"`
+
+	// Spec is the dry-run configuration of the perimeter. Violations of the
+	// dry-run configuration are logged but not denied. It is only used if
+	// UseExplicitDryRunSpec is true.
+	// +optional
+	Spec *ServicePerimeterConfig `json:"spec,omitempty"`
+
+	// UseExplicitDryRunSpec determines whether Spec is used as the dry-run
+	// configuration. If false, the dry-run configuration is the enforced
+	// one.
+	// +optional
+	UseExplicitDryRunSpec *bool `json:"useExplicitDryRunSpec,omitempty"`
+}
+
+// ServicePerimeterConfig is a configuration of a service perimeter.
+type ServicePerimeterConfig struct {
+	// Resources inside the perimeter, in the form
+	// projects/{project_number}.
+	// +optional
+	Resources []string `json:"resources,omitempty"`
+
+	// ResourceRefs references Projects and adds them to Resources.
+	// +optional
+	ResourceRefs []xpv1.Reference `json:"resourceRefs,omitempty"`
+
+	// ResourceSelector selects references to Projects and adds them to
+	// Resources.
+	// +optional
+	ResourceSelector *xpv1.Selector `json:"resourceSelector,omitempty"`
+
+	// AccessLevels that allow requests from outside the perimeter, in the
+	// form accessPolicies/{policy_id}/accessLevels/{short_name}. Must be
+	// empty for bridge perimeters.
+	// +optional
+	AccessLevels []string `json:"accessLevels,omitempty"`
+
+	// AccessLevelRefs references AccessLevels and adds them to
+	// AccessLevels.
+	// +optional
+	AccessLevelRefs []xpv1.Reference `json:"accessLevelRefs,omitempty"`
+
+	// AccessLevelSelector selects references to AccessLevels and adds them
+	// to AccessLevels.
+	// +optional
+	AccessLevelSelector *xpv1.Selector `json:"accessLevelSelector,omitempty"`
+
+	// RestrictedServices that are protected by the perimeter, e.g.
+	// storage.googleapis.com. Must be empty for bridge perimeters.
+	// +optional
+	RestrictedServices []string `json:"restrictedServices,omitempty"`
+
+	// VPCAccessibleServices restricts the services that can be reached from
+	// the networks inside the perimeter.
+	// +optional
+	VPCAccessibleServices *VPCAccessibleServices `json:"vpcAccessibleServices,omitempty"`
+}
+
+// VPCAccessibleServices restricts the services that can be reached from the
+// networks inside a perimeter.
+type VPCAccessibleServices struct {
+	// EnableRestriction restricts the reachable services to AllowedServices.
+	// +optional
+	EnableRestriction *bool `json:"enableRestriction,omitempty"`
+
+	// AllowedServices that can be reached, e.g. storage.googleapis.com or
+	// RESTRICTED-SERVICES for all restricted services.
+	// +optional
+	AllowedServices []string `json:"allowedServices,omitempty"`
+}
+
+// ServicePerimeterObservation is used to show the observed state of a
+// ServicePerimeter.
+type ServicePerimeterObservation struct {
+	// Name of the perimeter, in the form
+	// accessPolicies/{policy_id}/servicePerimeters/{short_name}.
+	Name string `json:"name,omitempty"`
+}
+
+// A ServicePerimeterSpec defines the desired state of a ServicePerimeter.
+type ServicePerimeterSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServicePerimeterParameters `json:"forProvider"`
+}
+
+// A ServicePerimeterStatus represents the observed state of a ServicePerimeter.
+type ServicePerimeterStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ServicePerimeterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ServicePerimeter is a managed resource that represents a Google Cloud VPC Service Controls perimeter.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TITLE",type="string",JSONPath=".spec.forProvider.title"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.perimeterType"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type ServicePerimeter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServicePerimeterSpec   `json:"spec"`
+	Status ServicePerimeterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServicePerimeterList contains a list of ServicePerimeter
+type ServicePerimeterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServicePerimeter `json:"items"`
+}
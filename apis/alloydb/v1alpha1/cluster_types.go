@@ -0,0 +1,270 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known Cluster states.
+const (
+	ClusterStateReady         = "READY"
+	ClusterStateCreating      = "CREATING"
+	ClusterStateDeleting      = "DELETING"
+	ClusterStateBootstrapping = "BOOTSTRAPPING"
+	ClusterStateMaintenance   = "MAINTENANCE"
+	ClusterStateStopped       = "STOPPED"
+	ClusterStateFailed        = "FAILED"
+)
+
+// DefaultInitialUser is the name of the initial user AlloyDB creates when none
+// is specified.
+const DefaultInitialUser = "postgres"
+
+// ClusterParameters define the desired state of an AlloyDB Cluster. Most
+// fields map directly to a Cluster:
+// https://cloud.google.com/alloydb/docs/reference/rest/v1/projects.locations.clusters#Cluster
+type ClusterParameters struct {
+	// Location is the region in which the cluster is created, e.g.
+	// us-central1.
+	// +immutable
+	Location string `json:"location"`
+
+	// Network is the resource link for the VPC network in which cluster
+	// resources are created and from which they are accessible via Private
+	// IP. It is of the form projects/{project}/global/networks/{network_id}.
+	// +optional
+	// +immutable
+	Network *string `json:"network,omitempty"`
+
+	// NetworkRef references a Network to retrieve its URI
+	// +optional
+	// +immutable
+	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to a Network
+	// +optional
+	// +immutable
+	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
+
+	// AllocatedIPRange is the name of the allocated IP range for the private
+	// IP AlloyDB cluster, e.g. "google-managed-services-default". If set, the
+	// instance IPs for this cluster will be created in the allocated range.
+	// +optional
+	// +immutable
+	AllocatedIPRange *string `json:"allocatedIpRange,omitempty"`
+
+	// DatabaseVersion is the database engine major version, e.g.
+	// POSTGRES_14. If unspecified the service default is used.
+	// +optional
+	// +immutable
+	DatabaseVersion *string `json:"databaseVersion,omitempty"`
+
+	// DisplayName is a user-settable and human-readable display name for
+	// the cluster.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Labels are used as additional metadata on the cluster.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// InitialUser is the name of the database user that is created with the
+	// cluster. Its password is generated by the provider and published to the
+	// connection secret. Defaults to "postgres".
+	// +optional
+	// +immutable
+	InitialUser *string `json:"initialUser,omitempty"`
+
+	// EncryptionConfig configures the customer-managed encryption key
+	// (CMEK) used to encrypt the cluster's data. If unspecified Google
+	// managed encryption is used.
+	// +optional
+	// +immutable
+	EncryptionConfig *EncryptionConfig `json:"encryptionConfig,omitempty"`
+
+	// AutomatedBackupPolicy is the automated backup policy for this cluster.
+	// If no policy is provided then the default policy will be used.
+	// +optional
+	AutomatedBackupPolicy *AutomatedBackupPolicy `json:"automatedBackupPolicy,omitempty"`
+}
+
+// EncryptionConfig describes the encryption config of a cluster or a backup
+// that is encrypted with a customer-managed encryption key.
+type EncryptionConfig struct {
+	// KMSKeyName is the fully-qualified resource name of the KMS key, in the
+	// form projects/{project}/locations/{location}/keyRings/{ring}/cryptoKeys/{key_name}.
+	// +optional
+	KMSKeyName *string `json:"kmsKeyName,omitempty"`
+
+	// KMSKeyNameRef allows you to specify custom resource name of the KMS
+	// Key to fill KMSKeyName field.
+	// +optional
+	KMSKeyNameRef *xpv1.Reference `json:"kmsKeyNameRef,omitempty"`
+
+	// KMSKeyNameSelector allows you to use selector constraints to select a
+	// KMS Key.
+	// +optional
+	KMSKeyNameSelector *xpv1.Selector `json:"kmsKeyNameSelector,omitempty"`
+}
+
+// AutomatedBackupPolicy defines the schedule and retention of the
+// automated backups of a cluster.
+type AutomatedBackupPolicy struct {
+	// Enabled indicates whether automated backups are enabled.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// BackupWindow is the length of the time window during which a backup
+	// can be taken, as a duration in seconds, e.g. "3600s".
+	// +optional
+	BackupWindow *string `json:"backupWindow,omitempty"`
+
+	// Location where the backup will be stored. Currently, the only
+	// supported option is to store the backup in the same region as the
+	// cluster.
+	// +optional
+	Location *string `json:"location,omitempty"`
+
+	// Labels to apply to backups created using this configuration.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// WeeklySchedule is the weekly schedule at which backups are taken.
+	// +optional
+	WeeklySchedule *WeeklySchedule `json:"weeklySchedule,omitempty"`
+
+	// TimeBasedRetention retains backups for a period of time, e.g.
+	// "1209600s". Mutually exclusive with QuantityBasedRetention.
+	// +optional
+	TimeBasedRetention *TimeBasedRetention `json:"timeBasedRetention,omitempty"`
+
+	// QuantityBasedRetention retains a fixed number of the most recent
+	// backups. Mutually exclusive with TimeBasedRetention.
+	// +optional
+	QuantityBasedRetention *QuantityBasedRetention `json:"quantityBasedRetention,omitempty"`
+
+	// EncryptionConfig configures the customer-managed encryption key used
+	// to encrypt backups. If unspecified the cluster encryption config is
+	// used.
+	// +optional
+	EncryptionConfig *EncryptionConfig `json:"encryptionConfig,omitempty"`
+}
+
+// WeeklySchedule is a weekly schedule which starts backups on the given
+// days at the given times.
+type WeeklySchedule struct {
+	// DaysOfWeek on which backups are taken, e.g. MONDAY. If empty,
+	// backups are taken every day.
+	// +optional
+	DaysOfWeek []string `json:"daysOfWeek,omitempty"`
+
+	// StartTimes are the times during the day at which a backup is started.
+	// +optional
+	StartTimes []TimeOfDay `json:"startTimes,omitempty"`
+}
+
+// TimeOfDay represents a time of day in UTC.
+type TimeOfDay struct {
+	// Hours of day in 24 hour format.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=23
+	Hours int64 `json:"hours"`
+
+	// Minutes of hour of day.
+	// +optional
+	Minutes int64 `json:"minutes,omitempty"`
+}
+
+// TimeBasedRetention retains backups for a period of time.
+type TimeBasedRetention struct {
+	// RetentionPeriod is the duration a backup is kept for, e.g.
+	// "1209600s".
+	RetentionPeriod string `json:"retentionPeriod"`
+}
+
+// QuantityBasedRetention retains a fixed number of backups.
+type QuantityBasedRetention struct {
+	// Count is the number of backups to retain.
+	Count int64 `json:"count"`
+}
+
+// ClusterObservation is used to show the observed state of the Cluster.
+type ClusterObservation struct {
+	// Name is the fully qualified name of the cluster, e.g.
+	// projects/{project}/locations/{region}/clusters/{cluster_id}.
+	Name string `json:"name,omitempty"`
+
+	// UID is a system-generated unique identifier for the cluster.
+	UID string `json:"uid,omitempty"`
+
+	// State of the cluster.
+	State string `json:"state,omitempty"`
+
+	// ClusterType is the type of the cluster, either PRIMARY or SECONDARY.
+	ClusterType string `json:"clusterType,omitempty"`
+
+	// DatabaseVersion is the database engine major version in use.
+	DatabaseVersion string `json:"databaseVersion,omitempty"`
+
+	// CreateTime is the time the cluster was created in RFC3339 format.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// Reconciling is true when the cluster is being updated by the service.
+	Reconciling bool `json:"reconciling,omitempty"`
+}
+
+// ClusterSpec defines the desired state of a Cluster.
+type ClusterSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ClusterParameters `json:"forProvider"`
+}
+
+// ClusterStatus represents the observed state of a Cluster.
+type ClusterStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ClusterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Cluster is a managed resource that represents an AlloyDB for PostgreSQL
+// cluster.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Cluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterSpec   `json:"spec"`
+	Status ClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterList contains a list of Cluster
+type ClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Cluster `json:"items"`
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known Instance states.
+const (
+	InstanceStateReady         = "READY"
+	InstanceStateCreating      = "CREATING"
+	InstanceStateDeleting      = "DELETING"
+	InstanceStateBootstrapping = "BOOTSTRAPPING"
+	InstanceStateMaintenance   = "MAINTENANCE"
+	InstanceStateStopped       = "STOPPED"
+	InstanceStateFailed        = "FAILED"
+)
+
+// Instance types.
+const (
+	InstanceTypePrimary  = "PRIMARY"
+	InstanceTypeReadPool = "READ_POOL"
+)
+
+// PostgreSQLPort is the port AlloyDB instances accept connections on.
+const PostgreSQLPort = "5432"
+
+// InstanceParameters define the desired state of an AlloyDB Instance. Most
+// fields map directly to an Instance:
+// https://cloud.google.com/alloydb/docs/reference/rest/v1/projects.locations.clusters.instances#Instance
+type InstanceParameters struct {
+	// Cluster is the fully qualified name of the AlloyDB cluster this
+	// instance belongs to, in the form
+	// projects/{project}/locations/{region}/clusters/{cluster_id}.
+	// +optional
+	// +immutable
+	Cluster *string `json:"cluster,omitempty"`
+
+	// ClusterRef references a Cluster to retrieve its name.
+	// +optional
+	// +immutable
+	ClusterRef *xpv1.Reference `json:"clusterRef,omitempty"`
+
+	// ClusterSelector selects a reference to a Cluster.
+	// +optional
+	// +immutable
+	ClusterSelector *xpv1.Selector `json:"clusterSelector,omitempty"`
+
+	// InstanceType is the type of the instance. A cluster has exactly one
+	// PRIMARY instance and may have any number of READ_POOL instances.
+	// +immutable
+	// +kubebuilder:validation:Enum=PRIMARY;READ_POOL
+	InstanceType string `json:"instanceType"`
+
+	// AvailabilityType of the instance. REGIONAL instances are highly
+	// available and fail over across zones; ZONAL instances are not.
+	// +optional
+	// +kubebuilder:validation:Enum=ZONAL;REGIONAL
+	AvailabilityType *string `json:"availabilityType,omitempty"`
+
+	// MachineConfig configures the compute resources of the instance.
+	// +optional
+	MachineConfig *MachineConfig `json:"machineConfig,omitempty"`
+
+	// ReadPoolConfig configures the nodes of a READ_POOL instance. It must
+	// be set if and only if InstanceType is READ_POOL.
+	// +optional
+	ReadPoolConfig *ReadPoolConfig `json:"readPoolConfig,omitempty"`
+
+	// GCEZone is the Compute Engine zone that the instance should serve
+	// from, per https://cloud.google.com/compute/docs/regions-zones. This can
+	// only be set for ZONAL instances.
+	// +optional
+	// +immutable
+	GCEZone *string `json:"gceZone,omitempty"`
+
+	// DatabaseFlags are the database flags set at the instance level, e.g.
+	// max_connections.
+	// +optional
+	DatabaseFlags map[string]string `json:"databaseFlags,omitempty"`
+
+	// DisplayName is a user-settable and human-readable display name for
+	// the instance.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Labels are used as additional metadata on the instance.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// MachineConfig describes the machine configuration of an instance.
+type MachineConfig struct {
+	// CPUCount is the number of CPUs in the VM instance.
+	// +optional
+	CPUCount *int64 `json:"cpuCount,omitempty"`
+}
+
+// ReadPoolConfig describes the configuration of a read pool instance.
+type ReadPoolConfig struct {
+	// NodeCount is the number of nodes in the read pool.
+	// +optional
+	NodeCount *int64 `json:"nodeCount,omitempty"`
+}
+
+// InstanceObservation is used to show the observed state of the Instance.
+type InstanceObservation struct {
+	// Name is the fully qualified name of the instance, e.g.
+	// projects/{project}/locations/{region}/clusters/{cluster_id}/instances/{instance_id}.
+	Name string `json:"name,omitempty"`
+
+	// UID is a system-generated unique identifier for the instance.
+	UID string `json:"uid,omitempty"`
+
+	// State of the instance.
+	State string `json:"state,omitempty"`
+
+	// IPAddress is the private IP address of the instance.
+	IPAddress string `json:"ipAddress,omitempty"`
+
+	// CreateTime is the time the instance was created in RFC3339 format.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// Reconciling is true when the instance is being updated by the
+	// service.
+	Reconciling bool `json:"reconciling,omitempty"`
+}
+
+// InstanceSpec defines the desired state of an Instance.
+type InstanceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InstanceParameters `json:"forProvider"`
+}
+
+// InstanceStatus represents the observed state of an Instance.
+type InstanceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          InstanceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Instance is a managed resource that represents a primary or read pool
+// instance of an AlloyDB for PostgreSQL cluster.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.instanceType"
+// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".status.atProvider.ipAddress"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Instance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InstanceSpec   `json:"spec"`
+	Status InstanceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InstanceList contains a list of Instance
+type InstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Instance `json:"items"`
+}
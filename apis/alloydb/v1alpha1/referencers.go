@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	computev1beta1 "github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	kmsv1alpha1 "github.com/crossplane/provider-gcp/apis/kms/v1alpha1"
+)
+
+// ClusterName extracts the fully qualified name of a Cluster.
+func ClusterName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		c, ok := mg.(*Cluster)
+		if !ok {
+			return ""
+		}
+		return c.Status.AtProvider.Name
+	}
+}
+
+// ResolveReferences of this Cluster
+func (mg *Cluster) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.network
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Network),
+		Reference:    mg.Spec.ForProvider.NetworkRef,
+		Selector:     mg.Spec.ForProvider.NetworkSelector,
+		To:           reference.To{Managed: &computev1beta1.Network{}, List: &computev1beta1.NetworkList{}},
+		Extract:      computev1beta1.NetworkURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.network")
+	}
+	mg.Spec.ForProvider.Network = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.NetworkRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.encryptionConfig.kmsKeyName
+	if ec := mg.Spec.ForProvider.EncryptionConfig; ec != nil {
+		if err := resolveEncryptionConfig(ctx, r, ec); err != nil {
+			return errors.Wrap(err, "spec.forProvider.encryptionConfig.kmsKeyName")
+		}
+	}
+
+	// Resolve spec.forProvider.automatedBackupPolicy.encryptionConfig.kmsKeyName
+	if p := mg.Spec.ForProvider.AutomatedBackupPolicy; p != nil && p.EncryptionConfig != nil {
+		if err := resolveEncryptionConfig(ctx, r, p.EncryptionConfig); err != nil {
+			return errors.Wrap(err, "spec.forProvider.automatedBackupPolicy.encryptionConfig.kmsKeyName")
+		}
+	}
+
+	return nil
+}
+
+func resolveEncryptionConfig(ctx context.Context, r *reference.APIResolver, ec *EncryptionConfig) error {
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(ec.KMSKeyName),
+		Reference:    ec.KMSKeyNameRef,
+		Selector:     ec.KMSKeyNameSelector,
+		To:           reference.To{Managed: &kmsv1alpha1.CryptoKey{}, List: &kmsv1alpha1.CryptoKeyList{}},
+		Extract:      kmsv1alpha1.CryptoKeyRRN(),
+	})
+	if err != nil {
+		return err
+	}
+	ec.KMSKeyName = reference.ToPtrValue(rsp.ResolvedValue)
+	ec.KMSKeyNameRef = rsp.ResolvedReference
+	return nil
+}
+
+// ResolveReferences of this Instance
+func (mg *Instance) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.cluster
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Cluster),
+		Reference:    mg.Spec.ForProvider.ClusterRef,
+		Selector:     mg.Spec.ForProvider.ClusterSelector,
+		To:           reference.To{Managed: &Cluster{}, List: &ClusterList{}},
+		Extract:      ClusterName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.cluster")
+	}
+	mg.Spec.ForProvider.Cluster = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ClusterRef = rsp.ResolvedReference
+
+	return nil
+}
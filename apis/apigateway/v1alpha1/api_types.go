@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known API, APIConfig and Gateway states.
+const (
+	StateCreating   = "CREATING"
+	StateActive     = "ACTIVE"
+	StateFailed     = "FAILED"
+	StateDeleting   = "DELETING"
+	StateUpdating   = "UPDATING"
+	StateActivating = "ACTIVATING"
+)
+
+// APIParameters define the desired state of an API Gateway API. APIs are
+// global resources. Most fields map directly to an Api:
+// https://cloud.google.com/api-gateway/docs/reference/rest/v1/projects.locations.apis#Api
+type APIParameters struct {
+	// DisplayName is a human readable name of the API.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Labels to apply to the API.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// ManagedService is the name of the Service Management service that
+	// backs the API. A new service is created if it is not set.
+	// +optional
+	// +immutable
+	ManagedService *string `json:"managedService,omitempty"`
+}
+
+// APIObservation is used to show the observed state of an API.
+type APIObservation struct {
+	// Name is the fully qualified name of the API.
+	Name string `json:"name,omitempty"`
+
+	// State of the API.
+	State string `json:"state,omitempty"`
+
+	// CreateTime is the time the API was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the API was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// An APISpec defines the desired state of an API.
+type APISpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       APIParameters `json:"forProvider"`
+}
+
+// An APIStatus represents the observed state of an API.
+type APIStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          APIObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An API is a managed resource that represents an API Gateway API.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type API struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   APISpec   `json:"spec"`
+	Status APIStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// APIList contains a list of API
+type APIList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []API `json:"items"`
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// APIConfigParameters define the desired state of an API Gateway APIConfig.
+// The documents of an APIConfig cannot be changed once it is created; create
+// a new APIConfig and point the Gateway at it instead. Most fields map
+// directly to an ApiConfig:
+// https://cloud.google.com/api-gateway/docs/reference/rest/v1/projects.locations.apis.configs#ApiConfig
+type APIConfigParameters struct {
+	// API is the name of the API this config belongs to.
+	// +optional
+	// +immutable
+	API *string `json:"api,omitempty"`
+
+	// APIRef references an API to retrieve its name.
+	// +optional
+	// +immutable
+	APIRef *xpv1.Reference `json:"apiRef,omitempty"`
+
+	// APISelector selects a reference to an API to retrieve its name.
+	// +optional
+	// +immutable
+	APISelector *xpv1.Selector `json:"apiSelector,omitempty"`
+
+	// DisplayName is a human readable name of the config.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Labels to apply to the config.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// GatewayServiceAccount is the email address of the IAM service account
+	// the gateway uses to authenticate to backends.
+	// +optional
+	// +immutable
+	GatewayServiceAccount *string `json:"gatewayServiceAccount,omitempty"`
+
+	// GatewayServiceAccountRef references a ServiceAccount to retrieve its
+	// email address.
+	// +optional
+	// +immutable
+	GatewayServiceAccountRef *xpv1.Reference `json:"gatewayServiceAccountRef,omitempty"`
+
+	// GatewayServiceAccountSelector selects a reference to a ServiceAccount
+	// to retrieve its email address.
+	// +optional
+	// +immutable
+	GatewayServiceAccountSelector *xpv1.Selector `json:"gatewayServiceAccountSelector,omitempty"`
+
+	// OpenAPIDocuments are the OpenAPI specifications that define the API.
+	// +immutable
+	// +kubebuilder:validation:MinItems=1
+	OpenAPIDocuments []OpenAPIDocument `json:"openapiDocuments"`
+}
+
+// An OpenAPIDocument is an OpenAPI specification of an APIConfig. Exactly one
+// of Contents, ConfigMapRef or SecretRef must be set.
+type OpenAPIDocument struct {
+	// Path is the file name of the document, e.g. openapi.yaml.
+	Path string `json:"path"`
+
+	// Contents of the document in YAML or JSON.
+	// +optional
+	Contents *string `json:"contents,omitempty"`
+
+	// ConfigMapRef selects a key of a ConfigMap that contains the document.
+	// +optional
+	ConfigMapRef *ConfigMapKeySelector `json:"configMapRef,omitempty"`
+
+	// SecretRef selects a key of a Secret that contains the document.
+	// +optional
+	SecretRef *xpv1.SecretKeySelector `json:"secretRef,omitempty"`
+}
+
+// A ConfigMapKeySelector is a reference to a ConfigMap key in an arbitrary
+// namespace.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// Key within the ConfigMap.
+	Key string `json:"key"`
+}
+
+// APIConfigObservation is used to show the observed state of an APIConfig.
+type APIConfigObservation struct {
+	// Name is the fully qualified name of the config.
+	Name string `json:"name,omitempty"`
+
+	// State of the config.
+	State string `json:"state,omitempty"`
+
+	// ServiceConfigID is the ID of the Service Management service config
+	// created from this config.
+	ServiceConfigID string `json:"serviceConfigId,omitempty"`
+
+	// CreateTime is the time the config was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the config was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// An APIConfigSpec defines the desired state of an APIConfig.
+type APIConfigSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       APIConfigParameters `json:"forProvider"`
+}
+
+// An APIConfigStatus represents the observed state of an APIConfig.
+type APIConfigStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          APIConfigObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An APIConfig is a managed resource that represents an API Gateway APIConfig.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type APIConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   APIConfigSpec   `json:"spec"`
+	Status APIConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// APIConfigList contains a list of APIConfig
+type APIConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []APIConfig `json:"items"`
+}
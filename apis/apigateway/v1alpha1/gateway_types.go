@@ -0,0 +1,112 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// GatewayParameters define the desired state of an API Gateway Gateway. Most
+// fields map directly to a Gateway:
+// https://cloud.google.com/api-gateway/docs/reference/rest/v1/projects.locations.gateways#Gateway
+type GatewayParameters struct {
+	// Location in which to create this gateway, e.g. us-central1.
+	// +immutable
+	Location string `json:"location"`
+
+	// APIConfig is the fully qualified name of the APIConfig the gateway
+	// serves, in the form
+	// projects/{project}/locations/global/apis/{api}/configs/{config}.
+	// +optional
+	APIConfig *string `json:"apiConfig,omitempty"`
+
+	// APIConfigRef references an APIConfig to retrieve its name.
+	// +optional
+	APIConfigRef *xpv1.Reference `json:"apiConfigRef,omitempty"`
+
+	// APIConfigSelector selects a reference to an APIConfig to retrieve its
+	// name.
+	// +optional
+	APIConfigSelector *xpv1.Selector `json:"apiConfigSelector,omitempty"`
+
+	// DisplayName is a human readable name of the gateway.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Labels to apply to the gateway.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// GatewayObservation is used to show the observed state of a Gateway.
+type GatewayObservation struct {
+	// Name is the fully qualified name of the gateway.
+	Name string `json:"name,omitempty"`
+
+	// State of the gateway.
+	State string `json:"state,omitempty"`
+
+	// DefaultHostname is the hostname on which the gateway serves the API.
+	DefaultHostname string `json:"defaultHostname,omitempty"`
+
+	// CreateTime is the time the gateway was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the gateway was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// A GatewaySpec defines the desired state of a Gateway.
+type GatewaySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       GatewayParameters `json:"forProvider"`
+}
+
+// A GatewayStatus represents the observed state of a Gateway.
+type GatewayStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          GatewayObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Gateway is a managed resource that represents an API Gateway Gateway.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="HOSTNAME",type="string",JSONPath=".status.atProvider.defaultHostname"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Gateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GatewaySpec   `json:"spec"`
+	Status GatewayStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GatewayList contains a list of Gateway
+type GatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Gateway `json:"items"`
+}
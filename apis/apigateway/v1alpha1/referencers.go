@@ -0,0 +1,96 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	iamv1alpha1 "github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+)
+
+// APIConfigName extracts the fully qualified name of an APIConfig.
+func APIConfigName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		c, ok := mg.(*APIConfig)
+		if !ok {
+			return ""
+		}
+		return c.Status.AtProvider.Name
+	}
+}
+
+// ResolveReferences of this APIConfig
+func (in *APIConfig) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.api
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.API),
+		Reference:    in.Spec.ForProvider.APIRef,
+		Selector:     in.Spec.ForProvider.APISelector,
+		To:           reference.To{Managed: &API{}, List: &APIList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.api")
+	}
+	in.Spec.ForProvider.API = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.APIRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.gatewayServiceAccount
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.GatewayServiceAccount),
+		Reference:    in.Spec.ForProvider.GatewayServiceAccountRef,
+		Selector:     in.Spec.ForProvider.GatewayServiceAccountSelector,
+		To:           reference.To{Managed: &iamv1alpha1.ServiceAccount{}, List: &iamv1alpha1.ServiceAccountList{}},
+		Extract:      iamv1alpha1.ServiceAccountEmail(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.gatewayServiceAccount")
+	}
+	in.Spec.ForProvider.GatewayServiceAccount = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.GatewayServiceAccountRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Gateway
+func (in *Gateway) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.apiConfig
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.APIConfig),
+		Reference:    in.Spec.ForProvider.APIConfigRef,
+		Selector:     in.Spec.ForProvider.APIConfigSelector,
+		To:           reference.To{Managed: &APIConfig{}, List: &APIConfigList{}},
+		Extract:      APIConfigName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.apiConfig")
+	}
+	in.Spec.ForProvider.APIConfig = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.APIConfigRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// EnvGroupParameters define the desired state of an Apigee EnvironmentGroup.
+// Most fields map directly to an EnvironmentGroup:
+// https://cloud.google.com/apigee/docs/reference/apis/apigee/rest/v1/organizations.envgroups#EnvironmentGroup
+type EnvGroupParameters struct {
+	// Organization of the environment group. Defaults to the organization of
+	// the project of the referenced ProviderConfig.
+	// +optional
+	// +immutable
+	Organization *string `json:"organization,omitempty"`
+
+	// OrganizationRef references an Organization to retrieve its name.
+	// +optional
+	// +immutable
+	OrganizationRef *xpv1.Reference `json:"organizationRef,omitempty"`
+
+	// OrganizationSelector selects a reference to an Organization.
+	// +optional
+	OrganizationSelector *xpv1.Selector `json:"organizationSelector,omitempty"`
+
+	// Hostnames of the environment group.
+	// +kubebuilder:validation:MinItems=1
+	Hostnames []string `json:"hostnames"`
+}
+
+// EnvGroupObservation is used to show the observed state of an EnvGroup.
+type EnvGroupObservation struct {
+	// State of the environment group, e.g. ACTIVE.
+	State string `json:"state,omitempty"`
+
+	// CreatedAt is the time the environment group was created, in
+	// milliseconds since epoch.
+	CreatedAt int64 `json:"createdAt,omitempty"`
+
+	// LastModifiedAt is the time the environment group was last modified,
+	// in milliseconds since epoch.
+	LastModifiedAt int64 `json:"lastModifiedAt,omitempty"`
+}
+
+// An EnvGroupSpec defines the desired state of a EnvGroup.
+type EnvGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EnvGroupParameters `json:"forProvider"`
+}
+
+// An EnvGroupStatus represents the observed state of a EnvGroup.
+type EnvGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EnvGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EnvGroup is a managed resource that represents an Apigee EnvironmentGroup.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type EnvGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EnvGroupSpec   `json:"spec"`
+	Status EnvGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EnvGroupList contains a list of EnvGroup
+type EnvGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EnvGroup `json:"items"`
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// EnvironmentParameters define the desired state of an Apigee Environment.
+// Most fields map directly to an Environment:
+// https://cloud.google.com/apigee/docs/reference/apis/apigee/rest/v1/organizations.environments#Environment
+type EnvironmentParameters struct {
+	// Organization of the environment. Defaults to the organization of the
+	// project of the referenced ProviderConfig.
+	// +optional
+	// +immutable
+	Organization *string `json:"organization,omitempty"`
+
+	// OrganizationRef references an Organization to retrieve its name.
+	// +optional
+	// +immutable
+	OrganizationRef *xpv1.Reference `json:"organizationRef,omitempty"`
+
+	// OrganizationSelector selects a reference to an Organization.
+	// +optional
+	OrganizationSelector *xpv1.Selector `json:"organizationSelector,omitempty"`
+
+	// DisplayName of the environment.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Description of the environment.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// DeploymentType of the environment.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=PROXY;ARCHIVE
+	DeploymentType *string `json:"deploymentType,omitempty"`
+
+	// APIProxyType of the environment.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=PROGRAMMABLE;CONFIGURABLE
+	APIProxyType *string `json:"apiProxyType,omitempty"`
+
+	// ForwardProxyURI is the URI of the forward proxy to be applied to the
+	// runtime instances in this environment, e.g. http://proxy:3128.
+	// +optional
+	ForwardProxyURI *string `json:"forwardProxyUri,omitempty"`
+
+	// Properties of the environment.
+	// +optional
+	Properties []Property `json:"properties,omitempty"`
+}
+
+// EnvironmentObservation is used to show the observed state of an
+// Environment.
+type EnvironmentObservation struct {
+	// State of the environment, e.g. ACTIVE.
+	State string `json:"state,omitempty"`
+
+	// CreatedAt is the time the environment was created, in milliseconds
+	// since epoch.
+	CreatedAt int64 `json:"createdAt,omitempty"`
+
+	// LastModifiedAt is the time the environment was last modified, in
+	// milliseconds since epoch.
+	LastModifiedAt int64 `json:"lastModifiedAt,omitempty"`
+}
+
+// An EnvironmentSpec defines the desired state of a Environment.
+type EnvironmentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EnvironmentParameters `json:"forProvider"`
+}
+
+// An EnvironmentStatus represents the observed state of a Environment.
+type EnvironmentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EnvironmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Environment is a managed resource that represents an Apigee Environment.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Environment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EnvironmentSpec   `json:"spec"`
+	Status EnvironmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EnvironmentList contains a list of Environment
+type EnvironmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Environment `json:"items"`
+}
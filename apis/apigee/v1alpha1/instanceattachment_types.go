@@ -0,0 +1,112 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// InstanceAttachmentParameters define the desired state of an Apigee
+// InstanceAttachment, which attaches an Environment to a runtime Instance.
+// https://cloud.google.com/apigee/docs/reference/apis/apigee/rest/v1/organizations.instances.attachments#InstanceAttachment
+type InstanceAttachmentParameters struct {
+	// Organization of the instance. Defaults to the organization of the
+	// project of the referenced ProviderConfig.
+	// +optional
+	// +immutable
+	Organization *string `json:"organization,omitempty"`
+
+	// OrganizationRef references an Organization to retrieve its name.
+	// +optional
+	// +immutable
+	OrganizationRef *xpv1.Reference `json:"organizationRef,omitempty"`
+
+	// OrganizationSelector selects a reference to an Organization.
+	// +optional
+	OrganizationSelector *xpv1.Selector `json:"organizationSelector,omitempty"`
+
+	// Instance is the name of the runtime instance to attach the environment
+	// to.
+	// +immutable
+	Instance string `json:"instance"`
+
+	// Environment to attach to the instance.
+	// +optional
+	// +immutable
+	Environment string `json:"environment,omitempty"`
+
+	// EnvironmentRef references an Environment to retrieve its name.
+	// +optional
+	// +immutable
+	EnvironmentRef *xpv1.Reference `json:"environmentRef,omitempty"`
+
+	// EnvironmentSelector selects a reference to an Environment.
+	// +optional
+	EnvironmentSelector *xpv1.Selector `json:"environmentSelector,omitempty"`
+}
+
+// InstanceAttachmentObservation is used to show the observed state of an
+// InstanceAttachment.
+type InstanceAttachmentObservation struct {
+	// Name is the server generated ID of the attachment.
+	Name string `json:"name,omitempty"`
+
+	// CreatedAt is the time the attachment was created, in milliseconds
+	// since epoch.
+	CreatedAt int64 `json:"createdAt,omitempty"`
+}
+
+// An InstanceAttachmentSpec defines the desired state of a InstanceAttachment.
+type InstanceAttachmentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InstanceAttachmentParameters `json:"forProvider"`
+}
+
+// An InstanceAttachmentStatus represents the observed state of a InstanceAttachment.
+type InstanceAttachmentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          InstanceAttachmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An InstanceAttachment is a managed resource that represents an attachment of an Apigee Environment to a runtime Instance.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="INSTANCE",type="string",JSONPath=".spec.forProvider.instance"
+// +kubebuilder:printcolumn:name="ENVIRONMENT",type="string",JSONPath=".spec.forProvider.environment"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type InstanceAttachment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InstanceAttachmentSpec   `json:"spec"`
+	Status InstanceAttachmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InstanceAttachmentList contains a list of InstanceAttachment
+type InstanceAttachmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InstanceAttachment `json:"items"`
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known Organization, Environment and EnvGroup states.
+const (
+	StateCreating = "CREATING"
+	StateActive   = "ACTIVE"
+	StateDeleting = "DELETING"
+	StateUpdating = "UPDATING"
+)
+
+// Runtime types of an Apigee Organization.
+const (
+	RuntimeTypeCloud  = "CLOUD"
+	RuntimeTypeHybrid = "HYBRID"
+)
+
+// OrganizationParameters define the desired state of an Apigee Organization.
+// An Apigee organization is always named after the project it is provisioned
+// in, i.e. the project of the referenced ProviderConfig. Most fields map
+// directly to an Organization:
+// https://cloud.google.com/apigee/docs/reference/apis/apigee/rest/v1/organizations#Organization
+type OrganizationParameters struct {
+	// DisplayName of the organization.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Description of the organization.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// AnalyticsRegion is the primary Google Cloud region for analytics data
+	// storage, e.g. us-central1.
+	// +immutable
+	AnalyticsRegion string `json:"analyticsRegion"`
+
+	// RuntimeType of the organization.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=CLOUD;HYBRID
+	// +kubebuilder:default=CLOUD
+	RuntimeType string `json:"runtimeType,omitempty"`
+
+	// BillingType of the organization, e.g. EVALUATION, PAYG or
+	// SUBSCRIPTION.
+	// +optional
+	// +immutable
+	BillingType *string `json:"billingType,omitempty"`
+
+	// AuthorizedNetwork is the name of the Compute Engine network used for
+	// Service Networking to be peered with Apigee runtime instances, e.g.
+	// default. Valid only when RuntimeType is CLOUD.
+	// +optional
+	AuthorizedNetwork *string `json:"authorizedNetwork,omitempty"`
+
+	// AuthorizedNetworkRef references a Network to retrieve its name.
+	// +optional
+	AuthorizedNetworkRef *xpv1.Reference `json:"authorizedNetworkRef,omitempty"`
+
+	// AuthorizedNetworkSelector selects a reference to a Network.
+	// +optional
+	AuthorizedNetworkSelector *xpv1.Selector `json:"authorizedNetworkSelector,omitempty"`
+
+	// DisableVPCPeering disables VPC peering with the authorized network.
+	// Private Service Connect is used to reach the runtime instead.
+	// +optional
+	// +immutable
+	DisableVPCPeering *bool `json:"disableVpcPeering,omitempty"`
+
+	// RuntimeDatabaseEncryptionKeyName is the Cloud KMS key name used for
+	// encrypting the data stored in the runtime database, in the form
+	// projects/*/locations/*/keyRings/*/cryptoKeys/*.
+	// +optional
+	// +immutable
+	RuntimeDatabaseEncryptionKeyName *string `json:"runtimeDatabaseEncryptionKeyName,omitempty"`
+
+	// Properties of the organization.
+	// +optional
+	Properties []Property `json:"properties,omitempty"`
+}
+
+// A Property is a name/value pair that configures an Apigee Organization or
+// Environment.
+type Property struct {
+	// Name of the property.
+	Name string `json:"name"`
+
+	// Value of the property.
+	Value string `json:"value"`
+}
+
+// OrganizationObservation is used to show the observed state of an
+// Organization.
+type OrganizationObservation struct {
+	// Name of the organization.
+	Name string `json:"name,omitempty"`
+
+	// State of the organization, e.g. ACTIVE.
+	State string `json:"state,omitempty"`
+
+	// CACertificate is the base64 encoded public certificate for the root
+	// CA of the Apigee organization.
+	CACertificate string `json:"caCertificate,omitempty"`
+
+	// ApigeeProjectID is the project ID associated with the Apigee
+	// organization's tenant project.
+	ApigeeProjectID string `json:"apigeeProjectId,omitempty"`
+
+	// SubscriptionType of the organization.
+	SubscriptionType string `json:"subscriptionType,omitempty"`
+
+	// Environments that belong to the organization.
+	Environments []string `json:"environments,omitempty"`
+
+	// CreatedAt is the time the organization was created, in milliseconds
+	// since epoch.
+	CreatedAt int64 `json:"createdAt,omitempty"`
+
+	// LastModifiedAt is the time the organization was last modified, in
+	// milliseconds since epoch.
+	LastModifiedAt int64 `json:"lastModifiedAt,omitempty"`
+}
+
+// An OrganizationSpec defines the desired state of a Organization.
+type OrganizationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OrganizationParameters `json:"forProvider"`
+}
+
+// An OrganizationStatus represents the observed state of a Organization.
+type OrganizationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OrganizationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Organization is a managed resource that represents an Apigee Organization.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="RUNTIME",type="string",JSONPath=".spec.forProvider.runtimeType"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Organization struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationSpec   `json:"spec"`
+	Status OrganizationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationList contains a list of Organization
+type OrganizationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Organization `json:"items"`
+}
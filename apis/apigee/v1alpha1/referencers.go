@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	computev1beta1 "github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+)
+
+// OrganizationName extracts the name of an Organization.
+func OrganizationName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		o, ok := mg.(*Organization)
+		if !ok {
+			return ""
+		}
+		return o.Status.AtProvider.Name
+	}
+}
+
+// ResolveReferences of this Organization
+func (in *Organization) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.authorizedNetwork
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.AuthorizedNetwork),
+		Reference:    in.Spec.ForProvider.AuthorizedNetworkRef,
+		Selector:     in.Spec.ForProvider.AuthorizedNetworkSelector,
+		To:           reference.To{Managed: &computev1beta1.Network{}, List: &computev1beta1.NetworkList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.authorizedNetwork")
+	}
+	in.Spec.ForProvider.AuthorizedNetwork = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.AuthorizedNetworkRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Environment
+func (in *Environment) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.organization
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Organization),
+		Reference:    in.Spec.ForProvider.OrganizationRef,
+		Selector:     in.Spec.ForProvider.OrganizationSelector,
+		To:           reference.To{Managed: &Organization{}, List: &OrganizationList{}},
+		Extract:      OrganizationName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.organization")
+	}
+	in.Spec.ForProvider.Organization = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.OrganizationRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this EnvGroup
+func (in *EnvGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.organization
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Organization),
+		Reference:    in.Spec.ForProvider.OrganizationRef,
+		Selector:     in.Spec.ForProvider.OrganizationSelector,
+		To:           reference.To{Managed: &Organization{}, List: &OrganizationList{}},
+		Extract:      OrganizationName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.organization")
+	}
+	in.Spec.ForProvider.Organization = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.OrganizationRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this InstanceAttachment
+func (in *InstanceAttachment) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.organization
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Organization),
+		Reference:    in.Spec.ForProvider.OrganizationRef,
+		Selector:     in.Spec.ForProvider.OrganizationSelector,
+		To:           reference.To{Managed: &Organization{}, List: &OrganizationList{}},
+		Extract:      OrganizationName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.organization")
+	}
+	in.Spec.ForProvider.Organization = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.OrganizationRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.environment
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: in.Spec.ForProvider.Environment,
+		Reference:    in.Spec.ForProvider.EnvironmentRef,
+		Selector:     in.Spec.ForProvider.EnvironmentSelector,
+		To:           reference.To{Managed: &Environment{}, List: &EnvironmentList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.environment")
+	}
+	in.Spec.ForProvider.Environment = rsp.ResolvedValue
+	in.Spec.ForProvider.EnvironmentRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "apigee.gcp.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// Organization type metadata.
+var (
+	OrganizationKind             = reflect.TypeOf(Organization{}).Name()
+	OrganizationGroupKind        = schema.GroupKind{Group: Group, Kind: OrganizationKind}.String()
+	OrganizationKindAPIVersion   = OrganizationKind + "." + SchemeGroupVersion.String()
+	OrganizationGroupVersionKind = SchemeGroupVersion.WithKind(OrganizationKind)
+)
+
+// Environment type metadata.
+var (
+	EnvironmentKind             = reflect.TypeOf(Environment{}).Name()
+	EnvironmentGroupKind        = schema.GroupKind{Group: Group, Kind: EnvironmentKind}.String()
+	EnvironmentKindAPIVersion   = EnvironmentKind + "." + SchemeGroupVersion.String()
+	EnvironmentGroupVersionKind = SchemeGroupVersion.WithKind(EnvironmentKind)
+)
+
+// EnvGroup type metadata.
+var (
+	EnvGroupKind             = reflect.TypeOf(EnvGroup{}).Name()
+	EnvGroupGroupKind        = schema.GroupKind{Group: Group, Kind: EnvGroupKind}.String()
+	EnvGroupKindAPIVersion   = EnvGroupKind + "." + SchemeGroupVersion.String()
+	EnvGroupGroupVersionKind = SchemeGroupVersion.WithKind(EnvGroupKind)
+)
+
+// InstanceAttachment type metadata.
+var (
+	InstanceAttachmentKind             = reflect.TypeOf(InstanceAttachment{}).Name()
+	InstanceAttachmentGroupKind        = schema.GroupKind{Group: Group, Kind: InstanceAttachmentKind}.String()
+	InstanceAttachmentKindAPIVersion   = InstanceAttachmentKind + "." + SchemeGroupVersion.String()
+	InstanceAttachmentGroupVersionKind = SchemeGroupVersion.WithKind(InstanceAttachmentKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Organization{}, &OrganizationList{})
+	SchemeBuilder.Register(&Environment{}, &EnvironmentList{})
+	SchemeBuilder.Register(&EnvGroup{}, &EnvGroupList{})
+	SchemeBuilder.Register(&InstanceAttachment{}, &InstanceAttachmentList{})
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known Application serving statuses.
+const (
+	ServingStatusServing        = "SERVING"
+	ServingStatusUserDisabled   = "USER_DISABLED"
+	ServingStatusSystemDisabled = "SYSTEM_DISABLED"
+)
+
+// ApplicationParameters define the desired state of an App Engine
+// Application. A project has at most one Application, which is created in
+// the project of the ProviderConfig. Most fields map directly to an
+// Application:
+// https://cloud.google.com/appengine/docs/admin-api/reference/rest/v1/apps#Application
+type ApplicationParameters struct {
+	// LocationID is the region in which the application is served, e.g.
+	// us-central. It cannot be changed once the application is created.
+	// +immutable
+	LocationID string `json:"locationId"`
+
+	// DatabaseType of the Firestore or Datastore database of the
+	// application.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=CLOUD_FIRESTORE;CLOUD_DATASTORE_COMPATIBILITY
+	DatabaseType *string `json:"databaseType,omitempty"`
+
+	// AuthDomain is the Google Apps domain that authenticates users of the
+	// application. Defaults to gmail.com.
+	// +optional
+	AuthDomain *string `json:"authDomain,omitempty"`
+
+	// ServingStatus of the application. Set to USER_DISABLED to stop
+	// serving traffic without deleting the application.
+	// +optional
+	// +kubebuilder:validation:Enum=SERVING;USER_DISABLED
+	ServingStatus *string `json:"servingStatus,omitempty"`
+
+	// FeatureSettings of the application.
+	// +optional
+	FeatureSettings *FeatureSettings `json:"featureSettings,omitempty"`
+}
+
+// FeatureSettings enable or disable App Engine features.
+type FeatureSettings struct {
+	// SplitHealthChecks uses separate liveness and readiness checks
+	// instead of legacy health checks.
+	// +optional
+	SplitHealthChecks *bool `json:"splitHealthChecks,omitempty"`
+
+	// UseContainerOptimizedOS runs flexible environment instances on
+	// Container-Optimized OS.
+	// +optional
+	UseContainerOptimizedOS *bool `json:"useContainerOptimizedOs,omitempty"`
+}
+
+// ApplicationObservation is used to show the observed state of an
+// Application.
+type ApplicationObservation struct {
+	// Name is the fully qualified name of the application.
+	Name string `json:"name,omitempty"`
+
+	// ServingStatus of the application.
+	ServingStatus string `json:"servingStatus,omitempty"`
+
+	// DefaultHostname is the hostname under which the application is
+	// served, e.g. my-project.appspot.com.
+	DefaultHostname string `json:"defaultHostname,omitempty"`
+
+	// DefaultBucket is the Cloud Storage bucket that can be used by the
+	// application.
+	DefaultBucket string `json:"defaultBucket,omitempty"`
+
+	// CodeBucket is the Cloud Storage bucket that stores the files of the
+	// application's versions.
+	CodeBucket string `json:"codeBucket,omitempty"`
+
+	// GCRDomain is the Container Registry domain used for the images of
+	// the application.
+	GCRDomain string `json:"gcrDomain,omitempty"`
+
+	// ServiceAccount is the default identity of the application.
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+}
+
+// A ApplicationSpec defines the desired state of a Application.
+type ApplicationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ApplicationParameters `json:"forProvider"`
+}
+
+// A ApplicationStatus represents the observed state of a Application.
+type ApplicationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ApplicationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Application is a managed resource that represents an App Engine Application. Deleting an Application does not delete
+// it in GCP, since App Engine applications cannot be deleted.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.forProvider.locationId"
+// +kubebuilder:printcolumn:name="HOSTNAME",type="string",JSONPath=".status.atProvider.defaultHostname"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Application struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ApplicationSpec   `json:"spec"`
+	Status ApplicationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ApplicationList contains a list of Application
+type ApplicationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Application `json:"items"`
+}
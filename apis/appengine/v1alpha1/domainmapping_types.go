@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// DomainMappingParameters define the desired state of an App Engine
+// DomainMapping. The external name of the resource is the mapped domain, e.g.
+// www.example.com. Most fields map directly to a DomainMapping:
+// https://cloud.google.com/appengine/docs/admin-api/reference/rest/v1/apps.domainMappings#DomainMapping
+type DomainMappingParameters struct {
+	// SSLSettings configure serving the domain over HTTPS.
+	// +optional
+	SSLSettings *SSLSettings `json:"sslSettings,omitempty"`
+}
+
+// SSLSettings configure serving a domain over HTTPS.
+type SSLSettings struct {
+	// SSLManagementType selects whether the certificate is managed by App
+	// Engine (AUTOMATIC) or uploaded by the user (MANUAL).
+	// +optional
+	// +kubebuilder:validation:Enum=AUTOMATIC;MANUAL
+	SSLManagementType *string `json:"sslManagementType,omitempty"`
+
+	// CertificateID of the uploaded certificate to serve the domain with.
+	// Only used if SSLManagementType is MANUAL.
+	// +optional
+	CertificateID *string `json:"certificateId,omitempty"`
+}
+
+// ResourceRecord is a DNS record that must be created for a domain mapping
+// to serve traffic.
+type ResourceRecord struct {
+	// Name of the record, e.g. www.
+	Name string `json:"name,omitempty"`
+
+	// Type of the record, e.g. CNAME.
+	Type string `json:"type,omitempty"`
+
+	// RRData is the data of the record, e.g. ghs.googlehosted.com.
+	RRData string `json:"rrdata,omitempty"`
+}
+
+// DomainMappingObservation is used to show the observed state of a
+// DomainMapping.
+type DomainMappingObservation struct {
+	// Name is the fully qualified name of the domain mapping.
+	Name string `json:"name,omitempty"`
+
+	// ResourceRecords that must be created in the DNS zone of the domain.
+	ResourceRecords []ResourceRecord `json:"resourceRecords,omitempty"`
+
+	// PendingManagedCertificateID is the ID of a managed certificate that
+	// is still being provisioned.
+	PendingManagedCertificateID string `json:"pendingManagedCertificateId,omitempty"`
+}
+
+// A DomainMappingSpec defines the desired state of a DomainMapping.
+type DomainMappingSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DomainMappingParameters `json:"forProvider"`
+}
+
+// A DomainMappingStatus represents the observed state of a DomainMapping.
+type DomainMappingStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DomainMappingObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DomainMapping is a managed resource that represents an App Engine DomainMapping.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type DomainMapping struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DomainMappingSpec   `json:"spec"`
+	Status DomainMappingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DomainMappingList contains a list of DomainMapping
+type DomainMappingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DomainMapping `json:"items"`
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// FirewallRuleParameters define the desired state of an App Engine
+// FirewallRule. Most fields map directly to a FirewallRule:
+// https://cloud.google.com/appengine/docs/admin-api/reference/rest/v1/apps.firewall.ingressRules#FirewallRule
+type FirewallRuleParameters struct {
+	// Priority of the rule, which also identifies it. Rules are evaluated
+	// in ascending order of priority; the default rule has priority
+	// 2147483647.
+	// +immutable
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=2147483646
+	Priority int64 `json:"priority"`
+
+	// Action to take for requests that match the source range.
+	// +kubebuilder:validation:Enum=ALLOW;DENY
+	Action string `json:"action"`
+
+	// SourceRange is the IP address or CIDR range the rule matches, e.g.
+	// 192.0.2.0/24 or *.
+	SourceRange string `json:"sourceRange"`
+
+	// Description of the rule.
+	// +optional
+	Description *string `json:"description,omitempty"`
+}
+
+// FirewallRuleObservation is used to show the observed state of a
+// FirewallRule.
+type FirewallRuleObservation struct{}
+
+// A FirewallRuleSpec defines the desired state of a FirewallRule.
+type FirewallRuleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FirewallRuleParameters `json:"forProvider"`
+}
+
+// A FirewallRuleStatus represents the observed state of a FirewallRule.
+type FirewallRuleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          FirewallRuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A FirewallRule is a managed resource that represents an App Engine firewall ingress rule.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PRIORITY",type="integer",JSONPath=".spec.forProvider.priority"
+// +kubebuilder:printcolumn:name="ACTION",type="string",JSONPath=".spec.forProvider.action"
+// +kubebuilder:printcolumn:name="SOURCE",type="string",JSONPath=".spec.forProvider.sourceRange"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type FirewallRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FirewallRuleSpec   `json:"spec"`
+	Status FirewallRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FirewallRuleList contains a list of FirewallRule
+type FirewallRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FirewallRule `json:"items"`
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	iamv1alpha1 "github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	kmsv1alpha1 "github.com/crossplane/provider-gcp/apis/kms/v1alpha1"
+	resourcemanagerv1alpha1 "github.com/crossplane/provider-gcp/apis/resourcemanager/v1alpha1"
+)
+
+// RepositoryName extracts the fully qualified name of a Repository.
+func RepositoryName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*Repository)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.Name
+	}
+}
+
+// ResolveReferences of this Repository
+func (in *Repository) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.project
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Project),
+		Reference:    in.Spec.ForProvider.ProjectRef,
+		Selector:     in.Spec.ForProvider.ProjectSelector,
+		To:           reference.To{Managed: &resourcemanagerv1alpha1.Project{}, List: &resourcemanagerv1alpha1.ProjectList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.project")
+	}
+	in.Spec.ForProvider.Project = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.ProjectRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.kmsKeyName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.KMSKeyName),
+		Reference:    in.Spec.ForProvider.KMSKeyNameRef,
+		Selector:     in.Spec.ForProvider.KMSKeyNameSelector,
+		To:           reference.To{Managed: &kmsv1alpha1.CryptoKey{}, List: &kmsv1alpha1.CryptoKeyList{}},
+		Extract:      kmsv1alpha1.CryptoKeyRRN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.kmsKeyName")
+	}
+	in.Spec.ForProvider.KMSKeyName = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.KMSKeyNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.virtualRepositoryConfig.upstreamPolicies[*].repository
+	if vc := in.Spec.ForProvider.VirtualRepositoryConfig; vc != nil {
+		for i := range vc.UpstreamPolicies {
+			u := &vc.UpstreamPolicies[i]
+			rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(u.Repository),
+				Reference:    u.RepositoryRef,
+				Selector:     u.RepositorySelector,
+				To:           reference.To{Managed: &Repository{}, List: &RepositoryList{}},
+				Extract:      RepositoryName(),
+			})
+			if err != nil {
+				return errors.Wrapf(err, "spec.forProvider.virtualRepositoryConfig.upstreamPolicies[%d].repository", i)
+			}
+			u.Repository = reference.ToPtrValue(rsp.ResolvedValue)
+			u.RepositoryRef = rsp.ResolvedReference
+		}
+	}
+
+	return nil
+}
+
+// ResolveReferences of this RepositoryIAMMember
+func (in *RepositoryIAMMember) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.repository
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Repository),
+		Reference:    in.Spec.ForProvider.RepositoryRef,
+		Selector:     in.Spec.ForProvider.RepositorySelector,
+		To:           reference.To{Managed: &Repository{}, List: &RepositoryList{}},
+		Extract:      RepositoryName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.repository")
+	}
+	in.Spec.ForProvider.Repository = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.RepositoryRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.member
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Member),
+		Reference:    in.Spec.ForProvider.ServiceAccountMemberRef,
+		Selector:     in.Spec.ForProvider.ServiceAccountMemberSelector,
+		To:           reference.To{Managed: &iamv1alpha1.ServiceAccount{}, List: &iamv1alpha1.ServiceAccountList{}},
+		Extract:      iamv1alpha1.ServiceAccountMemberName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.member")
+	}
+	in.Spec.ForProvider.Member = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.ServiceAccountMemberRef = rsp.ResolvedReference
+
+	return nil
+}
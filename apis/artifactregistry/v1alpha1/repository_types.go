@@ -0,0 +1,356 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Repository modes.
+const (
+	RepositoryModeStandard = "STANDARD_REPOSITORY"
+	RepositoryModeVirtual  = "VIRTUAL_REPOSITORY"
+	RepositoryModeRemote   = "REMOTE_REPOSITORY"
+)
+
+// RepositoryParameters define the desired state of an Artifact Registry
+// repository.
+type RepositoryParameters struct {
+	// Project the repository belongs to. Defaults to the project of the
+	// provider config.
+	// +immutable
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// ProjectRef references a Project and retrieves its external name.
+	// +immutable
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and retrieves its
+	// external name.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// Location of the repository, a region such as us-central1 or a
+	// multi-region such as us.
+	// +immutable
+	Location string `json:"location"`
+
+	// Format of the packages stored in the repository.
+	// +immutable
+	// +kubebuilder:validation:Enum=DOCKER;MAVEN;NPM;PYTHON;APT;YUM;GO
+	Format string `json:"format"`
+
+	// Mode of the repository. Standard repositories store artifacts, remote
+	// repositories cache the artifacts of a public upstream and virtual
+	// repositories serve the artifacts of other repositories of the same
+	// format. Defaults to STANDARD_REPOSITORY.
+	// +immutable
+	// +optional
+	// +kubebuilder:validation:Enum=STANDARD_REPOSITORY;VIRTUAL_REPOSITORY;REMOTE_REPOSITORY
+	Mode *string `json:"mode,omitempty"`
+
+	// Description of the repository.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels to apply to the repository.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// KMSKeyName is the resource name of the Cloud KMS key the contents of
+	// the repository are encrypted with, in the form
+	// projects/{project}/locations/{location}/keyRings/{keyring}/cryptoKeys/{key}.
+	// The Artifact Registry service agent must be allowed to use the key.
+	// +immutable
+	// +optional
+	KMSKeyName *string `json:"kmsKeyName,omitempty"`
+
+	// KMSKeyNameRef references a CryptoKey and retrieves its resource name.
+	// +immutable
+	// +optional
+	KMSKeyNameRef *xpv1.Reference `json:"kmsKeyNameRef,omitempty"`
+
+	// KMSKeyNameSelector selects a reference to a CryptoKey and retrieves
+	// its resource name.
+	// +optional
+	KMSKeyNameSelector *xpv1.Selector `json:"kmsKeyNameSelector,omitempty"`
+
+	// DockerConfig configures DOCKER repositories.
+	// +optional
+	DockerConfig *DockerRepositoryConfig `json:"dockerConfig,omitempty"`
+
+	// MavenConfig configures MAVEN repositories.
+	// +immutable
+	// +optional
+	MavenConfig *MavenRepositoryConfig `json:"mavenConfig,omitempty"`
+
+	// RemoteRepositoryConfig configures the upstream of a
+	// REMOTE_REPOSITORY.
+	// +immutable
+	// +optional
+	RemoteRepositoryConfig *RemoteRepositoryConfig `json:"remoteRepositoryConfig,omitempty"`
+
+	// VirtualRepositoryConfig configures the upstreams of a
+	// VIRTUAL_REPOSITORY.
+	// +optional
+	VirtualRepositoryConfig *VirtualRepositoryConfig `json:"virtualRepositoryConfig,omitempty"`
+
+	// CleanupPolicies decide which package versions are automatically
+	// deleted from the repository.
+	// +optional
+	CleanupPolicies []CleanupPolicy `json:"cleanupPolicies,omitempty"`
+
+	// CleanupPolicyDryRun prevents the cleanup policies from actually
+	// deleting versions, so their effect can be evaluated first.
+	// +optional
+	CleanupPolicyDryRun *bool `json:"cleanupPolicyDryRun,omitempty"`
+}
+
+// DockerRepositoryConfig configures a DOCKER repository.
+type DockerRepositoryConfig struct {
+	// ImmutableTags prevents tags from being moved or deleted once they
+	// were pushed.
+	// +optional
+	ImmutableTags *bool `json:"immutableTags,omitempty"`
+}
+
+// MavenRepositoryConfig configures a MAVEN repository.
+type MavenRepositoryConfig struct {
+	// AllowSnapshotOverwrites allows snapshot versions to be overwritten.
+	// +optional
+	AllowSnapshotOverwrites *bool `json:"allowSnapshotOverwrites,omitempty"`
+
+	// VersionPolicy restricts the repository to release or snapshot
+	// versions.
+	// +optional
+	// +kubebuilder:validation:Enum=RELEASE;SNAPSHOT
+	VersionPolicy *string `json:"versionPolicy,omitempty"`
+}
+
+// RemoteRepositoryConfig configures the public upstream a REMOTE_REPOSITORY
+// caches. Exactly one upstream matching the format of the repository must be
+// set.
+type RemoteRepositoryConfig struct {
+	// Description of the upstream.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// DockerRepository is the upstream of a DOCKER repository.
+	// +optional
+	DockerRepository *RemoteDockerRepository `json:"dockerRepository,omitempty"`
+
+	// MavenRepository is the upstream of a MAVEN repository.
+	// +optional
+	MavenRepository *RemoteMavenRepository `json:"mavenRepository,omitempty"`
+
+	// NPMRepository is the upstream of an NPM repository.
+	// +optional
+	NPMRepository *RemoteNPMRepository `json:"npmRepository,omitempty"`
+
+	// PythonRepository is the upstream of a PYTHON repository.
+	// +optional
+	PythonRepository *RemotePythonRepository `json:"pythonRepository,omitempty"`
+}
+
+// RemoteDockerRepository is a public Docker registry.
+type RemoteDockerRepository struct {
+	// PublicRepository to cache.
+	// +kubebuilder:validation:Enum=DOCKER_HUB
+	PublicRepository string `json:"publicRepository"`
+}
+
+// RemoteMavenRepository is a public Maven repository.
+type RemoteMavenRepository struct {
+	// PublicRepository to cache.
+	// +kubebuilder:validation:Enum=MAVEN_CENTRAL
+	PublicRepository string `json:"publicRepository"`
+}
+
+// RemoteNPMRepository is a public npm registry.
+type RemoteNPMRepository struct {
+	// PublicRepository to cache.
+	// +kubebuilder:validation:Enum=NPMJS
+	PublicRepository string `json:"publicRepository"`
+}
+
+// RemotePythonRepository is a public Python package index.
+type RemotePythonRepository struct {
+	// PublicRepository to cache.
+	// +kubebuilder:validation:Enum=PYPI
+	PublicRepository string `json:"publicRepository"`
+}
+
+// VirtualRepositoryConfig configures the repositories a VIRTUAL_REPOSITORY
+// serves artifacts from.
+type VirtualRepositoryConfig struct {
+	// UpstreamPolicies list the upstream repositories.
+	// +optional
+	UpstreamPolicies []UpstreamPolicy `json:"upstreamPolicies,omitempty"`
+}
+
+// An UpstreamPolicy adds a repository to a virtual repository.
+type UpstreamPolicy struct {
+	// ID of the upstream policy.
+	ID string `json:"id"`
+
+	// Repository is the resource name of the upstream repository, in the
+	// form projects/{project}/locations/{location}/repositories/{repository}.
+	// +optional
+	Repository *string `json:"repository,omitempty"`
+
+	// RepositoryRef references a Repository and retrieves its resource
+	// name.
+	// +optional
+	RepositoryRef *xpv1.Reference `json:"repositoryRef,omitempty"`
+
+	// RepositorySelector selects a reference to a Repository and retrieves
+	// its resource name.
+	// +optional
+	RepositorySelector *xpv1.Selector `json:"repositorySelector,omitempty"`
+
+	// Priority of the upstream. Artifacts are served from the upstream
+	// with the highest priority that has them.
+	// +optional
+	Priority *int64 `json:"priority,omitempty"`
+}
+
+// A CleanupPolicy deletes or keeps the package versions matching its
+// condition. Versions kept by any policy are never deleted.
+type CleanupPolicy struct {
+	// ID of the policy.
+	ID string `json:"id"`
+
+	// Action to take on the matching versions.
+	// +kubebuilder:validation:Enum=DELETE;KEEP
+	Action string `json:"action"`
+
+	// Condition the versions must match. Exactly one of Condition and
+	// MostRecentVersions must be set.
+	// +optional
+	Condition *CleanupPolicyCondition `json:"condition,omitempty"`
+
+	// MostRecentVersions matches the most recent versions of each package.
+	// Only valid with the KEEP action.
+	// +optional
+	MostRecentVersions *CleanupPolicyMostRecentVersions `json:"mostRecentVersions,omitempty"`
+}
+
+// A CleanupPolicyCondition matches package versions. All of the set fields
+// must match.
+type CleanupPolicyCondition struct {
+	// TagState matches versions by whether they are tagged.
+	// +optional
+	// +kubebuilder:validation:Enum=TAGGED;UNTAGGED;ANY
+	TagState *string `json:"tagState,omitempty"`
+
+	// TagPrefixes matches versions with a tag starting with one of the
+	// prefixes.
+	// +optional
+	TagPrefixes []string `json:"tagPrefixes,omitempty"`
+
+	// VersionNamePrefixes matches versions whose name starts with one of
+	// the prefixes.
+	// +optional
+	VersionNamePrefixes []string `json:"versionNamePrefixes,omitempty"`
+
+	// PackageNamePrefixes matches the versions of packages whose name
+	// starts with one of the prefixes.
+	// +optional
+	PackageNamePrefixes []string `json:"packageNamePrefixes,omitempty"`
+
+	// OlderThan matches versions that were uploaded longer ago than this
+	// duration, e.g. 2592000s.
+	// +optional
+	OlderThan *string `json:"olderThan,omitempty"`
+
+	// NewerThan matches versions that were uploaded less long ago than this
+	// duration, e.g. 86400s.
+	// +optional
+	NewerThan *string `json:"newerThan,omitempty"`
+}
+
+// CleanupPolicyMostRecentVersions matches the most recent versions of each
+// package.
+type CleanupPolicyMostRecentVersions struct {
+	// KeepCount is the number of versions to keep.
+	// +optional
+	KeepCount *int64 `json:"keepCount,omitempty"`
+
+	// PackageNamePrefixes restricts the policy to packages whose name
+	// starts with one of the prefixes.
+	// +optional
+	PackageNamePrefixes []string `json:"packageNamePrefixes,omitempty"`
+}
+
+// RepositoryObservation is used to show the observed state of the
+// Repository.
+type RepositoryObservation struct {
+	// Name is the resource name of the repository.
+	Name string `json:"name,omitempty"`
+
+	// CreateTime is when the repository was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is when the repository was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+
+	// SizeBytes is the size of all artifacts stored in the repository.
+	SizeBytes int64 `json:"sizeBytes,omitempty"`
+}
+
+// A RepositorySpec defines the desired state of a Repository.
+type RepositorySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RepositoryParameters `json:"forProvider"`
+}
+
+// A RepositoryStatus represents the observed state of a Repository.
+type RepositoryStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RepositoryObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Repository is a managed resource that represents a GCP Artifact Registry repository.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="FORMAT",type="string",JSONPath=".spec.forProvider.format"
+// +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.forProvider.location"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Repository struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RepositorySpec   `json:"spec"`
+	Status RepositoryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RepositoryList contains a list of Repository
+type RepositoryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Repository `json:"items"`
+}
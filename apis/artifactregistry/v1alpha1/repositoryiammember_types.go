@@ -0,0 +1,109 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// RepositoryIAMMemberParameters defines parameters for a desired
+// RepositoryIAMMember.
+type RepositoryIAMMemberParameters struct {
+	// Repository is the fully qualified name of the Artifact Registry
+	// Repository to which this member is bound, in the form
+	// projects/{project}/locations/{location}/repositories/{repository}.
+	// +optional
+	// +immutable
+	Repository *string `json:"repository,omitempty"`
+
+	// RepositoryRef references a Repository and retrieves its fully
+	// qualified name.
+	// +optional
+	// +immutable
+	RepositoryRef *xpv1.Reference `json:"repositoryRef,omitempty"`
+
+	// RepositorySelector selects a reference to a Repository.
+	// +optional
+	RepositorySelector *xpv1.Selector `json:"repositorySelector,omitempty"`
+
+	// Role that is assigned to Member, e.g. roles/artifactregistry.reader or
+	// roles/artifactregistry.writer.
+	// +immutable
+	Role string `json:"role"`
+
+	// Member is the identity that is granted Role, e.g. allUsers,
+	// user:{email}, serviceAccount:{email} or group:{email}.
+	// +optional
+	// +immutable
+	Member *string `json:"member,omitempty"`
+
+	// ServiceAccountMemberRef is reference to ServiceAccount used to set
+	// the Member.
+	// +optional
+	// +immutable
+	ServiceAccountMemberRef *xpv1.Reference `json:"serviceAccountMemberRef,omitempty"`
+
+	// ServiceAccountMemberSelector selects reference to ServiceAccount used
+	// to set the Member.
+	// +optional
+	// +immutable
+	ServiceAccountMemberSelector *xpv1.Selector `json:"serviceAccountMemberSelector,omitempty"`
+}
+
+// RepositoryIAMMemberSpec defines the desired state of a
+// RepositoryIAMMember.
+type RepositoryIAMMemberSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RepositoryIAMMemberParameters `json:"forProvider"`
+}
+
+// RepositoryIAMMemberStatus represents the observed state of a
+// RepositoryIAMMember.
+type RepositoryIAMMemberStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// RepositoryIAMMember is a managed resource that represents membership
+// of an Artifact Registry Repository IAM Policy.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ROLE",type="string",JSONPath=".spec.forProvider.role"
+// +kubebuilder:printcolumn:name="MEMBER",type="string",JSONPath=".spec.forProvider.member"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type RepositoryIAMMember struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RepositoryIAMMemberSpec   `json:"spec"`
+	Status RepositoryIAMMemberStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RepositoryIAMMemberList contains a list of RepositoryIAMMember
+// types
+type RepositoryIAMMemberList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RepositoryIAMMember `json:"items"`
+}
@@ -0,0 +1,248 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// BudgetParameters define the desired state of a Cloud Billing Budget.
+// Budget IDs are assigned by Cloud Billing; the external name of the
+// resource is the budget ID. Most fields map directly to a Budget:
+// https://cloud.google.com/billing/docs/reference/budget/rest/v1/billingAccounts.budgets#Budget
+type BudgetParameters struct {
+	// BillingAccount the budget belongs to, e.g. 012345-567890-ABCDEF.
+	// +immutable
+	BillingAccount string `json:"billingAccount"`
+
+	// DisplayName of the budget.
+	// +kubebuilder:validation:MaxLength=60
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Amount of the budget for each usage period.
+	Amount BudgetAmount `json:"amount"`
+
+	// Filter limits the usage that is counted against the budget. All
+	// usage paid for by the billing account is counted if this is not set.
+	// +optional
+	Filter *BudgetFilter `json:"filter,omitempty"`
+
+	// ThresholdRules trigger alerts when the spend exceeds a percentage of
+	// the budget.
+	// +optional
+	ThresholdRules []ThresholdRule `json:"thresholdRules,omitempty"`
+
+	// NotificationsRule configures where alerts are sent.
+	// +optional
+	NotificationsRule *NotificationsRule `json:"notificationsRule,omitempty"`
+}
+
+// BudgetAmount is the budgeted amount for each usage period. Exactly one of
+// its fields must be set.
+type BudgetAmount struct {
+	// SpecifiedAmount is a fixed amount to use as the budget.
+	// +optional
+	SpecifiedAmount *Money `json:"specifiedAmount,omitempty"`
+
+	// LastPeriodAmount uses the actual spend of the last period as the
+	// budget. It can only be used with a calendar period.
+	// +optional
+	LastPeriodAmount *bool `json:"lastPeriodAmount,omitempty"`
+}
+
+// Money is an amount of money in a currency.
+type Money struct {
+	// CurrencyCode is the ISO 4217 currency code of the amount. It must
+	// match the currency of the billing account and defaults to it.
+	// +optional
+	CurrencyCode *string `json:"currencyCode,omitempty"`
+
+	// Units is the whole units of the amount.
+	Units int64 `json:"units"`
+
+	// Nanos is the number of nano units of the amount.
+	// +optional
+	Nanos *int64 `json:"nanos,omitempty"`
+}
+
+// BudgetFilter limits the usage that is counted against a budget.
+type BudgetFilter struct {
+	// Projects whose usage is counted, in the form
+	// projects/{project_number}.
+	// +optional
+	Projects []string `json:"projects,omitempty"`
+
+	// ProjectRefs references Projects and retrieves their names.
+	// +optional
+	ProjectRefs []xpv1.Reference `json:"projectRefs,omitempty"`
+
+	// ProjectSelector selects references to Projects.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// ResourceAncestors are the folders and organizations whose usage is
+	// counted, in the form folders/{folder_id} or
+	// organizations/{organization_id}.
+	// +optional
+	ResourceAncestors []string `json:"resourceAncestors,omitempty"`
+
+	// Services whose usage is counted, in the form services/{service_id}.
+	// +optional
+	Services []string `json:"services,omitempty"`
+
+	// CreditTypesTreatment controls how credits are applied to the spend.
+	// +kubebuilder:validation:Enum=INCLUDE_ALL_CREDITS;EXCLUDE_ALL_CREDITS;INCLUDE_SPECIFIED_CREDITS
+	// +optional
+	CreditTypesTreatment *string `json:"creditTypesTreatment,omitempty"`
+
+	// CreditTypes subtracted from the spend if CreditTypesTreatment is
+	// INCLUDE_SPECIFIED_CREDITS.
+	// +optional
+	CreditTypes []string `json:"creditTypes,omitempty"`
+
+	// CalendarPeriod the budget tracks. Defaults to MONTH if no
+	// CustomPeriod is set.
+	// +kubebuilder:validation:Enum=MONTH;QUARTER;YEAR
+	// +optional
+	CalendarPeriod *string `json:"calendarPeriod,omitempty"`
+
+	// CustomPeriod is a fixed period the budget tracks.
+	// +optional
+	CustomPeriod *CustomPeriod `json:"customPeriod,omitempty"`
+}
+
+// CustomPeriod is a fixed time period.
+type CustomPeriod struct {
+	// StartDate of the period.
+	StartDate Date `json:"startDate"`
+
+	// EndDate of the period. Usage is tracked indefinitely if this is not
+	// set.
+	// +optional
+	EndDate *Date `json:"endDate,omitempty"`
+}
+
+// Date is a calendar date.
+type Date struct {
+	// Year of the date.
+	Year int64 `json:"year"`
+
+	// Month of the date.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=12
+	Month int64 `json:"month"`
+
+	// Day of the date.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=31
+	Day int64 `json:"day"`
+}
+
+// ThresholdRule triggers an alert when the spend exceeds a percentage of
+// the budget.
+type ThresholdRule struct {
+	// ThresholdPercent of the budget above which an alert is sent, e.g. 90.
+	// +kubebuilder:validation:Minimum=0
+	ThresholdPercent int64 `json:"thresholdPercent"`
+
+	// SpendBasis the threshold is compared to. Defaults to CURRENT_SPEND.
+	// +kubebuilder:validation:Enum=CURRENT_SPEND;FORECASTED_SPEND
+	// +optional
+	SpendBasis *string `json:"spendBasis,omitempty"`
+}
+
+// NotificationsRule configures where budget alerts are sent.
+type NotificationsRule struct {
+	// PubsubTopic is the name of the topic, in the project of the provider
+	// config, that budget updates are published to.
+	// +optional
+	PubsubTopic *string `json:"pubsubTopic,omitempty"`
+
+	// PubsubTopicRef references a Topic and retrieves its name.
+	// +optional
+	PubsubTopicRef *xpv1.Reference `json:"pubsubTopicRef,omitempty"`
+
+	// PubsubTopicSelector selects a reference to a Topic.
+	// +optional
+	PubsubTopicSelector *xpv1.Selector `json:"pubsubTopicSelector,omitempty"`
+
+	// MonitoringNotificationChannels are Cloud Monitoring email channels,
+	// in the form projects/{project_id}/notificationChannels/{channel_id},
+	// that alerts are sent to.
+	// +kubebuilder:validation:MaxItems=5
+	// +optional
+	MonitoringNotificationChannels []string `json:"monitoringNotificationChannels,omitempty"`
+
+	// DisableDefaultIAMRecipients stops alerts from being sent to the
+	// billing account administrators and users.
+	// +optional
+	DisableDefaultIAMRecipients *bool `json:"disableDefaultIamRecipients,omitempty"`
+
+	// EnableProjectLevelRecipients sends alerts to the owners of the
+	// project if the budget is filtered to a single project.
+	// +optional
+	EnableProjectLevelRecipients *bool `json:"enableProjectLevelRecipients,omitempty"`
+}
+
+// BudgetObservation is used to show the observed state of a Budget.
+type BudgetObservation struct {
+	// Name is the fully qualified name of the budget.
+	Name string `json:"name,omitempty"`
+
+	// Etag of the budget.
+	Etag string `json:"etag,omitempty"`
+}
+
+// A BudgetSpec defines the desired state of a Budget.
+type BudgetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BudgetParameters `json:"forProvider"`
+}
+
+// A BudgetStatus represents the observed state of a Budget.
+type BudgetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BudgetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Budget is a managed resource that represents a Cloud Billing Budget.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Budget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BudgetSpec   `json:"spec"`
+	Status BudgetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BudgetList contains a list of Budget
+type BudgetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Budget `json:"items"`
+}
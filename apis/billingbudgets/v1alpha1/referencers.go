@@ -0,0 +1,68 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	pubsubv1alpha1 "github.com/crossplane/provider-gcp/apis/pubsub/v1alpha1"
+	resourcemanagerv1alpha1 "github.com/crossplane/provider-gcp/apis/resourcemanager/v1alpha1"
+)
+
+// ResolveReferences of this Budget
+func (in *Budget) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.filter.projects
+	if f := in.Spec.ForProvider.Filter; f != nil {
+		mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+			CurrentValues: f.Projects,
+			References:    f.ProjectRefs,
+			Selector:      f.ProjectSelector,
+			To:            reference.To{Managed: &resourcemanagerv1alpha1.Project{}, List: &resourcemanagerv1alpha1.ProjectList{}},
+			Extract:       resourcemanagerv1alpha1.ProjectName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.filter.projects")
+		}
+		f.Projects = mrsp.ResolvedValues
+		f.ProjectRefs = mrsp.ResolvedReferences
+	}
+
+	// Resolve spec.forProvider.notificationsRule.pubsubTopic
+	if n := in.Spec.ForProvider.NotificationsRule; n != nil {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(n.PubsubTopic),
+			Reference:    n.PubsubTopicRef,
+			Selector:     n.PubsubTopicSelector,
+			To:           reference.To{Managed: &pubsubv1alpha1.Topic{}, List: &pubsubv1alpha1.TopicList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.notificationsRule.pubsubTopic")
+		}
+		n.PubsubTopic = reference.ToPtrValue(rsp.ResolvedValue)
+		n.PubsubTopicRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
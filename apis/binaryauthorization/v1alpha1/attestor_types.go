@@ -0,0 +1,148 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// AttestorParameters define the desired state of a Binary Authorization
+// attestor, which vouches for images by signing attestations with one of
+// its public keys.
+type AttestorParameters struct {
+	// Project the attestor belongs to. Defaults to the project of the
+	// provider config.
+	// +immutable
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// ProjectRef references a Project and retrieves its external name.
+	// +immutable
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and retrieves its
+	// external name.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// Description of the attestor.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// UserOwnedGrafeasNote configures the Container Analysis note the
+	// attestations of the attestor are stored under.
+	UserOwnedGrafeasNote UserOwnedGrafeasNote `json:"userOwnedGrafeasNote"`
+}
+
+// UserOwnedGrafeasNote is a Container Analysis note that is owned by the
+// user.
+type UserOwnedGrafeasNote struct {
+	// NoteReference is the resource name of the note, in the form
+	// projects/{project}/notes/{note}.
+	// +immutable
+	NoteReference string `json:"noteReference"`
+
+	// PublicKeys that verify the signatures of attestations. An
+	// attestation is valid if any of the keys verifies it.
+	// +optional
+	PublicKeys []AttestorPublicKey `json:"publicKeys,omitempty"`
+}
+
+// An AttestorPublicKey verifies the signatures of attestations. Exactly one
+// of ASCIIArmoredPGPPublicKey and PKIXPublicKey must be set.
+type AttestorPublicKey struct {
+	// ID of the key. It is the fingerprint of PGP keys and is generated for
+	// PKIX keys if omitted.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// Comment about the key.
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+
+	// ASCIIArmoredPGPPublicKey is an ASCII-armored PGP public key.
+	// +optional
+	ASCIIArmoredPGPPublicKey *string `json:"asciiArmoredPgpPublicKey,omitempty"`
+
+	// PKIXPublicKey is a PEM encoded PKIX public key.
+	// +optional
+	PKIXPublicKey *PKIXPublicKey `json:"pkixPublicKey,omitempty"`
+}
+
+// A PKIXPublicKey is a public key as described in RFC 5280.
+type PKIXPublicKey struct {
+	// PublicKeyPEM is the PEM encoded public key.
+	PublicKeyPEM string `json:"publicKeyPem"`
+
+	// SignatureAlgorithm the key signs with, such as
+	// ECDSA_P256_SHA256 or RSA_SIGN_PKCS1_4096_SHA512.
+	SignatureAlgorithm string `json:"signatureAlgorithm"`
+}
+
+// AttestorObservation is used to show the observed state of an attestor.
+type AttestorObservation struct {
+	// Name is the fully qualified name of the attestor.
+	Name string `json:"name,omitempty"`
+
+	// UpdateTime is when the attestor was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+
+	// DelegationServiceAccountEmail is the service account that reads the
+	// note of the attestor on behalf of Binary Authorization. It must be
+	// granted roles/containeranalysis.notes.occurrences.viewer on the note.
+	DelegationServiceAccountEmail string `json:"delegationServiceAccountEmail,omitempty"`
+}
+
+// A AttestorSpec defines the desired state of a Attestor.
+type AttestorSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AttestorParameters `json:"forProvider"`
+}
+
+// A AttestorStatus represents the observed state of a Attestor.
+type AttestorStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AttestorObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Attestor is a managed resource that represents a GCP Binary Authorization attestor.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Attestor struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AttestorSpec   `json:"spec"`
+	Status AttestorStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AttestorList contains a list of Attestor
+type AttestorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Attestor `json:"items"`
+}
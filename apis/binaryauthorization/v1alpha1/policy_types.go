@@ -0,0 +1,160 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// PolicyParameters define the desired state of the Binary Authorization
+// policy of a project. Every project has exactly one policy, so deleting a
+// Policy resets it to the default policy, which allows all images.
+type PolicyParameters struct {
+	// Project the policy belongs to. Defaults to the project of the
+	// provider config.
+	// +immutable
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// ProjectRef references a Project and retrieves its external name.
+	// +immutable
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and retrieves its
+	// external name.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// Description of the policy.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// GlobalPolicyEvaluationMode controls whether images maintained by
+	// Google, such as GKE system images, are exempt from the policy.
+	// +kubebuilder:validation:Enum=ENABLE;DISABLE
+	// +optional
+	GlobalPolicyEvaluationMode *string `json:"globalPolicyEvaluationMode,omitempty"`
+
+	// AdmissionWhitelistPatterns of images that are exempt from the policy.
+	// +optional
+	AdmissionWhitelistPatterns []AdmissionWhitelistPattern `json:"admissionWhitelistPatterns,omitempty"`
+
+	// DefaultAdmissionRule applies to all clusters that don't have a
+	// cluster admission rule.
+	DefaultAdmissionRule AdmissionRule `json:"defaultAdmissionRule"`
+
+	// ClusterAdmissionRules override the default admission rule for
+	// specific clusters.
+	// +optional
+	ClusterAdmissionRules []ClusterAdmissionRule `json:"clusterAdmissionRules,omitempty"`
+}
+
+// AdmissionWhitelistPattern exempts matching images from a policy.
+type AdmissionWhitelistPattern struct {
+	// NamePattern matches the path of an image, such as
+	// gcr.io/my-project/*. A trailing * matches a single path segment, a
+	// trailing ** matches any suffix.
+	NamePattern string `json:"namePattern"`
+}
+
+// An AdmissionRule decides whether an image may be deployed.
+type AdmissionRule struct {
+	// EvaluationMode decides how images are evaluated.
+	// +kubebuilder:validation:Enum=ALWAYS_ALLOW;REQUIRE_ATTESTATION;ALWAYS_DENY
+	EvaluationMode string `json:"evaluationMode"`
+
+	// EnforcementMode decides what happens to images that are rejected.
+	// +kubebuilder:validation:Enum=ENFORCED_BLOCK_AND_AUDIT_LOG;DRYRUN_AUDIT_LOG_ONLY
+	EnforcementMode string `json:"enforcementMode"`
+
+	// RequireAttestationsBy are the resource names of the attestors that
+	// must have attested an image, in the form
+	// projects/{project}/attestors/{attestor}. Only used with the
+	// REQUIRE_ATTESTATION evaluation mode.
+	// +optional
+	RequireAttestationsBy []string `json:"requireAttestationsBy,omitempty"`
+
+	// RequireAttestationsByRefs reference Attestors and retrieve their
+	// resource names.
+	// +optional
+	RequireAttestationsByRefs []xpv1.Reference `json:"requireAttestationsByRefs,omitempty"`
+
+	// RequireAttestationsBySelector selects references to Attestors and
+	// retrieves their resource names.
+	// +optional
+	RequireAttestationsBySelector *xpv1.Selector `json:"requireAttestationsBySelector,omitempty"`
+}
+
+// A ClusterAdmissionRule applies an admission rule to a single cluster.
+type ClusterAdmissionRule struct {
+	// Cluster the rule applies to, in the form {location}.{cluster}, such
+	// as us-central1-a.prod.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9-]+\.[a-z0-9-]+$`
+	Cluster string `json:"cluster"`
+
+	AdmissionRule `json:",inline"`
+}
+
+// PolicyObservation is used to show the observed state of a policy.
+type PolicyObservation struct {
+	// Name is the fully qualified name of the policy.
+	Name string `json:"name,omitempty"`
+
+	// UpdateTime is when the policy was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// A PolicySpec defines the desired state of a Policy.
+type PolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PolicyParameters `json:"forProvider"`
+}
+
+// A PolicyStatus represents the observed state of a Policy.
+type PolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Policy is a managed resource that represents the GCP Binary Authorization policy of a project.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DEFAULT-RULE",type="string",JSONPath=".spec.forProvider.defaultAdmissionRule.evaluationMode"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Policy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PolicySpec   `json:"spec"`
+	Status PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PolicyList contains a list of Policy
+type PolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Policy `json:"items"`
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	resourcemanagerv1alpha1 "github.com/crossplane/provider-gcp/apis/resourcemanager/v1alpha1"
+)
+
+// AttestorName extracts the fully qualified name of an Attestor.
+func AttestorName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		a, ok := mg.(*Attestor)
+		if !ok {
+			return ""
+		}
+		return a.Status.AtProvider.Name
+	}
+}
+
+// ResolveReferences of this Policy
+func (in *Policy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.project
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Project),
+		Reference:    in.Spec.ForProvider.ProjectRef,
+		Selector:     in.Spec.ForProvider.ProjectSelector,
+		To:           reference.To{Managed: &resourcemanagerv1alpha1.Project{}, List: &resourcemanagerv1alpha1.ProjectList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.project")
+	}
+	in.Spec.ForProvider.Project = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.ProjectRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.defaultAdmissionRule.requireAttestationsBy
+	if err := resolveAttestors(ctx, r, &in.Spec.ForProvider.DefaultAdmissionRule); err != nil {
+		return errors.Wrap(err, "spec.forProvider.defaultAdmissionRule.requireAttestationsBy")
+	}
+
+	// Resolve spec.forProvider.clusterAdmissionRules[*].requireAttestationsBy
+	for i := range in.Spec.ForProvider.ClusterAdmissionRules {
+		if err := resolveAttestors(ctx, r, &in.Spec.ForProvider.ClusterAdmissionRules[i].AdmissionRule); err != nil {
+			return errors.Wrapf(err, "spec.forProvider.clusterAdmissionRules[%d].requireAttestationsBy", i)
+		}
+	}
+
+	return nil
+}
+
+func resolveAttestors(ctx context.Context, r *reference.APIResolver, ar *AdmissionRule) error {
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: ar.RequireAttestationsBy,
+		References:    ar.RequireAttestationsByRefs,
+		Selector:      ar.RequireAttestationsBySelector,
+		To:            reference.To{Managed: &Attestor{}, List: &AttestorList{}},
+		Extract:       AttestorName(),
+	})
+	if err != nil {
+		return err
+	}
+	ar.RequireAttestationsBy = mrsp.ResolvedValues
+	ar.RequireAttestationsByRefs = mrsp.ResolvedReferences
+	return nil
+}
+
+// ResolveReferences of this Attestor
+func (in *Attestor) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.project
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Project),
+		Reference:    in.Spec.ForProvider.ProjectRef,
+		Selector:     in.Spec.ForProvider.ProjectSelector,
+		To:           reference.To{Managed: &resourcemanagerv1alpha1.Project{}, List: &resourcemanagerv1alpha1.ProjectList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.project")
+	}
+	in.Spec.ForProvider.Project = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.ProjectRef = rsp.ResolvedReference
+
+	return nil
+}
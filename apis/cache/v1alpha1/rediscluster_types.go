@@ -0,0 +1,186 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known RedisCluster states.
+const (
+	RedisClusterStateCreating = "CREATING"
+	RedisClusterStateActive   = "ACTIVE"
+	RedisClusterStateUpdating = "UPDATING"
+	RedisClusterStateDeleting = "DELETING"
+)
+
+// RedisClusterParameters define the desired state of a Memorystore for Redis
+// Cluster. Most fields map directly to a Cluster:
+// https://cloud.google.com/memorystore/docs/cluster/reference/rest/v1/projects.locations.clusters#Cluster
+type RedisClusterParameters struct {
+	// Region in which to create this cluster.
+	// +immutable
+	Region string `json:"region"`
+
+	// ShardCount is the number of shards of the cluster.
+	// +kubebuilder:validation:Minimum=1
+	ShardCount int64 `json:"shardCount"`
+
+	// ReplicaCount is the number of replica nodes per shard.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	ReplicaCount *int64 `json:"replicaCount,omitempty"`
+
+	// PSCConfigs configure the consumer networks in which Private Service
+	// Connect endpoints are created for client access to the cluster.
+	// Currently only one PSCConfig is supported.
+	// +immutable
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=1
+	PSCConfigs []PSCConfig `json:"pscConfigs"`
+
+	// AuthorizationMode of the cluster. Authorization is disabled if this
+	// is not set.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=AUTH_MODE_IAM_AUTH;AUTH_MODE_DISABLED
+	AuthorizationMode *string `json:"authorizationMode,omitempty"`
+
+	// TransitEncryptionMode of the cluster. In-transit encryption is
+	// disabled if this is not set.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=TRANSIT_ENCRYPTION_MODE_DISABLED;TRANSIT_ENCRYPTION_MODE_SERVER_AUTHENTICATION
+	TransitEncryptionMode *string `json:"transitEncryptionMode,omitempty"`
+}
+
+// PSCConfig configures a consumer network for Private Service Connect.
+type PSCConfig struct {
+	// Network is the consumer network where Private Service Connect
+	// endpoints for the cluster are created, in the form
+	// projects/{project}/global/networks/{network}.
+	// +optional
+	// +immutable
+	Network *string `json:"network,omitempty"`
+
+	// NetworkRef references a Network to retrieve its URL.
+	// +optional
+	// +immutable
+	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to a Network.
+	// +optional
+	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
+}
+
+// DiscoveryEndpoint is an endpoint that Redis clients can use to discover
+// the cluster topology.
+type DiscoveryEndpoint struct {
+	// Address of the endpoint.
+	Address string `json:"address,omitempty"`
+
+	// Port of the endpoint.
+	Port int64 `json:"port,omitempty"`
+}
+
+// PSCConnection is a Private Service Connect connection of the cluster.
+type PSCConnection struct {
+	// PSCConnectionID is the ID of the connection.
+	PSCConnectionID string `json:"pscConnectionId,omitempty"`
+
+	// Address is the IP allocated on the consumer network.
+	Address string `json:"address,omitempty"`
+
+	// ForwardingRule is the URI of the consumer side forwarding rule.
+	ForwardingRule string `json:"forwardingRule,omitempty"`
+
+	// ProjectID of the consumer network.
+	ProjectID string `json:"projectId,omitempty"`
+
+	// Network is the consumer network the connection was created in.
+	Network string `json:"network,omitempty"`
+}
+
+// RedisClusterObservation is used to show the observed state of a
+// RedisCluster.
+type RedisClusterObservation struct {
+	// Name is the fully qualified name of the cluster.
+	Name string `json:"name,omitempty"`
+
+	// UID is the system assigned, unique identifier of the cluster.
+	UID string `json:"uid,omitempty"`
+
+	// State of the cluster.
+	State string `json:"state,omitempty"`
+
+	// SizeGB is the Redis memory size of the entire cluster.
+	SizeGB int64 `json:"sizeGb,omitempty"`
+
+	// CreateTime is the time the cluster was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// DiscoveryEndpoints Redis clients use to connect to the cluster.
+	DiscoveryEndpoints []DiscoveryEndpoint `json:"discoveryEndpoints,omitempty"`
+
+	// PSCConnections used to discover the cluster topology and to access
+	// the cluster.
+	PSCConnections []PSCConnection `json:"pscConnections,omitempty"`
+}
+
+// A RedisClusterSpec defines the desired state of a RedisCluster.
+type RedisClusterSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RedisClusterParameters `json:"forProvider"`
+}
+
+// A RedisClusterStatus represents the observed state of a RedisCluster.
+type RedisClusterStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RedisClusterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RedisCluster is a managed resource that represents a Memorystore for
+// Redis Cluster, i.e. a sharded Redis deployment that is reached through
+// Private Service Connect. Use a CloudMemorystoreInstance for a standalone
+// Redis instance.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="SHARDS",type="integer",JSONPath=".spec.forProvider.shardCount"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type RedisCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RedisClusterSpec   `json:"spec"`
+	Status RedisClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RedisClusterList contains a list of RedisCluster
+type RedisClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RedisCluster `json:"items"`
+}
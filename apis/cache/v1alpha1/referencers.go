@@ -0,0 +1,51 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+)
+
+// ResolveReferences of this RedisCluster
+func (in *RedisCluster) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.pscConfigs[*].network
+	for i := range in.Spec.ForProvider.PSCConfigs {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.PSCConfigs[i].Network),
+			Reference:    in.Spec.ForProvider.PSCConfigs[i].NetworkRef,
+			Selector:     in.Spec.ForProvider.PSCConfigs[i].NetworkSelector,
+			To:           reference.To{Managed: &v1beta1.Network{}, List: &v1beta1.NetworkList{}},
+			Extract:      v1beta1.NetworkURL(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.pscConfigs[%d].network", i)
+		}
+		in.Spec.ForProvider.PSCConfigs[i].Network = reference.ToPtrValue(rsp.ResolvedValue)
+		in.Spec.ForProvider.PSCConfigs[i].NetworkRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
@@ -26,9 +26,11 @@ import (
 // Cloud Memorystore instance. Most fields map directly to an Instance:
 // https://cloud.google.com/memorystore/docs/redis/reference/rest/v1/projects.locations.instances#Instance
 type CloudMemorystoreInstanceParameters struct {
-	// Region in which to create this Cloud Memorystore cluster.
+	// Region in which to create this Cloud Memorystore cluster. Defaults
+	// to the default region of the ProviderConfig.
+	// +optional
 	// +immutable
-	Region string `json:"region"`
+	Region string `json:"region,omitempty"`
 
 	// Tier specifies the replication level of the Redis cluster. BASIC provides
 	// a single Redis instance with no high availability. STANDARD_HA provides a
@@ -53,7 +55,8 @@ type CloudMemorystoreInstanceParameters struct {
 	// the service will choose a zone for the instance. For STANDARD_HA tier,
 	// instances will be created across two zones for protection against zonal
 	// failures. If [alternative_location_id] is also provided, it must be
-	// different from [location_id].
+	// different from [location_id]. Defaults to the default zone of the
+	// ProviderConfig if it is in the region of the instance.
 	// +optional
 	// +immutable
 	LocationID *string `json:"locationId,omitempty"`
@@ -0,0 +1,191 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Scopes of a Certificate.
+const (
+	CertificateScopeDefault    = "DEFAULT"
+	CertificateScopeEdgeCache  = "EDGE_CACHE"
+	CertificateScopeAllRegions = "ALL_REGIONS"
+)
+
+// CertificateParameters define the desired state of a Certificate Manager
+// Certificate. Exactly one of Managed or SelfManaged must be set. Most fields
+// map directly to a Certificate:
+// https://cloud.google.com/certificate-manager/docs/reference/rest/v1/projects.locations.certificates#Certificate
+type CertificateParameters struct {
+	// Location in which to create this certificate. Certificates that are
+	// used by global load balancers must be created in the global location.
+	// +optional
+	// +immutable
+	// +kubebuilder:default=global
+	Location string `json:"location,omitempty"`
+
+	// Description of the certificate.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels to apply to the certificate.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Scope of the certificate, which determines where it can be served.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=DEFAULT;EDGE_CACHE;ALL_REGIONS
+	Scope *string `json:"scope,omitempty"`
+
+	// Managed configures a certificate that is issued and renewed by Google.
+	// +optional
+	// +immutable
+	Managed *ManagedCertificate `json:"managed,omitempty"`
+
+	// SelfManaged configures a certificate that is uploaded by the user.
+	// +optional
+	// +immutable
+	SelfManaged *SelfManagedCertificate `json:"selfManaged,omitempty"`
+}
+
+// ManagedCertificate configures a certificate that is issued and renewed by
+// Google.
+type ManagedCertificate struct {
+	// Domains the certificate is issued for. Wildcard domains such as
+	// *.example.com require a DNS authorization.
+	Domains []string `json:"domains"`
+
+	// DNSAuthorizations used to prove control over the domains, in the form
+	// projects/{project}/locations/{location}/dnsAuthorizations/{name}.
+	// +optional
+	DNSAuthorizations []string `json:"dnsAuthorizations,omitempty"`
+
+	// DNSAuthorizationRefs references DNSAuthorizations and retrieves their
+	// names.
+	// +optional
+	DNSAuthorizationRefs []xpv1.Reference `json:"dnsAuthorizationRefs,omitempty"`
+
+	// DNSAuthorizationSelector selects references to DNSAuthorizations.
+	// +optional
+	DNSAuthorizationSelector *xpv1.Selector `json:"dnsAuthorizationSelector,omitempty"`
+
+	// IssuanceConfig used to issue the certificate through a private CA, in
+	// the form
+	// projects/{project}/locations/{location}/certificateIssuanceConfigs/{name}.
+	// +optional
+	IssuanceConfig *string `json:"issuanceConfig,omitempty"`
+}
+
+// SelfManagedCertificate configures a certificate that is uploaded by the
+// user.
+type SelfManagedCertificate struct {
+	// PEMCertificate is the certificate chain in PEM format.
+	PEMCertificate string `json:"pemCertificate"`
+
+	// PEMPrivateKeySecretRef references the Secret key that holds the PEM
+	// encoded private key of the certificate.
+	PEMPrivateKeySecretRef xpv1.SecretKeySelector `json:"pemPrivateKeySecretRef"`
+}
+
+// CertificateObservation is used to show the observed state of a
+// Certificate.
+type CertificateObservation struct {
+	// Name is the fully qualified name of the certificate.
+	Name string `json:"name,omitempty"`
+
+	// SANDNSNames are the subject alternative names of the certificate.
+	SANDNSNames []string `json:"sanDnsnames,omitempty"`
+
+	// ExpireTime is the time the certificate expires.
+	ExpireTime string `json:"expireTime,omitempty"`
+
+	// CreateTime is the time the certificate was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the certificate was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+
+	// ManagedState is the provisioning state of a managed certificate, e.g.
+	// PROVISIONING or ACTIVE.
+	ManagedState string `json:"managedState,omitempty"`
+
+	// ProvisioningIssue explains why a managed certificate could not be
+	// provisioned yet.
+	ProvisioningIssue string `json:"provisioningIssue,omitempty"`
+
+	// AuthorizationAttempts lists the state of the authorization of each
+	// domain of a managed certificate.
+	AuthorizationAttempts []AuthorizationAttempt `json:"authorizationAttempts,omitempty"`
+}
+
+// AuthorizationAttempt is the state of the authorization of a domain.
+type AuthorizationAttempt struct {
+	// Domain that is being authorized.
+	Domain string `json:"domain,omitempty"`
+
+	// State of the authorization, e.g. AUTHORIZED or FAILED.
+	State string `json:"state,omitempty"`
+
+	// FailureReason of a failed authorization.
+	FailureReason string `json:"failureReason,omitempty"`
+
+	// Details of the authorization.
+	Details string `json:"details,omitempty"`
+}
+
+// A CertificateSpec defines the desired state of a Certificate.
+type CertificateSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CertificateParameters `json:"forProvider"`
+}
+
+// A CertificateStatus represents the observed state of a Certificate.
+type CertificateStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CertificateObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Certificate is a managed resource that represents a Certificate Manager Certificate.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.managedState"
+// +kubebuilder:printcolumn:name="EXPIRES",type="string",JSONPath=".status.atProvider.expireTime"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Certificate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CertificateSpec   `json:"spec"`
+	Status CertificateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CertificateList contains a list of Certificate
+type CertificateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Certificate `json:"items"`
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CertificateMapParameters define the desired state of a Certificate
+// Manager CertificateMap. Certificate maps are always created in the global
+// location. Most fields map directly to a CertificateMap:
+// https://cloud.google.com/certificate-manager/docs/reference/rest/v1/projects.locations.certificateMaps#CertificateMap
+type CertificateMapParameters struct {
+	// Description of the certificate map.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels to apply to the certificate map.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// GCLBTarget is a load balancer target proxy that serves a CertificateMap.
+type GCLBTarget struct {
+	// TargetHTTPSProxy serving the certificate map.
+	TargetHTTPSProxy string `json:"targetHttpsProxy,omitempty"`
+
+	// TargetSSLProxy serving the certificate map.
+	TargetSSLProxy string `json:"targetSslProxy,omitempty"`
+
+	// IPConfigs of the target proxy.
+	IPConfigs []IPConfig `json:"ipConfigs,omitempty"`
+}
+
+// IPConfig is an IP address and ports of a GCLBTarget.
+type IPConfig struct {
+	// IPAddress the target proxy is serving on.
+	IPAddress string `json:"ipAddress,omitempty"`
+
+	// Ports the target proxy is serving on.
+	Ports []int64 `json:"ports,omitempty"`
+}
+
+// CertificateMapObservation is used to show the observed state of a
+// CertificateMap.
+type CertificateMapObservation struct {
+	// Name is the fully qualified name of the certificate map.
+	Name string `json:"name,omitempty"`
+
+	// GCLBTargets are the load balancer target proxies serving the
+	// certificate map.
+	GCLBTargets []GCLBTarget `json:"gclbTargets,omitempty"`
+
+	// CreateTime is the time the certificate map was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the certificate map was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// A CertificateMapSpec defines the desired state of a CertificateMap.
+type CertificateMapSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CertificateMapParameters `json:"forProvider"`
+}
+
+// A CertificateMapStatus represents the observed state of a CertificateMap.
+type CertificateMapStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CertificateMapObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CertificateMap is a managed resource that represents a Certificate Manager CertificateMap.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type CertificateMap struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CertificateMapSpec   `json:"spec"`
+	Status CertificateMapStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CertificateMapList contains a list of CertificateMap
+type CertificateMapList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CertificateMap `json:"items"`
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Matchers of a CertificateMapEntry.
+const (
+	CertificateMapEntryMatcherPrimary = "PRIMARY"
+)
+
+// CertificateMapEntryParameters define the desired state of a Certificate
+// Manager CertificateMapEntry. Exactly one of Hostname or Matcher must be
+// set. Most fields map directly to a CertificateMapEntry:
+// https://cloud.google.com/certificate-manager/docs/reference/rest/v1/projects.locations.certificateMaps.certificateMapEntries#CertificateMapEntry
+type CertificateMapEntryParameters struct {
+	// CertificateMap this entry belongs to.
+	// +optional
+	// +immutable
+	CertificateMap string `json:"certificateMap,omitempty"`
+
+	// CertificateMapRef references a CertificateMap and retrieves its name.
+	// +optional
+	CertificateMapRef *xpv1.Reference `json:"certificateMapRef,omitempty"`
+
+	// CertificateMapSelector selects a reference to a CertificateMap.
+	// +optional
+	CertificateMapSelector *xpv1.Selector `json:"certificateMapSelector,omitempty"`
+
+	// Description of the certificate map entry.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels to apply to the certificate map entry.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Hostname the certificates are served for, e.g. www.example.com or
+	// *.example.com.
+	// +optional
+	// +immutable
+	Hostname *string `json:"hostname,omitempty"`
+
+	// Matcher selects the certificates for clients that do not match any
+	// other entry, e.g. because they do not send SNI.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=PRIMARY
+	Matcher *string `json:"matcher,omitempty"`
+
+	// Certificates served by this entry, in the form
+	// projects/{project}/locations/{location}/certificates/{name}.
+	// +optional
+	Certificates []string `json:"certificates,omitempty"`
+
+	// CertificateRefs references Certificates and retrieves their names.
+	// +optional
+	CertificateRefs []xpv1.Reference `json:"certificateRefs,omitempty"`
+
+	// CertificateSelector selects references to Certificates.
+	// +optional
+	CertificateSelector *xpv1.Selector `json:"certificateSelector,omitempty"`
+}
+
+// CertificateMapEntryObservation is used to show the observed state of a
+// CertificateMapEntry.
+type CertificateMapEntryObservation struct {
+	// Name is the fully qualified name of the certificate map entry.
+	Name string `json:"name,omitempty"`
+
+	// State of the certificate map entry, e.g. ACTIVE or PENDING.
+	State string `json:"state,omitempty"`
+
+	// CreateTime is the time the certificate map entry was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the certificate map entry was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// A CertificateMapEntrySpec defines the desired state of a CertificateMapEntry.
+type CertificateMapEntrySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CertificateMapEntryParameters `json:"forProvider"`
+}
+
+// A CertificateMapEntryStatus represents the observed state of a CertificateMapEntry.
+type CertificateMapEntryStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CertificateMapEntryObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CertificateMapEntry is a managed resource that represents a Certificate Manager CertificateMapEntry.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="HOSTNAME",type="string",JSONPath=".spec.forProvider.hostname"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type CertificateMapEntry struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CertificateMapEntrySpec   `json:"spec"`
+	Status CertificateMapEntryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CertificateMapEntryList contains a list of CertificateMapEntry
+type CertificateMapEntryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CertificateMapEntry `json:"items"`
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// DNSAuthorizationParameters define the desired state of a Certificate
+// Manager DnsAuthorization. Most fields map directly to a DnsAuthorization:
+// https://cloud.google.com/certificate-manager/docs/reference/rest/v1/projects.locations.dnsAuthorizations#DnsAuthorization
+type DNSAuthorizationParameters struct {
+	// Location in which to create this DNS authorization.
+	// +optional
+	// +immutable
+	// +kubebuilder:default=global
+	Location string `json:"location,omitempty"`
+
+	// Domain to authorize, e.g. example.com. The authorization also covers
+	// wildcard certificates such as *.example.com.
+	// +immutable
+	Domain string `json:"domain"`
+
+	// Description of the DNS authorization.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels to apply to the DNS authorization.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// DNSResourceRecord is the record that must be added to the DNS
+// configuration of a domain in order to authorize it.
+type DNSResourceRecord struct {
+	// Name of the record, e.g. _acme-challenge.example.com.
+	Name string `json:"name,omitempty"`
+
+	// Type of the record, always CNAME.
+	Type string `json:"type,omitempty"`
+
+	// Data of the record.
+	Data string `json:"data,omitempty"`
+}
+
+// DNSAuthorizationObservation is used to show the observed state of a
+// DNSAuthorization.
+type DNSAuthorizationObservation struct {
+	// Name is the fully qualified name of the DNS authorization.
+	Name string `json:"name,omitempty"`
+
+	// DNSResourceRecord that must be added to the DNS configuration of the
+	// domain, e.g. with a Cloud DNS ResourceRecordSet.
+	DNSResourceRecord DNSResourceRecord `json:"dnsResourceRecord,omitempty"`
+
+	// CreateTime is the time the DNS authorization was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the DNS authorization was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// A DNSAuthorizationSpec defines the desired state of a DNSAuthorization.
+type DNSAuthorizationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DNSAuthorizationParameters `json:"forProvider"`
+}
+
+// A DNSAuthorizationStatus represents the observed state of a DNSAuthorization.
+type DNSAuthorizationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DNSAuthorizationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DNSAuthorization is a managed resource that represents a Certificate Manager DnsAuthorization.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DOMAIN",type="string",JSONPath=".spec.forProvider.domain"
+// +kubebuilder:printcolumn:name="RECORD",type="string",JSONPath=".status.atProvider.dnsResourceRecord.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type DNSAuthorization struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DNSAuthorizationSpec   `json:"spec"`
+	Status DNSAuthorizationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DNSAuthorizationList contains a list of DNSAuthorization
+type DNSAuthorizationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DNSAuthorization `json:"items"`
+}
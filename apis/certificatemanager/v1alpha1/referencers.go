@@ -0,0 +1,110 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// DNSAuthorizationName extracts the fully qualified name of a
+// DNSAuthorization.
+func DNSAuthorizationName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		d, ok := mg.(*DNSAuthorization)
+		if !ok {
+			return ""
+		}
+		return d.Status.AtProvider.Name
+	}
+}
+
+// CertificateName extracts the fully qualified name of a Certificate.
+func CertificateName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		c, ok := mg.(*Certificate)
+		if !ok {
+			return ""
+		}
+		return c.Status.AtProvider.Name
+	}
+}
+
+// ResolveReferences of this Certificate
+func (in *Certificate) ResolveReferences(ctx context.Context, c client.Reader) error {
+	m := in.Spec.ForProvider.Managed
+	if m == nil {
+		return nil
+	}
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.managed.dnsAuthorizations
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: m.DNSAuthorizations,
+		References:    m.DNSAuthorizationRefs,
+		Selector:      m.DNSAuthorizationSelector,
+		To:            reference.To{Managed: &DNSAuthorization{}, List: &DNSAuthorizationList{}},
+		Extract:       DNSAuthorizationName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.managed.dnsAuthorizations")
+	}
+	m.DNSAuthorizations = mrsp.ResolvedValues
+	m.DNSAuthorizationRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this CertificateMapEntry
+func (in *CertificateMapEntry) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.certificateMap
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: in.Spec.ForProvider.CertificateMap,
+		Reference:    in.Spec.ForProvider.CertificateMapRef,
+		Selector:     in.Spec.ForProvider.CertificateMapSelector,
+		To:           reference.To{Managed: &CertificateMap{}, List: &CertificateMapList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.certificateMap")
+	}
+	in.Spec.ForProvider.CertificateMap = rsp.ResolvedValue
+	in.Spec.ForProvider.CertificateMapRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.certificates
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: in.Spec.ForProvider.Certificates,
+		References:    in.Spec.ForProvider.CertificateRefs,
+		Selector:      in.Spec.ForProvider.CertificateSelector,
+		To:            reference.To{Managed: &Certificate{}, List: &CertificateList{}},
+		Extract:       CertificateName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.certificates")
+	}
+	in.Spec.ForProvider.Certificates = mrsp.ResolvedValues
+	in.Spec.ForProvider.CertificateRefs = mrsp.ResolvedReferences
+
+	return nil
+}
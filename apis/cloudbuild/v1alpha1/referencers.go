@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	iamv1alpha1 "github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	resourcemanagerv1alpha1 "github.com/crossplane/provider-gcp/apis/resourcemanager/v1alpha1"
+)
+
+// WorkerPoolName extracts the fully qualified name of a WorkerPool.
+func WorkerPoolName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		p, ok := mg.(*WorkerPool)
+		if !ok {
+			return ""
+		}
+		return p.Status.AtProvider.Name
+	}
+}
+
+// ResolveReferences of this Trigger
+func (in *Trigger) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.project
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Project),
+		Reference:    in.Spec.ForProvider.ProjectRef,
+		Selector:     in.Spec.ForProvider.ProjectSelector,
+		To:           reference.To{Managed: &resourcemanagerv1alpha1.Project{}, List: &resourcemanagerv1alpha1.ProjectList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.project")
+	}
+	in.Spec.ForProvider.Project = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.ProjectRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.serviceAccount
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.ServiceAccount),
+		Reference:    in.Spec.ForProvider.ServiceAccountRef,
+		Selector:     in.Spec.ForProvider.ServiceAccountSelector,
+		To:           reference.To{Managed: &iamv1alpha1.ServiceAccount{}, List: &iamv1alpha1.ServiceAccountList{}},
+		Extract:      iamv1alpha1.ServiceAccountRRN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.serviceAccount")
+	}
+	in.Spec.ForProvider.ServiceAccount = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.ServiceAccountRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.build.options.workerPool
+	if b := in.Spec.ForProvider.Build; b != nil && b.Options != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(b.Options.WorkerPool),
+			Reference:    b.Options.WorkerPoolRef,
+			Selector:     b.Options.WorkerPoolSelector,
+			To:           reference.To{Managed: &WorkerPool{}, List: &WorkerPoolList{}},
+			Extract:      WorkerPoolName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.build.options.workerPool")
+		}
+		b.Options.WorkerPool = reference.ToPtrValue(rsp.ResolvedValue)
+		b.Options.WorkerPoolRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
+
+// ResolveReferences of this WorkerPool
+func (in *WorkerPool) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.project
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Project),
+		Reference:    in.Spec.ForProvider.ProjectRef,
+		Selector:     in.Spec.ForProvider.ProjectSelector,
+		To:           reference.To{Managed: &resourcemanagerv1alpha1.Project{}, List: &resourcemanagerv1alpha1.ProjectList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.project")
+	}
+	in.Spec.ForProvider.Project = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.ProjectRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,380 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TriggerParameters define the desired state of a Cloud Build trigger, which
+// starts a build whenever a GitHub or Cloud Source Repositories event
+// matches its filter.
+type TriggerParameters struct {
+	// Project the trigger belongs to. Defaults to the project of the
+	// provider config.
+	// +immutable
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// ProjectRef references a Project and retrieves its external name.
+	// +immutable
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and retrieves its
+	// external name.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// Location of the trigger, either global or a region such as
+	// us-central1.
+	// +immutable
+	Location string `json:"location"`
+
+	// Name of the trigger, unique within the project.
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9][a-zA-Z0-9-]{0,63}$`
+	Name string `json:"name"`
+
+	// Description of the trigger.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Tags to annotate the trigger with.
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// Disabled triggers don't start builds.
+	// +optional
+	Disabled *bool `json:"disabled,omitempty"`
+
+	// GitHub triggers builds on events of a GitHub repository connected
+	// through the Cloud Build GitHub app. Exactly one of GitHub and
+	// TriggerTemplate must be set.
+	// +optional
+	GitHub *GitHubEventsConfig `json:"github,omitempty"`
+
+	// TriggerTemplate triggers builds on pushes to a Cloud Source
+	// Repositories repository. Exactly one of GitHub and TriggerTemplate
+	// must be set.
+	// +optional
+	TriggerTemplate *RepoSource `json:"triggerTemplate,omitempty"`
+
+	// Filename is the path of the build config file in the repository,
+	// such as cloudbuild.yaml. Exactly one of Filename and Build must be
+	// set.
+	// +optional
+	Filename *string `json:"filename,omitempty"`
+
+	// Build is the build config the trigger starts. Exactly one of Filename
+	// and Build must be set.
+	// +optional
+	Build *BuildConfig `json:"build,omitempty"`
+
+	// Substitutions are the default values of the user-defined
+	// substitutions of the build. Keys must start with an underscore.
+	// +optional
+	Substitutions map[string]string `json:"substitutions,omitempty"`
+
+	// IncludedFiles are glob patterns of files. If set, only changes to
+	// matching files trigger a build.
+	// +optional
+	IncludedFiles []string `json:"includedFiles,omitempty"`
+
+	// IgnoredFiles are glob patterns of files whose changes don't trigger
+	// a build.
+	// +optional
+	IgnoredFiles []string `json:"ignoredFiles,omitempty"`
+
+	// ApprovalConfig requires builds to be approved before they start.
+	// +optional
+	ApprovalConfig *ApprovalConfig `json:"approvalConfig,omitempty"`
+
+	// IncludeBuildLogs shows the build logs on GitHub.
+	// +optional
+	// +kubebuilder:validation:Enum=INCLUDE_BUILD_LOGS_UNSPECIFIED;INCLUDE_BUILD_LOGS_WITH_STATUS
+	IncludeBuildLogs *string `json:"includeBuildLogs,omitempty"`
+
+	// ServiceAccount the builds run as, in the form
+	// projects/{project}/serviceAccounts/{email}. Defaults to the Cloud
+	// Build service account.
+	// +optional
+	ServiceAccount *string `json:"serviceAccount,omitempty"`
+
+	// ServiceAccountRef references a ServiceAccount and retrieves its
+	// resource name.
+	// +optional
+	ServiceAccountRef *xpv1.Reference `json:"serviceAccountRef,omitempty"`
+
+	// ServiceAccountSelector selects a reference to a ServiceAccount and
+	// retrieves its resource name.
+	// +optional
+	ServiceAccountSelector *xpv1.Selector `json:"serviceAccountSelector,omitempty"`
+}
+
+// GitHubEventsConfig selects the GitHub events that trigger a build.
+type GitHubEventsConfig struct {
+	// Owner of the repository, e.g. crossplane for
+	// https://github.com/crossplane/provider-gcp.
+	Owner string `json:"owner"`
+
+	// Name of the repository, e.g. provider-gcp for
+	// https://github.com/crossplane/provider-gcp.
+	Name string `json:"name"`
+
+	// PullRequest triggers builds on pull requests. Exactly one of
+	// PullRequest and Push must be set.
+	// +optional
+	PullRequest *PullRequestFilter `json:"pullRequest,omitempty"`
+
+	// Push triggers builds on pushes. Exactly one of PullRequest and Push
+	// must be set.
+	// +optional
+	Push *PushFilter `json:"push,omitempty"`
+}
+
+// PullRequestFilter matches pull requests.
+type PullRequestFilter struct {
+	// Branch is a regular expression the base branch of the pull request
+	// must match.
+	Branch string `json:"branch"`
+
+	// CommentControl configures whether builds need a /gcbrun comment of
+	// a repository owner or collaborator.
+	// +optional
+	// +kubebuilder:validation:Enum=COMMENTS_DISABLED;COMMENTS_ENABLED;COMMENTS_ENABLED_FOR_EXTERNAL_CONTRIBUTORS_ONLY
+	CommentControl *string `json:"commentControl,omitempty"`
+
+	// InvertRegex triggers builds for branches that don't match Branch.
+	// +optional
+	InvertRegex *bool `json:"invertRegex,omitempty"`
+}
+
+// PushFilter matches pushes. Exactly one of Branch and Tag must be set.
+type PushFilter struct {
+	// Branch is a regular expression the pushed branch must match.
+	// +optional
+	Branch *string `json:"branch,omitempty"`
+
+	// Tag is a regular expression the pushed tag must match.
+	// +optional
+	Tag *string `json:"tag,omitempty"`
+
+	// InvertRegex triggers builds for branches or tags that don't match.
+	// +optional
+	InvertRegex *bool `json:"invertRegex,omitempty"`
+}
+
+// RepoSource matches pushes to a Cloud Source Repositories repository.
+// Exactly one of BranchName and TagName must be set.
+type RepoSource struct {
+	// ProjectID of the repository. Defaults to the project of the trigger.
+	// +optional
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// RepoName is the name of the repository.
+	RepoName string `json:"repoName"`
+
+	// BranchName is a regular expression the pushed branch must match.
+	// +optional
+	BranchName *string `json:"branchName,omitempty"`
+
+	// TagName is a regular expression the pushed tag must match.
+	// +optional
+	TagName *string `json:"tagName,omitempty"`
+
+	// Dir the build runs in, relative to the root of the repository.
+	// +optional
+	Dir *string `json:"dir,omitempty"`
+
+	// InvertRegex triggers builds for branches or tags that don't match.
+	// +optional
+	InvertRegex *bool `json:"invertRegex,omitempty"`
+}
+
+// ApprovalConfig configures whether builds must be approved.
+type ApprovalConfig struct {
+	// ApprovalRequired holds builds of the trigger until they are
+	// approved.
+	// +optional
+	ApprovalRequired *bool `json:"approvalRequired,omitempty"`
+}
+
+// BuildConfig is an inline build config.
+type BuildConfig struct {
+	// Steps of the build.
+	// +kubebuilder:validation:MinItems=1
+	Steps []BuildStep `json:"steps"`
+
+	// Images pushed to a registry once all steps succeeded.
+	// +optional
+	Images []string `json:"images,omitempty"`
+
+	// Substitutions available to the steps of the build.
+	// +optional
+	Substitutions map[string]string `json:"substitutions,omitempty"`
+
+	// Tags to annotate the builds with.
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// Timeout of the build, e.g. 600s. Defaults to ten minutes.
+	// +optional
+	Timeout *string `json:"timeout,omitempty"`
+
+	// LogsBucket is the Cloud Storage bucket the build logs are written
+	// to, in the form gs://{bucket}.
+	// +optional
+	LogsBucket *string `json:"logsBucket,omitempty"`
+
+	// Options of the build.
+	// +optional
+	Options *BuildOptions `json:"options,omitempty"`
+}
+
+// A BuildStep runs a container.
+type BuildStep struct {
+	// Name of the container image the step runs, e.g.
+	// gcr.io/cloud-builders/docker.
+	Name string `json:"name"`
+
+	// ID of the step, which other steps can wait for.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// Args passed to the entrypoint of the image.
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// Env sets environment variables in the form KEY=VALUE.
+	// +optional
+	Env []string `json:"env,omitempty"`
+
+	// Entrypoint overrides the entrypoint of the image.
+	// +optional
+	Entrypoint *string `json:"entrypoint,omitempty"`
+
+	// Dir the step runs in, relative to the root of the source.
+	// +optional
+	Dir *string `json:"dir,omitempty"`
+
+	// Script runs in the container instead of Args.
+	// +optional
+	Script *string `json:"script,omitempty"`
+
+	// WaitFor lists the IDs of the steps that must complete before this
+	// step starts. Defaults to all previous steps.
+	// +optional
+	WaitFor []string `json:"waitFor,omitempty"`
+
+	// Timeout of the step, e.g. 300s.
+	// +optional
+	Timeout *string `json:"timeout,omitempty"`
+
+	// AllowFailure lets the build succeed even if the step fails.
+	// +optional
+	AllowFailure *bool `json:"allowFailure,omitempty"`
+}
+
+// BuildOptions configure how a build runs.
+type BuildOptions struct {
+	// MachineType the build runs on when it doesn't run in a worker pool.
+	// +optional
+	// +kubebuilder:validation:Enum=UNSPECIFIED;N1_HIGHCPU_8;N1_HIGHCPU_32;E2_HIGHCPU_8;E2_HIGHCPU_32;E2_MEDIUM
+	MachineType *string `json:"machineType,omitempty"`
+
+	// DiskSizeGB of the build machine.
+	// +optional
+	DiskSizeGB *int64 `json:"diskSizeGb,omitempty"`
+
+	// Logging decides where the build logs are stored.
+	// +optional
+	// +kubebuilder:validation:Enum=LEGACY;GCS_ONLY;CLOUD_LOGGING_ONLY;NONE
+	Logging *string `json:"logging,omitempty"`
+
+	// Env sets environment variables for all steps, in the form
+	// KEY=VALUE.
+	// +optional
+	Env []string `json:"env,omitempty"`
+
+	// WorkerPool the build runs in, in the form
+	// projects/{project}/locations/{location}/workerPools/{workerPool}.
+	// +optional
+	WorkerPool *string `json:"workerPool,omitempty"`
+
+	// WorkerPoolRef references a WorkerPool and retrieves its resource
+	// name.
+	// +optional
+	WorkerPoolRef *xpv1.Reference `json:"workerPoolRef,omitempty"`
+
+	// WorkerPoolSelector selects a reference to a WorkerPool and retrieves
+	// its resource name.
+	// +optional
+	WorkerPoolSelector *xpv1.Selector `json:"workerPoolSelector,omitempty"`
+}
+
+// TriggerObservation is used to show the observed state of the Trigger.
+type TriggerObservation struct {
+	// ID of the trigger.
+	ID string `json:"id,omitempty"`
+
+	// ResourceName is the fully qualified name of the trigger.
+	ResourceName string `json:"resourceName,omitempty"`
+
+	// CreateTime is when the trigger was created.
+	CreateTime string `json:"createTime,omitempty"`
+}
+
+// A TriggerSpec defines the desired state of a Trigger.
+type TriggerSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TriggerParameters `json:"forProvider"`
+}
+
+// A TriggerStatus represents the observed state of a Trigger.
+type TriggerStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TriggerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Trigger is a managed resource that represents a GCP Cloud Build trigger.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.forProvider.location"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Trigger struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TriggerSpec   `json:"spec"`
+	Status TriggerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TriggerList contains a list of Trigger
+type TriggerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Trigger `json:"items"`
+}
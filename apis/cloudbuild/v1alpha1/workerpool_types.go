@@ -0,0 +1,165 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Worker pool states.
+const (
+	WorkerPoolStateCreating = "CREATING"
+	WorkerPoolStateRunning  = "RUNNING"
+	WorkerPoolStateUpdating = "UPDATING"
+	WorkerPoolStateDeleting = "DELETING"
+)
+
+// WorkerPoolParameters define the desired state of a Cloud Build private
+// worker pool.
+type WorkerPoolParameters struct {
+	// Project the worker pool belongs to. Defaults to the project of the
+	// provider config.
+	// +immutable
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// ProjectRef references a Project and retrieves its external name.
+	// +immutable
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and retrieves its
+	// external name.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// Location of the worker pool, a region such as us-central1.
+	// +immutable
+	Location string `json:"location"`
+
+	// DisplayName of the worker pool.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Annotations of the worker pool.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// WorkerConfig configures the workers of the pool.
+	// +optional
+	WorkerConfig *WorkerConfig `json:"workerConfig,omitempty"`
+
+	// NetworkConfig configures the network of the workers.
+	// +optional
+	NetworkConfig *NetworkConfig `json:"networkConfig,omitempty"`
+}
+
+// WorkerConfig configures the workers of a pool.
+type WorkerConfig struct {
+	// MachineType of the workers, such as e2-standard-4.
+	// +optional
+	MachineType *string `json:"machineType,omitempty"`
+
+	// DiskSizeGB of the workers.
+	// +kubebuilder:validation:Maximum=2000
+	// +optional
+	DiskSizeGB *int64 `json:"diskSizeGb,omitempty"`
+}
+
+// NetworkConfig configures the network of the workers of a pool.
+type NetworkConfig struct {
+	// PeeredNetwork the workers are peered to, in the form
+	// projects/{project}/global/networks/{network}, where {project} is a
+	// project number. The network must have a private service connection
+	// to servicenetworking.googleapis.com.
+	// +immutable
+	PeeredNetwork string `json:"peeredNetwork"`
+
+	// PeeredNetworkIPRange is the range within the peered network the
+	// workers use, e.g. /29 or 192.168.0.0/29. Defaults to /24.
+	// +immutable
+	// +optional
+	PeeredNetworkIPRange *string `json:"peeredNetworkIpRange,omitempty"`
+
+	// EgressOption decides whether the workers get a public address.
+	// +optional
+	// +kubebuilder:validation:Enum=NO_PUBLIC_EGRESS;PUBLIC_EGRESS
+	EgressOption *string `json:"egressOption,omitempty"`
+}
+
+// WorkerPoolObservation is used to show the observed state of the
+// WorkerPool.
+type WorkerPoolObservation struct {
+	// Name is the resource name of the worker pool.
+	Name string `json:"name,omitempty"`
+
+	// UID of the worker pool.
+	UID string `json:"uid,omitempty"`
+
+	// State of the worker pool.
+	State string `json:"state,omitempty"`
+
+	// CreateTime is when the worker pool was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is when the worker pool was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+
+	// Etag of the worker pool.
+	Etag string `json:"etag,omitempty"`
+}
+
+// A WorkerPoolSpec defines the desired state of a WorkerPool.
+type WorkerPoolSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       WorkerPoolParameters `json:"forProvider"`
+}
+
+// A WorkerPoolStatus represents the observed state of a WorkerPool.
+type WorkerPoolStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          WorkerPoolObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A WorkerPool is a managed resource that represents a GCP Cloud Build private worker pool.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.forProvider.location"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type WorkerPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WorkerPoolSpec   `json:"spec"`
+	Status WorkerPoolStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WorkerPoolList contains a list of WorkerPool
+type WorkerPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WorkerPool `json:"items"`
+}
@@ -0,0 +1,381 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known Function states.
+const (
+	FunctionStateActive    = "ACTIVE"
+	FunctionStateDeploying = "DEPLOYING"
+	FunctionStateDeleting  = "DELETING"
+	FunctionStateFailed    = "FAILED"
+)
+
+// FunctionParameters define the desired state of a 2nd gen Cloud Function.
+// Most fields map directly to a Function:
+// https://cloud.google.com/functions/docs/reference/rest/v2/projects.locations.functions#Function
+type FunctionParameters struct {
+	// Location in which to create this function, e.g. us-central1.
+	// +immutable
+	Location string `json:"location"`
+
+	// Description of the function.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels associated with this function.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// KMSKeyName is the resource name of the Cloud KMS crypto key used to
+	// encrypt the function's resources, in the form
+	// projects/{project}/locations/{location}/keyRings/{key_ring}/cryptoKeys/{crypto_key}.
+	// +optional
+	// +immutable
+	KMSKeyName *string `json:"kmsKeyName,omitempty"`
+
+	// BuildConfig describes how the function's source is built.
+	BuildConfig BuildConfig `json:"buildConfig"`
+
+	// ServiceConfig describes the service that runs the function.
+	// +optional
+	ServiceConfig *ServiceConfig `json:"serviceConfig,omitempty"`
+
+	// EventTrigger causes the function to be invoked by Eventarc events.
+	// Functions without an event trigger are invoked over HTTPS.
+	// +optional
+	// +immutable
+	EventTrigger *EventTrigger `json:"eventTrigger,omitempty"`
+}
+
+// BuildConfig describes how the function's source is built.
+type BuildConfig struct {
+	// Runtime of the function, e.g. go121, nodejs20 or python312.
+	Runtime string `json:"runtime"`
+
+	// EntryPoint is the name of the function that is executed.
+	EntryPoint string `json:"entryPoint"`
+
+	// Source of the function.
+	Source Source `json:"source"`
+
+	// EnvironmentVariables passed to the build.
+	// +optional
+	EnvironmentVariables map[string]string `json:"environmentVariables,omitempty"`
+
+	// DockerRepository is the Artifact Registry repository the built image
+	// is pushed to, in the form
+	// projects/{project}/locations/{location}/repositories/{repository}.
+	// +optional
+	DockerRepository *string `json:"dockerRepository,omitempty"`
+}
+
+// Source of a function. Exactly one of StorageSource or RepoSource must be
+// set.
+type Source struct {
+	// StorageSource is an archive of the source in Cloud Storage.
+	// +optional
+	StorageSource *StorageSource `json:"storageSource,omitempty"`
+
+	// RepoSource is the source in a Cloud Source Repository.
+	// +optional
+	RepoSource *RepoSource `json:"repoSource,omitempty"`
+}
+
+// StorageSource is an archive of function source in Cloud Storage.
+type StorageSource struct {
+	// Bucket containing the archive.
+	Bucket string `json:"bucket"`
+
+	// Object is the name of the archive within the bucket.
+	Object string `json:"object"`
+
+	// Generation of the object. The latest generation is used if this is
+	// not set.
+	// +optional
+	Generation *int64 `json:"generation,omitempty"`
+}
+
+// RepoSource is function source in a Cloud Source Repository. Exactly one of
+// BranchName, TagName or CommitSHA must be set.
+type RepoSource struct {
+	// ProjectID that owns the repository. The function's project is used
+	// if this is not set.
+	// +optional
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// RepoName is the name of the repository.
+	RepoName string `json:"repoName"`
+
+	// BranchName to build.
+	// +optional
+	BranchName *string `json:"branchName,omitempty"`
+
+	// TagName to build.
+	// +optional
+	TagName *string `json:"tagName,omitempty"`
+
+	// CommitSHA to build.
+	// +optional
+	CommitSHA *string `json:"commitSha,omitempty"`
+
+	// Dir is the directory, relative to the root of the repository, that
+	// contains the function source.
+	// +optional
+	Dir *string `json:"dir,omitempty"`
+}
+
+// ServiceConfig describes the service that runs a function.
+type ServiceConfig struct {
+	// AvailableMemory of each function instance, e.g. 256M or 1Gi.
+	// +optional
+	AvailableMemory *string `json:"availableMemory,omitempty"`
+
+	// AvailableCPU of each function instance, e.g. 1 or 0.583.
+	// +optional
+	AvailableCPU *string `json:"availableCpu,omitempty"`
+
+	// TimeoutSeconds is the function execution timeout.
+	// +optional
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+
+	// MinInstanceCount is the number of instances kept warm.
+	// +optional
+	MinInstanceCount *int64 `json:"minInstanceCount,omitempty"`
+
+	// MaxInstanceCount is the maximum number of instances the function may
+	// scale out to.
+	// +optional
+	MaxInstanceCount *int64 `json:"maxInstanceCount,omitempty"`
+
+	// MaxInstanceRequestConcurrency is the maximum number of concurrent
+	// requests each instance may receive.
+	// +optional
+	MaxInstanceRequestConcurrency *int64 `json:"maxInstanceRequestConcurrency,omitempty"`
+
+	// EnvironmentVariables available to the function at runtime.
+	// +optional
+	EnvironmentVariables map[string]string `json:"environmentVariables,omitempty"`
+
+	// SecretEnvironmentVariables expose Secret Manager secrets to the
+	// function as environment variables.
+	// +optional
+	SecretEnvironmentVariables []SecretEnvVar `json:"secretEnvironmentVariables,omitempty"`
+
+	// SecretVolumes mount Secret Manager secrets into the function's file
+	// system.
+	// +optional
+	SecretVolumes []SecretVolume `json:"secretVolumes,omitempty"`
+
+	// IngressSettings control what traffic can reach the function.
+	// +optional
+	// +kubebuilder:validation:Enum=ALLOW_ALL;ALLOW_INTERNAL_ONLY;ALLOW_INTERNAL_AND_GCLB
+	IngressSettings *string `json:"ingressSettings,omitempty"`
+
+	// VPCConnector is the Serverless VPC Access connector the function uses,
+	// in the form projects/{project}/locations/{location}/connectors/{connector}.
+	// +optional
+	VPCConnector *string `json:"vpcConnector,omitempty"`
+
+	// VPCConnectorRef references a Connector to retrieve its name.
+	// +optional
+	VPCConnectorRef *xpv1.Reference `json:"vpcConnectorRef,omitempty"`
+
+	// VPCConnectorSelector selects a reference to a Connector to retrieve its
+	// name.
+	// +optional
+	VPCConnectorSelector *xpv1.Selector `json:"vpcConnectorSelector,omitempty"`
+
+	// VPCConnectorEgressSettings control what outgoing traffic is routed
+	// through the VPC connector.
+	// +optional
+	// +kubebuilder:validation:Enum=PRIVATE_RANGES_ONLY;ALL_TRAFFIC
+	VPCConnectorEgressSettings *string `json:"vpcConnectorEgressSettings,omitempty"`
+
+	// ServiceAccountEmail is the email of the service account the function
+	// runs as.
+	// +optional
+	ServiceAccountEmail *string `json:"serviceAccountEmail,omitempty"`
+
+	// AllTrafficOnLatestRevision routes all traffic to the latest revision
+	// of the function.
+	// +optional
+	AllTrafficOnLatestRevision *bool `json:"allTrafficOnLatestRevision,omitempty"`
+}
+
+// SecretEnvVar exposes a Secret Manager secret as an environment variable.
+type SecretEnvVar struct {
+	// Key is the name of the environment variable.
+	Key string `json:"key"`
+
+	// ProjectID of the project that contains the secret. The function's
+	// project is used if this is not set.
+	// +optional
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// Secret is the name of the secret in Secret Manager.
+	Secret string `json:"secret"`
+
+	// Version of the secret to expose, e.g. latest or 3.
+	Version string `json:"version"`
+}
+
+// SecretVolume mounts a Secret Manager secret into the file system.
+type SecretVolume struct {
+	// MountPath at which the secret is mounted, e.g. /etc/secrets.
+	MountPath string `json:"mountPath"`
+
+	// ProjectID of the project that contains the secret. The function's
+	// project is used if this is not set.
+	// +optional
+	ProjectID *string `json:"projectId,omitempty"`
+
+	// Secret is the name of the secret in Secret Manager.
+	Secret string `json:"secret"`
+
+	// Versions of the secret to mount. The latest version is mounted at
+	// /secret/{secret} if this is not set.
+	// +optional
+	Versions []SecretVersion `json:"versions,omitempty"`
+}
+
+// SecretVersion maps a version of a secret to a file path.
+type SecretVersion struct {
+	// Version of the secret, e.g. latest or 3.
+	Version string `json:"version"`
+
+	// Path of the file, relative to the mount path, that holds the secret.
+	Path string `json:"path"`
+}
+
+// EventTrigger causes a function to be invoked by Eventarc events.
+type EventTrigger struct {
+	// EventType is the type of event to observe, e.g.
+	// google.cloud.pubsub.topic.v1.messagePublished.
+	EventType string `json:"eventType"`
+
+	// EventFilters select which events trigger the function.
+	// +optional
+	EventFilters []EventFilter `json:"eventFilters,omitempty"`
+
+	// PubsubTopic is the Pub/Sub topic events are delivered through, in the
+	// form projects/{project}/topics/{topic}.
+	// +optional
+	PubsubTopic *string `json:"pubsubTopic,omitempty"`
+
+	// ServiceAccountEmail is the email of the service account used to invoke
+	// the function.
+	// +optional
+	ServiceAccountEmail *string `json:"serviceAccountEmail,omitempty"`
+
+	// RetryPolicy for failed invocations.
+	// +optional
+	// +kubebuilder:validation:Enum=RETRY_POLICY_DO_NOT_RETRY;RETRY_POLICY_RETRY
+	RetryPolicy *string `json:"retryPolicy,omitempty"`
+
+	// TriggerRegion is the region the trigger is created in. The function's
+	// location is used if this is not set.
+	// +optional
+	TriggerRegion *string `json:"triggerRegion,omitempty"`
+}
+
+// EventFilter selects events by attribute.
+type EventFilter struct {
+	// Attribute of the event to match on.
+	Attribute string `json:"attribute"`
+
+	// Value the attribute must have.
+	Value string `json:"value"`
+
+	// Operator used to match the value. Only match-path-pattern is
+	// supported, and matches exactly if this is not set.
+	// +optional
+	Operator *string `json:"operator,omitempty"`
+}
+
+// FunctionObservation is used to show the observed state of a Function.
+type FunctionObservation struct {
+	// Name is the fully qualified name of the function.
+	Name string `json:"name,omitempty"`
+
+	// State of the function.
+	State string `json:"state,omitempty"`
+
+	// URL at which the function is served.
+	URL string `json:"url,omitempty"`
+
+	// UpdateTime is the time the function was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+
+	// Build is the name of the Cloud Build that built the latest version of
+	// the function.
+	Build string `json:"build,omitempty"`
+
+	// Service is the name of the Cloud Run service that runs the function.
+	Service string `json:"service,omitempty"`
+
+	// Revision is the name of the latest Cloud Run revision of the function.
+	Revision string `json:"revision,omitempty"`
+
+	// Trigger is the name of the Eventarc trigger of the function.
+	Trigger string `json:"trigger,omitempty"`
+}
+
+// A FunctionSpec defines the desired state of a Function.
+type FunctionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FunctionParameters `json:"forProvider"`
+}
+
+// A FunctionStatus represents the observed state of a Function.
+type FunctionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          FunctionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Function is a managed resource that represents a 2nd gen Google Cloud
+// Function.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.atProvider.url"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Function struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FunctionSpec   `json:"spec"`
+	Status FunctionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FunctionList contains a list of Function
+type FunctionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Function `json:"items"`
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	vpcaccessv1alpha1 "github.com/crossplane/provider-gcp/apis/vpcaccess/v1alpha1"
+)
+
+// FunctionName extracts the fully qualified name of a Function.
+func FunctionName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		f, ok := mg.(*Function)
+		if !ok {
+			return ""
+		}
+		return f.Status.AtProvider.Name
+	}
+}
+
+// ResolveReferences of this Function
+func (in *Function) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.serviceConfig.vpcConnector
+	if sc := in.Spec.ForProvider.ServiceConfig; sc != nil {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(sc.VPCConnector),
+			Reference:    sc.VPCConnectorRef,
+			Selector:     sc.VPCConnectorSelector,
+			To:           reference.To{Managed: &vpcaccessv1alpha1.Connector{}, List: &vpcaccessv1alpha1.ConnectorList{}},
+			Extract:      vpcaccessv1alpha1.ConnectorName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.serviceConfig.vpcConnector")
+		}
+		sc.VPCConnector = reference.ToPtrValue(rsp.ResolvedValue)
+		sc.VPCConnectorRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
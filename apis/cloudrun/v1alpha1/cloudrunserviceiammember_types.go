@@ -0,0 +1,108 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CloudRunServiceIAMMemberParameters defines parameters for a desired
+// CloudRunServiceIAMMember.
+type CloudRunServiceIAMMemberParameters struct {
+	// Service is the fully qualified name of the Cloud Run Service to which
+	// this member is bound, in the form
+	// projects/{project}/locations/{location}/services/{service}.
+	// +optional
+	// +immutable
+	Service *string `json:"service,omitempty"`
+
+	// ServiceRef references a Service and retrieves its fully qualified
+	// name.
+	// +optional
+	// +immutable
+	ServiceRef *xpv1.Reference `json:"serviceRef,omitempty"`
+
+	// ServiceSelector selects a reference to a Service.
+	// +optional
+	ServiceSelector *xpv1.Selector `json:"serviceSelector,omitempty"`
+
+	// Role that is assigned to Member, e.g. roles/run.invoker.
+	// +immutable
+	Role string `json:"role"`
+
+	// Member is the identity that is granted Role, e.g. allUsers,
+	// user:{email}, serviceAccount:{email} or group:{email}.
+	// +optional
+	// +immutable
+	Member *string `json:"member,omitempty"`
+
+	// ServiceAccountMemberRef is reference to ServiceAccount used to set
+	// the Member.
+	// +optional
+	// +immutable
+	ServiceAccountMemberRef *xpv1.Reference `json:"serviceAccountMemberRef,omitempty"`
+
+	// ServiceAccountMemberSelector selects reference to ServiceAccount used
+	// to set the Member.
+	// +optional
+	// +immutable
+	ServiceAccountMemberSelector *xpv1.Selector `json:"serviceAccountMemberSelector,omitempty"`
+}
+
+// CloudRunServiceIAMMemberSpec defines the desired state of a
+// CloudRunServiceIAMMember.
+type CloudRunServiceIAMMemberSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CloudRunServiceIAMMemberParameters `json:"forProvider"`
+}
+
+// CloudRunServiceIAMMemberStatus represents the observed state of a
+// CloudRunServiceIAMMember.
+type CloudRunServiceIAMMemberStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudRunServiceIAMMember is a managed resource that represents membership
+// of a Cloud Run Service IAM Policy.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ROLE",type="string",JSONPath=".spec.forProvider.role"
+// +kubebuilder:printcolumn:name="MEMBER",type="string",JSONPath=".spec.forProvider.member"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type CloudRunServiceIAMMember struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudRunServiceIAMMemberSpec   `json:"spec"`
+	Status CloudRunServiceIAMMemberStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudRunServiceIAMMemberList contains a list of CloudRunServiceIAMMember
+// types
+type CloudRunServiceIAMMemberList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudRunServiceIAMMember `json:"items"`
+}
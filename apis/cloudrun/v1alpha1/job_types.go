@@ -0,0 +1,158 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// JobParameters define the desired state of a Cloud Run Job.
+// Most fields map directly to a Job:
+// https://cloud.google.com/run/docs/reference/rest/v2/projects.locations.jobs#Job
+type JobParameters struct {
+	// Location in which to create this job, e.g. us-central1.
+	// +immutable
+	Location string `json:"location"`
+
+	// Labels associated with this job.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Template describes the executions that are created from this job.
+	Template ExecutionTemplate `json:"template"`
+}
+
+// ExecutionTemplate describes the executions that are created from a Job.
+type ExecutionTemplate struct {
+	// Labels associated with each execution.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Parallelism is the maximum number of tasks of an execution that may
+	// run at the same time.
+	// +optional
+	Parallelism *int64 `json:"parallelism,omitempty"`
+
+	// TaskCount is the number of tasks each execution runs.
+	// +optional
+	TaskCount *int64 `json:"taskCount,omitempty"`
+
+	// Template describes the tasks of each execution.
+	Template TaskTemplate `json:"template"`
+}
+
+// TaskTemplate describes the tasks of an execution.
+type TaskTemplate struct {
+	// Containers that make up each task.
+	// +kubebuilder:validation:MinItems=1
+	Containers []Container `json:"containers"`
+
+	// MaxRetries is the number of times a failed task is retried.
+	// +optional
+	MaxRetries *int64 `json:"maxRetries,omitempty"`
+
+	// Timeout is the maximum time a task attempt may take, e.g. 600s.
+	// +optional
+	Timeout *string `json:"timeout,omitempty"`
+
+	// ServiceAccount is the email of the service account each task runs as.
+	// +optional
+	ServiceAccount *string `json:"serviceAccount,omitempty"`
+
+	// VPCAccess configures how each task connects to a VPC network.
+	// +optional
+	VPCAccess *VPCAccess `json:"vpcAccess,omitempty"`
+
+	// ExecutionEnvironment of each task.
+	// +optional
+	// +kubebuilder:validation:Enum=EXECUTION_ENVIRONMENT_GEN1;EXECUTION_ENVIRONMENT_GEN2
+	ExecutionEnvironment *string `json:"executionEnvironment,omitempty"`
+
+	// EncryptionKey is the resource name of the Cloud KMS crypto key used to
+	// encrypt container images, in the form
+	// projects/{project}/locations/{location}/keyRings/{key_ring}/cryptoKeys/{crypto_key}.
+	// +optional
+	EncryptionKey *string `json:"encryptionKey,omitempty"`
+}
+
+// JobObservation is used to show the observed state of a Job.
+type JobObservation struct {
+	// Name is the fully qualified name of the job.
+	Name string `json:"name,omitempty"`
+
+	// UID of the job.
+	UID string `json:"uid,omitempty"`
+
+	// Generation of the job that was last applied.
+	Generation int64 `json:"generation,omitempty"`
+
+	// ObservedGeneration is the generation of the job that was last
+	// reconciled by Cloud Run.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Reconciling is true while Cloud Run is rolling out a change.
+	Reconciling bool `json:"reconciling,omitempty"`
+
+	// TerminalCondition summarizes the state of the job.
+	TerminalCondition Condition `json:"terminalCondition,omitempty"`
+
+	// ExecutionCount is the number of executions created for this job.
+	ExecutionCount int64 `json:"executionCount,omitempty"`
+
+	// LatestCreatedExecution is the name of the last created execution.
+	LatestCreatedExecution string `json:"latestCreatedExecution,omitempty"`
+}
+
+// A JobSpec defines the desired state of a Job.
+type JobSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       JobParameters `json:"forProvider"`
+}
+
+// A JobStatus represents the observed state of a Job.
+type JobStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          JobObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Job is a managed resource that represents a Cloud Run Job.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXECUTIONS",type="integer",JSONPath=".status.atProvider.executionCount"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Job struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   JobSpec   `json:"spec"`
+	Status JobStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// JobList contains a list of Job
+type JobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Job `json:"items"`
+}
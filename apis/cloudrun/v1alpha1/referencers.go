@@ -0,0 +1,112 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	iamv1alpha1 "github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	vpcaccessv1alpha1 "github.com/crossplane/provider-gcp/apis/vpcaccess/v1alpha1"
+)
+
+// ServiceName extracts the fully qualified name of a Service.
+func ServiceName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		s, ok := mg.(*Service)
+		if !ok {
+			return ""
+		}
+		return s.Status.AtProvider.Name
+	}
+}
+
+// ResolveReferences of this CloudRunServiceIAMMember
+func (in *CloudRunServiceIAMMember) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.service
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Service),
+		Reference:    in.Spec.ForProvider.ServiceRef,
+		Selector:     in.Spec.ForProvider.ServiceSelector,
+		To:           reference.To{Managed: &Service{}, List: &ServiceList{}},
+		Extract:      ServiceName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.service")
+	}
+	in.Spec.ForProvider.Service = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.ServiceRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.member
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Member),
+		Reference:    in.Spec.ForProvider.ServiceAccountMemberRef,
+		Selector:     in.Spec.ForProvider.ServiceAccountMemberSelector,
+		To:           reference.To{Managed: &iamv1alpha1.ServiceAccount{}, List: &iamv1alpha1.ServiceAccountList{}},
+		Extract:      iamv1alpha1.ServiceAccountMemberName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.member")
+	}
+	in.Spec.ForProvider.Member = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.ServiceAccountMemberRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// resolveVPCAccess resolves the connector of the supplied VPCAccess, if any.
+func resolveVPCAccess(ctx context.Context, r *reference.APIResolver, v *VPCAccess, path string) error {
+	if v == nil {
+		return nil
+	}
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(v.Connector),
+		Reference:    v.ConnectorRef,
+		Selector:     v.ConnectorSelector,
+		To:           reference.To{Managed: &vpcaccessv1alpha1.Connector{}, List: &vpcaccessv1alpha1.ConnectorList{}},
+		Extract:      vpcaccessv1alpha1.ConnectorName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, path)
+	}
+	v.Connector = reference.ToPtrValue(rsp.ResolvedValue)
+	v.ConnectorRef = rsp.ResolvedReference
+	return nil
+}
+
+// ResolveReferences of this Service
+func (in *Service) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.template.vpcAccess.connector
+	return resolveVPCAccess(ctx, r, in.Spec.ForProvider.Template.VPCAccess, "spec.forProvider.template.vpcAccess.connector")
+}
+
+// ResolveReferences of this Job
+func (in *Job) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.template.template.vpcAccess.connector
+	return resolveVPCAccess(ctx, r, in.Spec.ForProvider.Template.Template.VPCAccess, "spec.forProvider.template.template.vpcAccess.connector")
+}
@@ -0,0 +1,346 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known states of the terminal condition of Cloud Run resources.
+const (
+	ConditionStateSucceeded   = "CONDITION_SUCCEEDED"
+	ConditionStateFailed      = "CONDITION_FAILED"
+	ConditionStatePending     = "CONDITION_PENDING"
+	ConditionStateReconciling = "CONDITION_RECONCILING"
+)
+
+// ServiceParameters define the desired state of a Cloud Run Service.
+// Most fields map directly to a Service:
+// https://cloud.google.com/run/docs/reference/rest/v2/projects.locations.services#Service
+type ServiceParameters struct {
+	// Location in which to create this service, e.g. us-central1.
+	// +immutable
+	Location string `json:"location"`
+
+	// Description of the service.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels associated with this service.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Ingress controls what traffic can reach the service.
+	// +optional
+	// +kubebuilder:validation:Enum=INGRESS_TRAFFIC_ALL;INGRESS_TRAFFIC_INTERNAL_ONLY;INGRESS_TRAFFIC_INTERNAL_LOAD_BALANCER
+	Ingress *string `json:"ingress,omitempty"`
+
+	// Template describes the revisions that are created from this service.
+	Template RevisionTemplate `json:"template"`
+
+	// Traffic splits requests between revisions of the service. All traffic
+	// is sent to the latest ready revision if this is not set.
+	// +optional
+	Traffic []TrafficTarget `json:"traffic,omitempty"`
+}
+
+// RevisionTemplate describes the revisions that are created from a Service.
+type RevisionTemplate struct {
+	// Labels associated with each revision.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Containers that make up each revision.
+	// +kubebuilder:validation:MinItems=1
+	Containers []Container `json:"containers"`
+
+	// Scaling settings of each revision.
+	// +optional
+	Scaling *RevisionScaling `json:"scaling,omitempty"`
+
+	// VPCAccess configures how each revision connects to a VPC network.
+	// +optional
+	VPCAccess *VPCAccess `json:"vpcAccess,omitempty"`
+
+	// ServiceAccount is the email of the service account each revision runs
+	// as.
+	// +optional
+	ServiceAccount *string `json:"serviceAccount,omitempty"`
+
+	// Timeout is the maximum time a request may take, e.g. 300s.
+	// +optional
+	Timeout *string `json:"timeout,omitempty"`
+
+	// MaxInstanceRequestConcurrency is the maximum number of concurrent
+	// requests each instance may receive.
+	// +optional
+	MaxInstanceRequestConcurrency *int64 `json:"maxInstanceRequestConcurrency,omitempty"`
+
+	// ExecutionEnvironment of each revision.
+	// +optional
+	// +kubebuilder:validation:Enum=EXECUTION_ENVIRONMENT_GEN1;EXECUTION_ENVIRONMENT_GEN2
+	ExecutionEnvironment *string `json:"executionEnvironment,omitempty"`
+
+	// EncryptionKey is the resource name of the Cloud KMS crypto key used to
+	// encrypt container images, in the form
+	// projects/{project}/locations/{location}/keyRings/{key_ring}/cryptoKeys/{crypto_key}.
+	// +optional
+	EncryptionKey *string `json:"encryptionKey,omitempty"`
+}
+
+// RevisionScaling settings of a revision.
+type RevisionScaling struct {
+	// MinInstanceCount is the number of instances kept warm.
+	// +optional
+	MinInstanceCount *int64 `json:"minInstanceCount,omitempty"`
+
+	// MaxInstanceCount is the maximum number of instances a revision may
+	// scale out to.
+	// +optional
+	MaxInstanceCount *int64 `json:"maxInstanceCount,omitempty"`
+}
+
+// VPCAccess configures how a revision or task connects to a VPC network.
+type VPCAccess struct {
+	// Connector is the Serverless VPC Access connector to use, in the form
+	// projects/{project}/locations/{location}/connectors/{connector}.
+	// +optional
+	Connector *string `json:"connector,omitempty"`
+
+	// ConnectorRef references a Connector to retrieve its name.
+	// +optional
+	ConnectorRef *xpv1.Reference `json:"connectorRef,omitempty"`
+
+	// ConnectorSelector selects a reference to a Connector to retrieve its
+	// name.
+	// +optional
+	ConnectorSelector *xpv1.Selector `json:"connectorSelector,omitempty"`
+
+	// Egress controls what outgoing traffic is routed through the VPC.
+	// +optional
+	// +kubebuilder:validation:Enum=ALL_TRAFFIC;PRIVATE_RANGES_ONLY
+	Egress *string `json:"egress,omitempty"`
+}
+
+// A Container that runs as part of a revision or task.
+type Container struct {
+	// Name of the container.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// Image is the URL of the container image, e.g.
+	// us-docker.pkg.dev/cloudrun/container/hello.
+	Image string `json:"image"`
+
+	// Command is the entrypoint of the container. The image's ENTRYPOINT is
+	// used if this is not set.
+	// +optional
+	Command []string `json:"command,omitempty"`
+
+	// Args to the entrypoint. The image's CMD is used if this is not set.
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// Env is a list of environment variables to set in the container.
+	// +optional
+	Env []EnvVar `json:"env,omitempty"`
+
+	// Ports exposed by the container. Only one port may be exposed.
+	// +optional
+	Ports []ContainerPort `json:"ports,omitempty"`
+
+	// Resources allocated to the container.
+	// +optional
+	Resources *ResourceRequirements `json:"resources,omitempty"`
+
+	// WorkingDir of the container. The image's WORKDIR is used if this is
+	// not set.
+	// +optional
+	WorkingDir *string `json:"workingDir,omitempty"`
+}
+
+// EnvVar is an environment variable set in a container. Exactly one of Value
+// or ValueSource must be set.
+type EnvVar struct {
+	// Name of the environment variable.
+	Name string `json:"name"`
+
+	// Value of the environment variable.
+	// +optional
+	Value *string `json:"value,omitempty"`
+
+	// ValueSource is the source of the environment variable's value.
+	// +optional
+	ValueSource *EnvVarSource `json:"valueSource,omitempty"`
+}
+
+// EnvVarSource is the source of an environment variable's value.
+type EnvVarSource struct {
+	// SecretKeyRef selects a version of a Secret Manager secret.
+	SecretKeyRef SecretKeySelector `json:"secretKeyRef"`
+}
+
+// SecretKeySelector selects a version of a Secret Manager secret.
+type SecretKeySelector struct {
+	// Secret is the name of the secret in Secret Manager, in the form
+	// {secret} or projects/{project}/secrets/{secret}.
+	Secret string `json:"secret"`
+
+	// Version of the secret, e.g. latest or 3.
+	// +optional
+	Version *string `json:"version,omitempty"`
+}
+
+// ContainerPort is a port exposed by a container.
+type ContainerPort struct {
+	// Name of the port, either http1 or h2c.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// ContainerPort is the port number the container listens on.
+	ContainerPort int64 `json:"containerPort"`
+}
+
+// ResourceRequirements of a container.
+type ResourceRequirements struct {
+	// Limits of the resources the container may use, keyed by resource
+	// name, e.g. cpu: "1" or memory: 512Mi.
+	// +optional
+	Limits map[string]string `json:"limits,omitempty"`
+
+	// CPUIdle allows the CPU to be throttled outside of requests.
+	// +optional
+	CPUIdle *bool `json:"cpuIdle,omitempty"`
+
+	// StartupCPUBoost allocates extra CPU while the container starts.
+	// +optional
+	StartupCPUBoost *bool `json:"startupCpuBoost,omitempty"`
+}
+
+// TrafficTarget sends a share of a service's traffic to a revision.
+type TrafficTarget struct {
+	// Type of the traffic target.
+	// +kubebuilder:validation:Enum=TRAFFIC_TARGET_ALLOCATION_TYPE_LATEST;TRAFFIC_TARGET_ALLOCATION_TYPE_REVISION
+	Type string `json:"type"`
+
+	// Revision to send traffic to. Required if Type is
+	// TRAFFIC_TARGET_ALLOCATION_TYPE_REVISION.
+	// +optional
+	Revision *string `json:"revision,omitempty"`
+
+	// Percent of the traffic sent to this target.
+	// +optional
+	Percent *int64 `json:"percent,omitempty"`
+
+	// Tag under which this target is additionally reachable.
+	// +optional
+	Tag *string `json:"tag,omitempty"`
+}
+
+// TrafficTargetStatus is the observed state of a traffic target.
+type TrafficTargetStatus struct {
+	Type     string `json:"type,omitempty"`
+	Revision string `json:"revision,omitempty"`
+	Percent  int64  `json:"percent,omitempty"`
+	Tag      string `json:"tag,omitempty"`
+	URI      string `json:"uri,omitempty"`
+}
+
+// Condition is the observed state of a Cloud Run condition.
+type Condition struct {
+	Type    string `json:"type,omitempty"`
+	State   string `json:"state,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// ServiceObservation is used to show the observed state of a Service.
+type ServiceObservation struct {
+	// Name is the fully qualified name of the service.
+	Name string `json:"name,omitempty"`
+
+	// UID of the service.
+	UID string `json:"uid,omitempty"`
+
+	// Generation of the service that was last applied.
+	Generation int64 `json:"generation,omitempty"`
+
+	// ObservedGeneration is the generation of the service that was last
+	// reconciled by Cloud Run.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// URI at which the service is served.
+	URI string `json:"uri,omitempty"`
+
+	// Reconciling is true while Cloud Run is rolling out a change.
+	Reconciling bool `json:"reconciling,omitempty"`
+
+	// TerminalCondition summarizes the state of the service.
+	TerminalCondition Condition `json:"terminalCondition,omitempty"`
+
+	// LatestReadyRevision is the name of the latest revision that is ready
+	// to serve traffic.
+	LatestReadyRevision string `json:"latestReadyRevision,omitempty"`
+
+	// LatestCreatedRevision is the name of the last created revision.
+	LatestCreatedRevision string `json:"latestCreatedRevision,omitempty"`
+
+	// TrafficStatuses describe how traffic is currently split between
+	// revisions.
+	TrafficStatuses []TrafficTargetStatus `json:"trafficStatuses,omitempty"`
+}
+
+// A ServiceSpec defines the desired state of a Service.
+type ServiceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServiceParameters `json:"forProvider"`
+}
+
+// A ServiceStatus represents the observed state of a Service.
+type ServiceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ServiceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Service is a managed resource that represents a Cloud Run Service.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="REVISION",type="string",JSONPath=".status.atProvider.latestReadyRevision"
+// +kubebuilder:printcolumn:name="URI",type="string",JSONPath=".status.atProvider.uri"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Service struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceSpec   `json:"spec"`
+	Status ServiceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceList contains a list of Service
+type ServiceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Service `json:"items"`
+}
@@ -0,0 +1,309 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known Job states.
+const (
+	JobStateEnabled      = "ENABLED"
+	JobStatePaused       = "PAUSED"
+	JobStateDisabled     = "DISABLED"
+	JobStateUpdateFailed = "UPDATE_FAILED"
+)
+
+// JobParameters define the desired state of a Cloud Scheduler Job. Exactly
+// one of HTTPTarget, PubsubTarget or AppEngineHTTPTarget must be set.
+// Most fields map directly to a Job:
+// https://cloud.google.com/scheduler/docs/reference/rest/v1/projects.locations.jobs#Job
+type JobParameters struct {
+	// Location in which to create this job, e.g. us-central1.
+	// +immutable
+	Location string `json:"location"`
+
+	// Description of the job.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Schedule on which the job is run, in unix-cron format, e.g.
+	// "0 */3 * * *".
+	Schedule string `json:"schedule"`
+
+	// TimeZone in which the schedule is interpreted, as a tz database name,
+	// e.g. Europe/Berlin. UTC is used if this is not set.
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+
+	// AttemptDeadline is how long an attempt may take before it is
+	// cancelled and considered failed, e.g. 180s.
+	// +optional
+	AttemptDeadline *string `json:"attemptDeadline,omitempty"`
+
+	// RetryConfig configures how failed attempts are retried.
+	// +optional
+	RetryConfig *RetryConfig `json:"retryConfig,omitempty"`
+
+	// HTTPTarget sends the job to an HTTP endpoint.
+	// +optional
+	HTTPTarget *HTTPTarget `json:"httpTarget,omitempty"`
+
+	// PubsubTarget publishes the job to a Pub/Sub topic.
+	// +optional
+	PubsubTarget *PubsubTarget `json:"pubsubTarget,omitempty"`
+
+	// AppEngineHTTPTarget sends the job to an App Engine application.
+	// +optional
+	AppEngineHTTPTarget *AppEngineHTTPTarget `json:"appEngineHttpTarget,omitempty"`
+}
+
+// RetryConfig configures how failed attempts of a job are retried.
+type RetryConfig struct {
+	// RetryCount is the number of times a failed attempt is retried.
+	// +optional
+	RetryCount *int64 `json:"retryCount,omitempty"`
+
+	// MaxRetryDuration limits the time for retrying a failed attempt,
+	// e.g. 3600s. Zero means unlimited.
+	// +optional
+	MaxRetryDuration *string `json:"maxRetryDuration,omitempty"`
+
+	// MinBackoffDuration is the minimum time to wait before retrying,
+	// e.g. 5s.
+	// +optional
+	MinBackoffDuration *string `json:"minBackoffDuration,omitempty"`
+
+	// MaxBackoffDuration is the maximum time to wait before retrying,
+	// e.g. 3600s.
+	// +optional
+	MaxBackoffDuration *string `json:"maxBackoffDuration,omitempty"`
+
+	// MaxDoublings is the number of times the backoff interval is doubled
+	// before it increases linearly.
+	// +optional
+	MaxDoublings *int64 `json:"maxDoublings,omitempty"`
+}
+
+// HTTPTarget sends a job to an HTTP endpoint.
+type HTTPTarget struct {
+	// URI of the endpoint, e.g. https://example.com/cron.
+	URI string `json:"uri"`
+
+	// HTTPMethod used for the request.
+	// +optional
+	// +kubebuilder:validation:Enum=POST;GET;HEAD;PUT;DELETE;PATCH;OPTIONS
+	HTTPMethod *string `json:"httpMethod,omitempty"`
+
+	// Headers sent with the request.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Body of the request. Only allowed for POST, PUT and PATCH requests.
+	// +optional
+	Body *string `json:"body,omitempty"`
+
+	// OIDCToken authenticates the request with an OIDC token, e.g. to call
+	// a Cloud Run service.
+	// +optional
+	OIDCToken *OIDCToken `json:"oidcToken,omitempty"`
+
+	// OAuthToken authenticates the request with an OAuth token, e.g. to
+	// call a Google API.
+	// +optional
+	OAuthToken *OAuthToken `json:"oauthToken,omitempty"`
+}
+
+// OIDCToken authenticates an HTTP request with an OIDC token.
+type OIDCToken struct {
+	// ServiceAccountEmail is the email of the service account whose token
+	// is sent.
+	// +optional
+	ServiceAccountEmail *string `json:"serviceAccountEmail,omitempty"`
+
+	// ServiceAccountRef references a ServiceAccount and retrieves its email.
+	// +optional
+	ServiceAccountRef *xpv1.Reference `json:"serviceAccountRef,omitempty"`
+
+	// ServiceAccountSelector selects a reference to a ServiceAccount.
+	// +optional
+	ServiceAccountSelector *xpv1.Selector `json:"serviceAccountSelector,omitempty"`
+
+	// Audience of the token. The URI of the target is used if this is not
+	// set.
+	// +optional
+	Audience *string `json:"audience,omitempty"`
+}
+
+// OAuthToken authenticates an HTTP request with an OAuth token.
+type OAuthToken struct {
+	// ServiceAccountEmail is the email of the service account whose token
+	// is sent.
+	// +optional
+	ServiceAccountEmail *string `json:"serviceAccountEmail,omitempty"`
+
+	// ServiceAccountRef references a ServiceAccount and retrieves its email.
+	// +optional
+	ServiceAccountRef *xpv1.Reference `json:"serviceAccountRef,omitempty"`
+
+	// ServiceAccountSelector selects a reference to a ServiceAccount.
+	// +optional
+	ServiceAccountSelector *xpv1.Selector `json:"serviceAccountSelector,omitempty"`
+
+	// Scope of the token. https://www.googleapis.com/auth/cloud-platform is
+	// used if this is not set.
+	// +optional
+	Scope *string `json:"scope,omitempty"`
+}
+
+// PubsubTarget publishes a job to a Pub/Sub topic.
+type PubsubTarget struct {
+	// Topic is the name of the topic, in the same project, to which the
+	// job is published.
+	// +optional
+	Topic *string `json:"topic,omitempty"`
+
+	// TopicRef references a Topic and retrieves its name.
+	// +optional
+	TopicRef *xpv1.Reference `json:"topicRef,omitempty"`
+
+	// TopicSelector selects a reference to a Topic.
+	// +optional
+	TopicSelector *xpv1.Selector `json:"topicSelector,omitempty"`
+
+	// Data is the payload of the published message.
+	// +optional
+	Data *string `json:"data,omitempty"`
+
+	// Attributes of the published message.
+	// +optional
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// AppEngineHTTPTarget sends a job to an App Engine application.
+type AppEngineHTTPTarget struct {
+	// HTTPMethod used for the request.
+	// +optional
+	// +kubebuilder:validation:Enum=POST;GET;HEAD;PUT;DELETE;PATCH;OPTIONS
+	HTTPMethod *string `json:"httpMethod,omitempty"`
+
+	// AppEngineRouting selects the service, version and instance that
+	// receive the request.
+	// +optional
+	AppEngineRouting *AppEngineRouting `json:"appEngineRouting,omitempty"`
+
+	// RelativeURI of the request, e.g. /cron. It must begin with a slash.
+	// +optional
+	RelativeURI *string `json:"relativeUri,omitempty"`
+
+	// Headers sent with the request.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Body of the request. Only allowed for POST and PUT requests.
+	// +optional
+	Body *string `json:"body,omitempty"`
+}
+
+// AppEngineRouting selects where an App Engine request is routed.
+type AppEngineRouting struct {
+	// Service of the application. The default service is used if this is
+	// not set.
+	// +optional
+	Service *string `json:"service,omitempty"`
+
+	// Version of the service. The default version is used if this is not
+	// set.
+	// +optional
+	Version *string `json:"version,omitempty"`
+
+	// Instance of the version. Any available instance is used if this is
+	// not set.
+	// +optional
+	Instance *string `json:"instance,omitempty"`
+}
+
+// JobObservation is used to show the observed state of a Job.
+type JobObservation struct {
+	// Name is the fully qualified name of the job.
+	Name string `json:"name,omitempty"`
+
+	// State of the job.
+	State string `json:"state,omitempty"`
+
+	// ScheduleTime is the next time the job is scheduled to run.
+	ScheduleTime string `json:"scheduleTime,omitempty"`
+
+	// LastAttemptTime is the time the last attempt of the job started.
+	LastAttemptTime string `json:"lastAttemptTime,omitempty"`
+
+	// UserUpdateTime is the time the job was last updated.
+	UserUpdateTime string `json:"userUpdateTime,omitempty"`
+
+	// LastAttemptStatus is the result of the last attempt of the job.
+	LastAttemptStatus *AttemptStatus `json:"lastAttemptStatus,omitempty"`
+}
+
+// AttemptStatus is the result of an attempt of a job.
+type AttemptStatus struct {
+	// Code is the gRPC status code of the attempt. Zero means success.
+	Code int64 `json:"code,omitempty"`
+
+	// Message describes the result of the attempt.
+	Message string `json:"message,omitempty"`
+}
+
+// A JobSpec defines the desired state of a Job.
+type JobSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       JobParameters `json:"forProvider"`
+}
+
+// A JobStatus represents the observed state of a Job.
+type JobStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          JobObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Job is a managed resource that represents a Cloud Scheduler Job.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SCHEDULE",type="string",JSONPath=".spec.forProvider.schedule"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Job struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   JobSpec   `json:"spec"`
+	Status JobStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// JobList contains a list of Job
+type JobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Job `json:"items"`
+}
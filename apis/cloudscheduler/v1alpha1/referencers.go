@@ -0,0 +1,84 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	iamv1alpha1 "github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	pubsubv1alpha1 "github.com/crossplane/provider-gcp/apis/pubsub/v1alpha1"
+)
+
+// ResolveReferences of this Job
+func (in *Job) ResolveReferences(ctx context.Context, c client.Reader) error { // nolint:gocyclo
+	r := reference.NewAPIResolver(c, in)
+
+	if t := in.Spec.ForProvider.HTTPTarget; t != nil && t.OIDCToken != nil {
+		// Resolve spec.forProvider.httpTarget.oidcToken.serviceAccountEmail
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(t.OIDCToken.ServiceAccountEmail),
+			Reference:    t.OIDCToken.ServiceAccountRef,
+			Selector:     t.OIDCToken.ServiceAccountSelector,
+			To:           reference.To{Managed: &iamv1alpha1.ServiceAccount{}, List: &iamv1alpha1.ServiceAccountList{}},
+			Extract:      iamv1alpha1.ServiceAccountEmail(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.httpTarget.oidcToken.serviceAccountEmail")
+		}
+		t.OIDCToken.ServiceAccountEmail = reference.ToPtrValue(rsp.ResolvedValue)
+		t.OIDCToken.ServiceAccountRef = rsp.ResolvedReference
+	}
+
+	if t := in.Spec.ForProvider.HTTPTarget; t != nil && t.OAuthToken != nil {
+		// Resolve spec.forProvider.httpTarget.oauthToken.serviceAccountEmail
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(t.OAuthToken.ServiceAccountEmail),
+			Reference:    t.OAuthToken.ServiceAccountRef,
+			Selector:     t.OAuthToken.ServiceAccountSelector,
+			To:           reference.To{Managed: &iamv1alpha1.ServiceAccount{}, List: &iamv1alpha1.ServiceAccountList{}},
+			Extract:      iamv1alpha1.ServiceAccountEmail(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.httpTarget.oauthToken.serviceAccountEmail")
+		}
+		t.OAuthToken.ServiceAccountEmail = reference.ToPtrValue(rsp.ResolvedValue)
+		t.OAuthToken.ServiceAccountRef = rsp.ResolvedReference
+	}
+
+	if t := in.Spec.ForProvider.PubsubTarget; t != nil {
+		// Resolve spec.forProvider.pubsubTarget.topic
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(t.Topic),
+			Reference:    t.TopicRef,
+			Selector:     t.TopicSelector,
+			To:           reference.To{Managed: &pubsubv1alpha1.Topic{}, List: &pubsubv1alpha1.TopicList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.pubsubTarget.topic")
+		}
+		t.Topic = reference.ToPtrValue(rsp.ResolvedValue)
+		t.TopicRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known Queue states.
+const (
+	QueueStateRunning  = "RUNNING"
+	QueueStatePaused   = "PAUSED"
+	QueueStateDisabled = "DISABLED"
+)
+
+// QueueParameters define the desired state of a Cloud Tasks Queue. Most fields
+// map directly to a Queue:
+// https://cloud.google.com/tasks/docs/reference/rest/v2/projects.locations.queues#Queue
+type QueueParameters struct {
+	// Location in which to create this queue, e.g. us-central1.
+	// +immutable
+	Location string `json:"location"`
+
+	// DesiredState of the queue. A RUNNING queue dispatches its tasks, a
+	// PAUSED queue keeps them until it is resumed. The queue is left as it
+	// is if this is not set.
+	// +optional
+	// +kubebuilder:validation:Enum=RUNNING;PAUSED
+	DesiredState *string `json:"desiredState,omitempty"`
+
+	// RateLimits limit the rate at which tasks are dispatched.
+	// +optional
+	RateLimits *RateLimits `json:"rateLimits,omitempty"`
+
+	// RetryConfig configures how failed tasks are retried.
+	// +optional
+	RetryConfig *RetryConfig `json:"retryConfig,omitempty"`
+
+	// StackdriverLoggingConfig configures the logging of task operations.
+	// No logs are written if this is not set.
+	// +optional
+	StackdriverLoggingConfig *StackdriverLoggingConfig `json:"stackdriverLoggingConfig,omitempty"`
+}
+
+// RateLimits limit the rate at which tasks of a queue are dispatched.
+type RateLimits struct {
+	// MaxDispatchesPerSecond is the maximum rate at which tasks are
+	// dispatched, as a decimal number, e.g. "500" or "0.5".
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	MaxDispatchesPerSecond *string `json:"maxDispatchesPerSecond,omitempty"`
+
+	// MaxConcurrentDispatches is the maximum number of tasks that may be
+	// dispatched at the same time.
+	// +optional
+	MaxConcurrentDispatches *int64 `json:"maxConcurrentDispatches,omitempty"`
+}
+
+// RetryConfig configures how failed tasks of a queue are retried.
+type RetryConfig struct {
+	// MaxAttempts is the number of attempts per task, including the first
+	// one. -1 means unlimited.
+	// +optional
+	MaxAttempts *int64 `json:"maxAttempts,omitempty"`
+
+	// MaxRetryDuration limits the time for retrying a failed task, e.g.
+	// 3600s. Zero means unlimited.
+	// +optional
+	MaxRetryDuration *string `json:"maxRetryDuration,omitempty"`
+
+	// MinBackoff is the minimum time to wait before retrying, e.g. 0.1s.
+	// +optional
+	MinBackoff *string `json:"minBackoff,omitempty"`
+
+	// MaxBackoff is the maximum time to wait before retrying, e.g. 3600s.
+	// +optional
+	MaxBackoff *string `json:"maxBackoff,omitempty"`
+
+	// MaxDoublings is the number of times the backoff interval is doubled
+	// before it increases linearly.
+	// +optional
+	MaxDoublings *int64 `json:"maxDoublings,omitempty"`
+}
+
+// StackdriverLoggingConfig configures the logging of task operations of a
+// queue.
+type StackdriverLoggingConfig struct {
+	// SamplingRatio is the fraction of operations to log, as a decimal
+	// number between 0.0 and 1.0, e.g. "0.25".
+	// +kubebuilder:validation:Pattern=`^(0(\.[0-9]+)?|1(\.0+)?)$`
+	SamplingRatio string `json:"samplingRatio"`
+}
+
+// QueueObservation is used to show the observed state of a Queue.
+type QueueObservation struct {
+	// Name is the fully qualified name of the queue.
+	Name string `json:"name,omitempty"`
+
+	// State of the queue.
+	State string `json:"state,omitempty"`
+
+	// MaxBurstSize is the number of tasks that may be dispatched at once
+	// when the queue is backed up.
+	MaxBurstSize int64 `json:"maxBurstSize,omitempty"`
+
+	// PurgeTime is the last time the queue was purged.
+	PurgeTime string `json:"purgeTime,omitempty"`
+}
+
+// A QueueSpec defines the desired state of a Queue.
+type QueueSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       QueueParameters `json:"forProvider"`
+}
+
+// A QueueStatus represents the observed state of a Queue.
+type QueueStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          QueueObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Queue is a managed resource that represents a Cloud Tasks Queue.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Queue struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   QueueSpec   `json:"spec"`
+	Status QueueStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// QueueList contains a list of Queue
+type QueueList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Queue `json:"items"`
+}
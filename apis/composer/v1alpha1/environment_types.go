@@ -0,0 +1,317 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known Environment states.
+const (
+	EnvironmentStateCreating = "CREATING"
+	EnvironmentStateRunning  = "RUNNING"
+	EnvironmentStateUpdating = "UPDATING"
+	EnvironmentStateDeleting = "DELETING"
+	EnvironmentStateError    = "ERROR"
+)
+
+// EnvironmentParameters define the desired state of a Cloud Composer
+// Environment. Most fields map directly to an Environment:
+// https://cloud.google.com/composer/docs/reference/rest/v1/projects.locations.environments#Environment
+type EnvironmentParameters struct {
+	// Location in which to create this environment, e.g. us-central1.
+	// +immutable
+	Location string `json:"location"`
+
+	// Labels to apply to the environment.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Config is the configuration of the environment.
+	// +optional
+	Config *EnvironmentConfig `json:"config,omitempty"`
+}
+
+// EnvironmentConfig configures an Environment.
+type EnvironmentConfig struct {
+	// NodeCount is the number of nodes of the GKE cluster that runs the
+	// environment. Only Cloud Composer 1 environments support it.
+	// +optional
+	NodeCount *int64 `json:"nodeCount,omitempty"`
+
+	// EnvironmentSize of a Cloud Composer 2 environment.
+	// +optional
+	// +kubebuilder:validation:Enum=ENVIRONMENT_SIZE_SMALL;ENVIRONMENT_SIZE_MEDIUM;ENVIRONMENT_SIZE_LARGE
+	EnvironmentSize *string `json:"environmentSize,omitempty"`
+
+	// NodeConfig configures the nodes that run the environment.
+	// +optional
+	// +immutable
+	NodeConfig *NodeConfig `json:"nodeConfig,omitempty"`
+
+	// SoftwareConfig configures the software that runs in the environment.
+	// +optional
+	SoftwareConfig *SoftwareConfig `json:"softwareConfig,omitempty"`
+
+	// PrivateEnvironmentConfig configures a private environment.
+	// +optional
+	// +immutable
+	PrivateEnvironmentConfig *PrivateEnvironmentConfig `json:"privateEnvironmentConfig,omitempty"`
+}
+
+// NodeConfig configures the nodes of an Environment.
+type NodeConfig struct {
+	// Location is the zone in which the nodes of a Cloud Composer 1
+	// environment run, e.g. us-central1-a.
+	// +optional
+	Location *string `json:"location,omitempty"`
+
+	// MachineType of the nodes of a Cloud Composer 1 environment, e.g.
+	// n1-standard-1.
+	// +optional
+	MachineType *string `json:"machineType,omitempty"`
+
+	// Network the nodes are attached to, in the form
+	// projects/{project}/global/networks/{network}.
+	// +optional
+	Network *string `json:"network,omitempty"`
+
+	// NetworkRef references a Network to retrieve its URL.
+	// +optional
+	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to a Network to retrieve its URL.
+	// +optional
+	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
+
+	// Subnetwork the nodes are attached to, in the form
+	// projects/{project}/regions/{region}/subnetworks/{subnetwork}.
+	// +optional
+	Subnetwork *string `json:"subnetwork,omitempty"`
+
+	// SubnetworkRef references a Subnetwork to retrieve its URL.
+	// +optional
+	SubnetworkRef *xpv1.Reference `json:"subnetworkRef,omitempty"`
+
+	// SubnetworkSelector selects a reference to a Subnetwork to retrieve its
+	// URL.
+	// +optional
+	SubnetworkSelector *xpv1.Selector `json:"subnetworkSelector,omitempty"`
+
+	// DiskSizeGB is the disk size of the nodes of a Cloud Composer 1
+	// environment.
+	// +optional
+	DiskSizeGB *int64 `json:"diskSizeGb,omitempty"`
+
+	// OAuthScopes available to the nodes of a Cloud Composer 1 environment.
+	// +optional
+	OAuthScopes []string `json:"oauthScopes,omitempty"`
+
+	// ServiceAccount is the email address of the IAM service account the
+	// nodes run as.
+	// +optional
+	ServiceAccount *string `json:"serviceAccount,omitempty"`
+
+	// ServiceAccountRef references a ServiceAccount to retrieve its email
+	// address.
+	// +optional
+	ServiceAccountRef *xpv1.Reference `json:"serviceAccountRef,omitempty"`
+
+	// ServiceAccountSelector selects a reference to a ServiceAccount to
+	// retrieve its email address.
+	// +optional
+	ServiceAccountSelector *xpv1.Selector `json:"serviceAccountSelector,omitempty"`
+
+	// Tags applied to the nodes of a Cloud Composer 1 environment.
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// IPAllocationPolicy configures the IP ranges of the GKE cluster.
+	// +optional
+	IPAllocationPolicy *IPAllocationPolicy `json:"ipAllocationPolicy,omitempty"`
+}
+
+// IPAllocationPolicy configures the IP ranges of the GKE cluster that runs an
+// Environment.
+type IPAllocationPolicy struct {
+	// UseIPAliases creates a VPC-native cluster. Only used by Cloud Composer
+	// 1 environments, Cloud Composer 2 environments always use IP aliases.
+	// +optional
+	UseIPAliases *bool `json:"useIpAliases,omitempty"`
+
+	// ClusterSecondaryRangeName is the name of the secondary range of the
+	// subnetwork used for pod IP addresses.
+	// +optional
+	ClusterSecondaryRangeName *string `json:"clusterSecondaryRangeName,omitempty"`
+
+	// ServicesSecondaryRangeName is the name of the secondary range of the
+	// subnetwork used for service IP addresses.
+	// +optional
+	ServicesSecondaryRangeName *string `json:"servicesSecondaryRangeName,omitempty"`
+
+	// ClusterIPv4CIDRBlock is the IP range used for pod IP addresses.
+	// +optional
+	ClusterIPv4CIDRBlock *string `json:"clusterIpv4CidrBlock,omitempty"`
+
+	// ServicesIPv4CIDRBlock is the IP range used for service IP addresses.
+	// +optional
+	ServicesIPv4CIDRBlock *string `json:"servicesIpv4CidrBlock,omitempty"`
+}
+
+// SoftwareConfig configures the software of an Environment.
+type SoftwareConfig struct {
+	// ImageVersion of Cloud Composer and Airflow to run, e.g.
+	// composer-2.4.6-airflow-2.6.3. Changing it upgrades the environment.
+	// +optional
+	ImageVersion *string `json:"imageVersion,omitempty"`
+
+	// AirflowConfigOverrides overrides Airflow configuration properties.
+	// Keys take the form section-property, e.g. core-dags_are_paused_at_creation.
+	// +optional
+	AirflowConfigOverrides map[string]string `json:"airflowConfigOverrides,omitempty"`
+
+	// PyPIPackages to install in the environment, keyed by package name.
+	// Values are version specifiers and extras, e.g. "[gcp]>=1.0", or empty.
+	// +optional
+	PyPIPackages map[string]string `json:"pypiPackages,omitempty"`
+
+	// EnvVariables to set for the Airflow scheduler, worker and webserver
+	// processes.
+	// +optional
+	EnvVariables map[string]string `json:"envVariables,omitempty"`
+
+	// PythonVersion of a Cloud Composer 1 environment.
+	// +optional
+	// +immutable
+	PythonVersion *string `json:"pythonVersion,omitempty"`
+
+	// SchedulerCount is the number of Airflow schedulers of a Cloud
+	// Composer 1 environment.
+	// +optional
+	SchedulerCount *int64 `json:"schedulerCount,omitempty"`
+}
+
+// PrivateEnvironmentConfig configures a private Environment.
+type PrivateEnvironmentConfig struct {
+	// EnablePrivateEnvironment creates the environment with private nodes.
+	// +optional
+	EnablePrivateEnvironment *bool `json:"enablePrivateEnvironment,omitempty"`
+
+	// PrivateClusterConfig configures the private GKE cluster.
+	// +optional
+	PrivateClusterConfig *PrivateClusterConfig `json:"privateClusterConfig,omitempty"`
+
+	// WebServerIPv4CIDRBlock is the IP range of the Airflow web server of a
+	// Cloud Composer 1 environment.
+	// +optional
+	WebServerIPv4CIDRBlock *string `json:"webServerIpv4CidrBlock,omitempty"`
+
+	// CloudSQLIPv4CIDRBlock is the IP range of the Cloud SQL instance.
+	// +optional
+	CloudSQLIPv4CIDRBlock *string `json:"cloudSqlIpv4CidrBlock,omitempty"`
+
+	// CloudComposerNetworkIPv4CIDRBlock is the IP range of the Cloud Composer
+	// network of a Cloud Composer 2 environment.
+	// +optional
+	CloudComposerNetworkIPv4CIDRBlock *string `json:"cloudComposerNetworkIpv4CidrBlock,omitempty"`
+
+	// EnablePrivatelyUsedPublicIPs allows the cluster to use privately used
+	// public IP ranges.
+	// +optional
+	EnablePrivatelyUsedPublicIPs *bool `json:"enablePrivatelyUsedPublicIps,omitempty"`
+}
+
+// PrivateClusterConfig configures the private GKE cluster of an Environment.
+type PrivateClusterConfig struct {
+	// EnablePrivateEndpoint disables access to the public endpoint of the
+	// GKE cluster.
+	// +optional
+	EnablePrivateEndpoint *bool `json:"enablePrivateEndpoint,omitempty"`
+
+	// MasterIPv4CIDRBlock is the IP range of the GKE control plane.
+	// +optional
+	MasterIPv4CIDRBlock *string `json:"masterIpv4CidrBlock,omitempty"`
+}
+
+// EnvironmentObservation is used to show the observed state of an
+// Environment.
+type EnvironmentObservation struct {
+	// Name is the fully qualified name of the environment.
+	Name string `json:"name,omitempty"`
+
+	// UUID of the environment.
+	UUID string `json:"uuid,omitempty"`
+
+	// State of the environment.
+	State string `json:"state,omitempty"`
+
+	// AirflowURI is the URI of the Airflow web interface.
+	AirflowURI string `json:"airflowUri,omitempty"`
+
+	// DAGGCSPrefix is the Cloud Storage prefix where DAGs are stored.
+	DAGGCSPrefix string `json:"dagGcsPrefix,omitempty"`
+
+	// GKECluster is the GKE cluster that runs the environment.
+	GKECluster string `json:"gkeCluster,omitempty"`
+
+	// CreateTime is the time the environment was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the environment was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// An EnvironmentSpec defines the desired state of an Environment.
+type EnvironmentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EnvironmentParameters `json:"forProvider"`
+}
+
+// An EnvironmentStatus represents the observed state of an Environment.
+type EnvironmentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EnvironmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Environment is a managed resource that represents a Cloud Composer Environment.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AIRFLOW",type="string",JSONPath=".status.atProvider.airflowUri"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Environment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EnvironmentSpec   `json:"spec"`
+	Status EnvironmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EnvironmentList contains a list of Environment
+type EnvironmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Environment `json:"items"`
+}
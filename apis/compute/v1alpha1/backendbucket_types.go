@@ -0,0 +1,255 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+)
+
+// Cache modes of a BackendBucketCDNPolicy.
+const (
+	CacheModeUseOriginHeaders = "USE_ORIGIN_HEADERS"
+	CacheModeForceCacheAll    = "FORCE_CACHE_ALL"
+	CacheModeCacheAllStatic   = "CACHE_ALL_STATIC"
+)
+
+// BackendBucketParameters define the desired state of a Google Compute Engine
+// BackendBucket. Most fields map directly to a BackendBucket:
+// https://cloud.google.com/compute/docs/reference/rest/v1/backendBuckets
+type BackendBucketParameters struct {
+	// BucketName: Cloud Storage bucket name.
+	// +optional
+	BucketName *string `json:"bucketName,omitempty"`
+
+	// BucketNameRef references a Bucket and retrieves its name.
+	// +optional
+	BucketNameRef *xpv1.Reference `json:"bucketNameRef,omitempty"`
+
+	// BucketNameSelector selects a reference to a Bucket.
+	// +optional
+	BucketNameSelector *xpv1.Selector `json:"bucketNameSelector,omitempty"`
+
+	// Description: An optional textual description of the resource.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// EnableCDN: If true, enable Cloud CDN for this BackendBucket.
+	// +optional
+	EnableCDN *bool `json:"enableCdn,omitempty"`
+
+	// CDNPolicy: Cloud CDN configuration for this BackendBucket.
+	// +optional
+	CDNPolicy *BackendBucketCDNPolicy `json:"cdnPolicy,omitempty"`
+
+	// CompressionMode: Compress text responses using Brotli or gzip
+	// compression, based on the client's Accept-Encoding header.
+	//
+	// Possible values:
+	//   "AUTOMATIC"
+	//   "DISABLED"
+	// +optional
+	// +kubebuilder:validation:Enum=AUTOMATIC;DISABLED
+	CompressionMode *string `json:"compressionMode,omitempty"`
+
+	// CustomResponseHeaders: Headers that the HTTP/S load balancer should
+	// add to proxied responses.
+	// +optional
+	CustomResponseHeaders []string `json:"customResponseHeaders,omitempty"`
+
+	// SignedURLKeys: Keys used to sign URLs and cookies for this
+	// BackendBucket. Key values cannot be read back from the API, so a key
+	// is identified by its name only; rotate a key by giving it a new name.
+	// +optional
+	SignedURLKeys []SignedURLKey `json:"signedUrlKeys,omitempty"`
+}
+
+// BackendBucketCDNPolicy is the Cloud CDN configuration of a BackendBucket.
+type BackendBucketCDNPolicy struct {
+	// CacheMode: Specifies the cache setting for all responses from this
+	// backend.
+	//
+	// Possible values:
+	//   "USE_ORIGIN_HEADERS" - Requires the origin to set valid caching
+	// headers to cache content.
+	//   "FORCE_CACHE_ALL" - Caches all content, ignoring any "private",
+	// "no-store" or "no-cache" directives in Cache-Control response headers.
+	//   "CACHE_ALL_STATIC" - Automatically cache static content, including
+	// common image formats, media (video and audio), and web assets.
+	// +optional
+	// +kubebuilder:validation:Enum=USE_ORIGIN_HEADERS;FORCE_CACHE_ALL;CACHE_ALL_STATIC
+	CacheMode *string `json:"cacheMode,omitempty"`
+
+	// ClientTTL: Specifies a separate client (e.g. browser client) maximum
+	// TTL in seconds.
+	// +optional
+	ClientTTL *int64 `json:"clientTtl,omitempty"`
+
+	// DefaultTTL: Specifies the default TTL in seconds for cached content
+	// served by this origin for responses that do not have an existing
+	// valid TTL.
+	// +optional
+	DefaultTTL *int64 `json:"defaultTtl,omitempty"`
+
+	// MaxTTL: Specifies the maximum allowed TTL in seconds for cached
+	// content served by this origin.
+	// +optional
+	MaxTTL *int64 `json:"maxTtl,omitempty"`
+
+	// NegativeCaching: Negative caching allows per-status code TTLs to be
+	// set, in order to apply fine-grained caching for common errors or
+	// redirects.
+	// +optional
+	NegativeCaching *bool `json:"negativeCaching,omitempty"`
+
+	// NegativeCachingPolicy: Sets a cache TTL for the specified HTTP status
+	// code. NegativeCaching must be enabled to configure it.
+	// +optional
+	NegativeCachingPolicy []NegativeCachingPolicy `json:"negativeCachingPolicy,omitempty"`
+
+	// RequestCoalescing: If true then Cloud CDN will combine multiple
+	// concurrent cache fill requests into a small number of requests to
+	// the origin.
+	// +optional
+	RequestCoalescing *bool `json:"requestCoalescing,omitempty"`
+
+	// ServeWhileStale: Serve existing content from the cache (if
+	// available) when revalidating content with the origin, or when an
+	// error is encountered when refreshing the cache. The value is given
+	// in seconds.
+	// +optional
+	ServeWhileStale *int64 `json:"serveWhileStale,omitempty"`
+
+	// SignedURLCacheMaxAgeSec: Maximum number of seconds the response to a
+	// signed URL request will be considered fresh.
+	// +optional
+	SignedURLCacheMaxAgeSec *int64 `json:"signedUrlCacheMaxAgeSec,omitempty"`
+
+	// CacheKeyPolicy: The CacheKeyPolicy for this CDNPolicy.
+	// +optional
+	CacheKeyPolicy *BackendBucketCacheKeyPolicy `json:"cacheKeyPolicy,omitempty"`
+}
+
+// NegativeCachingPolicy specifies the TTL of a cached response with a
+// particular HTTP status code.
+type NegativeCachingPolicy struct {
+	// Code: The HTTP status code to define a TTL against. Only HTTP status
+	// codes 300, 301, 302, 307, 308, 404, 405, 410, 421, 451 and 501 can be
+	// specified.
+	Code int64 `json:"code"`
+
+	// TTL: The TTL in seconds for which to cache responses with the
+	// corresponding status code.
+	// +optional
+	TTL *int64 `json:"ttl,omitempty"`
+}
+
+// BackendBucketCacheKeyPolicy specifies how a cache key is built for
+// requests to a BackendBucket.
+type BackendBucketCacheKeyPolicy struct {
+	// IncludeHTTPHeaders: Allows HTTP request headers (by name) to be used
+	// in the cache key.
+	// +optional
+	IncludeHTTPHeaders []string `json:"includeHttpHeaders,omitempty"`
+
+	// QueryStringWhitelist: Names of query string parameters to include in
+	// cache keys. All other parameters will be excluded.
+	// +optional
+	QueryStringWhitelist []string `json:"queryStringWhitelist,omitempty"`
+}
+
+// A SignedURLKey is a key used to sign Cloud CDN URLs and cookies.
+type SignedURLKey struct {
+	// KeyName: Name of the key. The name must be 1-63 characters long, and
+	// comply with RFC1035.
+	KeyName string `json:"keyName"`
+
+	// KeyValueSecretRef references the Secret key holding the 128-bit
+	// RFC4648 base64url encoded value of the key.
+	KeyValueSecretRef xpv1.SecretKeySelector `json:"keyValueSecretRef"`
+}
+
+// A BackendBucketObservation represents the observed state of a Google
+// Compute Engine BackendBucket.
+type BackendBucketObservation struct {
+	// CreationTimestamp: Creation timestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// ID: The unique identifier for the resource. This identifier is
+	// defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// SignedURLKeyNames: Names of the keys for signing request URLs that
+	// are currently configured on the BackendBucket.
+	SignedURLKeyNames []string `json:"signedUrlKeyNames,omitempty"`
+
+	// Diff: The fields of the BackendBucket that differ from the desired state, if
+	// any, e.g. routingConfig.routingMode. Fields are named as in the GCP
+	// API.
+	Diff []string `json:"diff,omitempty"`
+}
+
+// A BackendBucketSpec defines the desired state of a BackendBucket.
+type BackendBucketSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BackendBucketParameters `json:"forProvider"`
+}
+
+// A BackendBucketStatus represents the observed state of a BackendBucket.
+type BackendBucketStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BackendBucketObservation `json:"atProvider,omitempty"`
+
+	// LastOperation is the most recent operation that created, updated, or
+	// deleted the BackendBucket.
+	// +optional
+	LastOperation *v1beta1.Operation `json:"lastOperation,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BackendBucket is a managed resource that represents a Google Compute
+// Engine BackendBucket, which serves a Cloud Storage bucket through an HTTP(S)
+// load balancer and optionally Cloud CDN.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="BUCKET",type="string",JSONPath=".spec.forProvider.bucketName"
+// +kubebuilder:printcolumn:name="CDN",type="boolean",JSONPath=".spec.forProvider.enableCdn"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type BackendBucket struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BackendBucketSpec   `json:"spec"`
+	Status BackendBucketStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BackendBucketList contains a list of BackendBucket.
+type BackendBucketList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BackendBucket `json:"items"`
+}
@@ -0,0 +1,29 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/mutate-compute-gcp-crossplane-io-v1alpha1-router,mutating=true,failurePolicy=ignore,groups=compute.gcp.crossplane.io,resources=routers,versions=v1alpha1,name=routers.compute.gcp.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// Default sets the defaults of the Router that its schema can't express. A
+// zone that is specified as its region is replaced by the region of the zone.
+func (mg *Router) Default() {
+	mg.Spec.ForProvider.Region = v1beta1.RegionOf(mg.Spec.ForProvider.Region)
+}
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
 )
 
 // FirewallParameters define the desired state of a Google Compute Engine
@@ -67,6 +69,7 @@ type FirewallParameters struct {
 	// of `65535`. To avoid conflicts with the implied rules, use a priority
 	// number less than `65535`.
 	// +optional
+	// +kubebuilder:default=1000
 	Priority *int64 `json:"priority,omitempty"`
 
 	// SourceRanges: If source ranges are specified, the firewall rule
@@ -156,6 +159,7 @@ type FirewallParameters struct {
 	//   "EGRESS"
 	//   "INGRESS"
 	// +optional
+	// +kubebuilder:default=INGRESS
 	Direction *string `json:"direction,omitempty"`
 
 	// Disabled: Denotes whether the firewall rule is disabled. When set to
@@ -228,6 +232,11 @@ type FirewallObservation struct {
 
 	// SelfLink: Server-defined URL for the resource.
 	SelfLink string `json:"selfLink,omitempty"`
+
+	// Diff: The fields of the Firewall that differ from the desired state, if
+	// any, e.g. routingConfig.routingMode. Fields are named as in the GCP
+	// API.
+	Diff []string `json:"diff,omitempty"`
 }
 
 // A FirewallSpec defines the desired state of a Firewall.
@@ -240,6 +249,11 @@ type FirewallSpec struct {
 type FirewallStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          FirewallObservation `json:"atProvider,omitempty"`
+
+	// LastOperation is the most recent operation that created, updated, or
+	// deleted the Firewall.
+	// +optional
+	LastOperation *v1beta1.Operation `json:"lastOperation,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,108 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ImportedKind is the kind of the managed resources that an Import creates.
+type ImportedKind string
+
+// Kinds of managed resources that can be imported.
+const (
+	ImportedKindFirewall      ImportedKind = "Firewall"
+	ImportedKindGlobalAddress ImportedKind = "GlobalAddress"
+	ImportedKindNetwork       ImportedKind = "Network"
+	ImportedKindSubnetwork    ImportedKind = "Subnetwork"
+)
+
+// An ImportSpec defines which existing Google Compute Engine resources are
+// imported.
+type ImportSpec struct {
+	// ProviderConfigReference specifies the ProviderConfig that is used to
+	// list the resources, and that the imported managed resources use.
+	// +kubebuilder:default={"name": "default"}
+	ProviderConfigReference *xpv1.Reference `json:"providerConfigRef,omitempty"`
+
+	// Kind of the resources to import.
+	// +kubebuilder:validation:Enum=Firewall;GlobalAddress;Network;Subnetwork
+	Kind ImportedKind `json:"kind"`
+
+	// Filter selects the resources to import, using the filter syntax of
+	// the list methods of the Compute Engine API, e.g.
+	// name = "allow-*" or labels.team = "a". All resources are imported if
+	// this is not set.
+	// +optional
+	Filter string `json:"filter,omitempty"`
+
+	// NamePrefix is prepended to the names of the resources to form the
+	// names of their managed resources.
+	// +optional
+	NamePrefix string `json:"namePrefix,omitempty"`
+}
+
+// An ImportStatus represents the observed state of an Import.
+type ImportStatus struct {
+	xpv1.ConditionedStatus `json:",inline"`
+
+	// Imported is the number of resources that matched the filter when they
+	// were last listed, and that have a managed resource.
+	Imported int `json:"imported,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Import creates ObserveOnly managed resources for the existing Google
+// Compute Engine resources of a kind that match a filter. Resources that are
+// created later on are imported when the resources are listed again. The
+// managed resources are neither updated nor deleted by the Import. Imports
+// are experimental; they are only reconciled if the provider runs with
+// --enable-alpha-resources.
+// +kubebuilder:printcolumn:name="KIND",type="string",JSONPath=".spec.kind"
+// +kubebuilder:printcolumn:name="IMPORTED",type="integer",JSONPath=".status.imported"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,gcp}
+type Import struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ImportSpec   `json:"spec"`
+	Status ImportStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ImportList contains a list of Import.
+type ImportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Import `json:"items"`
+}
+
+// GetCondition of this Import.
+func (i *Import) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return i.Status.GetCondition(ct)
+}
+
+// SetConditions of this Import.
+func (i *Import) SetConditions(c ...xpv1.Condition) {
+	i.Status.SetConditions(c...)
+}
@@ -37,6 +37,22 @@ var (
 	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
 )
 
+// Address type metadata.
+var (
+	AddressKind             = reflect.TypeOf(Address{}).Name()
+	AddressGroupKind        = schema.GroupKind{Group: Group, Kind: AddressKind}.String()
+	AddressKindAPIVersion   = AddressKind + "." + SchemeGroupVersion.String()
+	AddressGroupVersionKind = SchemeGroupVersion.WithKind(AddressKind)
+)
+
+// BackendBucket type metadata.
+var (
+	BackendBucketKind             = reflect.TypeOf(BackendBucket{}).Name()
+	BackendBucketGroupKind        = schema.GroupKind{Group: Group, Kind: BackendBucketKind}.String()
+	BackendBucketKindAPIVersion   = BackendBucketKind + "." + SchemeGroupVersion.String()
+	BackendBucketGroupVersionKind = SchemeGroupVersion.WithKind(BackendBucketKind)
+)
+
 // Firewall type metadata.
 var (
 	FirewallKind             = reflect.TypeOf(Firewall{}).Name()
@@ -45,6 +61,14 @@ var (
 	FirewallGroupVersionKind = SchemeGroupVersion.WithKind(FirewallKind)
 )
 
+// Import type metadata.
+var (
+	ImportKind             = reflect.TypeOf(Import{}).Name()
+	ImportGroupKind        = schema.GroupKind{Group: Group, Kind: ImportKind}.String()
+	ImportKindAPIVersion   = ImportKind + "." + SchemeGroupVersion.String()
+	ImportGroupVersionKind = SchemeGroupVersion.WithKind(ImportKind)
+)
+
 // Router type metadata.
 var (
 	RouterKind             = reflect.TypeOf(Router{}).Name()
@@ -54,6 +78,9 @@ var (
 )
 
 func init() {
+	SchemeBuilder.Register(&Address{}, &AddressList{})
+	SchemeBuilder.Register(&BackendBucket{}, &BackendBucketList{})
 	SchemeBuilder.Register(&Firewall{}, &FirewallList{})
+	SchemeBuilder.Register(&Import{}, &ImportList{})
 	SchemeBuilder.Register(&Router{}, &RouterList{})
 }
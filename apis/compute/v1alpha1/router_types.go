@@ -14,8 +14,11 @@ limitations under the License.
 package v1alpha1
 
 import (
-	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
 )
 
 // RouterParameters define the desired state of a Google Compute Engine
@@ -29,9 +32,11 @@ type RouterParameters struct {
 	Description *string `json:"description,omitempty"`
 
 	// Region: URL of the region where the Subnetwork resides. This field
-	// can be set only at resource creation time.
+	// can be set only at resource creation time. Defaults to the default
+	// region of the ProviderConfig.
+	// +optional
 	// +immutable
-	Region string `json:"region"`
+	Region string `json:"region,omitempty"`
 
 	// Network: URI of the network to which this router belongs.
 	// +immutable
@@ -204,7 +209,10 @@ type RouterBgpPeer struct {
 type RouterNat struct {
 	// DrainNatIps: A list of URLs of the IP resources to be drained. These
 	// IPs must be valid static external IPs that have been assigned to the
-	// NAT. These IPs should be used for updating/patching a NAT only.
+	// NAT. These IPs should be used for updating/patching a NAT only. To
+	// rotate the IPs of a NAT, move them from NatIps to DrainNatIps, and
+	// remove them once they're drained. They are ignored when the router is
+	// created.
 	// +optional
 	DrainNatIps []string `json:"drainNatIps,omitempty"`
 
@@ -394,6 +402,11 @@ type RouterObservation struct {
 
 	// SelfLink: Server-defined URL for the resource.
 	SelfLink string `json:"selfLink,omitempty"`
+
+	// Diff: The fields of the Router that differ from the desired state, if
+	// any, e.g. routingConfig.routingMode. Fields are named as in the GCP
+	// API.
+	Diff []string `json:"diff,omitempty"`
 }
 
 // A RouterSpec defines the desired state of a Router.
@@ -406,6 +419,11 @@ type RouterSpec struct {
 type RouterStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          RouterObservation `json:"atProvider,omitempty"`
+
+	// LastOperation is the most recent operation that created, updated, or
+	// deleted the Router.
+	// +optional
+	LastOperation *v1beta1.Operation `json:"lastOperation,omitempty"`
 }
 
 // +kubebuilder:object:root=true
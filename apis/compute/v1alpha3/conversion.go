@@ -0,0 +1,342 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+)
+
+// AnnotationKeyHubFields is the annotation of a v1alpha3 resource that keeps
+// the fields that only exist in v1beta1, so that they survive a round trip
+// through v1alpha3.
+const AnnotationKeyHubFields = "compute.gcp.crossplane.io/v1beta1-fields"
+
+const (
+	errFmtUnsupportedHub = "unsupported conversion hub %T"
+	errSaveHubFields     = "cannot save v1beta1 fields"
+	errRestoreHubFields  = "cannot restore v1beta1 fields"
+)
+
+// networkHubFields are the fields of a v1beta1 Network that v1alpha3 can't
+// represent.
+type networkHubFields struct {
+	DeletionProtection *bool              `json:"deletionProtection,omitempty"`
+	Diff               []string           `json:"diff,omitempty"`
+	LastOperation      *v1beta1.Operation `json:"lastOperation,omitempty"`
+}
+
+// subnetworkHubFields are the fields of a v1beta1 Subnetwork that v1alpha3
+// can't represent. Booleans are only kept when they are explicitly false,
+// which v1alpha3 can't tell apart from unset.
+type subnetworkHubFields struct {
+	NetworkRef            *xpv1.Reference    `json:"networkRef,omitempty"`
+	NetworkSelector       *xpv1.Selector     `json:"networkSelector,omitempty"`
+	EnableFlowLogs        *bool              `json:"enableFlowLogs,omitempty"`
+	PrivateIPGoogleAccess *bool              `json:"privateIpGoogleAccess,omitempty"`
+	Diff                  []string           `json:"diff,omitempty"`
+	LastOperation         *v1beta1.Operation `json:"lastOperation,omitempty"`
+}
+
+// ConvertTo converts this Network to the v1beta1 hub. The name of the
+// external network becomes the external name of the hub.
+func (src *Network) ConvertTo(h conversion.Hub) error {
+	dst, ok := h.(*v1beta1.Network)
+	if !ok {
+		return errors.Errorf(errFmtUnsupportedHub, h)
+	}
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	if src.Spec.Name != "" {
+		meta.SetExternalName(dst, src.Spec.Name)
+	}
+	src.Spec.ResourceSpec.DeepCopyInto(&dst.Spec.ResourceSpec)
+	dst.Spec.ForProvider = v1beta1.NetworkParameters{
+		AutoCreateSubnetworks: copyBool(src.Spec.AutoCreateSubnetworks),
+		Description:           toStringPtr(src.Spec.Description),
+	}
+	if rc := src.Spec.RoutingConfig; rc != nil {
+		dst.Spec.ForProvider.RoutingConfig = &v1beta1.NetworkRoutingConfig{RoutingMode: rc.RoutingMode}
+	}
+
+	src.Status.ResourceStatus.DeepCopyInto(&dst.Status.ResourceStatus)
+	dst.Status.AtProvider = v1beta1.NetworkObservation{
+		CreationTimestamp: src.Status.CreationTimestamp,
+		GatewayIPv4:       src.Status.GatewayIPv4,
+		ID:                src.Status.ID,
+		SelfLink:          src.Status.SelfLink,
+		Subnetworks:       copyStrings(src.Status.Subnetworks),
+	}
+	for _, p := range src.Status.Peerings {
+		if p == nil {
+			continue
+		}
+		dst.Status.AtProvider.Peerings = append(dst.Status.AtProvider.Peerings, &v1beta1.NetworkPeering{
+			AutoCreateRoutes:     p.AutoCreateRoutes,
+			ExchangeSubnetRoutes: p.ExchangeSubnetRoutes,
+			Name:                 p.Name,
+			Network:              p.Network,
+			State:                p.State,
+			StateDetails:         p.StateDetails,
+		})
+	}
+
+	f := networkHubFields{}
+	if err := restoreHubFields(dst, &f); err != nil {
+		return err
+	}
+	dst.Spec.DeletionProtection = f.DeletionProtection
+	dst.Status.AtProvider.Diff = f.Diff
+	dst.Status.LastOperation = f.LastOperation
+	return nil
+}
+
+// ConvertFrom converts the v1beta1 hub to this Network. Fields that only
+// exist in v1beta1, like the last operation, are kept in the
+// AnnotationKeyHubFields annotation.
+func (dst *Network) ConvertFrom(h conversion.Hub) error {
+	src, ok := h.(*v1beta1.Network)
+	if !ok {
+		return errors.Errorf(errFmtUnsupportedHub, h)
+	}
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	src.Spec.ResourceSpec.DeepCopyInto(&dst.Spec.ResourceSpec)
+	dst.Spec.NetworkParameters = NetworkParameters{
+		Name:                  meta.GetExternalName(src),
+		AutoCreateSubnetworks: copyBool(src.Spec.ForProvider.AutoCreateSubnetworks),
+		Description:           fromStringPtr(src.Spec.ForProvider.Description),
+	}
+	if rc := src.Spec.ForProvider.RoutingConfig; rc != nil {
+		dst.Spec.RoutingConfig = &GCPNetworkRoutingConfig{RoutingMode: rc.RoutingMode}
+	}
+
+	src.Status.ResourceStatus.DeepCopyInto(&dst.Status.ResourceStatus)
+	dst.Status.GCPNetworkStatus = GCPNetworkStatus{
+		CreationTimestamp: src.Status.AtProvider.CreationTimestamp,
+		GatewayIPv4:       src.Status.AtProvider.GatewayIPv4,
+		ID:                src.Status.AtProvider.ID,
+		SelfLink:          src.Status.AtProvider.SelfLink,
+		Subnetworks:       copyStrings(src.Status.AtProvider.Subnetworks),
+	}
+	for _, p := range src.Status.AtProvider.Peerings {
+		if p == nil {
+			continue
+		}
+		dst.Status.Peerings = append(dst.Status.Peerings, &GCPNetworkPeering{
+			AutoCreateRoutes:     p.AutoCreateRoutes,
+			ExchangeSubnetRoutes: p.ExchangeSubnetRoutes,
+			Name:                 p.Name,
+			Network:              p.Network,
+			State:                p.State,
+			StateDetails:         p.StateDetails,
+		})
+	}
+
+	return saveHubFields(dst, networkHubFields{
+		DeletionProtection: copyBool(src.Spec.DeletionProtection),
+		Diff:               copyStrings(src.Status.AtProvider.Diff),
+		LastOperation:      src.Status.LastOperation.DeepCopy(),
+	})
+}
+
+// ConvertTo converts this Subnetwork to the v1beta1 hub. The name of the
+// external subnetwork becomes the external name of the hub.
+func (src *Subnetwork) ConvertTo(h conversion.Hub) error {
+	dst, ok := h.(*v1beta1.Subnetwork)
+	if !ok {
+		return errors.Errorf(errFmtUnsupportedHub, h)
+	}
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	if src.Spec.Name != "" {
+		meta.SetExternalName(dst, src.Spec.Name)
+	}
+	src.Spec.ResourceSpec.DeepCopyInto(&dst.Spec.ResourceSpec)
+	dst.Spec.ForProvider = v1beta1.SubnetworkParameters{
+		IPCidrRange:           src.Spec.IPCidrRange,
+		Network:               toStringPtr(src.Spec.Network),
+		Region:                src.Spec.Region,
+		Description:           toStringPtr(src.Spec.Description),
+		EnableFlowLogs:        toBoolPtr(src.Spec.EnableFlowLogs),
+		PrivateIPGoogleAccess: toBoolPtr(src.Spec.PrivateIPGoogleAccess),
+	}
+	for _, r := range src.Spec.SecondaryIPRanges {
+		if r == nil {
+			continue
+		}
+		dst.Spec.ForProvider.SecondaryIPRanges = append(dst.Spec.ForProvider.SecondaryIPRanges, &v1beta1.SubnetworkSecondaryRange{
+			IPCidrRange: r.IPCidrRange,
+			RangeName:   r.RangeName,
+		})
+	}
+
+	src.Status.ResourceStatus.DeepCopyInto(&dst.Status.ResourceStatus)
+	dst.Status.AtProvider = v1beta1.SubnetworkObservation{
+		CreationTimestamp: src.Status.CreationTimestamp,
+		Fingerprint:       src.Status.Fingerprint,
+		GatewayAddress:    src.Status.GatewayAddress,
+		ID:                src.Status.ID,
+		SelfLink:          src.Status.SelfLink,
+	}
+
+	f := subnetworkHubFields{}
+	if err := restoreHubFields(dst, &f); err != nil {
+		return err
+	}
+	dst.Spec.ForProvider.NetworkRef = f.NetworkRef
+	dst.Spec.ForProvider.NetworkSelector = f.NetworkSelector
+	if dst.Spec.ForProvider.EnableFlowLogs == nil {
+		dst.Spec.ForProvider.EnableFlowLogs = f.EnableFlowLogs
+	}
+	if dst.Spec.ForProvider.PrivateIPGoogleAccess == nil {
+		dst.Spec.ForProvider.PrivateIPGoogleAccess = f.PrivateIPGoogleAccess
+	}
+	dst.Status.AtProvider.Diff = f.Diff
+	dst.Status.LastOperation = f.LastOperation
+	return nil
+}
+
+// ConvertFrom converts the v1beta1 hub to this Subnetwork. Fields that only
+// exist in v1beta1, like the reference to the network, are kept in the
+// AnnotationKeyHubFields annotation.
+func (dst *Subnetwork) ConvertFrom(h conversion.Hub) error {
+	src, ok := h.(*v1beta1.Subnetwork)
+	if !ok {
+		return errors.Errorf(errFmtUnsupportedHub, h)
+	}
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	src.Spec.ResourceSpec.DeepCopyInto(&dst.Spec.ResourceSpec)
+	p := src.Spec.ForProvider
+	dst.Spec.SubnetworkParameters = SubnetworkParameters{
+		Name:                  meta.GetExternalName(src),
+		IPCidrRange:           p.IPCidrRange,
+		Network:               fromStringPtr(p.Network),
+		Region:                p.Region,
+		Description:           fromStringPtr(p.Description),
+		EnableFlowLogs:        fromBoolPtr(p.EnableFlowLogs),
+		PrivateIPGoogleAccess: fromBoolPtr(p.PrivateIPGoogleAccess),
+	}
+	for _, r := range p.SecondaryIPRanges {
+		if r == nil {
+			continue
+		}
+		dst.Spec.SecondaryIPRanges = append(dst.Spec.SecondaryIPRanges, &GCPSubnetworkSecondaryRange{
+			IPCidrRange: r.IPCidrRange,
+			RangeName:   r.RangeName,
+		})
+	}
+
+	src.Status.ResourceStatus.DeepCopyInto(&dst.Status.ResourceStatus)
+	dst.Status.GCPSubnetworkStatus = GCPSubnetworkStatus{
+		CreationTimestamp: src.Status.AtProvider.CreationTimestamp,
+		Fingerprint:       src.Status.AtProvider.Fingerprint,
+		GatewayAddress:    src.Status.AtProvider.GatewayAddress,
+		ID:                src.Status.AtProvider.ID,
+		SelfLink:          src.Status.AtProvider.SelfLink,
+	}
+
+	return saveHubFields(dst, subnetworkHubFields{
+		NetworkRef:            p.NetworkRef.DeepCopy(),
+		NetworkSelector:       p.NetworkSelector.DeepCopy(),
+		EnableFlowLogs:        explicitlyFalse(p.EnableFlowLogs),
+		PrivateIPGoogleAccess: explicitlyFalse(p.PrivateIPGoogleAccess),
+		Diff:                  copyStrings(src.Status.AtProvider.Diff),
+		LastOperation:         src.Status.LastOperation.DeepCopy(),
+	})
+}
+
+// saveHubFields stores the supplied v1beta1 fields in the hub fields
+// annotation of o, or removes the annotation if none of them are set.
+func saveHubFields(o metav1.Object, f interface{}) error {
+	meta.RemoveAnnotations(o, AnnotationKeyHubFields)
+	b, err := json.Marshal(f)
+	if err != nil {
+		return errors.Wrap(err, errSaveHubFields)
+	}
+	if string(b) != "{}" {
+		meta.AddAnnotations(o, map[string]string{AnnotationKeyHubFields: string(b)})
+	}
+	return nil
+}
+
+// restoreHubFields reads the v1beta1 fields saved in the hub fields annotation
+// of o into f, and removes the annotation.
+func restoreHubFields(o metav1.Object, f interface{}) error {
+	v, ok := o.GetAnnotations()[AnnotationKeyHubFields]
+	if !ok {
+		return nil
+	}
+	meta.RemoveAnnotations(o, AnnotationKeyHubFields)
+	return errors.Wrap(json.Unmarshal([]byte(v), f), errRestoreHubFields)
+}
+
+// v1alpha3 omitted unset optional strings and booleans rather than using
+// pointers, so their zero values convert to nil.
+
+func toStringPtr(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func fromStringPtr(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func toBoolPtr(b bool) *bool {
+	if !b {
+		return nil
+	}
+	return &b
+}
+
+func fromBoolPtr(b *bool) bool {
+	return b != nil && *b
+}
+
+func explicitlyFalse(b *bool) *bool {
+	if b == nil || *b {
+		return nil
+	}
+	f := false
+	return &f
+}
+
+func copyBool(b *bool) *bool {
+	if b == nil {
+		return nil
+	}
+	c := *b
+	return &c
+}
+
+func copyStrings(s []string) []string {
+	if s == nil {
+		return nil
+	}
+	return append([]string{}, s...)
+}
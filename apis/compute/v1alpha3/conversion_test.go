@@ -0,0 +1,230 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+)
+
+const (
+	testName    = "test-network"
+	testExtName = "test-external-network"
+	testNetwork = "projects/test-project/global/networks/test-network"
+)
+
+func TestNetworkConversion(t *testing.T) {
+	truth := true
+	description := "a network"
+
+	spoke := &Network{
+		ObjectMeta: metav1.ObjectMeta{Name: testName, Annotations: map[string]string{meta.AnnotationKeyExternalName: testExtName}},
+		Spec: NetworkSpec{
+			ResourceSpec: xpv1.ResourceSpec{DeletionPolicy: xpv1.DeletionOrphan},
+			NetworkParameters: NetworkParameters{
+				Name:                  testExtName,
+				Description:           description,
+				AutoCreateSubnetworks: &truth,
+				RoutingConfig:         &GCPNetworkRoutingConfig{RoutingMode: "REGIONAL"},
+			},
+		},
+		Status: NetworkStatus{
+			ResourceStatus: xpv1.ResourceStatus{ConditionedStatus: xpv1.ConditionedStatus{Conditions: []xpv1.Condition{xpv1.Available()}}},
+			GCPNetworkStatus: GCPNetworkStatus{
+				GatewayIPv4: "10.0.0.1",
+				ID:          42,
+				Peerings:    []*GCPNetworkPeering{{Name: "peering", State: "ACTIVE"}},
+				Subnetworks: []string{"subnetwork"},
+			},
+		},
+	}
+	hub := &v1beta1.Network{
+		ObjectMeta: metav1.ObjectMeta{Name: testName, Annotations: map[string]string{meta.AnnotationKeyExternalName: testExtName}},
+		Spec: v1beta1.NetworkSpec{
+			ResourceSpec: xpv1.ResourceSpec{DeletionPolicy: xpv1.DeletionOrphan},
+			ForProvider: v1beta1.NetworkParameters{
+				Description:           &description,
+				AutoCreateSubnetworks: &truth,
+				RoutingConfig:         &v1beta1.NetworkRoutingConfig{RoutingMode: "REGIONAL"},
+			},
+		},
+		Status: v1beta1.NetworkStatus{
+			ResourceStatus: xpv1.ResourceStatus{ConditionedStatus: xpv1.ConditionedStatus{Conditions: []xpv1.Condition{xpv1.Available()}}},
+			AtProvider: v1beta1.NetworkObservation{
+				GatewayIPv4: "10.0.0.1",
+				ID:          42,
+				Peerings:    []*v1beta1.NetworkPeering{{Name: "peering", State: "ACTIVE"}},
+				Subnetworks: []string{"subnetwork"},
+			},
+		},
+	}
+
+	gotHub := &v1beta1.Network{}
+	if err := spoke.ConvertTo(gotHub); err != nil {
+		t.Fatalf("ConvertTo(...): %s", err)
+	}
+	if diff := cmp.Diff(hub, gotHub); diff != "" {
+		t.Errorf("ConvertTo(...): -want, +got:\n%s", diff)
+	}
+
+	// Objects stored as v1alpha3 only specify the external name in spec.name.
+	stored := spoke.DeepCopy()
+	stored.SetAnnotations(nil)
+	gotHub = &v1beta1.Network{}
+	if err := stored.ConvertTo(gotHub); err != nil {
+		t.Fatalf("ConvertTo(...): %s", err)
+	}
+	if diff := cmp.Diff(testExtName, meta.GetExternalName(gotHub)); diff != "" {
+		t.Errorf("ConvertTo(...): -want external name, +got external name:\n%s", diff)
+	}
+
+	gotSpoke := &Network{}
+	if err := gotSpoke.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom(...): %s", err)
+	}
+	if diff := cmp.Diff(spoke, gotSpoke); diff != "" {
+		t.Errorf("ConvertFrom(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestSubnetworkConversion(t *testing.T) {
+	truth := true
+	network := testNetwork
+
+	spoke := &Subnetwork{
+		ObjectMeta: metav1.ObjectMeta{Name: testName, Annotations: map[string]string{meta.AnnotationKeyExternalName: testExtName}},
+		Spec: SubnetworkSpec{
+			SubnetworkParameters: SubnetworkParameters{
+				Name:                  testExtName,
+				IPCidrRange:           "10.0.0.0/24",
+				Network:               testNetwork,
+				Region:                "us-central1",
+				PrivateIPGoogleAccess: true,
+				SecondaryIPRanges:     []*GCPSubnetworkSecondaryRange{{IPCidrRange: "10.1.0.0/24", RangeName: "pods"}},
+			},
+		},
+		Status: SubnetworkStatus{
+			GCPSubnetworkStatus: GCPSubnetworkStatus{Fingerprint: "fp", SelfLink: "link"},
+		},
+	}
+	hub := &v1beta1.Subnetwork{
+		ObjectMeta: metav1.ObjectMeta{Name: testName, Annotations: map[string]string{meta.AnnotationKeyExternalName: testExtName}},
+		Spec: v1beta1.SubnetworkSpec{
+			ForProvider: v1beta1.SubnetworkParameters{
+				IPCidrRange:           "10.0.0.0/24",
+				Network:               &network,
+				Region:                "us-central1",
+				PrivateIPGoogleAccess: &truth,
+				SecondaryIPRanges:     []*v1beta1.SubnetworkSecondaryRange{{IPCidrRange: "10.1.0.0/24", RangeName: "pods"}},
+			},
+		},
+		Status: v1beta1.SubnetworkStatus{
+			AtProvider: v1beta1.SubnetworkObservation{Fingerprint: "fp", SelfLink: "link"},
+		},
+	}
+
+	gotHub := &v1beta1.Subnetwork{}
+	if err := spoke.ConvertTo(gotHub); err != nil {
+		t.Fatalf("ConvertTo(...): %s", err)
+	}
+	if diff := cmp.Diff(hub, gotHub); diff != "" {
+		t.Errorf("ConvertTo(...): -want, +got:\n%s", diff)
+	}
+
+	gotSpoke := &Subnetwork{}
+	if err := gotSpoke.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom(...): %s", err)
+	}
+	if diff := cmp.Diff(spoke, gotSpoke); diff != "" {
+		t.Errorf("ConvertFrom(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestNetworkRoundTrip(t *testing.T) {
+	truth := true
+	description := "a network"
+
+	hub := &v1beta1.Network{
+		ObjectMeta: metav1.ObjectMeta{Name: testName, Annotations: map[string]string{meta.AnnotationKeyExternalName: testExtName}},
+		Spec: v1beta1.NetworkSpec{
+			ForProvider: v1beta1.NetworkParameters{
+				Description:           &description,
+				AutoCreateSubnetworks: &truth,
+			},
+			DeletionProtection: &truth,
+		},
+		Status: v1beta1.NetworkStatus{
+			AtProvider:    v1beta1.NetworkObservation{ID: 42, Diff: []string{"description"}},
+			LastOperation: &v1beta1.Operation{SelfLink: "operation", Status: "DONE"},
+		},
+	}
+
+	spoke := &Network{}
+	if err := spoke.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom(...): %s", err)
+	}
+	got := &v1beta1.Network{}
+	if err := spoke.ConvertTo(got); err != nil {
+		t.Fatalf("ConvertTo(...): %s", err)
+	}
+	if diff := cmp.Diff(hub, got); diff != "" {
+		t.Errorf("ConvertTo(ConvertFrom(...)): -want, +got:\n%s", diff)
+	}
+}
+
+func TestSubnetworkRoundTrip(t *testing.T) {
+	falsity := false
+	network := testNetwork
+
+	hub := &v1beta1.Subnetwork{
+		ObjectMeta: metav1.ObjectMeta{Name: testName, Annotations: map[string]string{meta.AnnotationKeyExternalName: testExtName}},
+		Spec: v1beta1.SubnetworkSpec{
+			ForProvider: v1beta1.SubnetworkParameters{
+				IPCidrRange:           "10.0.0.0/24",
+				Network:               &network,
+				NetworkRef:            &xpv1.Reference{Name: testName},
+				NetworkSelector:       &xpv1.Selector{MatchLabels: map[string]string{"network": testName}},
+				Region:                "us-central1",
+				EnableFlowLogs:        &falsity,
+				PrivateIPGoogleAccess: &falsity,
+			},
+		},
+		Status: v1beta1.SubnetworkStatus{
+			AtProvider:    v1beta1.SubnetworkObservation{Fingerprint: "fp", Diff: []string{"ipCidrRange"}},
+			LastOperation: &v1beta1.Operation{SelfLink: "operation", Status: "RUNNING"},
+		},
+	}
+
+	spoke := &Subnetwork{}
+	if err := spoke.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom(...): %s", err)
+	}
+	got := &v1beta1.Subnetwork{}
+	if err := spoke.ConvertTo(got); err != nil {
+		t.Fatalf("ConvertTo(...): %s", err)
+	}
+	if diff := cmp.Diff(hub, got); diff != "" {
+		t.Errorf("ConvertTo(ConvertFrom(...)): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,24 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1alpha3 contains the deprecated v1alpha3 versions of the GCP
+// compute managed resources. They aren't served, but objects that are still
+// stored in them are converted to v1beta1 by the conversion webhooks, which
+// run when the provider is started with --enable-webhooks.
+// +kubebuilder:object:generate=true
+// +groupName=compute.gcp.crossplane.io
+// +versionName=v1alpha3
+package v1alpha3
@@ -0,0 +1,151 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// NetworkParameters define the desired state of a Google Compute Engine VPC
+// Network. Unlike in v1beta1 they are inlined into the spec, and the name of
+// the external network is specified by the name field rather than the
+// crossplane.io/external-name annotation.
+type NetworkParameters struct {
+	// Name: Name of the resource. Provided by the client when the resource
+	// is created. The name must be 1-63 characters long, and comply with
+	// RFC1035.
+	Name string `json:"name"`
+
+	// Description: An optional description of this resource. Provide this
+	// field when you create the resource.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// AutoCreateSubnetworks: When set to true, the VPC network is created
+	// in "auto" mode. When set to false, the VPC network is created in
+	// "custom" mode.
+	// +optional
+	AutoCreateSubnetworks *bool `json:"autoCreateSubnetworks,omitempty"`
+
+	// RoutingConfig: The network-level routing configuration for this
+	// network. Used by Cloud Router to determine what type of network-wide
+	// routing behavior to enforce.
+	// +optional
+	RoutingConfig *GCPNetworkRoutingConfig `json:"routingConfig,omitempty"`
+}
+
+// A GCPNetworkStatus represents the observed state of a Google Compute Engine
+// VPC Network.
+type GCPNetworkStatus struct {
+	// CreationTimestamp: Creation timestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// GatewayIPv4: The gateway address for default routing
+	// out of the network, selected by GCP.
+	GatewayIPv4 string `json:"gatewayIPv4,omitempty"`
+
+	// ID: The unique identifier for the resource. This
+	// identifier is defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// Peerings: A list of network peerings for the resource.
+	Peerings []*GCPNetworkPeering `json:"peerings,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// Subnetworks: Server-defined fully-qualified URLs for all subnetworks
+	// in this VPC network.
+	Subnetworks []string `json:"subnetworks,omitempty"`
+}
+
+// A GCPNetworkPeering represents the observed state of a Google Compute Engine
+// VPC Network Peering.
+type GCPNetworkPeering struct {
+	// AutoCreateRoutes: This field will be deprecated soon. Use the
+	// exchange_subnet_routes field instead.
+	AutoCreateRoutes bool `json:"autoCreateRoutes,omitempty"`
+
+	// ExchangeSubnetRoutes: Indicates whether full mesh connectivity is
+	// created and managed automatically.
+	ExchangeSubnetRoutes bool `json:"exchangeSubnetRoutes,omitempty"`
+
+	// Name: Name of this peering.
+	Name string `json:"name,omitempty"`
+
+	// Network: The URL of the peer network.
+	Network string `json:"network,omitempty"`
+
+	// State: State for the peering, either ACTIVE or INACTIVE.
+	State string `json:"state,omitempty"`
+
+	// StateDetails: Details about the current state of the peering.
+	StateDetails string `json:"stateDetails,omitempty"`
+}
+
+// A GCPNetworkRoutingConfig specifies the desired state of a Google Compute
+// Engine VPC Network Routing configuration.
+type GCPNetworkRoutingConfig struct {
+	// RoutingMode: The network-wide routing mode to use.
+	//
+	// Possible values:
+	//   "GLOBAL"
+	//   "REGIONAL"
+	// +kubebuilder:validation:Enum=GLOBAL;REGIONAL
+	RoutingMode string `json:"routingMode"`
+}
+
+// A NetworkSpec defines the desired state of a Network.
+type NetworkSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	NetworkParameters `json:",inline"`
+}
+
+// A NetworkStatus represents the observed state of a Network.
+type NetworkStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	GCPNetworkStatus    `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Network is a managed resource that represents a Google Compute Engine VPC
+// Network.
+// Deprecated: Use the v1beta1 Network.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+// +kubebuilder:unservedversion
+type Network struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetworkSpec   `json:"spec"`
+	Status NetworkStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetworkList contains a list of Network.
+type NetworkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Network `json:"items"`
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// SubnetworkParameters define the desired state of a Google Compute Engine
+// VPC Subnetwork. Unlike in v1beta1 they are inlined into the spec, and the
+// name of the external subnetwork is specified by the name field rather than
+// the crossplane.io/external-name annotation.
+type SubnetworkParameters struct {
+	// Name: The name of the resource, provided by the client when initially
+	// creating the resource. The name must be 1-63 characters long, and
+	// comply with RFC1035.
+	Name string `json:"name"`
+
+	// IPCidrRange: The range of internal addresses that are owned by this
+	// subnetwork.
+	IPCidrRange string `json:"ipCidrRange"`
+
+	// Network: The URL of the network to which this subnetwork belongs.
+	Network string `json:"network"`
+
+	// Region: URL of the GCP region for this subnetwork.
+	Region string `json:"region"`
+
+	// Description: An optional description of this resource.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// EnableFlowLogs: Whether to enable flow logging for this subnetwork.
+	// +optional
+	EnableFlowLogs bool `json:"enableFlowLogs,omitempty"`
+
+	// PrivateIPGoogleAccess: Whether the VMs in this subnet can access
+	// Google services without assigned external IP addresses.
+	// +optional
+	PrivateIPGoogleAccess bool `json:"privateIpGoogleAccess,omitempty"`
+
+	// SecondaryIPRanges: An array of configurations for secondary IP ranges
+	// for VM instances contained in this subnetwork.
+	// +optional
+	SecondaryIPRanges []*GCPSubnetworkSecondaryRange `json:"secondaryIpRanges,omitempty"`
+}
+
+// A GCPSubnetworkStatus represents the observed state of a Google Compute
+// Engine VPC Subnetwork.
+type GCPSubnetworkStatus struct {
+	// CreationTimestamp: Creation timestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// Fingerprint: Fingerprint of this resource, used for optimistic
+	// locking.
+	Fingerprint string `json:"fingerprint,omitempty"`
+
+	// GatewayAddress: The gateway address for default routes to reach
+	// destination addresses outside this subnetwork.
+	GatewayAddress string `json:"gatewayAddress,omitempty"`
+
+	// ID: The unique identifier for the resource. This
+	// identifier is defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+}
+
+// A GCPSubnetworkSecondaryRange defines the state of a Google Compute Engine
+// VPC Subnetwork secondary range.
+type GCPSubnetworkSecondaryRange struct {
+	// IPCidrRange: The range of IP addresses belonging to this subnetwork
+	// secondary range.
+	IPCidrRange string `json:"ipCidrRange"`
+
+	// RangeName: The name associated with this subnetwork secondary range,
+	// used when adding an alias IP range to a VM instance.
+	RangeName string `json:"rangeName"`
+}
+
+// A SubnetworkSpec defines the desired state of a Subnetwork.
+type SubnetworkSpec struct {
+	xpv1.ResourceSpec    `json:",inline"`
+	SubnetworkParameters `json:",inline"`
+}
+
+// A SubnetworkStatus represents the observed state of a Subnetwork.
+type SubnetworkStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	GCPSubnetworkStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Subnetwork is a managed resource that represents a Google Compute Engine
+// VPC Subnetwork.
+// Deprecated: Use the v1beta1 Subnetwork.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+// +kubebuilder:unservedversion
+type Subnetwork struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SubnetworkSpec   `json:"spec"`
+	Status SubnetworkStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SubnetworkList contains a list of Subnetwork.
+type SubnetworkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Subnetwork `json:"items"`
+}
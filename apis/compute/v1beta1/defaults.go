@@ -0,0 +1,42 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"regexp"
+)
+
+// zone matches the name of a zone, e.g. us-central1-a, and captures the name
+// of its region, e.g. us-central1.
+var zone = regexp.MustCompile(`^([a-z]+-[a-z]+[0-9]+)-[a-z]$`)
+
+// RegionOf returns the region of the supplied zone. Anything that isn't the
+// name of a zone, e.g. the name of a region, is returned unchanged.
+func RegionOf(location string) string {
+	if m := zone.FindStringSubmatch(location); m != nil {
+		return m[1]
+	}
+	return location
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/mutate-compute-gcp-crossplane-io-v1beta1-subnetwork,mutating=true,failurePolicy=ignore,groups=compute.gcp.crossplane.io,resources=subnetworks,versions=v1beta1,name=subnetworks.compute.gcp.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// Default sets the defaults of the Subnetwork that its schema can't express.
+// A zone that is specified as its region is replaced by the region of the zone.
+func (mg *Subnetwork) Default() {
+	mg.Spec.ForProvider.Region = RegionOf(mg.Spec.ForProvider.Region)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRegionOf(t *testing.T) {
+	cases := map[string]struct {
+		location string
+		want     string
+	}{
+		"Zone": {
+			location: "us-central1-a",
+			want:     "us-central1",
+		},
+		"Region": {
+			location: "europe-west4",
+			want:     "europe-west4",
+		},
+		"Empty": {
+			location: "",
+			want:     "",
+		},
+		"URL": {
+			location: "https://www.googleapis.com/compute/v1/projects/p/zones/us-central1-a",
+			want:     "https://www.googleapis.com/compute/v1/projects/p/zones/us-central1-a",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, RegionOf(tc.location)); diff != "" {
+				t.Errorf("RegionOf(%q): -want, +got:\n%s", tc.location, diff)
+			}
+		})
+	}
+}
@@ -33,7 +33,11 @@ const (
 // Global Address. Most fields map directly to an Address:
 // https://cloud.google.com/compute/docs/reference/rest/v1/globalAddresses
 type GlobalAddressParameters struct {
-	// Address: The static IP address represented by this resource.
+	// Address: The static IP address represented by this resource. Setting
+	// it to an ephemeral IP address that is in use, for example by a global
+	// forwarding rule, promotes that IP address to a static one. The
+	// ephemeral IP addresses of VM instances are regional and are promoted
+	// with an Address instead.
 	// +optional
 	// +immutable
 	Address *string `json:"address,omitempty"`
@@ -48,6 +52,7 @@ type GlobalAddressParameters struct {
 	// +optional
 	// +immutable
 	// +kubebuilder:validation:Enum=EXTERNAL;INTERNAL;UNSPECIFIED_TYPE
+	// +kubebuilder:default=EXTERNAL
 	AddressType *string `json:"addressType,omitempty"`
 
 	// Description: An optional description of this resource.
@@ -67,6 +72,18 @@ type GlobalAddressParameters struct {
 	// +kubebuilder:validation:Enum=IPV6;IPV4;UNSPECIFIED_VERSION
 	IPVersion *string `json:"ipVersion,omitempty"`
 
+	// IPv6EndpointType: The endpoint type of this address, which should be
+	// VM or NETLB. This is used for deciding which type of endpoint this
+	// address can be used after the external IPv6 address reservation.
+	//
+	// Possible values:
+	//   "NETLB"
+	//   "VM"
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=NETLB;VM
+	IPv6EndpointType *string `json:"ipv6EndpointType,omitempty"`
+
 	// Network: The URL of the network in which to reserve the address. This
 	// field can only be used with INTERNAL type with the VPC_PEERING
 	// purpose.
@@ -99,15 +116,18 @@ type GlobalAddressParameters struct {
 	// networks.
 	// - `NAT_AUTO` for addresses that are external IP addresses
 	// automatically reserved for Cloud NAT.
+	// - `PRIVATE_SERVICE_CONNECT` for INTERNAL addresses that are used to
+	// configure Private Service Connect consumer endpoints.
 	//
 	// Possible values:
 	//   "DNS_RESOLVER"
 	//   "GCE_ENDPOINT"
 	//   "NAT_AUTO"
+	//   "PRIVATE_SERVICE_CONNECT"
 	//   "VPC_PEERING"
 	// +optional
 	// +immutable
-	// +kubebuilder:validation:Enum=DNS_RESOLVER;GCE_ENDPOINT;NAT_AUTO;VPC_PEERING
+	// +kubebuilder:validation:Enum=DNS_RESOLVER;GCE_ENDPOINT;NAT_AUTO;PRIVATE_SERVICE_CONNECT;VPC_PEERING
 	Purpose *string `json:"purpose,omitempty"`
 
 	// Subnetwork: The URL of the subnetwork in which to reserve the
@@ -137,6 +157,9 @@ type GlobalAddressObservation struct {
 	// ID for the resource. This identifier is defined by the server.
 	ID uint64 `json:"id,omitempty"`
 
+	// LabelFingerprint is a fingerprint of the labels of the address.
+	LabelFingerprint string `json:"labelFingerprint,omitempty"`
+
 	// SelfLink: Server-defined URL for the resource.
 	SelfLink string `json:"selfLink,omitempty"`
 
@@ -166,12 +189,19 @@ type GlobalAddressSpec struct {
 type GlobalAddressStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          GlobalAddressObservation `json:"atProvider,omitempty"`
+
+	// LastOperation is the most recent operation that created, updated, or
+	// deleted the GlobalAddress.
+	// +optional
+	LastOperation *Operation `json:"lastOperation,omitempty"`
 }
 
 // A GlobalAddress is a managed resource that represents a Google Compute Engine
 // Global Address.
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ADDRESS",type="string",JSONPath=".spec.forProvider.address"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
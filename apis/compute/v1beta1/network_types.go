@@ -77,6 +77,11 @@ type NetworkObservation struct {
 	// Subnetworks: Server-defined fully-qualified URLs for
 	// all subnetworks in this VPC network.
 	Subnetworks []string `json:"subnetworks,omitempty"`
+
+	// Diff: The fields of the Network that differ from the desired state, if
+	// any, e.g. routingConfig.routingMode. Fields are named as in the GCP
+	// API.
+	Diff []string `json:"diff,omitempty"`
 }
 
 // A NetworkPeering represents the observed state of a Google Compute Engine
@@ -145,12 +150,23 @@ type NetworkRoutingConfig struct {
 type NetworkSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       NetworkParameters `json:"forProvider"`
+
+	// DeletionProtection prevents the deletion of the external network while
+	// true: the controller refuses to delete it, even if this managed resource
+	// is deleted.
+	// +optional
+	DeletionProtection *bool `json:"deletionProtection,omitempty"`
 }
 
 // A NetworkStatus represents the observed state of a Network.
 type NetworkStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          NetworkObservation `json:"atProvider,omitempty"`
+
+	// LastOperation is the most recent operation that created, updated, or
+	// deleted the Network.
+	// +optional
+	LastOperation *Operation `json:"lastOperation,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -159,6 +175,7 @@ type NetworkStatus struct {
 // Network.
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
 type Network struct {
@@ -177,3 +194,9 @@ type NetworkList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []Network `json:"items"`
 }
+
+// GetDeletionProtection returns true if the external network of this Network
+// must not be deleted.
+func (mg *Network) GetDeletionProtection() bool {
+	return mg.Spec.DeletionProtection != nil && *mg.Spec.DeletionProtection
+}
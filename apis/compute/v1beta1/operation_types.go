@@ -0,0 +1,50 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// An Operation is a Google Compute Engine operation, which GCP uses to track
+// the asynchronous progress of a request that creates, updates, or deletes a
+// resource. See https://cloud.google.com/compute/docs/reference/rest/v1/globalOperations
+type Operation struct {
+	// SelfLink: The URL of the operation.
+	SelfLink string `json:"selfLink"`
+
+	// OperationType: The type of the operation, e.g. insert, patch, or
+	// delete.
+	// +optional
+	OperationType string `json:"operationType,omitempty"`
+
+	// Status: The status of the operation, which can be one of the
+	// following: PENDING, RUNNING, or DONE.
+	// +optional
+	Status string `json:"status,omitempty"`
+
+	// Errors: The errors that caused the operation to fail, if any.
+	// +optional
+	Errors []OperationError `json:"errors,omitempty"`
+}
+
+// An OperationError is an error that occurred during an Operation.
+type OperationError struct {
+	// Code: The error type identifier for this error, e.g.
+	// IP_SPACE_EXHAUSTED.
+	Code string `json:"code"`
+
+	// Message: An optional, human-readable error message.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
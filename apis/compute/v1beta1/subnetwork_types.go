@@ -53,10 +53,11 @@ type SubnetworkParameters struct {
 	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
 
 	// Region: URL of the region where the Subnetwork resides. This field
-	// can be set only at resource creation time.
+	// can be set only at resource creation time. Defaults to the default
+	// region of the ProviderConfig.
 	// +optional
 	// +immutable
-	Region string `json:"region"`
+	Region string `json:"region,omitempty"`
 
 	// Description: An optional description of this resource. Provide this
 	// property when you create the resource. This field can be set only at
@@ -114,6 +115,11 @@ type SubnetworkObservation struct {
 
 	// SelfLink: Server-defined URL for the resource.
 	SelfLink string `json:"selfLink,omitempty"`
+
+	// Diff: The fields of the Subnetwork that differ from the desired state, if
+	// any, e.g. routingConfig.routingMode. Fields are named as in the GCP
+	// API.
+	Diff []string `json:"diff,omitempty"`
 }
 
 // A SubnetworkSecondaryRange defines the state of a Google Compute Engine
@@ -143,6 +149,11 @@ type SubnetworkSpec struct {
 type SubnetworkStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
 	AtProvider          SubnetworkObservation `json:"atProvider,omitempty"`
+
+	// LastOperation is the most recent operation that created, updated, or
+	// deleted the Subnetwork.
+	// +optional
+	LastOperation *Operation `json:"lastOperation,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -151,6 +162,7 @@ type SubnetworkStatus struct {
 // VPC Subnetwork.
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
 type Subnetwork struct {
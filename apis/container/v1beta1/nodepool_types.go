@@ -90,6 +90,11 @@ type NodePoolObservation struct {
 	// status of this
 	// node pool instance, if available.
 	StatusMessage string `json:"statusMessage,omitempty"`
+
+	// Diff: The fields of the NodePool that differ from the desired state, if
+	// any, e.g. routingConfig.routingMode. Fields are named as in the GCP
+	// API.
+	Diff []string `json:"diff,omitempty"`
 }
 
 // NodePoolParameters define the desired state of a Google Kubernetes Engine
@@ -413,6 +418,18 @@ type NodeConfig struct {
 	// +optional
 	ServiceAccount *string `json:"serviceAccount,omitempty"`
 
+	// ServiceAccountRef references a ServiceAccount to retrieve its email
+	// address.
+	// +immutable
+	// +optional
+	ServiceAccountRef *xpv1.Reference `json:"serviceAccountRef,omitempty"`
+
+	// ServiceAccountSelector selects a reference to a ServiceAccount to
+	// retrieve its email address.
+	// +immutable
+	// +optional
+	ServiceAccountSelector *xpv1.Selector `json:"serviceAccountSelector,omitempty"`
+
 	// ShieldedInstanceConfig: Shielded Instance options.
 	// +immutable
 	// +optional
@@ -642,6 +659,13 @@ type AutoUpgradeOptions struct {
 type NodePoolSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       NodePoolParameters `json:"forProvider"`
+
+	// IgnoreFields lists fields of the external node pool whose differences
+	// from forProvider are ignored, e.g. because an autoscaler changes them.
+	// Fields are named as in the GCP API and in status.atProvider.diff, e.g.
+	// autoscaling.maxNodeCount.
+	// +optional
+	IgnoreFields []string `json:"ignoreFields,omitempty"`
 }
 
 // A NodePoolStatus represents the observed state of a NodePool.
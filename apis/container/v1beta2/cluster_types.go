@@ -34,6 +34,12 @@ const (
 	ClusterStateDegraded     = "DEGRADED"
 )
 
+// ConnectionSecretClusterNameKey is the key of the connection detail that holds
+// the name of a Cluster, which is also the name of the cluster, user and
+// context of its kubeconfig. The endpoint and CA certificate of the Cluster
+// are published with the common keys endpoint and clusterCA.
+const ConnectionSecretClusterNameKey = "clusterName"
+
 // Defaults for GKE resources.
 const (
 	DefaultNumberOfNodes = int64(1)
@@ -70,7 +76,8 @@ type ClusterParameters struct {
 	// +immutable
 	AuthenticatorGroupsConfig *AuthenticatorGroupsConfig `json:"authenticatorGroupsConfig,omitempty"`
 
-	// Autopilot: Autopilot configuration for the cluster.
+	// Autopilot: Autopilot configuration for the cluster. Autopilot can
+	// only be enabled when the cluster is created.
 	// +optional
 	// +immutable
 	Autopilot *Autopilot `json:"autopilot,omitempty"`
@@ -366,6 +373,13 @@ type ClusterObservation struct {
 	// format.
 	ExpireTime string `json:"expireTime,omitempty"`
 
+	// ID: Unique id for the cluster.
+	ID string `json:"id,omitempty"`
+
+	// LabelFingerprint: The fingerprint of the set of labels for this
+	// cluster.
+	LabelFingerprint string `json:"labelFingerprint,omitempty"`
+
 	// Location: The name of the Google Compute
 	// Engine
 	// [zone](/compute/docs/regions-zones/regions-zones#available)
@@ -460,6 +474,11 @@ type ClusterObservation struct {
 	// resides.
 	// This field is deprecated, use location instead.
 	Zone string `json:"zone,omitempty"`
+
+	// Diff: The fields of the Cluster that differ from the desired state, if
+	// any, e.g. routingConfig.routingMode. Fields are named as in the GCP
+	// API.
+	Diff []string `json:"diff,omitempty"`
 }
 
 // AddonsConfig is configuration for the addons that can be automatically
@@ -704,6 +723,16 @@ type AutoprovisioningNodePoolDefaults struct {
 	// +optional
 	ServiceAccount *string `json:"serviceAccount,omitempty"`
 
+	// ServiceAccountRef references a ServiceAccount to retrieve its email
+	// address.
+	// +optional
+	ServiceAccountRef *xpv1.Reference `json:"serviceAccountRef,omitempty"`
+
+	// ServiceAccountSelector selects a reference to a ServiceAccount to
+	// retrieve its email address.
+	// +optional
+	ServiceAccountSelector *xpv1.Selector `json:"serviceAccountSelector,omitempty"`
+
 	// ShieldedInstanceConfig: Shielded Instance options.
 	// +optional
 	ShieldedInstanceConfig *ShieldedInstanceConfig `json:"shieldedInstanceConfig,omitempty"`
@@ -1796,6 +1825,19 @@ type AutoUpgradeOptionsClusterStatus struct {
 type ClusterSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       ClusterParameters `json:"forProvider"`
+
+	// DeletionProtection prevents the deletion of the external cluster while
+	// true: the controller refuses to delete it, even if this managed resource
+	// is deleted.
+	// +optional
+	DeletionProtection *bool `json:"deletionProtection,omitempty"`
+
+	// IgnoreFields lists fields of the external cluster whose differences
+	// from forProvider are ignored, e.g. because GKE or an autoscaler changes
+	// them. Fields are named as in the GCP API and in status.atProvider.diff,
+	// e.g. autoscaling.resourceLimits.
+	// +optional
+	IgnoreFields []string `json:"ignoreFields,omitempty"`
 }
 
 // A ClusterStatus represents the observed state of a Cluster.
@@ -1813,6 +1855,7 @@ type ClusterStatus struct {
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.status"
 // +kubebuilder:printcolumn:name="ENDPOINT",type="string",JSONPath=".status.atProvider.endpoint"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".status.atProvider.currentMasterVersion"
 // +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.forProvider.location"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
@@ -1832,3 +1875,9 @@ type ClusterList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []Cluster `json:"items"`
 }
+
+// GetDeletionProtection returns true if the external cluster of this Cluster
+// must not be deleted.
+func (mg *Cluster) GetDeletionProtection() bool {
+	return mg.Spec.DeletionProtection != nil && *mg.Spec.DeletionProtection
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/mutate-container-gcp-crossplane-io-v1beta2-cluster,mutating=true,failurePolicy=ignore,groups=container.gcp.crossplane.io,resources=clusters,versions=v1beta2,name=clusters.container.gcp.crossplane.io,sideEffects=None,admissionReviewVersions=v1
+
+// Default sets the defaults of the Cluster that its schema can't express. If
+// no location is specified but the zones of its nodes are, and they are all in
+// the same region, the Cluster is a regional cluster in that region.
+func (mg *Cluster) Default() {
+	p := &mg.Spec.ForProvider
+	if p.Location != "" || len(p.Locations) == 0 {
+		return
+	}
+	region := v1beta1.RegionOf(p.Locations[0])
+	for _, z := range p.Locations {
+		if z == v1beta1.RegionOf(z) || v1beta1.RegionOf(z) != region {
+			return
+		}
+	}
+	p.Location = region
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestClusterDefault(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		params ClusterParameters
+		want   string
+	}{
+		"LocationSpecified": {
+			reason: "A specified location should not be changed",
+			params: ClusterParameters{Location: "us-central1-a", Locations: []string{"us-central1-b"}},
+			want:   "us-central1-a",
+		},
+		"SameRegion": {
+			reason: "The location should default to the region of the zones of the nodes",
+			params: ClusterParameters{Locations: []string{"us-central1-a", "us-central1-b"}},
+			want:   "us-central1",
+		},
+		"DifferentRegions": {
+			reason: "The location should not be defaulted if the zones of the nodes are in different regions",
+			params: ClusterParameters{Locations: []string{"us-central1-a", "europe-west4-a"}},
+			want:   "",
+		},
+		"NotZones": {
+			reason: "The location should not be defaulted if the locations of the nodes are not zones",
+			params: ClusterParameters{Locations: []string{"us-central1"}},
+			want:   "",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &Cluster{Spec: ClusterSpec{ForProvider: tc.params}}
+			cr.Default()
+			if diff := cmp.Diff(tc.want, cr.Spec.ForProvider.Location); diff != "" {
+				t.Errorf("\n%s\nDefault(): -want location, +got location:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 	resource "github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	iamv1alpha1 "github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
 )
 
 // ClusterURL extracts the partially qualified URL of a Cluster.
@@ -73,5 +74,22 @@ func (mg *Cluster) ResolveReferences(ctx context.Context, c client.Reader) error
 	mg.Spec.ForProvider.Subnetwork = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.SubnetworkRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.autoscaling.autoprovisioningNodePoolDefaults.serviceAccount
+	if a := mg.Spec.ForProvider.Autoscaling; a != nil && a.AutoprovisioningNodePoolDefaults != nil {
+		d := a.AutoprovisioningNodePoolDefaults
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(d.ServiceAccount),
+			Reference:    d.ServiceAccountRef,
+			Selector:     d.ServiceAccountSelector,
+			To:           reference.To{Managed: &iamv1alpha1.ServiceAccount{}, List: &iamv1alpha1.ServiceAccountList{}},
+			Extract:      iamv1alpha1.ServiceAccountEmail(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.autoscaling.autoprovisioningNodePoolDefaults.serviceAccount")
+		}
+		d.ServiceAccount = reference.ToPtrValue(rsp.ResolvedValue)
+		d.ServiceAccountRef = rsp.ResolvedReference
+	}
+
 	return nil
 }
@@ -41,6 +41,7 @@ const (
 	CloudSQLSecretServerCACertificateSha1FingerprintKey  = "serverCACertificateSha1Fingerprint"
 
 	CloudSQLSecretConnectionName = "connectionName"
+	CloudSQLSecretDSNKey         = "dsn"
 )
 
 // CloudSQL version prefixes.
@@ -66,9 +67,11 @@ type CloudSQLInstanceParameters struct {
 	// instances only), us-central1 (SECOND_GEN instances only), asia-east1
 	// or europe-west1. Defaults to us-central or us-central1 depending on
 	// the instance type (First Generation or Second Generation). The region
-	// can not be changed after instance creation.
+	// can not be changed after instance creation. Defaults to the default
+	// region of the ProviderConfig.
+	// +optional
 	// +immutable
-	Region string `json:"region"`
+	Region string `json:"region,omitempty"`
 
 	// Settings: The user settings.
 	Settings Settings `json:"settings"`
@@ -482,6 +485,11 @@ type CloudSQLInstanceObservation struct {
 	// properly. During update, use the most recent settingsVersion value
 	// for this instance and do not try to update this value.
 	SettingsVersion int64 `json:"settingsVersion,omitempty"`
+
+	// Diff: The fields of the instance that differ from the desired state, if
+	// any, e.g. routingConfig.routingMode. Fields are named as in the GCP
+	// API.
+	Diff []string `json:"diff,omitempty"`
 }
 
 // IPMapping is database instance IP Mapping.
@@ -538,6 +546,20 @@ type DatabaseInstanceFailoverReplicaStatus struct {
 type CloudSQLInstanceSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       CloudSQLInstanceParameters `json:"forProvider"`
+
+	// DeletionProtection prevents the deletion of the external instance while
+	// true: the controller refuses to delete it, even if this managed resource
+	// is deleted. When set, it is also the native deletion protection of
+	// the instance, i.e. settings.deletionProtectionEnabled.
+	// +optional
+	DeletionProtection *bool `json:"deletionProtection,omitempty"`
+
+	// PublishDSN adds a DSN of the root user to the connection details when
+	// the instance is created. The DSN connects to a Cloud SQL Auth proxy
+	// that listens on localhost at the default port of the database engine,
+	// e.g. a sidecar. Only MySQL and PostgreSQL instances are supported.
+	// +optional
+	PublishDSN *bool `json:"publishDSN,omitempty"`
 }
 
 // A CloudSQLInstanceStatus represents the observed state of a CloudSQLInstance.
@@ -554,6 +576,7 @@ type CloudSQLInstanceStatus struct {
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".status.atProvider.ipAddresses[0].ipAddress"
 // +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".spec.forProvider.databaseVersion"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
@@ -573,3 +596,9 @@ type CloudSQLInstanceList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []CloudSQLInstance `json:"items"`
 }
+
+// GetDeletionProtection returns true if the external instance of this CloudSQLInstance
+// must not be deleted.
+func (mg *CloudSQLInstance) GetDeletionProtection() bool {
+	return mg.Spec.DeletionProtection != nil && *mg.Spec.DeletionProtection
+}
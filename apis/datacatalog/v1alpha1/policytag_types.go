@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// PolicyTagParameters define the desired state of a Data Catalog PolicyTag.
+type PolicyTagParameters struct {
+	// Taxonomy is the fully qualified name of the taxonomy the policy tag
+	// belongs to, in the form
+	// projects/{project}/locations/{location}/taxonomies/{taxonomy}.
+	// +optional
+	// +immutable
+	Taxonomy *string `json:"taxonomy,omitempty"`
+
+	// TaxonomyRef references a Taxonomy and retrieves its fully qualified
+	// name.
+	// +optional
+	// +immutable
+	TaxonomyRef *xpv1.Reference `json:"taxonomyRef,omitempty"`
+
+	// TaxonomySelector selects a reference to a Taxonomy.
+	// +optional
+	TaxonomySelector *xpv1.Selector `json:"taxonomySelector,omitempty"`
+
+	// DisplayName of the policy tag. It must be unique within the taxonomy.
+	DisplayName string `json:"displayName"`
+
+	// Description of the policy tag.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// ParentPolicyTag is the fully qualified name of the parent of this
+	// policy tag. Policy tags without a parent are at the root of the
+	// taxonomy.
+	// +optional
+	ParentPolicyTag *string `json:"parentPolicyTag,omitempty"`
+
+	// ParentPolicyTagRef references a PolicyTag and retrieves its fully
+	// qualified name.
+	// +optional
+	ParentPolicyTagRef *xpv1.Reference `json:"parentPolicyTagRef,omitempty"`
+
+	// ParentPolicyTagSelector selects a reference to a PolicyTag.
+	// +optional
+	ParentPolicyTagSelector *xpv1.Selector `json:"parentPolicyTagSelector,omitempty"`
+}
+
+// PolicyTagObservation is used to show the observed state of the PolicyTag.
+type PolicyTagObservation struct {
+	// Name is the fully qualified name of the policy tag.
+	Name string `json:"name,omitempty"`
+
+	// ChildPolicyTags are the fully qualified names of the children of the
+	// policy tag.
+	ChildPolicyTags []string `json:"childPolicyTags,omitempty"`
+}
+
+// A PolicyTagSpec defines the desired state of a PolicyTag.
+type PolicyTagSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PolicyTagParameters `json:"forProvider"`
+}
+
+// A PolicyTagStatus represents the observed state of a PolicyTag.
+type PolicyTagStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PolicyTagObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PolicyTag is a managed resource that represents a Data Catalog PolicyTag.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DISPLAY-NAME",type="string",JSONPath=".spec.forProvider.displayName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type PolicyTag struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PolicyTagSpec   `json:"spec"`
+	Status PolicyTagStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PolicyTagList contains a list of PolicyTag
+type PolicyTagList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PolicyTag `json:"items"`
+}
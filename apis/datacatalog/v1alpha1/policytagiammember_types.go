@@ -0,0 +1,110 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// PolicyTagIAMMemberParameters defines parameters for a desired
+// PolicyTagIAMMember.
+type PolicyTagIAMMemberParameters struct {
+	// PolicyTag is the fully qualified name of the Data Catalog PolicyTag to
+	// which this member is bound, in the form
+	// projects/{project}/locations/{location}/taxonomies/{taxonomy}/policyTags/{policyTag}.
+	// +optional
+	// +immutable
+	PolicyTag *string `json:"policyTag,omitempty"`
+
+	// PolicyTagRef references a PolicyTag and retrieves its fully qualified
+	// name.
+	// +optional
+	// +immutable
+	PolicyTagRef *xpv1.Reference `json:"policyTagRef,omitempty"`
+
+	// PolicyTagSelector selects a reference to a PolicyTag.
+	// +optional
+	PolicyTagSelector *xpv1.Selector `json:"policyTagSelector,omitempty"`
+
+	// Role that is assigned to Member, e.g.
+	// roles/datacatalog.categoryFineGrainedReader.
+	// +immutable
+	Role string `json:"role"`
+
+	// Member is the identity that is granted Role, e.g. allUsers,
+	// user:{email}, serviceAccount:{email} or group:{email}.
+	// +optional
+	// +immutable
+	Member *string `json:"member,omitempty"`
+
+	// ServiceAccountMemberRef is reference to ServiceAccount used to set
+	// the Member.
+	// +optional
+	// +immutable
+	ServiceAccountMemberRef *xpv1.Reference `json:"serviceAccountMemberRef,omitempty"`
+
+	// ServiceAccountMemberSelector selects reference to ServiceAccount used
+	// to set the Member.
+	// +optional
+	// +immutable
+	ServiceAccountMemberSelector *xpv1.Selector `json:"serviceAccountMemberSelector,omitempty"`
+}
+
+// PolicyTagIAMMemberSpec defines the desired state of a
+// PolicyTagIAMMember.
+type PolicyTagIAMMemberSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PolicyTagIAMMemberParameters `json:"forProvider"`
+}
+
+// PolicyTagIAMMemberStatus represents the observed state of a
+// PolicyTagIAMMember.
+type PolicyTagIAMMemberStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// PolicyTagIAMMember is a managed resource that represents membership of a
+// Data Catalog PolicyTag IAM Policy. Granting the Fine-Grained Reader role on
+// a policy tag allows reading the BigQuery columns it is applied to.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ROLE",type="string",JSONPath=".spec.forProvider.role"
+// +kubebuilder:printcolumn:name="MEMBER",type="string",JSONPath=".spec.forProvider.member"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type PolicyTagIAMMember struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PolicyTagIAMMemberSpec   `json:"spec"`
+	Status PolicyTagIAMMemberStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PolicyTagIAMMemberList contains a list of PolicyTagIAMMember
+// types
+type PolicyTagIAMMemberList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PolicyTagIAMMember `json:"items"`
+}
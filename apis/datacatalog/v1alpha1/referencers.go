@@ -0,0 +1,121 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	iamv1alpha1 "github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+)
+
+// TaxonomyName extracts the fully qualified name of a Taxonomy.
+func TaxonomyName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		t, ok := mg.(*Taxonomy)
+		if !ok {
+			return ""
+		}
+		return t.Status.AtProvider.Name
+	}
+}
+
+// PolicyTagName extracts the fully qualified name of a PolicyTag.
+func PolicyTagName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		t, ok := mg.(*PolicyTag)
+		if !ok {
+			return ""
+		}
+		return t.Status.AtProvider.Name
+	}
+}
+
+// ResolveReferences of this PolicyTag
+func (in *PolicyTag) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.taxonomy
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Taxonomy),
+		Reference:    in.Spec.ForProvider.TaxonomyRef,
+		Selector:     in.Spec.ForProvider.TaxonomySelector,
+		To:           reference.To{Managed: &Taxonomy{}, List: &TaxonomyList{}},
+		Extract:      TaxonomyName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.taxonomy")
+	}
+	in.Spec.ForProvider.Taxonomy = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.TaxonomyRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.parentPolicyTag
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.ParentPolicyTag),
+		Reference:    in.Spec.ForProvider.ParentPolicyTagRef,
+		Selector:     in.Spec.ForProvider.ParentPolicyTagSelector,
+		To:           reference.To{Managed: &PolicyTag{}, List: &PolicyTagList{}},
+		Extract:      PolicyTagName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.parentPolicyTag")
+	}
+	in.Spec.ForProvider.ParentPolicyTag = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.ParentPolicyTagRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this PolicyTagIAMMember
+func (in *PolicyTagIAMMember) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.policyTag
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.PolicyTag),
+		Reference:    in.Spec.ForProvider.PolicyTagRef,
+		Selector:     in.Spec.ForProvider.PolicyTagSelector,
+		To:           reference.To{Managed: &PolicyTag{}, List: &PolicyTagList{}},
+		Extract:      PolicyTagName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.policyTag")
+	}
+	in.Spec.ForProvider.PolicyTag = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.PolicyTagRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.member
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Member),
+		Reference:    in.Spec.ForProvider.ServiceAccountMemberRef,
+		Selector:     in.Spec.ForProvider.ServiceAccountMemberSelector,
+		To:           reference.To{Managed: &iamv1alpha1.ServiceAccount{}, List: &iamv1alpha1.ServiceAccountList{}},
+		Extract:      iamv1alpha1.ServiceAccountMemberName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.member")
+	}
+	in.Spec.ForProvider.Member = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.ServiceAccountMemberRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Primitive types of a TagTemplateField.
+const (
+	FieldTypeDouble    = "DOUBLE"
+	FieldTypeString    = "STRING"
+	FieldTypeBool      = "BOOL"
+	FieldTypeTimestamp = "TIMESTAMP"
+	FieldTypeRichtext  = "RICHTEXT"
+)
+
+// TagTemplateParameters define the desired state of a Data Catalog
+// TagTemplate.
+type TagTemplateParameters struct {
+	// Location of the tag template, e.g. us-central1.
+	// +immutable
+	Location string `json:"location"`
+
+	// DisplayName of the tag template.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// IsPubliclyReadable makes the tags created from this template readable
+	// by everyone who can view the tagged resource.
+	// +optional
+	IsPubliclyReadable *bool `json:"isPubliclyReadable,omitempty"`
+
+	// Fields of the tag template, keyed by field ID. Field IDs may contain
+	// letters, numbers and underscores and must start with a letter or
+	// underscore.
+	// +kubebuilder:validation:MinProperties=1
+	Fields map[string]TagTemplateField `json:"fields"`
+}
+
+// A TagTemplateField defines a field of the tags created from a TagTemplate.
+type TagTemplateField struct {
+	// DisplayName of the field.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Description of the field.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Type of the field. The type of a field cannot be changed, but values
+	// can be appended to an enum type.
+	Type FieldType `json:"type"`
+
+	// IsRequired makes tags without a value for this field invalid.
+	// +optional
+	IsRequired *bool `json:"isRequired,omitempty"`
+
+	// Order in which fields are displayed. Fields with a higher value are
+	// displayed first.
+	// +optional
+	Order *int64 `json:"order,omitempty"`
+}
+
+// FieldType is the type of a TagTemplateField. Exactly one of PrimitiveType
+// and EnumType must be set.
+type FieldType struct {
+	// PrimitiveType of the field.
+	// +optional
+	// +kubebuilder:validation:Enum=DOUBLE;STRING;BOOL;TIMESTAMP;RICHTEXT
+	PrimitiveType *string `json:"primitiveType,omitempty"`
+
+	// EnumType of the field.
+	// +optional
+	EnumType *EnumType `json:"enumType,omitempty"`
+}
+
+// EnumType is an enumerated TagTemplateField type.
+type EnumType struct {
+	// AllowedValues of the enum, by display name. Values can only be
+	// appended.
+	// +kubebuilder:validation:MinItems=1
+	AllowedValues []string `json:"allowedValues"`
+}
+
+// TagTemplateObservation is used to show the observed state of the
+// TagTemplate.
+type TagTemplateObservation struct {
+	// Name is the fully qualified name of the tag template.
+	Name string `json:"name,omitempty"`
+}
+
+// A TagTemplateSpec defines the desired state of a TagTemplate.
+type TagTemplateSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TagTemplateParameters `json:"forProvider"`
+}
+
+// A TagTemplateStatus represents the observed state of a TagTemplate.
+type TagTemplateStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TagTemplateObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TagTemplate is a managed resource that represents a Data Catalog TagTemplate.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DISPLAY-NAME",type="string",JSONPath=".spec.forProvider.displayName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type TagTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TagTemplateSpec   `json:"spec"`
+	Status TagTemplateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TagTemplateList contains a list of TagTemplate
+type TagTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TagTemplate `json:"items"`
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Policy types that can be activated on a Taxonomy.
+const (
+	PolicyTypeFineGrainedAccessControl = "FINE_GRAINED_ACCESS_CONTROL"
+)
+
+// TaxonomyParameters define the desired state of a Data Catalog Taxonomy.
+type TaxonomyParameters struct {
+	// Location of the taxonomy, e.g. us or europe-west1. Policy tags can only
+	// be applied to BigQuery columns in the same location.
+	// +immutable
+	Location string `json:"location"`
+
+	// DisplayName of the taxonomy. It must be unique within the location.
+	DisplayName string `json:"displayName"`
+
+	// Description of the taxonomy.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// ActivatedPolicyTypes that are enforced for the policy tags of the
+	// taxonomy. Fine-grained access control restricts BigQuery column access
+	// to members that were granted the Fine-Grained Reader role on a policy
+	// tag.
+	// +optional
+	ActivatedPolicyTypes []string `json:"activatedPolicyTypes,omitempty"`
+}
+
+// TaxonomyObservation is used to show the observed state of the Taxonomy.
+type TaxonomyObservation struct {
+	// Name is the fully qualified name of the taxonomy.
+	Name string `json:"name,omitempty"`
+
+	// PolicyTagCount is the number of policy tags in the taxonomy.
+	PolicyTagCount int64 `json:"policyTagCount,omitempty"`
+
+	// CreateTime is the time the taxonomy was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the taxonomy was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// A TaxonomySpec defines the desired state of a Taxonomy.
+type TaxonomySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TaxonomyParameters `json:"forProvider"`
+}
+
+// A TaxonomyStatus represents the observed state of a Taxonomy.
+type TaxonomyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TaxonomyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Taxonomy is a managed resource that represents a Data Catalog policy tag Taxonomy.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DISPLAY-NAME",type="string",JSONPath=".spec.forProvider.displayName"
+// +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.forProvider.location"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Taxonomy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TaxonomySpec   `json:"spec"`
+	Status TaxonomyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TaxonomyList contains a list of Taxonomy
+type TaxonomyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Taxonomy `json:"items"`
+}
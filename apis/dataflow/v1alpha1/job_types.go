@@ -0,0 +1,257 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known Job states.
+const (
+	JobStateUnknown         = "JOB_STATE_UNKNOWN"
+	JobStateStopped         = "JOB_STATE_STOPPED"
+	JobStateRunning         = "JOB_STATE_RUNNING"
+	JobStateDone            = "JOB_STATE_DONE"
+	JobStateFailed          = "JOB_STATE_FAILED"
+	JobStateCancelled       = "JOB_STATE_CANCELLED"
+	JobStateUpdated         = "JOB_STATE_UPDATED"
+	JobStateDraining        = "JOB_STATE_DRAINING"
+	JobStateDrained         = "JOB_STATE_DRAINED"
+	JobStatePending         = "JOB_STATE_PENDING"
+	JobStateCancelling      = "JOB_STATE_CANCELLING"
+	JobStateQueued          = "JOB_STATE_QUEUED"
+	JobStateResourceCleanup = "JOB_STATE_RESOURCE_CLEANING_UP"
+)
+
+// Supported behaviours when a Job is deleted.
+const (
+	// JobOnDeleteCancel cancels the job immediately.
+	JobOnDeleteCancel = "Cancel"
+
+	// JobOnDeleteDrain stops the job from ingesting new data but lets it
+	// finish processing buffered data. Only streaming jobs can be drained.
+	JobOnDeleteDrain = "Drain"
+)
+
+// JobParameters define the desired state of a Dataflow Job launched from a
+// template. Exactly one of TemplateGCSPath or ContainerSpecGCSPath must be
+// set. Jobs cannot be changed once launched. Most fields map directly to a
+// LaunchTemplateParameters or LaunchFlexTemplateParameter:
+// https://cloud.google.com/dataflow/docs/reference/rest/v1b3/LaunchTemplateParameters
+// https://cloud.google.com/dataflow/docs/reference/rest/v1b3/projects.locations.flexTemplates/launch#LaunchFlexTemplateParameter
+type JobParameters struct {
+	// Location is the regional endpoint in which to run this job, e.g.
+	// us-central1.
+	// +immutable
+	Location string `json:"location"`
+
+	// JobName is the name of the job. It must be unique among the running
+	// jobs of the project. Defaults to the name of this resource.
+	// +optional
+	// +immutable
+	JobName *string `json:"jobName,omitempty"`
+
+	// TemplateGCSPath is the Cloud Storage path of a classic template to
+	// launch, e.g. gs://dataflow-templates/latest/Word_Count.
+	// +optional
+	// +immutable
+	TemplateGCSPath *string `json:"templateGcsPath,omitempty"`
+
+	// ContainerSpecGCSPath is the Cloud Storage path of the container spec
+	// file of a flex template to launch.
+	// +optional
+	// +immutable
+	ContainerSpecGCSPath *string `json:"containerSpecGcsPath,omitempty"`
+
+	// Parameters passed to the template.
+	// +optional
+	// +immutable
+	Parameters map[string]string `json:"parameters,omitempty"`
+
+	// Environment the job runs in.
+	// +optional
+	// +immutable
+	Environment *JobEnvironment `json:"environment,omitempty"`
+
+	// OnDelete determines whether the job is cancelled or drained when
+	// this resource is deleted.
+	// +optional
+	// +kubebuilder:validation:Enum=Cancel;Drain
+	// +kubebuilder:default=Cancel
+	OnDelete *string `json:"onDelete,omitempty"`
+}
+
+// JobEnvironment configures the workers that run a Job.
+type JobEnvironment struct {
+	// NumWorkers is the initial number of workers.
+	// +optional
+	NumWorkers *int64 `json:"numWorkers,omitempty"`
+
+	// MaxWorkers is the maximum number of workers the job may scale to.
+	// +optional
+	MaxWorkers *int64 `json:"maxWorkers,omitempty"`
+
+	// MachineType of the workers, e.g. n1-standard-1.
+	// +optional
+	MachineType *string `json:"machineType,omitempty"`
+
+	// ServiceAccountEmail is the email address of the IAM service account
+	// the workers run as.
+	// +optional
+	ServiceAccountEmail *string `json:"serviceAccountEmail,omitempty"`
+
+	// ServiceAccountEmailRef references a ServiceAccount to retrieve its
+	// email address.
+	// +optional
+	ServiceAccountEmailRef *xpv1.Reference `json:"serviceAccountEmailRef,omitempty"`
+
+	// ServiceAccountEmailSelector selects a reference to a ServiceAccount to
+	// retrieve its email address.
+	// +optional
+	ServiceAccountEmailSelector *xpv1.Selector `json:"serviceAccountEmailSelector,omitempty"`
+
+	// TempLocation is the Cloud Storage path, starting with gs://, that the
+	// job uses for temporary files.
+	// +optional
+	TempLocation *string `json:"tempLocation,omitempty"`
+
+	// Network the workers are attached to.
+	// +optional
+	Network *string `json:"network,omitempty"`
+
+	// NetworkRef references a Network to retrieve its name.
+	// +optional
+	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to a Network to retrieve its name.
+	// +optional
+	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
+
+	// Subnetwork the workers are attached to, as a URL.
+	// +optional
+	Subnetwork *string `json:"subnetwork,omitempty"`
+
+	// SubnetworkRef references a Subnetwork to retrieve its URL.
+	// +optional
+	SubnetworkRef *xpv1.Reference `json:"subnetworkRef,omitempty"`
+
+	// SubnetworkSelector selects a reference to a Subnetwork to retrieve its
+	// URL.
+	// +optional
+	SubnetworkSelector *xpv1.Selector `json:"subnetworkSelector,omitempty"`
+
+	// WorkerRegion in which the workers run. Defaults to the job location.
+	// +optional
+	WorkerRegion *string `json:"workerRegion,omitempty"`
+
+	// WorkerZone in which the workers run.
+	// +optional
+	WorkerZone *string `json:"workerZone,omitempty"`
+
+	// IPConfiguration determines whether workers have public IP addresses.
+	// +optional
+	// +kubebuilder:validation:Enum=WORKER_IP_PUBLIC;WORKER_IP_PRIVATE
+	IPConfiguration *string `json:"ipConfiguration,omitempty"`
+
+	// KMSKeyName is the Cloud KMS key used to protect the job state.
+	// +optional
+	KMSKeyName *string `json:"kmsKeyName,omitempty"`
+
+	// AdditionalExperiments to enable for the job.
+	// +optional
+	AdditionalExperiments []string `json:"additionalExperiments,omitempty"`
+
+	// AdditionalUserLabels to apply to the job.
+	// +optional
+	AdditionalUserLabels map[string]string `json:"additionalUserLabels,omitempty"`
+
+	// EnableStreamingEngine moves the pipeline state of streaming jobs out of
+	// the worker VMs into the Dataflow service.
+	// +optional
+	EnableStreamingEngine *bool `json:"enableStreamingEngine,omitempty"`
+}
+
+// JobObservation is used to show the observed state of a Job.
+type JobObservation struct {
+	// Name of the job.
+	Name string `json:"name,omitempty"`
+
+	// Type of the job, e.g. JOB_TYPE_BATCH or JOB_TYPE_STREAMING.
+	Type string `json:"type,omitempty"`
+
+	// CurrentState of the job.
+	CurrentState string `json:"currentState,omitempty"`
+
+	// CurrentStateTime is the time the job entered its current state.
+	CurrentStateTime string `json:"currentStateTime,omitempty"`
+
+	// RequestedState is the state the job was last asked to enter.
+	RequestedState string `json:"requestedState,omitempty"`
+
+	// CreateTime is the time the job was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// StartTime is the time the job started running.
+	StartTime string `json:"startTime,omitempty"`
+
+	// SDKVersion is the version of the Apache Beam SDK the job uses.
+	SDKVersion string `json:"sdkVersion,omitempty"`
+
+	// SDKSupportStatus indicates whether the SDK version is still supported.
+	SDKSupportStatus string `json:"sdkSupportStatus,omitempty"`
+}
+
+// A JobSpec defines the desired state of a Job.
+type JobSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       JobParameters `json:"forProvider"`
+}
+
+// A JobStatus represents the observed state of a Job.
+type JobStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          JobObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Job is a managed resource that represents a Dataflow Job launched from a template.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.currentState"
+// +kubebuilder:printcolumn:name="SDK",type="string",JSONPath=".status.atProvider.sdkVersion"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Job struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   JobSpec   `json:"spec"`
+	Status JobStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// JobList contains a list of Job
+type JobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Job `json:"items"`
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	computev1beta1 "github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	iamv1alpha1 "github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+)
+
+// ResolveReferences of this Job
+func (in *Job) ResolveReferences(ctx context.Context, c client.Reader) error {
+	env := in.Spec.ForProvider.Environment
+	if env == nil {
+		return nil
+	}
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.environment.serviceAccountEmail
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(env.ServiceAccountEmail),
+		Reference:    env.ServiceAccountEmailRef,
+		Selector:     env.ServiceAccountEmailSelector,
+		To:           reference.To{Managed: &iamv1alpha1.ServiceAccount{}, List: &iamv1alpha1.ServiceAccountList{}},
+		Extract:      iamv1alpha1.ServiceAccountEmail(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.environment.serviceAccountEmail")
+	}
+	env.ServiceAccountEmail = reference.ToPtrValue(rsp.ResolvedValue)
+	env.ServiceAccountEmailRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.environment.network
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(env.Network),
+		Reference:    env.NetworkRef,
+		Selector:     env.NetworkSelector,
+		To:           reference.To{Managed: &computev1beta1.Network{}, List: &computev1beta1.NetworkList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.environment.network")
+	}
+	env.Network = reference.ToPtrValue(rsp.ResolvedValue)
+	env.NetworkRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.environment.subnetwork
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(env.Subnetwork),
+		Reference:    env.SubnetworkRef,
+		Selector:     env.SubnetworkSelector,
+		To:           reference.To{Managed: &computev1beta1.Subnetwork{}, List: &computev1beta1.SubnetworkList{}},
+		Extract:      computev1beta1.SubnetworkURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.environment.subnetwork")
+	}
+	env.Subnetwork = reference.ToPtrValue(rsp.ResolvedValue)
+	env.SubnetworkRef = rsp.ResolvedReference
+
+	return nil
+}
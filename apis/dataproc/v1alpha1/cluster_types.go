@@ -0,0 +1,353 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known Cluster states.
+const (
+	ClusterStateCreating         = "CREATING"
+	ClusterStateRunning          = "RUNNING"
+	ClusterStateError            = "ERROR"
+	ClusterStateErrorDueToUpdate = "ERROR_DUE_TO_UPDATE"
+	ClusterStateDeleting         = "DELETING"
+	ClusterStateUpdating         = "UPDATING"
+	ClusterStateStopping         = "STOPPING"
+	ClusterStateStopped          = "STOPPED"
+	ClusterStateStarting         = "STARTING"
+	ClusterStateRepairing        = "REPAIRING"
+)
+
+// ClusterParameters define the desired state of a Dataproc Cluster. Most
+// fields map directly to a Cluster:
+// https://cloud.google.com/dataproc/docs/reference/rest/v1/projects.regions.clusters#Cluster
+type ClusterParameters struct {
+	// Region in which to create this cluster, e.g. us-central1.
+	// +immutable
+	Region string `json:"region"`
+
+	// Labels to apply to the cluster.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Config of the cluster. Only the number of worker instances, the
+	// autoscaling policy and the labels can be changed once the cluster is
+	// created.
+	Config ClusterConfig `json:"config"`
+
+	// GracefulDecommissionTimeout is how long to wait for jobs in progress
+	// to finish before removing workers when the cluster is scaled down,
+	// e.g. 3600s. Workers are removed immediately if it is not set.
+	// +optional
+	GracefulDecommissionTimeout *string `json:"gracefulDecommissionTimeout,omitempty"`
+}
+
+// ClusterConfig is the configuration of a Dataproc cluster.
+type ClusterConfig struct {
+	// ConfigBucket is the Cloud Storage bucket used to stage job
+	// dependencies, configuration files and job driver output.
+	// +optional
+	// +immutable
+	ConfigBucket *string `json:"configBucket,omitempty"`
+
+	// TempBucket is the Cloud Storage bucket used to store ephemeral
+	// cluster and job data.
+	// +optional
+	// +immutable
+	TempBucket *string `json:"tempBucket,omitempty"`
+
+	// GCEClusterConfig holds the Compute Engine settings shared by all
+	// instances of the cluster.
+	// +optional
+	// +immutable
+	GCEClusterConfig *GCEClusterConfig `json:"gceClusterConfig,omitempty"`
+
+	// MasterConfig configures the master instances of the cluster.
+	// +optional
+	// +immutable
+	MasterConfig *InstanceGroupConfig `json:"masterConfig,omitempty"`
+
+	// WorkerConfig configures the primary worker instances of the cluster.
+	// +optional
+	WorkerConfig *InstanceGroupConfig `json:"workerConfig,omitempty"`
+
+	// SecondaryWorkerConfig configures the secondary worker instances of
+	// the cluster.
+	// +optional
+	SecondaryWorkerConfig *InstanceGroupConfig `json:"secondaryWorkerConfig,omitempty"`
+
+	// SoftwareConfig configures the software installed on the cluster.
+	// +optional
+	// +immutable
+	SoftwareConfig *SoftwareConfig `json:"softwareConfig,omitempty"`
+
+	// InitializationActions are executed on each instance after it is
+	// created.
+	// +optional
+	// +immutable
+	InitializationActions []InitializationAction `json:"initializationActions,omitempty"`
+
+	// AutoscalingConfig configures the autoscaling policy of the cluster.
+	// +optional
+	AutoscalingConfig *AutoscalingConfig `json:"autoscalingConfig,omitempty"`
+
+	// EndpointConfig configures access to the web interfaces of the
+	// cluster.
+	// +optional
+	// +immutable
+	EndpointConfig *EndpointConfig `json:"endpointConfig,omitempty"`
+}
+
+// GCEClusterConfig holds the Compute Engine settings of a Dataproc cluster.
+type GCEClusterConfig struct {
+	// Zone in which to create the instances of the cluster, e.g.
+	// us-central1-f. A zone is picked automatically if it is not set.
+	// +optional
+	Zone *string `json:"zone,omitempty"`
+
+	// Network is the URI of the network of the instances. Only one of
+	// Network and Subnetwork may be set.
+	// +optional
+	Network *string `json:"network,omitempty"`
+
+	// NetworkRef references a Network to retrieve its URI.
+	// +optional
+	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to a Network to retrieve its URI.
+	// +optional
+	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
+
+	// Subnetwork is the URI of the subnetwork of the instances.
+	// +optional
+	Subnetwork *string `json:"subnetwork,omitempty"`
+
+	// SubnetworkRef references a Subnetwork to retrieve its URI.
+	// +optional
+	SubnetworkRef *xpv1.Reference `json:"subnetworkRef,omitempty"`
+
+	// SubnetworkSelector selects a reference to a Subnetwork to retrieve its
+	// URI.
+	// +optional
+	SubnetworkSelector *xpv1.Selector `json:"subnetworkSelector,omitempty"`
+
+	// InternalIPOnly restricts the instances to internal IP addresses.
+	// +optional
+	InternalIPOnly *bool `json:"internalIpOnly,omitempty"`
+
+	// ServiceAccount is the email address of the IAM service account the
+	// instances run as.
+	// +optional
+	ServiceAccount *string `json:"serviceAccount,omitempty"`
+
+	// ServiceAccountRef references a ServiceAccount to retrieve its email
+	// address.
+	// +optional
+	ServiceAccountRef *xpv1.Reference `json:"serviceAccountRef,omitempty"`
+
+	// ServiceAccountSelector selects a reference to a ServiceAccount to
+	// retrieve its email address.
+	// +optional
+	ServiceAccountSelector *xpv1.Selector `json:"serviceAccountSelector,omitempty"`
+
+	// ServiceAccountScopes are the OAuth scopes granted to the service
+	// account.
+	// +optional
+	ServiceAccountScopes []string `json:"serviceAccountScopes,omitempty"`
+
+	// Tags are the network tags of the instances.
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// Metadata entries of the instances.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// InstanceGroupConfig configures a group of instances of a Dataproc
+// cluster.
+type InstanceGroupConfig struct {
+	// NumInstances is the number of instances in the group.
+	// +optional
+	NumInstances *int64 `json:"numInstances,omitempty"`
+
+	// MachineType of the instances, e.g. n1-standard-4.
+	// +optional
+	// +immutable
+	MachineType *string `json:"machineType,omitempty"`
+
+	// MinCPUPlatform of the instances, e.g. Intel Skylake.
+	// +optional
+	// +immutable
+	MinCPUPlatform *string `json:"minCpuPlatform,omitempty"`
+
+	// ImageURI is the Compute Engine image of the instances. The image of
+	// the cluster's image version is used if it is not set.
+	// +optional
+	// +immutable
+	ImageURI *string `json:"imageUri,omitempty"`
+
+	// DiskConfig configures the disks of the instances.
+	// +optional
+	// +immutable
+	DiskConfig *DiskConfig `json:"diskConfig,omitempty"`
+
+	// Accelerators attached to each instance.
+	// +optional
+	// +immutable
+	Accelerators []AcceleratorConfig `json:"accelerators,omitempty"`
+
+	// Preemptibility of the instances. Only secondary workers may be
+	// preemptible.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=NON_PREEMPTIBLE;PREEMPTIBLE;SPOT
+	Preemptibility *string `json:"preemptibility,omitempty"`
+}
+
+// DiskConfig configures the disks of Dataproc instances.
+type DiskConfig struct {
+	// BootDiskType is the type of the boot disk, e.g. pd-ssd.
+	// +optional
+	BootDiskType *string `json:"bootDiskType,omitempty"`
+
+	// BootDiskSizeGB is the size of the boot disk in GB.
+	// +optional
+	BootDiskSizeGB *int64 `json:"bootDiskSizeGb,omitempty"`
+
+	// NumLocalSSDs is the number of attached local SSDs.
+	// +optional
+	NumLocalSSDs *int64 `json:"numLocalSsds,omitempty"`
+}
+
+// AcceleratorConfig configures the accelerators attached to Dataproc
+// instances.
+type AcceleratorConfig struct {
+	// AcceleratorType is the type of the accelerator, e.g. nvidia-tesla-t4.
+	AcceleratorType string `json:"acceleratorType"`
+
+	// AcceleratorCount is the number of accelerators attached to each
+	// instance.
+	AcceleratorCount int64 `json:"acceleratorCount"`
+}
+
+// SoftwareConfig configures the software installed on a Dataproc cluster.
+type SoftwareConfig struct {
+	// ImageVersion is the Dataproc image version of the cluster, e.g. 2.1.
+	// The default image version is used if it is not set.
+	// +optional
+	ImageVersion *string `json:"imageVersion,omitempty"`
+
+	// OptionalComponents to install, e.g. JUPYTER.
+	// +optional
+	OptionalComponents []string `json:"optionalComponents,omitempty"`
+
+	// Properties used to configure the cluster's daemons, in the form
+	// prefix:property, e.g. spark:spark.executor.memory.
+	// +optional
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+// An InitializationAction is executed on each instance of a Dataproc cluster
+// after it is created.
+type InitializationAction struct {
+	// ExecutableFile is the Cloud Storage URI of the executable.
+	ExecutableFile string `json:"executableFile"`
+
+	// ExecutionTimeout of the action, e.g. 600s.
+	// +optional
+	ExecutionTimeout *string `json:"executionTimeout,omitempty"`
+}
+
+// AutoscalingConfig configures the autoscaling policy of a Dataproc cluster.
+type AutoscalingConfig struct {
+	// PolicyURI is the URI of the Dataproc autoscaling policy, in the form
+	// projects/{project}/regions/{region}/autoscalingPolicies/{policy}.
+	PolicyURI string `json:"policyUri"`
+}
+
+// EndpointConfig configures access to the web interfaces of a Dataproc
+// cluster.
+type EndpointConfig struct {
+	// EnableHTTPPortAccess enables the Component Gateway, which exposes
+	// the web interfaces of the cluster's components.
+	EnableHTTPPortAccess bool `json:"enableHttpPortAccess"`
+}
+
+// ClusterObservation is used to show the observed state of a Cluster.
+type ClusterObservation struct {
+	// ClusterUUID is the unique ID of the cluster.
+	ClusterUUID string `json:"clusterUuid,omitempty"`
+
+	// State of the cluster.
+	State string `json:"state,omitempty"`
+
+	// Substate of the cluster.
+	Substate string `json:"substate,omitempty"`
+
+	// StateDetail describes the state of the cluster.
+	StateDetail string `json:"stateDetail,omitempty"`
+
+	// StateStartTime is the time the cluster entered its current state.
+	StateStartTime string `json:"stateStartTime,omitempty"`
+
+	// HTTPPorts maps the web interfaces exposed by the Component Gateway
+	// to their URLs.
+	HTTPPorts map[string]string `json:"httpPorts,omitempty"`
+}
+
+// A ClusterSpec defines the desired state of a Cluster.
+type ClusterSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ClusterParameters `json:"forProvider"`
+}
+
+// A ClusterStatus represents the observed state of a Cluster.
+type ClusterStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ClusterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Cluster is a managed resource that represents a Dataproc Cluster.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Cluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterSpec   `json:"spec"`
+	Status ClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterList contains a list of Cluster
+type ClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Cluster `json:"items"`
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	computev1beta1 "github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	iamv1alpha1 "github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+)
+
+// resolveClusterConfig resolves the references of the supplied ClusterConfig,
+// whose path within the resource is the supplied path.
+func resolveClusterConfig(ctx context.Context, r *reference.APIResolver, cfg *ClusterConfig, path string) error {
+	gce := cfg.GCEClusterConfig
+	if gce == nil {
+		return nil
+	}
+	path += ".gceClusterConfig"
+
+	// Resolve <path>.network
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(gce.Network),
+		Reference:    gce.NetworkRef,
+		Selector:     gce.NetworkSelector,
+		To:           reference.To{Managed: &computev1beta1.Network{}, List: &computev1beta1.NetworkList{}},
+		Extract:      computev1beta1.NetworkURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, path+".network")
+	}
+	gce.Network = reference.ToPtrValue(rsp.ResolvedValue)
+	gce.NetworkRef = rsp.ResolvedReference
+
+	// Resolve <path>.subnetwork
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(gce.Subnetwork),
+		Reference:    gce.SubnetworkRef,
+		Selector:     gce.SubnetworkSelector,
+		To:           reference.To{Managed: &computev1beta1.Subnetwork{}, List: &computev1beta1.SubnetworkList{}},
+		Extract:      computev1beta1.SubnetworkURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, path+".subnetwork")
+	}
+	gce.Subnetwork = reference.ToPtrValue(rsp.ResolvedValue)
+	gce.SubnetworkRef = rsp.ResolvedReference
+
+	// Resolve <path>.serviceAccount
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(gce.ServiceAccount),
+		Reference:    gce.ServiceAccountRef,
+		Selector:     gce.ServiceAccountSelector,
+		To:           reference.To{Managed: &iamv1alpha1.ServiceAccount{}, List: &iamv1alpha1.ServiceAccountList{}},
+		Extract:      iamv1alpha1.ServiceAccountEmail(),
+	})
+	if err != nil {
+		return errors.Wrap(err, path+".serviceAccount")
+	}
+	gce.ServiceAccount = reference.ToPtrValue(rsp.ResolvedValue)
+	gce.ServiceAccountRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Cluster
+func (in *Cluster) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+	return resolveClusterConfig(ctx, r, &in.Spec.ForProvider.Config, "spec.forProvider.config")
+}
+
+// ResolveReferences of this WorkflowTemplate
+func (in *WorkflowTemplate) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+	mc := in.Spec.ForProvider.Placement.ManagedCluster
+	if mc == nil {
+		return nil
+	}
+	return resolveClusterConfig(ctx, r, &mc.Config, "spec.forProvider.placement.managedCluster.config")
+}
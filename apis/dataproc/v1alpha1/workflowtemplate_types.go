@@ -0,0 +1,313 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// WorkflowTemplateParameters define the desired state of a Dataproc
+// WorkflowTemplate. Most fields map directly to a WorkflowTemplate:
+// https://cloud.google.com/dataproc/docs/reference/rest/v1/projects.regions.workflowTemplates#WorkflowTemplate
+type WorkflowTemplateParameters struct {
+	// Region in which to create this template, e.g. us-central1.
+	// +immutable
+	Region string `json:"region"`
+
+	// Labels to apply to the template and the clusters it creates.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Placement determines the cluster the workflow runs on.
+	Placement WorkflowTemplatePlacement `json:"placement"`
+
+	// Jobs of the workflow.
+	// +kubebuilder:validation:MinItems=1
+	Jobs []OrderedJob `json:"jobs"`
+
+	// Parameters that may be substituted when the template is
+	// instantiated.
+	// +optional
+	Parameters []TemplateParameter `json:"parameters,omitempty"`
+
+	// DAGTimeout is how long the workflow may run before it is cancelled,
+	// e.g. 1800s.
+	// +optional
+	DAGTimeout *string `json:"dagTimeout,omitempty"`
+}
+
+// WorkflowTemplatePlacement determines the cluster a workflow runs on.
+// Exactly one of ManagedCluster or ClusterSelector must be set.
+type WorkflowTemplatePlacement struct {
+	// ManagedCluster is a cluster that is created for the workflow and
+	// deleted once it completes.
+	// +optional
+	ManagedCluster *ManagedCluster `json:"managedCluster,omitempty"`
+
+	// ClusterSelector selects an existing cluster to run the workflow on.
+	// +optional
+	ClusterSelector *ClusterSelector `json:"clusterSelector,omitempty"`
+}
+
+// A ManagedCluster is created for a workflow and deleted once it completes.
+type ManagedCluster struct {
+	// ClusterName is the prefix of the name of the cluster.
+	ClusterName string `json:"clusterName"`
+
+	// Config of the cluster.
+	Config ClusterConfig `json:"config"`
+
+	// Labels to apply to the cluster.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// A ClusterSelector selects an existing cluster by its labels.
+type ClusterSelector struct {
+	// Zone in which to look for clusters. The zone of the template's
+	// region is used if it is not set.
+	// +optional
+	Zone *string `json:"zone,omitempty"`
+
+	// ClusterLabels that the selected cluster must have.
+	ClusterLabels map[string]string `json:"clusterLabels"`
+}
+
+// An OrderedJob is a job of a workflow. Exactly one of SparkJob, PySparkJob,
+// HadoopJob or SparkSQLJob must be set.
+type OrderedJob struct {
+	// StepID uniquely identifies the job within the template.
+	StepID string `json:"stepId"`
+
+	// PrerequisiteStepIDs are the steps that must complete before this job
+	// starts.
+	// +optional
+	PrerequisiteStepIDs []string `json:"prerequisiteStepIds,omitempty"`
+
+	// Labels to apply to the job.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// SparkJob is a Spark job.
+	// +optional
+	SparkJob *SparkJob `json:"sparkJob,omitempty"`
+
+	// PySparkJob is a PySpark job.
+	// +optional
+	PySparkJob *PySparkJob `json:"pysparkJob,omitempty"`
+
+	// HadoopJob is a Hadoop MapReduce job.
+	// +optional
+	HadoopJob *HadoopJob `json:"hadoopJob,omitempty"`
+
+	// SparkSQLJob is a Spark SQL job.
+	// +optional
+	SparkSQLJob *SparkSQLJob `json:"sparkSqlJob,omitempty"`
+}
+
+// A SparkJob runs a Spark application. Exactly one of MainClass or
+// MainJarFileURI must be set.
+type SparkJob struct {
+	// MainClass is the name of the driver's main class.
+	// +optional
+	MainClass *string `json:"mainClass,omitempty"`
+
+	// MainJarFileURI is the URI of the jar file that contains the main
+	// class.
+	// +optional
+	MainJarFileURI *string `json:"mainJarFileUri,omitempty"`
+
+	// Args passed to the driver.
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// JarFileURIs are added to the classpath of the driver and tasks.
+	// +optional
+	JarFileURIs []string `json:"jarFileUris,omitempty"`
+
+	// FileURIs are copied to the working directory of each executor.
+	// +optional
+	FileURIs []string `json:"fileUris,omitempty"`
+
+	// ArchiveURIs are extracted into the working directory of each
+	// executor.
+	// +optional
+	ArchiveURIs []string `json:"archiveUris,omitempty"`
+
+	// Properties used to configure Spark.
+	// +optional
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+// A PySparkJob runs a PySpark application.
+type PySparkJob struct {
+	// MainPythonFileURI is the URI of the Python file of the driver.
+	MainPythonFileURI string `json:"mainPythonFileUri"`
+
+	// Args passed to the driver.
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// PythonFileURIs are passed to the PySpark framework.
+	// +optional
+	PythonFileURIs []string `json:"pythonFileUris,omitempty"`
+
+	// JarFileURIs are added to the classpath of the driver and tasks.
+	// +optional
+	JarFileURIs []string `json:"jarFileUris,omitempty"`
+
+	// FileURIs are copied to the working directory of each executor.
+	// +optional
+	FileURIs []string `json:"fileUris,omitempty"`
+
+	// ArchiveURIs are extracted into the working directory of each
+	// executor.
+	// +optional
+	ArchiveURIs []string `json:"archiveUris,omitempty"`
+
+	// Properties used to configure PySpark.
+	// +optional
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+// A HadoopJob runs a Hadoop MapReduce application. Exactly one of MainClass
+// or MainJarFileURI must be set.
+type HadoopJob struct {
+	// MainClass is the name of the driver's main class.
+	// +optional
+	MainClass *string `json:"mainClass,omitempty"`
+
+	// MainJarFileURI is the URI of the jar file that contains the main
+	// class.
+	// +optional
+	MainJarFileURI *string `json:"mainJarFileUri,omitempty"`
+
+	// Args passed to the driver.
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// JarFileURIs are added to the classpath of the driver and tasks.
+	// +optional
+	JarFileURIs []string `json:"jarFileUris,omitempty"`
+
+	// FileURIs are copied to the working directory of each task.
+	// +optional
+	FileURIs []string `json:"fileUris,omitempty"`
+
+	// ArchiveURIs are extracted into the working directory of each task.
+	// +optional
+	ArchiveURIs []string `json:"archiveUris,omitempty"`
+
+	// Properties used to configure Hadoop.
+	// +optional
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+// A SparkSQLJob runs Spark SQL queries. Exactly one of QueryFileURI or
+// Queries must be set.
+type SparkSQLJob struct {
+	// QueryFileURI is the URI of the script that contains the queries.
+	// +optional
+	QueryFileURI *string `json:"queryFileUri,omitempty"`
+
+	// Queries to run.
+	// +optional
+	Queries []string `json:"queries,omitempty"`
+
+	// ScriptVariables are the values of the variables of the queries.
+	// +optional
+	ScriptVariables map[string]string `json:"scriptVariables,omitempty"`
+
+	// JarFileURIs are added to the Spark classpath.
+	// +optional
+	JarFileURIs []string `json:"jarFileUris,omitempty"`
+
+	// Properties used to configure Spark SQL.
+	// +optional
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+// A TemplateParameter may be substituted when a template is instantiated.
+type TemplateParameter struct {
+	// Name of the parameter, e.g. CLUSTER_ZONE.
+	Name string `json:"name"`
+
+	// Fields of the template the parameter replaces, e.g.
+	// placement.managedCluster.config.gceClusterConfig.zoneUri.
+	Fields []string `json:"fields"`
+
+	// Description of the parameter.
+	// +optional
+	Description *string `json:"description,omitempty"`
+}
+
+// WorkflowTemplateObservation is used to show the observed state of a
+// WorkflowTemplate.
+type WorkflowTemplateObservation struct {
+	// Name is the fully qualified name of the template.
+	Name string `json:"name,omitempty"`
+
+	// Version of the template. It is incremented whenever the template is
+	// updated.
+	Version int64 `json:"version,omitempty"`
+
+	// CreateTime is the time the template was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the template was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// A WorkflowTemplateSpec defines the desired state of a WorkflowTemplate.
+type WorkflowTemplateSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       WorkflowTemplateParameters `json:"forProvider"`
+}
+
+// A WorkflowTemplateStatus represents the observed state of a WorkflowTemplate.
+type WorkflowTemplateStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          WorkflowTemplateObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A WorkflowTemplate is a managed resource that represents a Dataproc WorkflowTemplate.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="VERSION",type="integer",JSONPath=".status.atProvider.version"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type WorkflowTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WorkflowTemplateSpec   `json:"spec"`
+	Status WorkflowTemplateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WorkflowTemplateList contains a list of WorkflowTemplate
+type WorkflowTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WorkflowTemplate `json:"items"`
+}
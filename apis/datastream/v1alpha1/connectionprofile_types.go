@@ -0,0 +1,218 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ConnectionProfileParameters define the desired state of a Datastream
+// ConnectionProfile. Exactly one of MySQLProfile, PostgreSQLProfile,
+// BigQueryProfile or GCSProfile must be set. Source profiles must also set
+// one of StaticServiceIPConnectivity, ForwardSSHConnectivity or
+// PrivateConnectivity. Most fields map directly to a ConnectionProfile:
+// https://cloud.google.com/datastream/docs/reference/rest/v1/projects.locations.connectionProfiles#ConnectionProfile
+type ConnectionProfileParameters struct {
+	// Location in which to create this connection profile, e.g.
+	// us-central1.
+	// +immutable
+	Location string `json:"location"`
+
+	// DisplayName of the connection profile.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Labels to apply to the connection profile.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// MySQLProfile configures a MySQL source.
+	// +optional
+	// +immutable
+	MySQLProfile *MySQLProfile `json:"mysqlProfile,omitempty"`
+
+	// PostgreSQLProfile configures a PostgreSQL source.
+	// +optional
+	// +immutable
+	PostgreSQLProfile *PostgreSQLProfile `json:"postgresqlProfile,omitempty"`
+
+	// BigQueryProfile configures a BigQuery destination.
+	// +optional
+	// +immutable
+	BigQueryProfile *BigQueryProfile `json:"bigqueryProfile,omitempty"`
+
+	// GCSProfile configures a Cloud Storage destination.
+	// +optional
+	// +immutable
+	GCSProfile *GCSProfile `json:"gcsProfile,omitempty"`
+
+	// StaticServiceIPConnectivity connects to the source through the public
+	// IP addresses of Datastream, which must be allowed by the source.
+	// +optional
+	// +immutable
+	StaticServiceIPConnectivity *StaticServiceIPConnectivity `json:"staticServiceIpConnectivity,omitempty"`
+
+	// ForwardSSHConnectivity connects to the source through an SSH tunnel.
+	// +optional
+	// +immutable
+	ForwardSSHConnectivity *ForwardSSHConnectivity `json:"forwardSshConnectivity,omitempty"`
+
+	// PrivateConnectivity connects to the source through a Datastream
+	// private connection.
+	// +optional
+	// +immutable
+	PrivateConnectivity *PrivateConnectivity `json:"privateConnectivity,omitempty"`
+}
+
+// MySQLProfile configures a MySQL source.
+type MySQLProfile struct {
+	// Hostname or IP address of the MySQL server.
+	Hostname string `json:"hostname"`
+
+	// Port of the MySQL server. Defaults to 3306.
+	// +optional
+	Port *int64 `json:"port,omitempty"`
+
+	// Username used to connect to the MySQL server.
+	Username string `json:"username"`
+
+	// PasswordSecretRef references the key of a Secret that contains the
+	// password used to connect to the MySQL server.
+	PasswordSecretRef xpv1.SecretKeySelector `json:"passwordSecretRef"`
+}
+
+// PostgreSQLProfile configures a PostgreSQL source.
+type PostgreSQLProfile struct {
+	// Hostname or IP address of the PostgreSQL server.
+	Hostname string `json:"hostname"`
+
+	// Port of the PostgreSQL server. Defaults to 5432.
+	// +optional
+	Port *int64 `json:"port,omitempty"`
+
+	// Username used to connect to the PostgreSQL server.
+	Username string `json:"username"`
+
+	// PasswordSecretRef references the key of a Secret that contains the
+	// password used to connect to the PostgreSQL server.
+	PasswordSecretRef xpv1.SecretKeySelector `json:"passwordSecretRef"`
+
+	// Database to stream from.
+	Database string `json:"database"`
+}
+
+// BigQueryProfile configures a BigQuery destination. It has no fields.
+type BigQueryProfile struct{}
+
+// GCSProfile configures a Cloud Storage destination.
+type GCSProfile struct {
+	// Bucket to write to.
+	Bucket string `json:"bucket"`
+
+	// RootPath within the bucket to write to.
+	// +optional
+	RootPath *string `json:"rootPath,omitempty"`
+}
+
+// StaticServiceIPConnectivity connects to a source through the public IP
+// addresses of Datastream. It has no fields.
+type StaticServiceIPConnectivity struct{}
+
+// ForwardSSHConnectivity connects to a source through an SSH tunnel. Exactly
+// one of PasswordSecretRef or PrivateKeySecretRef must be set.
+type ForwardSSHConnectivity struct {
+	// Hostname of the SSH tunnel server.
+	Hostname string `json:"hostname"`
+
+	// Port of the SSH tunnel server. Defaults to 22.
+	// +optional
+	Port *int64 `json:"port,omitempty"`
+
+	// Username used to connect to the SSH tunnel server.
+	Username string `json:"username"`
+
+	// PasswordSecretRef references the key of a Secret that contains the
+	// password used to connect to the SSH tunnel server.
+	// +optional
+	PasswordSecretRef *xpv1.SecretKeySelector `json:"passwordSecretRef,omitempty"`
+
+	// PrivateKeySecretRef references the key of a Secret that contains the
+	// private key used to connect to the SSH tunnel server.
+	// +optional
+	PrivateKeySecretRef *xpv1.SecretKeySelector `json:"privateKeySecretRef,omitempty"`
+}
+
+// PrivateConnectivity connects to a source through a Datastream private
+// connection.
+type PrivateConnectivity struct {
+	// PrivateConnection is the fully qualified name of the private
+	// connection, in the form
+	// projects/{project}/locations/{location}/privateConnections/{name}.
+	PrivateConnection string `json:"privateConnection"`
+}
+
+// ConnectionProfileObservation is used to show the observed state of a
+// ConnectionProfile.
+type ConnectionProfileObservation struct {
+	// Name is the fully qualified name of the connection profile.
+	Name string `json:"name,omitempty"`
+
+	// CreateTime is the time the connection profile was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the connection profile was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// A ConnectionProfileSpec defines the desired state of a ConnectionProfile.
+type ConnectionProfileSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ConnectionProfileParameters `json:"forProvider"`
+}
+
+// A ConnectionProfileStatus represents the observed state of a ConnectionProfile.
+type ConnectionProfileStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ConnectionProfileObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ConnectionProfile is a managed resource that represents a Datastream ConnectionProfile.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type ConnectionProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConnectionProfileSpec   `json:"spec"`
+	Status ConnectionProfileStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ConnectionProfileList contains a list of ConnectionProfile
+type ConnectionProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ConnectionProfile `json:"items"`
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// ConnectionProfileName extracts the fully qualified name of a
+// ConnectionProfile.
+func ConnectionProfileName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cp, ok := mg.(*ConnectionProfile)
+		if !ok {
+			return ""
+		}
+		return cp.Status.AtProvider.Name
+	}
+}
+
+// ResolveReferences of this Stream
+func (in *Stream) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.sourceConfig.sourceConnectionProfile
+	src := &in.Spec.ForProvider.SourceConfig
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(src.SourceConnectionProfile),
+		Reference:    src.SourceConnectionProfileRef,
+		Selector:     src.SourceConnectionProfileSelector,
+		To:           reference.To{Managed: &ConnectionProfile{}, List: &ConnectionProfileList{}},
+		Extract:      ConnectionProfileName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.sourceConfig.sourceConnectionProfile")
+	}
+	src.SourceConnectionProfile = reference.ToPtrValue(rsp.ResolvedValue)
+	src.SourceConnectionProfileRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.destinationConfig.destinationConnectionProfile
+	dst := &in.Spec.ForProvider.DestinationConfig
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(dst.DestinationConnectionProfile),
+		Reference:    dst.DestinationConnectionProfileRef,
+		Selector:     dst.DestinationConnectionProfileSelector,
+		To:           reference.To{Managed: &ConnectionProfile{}, List: &ConnectionProfileList{}},
+		Extract:      ConnectionProfileName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.destinationConfig.destinationConnectionProfile")
+	}
+	dst.DestinationConnectionProfile = reference.ToPtrValue(rsp.ResolvedValue)
+	dst.DestinationConnectionProfileRef = rsp.ResolvedReference
+
+	return nil
+}
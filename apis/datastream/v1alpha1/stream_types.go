@@ -0,0 +1,372 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known Stream states.
+const (
+	StreamStateNotStarted        = "NOT_STARTED"
+	StreamStateRunning           = "RUNNING"
+	StreamStatePaused            = "PAUSED"
+	StreamStateMaintenance       = "MAINTENANCE"
+	StreamStateFailed            = "FAILED"
+	StreamStateFailedPermanently = "FAILED_PERMANENTLY"
+	StreamStateStarting          = "STARTING"
+	StreamStateDraining          = "DRAINING"
+)
+
+// StreamParameters define the desired state of a Datastream Stream. Exactly
+// one of BackfillAll or BackfillNone must be set. Most fields map directly to
+// a Stream:
+// https://cloud.google.com/datastream/docs/reference/rest/v1/projects.locations.streams#Stream
+type StreamParameters struct {
+	// Location in which to create this stream, e.g. us-central1.
+	// +immutable
+	Location string `json:"location"`
+
+	// DisplayName of the stream.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Labels to apply to the stream.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// SourceConfig configures where the stream reads from.
+	// +immutable
+	SourceConfig StreamSourceConfig `json:"sourceConfig"`
+
+	// DestinationConfig configures where the stream writes to.
+	// +immutable
+	DestinationConfig StreamDestinationConfig `json:"destinationConfig"`
+
+	// BackfillAll backfills all existing data of the source, except the
+	// excluded objects.
+	// +optional
+	// +immutable
+	BackfillAll *BackfillAllStrategy `json:"backfillAll,omitempty"`
+
+	// BackfillNone only streams changes made after the stream starts.
+	// +optional
+	// +immutable
+	BackfillNone *BackfillNoneStrategy `json:"backfillNone,omitempty"`
+
+	// CustomerManagedEncryptionKey is the Cloud KMS key used to encrypt data
+	// at rest.
+	// +optional
+	// +immutable
+	CustomerManagedEncryptionKey *string `json:"customerManagedEncryptionKey,omitempty"`
+
+	// DesiredState of the stream. Streams are created paused, and started
+	// once they exist if the desired state is RUNNING.
+	// +optional
+	// +kubebuilder:validation:Enum=RUNNING;PAUSED
+	// +kubebuilder:default=RUNNING
+	DesiredState *string `json:"desiredState,omitempty"`
+}
+
+// StreamSourceConfig configures the source of a Stream. Exactly one of
+// MySQLSourceConfig or PostgreSQLSourceConfig must be set.
+type StreamSourceConfig struct {
+	// SourceConnectionProfile is the fully qualified name of the source
+	// connection profile.
+	// +optional
+	SourceConnectionProfile *string `json:"sourceConnectionProfile,omitempty"`
+
+	// SourceConnectionProfileRef references a ConnectionProfile to retrieve
+	// its name.
+	// +optional
+	SourceConnectionProfileRef *xpv1.Reference `json:"sourceConnectionProfileRef,omitempty"`
+
+	// SourceConnectionProfileSelector selects a reference to a
+	// ConnectionProfile to retrieve its name.
+	// +optional
+	SourceConnectionProfileSelector *xpv1.Selector `json:"sourceConnectionProfileSelector,omitempty"`
+
+	// MySQLSourceConfig configures a MySQL source.
+	// +optional
+	MySQLSourceConfig *MySQLSourceConfig `json:"mysqlSourceConfig,omitempty"`
+
+	// PostgreSQLSourceConfig configures a PostgreSQL source.
+	// +optional
+	PostgreSQLSourceConfig *PostgreSQLSourceConfig `json:"postgresqlSourceConfig,omitempty"`
+}
+
+// MySQLSourceConfig configures a MySQL source.
+type MySQLSourceConfig struct {
+	// IncludeObjects to stream. Defaults to all objects.
+	// +optional
+	IncludeObjects *MySQLRDBMS `json:"includeObjects,omitempty"`
+
+	// ExcludeObjects not to stream.
+	// +optional
+	ExcludeObjects *MySQLRDBMS `json:"excludeObjects,omitempty"`
+
+	// MaxConcurrentBackfillTasks is the maximum number of concurrent backfill
+	// tasks.
+	// +optional
+	MaxConcurrentBackfillTasks *int64 `json:"maxConcurrentBackfillTasks,omitempty"`
+
+	// MaxConcurrentCDCTasks is the maximum number of concurrent change data
+	// capture tasks.
+	// +optional
+	MaxConcurrentCDCTasks *int64 `json:"maxConcurrentCdcTasks,omitempty"`
+}
+
+// MySQLRDBMS selects MySQL databases and tables.
+type MySQLRDBMS struct {
+	// Databases to select.
+	Databases []MySQLDatabase `json:"databases"`
+}
+
+// MySQLDatabase selects a MySQL database.
+type MySQLDatabase struct {
+	// Database name.
+	Database string `json:"database"`
+
+	// Tables to select. Defaults to all tables of the database.
+	// +optional
+	Tables []string `json:"tables,omitempty"`
+}
+
+// PostgreSQLSourceConfig configures a PostgreSQL source.
+type PostgreSQLSourceConfig struct {
+	// IncludeObjects to stream. Defaults to all objects.
+	// +optional
+	IncludeObjects *PostgreSQLRDBMS `json:"includeObjects,omitempty"`
+
+	// ExcludeObjects not to stream.
+	// +optional
+	ExcludeObjects *PostgreSQLRDBMS `json:"excludeObjects,omitempty"`
+
+	// ReplicationSlot is the name of the logical replication slot that
+	// Datastream reads changes from.
+	ReplicationSlot string `json:"replicationSlot"`
+
+	// Publication is the name of the publication that includes the set of
+	// tables to stream.
+	Publication string `json:"publication"`
+
+	// MaxConcurrentBackfillTasks is the maximum number of concurrent backfill
+	// tasks.
+	// +optional
+	MaxConcurrentBackfillTasks *int64 `json:"maxConcurrentBackfillTasks,omitempty"`
+}
+
+// PostgreSQLRDBMS selects PostgreSQL schemas and tables.
+type PostgreSQLRDBMS struct {
+	// Schemas to select.
+	Schemas []PostgreSQLSchema `json:"schemas"`
+}
+
+// PostgreSQLSchema selects a PostgreSQL schema.
+type PostgreSQLSchema struct {
+	// Schema name.
+	Schema string `json:"schema"`
+
+	// Tables to select. Defaults to all tables of the schema.
+	// +optional
+	Tables []string `json:"tables,omitempty"`
+}
+
+// StreamDestinationConfig configures the destination of a Stream. Exactly one
+// of BigQueryDestinationConfig or GCSDestinationConfig must be set.
+type StreamDestinationConfig struct {
+	// DestinationConnectionProfile is the fully qualified name of the
+	// destination connection profile.
+	// +optional
+	DestinationConnectionProfile *string `json:"destinationConnectionProfile,omitempty"`
+
+	// DestinationConnectionProfileRef references a ConnectionProfile to
+	// retrieve its name.
+	// +optional
+	DestinationConnectionProfileRef *xpv1.Reference `json:"destinationConnectionProfileRef,omitempty"`
+
+	// DestinationConnectionProfileSelector selects a reference to a
+	// ConnectionProfile to retrieve its name.
+	// +optional
+	DestinationConnectionProfileSelector *xpv1.Selector `json:"destinationConnectionProfileSelector,omitempty"`
+
+	// BigQueryDestinationConfig configures a BigQuery destination.
+	// +optional
+	BigQueryDestinationConfig *BigQueryDestinationConfig `json:"bigqueryDestinationConfig,omitempty"`
+
+	// GCSDestinationConfig configures a Cloud Storage destination.
+	// +optional
+	GCSDestinationConfig *GCSDestinationConfig `json:"gcsDestinationConfig,omitempty"`
+}
+
+// BigQueryDestinationConfig configures a BigQuery destination. Exactly one of
+// SingleTargetDataset or SourceHierarchyDatasets must be set.
+type BigQueryDestinationConfig struct {
+	// DataFreshness is how stale the data in BigQuery may be, e.g. 900s.
+	// +optional
+	DataFreshness *string `json:"dataFreshness,omitempty"`
+
+	// SingleTargetDataset writes all tables to a single dataset.
+	// +optional
+	SingleTargetDataset *SingleTargetDataset `json:"singleTargetDataset,omitempty"`
+
+	// SourceHierarchyDatasets writes each source schema to its own dataset.
+	// +optional
+	SourceHierarchyDatasets *SourceHierarchyDatasets `json:"sourceHierarchyDatasets,omitempty"`
+}
+
+// SingleTargetDataset writes all tables to a single dataset.
+type SingleTargetDataset struct {
+	// DatasetID of the dataset, in the form {project}:{dataset}.
+	DatasetID string `json:"datasetId"`
+}
+
+// SourceHierarchyDatasets writes each source schema to its own dataset.
+type SourceHierarchyDatasets struct {
+	// Location of the datasets, e.g. US.
+	Location string `json:"location"`
+
+	// DatasetIDPrefix prepended to the name of each dataset.
+	// +optional
+	DatasetIDPrefix *string `json:"datasetIdPrefix,omitempty"`
+
+	// KMSKeyName is the Cloud KMS key used to encrypt the datasets.
+	// +optional
+	KMSKeyName *string `json:"kmsKeyName,omitempty"`
+}
+
+// GCSDestinationConfig configures a Cloud Storage destination. Files are
+// written as Avro unless JSONFileFormat is set.
+type GCSDestinationConfig struct {
+	// Path within the bucket of the connection profile to write to.
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// FileRotationMB is the maximum size of a file before it is rotated.
+	// +optional
+	FileRotationMB *int64 `json:"fileRotationMb,omitempty"`
+
+	// FileRotationInterval is the maximum age of a file before it is
+	// rotated, e.g. 900s.
+	// +optional
+	FileRotationInterval *string `json:"fileRotationInterval,omitempty"`
+
+	// JSONFileFormat writes files as JSON.
+	// +optional
+	JSONFileFormat *JSONFileFormat `json:"jsonFileFormat,omitempty"`
+}
+
+// JSONFileFormat configures how files are written as JSON.
+type JSONFileFormat struct {
+	// SchemaFileFormat determines whether a schema file is written.
+	// +optional
+	// +kubebuilder:validation:Enum=NO_SCHEMA_FILE;AVRO_SCHEMA_FILE
+	SchemaFileFormat *string `json:"schemaFileFormat,omitempty"`
+
+	// Compression of the files.
+	// +optional
+	// +kubebuilder:validation:Enum=NO_COMPRESSION;GZIP
+	Compression *string `json:"compression,omitempty"`
+}
+
+// BackfillAllStrategy backfills all existing data of a Stream source.
+type BackfillAllStrategy struct {
+	// MySQLExcludedObjects are not backfilled.
+	// +optional
+	MySQLExcludedObjects *MySQLRDBMS `json:"mysqlExcludedObjects,omitempty"`
+
+	// PostgreSQLExcludedObjects are not backfilled.
+	// +optional
+	PostgreSQLExcludedObjects *PostgreSQLRDBMS `json:"postgresqlExcludedObjects,omitempty"`
+}
+
+// BackfillNoneStrategy does not backfill a Stream source. It has no fields.
+type BackfillNoneStrategy struct{}
+
+// StreamError is an error reported by a Stream.
+type StreamError struct {
+	// Reason of the error.
+	Reason string `json:"reason,omitempty"`
+
+	// Message describing the error.
+	Message string `json:"message,omitempty"`
+
+	// ErrorTime is the time the error occurred.
+	ErrorTime string `json:"errorTime,omitempty"`
+}
+
+// StreamObservation is used to show the observed state of a Stream.
+type StreamObservation struct {
+	// Name is the fully qualified name of the stream.
+	Name string `json:"name,omitempty"`
+
+	// State of the stream.
+	State string `json:"state,omitempty"`
+
+	// Errors reported by the stream.
+	Errors []StreamError `json:"errors,omitempty"`
+
+	// CreateTime is the time the stream was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the stream was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+
+	// LastRecoveryTime is the last time the stream recovered from a
+	// failure.
+	LastRecoveryTime string `json:"lastRecoveryTime,omitempty"`
+}
+
+// A StreamSpec defines the desired state of a Stream.
+type StreamSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       StreamParameters `json:"forProvider"`
+}
+
+// A StreamStatus represents the observed state of a Stream.
+type StreamStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          StreamObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Stream is a managed resource that represents a Datastream Stream.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Stream struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StreamSpec   `json:"spec"`
+	Status StreamStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StreamList contains a list of Stream
+type StreamList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Stream `json:"items"`
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Visibilities of a ManagedZone.
+const (
+	ManagedZoneVisibilityPublic  = "public"
+	ManagedZoneVisibilityPrivate = "private"
+)
+
+// ManagedZoneParameters define the desired state of a ManagedZone
+type ManagedZoneParameters struct {
+	// DNSName is the DNS name suffix of the records in this zone, e.g.
+	// example.com. including the trailing dot.
+	// +immutable
+	DNSName string `json:"dnsName"`
+
+	// Description of the managed zone.
+	// +optional
+	// +kubebuilder:default="Managed by Crossplane"
+	Description *string `json:"description,omitempty"`
+
+	// Labels to apply to the managed zone.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Visibility of the zone. Public zones are exposed to the Internet,
+	// private zones are only visible from the networks in
+	// PrivateVisibilityConfig.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=public;private
+	// +kubebuilder:default=public
+	Visibility *string `json:"visibility,omitempty"`
+
+	// DNSSECConfig configures DNSSEC for public zones.
+	// +optional
+	DNSSECConfig *ManagedZoneDNSSECConfig `json:"dnssecConfig,omitempty"`
+
+	// PrivateVisibilityConfig lists the networks a private zone is visible
+	// from.
+	// +optional
+	PrivateVisibilityConfig *ManagedZonePrivateVisibilityConfig `json:"privateVisibilityConfig,omitempty"`
+
+	// ForwardingConfig makes this a forwarding zone, whose queries are sent
+	// to the target name servers.
+	// +optional
+	ForwardingConfig *ManagedZoneForwardingConfig `json:"forwardingConfig,omitempty"`
+
+	// PeeringConfig makes this a peering zone, whose queries are resolved
+	// in the target network.
+	// +optional
+	PeeringConfig *ManagedZonePeeringConfig `json:"peeringConfig,omitempty"`
+}
+
+// ManagedZoneDNSSECConfig configures DNSSEC for a ManagedZone.
+type ManagedZoneDNSSECConfig struct {
+	// State of DNSSEC for the zone. Zones must be in state transfer before
+	// DNSSEC can be turned off.
+	// +optional
+	// +kubebuilder:validation:Enum=on;off;transfer
+	State *string `json:"state,omitempty"`
+
+	// NonExistence specifies the mechanism used to provide authenticated
+	// denial-of-existence responses.
+	// +optional
+	// +kubebuilder:validation:Enum=nsec;nsec3
+	NonExistence *string `json:"nonExistence,omitempty"`
+
+	// DefaultKeySpecs are the parameters for generating the initial key
+	// signing and zone signing keys of the zone.
+	// +optional
+	DefaultKeySpecs []DNSKeySpec `json:"defaultKeySpecs,omitempty"`
+}
+
+// DNSKeySpec are the parameters of a DNSSEC key.
+type DNSKeySpec struct {
+	// Algorithm used to generate the key.
+	// +kubebuilder:validation:Enum=rsasha1;rsasha256;rsasha512;ecdsap256sha256;ecdsap384sha384
+	Algorithm string `json:"algorithm"`
+
+	// KeyLength in bits.
+	KeyLength int64 `json:"keyLength"`
+
+	// KeyType specifies whether this is a key signing or a zone signing key.
+	// +kubebuilder:validation:Enum=keySigning;zoneSigning
+	KeyType string `json:"keyType"`
+}
+
+// ManagedZonePrivateVisibilityConfig lists the networks a private
+// ManagedZone is visible from.
+type ManagedZonePrivateVisibilityConfig struct {
+	// Networks the zone is visible from.
+	Networks []VPCNetwork `json:"networks"`
+}
+
+// VPCNetwork is a VPC network a ManagedZone, Policy or ResponsePolicy
+// applies to.
+type VPCNetwork struct {
+	// NetworkURL of the network, e.g.
+	// projects/{project}/global/networks/{network}.
+	// +optional
+	NetworkURL *string `json:"networkUrl,omitempty"`
+
+	// NetworkRef references a Network and retrieves its URL.
+	// +optional
+	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to a Network.
+	// +optional
+	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
+}
+
+// ManagedZoneForwardingConfig lists the name servers a forwarding
+// ManagedZone sends its queries to.
+type ManagedZoneForwardingConfig struct {
+	// TargetNameServers to forward queries to.
+	TargetNameServers []ForwardingTarget `json:"targetNameServers"`
+}
+
+// ForwardingTarget is a name server queries are forwarded to.
+type ForwardingTarget struct {
+	// IPv4Address of the name server.
+	IPv4Address string `json:"ipv4Address"`
+
+	// ForwardingPath selects how queries reach the name server. The default
+	// path uses the Internet for public addresses, private always uses the
+	// VPC network.
+	// +optional
+	// +kubebuilder:validation:Enum=default;private
+	ForwardingPath *string `json:"forwardingPath,omitempty"`
+}
+
+// ManagedZonePeeringConfig configures the network a peering ManagedZone
+// resolves its queries in.
+type ManagedZonePeeringConfig struct {
+	// TargetNetwork to resolve queries in.
+	TargetNetwork VPCNetwork `json:"targetNetwork"`
+}
+
+// ManagedZoneObservation is used to show the observed state of the
+// ManagedZone
+type ManagedZoneObservation struct {
+	// ID of the managed zone, assigned by Cloud DNS.
+	ID uint64 `json:"id,omitempty"`
+
+	// NameServers that are authoritative for the zone.
+	NameServers []string `json:"nameServers,omitempty"`
+
+	// CreationTime of the managed zone.
+	CreationTime string `json:"creationTime,omitempty"`
+}
+
+// ManagedZoneSpec defines the desired state of a ManagedZone.
+type ManagedZoneSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ManagedZoneParameters `json:"forProvider"`
+}
+
+// ManagedZoneStatus represents the observed state of a ManagedZone.
+type ManagedZoneStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ManagedZoneObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ManagedZone is a managed resource that represents a Managed Zone in Cloud DNS
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DNS NAME",type="string",JSONPath=".spec.forProvider.dnsName"
+// +kubebuilder:printcolumn:name="VISIBILITY",type="string",JSONPath=".spec.forProvider.visibility"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type ManagedZone struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ManagedZoneSpec   `json:"spec"`
+	Status ManagedZoneStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ManagedZoneList contains a list of ManagedZone
+type ManagedZoneList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ManagedZone `json:"items"`
+}
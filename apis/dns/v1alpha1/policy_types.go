@@ -0,0 +1,99 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// PolicyParameters define the desired state of a Policy
+type PolicyParameters struct {
+	// Description of the policy.
+	// +optional
+	// +kubebuilder:default="Managed by Crossplane"
+	Description *string `json:"description,omitempty"`
+
+	// EnableInboundForwarding allocates an inbound forwarder address in each
+	// of the networks, which on-premises DNS servers can send queries to.
+	// +optional
+	EnableInboundForwarding *bool `json:"enableInboundForwarding,omitempty"`
+
+	// EnableLogging enables query logging for the networks.
+	// +optional
+	EnableLogging *bool `json:"enableLogging,omitempty"`
+
+	// AlternativeNameServerConfig sends all queries of the networks to the
+	// target name servers instead of the Cloud DNS resolver.
+	// +optional
+	AlternativeNameServerConfig *PolicyAlternativeNameServerConfig `json:"alternativeNameServerConfig,omitempty"`
+
+	// Networks the policy applies to.
+	// +optional
+	Networks []VPCNetwork `json:"networks,omitempty"`
+}
+
+// PolicyAlternativeNameServerConfig lists the alternative name servers of a
+// Policy.
+type PolicyAlternativeNameServerConfig struct {
+	// TargetNameServers to send queries to.
+	TargetNameServers []ForwardingTarget `json:"targetNameServers"`
+}
+
+// PolicyObservation is used to show the observed state of the Policy
+type PolicyObservation struct {
+	// ID of the policy, assigned by Cloud DNS.
+	ID uint64 `json:"id,omitempty"`
+}
+
+// PolicySpec defines the desired state of a Policy.
+type PolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PolicyParameters `json:"forProvider"`
+}
+
+// PolicyStatus represents the observed state of a Policy.
+type PolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Policy is a managed resource that represents a DNS Server Policy in Cloud DNS
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Policy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PolicySpec   `json:"spec"`
+	Status PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PolicyList contains a list of Policy
+type PolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Policy `json:"items"`
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	computev1beta1 "github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+)
+
+// ResolveReferences of this ManagedZone
+func (in *ManagedZone) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	if pvc := in.Spec.ForProvider.PrivateVisibilityConfig; pvc != nil {
+		for i := range pvc.Networks {
+			if err := resolveNetwork(ctx, r, &pvc.Networks[i], fmt.Sprintf("spec.forProvider.privateVisibilityConfig.networks[%d]", i)); err != nil {
+				return err
+			}
+		}
+	}
+	if pc := in.Spec.ForProvider.PeeringConfig; pc != nil {
+		if err := resolveNetwork(ctx, r, &pc.TargetNetwork, "spec.forProvider.peeringConfig.targetNetwork"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resolveNetwork resolves the network URL of the supplied VPCNetwork.
+func resolveNetwork(ctx context.Context, r *reference.APIResolver, n *VPCNetwork, path string) error {
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(n.NetworkURL),
+		Reference:    n.NetworkRef,
+		Selector:     n.NetworkSelector,
+		To:           reference.To{Managed: &computev1beta1.Network{}, List: &computev1beta1.NetworkList{}},
+		Extract:      computev1beta1.NetworkURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, path+".networkUrl")
+	}
+	n.NetworkURL = reference.ToPtrValue(rsp.ResolvedValue)
+	n.NetworkRef = rsp.ResolvedReference
+	return nil
+}
+
+// ResolveReferences of this ResourceRecordSet
+func (in *ResourceRecordSet) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.managedZone
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: in.Spec.ForProvider.ManagedZone,
+		Reference:    in.Spec.ForProvider.ManagedZoneRef,
+		Selector:     in.Spec.ForProvider.ManagedZoneSelector,
+		To:           reference.To{Managed: &ManagedZone{}, List: &ManagedZoneList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.managedZone")
+	}
+	in.Spec.ForProvider.ManagedZone = rsp.ResolvedValue
+	in.Spec.ForProvider.ManagedZoneRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Policy
+func (in *Policy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	for i := range in.Spec.ForProvider.Networks {
+		if err := resolveNetwork(ctx, r, &in.Spec.ForProvider.Networks[i], fmt.Sprintf("spec.forProvider.networks[%d]", i)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ResolveReferences of this ResponsePolicy
+func (in *ResponsePolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	for i := range in.Spec.ForProvider.Networks {
+		if err := resolveNetwork(ctx, r, &in.Spec.ForProvider.Networks[i], fmt.Sprintf("spec.forProvider.networks[%d]", i)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ResolveReferences of this ResponsePolicyRule
+func (in *ResponsePolicyRule) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.responsePolicy
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: in.Spec.ForProvider.ResponsePolicy,
+		Reference:    in.Spec.ForProvider.ResponsePolicyRef,
+		Selector:     in.Spec.ForProvider.ResponsePolicySelector,
+		To:           reference.To{Managed: &ResponsePolicy{}, List: &ResponsePolicyList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.responsePolicy")
+	}
+	in.Spec.ForProvider.ResponsePolicy = rsp.ResolvedValue
+	in.Spec.ForProvider.ResponsePolicyRef = rsp.ResolvedReference
+
+	return nil
+}
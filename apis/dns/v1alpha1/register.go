@@ -37,6 +37,14 @@ var (
 	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
 )
 
+// ManagedZone type metadata.
+var (
+	ManagedZoneKind             = reflect.TypeOf(ManagedZone{}).Name()
+	ManagedZoneGroupKind        = schema.GroupKind{Group: Group, Kind: ManagedZoneKind}.String()
+	ManagedZoneKindAPIVersion   = ManagedZoneKind + "." + SchemeGroupVersion.String()
+	ManagedZoneGroupVersionKind = SchemeGroupVersion.WithKind(ManagedZoneKind)
+)
+
 // ResourceRecordSet type metadata.
 var (
 	ResourceRecordSetKind             = reflect.TypeOf(ResourceRecordSet{}).Name()
@@ -45,6 +53,34 @@ var (
 	ResourceRecordSetGroupVersionKind = SchemeGroupVersion.WithKind(ResourceRecordSetKind)
 )
 
+// Policy type metadata.
+var (
+	PolicyKind             = reflect.TypeOf(Policy{}).Name()
+	PolicyGroupKind        = schema.GroupKind{Group: Group, Kind: PolicyKind}.String()
+	PolicyKindAPIVersion   = PolicyKind + "." + SchemeGroupVersion.String()
+	PolicyGroupVersionKind = SchemeGroupVersion.WithKind(PolicyKind)
+)
+
+// ResponsePolicy type metadata.
+var (
+	ResponsePolicyKind             = reflect.TypeOf(ResponsePolicy{}).Name()
+	ResponsePolicyGroupKind        = schema.GroupKind{Group: Group, Kind: ResponsePolicyKind}.String()
+	ResponsePolicyKindAPIVersion   = ResponsePolicyKind + "." + SchemeGroupVersion.String()
+	ResponsePolicyGroupVersionKind = SchemeGroupVersion.WithKind(ResponsePolicyKind)
+)
+
+// ResponsePolicyRule type metadata.
+var (
+	ResponsePolicyRuleKind             = reflect.TypeOf(ResponsePolicyRule{}).Name()
+	ResponsePolicyRuleGroupKind        = schema.GroupKind{Group: Group, Kind: ResponsePolicyRuleKind}.String()
+	ResponsePolicyRuleKindAPIVersion   = ResponsePolicyRuleKind + "." + SchemeGroupVersion.String()
+	ResponsePolicyRuleGroupVersionKind = SchemeGroupVersion.WithKind(ResponsePolicyRuleKind)
+)
+
 func init() {
+	SchemeBuilder.Register(&ManagedZone{}, &ManagedZoneList{})
 	SchemeBuilder.Register(&ResourceRecordSet{}, &ResourceRecordSetList{})
+	SchemeBuilder.Register(&Policy{}, &PolicyList{})
+	SchemeBuilder.Register(&ResponsePolicy{}, &ResponsePolicyList{})
+	SchemeBuilder.Register(&ResponsePolicyRule{}, &ResponsePolicyRuleList{})
 }
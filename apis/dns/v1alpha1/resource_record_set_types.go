@@ -25,7 +25,16 @@ import (
 // ResourceRecordSetParameters define the desired state of a ResourceRecordSet
 type ResourceRecordSetParameters struct {
 	// Managed zone name that this ResourceRecordSet will be created in.
-	ManagedZone string `json:"managedZone"`
+	// +optional
+	ManagedZone string `json:"managedZone,omitempty"`
+
+	// ManagedZoneRef references a ManagedZone and retrieves its name.
+	// +optional
+	ManagedZoneRef *xpv1.Reference `json:"managedZoneRef,omitempty"`
+
+	// ManagedZoneSelector selects a reference to a ManagedZone.
+	// +optional
+	ManagedZoneSelector *xpv1.Selector `json:"managedZoneSelector,omitempty"`
 
 	// The identifier of a supported record type.
 	//
@@ -38,14 +47,78 @@ type ResourceRecordSetParameters struct {
 	TTL int64 `json:"ttl"`
 
 	// List of ResourceRecord datas as defined in
-	// RFC 1035 (section 5) and RFC 1034 (section 3.6.1)
-	RRDatas []string `json:"rrdatas"`
+	// RFC 1035 (section 5) and RFC 1034 (section 3.6.1). Either RRDatas or
+	// RoutingPolicy must be set.
+	//
+	// +optional
+	RRDatas []string `json:"rrdatas,omitempty"`
 
 	// List of Signature ResourceRecord datas, as
 	// defined in RFC 4034 (section 3.2).
 	//
 	// +optional
 	SignatureRRDatas []string `json:"signatureRrdatas,omitempty"`
+
+	// RoutingPolicy returns different ResourceRecord datas depending on the
+	// location of the client or a weighted round robin.
+	//
+	// +optional
+	RoutingPolicy *RoutingPolicy `json:"routingPolicy,omitempty"`
+}
+
+// RoutingPolicy of a ResourceRecordSet. Exactly one of Geo and WRR must be
+// set.
+type RoutingPolicy struct {
+	// Geo answers queries with the datas of the item closest to the
+	// location the query originated from.
+	//
+	// +optional
+	Geo *GeoPolicy `json:"geo,omitempty"`
+
+	// WRR answers queries with the datas of an item that is picked at
+	// random according to the weights of the items.
+	//
+	// +optional
+	WRR *WRRPolicy `json:"wrr,omitempty"`
+}
+
+// GeoPolicy routes queries by location.
+type GeoPolicy struct {
+	// Items of the policy, one per location.
+	Items []GeoPolicyItem `json:"items"`
+
+	// EnableFencing prevents queries from failing over to other locations
+	// when the health checked targets of a location are unhealthy.
+	//
+	// +optional
+	EnableFencing *bool `json:"enableFencing,omitempty"`
+}
+
+// GeoPolicyItem are the ResourceRecord datas returned for a location.
+type GeoPolicyItem struct {
+	// Location is the Google Cloud region the item applies to, e.g.
+	// us-east1.
+	Location string `json:"location"`
+
+	// RRDatas returned for the location.
+	RRDatas []string `json:"rrdatas"`
+}
+
+// WRRPolicy routes queries by weighted round robin.
+type WRRPolicy struct {
+	// Items of the policy.
+	Items []WRRPolicyItem `json:"items"`
+}
+
+// WRRPolicyItem are the ResourceRecord datas returned with a certain weight.
+type WRRPolicyItem struct {
+	// Weight of the item relative to the other items of the policy.
+	//
+	// +kubebuilder:validation:Minimum=0
+	Weight int64 `json:"weight"`
+
+	// RRDatas returned for the item.
+	RRDatas []string `json:"rrdatas"`
 }
 
 // ResourceRecordSetObservation is used to show the observed state of the ResourceRecordSet
@@ -0,0 +1,119 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Behaviors of a ResponsePolicyRule.
+const (
+	ResponsePolicyRuleBehaviorBypass = "bypassResponsePolicy"
+)
+
+// ResponsePolicyRuleParameters define the desired state of a
+// ResponsePolicyRule
+type ResponsePolicyRuleParameters struct {
+	// ResponsePolicy this rule belongs to.
+	// +optional
+	// +immutable
+	ResponsePolicy string `json:"responsePolicy,omitempty"`
+
+	// ResponsePolicyRef references a ResponsePolicy and retrieves its name.
+	// +optional
+	ResponsePolicyRef *xpv1.Reference `json:"responsePolicyRef,omitempty"`
+
+	// ResponsePolicySelector selects a reference to a ResponsePolicy.
+	// +optional
+	ResponsePolicySelector *xpv1.Selector `json:"responsePolicySelector,omitempty"`
+
+	// DNSName the rule applies to, e.g. www.example.com. including the
+	// trailing dot. Wildcards such as *.example.com. are supported.
+	DNSName string `json:"dnsName"`
+
+	// Behavior of the rule. Set to bypassResponsePolicy to exempt the DNS
+	// name from a wildcard rule. Either Behavior or LocalData must be set.
+	// +optional
+	// +kubebuilder:validation:Enum=bypassResponsePolicy
+	Behavior *string `json:"behavior,omitempty"`
+
+	// LocalData answers queries for the DNS name with these records instead
+	// of resolving it.
+	// +optional
+	LocalData []LocalRecordSet `json:"localData,omitempty"`
+}
+
+// LocalRecordSet is a record set a ResponsePolicyRule answers queries with.
+type LocalRecordSet struct {
+	// Name of the record set, e.g. www.example.com.
+	Name string `json:"name"`
+
+	// Type of the record set.
+	// +kubebuilder:validation:Enum=A;AAAA;CAA;CNAME;MX;NAPTR;NS;PTR;SPF;SRV;SSHFP;TLSA;TXT
+	Type string `json:"type"`
+
+	// TTL in seconds that the record set can be cached by resolvers.
+	TTL int64 `json:"ttl"`
+
+	// RRDatas of the record set.
+	RRDatas []string `json:"rrdatas"`
+}
+
+// ResponsePolicyRuleObservation is used to show the observed state of the
+// ResponsePolicyRule
+type ResponsePolicyRuleObservation struct{}
+
+// ResponsePolicyRuleSpec defines the desired state of a ResponsePolicyRule.
+type ResponsePolicyRuleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ResponsePolicyRuleParameters `json:"forProvider"`
+}
+
+// ResponsePolicyRuleStatus represents the observed state of a ResponsePolicyRule.
+type ResponsePolicyRuleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ResponsePolicyRuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResponsePolicyRule is a managed resource that represents a Response Policy Rule in Cloud DNS
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DNS NAME",type="string",JSONPath=".spec.forProvider.dnsName"
+// +kubebuilder:printcolumn:name="RESPONSE POLICY",type="string",JSONPath=".spec.forProvider.responsePolicy"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type ResponsePolicyRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ResponsePolicyRuleSpec   `json:"spec"`
+	Status ResponsePolicyRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResponsePolicyRuleList contains a list of ResponsePolicyRule
+type ResponsePolicyRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResponsePolicyRule `json:"items"`
+}
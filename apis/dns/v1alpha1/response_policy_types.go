@@ -0,0 +1,88 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ResponsePolicyParameters define the desired state of a ResponsePolicy
+type ResponsePolicyParameters struct {
+	// Description of the response policy.
+	// +optional
+	// +kubebuilder:default="Managed by Crossplane"
+	Description *string `json:"description,omitempty"`
+
+	// Labels to apply to the response policy.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Networks the response policy applies to.
+	// +optional
+	Networks []VPCNetwork `json:"networks,omitempty"`
+
+	// GKEClusters the response policy applies to, e.g.
+	// projects/{project}/locations/{location}/clusters/{cluster}.
+	// +optional
+	GKEClusters []string `json:"gkeClusters,omitempty"`
+}
+
+// ResponsePolicyObservation is used to show the observed state of the
+// ResponsePolicy
+type ResponsePolicyObservation struct {
+	// ID of the response policy, assigned by Cloud DNS.
+	ID int64 `json:"id,omitempty"`
+}
+
+// ResponsePolicySpec defines the desired state of a ResponsePolicy.
+type ResponsePolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ResponsePolicyParameters `json:"forProvider"`
+}
+
+// ResponsePolicyStatus represents the observed state of a ResponsePolicy.
+type ResponsePolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ResponsePolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResponsePolicy is a managed resource that represents a Response Policy in Cloud DNS
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type ResponsePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ResponsePolicySpec   `json:"spec"`
+	Status ResponsePolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResponsePolicyList contains a list of ResponsePolicy
+type ResponsePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResponsePolicy `json:"items"`
+}
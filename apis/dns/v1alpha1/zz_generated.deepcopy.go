@@ -21,9 +21,539 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSKeySpec) DeepCopyInto(out *DNSKeySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSKeySpec.
+func (in *DNSKeySpec) DeepCopy() *DNSKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ForwardingTarget) DeepCopyInto(out *ForwardingTarget) {
+	*out = *in
+	if in.ForwardingPath != nil {
+		in, out := &in.ForwardingPath, &out.ForwardingPath
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForwardingTarget.
+func (in *ForwardingTarget) DeepCopy() *ForwardingTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(ForwardingTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeoPolicy) DeepCopyInto(out *GeoPolicy) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GeoPolicyItem, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EnableFencing != nil {
+		in, out := &in.EnableFencing, &out.EnableFencing
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeoPolicy.
+func (in *GeoPolicy) DeepCopy() *GeoPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(GeoPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeoPolicyItem) DeepCopyInto(out *GeoPolicyItem) {
+	*out = *in
+	if in.RRDatas != nil {
+		in, out := &in.RRDatas, &out.RRDatas
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeoPolicyItem.
+func (in *GeoPolicyItem) DeepCopy() *GeoPolicyItem {
+	if in == nil {
+		return nil
+	}
+	out := new(GeoPolicyItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalRecordSet) DeepCopyInto(out *LocalRecordSet) {
+	*out = *in
+	if in.RRDatas != nil {
+		in, out := &in.RRDatas, &out.RRDatas
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalRecordSet.
+func (in *LocalRecordSet) DeepCopy() *LocalRecordSet {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalRecordSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedZone) DeepCopyInto(out *ManagedZone) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedZone.
+func (in *ManagedZone) DeepCopy() *ManagedZone {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedZone)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ManagedZone) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedZoneDNSSECConfig) DeepCopyInto(out *ManagedZoneDNSSECConfig) {
+	*out = *in
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.NonExistence != nil {
+		in, out := &in.NonExistence, &out.NonExistence
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultKeySpecs != nil {
+		in, out := &in.DefaultKeySpecs, &out.DefaultKeySpecs
+		*out = make([]DNSKeySpec, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedZoneDNSSECConfig.
+func (in *ManagedZoneDNSSECConfig) DeepCopy() *ManagedZoneDNSSECConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedZoneDNSSECConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedZoneForwardingConfig) DeepCopyInto(out *ManagedZoneForwardingConfig) {
+	*out = *in
+	if in.TargetNameServers != nil {
+		in, out := &in.TargetNameServers, &out.TargetNameServers
+		*out = make([]ForwardingTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedZoneForwardingConfig.
+func (in *ManagedZoneForwardingConfig) DeepCopy() *ManagedZoneForwardingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedZoneForwardingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedZoneList) DeepCopyInto(out *ManagedZoneList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ManagedZone, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedZoneList.
+func (in *ManagedZoneList) DeepCopy() *ManagedZoneList {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedZoneList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ManagedZoneList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedZoneObservation) DeepCopyInto(out *ManagedZoneObservation) {
+	*out = *in
+	if in.NameServers != nil {
+		in, out := &in.NameServers, &out.NameServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedZoneObservation.
+func (in *ManagedZoneObservation) DeepCopy() *ManagedZoneObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedZoneObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedZoneParameters) DeepCopyInto(out *ManagedZoneParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Visibility != nil {
+		in, out := &in.Visibility, &out.Visibility
+		*out = new(string)
+		**out = **in
+	}
+	if in.DNSSECConfig != nil {
+		in, out := &in.DNSSECConfig, &out.DNSSECConfig
+		*out = new(ManagedZoneDNSSECConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrivateVisibilityConfig != nil {
+		in, out := &in.PrivateVisibilityConfig, &out.PrivateVisibilityConfig
+		*out = new(ManagedZonePrivateVisibilityConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ForwardingConfig != nil {
+		in, out := &in.ForwardingConfig, &out.ForwardingConfig
+		*out = new(ManagedZoneForwardingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PeeringConfig != nil {
+		in, out := &in.PeeringConfig, &out.PeeringConfig
+		*out = new(ManagedZonePeeringConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedZoneParameters.
+func (in *ManagedZoneParameters) DeepCopy() *ManagedZoneParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedZoneParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedZonePeeringConfig) DeepCopyInto(out *ManagedZonePeeringConfig) {
+	*out = *in
+	in.TargetNetwork.DeepCopyInto(&out.TargetNetwork)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedZonePeeringConfig.
+func (in *ManagedZonePeeringConfig) DeepCopy() *ManagedZonePeeringConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedZonePeeringConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedZonePrivateVisibilityConfig) DeepCopyInto(out *ManagedZonePrivateVisibilityConfig) {
+	*out = *in
+	if in.Networks != nil {
+		in, out := &in.Networks, &out.Networks
+		*out = make([]VPCNetwork, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedZonePrivateVisibilityConfig.
+func (in *ManagedZonePrivateVisibilityConfig) DeepCopy() *ManagedZonePrivateVisibilityConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedZonePrivateVisibilityConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedZoneSpec) DeepCopyInto(out *ManagedZoneSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedZoneSpec.
+func (in *ManagedZoneSpec) DeepCopy() *ManagedZoneSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedZoneSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedZoneStatus) DeepCopyInto(out *ManagedZoneStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedZoneStatus.
+func (in *ManagedZoneStatus) DeepCopy() *ManagedZoneStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedZoneStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Policy) DeepCopyInto(out *Policy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Policy.
+func (in *Policy) DeepCopy() *Policy {
+	if in == nil {
+		return nil
+	}
+	out := new(Policy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Policy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyAlternativeNameServerConfig) DeepCopyInto(out *PolicyAlternativeNameServerConfig) {
+	*out = *in
+	if in.TargetNameServers != nil {
+		in, out := &in.TargetNameServers, &out.TargetNameServers
+		*out = make([]ForwardingTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyAlternativeNameServerConfig.
+func (in *PolicyAlternativeNameServerConfig) DeepCopy() *PolicyAlternativeNameServerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyAlternativeNameServerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyList) DeepCopyInto(out *PolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Policy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyList.
+func (in *PolicyList) DeepCopy() *PolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyObservation) DeepCopyInto(out *PolicyObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyObservation.
+func (in *PolicyObservation) DeepCopy() *PolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyParameters) DeepCopyInto(out *PolicyParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.EnableInboundForwarding != nil {
+		in, out := &in.EnableInboundForwarding, &out.EnableInboundForwarding
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableLogging != nil {
+		in, out := &in.EnableLogging, &out.EnableLogging
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AlternativeNameServerConfig != nil {
+		in, out := &in.AlternativeNameServerConfig, &out.AlternativeNameServerConfig
+		*out = new(PolicyAlternativeNameServerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Networks != nil {
+		in, out := &in.Networks, &out.Networks
+		*out = make([]VPCNetwork, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyParameters.
+func (in *PolicyParameters) DeepCopy() *PolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicySpec) DeepCopyInto(out *PolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySpec.
+func (in *PolicySpec) DeepCopy() *PolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyStatus) DeepCopyInto(out *PolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyStatus.
+func (in *PolicyStatus) DeepCopy() *PolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceRecordSet) DeepCopyInto(out *ResourceRecordSet) {
 	*out = *in
@@ -33,18 +563,166 @@ func (in *ResourceRecordSet) DeepCopyInto(out *ResourceRecordSet) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecordSet.
-func (in *ResourceRecordSet) DeepCopy() *ResourceRecordSet {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecordSet.
+func (in *ResourceRecordSet) DeepCopy() *ResourceRecordSet {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRecordSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceRecordSet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceRecordSetList) DeepCopyInto(out *ResourceRecordSetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResourceRecordSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecordSetList.
+func (in *ResourceRecordSetList) DeepCopy() *ResourceRecordSetList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRecordSetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceRecordSetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceRecordSetObservation) DeepCopyInto(out *ResourceRecordSetObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecordSetObservation.
+func (in *ResourceRecordSetObservation) DeepCopy() *ResourceRecordSetObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRecordSetObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceRecordSetParameters) DeepCopyInto(out *ResourceRecordSetParameters) {
+	*out = *in
+	if in.ManagedZoneRef != nil {
+		in, out := &in.ManagedZoneRef, &out.ManagedZoneRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ManagedZoneSelector != nil {
+		in, out := &in.ManagedZoneSelector, &out.ManagedZoneSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RRDatas != nil {
+		in, out := &in.RRDatas, &out.RRDatas
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SignatureRRDatas != nil {
+		in, out := &in.SignatureRRDatas, &out.SignatureRRDatas
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RoutingPolicy != nil {
+		in, out := &in.RoutingPolicy, &out.RoutingPolicy
+		*out = new(RoutingPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecordSetParameters.
+func (in *ResourceRecordSetParameters) DeepCopy() *ResourceRecordSetParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRecordSetParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceRecordSetSpec) DeepCopyInto(out *ResourceRecordSetSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecordSetSpec.
+func (in *ResourceRecordSetSpec) DeepCopy() *ResourceRecordSetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRecordSetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceRecordSetStatus) DeepCopyInto(out *ResourceRecordSetStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecordSetStatus.
+func (in *ResourceRecordSetStatus) DeepCopy() *ResourceRecordSetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRecordSetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResponsePolicy) DeepCopyInto(out *ResponsePolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponsePolicy.
+func (in *ResponsePolicy) DeepCopy() *ResponsePolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceRecordSet)
+	out := new(ResponsePolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ResourceRecordSet) DeepCopyObject() runtime.Object {
+func (in *ResponsePolicy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -52,31 +730,31 @@ func (in *ResourceRecordSet) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceRecordSetList) DeepCopyInto(out *ResourceRecordSetList) {
+func (in *ResponsePolicyList) DeepCopyInto(out *ResponsePolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ResourceRecordSet, len(*in))
+		*out = make([]ResponsePolicy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecordSetList.
-func (in *ResourceRecordSetList) DeepCopy() *ResourceRecordSetList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponsePolicyList.
+func (in *ResponsePolicyList) DeepCopy() *ResponsePolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceRecordSetList)
+	out := new(ResponsePolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ResourceRecordSetList) DeepCopyObject() runtime.Object {
+func (in *ResponsePolicyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -84,75 +762,331 @@ func (in *ResourceRecordSetList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceRecordSetObservation) DeepCopyInto(out *ResourceRecordSetObservation) {
+func (in *ResponsePolicyObservation) DeepCopyInto(out *ResponsePolicyObservation) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecordSetObservation.
-func (in *ResourceRecordSetObservation) DeepCopy() *ResourceRecordSetObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponsePolicyObservation.
+func (in *ResponsePolicyObservation) DeepCopy() *ResponsePolicyObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceRecordSetObservation)
+	out := new(ResponsePolicyObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceRecordSetParameters) DeepCopyInto(out *ResourceRecordSetParameters) {
+func (in *ResponsePolicyParameters) DeepCopyInto(out *ResponsePolicyParameters) {
 	*out = *in
-	if in.RRDatas != nil {
-		in, out := &in.RRDatas, &out.RRDatas
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
 	}
-	if in.SignatureRRDatas != nil {
-		in, out := &in.SignatureRRDatas, &out.SignatureRRDatas
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Networks != nil {
+		in, out := &in.Networks, &out.Networks
+		*out = make([]VPCNetwork, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.GKEClusters != nil {
+		in, out := &in.GKEClusters, &out.GKEClusters
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecordSetParameters.
-func (in *ResourceRecordSetParameters) DeepCopy() *ResourceRecordSetParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponsePolicyParameters.
+func (in *ResponsePolicyParameters) DeepCopy() *ResponsePolicyParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceRecordSetParameters)
+	out := new(ResponsePolicyParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceRecordSetSpec) DeepCopyInto(out *ResourceRecordSetSpec) {
+func (in *ResponsePolicyRule) DeepCopyInto(out *ResponsePolicyRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponsePolicyRule.
+func (in *ResponsePolicyRule) DeepCopy() *ResponsePolicyRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ResponsePolicyRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResponsePolicyRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResponsePolicyRuleList) DeepCopyInto(out *ResponsePolicyRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResponsePolicyRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponsePolicyRuleList.
+func (in *ResponsePolicyRuleList) DeepCopy() *ResponsePolicyRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResponsePolicyRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResponsePolicyRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResponsePolicyRuleObservation) DeepCopyInto(out *ResponsePolicyRuleObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponsePolicyRuleObservation.
+func (in *ResponsePolicyRuleObservation) DeepCopy() *ResponsePolicyRuleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ResponsePolicyRuleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResponsePolicyRuleParameters) DeepCopyInto(out *ResponsePolicyRuleParameters) {
+	*out = *in
+	if in.ResponsePolicyRef != nil {
+		in, out := &in.ResponsePolicyRef, &out.ResponsePolicyRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ResponsePolicySelector != nil {
+		in, out := &in.ResponsePolicySelector, &out.ResponsePolicySelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Behavior != nil {
+		in, out := &in.Behavior, &out.Behavior
+		*out = new(string)
+		**out = **in
+	}
+	if in.LocalData != nil {
+		in, out := &in.LocalData, &out.LocalData
+		*out = make([]LocalRecordSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponsePolicyRuleParameters.
+func (in *ResponsePolicyRuleParameters) DeepCopy() *ResponsePolicyRuleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ResponsePolicyRuleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResponsePolicyRuleSpec) DeepCopyInto(out *ResponsePolicyRuleSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecordSetSpec.
-func (in *ResourceRecordSetSpec) DeepCopy() *ResourceRecordSetSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponsePolicyRuleSpec.
+func (in *ResponsePolicyRuleSpec) DeepCopy() *ResponsePolicyRuleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceRecordSetSpec)
+	out := new(ResponsePolicyRuleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceRecordSetStatus) DeepCopyInto(out *ResourceRecordSetStatus) {
+func (in *ResponsePolicyRuleStatus) DeepCopyInto(out *ResponsePolicyRuleStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecordSetStatus.
-func (in *ResourceRecordSetStatus) DeepCopy() *ResourceRecordSetStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponsePolicyRuleStatus.
+func (in *ResponsePolicyRuleStatus) DeepCopy() *ResponsePolicyRuleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceRecordSetStatus)
+	out := new(ResponsePolicyRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResponsePolicySpec) DeepCopyInto(out *ResponsePolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponsePolicySpec.
+func (in *ResponsePolicySpec) DeepCopy() *ResponsePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResponsePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResponsePolicyStatus) DeepCopyInto(out *ResponsePolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponsePolicyStatus.
+func (in *ResponsePolicyStatus) DeepCopy() *ResponsePolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResponsePolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoutingPolicy) DeepCopyInto(out *RoutingPolicy) {
+	*out = *in
+	if in.Geo != nil {
+		in, out := &in.Geo, &out.Geo
+		*out = new(GeoPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WRR != nil {
+		in, out := &in.WRR, &out.WRR
+		*out = new(WRRPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingPolicy.
+func (in *RoutingPolicy) DeepCopy() *RoutingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RoutingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCNetwork) DeepCopyInto(out *VPCNetwork) {
+	*out = *in
+	if in.NetworkURL != nil {
+		in, out := &in.NetworkURL, &out.NetworkURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkRef != nil {
+		in, out := &in.NetworkRef, &out.NetworkRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.NetworkSelector != nil {
+		in, out := &in.NetworkSelector, &out.NetworkSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCNetwork.
+func (in *VPCNetwork) DeepCopy() *VPCNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WRRPolicy) DeepCopyInto(out *WRRPolicy) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WRRPolicyItem, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WRRPolicy.
+func (in *WRRPolicy) DeepCopy() *WRRPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(WRRPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WRRPolicyItem) DeepCopyInto(out *WRRPolicyItem) {
+	*out = *in
+	if in.RRDatas != nil {
+		in, out := &in.RRDatas, &out.RRDatas
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WRRPolicyItem.
+func (in *WRRPolicyItem) DeepCopy() *WRRPolicyItem {
+	if in == nil {
+		return nil
+	}
+	out := new(WRRPolicyItem)
 	in.DeepCopyInto(out)
 	return out
 }
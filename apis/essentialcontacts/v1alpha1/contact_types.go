@@ -0,0 +1,137 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Notification categories.
+const (
+	NotificationCategoryAll                = "ALL"
+	NotificationCategorySuspension         = "SUSPENSION"
+	NotificationCategorySecurity           = "SECURITY"
+	NotificationCategoryTechnical          = "TECHNICAL"
+	NotificationCategoryBilling            = "BILLING"
+	NotificationCategoryLegal              = "LEGAL"
+	NotificationCategoryProductUpdates     = "PRODUCT_UPDATES"
+	NotificationCategoryTechnicalIncidents = "TECHNICAL_INCIDENTS"
+)
+
+// ContactParameters define the desired state of an essential contact. Its
+// external name is the contact ID, which is assigned by Google Cloud:
+// https://cloud.google.com/resource-manager/docs/reference/essentialcontacts/rest/v1/projects.contacts
+type ContactParameters struct {
+	// Parent the contact is attached to, in the form projects/{project_id},
+	// folders/{folder_id} or organizations/{organization_id}. Defaults to the
+	// project of the provider config.
+	// +kubebuilder:validation:Pattern=`^(projects/[a-z][-a-z0-9]*|folders/[0-9]+|organizations/[0-9]+)$`
+	// +immutable
+	// +optional
+	Parent *string `json:"parent,omitempty"`
+
+	// ProjectRef references a Project and sets the parent to it.
+	// +immutable
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and sets the parent
+	// to it.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// FolderRef references a Folder and sets the parent to it.
+	// +immutable
+	// +optional
+	FolderRef *xpv1.Reference `json:"folderRef,omitempty"`
+
+	// FolderSelector selects a reference to a Folder and sets the parent to
+	// it.
+	// +optional
+	FolderSelector *xpv1.Selector `json:"folderSelector,omitempty"`
+
+	// Email address to send notifications to. It does not need to belong to
+	// a Google Account.
+	// +immutable
+	Email string `json:"email"`
+
+	// NotificationCategorySubscriptions are the categories of notifications
+	// the contact receives: ALL, SUSPENSION, SECURITY, TECHNICAL, BILLING,
+	// LEGAL, PRODUCT_UPDATES or TECHNICAL_INCIDENTS.
+	// +kubebuilder:validation:MinItems=1
+	NotificationCategorySubscriptions []string `json:"notificationCategorySubscriptions"`
+
+	// LanguageTag is the preferred language for notifications as an ISO
+	// 639-1 language code.
+	// +kubebuilder:default=en
+	// +optional
+	LanguageTag *string `json:"languageTag,omitempty"`
+}
+
+// ContactObservation is used to show the observed state of a Contact.
+type ContactObservation struct {
+	// Name of the contact, in the form {parent}/contacts/{contact_id}.
+	Name string `json:"name,omitempty"`
+
+	// ValidationState of the contact, i.e. whether it is the correct
+	// recipient for notifications of its parent.
+	ValidationState string `json:"validationState,omitempty"`
+
+	// ValidateTime is the last time the validation state was updated.
+	ValidateTime string `json:"validateTime,omitempty"`
+}
+
+// A ContactSpec defines the desired state of a Contact.
+type ContactSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ContactParameters `json:"forProvider"`
+}
+
+// A ContactStatus represents the observed state of a Contact.
+type ContactStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ContactObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Contact is a managed resource that represents an essential contact of a Google Cloud project, folder or organization.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EMAIL",type="string",JSONPath=".spec.forProvider.email"
+// +kubebuilder:printcolumn:name="VALIDATION",type="string",JSONPath=".status.atProvider.validationState"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Contact struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ContactSpec   `json:"spec"`
+	Status ContactStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ContactList contains a list of Contact
+type ContactList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Contact `json:"items"`
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	cloudfunctionsv1alpha1 "github.com/crossplane/provider-gcp/apis/cloudfunctions/v1alpha1"
+	cloudrunv1alpha1 "github.com/crossplane/provider-gcp/apis/cloudrun/v1alpha1"
+	containerv1beta2 "github.com/crossplane/provider-gcp/apis/container/v1beta2"
+	iamv1alpha1 "github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+)
+
+// ResolveReferences of this Trigger
+func (in *Trigger) ResolveReferences(ctx context.Context, c client.Reader) error { // nolint:gocyclo
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.serviceAccount
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.ServiceAccount),
+		Reference:    in.Spec.ForProvider.ServiceAccountRef,
+		Selector:     in.Spec.ForProvider.ServiceAccountSelector,
+		To:           reference.To{Managed: &iamv1alpha1.ServiceAccount{}, List: &iamv1alpha1.ServiceAccountList{}},
+		Extract:      iamv1alpha1.ServiceAccountEmail(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.serviceAccount")
+	}
+	in.Spec.ForProvider.ServiceAccount = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.ServiceAccountRef = rsp.ResolvedReference
+
+	d := &in.Spec.ForProvider.Destination
+
+	// Resolve spec.forProvider.destination.cloudFunction
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(d.CloudFunction),
+		Reference:    d.CloudFunctionRef,
+		Selector:     d.CloudFunctionSelector,
+		To:           reference.To{Managed: &cloudfunctionsv1alpha1.Function{}, List: &cloudfunctionsv1alpha1.FunctionList{}},
+		Extract:      cloudfunctionsv1alpha1.FunctionName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.destination.cloudFunction")
+	}
+	d.CloudFunction = reference.ToPtrValue(rsp.ResolvedValue)
+	d.CloudFunctionRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.destination.cloudRun.service
+	if d.CloudRun != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(d.CloudRun.Service),
+			Reference:    d.CloudRun.ServiceRef,
+			Selector:     d.CloudRun.ServiceSelector,
+			To:           reference.To{Managed: &cloudrunv1alpha1.Service{}, List: &cloudrunv1alpha1.ServiceList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.destination.cloudRun.service")
+		}
+		d.CloudRun.Service = reference.ToPtrValue(rsp.ResolvedValue)
+		d.CloudRun.ServiceRef = rsp.ResolvedReference
+	}
+
+	// Resolve spec.forProvider.destination.gke.cluster
+	if d.GKE != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(d.GKE.Cluster),
+			Reference:    d.GKE.ClusterRef,
+			Selector:     d.GKE.ClusterSelector,
+			To:           reference.To{Managed: &containerv1beta2.Cluster{}, List: &containerv1beta2.ClusterList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.destination.gke.cluster")
+		}
+		d.GKE.Cluster = reference.ToPtrValue(rsp.ResolvedValue)
+		d.GKE.ClusterRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
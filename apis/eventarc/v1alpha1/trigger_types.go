@@ -0,0 +1,239 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TriggerParameters define the desired state of an Eventarc Trigger. Most
+// fields map directly to a Trigger:
+// https://cloud.google.com/eventarc/docs/reference/rest/v1/projects.locations.triggers#Trigger
+type TriggerParameters struct {
+	// Location in which to create this trigger, e.g. us-central1.
+	// +immutable
+	Location string `json:"location"`
+
+	// EventFilters select the events that are routed to the destination.
+	// All filters must match for an event to be routed.
+	// +kubebuilder:validation:MinItems=1
+	EventFilters []EventFilter `json:"eventFilters"`
+
+	// Destination to which matching events are routed.
+	Destination Destination `json:"destination"`
+
+	// ServiceAccount is the email address of the IAM service account whose
+	// identity is used to invoke the destination.
+	// +optional
+	ServiceAccount *string `json:"serviceAccount,omitempty"`
+
+	// ServiceAccountRef references a ServiceAccount to retrieve its email
+	// address.
+	// +optional
+	ServiceAccountRef *xpv1.Reference `json:"serviceAccountRef,omitempty"`
+
+	// ServiceAccountSelector selects a reference to a ServiceAccount to
+	// retrieve its email address.
+	// +optional
+	ServiceAccountSelector *xpv1.Selector `json:"serviceAccountSelector,omitempty"`
+
+	// EventDataContentType is the content type of the event data that is
+	// delivered to the destination, e.g. application/json.
+	// +optional
+	EventDataContentType *string `json:"eventDataContentType,omitempty"`
+
+	// Labels to apply to the trigger.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// EventFilter matches events by one of their CloudEvents attributes.
+type EventFilter struct {
+	// Attribute is the name of the CloudEvents attribute, e.g. type.
+	Attribute string `json:"attribute"`
+
+	// Value the attribute must have, e.g.
+	// google.cloud.storage.object.v1.finalized.
+	Value string `json:"value"`
+
+	// Operator used to match the value. The value must match exactly if
+	// this is not set.
+	// +optional
+	// +kubebuilder:validation:Enum=match-path-pattern
+	Operator *string `json:"operator,omitempty"`
+}
+
+// Destination to which the events of a trigger are routed. Exactly one of
+// CloudRun, CloudFunction or GKE must be set.
+type Destination struct {
+	// CloudRun routes events to a Cloud Run service.
+	// +optional
+	CloudRun *CloudRunDestination `json:"cloudRun,omitempty"`
+
+	// CloudFunction is the fully qualified name of a Cloud Function (2nd
+	// gen) to route events to, in the format
+	// projects/{project}/locations/{location}/functions/{function}.
+	// +optional
+	CloudFunction *string `json:"cloudFunction,omitempty"`
+
+	// CloudFunctionRef references a Function to retrieve its name.
+	// +optional
+	CloudFunctionRef *xpv1.Reference `json:"cloudFunctionRef,omitempty"`
+
+	// CloudFunctionSelector selects a reference to a Function to retrieve
+	// its name.
+	// +optional
+	CloudFunctionSelector *xpv1.Selector `json:"cloudFunctionSelector,omitempty"`
+
+	// GKE routes events to a Kubernetes service in a GKE cluster.
+	// +optional
+	GKE *GKEDestination `json:"gke,omitempty"`
+}
+
+// CloudRunDestination routes events to a Cloud Run service.
+type CloudRunDestination struct {
+	// Service is the name of the Cloud Run service.
+	// +optional
+	Service *string `json:"service,omitempty"`
+
+	// ServiceRef references a Cloud Run Service to retrieve its name.
+	// +optional
+	ServiceRef *xpv1.Reference `json:"serviceRef,omitempty"`
+
+	// ServiceSelector selects a reference to a Cloud Run Service to
+	// retrieve its name.
+	// +optional
+	ServiceSelector *xpv1.Selector `json:"serviceSelector,omitempty"`
+
+	// Region in which the service is deployed, e.g. us-central1.
+	Region string `json:"region"`
+
+	// Path on the service to which events are sent, e.g. /events.
+	// +optional
+	Path *string `json:"path,omitempty"`
+}
+
+// GKEDestination routes events to a Kubernetes service in a GKE cluster.
+type GKEDestination struct {
+	// Cluster is the name of the GKE cluster.
+	// +optional
+	Cluster *string `json:"cluster,omitempty"`
+
+	// ClusterRef references a GKE Cluster to retrieve its name.
+	// +optional
+	ClusterRef *xpv1.Reference `json:"clusterRef,omitempty"`
+
+	// ClusterSelector selects a reference to a GKE Cluster to retrieve its
+	// name.
+	// +optional
+	ClusterSelector *xpv1.Selector `json:"clusterSelector,omitempty"`
+
+	// Location of the GKE cluster, e.g. us-central1.
+	Location string `json:"location"`
+
+	// Namespace in which the Kubernetes service runs.
+	Namespace string `json:"namespace"`
+
+	// Service is the name of the Kubernetes service.
+	Service string `json:"service"`
+
+	// Path on the service to which events are sent, e.g. /events.
+	// +optional
+	Path *string `json:"path,omitempty"`
+}
+
+// TriggerObservation is used to show the observed state of a Trigger.
+type TriggerObservation struct {
+	// Name is the fully qualified name of the trigger.
+	Name string `json:"name,omitempty"`
+
+	// UID of the trigger.
+	UID string `json:"uid,omitempty"`
+
+	// CreateTime is the time the trigger was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the trigger was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+
+	// Transport through which events are delivered to the destination.
+	Transport *TransportObservation `json:"transport,omitempty"`
+
+	// Conditions of the trigger, keyed by the part of the trigger they
+	// describe.
+	Conditions map[string]StateCondition `json:"conditions,omitempty"`
+}
+
+// TransportObservation is the Pub/Sub transport of a trigger.
+type TransportObservation struct {
+	// Topic is the fully qualified name of the Pub/Sub topic events are
+	// published to.
+	Topic string `json:"topic,omitempty"`
+
+	// Subscription is the fully qualified name of the Pub/Sub subscription
+	// that delivers events to the destination.
+	Subscription string `json:"subscription,omitempty"`
+}
+
+// StateCondition describes the state of a part of a trigger.
+type StateCondition struct {
+	// Code is the status code of the condition, e.g. OK.
+	Code string `json:"code,omitempty"`
+
+	// Message describes the condition.
+	Message string `json:"message,omitempty"`
+}
+
+// A TriggerSpec defines the desired state of a Trigger.
+type TriggerSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TriggerParameters `json:"forProvider"`
+}
+
+// A TriggerStatus represents the observed state of a Trigger.
+type TriggerStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TriggerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Trigger is a managed resource that represents an Eventarc Trigger.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TOPIC",type="string",JSONPath=".status.atProvider.transport.topic"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Trigger struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TriggerSpec   `json:"spec"`
+	Status TriggerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TriggerList contains a list of Trigger
+type TriggerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Trigger `json:"items"`
+}
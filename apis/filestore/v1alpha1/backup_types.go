@@ -0,0 +1,151 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known Backup states.
+const (
+	BackupStateCreating   = "CREATING"
+	BackupStateFinalizing = "FINALIZING"
+	BackupStateReady      = "READY"
+	BackupStateDeleting   = "DELETING"
+)
+
+// BackupParameters define the desired state of a Filestore backup. Most
+// fields map directly to a Backup:
+// https://cloud.google.com/filestore/docs/reference/rest/v1/projects.locations.backups#Backup
+type BackupParameters struct {
+	// Project the backup belongs to. Defaults to the project of the
+	// provider config.
+	// +immutable
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// ProjectRef references a Project and retrieves its external name.
+	// +immutable
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and retrieves its
+	// external name.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// Location of the backup. This must be a region.
+	// +immutable
+	Location string `json:"location"`
+
+	// Description of the backup.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels to apply to the backup.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// SourceInstance is the resource name of the instance the backup is
+	// taken of, in the form
+	// projects/{project}/locations/{location}/instances/{instance}.
+	// +immutable
+	// +optional
+	SourceInstance *string `json:"sourceInstance,omitempty"`
+
+	// SourceInstanceRef references an Instance and retrieves its resource
+	// name.
+	// +immutable
+	// +optional
+	SourceInstanceRef *xpv1.Reference `json:"sourceInstanceRef,omitempty"`
+
+	// SourceInstanceSelector selects a reference to an Instance.
+	// +optional
+	SourceInstanceSelector *xpv1.Selector `json:"sourceInstanceSelector,omitempty"`
+
+	// SourceFileShare is the name of the file share of the source instance
+	// that is backed up.
+	// +immutable
+	SourceFileShare string `json:"sourceFileShare"`
+}
+
+// BackupObservation is used to show the observed state of a Backup.
+type BackupObservation struct {
+	// Name is the fully qualified name of the backup.
+	Name string `json:"name,omitempty"`
+
+	// State of the backup.
+	State string `json:"state,omitempty"`
+
+	// CreateTime is the time the backup was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// CapacityGB is the capacity of the source file share when the backup
+	// was taken.
+	CapacityGB int64 `json:"capacityGb,omitempty"`
+
+	// StorageBytes is the size of the storage used by the backup.
+	StorageBytes int64 `json:"storageBytes,omitempty"`
+
+	// DownloadBytes is the amount of bytes that will be downloaded when the
+	// backup is restored.
+	DownloadBytes int64 `json:"downloadBytes,omitempty"`
+
+	// SourceInstanceTier is the tier of the source instance.
+	SourceInstanceTier string `json:"sourceInstanceTier,omitempty"`
+}
+
+// A BackupSpec defines the desired state of a Backup.
+type BackupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BackupParameters `json:"forProvider"`
+}
+
+// A BackupStatus represents the observed state of a Backup.
+type BackupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BackupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Backup is a managed resource that represents a backup of the file share of a Filestore instance.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.forProvider.location"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Backup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BackupSpec   `json:"spec"`
+	Status BackupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BackupList contains a list of Backup
+type BackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Backup `json:"items"`
+}
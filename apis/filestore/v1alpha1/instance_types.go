@@ -0,0 +1,268 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known Instance states.
+const (
+	InstanceStateCreating  = "CREATING"
+	InstanceStateReady     = "READY"
+	InstanceStateRepairing = "REPAIRING"
+	InstanceStateDeleting  = "DELETING"
+	InstanceStateRestoring = "RESTORING"
+)
+
+// Instance connection secret keys.
+const (
+	// InstanceSecretMountPathKey is the path under which the file share is
+	// exported, i.e. the remote path of an NFS mount. The IP address to mount
+	// from is published as the endpoint.
+	InstanceSecretMountPathKey = "mountPath"
+)
+
+// InstanceParameters define the desired state of a Filestore instance. Most
+// fields map directly to an Instance:
+// https://cloud.google.com/filestore/docs/reference/rest/v1/projects.locations.instances#Instance
+type InstanceParameters struct {
+	// Project the instance belongs to. Defaults to the project of the
+	// provider config.
+	// +immutable
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// ProjectRef references a Project and retrieves its external name.
+	// +immutable
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and retrieves its
+	// external name.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// Location of the instance. This is a zone for BASIC_HDD, BASIC_SSD,
+	// HIGH_SCALE_SSD and ZONAL instances and a region for ENTERPRISE and
+	// REGIONAL instances.
+	// +immutable
+	Location string `json:"location"`
+
+	// Tier of the instance.
+	// +immutable
+	// +kubebuilder:validation:Enum=BASIC_HDD;BASIC_SSD;HIGH_SCALE_SSD;ENTERPRISE;ZONAL;REGIONAL
+	Tier string `json:"tier"`
+
+	// Description of the instance.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels to apply to the instance.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// FileShare served by the instance. Filestore instances serve exactly
+	// one file share.
+	FileShare FileShareConfig `json:"fileShare"`
+
+	// Networks the instance is connected to. Only one network is supported.
+	// +immutable
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=1
+	Networks []NetworkConfig `json:"networks"`
+
+	// KMSKeyName is the resource name of the Cloud KMS key the instance
+	// data is encrypted with, in the form
+	// projects/{project}/locations/{location}/keyRings/{keyring}/cryptoKeys/{key}.
+	// +immutable
+	// +optional
+	KMSKeyName *string `json:"kmsKeyName,omitempty"`
+
+	// KMSKeyNameRef references a CryptoKey and retrieves its resource name.
+	// +immutable
+	// +optional
+	KMSKeyNameRef *xpv1.Reference `json:"kmsKeyNameRef,omitempty"`
+
+	// KMSKeyNameSelector selects a reference to a CryptoKey.
+	// +optional
+	KMSKeyNameSelector *xpv1.Selector `json:"kmsKeyNameSelector,omitempty"`
+}
+
+// FileShareConfig configures the file share of an instance.
+type FileShareConfig struct {
+	// Name of the file share. This is the path the share is exported under.
+	// +immutable
+	// +kubebuilder:validation:MaxLength=32
+	Name string `json:"name"`
+
+	// CapacityGB is the size of the file share in GiB. The capacity of a
+	// file share can only be increased after it was created.
+	// +kubebuilder:validation:Minimum=1
+	CapacityGB int64 `json:"capacityGb"`
+
+	// SourceBackup is the resource name of the backup the file share is
+	// restored from, in the form
+	// projects/{project}/locations/{location}/backups/{backup}.
+	// +immutable
+	// +optional
+	SourceBackup *string `json:"sourceBackup,omitempty"`
+
+	// SourceBackupRef references a Backup and retrieves its resource name.
+	// +immutable
+	// +optional
+	SourceBackupRef *xpv1.Reference `json:"sourceBackupRef,omitempty"`
+
+	// SourceBackupSelector selects a reference to a Backup.
+	// +optional
+	SourceBackupSelector *xpv1.Selector `json:"sourceBackupSelector,omitempty"`
+
+	// NFSExportOptions restrict which clients may access the file share and
+	// how. All clients have read and write access as root if this is not
+	// set.
+	// +optional
+	NFSExportOptions []NFSExportOptions `json:"nfsExportOptions,omitempty"`
+}
+
+// NFSExportOptions configure the access of a set of clients to a file share.
+type NFSExportOptions struct {
+	// IPRanges of the clients these options apply to, as IPv4 addresses or
+	// CIDR ranges.
+	IPRanges []string `json:"ipRanges"`
+
+	// AccessMode of the clients. Defaults to READ_WRITE.
+	// +optional
+	// +kubebuilder:validation:Enum=READ_ONLY;READ_WRITE
+	AccessMode *string `json:"accessMode,omitempty"`
+
+	// SquashMode of the clients. Defaults to NO_ROOT_SQUASH.
+	// +optional
+	// +kubebuilder:validation:Enum=NO_ROOT_SQUASH;ROOT_SQUASH
+	SquashMode *string `json:"squashMode,omitempty"`
+
+	// AnonUID is the user ID root is mapped to when SquashMode is
+	// ROOT_SQUASH.
+	// +optional
+	AnonUID *int64 `json:"anonUid,omitempty"`
+
+	// AnonGID is the group ID root is mapped to when SquashMode is
+	// ROOT_SQUASH.
+	// +optional
+	AnonGID *int64 `json:"anonGid,omitempty"`
+}
+
+// NetworkConfig connects an instance to a VPC network.
+type NetworkConfig struct {
+	// Network is the name of the VPC network the instance is connected to.
+	// +immutable
+	// +optional
+	Network *string `json:"network,omitempty"`
+
+	// NetworkRef references a Network and retrieves its name.
+	// +immutable
+	// +optional
+	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to a Network.
+	// +optional
+	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
+
+	// Modes are the IP address modes of the network. Only MODE_IPV4 is
+	// supported.
+	// +immutable
+	// +optional
+	Modes []string `json:"modes,omitempty"`
+
+	// ReservedIPRange is the CIDR range or the name of an allocated IP
+	// address range the instance's IP addresses are taken from.
+	// +immutable
+	// +optional
+	ReservedIPRange *string `json:"reservedIpRange,omitempty"`
+
+	// ConnectMode of the network. Defaults to DIRECT_PEERING.
+	// +immutable
+	// +optional
+	// +kubebuilder:validation:Enum=DIRECT_PEERING;PRIVATE_SERVICE_ACCESS
+	ConnectMode *string `json:"connectMode,omitempty"`
+}
+
+// InstanceObservation is used to show the observed state of an Instance.
+type InstanceObservation struct {
+	// Name is the fully qualified name of the instance.
+	Name string `json:"name,omitempty"`
+
+	// State of the instance.
+	State string `json:"state,omitempty"`
+
+	// StatusMessage gives additional information about the state of the
+	// instance.
+	StatusMessage string `json:"statusMessage,omitempty"`
+
+	// CreateTime is the time the instance was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// Etag of the instance.
+	Etag string `json:"etag,omitempty"`
+
+	// CapacityGB is the current size of the file share in GiB.
+	CapacityGB int64 `json:"capacityGb,omitempty"`
+
+	// IPAddresses the instance is reachable at.
+	IPAddresses []string `json:"ipAddresses,omitempty"`
+}
+
+// A InstanceSpec defines the desired state of a Instance.
+type InstanceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InstanceParameters `json:"forProvider"`
+}
+
+// A InstanceStatus represents the observed state of a Instance.
+type InstanceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          InstanceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Instance is a managed resource that represents a Filestore instance, i.e. a managed NFS file server.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TIER",type="string",JSONPath=".spec.forProvider.tier"
+// +kubebuilder:printcolumn:name="CAPACITY",type="integer",JSONPath=".spec.forProvider.fileShare.capacityGb"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Instance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InstanceSpec   `json:"spec"`
+	Status InstanceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InstanceList contains a list of Instance
+type InstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Instance `json:"items"`
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	computev1beta1 "github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	kmsv1alpha1 "github.com/crossplane/provider-gcp/apis/kms/v1alpha1"
+	resourcemanagerv1alpha1 "github.com/crossplane/provider-gcp/apis/resourcemanager/v1alpha1"
+)
+
+// InstanceName extracts the fully qualified name of an Instance.
+func InstanceName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		i, ok := mg.(*Instance)
+		if !ok {
+			return ""
+		}
+		return i.Status.AtProvider.Name
+	}
+}
+
+// BackupName extracts the fully qualified name of a Backup.
+func BackupName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		b, ok := mg.(*Backup)
+		if !ok {
+			return ""
+		}
+		return b.Status.AtProvider.Name
+	}
+}
+
+// ResolveReferences of this Instance
+func (in *Instance) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.project
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Project),
+		Reference:    in.Spec.ForProvider.ProjectRef,
+		Selector:     in.Spec.ForProvider.ProjectSelector,
+		To:           reference.To{Managed: &resourcemanagerv1alpha1.Project{}, List: &resourcemanagerv1alpha1.ProjectList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.project")
+	}
+	in.Spec.ForProvider.Project = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.ProjectRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.kmsKeyName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.KMSKeyName),
+		Reference:    in.Spec.ForProvider.KMSKeyNameRef,
+		Selector:     in.Spec.ForProvider.KMSKeyNameSelector,
+		To:           reference.To{Managed: &kmsv1alpha1.CryptoKey{}, List: &kmsv1alpha1.CryptoKeyList{}},
+		Extract:      kmsv1alpha1.CryptoKeyRRN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.kmsKeyName")
+	}
+	in.Spec.ForProvider.KMSKeyName = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.KMSKeyNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.fileShare.sourceBackup
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.FileShare.SourceBackup),
+		Reference:    in.Spec.ForProvider.FileShare.SourceBackupRef,
+		Selector:     in.Spec.ForProvider.FileShare.SourceBackupSelector,
+		To:           reference.To{Managed: &Backup{}, List: &BackupList{}},
+		Extract:      BackupName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.fileShare.sourceBackup")
+	}
+	in.Spec.ForProvider.FileShare.SourceBackup = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.FileShare.SourceBackupRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.networks[*].network
+	for i := range in.Spec.ForProvider.Networks {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Networks[i].Network),
+			Reference:    in.Spec.ForProvider.Networks[i].NetworkRef,
+			Selector:     in.Spec.ForProvider.Networks[i].NetworkSelector,
+			To:           reference.To{Managed: &computev1beta1.Network{}, List: &computev1beta1.NetworkList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.networks[%d].network", i)
+		}
+		in.Spec.ForProvider.Networks[i].Network = reference.ToPtrValue(rsp.ResolvedValue)
+		in.Spec.ForProvider.Networks[i].NetworkRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
+
+// ResolveReferences of this Backup
+func (in *Backup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.project
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Project),
+		Reference:    in.Spec.ForProvider.ProjectRef,
+		Selector:     in.Spec.ForProvider.ProjectSelector,
+		To:           reference.To{Managed: &resourcemanagerv1alpha1.Project{}, List: &resourcemanagerv1alpha1.ProjectList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.project")
+	}
+	in.Spec.ForProvider.Project = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.ProjectRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.sourceInstance
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.SourceInstance),
+		Reference:    in.Spec.ForProvider.SourceInstanceRef,
+		Selector:     in.Spec.ForProvider.SourceInstanceSelector,
+		To:           reference.To{Managed: &Instance{}, List: &InstanceList{}},
+		Extract:      InstanceName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.sourceInstance")
+	}
+	in.Spec.ForProvider.SourceInstance = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.SourceInstanceRef = rsp.ResolvedReference
+
+	return nil
+}
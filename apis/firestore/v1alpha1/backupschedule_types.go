@@ -0,0 +1,124 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// BackupScheduleParameters define the desired state of a Cloud Firestore
+// BackupSchedule. Most fields map directly to a BackupSchedule:
+// https://cloud.google.com/firestore/docs/reference/rest/v1/projects.databases.backupSchedules#BackupSchedule
+type BackupScheduleParameters struct {
+	// Database is the ID of the database to back up.
+	// +optional
+	// +immutable
+	Database *string `json:"database,omitempty"`
+
+	// DatabaseRef references a Database to retrieve its ID.
+	// +optional
+	// +immutable
+	DatabaseRef *xpv1.Reference `json:"databaseRef,omitempty"`
+
+	// DatabaseSelector selects a reference to a Database.
+	// +optional
+	// +immutable
+	DatabaseSelector *xpv1.Selector `json:"databaseSelector,omitempty"`
+
+	// Retention is how long backups are kept after they are created, as a
+	// duration in seconds with up to nine fractional digits terminated by
+	// 's', e.g. "604800s" for 7 days.
+	Retention string `json:"retention"`
+
+	// DailyRecurrence schedules a backup every day. Exactly one of
+	// DailyRecurrence or WeeklyRecurrence must be set.
+	// +optional
+	// +immutable
+	DailyRecurrence *DailyRecurrence `json:"dailyRecurrence,omitempty"`
+
+	// WeeklyRecurrence schedules a backup every week on the given day.
+	// Exactly one of DailyRecurrence or WeeklyRecurrence must be set.
+	// +optional
+	// +immutable
+	WeeklyRecurrence *WeeklyRecurrence `json:"weeklyRecurrence,omitempty"`
+}
+
+// DailyRecurrence is a schedule that runs at a specific time every day.
+type DailyRecurrence struct{}
+
+// WeeklyRecurrence is a schedule that runs on a specific day of the week.
+type WeeklyRecurrence struct {
+	// Day of the week to run the backup on.
+	// +kubebuilder:validation:Enum=MONDAY;TUESDAY;WEDNESDAY;THURSDAY;FRIDAY;SATURDAY;SUNDAY
+	Day string `json:"day"`
+}
+
+// BackupScheduleObservation is used to show the observed state of a
+// BackupSchedule.
+type BackupScheduleObservation struct {
+	// Name is the fully qualified, server-assigned name of the backup
+	// schedule.
+	Name string `json:"name,omitempty"`
+
+	// CreateTime is the timestamp at which this backup schedule was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the timestamp at which this backup schedule was most
+	// recently updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// A BackupScheduleSpec defines the desired state of a BackupSchedule.
+type BackupScheduleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BackupScheduleParameters `json:"forProvider"`
+}
+
+// A BackupScheduleStatus represents the observed state of a BackupSchedule.
+type BackupScheduleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BackupScheduleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BackupSchedule is a managed resource that represents a schedule of
+// automated backups of a Cloud Firestore database.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="RETENTION",type="string",JSONPath=".spec.forProvider.retention"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type BackupSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BackupScheduleSpec   `json:"spec"`
+	Status BackupScheduleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BackupScheduleList contains a list of BackupSchedule
+type BackupScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BackupSchedule `json:"items"`
+}
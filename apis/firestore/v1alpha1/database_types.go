@@ -0,0 +1,145 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Database types.
+const (
+	DatabaseTypeNative    = "FIRESTORE_NATIVE"
+	DatabaseTypeDatastore = "DATASTORE_MODE"
+)
+
+// DatabaseParameters define the desired state of a Cloud Firestore Database.
+// Most fields map directly to a Database:
+// https://cloud.google.com/firestore/docs/reference/rest/v1/projects.databases#Database
+type DatabaseParameters struct {
+	// LocationID is the location of the database, e.g. nam5 or us-east1.
+	// Available locations are listed at
+	// https://cloud.google.com/firestore/docs/locations.
+	// +immutable
+	LocationID string `json:"locationId"`
+
+	// Type of the database. FIRESTORE_NATIVE databases are accessed through
+	// the Firestore APIs, DATASTORE_MODE databases through the Datastore
+	// APIs.
+	// +kubebuilder:validation:Enum=FIRESTORE_NATIVE;DATASTORE_MODE
+	Type string `json:"type"`
+
+	// ConcurrencyMode is the concurrency control mode to use for this
+	// database.
+	// +optional
+	// +kubebuilder:validation:Enum=OPTIMISTIC;PESSIMISTIC;OPTIMISTIC_WITH_ENTITY_GROUPS
+	ConcurrencyMode *string `json:"concurrencyMode,omitempty"`
+
+	// AppEngineIntegrationMode controls whether an App Engine application
+	// in the same region affects this database.
+	// +optional
+	// +kubebuilder:validation:Enum=ENABLED;DISABLED
+	AppEngineIntegrationMode *string `json:"appEngineIntegrationMode,omitempty"`
+
+	// PointInTimeRecoveryEnablement controls whether point-in-time recovery
+	// (PITR) is enabled. When enabled, reads are supported on versions of the
+	// data from within the past 7 days.
+	// +optional
+	// +kubebuilder:validation:Enum=POINT_IN_TIME_RECOVERY_ENABLED;POINT_IN_TIME_RECOVERY_DISABLED
+	PointInTimeRecoveryEnablement *string `json:"pointInTimeRecoveryEnablement,omitempty"`
+
+	// DeleteProtectionState controls whether the database can be deleted.
+	// A database with delete protection enabled cannot be deleted until it
+	// is disabled again.
+	// +optional
+	// +kubebuilder:validation:Enum=DELETE_PROTECTION_ENABLED;DELETE_PROTECTION_DISABLED
+	DeleteProtectionState *string `json:"deleteProtectionState,omitempty"`
+}
+
+// DatabaseObservation is used to show the observed state of a Database.
+type DatabaseObservation struct {
+	// Name is the fully qualified name of the database, in the form
+	// projects/{project}/databases/{database}.
+	Name string `json:"name,omitempty"`
+
+	// UID is the system-generated UUID4 for this database.
+	UID string `json:"uid,omitempty"`
+
+	// CreateTime is the timestamp at which this database was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the timestamp at which this database was most recently
+	// updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+
+	// KeyPrefix used in combination with the project ID to construct the
+	// application ID returned by the Datastore APIs on App Engine first
+	// generation runtimes.
+	KeyPrefix string `json:"keyPrefix,omitempty"`
+
+	// VersionRetentionPeriod is the period during which past versions of data
+	// are retained in the database.
+	VersionRetentionPeriod string `json:"versionRetentionPeriod,omitempty"`
+
+	// EarliestVersionTime is the earliest timestamp at which older versions
+	// of the data can be read from the database.
+	EarliestVersionTime string `json:"earliestVersionTime,omitempty"`
+
+	// Etag is computed by the server based on the value of other fields.
+	Etag string `json:"etag,omitempty"`
+}
+
+// A DatabaseSpec defines the desired state of a Database.
+type DatabaseSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DatabaseParameters `json:"forProvider"`
+}
+
+// A DatabaseStatus represents the observed state of a Database.
+type DatabaseStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DatabaseObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Database is a managed resource that represents a Cloud Firestore
+// database, in either Native or Datastore mode.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.forProvider.locationId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Database struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DatabaseSpec   `json:"spec"`
+	Status DatabaseStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DatabaseList contains a list of Database
+type DatabaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Database `json:"items"`
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known Index states.
+const (
+	IndexStateCreating    = "CREATING"
+	IndexStateReady       = "READY"
+	IndexStateNeedsRepair = "NEEDS_REPAIR"
+)
+
+// IndexParameters define the desired state of a Cloud Firestore composite
+// Index. Most fields map directly to an Index:
+// https://cloud.google.com/firestore/docs/reference/rest/v1/projects.databases.collectionGroups.indexes#Index
+type IndexParameters struct {
+	// Database is the ID of the database this index belongs to.
+	// +optional
+	// +immutable
+	Database *string `json:"database,omitempty"`
+
+	// DatabaseRef references a Database to retrieve its ID.
+	// +optional
+	// +immutable
+	DatabaseRef *xpv1.Reference `json:"databaseRef,omitempty"`
+
+	// DatabaseSelector selects a reference to a Database.
+	// +optional
+	// +immutable
+	DatabaseSelector *xpv1.Selector `json:"databaseSelector,omitempty"`
+
+	// CollectionGroup is the ID of the collection group this index applies
+	// to.
+	// +immutable
+	CollectionGroup string `json:"collectionGroup"`
+
+	// QueryScope of the index. COLLECTION indexes serve queries against a
+	// single collection, COLLECTION_GROUP indexes serve queries against all
+	// collections with the same collection ID.
+	// +immutable
+	// +kubebuilder:validation:Enum=COLLECTION;COLLECTION_GROUP;COLLECTION_RECURSIVE
+	QueryScope string `json:"queryScope"`
+
+	// APIScope is the API scope supported by this index.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=ANY_API;DATASTORE_MODE_API
+	APIScope *string `json:"apiScope,omitempty"`
+
+	// Fields supported by this index. Composite indexes require at least
+	// two fields.
+	// +immutable
+	// +kubebuilder:validation:MinItems=2
+	Fields []IndexField `json:"fields"`
+}
+
+// IndexField is a field in an index. Exactly one of Order or ArrayConfig
+// must be set.
+type IndexField struct {
+	// FieldPath is the path of the indexed field.
+	FieldPath string `json:"fieldPath"`
+
+	// Order in which the field is indexed.
+	// +optional
+	// +kubebuilder:validation:Enum=ASCENDING;DESCENDING
+	Order *string `json:"order,omitempty"`
+
+	// ArrayConfig indicates that this field supports operations on array
+	// values.
+	// +optional
+	// +kubebuilder:validation:Enum=CONTAINS
+	ArrayConfig *string `json:"arrayConfig,omitempty"`
+}
+
+// IndexObservation is used to show the observed state of an Index.
+type IndexObservation struct {
+	// Name is the fully qualified, server-assigned name of the index.
+	Name string `json:"name,omitempty"`
+
+	// State is the serving state of the index.
+	State string `json:"state,omitempty"`
+}
+
+// An IndexSpec defines the desired state of an Index.
+type IndexSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       IndexParameters `json:"forProvider"`
+}
+
+// An IndexStatus represents the observed state of an Index.
+type IndexStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          IndexObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Index is a managed resource that represents a Cloud Firestore composite
+// index. Indexes can not be updated; all of their fields are immutable.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Index struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IndexSpec   `json:"spec"`
+	Status IndexStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IndexList contains a list of Index
+type IndexList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Index `json:"items"`
+}
@@ -20,21 +20,61 @@ package apis
 import (
 	"k8s.io/apimachinery/pkg/runtime"
 
+	accesscontextmanagerv1alpha1 "github.com/crossplane/provider-gcp/apis/accesscontextmanager/v1alpha1"
+	alloydbv1alpha1 "github.com/crossplane/provider-gcp/apis/alloydb/v1alpha1"
+	apigatewayv1alpha1 "github.com/crossplane/provider-gcp/apis/apigateway/v1alpha1"
+	apigeev1alpha1 "github.com/crossplane/provider-gcp/apis/apigee/v1alpha1"
+	appenginev1alpha1 "github.com/crossplane/provider-gcp/apis/appengine/v1alpha1"
+	artifactregistryv1alpha1 "github.com/crossplane/provider-gcp/apis/artifactregistry/v1alpha1"
+	billingbudgetsv1alpha1 "github.com/crossplane/provider-gcp/apis/billingbudgets/v1alpha1"
+	binaryauthorizationv1alpha1 "github.com/crossplane/provider-gcp/apis/binaryauthorization/v1alpha1"
+	cachev1alpha1 "github.com/crossplane/provider-gcp/apis/cache/v1alpha1"
 	cachev1beta1 "github.com/crossplane/provider-gcp/apis/cache/v1beta1"
+	certificatemanagerv1alpha1 "github.com/crossplane/provider-gcp/apis/certificatemanager/v1alpha1"
+	cloudbuildv1alpha1 "github.com/crossplane/provider-gcp/apis/cloudbuild/v1alpha1"
+	cloudfunctionsv1alpha1 "github.com/crossplane/provider-gcp/apis/cloudfunctions/v1alpha1"
+	cloudrunv1alpha1 "github.com/crossplane/provider-gcp/apis/cloudrun/v1alpha1"
+	cloudschedulerv1alpha1 "github.com/crossplane/provider-gcp/apis/cloudscheduler/v1alpha1"
+	cloudtasksv1alpha1 "github.com/crossplane/provider-gcp/apis/cloudtasks/v1alpha1"
+	composerv1alpha1 "github.com/crossplane/provider-gcp/apis/composer/v1alpha1"
 	computev1alpha1 "github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	computev1alpha3 "github.com/crossplane/provider-gcp/apis/compute/v1alpha3"
 	computev1beta1 "github.com/crossplane/provider-gcp/apis/compute/v1beta1"
 	containerv1beta1 "github.com/crossplane/provider-gcp/apis/container/v1beta1"
 	containerv1beta2 "github.com/crossplane/provider-gcp/apis/container/v1beta2"
 	databasev1beta1 "github.com/crossplane/provider-gcp/apis/database/v1beta1"
+	datacatalogv1alpha1 "github.com/crossplane/provider-gcp/apis/datacatalog/v1alpha1"
+	dataflowv1alpha1 "github.com/crossplane/provider-gcp/apis/dataflow/v1alpha1"
+	dataprocv1alpha1 "github.com/crossplane/provider-gcp/apis/dataproc/v1alpha1"
+	datastreamv1alpha1 "github.com/crossplane/provider-gcp/apis/datastream/v1alpha1"
 	dnsv1alpha1 "github.com/crossplane/provider-gcp/apis/dns/v1alpha1"
+	essentialcontactsv1alpha1 "github.com/crossplane/provider-gcp/apis/essentialcontacts/v1alpha1"
+	eventarcv1alpha1 "github.com/crossplane/provider-gcp/apis/eventarc/v1alpha1"
+	filestorev1alpha1 "github.com/crossplane/provider-gcp/apis/filestore/v1alpha1"
+	firestorev1alpha1 "github.com/crossplane/provider-gcp/apis/firestore/v1alpha1"
 	iam "github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	iapv1alpha1 "github.com/crossplane/provider-gcp/apis/iap/v1alpha1"
+	identityplatformv1alpha1 "github.com/crossplane/provider-gcp/apis/identityplatform/v1alpha1"
+	idsv1alpha1 "github.com/crossplane/provider-gcp/apis/ids/v1alpha1"
 	kms "github.com/crossplane/provider-gcp/apis/kms/v1alpha1"
+	loggingv1alpha1 "github.com/crossplane/provider-gcp/apis/logging/v1alpha1"
+	monitoringv1alpha1 "github.com/crossplane/provider-gcp/apis/monitoring/v1alpha1"
+	networkconnectivityv1alpha1 "github.com/crossplane/provider-gcp/apis/networkconnectivity/v1alpha1"
+	notebooksv1alpha1 "github.com/crossplane/provider-gcp/apis/notebooks/v1alpha1"
+	orgpolicyv1alpha1 "github.com/crossplane/provider-gcp/apis/orgpolicy/v1alpha1"
+	osconfigv1alpha1 "github.com/crossplane/provider-gcp/apis/osconfig/v1alpha1"
 	pubsub "github.com/crossplane/provider-gcp/apis/pubsub/v1alpha1"
+	recaptchaenterprisev1alpha1 "github.com/crossplane/provider-gcp/apis/recaptchaenterprise/v1alpha1"
+	resourcemanagerv1alpha1 "github.com/crossplane/provider-gcp/apis/resourcemanager/v1alpha1"
 	servicenetworkingv1beta1 "github.com/crossplane/provider-gcp/apis/servicenetworking/v1beta1"
+	serviceusagev1alpha1 "github.com/crossplane/provider-gcp/apis/serviceusage/v1alpha1"
 	storagev1alpha1 "github.com/crossplane/provider-gcp/apis/storage/v1alpha1"
 	storagev1alpha3 "github.com/crossplane/provider-gcp/apis/storage/v1alpha3"
 	gcpv1alpha3 "github.com/crossplane/provider-gcp/apis/v1alpha3"
 	gcpv1beta1 "github.com/crossplane/provider-gcp/apis/v1beta1"
+	vertexaiv1alpha1 "github.com/crossplane/provider-gcp/apis/vertexai/v1alpha1"
+	vpcaccessv1alpha1 "github.com/crossplane/provider-gcp/apis/vpcaccess/v1alpha1"
+	workflowsv1alpha1 "github.com/crossplane/provider-gcp/apis/workflows/v1alpha1"
 )
 
 func init() {
@@ -42,8 +82,10 @@ func init() {
 	AddToSchemes = append(AddToSchemes,
 		gcpv1alpha3.SchemeBuilder.AddToScheme,
 		gcpv1beta1.SchemeBuilder.AddToScheme,
+		cachev1alpha1.SchemeBuilder.AddToScheme,
 		cachev1beta1.SchemeBuilder.AddToScheme,
 		computev1alpha1.SchemeBuilder.AddToScheme,
+		computev1alpha3.SchemeBuilder.AddToScheme,
 		computev1beta1.SchemeBuilder.AddToScheme,
 		containerv1beta2.SchemeBuilder.AddToScheme,
 		containerv1beta1.SchemeBuilder.AddToScheme,
@@ -55,6 +97,44 @@ func init() {
 		storagev1alpha1.SchemeBuilder.AddToScheme,
 		storagev1alpha3.SchemeBuilder.AddToScheme,
 		dnsv1alpha1.SchemeBuilder.AddToScheme,
+		alloydbv1alpha1.SchemeBuilder.AddToScheme,
+		firestorev1alpha1.SchemeBuilder.AddToScheme,
+		cloudfunctionsv1alpha1.SchemeBuilder.AddToScheme,
+		cloudrunv1alpha1.SchemeBuilder.AddToScheme,
+		cloudschedulerv1alpha1.SchemeBuilder.AddToScheme,
+		cloudtasksv1alpha1.SchemeBuilder.AddToScheme,
+		eventarcv1alpha1.SchemeBuilder.AddToScheme,
+		workflowsv1alpha1.SchemeBuilder.AddToScheme,
+		appenginev1alpha1.SchemeBuilder.AddToScheme,
+		vpcaccessv1alpha1.SchemeBuilder.AddToScheme,
+		apigatewayv1alpha1.SchemeBuilder.AddToScheme,
+		dataprocv1alpha1.SchemeBuilder.AddToScheme,
+		dataflowv1alpha1.SchemeBuilder.AddToScheme,
+		composerv1alpha1.SchemeBuilder.AddToScheme,
+		datastreamv1alpha1.SchemeBuilder.AddToScheme,
+		datacatalogv1alpha1.SchemeBuilder.AddToScheme,
+		certificatemanagerv1alpha1.SchemeBuilder.AddToScheme,
+		apigeev1alpha1.SchemeBuilder.AddToScheme,
+		resourcemanagerv1alpha1.SchemeBuilder.AddToScheme,
+		serviceusagev1alpha1.SchemeBuilder.AddToScheme,
+		billingbudgetsv1alpha1.SchemeBuilder.AddToScheme,
+		orgpolicyv1alpha1.SchemeBuilder.AddToScheme,
+		essentialcontactsv1alpha1.SchemeBuilder.AddToScheme,
+		accesscontextmanagerv1alpha1.SchemeBuilder.AddToScheme,
+		loggingv1alpha1.SchemeBuilder.AddToScheme,
+		monitoringv1alpha1.SchemeBuilder.AddToScheme,
+		osconfigv1alpha1.SchemeBuilder.AddToScheme,
+		artifactregistryv1alpha1.SchemeBuilder.AddToScheme,
+		cloudbuildv1alpha1.SchemeBuilder.AddToScheme,
+		filestorev1alpha1.SchemeBuilder.AddToScheme,
+		networkconnectivityv1alpha1.SchemeBuilder.AddToScheme,
+		idsv1alpha1.SchemeBuilder.AddToScheme,
+		recaptchaenterprisev1alpha1.SchemeBuilder.AddToScheme,
+		iapv1alpha1.SchemeBuilder.AddToScheme,
+		binaryauthorizationv1alpha1.SchemeBuilder.AddToScheme,
+		vertexaiv1alpha1.SchemeBuilder.AddToScheme,
+		notebooksv1alpha1.SchemeBuilder.AddToScheme,
+		identityplatformv1alpha1.SchemeBuilder.AddToScheme,
 	)
 }
 
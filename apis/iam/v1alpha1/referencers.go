@@ -79,6 +79,18 @@ func ServiceAccountMemberName() reference.ExtractValueFn {
 	}
 }
 
+// ServiceAccountEmail returns the email address of a given ServiceAccount
+// Object.
+func ServiceAccountEmail() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		n, ok := mg.(*ServiceAccount)
+		if !ok {
+			return ""
+		}
+		return n.Status.AtProvider.Email
+	}
+}
+
 func (sar *ServiceAccountReferer) resolveReferences(ctx context.Context, resolver *reference.APIResolver) error {
 	// Resolve spec.forProvider.serviceAccount
 	rsp, err := resolver.Resolve(ctx, reference.ResolutionRequest{
@@ -78,6 +78,36 @@ func TestServiceAccountMemberName(t *testing.T) {
 	}
 }
 
+func TestServiceAccountEmail(t *testing.T) {
+	testCases := map[string]struct {
+		mg   resource.Managed
+		want string
+	}{
+		"NotServiceAccount": {
+			mg:   &ServiceAccountKey{},
+			want: "",
+		},
+		"Email": {
+			mg: &ServiceAccount{
+				Status: ServiceAccountStatus{
+					AtProvider: ServiceAccountObservation{
+						Email: testEmail,
+					},
+				},
+			},
+			want: testEmail,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, ServiceAccountEmail()(tc.mg)); diff != "" {
+				t.Fatalf("ServiceAccountEmail(): -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
 func TestServiceAccountKey_ResolveReferences(t *testing.T) {
 	type args struct {
 		saKey *ServiceAccountKey
@@ -0,0 +1,101 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// BrandParameters define the desired state of an Identity-Aware Proxy OAuth
+// brand, i.e. the OAuth consent screen of a project. A project has at most
+// one brand and a brand can neither be changed nor deleted once created.
+type BrandParameters struct {
+	// Project the brand belongs to. Defaults to the project of the
+	// provider config.
+	// +immutable
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// ProjectRef references a Project and retrieves its external name.
+	// +immutable
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and retrieves its
+	// external name.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// SupportEmail is the email address users can contact about the
+	// application. It must be the caller or a group the caller owns.
+	// +immutable
+	SupportEmail string `json:"supportEmail"`
+
+	// ApplicationTitle is shown to users on the OAuth consent screen.
+	// +immutable
+	ApplicationTitle string `json:"applicationTitle"`
+}
+
+// BrandObservation is used to show the observed state of a brand.
+type BrandObservation struct {
+	// Name is the fully qualified name of the brand.
+	Name string `json:"name,omitempty"`
+
+	// OrgInternalOnly is true if only users of the organization of the
+	// project can sign in to applications of the brand.
+	OrgInternalOnly bool `json:"orgInternalOnly,omitempty"`
+}
+
+// A BrandSpec defines the desired state of a Brand.
+type BrandSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BrandParameters `json:"forProvider"`
+}
+
+// A BrandStatus represents the observed state of a Brand.
+type BrandStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BrandObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Brand is a managed resource that represents a GCP Identity-Aware Proxy OAuth brand.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TITLE",type="string",JSONPath=".spec.forProvider.applicationTitle"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Brand struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BrandSpec   `json:"spec"`
+	Status BrandStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BrandList contains a list of Brand
+type BrandList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Brand `json:"items"`
+}
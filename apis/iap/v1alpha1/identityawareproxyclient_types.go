@@ -0,0 +1,98 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Connection secret keys of an IdentityAwareProxyClient.
+const (
+	IdentityAwareProxyClientSecretClientIDKey     = "clientId"
+	IdentityAwareProxyClientSecretClientSecretKey = "clientSecret"
+)
+
+// IdentityAwareProxyClientParameters define the desired state of an OAuth
+// client that is owned by Identity-Aware Proxy. The client ID and secret are
+// published to the connection secret.
+type IdentityAwareProxyClientParameters struct {
+	// Brand is the fully qualified name of the brand the client belongs to,
+	// in the form projects/{project}/brands/{brand}.
+	// +immutable
+	// +optional
+	Brand *string `json:"brand,omitempty"`
+
+	// BrandRef references a Brand and retrieves its fully qualified name.
+	// +immutable
+	// +optional
+	BrandRef *xpv1.Reference `json:"brandRef,omitempty"`
+
+	// BrandSelector selects a reference to a Brand.
+	// +optional
+	BrandSelector *xpv1.Selector `json:"brandSelector,omitempty"`
+
+	// DisplayName is the human-readable name of the client.
+	// +immutable
+	DisplayName string `json:"displayName"`
+}
+
+// IdentityAwareProxyClientObservation is used to show the observed state of
+// an IdentityAwareProxyClient.
+type IdentityAwareProxyClientObservation struct {
+	// Name is the fully qualified name of the client.
+	Name string `json:"name,omitempty"`
+}
+
+// A IdentityAwareProxyClientSpec defines the desired state of a IdentityAwareProxyClient.
+type IdentityAwareProxyClientSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       IdentityAwareProxyClientParameters `json:"forProvider"`
+}
+
+// A IdentityAwareProxyClientStatus represents the observed state of a IdentityAwareProxyClient.
+type IdentityAwareProxyClientStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          IdentityAwareProxyClientObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An IdentityAwareProxyClient is a managed resource that represents a GCP Identity-Aware Proxy OAuth client.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DISPLAY-NAME",type="string",JSONPath=".spec.forProvider.displayName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type IdentityAwareProxyClient struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IdentityAwareProxyClientSpec   `json:"spec"`
+	Status IdentityAwareProxyClientStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IdentityAwareProxyClientList contains a list of IdentityAwareProxyClient
+type IdentityAwareProxyClientList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IdentityAwareProxyClient `json:"items"`
+}
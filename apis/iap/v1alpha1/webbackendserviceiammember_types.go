@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// WebBackendServiceIAMMemberParameters defines parameters for a desired
+// WebBackendServiceIAMMember.
+type WebBackendServiceIAMMemberParameters struct {
+	// Project the backend service belongs to. Defaults to the project of the
+	// provider config.
+	// +immutable
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// ProjectRef references a Project and retrieves its external name.
+	// +immutable
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and retrieves its
+	// external name.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// BackendService is the name of the backend service that is protected
+	// by Identity-Aware Proxy.
+	// +immutable
+	BackendService string `json:"backendService"`
+
+	// Role that is assigned to Member, e.g.
+	// roles/iap.httpsResourceAccessor.
+	// +immutable
+	Role string `json:"role"`
+
+	// Member is the identity that is granted Role, e.g. user:{email},
+	// serviceAccount:{email}, group:{email} or domain:{domain}.
+	// +optional
+	// +immutable
+	Member *string `json:"member,omitempty"`
+
+	// ServiceAccountMemberRef is reference to ServiceAccount used to set
+	// the Member.
+	// +optional
+	// +immutable
+	ServiceAccountMemberRef *xpv1.Reference `json:"serviceAccountMemberRef,omitempty"`
+
+	// ServiceAccountMemberSelector selects reference to ServiceAccount used
+	// to set the Member.
+	// +optional
+	// +immutable
+	ServiceAccountMemberSelector *xpv1.Selector `json:"serviceAccountMemberSelector,omitempty"`
+}
+
+// WebBackendServiceIAMMemberSpec defines the desired state of a
+// WebBackendServiceIAMMember.
+type WebBackendServiceIAMMemberSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       WebBackendServiceIAMMemberParameters `json:"forProvider"`
+}
+
+// WebBackendServiceIAMMemberStatus represents the observed state of a
+// WebBackendServiceIAMMember.
+type WebBackendServiceIAMMemberStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// WebBackendServiceIAMMember is a managed resource that represents
+// membership of the Identity-Aware Proxy IAM Policy of a backend service.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="BACKEND-SERVICE",type="string",JSONPath=".spec.forProvider.backendService"
+// +kubebuilder:printcolumn:name="ROLE",type="string",JSONPath=".spec.forProvider.role"
+// +kubebuilder:printcolumn:name="MEMBER",type="string",JSONPath=".spec.forProvider.member"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type WebBackendServiceIAMMember struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WebBackendServiceIAMMemberSpec   `json:"spec"`
+	Status WebBackendServiceIAMMemberStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WebBackendServiceIAMMemberList contains a list of
+// WebBackendServiceIAMMember types
+type WebBackendServiceIAMMemberList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WebBackendServiceIAMMember `json:"items"`
+}
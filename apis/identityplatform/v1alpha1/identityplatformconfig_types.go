@@ -0,0 +1,199 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// IdentityPlatformConfigParameters define the desired Identity Platform
+// configuration of a project. Most fields map directly to a Config:
+// https://cloud.google.com/identity-platform/docs/reference/rest/v2/Config
+type IdentityPlatformConfigParameters struct {
+	// Project the configuration belongs to. Defaults to the project of the
+	// provider config.
+	// +immutable
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// ProjectRef references a Project and retrieves its external name.
+	// +immutable
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and retrieves its
+	// external name.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// AuthorizedDomains users may be redirected to after signing in, e.g.
+	// app.example.com.
+	// +optional
+	AuthorizedDomains []string `json:"authorizedDomains,omitempty"`
+
+	// SignIn configures the built-in sign-in providers.
+	// +optional
+	SignIn *SignInConfig `json:"signIn,omitempty"`
+
+	// MFA configures multi-factor authentication.
+	// +optional
+	MFA *MultiFactorAuthConfig `json:"mfa,omitempty"`
+
+	// AutodeleteAnonymousUsers deletes anonymous users 30 days after they
+	// were created.
+	// +optional
+	AutodeleteAnonymousUsers *bool `json:"autodeleteAnonymousUsers,omitempty"`
+
+	// MultiTenant configures multi-tenancy. Tenants can only be created if
+	// it's allowed here.
+	// +optional
+	MultiTenant *MultiTenantConfig `json:"multiTenant,omitempty"`
+}
+
+// SignInConfig configures the built-in sign-in providers.
+type SignInConfig struct {
+	// Email configures signing in with an email address.
+	// +optional
+	Email *EmailSignInConfig `json:"email,omitempty"`
+
+	// PhoneNumber configures signing in with a phone number.
+	// +optional
+	PhoneNumber *PhoneNumberSignInConfig `json:"phoneNumber,omitempty"`
+
+	// Anonymous configures anonymous sign-in.
+	// +optional
+	Anonymous *AnonymousSignInConfig `json:"anonymous,omitempty"`
+
+	// AllowDuplicateEmails allows multiple accounts to have the same email
+	// address.
+	// +optional
+	AllowDuplicateEmails *bool `json:"allowDuplicateEmails,omitempty"`
+}
+
+// EmailSignInConfig configures signing in with an email address.
+type EmailSignInConfig struct {
+	// Enabled allows users to sign in with their email address.
+	Enabled bool `json:"enabled"`
+
+	// PasswordRequired requires users to sign in with a password. Users may
+	// sign in with a link sent to their email address otherwise.
+	// +optional
+	PasswordRequired *bool `json:"passwordRequired,omitempty"`
+}
+
+// PhoneNumberSignInConfig configures signing in with a phone number.
+type PhoneNumberSignInConfig struct {
+	// Enabled allows users to sign in with their phone number.
+	Enabled bool `json:"enabled"`
+
+	// TestPhoneNumbers maps fictional phone numbers to the verification
+	// code that is accepted for them, for testing.
+	// +optional
+	TestPhoneNumbers map[string]string `json:"testPhoneNumbers,omitempty"`
+}
+
+// AnonymousSignInConfig configures anonymous sign-in.
+type AnonymousSignInConfig struct {
+	// Enabled allows users to sign in anonymously.
+	Enabled bool `json:"enabled"`
+}
+
+// MultiFactorAuthConfig configures multi-factor authentication.
+type MultiFactorAuthConfig struct {
+	// State of multi-factor authentication. Defaults to DISABLED.
+	// +kubebuilder:validation:Enum=DISABLED;ENABLED;MANDATORY
+	// +optional
+	State *string `json:"state,omitempty"`
+
+	// EnabledProviders are the second factors users may enroll, other than
+	// TOTP.
+	// +optional
+	EnabledProviders []string `json:"enabledProviders,omitempty"`
+
+	// TOTP enables time-based one-time passwords as a second factor.
+	// +optional
+	TOTP *TOTPConfig `json:"totp,omitempty"`
+}
+
+// TOTPConfig configures time-based one-time passwords as a second factor.
+type TOTPConfig struct {
+	// AdjacentIntervals is the number of 30 second intervals before and
+	// after the current one a password is accepted for. Defaults to 5.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	AdjacentIntervals *int64 `json:"adjacentIntervals,omitempty"`
+}
+
+// MultiTenantConfig configures multi-tenancy.
+type MultiTenantConfig struct {
+	// AllowTenants allows tenants to be created in the project.
+	AllowTenants bool `json:"allowTenants"`
+}
+
+// IdentityPlatformConfigObservation is used to show the observed state of an
+// IdentityPlatformConfig.
+type IdentityPlatformConfigObservation struct {
+	// Name is the fully qualified name of the configuration.
+	Name string `json:"name,omitempty"`
+
+	// Subtype of the project, i.e. IDENTITY_PLATFORM or FIREBASE_AUTH.
+	Subtype string `json:"subtype,omitempty"`
+}
+
+// An IdentityPlatformConfigSpec defines the desired state of an
+// IdentityPlatformConfig.
+type IdentityPlatformConfigSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       IdentityPlatformConfigParameters `json:"forProvider"`
+}
+
+// An IdentityPlatformConfigStatus represents the observed state of an
+// IdentityPlatformConfig.
+type IdentityPlatformConfigStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          IdentityPlatformConfigObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An IdentityPlatformConfig is a managed resource that represents the
+// Identity Platform configuration of a project. Every project with Identity
+// Platform enabled has exactly one configuration.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type IdentityPlatformConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IdentityPlatformConfigSpec   `json:"spec"`
+	Status IdentityPlatformConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IdentityPlatformConfigList contains a list of IdentityPlatformConfig
+type IdentityPlatformConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IdentityPlatformConfig `json:"items"`
+}
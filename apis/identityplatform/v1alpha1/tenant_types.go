@@ -0,0 +1,122 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TenantParameters define the desired state of an Identity Platform tenant.
+// Most fields map directly to a Tenant:
+// https://cloud.google.com/identity-platform/docs/reference/rest/v2/projects.tenants#Tenant
+type TenantParameters struct {
+	// Project the tenant belongs to. Defaults to the project of the
+	// provider config.
+	// +immutable
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// ProjectRef references a Project and retrieves its external name.
+	// +immutable
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and retrieves its
+	// external name.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// DisplayName of the tenant. It must start with a letter and may only
+	// contain letters, digits and hyphens.
+	// +kubebuilder:validation:MinLength=4
+	// +kubebuilder:validation:MaxLength=20
+	DisplayName string `json:"displayName"`
+
+	// AllowPasswordSignup allows users to sign in with an email address and
+	// password.
+	// +optional
+	AllowPasswordSignup *bool `json:"allowPasswordSignup,omitempty"`
+
+	// EnableEmailLinkSignin allows users to sign in with a link sent to
+	// their email address.
+	// +optional
+	EnableEmailLinkSignin *bool `json:"enableEmailLinkSignin,omitempty"`
+
+	// EnableAnonymousUser allows users to sign in anonymously.
+	// +optional
+	EnableAnonymousUser *bool `json:"enableAnonymousUser,omitempty"`
+
+	// DisableAuth prevents all users of the tenant from signing in.
+	// +optional
+	DisableAuth *bool `json:"disableAuth,omitempty"`
+
+	// MFAConfig configures multi-factor authentication.
+	// +optional
+	MFAConfig *MultiFactorAuthConfig `json:"mfaConfig,omitempty"`
+
+	// TestPhoneNumbers maps fictional phone numbers to the verification
+	// code that is accepted for them, for testing.
+	// +optional
+	TestPhoneNumbers map[string]string `json:"testPhoneNumbers,omitempty"`
+}
+
+// TenantObservation is used to show the observed state of a Tenant.
+type TenantObservation struct {
+	// Name is the fully qualified name of the tenant.
+	Name string `json:"name,omitempty"`
+}
+
+// A TenantSpec defines the desired state of a Tenant.
+type TenantSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TenantParameters `json:"forProvider"`
+}
+
+// A TenantStatus represents the observed state of a Tenant.
+type TenantStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TenantObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Tenant is a managed resource that represents an Identity Platform tenant,
+// i.e. an isolated group of users and their sign-in configuration.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DISPLAY-NAME",type="string",JSONPath=".spec.forProvider.displayName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Tenant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TenantSpec   `json:"spec"`
+	Status TenantStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TenantList contains a list of Tenant
+type TenantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Tenant `json:"items"`
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known Endpoint states.
+const (
+	EndpointStateCreating = "CREATING"
+	EndpointStateReady    = "READY"
+	EndpointStateUpdating = "UPDATING"
+	EndpointStateDeleting = "DELETING"
+)
+
+// EndpointParameters define the desired state of a Cloud IDS endpoint. Most
+// fields map directly to an Endpoint:
+// https://cloud.google.com/intrusion-detection-system/docs/reference/rest/v1/projects.locations.endpoints#Endpoint
+type EndpointParameters struct {
+	// Project the endpoint belongs to. Defaults to the project of the
+	// provider config.
+	// +immutable
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// ProjectRef references a Project and retrieves its external name.
+	// +immutable
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and retrieves its
+	// external name.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// Location of the endpoint. This must be a zone.
+	// +immutable
+	Location string `json:"location"`
+
+	// Network is the VPC network whose traffic the endpoint inspects, in
+	// the form projects/{project}/global/networks/{network}. The network
+	// must have private services access configured.
+	// +immutable
+	// +optional
+	Network *string `json:"network,omitempty"`
+
+	// NetworkRef references a Network to retrieve its URL.
+	// +immutable
+	// +optional
+	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to a Network.
+	// +optional
+	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
+
+	// Severity is the lowest threat severity the endpoint alerts on.
+	// +immutable
+	// +kubebuilder:validation:Enum=INFORMATIONAL;LOW;MEDIUM;HIGH;CRITICAL
+	Severity string `json:"severity"`
+
+	// Description of the endpoint.
+	// +immutable
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels to apply to the endpoint.
+	// +immutable
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// TrafficLogs enables the logging of all traffic in addition to
+	// threats.
+	// +immutable
+	// +optional
+	TrafficLogs *bool `json:"trafficLogs,omitempty"`
+
+	// ThreatExceptions are the IDs of threats that don't raise alerts.
+	// +optional
+	ThreatExceptions []string `json:"threatExceptions,omitempty"`
+}
+
+// EndpointObservation is used to show the observed state of an Endpoint.
+type EndpointObservation struct {
+	// Name is the fully qualified name of the endpoint.
+	Name string `json:"name,omitempty"`
+
+	// State of the endpoint.
+	State string `json:"state,omitempty"`
+
+	// CreateTime is the time the endpoint was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the endpoint was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+
+	// EndpointForwardingRule is the URL of the forwarding rule of the
+	// endpoint's internal load balancer. Use it as the collector of a
+	// packet mirroring policy to send traffic to the endpoint.
+	EndpointForwardingRule string `json:"endpointForwardingRule,omitempty"`
+
+	// EndpointIP is the IP address of the endpoint's internal load
+	// balancer.
+	EndpointIP string `json:"endpointIp,omitempty"`
+}
+
+// A EndpointSpec defines the desired state of a Endpoint.
+type EndpointSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EndpointParameters `json:"forProvider"`
+}
+
+// A EndpointStatus represents the observed state of a Endpoint.
+type EndpointStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EndpointObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Endpoint is a managed resource that represents a Cloud IDS endpoint, a zonal intrusion detection service that inspects the traffic mirrored to it from a VPC network.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SEVERITY",type="string",JSONPath=".spec.forProvider.severity"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Endpoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EndpointSpec   `json:"spec"`
+	Status EndpointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EndpointList contains a list of Endpoint
+type EndpointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Endpoint `json:"items"`
+}
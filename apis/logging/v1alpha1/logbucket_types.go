@@ -0,0 +1,159 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Log bucket lifecycle states.
+const (
+	LogBucketStateActive          = "ACTIVE"
+	LogBucketStateDeleteRequested = "DELETE_REQUESTED"
+)
+
+// LogBucketParameters define the desired state of a Cloud Logging bucket,
+// which stores the logs routed to it by sinks:
+// https://cloud.google.com/logging/docs/reference/v2/rest/v2/projects.locations.buckets
+type LogBucketParameters struct {
+	// Parent the bucket belongs to, in the form projects/{project_id},
+	// folders/{folder_id} or organizations/{organization_id}. Defaults to the
+	// project of the provider config.
+	// +kubebuilder:validation:Pattern=`^(projects/[a-z][-a-z0-9]*|folders/[0-9]+|organizations/[0-9]+)$`
+	// +immutable
+	// +optional
+	Parent *string `json:"parent,omitempty"`
+
+	// ProjectRef references a Project and sets the parent to it.
+	// +immutable
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and sets the parent
+	// to it.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// FolderRef references a Folder and sets the parent to it.
+	// +immutable
+	// +optional
+	FolderRef *xpv1.Reference `json:"folderRef,omitempty"`
+
+	// FolderSelector selects a reference to a Folder and sets the parent to
+	// it.
+	// +optional
+	FolderSelector *xpv1.Selector `json:"folderSelector,omitempty"`
+
+	// Location of the bucket, e.g. global or europe-west1.
+	// +immutable
+	Location string `json:"location"`
+
+	// Description of the bucket.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// RetentionDays is the number of days logs are kept in the bucket.
+	// Defaults to 30 days.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3650
+	// +optional
+	RetentionDays *int64 `json:"retentionDays,omitempty"`
+
+	// Locked buckets can't be deleted, and their retention can't be
+	// changed. A bucket can't be unlocked once it is locked.
+	// +optional
+	Locked *bool `json:"locked,omitempty"`
+
+	// AnalyticsEnabled makes the logs of the bucket available to Log
+	// Analytics. Log Analytics can't be disabled once it is enabled.
+	// +optional
+	AnalyticsEnabled *bool `json:"analyticsEnabled,omitempty"`
+
+	// KmsKeyName is the resource name of the Cloud KMS key the logs of the
+	// bucket are encrypted with, in the form
+	// projects/{project}/locations/{location}/keyRings/{keyring}/cryptoKeys/{key}.
+	// The key must be in the location of the bucket, and the Cloud Logging
+	// service account of the parent must be allowed to use it.
+	// +optional
+	KmsKeyName *string `json:"kmsKeyName,omitempty"`
+
+	// KmsKeyNameRef references a CryptoKey and retrieves its resource name.
+	// +optional
+	KmsKeyNameRef *xpv1.Reference `json:"kmsKeyNameRef,omitempty"`
+
+	// KmsKeyNameSelector selects a reference to a CryptoKey and retrieves
+	// its resource name.
+	// +optional
+	KmsKeyNameSelector *xpv1.Selector `json:"kmsKeyNameSelector,omitempty"`
+}
+
+// LogBucketObservation is used to show the observed state of a LogBucket.
+type LogBucketObservation struct {
+	// Name is the resource name of the bucket, e.g.
+	// projects/{project}/locations/{location}/buckets/{bucket}.
+	Name string `json:"name,omitempty"`
+
+	// LifecycleState of the bucket.
+	LifecycleState string `json:"lifecycleState,omitempty"`
+
+	// CreateTime is the time the bucket was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the bucket was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// A LogBucketSpec defines the desired state of a LogBucket.
+type LogBucketSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LogBucketParameters `json:"forProvider"`
+}
+
+// A LogBucketStatus represents the observed state of a LogBucket.
+type LogBucketStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          LogBucketObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LogBucket is a managed resource that represents a Cloud Logging bucket of a Google Cloud project, folder or organization.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.forProvider.location"
+// +kubebuilder:printcolumn:name="RETENTION",type="integer",JSONPath=".spec.forProvider.retentionDays"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type LogBucket struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LogBucketSpec   `json:"spec"`
+	Status LogBucketStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LogBucketList contains a list of LogBucket
+type LogBucketList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LogBucket `json:"items"`
+}
@@ -0,0 +1,242 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// LogMetricParameters define the desired state of a log-based metric, which
+// counts the log entries of a project that match its filter or extracts
+// values from them:
+// https://cloud.google.com/logging/docs/reference/v2/rest/v2/projects.metrics
+type LogMetricParameters struct {
+	// Project the metric belongs to. Defaults to the project of the provider
+	// config.
+	// +immutable
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// ProjectRef references a Project and retrieves its external name.
+	// +immutable
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and retrieves its
+	// external name.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// Filter selects the log entries the metric is computed from, in the
+	// Logging query language.
+	Filter string `json:"filter"`
+
+	// Description of the metric.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Disabled metrics are not computed.
+	// +optional
+	Disabled *bool `json:"disabled,omitempty"`
+
+	// BucketName is the resource name of the log bucket the metric is
+	// computed from, in the form
+	// projects/{project}/locations/{location}/buckets/{bucket}. Defaults to
+	// the logs of the project regardless of the bucket they are stored in.
+	// +optional
+	BucketName *string `json:"bucketName,omitempty"`
+
+	// BucketNameRef references a LogBucket and retrieves its resource name.
+	// +optional
+	BucketNameRef *xpv1.Reference `json:"bucketNameRef,omitempty"`
+
+	// BucketNameSelector selects a reference to a LogBucket and retrieves
+	// its resource name.
+	// +optional
+	BucketNameSelector *xpv1.Selector `json:"bucketNameSelector,omitempty"`
+
+	// MetricDescriptor describes the metric. Defaults to a counter of the
+	// matching log entries.
+	// +optional
+	MetricDescriptor *MetricDescriptor `json:"metricDescriptor,omitempty"`
+
+	// ValueExtractor extracts the values of a distribution metric from the
+	// log entries, e.g. EXTRACT(jsonPayload.latency).
+	// +optional
+	ValueExtractor *string `json:"valueExtractor,omitempty"`
+
+	// LabelExtractors extract the values of the labels of the metric from
+	// the log entries, keyed by the label.
+	// +optional
+	LabelExtractors map[string]string `json:"labelExtractors,omitempty"`
+
+	// BucketOptions define the histogram buckets of a distribution metric.
+	// +optional
+	BucketOptions *BucketOptions `json:"bucketOptions,omitempty"`
+}
+
+// A MetricDescriptor describes the values and labels of a log-based metric.
+type MetricDescriptor struct {
+	// MetricKind of the metric. Log-based metrics are DELTA metrics.
+	// +kubebuilder:validation:Enum=DELTA;GAUGE;CUMULATIVE
+	// +optional
+	MetricKind *string `json:"metricKind,omitempty"`
+
+	// ValueType of the metric. Counters are INT64 metrics, metrics with a
+	// value extractor DISTRIBUTION metrics.
+	// +kubebuilder:validation:Enum=BOOL;INT64;DOUBLE;STRING;DISTRIBUTION
+	// +optional
+	ValueType *string `json:"valueType,omitempty"`
+
+	// Unit of the values of the metric, e.g. 1 or ms.
+	// +optional
+	Unit *string `json:"unit,omitempty"`
+
+	// DisplayName of the metric.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Labels of the metric. Every label needs a label extractor.
+	// +optional
+	Labels []LabelDescriptor `json:"labels,omitempty"`
+}
+
+// A LabelDescriptor describes a label of a log-based metric.
+type LabelDescriptor struct {
+	// Key of the label.
+	Key string `json:"key"`
+
+	// ValueType of the label. Defaults to STRING.
+	// +kubebuilder:validation:Enum=STRING;BOOL;INT64
+	// +optional
+	ValueType *string `json:"valueType,omitempty"`
+
+	// Description of the label.
+	// +optional
+	Description *string `json:"description,omitempty"`
+}
+
+// BucketOptions define the histogram buckets of a distribution metric.
+// Exactly one of LinearBuckets, ExponentialBuckets and ExplicitBuckets must
+// be set.
+type BucketOptions struct {
+	// LinearBuckets have the same width.
+	// +optional
+	LinearBuckets *LinearBuckets `json:"linearBuckets,omitempty"`
+
+	// ExponentialBuckets grow in width by a constant factor.
+	// +optional
+	ExponentialBuckets *ExponentialBuckets `json:"exponentialBuckets,omitempty"`
+
+	// ExplicitBuckets have arbitrary bounds.
+	// +optional
+	ExplicitBuckets *ExplicitBuckets `json:"explicitBuckets,omitempty"`
+}
+
+// LinearBuckets have the bounds offset + width * i.
+type LinearBuckets struct {
+	// NumFiniteBuckets is the number of buckets besides the underflow and
+	// overflow buckets.
+	// +kubebuilder:validation:Minimum=1
+	NumFiniteBuckets int64 `json:"numFiniteBuckets"`
+
+	// Width of the buckets, as a decimal string, e.g. "10".
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	Width string `json:"width"`
+
+	// Offset is the lower bound of the first bucket, as a decimal string.
+	// +kubebuilder:validation:Pattern=`^-?[0-9]+(\.[0-9]+)?$`
+	// +optional
+	Offset *string `json:"offset,omitempty"`
+}
+
+// ExponentialBuckets have the bounds scale * growthFactor ^ i.
+type ExponentialBuckets struct {
+	// NumFiniteBuckets is the number of buckets besides the underflow and
+	// overflow buckets.
+	// +kubebuilder:validation:Minimum=1
+	NumFiniteBuckets int64 `json:"numFiniteBuckets"`
+
+	// GrowthFactor of the buckets, as a decimal string greater than 1.
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	GrowthFactor string `json:"growthFactor"`
+
+	// Scale is the upper bound of the first bucket, as a decimal string
+	// greater than 0.
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	Scale string `json:"scale"`
+}
+
+// ExplicitBuckets have the supplied bounds.
+type ExplicitBuckets struct {
+	// Bounds of the buckets, as decimal strings in increasing order.
+	// +kubebuilder:validation:MinItems=1
+	Bounds []string `json:"bounds"`
+}
+
+// LogMetricObservation is used to show the observed state of a LogMetric.
+type LogMetricObservation struct {
+	// MetricType is the Cloud Monitoring type of the metric, e.g.
+	// logging.googleapis.com/user/{metric}.
+	MetricType string `json:"metricType,omitempty"`
+
+	// CreateTime is the time the metric was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the metric was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// A LogMetricSpec defines the desired state of a LogMetric.
+type LogMetricSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LogMetricParameters `json:"forProvider"`
+}
+
+// A LogMetricStatus represents the observed state of a LogMetric.
+type LogMetricStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          LogMetricObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LogMetric is a managed resource that represents a log-based metric of a Google Cloud project.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".status.atProvider.metricType"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type LogMetric struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LogMetricSpec   `json:"spec"`
+	Status LogMetricStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LogMetricList contains a list of LogMetric
+type LogMetricList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LogMetric `json:"items"`
+}
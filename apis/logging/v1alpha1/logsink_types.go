@@ -0,0 +1,199 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// LogSinkParameters define the desired state of a Cloud Logging sink. A sink
+// routes the log entries of its parent that match its filter to a Cloud
+// Storage bucket, a BigQuery dataset, a Pub/Sub topic or a log bucket:
+// https://cloud.google.com/logging/docs/reference/v2/rest/v2/projects.sinks
+type LogSinkParameters struct {
+	// Parent the sink routes the logs of, in the form
+	// projects/{project_id}, folders/{folder_id} or
+	// organizations/{organization_id}. Defaults to the project of the
+	// provider config.
+	// +kubebuilder:validation:Pattern=`^(projects/[a-z][-a-z0-9]*|folders/[0-9]+|organizations/[0-9]+)$`
+	// +immutable
+	// +optional
+	Parent *string `json:"parent,omitempty"`
+
+	// ProjectRef references a Project and sets the parent to it.
+	// +immutable
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and sets the parent
+	// to it.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// FolderRef references a Folder and sets the parent to it.
+	// +immutable
+	// +optional
+	FolderRef *xpv1.Reference `json:"folderRef,omitempty"`
+
+	// FolderSelector selects a reference to a Folder and sets the parent to
+	// it.
+	// +optional
+	FolderSelector *xpv1.Selector `json:"folderSelector,omitempty"`
+
+	// Destination the logs are routed to, e.g.
+	// storage.googleapis.com/{bucket},
+	// bigquery.googleapis.com/projects/{project}/datasets/{dataset} or
+	// pubsub.googleapis.com/projects/{project}/topics/{topic}. Either
+	// Destination or Topic is required.
+	// +optional
+	Destination *string `json:"destination,omitempty"`
+
+	// BucketRef references a Bucket and sets the destination to it.
+	// +optional
+	BucketRef *xpv1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects a reference to a Bucket and sets the destination
+	// to it.
+	// +optional
+	BucketSelector *xpv1.Selector `json:"bucketSelector,omitempty"`
+
+	// Topic is the ID of a Pub/Sub topic in the project of the provider
+	// config that the logs are routed to. Ignored if Destination is set.
+	// +optional
+	Topic *string `json:"topic,omitempty"`
+
+	// TopicRef references a Topic and retrieves its ID.
+	// +optional
+	TopicRef *xpv1.Reference `json:"topicRef,omitempty"`
+
+	// TopicSelector selects a reference to a Topic and retrieves its ID.
+	// +optional
+	TopicSelector *xpv1.Selector `json:"topicSelector,omitempty"`
+
+	// Filter selects the log entries that are routed, in the Logging query
+	// language. All log entries are routed if empty.
+	// +optional
+	Filter *string `json:"filter,omitempty"`
+
+	// Description of the sink.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Disabled sinks don't route any logs.
+	// +optional
+	Disabled *bool `json:"disabled,omitempty"`
+
+	// Exclusions of log entries that match the filter but are not routed.
+	// +optional
+	Exclusions []LogExclusion `json:"exclusions,omitempty"`
+
+	// IncludeChildren routes the logs of the projects and folders below a
+	// folder or organization sink too.
+	// +optional
+	IncludeChildren *bool `json:"includeChildren,omitempty"`
+
+	// BigQueryOptions applies to sinks routing to BigQuery datasets.
+	// +optional
+	BigQueryOptions *BigQueryOptions `json:"bigqueryOptions,omitempty"`
+
+	// UniqueWriterIdentity gives a project sink a service account of its own
+	// to write to the destination, rather than the shared Cloud Logging
+	// service account. Folder and organization sinks always have a unique
+	// writer identity.
+	// +optional
+	UniqueWriterIdentity *bool `json:"uniqueWriterIdentity,omitempty"`
+}
+
+// A LogExclusion excludes the log entries that match its filter from a sink.
+type LogExclusion struct {
+	// Name of the exclusion, unique within the sink.
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_.-]{1,100}$`
+	Name string `json:"name"`
+
+	// Description of the exclusion.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Filter selects the log entries that are excluded.
+	Filter string `json:"filter"`
+
+	// Disabled exclusions don't exclude any log entries.
+	// +optional
+	Disabled *bool `json:"disabled,omitempty"`
+}
+
+// BigQueryOptions configures how logs are written to BigQuery tables.
+type BigQueryOptions struct {
+	// UsePartitionedTables writes logs to date partitioned tables rather
+	// than to a table per day.
+	UsePartitionedTables bool `json:"usePartitionedTables"`
+}
+
+// LogSinkObservation is used to show the observed state of a LogSink.
+type LogSinkObservation struct {
+	// WriterIdentity is the member that writes to the destination, e.g.
+	// serviceAccount:p123-456@gcp-sa-logging.iam.gserviceaccount.com. It
+	// must be granted permission to write to the destination.
+	WriterIdentity string `json:"writerIdentity,omitempty"`
+
+	// CreateTime is the time the sink was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the sink was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// A LogSinkSpec defines the desired state of a LogSink.
+type LogSinkSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LogSinkParameters `json:"forProvider"`
+}
+
+// A LogSinkStatus represents the observed state of a LogSink.
+type LogSinkStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          LogSinkObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LogSink is a managed resource that represents a Cloud Logging sink of a Google Cloud project, folder or organization.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DESTINATION",type="string",JSONPath=".spec.forProvider.destination"
+// +kubebuilder:printcolumn:name="WRITER",type="string",JSONPath=".status.atProvider.writerIdentity"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type LogSink struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LogSinkSpec   `json:"spec"`
+	Status LogSinkStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LogSinkList contains a list of LogSink
+type LogSinkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LogSink `json:"items"`
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	kmsv1alpha1 "github.com/crossplane/provider-gcp/apis/kms/v1alpha1"
+	pubsubv1alpha1 "github.com/crossplane/provider-gcp/apis/pubsub/v1alpha1"
+	resourcemanagerv1alpha1 "github.com/crossplane/provider-gcp/apis/resourcemanager/v1alpha1"
+	storagev1alpha3 "github.com/crossplane/provider-gcp/apis/storage/v1alpha3"
+)
+
+// bucketDestination extracts the sink destination of a Bucket.
+func bucketDestination() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		if meta.GetExternalName(mg) == "" {
+			return ""
+		}
+		return "storage.googleapis.com/" + meta.GetExternalName(mg)
+	}
+}
+
+// LogBucketName extracts the resource name of a LogBucket.
+func LogBucketName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		b, ok := mg.(*LogBucket)
+		if !ok {
+			return ""
+		}
+		return b.Status.AtProvider.Name
+	}
+}
+
+// ResolveReferences of this LogSink
+func (in *LogSink) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.parent from a project
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Parent),
+		Reference:    in.Spec.ForProvider.ProjectRef,
+		Selector:     in.Spec.ForProvider.ProjectSelector,
+		To:           reference.To{Managed: &resourcemanagerv1alpha1.Project{}, List: &resourcemanagerv1alpha1.ProjectList{}},
+		Extract:      resourcemanagerv1alpha1.ProjectParent(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.parent")
+	}
+	in.Spec.ForProvider.Parent = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.ProjectRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.parent from a folder
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Parent),
+		Reference:    in.Spec.ForProvider.FolderRef,
+		Selector:     in.Spec.ForProvider.FolderSelector,
+		To:           reference.To{Managed: &resourcemanagerv1alpha1.Folder{}, List: &resourcemanagerv1alpha1.FolderList{}},
+		Extract:      resourcemanagerv1alpha1.FolderName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.parent")
+	}
+	in.Spec.ForProvider.Parent = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.FolderRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.destination
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Destination),
+		Reference:    in.Spec.ForProvider.BucketRef,
+		Selector:     in.Spec.ForProvider.BucketSelector,
+		To:           reference.To{Managed: &storagev1alpha3.Bucket{}, List: &storagev1alpha3.BucketList{}},
+		Extract:      bucketDestination(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.destination")
+	}
+	in.Spec.ForProvider.Destination = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.BucketRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.topic
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Topic),
+		Reference:    in.Spec.ForProvider.TopicRef,
+		Selector:     in.Spec.ForProvider.TopicSelector,
+		To:           reference.To{Managed: &pubsubv1alpha1.Topic{}, List: &pubsubv1alpha1.TopicList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.topic")
+	}
+	in.Spec.ForProvider.Topic = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.TopicRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this LogBucket
+func (in *LogBucket) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.parent from a project
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Parent),
+		Reference:    in.Spec.ForProvider.ProjectRef,
+		Selector:     in.Spec.ForProvider.ProjectSelector,
+		To:           reference.To{Managed: &resourcemanagerv1alpha1.Project{}, List: &resourcemanagerv1alpha1.ProjectList{}},
+		Extract:      resourcemanagerv1alpha1.ProjectParent(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.parent")
+	}
+	in.Spec.ForProvider.Parent = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.ProjectRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.parent from a folder
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Parent),
+		Reference:    in.Spec.ForProvider.FolderRef,
+		Selector:     in.Spec.ForProvider.FolderSelector,
+		To:           reference.To{Managed: &resourcemanagerv1alpha1.Folder{}, List: &resourcemanagerv1alpha1.FolderList{}},
+		Extract:      resourcemanagerv1alpha1.FolderName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.parent")
+	}
+	in.Spec.ForProvider.Parent = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.FolderRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.kmsKeyName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.KmsKeyName),
+		Reference:    in.Spec.ForProvider.KmsKeyNameRef,
+		Selector:     in.Spec.ForProvider.KmsKeyNameSelector,
+		To:           reference.To{Managed: &kmsv1alpha1.CryptoKey{}, List: &kmsv1alpha1.CryptoKeyList{}},
+		Extract:      kmsv1alpha1.CryptoKeyRRN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.kmsKeyName")
+	}
+	in.Spec.ForProvider.KmsKeyName = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.KmsKeyNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this LogMetric
+func (in *LogMetric) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.project
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Project),
+		Reference:    in.Spec.ForProvider.ProjectRef,
+		Selector:     in.Spec.ForProvider.ProjectSelector,
+		To:           reference.To{Managed: &resourcemanagerv1alpha1.Project{}, List: &resourcemanagerv1alpha1.ProjectList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.project")
+	}
+	in.Spec.ForProvider.Project = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.ProjectRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.bucketName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.BucketName),
+		Reference:    in.Spec.ForProvider.BucketNameRef,
+		Selector:     in.Spec.ForProvider.BucketNameSelector,
+		To:           reference.To{Managed: &LogBucket{}, List: &LogBucketList{}},
+		Extract:      LogBucketName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.bucketName")
+	}
+	in.Spec.ForProvider.BucketName = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.BucketNameRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "logging.gcp.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// LogSink type metadata.
+var (
+	LogSinkKind             = reflect.TypeOf(LogSink{}).Name()
+	LogSinkGroupKind        = schema.GroupKind{Group: Group, Kind: LogSinkKind}.String()
+	LogSinkKindAPIVersion   = LogSinkKind + "." + SchemeGroupVersion.String()
+	LogSinkGroupVersionKind = SchemeGroupVersion.WithKind(LogSinkKind)
+)
+
+// LogBucket type metadata.
+var (
+	LogBucketKind             = reflect.TypeOf(LogBucket{}).Name()
+	LogBucketGroupKind        = schema.GroupKind{Group: Group, Kind: LogBucketKind}.String()
+	LogBucketKindAPIVersion   = LogBucketKind + "." + SchemeGroupVersion.String()
+	LogBucketGroupVersionKind = SchemeGroupVersion.WithKind(LogBucketKind)
+)
+
+// LogMetric type metadata.
+var (
+	LogMetricKind             = reflect.TypeOf(LogMetric{}).Name()
+	LogMetricGroupKind        = schema.GroupKind{Group: Group, Kind: LogMetricKind}.String()
+	LogMetricKindAPIVersion   = LogMetricKind + "." + SchemeGroupVersion.String()
+	LogMetricGroupVersionKind = SchemeGroupVersion.WithKind(LogMetricKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&LogSink{}, &LogSinkList{})
+	SchemeBuilder.Register(&LogBucket{}, &LogBucketList{})
+	SchemeBuilder.Register(&LogMetric{}, &LogMetricList{})
+}
@@ -0,0 +1,351 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// AlertPolicyParameters define the desired state of a Cloud Monitoring alert
+// policy:
+// https://cloud.google.com/monitoring/api/ref_v3/rest/v3/projects.alertPolicies
+type AlertPolicyParameters struct {
+	// Project the policy belongs to. Defaults to the project of the provider
+	// config.
+	// +immutable
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// ProjectRef references a Project and retrieves its external name.
+	// +immutable
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and retrieves its
+	// external name.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// DisplayName of the policy.
+	DisplayName string `json:"displayName"`
+
+	// Documentation is included with notifications to help responders
+	// understand and handle the incident.
+	// +optional
+	Documentation *Documentation `json:"documentation,omitempty"`
+
+	// UserLabels are user-supplied key/value pairs attached to the policy.
+	// +optional
+	UserLabels map[string]string `json:"userLabels,omitempty"`
+
+	// Conditions that determine whether the policy is violated. Conditions
+	// are matched by display name and updated in place.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=6
+	Conditions []Condition `json:"conditions"`
+
+	// Combiner determines how the results of multiple conditions are combined
+	// to determine whether an incident should be opened.
+	// +kubebuilder:validation:Enum=AND;OR;AND_WITH_MATCHING_RESOURCE
+	Combiner string `json:"combiner"`
+
+	// Enabled controls whether the policy is evaluated. Defaults to true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// NotificationChannels are the resource names of the channels that are
+	// notified when an incident is opened or closed, in the form
+	// projects/{project}/notificationChannels/{channel}.
+	// +optional
+	NotificationChannels []string `json:"notificationChannels,omitempty"`
+
+	// NotificationChannelRefs reference NotificationChannels and retrieve
+	// their resource names.
+	// +optional
+	NotificationChannelRefs []xpv1.Reference `json:"notificationChannelRefs,omitempty"`
+
+	// NotificationChannelSelector selects references to NotificationChannels
+	// and retrieves their resource names.
+	// +optional
+	NotificationChannelSelector *xpv1.Selector `json:"notificationChannelSelector,omitempty"`
+
+	// Severity of the incidents opened by the policy.
+	// +kubebuilder:validation:Enum=CRITICAL;ERROR;WARNING
+	// +optional
+	Severity *string `json:"severity,omitempty"`
+
+	// AlertStrategy controls how incidents are closed.
+	// +optional
+	AlertStrategy *AlertStrategy `json:"alertStrategy,omitempty"`
+}
+
+// Documentation of an AlertPolicy.
+type Documentation struct {
+	// Content of the documentation.
+	// +optional
+	Content *string `json:"content,omitempty"`
+
+	// MimeType of the content. Only text/markdown is supported.
+	// +optional
+	MimeType *string `json:"mimeType,omitempty"`
+
+	// Subject line of the notifications, up to 255 characters.
+	// +optional
+	Subject *string `json:"subject,omitempty"`
+}
+
+// AlertStrategy controls how the incidents of an AlertPolicy are closed.
+type AlertStrategy struct {
+	// AutoClose is the duration after which an incident without new data is
+	// closed automatically, in seconds with up to nine fractional digits
+	// terminated by 's'.
+	// +optional
+	AutoClose *string `json:"autoClose,omitempty"`
+}
+
+// A Condition of an AlertPolicy. Exactly one of the condition types must be
+// set.
+type Condition struct {
+	// DisplayName of the condition, which identifies it within the policy.
+	DisplayName string `json:"displayName"`
+
+	// ConditionThreshold is violated when a time series crosses a threshold.
+	// +optional
+	ConditionThreshold *MetricThreshold `json:"conditionThreshold,omitempty"`
+
+	// ConditionAbsent is violated when a time series has no data.
+	// +optional
+	ConditionAbsent *MetricAbsence `json:"conditionAbsent,omitempty"`
+
+	// ConditionMonitoringQueryLanguage is violated when a Monitoring Query
+	// Language query returns results.
+	// +optional
+	ConditionMonitoringQueryLanguage *MonitoringQueryLanguageCondition `json:"conditionMonitoringQueryLanguage,omitempty"`
+
+	// ConditionPrometheusQueryLanguage is violated when a PromQL query
+	// returns results.
+	// +optional
+	ConditionPrometheusQueryLanguage *PrometheusQueryLanguageCondition `json:"conditionPrometheusQueryLanguage,omitempty"`
+}
+
+// MetricThreshold is a Condition that compares time series with a threshold.
+type MetricThreshold struct {
+	// Filter selects the time series to compare with the threshold.
+	Filter string `json:"filter"`
+
+	// Aggregations to apply to the selected time series.
+	// +optional
+	Aggregations []Aggregation `json:"aggregations,omitempty"`
+
+	// DenominatorFilter selects time series the selected time series are
+	// divided by before they are compared with the threshold.
+	// +optional
+	DenominatorFilter *string `json:"denominatorFilter,omitempty"`
+
+	// DenominatorAggregations to apply to the denominator time series.
+	// +optional
+	DenominatorAggregations []Aggregation `json:"denominatorAggregations,omitempty"`
+
+	// Comparison between the time series and the threshold.
+	// +kubebuilder:validation:Enum=COMPARISON_GT;COMPARISON_GE;COMPARISON_LT;COMPARISON_LE;COMPARISON_EQ;COMPARISON_NE
+	Comparison string `json:"comparison"`
+
+	// ThresholdValue the time series are compared with, as a decimal
+	// number.
+	// +kubebuilder:validation:Pattern=`^-?[0-9]+(\.[0-9]+)?$`
+	// +optional
+	ThresholdValue *string `json:"thresholdValue,omitempty"`
+
+	// Duration the threshold has to be crossed for the condition to be
+	// violated, for example 300s.
+	Duration string `json:"duration"`
+
+	// Trigger determines how many time series have to cross the threshold.
+	// +optional
+	Trigger *Trigger `json:"trigger,omitempty"`
+
+	// EvaluationMissingData controls how data gaps are evaluated.
+	// +kubebuilder:validation:Enum=EVALUATION_MISSING_DATA_INACTIVE;EVALUATION_MISSING_DATA_ACTIVE;EVALUATION_MISSING_DATA_NO_OP
+	// +optional
+	EvaluationMissingData *string `json:"evaluationMissingData,omitempty"`
+}
+
+// MetricAbsence is a Condition that detects missing time series data.
+type MetricAbsence struct {
+	// Filter selects the time series that are expected to have data.
+	Filter string `json:"filter"`
+
+	// Aggregations to apply to the selected time series.
+	// +optional
+	Aggregations []Aggregation `json:"aggregations,omitempty"`
+
+	// Duration data has to be missing for the condition to be violated, for
+	// example 300s.
+	Duration string `json:"duration"`
+
+	// Trigger determines how many time series have to be missing data.
+	// +optional
+	Trigger *Trigger `json:"trigger,omitempty"`
+}
+
+// MonitoringQueryLanguageCondition is a Condition defined by a Monitoring
+// Query Language query.
+type MonitoringQueryLanguageCondition struct {
+	// Query in the Monitoring Query Language.
+	Query string `json:"query"`
+
+	// Duration the query has to return results for the condition to be
+	// violated, for example 300s.
+	Duration string `json:"duration"`
+
+	// Trigger determines how many time series have to be returned.
+	// +optional
+	Trigger *Trigger `json:"trigger,omitempty"`
+
+	// EvaluationMissingData controls how data gaps are evaluated.
+	// +kubebuilder:validation:Enum=EVALUATION_MISSING_DATA_INACTIVE;EVALUATION_MISSING_DATA_ACTIVE;EVALUATION_MISSING_DATA_NO_OP
+	// +optional
+	EvaluationMissingData *string `json:"evaluationMissingData,omitempty"`
+}
+
+// PrometheusQueryLanguageCondition is a Condition defined by a PromQL query.
+type PrometheusQueryLanguageCondition struct {
+	// Query in PromQL.
+	Query string `json:"query"`
+
+	// Duration the query has to return results for the condition to be
+	// violated, for example 300s.
+	// +optional
+	Duration *string `json:"duration,omitempty"`
+
+	// EvaluationInterval of the query, for example 30s.
+	// +optional
+	EvaluationInterval *string `json:"evaluationInterval,omitempty"`
+
+	// Labels added to the time series returned by the query.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// RuleGroup is the name of the Prometheus rule group the condition
+	// was migrated from.
+	// +optional
+	RuleGroup *string `json:"ruleGroup,omitempty"`
+
+	// AlertRule is the name of the Prometheus alerting rule the condition
+	// was migrated from.
+	// +optional
+	AlertRule *string `json:"alertRule,omitempty"`
+}
+
+// An Aggregation combines the points of time series.
+type Aggregation struct {
+	// AlignmentPeriod of the points of each time series, for example 60s.
+	// +optional
+	AlignmentPeriod *string `json:"alignmentPeriod,omitempty"`
+
+	// PerSeriesAligner aligns the points of each time series, for example
+	// ALIGN_RATE.
+	// +optional
+	PerSeriesAligner *string `json:"perSeriesAligner,omitempty"`
+
+	// CrossSeriesReducer combines the aligned time series, for example
+	// REDUCE_SUM.
+	// +optional
+	CrossSeriesReducer *string `json:"crossSeriesReducer,omitempty"`
+
+	// GroupByFields preserved by the reduction.
+	// +optional
+	GroupByFields []string `json:"groupByFields,omitempty"`
+}
+
+// A Trigger determines how many time series have to violate a Condition.
+// Only one of Count and Percent may be set.
+type Trigger struct {
+	// Count of time series.
+	// +optional
+	Count *int64 `json:"count,omitempty"`
+
+	// Percent of time series, as a decimal number.
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	// +optional
+	Percent *string `json:"percent,omitempty"`
+}
+
+// AlertPolicyObservation is used to show the observed state of the
+// AlertPolicy.
+type AlertPolicyObservation struct {
+	// Name is the resource name of the policy.
+	Name string `json:"name,omitempty"`
+
+	// Conditions of the policy as they were observed.
+	Conditions []ConditionObservation `json:"conditions,omitempty"`
+
+	// CreationTime of the policy.
+	CreationTime string `json:"creationTime,omitempty"`
+
+	// MutationTime is the time the policy was last changed.
+	MutationTime string `json:"mutationTime,omitempty"`
+}
+
+// ConditionObservation is used to show the observed state of a Condition.
+type ConditionObservation struct {
+	// Name is the resource name of the condition.
+	Name string `json:"name,omitempty"`
+
+	// DisplayName of the condition.
+	DisplayName string `json:"displayName,omitempty"`
+}
+
+// A AlertPolicySpec defines the desired state of a AlertPolicy.
+type AlertPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AlertPolicyParameters `json:"forProvider"`
+}
+
+// A AlertPolicyStatus represents the observed state of a AlertPolicy.
+type AlertPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AlertPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AlertPolicy is a managed resource that represents a Cloud Monitoring alert policy.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DISPLAY-NAME",type="string",JSONPath=".spec.forProvider.displayName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type AlertPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AlertPolicySpec   `json:"spec"`
+	Status AlertPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AlertPolicyList contains a list of AlertPolicy
+type AlertPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AlertPolicy `json:"items"`
+}
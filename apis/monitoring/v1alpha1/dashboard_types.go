@@ -0,0 +1,121 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// DashboardParameters define the desired state of a Cloud Monitoring
+// dashboard. Exactly one of DashboardJSON and DashboardJSONConfigMapRef must
+// be set:
+// https://cloud.google.com/monitoring/api/ref_v3/rest/v1/projects.dashboards
+type DashboardParameters struct {
+	// Project the dashboard belongs to. Defaults to the project of the provider
+	// config.
+	// +immutable
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// ProjectRef references a Project and retrieves its external name.
+	// +immutable
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and retrieves its
+	// external name.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// DashboardJSON is the dashboard in the JSON representation of the
+	// Dashboards API, as exported by gcloud monitoring dashboards describe
+	// --format=json. Its name and etag are ignored.
+	// +optional
+	DashboardJSON *string `json:"dashboardJson,omitempty"`
+
+	// DashboardJSONConfigMapRef selects a key of a ConfigMap that contains the
+	// dashboard in the JSON representation of the Dashboards API.
+	// +optional
+	DashboardJSONConfigMapRef *ConfigMapKeySelector `json:"dashboardJsonConfigMapRef,omitempty"`
+
+	// DisplayName of the dashboard. Overrides the display name in the JSON
+	// representation.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+}
+
+// A ConfigMapKeySelector is a reference to a ConfigMap key in an arbitrary
+// namespace.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// Key within the ConfigMap.
+	Key string `json:"key"`
+}
+
+// DashboardObservation is used to show the observed state of the Dashboard.
+type DashboardObservation struct {
+	// Name is the resource name of the dashboard.
+	Name string `json:"name,omitempty"`
+
+	// Etag of the dashboard, which changes whenever it is updated.
+	Etag string `json:"etag,omitempty"`
+}
+
+// A DashboardSpec defines the desired state of a Dashboard.
+type DashboardSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DashboardParameters `json:"forProvider"`
+}
+
+// A DashboardStatus represents the observed state of a Dashboard.
+type DashboardStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DashboardObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Dashboard is a managed resource that represents a Cloud Monitoring dashboard.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DISPLAY-NAME",type="string",JSONPath=".spec.forProvider.displayName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Dashboard struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DashboardSpec   `json:"spec"`
+	Status DashboardStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DashboardList contains a list of Dashboard
+type DashboardList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Dashboard `json:"items"`
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Keys of the sensitive labels of a NotificationChannel. Cloud Monitoring
+// never returns their values.
+const (
+	LabelAuthToken  = "auth_token"
+	LabelPassword   = "password"
+	LabelServiceKey = "service_key"
+)
+
+// NotificationChannelParameters define the desired state of a Cloud
+// Monitoring notification channel:
+// https://cloud.google.com/monitoring/api/ref_v3/rest/v3/projects.notificationChannels
+type NotificationChannelParameters struct {
+	// Project the channel belongs to. Defaults to the project of the provider
+	// config.
+	// +immutable
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// ProjectRef references a Project and retrieves its external name.
+	// +immutable
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and retrieves its
+	// external name.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// Type of the channel, for example email, slack or pagerduty. The
+	// supported types and their labels are listed by the
+	// notificationChannelDescriptors API.
+	// +immutable
+	Type string `json:"type"`
+
+	// DisplayName of the channel.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Description of the channel.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels configure the channel, for example the email_address of an
+	// email channel or the channel_name of a Slack channel. Sensitive labels
+	// are set via SensitiveLabels instead.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// SensitiveLabels configure the channel with values read from Secrets.
+	// Cloud Monitoring does not return them, so changing a Secret does not
+	// update the channel until another field changes.
+	// +optional
+	SensitiveLabels *SensitiveLabels `json:"sensitiveLabels,omitempty"`
+
+	// UserLabels are user-supplied key/value pairs attached to the channel.
+	// +optional
+	UserLabels map[string]string `json:"userLabels,omitempty"`
+
+	// Enabled controls whether notifications are sent to the channel.
+	// Defaults to true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// SensitiveLabels of a NotificationChannel.
+type SensitiveLabels struct {
+	// AuthTokenSecretRef references the auth_token of the channel, such as
+	// the OAuth token of a Slack channel.
+	// +optional
+	AuthTokenSecretRef *xpv1.SecretKeySelector `json:"authTokenSecretRef,omitempty"`
+
+	// PasswordSecretRef references the password of the channel, such as the
+	// basic auth password of a webhook.
+	// +optional
+	PasswordSecretRef *xpv1.SecretKeySelector `json:"passwordSecretRef,omitempty"`
+
+	// ServiceKeySecretRef references the service_key of the channel, such as
+	// the integration key of a PagerDuty service.
+	// +optional
+	ServiceKeySecretRef *xpv1.SecretKeySelector `json:"serviceKeySecretRef,omitempty"`
+}
+
+// NotificationChannelObservation is used to show the observed state of the
+// NotificationChannel.
+type NotificationChannelObservation struct {
+	// Name is the resource name of the channel, which is used to reference
+	// it from alert policies.
+	Name string `json:"name,omitempty"`
+
+	// VerificationStatus of the channel. Only some channel types, like email,
+	// are verified.
+	VerificationStatus string `json:"verificationStatus,omitempty"`
+}
+
+// A NotificationChannelSpec defines the desired state of a NotificationChannel.
+type NotificationChannelSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NotificationChannelParameters `json:"forProvider"`
+}
+
+// A NotificationChannelStatus represents the observed state of a NotificationChannel.
+type NotificationChannelStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NotificationChannelObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NotificationChannel is a managed resource that represents a Cloud Monitoring notification channel.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="VERIFICATION",type="string",JSONPath=".status.atProvider.verificationStatus"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type NotificationChannel struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NotificationChannelSpec   `json:"spec"`
+	Status NotificationChannelStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NotificationChannelList contains a list of NotificationChannel
+type NotificationChannelList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NotificationChannel `json:"items"`
+}
@@ -0,0 +1,238 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	cloudrunv1alpha1 "github.com/crossplane/provider-gcp/apis/cloudrun/v1alpha1"
+	containerv1beta2 "github.com/crossplane/provider-gcp/apis/container/v1beta2"
+	resourcemanagerv1alpha1 "github.com/crossplane/provider-gcp/apis/resourcemanager/v1alpha1"
+)
+
+// NotificationChannelName extracts the resource name of a
+// NotificationChannel.
+func NotificationChannelName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		nc, ok := mg.(*NotificationChannel)
+		if !ok {
+			return ""
+		}
+		return nc.Status.AtProvider.Name
+	}
+}
+
+// ServiceName extracts the resource name of a Service.
+func ServiceName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		s, ok := mg.(*Service)
+		if !ok {
+			return ""
+		}
+		return s.Status.AtProvider.Name
+	}
+}
+
+// ResolveReferences of this NotificationChannel
+func (in *NotificationChannel) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.project
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Project),
+		Reference:    in.Spec.ForProvider.ProjectRef,
+		Selector:     in.Spec.ForProvider.ProjectSelector,
+		To:           reference.To{Managed: &resourcemanagerv1alpha1.Project{}, List: &resourcemanagerv1alpha1.ProjectList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.project")
+	}
+	in.Spec.ForProvider.Project = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.ProjectRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this AlertPolicy
+func (in *AlertPolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.project
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Project),
+		Reference:    in.Spec.ForProvider.ProjectRef,
+		Selector:     in.Spec.ForProvider.ProjectSelector,
+		To:           reference.To{Managed: &resourcemanagerv1alpha1.Project{}, List: &resourcemanagerv1alpha1.ProjectList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.project")
+	}
+	in.Spec.ForProvider.Project = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.ProjectRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.notificationChannels
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: in.Spec.ForProvider.NotificationChannels,
+		References:    in.Spec.ForProvider.NotificationChannelRefs,
+		Selector:      in.Spec.ForProvider.NotificationChannelSelector,
+		To:            reference.To{Managed: &NotificationChannel{}, List: &NotificationChannelList{}},
+		Extract:       NotificationChannelName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.notificationChannels")
+	}
+	in.Spec.ForProvider.NotificationChannels = mrsp.ResolvedValues
+	in.Spec.ForProvider.NotificationChannelRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this UptimeCheckConfig
+func (in *UptimeCheckConfig) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.project
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Project),
+		Reference:    in.Spec.ForProvider.ProjectRef,
+		Selector:     in.Spec.ForProvider.ProjectSelector,
+		To:           reference.To{Managed: &resourcemanagerv1alpha1.Project{}, List: &resourcemanagerv1alpha1.ProjectList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.project")
+	}
+	in.Spec.ForProvider.Project = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.ProjectRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Dashboard
+func (in *Dashboard) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.project
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Project),
+		Reference:    in.Spec.ForProvider.ProjectRef,
+		Selector:     in.Spec.ForProvider.ProjectSelector,
+		To:           reference.To{Managed: &resourcemanagerv1alpha1.Project{}, List: &resourcemanagerv1alpha1.ProjectList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.project")
+	}
+	in.Spec.ForProvider.Project = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.ProjectRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Service
+func (in *Service) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.project
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Project),
+		Reference:    in.Spec.ForProvider.ProjectRef,
+		Selector:     in.Spec.ForProvider.ProjectSelector,
+		To:           reference.To{Managed: &resourcemanagerv1alpha1.Project{}, List: &resourcemanagerv1alpha1.ProjectList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.project")
+	}
+	in.Spec.ForProvider.Project = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.ProjectRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.cloudRun.serviceName
+	if cr := in.Spec.ForProvider.CloudRun; cr != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(cr.ServiceName),
+			Reference:    cr.ServiceNameRef,
+			Selector:     cr.ServiceNameSelector,
+			To:           reference.To{Managed: &cloudrunv1alpha1.Service{}, List: &cloudrunv1alpha1.ServiceList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.cloudRun.serviceName")
+		}
+		cr.ServiceName = reference.ToPtrValue(rsp.ResolvedValue)
+		cr.ServiceNameRef = rsp.ResolvedReference
+	}
+
+	if s := in.Spec.ForProvider.GKEService; s != nil {
+		if err := resolveGKECluster(ctx, r, &s.GKECluster, "spec.forProvider.gkeService.clusterName"); err != nil {
+			return err
+		}
+	}
+	if w := in.Spec.ForProvider.GKEWorkload; w != nil {
+		if err := resolveGKECluster(ctx, r, &w.GKECluster, "spec.forProvider.gkeWorkload.clusterName"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resolveGKECluster resolves the cluster name of the supplied GKECluster.
+func resolveGKECluster(ctx context.Context, r *reference.APIResolver, g *GKECluster, path string) error {
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(g.ClusterName),
+		Reference:    g.ClusterNameRef,
+		Selector:     g.ClusterNameSelector,
+		To:           reference.To{Managed: &containerv1beta2.Cluster{}, List: &containerv1beta2.ClusterList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, path)
+	}
+	g.ClusterName = reference.ToPtrValue(rsp.ResolvedValue)
+	g.ClusterNameRef = rsp.ResolvedReference
+	return nil
+}
+
+// ResolveReferences of this ServiceLevelObjective
+func (in *ServiceLevelObjective) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.service
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Service),
+		Reference:    in.Spec.ForProvider.ServiceRef,
+		Selector:     in.Spec.ForProvider.ServiceSelector,
+		To:           reference.To{Managed: &Service{}, List: &ServiceList{}},
+		Extract:      ServiceName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.service")
+	}
+	in.Spec.ForProvider.Service = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.ServiceRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ServiceParameters define the desired state of a Cloud Monitoring service,
+// a collection of telemetry that service level objectives are defined on:
+// https://cloud.google.com/monitoring/api/ref_v3/rest/v3/services
+type ServiceParameters struct {
+	// Project the service belongs to. Defaults to the project of the provider
+	// config.
+	// +immutable
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// ProjectRef references a Project and retrieves its external name.
+	// +immutable
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and retrieves its
+	// external name.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// DisplayName of the service.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Custom marks the service as a custom service whose telemetry is
+	// selected by the filters of its service level indicators. Exactly one
+	// of Custom, CloudRun, GKEService and GKEWorkload must be set.
+	// +immutable
+	// +optional
+	Custom *CustomService `json:"custom,omitempty"`
+
+	// CloudRun identifies a Cloud Run service.
+	// +immutable
+	// +optional
+	CloudRun *CloudRunService `json:"cloudRun,omitempty"`
+
+	// GKEService identifies a Kubernetes Service running in a GKE cluster.
+	// +immutable
+	// +optional
+	GKEService *GKEService `json:"gkeService,omitempty"`
+
+	// GKEWorkload identifies a workload running in a GKE cluster.
+	// +immutable
+	// +optional
+	GKEWorkload *GKEWorkload `json:"gkeWorkload,omitempty"`
+
+	// UserLabels to attach to the service.
+	// +optional
+	UserLabels map[string]string `json:"userLabels,omitempty"`
+}
+
+// CustomService has no configuration; its telemetry is described entirely by
+// the service level indicators defined on it.
+type CustomService struct{}
+
+// CloudRunService identifies a Cloud Run service.
+type CloudRunService struct {
+	// ServiceName is the name of the Cloud Run service.
+	// +optional
+	ServiceName *string `json:"serviceName,omitempty"`
+
+	// ServiceNameRef references a Cloud Run Service and retrieves its
+	// external name.
+	// +optional
+	ServiceNameRef *xpv1.Reference `json:"serviceNameRef,omitempty"`
+
+	// ServiceNameSelector selects a reference to a Cloud Run Service and
+	// retrieves its external name.
+	// +optional
+	ServiceNameSelector *xpv1.Selector `json:"serviceNameSelector,omitempty"`
+
+	// Location of the Cloud Run service, e.g. us-central1.
+	Location string `json:"location"`
+}
+
+// GKECluster identifies the GKE cluster a service or workload runs in.
+type GKECluster struct {
+	// Location of the cluster, a zone or a region.
+	Location string `json:"location"`
+
+	// ClusterName is the name of the cluster.
+	// +optional
+	ClusterName *string `json:"clusterName,omitempty"`
+
+	// ClusterNameRef references a GKE Cluster and retrieves its external
+	// name.
+	// +optional
+	ClusterNameRef *xpv1.Reference `json:"clusterNameRef,omitempty"`
+
+	// ClusterNameSelector selects a reference to a GKE Cluster and retrieves
+	// its external name.
+	// +optional
+	ClusterNameSelector *xpv1.Selector `json:"clusterNameSelector,omitempty"`
+
+	// NamespaceName is the Kubernetes namespace the service or workload
+	// lives in.
+	NamespaceName string `json:"namespaceName"`
+}
+
+// GKEService identifies a Kubernetes Service running in a GKE cluster.
+type GKEService struct {
+	GKECluster `json:",inline"`
+
+	// ServiceName is the name of the Kubernetes Service.
+	ServiceName string `json:"serviceName"`
+}
+
+// GKEWorkload identifies a workload running in a GKE cluster.
+type GKEWorkload struct {
+	GKECluster `json:",inline"`
+
+	// TopLevelControllerType is the kind of the controller of the workload,
+	// e.g. Deployment or StatefulSet.
+	TopLevelControllerType string `json:"topLevelControllerType"`
+
+	// TopLevelControllerName is the name of the controller of the workload.
+	TopLevelControllerName string `json:"topLevelControllerName"`
+}
+
+// ServiceObservation is used to show the observed state of the Service.
+type ServiceObservation struct {
+	// Name is the resource name of the service.
+	Name string `json:"name,omitempty"`
+
+	// TelemetryResourceName is the full name of the resource that the
+	// service's telemetry is attributed to.
+	TelemetryResourceName string `json:"telemetryResourceName,omitempty"`
+}
+
+// A ServiceSpec defines the desired state of a Service.
+type ServiceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServiceParameters `json:"forProvider"`
+}
+
+// A ServiceStatus represents the observed state of a Service.
+type ServiceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ServiceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Service is a managed resource that represents a Cloud Monitoring service.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DISPLAY-NAME",type="string",JSONPath=".spec.forProvider.displayName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Service struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceSpec   `json:"spec"`
+	Status ServiceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceList contains a list of Service
+type ServiceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Service `json:"items"`
+}
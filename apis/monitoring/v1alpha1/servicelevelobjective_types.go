@@ -0,0 +1,279 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ServiceLevelObjectiveParameters define the desired state of a Cloud
+// Monitoring service level objective:
+// https://cloud.google.com/monitoring/api/ref_v3/rest/v3/services.serviceLevelObjectives
+type ServiceLevelObjectiveParameters struct {
+	// Service the objective is defined on, in the form
+	// projects/{project}/services/{service}.
+	// +immutable
+	// +optional
+	Service *string `json:"service,omitempty"`
+
+	// ServiceRef references a Service and retrieves its resource name.
+	// +immutable
+	// +optional
+	ServiceRef *xpv1.Reference `json:"serviceRef,omitempty"`
+
+	// ServiceSelector selects a reference to a Service and retrieves its
+	// resource name.
+	// +optional
+	ServiceSelector *xpv1.Selector `json:"serviceSelector,omitempty"`
+
+	// DisplayName of the objective.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Goal is the fraction of good service that is aimed for, as a decimal
+	// number between 0 and 1, e.g. 0.999.
+	// +kubebuilder:validation:Pattern=`^0(\.[0-9]+)?$`
+	Goal string `json:"goal"`
+
+	// RollingPeriod is the duration the goal is evaluated over, a multiple of
+	// a day between 1 and 30 days, e.g. 2419200s. Exactly one of
+	// RollingPeriod and CalendarPeriod must be set.
+	// +optional
+	RollingPeriod *string `json:"rollingPeriod,omitempty"`
+
+	// CalendarPeriod the goal is evaluated over.
+	// +kubebuilder:validation:Enum=DAY;WEEK;FORTNIGHT;MONTH
+	// +optional
+	CalendarPeriod *string `json:"calendarPeriod,omitempty"`
+
+	// ServiceLevelIndicator that measures the service.
+	ServiceLevelIndicator ServiceLevelIndicator `json:"serviceLevelIndicator"`
+
+	// UserLabels to attach to the objective.
+	// +optional
+	UserLabels map[string]string `json:"userLabels,omitempty"`
+}
+
+// A ServiceLevelIndicator describes what good service is. Exactly one of
+// BasicSLI, RequestBased and WindowsBased must be set.
+type ServiceLevelIndicator struct {
+	// BasicSLI uses the telemetry Cloud Monitoring collects for the service
+	// automatically. It is only supported for Cloud Run and GKE services.
+	// +optional
+	BasicSLI *BasicSLI `json:"basicSli,omitempty"`
+
+	// RequestBased counts good requests among all requests.
+	// +optional
+	RequestBased *RequestBasedSLI `json:"requestBased,omitempty"`
+
+	// WindowsBased counts good time windows among all time windows.
+	// +optional
+	WindowsBased *WindowsBasedSLI `json:"windowsBased,omitempty"`
+}
+
+// BasicSLI is an availability or latency indicator based on the telemetry
+// of the service.
+type BasicSLI struct {
+	// Method limits the indicator to the supplied API methods.
+	// +optional
+	Method []string `json:"method,omitempty"`
+
+	// Location limits the indicator to the supplied locations.
+	// +optional
+	Location []string `json:"location,omitempty"`
+
+	// Version limits the indicator to the supplied API versions.
+	// +optional
+	Version []string `json:"version,omitempty"`
+
+	// Availability counts requests that did not fail as good. Exactly one of
+	// Availability and Latency must be set.
+	// +optional
+	Availability *AvailabilityCriteria `json:"availability,omitempty"`
+
+	// Latency counts requests that were served within a threshold as good.
+	// +optional
+	Latency *LatencyCriteria `json:"latency,omitempty"`
+}
+
+// AvailabilityCriteria has no configuration.
+type AvailabilityCriteria struct{}
+
+// LatencyCriteria of a BasicSLI.
+type LatencyCriteria struct {
+	// Threshold below which requests are good, e.g. 0.5s.
+	Threshold string `json:"threshold"`
+}
+
+// RequestBasedSLI counts good requests among all requests. Exactly one of
+// GoodTotalRatio and DistributionCut must be set.
+type RequestBasedSLI struct {
+	// GoodTotalRatio selects good, bad and total requests using time series
+	// filters.
+	// +optional
+	GoodTotalRatio *TimeSeriesRatio `json:"goodTotalRatio,omitempty"`
+
+	// DistributionCut counts the requests of a distribution metric that fall
+	// within a range as good.
+	// +optional
+	DistributionCut *DistributionCut `json:"distributionCut,omitempty"`
+}
+
+// TimeSeriesRatio selects good, bad and total requests. Two of the three
+// filters must be set.
+type TimeSeriesRatio struct {
+	// GoodServiceFilter selects the time series that count good requests.
+	// +optional
+	GoodServiceFilter *string `json:"goodServiceFilter,omitempty"`
+
+	// BadServiceFilter selects the time series that count bad requests.
+	// +optional
+	BadServiceFilter *string `json:"badServiceFilter,omitempty"`
+
+	// TotalServiceFilter selects the time series that count all requests.
+	// +optional
+	TotalServiceFilter *string `json:"totalServiceFilter,omitempty"`
+}
+
+// DistributionCut counts the values of a distribution that fall within a
+// range as good.
+type DistributionCut struct {
+	// DistributionFilter selects a time series of a distribution metric
+	// with DELTA or CUMULATIVE kind.
+	DistributionFilter string `json:"distributionFilter"`
+
+	// Range of good values.
+	Range Range `json:"range"`
+}
+
+// A Range of values, both bounds inclusive.
+type Range struct {
+	// Min of the range, as a decimal number.
+	// +kubebuilder:validation:Pattern=`^-?[0-9]+(\.[0-9]+)?$`
+	// +optional
+	Min *string `json:"min,omitempty"`
+
+	// Max of the range, as a decimal number.
+	// +kubebuilder:validation:Pattern=`^-?[0-9]+(\.[0-9]+)?$`
+	// +optional
+	Max *string `json:"max,omitempty"`
+}
+
+// WindowsBasedSLI counts good time windows among all time windows. Exactly
+// one of GoodBadMetricFilter, GoodTotalRatioThreshold, MetricMeanInRange and
+// MetricSumInRange must be set.
+type WindowsBasedSLI struct {
+	// WindowPeriod is the duration of a window, a multiple of 60s between
+	// 60s and 86400s.
+	WindowPeriod string `json:"windowPeriod"`
+
+	// GoodBadMetricFilter selects a boolean time series that tells whether a
+	// window is good.
+	// +optional
+	GoodBadMetricFilter *string `json:"goodBadMetricFilter,omitempty"`
+
+	// GoodTotalRatioThreshold counts a window as good if the performance of
+	// an indicator within the window meets a threshold.
+	// +optional
+	GoodTotalRatioThreshold *PerformanceThreshold `json:"goodTotalRatioThreshold,omitempty"`
+
+	// MetricMeanInRange counts a window as good if the mean of a time series
+	// within the window falls within a range.
+	// +optional
+	MetricMeanInRange *MetricRange `json:"metricMeanInRange,omitempty"`
+
+	// MetricSumInRange counts a window as good if the sum of a time series
+	// within the window falls within a range.
+	// +optional
+	MetricSumInRange *MetricRange `json:"metricSumInRange,omitempty"`
+}
+
+// PerformanceThreshold counts a window as good if the performance of an
+// indicator meets a threshold. Exactly one of Performance and
+// BasicSLIPerformance must be set.
+type PerformanceThreshold struct {
+	// Performance is a request based indicator evaluated per window.
+	// +optional
+	Performance *RequestBasedSLI `json:"performance,omitempty"`
+
+	// BasicSLIPerformance is a basic indicator evaluated per window.
+	// +optional
+	BasicSLIPerformance *BasicSLI `json:"basicSliPerformance,omitempty"`
+
+	// Threshold the performance has to meet, as a decimal number between 0
+	// and 1.
+	// +kubebuilder:validation:Pattern=`^[01](\.[0-9]+)?$`
+	Threshold string `json:"threshold"`
+}
+
+// MetricRange counts a window as good if a time series falls within a
+// range.
+type MetricRange struct {
+	// TimeSeries selects a single time series.
+	TimeSeries string `json:"timeSeries"`
+
+	// Range of good values.
+	Range Range `json:"range"`
+}
+
+// ServiceLevelObjectiveObservation is used to show the observed state of the
+// ServiceLevelObjective.
+type ServiceLevelObjectiveObservation struct {
+	// Name is the resource name of the objective.
+	Name string `json:"name,omitempty"`
+}
+
+// A ServiceLevelObjectiveSpec defines the desired state of a ServiceLevelObjective.
+type ServiceLevelObjectiveSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServiceLevelObjectiveParameters `json:"forProvider"`
+}
+
+// A ServiceLevelObjectiveStatus represents the observed state of a ServiceLevelObjective.
+type ServiceLevelObjectiveStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ServiceLevelObjectiveObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ServiceLevelObjective is a managed resource that represents a Cloud Monitoring service level objective.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="GOAL",type="string",JSONPath=".spec.forProvider.goal"
+// +kubebuilder:printcolumn:name="SERVICE",type="string",JSONPath=".spec.forProvider.service"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type ServiceLevelObjective struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceLevelObjectiveSpec   `json:"spec"`
+	Status ServiceLevelObjectiveStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceLevelObjectiveList contains a list of ServiceLevelObjective
+type ServiceLevelObjectiveList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceLevelObjective `json:"items"`
+}
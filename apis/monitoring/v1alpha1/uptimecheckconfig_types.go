@@ -0,0 +1,241 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// UptimeCheckConfigParameters define the desired state of a Cloud Monitoring
+// uptime check, which probes a public or private endpoint from a set of
+// regions:
+// https://cloud.google.com/monitoring/api/ref_v3/rest/v3/projects.uptimeCheckConfigs
+type UptimeCheckConfigParameters struct {
+	// Project the check belongs to. Defaults to the project of the provider
+	// config.
+	// +immutable
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// ProjectRef references a Project and retrieves its external name.
+	// +immutable
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and retrieves its
+	// external name.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// DisplayName of the check.
+	DisplayName string `json:"displayName"`
+
+	// MonitoredResource that is checked, for example an uptime_url with a
+	// host label.
+	// +immutable
+	MonitoredResource MonitoredResource `json:"monitoredResource"`
+
+	// HTTPCheck probes the resource via HTTP(S). Exactly one of HTTPCheck and
+	// TCPCheck must be set.
+	// +optional
+	HTTPCheck *HTTPCheck `json:"httpCheck,omitempty"`
+
+	// TCPCheck probes the resource via TCP.
+	// +optional
+	TCPCheck *TCPCheck `json:"tcpCheck,omitempty"`
+
+	// Period between two checks. Defaults to 60s.
+	// +kubebuilder:validation:Enum="60s";"300s";"600s";"900s"
+	// +immutable
+	// +optional
+	Period *string `json:"period,omitempty"`
+
+	// Timeout of a check, between 1s and 60s.
+	// +optional
+	Timeout *string `json:"timeout,omitempty"`
+
+	// ContentMatchers the response has to match for a check to succeed.
+	// +optional
+	ContentMatchers []ContentMatcher `json:"contentMatchers,omitempty"`
+
+	// SelectedRegions the resource is checked from, for example USA or
+	// EUROPE. Defaults to all regions. At least three regions have to be
+	// selected if any are.
+	// +optional
+	SelectedRegions []string `json:"selectedRegions,omitempty"`
+
+	// CheckerType determines whether the check runs from public or VPC
+	// checkers.
+	// +kubebuilder:validation:Enum=STATIC_IP_CHECKERS;VPC_CHECKERS
+	// +immutable
+	// +optional
+	CheckerType *string `json:"checkerType,omitempty"`
+
+	// UserLabels are user-supplied key/value pairs attached to the check.
+	// +optional
+	UserLabels map[string]string `json:"userLabels,omitempty"`
+}
+
+// A MonitoredResource identifies what an UptimeCheckConfig probes.
+type MonitoredResource struct {
+	// Type of the resource, for example uptime_url, gce_instance or
+	// k8s_service.
+	Type string `json:"type"`
+
+	// Labels identifying the resource, such as the host and project_id of an
+	// uptime_url.
+	Labels map[string]string `json:"labels"`
+}
+
+// HTTPCheck configures an HTTP(S) UptimeCheckConfig.
+type HTTPCheck struct {
+	// RequestMethod of the check. Defaults to GET.
+	// +kubebuilder:validation:Enum=GET;POST
+	// +optional
+	RequestMethod *string `json:"requestMethod,omitempty"`
+
+	// UseSSL makes the check use HTTPS.
+	// +optional
+	UseSSL *bool `json:"useSsl,omitempty"`
+
+	// ValidateSSL makes the check fail if the SSL certificate is invalid.
+	// +optional
+	ValidateSSL *bool `json:"validateSsl,omitempty"`
+
+	// Path of the request, starting with a slash. Defaults to /.
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// Port of the request. Defaults to 80, or 443 if UseSSL is set.
+	// +optional
+	Port *int64 `json:"port,omitempty"`
+
+	// AuthInfo configures basic authentication for the request.
+	// +optional
+	AuthInfo *BasicAuthentication `json:"authInfo,omitempty"`
+
+	// Headers of the request.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// MaskHeaders hides the header values in the API and the console.
+	// +optional
+	MaskHeaders *bool `json:"maskHeaders,omitempty"`
+
+	// ContentType of the body of a POST request.
+	// +kubebuilder:validation:Enum=TYPE_UNSPECIFIED;URL_ENCODED;USER_PROVIDED
+	// +optional
+	ContentType *string `json:"contentType,omitempty"`
+
+	// Body of a POST request, base64 encoded.
+	// +optional
+	Body *string `json:"body,omitempty"`
+
+	// AcceptedResponseStatusCodes that count as a successful check. Defaults
+	// to all 2xx codes.
+	// +optional
+	AcceptedResponseStatusCodes []ResponseStatusCode `json:"acceptedResponseStatusCodes,omitempty"`
+}
+
+// BasicAuthentication of an HTTPCheck.
+type BasicAuthentication struct {
+	// Username to authenticate as.
+	Username string `json:"username"`
+
+	// PasswordSecretRef references the password to authenticate with. Cloud
+	// Monitoring does not return it, so changing the Secret does not update
+	// the check until another field changes.
+	PasswordSecretRef xpv1.SecretKeySelector `json:"passwordSecretRef"`
+}
+
+// A ResponseStatusCode accepted by an HTTPCheck. Exactly one of StatusValue
+// and StatusClass must be set.
+type ResponseStatusCode struct {
+	// StatusValue is an exact status code, for example 301.
+	// +optional
+	StatusValue *int64 `json:"statusValue,omitempty"`
+
+	// StatusClass is a class of status codes, for example STATUS_CLASS_2XX.
+	// +kubebuilder:validation:Enum=STATUS_CLASS_1XX;STATUS_CLASS_2XX;STATUS_CLASS_3XX;STATUS_CLASS_4XX;STATUS_CLASS_5XX;STATUS_CLASS_ANY
+	// +optional
+	StatusClass *string `json:"statusClass,omitempty"`
+}
+
+// TCPCheck configures a TCP UptimeCheckConfig.
+type TCPCheck struct {
+	// Port to connect to.
+	Port int64 `json:"port"`
+}
+
+// A ContentMatcher is matched against the response of an UptimeCheckConfig.
+type ContentMatcher struct {
+	// Content to look for.
+	Content string `json:"content"`
+
+	// Matcher determines how the content is matched. Defaults to
+	// CONTAINS_STRING.
+	// +kubebuilder:validation:Enum=CONTAINS_STRING;NOT_CONTAINS_STRING;MATCHES_REGEX;NOT_MATCHES_REGEX
+	// +optional
+	Matcher *string `json:"matcher,omitempty"`
+}
+
+// UptimeCheckConfigObservation is used to show the observed state of the
+// UptimeCheckConfig.
+type UptimeCheckConfigObservation struct {
+	// Name is the resource name of the check.
+	Name string `json:"name,omitempty"`
+}
+
+// A UptimeCheckConfigSpec defines the desired state of a UptimeCheckConfig.
+type UptimeCheckConfigSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       UptimeCheckConfigParameters `json:"forProvider"`
+}
+
+// A UptimeCheckConfigStatus represents the observed state of a UptimeCheckConfig.
+type UptimeCheckConfigStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          UptimeCheckConfigObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An UptimeCheckConfig is a managed resource that represents a Cloud Monitoring uptime check.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DISPLAY-NAME",type="string",JSONPath=".spec.forProvider.displayName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type UptimeCheckConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   UptimeCheckConfigSpec   `json:"spec"`
+	Status UptimeCheckConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// UptimeCheckConfigList contains a list of UptimeCheckConfig
+type UptimeCheckConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UptimeCheckConfig `json:"items"`
+}
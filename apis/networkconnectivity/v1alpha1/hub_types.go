@@ -0,0 +1,121 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known Hub and Spoke states.
+const (
+	StateCreating = "CREATING"
+	StateActive   = "ACTIVE"
+	StateDeleting = "DELETING"
+	StateUpdating = "UPDATING"
+	StateInactive = "INACTIVE"
+)
+
+// HubParameters define the desired state of a Network Connectivity Center
+// hub. Most fields map directly to a Hub:
+// https://cloud.google.com/network-connectivity/docs/reference/networkconnectivity/rest/v1/projects.locations.global.hubs#Hub
+type HubParameters struct {
+	// Project the hub belongs to. Defaults to the project of the provider
+	// config.
+	// +immutable
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// ProjectRef references a Project and retrieves its external name.
+	// +immutable
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and retrieves its
+	// external name.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// Description of the hub.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels to apply to the hub.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// HubObservation is used to show the observed state of a Hub.
+type HubObservation struct {
+	// Name is the fully qualified name of the hub.
+	Name string `json:"name,omitempty"`
+
+	// UniqueID is the Google-generated unique identifier of the hub.
+	UniqueID string `json:"uniqueId,omitempty"`
+
+	// State of the hub.
+	State string `json:"state,omitempty"`
+
+	// CreateTime is the time the hub was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the hub was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+
+	// RoutingVPCs are the URIs of the VPC networks that are associated with
+	// the hub through its spokes.
+	RoutingVPCs []string `json:"routingVpcs,omitempty"`
+}
+
+// A HubSpec defines the desired state of a Hub.
+type HubSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       HubParameters `json:"forProvider"`
+}
+
+// A HubStatus represents the observed state of a Hub.
+type HubStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          HubObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Hub is a managed resource that represents a Network Connectivity Center hub, the global control plane that connects the networks attached to it through spokes.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Hub struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HubSpec   `json:"spec"`
+	Status HubStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HubList contains a list of Hub
+type HubList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Hub `json:"items"`
+}
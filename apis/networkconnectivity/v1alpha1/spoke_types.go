@@ -0,0 +1,188 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// SpokeParameters define the desired state of a Network Connectivity Center
+// spoke. Exactly one of LinkedVPNTunnels, LinkedInterconnectAttachments and
+// LinkedRouterApplianceInstances must be set. Most fields map directly to a
+// Spoke:
+// https://cloud.google.com/network-connectivity/docs/reference/networkconnectivity/rest/v1/projects.locations.spokes#Spoke
+type SpokeParameters struct {
+	// Project the spoke belongs to. Defaults to the project of the provider
+	// config.
+	// +immutable
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// ProjectRef references a Project and retrieves its external name.
+	// +immutable
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and retrieves its
+	// external name.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// Location of the spoke. This is the region of the linked resources.
+	// +immutable
+	Location string `json:"location"`
+
+	// Hub the spoke is attached to, in the form
+	// projects/{project}/locations/global/hubs/{hub}.
+	// +immutable
+	// +optional
+	Hub *string `json:"hub,omitempty"`
+
+	// HubRef references a Hub and retrieves its resource name.
+	// +immutable
+	// +optional
+	HubRef *xpv1.Reference `json:"hubRef,omitempty"`
+
+	// HubSelector selects a reference to a Hub.
+	// +optional
+	HubSelector *xpv1.Selector `json:"hubSelector,omitempty"`
+
+	// Description of the spoke.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels to apply to the spoke.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// LinkedVPNTunnels attaches HA VPN tunnels to the hub.
+	// +immutable
+	// +optional
+	LinkedVPNTunnels *LinkedResources `json:"linkedVpnTunnels,omitempty"`
+
+	// LinkedInterconnectAttachments attaches VLAN attachments of Cloud
+	// Interconnect connections to the hub.
+	// +immutable
+	// +optional
+	LinkedInterconnectAttachments *LinkedResources `json:"linkedInterconnectAttachments,omitempty"`
+
+	// LinkedRouterApplianceInstances attaches router appliance instances,
+	// i.e. VMs running third party routing software, to the hub.
+	// +immutable
+	// +optional
+	LinkedRouterApplianceInstances *LinkedRouterApplianceInstances `json:"linkedRouterApplianceInstances,omitempty"`
+}
+
+// LinkedResources are VPN tunnels or interconnect attachments attached to a
+// hub by a spoke. All of them must be in the region of the spoke.
+type LinkedResources struct {
+	// URIs of the linked resources.
+	// +kubebuilder:validation:MinItems=1
+	URIs []string `json:"uris"`
+
+	// SiteToSiteDataTransfer enables data transfer between the sites that
+	// are attached to the hub through this spoke.
+	SiteToSiteDataTransfer bool `json:"siteToSiteDataTransfer"`
+}
+
+// LinkedRouterApplianceInstances are router appliance instances attached to
+// a hub by a spoke.
+type LinkedRouterApplianceInstances struct {
+	// Instances that are linked.
+	// +kubebuilder:validation:MinItems=1
+	Instances []RouterApplianceInstance `json:"instances"`
+
+	// SiteToSiteDataTransfer enables data transfer between the sites that
+	// are attached to the hub through this spoke.
+	SiteToSiteDataTransfer bool `json:"siteToSiteDataTransfer"`
+}
+
+// RouterApplianceInstance is a VM running routing software.
+type RouterApplianceInstance struct {
+	// VirtualMachine is the URI of the VM.
+	VirtualMachine string `json:"virtualMachine"`
+
+	// IPAddress of the VM's network interface that peers with the Cloud
+	// Router of the hub.
+	IPAddress string `json:"ipAddress"`
+}
+
+// SpokeObservation is used to show the observed state of a Spoke.
+type SpokeObservation struct {
+	// Name is the fully qualified name of the spoke.
+	Name string `json:"name,omitempty"`
+
+	// UniqueID is the Google-generated unique identifier of the spoke.
+	UniqueID string `json:"uniqueId,omitempty"`
+
+	// State of the spoke.
+	State string `json:"state,omitempty"`
+
+	// SpokeType is the type of resources linked by the spoke.
+	SpokeType string `json:"spokeType,omitempty"`
+
+	// VPCNetwork is the VPC network the linked resources are located in.
+	VPCNetwork string `json:"vpcNetwork,omitempty"`
+
+	// CreateTime is the time the spoke was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the spoke was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// A SpokeSpec defines the desired state of a Spoke.
+type SpokeSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SpokeParameters `json:"forProvider"`
+}
+
+// A SpokeStatus represents the observed state of a Spoke.
+type SpokeStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SpokeObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Spoke is a managed resource that represents a Network Connectivity Center spoke, which attaches hybrid connectivity resources of one region to a hub.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.forProvider.location"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".status.atProvider.spokeType"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Spoke struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SpokeSpec   `json:"spec"`
+	Status SpokeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SpokeList contains a list of Spoke
+type SpokeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Spoke `json:"items"`
+}
@@ -0,0 +1,268 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known NotebookInstance states.
+const (
+	NotebookInstanceStateStarting     = "STARTING"
+	NotebookInstanceStateProvisioning = "PROVISIONING"
+	NotebookInstanceStateInitializing = "INITIALIZING"
+	NotebookInstanceStateActive       = "ACTIVE"
+	NotebookInstanceStateStopping     = "STOPPING"
+	NotebookInstanceStateStopped      = "STOPPED"
+	NotebookInstanceStateUpgrading    = "UPGRADING"
+)
+
+// NotebookInstanceParameters define the desired state of a Vertex AI
+// Workbench instance. Most fields map directly to an Instance:
+// https://cloud.google.com/vertex-ai/docs/workbench/reference/rest/v2/projects.locations.instances#Instance
+type NotebookInstanceParameters struct {
+	// Project the instance belongs to. Defaults to the project of the
+	// provider config.
+	// +immutable
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// ProjectRef references a Project and retrieves its external name.
+	// +immutable
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and retrieves its
+	// external name.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// Location is the zone of the instance, e.g. us-central1-a.
+	// +immutable
+	Location string `json:"location"`
+
+	// MachineType of the instance, e.g. e2-standard-4. The machine type can
+	// only be changed while the instance is stopped.
+	MachineType string `json:"machineType"`
+
+	// Labels to apply to the instance.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// VMImage the instance is booted from. Defaults to the latest Workbench
+	// image.
+	// +immutable
+	// +optional
+	VMImage *VMImage `json:"vmImage,omitempty"`
+
+	// BootDisk configures the boot disk of the instance.
+	// +immutable
+	// +optional
+	BootDisk *Disk `json:"bootDisk,omitempty"`
+
+	// DataDisk configures the disk the home directories of the instance are
+	// stored on.
+	// +immutable
+	// +optional
+	DataDisk *Disk `json:"dataDisk,omitempty"`
+
+	// Network is the partially qualified URL of the VPC network the instance
+	// is connected to, in the form projects/{project}/global/networks/{network}.
+	// Defaults to the default network of the project.
+	// +immutable
+	// +optional
+	Network *string `json:"network,omitempty"`
+
+	// NetworkRef references a Network and retrieves its URL.
+	// +immutable
+	// +optional
+	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to a Network.
+	// +optional
+	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
+
+	// Subnet is the partially qualified URL of the subnetwork the instance
+	// is connected to, in the form
+	// projects/{project}/regions/{region}/subnetworks/{subnetwork}.
+	// +immutable
+	// +optional
+	Subnet *string `json:"subnet,omitempty"`
+
+	// SubnetRef references a Subnetwork and retrieves its URL.
+	// +immutable
+	// +optional
+	SubnetRef *xpv1.Reference `json:"subnetRef,omitempty"`
+
+	// SubnetSelector selects a reference to a Subnetwork.
+	// +optional
+	SubnetSelector *xpv1.Selector `json:"subnetSelector,omitempty"`
+
+	// DisablePublicIP prevents the instance from being assigned a public IP
+	// address.
+	// +immutable
+	// +optional
+	DisablePublicIP *bool `json:"disablePublicIp,omitempty"`
+
+	// Tags are the network tags of the instance.
+	// +immutable
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// ServiceAccount is the email address of the service account the
+	// instance runs as. Defaults to the Compute Engine default service
+	// account.
+	// +immutable
+	// +optional
+	ServiceAccount *string `json:"serviceAccount,omitempty"`
+
+	// ServiceAccountRef references a ServiceAccount and retrieves its email
+	// address.
+	// +immutable
+	// +optional
+	ServiceAccountRef *xpv1.Reference `json:"serviceAccountRef,omitempty"`
+
+	// ServiceAccountSelector selects a reference to a ServiceAccount.
+	// +optional
+	ServiceAccountSelector *xpv1.Selector `json:"serviceAccountSelector,omitempty"`
+
+	// InstanceOwners are the users that may use the instance, in the form
+	// of email addresses. Anyone with access to the instance's service
+	// account may use the instance if this is not set.
+	// +immutable
+	// +optional
+	InstanceOwners []string `json:"instanceOwners,omitempty"`
+
+	// IdleShutdownTimeoutMinutes is the number of minutes the instance may
+	// be idle before it's stopped. Idle instances are not stopped if this
+	// is not set.
+	// +kubebuilder:validation:Minimum=10
+	// +kubebuilder:validation:Maximum=1440
+	// +optional
+	IdleShutdownTimeoutMinutes *int64 `json:"idleShutdownTimeoutMinutes,omitempty"`
+
+	// PostStartupScript is the Cloud Storage URI of a script that is run
+	// after the instance starts, e.g. gs://bucket/setup.sh.
+	// +optional
+	PostStartupScript *string `json:"postStartupScript,omitempty"`
+
+	// PostStartupScriptBehavior determines when the post-startup script is
+	// run. Defaults to run_once.
+	// +kubebuilder:validation:Enum=run_once;run_every_start;download_and_run_every_start
+	// +optional
+	PostStartupScriptBehavior *string `json:"postStartupScriptBehavior,omitempty"`
+
+	// Metadata is additional custom metadata to apply to the instance.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// VMImage identifies a Compute Engine image.
+type VMImage struct {
+	// Project the image belongs to, e.g. cloud-notebooks-managed.
+	Project string `json:"project"`
+
+	// Family of the image. The latest image of the family is used. Exactly
+	// one of Family and Name must be set.
+	// +optional
+	Family *string `json:"family,omitempty"`
+
+	// Name of the image.
+	// +optional
+	Name *string `json:"name,omitempty"`
+}
+
+// Disk configures a disk of an instance.
+type Disk struct {
+	// DiskSizeGB is the size of the disk in GB.
+	// +optional
+	DiskSizeGB *int64 `json:"diskSizeGb,omitempty"`
+
+	// DiskType of the disk. Defaults to PD_STANDARD.
+	// +kubebuilder:validation:Enum=PD_STANDARD;PD_SSD;PD_BALANCED;PD_EXTREME
+	// +optional
+	DiskType *string `json:"diskType,omitempty"`
+}
+
+// NotebookInstanceObservation is used to show the observed state of a
+// NotebookInstance.
+type NotebookInstanceObservation struct {
+	// Name is the fully qualified name of the instance.
+	Name string `json:"name,omitempty"`
+
+	// ID is the unique ID of the instance.
+	ID string `json:"id,omitempty"`
+
+	// State of the instance.
+	State string `json:"state,omitempty"`
+
+	// HealthState of the instance.
+	HealthState string `json:"healthState,omitempty"`
+
+	// ProxyURI is the address JupyterLab is served at.
+	ProxyURI string `json:"proxyUri,omitempty"`
+
+	// Creator is the email address of the user that created the instance.
+	Creator string `json:"creator,omitempty"`
+
+	// CreateTime is the time the instance was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the instance was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// A NotebookInstanceSpec defines the desired state of a NotebookInstance.
+type NotebookInstanceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NotebookInstanceParameters `json:"forProvider"`
+}
+
+// A NotebookInstanceStatus represents the observed state of a NotebookInstance.
+type NotebookInstanceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NotebookInstanceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NotebookInstance is a managed resource that represents a Vertex AI Workbench instance, i.e. a VM that serves JupyterLab.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="MACHINE-TYPE",type="string",JSONPath=".spec.forProvider.machineType"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type NotebookInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NotebookInstanceSpec   `json:"spec"`
+	Status NotebookInstanceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NotebookInstanceList contains a list of NotebookInstance
+type NotebookInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NotebookInstance `json:"items"`
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// OrgPolicyParameters define the desired state of an organization policy.
+// A policy is identified by its parent and the constraint it configures:
+// https://cloud.google.com/resource-manager/docs/organization-policy/reference/rest/v2/organizations.policies
+type OrgPolicyParameters struct {
+	// Parent the policy is set on, in the form projects/{project_id},
+	// folders/{folder_id} or organizations/{organization_id}. Defaults to the
+	// project of the provider config.
+	// +kubebuilder:validation:Pattern=`^(projects/[a-z][-a-z0-9]*|folders/[0-9]+|organizations/[0-9]+)$`
+	// +immutable
+	// +optional
+	Parent *string `json:"parent,omitempty"`
+
+	// ProjectRef references a Project and sets the parent to it.
+	// +immutable
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and sets the parent
+	// to it.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// FolderRef references a Folder and sets the parent to it.
+	// +immutable
+	// +optional
+	FolderRef *xpv1.Reference `json:"folderRef,omitempty"`
+
+	// FolderSelector selects a reference to a Folder and sets the parent to
+	// it.
+	// +optional
+	FolderSelector *xpv1.Selector `json:"folderSelector,omitempty"`
+
+	// Constraint the policy configures, e.g.
+	// constraints/compute.vmExternalIpAccess.
+	// +kubebuilder:validation:Pattern=`^constraints/[a-zA-Z0-9.]+$`
+	// +immutable
+	Constraint string `json:"constraint"`
+
+	// InheritFromParent determines whether the rules of the policy are merged
+	// with the policy of the parent. Only applies to list constraints.
+	// +optional
+	InheritFromParent *bool `json:"inheritFromParent,omitempty"`
+
+	// Reset ignores the policies of the parent and restores the default
+	// behavior of the constraint. Rules must be empty if set.
+	// +optional
+	Reset *bool `json:"reset,omitempty"`
+
+	// Rules of the policy. Boolean constraints take at most one unconditional
+	// rule, list constraints any number of rules.
+	// +optional
+	Rules []PolicyRule `json:"rules,omitempty"`
+}
+
+// A PolicyRule describes the enforcement of a constraint. Exactly one of
+// Values, AllowAll, DenyAll and Enforce should be set.
+type PolicyRule struct {
+	// Values allowed or denied by a list constraint.
+	// +optional
+	Values *StringValues `json:"values,omitempty"`
+
+	// AllowAll allows all values of a list constraint.
+	// +optional
+	AllowAll *bool `json:"allowAll,omitempty"`
+
+	// DenyAll denies all values of a list constraint.
+	// +optional
+	DenyAll *bool `json:"denyAll,omitempty"`
+
+	// Enforce determines whether a boolean constraint is enforced.
+	// +optional
+	Enforce *bool `json:"enforce,omitempty"`
+
+	// Condition restricts the rule to the resources it evaluates to true
+	// for, e.g. resource.matchTag('123456789/environment', 'prod').
+	// +optional
+	Condition *Expr `json:"condition,omitempty"`
+}
+
+// StringValues of a list constraint.
+type StringValues struct {
+	// AllowedValues of the constraint, e.g.
+	// projects/my-project/zones/us-central1-a/instances/my-instance.
+	// +optional
+	AllowedValues []string `json:"allowedValues,omitempty"`
+
+	// DeniedValues of the constraint.
+	// +optional
+	DeniedValues []string `json:"deniedValues,omitempty"`
+}
+
+// Expr is a Common Expression Language expression.
+type Expr struct {
+	// Expression in Common Expression Language syntax.
+	Expression string `json:"expression"`
+
+	// Title of the expression.
+	// +optional
+	Title *string `json:"title,omitempty"`
+
+	// Description of the expression.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Location of the expression for error reporting.
+	// +optional
+	Location *string `json:"location,omitempty"`
+}
+
+// OrgPolicyObservation is used to show the observed state of an
+// OrgPolicy.
+type OrgPolicyObservation struct {
+	// Name of the policy, in the form
+	// {parent}/policies/{constraint_name}.
+	Name string `json:"name,omitempty"`
+
+	// Etag of the policy spec.
+	Etag string `json:"etag,omitempty"`
+
+	// UpdateTime is the time the policy spec was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// A OrgPolicySpec defines the desired state of a OrgPolicy.
+type OrgPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OrgPolicyParameters `json:"forProvider"`
+}
+
+// A OrgPolicyStatus represents the observed state of a OrgPolicy.
+type OrgPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OrgPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OrgPolicy is a managed resource that represents a Google Cloud organization policy on a project, folder or organization.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PARENT",type="string",JSONPath=".spec.forProvider.parent"
+// +kubebuilder:printcolumn:name="CONSTRAINT",type="string",JSONPath=".spec.forProvider.constraint"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type OrgPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrgPolicySpec   `json:"spec"`
+	Status OrgPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrgPolicyList contains a list of OrgPolicy
+type OrgPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrgPolicy `json:"items"`
+}
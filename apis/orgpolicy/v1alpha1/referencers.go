@@ -0,0 +1,63 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	resourcemanagerv1alpha1 "github.com/crossplane/provider-gcp/apis/resourcemanager/v1alpha1"
+)
+
+// ResolveReferences of this OrgPolicy
+func (in *OrgPolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.parent from a project
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Parent),
+		Reference:    in.Spec.ForProvider.ProjectRef,
+		Selector:     in.Spec.ForProvider.ProjectSelector,
+		To:           reference.To{Managed: &resourcemanagerv1alpha1.Project{}, List: &resourcemanagerv1alpha1.ProjectList{}},
+		Extract:      resourcemanagerv1alpha1.ProjectParent(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.parent")
+	}
+	in.Spec.ForProvider.Parent = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.ProjectRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.parent from a folder
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Parent),
+		Reference:    in.Spec.ForProvider.FolderRef,
+		Selector:     in.Spec.ForProvider.FolderSelector,
+		To:           reference.To{Managed: &resourcemanagerv1alpha1.Folder{}, List: &resourcemanagerv1alpha1.FolderList{}},
+		Extract:      resourcemanagerv1alpha1.FolderName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.parent")
+	}
+	in.Spec.ForProvider.Parent = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.FolderRef = rsp.ResolvedReference
+
+	return nil
+}
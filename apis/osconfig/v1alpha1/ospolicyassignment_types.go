@@ -0,0 +1,493 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Rollout states of an OSPolicyAssignment.
+const (
+	RolloutStateInProgress = "IN_PROGRESS"
+	RolloutStateCancelling = "CANCELLING"
+	RolloutStateCancelled  = "CANCELLED"
+	RolloutStateSucceeded  = "SUCCEEDED"
+)
+
+// OSPolicyAssignmentParameters define the desired state of an OS policy
+// assignment, which applies a set of OS policies to the VM instances of a
+// zone, for example to install and configure the Ops Agent:
+// https://cloud.google.com/compute/docs/os-configuration-management
+type OSPolicyAssignmentParameters struct {
+	// Project the assignment belongs to. Defaults to the project of the
+	// provider config.
+	// +immutable
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// ProjectRef references a Project and retrieves its external name.
+	// +immutable
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and retrieves its
+	// external name.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// Location of the assignment, a zone such as us-central1-a. The
+	// assignment applies to the instances of this zone.
+	// +immutable
+	Location string `json:"location"`
+
+	// Description of the assignment.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// OSPolicies to apply.
+	// +kubebuilder:validation:MinItems=1
+	OSPolicies []OSPolicy `json:"osPolicies"`
+
+	// InstanceFilter selects the instances the policies apply to.
+	InstanceFilter InstanceFilter `json:"instanceFilter"`
+
+	// Rollout controls how quickly changes to the assignment are applied
+	// to the selected instances.
+	Rollout Rollout `json:"rollout"`
+}
+
+// An OSPolicy is a set of resources an instance should be configured with.
+type OSPolicy struct {
+	// ID of the policy, unique within the assignment.
+	ID string `json:"id"`
+
+	// Description of the policy.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Mode of the policy. VALIDATION only reports whether instances are
+	// compliant, ENFORCEMENT also makes them compliant.
+	// +kubebuilder:validation:Enum=VALIDATION;ENFORCEMENT
+	Mode string `json:"mode"`
+
+	// ResourceGroups of the policy. The first group whose inventory filters
+	// match an instance is applied to it.
+	// +kubebuilder:validation:MinItems=1
+	ResourceGroups []ResourceGroup `json:"resourceGroups"`
+
+	// AllowNoResourceGroupMatch reports instances that no resource group
+	// matches as compliant rather than as failing.
+	// +optional
+	AllowNoResourceGroupMatch *bool `json:"allowNoResourceGroupMatch,omitempty"`
+}
+
+// A ResourceGroup is a set of resources applied to the instances that run a
+// matching operating system.
+type ResourceGroup struct {
+	// InventoryFilters select the operating systems the group applies to.
+	// A group without filters applies to all instances.
+	// +optional
+	InventoryFilters []InventoryFilter `json:"inventoryFilters,omitempty"`
+
+	// Resources of the group, applied in order.
+	// +kubebuilder:validation:MinItems=1
+	Resources []Resource `json:"resources"`
+}
+
+// An InventoryFilter matches an operating system.
+type InventoryFilter struct {
+	// OSShortName of the operating system, e.g. debian or windows.
+	OSShortName string `json:"osShortName"`
+
+	// OSVersion of the operating system. Supports a trailing wildcard, e.g.
+	// 10.*.
+	// +optional
+	OSVersion *string `json:"osVersion,omitempty"`
+}
+
+// A Resource an instance should be configured with. Exactly one of Pkg,
+// Repository, Exec and File must be set.
+type Resource struct {
+	// ID of the resource, unique within the policy.
+	ID string `json:"id"`
+
+	// Pkg manages a package.
+	// +optional
+	Pkg *PackageResource `json:"pkg,omitempty"`
+
+	// Repository manages a package repository.
+	// +optional
+	Repository *RepositoryResource `json:"repository,omitempty"`
+
+	// Exec runs a script to validate and enforce a configuration.
+	// +optional
+	Exec *ExecResource `json:"exec,omitempty"`
+
+	// File manages a file.
+	// +optional
+	File *FileResource `json:"file,omitempty"`
+}
+
+// A PackageResource manages a package. Exactly one package manager must be
+// set.
+type PackageResource struct {
+	// DesiredState of the package.
+	// +kubebuilder:validation:Enum=INSTALLED;REMOVED
+	DesiredState string `json:"desiredState"`
+
+	// Apt installs a package with apt-get.
+	// +optional
+	Apt *PackageName `json:"apt,omitempty"`
+
+	// Yum installs a package with yum.
+	// +optional
+	Yum *PackageName `json:"yum,omitempty"`
+
+	// Zypper installs a package with zypper.
+	// +optional
+	Zypper *PackageName `json:"zypper,omitempty"`
+
+	// GooGet installs a package with googet.
+	// +optional
+	GooGet *PackageName `json:"googet,omitempty"`
+
+	// Deb installs a deb file with dpkg.
+	// +optional
+	Deb *PackageFile `json:"deb,omitempty"`
+
+	// RPM installs an rpm file with rpm.
+	// +optional
+	RPM *PackageFile `json:"rpm,omitempty"`
+
+	// MSI installs an msi file with msiexec.
+	// +optional
+	MSI *MSIPackage `json:"msi,omitempty"`
+}
+
+// PackageName is a package that is installed from a repository.
+type PackageName struct {
+	// Name of the package.
+	Name string `json:"name"`
+}
+
+// PackageFile is a package that is installed from a file.
+type PackageFile struct {
+	// Source of the package file.
+	Source File `json:"source"`
+
+	// PullDeps installs the dependencies of the package too.
+	// +optional
+	PullDeps *bool `json:"pullDeps,omitempty"`
+}
+
+// MSIPackage is a Windows package that is installed from an msi file.
+type MSIPackage struct {
+	// Source of the msi file.
+	Source File `json:"source"`
+
+	// Properties to pass to msiexec. Defaults to ACTION=INSTALL
+	// REBOOT=ReallySuppress.
+	// +optional
+	Properties []string `json:"properties,omitempty"`
+}
+
+// A RepositoryResource manages a package repository. Exactly one of Apt,
+// Yum, Zypper and Goo must be set.
+type RepositoryResource struct {
+	// Apt configures an apt repository.
+	// +optional
+	Apt *AptRepository `json:"apt,omitempty"`
+
+	// Yum configures a yum repository.
+	// +optional
+	Yum *YumRepository `json:"yum,omitempty"`
+
+	// Zypper configures a zypper repository.
+	// +optional
+	Zypper *YumRepository `json:"zypper,omitempty"`
+
+	// Goo configures a googet repository.
+	// +optional
+	Goo *GooRepository `json:"goo,omitempty"`
+}
+
+// AptRepository is an apt package repository.
+type AptRepository struct {
+	// ArchiveType of the repository.
+	// +kubebuilder:validation:Enum=DEB;DEB_SRC
+	ArchiveType string `json:"archiveType"`
+
+	// URI of the repository.
+	URI string `json:"uri"`
+
+	// Distribution of the repository.
+	Distribution string `json:"distribution"`
+
+	// Components of the repository.
+	// +kubebuilder:validation:MinItems=1
+	Components []string `json:"components"`
+
+	// GPGKey is the URI of the key the repository is signed with.
+	// +optional
+	GPGKey *string `json:"gpgKey,omitempty"`
+}
+
+// YumRepository is a yum or zypper package repository.
+type YumRepository struct {
+	// ID of the repository.
+	ID string `json:"id"`
+
+	// DisplayName of the repository.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// BaseURL of the repository.
+	BaseURL string `json:"baseUrl"`
+
+	// GPGKeys are the URIs of the keys the repository is signed with.
+	// +optional
+	GPGKeys []string `json:"gpgKeys,omitempty"`
+}
+
+// GooRepository is a googet package repository.
+type GooRepository struct {
+	// Name of the repository.
+	Name string `json:"name"`
+
+	// URL of the repository.
+	URL string `json:"url"`
+}
+
+// An ExecResource runs a validate script and, if it reports that the
+// instance is not compliant, an enforce script.
+type ExecResource struct {
+	// Validate checks whether the instance is compliant. It must exit with
+	// 100 if it is and with 101 if it is not.
+	Validate Exec `json:"validate"`
+
+	// Enforce makes the instance compliant. It must exit with 100 on
+	// success.
+	// +optional
+	Enforce *Exec `json:"enforce,omitempty"`
+}
+
+// Exec is a script or executable. Exactly one of File and Script must be set.
+type Exec struct {
+	// File to execute.
+	// +optional
+	File *File `json:"file,omitempty"`
+
+	// Script to execute.
+	// +optional
+	Script *string `json:"script,omitempty"`
+
+	// Args to pass to the script or executable.
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// Interpreter to run the script or file with.
+	// +kubebuilder:validation:Enum=NONE;SHELL;POWERSHELL
+	Interpreter string `json:"interpreter"`
+
+	// OutputFilePath of a file the script writes its output to, which is
+	// reported by OS Config.
+	// +optional
+	OutputFilePath *string `json:"outputFilePath,omitempty"`
+}
+
+// A FileResource manages a file. Exactly one of Content and File must be
+// set unless the file should be absent.
+type FileResource struct {
+	// Path of the file.
+	Path string `json:"path"`
+
+	// State the file should be in.
+	// +kubebuilder:validation:Enum=PRESENT;ABSENT;CONTENTS_MATCH
+	State string `json:"state"`
+
+	// Content of the file.
+	// +optional
+	Content *string `json:"content,omitempty"`
+
+	// File to copy to the path.
+	// +optional
+	File *File `json:"file,omitempty"`
+}
+
+// A File that is downloaded to or already present on the instance. Exactly
+// one of Remote, GCS and LocalPath must be set.
+type File struct {
+	// Remote file that is downloaded via HTTP(S).
+	// +optional
+	Remote *RemoteFile `json:"remote,omitempty"`
+
+	// GCS object that is downloaded.
+	// +optional
+	GCS *GCSFile `json:"gcs,omitempty"`
+
+	// LocalPath of a file on the instance.
+	// +optional
+	LocalPath *string `json:"localPath,omitempty"`
+
+	// AllowInsecure allows remote files to be downloaded without
+	// verifying their checksum or generation.
+	// +optional
+	AllowInsecure *bool `json:"allowInsecure,omitempty"`
+}
+
+// RemoteFile is a file that is downloaded via HTTP(S).
+type RemoteFile struct {
+	// URI of the file.
+	URI string `json:"uri"`
+
+	// SHA256Checksum of the file.
+	// +optional
+	SHA256Checksum *string `json:"sha256Checksum,omitempty"`
+}
+
+// GCSFile is a Cloud Storage object.
+type GCSFile struct {
+	// Bucket of the object.
+	Bucket string `json:"bucket"`
+
+	// Object name.
+	Object string `json:"object"`
+
+	// Generation of the object.
+	// +optional
+	Generation *int64 `json:"generation,omitempty"`
+}
+
+// InstanceFilter selects the instances of the zone the assignment applies
+// to.
+type InstanceFilter struct {
+	// All selects all instances of the zone.
+	// +optional
+	All *bool `json:"all,omitempty"`
+
+	// InclusionLabels select instances that have all labels of any of the
+	// label sets.
+	// +optional
+	InclusionLabels []LabelSet `json:"inclusionLabels,omitempty"`
+
+	// ExclusionLabels exclude instances that have all labels of any of the
+	// label sets.
+	// +optional
+	ExclusionLabels []LabelSet `json:"exclusionLabels,omitempty"`
+
+	// Inventories select instances that run any of the operating systems.
+	// +optional
+	Inventories []InventoryFilter `json:"inventories,omitempty"`
+}
+
+// A LabelSet is a set of instance labels.
+type LabelSet struct {
+	// Labels of the set.
+	Labels map[string]string `json:"labels"`
+}
+
+// Rollout controls how quickly an assignment is applied.
+type Rollout struct {
+	// DisruptionBudget is the number or percentage of instances that may be
+	// updated at the same time.
+	DisruptionBudget FixedOrPercent `json:"disruptionBudget"`
+
+	// MinWaitDuration is how long to wait after an instance was updated
+	// before the next instances are updated, e.g. 60s.
+	MinWaitDuration string `json:"minWaitDuration"`
+}
+
+// FixedOrPercent is either a fixed number or a percentage. Exactly one of
+// Fixed and Percent must be set.
+type FixedOrPercent struct {
+	// Fixed number.
+	// +optional
+	Fixed *int64 `json:"fixed,omitempty"`
+
+	// Percent between 0 and 100.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Percent *int64 `json:"percent,omitempty"`
+}
+
+// OSPolicyAssignmentObservation is used to show the observed state of the
+// OSPolicyAssignment.
+type OSPolicyAssignmentObservation struct {
+	// Name is the resource name of the assignment.
+	Name string `json:"name,omitempty"`
+
+	// UID of the assignment.
+	UID string `json:"uid,omitempty"`
+
+	// RevisionID of the current revision of the assignment.
+	RevisionID string `json:"revisionId,omitempty"`
+
+	// RevisionCreateTime is when the current revision was created.
+	RevisionCreateTime string `json:"revisionCreateTime,omitempty"`
+
+	// RolloutState of the current revision.
+	RolloutState string `json:"rolloutState,omitempty"`
+
+	// Reconciling is true while the current revision is being rolled out.
+	Reconciling bool `json:"reconciling,omitempty"`
+
+	// Baseline is true if this revision is the baseline instances are
+	// compared with.
+	Baseline bool `json:"baseline,omitempty"`
+}
+
+// A OSPolicyAssignmentSpec defines the desired state of a OSPolicyAssignment.
+type OSPolicyAssignmentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OSPolicyAssignmentParameters `json:"forProvider"`
+}
+
+// A OSPolicyAssignmentStatus represents the observed state of a OSPolicyAssignment.
+type OSPolicyAssignmentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OSPolicyAssignmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OSPolicyAssignment is a managed resource that represents a GCP OS Config OS policy assignment.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.forProvider.location"
+// +kubebuilder:printcolumn:name="ROLLOUT-STATE",type="string",JSONPath=".status.atProvider.rolloutState"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type OSPolicyAssignment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OSPolicyAssignmentSpec   `json:"spec"`
+	Status OSPolicyAssignmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OSPolicyAssignmentList contains a list of OSPolicyAssignment
+type OSPolicyAssignmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OSPolicyAssignment `json:"items"`
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// KeySecretSiteKeyKey is the key of the connection secret entry that holds
+// the site key, i.e. the key string frontends embed to load reCAPTCHA.
+const KeySecretSiteKeyKey = "siteKey"
+
+// KeyParameters define the desired state of a reCAPTCHA Enterprise key.
+// Exactly one of WebSettings, AndroidSettings and IOSSettings must be set.
+type KeyParameters struct {
+	// Project the key belongs to. Defaults to the project of the provider
+	// config.
+	// +immutable
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// ProjectRef references a Project and retrieves its external name.
+	// +immutable
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and retrieves its
+	// external name.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// DisplayName is the human-readable name of the key.
+	DisplayName string `json:"displayName"`
+
+	// Labels to attach to the key.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// WebSettings configure a key that is used by websites.
+	// +optional
+	WebSettings *WebKeySettings `json:"webSettings,omitempty"`
+
+	// AndroidSettings configure a key that is used by Android apps.
+	// +optional
+	AndroidSettings *AndroidKeySettings `json:"androidSettings,omitempty"`
+
+	// IOSSettings configure a key that is used by iOS apps.
+	// +optional
+	IOSSettings *IOSKeySettings `json:"iosSettings,omitempty"`
+
+	// WAFSettings integrate the key with a web application firewall.
+	// +immutable
+	// +optional
+	WAFSettings *WAFSettings `json:"wafSettings,omitempty"`
+}
+
+// WebKeySettings configure a key that is used by websites.
+type WebKeySettings struct {
+	// IntegrationType describes how the key is integrated into the website.
+	// +immutable
+	// +kubebuilder:validation:Enum=SCORE;CHECKBOX;INVISIBLE
+	IntegrationType string `json:"integrationType"`
+
+	// AllowAllDomains disables the domain check of the key.
+	// +optional
+	AllowAllDomains *bool `json:"allowAllDomains,omitempty"`
+
+	// AllowedDomains the key may be used on, such as example.com.
+	// Subdomains are allowed implicitly.
+	// +optional
+	AllowedDomains []string `json:"allowedDomains,omitempty"`
+
+	// AllowAMPTraffic allows the key to be used on AMP pages.
+	// +optional
+	AllowAMPTraffic *bool `json:"allowAmpTraffic,omitempty"`
+
+	// ChallengeSecurityPreference tunes the amount and difficulty of the
+	// challenges shown. Only applies to CHECKBOX and INVISIBLE keys.
+	// +kubebuilder:validation:Enum=USABILITY;BALANCE;SECURITY
+	// +optional
+	ChallengeSecurityPreference *string `json:"challengeSecurityPreference,omitempty"`
+}
+
+// AndroidKeySettings configure a key that is used by Android apps.
+type AndroidKeySettings struct {
+	// AllowAllPackageNames disables the package name check of the key.
+	// +optional
+	AllowAllPackageNames *bool `json:"allowAllPackageNames,omitempty"`
+
+	// AllowedPackageNames of the apps the key may be used in, such as
+	// com.example.app.
+	// +optional
+	AllowedPackageNames []string `json:"allowedPackageNames,omitempty"`
+
+	// SupportNonGoogleAppStoreDistribution allows the key to be used in
+	// apps that are not distributed through the Google Play Store.
+	// +optional
+	SupportNonGoogleAppStoreDistribution *bool `json:"supportNonGoogleAppStoreDistribution,omitempty"`
+}
+
+// IOSKeySettings configure a key that is used by iOS apps.
+type IOSKeySettings struct {
+	// AllowAllBundleIDs disables the bundle ID check of the key.
+	// +optional
+	AllowAllBundleIDs *bool `json:"allowAllBundleIds,omitempty"`
+
+	// AllowedBundleIDs of the apps the key may be used in, such as
+	// com.example.app.
+	// +optional
+	AllowedBundleIDs []string `json:"allowedBundleIds,omitempty"`
+}
+
+// WAFSettings integrate a key with a web application firewall.
+type WAFSettings struct {
+	// WAFService is the firewall the key is used with.
+	// +kubebuilder:validation:Enum=CA;FASTLY
+	WAFService string `json:"wafService"`
+
+	// WAFFeature is the firewall feature the key protects traffic with.
+	// +kubebuilder:validation:Enum=CHALLENGE_PAGE;SESSION_TOKEN;ACTION_TOKEN;EXPRESS
+	WAFFeature string `json:"wafFeature"`
+}
+
+// KeyObservation is used to show the observed state of a reCAPTCHA
+// Enterprise key.
+type KeyObservation struct {
+	// Name is the fully qualified name of the key.
+	Name string `json:"name,omitempty"`
+
+	// CreateTime is when the key was created.
+	CreateTime string `json:"createTime,omitempty"`
+}
+
+// A KeySpec defines the desired state of a Key.
+type KeySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       KeyParameters `json:"forProvider"`
+}
+
+// A KeyStatus represents the observed state of a Key.
+type KeyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          KeyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Key is a managed resource that represents a GCP reCAPTCHA Enterprise key.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DISPLAY-NAME",type="string",JSONPath=".spec.forProvider.displayName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Key struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KeySpec   `json:"spec"`
+	Status KeyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KeyList contains a list of Key
+type KeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Key `json:"items"`
+}
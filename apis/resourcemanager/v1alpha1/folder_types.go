@@ -0,0 +1,112 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Folder lifecycle states.
+const (
+	FolderStateActive          = "ACTIVE"
+	FolderStateDeleteRequested = "DELETE_REQUESTED"
+)
+
+// FolderParameters define the desired state of a Google Cloud Folder. Folder
+// IDs are assigned by Resource Manager; the external name of the resource is
+// the numeric folder ID. Most fields map directly to a Folder:
+// https://cloud.google.com/resource-manager/reference/rest/v3/folders#Folder
+type FolderParameters struct {
+	// Parent of the folder, either another folder or an organization, in the
+	// form folders/{folder_id} or organizations/{organization_id}. Changing
+	// the parent moves the folder.
+	// +kubebuilder:validation:Pattern=`^(folders|organizations)/[0-9]+$`
+	// +optional
+	Parent string `json:"parent,omitempty"`
+
+	// ParentRef references a Folder and retrieves its name.
+	// +optional
+	ParentRef *xpv1.Reference `json:"parentRef,omitempty"`
+
+	// ParentSelector selects a reference to a Folder and retrieves its name.
+	// +optional
+	ParentSelector *xpv1.Selector `json:"parentSelector,omitempty"`
+
+	// DisplayName of the folder. It must be unique among the folders that
+	// share the same parent.
+	// +kubebuilder:validation:MaxLength=30
+	DisplayName string `json:"displayName"`
+}
+
+// FolderObservation is used to show the observed state of a Folder.
+type FolderObservation struct {
+	// Name is the fully qualified name of the folder, in the form
+	// folders/{folder_id}.
+	Name string `json:"name,omitempty"`
+
+	// State of the folder.
+	State string `json:"state,omitempty"`
+
+	// CreateTime is the time the folder was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the folder was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+
+	// DeleteTime is the time the folder was requested to be deleted.
+	DeleteTime string `json:"deleteTime,omitempty"`
+}
+
+// A FolderSpec defines the desired state of a Folder.
+type FolderSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FolderParameters `json:"forProvider"`
+}
+
+// A FolderStatus represents the observed state of a Folder.
+type FolderStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          FolderObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Folder is a managed resource that represents a Google Cloud Folder.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Folder struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FolderSpec   `json:"spec"`
+	Status FolderStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FolderList contains a list of Folder
+type FolderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Folder `json:"items"`
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Project lifecycle states.
+const (
+	ProjectStateActive          = "ACTIVE"
+	ProjectStateDeleteRequested = "DELETE_REQUESTED"
+)
+
+// ProjectParameters define the desired state of a Google Cloud Project. The
+// project ID is the external name of the resource. Most fields map directly
+// to a Project:
+// https://cloud.google.com/resource-manager/reference/rest/v3/projects#Project
+type ProjectParameters struct {
+	// Parent of the project, either a folder or an organization, in the
+	// form folders/{folder_id} or organizations/{organization_id}. Changing
+	// the parent moves the project.
+	// +kubebuilder:validation:Pattern=`^(folders|organizations)/[0-9]+$`
+	// +optional
+	Parent string `json:"parent,omitempty"`
+
+	// ParentRef references a Folder and retrieves its name.
+	// +optional
+	ParentRef *xpv1.Reference `json:"parentRef,omitempty"`
+
+	// ParentSelector selects a reference to a Folder and retrieves its name.
+	// +optional
+	ParentSelector *xpv1.Selector `json:"parentSelector,omitempty"`
+
+	// DisplayName is a user-assigned name of the project.
+	// +optional
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Labels to apply to the project.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// BillingAccount the project is linked to, e.g. 012345-567890-ABCDEF.
+	// The project's billing link is left untouched if this is not set.
+	// +optional
+	BillingAccount *string `json:"billingAccount,omitempty"`
+
+	// AutoCreateNetwork controls whether the default network that Compute
+	// Engine creates in new projects is kept. If set to false the default
+	// network and its firewall rules are deleted whenever they are found.
+	// +optional
+	// +kubebuilder:default=true
+	AutoCreateNetwork *bool `json:"autoCreateNetwork,omitempty"`
+}
+
+// ProjectObservation is used to show the observed state of a Project.
+type ProjectObservation struct {
+	// Name is the fully qualified name of the project, in the form
+	// projects/{project_number}.
+	Name string `json:"name,omitempty"`
+
+	// State of the project.
+	State string `json:"state,omitempty"`
+
+	// BillingEnabled is true if the project is linked to an open billing
+	// account. It is only observed if a billing account is specified.
+	BillingEnabled bool `json:"billingEnabled,omitempty"`
+
+	// CreateTime is the time the project was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the project was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+
+	// DeleteTime is the time the project was requested to be deleted. The
+	// project is purged 30 days after this time.
+	DeleteTime string `json:"deleteTime,omitempty"`
+}
+
+// A ProjectSpec defines the desired state of a Project.
+type ProjectSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ProjectParameters `json:"forProvider"`
+}
+
+// A ProjectStatus represents the observed state of a Project.
+type ProjectStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ProjectObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Project is a managed resource that represents a Google Cloud Project.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Project struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProjectSpec   `json:"spec"`
+	Status ProjectStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectList contains a list of Project
+type ProjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Project `json:"items"`
+}
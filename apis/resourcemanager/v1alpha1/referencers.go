@@ -0,0 +1,195 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// FolderName extracts the name of a Folder.
+func FolderName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		f, ok := mg.(*Folder)
+		if !ok {
+			return ""
+		}
+		return f.Status.AtProvider.Name
+	}
+}
+
+// ProjectName extracts the name of a Project, which contains its project
+// number.
+func ProjectName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		p, ok := mg.(*Project)
+		if !ok {
+			return ""
+		}
+		return p.Status.AtProvider.Name
+	}
+}
+
+// ProjectParent extracts the external name of a Project in the form
+// projects/{project_id}, which is how resources that can be attached to a
+// project, folder or organization refer to their parent.
+func ProjectParent() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		if meta.GetExternalName(mg) == "" {
+			return ""
+		}
+		return "projects/" + meta.GetExternalName(mg)
+	}
+}
+
+// projectFullResourceName extracts the full resource name of a Project,
+// which is how tag bindings refer to the resource they bind to.
+func projectFullResourceName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		name := ProjectName()(mg)
+		if name == "" {
+			return ""
+		}
+		return "//cloudresourcemanager.googleapis.com/" + name
+	}
+}
+
+// TagKeyName extracts the name of a TagKey.
+func TagKeyName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		k, ok := mg.(*TagKey)
+		if !ok {
+			return ""
+		}
+		return k.Status.AtProvider.Name
+	}
+}
+
+// TagValueName extracts the name of a TagValue.
+func TagValueName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		v, ok := mg.(*TagValue)
+		if !ok {
+			return ""
+		}
+		return v.Status.AtProvider.Name
+	}
+}
+
+// ResolveReferences of this Project
+func (in *Project) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.parent
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: in.Spec.ForProvider.Parent,
+		Reference:    in.Spec.ForProvider.ParentRef,
+		Selector:     in.Spec.ForProvider.ParentSelector,
+		To:           reference.To{Managed: &Folder{}, List: &FolderList{}},
+		Extract:      FolderName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.parent")
+	}
+	in.Spec.ForProvider.Parent = rsp.ResolvedValue
+	in.Spec.ForProvider.ParentRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Folder
+func (in *Folder) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.parent
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: in.Spec.ForProvider.Parent,
+		Reference:    in.Spec.ForProvider.ParentRef,
+		Selector:     in.Spec.ForProvider.ParentSelector,
+		To:           reference.To{Managed: &Folder{}, List: &FolderList{}},
+		Extract:      FolderName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.parent")
+	}
+	in.Spec.ForProvider.Parent = rsp.ResolvedValue
+	in.Spec.ForProvider.ParentRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this TagValue
+func (in *TagValue) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.parent
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Parent),
+		Reference:    in.Spec.ForProvider.ParentRef,
+		Selector:     in.Spec.ForProvider.ParentSelector,
+		To:           reference.To{Managed: &TagKey{}, List: &TagKeyList{}},
+		Extract:      TagKeyName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.parent")
+	}
+	in.Spec.ForProvider.Parent = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.ParentRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this TagBinding
+func (in *TagBinding) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.parent
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Parent),
+		Reference:    in.Spec.ForProvider.ProjectRef,
+		Selector:     in.Spec.ForProvider.ProjectSelector,
+		To:           reference.To{Managed: &Project{}, List: &ProjectList{}},
+		Extract:      projectFullResourceName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.parent")
+	}
+	in.Spec.ForProvider.Parent = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.ProjectRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.tagValue
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.TagValue),
+		Reference:    in.Spec.ForProvider.TagValueRef,
+		Selector:     in.Spec.ForProvider.TagValueSelector,
+		To:           reference.To{Managed: &TagValue{}, List: &TagValueList{}},
+		Extract:      TagValueName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.tagValue")
+	}
+	in.Spec.ForProvider.TagValue = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.TagValueRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TagBindingParameters define the desired state of a tag binding, which
+// attaches a tag value to a resource. Tag bindings can't be updated:
+// https://cloud.google.com/resource-manager/reference/rest/v3/tagBindings
+type TagBindingParameters struct {
+	// Parent is the full resource name of the resource the tag value is bound
+	// to, e.g. //cloudresourcemanager.googleapis.com/projects/{project_number}
+	// or //compute.googleapis.com/projects/{project}/zones/{zone}/instances/{instance_id}.
+	// +immutable
+	// +optional
+	Parent *string `json:"parent,omitempty"`
+
+	// ProjectRef references a Project and binds the tag value to it.
+	// +immutable
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and binds the tag
+	// value to it.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// Location of the resource the tag value is bound to, e.g. europe-west1-b
+	// for a VM instance. Bindings of zonal and regional resources are managed
+	// by the Resource Manager endpoint of their location. Leave empty for
+	// global resources like projects.
+	// +immutable
+	// +optional
+	Location *string `json:"location,omitempty"`
+
+	// TagValue to bind, in the form tagValues/{tag_value_id}.
+	// +kubebuilder:validation:Pattern=`^tagValues/[0-9]+$`
+	// +immutable
+	// +optional
+	TagValue *string `json:"tagValue,omitempty"`
+
+	// TagValueRef references a TagValue and retrieves its name.
+	// +immutable
+	// +optional
+	TagValueRef *xpv1.Reference `json:"tagValueRef,omitempty"`
+
+	// TagValueSelector selects a reference to a TagValue and retrieves its
+	// name.
+	// +optional
+	TagValueSelector *xpv1.Selector `json:"tagValueSelector,omitempty"`
+}
+
+// TagBindingObservation is used to show the observed state of a TagBinding.
+type TagBindingObservation struct {
+	// Name of the tag binding, in the form
+	// tagBindings/{url_encoded_parent}/tagValues/{tag_value_id}.
+	Name string `json:"name,omitempty"`
+
+	// TagValueNamespacedName of the bound tag value, in the form
+	// {parent_id}/{tag_key_short_name}/{tag_value_short_name}.
+	TagValueNamespacedName string `json:"tagValueNamespacedName,omitempty"`
+}
+
+// A TagBindingSpec defines the desired state of a TagBinding.
+type TagBindingSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TagBindingParameters `json:"forProvider"`
+}
+
+// A TagBindingStatus represents the observed state of a TagBinding.
+type TagBindingStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TagBindingObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TagBinding is a managed resource that represents the binding of a Google Cloud tag value to a resource.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TAG-VALUE",type="string",JSONPath=".status.atProvider.tagValueNamespacedName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type TagBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TagBindingSpec   `json:"spec"`
+	Status TagBindingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TagBindingList contains a list of TagBinding
+type TagBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TagBinding `json:"items"`
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TagKeyParameters define the desired state of a tag key. Tag key IDs are
+// assigned by Resource Manager; the external name of the resource is the
+// numeric tag key ID:
+// https://cloud.google.com/resource-manager/reference/rest/v3/tagKeys
+type TagKeyParameters struct {
+	// Parent of the tag key, in the form organizations/{organization_id} or
+	// projects/{project_id}.
+	// +kubebuilder:validation:Pattern=`^(organizations/[0-9]+|projects/[a-z][-a-z0-9]*)$`
+	// +immutable
+	Parent string `json:"parent"`
+
+	// ShortName of the tag key, e.g. environment. It must be unique among the
+	// tag keys that share the same parent.
+	// +kubebuilder:validation:MaxLength=63
+	// +immutable
+	ShortName string `json:"shortName"`
+
+	// Description of the tag key.
+	// +kubebuilder:validation:MaxLength=256
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Purpose of the tag key. Tag keys with the GCE_FIREWALL purpose can be
+	// used by network firewall policies.
+	// +kubebuilder:validation:Enum=GCE_FIREWALL
+	// +immutable
+	// +optional
+	Purpose *string `json:"purpose,omitempty"`
+
+	// PurposeData qualifies the purpose, e.g. the network a GCE_FIREWALL tag
+	// key applies to in the form
+	// {"network": "https://www.googleapis.com/compute/v1/projects/{project}/global/networks/{network}"}.
+	// +immutable
+	// +optional
+	PurposeData map[string]string `json:"purposeData,omitempty"`
+}
+
+// TagKeyObservation is used to show the observed state of a TagKey.
+type TagKeyObservation struct {
+	// Name of the tag key, in the form tagKeys/{tag_key_id}.
+	Name string `json:"name,omitempty"`
+
+	// NamespacedName of the tag key, in the form
+	// {parent_id}/{short_name}.
+	NamespacedName string `json:"namespacedName,omitempty"`
+
+	// Etag of the tag key.
+	Etag string `json:"etag,omitempty"`
+
+	// CreateTime is the time the tag key was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the tag key was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// A TagKeySpec defines the desired state of a TagKey.
+type TagKeySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TagKeyParameters `json:"forProvider"`
+}
+
+// A TagKeyStatus represents the observed state of a TagKey.
+type TagKeyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TagKeyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TagKey is a managed resource that represents a Google Cloud tag key.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NAMESPACED-NAME",type="string",JSONPath=".status.atProvider.namespacedName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type TagKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TagKeySpec   `json:"spec"`
+	Status TagKeyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TagKeyList contains a list of TagKey
+type TagKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TagKey `json:"items"`
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TagValueParameters define the desired state of a tag value. Tag value IDs
+// are assigned by Resource Manager; the external name of the resource is the
+// numeric tag value ID:
+// https://cloud.google.com/resource-manager/reference/rest/v3/tagValues
+type TagValueParameters struct {
+	// Parent is the tag key of the tag value, in the form
+	// tagKeys/{tag_key_id}.
+	// +kubebuilder:validation:Pattern=`^tagKeys/[0-9]+$`
+	// +immutable
+	// +optional
+	Parent *string `json:"parent,omitempty"`
+
+	// ParentRef references a TagKey and retrieves its name.
+	// +immutable
+	// +optional
+	ParentRef *xpv1.Reference `json:"parentRef,omitempty"`
+
+	// ParentSelector selects a reference to a TagKey and retrieves its name.
+	// +optional
+	ParentSelector *xpv1.Selector `json:"parentSelector,omitempty"`
+
+	// ShortName of the tag value, e.g. production. It must be unique among
+	// the values of the tag key.
+	// +kubebuilder:validation:MaxLength=63
+	// +immutable
+	ShortName string `json:"shortName"`
+
+	// Description of the tag value.
+	// +kubebuilder:validation:MaxLength=256
+	// +optional
+	Description *string `json:"description,omitempty"`
+}
+
+// TagValueObservation is used to show the observed state of a TagValue.
+type TagValueObservation struct {
+	// Name of the tag value, in the form tagValues/{tag_value_id}.
+	Name string `json:"name,omitempty"`
+
+	// NamespacedName of the tag value, in the form
+	// {parent_id}/{tag_key_short_name}/{short_name}.
+	NamespacedName string `json:"namespacedName,omitempty"`
+
+	// Etag of the tag value.
+	Etag string `json:"etag,omitempty"`
+
+	// CreateTime is the time the tag value was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the tag value was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// A TagValueSpec defines the desired state of a TagValue.
+type TagValueSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TagValueParameters `json:"forProvider"`
+}
+
+// A TagValueStatus represents the observed state of a TagValue.
+type TagValueStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TagValueObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TagValue is a managed resource that represents a Google Cloud tag value.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NAMESPACED-NAME",type="string",JSONPath=".status.atProvider.namespacedName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type TagValue struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TagValueSpec   `json:"spec"`
+	Status TagValueStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TagValueList contains a list of TagValue
+type TagValueList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TagValue `json:"items"`
+}
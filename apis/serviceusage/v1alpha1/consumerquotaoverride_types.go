@@ -0,0 +1,121 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ConsumerQuotaOverrideParameters define the desired state of a consumer
+// quota override of a project.
+// https://cloud.google.com/service-usage/docs/reference/rest/v1beta1/services.consumerQuotaMetrics.limits.consumerOverrides
+type ConsumerQuotaOverrideParameters struct {
+	// Project the quota is overridden in. Defaults to the project of the
+	// provider config.
+	// +optional
+	// +immutable
+	Project *string `json:"project,omitempty"`
+
+	// ProjectRef references a Project and retrieves its external name.
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and retrieves its
+	// external name.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// Service the quota belongs to, e.g. compute.googleapis.com.
+	// +immutable
+	Service string `json:"service"`
+
+	// Metric the quota limits, e.g. compute.googleapis.com/cpus.
+	// +immutable
+	Metric string `json:"metric"`
+
+	// Limit is the unit of the quota limit that is overridden, e.g.
+	// /project/region.
+	// +immutable
+	Limit string `json:"limit"`
+
+	// OverrideValue is the new value of the quota limit. -1 means unlimited.
+	// +kubebuilder:validation:Minimum=-1
+	OverrideValue int64 `json:"overrideValue"`
+
+	// Dimensions the override applies to, e.g. region: us-central1. Only
+	// the region and zone dimensions are supported. An override without
+	// dimensions applies to every region and zone.
+	// +optional
+	// +immutable
+	Dimensions map[string]string `json:"dimensions,omitempty"`
+
+	// Force the override even if it decreases the quota by more than 10
+	// percent, or below the current usage.
+	// +optional
+	Force *bool `json:"force,omitempty"`
+}
+
+// ConsumerQuotaOverrideObservation is used to show the observed state of a
+// ConsumerQuotaOverride.
+type ConsumerQuotaOverrideObservation struct {
+	// Name is the resource name of the override.
+	Name string `json:"name,omitempty"`
+
+	// Unit of the quota limit, e.g. 1/{project}/{region}.
+	Unit string `json:"unit,omitempty"`
+}
+
+// A ConsumerQuotaOverrideSpec defines the desired state of a ConsumerQuotaOverride.
+type ConsumerQuotaOverrideSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ConsumerQuotaOverrideParameters `json:"forProvider"`
+}
+
+// A ConsumerQuotaOverrideStatus represents the observed state of a ConsumerQuotaOverride.
+type ConsumerQuotaOverrideStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ConsumerQuotaOverrideObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ConsumerQuotaOverride is a managed resource that represents an override of a quota limit of a Google Cloud project.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="METRIC",type="string",JSONPath=".spec.forProvider.metric"
+// +kubebuilder:printcolumn:name="VALUE",type="integer",JSONPath=".spec.forProvider.overrideValue"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type ConsumerQuotaOverride struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConsumerQuotaOverrideSpec   `json:"spec"`
+	Status ConsumerQuotaOverrideStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ConsumerQuotaOverrideList contains a list of ConsumerQuotaOverride
+type ConsumerQuotaOverrideList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ConsumerQuotaOverride `json:"items"`
+}
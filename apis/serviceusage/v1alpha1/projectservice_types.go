@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Service states.
+const (
+	ServiceStateEnabled  = "ENABLED"
+	ServiceStateDisabled = "DISABLED"
+)
+
+// ProjectServiceParameters define the desired state of a set of services
+// enabled in a project. The services are enabled with a single batch call:
+// https://cloud.google.com/service-usage/docs/reference/rest/v1/services/batchEnable
+type ProjectServiceParameters struct {
+	// Project the services are enabled in. Defaults to the project of the
+	// provider config.
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// ProjectRef references a Project and retrieves its external name.
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and retrieves its
+	// external name.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// Services to enable, e.g. compute.googleapis.com. Services that are
+	// removed from this list are left enabled.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=20
+	Services []string `json:"services"`
+
+	// DisableDependentServices controls whether the services that depend on
+	// the services being disabled are disabled too when the ProjectService
+	// is deleted. If false, disabling a service that other enabled services
+	// depend on fails.
+	// +optional
+	DisableDependentServices *bool `json:"disableDependentServices,omitempty"`
+}
+
+// ServiceObservation is the observed state of a single service.
+type ServiceObservation struct {
+	// Name of the service, e.g. compute.googleapis.com.
+	Name string `json:"name"`
+
+	// State of the service.
+	State string `json:"state"`
+}
+
+// ProjectServiceObservation is used to show the observed state of the
+// services of a ProjectService.
+type ProjectServiceObservation struct {
+	// Services are the observed states of the services.
+	Services []ServiceObservation `json:"services,omitempty"`
+}
+
+// A ProjectServiceSpec defines the desired state of a ProjectService.
+type ProjectServiceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ProjectServiceParameters `json:"forProvider"`
+}
+
+// A ProjectServiceStatus represents the observed state of a ProjectService.
+type ProjectServiceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ProjectServiceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProjectService is a managed resource that represents a set of services enabled in a Google Cloud Project.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type ProjectService struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProjectServiceSpec   `json:"spec"`
+	Status ProjectServiceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProjectServiceList contains a list of ProjectService
+type ProjectServiceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProjectService `json:"items"`
+}
@@ -797,6 +797,12 @@ type BucketParameters struct {
 type BucketSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	BucketParameters  `json:",inline"`
+
+	// DeletionProtection prevents the deletion of the external bucket while
+	// true: the controller refuses to delete it, even if this managed resource
+	// is deleted.
+	// +optional
+	DeletionProtection *bool `json:"deletionProtection,omitempty"`
 }
 
 // A BucketStatus represents the observed state of a Bucket.
@@ -832,3 +838,9 @@ type BucketList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []Bucket `json:"items"`
 }
+
+// GetDeletionProtection returns true if the external bucket of this Bucket
+// must not be deleted.
+func (mg *Bucket) GetDeletionProtection() bool {
+	return mg.Spec.DeletionProtection != nil && *mg.Spec.DeletionProtection
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A DefaultProviderConfigPolicySpec defines the desired state of a
+// DefaultProviderConfigPolicy.
+type DefaultProviderConfigPolicySpec struct {
+	// Selector selects the managed resources this policy applies to by their
+	// labels. Crossplane labels composed resources with
+	// crossplane.io/claim-namespace, crossplane.io/claim-name and
+	// crossplane.io/composite, so resources may be selected by the namespace
+	// of the claim or by the composite resource they belong to. An empty
+	// selector selects all managed resources.
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// ProviderConfigRef references the ProviderConfig that selected managed
+	// resources should use instead of the default ProviderConfig.
+	ProviderConfigRef xpv1.Reference `json:"providerConfigRef"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DefaultProviderConfigPolicy routes managed resources that use the default
+// ProviderConfig to another ProviderConfig. Managed resources without a
+// providerConfigRef reference the ProviderConfig named "default"; when such a
+// resource matches a policy its providerConfigRef is set to the ProviderConfig
+// the policy references.
+// +kubebuilder:printcolumn:name="CONFIG-NAME",type="string",JSONPath=".spec.providerConfigRef.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,provider,gcp}
+type DefaultProviderConfigPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec DefaultProviderConfigPolicySpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// DefaultProviderConfigPolicyList contains a list of
+// DefaultProviderConfigPolicy
+type DefaultProviderConfigPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DefaultProviderConfigPolicy `json:"items"`
+}
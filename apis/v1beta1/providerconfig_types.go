@@ -22,6 +22,17 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 )
 
+// Credentials sources that are specific to GCP.
+const (
+	// CredentialsSourceAccessToken indicates that the provider should
+	// authenticate with an OAuth access token that is stored in a Secret.
+	CredentialsSourceAccessToken xpv1.CredentialsSource = "AccessToken"
+
+	// CredentialsSourceFederation indicates that the provider should
+	// authenticate using Workload Identity Federation.
+	CredentialsSourceFederation xpv1.CredentialsSource = "Federation"
+)
+
 // A ProviderConfigSpec defines the desired state of a ProviderConfig.
 type ProviderConfigSpec struct {
 	// Credentials required to authenticate to this provider.
@@ -29,15 +40,211 @@ type ProviderConfigSpec struct {
 
 	// ProjectID is the project name (not numerical ID) of this GCP ProviderConfig.
 	ProjectID string `json:"projectID"`
+
+	// QuotaProject is the project that is billed for, and whose quota is
+	// used by, the API calls of the provider. It is sent as the
+	// X-Goog-User-Project header, which some APIs like serviceusage require
+	// when authenticating as a user.
+	// +optional
+	QuotaProject *string `json:"quotaProject,omitempty"`
+
+	// Endpoints overrides the API endpoints of GCP services, e.g. to route
+	// traffic through Private Service Connect. The keys are service names
+	// as in the default endpoint {service}.googleapis.com, e.g. compute,
+	// container or sqladmin. An override replaces the whole base URL of a
+	// service, including a path like /compute/v1/ where the service has one.
+	// +optional
+	Endpoints map[string]string `json:"endpoints,omitempty"`
+
+	// Region in which the API calls of services that have regional
+	// endpoints, e.g. cloudkms or storage, are terminated, e.g. to comply
+	// with a data boundary like europe-west3. Services without a regional
+	// endpoint keep using their global endpoint; the RegionalEndpoints
+	// condition lists the services that use a regional one. Endpoints take
+	// precedence over the regional endpoints.
+	// +optional
+	Region *string `json:"region,omitempty"`
+
+	// Proxy through which the GCP APIs are reached. The proxy configured by
+	// the HTTPS_PROXY and NO_PROXY environment variables of the provider pod
+	// is used if this is not set.
+	// +optional
+	Proxy *ProxyConfig `json:"proxy,omitempty"`
+
+	// CABundleSecretRef references a PEM encoded bundle of CA certificates
+	// that are trusted in addition to the system ones, e.g. the one of a
+	// proxy that intercepts TLS.
+	// +optional
+	CABundleSecretRef *xpv1.SecretKeySelector `json:"caBundleSecretRef,omitempty"`
+
+	// RateLimit limits the rate of the API calls of all managed resources
+	// that use this ProviderConfig.
+	// +optional
+	RateLimit *RateLimitConfig `json:"rateLimit,omitempty"`
+
+	// ExternalNameTemplate is a Go template that renders the external name
+	// of managed resources that are created without one, e.g.
+	// "team-a-{{ .Name }}". The template is passed the .Name and .Labels of
+	// the managed resource. Managed resources whose external name is
+	// assigned by GCP ignore the template. Defaults to the name of the
+	// managed resource.
+	// +optional
+	ExternalNameTemplate *string `json:"externalNameTemplate,omitempty"`
+
+	// DefaultLabels are added to the GCP labels of the managed resources
+	// that use this ProviderConfig and support them. Labels that are set by
+	// a managed resource take precedence.
+	// +optional
+	DefaultLabels map[string]string `json:"defaultLabels,omitempty"`
+
+	// DefaultRegion of the managed resources that use this ProviderConfig
+	// and don't specify a region, e.g. us-central1. Defaults to the region
+	// of DefaultZone. The region is recorded in the spec of the managed
+	// resources.
+	// +optional
+	DefaultRegion *string `json:"defaultRegion,omitempty"`
+
+	// DefaultZone of the managed resources that use this ProviderConfig
+	// and don't specify a zone, e.g. us-central1-a. It's only used by
+	// managed resources in the region of the zone. The zone is recorded in
+	// the spec of the managed resources.
+	// +optional
+	DefaultZone *string `json:"defaultZone,omitempty"`
+}
+
+// RateLimitConfig configures client-side rate limits of API calls.
+type RateLimitConfig struct {
+	// QPS is the number of API calls per second shared by all services that
+	// don't have a rate limit of their own. Calls of those services aren't
+	// limited if this is not set.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	QPS *int64 `json:"qps,omitempty"`
+
+	// Burst is the number of API calls that may be made at once by services
+	// that don't have a rate limit of their own. Defaults to QPS.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Burst *int64 `json:"burst,omitempty"`
+
+	// Services configures rate limits of individual GCP services, keyed by
+	// the name of the service, e.g. compute.
+	// +optional
+	Services map[string]RateLimit `json:"services,omitempty"`
+}
+
+// A RateLimit limits the rate of API calls using a token bucket.
+type RateLimit struct {
+	// QPS is the number of API calls per second.
+	// +kubebuilder:validation:Minimum=1
+	QPS int64 `json:"qps"`
+
+	// Burst is the number of API calls that may be made at once. Defaults
+	// to QPS.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Burst *int64 `json:"burst,omitempty"`
+}
+
+// ProxyConfig configures an HTTP proxy.
+type ProxyConfig struct {
+	// URL of the proxy, e.g. http://proxy.example.com:3128.
+	URL string `json:"url"`
+
+	// CredentialsSecretRef references the credentials used to authenticate
+	// to the proxy, in the form username:password.
+	// +optional
+	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
 }
 
 // ProviderCredentials required to authenticate.
 type ProviderCredentials struct {
-	// Source of the provider credentials.
-	// +kubebuilder:validation:Enum=None;Secret;Environment;Filesystem
+	// Source of the provider credentials. The credentials of the Secret,
+	// Environment and Filesystem sources may be service account keys or
+	// external account configurations of Workload Identity Federation.
+	// InjectedIdentity uses the application default credentials of the
+	// provider pod, e.g. those of its GKE Workload Identity. AccessToken
+	// reads an OAuth access token from the key of the Secret referenced by
+	// secretRef. Federation exchanges a token of an external identity
+	// provider for GCP credentials as configured by federation.
+	// +kubebuilder:validation:Enum=None;Secret;InjectedIdentity;Environment;Filesystem;AccessToken;Federation
 	Source xpv1.CredentialsSource `json:"source"`
 
 	xpv1.CommonCredentialSelectors `json:",inline"`
+
+	// Scopes of the access tokens requested with Secret, InjectedIdentity
+	// and Federation credentials. Defaults to the cloud-platform scope.
+	// +optional
+	Scopes []string `json:"scopes,omitempty"`
+
+	// Federation configures Workload Identity Federation. Required if the
+	// source is Federation.
+	// +optional
+	Federation *FederationConfig `json:"federation,omitempty"`
+}
+
+// FederationConfig configures how a token of an external identity provider
+// is exchanged for GCP credentials using Workload Identity Federation.
+type FederationConfig struct {
+	// Audience of the exchange, i.e. the full resource name of the workload
+	// identity pool provider, e.g.
+	// //iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/my-pool/providers/my-provider.
+	Audience string `json:"audience"`
+
+	// SubjectTokenType is the type of the token of the external identity
+	// provider, e.g. urn:ietf:params:oauth:token-type:jwt.
+	// +optional
+	SubjectTokenType *string `json:"subjectTokenType,omitempty"`
+
+	// TokenURL of the Security Token Service. Defaults to
+	// https://sts.googleapis.com/v1/token.
+	// +optional
+	TokenURL *string `json:"tokenURL,omitempty"`
+
+	// ServiceAccountEmail of the service account to impersonate with the
+	// federated credentials. The federated identity is used directly if
+	// this is not set.
+	// +optional
+	ServiceAccountEmail *string `json:"serviceAccountEmail,omitempty"`
+
+	// CredentialSource configures where the token of the external identity
+	// provider is read from.
+	CredentialSource FederationCredentialSource `json:"credentialSource"`
+}
+
+// FederationCredentialSource configures where the token of an external
+// identity provider is read from. Exactly one of File and URL must be set.
+type FederationCredentialSource struct {
+	// File the token is read from, e.g. a projected service account token
+	// of the provider pod.
+	// +optional
+	File *string `json:"file,omitempty"`
+
+	// URL the token is read from.
+	// +optional
+	URL *string `json:"url,omitempty"`
+
+	// Headers sent when reading the token from URL.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Format of the file or response the token is read from. The whole
+	// content is used as token by default.
+	// +optional
+	Format *FederationCredentialFormat `json:"format,omitempty"`
+}
+
+// FederationCredentialFormat is the format of the file or response the token
+// of an external identity provider is read from.
+type FederationCredentialFormat struct {
+	// Type of the content.
+	// +kubebuilder:validation:Enum=text;json
+	Type string `json:"type"`
+
+	// SubjectTokenFieldName is the field that contains the token if the
+	// type is json.
+	// +optional
+	SubjectTokenFieldName *string `json:"subjectTokenFieldName,omitempty"`
 }
 
 // A ProviderConfigStatus represents the status of a ProviderConfig.
@@ -49,6 +256,7 @@ type ProviderConfigStatus struct {
 
 // A ProviderConfig configures how GCP controller should connect to GCP API.
 // +kubebuilder:printcolumn:name="PROJECT-ID",type="string",JSONPath=".spec.projectID"
+// +kubebuilder:printcolumn:name="USERS",type="integer",JSONPath=".status.users"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="SECRET-NAME",type="string",JSONPath=".spec.credentialsSecretRef.name",priority=1
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,provider,gcp}
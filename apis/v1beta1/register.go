@@ -58,7 +58,25 @@ var (
 	ProviderConfigUsageListGroupVersionKind = SchemeGroupVersion.WithKind(ProviderConfigUsageListKind)
 )
 
+// DefaultProviderConfigPolicy type metadata.
+var (
+	DefaultProviderConfigPolicyKind             = reflect.TypeOf(DefaultProviderConfigPolicy{}).Name()
+	DefaultProviderConfigPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: DefaultProviderConfigPolicyKind}.String()
+	DefaultProviderConfigPolicyKindAPIVersion   = DefaultProviderConfigPolicyKind + "." + SchemeGroupVersion.String()
+	DefaultProviderConfigPolicyGroupVersionKind = SchemeGroupVersion.WithKind(DefaultProviderConfigPolicyKind)
+)
+
+// StoreConfig type metadata.
+var (
+	StoreConfigKind             = reflect.TypeOf(StoreConfig{}).Name()
+	StoreConfigGroupKind        = schema.GroupKind{Group: Group, Kind: StoreConfigKind}.String()
+	StoreConfigKindAPIVersion   = StoreConfigKind + "." + SchemeGroupVersion.String()
+	StoreConfigGroupVersionKind = SchemeGroupVersion.WithKind(StoreConfigKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&ProviderConfig{}, &ProviderConfigList{})
 	SchemeBuilder.Register(&ProviderConfigUsage{}, &ProviderConfigUsageList{})
+	SchemeBuilder.Register(&DefaultProviderConfigPolicy{}, &DefaultProviderConfigPolicyList{})
+	SchemeBuilder.Register(&StoreConfig{}, &StoreConfigList{})
 }
@@ -0,0 +1,174 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A SecretStoreType is a type of store that connection details are published
+// to.
+type SecretStoreType string
+
+// Secret store types.
+const (
+	// SecretStoreKubernetes stores connection details in Kubernetes Secrets.
+	SecretStoreKubernetes SecretStoreType = "Kubernetes"
+
+	// SecretStoreSecretManager stores connection details in GCP Secret
+	// Manager secrets.
+	SecretStoreSecretManager SecretStoreType = "SecretManager"
+
+	// SecretStoreVault stores connection details in a Vault KV secrets
+	// engine.
+	SecretStoreVault SecretStoreType = "Vault"
+)
+
+// A VaultKVVersion is a version of the Vault KV secrets engine.
+type VaultKVVersion string
+
+// Vault KV secrets engine versions.
+const (
+	// VaultKVVersionV1 is version 1 of the KV secrets engine, which doesn't
+	// keep previous versions of secrets.
+	VaultKVVersionV1 VaultKVVersion = "v1"
+
+	// VaultKVVersionV2 is version 2 of the KV secrets engine, which keeps
+	// previous versions of secrets.
+	VaultKVVersionV2 VaultKVVersion = "v2"
+)
+
+// A VaultAuthMethod is a method of authenticating to Vault.
+type VaultAuthMethod string
+
+// Vault authentication methods.
+const (
+	// VaultAuthToken authenticates with a Vault token.
+	VaultAuthToken VaultAuthMethod = "Token"
+)
+
+// A VaultAuthTokenConfig configures where the Vault token is read from.
+type VaultAuthTokenConfig struct {
+	// Source of the token.
+	// +kubebuilder:validation:Enum=Secret;Environment;Filesystem
+	Source xpv1.CredentialsSource `json:"source"`
+
+	xpv1.CommonCredentialSelectors `json:",inline"`
+}
+
+// A VaultAuthConfig configures how the provider authenticates to Vault.
+type VaultAuthConfig struct {
+	// Method of authentication.
+	// +kubebuilder:validation:Enum=Token
+	Method VaultAuthMethod `json:"method"`
+
+	// Token configures the token of the Token method.
+	// +optional
+	Token *VaultAuthTokenConfig `json:"token,omitempty"`
+}
+
+// A VaultCABundleConfig configures where the PEM encoded CA bundle that
+// verifies the TLS certificate of the Vault server is read from.
+type VaultCABundleConfig struct {
+	// Source of the CA bundle.
+	// +kubebuilder:validation:Enum=Secret;Environment;Filesystem
+	Source xpv1.CredentialsSource `json:"source"`
+
+	xpv1.CommonCredentialSelectors `json:",inline"`
+}
+
+// A VaultStoreConfig configures a Vault store.
+type VaultStoreConfig struct {
+	// Server is the address of the Vault server, e.g.
+	// "https://vault.vault-system:8200".
+	Server string `json:"server"`
+
+	// MountPath is the path the KV secrets engine is mounted at, e.g.
+	// "secret".
+	MountPath string `json:"mountPath"`
+
+	// Version of the KV secrets engine.
+	// +optional
+	// +kubebuilder:validation:Enum=v1;v2
+	// +kubebuilder:default=v2
+	Version *VaultKVVersion `json:"version,omitempty"`
+
+	// CABundle configures the CA bundle that verifies the TLS certificate of
+	// the Vault server. The system CAs are used if it's not set.
+	// +optional
+	CABundle *VaultCABundleConfig `json:"caBundle,omitempty"`
+
+	// Auth configures how the provider authenticates to Vault.
+	Auth VaultAuthConfig `json:"auth"`
+}
+
+// A StoreConfigSpec defines the desired state of a StoreConfig.
+type StoreConfigSpec struct {
+	// Type of the store.
+	// +optional
+	// +kubebuilder:validation:Enum=Kubernetes;SecretManager;Vault
+	// +kubebuilder:default=Kubernetes
+	Type SecretStoreType `json:"type,omitempty"`
+
+	// DefaultScope of the secrets written to the store: the namespace of
+	// Kubernetes Secrets, the project of Secret Manager secrets, or the path
+	// under the mount path of Vault secrets. Secret Manager secrets are
+	// written to the project of the ProviderConfig, and Vault secrets to the
+	// mount path, if it's empty.
+	// +optional
+	DefaultScope string `json:"defaultScope,omitempty"`
+
+	// ProviderConfigReference references the ProviderConfig that is used to
+	// access Secret Manager. Defaults to the ProviderConfig named "default".
+	// +optional
+	ProviderConfigReference *xpv1.Reference `json:"providerConfigRef,omitempty"`
+
+	// Vault configures the store if its type is Vault.
+	// +optional
+	Vault *VaultStoreConfig `json:"vault,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A StoreConfig configures a store that the connection details of managed
+// resources are published to, e.g. to keep secrets such as database
+// passwords out of etcd. Managed resources publish their connection details
+// to a secret of the store named by their
+// gcp.crossplane.io/publish-connection-details-to annotation. They use the
+// StoreConfig named by their gcp.crossplane.io/connection-details-store
+// annotation, or the StoreConfig named "default".
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.type"
+// +kubebuilder:printcolumn:name="DEFAULT-SCOPE",type="string",JSONPath=".spec.defaultScope"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,store,gcp}
+type StoreConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec StoreConfigSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// StoreConfigList contains a list of StoreConfig
+type StoreConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StoreConfig `json:"items"`
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// DatasetParameters define the desired state of a Vertex AI dataset. Most
+// fields map directly to a Dataset:
+// https://cloud.google.com/vertex-ai/docs/reference/rest/v1/projects.locations.datasets#Dataset
+type DatasetParameters struct {
+	// Project the dataset belongs to. Defaults to the project of the
+	// provider config.
+	// +immutable
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// ProjectRef references a Project and retrieves its external name.
+	// +immutable
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and retrieves its
+	// external name.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// Location is the region of the dataset, e.g. us-central1.
+	// +immutable
+	Location string `json:"location"`
+
+	// DisplayName of the dataset.
+	// +kubebuilder:validation:MaxLength=128
+	DisplayName string `json:"displayName"`
+
+	// Description of the dataset.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels to apply to the dataset.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// MetadataSchemaURI points to the schema of the dataset metadata, which
+	// determines the kind of data the dataset holds, e.g.
+	// gs://google-cloud-aiplatform/schema/dataset/metadata/image_1.0.0.yaml.
+	// +immutable
+	MetadataSchemaURI string `json:"metadataSchemaUri"`
+
+	// KMSKeyName is the resource name of the Cloud KMS key the dataset is
+	// encrypted with, in the form
+	// projects/{project}/locations/{location}/keyRings/{keyring}/cryptoKeys/{key}.
+	// The key must be in the same region as the dataset.
+	// +immutable
+	// +optional
+	KMSKeyName *string `json:"kmsKeyName,omitempty"`
+
+	// KMSKeyNameRef references a CryptoKey and retrieves its resource name.
+	// +immutable
+	// +optional
+	KMSKeyNameRef *xpv1.Reference `json:"kmsKeyNameRef,omitempty"`
+
+	// KMSKeyNameSelector selects a reference to a CryptoKey.
+	// +optional
+	KMSKeyNameSelector *xpv1.Selector `json:"kmsKeyNameSelector,omitempty"`
+}
+
+// DatasetObservation is used to show the observed state of a Dataset.
+type DatasetObservation struct {
+	// Name is the fully qualified name of the dataset.
+	Name string `json:"name,omitempty"`
+
+	// DataItemCount is the number of data items in the dataset.
+	DataItemCount int64 `json:"dataItemCount,omitempty"`
+
+	// MetadataArtifact is the resource name of the artifact that tracks
+	// the dataset in Vertex ML Metadata.
+	MetadataArtifact string `json:"metadataArtifact,omitempty"`
+
+	// CreateTime is the time the dataset was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the dataset was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+
+	// Etag of the dataset.
+	Etag string `json:"etag,omitempty"`
+}
+
+// A DatasetSpec defines the desired state of a Dataset.
+type DatasetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DatasetParameters `json:"forProvider"`
+}
+
+// A DatasetStatus represents the observed state of a Dataset.
+type DatasetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DatasetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Dataset is a managed resource that represents a Vertex AI dataset, i.e. a collection of data items used to train models.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DISPLAY-NAME",type="string",JSONPath=".spec.forProvider.displayName"
+// +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.forProvider.location"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Dataset struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DatasetSpec   `json:"spec"`
+	Status DatasetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DatasetList contains a list of Dataset
+type DatasetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Dataset `json:"items"`
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// EndpointParameters define the desired state of a Vertex AI endpoint. Most
+// fields map directly to an Endpoint:
+// https://cloud.google.com/vertex-ai/docs/reference/rest/v1/projects.locations.endpoints#Endpoint
+type EndpointParameters struct {
+	// Project the endpoint belongs to. Defaults to the project of the
+	// provider config.
+	// +immutable
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// ProjectRef references a Project and retrieves its external name.
+	// +immutable
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and retrieves its
+	// external name.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// Location is the region of the endpoint, e.g. us-central1.
+	// +immutable
+	Location string `json:"location"`
+
+	// DisplayName of the endpoint.
+	// +kubebuilder:validation:MaxLength=128
+	DisplayName string `json:"displayName"`
+
+	// Description of the endpoint.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels to apply to the endpoint.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// TrafficSplit maps the IDs of the models deployed to the endpoint to
+	// the percentage of the traffic they receive. The percentages must add
+	// up to 100. Models are deployed to an endpoint outside of Crossplane,
+	// so the IDs of the deployed models are reported in
+	// status.atProvider.deployedModels. The traffic split is left as is if
+	// this is not set, e.g. because it's managed by whoever deploys models.
+	// +optional
+	TrafficSplit map[string]int64 `json:"trafficSplit,omitempty"`
+
+	// Network is the full name of the VPC network the endpoint is peered
+	// with, in the form projects/{project-number}/global/networks/{network}.
+	// The endpoint is only reachable from this network if it is set.
+	// +immutable
+	// +optional
+	Network *string `json:"network,omitempty"`
+
+	// KMSKeyName is the resource name of the Cloud KMS key the endpoint is
+	// encrypted with, in the form
+	// projects/{project}/locations/{location}/keyRings/{keyring}/cryptoKeys/{key}.
+	// The key must be in the same region as the endpoint.
+	// +immutable
+	// +optional
+	KMSKeyName *string `json:"kmsKeyName,omitempty"`
+
+	// KMSKeyNameRef references a CryptoKey and retrieves its resource name.
+	// +immutable
+	// +optional
+	KMSKeyNameRef *xpv1.Reference `json:"kmsKeyNameRef,omitempty"`
+
+	// KMSKeyNameSelector selects a reference to a CryptoKey.
+	// +optional
+	KMSKeyNameSelector *xpv1.Selector `json:"kmsKeyNameSelector,omitempty"`
+}
+
+// DeployedModel is a model that is deployed to an endpoint.
+type DeployedModel struct {
+	// ID of the deployed model. This is the key of the model in the traffic
+	// split of the endpoint.
+	ID string `json:"id,omitempty"`
+
+	// Model is the resource name of the model that is deployed.
+	Model string `json:"model,omitempty"`
+
+	// ModelVersionID is the version of the model that is deployed.
+	ModelVersionID string `json:"modelVersionId,omitempty"`
+
+	// DisplayName of the deployed model.
+	DisplayName string `json:"displayName,omitempty"`
+
+	// CreateTime is the time the model was deployed.
+	CreateTime string `json:"createTime,omitempty"`
+}
+
+// EndpointObservation is used to show the observed state of an Endpoint.
+type EndpointObservation struct {
+	// Name is the fully qualified name of the endpoint.
+	Name string `json:"name,omitempty"`
+
+	// DeployedModels are the models deployed to the endpoint.
+	DeployedModels []DeployedModel `json:"deployedModels,omitempty"`
+
+	// CreateTime is the time the endpoint was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the endpoint was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+
+	// Etag of the endpoint.
+	Etag string `json:"etag,omitempty"`
+}
+
+// A EndpointSpec defines the desired state of a Endpoint.
+type EndpointSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EndpointParameters `json:"forProvider"`
+}
+
+// A EndpointStatus represents the observed state of a Endpoint.
+type EndpointStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EndpointObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Endpoint is a managed resource that represents a Vertex AI endpoint, i.e. a serving endpoint models are deployed to.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DISPLAY-NAME",type="string",JSONPath=".spec.forProvider.displayName"
+// +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.forProvider.location"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Endpoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EndpointSpec   `json:"spec"`
+	Status EndpointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EndpointList contains a list of Endpoint
+type EndpointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Endpoint `json:"items"`
+}
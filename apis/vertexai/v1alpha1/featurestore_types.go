@@ -0,0 +1,177 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known Featurestore states.
+const (
+	FeaturestoreStateStable   = "STABLE"
+	FeaturestoreStateUpdating = "UPDATING"
+)
+
+// FeaturestoreParameters define the desired state of a Vertex AI
+// featurestore. Most fields map directly to a Featurestore:
+// https://cloud.google.com/vertex-ai/docs/reference/rest/v1/projects.locations.featurestores#Featurestore
+type FeaturestoreParameters struct {
+	// Project the featurestore belongs to. Defaults to the project of the
+	// provider config.
+	// +immutable
+	// +optional
+	Project *string `json:"project,omitempty"`
+
+	// ProjectRef references a Project and retrieves its external name.
+	// +immutable
+	// +optional
+	ProjectRef *xpv1.Reference `json:"projectRef,omitempty"`
+
+	// ProjectSelector selects a reference to a Project and retrieves its
+	// external name.
+	// +optional
+	ProjectSelector *xpv1.Selector `json:"projectSelector,omitempty"`
+
+	// Location is the region of the featurestore, e.g. us-central1.
+	// +immutable
+	Location string `json:"location"`
+
+	// Labels to apply to the featurestore.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// OnlineServingConfig configures the nodes that serve online feature
+	// values. Online serving is disabled if this is not set.
+	// +optional
+	OnlineServingConfig *OnlineServingConfig `json:"onlineServingConfig,omitempty"`
+
+	// OnlineStorageTTLDays is the number of days feature values are kept in
+	// the online storage. Defaults to 4000 days.
+	// +optional
+	OnlineStorageTTLDays *int64 `json:"onlineStorageTtlDays,omitempty"`
+
+	// KMSKeyName is the resource name of the Cloud KMS key the featurestore is
+	// encrypted with, in the form
+	// projects/{project}/locations/{location}/keyRings/{keyring}/cryptoKeys/{key}.
+	// The key must be in the same region as the featurestore.
+	// +immutable
+	// +optional
+	KMSKeyName *string `json:"kmsKeyName,omitempty"`
+
+	// KMSKeyNameRef references a CryptoKey and retrieves its resource name.
+	// +immutable
+	// +optional
+	KMSKeyNameRef *xpv1.Reference `json:"kmsKeyNameRef,omitempty"`
+
+	// KMSKeyNameSelector selects a reference to a CryptoKey.
+	// +optional
+	KMSKeyNameSelector *xpv1.Selector `json:"kmsKeyNameSelector,omitempty"`
+}
+
+// OnlineServingConfig configures the online serving nodes of a featurestore.
+// Only one of FixedNodeCount and Scaling may be set.
+type OnlineServingConfig struct {
+	// FixedNodeCount is the number of nodes that serve online feature
+	// values. No nodes are provisioned if this is 0.
+	// +optional
+	FixedNodeCount *int64 `json:"fixedNodeCount,omitempty"`
+
+	// Scaling lets the number of online serving nodes scale with their
+	// CPU utilization.
+	// +optional
+	Scaling *OnlineServingScaling `json:"scaling,omitempty"`
+}
+
+// OnlineServingScaling configures the autoscaling of the online serving
+// nodes of a featurestore.
+type OnlineServingScaling struct {
+	// MinNodeCount is the minimum number of nodes.
+	// +kubebuilder:validation:Minimum=1
+	MinNodeCount int64 `json:"minNodeCount"`
+
+	// MaxNodeCount is the maximum number of nodes. Must be at least
+	// MinNodeCount.
+	// +optional
+	MaxNodeCount *int64 `json:"maxNodeCount,omitempty"`
+
+	// CPUUtilizationTarget is the CPU utilization in percent the nodes are
+	// scaled to. Defaults to 50.
+	// +kubebuilder:validation:Minimum=10
+	// +kubebuilder:validation:Maximum=80
+	// +optional
+	CPUUtilizationTarget *int64 `json:"cpuUtilizationTarget,omitempty"`
+}
+
+// FeaturestoreObservation is used to show the observed state of a
+// Featurestore.
+type FeaturestoreObservation struct {
+	// Name is the fully qualified name of the featurestore.
+	Name string `json:"name,omitempty"`
+
+	// State of the featurestore.
+	State string `json:"state,omitempty"`
+
+	// CreateTime is the time the featurestore was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the featurestore was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+
+	// Etag of the featurestore.
+	Etag string `json:"etag,omitempty"`
+}
+
+// A FeaturestoreSpec defines the desired state of a Featurestore.
+type FeaturestoreSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FeaturestoreParameters `json:"forProvider"`
+}
+
+// A FeaturestoreStatus represents the observed state of a Featurestore.
+type FeaturestoreStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          FeaturestoreObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Featurestore is a managed resource that represents a Vertex AI featurestore, i.e. a store that serves ML features.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.forProvider.location"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Featurestore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FeaturestoreSpec   `json:"spec"`
+	Status FeaturestoreStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FeaturestoreList contains a list of Featurestore
+type FeaturestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Featurestore `json:"items"`
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known Connector states.
+const (
+	ConnectorStateReady    = "READY"
+	ConnectorStateCreating = "CREATING"
+	ConnectorStateDeleting = "DELETING"
+	ConnectorStateError    = "ERROR"
+	ConnectorStateUpdating = "UPDATING"
+)
+
+// ConnectorParameters define the desired state of a Serverless VPC Access
+// Connector. Either Network and IPCIDRRange, or Subnet must be set. Most
+// fields map directly to a Connector:
+// https://cloud.google.com/vpc/docs/reference/vpcaccess/rest/v1/projects.locations.connectors#Connector
+type ConnectorParameters struct {
+	// Location in which to create this connector, e.g. us-central1.
+	// +immutable
+	Location string `json:"location"`
+
+	// Network is the name of the VPC network the connector attaches to.
+	// +optional
+	// +immutable
+	Network *string `json:"network,omitempty"`
+
+	// NetworkRef references a Network to retrieve its name.
+	// +optional
+	// +immutable
+	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to a Network to retrieve its name.
+	// +optional
+	// +immutable
+	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
+
+	// IPCIDRRange is the /28 range of internal addresses used by the
+	// connector, e.g. 10.8.0.0/28. It must not overlap with any existing
+	// subnetwork of the network.
+	// +optional
+	// +immutable
+	IPCIDRRange *string `json:"ipCidrRange,omitempty"`
+
+	// Subnet is an existing /28 subnetwork in which to house the connector.
+	// +optional
+	// +immutable
+	Subnet *Subnet `json:"subnet,omitempty"`
+
+	// MachineType of the VM instances underlying the connector, e.g.
+	// e2-micro.
+	// +optional
+	MachineType *string `json:"machineType,omitempty"`
+
+	// MinInstances is the minimum number of instances the connector scales
+	// in to.
+	// +optional
+	// +kubebuilder:validation:Minimum=2
+	MinInstances *int64 `json:"minInstances,omitempty"`
+
+	// MaxInstances is the maximum number of instances the connector scales
+	// out to.
+	// +optional
+	// +kubebuilder:validation:Maximum=10
+	MaxInstances *int64 `json:"maxInstances,omitempty"`
+
+	// MinThroughput of the connector in Mbps. Prefer MinInstances.
+	// +optional
+	// +immutable
+	MinThroughput *int64 `json:"minThroughput,omitempty"`
+
+	// MaxThroughput of the connector in Mbps. Prefer MaxInstances.
+	// +optional
+	// +immutable
+	MaxThroughput *int64 `json:"maxThroughput,omitempty"`
+}
+
+// A Subnet in which to house a connector.
+type Subnet struct {
+	// Name of the subnetwork.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// NameRef references a Subnetwork to retrieve its name.
+	// +optional
+	NameRef *xpv1.Reference `json:"nameRef,omitempty"`
+
+	// NameSelector selects a reference to a Subnetwork to retrieve its name.
+	// +optional
+	NameSelector *xpv1.Selector `json:"nameSelector,omitempty"`
+
+	// ProjectID of the host project of the subnetwork, if it is not the
+	// project of the connector, e.g. when using Shared VPC.
+	// +optional
+	ProjectID *string `json:"projectId,omitempty"`
+}
+
+// ConnectorObservation is used to show the observed state of a Connector.
+type ConnectorObservation struct {
+	// Name is the fully qualified name of the connector.
+	Name string `json:"name,omitempty"`
+
+	// State of the connector.
+	State string `json:"state,omitempty"`
+
+	// ConnectedProjects lists the projects that use the connector.
+	ConnectedProjects []string `json:"connectedProjects,omitempty"`
+}
+
+// A ConnectorSpec defines the desired state of a Connector.
+type ConnectorSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ConnectorParameters `json:"forProvider"`
+}
+
+// A ConnectorStatus represents the observed state of a Connector.
+type ConnectorStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ConnectorObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Connector is a managed resource that represents a Serverless VPC Access Connector.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Connector struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConnectorSpec   `json:"spec"`
+	Status ConnectorStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ConnectorList contains a list of Connector
+type ConnectorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Connector `json:"items"`
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	computev1beta1 "github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+)
+
+// ConnectorName extracts the fully qualified name of a Connector.
+func ConnectorName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		c, ok := mg.(*Connector)
+		if !ok {
+			return ""
+		}
+		return c.Status.AtProvider.Name
+	}
+}
+
+// ResolveReferences of this Connector
+func (in *Connector) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	// Resolve spec.forProvider.network
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.Network),
+		Reference:    in.Spec.ForProvider.NetworkRef,
+		Selector:     in.Spec.ForProvider.NetworkSelector,
+		To:           reference.To{Managed: &computev1beta1.Network{}, List: &computev1beta1.NetworkList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.network")
+	}
+	in.Spec.ForProvider.Network = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.NetworkRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.subnet.name
+	if s := in.Spec.ForProvider.Subnet; s != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(s.Name),
+			Reference:    s.NameRef,
+			Selector:     s.NameSelector,
+			To:           reference.To{Managed: &computev1beta1.Subnetwork{}, List: &computev1beta1.SubnetworkList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.subnet.name")
+		}
+		s.Name = reference.ToPtrValue(rsp.ResolvedValue)
+		s.NameRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known Workflow states.
+const (
+	WorkflowStateActive      = "ACTIVE"
+	WorkflowStateUnavailable = "UNAVAILABLE"
+)
+
+// WorkflowParameters define the desired state of a Workflows Workflow.
+// Exactly one of SourceContents or SourceConfigMapRef must be set. Most fields
+// map directly to a Workflow:
+// https://cloud.google.com/workflows/docs/reference/rest/v1/projects.locations.workflows#Workflow
+type WorkflowParameters struct {
+	// Location in which to create this workflow, e.g. us-central1.
+	// +immutable
+	Location string `json:"location"`
+
+	// Description of the workflow.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels to apply to the workflow.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// SourceContents is the workflow definition in YAML or JSON.
+	// +optional
+	SourceContents *string `json:"sourceContents,omitempty"`
+
+	// SourceConfigMapRef selects a key of a ConfigMap that contains the
+	// workflow definition in YAML or JSON.
+	// +optional
+	SourceConfigMapRef *ConfigMapKeySelector `json:"sourceConfigMapRef,omitempty"`
+
+	// ServiceAccount is the email address of the IAM service account whose
+	// identity the workflow runs as.
+	// +optional
+	ServiceAccount *string `json:"serviceAccount,omitempty"`
+
+	// ServiceAccountRef references a ServiceAccount to retrieve its email
+	// address.
+	// +optional
+	ServiceAccountRef *xpv1.Reference `json:"serviceAccountRef,omitempty"`
+
+	// ServiceAccountSelector selects a reference to a ServiceAccount to
+	// retrieve its email address.
+	// +optional
+	ServiceAccountSelector *xpv1.Selector `json:"serviceAccountSelector,omitempty"`
+
+	// EnvironmentVariables that are available to the workflow through
+	// sys.get_env.
+	// +optional
+	EnvironmentVariables map[string]string `json:"environmentVariables,omitempty"`
+
+	// CallLogLevel configures which call steps of the workflow are logged.
+	// +optional
+	// +kubebuilder:validation:Enum=LOG_ALL_CALLS;LOG_ERRORS_ONLY;LOG_NONE
+	CallLogLevel *string `json:"callLogLevel,omitempty"`
+}
+
+// A ConfigMapKeySelector is a reference to a ConfigMap key in an arbitrary
+// namespace.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// Key within the ConfigMap.
+	Key string `json:"key"`
+}
+
+// WorkflowObservation is used to show the observed state of a Workflow.
+type WorkflowObservation struct {
+	// Name is the fully qualified name of the workflow.
+	Name string `json:"name,omitempty"`
+
+	// State of the workflow.
+	State string `json:"state,omitempty"`
+
+	// StateError describes why the workflow is unavailable.
+	StateError string `json:"stateError,omitempty"`
+
+	// RevisionID of the deployed revision of the workflow. A new revision
+	// is created whenever the source or service account changes.
+	RevisionID string `json:"revisionId,omitempty"`
+
+	// RevisionCreateTime is the time the deployed revision was created.
+	RevisionCreateTime string `json:"revisionCreateTime,omitempty"`
+
+	// CreateTime is the time the workflow was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime is the time the workflow was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// A WorkflowSpec defines the desired state of a Workflow.
+type WorkflowSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       WorkflowParameters `json:"forProvider"`
+}
+
+// A WorkflowStatus represents the observed state of a Workflow.
+type WorkflowStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          WorkflowObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Workflow is a managed resource that represents a Workflows Workflow.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="REVISION",type="string",JSONPath=".status.atProvider.revisionId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Workflow struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WorkflowSpec   `json:"spec"`
+	Status WorkflowStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WorkflowList contains a list of Workflow
+type WorkflowList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Workflow `json:"items"`
+}
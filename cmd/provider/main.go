@@ -17,17 +17,22 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"os"
 	"path/filepath"
+	"time"
 
 	"gopkg.in/alecthomas/kingpin.v2"
+	corev1 "k8s.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
 
 	"github.com/crossplane/provider-gcp/apis"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 	"github.com/crossplane/provider-gcp/pkg/controller"
 )
 
@@ -35,11 +40,44 @@ func main() {
 	var (
 		app            = kingpin.New(filepath.Base(os.Args[0]), "GCP support for Crossplane.").DefaultEnvars()
 		debug          = app.Flag("debug", "Run with debug logging.").Short('d').Bool()
+		debugGCP       = app.Flag("debug-gcp", "Log the method, URL, latency and status code of every request sent to a GCP API, along with the managed resource it was sent for. Request and response bodies aren't logged.").Bool()
 		syncInterval   = app.Flag("sync", "Sync interval controls how often all resources will be double checked for drift.").Short('s').Default("1h").Duration()
-		pollInterval   = app.Flag("poll", "Poll interval controls how often an individual resource should be checked for drift.").Default("1m").Duration()
+		pollInterval   = app.Flag("poll-interval", "Poll interval controls how often an individual resource should be checked for drift. Resources may override it with the gcp.crossplane.io/poll-interval annotation.").Default("1m").Duration()
+		poll           = app.Flag("poll", "Deprecated: use --poll-interval.").Hidden().Duration()
+		maxReconcile   = app.Flag("max-reconcile-rate", "The maximum number of managed resources of each kind that may be reconciled concurrently.").Default("1").Int()
+		maxReconciles  = app.Flag("max-concurrent-reconciles", "The maximum number of managed resources of a kind that may be reconciled concurrently, overriding --max-reconcile-rate, e.g. ResourceRecordSet.dns.gcp.crossplane.io=10. May be repeated.").PlaceHolder("KIND=N").StringMap()
+		otlpEndpoint   = app.Flag("otlp-endpoint", "Endpoint of an OTLP/HTTP collector, e.g. localhost:4318, to export traces of reconciles and GCP API requests to. Tracing is disabled if unset.").String()
+		otlpInsecure   = app.Flag("otlp-insecure", "Export traces to the OTLP/HTTP collector without TLS.").Bool()
+		enableWebhooks = app.Flag("enable-webhooks", "Run the webhook server, which converts Networks and Subnetworks that are still stored as v1alpha3 to v1beta1 and sets defaults such as the region of a zone. Requires Crossplane to provide the webhook TLS certificates.").Default("false").Bool()
+		webhookCertDir = app.Flag("webhook-tls-cert-dir", "Directory of the tls.crt and tls.key files of the webhook server.").Default("/webhook/tls").Envar("WEBHOOK_TLS_CERT_DIR").String()
+		probeAddr      = app.Flag("health-probe-bind-address", "Address the /healthz and /readyz endpoints bind to. The provider is ready once its caches have synced, whether or not it is the leader.").Default(":8081").String()
+		drainTimeout   = app.Flag("graceful-shutdown-timeout", "How long in-flight reconciles may take to finish when the provider is stopped, e.g. on SIGTERM.").Default("30s").Duration()
+		listTTL        = app.Flag("list-observation-ttl", "Observe high-cardinality kinds, such as ResourceRecordSets, by listing their external resources and caching the lists for this long, rather than getting them one by one. Disabled if 0.").Default("0s").Duration()
+		dryRun         = app.Flag("dry-run", "Don't create, update or delete any external resources, but report what would be done in the DryRun condition and events of managed resources. Resources may override it with the gcp.crossplane.io/dry-run annotation.").Bool()
+		enableAlpha    = app.Flag("enable-alpha-resources", "Run the controllers of experimental kinds, such as Imports of the compute group and the v1alpha1 kinds of the alloydb group. Their APIs may change in backward incompatible ways. Their CRDs are always installed, but their managed resources are not reconciled unless this is set.").Default("false").Bool()
 		leaderElection = app.Flag("leader-election", "Use leader election for the conroller manager.").Short('l').Default("false").OverrideDefaultFromEnvar("LEADER_ELECTION").Bool()
+		leaderID       = app.Flag("leader-election-id", "Name of the lease used for leader election. Deployments that run different --controllers must use different names.").Default("crossplane-leader-election-provider-gcp").String()
+		leaseDuration  = app.Flag("leader-election-lease-duration", "How long standby replicas wait before they take over leadership from a leader that stopped renewing its lease.").Default("15s").Duration()
+		controllers    = app.Flag("controllers", "API group whose controllers are run, e.g. compute or database, or, prefixed with a dash, aren't run, e.g. -compute. The controllers of all groups are run by default. The gcp group accounts for the usage of ProviderConfigs. May be repeated.").PlaceHolder("GROUP").Strings()
 	)
 	kingpin.MustParse(app.Parse(os.Args[1:]))
+	if *poll != 0 {
+		pollInterval = poll
+	}
+
+	mcr, err := gcp.ParseMaxConcurrentReconciles(*maxReconcile, *maxReconciles)
+	kingpin.FatalIfError(err, "Cannot parse maximum concurrent reconciles")
+
+	filter, err := controller.ParseFilter(*controllers)
+	kingpin.FatalIfError(err, "Cannot parse controllers")
+	filter.EnableAlpha = *enableAlpha
+
+	if *listTTL > 0 {
+		gcp.EnableListObservation(*listTTL)
+	}
+	if *dryRun {
+		gcp.EnableDryRun()
+	}
 
 	zl := zap.New(zap.UseDevMode(*debug))
 	log := logging.NewLogrLogger(zl.WithName("provider-gcp"))
@@ -49,6 +87,9 @@ func main() {
 		// logger when we're running in debug mode.
 		ctrl.SetLogger(zl)
 	}
+	if *debugGCP {
+		gcp.EnableAPIDebugLogging(log.WithValues("component", "gcp-api"))
+	}
 
 	log.Debug("Starting", "sync-period", syncInterval.String())
 
@@ -57,12 +98,42 @@ func main() {
 
 	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
 		LeaderElection:   *leaderElection,
-		LeaderElectionID: "crossplane-leader-election-provider-gcp",
-		SyncPeriod:       syncInterval,
+		LeaderElectionID: *leaderID,
+		LeaseDuration:    leaseDuration,
+		// Hand over leadership as soon as in-flight reconciles are drained
+		// rather than when the lease expires.
+		LeaderElectionReleaseOnCancel: true,
+		SyncPeriod:                    syncInterval,
+		CertDir:                       *webhookCertDir,
+		HealthProbeBindAddress:        *probeAddr,
+		GracefulShutdownTimeout:       drainTimeout,
+		// Secrets are read from the API server rather than from a cache of
+		// every Secret of the cluster. Credential Secrets are watched through
+		// a cache of their own, see gcp.CredentialSecrets.
+		ClientDisableCacheFor: []client.Object{&corev1.Secret{}},
 	})
 	kingpin.FatalIfError(err, "Cannot create controller manager")
 
 	kingpin.FatalIfError(apis.AddToScheme(mgr.GetScheme()), "Cannot add GCP APIs to scheme")
-	kingpin.FatalIfError(controller.Setup(mgr, log, ratelimiter.NewDefaultProviderRateLimiter(ratelimiter.DefaultProviderRPS), *pollInterval), "Cannot setup GCP controllers")
-	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
+	kingpin.FatalIfError(controller.SetupHealthChecks(mgr), "Cannot setup health checks")
+	kingpin.FatalIfError(controller.Setup(mgr, log, ratelimiter.NewDefaultProviderRateLimiter(ratelimiter.DefaultProviderRPS), *pollInterval, mcr, filter), "Cannot setup GCP controllers")
+	if *enableWebhooks {
+		kingpin.FatalIfError(controller.SetupWebhooks(mgr), "Cannot setup GCP webhooks")
+	}
+
+	ctx := ctrl.SetupSignalHandler()
+	stopTracing := func(context.Context) error { return nil }
+	if *otlpEndpoint != "" {
+		stopTracing = gcp.SetupTracing(*otlpEndpoint, *otlpInsecure)
+	}
+	err = mgr.Start(ctx)
+
+	// The signal handler's context is done by now, so pending spans are
+	// flushed with a fresh one.
+	flushCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := stopTracing(flushCtx); err != nil {
+		log.Info("Cannot flush traces", "error", err)
+	}
+	kingpin.FatalIfError(err, "Cannot start controller manager")
 }
@@ -0,0 +1,129 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesscontextmanager
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	accesscontextmanager "google.golang.org/api/accesscontextmanager/v1"
+
+	"github.com/crossplane/provider-gcp/apis/accesscontextmanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	accessLevelNameFormat = "accessPolicies/%s/accessLevels/%s"
+
+	// AccessLevelUpdateMask is the list of access level fields that can be
+	// updated.
+	AccessLevelUpdateMask = "title,description,basic,custom"
+)
+
+// GetAccessLevelName builds the fully qualified name of an access level.
+func GetAccessLevelName(policy, id string) string {
+	return fmt.Sprintf(accessLevelNameFormat, policy, id)
+}
+
+// GenerateAccessLevel produces an AccessLevel with the supplied name that is
+// configured via the supplied AccessLevelParameters.
+func GenerateAccessLevel(name string, p v1alpha1.AccessLevelParameters) *accesscontextmanager.AccessLevel {
+	l := &accesscontextmanager.AccessLevel{
+		Name:        name,
+		Title:       p.Title,
+		Description: gcp.StringValue(p.Description),
+	}
+	if b := p.Basic; b != nil {
+		l.Basic = &accesscontextmanager.BasicLevel{
+			CombiningFunction: gcp.StringValue(b.CombiningFunction),
+		}
+		for _, c := range b.Conditions {
+			l.Basic.Conditions = append(l.Basic.Conditions, generateCondition(c))
+		}
+	}
+	if c := p.Custom; c != nil {
+		l.Custom = &accesscontextmanager.CustomLevel{
+			Expr: &accesscontextmanager.Expr{
+				Expression:  c.Expr.Expression,
+				Title:       gcp.StringValue(c.Expr.Title),
+				Description: gcp.StringValue(c.Expr.Description),
+				Location:    gcp.StringValue(c.Expr.Location),
+			},
+		}
+	}
+	return l
+}
+
+func generateCondition(c v1alpha1.Condition) *accesscontextmanager.Condition {
+	out := &accesscontextmanager.Condition{
+		IpSubnetworks:        c.IPSubnetworks,
+		Members:              c.Members,
+		Negate:               gcp.BoolValue(c.Negate),
+		Regions:              c.Regions,
+		RequiredAccessLevels: c.RequiredAccessLevels,
+	}
+	if d := c.DevicePolicy; d != nil {
+		out.DevicePolicy = &accesscontextmanager.DevicePolicy{
+			RequireScreenlock:             gcp.BoolValue(d.RequireScreenlock),
+			AllowedEncryptionStatuses:     d.AllowedEncryptionStatuses,
+			AllowedDeviceManagementLevels: d.AllowedDeviceManagementLevels,
+			RequireAdminApproval:          gcp.BoolValue(d.RequireAdminApproval),
+			RequireCorpOwned:              gcp.BoolValue(d.RequireCorpOwned),
+		}
+		for _, o := range d.OsConstraints {
+			out.DevicePolicy.OsConstraints = append(out.DevicePolicy.OsConstraints, &accesscontextmanager.OsConstraint{
+				OsType:                  o.OsType,
+				MinimumVersion:          gcp.StringValue(o.MinimumVersion),
+				RequireVerifiedChromeOs: gcp.BoolValue(o.RequireVerifiedChromeOs),
+			})
+		}
+	}
+	return out
+}
+
+// GenerateAccessLevelObservation produces an AccessLevelObservation from the
+// supplied AccessLevel.
+func GenerateAccessLevelObservation(l accesscontextmanager.AccessLevel) v1alpha1.AccessLevelObservation {
+	return v1alpha1.AccessLevelObservation{Name: l.Name}
+}
+
+// LateInitializeAccessLevel fills the empty fields of the supplied
+// AccessLevelParameters with the values of the supplied AccessLevel.
+func LateInitializeAccessLevel(p *v1alpha1.AccessLevelParameters, l accesscontextmanager.AccessLevel) {
+	p.Description = gcp.LateInitializeString(p.Description, l.Description)
+	if p.Basic != nil && l.Basic != nil {
+		p.Basic.CombiningFunction = gcp.LateInitializeString(p.Basic.CombiningFunction, l.Basic.CombiningFunction)
+	}
+}
+
+// IsAccessLevelUpToDate returns true if the supplied AccessLevel matches the
+// supplied AccessLevelParameters.
+func IsAccessLevelUpToDate(p v1alpha1.AccessLevelParameters, l accesscontextmanager.AccessLevel) bool {
+	desired := GenerateAccessLevel(l.Name, p)
+	if desired.Title != l.Title || desired.Description != l.Description {
+		return false
+	}
+	return cmp.Equal(desired.Basic, l.Basic, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(accesscontextmanager.BasicLevel{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(accesscontextmanager.Condition{}, "VpcNetworkSources", "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(accesscontextmanager.DevicePolicy{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(accesscontextmanager.OsConstraint{}, "ForceSendFields", "NullFields")) &&
+		cmp.Equal(desired.Custom, l.Custom, cmpopts.EquateEmpty(),
+			cmpopts.IgnoreFields(accesscontextmanager.CustomLevel{}, "ForceSendFields", "NullFields"),
+			cmpopts.IgnoreFields(accesscontextmanager.Expr{}, "ForceSendFields", "NullFields"))
+}
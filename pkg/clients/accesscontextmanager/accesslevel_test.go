@@ -0,0 +1,148 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesscontextmanager
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	accesscontextmanager "google.golang.org/api/accesscontextmanager/v1"
+
+	"github.com/crossplane/provider-gcp/apis/accesscontextmanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const accessLevelName = "accessPolicies/1234567890/accessLevels/corp_network"
+
+func levelParams(m ...func(*v1alpha1.AccessLevelParameters)) *v1alpha1.AccessLevelParameters {
+	p := &v1alpha1.AccessLevelParameters{
+		AccessPolicy: gcp.StringPtr(policyID),
+		Title:        "Corporate network",
+		Basic: &v1alpha1.BasicLevel{
+			CombiningFunction: gcp.StringPtr(v1alpha1.CombiningFunctionAnd),
+			Conditions: []v1alpha1.Condition{{
+				IPSubnetworks: []string{"192.0.2.0/24"},
+				DevicePolicy: &v1alpha1.DevicePolicy{
+					RequireScreenlock: gcp.BoolPtr(true),
+					OsConstraints:     []v1alpha1.OsConstraint{{OsType: "DESKTOP_MAC", MinimumVersion: gcp.StringPtr("13.0.0")}},
+				},
+			}},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func accessLevel(m ...func(*accesscontextmanager.AccessLevel)) *accesscontextmanager.AccessLevel {
+	l := &accesscontextmanager.AccessLevel{
+		Name:  accessLevelName,
+		Title: "Corporate network",
+		Basic: &accesscontextmanager.BasicLevel{
+			CombiningFunction: "AND",
+			Conditions: []*accesscontextmanager.Condition{{
+				IpSubnetworks: []string{"192.0.2.0/24"},
+				DevicePolicy: &accesscontextmanager.DevicePolicy{
+					RequireScreenlock: true,
+					OsConstraints:     []*accesscontextmanager.OsConstraint{{OsType: "DESKTOP_MAC", MinimumVersion: "13.0.0"}},
+				},
+			}},
+		},
+	}
+	for _, f := range m {
+		f(l)
+	}
+	return l
+}
+
+func TestGenerateAccessLevel(t *testing.T) {
+	if diff := cmp.Diff(accessLevelName, GetAccessLevelName(policyID, "corp_network")); diff != "" {
+		t.Errorf("GetAccessLevelName(...): -want, +got:\n%s", diff)
+	}
+	cases := map[string]struct {
+		params *v1alpha1.AccessLevelParameters
+		want   *accesscontextmanager.AccessLevel
+	}{
+		"Basic": {
+			params: levelParams(),
+			want:   accessLevel(),
+		},
+		"Custom": {
+			params: levelParams(func(p *v1alpha1.AccessLevelParameters) {
+				p.Basic = nil
+				p.Custom = &v1alpha1.CustomLevel{Expr: v1alpha1.Expr{Expression: "device.os_type == OsType.DESKTOP_MAC"}}
+			}),
+			want: accessLevel(func(l *accesscontextmanager.AccessLevel) {
+				l.Basic = nil
+				l.Custom = &accesscontextmanager.CustomLevel{Expr: &accesscontextmanager.Expr{Expression: "device.os_type == OsType.DESKTOP_MAC"}}
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateAccessLevel(accessLevelName, *tc.params)); diff != "" {
+				t.Errorf("GenerateAccessLevel(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeAccessLevel(t *testing.T) {
+	got := levelParams(func(p *v1alpha1.AccessLevelParameters) {
+		p.Basic.CombiningFunction = nil
+	})
+	LateInitializeAccessLevel(got, *accessLevel(func(l *accesscontextmanager.AccessLevel) {
+		l.Description = "Requests from the office"
+	}))
+	want := levelParams(func(p *v1alpha1.AccessLevelParameters) {
+		p.Description = gcp.StringPtr("Requests from the office")
+	})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("LateInitializeAccessLevel(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsAccessLevelUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		params *v1alpha1.AccessLevelParameters
+		want   bool
+	}{
+		"UpToDate": {
+			params: levelParams(),
+			want:   true,
+		},
+		"TitleChanged": {
+			params: levelParams(func(p *v1alpha1.AccessLevelParameters) { p.Title = "Office" }),
+			want:   false,
+		},
+		"ConditionChanged": {
+			params: levelParams(func(p *v1alpha1.AccessLevelParameters) {
+				p.Basic.Conditions[0].Regions = []string{"DE"}
+			}),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsAccessLevelUpToDate(*tc.params, *accessLevel())
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsAccessLevelUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
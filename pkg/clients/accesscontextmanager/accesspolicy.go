@@ -0,0 +1,83 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesscontextmanager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	accesscontextmanager "google.golang.org/api/accesscontextmanager/v1"
+
+	"github.com/crossplane/provider-gcp/apis/accesscontextmanager/v1alpha1"
+)
+
+const (
+	accessPolicyNameFormat = "accessPolicies/%s"
+
+	// AccessPolicyUpdateMask is the list of access policy fields that can be
+	// updated.
+	AccessPolicyUpdateMask = "title,scopes"
+)
+
+// GetAccessPolicyName builds the fully qualified name of an access policy.
+func GetAccessPolicyName(id string) string {
+	return fmt.Sprintf(accessPolicyNameFormat, id)
+}
+
+// GetID extracts the ID of an access policy, access level or service
+// perimeter from its fully qualified name.
+func GetID(name string) string {
+	return name[strings.LastIndex(name, "/")+1:]
+}
+
+// GenerateAccessPolicy produces an AccessPolicy that is configured via the
+// supplied AccessPolicyParameters.
+func GenerateAccessPolicy(p v1alpha1.AccessPolicyParameters) *accesscontextmanager.AccessPolicy {
+	return &accesscontextmanager.AccessPolicy{
+		Parent: p.Parent,
+		Title:  p.Title,
+		Scopes: p.Scopes,
+	}
+}
+
+// GenerateAccessPolicyObservation produces an AccessPolicyObservation from
+// the supplied AccessPolicy.
+func GenerateAccessPolicyObservation(ap accesscontextmanager.AccessPolicy) v1alpha1.AccessPolicyObservation {
+	return v1alpha1.AccessPolicyObservation{
+		Name: ap.Name,
+		Etag: ap.Etag,
+	}
+}
+
+// IsAccessPolicyUpToDate returns true if the supplied AccessPolicy matches
+// the supplied AccessPolicyParameters.
+func IsAccessPolicyUpToDate(p v1alpha1.AccessPolicyParameters, ap accesscontextmanager.AccessPolicy) bool {
+	return p.Title == ap.Title && cmp.Equal(p.Scopes, ap.Scopes, cmpopts.EquateEmpty())
+}
+
+// FindAccessPolicy returns the policy of the supplied policies that has the
+// title of the supplied AccessPolicyParameters, or nil if there is none.
+func FindAccessPolicy(p v1alpha1.AccessPolicyParameters, policies []*accesscontextmanager.AccessPolicy) *accesscontextmanager.AccessPolicy {
+	for _, ap := range policies {
+		if ap.Title == p.Title {
+			return ap
+		}
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesscontextmanager
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	accesscontextmanager "google.golang.org/api/accesscontextmanager/v1"
+
+	"github.com/crossplane/provider-gcp/apis/accesscontextmanager/v1alpha1"
+)
+
+const policyID = "1234567890"
+
+func policyParams(m ...func(*v1alpha1.AccessPolicyParameters)) *v1alpha1.AccessPolicyParameters {
+	p := &v1alpha1.AccessPolicyParameters{
+		Parent: "organizations/4711",
+		Title:  "Cool policy",
+		Scopes: []string{"folders/42"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func accessPolicy(m ...func(*accesscontextmanager.AccessPolicy)) *accesscontextmanager.AccessPolicy {
+	ap := &accesscontextmanager.AccessPolicy{
+		Name:   "accessPolicies/1234567890",
+		Parent: "organizations/4711",
+		Title:  "Cool policy",
+		Scopes: []string{"folders/42"},
+		Etag:   "9fb3ca0a1c8d4d95",
+	}
+	for _, f := range m {
+		f(ap)
+	}
+	return ap
+}
+
+func TestGetAccessPolicyName(t *testing.T) {
+	if diff := cmp.Diff("accessPolicies/1234567890", GetAccessPolicyName(policyID)); diff != "" {
+		t.Errorf("GetAccessPolicyName(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(policyID, GetID("accessPolicies/1234567890")); diff != "" {
+		t.Errorf("GetID(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateAccessPolicy(t *testing.T) {
+	want := accessPolicy(func(ap *accesscontextmanager.AccessPolicy) {
+		ap.Name = ""
+		ap.Etag = ""
+	})
+	if diff := cmp.Diff(want, GenerateAccessPolicy(*policyParams())); diff != "" {
+		t.Errorf("GenerateAccessPolicy(...): -want, +got:\n%s", diff)
+	}
+	o := v1alpha1.AccessPolicyObservation{Name: "accessPolicies/1234567890", Etag: "9fb3ca0a1c8d4d95"}
+	if diff := cmp.Diff(o, GenerateAccessPolicyObservation(*accessPolicy())); diff != "" {
+		t.Errorf("GenerateAccessPolicyObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsAccessPolicyUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		params *v1alpha1.AccessPolicyParameters
+		want   bool
+	}{
+		"UpToDate": {
+			params: policyParams(),
+			want:   true,
+		},
+		"TitleChanged": {
+			params: policyParams(func(p *v1alpha1.AccessPolicyParameters) { p.Title = "Cooler policy" }),
+			want:   false,
+		},
+		"ScopesChanged": {
+			params: policyParams(func(p *v1alpha1.AccessPolicyParameters) { p.Scopes = nil }),
+			want:   false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsAccessPolicyUpToDate(*tc.params, *accessPolicy())
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsAccessPolicyUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindAccessPolicy(t *testing.T) {
+	other := accessPolicy(func(ap *accesscontextmanager.AccessPolicy) {
+		ap.Name = "accessPolicies/42"
+		ap.Title = "Other policy"
+	})
+	policies := []*accesscontextmanager.AccessPolicy{other, accessPolicy()}
+	if diff := cmp.Diff(accessPolicy(), FindAccessPolicy(*policyParams(), policies)); diff != "" {
+		t.Errorf("FindAccessPolicy(...): -want, +got:\n%s", diff)
+	}
+	if got := FindAccessPolicy(*policyParams(), policies[:1]); got != nil {
+		t.Errorf("FindAccessPolicy(...): want nil, got %v", got)
+	}
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesscontextmanager
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	accesscontextmanager "google.golang.org/api/accesscontextmanager/v1"
+
+	"github.com/crossplane/provider-gcp/apis/accesscontextmanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	servicePerimeterNameFormat = "accessPolicies/%s/servicePerimeters/%s"
+
+	// ServicePerimeterUpdateMask is the list of service perimeter fields that
+	// can be updated.
+	ServicePerimeterUpdateMask = "title,description,status,spec,useExplicitDryRunSpec"
+)
+
+// GetServicePerimeterName builds the fully qualified name of a service
+// perimeter.
+func GetServicePerimeterName(policy, id string) string {
+	return fmt.Sprintf(servicePerimeterNameFormat, policy, id)
+}
+
+// GenerateServicePerimeter produces a ServicePerimeter with the supplied name
+// that is configured via the supplied ServicePerimeterParameters.
+func GenerateServicePerimeter(name string, p v1alpha1.ServicePerimeterParameters) *accesscontextmanager.ServicePerimeter {
+	return &accesscontextmanager.ServicePerimeter{
+		Name:                  name,
+		Title:                 p.Title,
+		Description:           gcp.StringValue(p.Description),
+		PerimeterType:         gcp.StringValue(p.PerimeterType),
+		Status:                generateServicePerimeterConfig(p.Status),
+		Spec:                  generateServicePerimeterConfig(p.Spec),
+		UseExplicitDryRunSpec: gcp.BoolValue(p.UseExplicitDryRunSpec),
+	}
+}
+
+func generateServicePerimeterConfig(c *v1alpha1.ServicePerimeterConfig) *accesscontextmanager.ServicePerimeterConfig {
+	if c == nil {
+		return nil
+	}
+	out := &accesscontextmanager.ServicePerimeterConfig{
+		Resources:          c.Resources,
+		AccessLevels:       c.AccessLevels,
+		RestrictedServices: c.RestrictedServices,
+	}
+	if v := c.VPCAccessibleServices; v != nil {
+		out.VpcAccessibleServices = &accesscontextmanager.VpcAccessibleServices{
+			EnableRestriction: gcp.BoolValue(v.EnableRestriction),
+			AllowedServices:   v.AllowedServices,
+		}
+	}
+	return out
+}
+
+// GenerateServicePerimeterObservation produces a ServicePerimeterObservation
+// from the supplied ServicePerimeter.
+func GenerateServicePerimeterObservation(sp accesscontextmanager.ServicePerimeter) v1alpha1.ServicePerimeterObservation {
+	return v1alpha1.ServicePerimeterObservation{Name: sp.Name}
+}
+
+// LateInitializeServicePerimeter fills the empty fields of the supplied
+// ServicePerimeterParameters with the values of the supplied
+// ServicePerimeter.
+func LateInitializeServicePerimeter(p *v1alpha1.ServicePerimeterParameters, sp accesscontextmanager.ServicePerimeter) {
+	p.Description = gcp.LateInitializeString(p.Description, sp.Description)
+	p.PerimeterType = gcp.LateInitializeString(p.PerimeterType, sp.PerimeterType)
+}
+
+// IsServicePerimeterUpToDate returns true if the supplied ServicePerimeter
+// matches the supplied ServicePerimeterParameters. The order of resources,
+// access levels and services is not significant.
+func IsServicePerimeterUpToDate(p v1alpha1.ServicePerimeterParameters, sp accesscontextmanager.ServicePerimeter) bool {
+	desired := GenerateServicePerimeter(sp.Name, p)
+	if desired.Title != sp.Title || desired.Description != sp.Description || desired.UseExplicitDryRunSpec != sp.UseExplicitDryRunSpec {
+		return false
+	}
+	opts := []cmp.Option{
+		cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+		cmpopts.IgnoreFields(accesscontextmanager.ServicePerimeterConfig{}, "EgressPolicies", "IngressPolicies", "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(accesscontextmanager.VpcAccessibleServices{}, "ForceSendFields", "NullFields"),
+	}
+	return cmp.Equal(desired.Status, sp.Status, opts...) && cmp.Equal(desired.Spec, sp.Spec, opts...)
+}
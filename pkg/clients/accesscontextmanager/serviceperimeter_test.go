@@ -0,0 +1,143 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesscontextmanager
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	accesscontextmanager "google.golang.org/api/accesscontextmanager/v1"
+
+	"github.com/crossplane/provider-gcp/apis/accesscontextmanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const servicePerimeterName = "accessPolicies/1234567890/servicePerimeters/team_a"
+
+func perimeterParams(m ...func(*v1alpha1.ServicePerimeterParameters)) *v1alpha1.ServicePerimeterParameters {
+	p := &v1alpha1.ServicePerimeterParameters{
+		AccessPolicy:  gcp.StringPtr(policyID),
+		Title:         "Team A",
+		PerimeterType: gcp.StringPtr(v1alpha1.PerimeterTypeRegular),
+		Status: &v1alpha1.ServicePerimeterConfig{
+			Resources:          []string{"projects/111", "projects/222"},
+			AccessLevels:       []string{accessLevelName},
+			RestrictedServices: []string{"storage.googleapis.com"},
+		},
+		Spec: &v1alpha1.ServicePerimeterConfig{
+			Resources:          []string{"projects/111", "projects/222"},
+			RestrictedServices: []string{"storage.googleapis.com", "bigquery.googleapis.com"},
+			VPCAccessibleServices: &v1alpha1.VPCAccessibleServices{
+				EnableRestriction: gcp.BoolPtr(true),
+				AllowedServices:   []string{"RESTRICTED-SERVICES"},
+			},
+		},
+		UseExplicitDryRunSpec: gcp.BoolPtr(true),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func servicePerimeter(m ...func(*accesscontextmanager.ServicePerimeter)) *accesscontextmanager.ServicePerimeter {
+	sp := &accesscontextmanager.ServicePerimeter{
+		Name:          servicePerimeterName,
+		Title:         "Team A",
+		PerimeterType: "PERIMETER_TYPE_REGULAR",
+		Status: &accesscontextmanager.ServicePerimeterConfig{
+			Resources:          []string{"projects/111", "projects/222"},
+			AccessLevels:       []string{accessLevelName},
+			RestrictedServices: []string{"storage.googleapis.com"},
+		},
+		Spec: &accesscontextmanager.ServicePerimeterConfig{
+			Resources:          []string{"projects/111", "projects/222"},
+			RestrictedServices: []string{"storage.googleapis.com", "bigquery.googleapis.com"},
+			VpcAccessibleServices: &accesscontextmanager.VpcAccessibleServices{
+				EnableRestriction: true,
+				AllowedServices:   []string{"RESTRICTED-SERVICES"},
+			},
+		},
+		UseExplicitDryRunSpec: true,
+	}
+	for _, f := range m {
+		f(sp)
+	}
+	return sp
+}
+
+func TestGenerateServicePerimeter(t *testing.T) {
+	if diff := cmp.Diff(servicePerimeterName, GetServicePerimeterName(policyID, "team_a")); diff != "" {
+		t.Errorf("GetServicePerimeterName(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(servicePerimeter(), GenerateServicePerimeter(servicePerimeterName, *perimeterParams())); diff != "" {
+		t.Errorf("GenerateServicePerimeter(...): -want, +got:\n%s", diff)
+	}
+	o := v1alpha1.ServicePerimeterObservation{Name: servicePerimeterName}
+	if diff := cmp.Diff(o, GenerateServicePerimeterObservation(*servicePerimeter())); diff != "" {
+		t.Errorf("GenerateServicePerimeterObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeServicePerimeter(t *testing.T) {
+	got := perimeterParams(func(p *v1alpha1.ServicePerimeterParameters) {
+		p.PerimeterType = nil
+	})
+	LateInitializeServicePerimeter(got, *servicePerimeter())
+	if diff := cmp.Diff(perimeterParams(), got); diff != "" {
+		t.Errorf("LateInitializeServicePerimeter(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsServicePerimeterUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		params *v1alpha1.ServicePerimeterParameters
+		want   bool
+	}{
+		"UpToDate": {
+			params: perimeterParams(),
+			want:   true,
+		},
+		"ResourcesReordered": {
+			params: perimeterParams(func(p *v1alpha1.ServicePerimeterParameters) {
+				p.Status.Resources = []string{"projects/222", "projects/111"}
+			}),
+			want: true,
+		},
+		"RestrictedServicesChanged": {
+			params: perimeterParams(func(p *v1alpha1.ServicePerimeterParameters) {
+				p.Status.RestrictedServices = []string{"storage.googleapis.com", "bigquery.googleapis.com"}
+			}),
+			want: false,
+		},
+		"DryRunSpecRemoved": {
+			params: perimeterParams(func(p *v1alpha1.ServicePerimeterParameters) {
+				p.Spec = nil
+				p.UseExplicitDryRunSpec = nil
+			}),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsServicePerimeterUpToDate(*tc.params, *servicePerimeter())
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsServicePerimeterUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
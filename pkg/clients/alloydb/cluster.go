@@ -0,0 +1,180 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alloydb
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	alloydb "google.golang.org/api/alloydb/v1"
+
+	"github.com/crossplane/provider-gcp/apis/alloydb/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	clusterNameFormat = "projects/%s/locations/%s/clusters/%s"
+	parentFormat      = "projects/%s/locations/%s"
+)
+
+// ClusterUpdateMask is the set of Cluster fields that can be updated in place.
+const ClusterUpdateMask = "display_name,labels,automated_backup_policy"
+
+// GetClusterParent builds the fully qualified name of the cluster parent.
+func GetClusterParent(project string, p v1alpha1.ClusterParameters) string {
+	return fmt.Sprintf(parentFormat, project, p.Location)
+}
+
+// GetClusterName builds the fully qualified name of the cluster.
+func GetClusterName(project string, p v1alpha1.ClusterParameters, name string) string {
+	return fmt.Sprintf(clusterNameFormat, project, p.Location, name)
+}
+
+// GenerateCluster produces a Cluster that is configured via given
+// ClusterParameters.
+func GenerateCluster(name string, p v1alpha1.ClusterParameters) *alloydb.Cluster {
+	c := &alloydb.Cluster{
+		Name:            name,
+		DatabaseVersion: gcp.StringValue(p.DatabaseVersion),
+		DisplayName:     gcp.StringValue(p.DisplayName),
+		Labels:          p.Labels,
+	}
+	if p.Network != nil || p.AllocatedIPRange != nil {
+		c.NetworkConfig = &alloydb.NetworkConfig{
+			Network:          gcp.StringValue(p.Network),
+			AllocatedIpRange: gcp.StringValue(p.AllocatedIPRange),
+		}
+	}
+	c.EncryptionConfig = generateEncryptionConfig(p.EncryptionConfig)
+	c.AutomatedBackupPolicy = generateAutomatedBackupPolicy(p.AutomatedBackupPolicy)
+	return c
+}
+
+func generateEncryptionConfig(in *v1alpha1.EncryptionConfig) *alloydb.EncryptionConfig {
+	if in == nil {
+		return nil
+	}
+	return &alloydb.EncryptionConfig{KmsKeyName: gcp.StringValue(in.KMSKeyName)}
+}
+
+func generateAutomatedBackupPolicy(in *v1alpha1.AutomatedBackupPolicy) *alloydb.AutomatedBackupPolicy {
+	if in == nil {
+		return nil
+	}
+	out := &alloydb.AutomatedBackupPolicy{
+		Enabled:          gcp.BoolValue(in.Enabled),
+		BackupWindow:     gcp.StringValue(in.BackupWindow),
+		Location:         gcp.StringValue(in.Location),
+		Labels:           in.Labels,
+		EncryptionConfig: generateEncryptionConfig(in.EncryptionConfig),
+	}
+	// Enabled must be sent explicitly so that backups can be turned off.
+	if in.Enabled != nil {
+		out.ForceSendFields = []string{"Enabled"}
+	}
+	if in.WeeklySchedule != nil {
+		out.WeeklySchedule = &alloydb.WeeklySchedule{DaysOfWeek: in.WeeklySchedule.DaysOfWeek}
+		for _, t := range in.WeeklySchedule.StartTimes {
+			out.WeeklySchedule.StartTimes = append(out.WeeklySchedule.StartTimes, &alloydb.GoogleTypeTimeOfDay{Hours: t.Hours, Minutes: t.Minutes})
+		}
+	}
+	if in.TimeBasedRetention != nil {
+		out.TimeBasedRetention = &alloydb.TimeBasedRetention{RetentionPeriod: in.TimeBasedRetention.RetentionPeriod}
+	}
+	if in.QuantityBasedRetention != nil {
+		out.QuantityBasedRetention = &alloydb.QuantityBasedRetention{Count: in.QuantityBasedRetention.Count}
+	}
+	return out
+}
+
+// GenerateClusterObservation produces a ClusterObservation from the supplied
+// Cluster.
+func GenerateClusterObservation(c alloydb.Cluster) v1alpha1.ClusterObservation {
+	return v1alpha1.ClusterObservation{
+		Name:            c.Name,
+		UID:             c.Uid,
+		State:           c.State,
+		ClusterType:     c.ClusterType,
+		DatabaseVersion: c.DatabaseVersion,
+		CreateTime:      c.CreateTime,
+		Reconciling:     c.Reconciling,
+	}
+}
+
+// LateInitializeCluster fills the empty fields of ClusterParameters with the
+// values seen in the supplied Cluster.
+func LateInitializeCluster(p *v1alpha1.ClusterParameters, c alloydb.Cluster) {
+	p.DatabaseVersion = gcp.LateInitializeString(p.DatabaseVersion, c.DatabaseVersion)
+	p.DisplayName = gcp.LateInitializeString(p.DisplayName, c.DisplayName)
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, c.Labels)
+	if c.NetworkConfig != nil {
+		p.Network = gcp.LateInitializeString(p.Network, c.NetworkConfig.Network)
+		p.AllocatedIPRange = gcp.LateInitializeString(p.AllocatedIPRange, c.NetworkConfig.AllocatedIpRange)
+	}
+	if p.EncryptionConfig == nil && c.EncryptionConfig != nil && c.EncryptionConfig.KmsKeyName != "" {
+		p.EncryptionConfig = &v1alpha1.EncryptionConfig{KMSKeyName: gcp.StringPtr(c.EncryptionConfig.KmsKeyName)}
+	}
+	if p.AutomatedBackupPolicy == nil && c.AutomatedBackupPolicy != nil {
+		p.AutomatedBackupPolicy = &v1alpha1.AutomatedBackupPolicy{
+			Enabled: gcp.BoolPtr(c.AutomatedBackupPolicy.Enabled),
+		}
+	}
+	if p.AutomatedBackupPolicy != nil && c.AutomatedBackupPolicy != nil {
+		lateInitializeAutomatedBackupPolicy(p.AutomatedBackupPolicy, *c.AutomatedBackupPolicy)
+	}
+}
+
+func lateInitializeAutomatedBackupPolicy(p *v1alpha1.AutomatedBackupPolicy, o alloydb.AutomatedBackupPolicy) {
+	p.BackupWindow = gcp.LateInitializeString(p.BackupWindow, o.BackupWindow)
+	p.Location = gcp.LateInitializeString(p.Location, o.Location)
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, o.Labels)
+	if p.WeeklySchedule == nil && o.WeeklySchedule != nil {
+		p.WeeklySchedule = &v1alpha1.WeeklySchedule{DaysOfWeek: o.WeeklySchedule.DaysOfWeek}
+		for _, t := range o.WeeklySchedule.StartTimes {
+			if t == nil {
+				continue
+			}
+			p.WeeklySchedule.StartTimes = append(p.WeeklySchedule.StartTimes, v1alpha1.TimeOfDay{Hours: t.Hours, Minutes: t.Minutes})
+		}
+	}
+	if p.TimeBasedRetention == nil && p.QuantityBasedRetention == nil {
+		if o.TimeBasedRetention != nil {
+			p.TimeBasedRetention = &v1alpha1.TimeBasedRetention{RetentionPeriod: o.TimeBasedRetention.RetentionPeriod}
+		}
+		if o.QuantityBasedRetention != nil {
+			p.QuantityBasedRetention = &v1alpha1.QuantityBasedRetention{Count: o.QuantityBasedRetention.Count}
+		}
+	}
+}
+
+// IsClusterUpToDate returns true if the supplied Cluster matches the fields
+// of the supplied ClusterParameters that can be updated in place.
+func IsClusterUpToDate(p v1alpha1.ClusterParameters, c alloydb.Cluster) bool {
+	desired := GenerateCluster(c.Name, p)
+	if desired.DisplayName != c.DisplayName {
+		return false
+	}
+	if !cmp.Equal(desired.Labels, c.Labels, cmpopts.EquateEmpty()) {
+		return false
+	}
+	if desired.AutomatedBackupPolicy == nil {
+		return true
+	}
+	return cmp.Equal(desired.AutomatedBackupPolicy, c.AutomatedBackupPolicy, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(alloydb.AutomatedBackupPolicy{}, "ForceSendFields"))
+}
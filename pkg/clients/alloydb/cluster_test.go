@@ -0,0 +1,210 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alloydb
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	alloydb "google.golang.org/api/alloydb/v1"
+
+	"github.com/crossplane/provider-gcp/apis/alloydb/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	project     = "my-project"
+	location    = "us-central1"
+	clusterName = "my-cluster"
+	network     = "projects/my-project/global/networks/default"
+	kmsKey      = "projects/my-project/locations/us-central1/keyRings/r/cryptoKeys/k"
+)
+
+var fullClusterName = "projects/my-project/locations/us-central1/clusters/my-cluster"
+
+func clusterParams(m ...func(*v1alpha1.ClusterParameters)) *v1alpha1.ClusterParameters {
+	p := &v1alpha1.ClusterParameters{
+		Location:         location,
+		Network:          gcp.StringPtr(network),
+		DatabaseVersion:  gcp.StringPtr("POSTGRES_15"),
+		DisplayName:      gcp.StringPtr("my cluster"),
+		Labels:           map[string]string{"team": "db"},
+		EncryptionConfig: &v1alpha1.EncryptionConfig{KMSKeyName: gcp.StringPtr(kmsKey)},
+		AutomatedBackupPolicy: &v1alpha1.AutomatedBackupPolicy{
+			Enabled: gcp.BoolPtr(true),
+			WeeklySchedule: &v1alpha1.WeeklySchedule{
+				DaysOfWeek: []string{"MONDAY"},
+				StartTimes: []v1alpha1.TimeOfDay{{Hours: 2}},
+			},
+			QuantityBasedRetention: &v1alpha1.QuantityBasedRetention{Count: 7},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func cluster(m ...func(*alloydb.Cluster)) *alloydb.Cluster {
+	c := &alloydb.Cluster{
+		Name:             fullClusterName,
+		NetworkConfig:    &alloydb.NetworkConfig{Network: network},
+		DatabaseVersion:  "POSTGRES_15",
+		DisplayName:      "my cluster",
+		Labels:           map[string]string{"team": "db"},
+		EncryptionConfig: &alloydb.EncryptionConfig{KmsKeyName: kmsKey},
+		AutomatedBackupPolicy: &alloydb.AutomatedBackupPolicy{
+			Enabled:         true,
+			ForceSendFields: []string{"Enabled"},
+			WeeklySchedule: &alloydb.WeeklySchedule{
+				DaysOfWeek: []string{"MONDAY"},
+				StartTimes: []*alloydb.GoogleTypeTimeOfDay{{Hours: 2}},
+			},
+			QuantityBasedRetention: &alloydb.QuantityBasedRetention{Count: 7},
+		},
+	}
+	for _, f := range m {
+		f(c)
+	}
+	return c
+}
+
+func TestGetClusterName(t *testing.T) {
+	got := GetClusterName(project, *clusterParams(), clusterName)
+	if diff := cmp.Diff(fullClusterName, got); diff != "" {
+		t.Errorf("GetClusterName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateCluster(t *testing.T) {
+	type args struct {
+		name string
+		p    v1alpha1.ClusterParameters
+	}
+	cases := map[string]struct {
+		args args
+		want *alloydb.Cluster
+	}{
+		"FullConversion": {
+			args: args{name: fullClusterName, p: *clusterParams()},
+			want: cluster(),
+		},
+		"MissingFields": {
+			args: args{name: fullClusterName, p: *clusterParams(func(p *v1alpha1.ClusterParameters) {
+				p.Network = nil
+				p.EncryptionConfig = nil
+				p.AutomatedBackupPolicy = nil
+			})},
+			want: cluster(func(c *alloydb.Cluster) {
+				c.NetworkConfig = nil
+				c.EncryptionConfig = nil
+				c.AutomatedBackupPolicy = nil
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCluster(tc.args.name, tc.args.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateCluster(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeCluster(t *testing.T) {
+	type args struct {
+		p *v1alpha1.ClusterParameters
+		c alloydb.Cluster
+	}
+	cases := map[string]struct {
+		args args
+		want *v1alpha1.ClusterParameters
+	}{
+		"AllFilled": {
+			args: args{p: clusterParams(), c: *cluster(func(c *alloydb.Cluster) {
+				c.DisplayName = "other"
+			})},
+			want: clusterParams(),
+		},
+		"AllEmpty": {
+			args: args{p: &v1alpha1.ClusterParameters{Location: location}, c: *cluster()},
+			want: clusterParams(func(p *v1alpha1.ClusterParameters) {
+				p.AllocatedIPRange = nil
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeCluster(tc.args.p, tc.args.c)
+			if diff := cmp.Diff(tc.want, tc.args.p); diff != "" {
+				t.Errorf("LateInitializeCluster(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsClusterUpToDate(t *testing.T) {
+	type args struct {
+		p v1alpha1.ClusterParameters
+		c alloydb.Cluster
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{p: *clusterParams(), c: *cluster(func(c *alloydb.Cluster) {
+				c.State = v1alpha1.ClusterStateReady
+				c.ForceSendFields = nil
+			})},
+			want: true,
+		},
+		"ImmutableFieldDiffers": {
+			args: args{p: *clusterParams(), c: *cluster(func(c *alloydb.Cluster) {
+				c.DatabaseVersion = "POSTGRES_14"
+			})},
+			want: true,
+		},
+		"DisplayNameDiffers": {
+			args: args{p: *clusterParams(), c: *cluster(func(c *alloydb.Cluster) {
+				c.DisplayName = "other"
+			})},
+			want: false,
+		},
+		"LabelsDiffer": {
+			args: args{p: *clusterParams(), c: *cluster(func(c *alloydb.Cluster) {
+				c.Labels = nil
+			})},
+			want: false,
+		},
+		"BackupPolicyDiffers": {
+			args: args{p: *clusterParams(), c: *cluster(func(c *alloydb.Cluster) {
+				c.AutomatedBackupPolicy.Enabled = false
+			})},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsClusterUpToDate(tc.args.p, tc.args.c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsClusterUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alloydb
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	alloydb "google.golang.org/api/alloydb/v1"
+
+	"github.com/crossplane/provider-gcp/apis/alloydb/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// InstanceUpdateMask is the set of Instance fields that can be updated in
+// place.
+const InstanceUpdateMask = "display_name,labels,database_flags,availability_type,machine_config.cpu_count,read_pool_config.node_count"
+
+// GetInstanceName builds the fully qualified name of the instance.
+func GetInstanceName(p v1alpha1.InstanceParameters, name string) string {
+	return gcp.StringValue(p.Cluster) + "/instances/" + name
+}
+
+// GenerateInstance produces an Instance that is configured via given
+// InstanceParameters.
+func GenerateInstance(name string, p v1alpha1.InstanceParameters) *alloydb.Instance {
+	i := &alloydb.Instance{
+		Name:             name,
+		InstanceType:     p.InstanceType,
+		AvailabilityType: gcp.StringValue(p.AvailabilityType),
+		GceZone:          gcp.StringValue(p.GCEZone),
+		DatabaseFlags:    p.DatabaseFlags,
+		DisplayName:      gcp.StringValue(p.DisplayName),
+		Labels:           p.Labels,
+	}
+	if p.MachineConfig != nil {
+		i.MachineConfig = &alloydb.MachineConfig{CpuCount: gcp.Int64Value(p.MachineConfig.CPUCount)}
+	}
+	if p.ReadPoolConfig != nil {
+		i.ReadPoolConfig = &alloydb.ReadPoolConfig{NodeCount: gcp.Int64Value(p.ReadPoolConfig.NodeCount)}
+	}
+	return i
+}
+
+// GenerateInstanceObservation produces an InstanceObservation from the
+// supplied Instance.
+func GenerateInstanceObservation(i alloydb.Instance) v1alpha1.InstanceObservation {
+	return v1alpha1.InstanceObservation{
+		Name:        i.Name,
+		UID:         i.Uid,
+		State:       i.State,
+		IPAddress:   i.IpAddress,
+		CreateTime:  i.CreateTime,
+		Reconciling: i.Reconciling,
+	}
+}
+
+// LateInitializeInstance fills the empty fields of InstanceParameters with
+// the values seen in the supplied Instance.
+func LateInitializeInstance(p *v1alpha1.InstanceParameters, i alloydb.Instance) {
+	p.AvailabilityType = gcp.LateInitializeString(p.AvailabilityType, i.AvailabilityType)
+	p.GCEZone = gcp.LateInitializeString(p.GCEZone, i.GceZone)
+	p.DatabaseFlags = gcp.LateInitializeStringMap(p.DatabaseFlags, i.DatabaseFlags)
+	p.DisplayName = gcp.LateInitializeString(p.DisplayName, i.DisplayName)
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, i.Labels)
+	if i.MachineConfig != nil {
+		if p.MachineConfig == nil {
+			p.MachineConfig = &v1alpha1.MachineConfig{}
+		}
+		p.MachineConfig.CPUCount = gcp.LateInitializeInt64(p.MachineConfig.CPUCount, i.MachineConfig.CpuCount)
+	}
+	if i.ReadPoolConfig != nil {
+		if p.ReadPoolConfig == nil {
+			p.ReadPoolConfig = &v1alpha1.ReadPoolConfig{}
+		}
+		p.ReadPoolConfig.NodeCount = gcp.LateInitializeInt64(p.ReadPoolConfig.NodeCount, i.ReadPoolConfig.NodeCount)
+	}
+}
+
+// IsInstanceUpToDate returns true if the supplied Instance matches the fields
+// of the supplied InstanceParameters that can be updated in place.
+func IsInstanceUpToDate(p v1alpha1.InstanceParameters, i alloydb.Instance) bool {
+	desired := GenerateInstance(i.Name, p)
+	switch {
+	case desired.DisplayName != i.DisplayName,
+		desired.AvailabilityType != "" && desired.AvailabilityType != i.AvailabilityType,
+		!cmp.Equal(desired.Labels, i.Labels, cmpopts.EquateEmpty()),
+		!cmp.Equal(desired.DatabaseFlags, i.DatabaseFlags, cmpopts.EquateEmpty()):
+		return false
+	}
+	if desired.MachineConfig != nil && (i.MachineConfig == nil || desired.MachineConfig.CpuCount != i.MachineConfig.CpuCount) {
+		return false
+	}
+	if desired.ReadPoolConfig != nil && (i.ReadPoolConfig == nil || desired.ReadPoolConfig.NodeCount != i.ReadPoolConfig.NodeCount) {
+		return false
+	}
+	return true
+}
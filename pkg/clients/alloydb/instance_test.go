@@ -0,0 +1,178 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alloydb
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	alloydb "google.golang.org/api/alloydb/v1"
+
+	"github.com/crossplane/provider-gcp/apis/alloydb/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+var fullInstanceName = fullClusterName + "/instances/my-instance"
+
+func instanceParams(m ...func(*v1alpha1.InstanceParameters)) *v1alpha1.InstanceParameters {
+	p := &v1alpha1.InstanceParameters{
+		Cluster:          gcp.StringPtr(fullClusterName),
+		InstanceType:     v1alpha1.InstanceTypePrimary,
+		AvailabilityType: gcp.StringPtr("ZONAL"),
+		MachineConfig:    &v1alpha1.MachineConfig{CPUCount: gcp.Int64Ptr(2)},
+		DatabaseFlags:    map[string]string{"max_connections": "100"},
+		DisplayName:      gcp.StringPtr("primary"),
+		Labels:           map[string]string{"team": "db"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func instance(m ...func(*alloydb.Instance)) *alloydb.Instance {
+	i := &alloydb.Instance{
+		Name:             fullInstanceName,
+		InstanceType:     v1alpha1.InstanceTypePrimary,
+		AvailabilityType: "ZONAL",
+		MachineConfig:    &alloydb.MachineConfig{CpuCount: 2},
+		DatabaseFlags:    map[string]string{"max_connections": "100"},
+		DisplayName:      "primary",
+		Labels:           map[string]string{"team": "db"},
+	}
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+func TestGetInstanceName(t *testing.T) {
+	got := GetInstanceName(*instanceParams(), "my-instance")
+	if diff := cmp.Diff(fullInstanceName, got); diff != "" {
+		t.Errorf("GetInstanceName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateInstance(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.InstanceParameters
+		want *alloydb.Instance
+	}{
+		"Primary": {
+			p:    *instanceParams(),
+			want: instance(),
+		},
+		"ReadPool": {
+			p: *instanceParams(func(p *v1alpha1.InstanceParameters) {
+				p.InstanceType = v1alpha1.InstanceTypeReadPool
+				p.ReadPoolConfig = &v1alpha1.ReadPoolConfig{NodeCount: gcp.Int64Ptr(2)}
+			}),
+			want: instance(func(i *alloydb.Instance) {
+				i.InstanceType = v1alpha1.InstanceTypeReadPool
+				i.ReadPoolConfig = &alloydb.ReadPoolConfig{NodeCount: 2}
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateInstance(fullInstanceName, tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateInstance(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeInstance(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.InstanceParameters
+		i    alloydb.Instance
+		want *v1alpha1.InstanceParameters
+	}{
+		"AllFilled": {
+			p: instanceParams(),
+			i: *instance(func(i *alloydb.Instance) {
+				i.MachineConfig.CpuCount = 4
+			}),
+			want: instanceParams(),
+		},
+		"AllEmpty": {
+			p: &v1alpha1.InstanceParameters{
+				Cluster:      gcp.StringPtr(fullClusterName),
+				InstanceType: v1alpha1.InstanceTypePrimary,
+			},
+			i:    *instance(),
+			want: instanceParams(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeInstance(tc.p, tc.i)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("LateInitializeInstance(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsInstanceUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.InstanceParameters
+		i    alloydb.Instance
+		want bool
+	}{
+		"UpToDate": {
+			p: *instanceParams(),
+			i: *instance(func(i *alloydb.Instance) {
+				i.State = v1alpha1.InstanceStateReady
+				i.IpAddress = "10.0.0.2"
+			}),
+			want: true,
+		},
+		"CPUCountDiffers": {
+			p: *instanceParams(),
+			i: *instance(func(i *alloydb.Instance) {
+				i.MachineConfig.CpuCount = 4
+			}),
+			want: false,
+		},
+		"DatabaseFlagsDiffer": {
+			p: *instanceParams(),
+			i: *instance(func(i *alloydb.Instance) {
+				i.DatabaseFlags = nil
+			}),
+			want: false,
+		},
+		"ReadPoolNodeCountDiffers": {
+			p: *instanceParams(func(p *v1alpha1.InstanceParameters) {
+				p.ReadPoolConfig = &v1alpha1.ReadPoolConfig{NodeCount: gcp.Int64Ptr(3)}
+			}),
+			i: *instance(func(i *alloydb.Instance) {
+				i.ReadPoolConfig = &alloydb.ReadPoolConfig{NodeCount: 2}
+			}),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsInstanceUpToDate(tc.p, tc.i)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsInstanceUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
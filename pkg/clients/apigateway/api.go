@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apigateway "google.golang.org/api/apigateway/v1"
+
+	"github.com/crossplane/provider-gcp/apis/apigateway/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	apiNameFormat = "projects/%s/locations/global/apis/%s"
+	globalFormat  = "projects/%s/locations/global"
+)
+
+// APIUpdateMask is the set of API fields that can be updated in place.
+const APIUpdateMask = "displayName,labels"
+
+// GetAPIParent builds the fully qualified name of the parent of all APIs of
+// the supplied project. APIs are always global.
+func GetAPIParent(project string) string {
+	return fmt.Sprintf(globalFormat, project)
+}
+
+// GetAPIName builds the fully qualified name of the API.
+func GetAPIName(project, name string) string {
+	return fmt.Sprintf(apiNameFormat, project, name)
+}
+
+// GenerateAPI produces an API Gateway API that is configured via the given
+// APIParameters.
+func GenerateAPI(name string, p v1alpha1.APIParameters) *apigateway.ApigatewayApi {
+	return &apigateway.ApigatewayApi{
+		Name:           name,
+		DisplayName:    gcp.StringValue(p.DisplayName),
+		Labels:         p.Labels,
+		ManagedService: gcp.StringValue(p.ManagedService),
+	}
+}
+
+// GenerateAPIObservation produces an APIObservation from the supplied API.
+func GenerateAPIObservation(a apigateway.ApigatewayApi) v1alpha1.APIObservation {
+	return v1alpha1.APIObservation{
+		Name:       a.Name,
+		State:      a.State,
+		CreateTime: a.CreateTime,
+		UpdateTime: a.UpdateTime,
+	}
+}
+
+// LateInitializeAPI fills the empty fields of APIParameters with the values
+// seen in the supplied API.
+func LateInitializeAPI(p *v1alpha1.APIParameters, a apigateway.ApigatewayApi) {
+	p.DisplayName = gcp.LateInitializeString(p.DisplayName, a.DisplayName)
+	p.ManagedService = gcp.LateInitializeString(p.ManagedService, a.ManagedService)
+}
+
+// IsAPIUpToDate returns true if the supplied API matches the fields of the
+// supplied APIParameters that can be updated in place.
+func IsAPIUpToDate(p v1alpha1.APIParameters, a apigateway.ApigatewayApi) bool {
+	return gcp.StringValue(p.DisplayName) == a.DisplayName &&
+		cmp.Equal(p.Labels, a.Labels, cmpopts.EquateEmpty())
+}
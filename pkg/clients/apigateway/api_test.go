@@ -0,0 +1,130 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apigateway "google.golang.org/api/apigateway/v1"
+
+	"github.com/crossplane/provider-gcp/apis/apigateway/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	apiName = "projects/coolProject/locations/global/apis/cool-api"
+)
+
+func apiParams(m ...func(*v1alpha1.APIParameters)) *v1alpha1.APIParameters {
+	p := &v1alpha1.APIParameters{
+		DisplayName:    gcp.StringPtr("Cool API"),
+		Labels:         map[string]string{"cool": "true"},
+		ManagedService: gcp.StringPtr("cool-api.apigateway.coolProject.cloud.goog"),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func api(m ...func(*apigateway.ApigatewayApi)) *apigateway.ApigatewayApi {
+	a := &apigateway.ApigatewayApi{
+		Name:           apiName,
+		DisplayName:    "Cool API",
+		Labels:         map[string]string{"cool": "true"},
+		ManagedService: "cool-api.apigateway.coolProject.cloud.goog",
+	}
+	for _, f := range m {
+		f(a)
+	}
+	return a
+}
+
+func TestGetAPIName(t *testing.T) {
+	if diff := cmp.Diff(apiName, GetAPIName("coolProject", "cool-api")); diff != "" {
+		t.Errorf("GetAPIName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateAPI(t *testing.T) {
+	if diff := cmp.Diff(api(), GenerateAPI(apiName, *apiParams())); diff != "" {
+		t.Errorf("GenerateAPI(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateAPIObservation(t *testing.T) {
+	a := *api(func(a *apigateway.ApigatewayApi) {
+		a.State = v1alpha1.StateActive
+		a.CreateTime = "2021-01-01T00:00:00Z"
+	})
+	want := v1alpha1.APIObservation{
+		Name:       apiName,
+		State:      v1alpha1.StateActive,
+		CreateTime: "2021-01-01T00:00:00Z",
+	}
+	if diff := cmp.Diff(want, GenerateAPIObservation(a)); diff != "" {
+		t.Errorf("GenerateAPIObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeAPI(t *testing.T) {
+	p := apiParams(func(p *v1alpha1.APIParameters) {
+		p.DisplayName = nil
+		p.ManagedService = nil
+	})
+	LateInitializeAPI(p, *api())
+	if diff := cmp.Diff(apiParams(), p); diff != "" {
+		t.Errorf("LateInitializeAPI(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsAPIUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.APIParameters
+		a    apigateway.ApigatewayApi
+		want bool
+	}{
+		"UpToDate": {
+			p:    *apiParams(),
+			a:    *api(func(a *apigateway.ApigatewayApi) { a.State = v1alpha1.StateActive }),
+			want: true,
+		},
+		"NoLabels": {
+			p:    *apiParams(func(p *v1alpha1.APIParameters) { p.Labels = nil }),
+			a:    *api(func(a *apigateway.ApigatewayApi) { a.Labels = map[string]string{} }),
+			want: true,
+		},
+		"DisplayNameDiffers": {
+			p:    *apiParams(),
+			a:    *api(func(a *apigateway.ApigatewayApi) { a.DisplayName = "Uncool API" }),
+			want: false,
+		},
+		"LabelsDiffer": {
+			p:    *apiParams(),
+			a:    *api(func(a *apigateway.ApigatewayApi) { a.Labels = map[string]string{"cool": "false"} }),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsAPIUpToDate(tc.p, tc.a)); diff != "" {
+				t.Errorf("IsAPIUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apigateway "google.golang.org/api/apigateway/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane/provider-gcp/apis/apigateway/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	apiConfigNameFormat = "projects/%s/locations/global/apis/%s/configs/%s"
+
+	errGetConfigMap  = "cannot get document ConfigMap"
+	errGetSecret     = "cannot get document Secret"
+	errNoDocumentKey = "document %q has no key %q"
+)
+
+// APIConfigUpdateMask is the set of APIConfig fields that can be updated in
+// place.
+const APIConfigUpdateMask = "displayName,labels"
+
+// GetAPIConfigParent builds the fully qualified name of the API the supplied
+// APIConfigParameters belong to.
+func GetAPIConfigParent(project string, p v1alpha1.APIConfigParameters) string {
+	return GetAPIName(project, gcp.StringValue(p.API))
+}
+
+// GetAPIConfigName builds the fully qualified name of the APIConfig.
+func GetAPIConfigName(project string, p v1alpha1.APIConfigParameters, name string) string {
+	return fmt.Sprintf(apiConfigNameFormat, project, gcp.StringValue(p.API), name)
+}
+
+// GetOpenAPIDocuments returns the OpenAPI documents of the supplied
+// APIConfigParameters, reading their contents from the referenced ConfigMaps
+// and Secrets if they are not inlined.
+func GetOpenAPIDocuments(ctx context.Context, kube client.Reader, p v1alpha1.APIConfigParameters) ([]*apigateway.ApigatewayApiConfigOpenApiDocument, error) {
+	docs := make([]*apigateway.ApigatewayApiConfigOpenApiDocument, len(p.OpenAPIDocuments))
+	for i, d := range p.OpenAPIDocuments {
+		contents, err := getDocumentContents(ctx, kube, d)
+		if err != nil {
+			return nil, err
+		}
+		docs[i] = &apigateway.ApigatewayApiConfigOpenApiDocument{
+			Document: &apigateway.ApigatewayApiConfigFile{
+				Path:     d.Path,
+				Contents: base64.StdEncoding.EncodeToString([]byte(contents)),
+			},
+		}
+	}
+	return docs, nil
+}
+
+func getDocumentContents(ctx context.Context, kube client.Reader, d v1alpha1.OpenAPIDocument) (string, error) {
+	switch {
+	case d.ConfigMapRef != nil:
+		ref := d.ConfigMapRef
+		cm := &corev1.ConfigMap{}
+		if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, cm); err != nil {
+			return "", errors.Wrap(err, errGetConfigMap)
+		}
+		contents, ok := cm.Data[ref.Key]
+		if !ok {
+			return "", errors.Errorf(errNoDocumentKey, d.Path, ref.Key)
+		}
+		return contents, nil
+	case d.SecretRef != nil:
+		ref := d.SecretRef
+		s := &corev1.Secret{}
+		if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+			return "", errors.Wrap(err, errGetSecret)
+		}
+		contents, ok := s.Data[ref.Key]
+		if !ok {
+			return "", errors.Errorf(errNoDocumentKey, d.Path, ref.Key)
+		}
+		return string(contents), nil
+	}
+	return gcp.StringValue(d.Contents), nil
+}
+
+// GenerateAPIConfig produces an API Gateway APIConfig with the supplied
+// OpenAPI documents that is configured via the given APIConfigParameters.
+func GenerateAPIConfig(name string, docs []*apigateway.ApigatewayApiConfigOpenApiDocument, p v1alpha1.APIConfigParameters) *apigateway.ApigatewayApiConfig {
+	return &apigateway.ApigatewayApiConfig{
+		Name:                  name,
+		DisplayName:           gcp.StringValue(p.DisplayName),
+		Labels:                p.Labels,
+		GatewayServiceAccount: gcp.StringValue(p.GatewayServiceAccount),
+		OpenapiDocuments:      docs,
+	}
+}
+
+// GenerateAPIConfigObservation produces an APIConfigObservation from the
+// supplied APIConfig.
+func GenerateAPIConfigObservation(c apigateway.ApigatewayApiConfig) v1alpha1.APIConfigObservation {
+	return v1alpha1.APIConfigObservation{
+		Name:            c.Name,
+		State:           c.State,
+		ServiceConfigID: c.ServiceConfigId,
+		CreateTime:      c.CreateTime,
+		UpdateTime:      c.UpdateTime,
+	}
+}
+
+// LateInitializeAPIConfig fills the empty fields of APIConfigParameters with
+// the values seen in the supplied APIConfig.
+func LateInitializeAPIConfig(p *v1alpha1.APIConfigParameters, c apigateway.ApigatewayApiConfig) {
+	p.DisplayName = gcp.LateInitializeString(p.DisplayName, c.DisplayName)
+	p.GatewayServiceAccount = gcp.LateInitializeString(p.GatewayServiceAccount, c.GatewayServiceAccount)
+}
+
+// IsAPIConfigUpToDate returns true if the supplied APIConfig matches the
+// fields of the supplied APIConfigParameters that can be updated in place.
+func IsAPIConfigUpToDate(p v1alpha1.APIConfigParameters, c apigateway.ApigatewayApiConfig) bool {
+	return gcp.StringValue(p.DisplayName) == c.DisplayName &&
+		cmp.Equal(p.Labels, c.Labels, cmpopts.EquateEmpty())
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apigateway "google.golang.org/api/apigateway/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/apigateway/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	apiConfigName = "projects/coolProject/locations/global/apis/cool-api/configs/cool-config"
+	email         = "cool@coolProject.iam.gserviceaccount.com"
+	document      = "swagger: '2.0'\ninfo:\n  title: cool\n  version: 1.0.0\n"
+)
+
+var errBoom = errors.New("boom")
+
+func apiConfigParams(m ...func(*v1alpha1.APIConfigParameters)) *v1alpha1.APIConfigParameters {
+	p := &v1alpha1.APIConfigParameters{
+		API:                   gcp.StringPtr("cool-api"),
+		DisplayName:           gcp.StringPtr("Cool config"),
+		Labels:                map[string]string{"cool": "true"},
+		GatewayServiceAccount: gcp.StringPtr(email),
+		OpenAPIDocuments:      []v1alpha1.OpenAPIDocument{{Path: "openapi.yaml", Contents: gcp.StringPtr(document)}},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func documents() []*apigateway.ApigatewayApiConfigOpenApiDocument {
+	return []*apigateway.ApigatewayApiConfigOpenApiDocument{{
+		Document: &apigateway.ApigatewayApiConfigFile{
+			Path:     "openapi.yaml",
+			Contents: base64.StdEncoding.EncodeToString([]byte(document)),
+		},
+	}}
+}
+
+func apiConfig(m ...func(*apigateway.ApigatewayApiConfig)) *apigateway.ApigatewayApiConfig {
+	c := &apigateway.ApigatewayApiConfig{
+		Name:                  apiConfigName,
+		DisplayName:           "Cool config",
+		Labels:                map[string]string{"cool": "true"},
+		GatewayServiceAccount: email,
+		OpenapiDocuments:      documents(),
+	}
+	for _, f := range m {
+		f(c)
+	}
+	return c
+}
+
+func TestGetAPIConfigName(t *testing.T) {
+	if diff := cmp.Diff(apiConfigName, GetAPIConfigName("coolProject", *apiConfigParams(), "cool-config")); diff != "" {
+		t.Errorf("GetAPIConfigName(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(apiName, GetAPIConfigParent("coolProject", *apiConfigParams())); diff != "" {
+		t.Errorf("GetAPIConfigParent(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetOpenAPIDocuments(t *testing.T) {
+	type want struct {
+		docs []*apigateway.ApigatewayApiConfigOpenApiDocument
+		err  error
+	}
+	cmRef := &v1alpha1.ConfigMapKeySelector{Name: "cool-cm", Namespace: "cool-ns", Key: "openapi.yaml"}
+	secretRef := &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Name: "cool-secret", Namespace: "cool-ns"},
+		Key:             "openapi.yaml",
+	}
+	cases := map[string]struct {
+		kube client.Reader
+		p    v1alpha1.APIConfigParameters
+		want want
+	}{
+		"Inline": {
+			p:    *apiConfigParams(),
+			want: want{docs: documents()},
+		},
+		"ConfigMap": {
+			kube: &test.MockClient{MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+				if diff := cmp.Diff(client.ObjectKey{Name: "cool-cm", Namespace: "cool-ns"}, key); diff != "" {
+					t.Errorf("Get(...): -want key, +got key:\n%s", diff)
+				}
+				obj.(*corev1.ConfigMap).Data = map[string]string{"openapi.yaml": document}
+				return nil
+			}},
+			p: *apiConfigParams(func(p *v1alpha1.APIConfigParameters) {
+				p.OpenAPIDocuments = []v1alpha1.OpenAPIDocument{{Path: "openapi.yaml", ConfigMapRef: cmRef}}
+			}),
+			want: want{docs: documents()},
+		},
+		"ConfigMapMissingKey": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+			p: *apiConfigParams(func(p *v1alpha1.APIConfigParameters) {
+				p.OpenAPIDocuments = []v1alpha1.OpenAPIDocument{{Path: "openapi.yaml", ConfigMapRef: cmRef}}
+			}),
+			want: want{err: errors.Errorf(errNoDocumentKey, "openapi.yaml", "openapi.yaml")},
+		},
+		"ConfigMapGetFailed": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			p: *apiConfigParams(func(p *v1alpha1.APIConfigParameters) {
+				p.OpenAPIDocuments = []v1alpha1.OpenAPIDocument{{Path: "openapi.yaml", ConfigMapRef: cmRef}}
+			}),
+			want: want{err: errors.Wrap(errBoom, errGetConfigMap)},
+		},
+		"Secret": {
+			kube: &test.MockClient{MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+				if diff := cmp.Diff(client.ObjectKey{Name: "cool-secret", Namespace: "cool-ns"}, key); diff != "" {
+					t.Errorf("Get(...): -want key, +got key:\n%s", diff)
+				}
+				obj.(*corev1.Secret).Data = map[string][]byte{"openapi.yaml": []byte(document)}
+				return nil
+			}},
+			p: *apiConfigParams(func(p *v1alpha1.APIConfigParameters) {
+				p.OpenAPIDocuments = []v1alpha1.OpenAPIDocument{{Path: "openapi.yaml", SecretRef: secretRef}}
+			}),
+			want: want{docs: documents()},
+		},
+		"SecretGetFailed": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			p: *apiConfigParams(func(p *v1alpha1.APIConfigParameters) {
+				p.OpenAPIDocuments = []v1alpha1.OpenAPIDocument{{Path: "openapi.yaml", SecretRef: secretRef}}
+			}),
+			want: want{err: errors.Wrap(errBoom, errGetSecret)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GetOpenAPIDocuments(context.Background(), tc.kube, tc.p)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GetOpenAPIDocuments(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.docs, got); diff != "" {
+				t.Errorf("GetOpenAPIDocuments(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateAPIConfig(t *testing.T) {
+	if diff := cmp.Diff(apiConfig(), GenerateAPIConfig(apiConfigName, documents(), *apiConfigParams())); diff != "" {
+		t.Errorf("GenerateAPIConfig(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateAPIConfigObservation(t *testing.T) {
+	c := *apiConfig(func(c *apigateway.ApigatewayApiConfig) {
+		c.State = v1alpha1.StateActive
+		c.ServiceConfigId = "cool-config-1"
+	})
+	want := v1alpha1.APIConfigObservation{
+		Name:            apiConfigName,
+		State:           v1alpha1.StateActive,
+		ServiceConfigID: "cool-config-1",
+	}
+	if diff := cmp.Diff(want, GenerateAPIConfigObservation(c)); diff != "" {
+		t.Errorf("GenerateAPIConfigObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeAPIConfig(t *testing.T) {
+	p := apiConfigParams(func(p *v1alpha1.APIConfigParameters) {
+		p.DisplayName = nil
+		p.GatewayServiceAccount = nil
+	})
+	LateInitializeAPIConfig(p, *apiConfig())
+	if diff := cmp.Diff(apiConfigParams(), p); diff != "" {
+		t.Errorf("LateInitializeAPIConfig(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsAPIConfigUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.APIConfigParameters
+		c    apigateway.ApigatewayApiConfig
+		want bool
+	}{
+		"UpToDate": {
+			p:    *apiConfigParams(),
+			c:    *apiConfig(func(c *apigateway.ApigatewayApiConfig) { c.OpenapiDocuments = nil }),
+			want: true,
+		},
+		"DisplayNameDiffers": {
+			p:    *apiConfigParams(),
+			c:    *apiConfig(func(c *apigateway.ApigatewayApiConfig) { c.DisplayName = "Uncool config" }),
+			want: false,
+		},
+		"LabelsDiffer": {
+			p:    *apiConfigParams(),
+			c:    *apiConfig(func(c *apigateway.ApigatewayApiConfig) { c.Labels = nil }),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsAPIConfigUpToDate(tc.p, tc.c)); diff != "" {
+				t.Errorf("IsAPIConfigUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apigateway "google.golang.org/api/apigateway/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-gcp/apis/apigateway/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	gatewayNameFormat = "projects/%s/locations/%s/gateways/%s"
+	locationFormat    = "projects/%s/locations/%s"
+)
+
+// GatewayUpdateMask is the set of Gateway fields that can be updated in
+// place.
+const GatewayUpdateMask = "apiConfig,displayName,labels"
+
+// GetGatewayParent builds the fully qualified name of the gateway parent.
+func GetGatewayParent(project string, p v1alpha1.GatewayParameters) string {
+	return fmt.Sprintf(locationFormat, project, p.Location)
+}
+
+// GetGatewayName builds the fully qualified name of the gateway.
+func GetGatewayName(project string, p v1alpha1.GatewayParameters, name string) string {
+	return fmt.Sprintf(gatewayNameFormat, project, p.Location, name)
+}
+
+// GenerateGateway produces an API Gateway Gateway that is configured via the
+// given GatewayParameters.
+func GenerateGateway(name string, p v1alpha1.GatewayParameters) *apigateway.ApigatewayGateway {
+	return &apigateway.ApigatewayGateway{
+		Name:        name,
+		ApiConfig:   gcp.StringValue(p.APIConfig),
+		DisplayName: gcp.StringValue(p.DisplayName),
+		Labels:      p.Labels,
+	}
+}
+
+// GenerateGatewayObservation produces a GatewayObservation from the supplied
+// Gateway.
+func GenerateGatewayObservation(g apigateway.ApigatewayGateway) v1alpha1.GatewayObservation {
+	return v1alpha1.GatewayObservation{
+		Name:            g.Name,
+		State:           g.State,
+		DefaultHostname: g.DefaultHostname,
+		CreateTime:      g.CreateTime,
+		UpdateTime:      g.UpdateTime,
+	}
+}
+
+// GetGatewayConnectionDetails returns the default hostname of the supplied
+// observation as the endpoint of the gateway, if it is known.
+func GetGatewayConnectionDetails(o v1alpha1.GatewayObservation) managed.ConnectionDetails {
+	if o.DefaultHostname == "" {
+		return managed.ConnectionDetails{}
+	}
+	return managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(o.DefaultHostname),
+	}
+}
+
+// LateInitializeGateway fills the empty fields of GatewayParameters with the
+// values seen in the supplied Gateway.
+func LateInitializeGateway(p *v1alpha1.GatewayParameters, g apigateway.ApigatewayGateway) {
+	p.DisplayName = gcp.LateInitializeString(p.DisplayName, g.DisplayName)
+}
+
+// IsGatewayUpToDate returns true if the supplied Gateway matches the fields
+// of the supplied GatewayParameters that can be updated in place.
+func IsGatewayUpToDate(p v1alpha1.GatewayParameters, g apigateway.ApigatewayGateway) bool {
+	return gcp.StringValue(p.APIConfig) == g.ApiConfig &&
+		gcp.StringValue(p.DisplayName) == g.DisplayName &&
+		cmp.Equal(p.Labels, g.Labels, cmpopts.EquateEmpty())
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apigateway "google.golang.org/api/apigateway/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-gcp/apis/apigateway/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	gatewayName = "projects/coolProject/locations/us-cool1/gateways/cool-gateway"
+	hostname    = "cool-gateway-abc123.uc.gateway.dev"
+)
+
+func gatewayParams(m ...func(*v1alpha1.GatewayParameters)) *v1alpha1.GatewayParameters {
+	p := &v1alpha1.GatewayParameters{
+		Location:    "us-cool1",
+		APIConfig:   gcp.StringPtr(apiConfigName),
+		DisplayName: gcp.StringPtr("Cool gateway"),
+		Labels:      map[string]string{"cool": "true"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func gateway(m ...func(*apigateway.ApigatewayGateway)) *apigateway.ApigatewayGateway {
+	g := &apigateway.ApigatewayGateway{
+		Name:        gatewayName,
+		ApiConfig:   apiConfigName,
+		DisplayName: "Cool gateway",
+		Labels:      map[string]string{"cool": "true"},
+	}
+	for _, f := range m {
+		f(g)
+	}
+	return g
+}
+
+func TestGetGatewayName(t *testing.T) {
+	if diff := cmp.Diff(gatewayName, GetGatewayName("coolProject", *gatewayParams(), "cool-gateway")); diff != "" {
+		t.Errorf("GetGatewayName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateGateway(t *testing.T) {
+	if diff := cmp.Diff(gateway(), GenerateGateway(gatewayName, *gatewayParams())); diff != "" {
+		t.Errorf("GenerateGateway(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateGatewayObservation(t *testing.T) {
+	g := *gateway(func(g *apigateway.ApigatewayGateway) {
+		g.State = v1alpha1.StateActive
+		g.DefaultHostname = hostname
+	})
+	want := v1alpha1.GatewayObservation{
+		Name:            gatewayName,
+		State:           v1alpha1.StateActive,
+		DefaultHostname: hostname,
+	}
+	if diff := cmp.Diff(want, GenerateGatewayObservation(g)); diff != "" {
+		t.Errorf("GenerateGatewayObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetGatewayConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		o    v1alpha1.GatewayObservation
+		want managed.ConnectionDetails
+	}{
+		"NoHostname": {
+			o:    v1alpha1.GatewayObservation{},
+			want: managed.ConnectionDetails{},
+		},
+		"Hostname": {
+			o: v1alpha1.GatewayObservation{DefaultHostname: hostname},
+			want: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretEndpointKey: []byte(hostname),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GetGatewayConnectionDetails(tc.o)); diff != "" {
+				t.Errorf("GetGatewayConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeGateway(t *testing.T) {
+	p := gatewayParams(func(p *v1alpha1.GatewayParameters) { p.DisplayName = nil })
+	LateInitializeGateway(p, *gateway())
+	if diff := cmp.Diff(gatewayParams(), p); diff != "" {
+		t.Errorf("LateInitializeGateway(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsGatewayUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.GatewayParameters
+		g    apigateway.ApigatewayGateway
+		want bool
+	}{
+		"UpToDate": {
+			p:    *gatewayParams(),
+			g:    *gateway(func(g *apigateway.ApigatewayGateway) { g.DefaultHostname = hostname }),
+			want: true,
+		},
+		"APIConfigDiffers": {
+			p:    *gatewayParams(),
+			g:    *gateway(func(g *apigateway.ApigatewayGateway) { g.ApiConfig = apiName + "/configs/old-config" }),
+			want: false,
+		},
+		"LabelsDiffer": {
+			p:    *gatewayParams(),
+			g:    *gateway(func(g *apigateway.ApigatewayGateway) { g.Labels = map[string]string{"cool": "false"} }),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsGatewayUpToDate(tc.p, tc.g)); diff != "" {
+				t.Errorf("IsGatewayUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
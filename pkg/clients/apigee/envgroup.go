@@ -0,0 +1,65 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apigee "google.golang.org/api/apigee/v1"
+
+	"github.com/crossplane/provider-gcp/apis/apigee/v1alpha1"
+)
+
+// EnvGroupUpdateMask is the set of EnvironmentGroup fields that can be
+// updated in place.
+const EnvGroupUpdateMask = "hostnames"
+
+// GetEnvGroupParent builds the fully qualified name of the parent of an
+// environment group.
+func GetEnvGroupParent(project string, p v1alpha1.EnvGroupParameters) string {
+	return organization(project, p.Organization)
+}
+
+// GetEnvGroupName builds the fully qualified name of an environment group.
+func GetEnvGroupName(project string, p v1alpha1.EnvGroupParameters, name string) string {
+	return GetEnvGroupParent(project, p) + "/envgroups/" + name
+}
+
+// GenerateEnvGroup produces an Apigee EnvironmentGroup with the supplied short
+// name, e.g. public, that is configured via the given EnvGroupParameters.
+func GenerateEnvGroup(name string, p v1alpha1.EnvGroupParameters) *apigee.GoogleCloudApigeeV1EnvironmentGroup {
+	return &apigee.GoogleCloudApigeeV1EnvironmentGroup{
+		Name:      name,
+		Hostnames: p.Hostnames,
+	}
+}
+
+// GenerateEnvGroupObservation produces an EnvGroupObservation from the
+// supplied EnvironmentGroup.
+func GenerateEnvGroupObservation(g apigee.GoogleCloudApigeeV1EnvironmentGroup) v1alpha1.EnvGroupObservation {
+	return v1alpha1.EnvGroupObservation{
+		State:          g.State,
+		CreatedAt:      g.CreatedAt,
+		LastModifiedAt: g.LastModifiedAt,
+	}
+}
+
+// IsEnvGroupUpToDate returns true if the supplied EnvironmentGroup matches the
+// fields of the supplied EnvGroupParameters that can be updated in place.
+func IsEnvGroupUpToDate(p v1alpha1.EnvGroupParameters, g apigee.GoogleCloudApigeeV1EnvironmentGroup) bool {
+	return cmp.Equal(p.Hostnames, g.Hostnames, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b }))
+}
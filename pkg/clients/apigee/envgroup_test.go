@@ -0,0 +1,85 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apigee "google.golang.org/api/apigee/v1"
+
+	"github.com/crossplane/provider-gcp/apis/apigee/v1alpha1"
+)
+
+const envGroupName = "organizations/coolProject/envgroups/public"
+
+func envGroupParams() *v1alpha1.EnvGroupParameters {
+	return &v1alpha1.EnvGroupParameters{
+		Hostnames: []string{"api.example.com", "api.example.org"},
+	}
+}
+
+func TestGetEnvGroupName(t *testing.T) {
+	if diff := cmp.Diff(envGroupName, GetEnvGroupName(project, *envGroupParams(), "public")); diff != "" {
+		t.Errorf("GetEnvGroupName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateEnvGroup(t *testing.T) {
+	want := &apigee.GoogleCloudApigeeV1EnvironmentGroup{
+		Name:      "public",
+		Hostnames: []string{"api.example.com", "api.example.org"},
+	}
+	if diff := cmp.Diff(want, GenerateEnvGroup("public", *envGroupParams())); diff != "" {
+		t.Errorf("GenerateEnvGroup(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateEnvGroupObservation(t *testing.T) {
+	want := v1alpha1.EnvGroupObservation{State: "ACTIVE", LastModifiedAt: 1600000000000}
+	got := GenerateEnvGroupObservation(apigee.GoogleCloudApigeeV1EnvironmentGroup{State: "ACTIVE", LastModifiedAt: 1600000000000})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateEnvGroupObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsEnvGroupUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		g    apigee.GoogleCloudApigeeV1EnvironmentGroup
+		want bool
+	}{
+		"UpToDate": {
+			g:    apigee.GoogleCloudApigeeV1EnvironmentGroup{Hostnames: []string{"api.example.com", "api.example.org"}},
+			want: true,
+		},
+		"DifferentOrder": {
+			g:    apigee.GoogleCloudApigeeV1EnvironmentGroup{Hostnames: []string{"api.example.org", "api.example.com"}},
+			want: true,
+		},
+		"HostnameMissing": {
+			g:    apigee.GoogleCloudApigeeV1EnvironmentGroup{Hostnames: []string{"api.example.com"}},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsEnvGroupUpToDate(*envGroupParams(), tc.g)); diff != "" {
+				t.Errorf("IsEnvGroupUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	apigee "google.golang.org/api/apigee/v1"
+
+	"github.com/crossplane/provider-gcp/apis/apigee/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// GetEnvironmentParent builds the fully qualified name of the parent of an
+// environment.
+func GetEnvironmentParent(project string, p v1alpha1.EnvironmentParameters) string {
+	return organization(project, p.Organization)
+}
+
+// GetEnvironmentName builds the fully qualified name of an environment.
+func GetEnvironmentName(project string, p v1alpha1.EnvironmentParameters, name string) string {
+	return GetEnvironmentParent(project, p) + "/environments/" + name
+}
+
+// GenerateEnvironment produces an Apigee Environment with the supplied short
+// name, e.g. dev, that is configured via the given EnvironmentParameters.
+func GenerateEnvironment(name string, p v1alpha1.EnvironmentParameters) *apigee.GoogleCloudApigeeV1Environment {
+	return &apigee.GoogleCloudApigeeV1Environment{
+		Name:            name,
+		DisplayName:     gcp.StringValue(p.DisplayName),
+		Description:     gcp.StringValue(p.Description),
+		DeploymentType:  gcp.StringValue(p.DeploymentType),
+		ApiProxyType:    gcp.StringValue(p.APIProxyType),
+		ForwardProxyUri: gcp.StringValue(p.ForwardProxyURI),
+		Properties:      generateProperties(p.Properties),
+	}
+}
+
+// GenerateEnvironmentObservation produces an EnvironmentObservation from the
+// supplied Environment.
+func GenerateEnvironmentObservation(e apigee.GoogleCloudApigeeV1Environment) v1alpha1.EnvironmentObservation {
+	return v1alpha1.EnvironmentObservation{
+		State:          e.State,
+		CreatedAt:      e.CreatedAt,
+		LastModifiedAt: e.LastModifiedAt,
+	}
+}
+
+// LateInitializeEnvironment fills the empty fields of EnvironmentParameters
+// with the values seen in the supplied Environment.
+func LateInitializeEnvironment(p *v1alpha1.EnvironmentParameters, e apigee.GoogleCloudApigeeV1Environment) {
+	p.DisplayName = gcp.LateInitializeString(p.DisplayName, e.DisplayName)
+	p.Description = gcp.LateInitializeString(p.Description, e.Description)
+	p.DeploymentType = gcp.LateInitializeString(p.DeploymentType, e.DeploymentType)
+	p.APIProxyType = gcp.LateInitializeString(p.APIProxyType, e.ApiProxyType)
+	p.ForwardProxyURI = gcp.LateInitializeString(p.ForwardProxyURI, e.ForwardProxyUri)
+	p.Properties = lateInitializeProperties(p.Properties, e.Properties)
+}
+
+// IsEnvironmentUpToDate returns true if the supplied Environment matches the
+// fields of the supplied EnvironmentParameters that can be updated in place.
+func IsEnvironmentUpToDate(p v1alpha1.EnvironmentParameters, e apigee.GoogleCloudApigeeV1Environment) bool {
+	return gcp.StringValue(p.DisplayName) == e.DisplayName &&
+		gcp.StringValue(p.Description) == e.Description &&
+		gcp.StringValue(p.ForwardProxyURI) == e.ForwardProxyUri &&
+		arePropertiesUpToDate(p.Properties, e.Properties)
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apigee "google.golang.org/api/apigee/v1"
+
+	"github.com/crossplane/provider-gcp/apis/apigee/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const envName = "organizations/coolProject/environments/dev"
+
+func envParams(m ...func(*v1alpha1.EnvironmentParameters)) *v1alpha1.EnvironmentParameters {
+	p := &v1alpha1.EnvironmentParameters{
+		DisplayName:    gcp.StringPtr("Dev"),
+		Description:    gcp.StringPtr("Development environment"),
+		DeploymentType: gcp.StringPtr("PROXY"),
+		APIProxyType:   gcp.StringPtr("PROGRAMMABLE"),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func env(m ...func(*apigee.GoogleCloudApigeeV1Environment)) *apigee.GoogleCloudApigeeV1Environment {
+	e := &apigee.GoogleCloudApigeeV1Environment{
+		Name:           "dev",
+		DisplayName:    "Dev",
+		Description:    "Development environment",
+		DeploymentType: "PROXY",
+		ApiProxyType:   "PROGRAMMABLE",
+	}
+	for _, f := range m {
+		f(e)
+	}
+	return e
+}
+
+func TestGetEnvironmentName(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.EnvironmentParameters
+		want string
+	}{
+		"DefaultOrganization": {
+			p:    *envParams(),
+			want: envName,
+		},
+		"Organization": {
+			p:    *envParams(func(p *v1alpha1.EnvironmentParameters) { p.Organization = gcp.StringPtr("otherProject") }),
+			want: "organizations/otherProject/environments/dev",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GetEnvironmentName(project, tc.p, "dev")); diff != "" {
+				t.Errorf("GetEnvironmentName(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateEnvironment(t *testing.T) {
+	if diff := cmp.Diff(env(), GenerateEnvironment("dev", *envParams())); diff != "" {
+		t.Errorf("GenerateEnvironment(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateEnvironmentObservation(t *testing.T) {
+	want := v1alpha1.EnvironmentObservation{State: "ACTIVE", CreatedAt: 1600000000000}
+	got := GenerateEnvironmentObservation(*env(func(e *apigee.GoogleCloudApigeeV1Environment) {
+		e.State = "ACTIVE"
+		e.CreatedAt = 1600000000000
+	}))
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateEnvironmentObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeEnvironment(t *testing.T) {
+	p := envParams(func(p *v1alpha1.EnvironmentParameters) {
+		p.DeploymentType = nil
+		p.APIProxyType = nil
+	})
+	LateInitializeEnvironment(p, *env())
+	if diff := cmp.Diff(envParams(), p); diff != "" {
+		t.Errorf("LateInitializeEnvironment(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsEnvironmentUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		e    apigee.GoogleCloudApigeeV1Environment
+		want bool
+	}{
+		"UpToDate": {
+			e:    *env(),
+			want: true,
+		},
+		"DescriptionDiffers": {
+			e:    *env(func(e *apigee.GoogleCloudApigeeV1Environment) { e.Description = "Old" }),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsEnvironmentUpToDate(*envParams(), tc.e)); diff != "" {
+				t.Errorf("IsEnvironmentUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
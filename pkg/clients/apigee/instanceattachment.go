@@ -0,0 +1,65 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	apigee "google.golang.org/api/apigee/v1"
+
+	"github.com/crossplane/provider-gcp/apis/apigee/v1alpha1"
+)
+
+// GetInstanceName builds the fully qualified name of the instance an
+// environment is attached to.
+func GetInstanceName(project string, p v1alpha1.InstanceAttachmentParameters) string {
+	return organization(project, p.Organization) + "/instances/" + p.Instance
+}
+
+// GetInstanceAttachmentName builds the fully qualified name of an instance
+// attachment with the supplied server generated ID.
+func GetInstanceAttachmentName(project string, p v1alpha1.InstanceAttachmentParameters, id string) string {
+	return GetInstanceName(project, p) + "/attachments/" + id
+}
+
+// GenerateInstanceAttachment produces an Apigee InstanceAttachment that is
+// configured via the supplied InstanceAttachmentParameters.
+func GenerateInstanceAttachment(p v1alpha1.InstanceAttachmentParameters) *apigee.GoogleCloudApigeeV1InstanceAttachment {
+	return &apigee.GoogleCloudApigeeV1InstanceAttachment{
+		Environment: p.Environment,
+	}
+}
+
+// FindInstanceAttachment returns the attachment of the environment of the
+// supplied InstanceAttachmentParameters, or nil if the environment is not
+// attached. Attachment IDs are generated by the server, so an attachment is
+// identified by the environment it attaches.
+func FindInstanceAttachment(p v1alpha1.InstanceAttachmentParameters, attachments []*apigee.GoogleCloudApigeeV1InstanceAttachment) *apigee.GoogleCloudApigeeV1InstanceAttachment {
+	for _, a := range attachments {
+		if a.Environment == p.Environment {
+			return a
+		}
+	}
+	return nil
+}
+
+// GenerateInstanceAttachmentObservation produces an
+// InstanceAttachmentObservation from the supplied InstanceAttachment.
+func GenerateInstanceAttachmentObservation(a apigee.GoogleCloudApigeeV1InstanceAttachment) v1alpha1.InstanceAttachmentObservation {
+	return v1alpha1.InstanceAttachmentObservation{
+		Name:      a.Name,
+		CreatedAt: a.CreatedAt,
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apigee "google.golang.org/api/apigee/v1"
+
+	"github.com/crossplane/provider-gcp/apis/apigee/v1alpha1"
+)
+
+func attachmentParams() *v1alpha1.InstanceAttachmentParameters {
+	return &v1alpha1.InstanceAttachmentParameters{
+		Instance:    "us-central1",
+		Environment: "dev",
+	}
+}
+
+func TestInstanceAttachmentNames(t *testing.T) {
+	if diff := cmp.Diff("organizations/coolProject/instances/us-central1", GetInstanceName(project, *attachmentParams())); diff != "" {
+		t.Errorf("GetInstanceName(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("organizations/coolProject/instances/us-central1/attachments/abc", GetInstanceAttachmentName(project, *attachmentParams(), "abc")); diff != "" {
+		t.Errorf("GetInstanceAttachmentName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateInstanceAttachment(t *testing.T) {
+	want := &apigee.GoogleCloudApigeeV1InstanceAttachment{Environment: "dev"}
+	if diff := cmp.Diff(want, GenerateInstanceAttachment(*attachmentParams())); diff != "" {
+		t.Errorf("GenerateInstanceAttachment(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestFindInstanceAttachment(t *testing.T) {
+	dev := &apigee.GoogleCloudApigeeV1InstanceAttachment{Name: "abc", Environment: "dev"}
+	prod := &apigee.GoogleCloudApigeeV1InstanceAttachment{Name: "def", Environment: "prod"}
+	cases := map[string]struct {
+		attachments []*apigee.GoogleCloudApigeeV1InstanceAttachment
+		want        *apigee.GoogleCloudApigeeV1InstanceAttachment
+	}{
+		"Found": {
+			attachments: []*apigee.GoogleCloudApigeeV1InstanceAttachment{prod, dev},
+			want:        dev,
+		},
+		"NotFound": {
+			attachments: []*apigee.GoogleCloudApigeeV1InstanceAttachment{prod},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, FindInstanceAttachment(*attachmentParams(), tc.attachments)); diff != "" {
+				t.Errorf("FindInstanceAttachment(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateInstanceAttachmentObservation(t *testing.T) {
+	want := v1alpha1.InstanceAttachmentObservation{Name: "abc", CreatedAt: 1600000000000}
+	got := GenerateInstanceAttachmentObservation(apigee.GoogleCloudApigeeV1InstanceAttachment{Name: "abc", Environment: "dev", CreatedAt: 1600000000000})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateInstanceAttachmentObservation(...): -want, +got:\n%s", diff)
+	}
+}
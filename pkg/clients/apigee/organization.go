@@ -0,0 +1,141 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apigee "google.golang.org/api/apigee/v1"
+
+	"github.com/crossplane/provider-gcp/apis/apigee/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	organizationNameFormat   = "organizations/%s"
+	organizationParentFormat = "projects/%s"
+)
+
+// GetOrganizationName builds the fully qualified name of the organization of
+// the supplied project.
+func GetOrganizationName(project string) string {
+	return fmt.Sprintf(organizationNameFormat, project)
+}
+
+// GetOrganizationParent builds the fully qualified name of the parent of the
+// organization of the supplied project.
+func GetOrganizationParent(project string) string {
+	return fmt.Sprintf(organizationParentFormat, project)
+}
+
+// organization returns the supplied organization if set, or the organization
+// of the supplied project otherwise.
+func organization(project string, org *string) string {
+	if org != nil && *org != "" {
+		return GetOrganizationName(*org)
+	}
+	return GetOrganizationName(project)
+}
+
+// GenerateOrganization produces an Apigee Organization that is configured via
+// the supplied OrganizationParameters.
+func GenerateOrganization(p v1alpha1.OrganizationParameters) *apigee.GoogleCloudApigeeV1Organization {
+	return &apigee.GoogleCloudApigeeV1Organization{
+		DisplayName:                      gcp.StringValue(p.DisplayName),
+		Description:                      gcp.StringValue(p.Description),
+		AnalyticsRegion:                  p.AnalyticsRegion,
+		RuntimeType:                      p.RuntimeType,
+		BillingType:                      gcp.StringValue(p.BillingType),
+		AuthorizedNetwork:                gcp.StringValue(p.AuthorizedNetwork),
+		DisableVpcPeering:                gcp.BoolValue(p.DisableVPCPeering),
+		RuntimeDatabaseEncryptionKeyName: gcp.StringValue(p.RuntimeDatabaseEncryptionKeyName),
+		Properties:                       generateProperties(p.Properties),
+	}
+}
+
+func generateProperties(in []v1alpha1.Property) *apigee.GoogleCloudApigeeV1Properties {
+	if len(in) == 0 {
+		return nil
+	}
+	out := &apigee.GoogleCloudApigeeV1Properties{}
+	for _, p := range in {
+		out.Property = append(out.Property, &apigee.GoogleCloudApigeeV1Property{Name: p.Name, Value: p.Value})
+	}
+	return out
+}
+
+func lateInitializeProperties(in []v1alpha1.Property, observed *apigee.GoogleCloudApigeeV1Properties) []v1alpha1.Property {
+	if len(in) != 0 || observed == nil {
+		return in
+	}
+	for _, p := range observed.Property {
+		in = append(in, v1alpha1.Property{Name: p.Name, Value: p.Value})
+	}
+	return in
+}
+
+func arePropertiesUpToDate(in []v1alpha1.Property, observed *apigee.GoogleCloudApigeeV1Properties) bool {
+	var o []*apigee.GoogleCloudApigeeV1Property
+	if observed != nil {
+		o = observed.Property
+	}
+	var d []*apigee.GoogleCloudApigeeV1Property
+	if p := generateProperties(in); p != nil {
+		d = p.Property
+	}
+	return cmp.Equal(d, o, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(apigee.GoogleCloudApigeeV1Property{}, "ForceSendFields", "NullFields"),
+		cmpopts.SortSlices(func(a, b *apigee.GoogleCloudApigeeV1Property) bool { return a.Name < b.Name }))
+}
+
+// GenerateOrganizationObservation produces an OrganizationObservation from
+// the supplied Organization.
+func GenerateOrganizationObservation(o apigee.GoogleCloudApigeeV1Organization) v1alpha1.OrganizationObservation {
+	return v1alpha1.OrganizationObservation{
+		Name:             o.Name,
+		State:            o.State,
+		CACertificate:    o.CaCertificate,
+		ApigeeProjectID:  o.ApigeeProjectId,
+		SubscriptionType: o.SubscriptionType,
+		Environments:     o.Environments,
+		CreatedAt:        o.CreatedAt,
+		LastModifiedAt:   o.LastModifiedAt,
+	}
+}
+
+// LateInitializeOrganization fills the empty fields of OrganizationParameters
+// with the values seen in the supplied Organization.
+func LateInitializeOrganization(p *v1alpha1.OrganizationParameters, o apigee.GoogleCloudApigeeV1Organization) {
+	p.DisplayName = gcp.LateInitializeString(p.DisplayName, o.DisplayName)
+	p.Description = gcp.LateInitializeString(p.Description, o.Description)
+	p.BillingType = gcp.LateInitializeString(p.BillingType, o.BillingType)
+	p.AuthorizedNetwork = gcp.LateInitializeString(p.AuthorizedNetwork, o.AuthorizedNetwork)
+	p.DisableVPCPeering = gcp.LateInitializeBool(p.DisableVPCPeering, o.DisableVpcPeering)
+	p.RuntimeDatabaseEncryptionKeyName = gcp.LateInitializeString(p.RuntimeDatabaseEncryptionKeyName, o.RuntimeDatabaseEncryptionKeyName)
+	p.Properties = lateInitializeProperties(p.Properties, o.Properties)
+}
+
+// IsOrganizationUpToDate returns true if the supplied Organization matches the
+// fields of the supplied OrganizationParameters that can be updated in place.
+func IsOrganizationUpToDate(p v1alpha1.OrganizationParameters, o apigee.GoogleCloudApigeeV1Organization) bool {
+	return gcp.StringValue(p.DisplayName) == o.DisplayName &&
+		gcp.StringValue(p.Description) == o.Description &&
+		gcp.StringValue(p.AuthorizedNetwork) == o.AuthorizedNetwork &&
+		arePropertiesUpToDate(p.Properties, o.Properties)
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apigee "google.golang.org/api/apigee/v1"
+
+	"github.com/crossplane/provider-gcp/apis/apigee/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const project = "coolProject"
+
+func orgParams(m ...func(*v1alpha1.OrganizationParameters)) *v1alpha1.OrganizationParameters {
+	p := &v1alpha1.OrganizationParameters{
+		DisplayName:       gcp.StringPtr("Cool org"),
+		Description:       gcp.StringPtr("Cool organization"),
+		AnalyticsRegion:   "us-central1",
+		RuntimeType:       v1alpha1.RuntimeTypeCloud,
+		BillingType:       gcp.StringPtr("EVALUATION"),
+		AuthorizedNetwork: gcp.StringPtr("default"),
+		DisableVPCPeering: gcp.BoolPtr(false),
+		Properties:        []v1alpha1.Property{{Name: "features.hybrid.enabled", Value: "false"}},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func org(m ...func(*apigee.GoogleCloudApigeeV1Organization)) *apigee.GoogleCloudApigeeV1Organization {
+	o := &apigee.GoogleCloudApigeeV1Organization{
+		DisplayName:       "Cool org",
+		Description:       "Cool organization",
+		AnalyticsRegion:   "us-central1",
+		RuntimeType:       v1alpha1.RuntimeTypeCloud,
+		BillingType:       "EVALUATION",
+		AuthorizedNetwork: "default",
+		Properties: &apigee.GoogleCloudApigeeV1Properties{
+			Property: []*apigee.GoogleCloudApigeeV1Property{{Name: "features.hybrid.enabled", Value: "false"}},
+		},
+	}
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func TestOrganizationNames(t *testing.T) {
+	if diff := cmp.Diff("organizations/coolProject", GetOrganizationName(project)); diff != "" {
+		t.Errorf("GetOrganizationName(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("projects/coolProject", GetOrganizationParent(project)); diff != "" {
+		t.Errorf("GetOrganizationParent(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateOrganization(t *testing.T) {
+	if diff := cmp.Diff(org(), GenerateOrganization(*orgParams())); diff != "" {
+		t.Errorf("GenerateOrganization(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateOrganizationObservation(t *testing.T) {
+	o := *org(func(o *apigee.GoogleCloudApigeeV1Organization) {
+		o.Name = project
+		o.State = "ACTIVE"
+		o.Environments = []string{"dev"}
+		o.CreatedAt = 1600000000000
+	})
+	want := v1alpha1.OrganizationObservation{
+		Name:         project,
+		State:        "ACTIVE",
+		Environments: []string{"dev"},
+		CreatedAt:    1600000000000,
+	}
+	if diff := cmp.Diff(want, GenerateOrganizationObservation(o)); diff != "" {
+		t.Errorf("GenerateOrganizationObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeOrganization(t *testing.T) {
+	p := orgParams(func(p *v1alpha1.OrganizationParameters) {
+		p.DisplayName = nil
+		p.BillingType = nil
+		p.Properties = nil
+	})
+	LateInitializeOrganization(p, *org())
+	want := orgParams(func(p *v1alpha1.OrganizationParameters) { p.DisableVPCPeering = gcp.BoolPtr(false) })
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("LateInitializeOrganization(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsOrganizationUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		o    apigee.GoogleCloudApigeeV1Organization
+		want bool
+	}{
+		"UpToDate": {
+			o:    *org(func(o *apigee.GoogleCloudApigeeV1Organization) { o.State = "ACTIVE" }),
+			want: true,
+		},
+		"DisplayNameDiffers": {
+			o:    *org(func(o *apigee.GoogleCloudApigeeV1Organization) { o.DisplayName = "Uncool org" }),
+			want: false,
+		},
+		"PropertiesDiffer": {
+			o:    *org(func(o *apigee.GoogleCloudApigeeV1Organization) { o.Properties = nil }),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsOrganizationUpToDate(*orgParams(), tc.o)); diff != "" {
+				t.Errorf("IsOrganizationUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
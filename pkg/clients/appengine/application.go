@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appengine
+
+import (
+	appengine "google.golang.org/api/appengine/v1"
+
+	"github.com/crossplane/provider-gcp/apis/appengine/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// ApplicationUpdateMask is the set of Application fields that can be updated
+// in place.
+const ApplicationUpdateMask = "authDomain,servingStatus,featureSettings"
+
+// GenerateApplication produces an App Engine Application that is configured
+// via the given ApplicationParameters. The ID of an application is the ID of
+// its project.
+func GenerateApplication(project string, p v1alpha1.ApplicationParameters) *appengine.Application {
+	a := &appengine.Application{
+		Id:            project,
+		LocationId:    p.LocationID,
+		DatabaseType:  gcp.StringValue(p.DatabaseType),
+		AuthDomain:    gcp.StringValue(p.AuthDomain),
+		ServingStatus: gcp.StringValue(p.ServingStatus),
+	}
+	if fs := p.FeatureSettings; fs != nil {
+		a.FeatureSettings = &appengine.FeatureSettings{
+			SplitHealthChecks:       gcp.BoolValue(fs.SplitHealthChecks),
+			UseContainerOptimizedOs: gcp.BoolValue(fs.UseContainerOptimizedOS),
+		}
+		// Turning a feature off is meaningful, so we send every setting
+		// that is set.
+		if fs.SplitHealthChecks != nil {
+			a.FeatureSettings.ForceSendFields = append(a.FeatureSettings.ForceSendFields, "SplitHealthChecks")
+		}
+		if fs.UseContainerOptimizedOS != nil {
+			a.FeatureSettings.ForceSendFields = append(a.FeatureSettings.ForceSendFields, "UseContainerOptimizedOs")
+		}
+	}
+	return a
+}
+
+// GenerateApplicationObservation produces an ApplicationObservation from the
+// supplied Application.
+func GenerateApplicationObservation(a appengine.Application) v1alpha1.ApplicationObservation {
+	return v1alpha1.ApplicationObservation{
+		Name:            a.Name,
+		ServingStatus:   a.ServingStatus,
+		DefaultHostname: a.DefaultHostname,
+		DefaultBucket:   a.DefaultBucket,
+		CodeBucket:      a.CodeBucket,
+		GCRDomain:       a.GcrDomain,
+		ServiceAccount:  a.ServiceAccount,
+	}
+}
+
+// LateInitializeApplication fills the empty fields of ApplicationParameters
+// with the values seen in the supplied Application.
+func LateInitializeApplication(p *v1alpha1.ApplicationParameters, a appengine.Application) {
+	p.DatabaseType = gcp.LateInitializeString(p.DatabaseType, a.DatabaseType)
+	p.AuthDomain = gcp.LateInitializeString(p.AuthDomain, a.AuthDomain)
+	p.ServingStatus = gcp.LateInitializeString(p.ServingStatus, a.ServingStatus)
+	if a.FeatureSettings == nil {
+		return
+	}
+	fs := v1alpha1.FeatureSettings{}
+	if p.FeatureSettings != nil {
+		fs = *p.FeatureSettings
+	}
+	fs.SplitHealthChecks = gcp.LateInitializeBool(fs.SplitHealthChecks, a.FeatureSettings.SplitHealthChecks)
+	fs.UseContainerOptimizedOS = gcp.LateInitializeBool(fs.UseContainerOptimizedOS, a.FeatureSettings.UseContainerOptimizedOs)
+	if fs.SplitHealthChecks != nil || fs.UseContainerOptimizedOS != nil {
+		p.FeatureSettings = &fs
+	}
+}
+
+// IsApplicationUpToDate returns true if the supplied Application matches the
+// fields of the supplied ApplicationParameters that can be updated in place.
+func IsApplicationUpToDate(p v1alpha1.ApplicationParameters, a appengine.Application) bool {
+	if p.AuthDomain != nil && *p.AuthDomain != a.AuthDomain {
+		return false
+	}
+	if p.ServingStatus != nil && *p.ServingStatus != a.ServingStatus {
+		return false
+	}
+	if fs := p.FeatureSettings; fs != nil {
+		observed := appengine.FeatureSettings{}
+		if a.FeatureSettings != nil {
+			observed = *a.FeatureSettings
+		}
+		if fs.SplitHealthChecks != nil && *fs.SplitHealthChecks != observed.SplitHealthChecks {
+			return false
+		}
+		if fs.UseContainerOptimizedOS != nil && *fs.UseContainerOptimizedOS != observed.UseContainerOptimizedOs {
+			return false
+		}
+	}
+	return true
+}
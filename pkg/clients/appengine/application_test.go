@@ -0,0 +1,165 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appengine
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	appengine "google.golang.org/api/appengine/v1"
+
+	"github.com/crossplane/provider-gcp/apis/appengine/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const project = "coolProject"
+
+func applicationParams(m ...func(*v1alpha1.ApplicationParameters)) *v1alpha1.ApplicationParameters {
+	p := &v1alpha1.ApplicationParameters{
+		LocationID:    "us-central",
+		DatabaseType:  gcp.StringPtr("CLOUD_FIRESTORE"),
+		AuthDomain:    gcp.StringPtr("gmail.com"),
+		ServingStatus: gcp.StringPtr(v1alpha1.ServingStatusServing),
+		FeatureSettings: &v1alpha1.FeatureSettings{
+			SplitHealthChecks: gcp.BoolPtr(true),
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func application(m ...func(*appengine.Application)) *appengine.Application {
+	a := &appengine.Application{
+		Id:            project,
+		LocationId:    "us-central",
+		DatabaseType:  "CLOUD_FIRESTORE",
+		AuthDomain:    "gmail.com",
+		ServingStatus: v1alpha1.ServingStatusServing,
+		FeatureSettings: &appengine.FeatureSettings{
+			SplitHealthChecks: true,
+			ForceSendFields:   []string{"SplitHealthChecks"},
+		},
+	}
+	for _, f := range m {
+		f(a)
+	}
+	return a
+}
+
+func TestGenerateApplication(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.ApplicationParameters
+		want *appengine.Application
+	}{
+		"FullConversion": {
+			p:    *applicationParams(),
+			want: application(),
+		},
+		"FeatureDisabled": {
+			p: *applicationParams(func(p *v1alpha1.ApplicationParameters) {
+				p.FeatureSettings.SplitHealthChecks = gcp.BoolPtr(false)
+			}),
+			want: application(func(a *appengine.Application) {
+				a.FeatureSettings.SplitHealthChecks = false
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateApplication(project, tc.p)); diff != "" {
+				t.Errorf("GenerateApplication(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateApplicationObservation(t *testing.T) {
+	a := *application(func(a *appengine.Application) {
+		a.Name = "apps/coolProject"
+		a.DefaultHostname = "coolProject.uc.r.appspot.com"
+		a.DefaultBucket = "coolProject.appspot.com"
+	})
+	want := v1alpha1.ApplicationObservation{
+		Name:            "apps/coolProject",
+		ServingStatus:   v1alpha1.ServingStatusServing,
+		DefaultHostname: "coolProject.uc.r.appspot.com",
+		DefaultBucket:   "coolProject.appspot.com",
+	}
+	if diff := cmp.Diff(want, GenerateApplicationObservation(a)); diff != "" {
+		t.Errorf("GenerateApplicationObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeApplication(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.ApplicationParameters
+		a    appengine.Application
+		want *v1alpha1.ApplicationParameters
+	}{
+		"FillsEmptyFields": {
+			p:    &v1alpha1.ApplicationParameters{LocationID: "us-central"},
+			a:    *application(),
+			want: applicationParams(),
+		},
+		"NoFeaturesEnabled": {
+			p: &v1alpha1.ApplicationParameters{LocationID: "us-central"},
+			a: *application(func(a *appengine.Application) {
+				a.FeatureSettings = &appengine.FeatureSettings{}
+			}),
+			want: applicationParams(func(p *v1alpha1.ApplicationParameters) {
+				p.FeatureSettings = nil
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeApplication(tc.p, tc.a)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("LateInitializeApplication(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsApplicationUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		a    appengine.Application
+		want bool
+	}{
+		"UpToDate": {
+			a:    *application(func(a *appengine.Application) { a.DefaultHostname = "coolProject.appspot.com" }),
+			want: true,
+		},
+		"ServingStatusDiffers": {
+			a:    *application(func(a *appengine.Application) { a.ServingStatus = v1alpha1.ServingStatusUserDisabled }),
+			want: false,
+		},
+		"FeatureSettingsDiffer": {
+			a:    *application(func(a *appengine.Application) { a.FeatureSettings = nil }),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsApplicationUpToDate(*applicationParams(), tc.a)); diff != "" {
+				t.Errorf("IsApplicationUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
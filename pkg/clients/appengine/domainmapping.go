@@ -0,0 +1,103 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appengine
+
+import (
+	appengine "google.golang.org/api/appengine/v1"
+
+	"github.com/crossplane/provider-gcp/apis/appengine/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// SSLManagementTypeAutomatic is the SSL management type of domain mappings
+// whose certificate is managed by App Engine.
+const SSLManagementTypeAutomatic = "AUTOMATIC"
+
+// GetDomainMappingUpdateMask returns the set of DomainMapping fields that are
+// updated in place. The certificate of an automatically managed domain
+// mapping cannot be set.
+func GetDomainMappingUpdateMask(p v1alpha1.DomainMappingParameters) string {
+	if p.SSLSettings != nil && gcp.StringValue(p.SSLSettings.SSLManagementType) == SSLManagementTypeAutomatic {
+		return "sslSettings.sslManagementType"
+	}
+	return "sslSettings.sslManagementType,sslSettings.certificateId"
+}
+
+// GenerateDomainMapping produces an App Engine DomainMapping for the supplied
+// domain that is configured via the given DomainMappingParameters.
+func GenerateDomainMapping(domain string, p v1alpha1.DomainMappingParameters) *appengine.DomainMapping {
+	dm := &appengine.DomainMapping{Id: domain}
+	if s := p.SSLSettings; s != nil {
+		dm.SslSettings = &appengine.SslSettings{
+			SslManagementType: gcp.StringValue(s.SSLManagementType),
+			CertificateId:     gcp.StringValue(s.CertificateID),
+		}
+	}
+	return dm
+}
+
+// GenerateDomainMappingObservation produces a DomainMappingObservation from
+// the supplied DomainMapping.
+func GenerateDomainMappingObservation(dm appengine.DomainMapping) v1alpha1.DomainMappingObservation {
+	o := v1alpha1.DomainMappingObservation{Name: dm.Name}
+	for _, r := range dm.ResourceRecords {
+		if r == nil {
+			continue
+		}
+		o.ResourceRecords = append(o.ResourceRecords, v1alpha1.ResourceRecord{Name: r.Name, Type: r.Type, RRData: r.Rrdata})
+	}
+	if dm.SslSettings != nil {
+		o.PendingManagedCertificateID = dm.SslSettings.PendingManagedCertificateId
+	}
+	return o
+}
+
+// LateInitializeDomainMapping fills the empty fields of
+// DomainMappingParameters with the values seen in the supplied DomainMapping.
+func LateInitializeDomainMapping(p *v1alpha1.DomainMappingParameters, dm appengine.DomainMapping) {
+	if dm.SslSettings == nil {
+		return
+	}
+	if p.SSLSettings == nil {
+		p.SSLSettings = &v1alpha1.SSLSettings{}
+	}
+	p.SSLSettings.SSLManagementType = gcp.LateInitializeString(p.SSLSettings.SSLManagementType, dm.SslSettings.SslManagementType)
+	if gcp.StringValue(p.SSLSettings.SSLManagementType) != SSLManagementTypeAutomatic {
+		p.SSLSettings.CertificateID = gcp.LateInitializeString(p.SSLSettings.CertificateID, dm.SslSettings.CertificateId)
+	}
+}
+
+// IsDomainMappingUpToDate returns true if the supplied DomainMapping matches
+// the supplied DomainMappingParameters.
+func IsDomainMappingUpToDate(p v1alpha1.DomainMappingParameters, dm appengine.DomainMapping) bool {
+	if p.SSLSettings == nil {
+		return true
+	}
+	observed := appengine.SslSettings{}
+	if dm.SslSettings != nil {
+		observed = *dm.SslSettings
+	}
+	if p.SSLSettings.SSLManagementType != nil && *p.SSLSettings.SSLManagementType != observed.SslManagementType {
+		return false
+	}
+	// Automatically managed certificates are rotated by App Engine, so
+	// their ID is only compared for manually managed certificates.
+	if observed.SslManagementType == SSLManagementTypeAutomatic {
+		return true
+	}
+	return p.SSLSettings.CertificateID == nil || *p.SSLSettings.CertificateID == observed.CertificateId
+}
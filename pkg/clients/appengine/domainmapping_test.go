@@ -0,0 +1,156 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appengine
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	appengine "google.golang.org/api/appengine/v1"
+
+	"github.com/crossplane/provider-gcp/apis/appengine/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const domain = "www.example.com"
+
+func TestGenerateDomainMapping(t *testing.T) {
+	p := v1alpha1.DomainMappingParameters{SSLSettings: &v1alpha1.SSLSettings{
+		SSLManagementType: gcp.StringPtr("MANUAL"),
+		CertificateID:     gcp.StringPtr("12345"),
+	}}
+	want := &appengine.DomainMapping{
+		Id:          domain,
+		SslSettings: &appengine.SslSettings{SslManagementType: "MANUAL", CertificateId: "12345"},
+	}
+	if diff := cmp.Diff(want, GenerateDomainMapping(domain, p)); diff != "" {
+		t.Errorf("GenerateDomainMapping(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetDomainMappingUpdateMask(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.DomainMappingParameters
+		want string
+	}{
+		"Automatic": {
+			p:    v1alpha1.DomainMappingParameters{SSLSettings: &v1alpha1.SSLSettings{SSLManagementType: gcp.StringPtr(SSLManagementTypeAutomatic)}},
+			want: "sslSettings.sslManagementType",
+		},
+		"Manual": {
+			p:    v1alpha1.DomainMappingParameters{SSLSettings: &v1alpha1.SSLSettings{SSLManagementType: gcp.StringPtr("MANUAL")}},
+			want: "sslSettings.sslManagementType,sslSettings.certificateId",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GetDomainMappingUpdateMask(tc.p)); diff != "" {
+				t.Errorf("GetDomainMappingUpdateMask(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateDomainMappingObservation(t *testing.T) {
+	dm := appengine.DomainMapping{
+		Name:            "apps/coolProject/domainMappings/" + domain,
+		ResourceRecords: []*appengine.ResourceRecord{{Name: "www", Type: "CNAME", Rrdata: "ghs.googlehosted.com."}},
+		SslSettings:     &appengine.SslSettings{SslManagementType: SSLManagementTypeAutomatic, PendingManagedCertificateId: "67890"},
+	}
+	want := v1alpha1.DomainMappingObservation{
+		Name:                        "apps/coolProject/domainMappings/" + domain,
+		ResourceRecords:             []v1alpha1.ResourceRecord{{Name: "www", Type: "CNAME", RRData: "ghs.googlehosted.com."}},
+		PendingManagedCertificateID: "67890",
+	}
+	if diff := cmp.Diff(want, GenerateDomainMappingObservation(dm)); diff != "" {
+		t.Errorf("GenerateDomainMappingObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeDomainMapping(t *testing.T) {
+	cases := map[string]struct {
+		dm   appengine.DomainMapping
+		want *v1alpha1.DomainMappingParameters
+	}{
+		"Automatic": {
+			dm: appengine.DomainMapping{SslSettings: &appengine.SslSettings{SslManagementType: SSLManagementTypeAutomatic, CertificateId: "12345"}},
+			want: &v1alpha1.DomainMappingParameters{SSLSettings: &v1alpha1.SSLSettings{
+				SSLManagementType: gcp.StringPtr(SSLManagementTypeAutomatic),
+			}},
+		},
+		"Manual": {
+			dm: appengine.DomainMapping{SslSettings: &appengine.SslSettings{SslManagementType: "MANUAL", CertificateId: "12345"}},
+			want: &v1alpha1.DomainMappingParameters{SSLSettings: &v1alpha1.SSLSettings{
+				SSLManagementType: gcp.StringPtr("MANUAL"),
+				CertificateID:     gcp.StringPtr("12345"),
+			}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := &v1alpha1.DomainMappingParameters{}
+			LateInitializeDomainMapping(p, tc.dm)
+			if diff := cmp.Diff(tc.want, p); diff != "" {
+				t.Errorf("LateInitializeDomainMapping(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsDomainMappingUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.DomainMappingParameters
+		dm   appengine.DomainMapping
+		want bool
+	}{
+		"NoSSLSettings": {
+			p:    v1alpha1.DomainMappingParameters{},
+			dm:   appengine.DomainMapping{SslSettings: &appengine.SslSettings{SslManagementType: SSLManagementTypeAutomatic}},
+			want: true,
+		},
+		"AutomaticCertificateRotated": {
+			p: v1alpha1.DomainMappingParameters{SSLSettings: &v1alpha1.SSLSettings{
+				SSLManagementType: gcp.StringPtr(SSLManagementTypeAutomatic),
+				CertificateID:     gcp.StringPtr("12345"),
+			}},
+			dm:   appengine.DomainMapping{SslSettings: &appengine.SslSettings{SslManagementType: SSLManagementTypeAutomatic, CertificateId: "67890"}},
+			want: true,
+		},
+		"ManualCertificateDiffers": {
+			p: v1alpha1.DomainMappingParameters{SSLSettings: &v1alpha1.SSLSettings{
+				SSLManagementType: gcp.StringPtr("MANUAL"),
+				CertificateID:     gcp.StringPtr("12345"),
+			}},
+			dm:   appengine.DomainMapping{SslSettings: &appengine.SslSettings{SslManagementType: "MANUAL", CertificateId: "67890"}},
+			want: false,
+		},
+		"ManagementTypeDiffers": {
+			p: v1alpha1.DomainMappingParameters{SSLSettings: &v1alpha1.SSLSettings{
+				SSLManagementType: gcp.StringPtr("MANUAL"),
+			}},
+			dm:   appengine.DomainMapping{SslSettings: &appengine.SslSettings{SslManagementType: SSLManagementTypeAutomatic}},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsDomainMappingUpToDate(tc.p, tc.dm)); diff != "" {
+				t.Errorf("IsDomainMappingUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appengine
+
+import (
+	"strconv"
+
+	appengine "google.golang.org/api/appengine/v1"
+
+	"github.com/crossplane/provider-gcp/apis/appengine/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// FirewallRuleUpdateMask is the set of FirewallRule fields that can be
+// updated in place.
+const FirewallRuleUpdateMask = "action,sourceRange,description"
+
+// GetFirewallRuleID returns the ID of the supplied firewall rule, which is
+// its priority.
+func GetFirewallRuleID(p v1alpha1.FirewallRuleParameters) string {
+	return strconv.FormatInt(p.Priority, 10)
+}
+
+// GenerateFirewallRule produces an App Engine FirewallRule that is configured
+// via the given FirewallRuleParameters.
+func GenerateFirewallRule(p v1alpha1.FirewallRuleParameters) *appengine.FirewallRule {
+	return &appengine.FirewallRule{
+		Priority:    p.Priority,
+		Action:      p.Action,
+		SourceRange: p.SourceRange,
+		Description: gcp.StringValue(p.Description),
+	}
+}
+
+// LateInitializeFirewallRule fills the empty fields of FirewallRuleParameters
+// with the values seen in the supplied FirewallRule.
+func LateInitializeFirewallRule(p *v1alpha1.FirewallRuleParameters, r appengine.FirewallRule) {
+	p.Description = gcp.LateInitializeString(p.Description, r.Description)
+}
+
+// IsFirewallRuleUpToDate returns true if the supplied FirewallRule matches the
+// supplied FirewallRuleParameters.
+func IsFirewallRuleUpToDate(p v1alpha1.FirewallRuleParameters, r appengine.FirewallRule) bool {
+	return p.Action == r.Action &&
+		p.SourceRange == r.SourceRange &&
+		gcp.StringValue(p.Description) == r.Description
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appengine
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	appengine "google.golang.org/api/appengine/v1"
+
+	"github.com/crossplane/provider-gcp/apis/appengine/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+func firewallRuleParams() v1alpha1.FirewallRuleParameters {
+	return v1alpha1.FirewallRuleParameters{
+		Priority:    100,
+		Action:      "ALLOW",
+		SourceRange: "192.0.2.0/24",
+		Description: gcp.StringPtr("office"),
+	}
+}
+
+func TestGetFirewallRuleID(t *testing.T) {
+	if diff := cmp.Diff("100", GetFirewallRuleID(firewallRuleParams())); diff != "" {
+		t.Errorf("GetFirewallRuleID(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateFirewallRule(t *testing.T) {
+	want := &appengine.FirewallRule{Priority: 100, Action: "ALLOW", SourceRange: "192.0.2.0/24", Description: "office"}
+	if diff := cmp.Diff(want, GenerateFirewallRule(firewallRuleParams())); diff != "" {
+		t.Errorf("GenerateFirewallRule(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeFirewallRule(t *testing.T) {
+	p := firewallRuleParams()
+	p.Description = nil
+	LateInitializeFirewallRule(&p, appengine.FirewallRule{Description: "office"})
+	if diff := cmp.Diff(firewallRuleParams(), p); diff != "" {
+		t.Errorf("LateInitializeFirewallRule(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsFirewallRuleUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		r    appengine.FirewallRule
+		want bool
+	}{
+		"UpToDate": {
+			r:    appengine.FirewallRule{Priority: 100, Action: "ALLOW", SourceRange: "192.0.2.0/24", Description: "office"},
+			want: true,
+		},
+		"ActionDiffers": {
+			r:    appengine.FirewallRule{Priority: 100, Action: "DENY", SourceRange: "192.0.2.0/24", Description: "office"},
+			want: false,
+		},
+		"SourceRangeDiffers": {
+			r:    appengine.FirewallRule{Priority: 100, Action: "ALLOW", SourceRange: "*", Description: "office"},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsFirewallRuleUpToDate(firewallRuleParams(), tc.r)); diff != "" {
+				t.Errorf("IsFirewallRuleUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
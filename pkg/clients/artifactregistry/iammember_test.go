@@ -0,0 +1,172 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package artifactregistry
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	artifactregistry "google.golang.org/api/artifactregistry/v1"
+
+	"github.com/crossplane/provider-gcp/apis/artifactregistry/v1alpha1"
+	iamv1alpha1 "github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	reader = "roles/artifactregistry.reader"
+	writer = "roles/artifactregistry.writer"
+)
+
+func memberParams() v1alpha1.RepositoryIAMMemberParameters {
+	return v1alpha1.RepositoryIAMMemberParameters{
+		Repository: gcp.StringPtr(repositoryName),
+		Role:       reader,
+		Member:     gcp.StringPtr("allUsers"),
+	}
+}
+
+func TestBindRoleToMember(t *testing.T) {
+	type want struct {
+		changed bool
+		policy  *artifactregistry.Policy
+	}
+	cases := map[string]struct {
+		policy *artifactregistry.Policy
+		want   want
+	}{
+		"EmptyPolicy": {
+			policy: &artifactregistry.Policy{},
+			want: want{
+				changed: true,
+				policy: &artifactregistry.Policy{
+					Version:  iamv1alpha1.PolicyVersion,
+					Bindings: []*artifactregistry.Binding{{Role: reader, Members: []string{"allUsers"}}},
+				},
+			},
+		},
+		"RoleExists": {
+			policy: &artifactregistry.Policy{
+				Bindings: []*artifactregistry.Binding{{Role: reader, Members: []string{"user:cool@example.com"}}},
+			},
+			want: want{
+				changed: true,
+				policy: &artifactregistry.Policy{
+					Version:  iamv1alpha1.PolicyVersion,
+					Bindings: []*artifactregistry.Binding{{Role: reader, Members: []string{"user:cool@example.com", "allUsers"}}},
+				},
+			},
+		},
+		"AlreadyBound": {
+			policy: &artifactregistry.Policy{
+				Bindings: []*artifactregistry.Binding{{Role: reader, Members: []string{"allUsers"}}},
+			},
+			want: want{
+				changed: false,
+				policy: &artifactregistry.Policy{
+					Version:  iamv1alpha1.PolicyVersion,
+					Bindings: []*artifactregistry.Binding{{Role: reader, Members: []string{"allUsers"}}},
+				},
+			},
+		},
+		"ConditionalBindingIgnored": {
+			policy: &artifactregistry.Policy{
+				Bindings: []*artifactregistry.Binding{{Role: reader, Members: []string{"allUsers"}, Condition: &artifactregistry.Expr{Expression: "true"}}},
+			},
+			want: want{
+				changed: true,
+				policy: &artifactregistry.Policy{
+					Version: iamv1alpha1.PolicyVersion,
+					Bindings: []*artifactregistry.Binding{
+						{Role: reader, Members: []string{"allUsers"}, Condition: &artifactregistry.Expr{Expression: "true"}},
+						{Role: reader, Members: []string{"allUsers"}},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			changed := BindRoleToMember(memberParams(), tc.policy)
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("BindRoleToMember(...): -want changed, +got changed:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.policy, tc.policy); diff != "" {
+				t.Errorf("BindRoleToMember(...): -want policy, +got policy:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUnbindRoleFromMember(t *testing.T) {
+	type want struct {
+		changed bool
+		policy  *artifactregistry.Policy
+	}
+	cases := map[string]struct {
+		policy *artifactregistry.Policy
+		want   want
+	}{
+		"NotBound": {
+			policy: &artifactregistry.Policy{
+				Bindings: []*artifactregistry.Binding{{Role: writer, Members: []string{"allUsers"}}},
+			},
+			want: want{
+				changed: false,
+				policy: &artifactregistry.Policy{
+					Bindings: []*artifactregistry.Binding{{Role: writer, Members: []string{"allUsers"}}},
+				},
+			},
+		},
+		"OtherMembersRemain": {
+			policy: &artifactregistry.Policy{
+				Bindings: []*artifactregistry.Binding{{Role: reader, Members: []string{"allUsers", "user:cool@example.com"}}},
+			},
+			want: want{
+				changed: true,
+				policy: &artifactregistry.Policy{
+					Bindings: []*artifactregistry.Binding{{Role: reader, Members: []string{"user:cool@example.com"}}},
+				},
+			},
+		},
+		"LastMemberRemovesBinding": {
+			policy: &artifactregistry.Policy{
+				Bindings: []*artifactregistry.Binding{
+					{Role: writer, Members: []string{"allUsers"}},
+					{Role: reader, Members: []string{"allUsers"}},
+				},
+			},
+			want: want{
+				changed: true,
+				policy: &artifactregistry.Policy{
+					Bindings: []*artifactregistry.Binding{{Role: writer, Members: []string{"allUsers"}}},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			changed := UnbindRoleFromMember(memberParams(), tc.policy)
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("UnbindRoleFromMember(...): -want changed, +got changed:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.policy, tc.policy); diff != "" {
+				t.Errorf("UnbindRoleFromMember(...): -want policy, +got policy:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package artifactregistry
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	artifactregistry "google.golang.org/api/artifactregistry/v1"
+
+	"github.com/crossplane/provider-gcp/apis/artifactregistry/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// RepositoryUpdateMask is the list of repository fields that can be updated
+// with a patch call. The format specific settings are added by
+// GetRepositoryUpdateMask when they are configured.
+const RepositoryUpdateMask = "description,labels,cleanupPolicies,cleanupPolicyDryRun"
+
+// ignoreForceSendFields ignores the ForceSendFields of all API types, which
+// are only set on the desired side of a comparison.
+var ignoreForceSendFields = cmp.FilterPath(func(p cmp.Path) bool {
+	return p.Last().String() == ".ForceSendFields"
+}, cmp.Ignore())
+
+// GetRepositoryParent returns the location of the supplied
+// RepositoryParameters in the form projects/{project}/locations/{location},
+// falling back to the supplied default project.
+func GetRepositoryParent(defaultProject string, p v1alpha1.RepositoryParameters) string {
+	project := defaultProject
+	if p.Project != nil {
+		project = *p.Project
+	}
+	return fmt.Sprintf("projects/%s/locations/%s", project, p.Location)
+}
+
+// GetRepositoryName builds the fully qualified name of the repository with
+// the supplied ID in the supplied parent.
+func GetRepositoryName(parent, id string) string {
+	return parent + "/repositories/" + id
+}
+
+// GetRepositoryUpdateMask returns the fields of a repository configured via
+// the supplied RepositoryParameters that are updated with a patch call.
+func GetRepositoryUpdateMask(p v1alpha1.RepositoryParameters) string {
+	mask := []string{RepositoryUpdateMask}
+	if p.DockerConfig != nil {
+		mask = append(mask, "dockerConfig")
+	}
+	if p.VirtualRepositoryConfig != nil {
+		mask = append(mask, "virtualRepositoryConfig")
+	}
+	return strings.Join(mask, ",")
+}
+
+// GenerateRepository produces a Repository that is configured via the
+// supplied RepositoryParameters.
+func GenerateRepository(p v1alpha1.RepositoryParameters) *artifactregistry.Repository {
+	r := &artifactregistry.Repository{
+		Format:              p.Format,
+		Mode:                gcp.StringValue(p.Mode),
+		Description:         gcp.StringValue(p.Description),
+		Labels:              p.Labels,
+		KmsKeyName:          gcp.StringValue(p.KMSKeyName),
+		CleanupPolicyDryRun: gcp.BoolValue(p.CleanupPolicyDryRun),
+	}
+	if p.CleanupPolicyDryRun != nil {
+		r.ForceSendFields = []string{"CleanupPolicyDryRun"}
+	}
+	if c := p.DockerConfig; c != nil {
+		r.DockerConfig = &artifactregistry.DockerRepositoryConfig{ImmutableTags: gcp.BoolValue(c.ImmutableTags)}
+		if c.ImmutableTags != nil {
+			r.DockerConfig.ForceSendFields = []string{"ImmutableTags"}
+		}
+	}
+	if c := p.MavenConfig; c != nil {
+		r.MavenConfig = &artifactregistry.MavenRepositoryConfig{
+			AllowSnapshotOverwrites: gcp.BoolValue(c.AllowSnapshotOverwrites),
+			VersionPolicy:           gcp.StringValue(c.VersionPolicy),
+		}
+	}
+	if c := p.RemoteRepositoryConfig; c != nil {
+		r.RemoteRepositoryConfig = generateRemoteRepositoryConfig(*c)
+	}
+	if c := p.VirtualRepositoryConfig; c != nil {
+		r.VirtualRepositoryConfig = &artifactregistry.VirtualRepositoryConfig{}
+		for _, u := range c.UpstreamPolicies {
+			r.VirtualRepositoryConfig.UpstreamPolicies = append(r.VirtualRepositoryConfig.UpstreamPolicies, &artifactregistry.UpstreamPolicy{
+				Id:         u.ID,
+				Repository: gcp.StringValue(u.Repository),
+				Priority:   gcp.Int64Value(u.Priority),
+			})
+		}
+	}
+	if len(p.CleanupPolicies) > 0 {
+		r.CleanupPolicies = make(map[string]artifactregistry.CleanupPolicy, len(p.CleanupPolicies))
+		for _, cp := range p.CleanupPolicies {
+			r.CleanupPolicies[cp.ID] = generateCleanupPolicy(cp)
+		}
+	}
+	return r
+}
+
+func generateRemoteRepositoryConfig(c v1alpha1.RemoteRepositoryConfig) *artifactregistry.RemoteRepositoryConfig {
+	rc := &artifactregistry.RemoteRepositoryConfig{Description: gcp.StringValue(c.Description)}
+	if c.DockerRepository != nil {
+		rc.DockerRepository = &artifactregistry.DockerRepository{PublicRepository: c.DockerRepository.PublicRepository}
+	}
+	if c.MavenRepository != nil {
+		rc.MavenRepository = &artifactregistry.MavenRepository{PublicRepository: c.MavenRepository.PublicRepository}
+	}
+	if c.NPMRepository != nil {
+		rc.NpmRepository = &artifactregistry.NpmRepository{PublicRepository: c.NPMRepository.PublicRepository}
+	}
+	if c.PythonRepository != nil {
+		rc.PythonRepository = &artifactregistry.PythonRepository{PublicRepository: c.PythonRepository.PublicRepository}
+	}
+	return rc
+}
+
+func generateCleanupPolicy(p v1alpha1.CleanupPolicy) artifactregistry.CleanupPolicy {
+	cp := artifactregistry.CleanupPolicy{Id: p.ID, Action: p.Action}
+	if c := p.Condition; c != nil {
+		cp.Condition = &artifactregistry.CleanupPolicyCondition{
+			TagState:            gcp.StringValue(c.TagState),
+			TagPrefixes:         c.TagPrefixes,
+			VersionNamePrefixes: c.VersionNamePrefixes,
+			PackageNamePrefixes: c.PackageNamePrefixes,
+			OlderThan:           gcp.StringValue(c.OlderThan),
+			NewerThan:           gcp.StringValue(c.NewerThan),
+		}
+	}
+	if v := p.MostRecentVersions; v != nil {
+		cp.MostRecentVersions = &artifactregistry.CleanupPolicyMostRecentVersions{
+			KeepCount:           gcp.Int64Value(v.KeepCount),
+			PackageNamePrefixes: v.PackageNamePrefixes,
+		}
+	}
+	return cp
+}
+
+// GenerateRepositoryObservation produces a RepositoryObservation from the
+// supplied Repository.
+func GenerateRepositoryObservation(r artifactregistry.Repository) v1alpha1.RepositoryObservation {
+	return v1alpha1.RepositoryObservation{
+		Name:       r.Name,
+		CreateTime: r.CreateTime,
+		UpdateTime: r.UpdateTime,
+		SizeBytes:  r.SizeBytes,
+	}
+}
+
+// LateInitializeRepository fills the empty fields of the supplied
+// RepositoryParameters with the values of the supplied Repository.
+func LateInitializeRepository(p *v1alpha1.RepositoryParameters, r artifactregistry.Repository) {
+	p.Mode = gcp.LateInitializeString(p.Mode, r.Mode)
+	p.Description = gcp.LateInitializeString(p.Description, r.Description)
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, r.Labels)
+	p.KMSKeyName = gcp.LateInitializeString(p.KMSKeyName, r.KmsKeyName)
+	p.CleanupPolicyDryRun = gcp.LateInitializeBool(p.CleanupPolicyDryRun, r.CleanupPolicyDryRun)
+}
+
+// IsRepositoryUpToDate returns true if the supplied Repository matches the
+// fields of the supplied RepositoryParameters that can be updated with a
+// patch call.
+func IsRepositoryUpToDate(p v1alpha1.RepositoryParameters, r artifactregistry.Repository) bool {
+	desired := GenerateRepository(p)
+	if p.VirtualRepositoryConfig == nil {
+		desired.VirtualRepositoryConfig = r.VirtualRepositoryConfig
+	}
+	// The API omits the Docker config when tags are mutable, so we compare
+	// the setting rather than the config.
+	if p.DockerConfig != nil && gcp.BoolValue(p.DockerConfig.ImmutableTags) != immutableTags(r) {
+		return false
+	}
+	return cmp.Equal(desired, &r, cmpopts.EquateEmpty(), ignoreForceSendFields,
+		cmpopts.IgnoreFields(artifactregistry.Repository{}, "Name", "Format", "Mode", "KmsKeyName", "DockerConfig",
+			"MavenConfig", "RemoteRepositoryConfig", "CreateTime", "UpdateTime", "SizeBytes", "SatisfiesPzs", "ServerResponse"))
+}
+
+func immutableTags(r artifactregistry.Repository) bool {
+	return r.DockerConfig != nil && r.DockerConfig.ImmutableTags
+}
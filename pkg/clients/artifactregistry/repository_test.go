@@ -0,0 +1,267 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package artifactregistry
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	artifactregistry "google.golang.org/api/artifactregistry/v1"
+
+	"github.com/crossplane/provider-gcp/apis/artifactregistry/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	project        = "cool-project"
+	repositoryName = "projects/cool-project/locations/us-central1/repositories/images"
+)
+
+func params(m ...func(*v1alpha1.RepositoryParameters)) *v1alpha1.RepositoryParameters {
+	p := &v1alpha1.RepositoryParameters{
+		Location:     "us-central1",
+		Format:       "DOCKER",
+		Mode:         gcp.StringPtr(v1alpha1.RepositoryModeStandard),
+		Description:  gcp.StringPtr("Container images"),
+		Labels:       map[string]string{"team": "platform"},
+		KMSKeyName:   gcp.StringPtr("projects/cool-project/locations/us-central1/keyRings/ring/cryptoKeys/key"),
+		DockerConfig: &v1alpha1.DockerRepositoryConfig{ImmutableTags: gcp.BoolPtr(true)},
+		CleanupPolicies: []v1alpha1.CleanupPolicy{
+			{
+				ID:     "delete-untagged",
+				Action: "DELETE",
+				Condition: &v1alpha1.CleanupPolicyCondition{
+					TagState:  gcp.StringPtr("UNTAGGED"),
+					OlderThan: gcp.StringPtr("2592000s"),
+				},
+			},
+			{
+				ID:                 "keep-recent",
+				Action:             "KEEP",
+				MostRecentVersions: &v1alpha1.CleanupPolicyMostRecentVersions{KeepCount: gcp.Int64Ptr(10)},
+			},
+		},
+		CleanupPolicyDryRun: gcp.BoolPtr(false),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func repository(m ...func(*artifactregistry.Repository)) *artifactregistry.Repository {
+	r := &artifactregistry.Repository{
+		Name:         repositoryName,
+		Format:       "DOCKER",
+		Mode:         v1alpha1.RepositoryModeStandard,
+		Description:  "Container images",
+		Labels:       map[string]string{"team": "platform"},
+		KmsKeyName:   "projects/cool-project/locations/us-central1/keyRings/ring/cryptoKeys/key",
+		DockerConfig: &artifactregistry.DockerRepositoryConfig{ImmutableTags: true},
+		CleanupPolicies: map[string]artifactregistry.CleanupPolicy{
+			"delete-untagged": {
+				Id:        "delete-untagged",
+				Action:    "DELETE",
+				Condition: &artifactregistry.CleanupPolicyCondition{TagState: "UNTAGGED", OlderThan: "2592000s"},
+			},
+			"keep-recent": {
+				Id:                 "keep-recent",
+				Action:             "KEEP",
+				MostRecentVersions: &artifactregistry.CleanupPolicyMostRecentVersions{KeepCount: 10},
+			},
+		},
+		CreateTime: "2021-06-01T00:00:00Z",
+		UpdateTime: "2021-06-02T00:00:00Z",
+		SizeBytes:  1024,
+	}
+	for _, f := range m {
+		f(r)
+	}
+	return r
+}
+
+func TestRepositoryNames(t *testing.T) {
+	parent := GetRepositoryParent(project, *params())
+	if diff := cmp.Diff("projects/cool-project/locations/us-central1", parent); diff != "" {
+		t.Errorf("GetRepositoryParent(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(repositoryName, GetRepositoryName(parent, "images")); diff != "" {
+		t.Errorf("GetRepositoryName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetRepositoryUpdateMask(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.RepositoryParameters
+		want string
+	}{
+		"Docker": {
+			p:    params(),
+			want: RepositoryUpdateMask + ",dockerConfig",
+		},
+		"Virtual": {
+			p: params(func(p *v1alpha1.RepositoryParameters) {
+				p.DockerConfig = nil
+				p.VirtualRepositoryConfig = &v1alpha1.VirtualRepositoryConfig{}
+			}),
+			want: RepositoryUpdateMask + ",virtualRepositoryConfig",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GetRepositoryUpdateMask(*tc.p)); diff != "" {
+				t.Errorf("GetRepositoryUpdateMask(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateRepository(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.RepositoryParameters
+		want *artifactregistry.Repository
+	}{
+		"Standard": {
+			p: params(),
+			want: repository(func(r *artifactregistry.Repository) {
+				r.Name = ""
+				r.CreateTime = ""
+				r.UpdateTime = ""
+				r.SizeBytes = 0
+				r.DockerConfig.ForceSendFields = []string{"ImmutableTags"}
+				r.ForceSendFields = []string{"CleanupPolicyDryRun"}
+			}),
+		},
+		"Remote": {
+			p: &v1alpha1.RepositoryParameters{
+				Location: "us-central1",
+				Format:   "PYTHON",
+				Mode:     gcp.StringPtr(v1alpha1.RepositoryModeRemote),
+				RemoteRepositoryConfig: &v1alpha1.RemoteRepositoryConfig{
+					Description:      gcp.StringPtr("PyPI mirror"),
+					PythonRepository: &v1alpha1.RemotePythonRepository{PublicRepository: "PYPI"},
+				},
+			},
+			want: &artifactregistry.Repository{
+				Format: "PYTHON",
+				Mode:   v1alpha1.RepositoryModeRemote,
+				RemoteRepositoryConfig: &artifactregistry.RemoteRepositoryConfig{
+					Description:      "PyPI mirror",
+					PythonRepository: &artifactregistry.PythonRepository{PublicRepository: "PYPI"},
+				},
+			},
+		},
+		"Virtual": {
+			p: &v1alpha1.RepositoryParameters{
+				Location: "us-central1",
+				Format:   "MAVEN",
+				Mode:     gcp.StringPtr(v1alpha1.RepositoryModeVirtual),
+				MavenConfig: &v1alpha1.MavenRepositoryConfig{
+					VersionPolicy: gcp.StringPtr("RELEASE"),
+				},
+				VirtualRepositoryConfig: &v1alpha1.VirtualRepositoryConfig{
+					UpstreamPolicies: []v1alpha1.UpstreamPolicy{{ID: "central", Repository: gcp.StringPtr(repositoryName), Priority: gcp.Int64Ptr(100)}},
+				},
+			},
+			want: &artifactregistry.Repository{
+				Format:      "MAVEN",
+				Mode:        v1alpha1.RepositoryModeVirtual,
+				MavenConfig: &artifactregistry.MavenRepositoryConfig{VersionPolicy: "RELEASE"},
+				VirtualRepositoryConfig: &artifactregistry.VirtualRepositoryConfig{
+					UpstreamPolicies: []*artifactregistry.UpstreamPolicy{{Id: "central", Repository: repositoryName, Priority: 100}},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateRepository(*tc.p)); diff != "" {
+				t.Errorf("GenerateRepository(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateRepositoryObservation(t *testing.T) {
+	want := v1alpha1.RepositoryObservation{
+		Name:       repositoryName,
+		CreateTime: "2021-06-01T00:00:00Z",
+		UpdateTime: "2021-06-02T00:00:00Z",
+		SizeBytes:  1024,
+	}
+	if diff := cmp.Diff(want, GenerateRepositoryObservation(*repository())); diff != "" {
+		t.Errorf("GenerateRepositoryObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeRepository(t *testing.T) {
+	got := params(func(p *v1alpha1.RepositoryParameters) {
+		p.Mode = nil
+		p.Description = nil
+		p.Labels = nil
+	})
+	LateInitializeRepository(got, *repository())
+	if diff := cmp.Diff(params(), got); diff != "" {
+		t.Errorf("LateInitializeRepository(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsRepositoryUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.RepositoryParameters
+		r    *artifactregistry.Repository
+		want bool
+	}{
+		"UpToDate": {
+			p:    params(),
+			r:    repository(),
+			want: true,
+		},
+		"MutableTagsOmitted": {
+			p: params(func(p *v1alpha1.RepositoryParameters) {
+				p.DockerConfig.ImmutableTags = gcp.BoolPtr(false)
+			}),
+			r:    repository(func(r *artifactregistry.Repository) { r.DockerConfig = nil }),
+			want: true,
+		},
+		"ImmutableTagsDiffer": {
+			p: params(),
+			r: repository(func(r *artifactregistry.Repository) { r.DockerConfig = nil }),
+		},
+		"LabelsDiffer": {
+			p: params(),
+			r: repository(func(r *artifactregistry.Repository) { r.Labels = map[string]string{"team": "data"} }),
+		},
+		"CleanupPolicyDiffers": {
+			p: params(func(p *v1alpha1.RepositoryParameters) {
+				p.CleanupPolicies[1].MostRecentVersions.KeepCount = gcp.Int64Ptr(5)
+			}),
+			r: repository(),
+		},
+		"DryRunDiffers": {
+			p: params(func(p *v1alpha1.RepositoryParameters) { p.CleanupPolicyDryRun = gcp.BoolPtr(true) }),
+			r: repository(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsRepositoryUpToDate(*tc.p, *tc.r); got != tc.want {
+				t.Errorf("IsRepositoryUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
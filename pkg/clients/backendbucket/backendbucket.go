@@ -0,0 +1,243 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backendbucket
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitchellh/copystructure"
+	compute "google.golang.org/api/compute/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	errCheckUpToDate = "unable to determine if external resource is up to date"
+	errGetSecret     = "cannot get signed URL key Secret"
+	errNoSecretKey   = "signed URL key Secret has no key %q"
+)
+
+// GenerateBackendBucket takes a *BackendBucketParameters and returns
+// *compute.BackendBucket. It assigns only the fields that are writable, i.e.
+// not labelled as [Output Only] in Google's reference.
+func GenerateBackendBucket(name string, in v1alpha1.BackendBucketParameters, bb *compute.BackendBucket) {
+	bb.Name = name
+	bb.BucketName = gcp.StringValue(in.BucketName)
+	bb.Description = gcp.StringValue(in.Description)
+	bb.EnableCdn = gcp.BoolValue(in.EnableCDN)
+	bb.CompressionMode = gcp.StringValue(in.CompressionMode)
+	bb.CustomResponseHeaders = in.CustomResponseHeaders
+	if in.EnableCDN != nil {
+		bb.ForceSendFields = []string{"EnableCdn"}
+	}
+	if in.CDNPolicy != nil {
+		bb.CdnPolicy = generateCDNPolicy(*in.CDNPolicy, bb.CdnPolicy)
+	}
+}
+
+func generateCDNPolicy(in v1alpha1.BackendBucketCDNPolicy, observed *compute.BackendBucketCdnPolicy) *compute.BackendBucketCdnPolicy {
+	p := &compute.BackendBucketCdnPolicy{
+		CacheMode:               gcp.StringValue(in.CacheMode),
+		ClientTtl:               gcp.Int64Value(in.ClientTTL),
+		DefaultTtl:              gcp.Int64Value(in.DefaultTTL),
+		MaxTtl:                  gcp.Int64Value(in.MaxTTL),
+		NegativeCaching:         gcp.BoolValue(in.NegativeCaching),
+		RequestCoalescing:       gcp.BoolValue(in.RequestCoalescing),
+		ServeWhileStale:         gcp.Int64Value(in.ServeWhileStale),
+		SignedUrlCacheMaxAgeSec: gcp.Int64Value(in.SignedURLCacheMaxAgeSec),
+	}
+	// The signed URL key names are managed through their own API calls and
+	// must not be dropped when the policy is regenerated.
+	if observed != nil {
+		p.SignedUrlKeyNames = observed.SignedUrlKeyNames
+	}
+	if in.NegativeCaching != nil {
+		p.ForceSendFields = append(p.ForceSendFields, "NegativeCaching")
+	}
+	if in.RequestCoalescing != nil {
+		p.ForceSendFields = append(p.ForceSendFields, "RequestCoalescing")
+	}
+	for _, ncp := range in.NegativeCachingPolicy {
+		p.NegativeCachingPolicy = append(p.NegativeCachingPolicy, &compute.BackendBucketCdnPolicyNegativeCachingPolicy{
+			Code: ncp.Code,
+			Ttl:  gcp.Int64Value(ncp.TTL),
+		})
+	}
+	if ckp := in.CacheKeyPolicy; ckp != nil {
+		p.CacheKeyPolicy = &compute.BackendBucketCdnPolicyCacheKeyPolicy{
+			IncludeHttpHeaders:   ckp.IncludeHTTPHeaders,
+			QueryStringWhitelist: ckp.QueryStringWhitelist,
+		}
+	}
+	return p
+}
+
+// GenerateBackendBucketObservation takes a compute.BackendBucket and returns
+// *BackendBucketObservation.
+func GenerateBackendBucketObservation(in compute.BackendBucket) v1alpha1.BackendBucketObservation {
+	o := v1alpha1.BackendBucketObservation{
+		CreationTimestamp: in.CreationTimestamp,
+		ID:                in.Id,
+		SelfLink:          in.SelfLink,
+	}
+	if in.CdnPolicy != nil {
+		o.SignedURLKeyNames = in.CdnPolicy.SignedUrlKeyNames
+	}
+	return o
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.BackendBucket object.
+func LateInitializeSpec(spec *v1alpha1.BackendBucketParameters, in compute.BackendBucket) {
+	spec.BucketName = gcp.LateInitializeString(spec.BucketName, in.BucketName)
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.EnableCDN = gcp.LateInitializeBool(spec.EnableCDN, in.EnableCdn)
+	spec.CompressionMode = gcp.LateInitializeString(spec.CompressionMode, in.CompressionMode)
+	spec.CustomResponseHeaders = gcp.LateInitializeStringSlice(spec.CustomResponseHeaders, in.CustomResponseHeaders)
+
+	if in.CdnPolicy == nil {
+		return
+	}
+	if spec.CDNPolicy == nil {
+		spec.CDNPolicy = &v1alpha1.BackendBucketCDNPolicy{}
+	}
+	p := spec.CDNPolicy
+	p.CacheMode = gcp.LateInitializeString(p.CacheMode, in.CdnPolicy.CacheMode)
+	p.ClientTTL = gcp.LateInitializeInt64(p.ClientTTL, in.CdnPolicy.ClientTtl)
+	p.DefaultTTL = gcp.LateInitializeInt64(p.DefaultTTL, in.CdnPolicy.DefaultTtl)
+	p.MaxTTL = gcp.LateInitializeInt64(p.MaxTTL, in.CdnPolicy.MaxTtl)
+	p.ServeWhileStale = gcp.LateInitializeInt64(p.ServeWhileStale, in.CdnPolicy.ServeWhileStale)
+	p.SignedURLCacheMaxAgeSec = gcp.LateInitializeInt64(p.SignedURLCacheMaxAgeSec, in.CdnPolicy.SignedUrlCacheMaxAgeSec)
+	p.NegativeCaching = gcp.LateInitializeBool(p.NegativeCaching, in.CdnPolicy.NegativeCaching)
+	p.RequestCoalescing = gcp.LateInitializeBool(p.RequestCoalescing, in.CdnPolicy.RequestCoalescing)
+	if len(p.NegativeCachingPolicy) == 0 {
+		for _, ncp := range in.CdnPolicy.NegativeCachingPolicy {
+			p.NegativeCachingPolicy = append(p.NegativeCachingPolicy, v1alpha1.NegativeCachingPolicy{
+				Code: ncp.Code,
+				TTL:  gcp.Int64Ptr(ncp.Ttl),
+			})
+		}
+	}
+	if p.CacheKeyPolicy == nil && in.CdnPolicy.CacheKeyPolicy != nil {
+		p.CacheKeyPolicy = &v1alpha1.BackendBucketCacheKeyPolicy{
+			IncludeHTTPHeaders:   in.CdnPolicy.CacheKeyPolicy.IncludeHttpHeaders,
+			QueryStringWhitelist: in.CdnPolicy.CacheKeyPolicy.QueryStringWhitelist,
+		}
+	}
+}
+
+// IsUpToDate checks whether current state is up-to-date compared to the given
+// set of parameters. Signed URL keys are not considered; see
+// AreSignedURLKeysUpToDate.
+func IsUpToDate(name string, in *v1alpha1.BackendBucketParameters, observed *compute.BackendBucket) (bool, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return true, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*compute.BackendBucket)
+	if !ok {
+		return true, errors.New(errCheckUpToDate)
+	}
+	GenerateBackendBucket(name, *in, desired)
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(compute.BackendBucket{}, "ForceSendFields"),
+		cmpopts.IgnoreFields(compute.BackendBucketCdnPolicy{}, "ForceSendFields"),
+	), nil
+}
+
+// Diff returns the paths of the fields of the observed BackendBucket that differ from
+// the given set of parameters, e.g. to explain why it is not up-to-date.
+func Diff(name string, in *v1alpha1.BackendBucketParameters, observed *compute.BackendBucket) ([]string, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return nil, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*compute.BackendBucket)
+	if !ok {
+		return nil, errors.New(errCheckUpToDate)
+	}
+	GenerateBackendBucket(name, *in, desired)
+	return gcp.Diff(desired, observed, cmpopts.EquateEmpty()), nil
+}
+
+// SignedURLKeysToAdd returns the desired signed URL keys that are not yet
+// configured on the supplied BackendBucket.
+func SignedURLKeysToAdd(in v1alpha1.BackendBucketParameters, observed compute.BackendBucket) []v1alpha1.SignedURLKey {
+	existing := map[string]bool{}
+	for _, n := range signedURLKeyNames(observed) {
+		existing[n] = true
+	}
+	var add []v1alpha1.SignedURLKey
+	for _, k := range in.SignedURLKeys {
+		if !existing[k.KeyName] {
+			add = append(add, k)
+		}
+	}
+	return add
+}
+
+// SignedURLKeysToDelete returns the names of the signed URL keys that are
+// configured on the supplied BackendBucket but no longer desired.
+func SignedURLKeysToDelete(in v1alpha1.BackendBucketParameters, observed compute.BackendBucket) []string {
+	desired := map[string]bool{}
+	for _, k := range in.SignedURLKeys {
+		desired[k.KeyName] = true
+	}
+	var del []string
+	for _, n := range signedURLKeyNames(observed) {
+		if !desired[n] {
+			del = append(del, n)
+		}
+	}
+	return del
+}
+
+// AreSignedURLKeysUpToDate returns true if the signed URL keys configured on
+// the supplied BackendBucket match the desired ones by name.
+func AreSignedURLKeysUpToDate(in v1alpha1.BackendBucketParameters, observed compute.BackendBucket) bool {
+	return len(SignedURLKeysToAdd(in, observed)) == 0 && len(SignedURLKeysToDelete(in, observed)) == 0
+}
+
+func signedURLKeyNames(bb compute.BackendBucket) []string {
+	if bb.CdnPolicy == nil {
+		return nil
+	}
+	return bb.CdnPolicy.SignedUrlKeyNames
+}
+
+// GenerateSignedURLKey reads the value of the supplied SignedURLKey from its
+// Secret and returns a *compute.SignedUrlKey.
+func GenerateSignedURLKey(ctx context.Context, kube client.Reader, k v1alpha1.SignedURLKey) (*compute.SignedUrlKey, error) {
+	ref := k.KeyValueSecretRef
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return nil, errors.Wrap(err, errGetSecret)
+	}
+	v, ok := s.Data[ref.Key]
+	if !ok {
+		return nil, errors.Errorf(errNoSecretKey, ref.Key)
+	}
+	return &compute.SignedUrlKey{KeyName: k.KeyName, KeyValue: string(v)}, nil
+}
@@ -0,0 +1,313 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backendbucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	compute "google.golang.org/api/compute/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	testName              = "some-name"
+	testBucket            = "some-bucket"
+	testCreationTimestamp = "10/10/2023"
+	testSelfLink          = "/link/to/self"
+)
+
+var errBoom = errors.New("boom")
+
+func key(name string) v1alpha1.SignedURLKey {
+	return v1alpha1.SignedURLKey{
+		KeyName: name,
+		KeyValueSecretRef: xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Name: "cdn-keys", Namespace: "crossplane-system"},
+			Key:             name,
+		},
+	}
+}
+
+func params(m ...func(*v1alpha1.BackendBucketParameters)) *v1alpha1.BackendBucketParameters {
+	o := &v1alpha1.BackendBucketParameters{
+		BucketName:  gcp.StringPtr(testBucket),
+		Description: gcp.StringPtr("some desc"),
+		EnableCDN:   gcp.BoolPtr(true),
+		CDNPolicy: &v1alpha1.BackendBucketCDNPolicy{
+			CacheMode:       gcp.StringPtr(v1alpha1.CacheModeCacheAllStatic),
+			DefaultTTL:      gcp.Int64Ptr(3600),
+			MaxTTL:          gcp.Int64Ptr(86400),
+			ClientTTL:       gcp.Int64Ptr(3600),
+			NegativeCaching: gcp.BoolPtr(true),
+			NegativeCachingPolicy: []v1alpha1.NegativeCachingPolicy{
+				{Code: 404, TTL: gcp.Int64Ptr(60)},
+			},
+		},
+		SignedURLKeys: []v1alpha1.SignedURLKey{key("key-1")},
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func backendBucket(m ...func(*compute.BackendBucket)) *compute.BackendBucket {
+	o := &compute.BackendBucket{
+		Name:        testName,
+		BucketName:  testBucket,
+		Description: "some desc",
+		EnableCdn:   true,
+		CdnPolicy: &compute.BackendBucketCdnPolicy{
+			CacheMode:       v1alpha1.CacheModeCacheAllStatic,
+			DefaultTtl:      3600,
+			MaxTtl:          86400,
+			ClientTtl:       3600,
+			NegativeCaching: true,
+			NegativeCachingPolicy: []*compute.BackendBucketCdnPolicyNegativeCachingPolicy{
+				{Code: 404, Ttl: 60},
+			},
+		},
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func withKeys(names ...string) func(*compute.BackendBucket) {
+	return func(bb *compute.BackendBucket) {
+		bb.CdnPolicy.SignedUrlKeyNames = names
+	}
+}
+
+func TestGenerateBackendBucket(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.BackendBucketParameters
+		want *compute.BackendBucket
+	}{
+		"Full": {
+			in:   *params(),
+			want: backendBucket(),
+		},
+		"NoCDNPolicy": {
+			in: *params(func(p *v1alpha1.BackendBucketParameters) {
+				p.EnableCDN = gcp.BoolPtr(false)
+				p.CDNPolicy = nil
+			}),
+			want: backendBucket(func(bb *compute.BackendBucket) {
+				bb.EnableCdn = false
+				bb.CdnPolicy = nil
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := &compute.BackendBucket{}
+			GenerateBackendBucket(testName, tc.in, got)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreFields(compute.BackendBucket{}, "ForceSendFields"), cmpopts.IgnoreFields(compute.BackendBucketCdnPolicy{}, "ForceSendFields")); diff != "" {
+				t.Errorf("GenerateBackendBucket(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateBackendBucketObservation(t *testing.T) {
+	bb := backendBucket(withKeys("key-1"), func(bb *compute.BackendBucket) {
+		bb.CreationTimestamp = testCreationTimestamp
+		bb.Id = 2029819203
+		bb.SelfLink = testSelfLink
+	})
+	want := v1alpha1.BackendBucketObservation{
+		CreationTimestamp: testCreationTimestamp,
+		ID:                2029819203,
+		SelfLink:          testSelfLink,
+		SignedURLKeyNames: []string{"key-1"},
+	}
+	if diff := cmp.Diff(want, GenerateBackendBucketObservation(*bb)); diff != "" {
+		t.Errorf("GenerateBackendBucketObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	cases := map[string]struct {
+		spec *v1alpha1.BackendBucketParameters
+		in   compute.BackendBucket
+		want *v1alpha1.BackendBucketParameters
+	}{
+		"AllFilledAlready": {
+			spec: params(),
+			in:   *backendBucket(),
+			want: params(),
+		},
+		"CDNPolicyEmpty": {
+			spec: params(func(p *v1alpha1.BackendBucketParameters) {
+				p.Description = nil
+				p.CDNPolicy = nil
+			}),
+			in:   *backendBucket(),
+			want: params(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.spec, tc.in)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in   *v1alpha1.BackendBucketParameters
+		bb   *compute.BackendBucket
+		want bool
+	}{
+		"UpToDate": {
+			in:   params(),
+			bb:   backendBucket(withKeys("key-1")),
+			want: true,
+		},
+		"UpToDateIgnoringKeys": {
+			in:   params(),
+			bb:   backendBucket(withKeys("key-2")),
+			want: true,
+		},
+		"CDNDisabled": {
+			in:   params(),
+			bb:   backendBucket(func(bb *compute.BackendBucket) { bb.EnableCdn = false }),
+			want: false,
+		},
+		"TTLDiffers": {
+			in:   params(),
+			bb:   backendBucket(func(bb *compute.BackendBucket) { bb.CdnPolicy.DefaultTtl = 60 }),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsUpToDate(testName, tc.in, tc.bb)
+			if err != nil {
+				t.Errorf("IsUpToDate(...): unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSignedURLKeys(t *testing.T) {
+	type want struct {
+		add      []v1alpha1.SignedURLKey
+		del      []string
+		upToDate bool
+	}
+	cases := map[string]struct {
+		in   v1alpha1.BackendBucketParameters
+		bb   compute.BackendBucket
+		want want
+	}{
+		"UpToDate": {
+			in:   *params(),
+			bb:   *backendBucket(withKeys("key-1")),
+			want: want{upToDate: true},
+		},
+		"NoCDNPolicy": {
+			in:   *params(),
+			bb:   *backendBucket(func(bb *compute.BackendBucket) { bb.CdnPolicy = nil }),
+			want: want{add: []v1alpha1.SignedURLKey{key("key-1")}},
+		},
+		"Rotated": {
+			in: *params(func(p *v1alpha1.BackendBucketParameters) {
+				p.SignedURLKeys = []v1alpha1.SignedURLKey{key("key-2")}
+			}),
+			bb: *backendBucket(withKeys("key-1")),
+			want: want{
+				add: []v1alpha1.SignedURLKey{key("key-2")},
+				del: []string{"key-1"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want.add, SignedURLKeysToAdd(tc.in, tc.bb)); diff != "" {
+				t.Errorf("SignedURLKeysToAdd(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.del, SignedURLKeysToDelete(tc.in, tc.bb)); diff != "" {
+				t.Errorf("SignedURLKeysToDelete(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, AreSignedURLKeysUpToDate(tc.in, tc.bb)); diff != "" {
+				t.Errorf("AreSignedURLKeysUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateSignedURLKey(t *testing.T) {
+	type want struct {
+		key *compute.SignedUrlKey
+		err error
+	}
+	cases := map[string]struct {
+		kube client.Reader
+		want want
+	}{
+		"Successful": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+				obj.(*corev1.Secret).Data = map[string][]byte{"key-1": []byte("c2VjcmV0")}
+				return nil
+			})},
+			want: want{key: &compute.SignedUrlKey{KeyName: "key-1", KeyValue: "c2VjcmV0"}},
+		},
+		"MissingKey": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+			want: want{err: errors.Errorf(errNoSecretKey, "key-1")},
+		},
+		"GetFailed": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			want: want{err: errors.Wrap(errBoom, errGetSecret)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GenerateSignedURLKey(context.Background(), tc.kube, key("key-1"))
+			if diff := cmp.Diff(tc.want.key, got); diff != "" {
+				t.Errorf("GenerateSignedURLKey(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GenerateSignedURLKey(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
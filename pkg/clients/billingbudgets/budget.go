@@ -0,0 +1,162 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package billingbudgets
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	billingbudgets "google.golang.org/api/billingbudgets/v1"
+
+	"github.com/crossplane/provider-gcp/apis/billingbudgets/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat     = "billingAccounts/%s"
+	budgetNameFormat = "billingAccounts/%s/budgets/%s"
+	topicNameFormat  = "projects/%s/topics/%s"
+
+	// schemaVersion is the only supported version of the notifications
+	// that are published to Pub/Sub topics.
+	schemaVersion = "1.0"
+
+	// BudgetUpdateMask is the list of budget fields that can be updated.
+	BudgetUpdateMask = "displayName,amount,budgetFilter,thresholdRules,notificationsRule"
+)
+
+// GetBudgetParent builds the fully qualified name of the parent of a budget.
+func GetBudgetParent(billingAccount string) string {
+	return fmt.Sprintf(parentFormat, billingAccount)
+}
+
+// GetBudgetName builds the fully qualified name of a budget.
+func GetBudgetName(billingAccount, id string) string {
+	return fmt.Sprintf(budgetNameFormat, billingAccount, id)
+}
+
+// GetBudgetID extracts the ID of a budget from its fully qualified name.
+func GetBudgetID(name string) string {
+	return name[strings.LastIndex(name, "/")+1:]
+}
+
+// GenerateBudget produces a Budget that is configured via the given
+// BudgetParameters. Pub/Sub topics are looked up in the supplied project.
+func GenerateBudget(project string, p v1alpha1.BudgetParameters) *billingbudgets.GoogleCloudBillingBudgetsV1Budget {
+	b := &billingbudgets.GoogleCloudBillingBudgetsV1Budget{
+		DisplayName: gcp.StringValue(p.DisplayName),
+		Amount:      &billingbudgets.GoogleCloudBillingBudgetsV1BudgetAmount{},
+	}
+	if m := p.Amount.SpecifiedAmount; m != nil {
+		b.Amount.SpecifiedAmount = &billingbudgets.GoogleTypeMoney{
+			CurrencyCode: gcp.StringValue(m.CurrencyCode),
+			Units:        m.Units,
+			Nanos:        gcp.Int64Value(m.Nanos),
+		}
+	}
+	if gcp.BoolValue(p.Amount.LastPeriodAmount) {
+		b.Amount.LastPeriodAmount = &billingbudgets.GoogleCloudBillingBudgetsV1LastPeriodAmount{}
+	}
+	if f := p.Filter; f != nil {
+		b.BudgetFilter = &billingbudgets.GoogleCloudBillingBudgetsV1Filter{
+			Projects:             f.Projects,
+			ResourceAncestors:    f.ResourceAncestors,
+			Services:             f.Services,
+			CreditTypesTreatment: gcp.StringValue(f.CreditTypesTreatment),
+			CreditTypes:          f.CreditTypes,
+			CalendarPeriod:       gcp.StringValue(f.CalendarPeriod),
+		}
+		if c := f.CustomPeriod; c != nil {
+			b.BudgetFilter.CustomPeriod = &billingbudgets.GoogleCloudBillingBudgetsV1CustomPeriod{
+				StartDate: generateDate(&c.StartDate),
+				EndDate:   generateDate(c.EndDate),
+			}
+		}
+	}
+	for _, r := range p.ThresholdRules {
+		b.ThresholdRules = append(b.ThresholdRules, &billingbudgets.GoogleCloudBillingBudgetsV1ThresholdRule{
+			ThresholdPercent: float64(r.ThresholdPercent) / 100,
+			SpendBasis:       gcp.StringValue(r.SpendBasis),
+		})
+	}
+	if n := p.NotificationsRule; n != nil {
+		b.NotificationsRule = &billingbudgets.GoogleCloudBillingBudgetsV1NotificationsRule{
+			MonitoringNotificationChannels: n.MonitoringNotificationChannels,
+			DisableDefaultIamRecipients:    gcp.BoolValue(n.DisableDefaultIAMRecipients),
+			EnableProjectLevelRecipients:   gcp.BoolValue(n.EnableProjectLevelRecipients),
+		}
+		if n.PubsubTopic != nil {
+			b.NotificationsRule.PubsubTopic = fmt.Sprintf(topicNameFormat, project, *n.PubsubTopic)
+			b.NotificationsRule.SchemaVersion = schemaVersion
+		}
+	}
+	return b
+}
+
+func generateDate(d *v1alpha1.Date) *billingbudgets.GoogleTypeDate {
+	if d == nil {
+		return nil
+	}
+	return &billingbudgets.GoogleTypeDate{Year: d.Year, Month: d.Month, Day: d.Day}
+}
+
+// GenerateBudgetObservation produces a BudgetObservation from the supplied
+// Budget.
+func GenerateBudgetObservation(b billingbudgets.GoogleCloudBillingBudgetsV1Budget) v1alpha1.BudgetObservation {
+	return v1alpha1.BudgetObservation{
+		Name: b.Name,
+		Etag: b.Etag,
+	}
+}
+
+// LateInitializeBudget fills the empty fields of BudgetParameters with the
+// values seen in the supplied Budget.
+func LateInitializeBudget(p *v1alpha1.BudgetParameters, b billingbudgets.GoogleCloudBillingBudgetsV1Budget) {
+	p.DisplayName = gcp.LateInitializeString(p.DisplayName, b.DisplayName)
+	if m := p.Amount.SpecifiedAmount; m != nil && b.Amount != nil && b.Amount.SpecifiedAmount != nil {
+		m.CurrencyCode = gcp.LateInitializeString(m.CurrencyCode, b.Amount.SpecifiedAmount.CurrencyCode)
+	}
+	if f := b.BudgetFilter; f != nil {
+		if p.Filter == nil {
+			p.Filter = &v1alpha1.BudgetFilter{}
+		}
+		p.Filter.CreditTypesTreatment = gcp.LateInitializeString(p.Filter.CreditTypesTreatment, f.CreditTypesTreatment)
+		if p.Filter.CustomPeriod == nil {
+			p.Filter.CalendarPeriod = gcp.LateInitializeString(p.Filter.CalendarPeriod, f.CalendarPeriod)
+		}
+	}
+	for i := range p.ThresholdRules {
+		if i < len(b.ThresholdRules) {
+			p.ThresholdRules[i].SpendBasis = gcp.LateInitializeString(p.ThresholdRules[i].SpendBasis, b.ThresholdRules[i].SpendBasis)
+		}
+	}
+}
+
+// IsBudgetUpToDate returns true if the supplied Budget matches the supplied
+// BudgetParameters.
+func IsBudgetUpToDate(project string, p v1alpha1.BudgetParameters, b billingbudgets.GoogleCloudBillingBudgetsV1Budget) bool {
+	observed := &billingbudgets.GoogleCloudBillingBudgetsV1Budget{
+		DisplayName:       b.DisplayName,
+		Amount:            b.Amount,
+		BudgetFilter:      b.BudgetFilter,
+		ThresholdRules:    b.ThresholdRules,
+		NotificationsRule: b.NotificationsRule,
+	}
+	return cmp.Equal(GenerateBudget(project, p), observed, cmpopts.EquateEmpty())
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package billingbudgets
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	billingbudgets "google.golang.org/api/billingbudgets/v1"
+
+	"github.com/crossplane/provider-gcp/apis/billingbudgets/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	project        = "cool-project"
+	billingAccount = "012345-567890-ABCDEF"
+	budgetName     = "billingAccounts/012345-567890-ABCDEF/budgets/cool-budget"
+)
+
+func params(m ...func(*v1alpha1.BudgetParameters)) *v1alpha1.BudgetParameters {
+	p := &v1alpha1.BudgetParameters{
+		BillingAccount: billingAccount,
+		DisplayName:    gcp.StringPtr("Cool budget"),
+		Amount: v1alpha1.BudgetAmount{
+			SpecifiedAmount: &v1alpha1.Money{CurrencyCode: gcp.StringPtr("EUR"), Units: 100},
+		},
+		Filter: &v1alpha1.BudgetFilter{
+			Projects:             []string{"projects/42"},
+			CreditTypesTreatment: gcp.StringPtr("INCLUDE_ALL_CREDITS"),
+			CalendarPeriod:       gcp.StringPtr("MONTH"),
+		},
+		ThresholdRules: []v1alpha1.ThresholdRule{
+			{ThresholdPercent: 50, SpendBasis: gcp.StringPtr("CURRENT_SPEND")},
+			{ThresholdPercent: 90, SpendBasis: gcp.StringPtr("FORECASTED_SPEND")},
+		},
+		NotificationsRule: &v1alpha1.NotificationsRule{
+			PubsubTopic:                 gcp.StringPtr("budget-alerts"),
+			DisableDefaultIAMRecipients: gcp.BoolPtr(true),
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func budget(m ...func(*billingbudgets.GoogleCloudBillingBudgetsV1Budget)) *billingbudgets.GoogleCloudBillingBudgetsV1Budget {
+	b := &billingbudgets.GoogleCloudBillingBudgetsV1Budget{
+		DisplayName: "Cool budget",
+		Amount: &billingbudgets.GoogleCloudBillingBudgetsV1BudgetAmount{
+			SpecifiedAmount: &billingbudgets.GoogleTypeMoney{CurrencyCode: "EUR", Units: 100},
+		},
+		BudgetFilter: &billingbudgets.GoogleCloudBillingBudgetsV1Filter{
+			Projects:             []string{"projects/42"},
+			CreditTypesTreatment: "INCLUDE_ALL_CREDITS",
+			CalendarPeriod:       "MONTH",
+		},
+		ThresholdRules: []*billingbudgets.GoogleCloudBillingBudgetsV1ThresholdRule{
+			{ThresholdPercent: 0.5, SpendBasis: "CURRENT_SPEND"},
+			{ThresholdPercent: 0.9, SpendBasis: "FORECASTED_SPEND"},
+		},
+		NotificationsRule: &billingbudgets.GoogleCloudBillingBudgetsV1NotificationsRule{
+			PubsubTopic:                 "projects/cool-project/topics/budget-alerts",
+			SchemaVersion:               "1.0",
+			DisableDefaultIamRecipients: true,
+		},
+	}
+	for _, f := range m {
+		f(b)
+	}
+	return b
+}
+
+func TestGetBudgetName(t *testing.T) {
+	if diff := cmp.Diff("billingAccounts/012345-567890-ABCDEF", GetBudgetParent(billingAccount)); diff != "" {
+		t.Errorf("GetBudgetParent(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(budgetName, GetBudgetName(billingAccount, "cool-budget")); diff != "" {
+		t.Errorf("GetBudgetName(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("cool-budget", GetBudgetID(budgetName)); diff != "" {
+		t.Errorf("GetBudgetID(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateBudget(t *testing.T) {
+	cases := map[string]struct {
+		params *v1alpha1.BudgetParameters
+		want   *billingbudgets.GoogleCloudBillingBudgetsV1Budget
+	}{
+		"Full": {
+			params: params(),
+			want:   budget(),
+		},
+		"LastPeriodAmountAndCustomPeriod": {
+			params: params(func(p *v1alpha1.BudgetParameters) {
+				p.Amount = v1alpha1.BudgetAmount{LastPeriodAmount: gcp.BoolPtr(true)}
+				p.Filter.CalendarPeriod = nil
+				p.Filter.CustomPeriod = &v1alpha1.CustomPeriod{
+					StartDate: v1alpha1.Date{Year: 2021, Month: 1, Day: 1},
+				}
+			}),
+			want: budget(func(b *billingbudgets.GoogleCloudBillingBudgetsV1Budget) {
+				b.Amount = &billingbudgets.GoogleCloudBillingBudgetsV1BudgetAmount{
+					LastPeriodAmount: &billingbudgets.GoogleCloudBillingBudgetsV1LastPeriodAmount{},
+				}
+				b.BudgetFilter.CalendarPeriod = ""
+				b.BudgetFilter.CustomPeriod = &billingbudgets.GoogleCloudBillingBudgetsV1CustomPeriod{
+					StartDate: &billingbudgets.GoogleTypeDate{Year: 2021, Month: 1, Day: 1},
+				}
+			}),
+		},
+		"Minimal": {
+			params: &v1alpha1.BudgetParameters{
+				BillingAccount: billingAccount,
+				Amount:         v1alpha1.BudgetAmount{SpecifiedAmount: &v1alpha1.Money{Units: 100}},
+			},
+			want: &billingbudgets.GoogleCloudBillingBudgetsV1Budget{
+				Amount: &billingbudgets.GoogleCloudBillingBudgetsV1BudgetAmount{
+					SpecifiedAmount: &billingbudgets.GoogleTypeMoney{Units: 100},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateBudget(project, *tc.params)); diff != "" {
+				t.Errorf("GenerateBudget(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateBudgetObservation(t *testing.T) {
+	b := *budget(func(b *billingbudgets.GoogleCloudBillingBudgetsV1Budget) {
+		b.Name = budgetName
+		b.Etag = "cool-etag"
+	})
+	want := v1alpha1.BudgetObservation{Name: budgetName, Etag: "cool-etag"}
+	if diff := cmp.Diff(want, GenerateBudgetObservation(b)); diff != "" {
+		t.Errorf("GenerateBudgetObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeBudget(t *testing.T) {
+	cases := map[string]struct {
+		params *v1alpha1.BudgetParameters
+		obs    *billingbudgets.GoogleCloudBillingBudgetsV1Budget
+		want   *v1alpha1.BudgetParameters
+	}{
+		"AllFilled": {
+			params: params(),
+			obs: budget(func(b *billingbudgets.GoogleCloudBillingBudgetsV1Budget) {
+				b.DisplayName = "Other budget"
+			}),
+			want: params(),
+		},
+		"AllEmpty": {
+			params: params(func(p *v1alpha1.BudgetParameters) {
+				p.DisplayName = nil
+				p.Amount.SpecifiedAmount.CurrencyCode = nil
+				p.Filter = nil
+				p.ThresholdRules[0].SpendBasis = nil
+				p.ThresholdRules[1].SpendBasis = nil
+			}),
+			obs: budget(),
+			want: params(func(p *v1alpha1.BudgetParameters) {
+				p.Filter.Projects = nil
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeBudget(tc.params, *tc.obs)
+			if diff := cmp.Diff(tc.want, tc.params); diff != "" {
+				t.Errorf("LateInitializeBudget(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsBudgetUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		params *v1alpha1.BudgetParameters
+		obs    *billingbudgets.GoogleCloudBillingBudgetsV1Budget
+		want   bool
+	}{
+		"UpToDate": {
+			params: params(),
+			obs: budget(func(b *billingbudgets.GoogleCloudBillingBudgetsV1Budget) {
+				b.Name = budgetName
+				b.Etag = "cool-etag"
+			}),
+			want: true,
+		},
+		"AmountDiffers": {
+			params: params(),
+			obs: budget(func(b *billingbudgets.GoogleCloudBillingBudgetsV1Budget) {
+				b.Amount.SpecifiedAmount.Units = 200
+			}),
+			want: false,
+		},
+		"ThresholdDiffers": {
+			params: params(),
+			obs: budget(func(b *billingbudgets.GoogleCloudBillingBudgetsV1Budget) {
+				b.ThresholdRules[1].ThresholdPercent = 1
+			}),
+			want: false,
+		},
+		"TopicDiffers": {
+			params: params(),
+			obs: budget(func(b *billingbudgets.GoogleCloudBillingBudgetsV1Budget) {
+				b.NotificationsRule.PubsubTopic = ""
+				b.NotificationsRule.SchemaVersion = ""
+			}),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsBudgetUpToDate(project, *tc.params, *tc.obs)); diff != "" {
+				t.Errorf("IsBudgetUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
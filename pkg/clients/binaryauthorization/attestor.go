@@ -0,0 +1,112 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binaryauthorization
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	binaryauthorization "google.golang.org/api/binaryauthorization/v1"
+
+	"github.com/crossplane/provider-gcp/apis/binaryauthorization/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const parentFormat = "projects/%s"
+
+// GetAttestorParent returns the project of the supplied AttestorParameters in
+// the form projects/{project}, falling back to the supplied default project.
+func GetAttestorParent(defaultProject string, p v1alpha1.AttestorParameters) string {
+	project := defaultProject
+	if p.Project != nil {
+		project = *p.Project
+	}
+	return fmt.Sprintf(parentFormat, project)
+}
+
+// GetAttestorName builds the fully qualified name of the attestor with the
+// supplied ID in the supplied parent.
+func GetAttestorName(parent, id string) string {
+	return parent + "/attestors/" + id
+}
+
+// GenerateAttestor produces an Attestor that is configured via the supplied
+// AttestorParameters.
+func GenerateAttestor(p v1alpha1.AttestorParameters) *binaryauthorization.Attestor {
+	n := &binaryauthorization.UserOwnedGrafeasNote{
+		NoteReference: p.UserOwnedGrafeasNote.NoteReference,
+	}
+	for _, k := range p.UserOwnedGrafeasNote.PublicKeys {
+		pk := &binaryauthorization.AttestorPublicKey{
+			Id:                       gcp.StringValue(k.ID),
+			Comment:                  gcp.StringValue(k.Comment),
+			AsciiArmoredPgpPublicKey: gcp.StringValue(k.ASCIIArmoredPGPPublicKey),
+		}
+		if x := k.PKIXPublicKey; x != nil {
+			pk.PkixPublicKey = &binaryauthorization.PkixPublicKey{
+				PublicKeyPem:       x.PublicKeyPEM,
+				SignatureAlgorithm: x.SignatureAlgorithm,
+			}
+		}
+		n.PublicKeys = append(n.PublicKeys, pk)
+	}
+	return &binaryauthorization.Attestor{
+		Description:          gcp.StringValue(p.Description),
+		UserOwnedGrafeasNote: n,
+	}
+}
+
+// GenerateAttestorObservation produces an AttestorObservation from the
+// supplied Attestor.
+func GenerateAttestorObservation(a binaryauthorization.Attestor) v1alpha1.AttestorObservation {
+	o := v1alpha1.AttestorObservation{
+		Name:       a.Name,
+		UpdateTime: a.UpdateTime,
+	}
+	if a.UserOwnedGrafeasNote != nil {
+		o.DelegationServiceAccountEmail = a.UserOwnedGrafeasNote.DelegationServiceAccountEmail
+	}
+	return o
+}
+
+// LateInitializeAttestor fills the empty fields of the supplied
+// AttestorParameters with the values seen in the supplied Attestor. Public
+// keys are matched by position.
+func LateInitializeAttestor(p *v1alpha1.AttestorParameters, a binaryauthorization.Attestor) {
+	p.Description = gcp.LateInitializeString(p.Description, a.Description)
+	if a.UserOwnedGrafeasNote == nil {
+		return
+	}
+	observed := a.UserOwnedGrafeasNote.PublicKeys
+	for i := range p.UserOwnedGrafeasNote.PublicKeys {
+		if i >= len(observed) || observed[i] == nil {
+			break
+		}
+		k := &p.UserOwnedGrafeasNote.PublicKeys[i]
+		k.ID = gcp.LateInitializeString(k.ID, observed[i].Id)
+	}
+}
+
+// IsAttestorUpToDate returns true if the supplied Attestor matches the
+// supplied AttestorParameters.
+func IsAttestorUpToDate(p v1alpha1.AttestorParameters, a binaryauthorization.Attestor) bool {
+	desired := GenerateAttestor(p)
+	return cmp.Equal(desired, &a, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(binaryauthorization.Attestor{}, "Name", "Etag", "UpdateTime", "ServerResponse"),
+		cmpopts.IgnoreFields(binaryauthorization.UserOwnedGrafeasNote{}, "DelegationServiceAccountEmail"))
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binaryauthorization
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	binaryauthorization "google.golang.org/api/binaryauthorization/v1"
+
+	"github.com/crossplane/provider-gcp/apis/binaryauthorization/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	note   = "projects/cool-project/notes/built-by-cloud-build"
+	pem    = "-----BEGIN PUBLIC KEY-----\nMFkw\n-----END PUBLIC KEY-----\n"
+	keyID  = "//cloudkms.googleapis.com/v1/projects/cool-project/locations/global/keyRings/attestors/cryptoKeys/build/cryptoKeyVersions/1"
+	sa     = "service-123@gcp-sa-binaryauthorization.iam.gserviceaccount.com"
+	update = "2021-06-01T00:00:00Z"
+)
+
+func attestorParams(m ...func(*v1alpha1.AttestorParameters)) *v1alpha1.AttestorParameters {
+	p := &v1alpha1.AttestorParameters{
+		Description: gcp.StringPtr("Images built by Cloud Build"),
+		UserOwnedGrafeasNote: v1alpha1.UserOwnedGrafeasNote{
+			NoteReference: note,
+			PublicKeys: []v1alpha1.AttestorPublicKey{{
+				ID:      gcp.StringPtr(keyID),
+				Comment: gcp.StringPtr("Cloud KMS signing key"),
+				PKIXPublicKey: &v1alpha1.PKIXPublicKey{
+					PublicKeyPEM:       pem,
+					SignatureAlgorithm: "ECDSA_P256_SHA256",
+				},
+			}},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func attestorObj(m ...func(*binaryauthorization.Attestor)) *binaryauthorization.Attestor {
+	a := &binaryauthorization.Attestor{
+		Name:        attestor,
+		UpdateTime:  update,
+		Etag:        "abc",
+		Description: "Images built by Cloud Build",
+		UserOwnedGrafeasNote: &binaryauthorization.UserOwnedGrafeasNote{
+			NoteReference:                 note,
+			DelegationServiceAccountEmail: sa,
+			PublicKeys: []*binaryauthorization.AttestorPublicKey{{
+				Id:      keyID,
+				Comment: "Cloud KMS signing key",
+				PkixPublicKey: &binaryauthorization.PkixPublicKey{
+					PublicKeyPem:       pem,
+					SignatureAlgorithm: "ECDSA_P256_SHA256",
+				},
+			}},
+		},
+	}
+	for _, f := range m {
+		f(a)
+	}
+	return a
+}
+
+func TestAttestorNames(t *testing.T) {
+	parent := GetAttestorParent(project, *attestorParams())
+	if diff := cmp.Diff(attestor, GetAttestorName(parent, "built-by-cloud-build")); diff != "" {
+		t.Errorf("GetAttestorName(...): -want, +got:\n%s", diff)
+	}
+	parent = GetAttestorParent(project, *attestorParams(func(p *v1alpha1.AttestorParameters) { p.Project = gcp.StringPtr("other-project") }))
+	if diff := cmp.Diff("projects/other-project", parent); diff != "" {
+		t.Errorf("GetAttestorParent(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateAttestor(t *testing.T) {
+	want := attestorObj(func(a *binaryauthorization.Attestor) {
+		a.Name = ""
+		a.UpdateTime = ""
+		a.Etag = ""
+		a.UserOwnedGrafeasNote.DelegationServiceAccountEmail = ""
+	})
+	if diff := cmp.Diff(want, GenerateAttestor(*attestorParams())); diff != "" {
+		t.Errorf("GenerateAttestor(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateAttestorObservation(t *testing.T) {
+	want := v1alpha1.AttestorObservation{
+		Name:                          attestor,
+		UpdateTime:                    update,
+		DelegationServiceAccountEmail: sa,
+	}
+	if diff := cmp.Diff(want, GenerateAttestorObservation(*attestorObj())); diff != "" {
+		t.Errorf("GenerateAttestorObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeAttestor(t *testing.T) {
+	got := attestorParams(func(p *v1alpha1.AttestorParameters) {
+		p.Description = nil
+		p.UserOwnedGrafeasNote.PublicKeys[0].ID = nil
+	})
+	LateInitializeAttestor(got, *attestorObj())
+	if diff := cmp.Diff(attestorParams(), got); diff != "" {
+		t.Errorf("LateInitializeAttestor(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsAttestorUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.AttestorParameters
+		want bool
+	}{
+		"UpToDate": {
+			p:    attestorParams(),
+			want: true,
+		},
+		"DescriptionChanged": {
+			p: attestorParams(func(p *v1alpha1.AttestorParameters) { p.Description = gcp.StringPtr("Images built anywhere") }),
+		},
+		"KeyAdded": {
+			p: attestorParams(func(p *v1alpha1.AttestorParameters) {
+				p.UserOwnedGrafeasNote.PublicKeys = append(p.UserOwnedGrafeasNote.PublicKeys, v1alpha1.AttestorPublicKey{
+					ASCIIArmoredPGPPublicKey: gcp.StringPtr("-----BEGIN PGP PUBLIC KEY BLOCK-----"),
+				})
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsAttestorUpToDate(*tc.p, *attestorObj()); got != tc.want {
+				t.Errorf("IsAttestorUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binaryauthorization
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	binaryauthorization "google.golang.org/api/binaryauthorization/v1"
+
+	"github.com/crossplane/provider-gcp/apis/binaryauthorization/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const policyNameFormat = "projects/%s/policy"
+
+// Modes of an admission rule.
+const (
+	EvaluationModeAlwaysAllow            = "ALWAYS_ALLOW"
+	EnforcementModeEnforcedBlockAndAudit = "ENFORCED_BLOCK_AND_AUDIT_LOG"
+)
+
+// GetPolicyName returns the name of the policy of the project of the supplied
+// PolicyParameters in the form projects/{project}/policy, falling back to the
+// supplied default project.
+func GetPolicyName(defaultProject string, p v1alpha1.PolicyParameters) string {
+	project := defaultProject
+	if p.Project != nil {
+		project = *p.Project
+	}
+	return fmt.Sprintf(policyNameFormat, project)
+}
+
+// GeneratePolicy produces a Policy that is configured via the supplied
+// PolicyParameters.
+func GeneratePolicy(p v1alpha1.PolicyParameters) *binaryauthorization.Policy {
+	bp := &binaryauthorization.Policy{
+		Description:                gcp.StringValue(p.Description),
+		GlobalPolicyEvaluationMode: gcp.StringValue(p.GlobalPolicyEvaluationMode),
+		DefaultAdmissionRule:       generateAdmissionRule(p.DefaultAdmissionRule),
+	}
+	for _, w := range p.AdmissionWhitelistPatterns {
+		bp.AdmissionWhitelistPatterns = append(bp.AdmissionWhitelistPatterns, &binaryauthorization.AdmissionWhitelistPattern{NamePattern: w.NamePattern})
+	}
+	if len(p.ClusterAdmissionRules) > 0 {
+		bp.ClusterAdmissionRules = make(map[string]binaryauthorization.AdmissionRule, len(p.ClusterAdmissionRules))
+		for _, r := range p.ClusterAdmissionRules {
+			bp.ClusterAdmissionRules[r.Cluster] = *generateAdmissionRule(r.AdmissionRule)
+		}
+	}
+	return bp
+}
+
+func generateAdmissionRule(r v1alpha1.AdmissionRule) *binaryauthorization.AdmissionRule {
+	return &binaryauthorization.AdmissionRule{
+		EvaluationMode:        r.EvaluationMode,
+		EnforcementMode:       r.EnforcementMode,
+		RequireAttestationsBy: r.RequireAttestationsBy,
+	}
+}
+
+// GenerateDefaultPolicy produces the policy a project starts out with, which
+// allows all images to be deployed.
+func GenerateDefaultPolicy() *binaryauthorization.Policy {
+	return &binaryauthorization.Policy{
+		GlobalPolicyEvaluationMode: "ENABLE",
+		DefaultAdmissionRule: &binaryauthorization.AdmissionRule{
+			EvaluationMode:  EvaluationModeAlwaysAllow,
+			EnforcementMode: EnforcementModeEnforcedBlockAndAudit,
+		},
+	}
+}
+
+// IsDefaultPolicy returns true if the supplied Policy allows all images to
+// be deployed, i.e. it is the policy a project starts out with.
+func IsDefaultPolicy(p binaryauthorization.Policy) bool {
+	return p.DefaultAdmissionRule != nil &&
+		p.DefaultAdmissionRule.EvaluationMode == EvaluationModeAlwaysAllow &&
+		len(p.ClusterAdmissionRules) == 0 &&
+		len(p.AdmissionWhitelistPatterns) == 0
+}
+
+// GeneratePolicyObservation produces a PolicyObservation from the supplied
+// Policy.
+func GeneratePolicyObservation(p binaryauthorization.Policy) v1alpha1.PolicyObservation {
+	return v1alpha1.PolicyObservation{
+		Name:       p.Name,
+		UpdateTime: p.UpdateTime,
+	}
+}
+
+// LateInitializePolicy fills the empty fields of the supplied
+// PolicyParameters with the values seen in the supplied Policy.
+func LateInitializePolicy(p *v1alpha1.PolicyParameters, bp binaryauthorization.Policy) {
+	p.Description = gcp.LateInitializeString(p.Description, bp.Description)
+	p.GlobalPolicyEvaluationMode = gcp.LateInitializeString(p.GlobalPolicyEvaluationMode, bp.GlobalPolicyEvaluationMode)
+}
+
+// IsPolicyUpToDate returns true if the supplied Policy matches the supplied
+// PolicyParameters.
+func IsPolicyUpToDate(p v1alpha1.PolicyParameters, bp binaryauthorization.Policy) bool {
+	return cmp.Equal(GeneratePolicy(p), &bp, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(binaryauthorization.Policy{}, "Name", "Etag", "UpdateTime", "ServerResponse"))
+}
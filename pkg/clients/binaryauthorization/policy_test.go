@@ -0,0 +1,183 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binaryauthorization
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	binaryauthorization "google.golang.org/api/binaryauthorization/v1"
+
+	"github.com/crossplane/provider-gcp/apis/binaryauthorization/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	project    = "cool-project"
+	policyName = "projects/cool-project/policy"
+	attestor   = "projects/cool-project/attestors/built-by-cloud-build"
+)
+
+func policyParams(m ...func(*v1alpha1.PolicyParameters)) *v1alpha1.PolicyParameters {
+	p := &v1alpha1.PolicyParameters{
+		Description:                gcp.StringPtr("Only signed images in prod"),
+		GlobalPolicyEvaluationMode: gcp.StringPtr("ENABLE"),
+		AdmissionWhitelistPatterns: []v1alpha1.AdmissionWhitelistPattern{{NamePattern: "gcr.io/cool-project/tools/*"}},
+		DefaultAdmissionRule: v1alpha1.AdmissionRule{
+			EvaluationMode:  "ALWAYS_DENY",
+			EnforcementMode: "ENFORCED_BLOCK_AND_AUDIT_LOG",
+		},
+		ClusterAdmissionRules: []v1alpha1.ClusterAdmissionRule{{
+			Cluster: "us-central1.prod",
+			AdmissionRule: v1alpha1.AdmissionRule{
+				EvaluationMode:        "REQUIRE_ATTESTATION",
+				EnforcementMode:       "ENFORCED_BLOCK_AND_AUDIT_LOG",
+				RequireAttestationsBy: []string{attestor},
+			},
+		}},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func policy(m ...func(*binaryauthorization.Policy)) *binaryauthorization.Policy {
+	p := &binaryauthorization.Policy{
+		Name:                       policyName,
+		UpdateTime:                 "2021-06-01T00:00:00Z",
+		Etag:                       "abc",
+		Description:                "Only signed images in prod",
+		GlobalPolicyEvaluationMode: "ENABLE",
+		AdmissionWhitelistPatterns: []*binaryauthorization.AdmissionWhitelistPattern{{NamePattern: "gcr.io/cool-project/tools/*"}},
+		DefaultAdmissionRule: &binaryauthorization.AdmissionRule{
+			EvaluationMode:  "ALWAYS_DENY",
+			EnforcementMode: "ENFORCED_BLOCK_AND_AUDIT_LOG",
+		},
+		ClusterAdmissionRules: map[string]binaryauthorization.AdmissionRule{
+			"us-central1.prod": {
+				EvaluationMode:        "REQUIRE_ATTESTATION",
+				EnforcementMode:       "ENFORCED_BLOCK_AND_AUDIT_LOG",
+				RequireAttestationsBy: []string{attestor},
+			},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func TestGetPolicyName(t *testing.T) {
+	if diff := cmp.Diff(policyName, GetPolicyName(project, *policyParams())); diff != "" {
+		t.Errorf("GetPolicyName(...): -want, +got:\n%s", diff)
+	}
+	got := GetPolicyName(project, *policyParams(func(p *v1alpha1.PolicyParameters) { p.Project = gcp.StringPtr("other-project") }))
+	if diff := cmp.Diff("projects/other-project/policy", got); diff != "" {
+		t.Errorf("GetPolicyName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGeneratePolicy(t *testing.T) {
+	want := policy(func(p *binaryauthorization.Policy) {
+		p.Name = ""
+		p.UpdateTime = ""
+		p.Etag = ""
+	})
+	if diff := cmp.Diff(want, GeneratePolicy(*policyParams())); diff != "" {
+		t.Errorf("GeneratePolicy(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsDefaultPolicy(t *testing.T) {
+	cases := map[string]struct {
+		p    *binaryauthorization.Policy
+		want bool
+	}{
+		"Default": {
+			p:    GenerateDefaultPolicy(),
+			want: true,
+		},
+		"Custom": {
+			p: policy(),
+		},
+		"AllowAllExceptCluster": {
+			p: policy(func(p *binaryauthorization.Policy) {
+				p.AdmissionWhitelistPatterns = nil
+				p.DefaultAdmissionRule.EvaluationMode = EvaluationModeAlwaysAllow
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsDefaultPolicy(*tc.p); got != tc.want {
+				t.Errorf("IsDefaultPolicy(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestGeneratePolicyObservation(t *testing.T) {
+	want := v1alpha1.PolicyObservation{
+		Name:       policyName,
+		UpdateTime: "2021-06-01T00:00:00Z",
+	}
+	if diff := cmp.Diff(want, GeneratePolicyObservation(*policy())); diff != "" {
+		t.Errorf("GeneratePolicyObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializePolicy(t *testing.T) {
+	got := policyParams(func(p *v1alpha1.PolicyParameters) {
+		p.Description = nil
+		p.GlobalPolicyEvaluationMode = nil
+	})
+	LateInitializePolicy(got, *policy())
+	if diff := cmp.Diff(policyParams(), got); diff != "" {
+		t.Errorf("LateInitializePolicy(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsPolicyUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.PolicyParameters
+		want bool
+	}{
+		"UpToDate": {
+			p:    policyParams(),
+			want: true,
+		},
+		"DefaultRuleChanged": {
+			p: policyParams(func(p *v1alpha1.PolicyParameters) { p.DefaultAdmissionRule.EnforcementMode = "DRYRUN_AUDIT_LOG_ONLY" }),
+		},
+		"ClusterRuleRemoved": {
+			p: policyParams(func(p *v1alpha1.PolicyParameters) { p.ClusterAdmissionRules = nil }),
+		},
+		"AttestorAdded": {
+			p: policyParams(func(p *v1alpha1.PolicyParameters) {
+				p.ClusterAdmissionRules[0].RequireAttestationsBy = append(p.ClusterAdmissionRules[0].RequireAttestationsBy, "projects/cool-project/attestors/qa")
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsPolicyUpToDate(*tc.p, *policy()); got != tc.want {
+				t.Errorf("IsPolicyUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
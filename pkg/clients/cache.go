@@ -0,0 +1,168 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane/provider-gcp/apis/v1beta1"
+)
+
+const errGetReferencedSecret = "cannot get secret referenced by provider config"
+
+// providerConfigClients caches the client options built from ProviderConfigs.
+// Their HTTP clients cache access tokens, so reusing them saves us from reading
+// the credentials and exchanging them for a token every time a managed
+// resource is reconciled.
+var providerConfigClients = &clientCache{entries: map[types.UID]*cachedClient{}}
+
+type cachedClient struct {
+	mu        sync.Mutex
+	version   string
+	projectID string
+	opts      ClientOptions
+}
+
+// A clientCache keeps the client options built from a ProviderConfig until
+// the ProviderConfig or any of the Secrets it references change or are
+// deleted.
+type clientCache struct {
+	mu      sync.Mutex
+	entries map[types.UID]*cachedClient
+}
+
+// get returns the cached project ID and client options of the supplied
+// ProviderConfig, calling build to create them if they weren't cached yet or
+// are stale. Entries are keyed by the UID of the ProviderConfig, and are stale
+// once its generation or the resource version of a referenced Secret changed.
+// Concurrent callers wait for a single call of build for the same
+// ProviderConfig, but not for builds of other ProviderConfigs.
+func (cc *clientCache) get(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, build func() (string, ClientOptions, error)) (string, ClientOptions, error) {
+	version, err := providerConfigVersion(ctx, c, pc)
+	if err != nil {
+		// A referenced Secret may have been deleted, so the client options
+		// may not be used anymore.
+		cc.evict(pc.GetUID())
+		return "", ClientOptions{}, err
+	}
+
+	cc.mu.Lock()
+	e, ok := cc.entries[pc.GetUID()]
+	if !ok {
+		e = &cachedClient{}
+		cc.entries[pc.GetUID()] = e
+	}
+	cc.mu.Unlock()
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.version == version {
+		return e.projectID, e.opts, nil
+	}
+	projectID, opts, err := build()
+	if err != nil {
+		if e.version == "" {
+			cc.forget(pc.GetUID(), e)
+		}
+		return "", ClientOptions{}, err
+	}
+	e.version, e.projectID, e.opts = version, projectID, opts
+	return projectID, opts, nil
+}
+
+// evict the client options built from the ProviderConfig with the supplied
+// UID.
+func (cc *clientCache) evict(uid types.UID) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	delete(cc.entries, uid)
+}
+
+// forget drops the supplied entry, unless it was replaced already.
+func (cc *clientCache) forget(uid types.UID, e *cachedClient) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	if cc.entries[uid] == e {
+		delete(cc.entries, uid)
+	}
+}
+
+// providerConfigVersion returns a string that changes whenever the supplied
+// ProviderConfig, or any of the Secrets it references, changes.
+func providerConfigVersion(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig) (string, error) {
+	refs := []*xpv1.SecretKeySelector{pc.Spec.CABundleSecretRef}
+	switch pc.Spec.Credentials.Source {
+	case xpv1.CredentialsSourceSecret, v1beta1.CredentialsSourceAccessToken:
+		refs = append(refs, pc.Spec.Credentials.SecretRef)
+	}
+	if p := pc.Spec.Proxy; p != nil {
+		refs = append(refs, p.CredentialsSecretRef)
+	}
+	v := []string{strconv.FormatInt(pc.GetGeneration(), 10)}
+	for _, ref := range refs {
+		if ref == nil {
+			continue
+		}
+		// Watch the Secret even if it doesn't exist yet, so that managed
+		// resources recover once it's created.
+		referencedSecrets.add(ref)
+		s := &v1.Secret{}
+		if err := c.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+			return "", errors.Wrap(err, errGetReferencedSecret)
+		}
+		v = append(v, s.GetResourceVersion())
+	}
+	return strings.Join(v, "/"), nil
+}
+
+// EvictProviderConfigClients returns an event handler that evicts the client
+// options and credentials cached for a ProviderConfig when its spec changes or
+// it's deleted. Otherwise they'd only be replaced the next time the
+// ProviderConfig is used, and kept until the provider restarts if it's never
+// used again.
+func EvictProviderConfigClients() handler.EventHandler {
+	return handler.Funcs{
+		UpdateFunc: func(e event.UpdateEvent, _ workqueue.RateLimitingInterface) {
+			if e.ObjectOld.GetGeneration() != e.ObjectNew.GetGeneration() {
+				evictProviderConfig(e.ObjectOld)
+			}
+		},
+		DeleteFunc: func(e event.DeleteEvent, _ workqueue.RateLimitingInterface) {
+			evictProviderConfig(e.Object)
+		},
+	}
+}
+
+func evictProviderConfig(o client.Object) {
+	providerConfigClients.evict(o.GetUID())
+	if pc, ok := o.(*v1beta1.ProviderConfig); ok && pc.Spec.Credentials.SecretRef != nil {
+		secretCredentials.evict(pc.Spec.Credentials.SecretRef)
+	}
+}
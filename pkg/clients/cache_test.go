@@ -0,0 +1,222 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/v1beta1"
+)
+
+func TestClientCache(t *testing.T) {
+	resourceVersion := "1"
+	kube := &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			obj.(*corev1.Secret).SetResourceVersion(resourceVersion)
+			return nil
+		},
+	}
+	pc := &v1beta1.ProviderConfig{Spec: v1beta1.ProviderConfigSpec{
+		ProjectID: "p",
+		Credentials: v1beta1.ProviderCredentials{
+			Source:                    xpv1.CredentialsSourceSecret,
+			CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: secretRef("creds")},
+		},
+	}}
+	pc.SetUID("uid")
+	pc.SetGeneration(1)
+
+	builds := 0
+	build := func() (string, ClientOptions, error) {
+		builds++
+		return pc.Spec.ProjectID, ClientOptions{}, nil
+	}
+	cc := &clientCache{entries: map[types.UID]*cachedClient{}}
+	get := func() {
+		t.Helper()
+		if _, _, err := cc.get(context.Background(), kube, pc, build); err != nil {
+			t.Fatalf("get(...): %s", err)
+		}
+	}
+
+	get()
+	get()
+	if builds != 1 {
+		t.Errorf("get(...): want the client options to be reused while nothing changes, got %d builds", builds)
+	}
+
+	pc.SetGeneration(2)
+	get()
+	if builds != 2 {
+		t.Errorf("get(...): want the client options to be rebuilt when the ProviderConfig changes, got %d builds", builds)
+	}
+
+	resourceVersion = "2"
+	get()
+	if builds != 3 {
+		t.Errorf("get(...): want the client options to be rebuilt when the credentials Secret changes, got %d builds", builds)
+	}
+}
+
+func TestClientCacheConcurrency(t *testing.T) {
+	kube := &test.MockClient{MockGet: test.NewMockGetFn(nil)}
+	slow, fast := &v1beta1.ProviderConfig{}, &v1beta1.ProviderConfig{}
+	slow.SetUID("slow")
+	fast.SetUID("fast")
+
+	var mu sync.Mutex
+	builds := 0
+	started, release := make(chan struct{}), make(chan struct{})
+	var once sync.Once
+	buildSlow := func() (string, ClientOptions, error) {
+		mu.Lock()
+		builds++
+		mu.Unlock()
+		once.Do(func() { close(started) })
+		<-release
+		return "slow", ClientOptions{}, nil
+	}
+	cc := &clientCache{entries: map[types.UID]*cachedClient{}}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, _, err := cc.get(context.Background(), kube, slow, buildSlow); err != nil {
+				t.Errorf("get(...): %s", err)
+			}
+		}()
+	}
+	<-started
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _, _ = cc.get(context.Background(), kube, fast, func() (string, ClientOptions, error) { return "fast", ClientOptions{}, nil })
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Errorf("get(...): want building the client options of one ProviderConfig not to block other ProviderConfigs")
+	}
+
+	close(release)
+	wg.Wait()
+	if builds != 1 {
+		t.Errorf("get(...): want concurrent callers to wait for a single build, got %d builds", builds)
+	}
+}
+
+func TestClientCacheErrors(t *testing.T) {
+	errBoom := errors.New("boom")
+	pc := &v1beta1.ProviderConfig{Spec: v1beta1.ProviderConfigSpec{CABundleSecretRef: secretRef("ca")}}
+
+	cases := map[string]struct {
+		reason string
+		kube   client.Client
+		build  func() (string, ClientOptions, error)
+		want   error
+	}{
+		"GetSecretFailed": {
+			reason: "Should return an error if a referenced Secret can't be read",
+			kube:   &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			want:   errors.Wrap(errBoom, errGetReferencedSecret),
+		},
+		"BuildFailed": {
+			reason: "Should return an error if the client options can't be built",
+			kube:   &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+			build:  func() (string, ClientOptions, error) { return "", ClientOptions{}, errBoom },
+			want:   errBoom,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cc := &clientCache{entries: map[types.UID]*cachedClient{}}
+			_, _, err := cc.get(context.Background(), tc.kube, pc, tc.build)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nget(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if len(cc.entries) != 0 {
+				t.Errorf("\n%s\nget(...): want nothing to be cached", tc.reason)
+			}
+		})
+	}
+}
+
+func TestEvictProviderConfigClients(t *testing.T) {
+	pc := &v1beta1.ProviderConfig{Spec: v1beta1.ProviderConfigSpec{
+		Credentials: v1beta1.ProviderCredentials{
+			Source:                    xpv1.CredentialsSourceSecret,
+			CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: secretRef("evicted-creds")},
+		},
+	}}
+	pc.SetUID("evicted")
+	pc.SetGeneration(1)
+	nn := types.NamespacedName{Name: "evicted-creds", Namespace: pc.Spec.Credentials.SecretRef.Namespace}
+	cache := func() {
+		providerConfigClients.mu.Lock()
+		providerConfigClients.entries[pc.GetUID()] = &cachedClient{}
+		providerConfigClients.mu.Unlock()
+		secretCredentials.mu.Lock()
+		secretCredentials.entries[nn] = &cachedCredentials{}
+		secretCredentials.mu.Unlock()
+	}
+	cached := func() (client, creds bool) {
+		providerConfigClients.mu.Lock()
+		_, client = providerConfigClients.entries[pc.GetUID()]
+		providerConfigClients.mu.Unlock()
+		secretCredentials.mu.Lock()
+		_, creds = secretCredentials.entries[nn]
+		secretCredentials.mu.Unlock()
+		return client, creds
+	}
+	h := EvictProviderConfigClients()
+
+	cache()
+	h.Update(event.UpdateEvent{ObjectOld: pc, ObjectNew: pc.DeepCopy()}, nil)
+	if client, creds := cached(); !client || !creds {
+		t.Errorf("Update(...): want nothing to be evicted if the spec didn't change")
+	}
+
+	changed := pc.DeepCopy()
+	changed.SetGeneration(2)
+	h.Update(event.UpdateEvent{ObjectOld: pc, ObjectNew: changed}, nil)
+	if client, creds := cached(); client || creds {
+		t.Errorf("Update(...): want the client and credentials to be evicted if the spec changed")
+	}
+
+	cache()
+	h.Delete(event.DeleteEvent{Object: pc}, nil)
+	if client, creds := cached(); client || creds {
+		t.Errorf("Delete(...): want the client and credentials to be evicted")
+	}
+}
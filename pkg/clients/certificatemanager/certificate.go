@@ -0,0 +1,146 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificatemanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	certificatemanager "google.golang.org/api/certificatemanager/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane/provider-gcp/apis/certificatemanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	certificateNameFormat = "projects/%s/locations/%s/certificates/%s"
+	parentFormat          = "projects/%s/locations/%s"
+
+	errGetSecret   = "cannot get private key Secret"
+	errNoSecretKey = "private key Secret has no key %q"
+)
+
+// UpdateMask is the set of fields of Certificates, DnsAuthorizations,
+// CertificateMaps and CertificateMapEntries that can be updated in place.
+const UpdateMask = "description,labels"
+
+// GetCertificateParent builds the fully qualified name of the parent of a
+// certificate.
+func GetCertificateParent(project string, p v1alpha1.CertificateParameters) string {
+	return fmt.Sprintf(parentFormat, project, p.Location)
+}
+
+// GetCertificateName builds the fully qualified name of a certificate.
+func GetCertificateName(project string, p v1alpha1.CertificateParameters, name string) string {
+	return fmt.Sprintf(certificateNameFormat, project, p.Location, name)
+}
+
+// GetPrivateKey reads the PEM encoded private key of a self-managed
+// certificate from the referenced Secret. It returns an empty string for
+// managed certificates.
+func GetPrivateKey(ctx context.Context, kube client.Reader, p v1alpha1.CertificateParameters) (string, error) {
+	if p.SelfManaged == nil {
+		return "", nil
+	}
+	ref := p.SelfManaged.PEMPrivateKeySecretRef
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return "", errors.Wrap(err, errGetSecret)
+	}
+	v, ok := s.Data[ref.Key]
+	if !ok {
+		return "", errors.Errorf(errNoSecretKey, ref.Key)
+	}
+	return string(v), nil
+}
+
+// GenerateCertificate produces a Certificate Manager Certificate with the
+// supplied fully qualified name and private key that is configured via the
+// given CertificateParameters.
+func GenerateCertificate(name string, p v1alpha1.CertificateParameters, privateKey string) *certificatemanager.Certificate {
+	c := &certificatemanager.Certificate{
+		Name:        name,
+		Description: gcp.StringValue(p.Description),
+		Labels:      p.Labels,
+		Scope:       gcp.StringValue(p.Scope),
+	}
+	if m := p.Managed; m != nil {
+		c.Managed = &certificatemanager.ManagedCertificate{
+			Domains:           m.Domains,
+			DnsAuthorizations: m.DNSAuthorizations,
+			IssuanceConfig:    gcp.StringValue(m.IssuanceConfig),
+		}
+	}
+	if sm := p.SelfManaged; sm != nil {
+		c.SelfManaged = &certificatemanager.SelfManagedCertificate{
+			PemCertificate: sm.PEMCertificate,
+			PemPrivateKey:  privateKey,
+		}
+	}
+	return c
+}
+
+// GenerateCertificateObservation produces a CertificateObservation from the
+// supplied Certificate.
+func GenerateCertificateObservation(c certificatemanager.Certificate) v1alpha1.CertificateObservation {
+	o := v1alpha1.CertificateObservation{
+		Name:        c.Name,
+		SANDNSNames: c.SanDnsnames,
+		ExpireTime:  c.ExpireTime,
+		CreateTime:  c.CreateTime,
+		UpdateTime:  c.UpdateTime,
+	}
+	if m := c.Managed; m != nil {
+		o.ManagedState = m.State
+		if m.ProvisioningIssue != nil {
+			o.ProvisioningIssue = m.ProvisioningIssue.Reason
+			if m.ProvisioningIssue.Details != "" {
+				o.ProvisioningIssue += ": " + m.ProvisioningIssue.Details
+			}
+		}
+		for _, a := range m.AuthorizationAttemptInfo {
+			o.AuthorizationAttempts = append(o.AuthorizationAttempts, v1alpha1.AuthorizationAttempt{
+				Domain:        a.Domain,
+				State:         a.State,
+				FailureReason: a.FailureReason,
+				Details:       a.Details,
+			})
+		}
+	}
+	return o
+}
+
+// LateInitializeCertificate fills the empty fields of CertificateParameters
+// with the values seen in the supplied Certificate.
+func LateInitializeCertificate(p *v1alpha1.CertificateParameters, c certificatemanager.Certificate) {
+	p.Description = gcp.LateInitializeString(p.Description, c.Description)
+	p.Scope = gcp.LateInitializeString(p.Scope, c.Scope)
+}
+
+// IsCertificateUpToDate returns true if the supplied Certificate matches the
+// fields of the supplied CertificateParameters that can be updated in place.
+func IsCertificateUpToDate(p v1alpha1.CertificateParameters, c certificatemanager.Certificate) bool {
+	return gcp.StringValue(p.Description) == c.Description &&
+		cmp.Equal(p.Labels, c.Labels, cmpopts.EquateEmpty())
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificatemanager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	certificatemanager "google.golang.org/api/certificatemanager/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/certificatemanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	project          = "coolProject"
+	certificateName  = "projects/coolProject/locations/global/certificates/cool-cert"
+	dnsAuthorization = "projects/coolProject/locations/global/dnsAuthorizations/cool-auth"
+)
+
+var errBoom = errors.New("boom")
+
+func certificateParams(m ...func(*v1alpha1.CertificateParameters)) *v1alpha1.CertificateParameters {
+	p := &v1alpha1.CertificateParameters{
+		Location:    "global",
+		Description: gcp.StringPtr("Cool certificate"),
+		Labels:      map[string]string{"cool": "true"},
+		Scope:       gcp.StringPtr(v1alpha1.CertificateScopeDefault),
+		Managed: &v1alpha1.ManagedCertificate{
+			Domains:           []string{"*.example.com"},
+			DNSAuthorizations: []string{dnsAuthorization},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func certificate(m ...func(*certificatemanager.Certificate)) *certificatemanager.Certificate {
+	c := &certificatemanager.Certificate{
+		Name:        certificateName,
+		Description: "Cool certificate",
+		Labels:      map[string]string{"cool": "true"},
+		Scope:       v1alpha1.CertificateScopeDefault,
+		Managed: &certificatemanager.ManagedCertificate{
+			Domains:           []string{"*.example.com"},
+			DnsAuthorizations: []string{dnsAuthorization},
+		},
+	}
+	for _, f := range m {
+		f(c)
+	}
+	return c
+}
+
+func selfManaged(p *v1alpha1.CertificateParameters) {
+	p.Managed = nil
+	p.SelfManaged = &v1alpha1.SelfManagedCertificate{
+		PEMCertificate: "cool-cert",
+		PEMPrivateKeySecretRef: xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Name: "cool-secret", Namespace: "cool-ns"},
+			Key:             "tls.key",
+		},
+	}
+}
+
+func TestGetCertificateName(t *testing.T) {
+	if diff := cmp.Diff(certificateName, GetCertificateName(project, *certificateParams(), "cool-cert")); diff != "" {
+		t.Errorf("GetCertificateName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetPrivateKey(t *testing.T) {
+	type want struct {
+		key string
+		err error
+	}
+	secret := func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+		if diff := cmp.Diff(client.ObjectKey{Name: "cool-secret", Namespace: "cool-ns"}, key); diff != "" {
+			t.Errorf("Get(...): -want key, +got key:\n%s", diff)
+		}
+		obj.(*corev1.Secret).Data = map[string][]byte{"tls.key": []byte("cool-key")}
+		return nil
+	}
+	cases := map[string]struct {
+		kube client.Reader
+		p    v1alpha1.CertificateParameters
+		want want
+	}{
+		"Managed": {
+			p:    *certificateParams(),
+			want: want{},
+		},
+		"SelfManaged": {
+			kube: &test.MockClient{MockGet: secret},
+			p:    *certificateParams(selfManaged),
+			want: want{key: "cool-key"},
+		},
+		"MissingKey": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+			p:    *certificateParams(selfManaged),
+			want: want{err: errors.Errorf(errNoSecretKey, "tls.key")},
+		},
+		"GetFailed": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			p:    *certificateParams(selfManaged),
+			want: want{err: errors.Wrap(errBoom, errGetSecret)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GetPrivateKey(context.Background(), tc.kube, tc.p)
+			if diff := cmp.Diff(tc.want.key, got); diff != "" {
+				t.Errorf("GetPrivateKey(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GetPrivateKey(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateCertificate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.CertificateParameters
+		key  string
+		want *certificatemanager.Certificate
+	}{
+		"Managed": {
+			p:    *certificateParams(),
+			want: certificate(),
+		},
+		"SelfManaged": {
+			p:   *certificateParams(selfManaged),
+			key: "cool-key",
+			want: certificate(func(c *certificatemanager.Certificate) {
+				c.Managed = nil
+				c.SelfManaged = &certificatemanager.SelfManagedCertificate{PemCertificate: "cool-cert", PemPrivateKey: "cool-key"}
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCertificate(certificateName, tc.p, tc.key)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateCertificate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateCertificateObservation(t *testing.T) {
+	c := *certificate(func(c *certificatemanager.Certificate) {
+		c.SanDnsnames = []string{"*.example.com"}
+		c.ExpireTime = "2022-01-01T00:00:00Z"
+		c.Managed.State = "PROVISIONING"
+		c.Managed.ProvisioningIssue = &certificatemanager.ProvisioningIssue{Reason: "AUTHORIZATION_ISSUE", Details: "CNAME missing"}
+		c.Managed.AuthorizationAttemptInfo = []*certificatemanager.AuthorizationAttemptInfo{{Domain: "*.example.com", State: "FAILED", FailureReason: "CONFIG"}}
+	})
+	want := v1alpha1.CertificateObservation{
+		Name:              certificateName,
+		SANDNSNames:       []string{"*.example.com"},
+		ExpireTime:        "2022-01-01T00:00:00Z",
+		ManagedState:      "PROVISIONING",
+		ProvisioningIssue: "AUTHORIZATION_ISSUE: CNAME missing",
+		AuthorizationAttempts: []v1alpha1.AuthorizationAttempt{
+			{Domain: "*.example.com", State: "FAILED", FailureReason: "CONFIG"},
+		},
+	}
+	if diff := cmp.Diff(want, GenerateCertificateObservation(c)); diff != "" {
+		t.Errorf("GenerateCertificateObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeCertificate(t *testing.T) {
+	p := certificateParams(func(p *v1alpha1.CertificateParameters) {
+		p.Description = nil
+		p.Scope = nil
+	})
+	LateInitializeCertificate(p, *certificate())
+	if diff := cmp.Diff(certificateParams(), p); diff != "" {
+		t.Errorf("LateInitializeCertificate(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsCertificateUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		c    certificatemanager.Certificate
+		want bool
+	}{
+		"UpToDate": {
+			c:    *certificate(func(c *certificatemanager.Certificate) { c.Managed.State = "ACTIVE" }),
+			want: true,
+		},
+		"DescriptionDiffers": {
+			c:    *certificate(func(c *certificatemanager.Certificate) { c.Description = "Uncool certificate" }),
+			want: false,
+		},
+		"LabelsDiffer": {
+			c:    *certificate(func(c *certificatemanager.Certificate) { c.Labels = nil }),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsCertificateUpToDate(*certificateParams(), tc.c)); diff != "" {
+				t.Errorf("IsCertificateUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificatemanager
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	certificatemanager "google.golang.org/api/certificatemanager/v1"
+
+	"github.com/crossplane/provider-gcp/apis/certificatemanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	// certificateMapLocation is the only location certificate maps can be
+	// created in.
+	certificateMapLocation   = "global"
+	certificateMapNameFormat = "projects/%s/locations/global/certificateMaps/%s"
+)
+
+// GetCertificateMapParent builds the fully qualified name of the parent of a
+// certificate map.
+func GetCertificateMapParent(project string) string {
+	return fmt.Sprintf(parentFormat, project, certificateMapLocation)
+}
+
+// GetCertificateMapName builds the fully qualified name of a certificate map.
+func GetCertificateMapName(project, name string) string {
+	return fmt.Sprintf(certificateMapNameFormat, project, name)
+}
+
+// GenerateCertificateMap produces a Certificate Manager CertificateMap with
+// the supplied fully qualified name that is configured via the given
+// CertificateMapParameters.
+func GenerateCertificateMap(name string, p v1alpha1.CertificateMapParameters) *certificatemanager.CertificateMap {
+	return &certificatemanager.CertificateMap{
+		Name:        name,
+		Description: gcp.StringValue(p.Description),
+		Labels:      p.Labels,
+	}
+}
+
+// GenerateCertificateMapObservation produces a CertificateMapObservation from
+// the supplied CertificateMap.
+func GenerateCertificateMapObservation(m certificatemanager.CertificateMap) v1alpha1.CertificateMapObservation {
+	o := v1alpha1.CertificateMapObservation{
+		Name:       m.Name,
+		CreateTime: m.CreateTime,
+		UpdateTime: m.UpdateTime,
+	}
+	for _, t := range m.GclbTargets {
+		target := v1alpha1.GCLBTarget{
+			TargetHTTPSProxy: t.TargetHttpsProxy,
+			TargetSSLProxy:   t.TargetSslProxy,
+		}
+		for _, c := range t.IpConfigs {
+			target.IPConfigs = append(target.IPConfigs, v1alpha1.IPConfig{IPAddress: c.IpAddress, Ports: c.Ports})
+		}
+		o.GCLBTargets = append(o.GCLBTargets, target)
+	}
+	return o
+}
+
+// LateInitializeCertificateMap fills the empty fields of
+// CertificateMapParameters with the values seen in the supplied
+// CertificateMap.
+func LateInitializeCertificateMap(p *v1alpha1.CertificateMapParameters, m certificatemanager.CertificateMap) {
+	p.Description = gcp.LateInitializeString(p.Description, m.Description)
+}
+
+// IsCertificateMapUpToDate returns true if the supplied CertificateMap
+// matches the fields of the supplied CertificateMapParameters that can be
+// updated in place.
+func IsCertificateMapUpToDate(p v1alpha1.CertificateMapParameters, m certificatemanager.CertificateMap) bool {
+	return gcp.StringValue(p.Description) == m.Description &&
+		cmp.Equal(p.Labels, m.Labels, cmpopts.EquateEmpty())
+}
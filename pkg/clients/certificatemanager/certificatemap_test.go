@@ -0,0 +1,117 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificatemanager
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	certificatemanager "google.golang.org/api/certificatemanager/v1"
+
+	"github.com/crossplane/provider-gcp/apis/certificatemanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const certificateMapName = "projects/coolProject/locations/global/certificateMaps/cool-map"
+
+func certificateMapParams(m ...func(*v1alpha1.CertificateMapParameters)) *v1alpha1.CertificateMapParameters {
+	p := &v1alpha1.CertificateMapParameters{
+		Description: gcp.StringPtr("Cool map"),
+		Labels:      map[string]string{"cool": "true"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func certificateMap(m ...func(*certificatemanager.CertificateMap)) *certificatemanager.CertificateMap {
+	cm := &certificatemanager.CertificateMap{
+		Name:        certificateMapName,
+		Description: "Cool map",
+		Labels:      map[string]string{"cool": "true"},
+	}
+	for _, f := range m {
+		f(cm)
+	}
+	return cm
+}
+
+func TestGetCertificateMapName(t *testing.T) {
+	if diff := cmp.Diff(certificateMapName, GetCertificateMapName(project, "cool-map")); diff != "" {
+		t.Errorf("GetCertificateMapName(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("projects/coolProject/locations/global", GetCertificateMapParent(project)); diff != "" {
+		t.Errorf("GetCertificateMapParent(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateCertificateMap(t *testing.T) {
+	if diff := cmp.Diff(certificateMap(), GenerateCertificateMap(certificateMapName, *certificateMapParams())); diff != "" {
+		t.Errorf("GenerateCertificateMap(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateCertificateMapObservation(t *testing.T) {
+	cm := *certificateMap(func(cm *certificatemanager.CertificateMap) {
+		cm.GclbTargets = []*certificatemanager.GclbTarget{{
+			TargetHttpsProxy: "projects/coolProject/global/targetHttpsProxies/cool-proxy",
+			IpConfigs:        []*certificatemanager.IpConfig{{IpAddress: "10.0.0.1", Ports: []int64{443}}},
+		}}
+	})
+	want := v1alpha1.CertificateMapObservation{
+		Name: certificateMapName,
+		GCLBTargets: []v1alpha1.GCLBTarget{{
+			TargetHTTPSProxy: "projects/coolProject/global/targetHttpsProxies/cool-proxy",
+			IPConfigs:        []v1alpha1.IPConfig{{IPAddress: "10.0.0.1", Ports: []int64{443}}},
+		}},
+	}
+	if diff := cmp.Diff(want, GenerateCertificateMapObservation(cm)); diff != "" {
+		t.Errorf("GenerateCertificateMapObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeCertificateMap(t *testing.T) {
+	p := certificateMapParams(func(p *v1alpha1.CertificateMapParameters) { p.Description = nil })
+	LateInitializeCertificateMap(p, *certificateMap())
+	if diff := cmp.Diff(certificateMapParams(), p); diff != "" {
+		t.Errorf("LateInitializeCertificateMap(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsCertificateMapUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		cm   certificatemanager.CertificateMap
+		want bool
+	}{
+		"UpToDate": {
+			cm:   *certificateMap(),
+			want: true,
+		},
+		"DescriptionDiffers": {
+			cm:   *certificateMap(func(cm *certificatemanager.CertificateMap) { cm.Description = "Uncool map" }),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsCertificateMapUpToDate(*certificateMapParams(), tc.cm)); diff != "" {
+				t.Errorf("IsCertificateMapUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
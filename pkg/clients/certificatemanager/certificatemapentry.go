@@ -0,0 +1,83 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificatemanager
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	certificatemanager "google.golang.org/api/certificatemanager/v1"
+
+	"github.com/crossplane/provider-gcp/apis/certificatemanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// CertificateMapEntryUpdateMask is the set of CertificateMapEntry fields that
+// can be updated in place.
+const CertificateMapEntryUpdateMask = "description,labels,certificates"
+
+// GetCertificateMapEntryParent builds the fully qualified name of the parent
+// of a certificate map entry.
+func GetCertificateMapEntryParent(project string, p v1alpha1.CertificateMapEntryParameters) string {
+	return GetCertificateMapName(project, p.CertificateMap)
+}
+
+// GetCertificateMapEntryName builds the fully qualified name of a certificate
+// map entry.
+func GetCertificateMapEntryName(project string, p v1alpha1.CertificateMapEntryParameters, name string) string {
+	return GetCertificateMapEntryParent(project, p) + "/certificateMapEntries/" + name
+}
+
+// GenerateCertificateMapEntry produces a Certificate Manager
+// CertificateMapEntry with the supplied fully qualified name that is
+// configured via the given CertificateMapEntryParameters.
+func GenerateCertificateMapEntry(name string, p v1alpha1.CertificateMapEntryParameters) *certificatemanager.CertificateMapEntry {
+	return &certificatemanager.CertificateMapEntry{
+		Name:         name,
+		Description:  gcp.StringValue(p.Description),
+		Labels:       p.Labels,
+		Hostname:     gcp.StringValue(p.Hostname),
+		Matcher:      gcp.StringValue(p.Matcher),
+		Certificates: p.Certificates,
+	}
+}
+
+// GenerateCertificateMapEntryObservation produces a
+// CertificateMapEntryObservation from the supplied CertificateMapEntry.
+func GenerateCertificateMapEntryObservation(e certificatemanager.CertificateMapEntry) v1alpha1.CertificateMapEntryObservation {
+	return v1alpha1.CertificateMapEntryObservation{
+		Name:       e.Name,
+		State:      e.State,
+		CreateTime: e.CreateTime,
+		UpdateTime: e.UpdateTime,
+	}
+}
+
+// LateInitializeCertificateMapEntry fills the empty fields of
+// CertificateMapEntryParameters with the values seen in the supplied
+// CertificateMapEntry.
+func LateInitializeCertificateMapEntry(p *v1alpha1.CertificateMapEntryParameters, e certificatemanager.CertificateMapEntry) {
+	p.Description = gcp.LateInitializeString(p.Description, e.Description)
+}
+
+// IsCertificateMapEntryUpToDate returns true if the supplied
+// CertificateMapEntry matches the fields of the supplied
+// CertificateMapEntryParameters that can be updated in place.
+func IsCertificateMapEntryUpToDate(p v1alpha1.CertificateMapEntryParameters, e certificatemanager.CertificateMapEntry) bool {
+	return gcp.StringValue(p.Description) == e.Description &&
+		cmp.Equal(p.Labels, e.Labels, cmpopts.EquateEmpty()) &&
+		cmp.Equal(p.Certificates, e.Certificates, cmpopts.EquateEmpty())
+}
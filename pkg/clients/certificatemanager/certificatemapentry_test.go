@@ -0,0 +1,131 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificatemanager
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	certificatemanager "google.golang.org/api/certificatemanager/v1"
+
+	"github.com/crossplane/provider-gcp/apis/certificatemanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const entryName = "projects/coolProject/locations/global/certificateMaps/cool-map/certificateMapEntries/cool-entry"
+
+func entryParams(m ...func(*v1alpha1.CertificateMapEntryParameters)) *v1alpha1.CertificateMapEntryParameters {
+	p := &v1alpha1.CertificateMapEntryParameters{
+		CertificateMap: "cool-map",
+		Description:    gcp.StringPtr("Cool entry"),
+		Labels:         map[string]string{"cool": "true"},
+		Hostname:       gcp.StringPtr("*.example.com"),
+		Certificates:   []string{certificateName},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func entry(m ...func(*certificatemanager.CertificateMapEntry)) *certificatemanager.CertificateMapEntry {
+	e := &certificatemanager.CertificateMapEntry{
+		Name:         entryName,
+		Description:  "Cool entry",
+		Labels:       map[string]string{"cool": "true"},
+		Hostname:     "*.example.com",
+		Certificates: []string{certificateName},
+	}
+	for _, f := range m {
+		f(e)
+	}
+	return e
+}
+
+func TestGetCertificateMapEntryName(t *testing.T) {
+	if diff := cmp.Diff(entryName, GetCertificateMapEntryName(project, *entryParams(), "cool-entry")); diff != "" {
+		t.Errorf("GetCertificateMapEntryName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateCertificateMapEntry(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.CertificateMapEntryParameters
+		want *certificatemanager.CertificateMapEntry
+	}{
+		"Hostname": {
+			p:    *entryParams(),
+			want: entry(),
+		},
+		"Matcher": {
+			p: *entryParams(func(p *v1alpha1.CertificateMapEntryParameters) {
+				p.Hostname = nil
+				p.Matcher = gcp.StringPtr(v1alpha1.CertificateMapEntryMatcherPrimary)
+			}),
+			want: entry(func(e *certificatemanager.CertificateMapEntry) {
+				e.Hostname = ""
+				e.Matcher = v1alpha1.CertificateMapEntryMatcherPrimary
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateCertificateMapEntry(entryName, tc.p)); diff != "" {
+				t.Errorf("GenerateCertificateMapEntry(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateCertificateMapEntryObservation(t *testing.T) {
+	want := v1alpha1.CertificateMapEntryObservation{Name: entryName, State: "ACTIVE"}
+	got := GenerateCertificateMapEntryObservation(*entry(func(e *certificatemanager.CertificateMapEntry) { e.State = "ACTIVE" }))
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateCertificateMapEntryObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeCertificateMapEntry(t *testing.T) {
+	p := entryParams(func(p *v1alpha1.CertificateMapEntryParameters) { p.Description = nil })
+	LateInitializeCertificateMapEntry(p, *entry())
+	if diff := cmp.Diff(entryParams(), p); diff != "" {
+		t.Errorf("LateInitializeCertificateMapEntry(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsCertificateMapEntryUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		e    certificatemanager.CertificateMapEntry
+		want bool
+	}{
+		"UpToDate": {
+			e:    *entry(func(e *certificatemanager.CertificateMapEntry) { e.State = "ACTIVE" }),
+			want: true,
+		},
+		"CertificatesDiffer": {
+			e:    *entry(func(e *certificatemanager.CertificateMapEntry) { e.Certificates = nil }),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsCertificateMapEntryUpToDate(*entryParams(), tc.e)); diff != "" {
+				t.Errorf("IsCertificateMapEntryUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
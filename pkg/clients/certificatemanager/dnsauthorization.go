@@ -0,0 +1,87 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificatemanager
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	certificatemanager "google.golang.org/api/certificatemanager/v1"
+
+	"github.com/crossplane/provider-gcp/apis/certificatemanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const dnsAuthorizationNameFormat = "projects/%s/locations/%s/dnsAuthorizations/%s"
+
+// GetDNSAuthorizationParent builds the fully qualified name of the parent of
+// a DNS authorization.
+func GetDNSAuthorizationParent(project string, p v1alpha1.DNSAuthorizationParameters) string {
+	return fmt.Sprintf(parentFormat, project, p.Location)
+}
+
+// GetDNSAuthorizationName builds the fully qualified name of a DNS
+// authorization.
+func GetDNSAuthorizationName(project string, p v1alpha1.DNSAuthorizationParameters, name string) string {
+	return fmt.Sprintf(dnsAuthorizationNameFormat, project, p.Location, name)
+}
+
+// GenerateDNSAuthorization produces a Certificate Manager DnsAuthorization
+// with the supplied fully qualified name that is configured via the given
+// DNSAuthorizationParameters.
+func GenerateDNSAuthorization(name string, p v1alpha1.DNSAuthorizationParameters) *certificatemanager.DnsAuthorization {
+	return &certificatemanager.DnsAuthorization{
+		Name:        name,
+		Domain:      p.Domain,
+		Description: gcp.StringValue(p.Description),
+		Labels:      p.Labels,
+	}
+}
+
+// GenerateDNSAuthorizationObservation produces a DNSAuthorizationObservation
+// from the supplied DnsAuthorization.
+func GenerateDNSAuthorizationObservation(d certificatemanager.DnsAuthorization) v1alpha1.DNSAuthorizationObservation {
+	o := v1alpha1.DNSAuthorizationObservation{
+		Name:       d.Name,
+		CreateTime: d.CreateTime,
+		UpdateTime: d.UpdateTime,
+	}
+	if r := d.DnsResourceRecord; r != nil {
+		o.DNSResourceRecord = v1alpha1.DNSResourceRecord{
+			Name: r.Name,
+			Type: r.Type,
+			Data: r.Data,
+		}
+	}
+	return o
+}
+
+// LateInitializeDNSAuthorization fills the empty fields of
+// DNSAuthorizationParameters with the values seen in the supplied
+// DnsAuthorization.
+func LateInitializeDNSAuthorization(p *v1alpha1.DNSAuthorizationParameters, d certificatemanager.DnsAuthorization) {
+	p.Description = gcp.LateInitializeString(p.Description, d.Description)
+}
+
+// IsDNSAuthorizationUpToDate returns true if the supplied DnsAuthorization
+// matches the fields of the supplied DNSAuthorizationParameters that can be
+// updated in place.
+func IsDNSAuthorizationUpToDate(p v1alpha1.DNSAuthorizationParameters, d certificatemanager.DnsAuthorization) bool {
+	return gcp.StringValue(p.Description) == d.Description &&
+		cmp.Equal(p.Labels, d.Labels, cmpopts.EquateEmpty())
+}
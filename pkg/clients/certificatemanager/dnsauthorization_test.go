@@ -0,0 +1,119 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificatemanager
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	certificatemanager "google.golang.org/api/certificatemanager/v1"
+
+	"github.com/crossplane/provider-gcp/apis/certificatemanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+func dnsAuthorizationParams(m ...func(*v1alpha1.DNSAuthorizationParameters)) *v1alpha1.DNSAuthorizationParameters {
+	p := &v1alpha1.DNSAuthorizationParameters{
+		Location:    "global",
+		Domain:      "example.com",
+		Description: gcp.StringPtr("Cool authorization"),
+		Labels:      map[string]string{"cool": "true"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func dnsAuth(m ...func(*certificatemanager.DnsAuthorization)) *certificatemanager.DnsAuthorization {
+	d := &certificatemanager.DnsAuthorization{
+		Name:        dnsAuthorization,
+		Domain:      "example.com",
+		Description: "Cool authorization",
+		Labels:      map[string]string{"cool": "true"},
+	}
+	for _, f := range m {
+		f(d)
+	}
+	return d
+}
+
+func TestGetDNSAuthorizationName(t *testing.T) {
+	if diff := cmp.Diff(dnsAuthorization, GetDNSAuthorizationName(project, *dnsAuthorizationParams(), "cool-auth")); diff != "" {
+		t.Errorf("GetDNSAuthorizationName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateDNSAuthorization(t *testing.T) {
+	if diff := cmp.Diff(dnsAuth(), GenerateDNSAuthorization(dnsAuthorization, *dnsAuthorizationParams())); diff != "" {
+		t.Errorf("GenerateDNSAuthorization(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateDNSAuthorizationObservation(t *testing.T) {
+	d := *dnsAuth(func(d *certificatemanager.DnsAuthorization) {
+		d.CreateTime = "2021-01-01T00:00:00Z"
+		d.DnsResourceRecord = &certificatemanager.DnsResourceRecord{
+			Name: "_acme-challenge.example.com.",
+			Type: "CNAME",
+			Data: "cool.authorize.certificatemanager.goog.",
+		}
+	})
+	want := v1alpha1.DNSAuthorizationObservation{
+		Name:       dnsAuthorization,
+		CreateTime: "2021-01-01T00:00:00Z",
+		DNSResourceRecord: v1alpha1.DNSResourceRecord{
+			Name: "_acme-challenge.example.com.",
+			Type: "CNAME",
+			Data: "cool.authorize.certificatemanager.goog.",
+		},
+	}
+	if diff := cmp.Diff(want, GenerateDNSAuthorizationObservation(d)); diff != "" {
+		t.Errorf("GenerateDNSAuthorizationObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeDNSAuthorization(t *testing.T) {
+	p := dnsAuthorizationParams(func(p *v1alpha1.DNSAuthorizationParameters) { p.Description = nil })
+	LateInitializeDNSAuthorization(p, *dnsAuth())
+	if diff := cmp.Diff(dnsAuthorizationParams(), p); diff != "" {
+		t.Errorf("LateInitializeDNSAuthorization(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsDNSAuthorizationUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		d    certificatemanager.DnsAuthorization
+		want bool
+	}{
+		"UpToDate": {
+			d:    *dnsAuth(),
+			want: true,
+		},
+		"LabelsDiffer": {
+			d:    *dnsAuth(func(d *certificatemanager.DnsAuthorization) { d.Labels = map[string]string{"cool": "false"} }),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsDNSAuthorizationUpToDate(*dnsAuthorizationParams(), tc.d)); diff != "" {
+				t.Errorf("IsDNSAuthorizationUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudbuild
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	cloudbuild "google.golang.org/api/cloudbuild/v1"
+
+	"github.com/crossplane/provider-gcp/apis/cloudbuild/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const parentFormat = "projects/%s/locations/%s"
+
+// GetTriggerParent returns the location of the supplied TriggerParameters in
+// the form projects/{project}/locations/{location}, falling back to the
+// supplied default project.
+func GetTriggerParent(defaultProject string, p v1alpha1.TriggerParameters) string {
+	project := defaultProject
+	if p.Project != nil {
+		project = *p.Project
+	}
+	return fmt.Sprintf(parentFormat, project, p.Location)
+}
+
+// GetTriggerName builds the fully qualified name of the trigger with the
+// supplied ID in the supplied parent.
+func GetTriggerName(parent, id string) string {
+	return parent + "/triggers/" + id
+}
+
+// GenerateTrigger produces a BuildTrigger that is configured via the
+// supplied TriggerParameters.
+func GenerateTrigger(p v1alpha1.TriggerParameters) *cloudbuild.BuildTrigger {
+	t := &cloudbuild.BuildTrigger{
+		Name:             p.Name,
+		Description:      gcp.StringValue(p.Description),
+		Tags:             p.Tags,
+		Disabled:         gcp.BoolValue(p.Disabled),
+		Filename:         gcp.StringValue(p.Filename),
+		Substitutions:    p.Substitutions,
+		IncludedFiles:    p.IncludedFiles,
+		IgnoredFiles:     p.IgnoredFiles,
+		IncludeBuildLogs: gcp.StringValue(p.IncludeBuildLogs),
+		ServiceAccount:   gcp.StringValue(p.ServiceAccount),
+	}
+	if gh := p.GitHub; gh != nil {
+		t.Github = &cloudbuild.GitHubEventsConfig{Owner: gh.Owner, Name: gh.Name}
+		if pr := gh.PullRequest; pr != nil {
+			t.Github.PullRequest = &cloudbuild.PullRequestFilter{
+				Branch:         pr.Branch,
+				CommentControl: gcp.StringValue(pr.CommentControl),
+				InvertRegex:    gcp.BoolValue(pr.InvertRegex),
+			}
+		}
+		if push := gh.Push; push != nil {
+			t.Github.Push = &cloudbuild.PushFilter{
+				Branch:      gcp.StringValue(push.Branch),
+				Tag:         gcp.StringValue(push.Tag),
+				InvertRegex: gcp.BoolValue(push.InvertRegex),
+			}
+		}
+	}
+	if rs := p.TriggerTemplate; rs != nil {
+		t.TriggerTemplate = &cloudbuild.RepoSource{
+			ProjectId:   gcp.StringValue(rs.ProjectID),
+			RepoName:    rs.RepoName,
+			BranchName:  gcp.StringValue(rs.BranchName),
+			TagName:     gcp.StringValue(rs.TagName),
+			Dir:         gcp.StringValue(rs.Dir),
+			InvertRegex: gcp.BoolValue(rs.InvertRegex),
+		}
+	}
+	if b := p.Build; b != nil {
+		t.Build = generateBuild(*b)
+	}
+	if a := p.ApprovalConfig; a != nil {
+		t.ApprovalConfig = &cloudbuild.ApprovalConfig{ApprovalRequired: gcp.BoolValue(a.ApprovalRequired)}
+	}
+	return t
+}
+
+func generateBuild(in v1alpha1.BuildConfig) *cloudbuild.Build {
+	b := &cloudbuild.Build{
+		Images:        in.Images,
+		Substitutions: in.Substitutions,
+		Tags:          in.Tags,
+		Timeout:       gcp.StringValue(in.Timeout),
+		LogsBucket:    gcp.StringValue(in.LogsBucket),
+		Steps:         make([]*cloudbuild.BuildStep, len(in.Steps)),
+	}
+	for i, s := range in.Steps {
+		b.Steps[i] = &cloudbuild.BuildStep{
+			Name:         s.Name,
+			Id:           gcp.StringValue(s.ID),
+			Args:         s.Args,
+			Env:          s.Env,
+			Entrypoint:   gcp.StringValue(s.Entrypoint),
+			Dir:          gcp.StringValue(s.Dir),
+			Script:       gcp.StringValue(s.Script),
+			WaitFor:      s.WaitFor,
+			Timeout:      gcp.StringValue(s.Timeout),
+			AllowFailure: gcp.BoolValue(s.AllowFailure),
+		}
+	}
+	if o := in.Options; o != nil {
+		b.Options = &cloudbuild.BuildOptions{
+			MachineType: gcp.StringValue(o.MachineType),
+			DiskSizeGb:  gcp.Int64Value(o.DiskSizeGB),
+			Logging:     gcp.StringValue(o.Logging),
+			Env:         o.Env,
+		}
+		if o.WorkerPool != nil {
+			b.Options.Pool = &cloudbuild.PoolOption{Name: *o.WorkerPool}
+		}
+	}
+	return b
+}
+
+// GenerateTriggerObservation produces a TriggerObservation from the supplied
+// BuildTrigger.
+func GenerateTriggerObservation(t cloudbuild.BuildTrigger) v1alpha1.TriggerObservation {
+	return v1alpha1.TriggerObservation{
+		ID:           t.Id,
+		ResourceName: t.ResourceName,
+		CreateTime:   t.CreateTime,
+	}
+}
+
+// LateInitializeTrigger fills the empty fields of the supplied
+// TriggerParameters with the values of the supplied BuildTrigger.
+func LateInitializeTrigger(p *v1alpha1.TriggerParameters, t cloudbuild.BuildTrigger) {
+	p.Description = gcp.LateInitializeString(p.Description, t.Description)
+	p.Disabled = gcp.LateInitializeBool(p.Disabled, t.Disabled)
+	if p.Build != nil && t.Build != nil {
+		p.Build.Timeout = gcp.LateInitializeString(p.Build.Timeout, t.Build.Timeout)
+	}
+}
+
+// IsTriggerUpToDate returns true if the supplied BuildTrigger matches the
+// supplied TriggerParameters.
+func IsTriggerUpToDate(p v1alpha1.TriggerParameters, t cloudbuild.BuildTrigger) bool {
+	return cmp.Equal(GenerateTrigger(p), &t, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(cloudbuild.BuildTrigger{}, "Id", "ResourceName", "CreateTime", "EventType", "Autodetect", "ServerResponse"))
+}
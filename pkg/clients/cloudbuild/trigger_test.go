@@ -0,0 +1,220 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudbuild
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cloudbuild "google.golang.org/api/cloudbuild/v1"
+
+	"github.com/crossplane/provider-gcp/apis/cloudbuild/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	project  = "cool-project"
+	poolName = "projects/cool-project/locations/us-central1/workerPools/private"
+)
+
+func triggerParams(m ...func(*v1alpha1.TriggerParameters)) *v1alpha1.TriggerParameters {
+	p := &v1alpha1.TriggerParameters{
+		Location:    "us-central1",
+		Name:        "deploy",
+		Description: gcp.StringPtr("Deploy on push to main"),
+		Disabled:    gcp.BoolPtr(false),
+		GitHub: &v1alpha1.GitHubEventsConfig{
+			Owner: "crossplane",
+			Name:  "provider-gcp",
+			Push:  &v1alpha1.PushFilter{Branch: gcp.StringPtr("^main$")},
+		},
+		Build: &v1alpha1.BuildConfig{
+			Steps: []v1alpha1.BuildStep{{
+				Name: "gcr.io/cloud-builders/docker",
+				Args: []string{"build", "-t", "$_IMAGE", "."},
+			}},
+			Images:  []string{"$_IMAGE"},
+			Timeout: gcp.StringPtr("600s"),
+			Options: &v1alpha1.BuildOptions{
+				Logging:    gcp.StringPtr("CLOUD_LOGGING_ONLY"),
+				WorkerPool: gcp.StringPtr(poolName),
+			},
+		},
+		Substitutions:  map[string]string{"_IMAGE": "us-docker.pkg.dev/cool-project/images/app"},
+		ApprovalConfig: &v1alpha1.ApprovalConfig{ApprovalRequired: gcp.BoolPtr(true)},
+		ServiceAccount: gcp.StringPtr("projects/cool-project/serviceAccounts/builder@cool-project.iam.gserviceaccount.com"),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func trigger(m ...func(*cloudbuild.BuildTrigger)) *cloudbuild.BuildTrigger {
+	t := &cloudbuild.BuildTrigger{
+		Id:           "0123-4567",
+		ResourceName: "projects/cool-project/locations/us-central1/triggers/deploy",
+		CreateTime:   "2021-06-01T00:00:00Z",
+		EventType:    "REPO",
+		Name:         "deploy",
+		Description:  "Deploy on push to main",
+		Github: &cloudbuild.GitHubEventsConfig{
+			Owner: "crossplane",
+			Name:  "provider-gcp",
+			Push:  &cloudbuild.PushFilter{Branch: "^main$"},
+		},
+		Build: &cloudbuild.Build{
+			Steps: []*cloudbuild.BuildStep{{
+				Name: "gcr.io/cloud-builders/docker",
+				Args: []string{"build", "-t", "$_IMAGE", "."},
+			}},
+			Images:  []string{"$_IMAGE"},
+			Timeout: "600s",
+			Options: &cloudbuild.BuildOptions{
+				Logging: "CLOUD_LOGGING_ONLY",
+				Pool:    &cloudbuild.PoolOption{Name: poolName},
+			},
+		},
+		Substitutions:  map[string]string{"_IMAGE": "us-docker.pkg.dev/cool-project/images/app"},
+		ApprovalConfig: &cloudbuild.ApprovalConfig{ApprovalRequired: true},
+		ServiceAccount: "projects/cool-project/serviceAccounts/builder@cool-project.iam.gserviceaccount.com",
+	}
+	for _, f := range m {
+		f(t)
+	}
+	return t
+}
+
+func TestTriggerNames(t *testing.T) {
+	parent := GetTriggerParent(project, *triggerParams())
+	if diff := cmp.Diff("projects/cool-project/locations/us-central1", parent); diff != "" {
+		t.Errorf("GetTriggerParent(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("projects/cool-project/locations/us-central1/triggers/0123-4567", GetTriggerName(parent, "0123-4567")); diff != "" {
+		t.Errorf("GetTriggerName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateTrigger(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.TriggerParameters
+		want *cloudbuild.BuildTrigger
+	}{
+		"GitHubInlineBuild": {
+			p: triggerParams(),
+			want: trigger(func(t *cloudbuild.BuildTrigger) {
+				t.Id = ""
+				t.ResourceName = ""
+				t.CreateTime = ""
+				t.EventType = ""
+			}),
+		},
+		"SourceRepositoryBuildFile": {
+			p: &v1alpha1.TriggerParameters{
+				Location: "global",
+				Name:     "test",
+				TriggerTemplate: &v1alpha1.RepoSource{
+					RepoName:   "app",
+					BranchName: gcp.StringPtr(".*"),
+				},
+				Filename:     gcp.StringPtr("cloudbuild.yaml"),
+				IgnoredFiles: []string{"docs/**"},
+			},
+			want: &cloudbuild.BuildTrigger{
+				Name:            "test",
+				TriggerTemplate: &cloudbuild.RepoSource{RepoName: "app", BranchName: ".*"},
+				Filename:        "cloudbuild.yaml",
+				IgnoredFiles:    []string{"docs/**"},
+			},
+		},
+		"PullRequest": {
+			p: triggerParams(func(p *v1alpha1.TriggerParameters) {
+				p.GitHub.Push = nil
+				p.GitHub.PullRequest = &v1alpha1.PullRequestFilter{Branch: "^main$", CommentControl: gcp.StringPtr("COMMENTS_ENABLED")}
+				p.Build = nil
+				p.Filename = gcp.StringPtr("cloudbuild.yaml")
+			}),
+			want: trigger(func(t *cloudbuild.BuildTrigger) {
+				t.Id = ""
+				t.ResourceName = ""
+				t.CreateTime = ""
+				t.EventType = ""
+				t.Github.Push = nil
+				t.Github.PullRequest = &cloudbuild.PullRequestFilter{Branch: "^main$", CommentControl: "COMMENTS_ENABLED"}
+				t.Build = nil
+				t.Filename = "cloudbuild.yaml"
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateTrigger(*tc.p)); diff != "" {
+				t.Errorf("GenerateTrigger(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateTriggerObservation(t *testing.T) {
+	want := v1alpha1.TriggerObservation{
+		ID:           "0123-4567",
+		ResourceName: "projects/cool-project/locations/us-central1/triggers/deploy",
+		CreateTime:   "2021-06-01T00:00:00Z",
+	}
+	if diff := cmp.Diff(want, GenerateTriggerObservation(*trigger())); diff != "" {
+		t.Errorf("GenerateTriggerObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeTrigger(t *testing.T) {
+	got := triggerParams(func(p *v1alpha1.TriggerParameters) {
+		p.Description = nil
+		p.Build.Timeout = nil
+	})
+	LateInitializeTrigger(got, *trigger())
+	if diff := cmp.Diff(triggerParams(func(p *v1alpha1.TriggerParameters) { p.Disabled = gcp.BoolPtr(false) }), got); diff != "" {
+		t.Errorf("LateInitializeTrigger(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsTriggerUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		t    *cloudbuild.BuildTrigger
+		want bool
+	}{
+		"UpToDate": {
+			t:    trigger(),
+			want: true,
+		},
+		"BranchDiffers": {
+			t: trigger(func(t *cloudbuild.BuildTrigger) { t.Github.Push.Branch = ".*" }),
+		},
+		"ApprovalDiffers": {
+			t: trigger(func(t *cloudbuild.BuildTrigger) { t.ApprovalConfig = nil }),
+		},
+		"StepDiffers": {
+			t: trigger(func(t *cloudbuild.BuildTrigger) { t.Build.Steps[0].Args = []string{"push", "$_IMAGE"} }),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsTriggerUpToDate(*triggerParams(), *tc.t); got != tc.want {
+				t.Errorf("IsTriggerUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudbuild
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	cloudbuild "google.golang.org/api/cloudbuild/v1"
+
+	"github.com/crossplane/provider-gcp/apis/cloudbuild/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// WorkerPoolUpdateMask is the list of worker pool fields that can be updated
+// with a patch call. The peered network of a pool is immutable.
+const WorkerPoolUpdateMask = "displayName,annotations,privatePoolV1Config.workerConfig,privatePoolV1Config.networkConfig.egressOption"
+
+// GetWorkerPoolParent returns the location of the supplied
+// WorkerPoolParameters in the form projects/{project}/locations/{location},
+// falling back to the supplied default project.
+func GetWorkerPoolParent(defaultProject string, p v1alpha1.WorkerPoolParameters) string {
+	project := defaultProject
+	if p.Project != nil {
+		project = *p.Project
+	}
+	return fmt.Sprintf(parentFormat, project, p.Location)
+}
+
+// GetWorkerPoolName builds the fully qualified name of the worker pool with
+// the supplied ID in the supplied parent.
+func GetWorkerPoolName(parent, id string) string {
+	return parent + "/workerPools/" + id
+}
+
+// GenerateWorkerPool produces a WorkerPool that is configured via the
+// supplied WorkerPoolParameters.
+func GenerateWorkerPool(p v1alpha1.WorkerPoolParameters) *cloudbuild.WorkerPool {
+	wp := &cloudbuild.WorkerPool{
+		DisplayName:         gcp.StringValue(p.DisplayName),
+		Annotations:         p.Annotations,
+		PrivatePoolV1Config: &cloudbuild.PrivatePoolV1Config{},
+	}
+	if c := p.WorkerConfig; c != nil {
+		wp.PrivatePoolV1Config.WorkerConfig = &cloudbuild.WorkerConfig{
+			MachineType: gcp.StringValue(c.MachineType),
+			DiskSizeGb:  gcp.Int64Value(c.DiskSizeGB),
+		}
+	}
+	if c := p.NetworkConfig; c != nil {
+		wp.PrivatePoolV1Config.NetworkConfig = &cloudbuild.NetworkConfig{
+			PeeredNetwork:        c.PeeredNetwork,
+			PeeredNetworkIpRange: gcp.StringValue(c.PeeredNetworkIPRange),
+			EgressOption:         gcp.StringValue(c.EgressOption),
+		}
+	}
+	return wp
+}
+
+// GenerateWorkerPoolObservation produces a WorkerPoolObservation from the
+// supplied WorkerPool.
+func GenerateWorkerPoolObservation(wp cloudbuild.WorkerPool) v1alpha1.WorkerPoolObservation {
+	return v1alpha1.WorkerPoolObservation{
+		Name:       wp.Name,
+		UID:        wp.Uid,
+		State:      wp.State,
+		CreateTime: wp.CreateTime,
+		UpdateTime: wp.UpdateTime,
+		Etag:       wp.Etag,
+	}
+}
+
+// LateInitializeWorkerPool fills the empty fields of the supplied
+// WorkerPoolParameters with the values of the supplied WorkerPool.
+func LateInitializeWorkerPool(p *v1alpha1.WorkerPoolParameters, wp cloudbuild.WorkerPool) {
+	p.DisplayName = gcp.LateInitializeString(p.DisplayName, wp.DisplayName)
+	p.Annotations = gcp.LateInitializeStringMap(p.Annotations, wp.Annotations)
+	if wp.PrivatePoolV1Config == nil {
+		return
+	}
+	if c := wp.PrivatePoolV1Config.WorkerConfig; c != nil {
+		if p.WorkerConfig == nil {
+			p.WorkerConfig = &v1alpha1.WorkerConfig{}
+		}
+		p.WorkerConfig.MachineType = gcp.LateInitializeString(p.WorkerConfig.MachineType, c.MachineType)
+		p.WorkerConfig.DiskSizeGB = gcp.LateInitializeInt64(p.WorkerConfig.DiskSizeGB, c.DiskSizeGb)
+	}
+	if c := wp.PrivatePoolV1Config.NetworkConfig; c != nil && p.NetworkConfig != nil {
+		p.NetworkConfig.PeeredNetworkIPRange = gcp.LateInitializeString(p.NetworkConfig.PeeredNetworkIPRange, c.PeeredNetworkIpRange)
+		p.NetworkConfig.EgressOption = gcp.LateInitializeString(p.NetworkConfig.EgressOption, c.EgressOption)
+	}
+}
+
+// IsWorkerPoolUpToDate returns true if the supplied WorkerPool matches the
+// fields of the supplied WorkerPoolParameters that can be updated with a
+// patch call.
+func IsWorkerPoolUpToDate(p v1alpha1.WorkerPoolParameters, wp cloudbuild.WorkerPool) bool {
+	var wc cloudbuild.WorkerConfig
+	var egress string
+	if c := wp.PrivatePoolV1Config; c != nil {
+		if c.WorkerConfig != nil {
+			wc = *c.WorkerConfig
+		}
+		if c.NetworkConfig != nil {
+			egress = c.NetworkConfig.EgressOption
+		}
+	}
+	if w := p.WorkerConfig; w != nil {
+		if gcp.StringValue(w.MachineType) != wc.MachineType || gcp.Int64Value(w.DiskSizeGB) != wc.DiskSizeGb {
+			return false
+		}
+	}
+	if n := p.NetworkConfig; n != nil && gcp.StringValue(n.EgressOption) != egress {
+		return false
+	}
+	return gcp.StringValue(p.DisplayName) == wp.DisplayName &&
+		cmp.Equal(p.Annotations, wp.Annotations, cmpopts.EquateEmpty())
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudbuild
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cloudbuild "google.golang.org/api/cloudbuild/v1"
+
+	"github.com/crossplane/provider-gcp/apis/cloudbuild/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+func poolParams(m ...func(*v1alpha1.WorkerPoolParameters)) *v1alpha1.WorkerPoolParameters {
+	p := &v1alpha1.WorkerPoolParameters{
+		Location:    "us-central1",
+		DisplayName: gcp.StringPtr("Private builds"),
+		WorkerConfig: &v1alpha1.WorkerConfig{
+			MachineType: gcp.StringPtr("e2-standard-4"),
+			DiskSizeGB:  gcp.Int64Ptr(100),
+		},
+		NetworkConfig: &v1alpha1.NetworkConfig{
+			PeeredNetwork:        "projects/123456/global/networks/default",
+			PeeredNetworkIPRange: gcp.StringPtr("/29"),
+			EgressOption:         gcp.StringPtr("NO_PUBLIC_EGRESS"),
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func pool(m ...func(*cloudbuild.WorkerPool)) *cloudbuild.WorkerPool {
+	wp := &cloudbuild.WorkerPool{
+		Name:        poolName,
+		Uid:         "abc-123",
+		State:       v1alpha1.WorkerPoolStateRunning,
+		CreateTime:  "2021-06-01T00:00:00Z",
+		UpdateTime:  "2021-06-02T00:00:00Z",
+		Etag:        "etag",
+		DisplayName: "Private builds",
+		PrivatePoolV1Config: &cloudbuild.PrivatePoolV1Config{
+			WorkerConfig: &cloudbuild.WorkerConfig{MachineType: "e2-standard-4", DiskSizeGb: 100},
+			NetworkConfig: &cloudbuild.NetworkConfig{
+				PeeredNetwork:        "projects/123456/global/networks/default",
+				PeeredNetworkIpRange: "/29",
+				EgressOption:         "NO_PUBLIC_EGRESS",
+			},
+		},
+	}
+	for _, f := range m {
+		f(wp)
+	}
+	return wp
+}
+
+func TestWorkerPoolNames(t *testing.T) {
+	parent := GetWorkerPoolParent(project, *poolParams())
+	if diff := cmp.Diff(poolName, GetWorkerPoolName(parent, "private")); diff != "" {
+		t.Errorf("GetWorkerPoolName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateWorkerPool(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.WorkerPoolParameters
+		want *cloudbuild.WorkerPool
+	}{
+		"Full": {
+			p: poolParams(),
+			want: pool(func(wp *cloudbuild.WorkerPool) {
+				wp.Name = ""
+				wp.Uid = ""
+				wp.State = ""
+				wp.CreateTime = ""
+				wp.UpdateTime = ""
+				wp.Etag = ""
+			}),
+		},
+		"Minimal": {
+			p:    &v1alpha1.WorkerPoolParameters{Location: "us-central1"},
+			want: &cloudbuild.WorkerPool{PrivatePoolV1Config: &cloudbuild.PrivatePoolV1Config{}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateWorkerPool(*tc.p)); diff != "" {
+				t.Errorf("GenerateWorkerPool(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateWorkerPoolObservation(t *testing.T) {
+	want := v1alpha1.WorkerPoolObservation{
+		Name:       poolName,
+		UID:        "abc-123",
+		State:      v1alpha1.WorkerPoolStateRunning,
+		CreateTime: "2021-06-01T00:00:00Z",
+		UpdateTime: "2021-06-02T00:00:00Z",
+		Etag:       "etag",
+	}
+	if diff := cmp.Diff(want, GenerateWorkerPoolObservation(*pool())); diff != "" {
+		t.Errorf("GenerateWorkerPoolObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeWorkerPool(t *testing.T) {
+	got := poolParams(func(p *v1alpha1.WorkerPoolParameters) {
+		p.WorkerConfig = nil
+		p.NetworkConfig.PeeredNetworkIPRange = nil
+		p.NetworkConfig.EgressOption = nil
+	})
+	LateInitializeWorkerPool(got, *pool())
+	if diff := cmp.Diff(poolParams(), got); diff != "" {
+		t.Errorf("LateInitializeWorkerPool(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsWorkerPoolUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.WorkerPoolParameters
+		wp   *cloudbuild.WorkerPool
+		want bool
+	}{
+		"UpToDate": {
+			p:    poolParams(),
+			wp:   pool(),
+			want: true,
+		},
+		"MachineTypeDiffers": {
+			p:  poolParams(func(p *v1alpha1.WorkerPoolParameters) { p.WorkerConfig.MachineType = gcp.StringPtr("e2-standard-8") }),
+			wp: pool(),
+		},
+		"EgressDiffers": {
+			p:  poolParams(func(p *v1alpha1.WorkerPoolParameters) { p.NetworkConfig.EgressOption = gcp.StringPtr("PUBLIC_EGRESS") }),
+			wp: pool(),
+		},
+		"AnnotationsDiffer": {
+			p:  poolParams(func(p *v1alpha1.WorkerPoolParameters) { p.Annotations = map[string]string{"team": "platform"} }),
+			wp: pool(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsWorkerPoolUpToDate(*tc.p, *tc.wp); got != tc.want {
+				t.Errorf("IsWorkerPoolUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,270 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudfunctions
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitchellh/copystructure"
+	cloudfunctions "google.golang.org/api/cloudfunctions/v2"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane/provider-gcp/apis/cloudfunctions/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	functionNameFormat = "projects/%s/locations/%s/functions/%s"
+	parentFormat       = "projects/%s/locations/%s"
+
+	errCheckUpToDate = "unable to determine if external resource is up to date"
+)
+
+// UpdateMask is the set of Function fields that can be updated in place.
+const UpdateMask = "description,labels,buildConfig,serviceConfig"
+
+// GetFullyQualifiedParent builds the fully qualified name of the function
+// parent.
+func GetFullyQualifiedParent(project string, p v1alpha1.FunctionParameters) string {
+	return fmt.Sprintf(parentFormat, project, p.Location)
+}
+
+// GetFullyQualifiedName builds the fully qualified name of the function.
+func GetFullyQualifiedName(project string, p v1alpha1.FunctionParameters, name string) string {
+	return fmt.Sprintf(functionNameFormat, project, p.Location, name)
+}
+
+// GenerateFunction is used to convert Crossplane FunctionParameters to GCP's
+// Function object. Name must be a fully qualified name for the function.
+func GenerateFunction(name string, p v1alpha1.FunctionParameters, f *cloudfunctions.Function) {
+	f.Name = name
+	f.Description = gcp.StringValue(p.Description)
+	f.Labels = p.Labels
+	f.KmsKeyName = gcp.StringValue(p.KMSKeyName)
+	if f.BuildConfig == nil {
+		f.BuildConfig = &cloudfunctions.BuildConfig{}
+	}
+	generateBuildConfig(p.BuildConfig, f.BuildConfig)
+	if p.ServiceConfig != nil {
+		if f.ServiceConfig == nil {
+			f.ServiceConfig = &cloudfunctions.ServiceConfig{}
+		}
+		generateServiceConfig(*p.ServiceConfig, f.ServiceConfig)
+	}
+	if p.EventTrigger != nil {
+		if f.EventTrigger == nil {
+			f.EventTrigger = &cloudfunctions.EventTrigger{}
+		}
+		generateEventTrigger(*p.EventTrigger, f.EventTrigger)
+	}
+}
+
+func generateBuildConfig(in v1alpha1.BuildConfig, bc *cloudfunctions.BuildConfig) {
+	bc.Runtime = in.Runtime
+	bc.EntryPoint = in.EntryPoint
+	bc.EnvironmentVariables = in.EnvironmentVariables
+	bc.DockerRepository = gcp.StringValue(in.DockerRepository)
+	observed := bc.Source
+	bc.Source = &cloudfunctions.Source{}
+	if s := in.Source.StorageSource; s != nil {
+		bc.Source.StorageSource = &cloudfunctions.StorageSource{
+			Bucket:     s.Bucket,
+			Object:     s.Object,
+			Generation: gcp.Int64Value(s.Generation),
+		}
+		// The latest generation of the object is used when none is given, so
+		// we keep whatever generation was deployed for the same object.
+		if s.Generation == nil && observed != nil && observed.StorageSource != nil &&
+			observed.StorageSource.Bucket == s.Bucket && observed.StorageSource.Object == s.Object {
+			bc.Source.StorageSource.Generation = observed.StorageSource.Generation
+		}
+	}
+	if s := in.Source.RepoSource; s != nil {
+		bc.Source.RepoSource = &cloudfunctions.RepoSource{
+			ProjectId:  gcp.StringValue(s.ProjectID),
+			RepoName:   s.RepoName,
+			BranchName: gcp.StringValue(s.BranchName),
+			TagName:    gcp.StringValue(s.TagName),
+			CommitSha:  gcp.StringValue(s.CommitSHA),
+			Dir:        gcp.StringValue(s.Dir),
+		}
+	}
+}
+
+func generateServiceConfig(in v1alpha1.ServiceConfig, sc *cloudfunctions.ServiceConfig) { // nolint:gocyclo
+	if in.AvailableMemory != nil {
+		sc.AvailableMemory = *in.AvailableMemory
+	}
+	if in.AvailableCPU != nil {
+		sc.AvailableCpu = *in.AvailableCPU
+	}
+	if in.TimeoutSeconds != nil {
+		sc.TimeoutSeconds = *in.TimeoutSeconds
+	}
+	// Scaling down to zero instances is meaningful, so we send the minimum
+	// instance count whenever it is set.
+	if in.MinInstanceCount != nil {
+		sc.MinInstanceCount = *in.MinInstanceCount
+		sc.ForceSendFields = []string{"MinInstanceCount"}
+	}
+	if in.MaxInstanceCount != nil {
+		sc.MaxInstanceCount = *in.MaxInstanceCount
+	}
+	if in.MaxInstanceRequestConcurrency != nil {
+		sc.MaxInstanceRequestConcurrency = *in.MaxInstanceRequestConcurrency
+	}
+	if in.IngressSettings != nil {
+		sc.IngressSettings = *in.IngressSettings
+	}
+	if in.VPCConnector != nil {
+		sc.VpcConnector = *in.VPCConnector
+	}
+	if in.VPCConnectorEgressSettings != nil {
+		sc.VpcConnectorEgressSettings = *in.VPCConnectorEgressSettings
+	}
+	if in.ServiceAccountEmail != nil {
+		sc.ServiceAccountEmail = *in.ServiceAccountEmail
+	}
+	if in.AllTrafficOnLatestRevision != nil {
+		sc.AllTrafficOnLatestRevision = *in.AllTrafficOnLatestRevision
+	}
+	sc.EnvironmentVariables = in.EnvironmentVariables
+	sc.SecretEnvironmentVariables = nil
+	for _, s := range in.SecretEnvironmentVariables {
+		sc.SecretEnvironmentVariables = append(sc.SecretEnvironmentVariables, &cloudfunctions.SecretEnvVar{
+			Key:       s.Key,
+			ProjectId: gcp.StringValue(s.ProjectID),
+			Secret:    s.Secret,
+			Version:   s.Version,
+		})
+	}
+	sc.SecretVolumes = nil
+	for _, s := range in.SecretVolumes {
+		v := &cloudfunctions.SecretVolume{
+			MountPath: s.MountPath,
+			ProjectId: gcp.StringValue(s.ProjectID),
+			Secret:    s.Secret,
+		}
+		for _, sv := range s.Versions {
+			v.Versions = append(v.Versions, &cloudfunctions.SecretVersion{Path: sv.Path, Version: sv.Version})
+		}
+		sc.SecretVolumes = append(sc.SecretVolumes, v)
+	}
+}
+
+func generateEventTrigger(in v1alpha1.EventTrigger, et *cloudfunctions.EventTrigger) {
+	et.EventType = in.EventType
+	if in.PubsubTopic != nil {
+		et.PubsubTopic = *in.PubsubTopic
+	}
+	if in.ServiceAccountEmail != nil {
+		et.ServiceAccountEmail = *in.ServiceAccountEmail
+	}
+	if in.RetryPolicy != nil {
+		et.RetryPolicy = *in.RetryPolicy
+	}
+	if in.TriggerRegion != nil {
+		et.TriggerRegion = *in.TriggerRegion
+	}
+	et.EventFilters = nil
+	for _, f := range in.EventFilters {
+		et.EventFilters = append(et.EventFilters, &cloudfunctions.EventFilter{
+			Attribute: f.Attribute,
+			Value:     f.Value,
+			Operator:  gcp.StringValue(f.Operator),
+		})
+	}
+}
+
+// GenerateObservation is used to produce an observation object from GCP's
+// Function object.
+func GenerateObservation(f cloudfunctions.Function) v1alpha1.FunctionObservation {
+	o := v1alpha1.FunctionObservation{
+		Name:       f.Name,
+		State:      f.State,
+		URL:        f.Url,
+		UpdateTime: f.UpdateTime,
+	}
+	if f.BuildConfig != nil {
+		o.Build = f.BuildConfig.Build
+	}
+	if f.ServiceConfig != nil {
+		o.Service = f.ServiceConfig.Service
+		o.Revision = f.ServiceConfig.Revision
+	}
+	if f.EventTrigger != nil {
+		o.Trigger = f.EventTrigger.Trigger
+	}
+	return o
+}
+
+// LateInitializeSpec fills empty spec fields with the data retrieved from GCP.
+func LateInitializeSpec(p *v1alpha1.FunctionParameters, f cloudfunctions.Function) {
+	p.Description = gcp.LateInitializeString(p.Description, f.Description)
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, f.Labels)
+	p.KMSKeyName = gcp.LateInitializeString(p.KMSKeyName, f.KmsKeyName)
+	if f.BuildConfig != nil {
+		p.BuildConfig.EnvironmentVariables = gcp.LateInitializeStringMap(p.BuildConfig.EnvironmentVariables, f.BuildConfig.EnvironmentVariables)
+		p.BuildConfig.DockerRepository = gcp.LateInitializeString(p.BuildConfig.DockerRepository, f.BuildConfig.DockerRepository)
+	}
+	if f.ServiceConfig != nil {
+		if p.ServiceConfig == nil {
+			p.ServiceConfig = &v1alpha1.ServiceConfig{}
+		}
+		lateInitializeServiceConfig(p.ServiceConfig, *f.ServiceConfig)
+	}
+	if f.EventTrigger != nil && p.EventTrigger != nil {
+		p.EventTrigger.PubsubTopic = gcp.LateInitializeString(p.EventTrigger.PubsubTopic, f.EventTrigger.PubsubTopic)
+		p.EventTrigger.ServiceAccountEmail = gcp.LateInitializeString(p.EventTrigger.ServiceAccountEmail, f.EventTrigger.ServiceAccountEmail)
+		p.EventTrigger.RetryPolicy = gcp.LateInitializeString(p.EventTrigger.RetryPolicy, f.EventTrigger.RetryPolicy)
+		p.EventTrigger.TriggerRegion = gcp.LateInitializeString(p.EventTrigger.TriggerRegion, f.EventTrigger.TriggerRegion)
+	}
+}
+
+func lateInitializeServiceConfig(in *v1alpha1.ServiceConfig, sc cloudfunctions.ServiceConfig) {
+	in.AvailableMemory = gcp.LateInitializeString(in.AvailableMemory, sc.AvailableMemory)
+	in.AvailableCPU = gcp.LateInitializeString(in.AvailableCPU, sc.AvailableCpu)
+	in.TimeoutSeconds = gcp.LateInitializeInt64(in.TimeoutSeconds, sc.TimeoutSeconds)
+	in.MinInstanceCount = gcp.LateInitializeInt64(in.MinInstanceCount, sc.MinInstanceCount)
+	in.MaxInstanceCount = gcp.LateInitializeInt64(in.MaxInstanceCount, sc.MaxInstanceCount)
+	in.MaxInstanceRequestConcurrency = gcp.LateInitializeInt64(in.MaxInstanceRequestConcurrency, sc.MaxInstanceRequestConcurrency)
+	in.EnvironmentVariables = gcp.LateInitializeStringMap(in.EnvironmentVariables, sc.EnvironmentVariables)
+	in.IngressSettings = gcp.LateInitializeString(in.IngressSettings, sc.IngressSettings)
+	in.VPCConnector = gcp.LateInitializeString(in.VPCConnector, sc.VpcConnector)
+	in.VPCConnectorEgressSettings = gcp.LateInitializeString(in.VPCConnectorEgressSettings, sc.VpcConnectorEgressSettings)
+	in.ServiceAccountEmail = gcp.LateInitializeString(in.ServiceAccountEmail, sc.ServiceAccountEmail)
+	in.AllTrafficOnLatestRevision = gcp.LateInitializeBool(in.AllTrafficOnLatestRevision, sc.AllTrafficOnLatestRevision)
+}
+
+// IsUpToDate returns true if the supplied Kubernetes resource does not differ
+// from the supplied GCP resource.
+func IsUpToDate(name string, p *v1alpha1.FunctionParameters, observed *cloudfunctions.Function) (bool, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return true, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*cloudfunctions.Function)
+	if !ok {
+		return true, errors.New(errCheckUpToDate)
+	}
+	GenerateFunction(name, *p, desired)
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(cloudfunctions.ServiceConfig{}, "ForceSendFields")), nil
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudfunctions
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cloudfunctions "google.golang.org/api/cloudfunctions/v2"
+
+	"github.com/crossplane/provider-gcp/apis/cloudfunctions/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	fullName = "projects/coolProject/locations/us-cool1/functions/cool-function"
+	bucket   = "cool-bucket"
+	object   = "function.zip"
+)
+
+func params(m ...func(*v1alpha1.FunctionParameters)) *v1alpha1.FunctionParameters {
+	p := &v1alpha1.FunctionParameters{
+		Location:    "us-cool1",
+		Description: gcp.StringPtr("so cool"),
+		Labels:      map[string]string{"cool": "true"},
+		BuildConfig: v1alpha1.BuildConfig{
+			Runtime:    "go121",
+			EntryPoint: "Cool",
+			Source: v1alpha1.Source{
+				StorageSource: &v1alpha1.StorageSource{Bucket: bucket, Object: object},
+			},
+		},
+		ServiceConfig: &v1alpha1.ServiceConfig{
+			AvailableMemory:      gcp.StringPtr("256M"),
+			MinInstanceCount:     gcp.Int64Ptr(1),
+			MaxInstanceCount:     gcp.Int64Ptr(10),
+			EnvironmentVariables: map[string]string{"COOL": "yes"},
+			SecretEnvironmentVariables: []v1alpha1.SecretEnvVar{
+				{Key: "PASSWORD", Secret: "cool-secret", Version: "latest"},
+			},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func function(m ...func(*cloudfunctions.Function)) *cloudfunctions.Function {
+	f := &cloudfunctions.Function{
+		Name:        fullName,
+		Description: "so cool",
+		Labels:      map[string]string{"cool": "true"},
+		BuildConfig: &cloudfunctions.BuildConfig{
+			Runtime:    "go121",
+			EntryPoint: "Cool",
+			Source: &cloudfunctions.Source{
+				StorageSource: &cloudfunctions.StorageSource{Bucket: bucket, Object: object},
+			},
+		},
+		ServiceConfig: &cloudfunctions.ServiceConfig{
+			AvailableMemory:      "256M",
+			MinInstanceCount:     1,
+			MaxInstanceCount:     10,
+			EnvironmentVariables: map[string]string{"COOL": "yes"},
+			SecretEnvironmentVariables: []*cloudfunctions.SecretEnvVar{
+				{Key: "PASSWORD", Secret: "cool-secret", Version: "latest"},
+			},
+			ForceSendFields: []string{"MinInstanceCount"},
+		},
+	}
+	for _, fn := range m {
+		fn(f)
+	}
+	return f
+}
+
+func TestGenerateFunction(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.FunctionParameters
+		want *cloudfunctions.Function
+	}{
+		"FullConversion": {
+			p:    *params(),
+			want: function(),
+		},
+		"RepoSource": {
+			p: *params(func(p *v1alpha1.FunctionParameters) {
+				p.BuildConfig.Source = v1alpha1.Source{
+					RepoSource: &v1alpha1.RepoSource{RepoName: "cool-repo", BranchName: gcp.StringPtr("main")},
+				}
+			}),
+			want: function(func(f *cloudfunctions.Function) {
+				f.BuildConfig.Source = &cloudfunctions.Source{
+					RepoSource: &cloudfunctions.RepoSource{RepoName: "cool-repo", BranchName: "main"},
+				}
+			}),
+		},
+		"EventTrigger": {
+			p: *params(func(p *v1alpha1.FunctionParameters) {
+				p.ServiceConfig = nil
+				p.EventTrigger = &v1alpha1.EventTrigger{
+					EventType:    "google.cloud.storage.object.v1.finalized",
+					EventFilters: []v1alpha1.EventFilter{{Attribute: "bucket", Value: bucket}},
+				}
+			}),
+			want: function(func(f *cloudfunctions.Function) {
+				f.ServiceConfig = nil
+				f.EventTrigger = &cloudfunctions.EventTrigger{
+					EventType:    "google.cloud.storage.object.v1.finalized",
+					EventFilters: []*cloudfunctions.EventFilter{{Attribute: "bucket", Value: bucket}},
+				}
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := &cloudfunctions.Function{}
+			GenerateFunction(fullName, tc.p, got)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateFunction(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	f := *function(func(f *cloudfunctions.Function) {
+		f.State = v1alpha1.FunctionStateActive
+		f.Url = "https://cool-function.run.app"
+		f.BuildConfig.Build = "projects/123/locations/us-cool1/builds/abc"
+		f.ServiceConfig.Service = "projects/coolProject/locations/us-cool1/services/cool-function"
+		f.ServiceConfig.Revision = "cool-function-00001-abc"
+	})
+	want := v1alpha1.FunctionObservation{
+		Name:     fullName,
+		State:    v1alpha1.FunctionStateActive,
+		URL:      "https://cool-function.run.app",
+		Build:    "projects/123/locations/us-cool1/builds/abc",
+		Service:  "projects/coolProject/locations/us-cool1/services/cool-function",
+		Revision: "cool-function-00001-abc",
+	}
+	if diff := cmp.Diff(want, GenerateObservation(f)); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	p := params(func(p *v1alpha1.FunctionParameters) {
+		p.Description = nil
+		p.ServiceConfig.AvailableMemory = nil
+	})
+	LateInitializeSpec(p, *function(func(f *cloudfunctions.Function) {
+		f.ServiceConfig.TimeoutSeconds = 60
+	}))
+	want := params(func(p *v1alpha1.FunctionParameters) {
+		p.ServiceConfig.TimeoutSeconds = gcp.Int64Ptr(60)
+	})
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.FunctionParameters
+		f    *cloudfunctions.Function
+		want bool
+	}{
+		"UpToDate": {
+			p: params(),
+			f: function(func(f *cloudfunctions.Function) {
+				f.State = v1alpha1.FunctionStateActive
+				f.BuildConfig.Source.StorageSource.Generation = 42
+				f.ServiceConfig.ForceSendFields = nil
+				f.ServiceConfig.Uri = "https://cool-function.run.app"
+			}),
+			want: true,
+		},
+		"EnvironmentVariablesDiffer": {
+			p: params(),
+			f: function(func(f *cloudfunctions.Function) {
+				f.ServiceConfig.EnvironmentVariables = map[string]string{"COOL": "no"}
+			}),
+			want: false,
+		},
+		"SourceDiffers": {
+			p: params(),
+			f: function(func(f *cloudfunctions.Function) {
+				f.BuildConfig.Source.StorageSource.Object = "other.zip"
+			}),
+			want: false,
+		},
+		"MinInstanceCountDiffers": {
+			p: params(func(p *v1alpha1.FunctionParameters) {
+				p.ServiceConfig.MinInstanceCount = gcp.Int64Ptr(0)
+			}),
+			f:    function(),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsUpToDate(fullName, tc.p, tc.f)
+			if err != nil {
+				t.Fatalf("IsUpToDate(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
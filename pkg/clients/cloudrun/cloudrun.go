@@ -0,0 +1,144 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudrun
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	run "google.golang.org/api/run/v2"
+
+	"github.com/crossplane/provider-gcp/apis/cloudrun/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat = "projects/%s/locations/%s"
+
+	errCheckUpToDate = "unable to determine if external resource is up to date"
+)
+
+// ignoreForceSendFields ignores the ForceSendFields of all API types, which
+// are only set on the desired side of a comparison.
+var ignoreForceSendFields = cmp.FilterPath(func(p cmp.Path) bool {
+	return p.Last().String() == ".ForceSendFields"
+}, cmp.Ignore())
+
+// GetParent builds the fully qualified name of the parent of Cloud Run
+// services and jobs in the supplied location.
+func GetParent(project, location string) string {
+	return fmt.Sprintf(parentFormat, project, location)
+}
+
+// GenerateContainers overlays the supplied Containers onto the supplied
+// Cloud Run containers, which are matched by their index. Fields that are
+// not set in the supplied Containers are left untouched.
+func GenerateContainers(in []v1alpha1.Container, out []*run.GoogleCloudRunV2Container) []*run.GoogleCloudRunV2Container {
+	if len(out) != len(in) {
+		out = make([]*run.GoogleCloudRunV2Container, len(in))
+	}
+	for i := range in {
+		if out[i] == nil {
+			out[i] = &run.GoogleCloudRunV2Container{}
+		}
+		generateContainer(in[i], out[i])
+	}
+	return out
+}
+
+func generateContainer(in v1alpha1.Container, c *run.GoogleCloudRunV2Container) { // nolint:gocyclo
+	c.Image = in.Image
+	if in.Name != nil {
+		c.Name = *in.Name
+	}
+	if in.Command != nil {
+		c.Command = in.Command
+	}
+	if in.Args != nil {
+		c.Args = in.Args
+	}
+	if in.WorkingDir != nil {
+		c.WorkingDir = *in.WorkingDir
+	}
+	c.Env = nil
+	for _, e := range in.Env {
+		env := &run.GoogleCloudRunV2EnvVar{Name: e.Name, Value: gcp.StringValue(e.Value)}
+		if e.ValueSource != nil {
+			env.ValueSource = &run.GoogleCloudRunV2EnvVarSource{
+				SecretKeyRef: &run.GoogleCloudRunV2SecretKeySelector{
+					Secret:  e.ValueSource.SecretKeyRef.Secret,
+					Version: gcp.StringValue(e.ValueSource.SecretKeyRef.Version),
+				},
+			}
+		}
+		c.Env = append(c.Env, env)
+	}
+	if in.Ports != nil {
+		c.Ports = make([]*run.GoogleCloudRunV2ContainerPort, len(in.Ports))
+		for i, p := range in.Ports {
+			c.Ports[i] = &run.GoogleCloudRunV2ContainerPort{Name: gcp.StringValue(p.Name), ContainerPort: p.ContainerPort}
+		}
+	}
+	if in.Resources == nil {
+		return
+	}
+	if c.Resources == nil {
+		c.Resources = &run.GoogleCloudRunV2ResourceRequirements{}
+	}
+	if in.Resources.Limits != nil {
+		c.Resources.Limits = in.Resources.Limits
+	}
+	if in.Resources.CPUIdle != nil {
+		c.Resources.CpuIdle = *in.Resources.CPUIdle
+		c.Resources.ForceSendFields = append(c.Resources.ForceSendFields, "CpuIdle")
+	}
+	if in.Resources.StartupCPUBoost != nil {
+		c.Resources.StartupCpuBoost = *in.Resources.StartupCPUBoost
+	}
+}
+
+// GenerateVPCAccess overlays the supplied VPCAccess onto the supplied Cloud
+// Run VPC access settings.
+func GenerateVPCAccess(in *v1alpha1.VPCAccess, out *run.GoogleCloudRunV2VpcAccess) *run.GoogleCloudRunV2VpcAccess {
+	if in == nil {
+		return out
+	}
+	if out == nil {
+		out = &run.GoogleCloudRunV2VpcAccess{}
+	}
+	if in.Connector != nil {
+		out.Connector = *in.Connector
+	}
+	if in.Egress != nil {
+		out.Egress = *in.Egress
+	}
+	return out
+}
+
+// GenerateConditionObservation produces a Condition from the supplied Cloud
+// Run condition.
+func GenerateConditionObservation(c *run.GoogleCloudRunV2Condition) v1alpha1.Condition {
+	if c == nil {
+		return v1alpha1.Condition{}
+	}
+	return v1alpha1.Condition{
+		Type:    c.Type,
+		State:   c.State,
+		Reason:  c.Reason,
+		Message: c.Message,
+	}
+}
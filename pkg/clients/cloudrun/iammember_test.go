@@ -0,0 +1,172 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudrun
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	run "google.golang.org/api/run/v2"
+
+	"github.com/crossplane/provider-gcp/apis/cloudrun/v1alpha1"
+	iamv1alpha1 "github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	invoker = "roles/run.invoker"
+	viewer  = "roles/run.viewer"
+)
+
+func memberParams() v1alpha1.CloudRunServiceIAMMemberParameters {
+	return v1alpha1.CloudRunServiceIAMMemberParameters{
+		Service: gcp.StringPtr(serviceName),
+		Role:    invoker,
+		Member:  gcp.StringPtr("allUsers"),
+	}
+}
+
+func TestBindRoleToMember(t *testing.T) {
+	type want struct {
+		changed bool
+		policy  *run.GoogleIamV1Policy
+	}
+	cases := map[string]struct {
+		policy *run.GoogleIamV1Policy
+		want   want
+	}{
+		"EmptyPolicy": {
+			policy: &run.GoogleIamV1Policy{},
+			want: want{
+				changed: true,
+				policy: &run.GoogleIamV1Policy{
+					Version:  iamv1alpha1.PolicyVersion,
+					Bindings: []*run.GoogleIamV1Binding{{Role: invoker, Members: []string{"allUsers"}}},
+				},
+			},
+		},
+		"RoleExists": {
+			policy: &run.GoogleIamV1Policy{
+				Bindings: []*run.GoogleIamV1Binding{{Role: invoker, Members: []string{"user:cool@example.com"}}},
+			},
+			want: want{
+				changed: true,
+				policy: &run.GoogleIamV1Policy{
+					Version:  iamv1alpha1.PolicyVersion,
+					Bindings: []*run.GoogleIamV1Binding{{Role: invoker, Members: []string{"user:cool@example.com", "allUsers"}}},
+				},
+			},
+		},
+		"AlreadyBound": {
+			policy: &run.GoogleIamV1Policy{
+				Bindings: []*run.GoogleIamV1Binding{{Role: invoker, Members: []string{"allUsers"}}},
+			},
+			want: want{
+				changed: false,
+				policy: &run.GoogleIamV1Policy{
+					Version:  iamv1alpha1.PolicyVersion,
+					Bindings: []*run.GoogleIamV1Binding{{Role: invoker, Members: []string{"allUsers"}}},
+				},
+			},
+		},
+		"ConditionalBindingIgnored": {
+			policy: &run.GoogleIamV1Policy{
+				Bindings: []*run.GoogleIamV1Binding{{Role: invoker, Members: []string{"allUsers"}, Condition: &run.GoogleTypeExpr{Expression: "true"}}},
+			},
+			want: want{
+				changed: true,
+				policy: &run.GoogleIamV1Policy{
+					Version: iamv1alpha1.PolicyVersion,
+					Bindings: []*run.GoogleIamV1Binding{
+						{Role: invoker, Members: []string{"allUsers"}, Condition: &run.GoogleTypeExpr{Expression: "true"}},
+						{Role: invoker, Members: []string{"allUsers"}},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			changed := BindRoleToMember(memberParams(), tc.policy)
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("BindRoleToMember(...): -want changed, +got changed:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.policy, tc.policy); diff != "" {
+				t.Errorf("BindRoleToMember(...): -want policy, +got policy:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUnbindRoleFromMember(t *testing.T) {
+	type want struct {
+		changed bool
+		policy  *run.GoogleIamV1Policy
+	}
+	cases := map[string]struct {
+		policy *run.GoogleIamV1Policy
+		want   want
+	}{
+		"NotBound": {
+			policy: &run.GoogleIamV1Policy{
+				Bindings: []*run.GoogleIamV1Binding{{Role: viewer, Members: []string{"allUsers"}}},
+			},
+			want: want{
+				changed: false,
+				policy: &run.GoogleIamV1Policy{
+					Bindings: []*run.GoogleIamV1Binding{{Role: viewer, Members: []string{"allUsers"}}},
+				},
+			},
+		},
+		"OtherMembersRemain": {
+			policy: &run.GoogleIamV1Policy{
+				Bindings: []*run.GoogleIamV1Binding{{Role: invoker, Members: []string{"allUsers", "user:cool@example.com"}}},
+			},
+			want: want{
+				changed: true,
+				policy: &run.GoogleIamV1Policy{
+					Bindings: []*run.GoogleIamV1Binding{{Role: invoker, Members: []string{"user:cool@example.com"}}},
+				},
+			},
+		},
+		"LastMemberRemovesBinding": {
+			policy: &run.GoogleIamV1Policy{
+				Bindings: []*run.GoogleIamV1Binding{
+					{Role: viewer, Members: []string{"allUsers"}},
+					{Role: invoker, Members: []string{"allUsers"}},
+				},
+			},
+			want: want{
+				changed: true,
+				policy: &run.GoogleIamV1Policy{
+					Bindings: []*run.GoogleIamV1Binding{{Role: viewer, Members: []string{"allUsers"}}},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			changed := UnbindRoleFromMember(memberParams(), tc.policy)
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("UnbindRoleFromMember(...): -want changed, +got changed:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.policy, tc.policy); diff != "" {
+				t.Errorf("UnbindRoleFromMember(...): -want policy, +got policy:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudrun
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitchellh/copystructure"
+	run "google.golang.org/api/run/v2"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane/provider-gcp/apis/cloudrun/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const jobNameFormat = "projects/%s/locations/%s/jobs/%s"
+
+// GetJobName builds the fully qualified name of the job.
+func GetJobName(project string, p v1alpha1.JobParameters, name string) string {
+	return fmt.Sprintf(jobNameFormat, project, p.Location, name)
+}
+
+// GenerateJob overlays the supplied JobParameters onto the supplied Cloud
+// Run Job. Name must be a fully qualified name for the job.
+func GenerateJob(name string, p v1alpha1.JobParameters, j *run.GoogleCloudRunV2Job) {
+	j.Name = name
+	j.Labels = p.Labels
+	if j.Template == nil {
+		j.Template = &run.GoogleCloudRunV2ExecutionTemplate{}
+	}
+	et := j.Template
+	et.Labels = p.Template.Labels
+	if p.Template.Parallelism != nil {
+		et.Parallelism = *p.Template.Parallelism
+	}
+	if p.Template.TaskCount != nil {
+		et.TaskCount = *p.Template.TaskCount
+	}
+	if et.Template == nil {
+		et.Template = &run.GoogleCloudRunV2TaskTemplate{}
+	}
+	in, tt := p.Template.Template, et.Template
+	tt.Containers = GenerateContainers(in.Containers, tt.Containers)
+	tt.VpcAccess = GenerateVPCAccess(in.VPCAccess, tt.VpcAccess)
+	// A task that must not be retried is configured with zero retries, so
+	// we send the retry count whenever it is set.
+	if in.MaxRetries != nil {
+		tt.MaxRetries = *in.MaxRetries
+		tt.ForceSendFields = []string{"MaxRetries"}
+	}
+	if in.Timeout != nil {
+		tt.Timeout = *in.Timeout
+	}
+	if in.ServiceAccount != nil {
+		tt.ServiceAccount = *in.ServiceAccount
+	}
+	if in.ExecutionEnvironment != nil {
+		tt.ExecutionEnvironment = *in.ExecutionEnvironment
+	}
+	if in.EncryptionKey != nil {
+		tt.EncryptionKey = *in.EncryptionKey
+	}
+}
+
+// GenerateJobObservation is used to produce an observation object from GCP's
+// Cloud Run Job object.
+func GenerateJobObservation(j run.GoogleCloudRunV2Job) v1alpha1.JobObservation {
+	o := v1alpha1.JobObservation{
+		Name:               j.Name,
+		UID:                j.Uid,
+		Generation:         j.Generation,
+		ObservedGeneration: j.ObservedGeneration,
+		Reconciling:        j.Reconciling,
+		TerminalCondition:  GenerateConditionObservation(j.TerminalCondition),
+		ExecutionCount:     j.ExecutionCount,
+	}
+	if j.LatestCreatedExecution != nil {
+		o.LatestCreatedExecution = j.LatestCreatedExecution.Name
+	}
+	return o
+}
+
+// LateInitializeJob fills empty spec fields with the data retrieved from GCP.
+func LateInitializeJob(p *v1alpha1.JobParameters, j run.GoogleCloudRunV2Job) {
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, j.Labels)
+	if j.Template == nil {
+		return
+	}
+	p.Template.Parallelism = gcp.LateInitializeInt64(p.Template.Parallelism, j.Template.Parallelism)
+	p.Template.TaskCount = gcp.LateInitializeInt64(p.Template.TaskCount, j.Template.TaskCount)
+	if j.Template.Template == nil {
+		return
+	}
+	t := &p.Template.Template
+	t.MaxRetries = gcp.LateInitializeInt64(t.MaxRetries, j.Template.Template.MaxRetries)
+	t.Timeout = gcp.LateInitializeString(t.Timeout, j.Template.Template.Timeout)
+	t.ServiceAccount = gcp.LateInitializeString(t.ServiceAccount, j.Template.Template.ServiceAccount)
+	t.ExecutionEnvironment = gcp.LateInitializeString(t.ExecutionEnvironment, j.Template.Template.ExecutionEnvironment)
+}
+
+// IsJobUpToDate returns true if the supplied Kubernetes resource does not
+// differ from the supplied GCP resource.
+func IsJobUpToDate(name string, p *v1alpha1.JobParameters, observed *run.GoogleCloudRunV2Job) (bool, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return true, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*run.GoogleCloudRunV2Job)
+	if !ok {
+		return true, errors.New(errCheckUpToDate)
+	}
+	GenerateJob(name, *p, desired)
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(), ignoreForceSendFields), nil
+}
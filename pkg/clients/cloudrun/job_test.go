@@ -0,0 +1,156 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudrun
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	run "google.golang.org/api/run/v2"
+
+	"github.com/crossplane/provider-gcp/apis/cloudrun/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const jobName = "projects/coolProject/locations/us-cool1/jobs/cool-job"
+
+func jobParams(m ...func(*v1alpha1.JobParameters)) *v1alpha1.JobParameters {
+	p := &v1alpha1.JobParameters{
+		Location: "us-cool1",
+		Labels:   map[string]string{"cool": "true"},
+		Template: v1alpha1.ExecutionTemplate{
+			TaskCount:   gcp.Int64Ptr(3),
+			Parallelism: gcp.Int64Ptr(3),
+			Template: v1alpha1.TaskTemplate{
+				Containers: []v1alpha1.Container{{Image: image, Args: []string{"--cool"}}},
+				MaxRetries: gcp.Int64Ptr(0),
+				Timeout:    gcp.StringPtr("600s"),
+			},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func job(m ...func(*run.GoogleCloudRunV2Job)) *run.GoogleCloudRunV2Job {
+	j := &run.GoogleCloudRunV2Job{
+		Name:   jobName,
+		Labels: map[string]string{"cool": "true"},
+		Template: &run.GoogleCloudRunV2ExecutionTemplate{
+			TaskCount:   3,
+			Parallelism: 3,
+			Template: &run.GoogleCloudRunV2TaskTemplate{
+				Containers:      []*run.GoogleCloudRunV2Container{{Image: image, Args: []string{"--cool"}}},
+				MaxRetries:      0,
+				Timeout:         "600s",
+				ForceSendFields: []string{"MaxRetries"},
+			},
+		},
+	}
+	for _, f := range m {
+		f(j)
+	}
+	return j
+}
+
+func TestGenerateJob(t *testing.T) {
+	got := &run.GoogleCloudRunV2Job{}
+	GenerateJob(jobName, *jobParams(), got)
+	if diff := cmp.Diff(job(), got); diff != "" {
+		t.Errorf("GenerateJob(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateJobObservation(t *testing.T) {
+	j := *job(func(j *run.GoogleCloudRunV2Job) {
+		j.Uid = "cool-uid"
+		j.ExecutionCount = 2
+		j.LatestCreatedExecution = &run.GoogleCloudRunV2ExecutionReference{Name: "cool-job-abc12"}
+		j.TerminalCondition = &run.GoogleCloudRunV2Condition{Type: "Ready", State: v1alpha1.ConditionStateSucceeded}
+	})
+	want := v1alpha1.JobObservation{
+		Name:                   jobName,
+		UID:                    "cool-uid",
+		ExecutionCount:         2,
+		LatestCreatedExecution: "cool-job-abc12",
+		TerminalCondition:      v1alpha1.Condition{Type: "Ready", State: v1alpha1.ConditionStateSucceeded},
+	}
+	if diff := cmp.Diff(want, GenerateJobObservation(j)); diff != "" {
+		t.Errorf("GenerateJobObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeJob(t *testing.T) {
+	p := jobParams(func(p *v1alpha1.JobParameters) {
+		p.Template.Parallelism = nil
+		p.Template.Template.Timeout = nil
+	})
+	LateInitializeJob(p, *job(func(j *run.GoogleCloudRunV2Job) {
+		j.Template.Template.ServiceAccount = "cool@coolProject.iam.gserviceaccount.com"
+	}))
+	want := jobParams(func(p *v1alpha1.JobParameters) {
+		p.Template.Template.ServiceAccount = gcp.StringPtr("cool@coolProject.iam.gserviceaccount.com")
+	})
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("LateInitializeJob(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsJobUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.JobParameters
+		j    *run.GoogleCloudRunV2Job
+		want bool
+	}{
+		"UpToDate": {
+			p: jobParams(),
+			j: job(func(j *run.GoogleCloudRunV2Job) {
+				j.ExecutionCount = 4
+				j.Template.Template.ForceSendFields = nil
+				j.Template.Template.Containers[0].Resources = &run.GoogleCloudRunV2ResourceRequirements{Limits: map[string]string{"cpu": "1000m"}}
+			}),
+			want: true,
+		},
+		"ArgsDiffer": {
+			p: jobParams(),
+			j: job(func(j *run.GoogleCloudRunV2Job) {
+				j.Template.Template.Containers[0].Args = []string{"--uncool"}
+			}),
+			want: false,
+		},
+		"TaskCountDiffers": {
+			p: jobParams(),
+			j: job(func(j *run.GoogleCloudRunV2Job) {
+				j.Template.TaskCount = 1
+			}),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsJobUpToDate(jobName, tc.p, tc.j)
+			if err != nil {
+				t.Fatalf("IsJobUpToDate(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsJobUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
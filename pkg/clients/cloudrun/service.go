@@ -0,0 +1,163 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudrun
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitchellh/copystructure"
+	run "google.golang.org/api/run/v2"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane/provider-gcp/apis/cloudrun/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const serviceNameFormat = "projects/%s/locations/%s/services/%s"
+
+// GetServiceName builds the fully qualified name of the service.
+func GetServiceName(project string, p v1alpha1.ServiceParameters, name string) string {
+	return fmt.Sprintf(serviceNameFormat, project, p.Location, name)
+}
+
+// GenerateService overlays the supplied ServiceParameters onto the supplied
+// Cloud Run Service. Name must be a fully qualified name for the service.
+func GenerateService(name string, p v1alpha1.ServiceParameters, s *run.GoogleCloudRunV2Service) {
+	s.Name = name
+	s.Description = gcp.StringValue(p.Description)
+	s.Labels = p.Labels
+	if p.Ingress != nil {
+		s.Ingress = *p.Ingress
+	}
+	if s.Template == nil {
+		s.Template = &run.GoogleCloudRunV2RevisionTemplate{}
+	}
+	generateRevisionTemplate(p.Template, s.Template)
+	if p.Traffic != nil {
+		s.Traffic = make([]*run.GoogleCloudRunV2TrafficTarget, len(p.Traffic))
+		for i, t := range p.Traffic {
+			s.Traffic[i] = &run.GoogleCloudRunV2TrafficTarget{
+				Type:     t.Type,
+				Revision: gcp.StringValue(t.Revision),
+				Percent:  gcp.Int64Value(t.Percent),
+				Tag:      gcp.StringValue(t.Tag),
+			}
+			// Tagged targets may deliberately receive no traffic.
+			if t.Percent != nil {
+				s.Traffic[i].ForceSendFields = []string{"Percent"}
+			}
+		}
+	}
+}
+
+func generateRevisionTemplate(in v1alpha1.RevisionTemplate, t *run.GoogleCloudRunV2RevisionTemplate) {
+	t.Labels = in.Labels
+	t.Containers = GenerateContainers(in.Containers, t.Containers)
+	t.VpcAccess = GenerateVPCAccess(in.VPCAccess, t.VpcAccess)
+	if in.Scaling != nil {
+		if t.Scaling == nil {
+			t.Scaling = &run.GoogleCloudRunV2RevisionScaling{}
+		}
+		// Scaling down to zero instances is meaningful, so we send the
+		// minimum instance count whenever it is set.
+		if in.Scaling.MinInstanceCount != nil {
+			t.Scaling.MinInstanceCount = *in.Scaling.MinInstanceCount
+			t.Scaling.ForceSendFields = []string{"MinInstanceCount"}
+		}
+		if in.Scaling.MaxInstanceCount != nil {
+			t.Scaling.MaxInstanceCount = *in.Scaling.MaxInstanceCount
+		}
+	}
+	if in.ServiceAccount != nil {
+		t.ServiceAccount = *in.ServiceAccount
+	}
+	if in.Timeout != nil {
+		t.Timeout = *in.Timeout
+	}
+	if in.MaxInstanceRequestConcurrency != nil {
+		t.MaxInstanceRequestConcurrency = *in.MaxInstanceRequestConcurrency
+	}
+	if in.ExecutionEnvironment != nil {
+		t.ExecutionEnvironment = *in.ExecutionEnvironment
+	}
+	if in.EncryptionKey != nil {
+		t.EncryptionKey = *in.EncryptionKey
+	}
+}
+
+// GenerateServiceObservation is used to produce an observation object from
+// GCP's Cloud Run Service object.
+func GenerateServiceObservation(s run.GoogleCloudRunV2Service) v1alpha1.ServiceObservation {
+	o := v1alpha1.ServiceObservation{
+		Name:                  s.Name,
+		UID:                   s.Uid,
+		Generation:            s.Generation,
+		ObservedGeneration:    s.ObservedGeneration,
+		URI:                   s.Uri,
+		Reconciling:           s.Reconciling,
+		TerminalCondition:     GenerateConditionObservation(s.TerminalCondition),
+		LatestReadyRevision:   s.LatestReadyRevision,
+		LatestCreatedRevision: s.LatestCreatedRevision,
+	}
+	for _, t := range s.TrafficStatuses {
+		if t == nil {
+			continue
+		}
+		o.TrafficStatuses = append(o.TrafficStatuses, v1alpha1.TrafficTargetStatus{
+			Type:     t.Type,
+			Revision: t.Revision,
+			Percent:  t.Percent,
+			Tag:      t.Tag,
+			URI:      t.Uri,
+		})
+	}
+	return o
+}
+
+// LateInitializeService fills empty spec fields with the data retrieved from
+// GCP.
+func LateInitializeService(p *v1alpha1.ServiceParameters, s run.GoogleCloudRunV2Service) {
+	p.Description = gcp.LateInitializeString(p.Description, s.Description)
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, s.Labels)
+	p.Ingress = gcp.LateInitializeString(p.Ingress, s.Ingress)
+	if s.Template == nil {
+		return
+	}
+	t := &p.Template
+	t.ServiceAccount = gcp.LateInitializeString(t.ServiceAccount, s.Template.ServiceAccount)
+	t.Timeout = gcp.LateInitializeString(t.Timeout, s.Template.Timeout)
+	t.MaxInstanceRequestConcurrency = gcp.LateInitializeInt64(t.MaxInstanceRequestConcurrency, s.Template.MaxInstanceRequestConcurrency)
+	t.ExecutionEnvironment = gcp.LateInitializeString(t.ExecutionEnvironment, s.Template.ExecutionEnvironment)
+}
+
+// IsServiceUpToDate returns true if the supplied Kubernetes resource does not
+// differ from the supplied GCP resource.
+func IsServiceUpToDate(name string, p *v1alpha1.ServiceParameters, observed *run.GoogleCloudRunV2Service) (bool, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return true, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*run.GoogleCloudRunV2Service)
+	if !ok {
+		return true, errors.New(errCheckUpToDate)
+	}
+	GenerateService(name, *p, desired)
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(), ignoreForceSendFields), nil
+}
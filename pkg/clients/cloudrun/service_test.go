@@ -0,0 +1,221 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudrun
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	run "google.golang.org/api/run/v2"
+
+	"github.com/crossplane/provider-gcp/apis/cloudrun/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	serviceName = "projects/coolProject/locations/us-cool1/services/cool-service"
+	image       = "us-docker.pkg.dev/cloudrun/container/hello"
+	connector   = "projects/coolProject/locations/us-cool1/connectors/cool-connector"
+)
+
+func serviceParams(m ...func(*v1alpha1.ServiceParameters)) *v1alpha1.ServiceParameters {
+	p := &v1alpha1.ServiceParameters{
+		Location:    "us-cool1",
+		Description: gcp.StringPtr("so cool"),
+		Labels:      map[string]string{"cool": "true"},
+		Ingress:     gcp.StringPtr("INGRESS_TRAFFIC_ALL"),
+		Template: v1alpha1.RevisionTemplate{
+			Containers: []v1alpha1.Container{{
+				Image: image,
+				Env: []v1alpha1.EnvVar{
+					{Name: "COOL", Value: gcp.StringPtr("yes")},
+					{Name: "PASSWORD", ValueSource: &v1alpha1.EnvVarSource{
+						SecretKeyRef: v1alpha1.SecretKeySelector{Secret: "cool-secret", Version: gcp.StringPtr("latest")},
+					}},
+				},
+				Resources: &v1alpha1.ResourceRequirements{Limits: map[string]string{"memory": "1Gi"}},
+			}},
+			Scaling:        &v1alpha1.RevisionScaling{MinInstanceCount: gcp.Int64Ptr(0), MaxInstanceCount: gcp.Int64Ptr(5)},
+			VPCAccess:      &v1alpha1.VPCAccess{Connector: gcp.StringPtr(connector), Egress: gcp.StringPtr("PRIVATE_RANGES_ONLY")},
+			ServiceAccount: gcp.StringPtr("cool@coolProject.iam.gserviceaccount.com"),
+			Timeout:        gcp.StringPtr("300s"),
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func service(m ...func(*run.GoogleCloudRunV2Service)) *run.GoogleCloudRunV2Service {
+	s := &run.GoogleCloudRunV2Service{
+		Name:        serviceName,
+		Description: "so cool",
+		Labels:      map[string]string{"cool": "true"},
+		Ingress:     "INGRESS_TRAFFIC_ALL",
+		Template: &run.GoogleCloudRunV2RevisionTemplate{
+			Containers: []*run.GoogleCloudRunV2Container{{
+				Image: image,
+				Env: []*run.GoogleCloudRunV2EnvVar{
+					{Name: "COOL", Value: "yes"},
+					{Name: "PASSWORD", ValueSource: &run.GoogleCloudRunV2EnvVarSource{
+						SecretKeyRef: &run.GoogleCloudRunV2SecretKeySelector{Secret: "cool-secret", Version: "latest"},
+					}},
+				},
+				Resources: &run.GoogleCloudRunV2ResourceRequirements{Limits: map[string]string{"memory": "1Gi"}},
+			}},
+			Scaling:        &run.GoogleCloudRunV2RevisionScaling{MinInstanceCount: 0, MaxInstanceCount: 5, ForceSendFields: []string{"MinInstanceCount"}},
+			VpcAccess:      &run.GoogleCloudRunV2VpcAccess{Connector: connector, Egress: "PRIVATE_RANGES_ONLY"},
+			ServiceAccount: "cool@coolProject.iam.gserviceaccount.com",
+			Timeout:        "300s",
+		},
+	}
+	for _, f := range m {
+		f(s)
+	}
+	return s
+}
+
+func TestGenerateService(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.ServiceParameters
+		want *run.GoogleCloudRunV2Service
+	}{
+		"FullConversion": {
+			p:    *serviceParams(),
+			want: service(),
+		},
+		"Traffic": {
+			p: *serviceParams(func(p *v1alpha1.ServiceParameters) {
+				p.Traffic = []v1alpha1.TrafficTarget{
+					{Type: "TRAFFIC_TARGET_ALLOCATION_TYPE_LATEST", Percent: gcp.Int64Ptr(100)},
+					{Type: "TRAFFIC_TARGET_ALLOCATION_TYPE_REVISION", Revision: gcp.StringPtr("cool-service-00001"), Percent: gcp.Int64Ptr(0), Tag: gcp.StringPtr("old")},
+				}
+			}),
+			want: service(func(s *run.GoogleCloudRunV2Service) {
+				s.Traffic = []*run.GoogleCloudRunV2TrafficTarget{
+					{Type: "TRAFFIC_TARGET_ALLOCATION_TYPE_LATEST", Percent: 100, ForceSendFields: []string{"Percent"}},
+					{Type: "TRAFFIC_TARGET_ALLOCATION_TYPE_REVISION", Revision: "cool-service-00001", Tag: "old", ForceSendFields: []string{"Percent"}},
+				}
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := &run.GoogleCloudRunV2Service{}
+			GenerateService(serviceName, tc.p, got)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateService(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateServiceObservation(t *testing.T) {
+	s := *service(func(s *run.GoogleCloudRunV2Service) {
+		s.Uid = "cool-uid"
+		s.Uri = "https://cool-service-abc123-uc.a.run.app"
+		s.LatestReadyRevision = "cool-service-00002"
+		s.TerminalCondition = &run.GoogleCloudRunV2Condition{Type: "Ready", State: v1alpha1.ConditionStateSucceeded}
+		s.TrafficStatuses = []*run.GoogleCloudRunV2TrafficTargetStatus{
+			{Type: "TRAFFIC_TARGET_ALLOCATION_TYPE_LATEST", Percent: 100},
+		}
+	})
+	want := v1alpha1.ServiceObservation{
+		Name:                serviceName,
+		UID:                 "cool-uid",
+		URI:                 "https://cool-service-abc123-uc.a.run.app",
+		LatestReadyRevision: "cool-service-00002",
+		TerminalCondition:   v1alpha1.Condition{Type: "Ready", State: v1alpha1.ConditionStateSucceeded},
+		TrafficStatuses: []v1alpha1.TrafficTargetStatus{
+			{Type: "TRAFFIC_TARGET_ALLOCATION_TYPE_LATEST", Percent: 100},
+		},
+	}
+	if diff := cmp.Diff(want, GenerateServiceObservation(s)); diff != "" {
+		t.Errorf("GenerateServiceObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeService(t *testing.T) {
+	p := serviceParams(func(p *v1alpha1.ServiceParameters) {
+		p.Ingress = nil
+		p.Template.ServiceAccount = nil
+	})
+	LateInitializeService(p, *service(func(s *run.GoogleCloudRunV2Service) {
+		s.Template.MaxInstanceRequestConcurrency = 80
+	}))
+	want := serviceParams(func(p *v1alpha1.ServiceParameters) {
+		p.Template.MaxInstanceRequestConcurrency = gcp.Int64Ptr(80)
+	})
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("LateInitializeService(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsServiceUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.ServiceParameters
+		s    *run.GoogleCloudRunV2Service
+		want bool
+	}{
+		"UpToDate": {
+			p: serviceParams(),
+			s: service(func(s *run.GoogleCloudRunV2Service) {
+				s.Uri = "https://cool-service-abc123-uc.a.run.app"
+				s.Template.Scaling.ForceSendFields = nil
+				s.Template.Containers[0].Ports = []*run.GoogleCloudRunV2ContainerPort{{Name: "http1", ContainerPort: 8080}}
+				s.Template.Containers[0].Resources.Limits = map[string]string{"memory": "1Gi"}
+				s.Traffic = []*run.GoogleCloudRunV2TrafficTarget{{Type: "TRAFFIC_TARGET_ALLOCATION_TYPE_LATEST", Percent: 100}}
+			}),
+			want: true,
+		},
+		"ImageDiffers": {
+			p: serviceParams(),
+			s: service(func(s *run.GoogleCloudRunV2Service) {
+				s.Template.Containers[0].Image = "gcr.io/cool/other"
+			}),
+			want: false,
+		},
+		"ScalingDiffers": {
+			p: serviceParams(),
+			s: service(func(s *run.GoogleCloudRunV2Service) {
+				s.Template.Scaling.MinInstanceCount = 1
+			}),
+			want: false,
+		},
+		"TrafficDiffers": {
+			p: serviceParams(func(p *v1alpha1.ServiceParameters) {
+				p.Traffic = []v1alpha1.TrafficTarget{{Type: "TRAFFIC_TARGET_ALLOCATION_TYPE_REVISION", Revision: gcp.StringPtr("cool-service-00001"), Percent: gcp.Int64Ptr(100)}}
+			}),
+			s: service(func(s *run.GoogleCloudRunV2Service) {
+				s.Traffic = []*run.GoogleCloudRunV2TrafficTarget{{Type: "TRAFFIC_TARGET_ALLOCATION_TYPE_LATEST", Percent: 100}}
+			}),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsServiceUpToDate(serviceName, tc.p, tc.s)
+			if err != nil {
+				t.Fatalf("IsServiceUpToDate(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsServiceUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
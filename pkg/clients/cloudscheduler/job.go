@@ -0,0 +1,239 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudscheduler
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitchellh/copystructure"
+	cloudscheduler "google.golang.org/api/cloudscheduler/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane/provider-gcp/apis/cloudscheduler/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/topic"
+)
+
+const (
+	jobNameFormat = "projects/%s/locations/%s/jobs/%s"
+	parentFormat  = "projects/%s/locations/%s"
+
+	errCheckUpToDate = "unable to determine if external resource is up to date"
+)
+
+// UpdateMask is the set of Job fields that can be updated in place.
+const UpdateMask = "description,schedule,timeZone,attemptDeadline,retryConfig,httpTarget,pubsubTarget,appEngineHttpTarget"
+
+// GetFullyQualifiedParent builds the fully qualified name of the job parent.
+func GetFullyQualifiedParent(project string, p v1alpha1.JobParameters) string {
+	return fmt.Sprintf(parentFormat, project, p.Location)
+}
+
+// GetFullyQualifiedName builds the fully qualified name of the job.
+func GetFullyQualifiedName(project string, p v1alpha1.JobParameters, name string) string {
+	return fmt.Sprintf(jobNameFormat, project, p.Location, name)
+}
+
+// GenerateJob overlays the supplied JobParameters onto the supplied Cloud
+// Scheduler Job. Name must be a fully qualified name for the job, and project
+// is used to qualify the name of a Pub/Sub topic.
+func GenerateJob(name, project string, p v1alpha1.JobParameters, j *cloudscheduler.Job) {
+	j.Name = name
+	j.Description = gcp.StringValue(p.Description)
+	j.Schedule = p.Schedule
+	if p.TimeZone != nil {
+		j.TimeZone = *p.TimeZone
+	}
+	if p.AttemptDeadline != nil {
+		j.AttemptDeadline = *p.AttemptDeadline
+	}
+	if p.RetryConfig != nil {
+		if j.RetryConfig == nil {
+			j.RetryConfig = &cloudscheduler.RetryConfig{}
+		}
+		generateRetryConfig(*p.RetryConfig, j.RetryConfig)
+	}
+
+	// A job has exactly one target, so any target that is not in the spec
+	// is removed.
+	j.HttpTarget = generateHTTPTarget(p.HTTPTarget, j.HttpTarget)
+	j.AppEngineHttpTarget = generateAppEngineHTTPTarget(p.AppEngineHTTPTarget, j.AppEngineHttpTarget)
+	j.PubsubTarget = nil
+	if t := p.PubsubTarget; t != nil {
+		j.PubsubTarget = &cloudscheduler.PubsubTarget{
+			TopicName:  topic.GetFullyQualifiedName(project, gcp.StringValue(t.Topic)),
+			Data:       encode(t.Data),
+			Attributes: t.Attributes,
+		}
+	}
+}
+
+func generateRetryConfig(in v1alpha1.RetryConfig, rc *cloudscheduler.RetryConfig) {
+	// Not retrying at all is meaningful, so we send the retry count
+	// whenever it is set.
+	if in.RetryCount != nil {
+		rc.RetryCount = *in.RetryCount
+		rc.ForceSendFields = []string{"RetryCount"}
+	}
+	if in.MaxRetryDuration != nil {
+		rc.MaxRetryDuration = *in.MaxRetryDuration
+	}
+	if in.MinBackoffDuration != nil {
+		rc.MinBackoffDuration = *in.MinBackoffDuration
+	}
+	if in.MaxBackoffDuration != nil {
+		rc.MaxBackoffDuration = *in.MaxBackoffDuration
+	}
+	if in.MaxDoublings != nil {
+		rc.MaxDoublings = *in.MaxDoublings
+	}
+}
+
+func generateHTTPTarget(in *v1alpha1.HTTPTarget, t *cloudscheduler.HttpTarget) *cloudscheduler.HttpTarget { // nolint:gocyclo
+	if in == nil {
+		return nil
+	}
+	if t == nil {
+		t = &cloudscheduler.HttpTarget{}
+	}
+	t.Uri = in.URI
+	if in.HTTPMethod != nil {
+		t.HttpMethod = *in.HTTPMethod
+	}
+	if in.Headers != nil {
+		t.Headers = in.Headers
+	}
+	t.Body = encode(in.Body)
+	t.OidcToken = nil
+	if in.OIDCToken != nil {
+		t.OidcToken = &cloudscheduler.OidcToken{
+			ServiceAccountEmail: gcp.StringValue(in.OIDCToken.ServiceAccountEmail),
+			Audience:            gcp.StringValue(in.OIDCToken.Audience),
+		}
+	}
+	t.OauthToken = nil
+	if in.OAuthToken != nil {
+		t.OauthToken = &cloudscheduler.OAuthToken{
+			ServiceAccountEmail: gcp.StringValue(in.OAuthToken.ServiceAccountEmail),
+			Scope:               gcp.StringValue(in.OAuthToken.Scope),
+		}
+	}
+	return t
+}
+
+func generateAppEngineHTTPTarget(in *v1alpha1.AppEngineHTTPTarget, t *cloudscheduler.AppEngineHttpTarget) *cloudscheduler.AppEngineHttpTarget { // nolint:gocyclo
+	if in == nil {
+		return nil
+	}
+	if t == nil {
+		t = &cloudscheduler.AppEngineHttpTarget{}
+	}
+	if in.HTTPMethod != nil {
+		t.HttpMethod = *in.HTTPMethod
+	}
+	if in.RelativeURI != nil {
+		t.RelativeUri = *in.RelativeURI
+	}
+	if in.Headers != nil {
+		t.Headers = in.Headers
+	}
+	t.Body = encode(in.Body)
+	if r := in.AppEngineRouting; r != nil {
+		if t.AppEngineRouting == nil {
+			t.AppEngineRouting = &cloudscheduler.AppEngineRouting{}
+		}
+		if r.Service != nil {
+			t.AppEngineRouting.Service = *r.Service
+		}
+		if r.Version != nil {
+			t.AppEngineRouting.Version = *r.Version
+		}
+		if r.Instance != nil {
+			t.AppEngineRouting.Instance = *r.Instance
+		}
+	}
+	return t
+}
+
+// encode returns the supplied payload in the base64 encoding that is
+// expected by the API.
+func encode(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return base64.StdEncoding.EncodeToString([]byte(*s))
+}
+
+// GenerateObservation is used to produce an observation object from GCP's
+// Job object.
+func GenerateObservation(j cloudscheduler.Job) v1alpha1.JobObservation {
+	o := v1alpha1.JobObservation{
+		Name:            j.Name,
+		State:           j.State,
+		ScheduleTime:    j.ScheduleTime,
+		LastAttemptTime: j.LastAttemptTime,
+		UserUpdateTime:  j.UserUpdateTime,
+	}
+	if j.Status != nil {
+		o.LastAttemptStatus = &v1alpha1.AttemptStatus{Code: j.Status.Code, Message: j.Status.Message}
+	}
+	return o
+}
+
+// LateInitializeSpec fills empty spec fields with the data retrieved from GCP.
+func LateInitializeSpec(p *v1alpha1.JobParameters, j cloudscheduler.Job) {
+	p.Description = gcp.LateInitializeString(p.Description, j.Description)
+	p.TimeZone = gcp.LateInitializeString(p.TimeZone, j.TimeZone)
+	p.AttemptDeadline = gcp.LateInitializeString(p.AttemptDeadline, j.AttemptDeadline)
+	if j.RetryConfig != nil {
+		if p.RetryConfig == nil {
+			p.RetryConfig = &v1alpha1.RetryConfig{}
+		}
+		rc := p.RetryConfig
+		rc.RetryCount = gcp.LateInitializeInt64(rc.RetryCount, j.RetryConfig.RetryCount)
+		rc.MaxRetryDuration = gcp.LateInitializeString(rc.MaxRetryDuration, j.RetryConfig.MaxRetryDuration)
+		rc.MinBackoffDuration = gcp.LateInitializeString(rc.MinBackoffDuration, j.RetryConfig.MinBackoffDuration)
+		rc.MaxBackoffDuration = gcp.LateInitializeString(rc.MaxBackoffDuration, j.RetryConfig.MaxBackoffDuration)
+		rc.MaxDoublings = gcp.LateInitializeInt64(rc.MaxDoublings, j.RetryConfig.MaxDoublings)
+	}
+	if p.HTTPTarget != nil && j.HttpTarget != nil {
+		p.HTTPTarget.HTTPMethod = gcp.LateInitializeString(p.HTTPTarget.HTTPMethod, j.HttpTarget.HttpMethod)
+	}
+	if p.AppEngineHTTPTarget != nil && j.AppEngineHttpTarget != nil {
+		p.AppEngineHTTPTarget.HTTPMethod = gcp.LateInitializeString(p.AppEngineHTTPTarget.HTTPMethod, j.AppEngineHttpTarget.HttpMethod)
+	}
+}
+
+// IsUpToDate returns true if the supplied Kubernetes resource does not differ
+// from the supplied GCP resource.
+func IsUpToDate(name, project string, p *v1alpha1.JobParameters, observed *cloudscheduler.Job) (bool, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return true, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*cloudscheduler.Job)
+	if !ok {
+		return true, errors.New(errCheckUpToDate)
+	}
+	GenerateJob(name, project, *p, desired)
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(cloudscheduler.RetryConfig{}, "ForceSendFields")), nil
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudscheduler
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cloudscheduler "google.golang.org/api/cloudscheduler/v1"
+
+	"github.com/crossplane/provider-gcp/apis/cloudscheduler/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	project  = "coolProject"
+	fullName = "projects/coolProject/locations/us-cool1/jobs/cool-job"
+	email    = "cool@coolProject.iam.gserviceaccount.com"
+)
+
+func params(m ...func(*v1alpha1.JobParameters)) *v1alpha1.JobParameters {
+	p := &v1alpha1.JobParameters{
+		Location:        "us-cool1",
+		Description:     gcp.StringPtr("so cool"),
+		Schedule:        "0 * * * *",
+		TimeZone:        gcp.StringPtr("Europe/Berlin"),
+		AttemptDeadline: gcp.StringPtr("180s"),
+		RetryConfig:     &v1alpha1.RetryConfig{RetryCount: gcp.Int64Ptr(0)},
+		HTTPTarget: &v1alpha1.HTTPTarget{
+			URI:        "https://cool.run.app/cron",
+			HTTPMethod: gcp.StringPtr("POST"),
+			Body:       gcp.StringPtr("cool"),
+			OIDCToken:  &v1alpha1.OIDCToken{ServiceAccountEmail: gcp.StringPtr(email)},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func job(m ...func(*cloudscheduler.Job)) *cloudscheduler.Job {
+	j := &cloudscheduler.Job{
+		Name:            fullName,
+		Description:     "so cool",
+		Schedule:        "0 * * * *",
+		TimeZone:        "Europe/Berlin",
+		AttemptDeadline: "180s",
+		RetryConfig:     &cloudscheduler.RetryConfig{ForceSendFields: []string{"RetryCount"}},
+		HttpTarget: &cloudscheduler.HttpTarget{
+			Uri:        "https://cool.run.app/cron",
+			HttpMethod: "POST",
+			Body:       "Y29vbA==",
+			OidcToken:  &cloudscheduler.OidcToken{ServiceAccountEmail: email},
+		},
+	}
+	for _, f := range m {
+		f(j)
+	}
+	return j
+}
+
+func TestGenerateJob(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.JobParameters
+		want *cloudscheduler.Job
+	}{
+		"HTTPTarget": {
+			p:    *params(),
+			want: job(),
+		},
+		"PubsubTarget": {
+			p: *params(func(p *v1alpha1.JobParameters) {
+				p.HTTPTarget = nil
+				p.PubsubTarget = &v1alpha1.PubsubTarget{
+					Topic:      gcp.StringPtr("cool-topic"),
+					Data:       gcp.StringPtr("cool"),
+					Attributes: map[string]string{"cool": "true"},
+				}
+			}),
+			want: job(func(j *cloudscheduler.Job) {
+				j.HttpTarget = nil
+				j.PubsubTarget = &cloudscheduler.PubsubTarget{
+					TopicName:  "projects/coolProject/topics/cool-topic",
+					Data:       "Y29vbA==",
+					Attributes: map[string]string{"cool": "true"},
+				}
+			}),
+		},
+		"AppEngineHTTPTarget": {
+			p: *params(func(p *v1alpha1.JobParameters) {
+				p.HTTPTarget = nil
+				p.AppEngineHTTPTarget = &v1alpha1.AppEngineHTTPTarget{
+					RelativeURI:      gcp.StringPtr("/cron"),
+					AppEngineRouting: &v1alpha1.AppEngineRouting{Service: gcp.StringPtr("worker")},
+				}
+			}),
+			want: job(func(j *cloudscheduler.Job) {
+				j.HttpTarget = nil
+				j.AppEngineHttpTarget = &cloudscheduler.AppEngineHttpTarget{
+					RelativeUri:      "/cron",
+					AppEngineRouting: &cloudscheduler.AppEngineRouting{Service: "worker"},
+				}
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := &cloudscheduler.Job{}
+			GenerateJob(fullName, project, tc.p, got)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateJob(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	j := *job(func(j *cloudscheduler.Job) {
+		j.State = v1alpha1.JobStateEnabled
+		j.ScheduleTime = "2021-06-01T10:00:00Z"
+		j.Status = &cloudscheduler.Status{Code: 5, Message: "not found"}
+	})
+	want := v1alpha1.JobObservation{
+		Name:              fullName,
+		State:             v1alpha1.JobStateEnabled,
+		ScheduleTime:      "2021-06-01T10:00:00Z",
+		LastAttemptStatus: &v1alpha1.AttemptStatus{Code: 5, Message: "not found"},
+	}
+	if diff := cmp.Diff(want, GenerateObservation(j)); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	p := params(func(p *v1alpha1.JobParameters) {
+		p.TimeZone = nil
+		p.RetryConfig = nil
+		p.HTTPTarget.HTTPMethod = nil
+	})
+	LateInitializeSpec(p, *job(func(j *cloudscheduler.Job) {
+		j.RetryConfig = &cloudscheduler.RetryConfig{RetryCount: 3, MinBackoffDuration: "5s"}
+	}))
+	want := params(func(p *v1alpha1.JobParameters) {
+		p.RetryConfig = &v1alpha1.RetryConfig{RetryCount: gcp.Int64Ptr(3), MinBackoffDuration: gcp.StringPtr("5s")}
+	})
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.JobParameters
+		j    *cloudscheduler.Job
+		want bool
+	}{
+		"UpToDate": {
+			p: params(),
+			j: job(func(j *cloudscheduler.Job) {
+				j.State = v1alpha1.JobStateEnabled
+				j.RetryConfig = &cloudscheduler.RetryConfig{MinBackoffDuration: "5s", MaxDoublings: 5}
+			}),
+			want: true,
+		},
+		"ScheduleDiffers": {
+			p: params(),
+			j: job(func(j *cloudscheduler.Job) {
+				j.Schedule = "*/5 * * * *"
+			}),
+			want: false,
+		},
+		"BodyDiffers": {
+			p: params(),
+			j: job(func(j *cloudscheduler.Job) {
+				j.HttpTarget.Body = "dW5jb29s"
+			}),
+			want: false,
+		},
+		"TargetDiffers": {
+			p: params(),
+			j: job(func(j *cloudscheduler.Job) {
+				j.HttpTarget = nil
+				j.PubsubTarget = &cloudscheduler.PubsubTarget{TopicName: "projects/coolProject/topics/cool-topic"}
+			}),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsUpToDate(fullName, project, tc.p, tc.j)
+			if err != nil {
+				t.Fatalf("IsUpToDate(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package cloudsql
 
 import (
+	"fmt"
+	"net/url"
 	"strings"
 
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
@@ -322,6 +324,21 @@ func IsUpToDate(name string, in *v1beta1.CloudSQLInstanceParameters, observed *s
 	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(), cmpopts.IgnoreFields(sqladmin.DatabaseInstance{}, "Settings.IpConfiguration.ForceSendFields")), nil
 }
 
+// Diff returns the paths of the fields of the observed database instance that differ from
+// the given set of parameters, e.g. to explain why it is not up-to-date.
+func Diff(name string, in *v1beta1.CloudSQLInstanceParameters, observed *sqladmin.DatabaseInstance) ([]string, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return nil, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*sqladmin.DatabaseInstance)
+	if !ok {
+		return nil, errors.New(errCheckUpToDate)
+	}
+	GenerateDatabaseInstance(name, *in, desired)
+	return gcp.Diff(desired, observed, cmpopts.EquateEmpty()), nil
+}
+
 // DatabaseUserName returns default database user name base on database version
 func DatabaseUserName(p v1beta1.CloudSQLInstanceParameters) string {
 	if strings.HasPrefix(gcp.StringValue(p.DatabaseVersion), v1beta1.PostgresqlDBVersionPrefix) {
@@ -330,6 +347,28 @@ func DatabaseUserName(p v1beta1.CloudSQLInstanceParameters) string {
 	return v1beta1.MysqlDefaultUser
 }
 
+// ProxyDSN returns a DSN of the default database user with the supplied
+// password that connects to a Cloud SQL Auth proxy listening on localhost. It
+// returns an empty string for database engines other than MySQL and
+// PostgreSQL.
+func ProxyDSN(p v1beta1.CloudSQLInstanceParameters, password string) string {
+	v := gcp.StringValue(p.DatabaseVersion)
+	switch {
+	case strings.HasPrefix(v, v1beta1.PostgresqlDBVersionPrefix):
+		u := url.URL{
+			Scheme:   "postgres",
+			User:     url.UserPassword(v1beta1.PostgresqlDefaultUser, password),
+			Host:     "127.0.0.1:5432",
+			Path:     v1beta1.PostgresqlDefaultUser,
+			RawQuery: "sslmode=disable",
+		}
+		return u.String()
+	case v == "" || strings.HasPrefix(v, v1beta1.MysqlDBVersionPrefix):
+		return fmt.Sprintf("%s:%s@tcp(127.0.0.1:3306)/", v1beta1.MysqlDefaultUser, password)
+	}
+	return ""
+}
+
 // GetServerCACertificate takes sqladmin.DatabaseInstance and returns the server CA certificate
 // in a form that can be embedded directly into a connection secret.
 func GetServerCACertificate(in sqladmin.DatabaseInstance) map[string][]byte {
@@ -0,0 +1,175 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudtasks
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitchellh/copystructure"
+	cloudtasks "google.golang.org/api/cloudtasks/v2"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane/provider-gcp/apis/cloudtasks/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	queueNameFormat = "projects/%s/locations/%s/queues/%s"
+	parentFormat    = "projects/%s/locations/%s"
+
+	errCheckUpToDate      = "unable to determine if external resource is up to date"
+	errParseDispatchRate  = "cannot parse maxDispatchesPerSecond"
+	errParseSamplingRatio = "cannot parse samplingRatio"
+)
+
+// UpdateMask is the set of Queue fields that can be updated in place. The
+// state of a queue is changed by pausing or resuming it instead.
+const UpdateMask = "rateLimits,retryConfig,stackdriverLoggingConfig"
+
+// GetFullyQualifiedParent builds the fully qualified name of the queue parent.
+func GetFullyQualifiedParent(project string, p v1alpha1.QueueParameters) string {
+	return fmt.Sprintf(parentFormat, project, p.Location)
+}
+
+// GetFullyQualifiedName builds the fully qualified name of the queue.
+func GetFullyQualifiedName(project string, p v1alpha1.QueueParameters, name string) string {
+	return fmt.Sprintf(queueNameFormat, project, p.Location, name)
+}
+
+// GenerateQueue overlays the supplied QueueParameters onto the supplied Cloud
+// Tasks Queue. Name must be a fully qualified name for the queue.
+func GenerateQueue(name string, p v1alpha1.QueueParameters, q *cloudtasks.Queue) error { // nolint:gocyclo
+	q.Name = name
+	if rl := p.RateLimits; rl != nil {
+		if q.RateLimits == nil {
+			q.RateLimits = &cloudtasks.RateLimits{}
+		}
+		if rl.MaxDispatchesPerSecond != nil {
+			v, err := strconv.ParseFloat(*rl.MaxDispatchesPerSecond, 64)
+			if err != nil {
+				return errors.Wrap(err, errParseDispatchRate)
+			}
+			q.RateLimits.MaxDispatchesPerSecond = v
+		}
+		if rl.MaxConcurrentDispatches != nil {
+			q.RateLimits.MaxConcurrentDispatches = *rl.MaxConcurrentDispatches
+		}
+	}
+	if rc := p.RetryConfig; rc != nil {
+		if q.RetryConfig == nil {
+			q.RetryConfig = &cloudtasks.RetryConfig{}
+		}
+		if rc.MaxAttempts != nil {
+			q.RetryConfig.MaxAttempts = *rc.MaxAttempts
+		}
+		if rc.MaxRetryDuration != nil {
+			q.RetryConfig.MaxRetryDuration = *rc.MaxRetryDuration
+		}
+		if rc.MinBackoff != nil {
+			q.RetryConfig.MinBackoff = *rc.MinBackoff
+		}
+		if rc.MaxBackoff != nil {
+			q.RetryConfig.MaxBackoff = *rc.MaxBackoff
+		}
+		if rc.MaxDoublings != nil {
+			q.RetryConfig.MaxDoublings = *rc.MaxDoublings
+		}
+	}
+	if lc := p.StackdriverLoggingConfig; lc != nil {
+		v, err := strconv.ParseFloat(lc.SamplingRatio, 64)
+		if err != nil {
+			return errors.Wrap(err, errParseSamplingRatio)
+		}
+		// Logging nothing is meaningful, so we send the ratio even if it is
+		// zero.
+		q.StackdriverLoggingConfig = &cloudtasks.StackdriverLoggingConfig{
+			SamplingRatio:   v,
+			ForceSendFields: []string{"SamplingRatio"},
+		}
+	}
+	return nil
+}
+
+// GenerateObservation is used to produce an observation object from GCP's
+// Queue object.
+func GenerateObservation(q cloudtasks.Queue) v1alpha1.QueueObservation {
+	o := v1alpha1.QueueObservation{
+		Name:      q.Name,
+		State:     q.State,
+		PurgeTime: q.PurgeTime,
+	}
+	if q.RateLimits != nil {
+		o.MaxBurstSize = q.RateLimits.MaxBurstSize
+	}
+	return o
+}
+
+// LateInitializeSpec fills empty spec fields with the data retrieved from GCP.
+func LateInitializeSpec(p *v1alpha1.QueueParameters, q cloudtasks.Queue) {
+	if q.RateLimits != nil {
+		if p.RateLimits == nil {
+			p.RateLimits = &v1alpha1.RateLimits{}
+		}
+		rl := p.RateLimits
+		if rl.MaxDispatchesPerSecond == nil && q.RateLimits.MaxDispatchesPerSecond != 0 {
+			rl.MaxDispatchesPerSecond = gcp.StringPtr(strconv.FormatFloat(q.RateLimits.MaxDispatchesPerSecond, 'f', -1, 64))
+		}
+		rl.MaxConcurrentDispatches = gcp.LateInitializeInt64(rl.MaxConcurrentDispatches, q.RateLimits.MaxConcurrentDispatches)
+	}
+	if q.RetryConfig != nil {
+		if p.RetryConfig == nil {
+			p.RetryConfig = &v1alpha1.RetryConfig{}
+		}
+		rc := p.RetryConfig
+		rc.MaxAttempts = gcp.LateInitializeInt64(rc.MaxAttempts, q.RetryConfig.MaxAttempts)
+		rc.MaxRetryDuration = gcp.LateInitializeString(rc.MaxRetryDuration, q.RetryConfig.MaxRetryDuration)
+		rc.MinBackoff = gcp.LateInitializeString(rc.MinBackoff, q.RetryConfig.MinBackoff)
+		rc.MaxBackoff = gcp.LateInitializeString(rc.MaxBackoff, q.RetryConfig.MaxBackoff)
+		rc.MaxDoublings = gcp.LateInitializeInt64(rc.MaxDoublings, q.RetryConfig.MaxDoublings)
+	}
+}
+
+// IsUpToDate returns true if the supplied Kubernetes resource does not differ
+// from the supplied GCP resource, including whether the queue is paused.
+func IsUpToDate(name string, p *v1alpha1.QueueParameters, observed *cloudtasks.Queue) (bool, error) {
+	if !IsStateUpToDate(p, observed.State) {
+		return false, nil
+	}
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return true, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*cloudtasks.Queue)
+	if !ok {
+		return true, errors.New(errCheckUpToDate)
+	}
+	if err := GenerateQueue(name, *p, desired); err != nil {
+		return true, errors.Wrap(err, errCheckUpToDate)
+	}
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(cloudtasks.StackdriverLoggingConfig{}, "ForceSendFields")), nil
+}
+
+// IsStateUpToDate returns true if the queue does not need to be paused or
+// resumed.
+func IsStateUpToDate(p *v1alpha1.QueueParameters, state string) bool {
+	return p.DesiredState == nil || *p.DesiredState == state
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudtasks
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cloudtasks "google.golang.org/api/cloudtasks/v2"
+
+	"github.com/crossplane/provider-gcp/apis/cloudtasks/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const fullName = "projects/coolProject/locations/us-cool1/queues/cool-queue"
+
+func params(m ...func(*v1alpha1.QueueParameters)) *v1alpha1.QueueParameters {
+	p := &v1alpha1.QueueParameters{
+		Location: "us-cool1",
+		RateLimits: &v1alpha1.RateLimits{
+			MaxDispatchesPerSecond:  gcp.StringPtr("0.5"),
+			MaxConcurrentDispatches: gcp.Int64Ptr(10),
+		},
+		RetryConfig: &v1alpha1.RetryConfig{
+			MaxAttempts: gcp.Int64Ptr(5),
+			MinBackoff:  gcp.StringPtr("0.100s"),
+		},
+		StackdriverLoggingConfig: &v1alpha1.StackdriverLoggingConfig{SamplingRatio: "0"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func queue(m ...func(*cloudtasks.Queue)) *cloudtasks.Queue {
+	q := &cloudtasks.Queue{
+		Name: fullName,
+		RateLimits: &cloudtasks.RateLimits{
+			MaxDispatchesPerSecond:  0.5,
+			MaxConcurrentDispatches: 10,
+		},
+		RetryConfig: &cloudtasks.RetryConfig{
+			MaxAttempts: 5,
+			MinBackoff:  "0.100s",
+		},
+		StackdriverLoggingConfig: &cloudtasks.StackdriverLoggingConfig{ForceSendFields: []string{"SamplingRatio"}},
+	}
+	for _, f := range m {
+		f(q)
+	}
+	return q
+}
+
+func TestGenerateQueue(t *testing.T) {
+	type want struct {
+		q   *cloudtasks.Queue
+		err bool
+	}
+	cases := map[string]struct {
+		p    v1alpha1.QueueParameters
+		want want
+	}{
+		"FullConversion": {
+			p:    *params(),
+			want: want{q: queue()},
+		},
+		"NoLogging": {
+			p: *params(func(p *v1alpha1.QueueParameters) {
+				p.StackdriverLoggingConfig = nil
+			}),
+			want: want{q: queue(func(q *cloudtasks.Queue) {
+				q.StackdriverLoggingConfig = nil
+			})},
+		},
+		"InvalidDispatchRate": {
+			p: *params(func(p *v1alpha1.QueueParameters) {
+				p.RateLimits.MaxDispatchesPerSecond = gcp.StringPtr("fast")
+			}),
+			want: want{err: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := &cloudtasks.Queue{}
+			err := GenerateQueue(fullName, tc.p, got)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Fatalf("GenerateQueue(...): -want error, +got error:\n%s", diff)
+			}
+			if tc.want.err {
+				return
+			}
+			if diff := cmp.Diff(tc.want.q, got); diff != "" {
+				t.Errorf("GenerateQueue(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	q := *queue(func(q *cloudtasks.Queue) {
+		q.State = v1alpha1.QueueStateRunning
+		q.RateLimits.MaxBurstSize = 100
+	})
+	want := v1alpha1.QueueObservation{
+		Name:         fullName,
+		State:        v1alpha1.QueueStateRunning,
+		MaxBurstSize: 100,
+	}
+	if diff := cmp.Diff(want, GenerateObservation(q)); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	p := params(func(p *v1alpha1.QueueParameters) {
+		p.RateLimits = nil
+		p.RetryConfig.MinBackoff = nil
+	})
+	LateInitializeSpec(p, *queue())
+	if diff := cmp.Diff(params(), p); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.QueueParameters
+		q    *cloudtasks.Queue
+		want bool
+	}{
+		"UpToDate": {
+			p: params(),
+			q: queue(func(q *cloudtasks.Queue) {
+				q.State = v1alpha1.QueueStateRunning
+				q.RateLimits.MaxBurstSize = 100
+				q.StackdriverLoggingConfig.ForceSendFields = nil
+			}),
+			want: true,
+		},
+		"RateLimitsDiffer": {
+			p: params(),
+			q: queue(func(q *cloudtasks.Queue) {
+				q.RateLimits.MaxDispatchesPerSecond = 500
+			}),
+			want: false,
+		},
+		"SamplingRatioDiffers": {
+			p: params(),
+			q: queue(func(q *cloudtasks.Queue) {
+				q.StackdriverLoggingConfig.SamplingRatio = 1
+			}),
+			want: false,
+		},
+		"ShouldBePaused": {
+			p: params(func(p *v1alpha1.QueueParameters) {
+				p.DesiredState = gcp.StringPtr(v1alpha1.QueueStatePaused)
+			}),
+			q: queue(func(q *cloudtasks.Queue) {
+				q.State = v1alpha1.QueueStateRunning
+			}),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsUpToDate(fullName, tc.p, tc.q)
+			if err != nil {
+				t.Fatalf("IsUpToDate(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -49,8 +49,9 @@ const (
 )
 
 const (
-	errNoSecretInfo  = "missing secret information for GKE cluster"
-	errCheckUpToDate = "unable to determine if external resource is up to date"
+	errNoSecretInfo       = "missing secret information for GKE cluster"
+	errCheckUpToDate      = "unable to determine if external resource is up to date"
+	errAutopilotImmutable = "autopilot can only be set when a GKE cluster is created"
 )
 
 // AddNodePoolForCreate inserts the default node pool into *container.Cluster so
@@ -519,6 +520,8 @@ func GenerateObservation(in container.Cluster) v1beta2.ClusterObservation { // n
 		CurrentNodeVersion:   in.CurrentNodeVersion,
 		Endpoint:             in.Endpoint,
 		ExpireTime:           in.ExpireTime,
+		ID:                   in.Id,
+		LabelFingerprint:     in.LabelFingerprint,
 		Location:             in.Location,
 		NodeIpv4CidrSize:     in.NodeIpv4CidrSize,
 		SelfLink:             in.SelfLink,
@@ -954,13 +957,11 @@ func LateInitializeSpec(spec *v1beta2.ClusterParameters, in container.Cluster) {
 }
 
 // newAddonsConfigUpdateFn returns a function that updates the AddonsConfig of a cluster.
-func newAddonsConfigUpdateFn(in *v1beta2.AddonsConfig) UpdateFn {
+func newAddonsConfigUpdateFn(in *container.AddonsConfig) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
-		out := &container.Cluster{}
-		GenerateAddonsConfig(in, out)
 		update := &container.UpdateClusterRequest{
 			Update: &container.ClusterUpdate{
-				DesiredAddonsConfig: out.AddonsConfig,
+				DesiredAddonsConfig: in,
 			},
 		}
 		return s.Projects.Locations.Clusters.Update(name, update).Context(ctx).Do()
@@ -968,13 +969,11 @@ func newAddonsConfigUpdateFn(in *v1beta2.AddonsConfig) UpdateFn {
 }
 
 // newAutoscalingUpdateFn returns a function that updates the Autoscaling of a cluster.
-func newAutoscalingUpdateFn(in *v1beta2.ClusterAutoscaling) UpdateFn {
+func newAutoscalingUpdateFn(in *container.ClusterAutoscaling) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
-		out := &container.Cluster{}
-		GenerateAutoscaling(in, out)
 		update := &container.UpdateClusterRequest{
 			Update: &container.ClusterUpdate{
-				DesiredClusterAutoscaling: out.Autoscaling,
+				DesiredClusterAutoscaling: in,
 			},
 		}
 		return s.Projects.Locations.Clusters.Update(name, update).Context(ctx).Do()
@@ -982,41 +981,31 @@ func newAutoscalingUpdateFn(in *v1beta2.ClusterAutoscaling) UpdateFn {
 }
 
 // newBinaryAuthorizationUpdateFn returns a function that updates the BinaryAuthorization of a cluster.
-func newBinaryAuthorizationUpdateFn(in *v1beta2.BinaryAuthorization) UpdateFn {
+func newBinaryAuthorizationUpdateFn(in *container.BinaryAuthorization) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
-		out := &container.Cluster{}
-		GenerateBinaryAuthorization(in, out)
 		update := &container.UpdateClusterRequest{
 			Update: &container.ClusterUpdate{
-				DesiredBinaryAuthorization: out.BinaryAuthorization,
+				DesiredBinaryAuthorization: in,
 			},
 		}
 		return s.Projects.Locations.Clusters.Update(name, update).Context(ctx).Do()
 	}
 }
 
-// newAutopilotUpdateFn returns a function that updates the Autopilot of a cluster.
-func newAutopilotUpdateFn(in *v1beta2.Autopilot) UpdateFn {
-	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
-		out := &container.Cluster{}
-		GenerateAutopilot(in, out)
-		update := &container.UpdateClusterRequest{
-			Update: &container.ClusterUpdate{
-				DesiredAutopilot: out.Autopilot,
-			},
-		}
-		return s.Projects.Locations.Clusters.Update(name, update).Context(ctx).Do()
+// newAutopilotUpdateFn returns a function that reports that the Autopilot of
+// a cluster can't be updated. The container/v1 API has no DesiredAutopilot.
+func newAutopilotUpdateFn() UpdateFn {
+	return func(_ context.Context, _ *container.Service, _ string) (*container.Operation, error) {
+		return nil, errors.New(errAutopilotImmutable)
 	}
 }
 
 // newDatabaseEncryptionUpdateFn returns a function that updates the DatabaseEncryption of a cluster.
-func newDatabaseEncryptionUpdateFn(in *v1beta2.DatabaseEncryption) UpdateFn {
+func newDatabaseEncryptionUpdateFn(in *container.DatabaseEncryption) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
-		out := &container.Cluster{}
-		GenerateDatabaseEncryption(in, out)
 		update := &container.UpdateClusterRequest{
 			Update: &container.ClusterUpdate{
-				DesiredDatabaseEncryption: out.DatabaseEncryption,
+				DesiredDatabaseEncryption: in,
 			},
 		}
 		return s.Projects.Locations.Clusters.Update(name, update).Context(ctx).Do()
@@ -1024,12 +1013,10 @@ func newDatabaseEncryptionUpdateFn(in *v1beta2.DatabaseEncryption) UpdateFn {
 }
 
 // newLegacyAbacUpdateFn returns a function that updates the LegacyAbac of a cluster.
-func newLegacyAbacUpdateFn(in *v1beta2.LegacyAbac) UpdateFn {
+func newLegacyAbacUpdateFn(in *container.LegacyAbac) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
-		out := &container.Cluster{}
-		GenerateLegacyAbac(in, out)
 		update := &container.SetLegacyAbacRequest{
-			Enabled: out.LegacyAbac.Enabled,
+			Enabled: in.Enabled,
 		}
 		return s.Projects.Locations.Clusters.SetLegacyAbac(name, update).Context(ctx).Do()
 	}
@@ -1048,11 +1035,11 @@ func newLocationsUpdateFn(in []string) UpdateFn {
 }
 
 // newLoggingServiceUpdateFn returns a function that updates the LoggingService of a cluster.
-func newLoggingServiceUpdateFn(in *string) UpdateFn {
+func newLoggingServiceUpdateFn(in string) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
 		update := &container.UpdateClusterRequest{
 			Update: &container.ClusterUpdate{
-				DesiredLoggingService: gcp.StringValue(in),
+				DesiredLoggingService: in,
 			},
 		}
 		return s.Projects.Locations.Clusters.Update(name, update).Context(ctx).Do()
@@ -1060,25 +1047,21 @@ func newLoggingServiceUpdateFn(in *string) UpdateFn {
 }
 
 // newMaintenancePolicyUpdateFn returns a function that updates the MaintenancePolicy of a cluster.
-func newMaintenancePolicyUpdateFn(in *v1beta2.MaintenancePolicySpec) UpdateFn {
+func newMaintenancePolicyUpdateFn(in *container.MaintenancePolicy) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
-		out := &container.Cluster{}
-		GenerateMaintenancePolicy(in, out)
 		update := &container.SetMaintenancePolicyRequest{
-			MaintenancePolicy: out.MaintenancePolicy,
+			MaintenancePolicy: in,
 		}
 		return s.Projects.Locations.Clusters.SetMaintenancePolicy(name, update).Context(ctx).Do()
 	}
 }
 
 // newMasterAuthorizedNetworksConfigUpdateFn returns a function that updates the MasterAuthorizedNetworksConfig of a cluster.
-func newMasterAuthorizedNetworksConfigUpdateFn(in *v1beta2.MasterAuthorizedNetworksConfig) UpdateFn {
+func newMasterAuthorizedNetworksConfigUpdateFn(in *container.MasterAuthorizedNetworksConfig) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
-		out := &container.Cluster{}
-		GenerateMasterAuthorizedNetworksConfig(in, out)
 		update := &container.UpdateClusterRequest{
 			Update: &container.ClusterUpdate{
-				DesiredMasterAuthorizedNetworksConfig: out.MasterAuthorizedNetworksConfig,
+				DesiredMasterAuthorizedNetworksConfig: in,
 			},
 		}
 		return s.Projects.Locations.Clusters.Update(name, update).Context(ctx).Do()
@@ -1086,11 +1069,11 @@ func newMasterAuthorizedNetworksConfigUpdateFn(in *v1beta2.MasterAuthorizedNetwo
 }
 
 // newMonitoringServiceUpdateFn returns a function that updates the MonitoringService of a cluster.
-func newMonitoringServiceUpdateFn(in *string) UpdateFn {
+func newMonitoringServiceUpdateFn(in string) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
 		update := &container.UpdateClusterRequest{
 			Update: &container.ClusterUpdate{
-				DesiredMonitoringService: gcp.StringValue(in),
+				DesiredMonitoringService: in,
 			},
 		}
 		return s.Projects.Locations.Clusters.Update(name, update).Context(ctx).Do()
@@ -1099,11 +1082,11 @@ func newMonitoringServiceUpdateFn(in *string) UpdateFn {
 
 // newDatapathProviderUpdateFn returns a function that updates the
 // DatapathProvider of a cluster.
-func newDatapathProviderUpdateFn(in *string) UpdateFn {
+func newDatapathProviderUpdateFn(in string) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
 		update := &container.UpdateClusterRequest{
 			Update: &container.ClusterUpdate{
-				DesiredDatapathProvider: gcp.StringValue(in),
+				DesiredDatapathProvider: in,
 			},
 		}
 		return s.Projects.Locations.Clusters.Update(name, update).Context(ctx).Do()
@@ -1112,12 +1095,12 @@ func newDatapathProviderUpdateFn(in *string) UpdateFn {
 
 // newIntraNodeVisibilityConfigUpdateFn returns a function that updates the
 // IntraNodeVisibility of a cluster.
-func newIntraNodeVisibilityConfigUpdateFn(in *bool) UpdateFn {
+func newIntraNodeVisibilityConfigUpdateFn(in bool) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
 		update := &container.UpdateClusterRequest{
 			Update: &container.ClusterUpdate{
 				DesiredIntraNodeVisibilityConfig: &container.IntraNodeVisibilityConfig{
-					Enabled: gcp.BoolValue(in),
+					Enabled: in,
 				},
 			},
 		}
@@ -1126,25 +1109,21 @@ func newIntraNodeVisibilityConfigUpdateFn(in *bool) UpdateFn {
 }
 
 // newNetworkPolicyUpdateFn returns a function that updates the NetworkPolicy of a cluster.
-func newNetworkPolicyUpdateFn(in *v1beta2.NetworkPolicy) UpdateFn {
+func newNetworkPolicyUpdateFn(in *container.NetworkPolicy) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
-		out := &container.Cluster{}
-		GenerateNetworkPolicy(in, out)
 		update := &container.SetNetworkPolicyRequest{
-			NetworkPolicy: out.NetworkPolicy,
+			NetworkPolicy: in,
 		}
 		return s.Projects.Locations.Clusters.SetNetworkPolicy(name, update).Context(ctx).Do()
 	}
 }
 
 // newNotificationConfigUpdateFn returns a function that updates the NotificationConfig of a cluster.
-func newNotificationConfigUpdateFn(in *v1beta2.NotificationConfig) UpdateFn {
+func newNotificationConfigUpdateFn(in *container.NotificationConfig) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
-		out := &container.Cluster{}
-		GenerateNotificationConfig(in, out)
 		update := &container.UpdateClusterRequest{
 			Update: &container.ClusterUpdate{
-				DesiredNotificationConfig: out.NotificationConfig,
+				DesiredNotificationConfig: in,
 			},
 		}
 		return s.Projects.Locations.Clusters.Update(name, update).Context(ctx).Do()
@@ -1152,13 +1131,11 @@ func newNotificationConfigUpdateFn(in *v1beta2.NotificationConfig) UpdateFn {
 }
 
 // newPrivateClusterConfigUpdateFn returns a function that updates the PrivateClusterConfig of a cluster.
-func newPrivateClusterConfigUpdateFn(in *v1beta2.PrivateClusterConfigSpec) UpdateFn {
+func newPrivateClusterConfigUpdateFn(in *container.PrivateClusterConfig) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
-		out := &container.Cluster{}
-		GeneratePrivateClusterConfig(in, out)
 		update := &container.UpdateClusterRequest{
 			Update: &container.ClusterUpdate{
-				DesiredPrivateClusterConfig: out.PrivateClusterConfig,
+				DesiredPrivateClusterConfig: in,
 			},
 		}
 		return s.Projects.Locations.Clusters.Update(name, update).Context(ctx).Do()
@@ -1166,13 +1143,11 @@ func newPrivateClusterConfigUpdateFn(in *v1beta2.PrivateClusterConfigSpec) Updat
 }
 
 // newReleaseChannelUpdateFn returns a function that updates the ReleaseChannel of a cluster.
-func newReleaseChannelUpdateFn(in *v1beta2.ReleaseChannel) UpdateFn {
+func newReleaseChannelUpdateFn(in *container.ReleaseChannel) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
-		out := &container.Cluster{}
-		GenerateReleaseChannel(in, out)
 		update := &container.UpdateClusterRequest{
 			Update: &container.ClusterUpdate{
-				DesiredReleaseChannel: out.ReleaseChannel,
+				DesiredReleaseChannel: in,
 			},
 		}
 		return s.Projects.Locations.Clusters.Update(name, update).Context(ctx).Do()
@@ -1190,13 +1165,11 @@ func newResourceLabelsUpdateFn(in map[string]string) UpdateFn {
 }
 
 // newResourceUsageExportConfigUpdateFn returns a function that updates the ResourceUsageExportConfig of a cluster.
-func newResourceUsageExportConfigUpdateFn(in *v1beta2.ResourceUsageExportConfig) UpdateFn {
+func newResourceUsageExportConfigUpdateFn(in *container.ResourceUsageExportConfig) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
-		out := &container.Cluster{}
-		GenerateResourceUsageExportConfig(in, out)
 		update := &container.UpdateClusterRequest{
 			Update: &container.ClusterUpdate{
-				DesiredResourceUsageExportConfig: out.ResourceUsageExportConfig,
+				DesiredResourceUsageExportConfig: in,
 			},
 		}
 		return s.Projects.Locations.Clusters.Update(name, update).Context(ctx).Do()
@@ -1204,13 +1177,11 @@ func newResourceUsageExportConfigUpdateFn(in *v1beta2.ResourceUsageExportConfig)
 }
 
 // newVerticalPodAutoscalingUpdateFn returns a function that updates the VerticalPodAutoscaling of a cluster.
-func newVerticalPodAutoscalingUpdateFn(in *v1beta2.VerticalPodAutoscaling) UpdateFn {
+func newVerticalPodAutoscalingUpdateFn(in *container.VerticalPodAutoscaling) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
-		out := &container.Cluster{}
-		GenerateVerticalPodAutoscaling(in, out)
 		update := &container.UpdateClusterRequest{
 			Update: &container.ClusterUpdate{
-				DesiredVerticalPodAutoscaling: out.VerticalPodAutoscaling,
+				DesiredVerticalPodAutoscaling: in,
 			},
 		}
 		return s.Projects.Locations.Clusters.Update(name, update).Context(ctx).Do()
@@ -1218,13 +1189,11 @@ func newVerticalPodAutoscalingUpdateFn(in *v1beta2.VerticalPodAutoscaling) Updat
 }
 
 // newWorkloadIdentityConfigUpdateFn returns a function that updates the WorkloadIdentityConfig of a cluster.
-func newWorkloadIdentityConfigUpdateFn(in *v1beta2.WorkloadIdentityConfig) UpdateFn {
+func newWorkloadIdentityConfigUpdateFn(in *container.WorkloadIdentityConfig) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
-		out := &container.Cluster{}
-		GenerateWorkloadIdentityConfig(in, out)
 		update := &container.UpdateClusterRequest{
 			Update: &container.ClusterUpdate{
-				DesiredWorkloadIdentityConfig: out.WorkloadIdentityConfig,
+				DesiredWorkloadIdentityConfig: in,
 			},
 		}
 		return s.Projects.Locations.Clusters.Update(name, update).Context(ctx).Do()
@@ -1257,12 +1226,17 @@ func checkForBootstrapNodePool(c *container.Cluster) bool {
 	return false
 }
 
+// autopilotEnabled returns true if Autopilot is enabled for the cluster.
+func autopilotEnabled(c *container.Cluster) bool {
+	return c.Autopilot != nil && c.Autopilot.Enabled
+}
+
 // IsUpToDate checks whether current state is up-to-date compared to the given
 // set of parameters.
 // NOTE(hasheddan): This function is significantly above our cyclomatic
 // complexity limit, but is necessary due to the fact that the GKE API only
 // allows for update of one field at a time.
-func IsUpToDate(name string, in *v1beta2.ClusterParameters, observed *container.Cluster) (bool, UpdateFn, error) { // nolint:gocyclo
+func IsUpToDate(name string, in *v1beta2.ClusterParameters, observed *container.Cluster, ignore ...string) (bool, UpdateFn, error) { // nolint:gocyclo
 	generated, err := copystructure.Copy(observed)
 	if err != nil {
 		return true, noOpUpdate, errors.Wrap(err, errCheckUpToDate)
@@ -1272,6 +1246,16 @@ func IsUpToDate(name string, in *v1beta2.ClusterParameters, observed *container.
 		return true, noOpUpdate, errors.New(errCheckUpToDate)
 	}
 	GenerateCluster(name, *in, desired)
+	if err := gcp.IgnoreFields(desired, observed, ignore); err != nil {
+		return true, noOpUpdate, errors.Wrap(err, errCheckUpToDate)
+	}
+	// Updates are generated from the parameters alone, but must not change
+	// the fields that are ignored either.
+	update := &container.Cluster{}
+	GenerateCluster(name, *in, update)
+	if err := gcp.IgnoreFields(update, observed, ignore); err != nil {
+		return true, noOpUpdate, errors.Wrap(err, errCheckUpToDate)
+	}
 	if checkForBootstrapNodePool(observed) {
 		return false, deleteBootstrapNodePoolFn(), nil
 	}
@@ -1284,77 +1268,95 @@ func IsUpToDate(name string, in *v1beta2.ClusterParameters, observed *container.
 		cmpopts.IgnoreFields(container.AddonsConfig{}, "HttpLoadBalancing.ForceSendFields"),
 		cmpopts.IgnoreFields(container.AddonsConfig{}, "KubernetesDashboard.ForceSendFields"),
 		cmpopts.IgnoreFields(container.AddonsConfig{}, "NetworkPolicyConfig.ForceSendFields")) {
-		return false, newAddonsConfigUpdateFn(in.AddonsConfig), nil
+		return false, newAddonsConfigUpdateFn(update.AddonsConfig), nil
 	}
-	if !cmp.Equal(desired.Autopilot, observed.Autopilot, cmpopts.EquateEmpty()) {
-		return false, newAutopilotUpdateFn(in.Autopilot), nil
+	if autopilotEnabled(desired) != autopilotEnabled(observed) {
+		return false, newAutopilotUpdateFn(), nil
 	}
 	if !cmp.Equal(desired.Autoscaling, observed.Autoscaling, cmpopts.EquateEmpty()) {
-		return false, newAutoscalingUpdateFn(in.Autoscaling), nil
+		return false, newAutoscalingUpdateFn(update.Autoscaling), nil
 	}
 	if !cmp.Equal(desired.BinaryAuthorization, observed.BinaryAuthorization, cmpopts.EquateEmpty()) {
-		return false, newBinaryAuthorizationUpdateFn(in.BinaryAuthorization), nil
+		return false, newBinaryAuthorizationUpdateFn(update.BinaryAuthorization), nil
 	}
 	if !cmp.Equal(desired.DatabaseEncryption, observed.DatabaseEncryption, cmpopts.EquateEmpty()) {
-		return false, newDatabaseEncryptionUpdateFn(in.DatabaseEncryption), nil
+		return false, newDatabaseEncryptionUpdateFn(update.DatabaseEncryption), nil
 	}
 	if !cmp.Equal(desired.LegacyAbac, observed.LegacyAbac, cmpopts.EquateEmpty()) {
-		return false, newLegacyAbacUpdateFn(in.LegacyAbac), nil
+		return false, newLegacyAbacUpdateFn(update.LegacyAbac), nil
 	}
 	if !cmp.Equal(desired.Locations, observed.Locations, cmpopts.EquateEmpty()) {
-		return false, newLocationsUpdateFn(in.Locations), nil
+		return false, newLocationsUpdateFn(update.Locations), nil
 	}
 	if !cmp.Equal(desired.LoggingService, observed.LoggingService, cmpopts.EquateEmpty()) {
-		return false, newLoggingServiceUpdateFn(in.LoggingService), nil
+		return false, newLoggingServiceUpdateFn(update.LoggingService), nil
 	}
 	if !cmp.Equal(desired.MaintenancePolicy, observed.MaintenancePolicy, cmpopts.EquateEmpty()) {
-		return false, newMaintenancePolicyUpdateFn(in.MaintenancePolicy), nil
+		return false, newMaintenancePolicyUpdateFn(update.MaintenancePolicy), nil
 	}
 	if !cmp.Equal(desired.MasterAuthorizedNetworksConfig, observed.MasterAuthorizedNetworksConfig, cmpopts.EquateEmpty()) {
-		return false, newMasterAuthorizedNetworksConfigUpdateFn(in.MasterAuthorizedNetworksConfig), nil
+		return false, newMasterAuthorizedNetworksConfigUpdateFn(update.MasterAuthorizedNetworksConfig), nil
 	}
 	if !cmp.Equal(desired.MonitoringService, observed.MonitoringService, cmpopts.EquateEmpty()) {
-		return false, newMonitoringServiceUpdateFn(in.MonitoringService), nil
+		return false, newMonitoringServiceUpdateFn(update.MonitoringService), nil
 	}
 	if desired.NetworkConfig != nil {
 		if observed.NetworkConfig == nil {
 			observed.NetworkConfig = &container.NetworkConfig{}
 		}
 		if !cmp.Equal(desired.NetworkConfig.EnableIntraNodeVisibility, observed.NetworkConfig.EnableIntraNodeVisibility, cmpopts.EquateEmpty()) {
-			return false, newIntraNodeVisibilityConfigUpdateFn(in.NetworkConfig.EnableIntraNodeVisibility), nil
+			return false, newIntraNodeVisibilityConfigUpdateFn(update.NetworkConfig.EnableIntraNodeVisibility), nil
 		}
 		if !cmp.Equal(desired.NetworkConfig.DatapathProvider, observed.NetworkConfig.DatapathProvider, cmpopts.EquateEmpty()) {
-			return false, newDatapathProviderUpdateFn(in.NetworkConfig.DatapathProvider), nil
+			return false, newDatapathProviderUpdateFn(update.NetworkConfig.DatapathProvider), nil
 		}
 	}
 
 	if !cmp.Equal(desired.NetworkPolicy, observed.NetworkPolicy, cmpopts.EquateEmpty()) {
-		return false, newNetworkPolicyUpdateFn(in.NetworkPolicy), nil
+		return false, newNetworkPolicyUpdateFn(update.NetworkPolicy), nil
 	}
 	if !cmp.Equal(desired.NotificationConfig, observed.NotificationConfig, cmpopts.EquateEmpty()) {
-		return false, newNotificationConfigUpdateFn(in.NotificationConfig), nil
+		return false, newNotificationConfigUpdateFn(update.NotificationConfig), nil
 	}
 	if !cmp.Equal(desired.PrivateClusterConfig, observed.PrivateClusterConfig, cmpopts.EquateEmpty()) {
-		return false, newPrivateClusterConfigUpdateFn(in.PrivateClusterConfig), nil
+		return false, newPrivateClusterConfigUpdateFn(update.PrivateClusterConfig), nil
 	}
 	if !cmp.Equal(desired.ReleaseChannel, observed.ReleaseChannel, cmpopts.EquateEmpty()) {
-		return false, newReleaseChannelUpdateFn(in.ReleaseChannel), nil
+		return false, newReleaseChannelUpdateFn(update.ReleaseChannel), nil
 	}
 	if !cmp.Equal(desired.ResourceLabels, observed.ResourceLabels, cmpopts.EquateEmpty()) {
-		return false, newResourceLabelsUpdateFn(in.ResourceLabels), nil
+		return false, newResourceLabelsUpdateFn(update.ResourceLabels), nil
 	}
 	if !cmp.Equal(desired.ResourceUsageExportConfig, observed.ResourceUsageExportConfig, cmpopts.EquateEmpty()) {
-		return false, newResourceUsageExportConfigUpdateFn(in.ResourceUsageExportConfig), nil
+		return false, newResourceUsageExportConfigUpdateFn(update.ResourceUsageExportConfig), nil
 	}
 	if !cmp.Equal(desired.VerticalPodAutoscaling, observed.VerticalPodAutoscaling, cmpopts.EquateEmpty()) {
-		return false, newVerticalPodAutoscalingUpdateFn(in.VerticalPodAutoscaling), nil
+		return false, newVerticalPodAutoscalingUpdateFn(update.VerticalPodAutoscaling), nil
 	}
 	if !cmp.Equal(desired.WorkloadIdentityConfig, observed.WorkloadIdentityConfig, cmpopts.EquateEmpty()) {
-		return false, newWorkloadIdentityConfigUpdateFn(in.WorkloadIdentityConfig), nil
+		return false, newWorkloadIdentityConfigUpdateFn(update.WorkloadIdentityConfig), nil
 	}
 	return true, noOpUpdate, nil
 }
 
+// Diff returns the paths of the fields of the observed Cluster that differ from
+// the given set of parameters, e.g. to explain why it is not up-to-date.
+func Diff(name string, in *v1beta2.ClusterParameters, observed *container.Cluster, ignore ...string) ([]string, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return nil, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*container.Cluster)
+	if !ok {
+		return nil, errors.New(errCheckUpToDate)
+	}
+	GenerateCluster(name, *in, desired)
+	if err := gcp.IgnoreFields(desired, observed, ignore); err != nil {
+		return nil, errors.Wrap(err, errCheckUpToDate)
+	}
+	return gcp.Diff(desired, observed, cmpopts.EquateEmpty()), nil
+}
+
 // GetFullyQualifiedParent builds the fully qualified name of the cluster
 // parent.
 func GetFullyQualifiedParent(project string, p v1beta2.ClusterParameters) string {
@@ -17,12 +17,17 @@ limitations under the License.
 package cluster
 
 import (
+	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	container "google.golang.org/api/container/v1"
+	"google.golang.org/api/option"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
@@ -102,6 +107,8 @@ func observation(m ...func(*v1beta2.ClusterObservation)) *v1beta2.ClusterObserva
 		CurrentNodeVersion:   "1.16",
 		Endpoint:             "12.12.12.12",
 		ExpireTime:           "13:13",
+		ID:                   "cluster-id",
+		LabelFingerprint:     "label-fingerprint",
 		Location:             "us-central1",
 		NodeIpv4CidrSize:     8,
 		SelfLink:             "/link/to/myself",
@@ -149,6 +156,8 @@ func addOutputFields(c *container.Cluster) {
 	c.CurrentNodeVersion = "1.16"
 	c.Endpoint = "12.12.12.12"
 	c.ExpireTime = "13:13"
+	c.Id = "cluster-id"
+	c.LabelFingerprint = "label-fingerprint"
 	c.Location = "us-central1"
 	c.NodeIpv4CidrSize = 8
 	c.SelfLink = "/link/to/myself"
@@ -1546,6 +1555,36 @@ func TestIsUpToDate(t *testing.T) {
 				isErr:    false,
 			},
 		},
+		"UpToDateAutopilotUnset": {
+			args: args{
+				name: name,
+				cluster: cluster(func(c *container.Cluster) {
+					c.Autopilot = nil
+				}),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.Autopilot = &v1beta2.Autopilot{Enabled: false}
+				}),
+			},
+			want: want{
+				upToDate: true,
+				isErr:    false,
+			},
+		},
+		"NeedsUpdateAutopilot": {
+			args: args{
+				name: name,
+				cluster: cluster(func(c *container.Cluster) {
+					c.Autopilot = &container.Autopilot{Enabled: false}
+				}),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.Autopilot = &v1beta2.Autopilot{Enabled: true}
+				}),
+			},
+			want: want{
+				upToDate: false,
+				isErr:    false,
+			},
+		},
 		"NeedsUpdate": {
 			args: args{
 				name: name,
@@ -1628,6 +1667,41 @@ func TestIsUpToDate(t *testing.T) {
 	}
 }
 
+func TestIsUpToDateUpdate(t *testing.T) {
+	var got container.UpdateClusterRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		_ = json.NewEncoder(w).Encode(&container.Operation{})
+	}))
+	defer srv.Close()
+	s, _ := container.NewService(context.Background(), option.WithEndpoint(srv.URL), option.WithoutAuthentication())
+
+	// Both the ignored autoprovisioning locations and whether node
+	// autoprovisioning is enabled differ, but only the latter may be updated.
+	observed := cluster(func(c *container.Cluster) {
+		c.Autoscaling = &container.ClusterAutoscaling{AutoprovisioningLocations: []string{"us-central1-a"}}
+	})
+	in := params(func(p *v1beta2.ClusterParameters) {
+		p.Autoscaling = &v1beta2.ClusterAutoscaling{AutoprovisioningLocations: []string{"us-central1-b"}, EnableNodeAutoprovisioning: gcp.BoolPtr(true)}
+	})
+	u, fn, err := IsUpToDate(name, in, observed, "autoscaling.autoprovisioningLocations")
+	if err != nil {
+		t.Fatalf("IsUpToDate(...): %s", err)
+	}
+	if u {
+		t.Fatalf("IsUpToDate(...): want not up to date")
+	}
+	if _, err := fn(context.Background(), s, GetFullyQualifiedName(project, *in, name)); err != nil {
+		t.Fatalf("UpdateFn(...): %s", err)
+	}
+	want := container.UpdateClusterRequest{Update: &container.ClusterUpdate{
+		DesiredClusterAutoscaling: &container.ClusterAutoscaling{AutoprovisioningLocations: []string{"us-central1-a"}, EnableNodeAutoprovisioning: true},
+	}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("UpdateFn(...): ignored fields should not be updated: -want, +got:\n%s", diff)
+	}
+}
+
 func TestGetFullyQualifiedParent(t *testing.T) {
 	type args struct {
 		project string
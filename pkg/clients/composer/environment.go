@@ -0,0 +1,225 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package composer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	composer "google.golang.org/api/composer/v1"
+
+	"github.com/crossplane/provider-gcp/apis/composer/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	environmentNameFormat = "projects/%s/locations/%s/environments/%s"
+	parentFormat          = "projects/%s/locations/%s"
+)
+
+// GetEnvironmentParent builds the fully qualified name of the parent of an
+// environment.
+func GetEnvironmentParent(project string, p v1alpha1.EnvironmentParameters) string {
+	return fmt.Sprintf(parentFormat, project, p.Location)
+}
+
+// GetEnvironmentName builds the fully qualified name of an environment.
+func GetEnvironmentName(project string, p v1alpha1.EnvironmentParameters, name string) string {
+	return fmt.Sprintf(environmentNameFormat, project, p.Location, name)
+}
+
+// GenerateEnvironment produces an Environment with the supplied fully
+// qualified name that is configured via the given EnvironmentParameters.
+func GenerateEnvironment(name string, p v1alpha1.EnvironmentParameters) *composer.Environment {
+	e := &composer.Environment{
+		Name:   name,
+		Labels: p.Labels,
+	}
+	c := p.Config
+	if c == nil {
+		return e
+	}
+	e.Config = &composer.EnvironmentConfig{
+		NodeCount:       gcp.Int64Value(c.NodeCount),
+		EnvironmentSize: gcp.StringValue(c.EnvironmentSize),
+	}
+	if nc := c.NodeConfig; nc != nil {
+		e.Config.NodeConfig = &composer.NodeConfig{
+			Location:       gcp.StringValue(nc.Location),
+			MachineType:    gcp.StringValue(nc.MachineType),
+			Network:        gcp.StringValue(nc.Network),
+			Subnetwork:     gcp.StringValue(nc.Subnetwork),
+			DiskSizeGb:     gcp.Int64Value(nc.DiskSizeGB),
+			OauthScopes:    nc.OAuthScopes,
+			ServiceAccount: gcp.StringValue(nc.ServiceAccount),
+			Tags:           nc.Tags,
+		}
+		if ip := nc.IPAllocationPolicy; ip != nil {
+			e.Config.NodeConfig.IpAllocationPolicy = &composer.IPAllocationPolicy{
+				UseIpAliases:               gcp.BoolValue(ip.UseIPAliases),
+				ClusterSecondaryRangeName:  gcp.StringValue(ip.ClusterSecondaryRangeName),
+				ServicesSecondaryRangeName: gcp.StringValue(ip.ServicesSecondaryRangeName),
+				ClusterIpv4CidrBlock:       gcp.StringValue(ip.ClusterIPv4CIDRBlock),
+				ServicesIpv4CidrBlock:      gcp.StringValue(ip.ServicesIPv4CIDRBlock),
+			}
+		}
+	}
+	if sc := c.SoftwareConfig; sc != nil {
+		e.Config.SoftwareConfig = &composer.SoftwareConfig{
+			ImageVersion:           gcp.StringValue(sc.ImageVersion),
+			AirflowConfigOverrides: sc.AirflowConfigOverrides,
+			PypiPackages:           sc.PyPIPackages,
+			EnvVariables:           sc.EnvVariables,
+			PythonVersion:          gcp.StringValue(sc.PythonVersion),
+			SchedulerCount:         gcp.Int64Value(sc.SchedulerCount),
+		}
+	}
+	if pc := c.PrivateEnvironmentConfig; pc != nil {
+		e.Config.PrivateEnvironmentConfig = &composer.PrivateEnvironmentConfig{
+			EnablePrivateEnvironment:          gcp.BoolValue(pc.EnablePrivateEnvironment),
+			WebServerIpv4CidrBlock:            gcp.StringValue(pc.WebServerIPv4CIDRBlock),
+			CloudSqlIpv4CidrBlock:             gcp.StringValue(pc.CloudSQLIPv4CIDRBlock),
+			CloudComposerNetworkIpv4CidrBlock: gcp.StringValue(pc.CloudComposerNetworkIPv4CIDRBlock),
+			EnablePrivatelyUsedPublicIps:      gcp.BoolValue(pc.EnablePrivatelyUsedPublicIPs),
+		}
+		if pcc := pc.PrivateClusterConfig; pcc != nil {
+			e.Config.PrivateEnvironmentConfig.PrivateClusterConfig = &composer.PrivateClusterConfig{
+				EnablePrivateEndpoint: gcp.BoolValue(pcc.EnablePrivateEndpoint),
+				MasterIpv4CidrBlock:   gcp.StringValue(pcc.MasterIPv4CIDRBlock),
+			}
+		}
+	}
+	return e
+}
+
+// GenerateEnvironmentObservation produces an EnvironmentObservation from the
+// supplied Environment.
+func GenerateEnvironmentObservation(e composer.Environment) v1alpha1.EnvironmentObservation {
+	o := v1alpha1.EnvironmentObservation{
+		Name:       e.Name,
+		UUID:       e.Uuid,
+		State:      e.State,
+		CreateTime: e.CreateTime,
+		UpdateTime: e.UpdateTime,
+	}
+	if e.Config != nil {
+		o.AirflowURI = e.Config.AirflowUri
+		o.DAGGCSPrefix = e.Config.DagGcsPrefix
+		o.GKECluster = e.Config.GkeCluster
+	}
+	return o
+}
+
+// LateInitializeEnvironment fills the empty fields of EnvironmentParameters
+// with the values seen in the supplied Environment.
+func LateInitializeEnvironment(p *v1alpha1.EnvironmentParameters, e composer.Environment) {
+	if e.Config == nil {
+		return
+	}
+	if p.Config == nil {
+		p.Config = &v1alpha1.EnvironmentConfig{}
+	}
+	p.Config.NodeCount = gcp.LateInitializeInt64(p.Config.NodeCount, e.Config.NodeCount)
+	p.Config.EnvironmentSize = gcp.LateInitializeString(p.Config.EnvironmentSize, e.Config.EnvironmentSize)
+	if sc := e.Config.SoftwareConfig; sc != nil && sc.ImageVersion != "" {
+		if p.Config.SoftwareConfig == nil {
+			p.Config.SoftwareConfig = &v1alpha1.SoftwareConfig{}
+		}
+		p.Config.SoftwareConfig.ImageVersion = gcp.LateInitializeString(p.Config.SoftwareConfig.ImageVersion, sc.ImageVersion)
+	}
+}
+
+// IsEnvironmentUpToDate returns true if the supplied Environment matches the
+// supplied EnvironmentParameters. Cloud Composer only accepts a single field
+// per update, so if the Environment is not up to date the update mask of the
+// first field that differs is returned too.
+func IsEnvironmentUpToDate(p v1alpha1.EnvironmentParameters, e composer.Environment) (bool, string) { // nolint:gocyclo
+	if !cmp.Equal(p.Labels, e.Labels, cmpopts.EquateEmpty()) {
+		return false, "labels"
+	}
+	c := p.Config
+	if c == nil {
+		return true, ""
+	}
+	observed := e.Config
+	if observed == nil {
+		observed = &composer.EnvironmentConfig{}
+	}
+	if c.NodeCount != nil && *c.NodeCount != observed.NodeCount {
+		return false, "config.nodeCount"
+	}
+	if c.EnvironmentSize != nil && *c.EnvironmentSize != observed.EnvironmentSize {
+		return false, "config.environmentSize"
+	}
+	sc := c.SoftwareConfig
+	if sc == nil {
+		return true, ""
+	}
+	osc := observed.SoftwareConfig
+	if osc == nil {
+		osc = &composer.SoftwareConfig{}
+	}
+	switch {
+	case sc.ImageVersion != nil && !isSameImageVersion(*sc.ImageVersion, osc.ImageVersion):
+		return false, "config.softwareConfig.imageVersion"
+	case !cmp.Equal(sc.PyPIPackages, osc.PypiPackages, cmpopts.EquateEmpty()):
+		return false, "config.softwareConfig.pypiPackages"
+	case !cmp.Equal(sc.EnvVariables, osc.EnvVariables, cmpopts.EquateEmpty()):
+		return false, "config.softwareConfig.envVariables"
+	case !cmp.Equal(sc.AirflowConfigOverrides, osc.AirflowConfigOverrides, cmpopts.EquateEmpty()):
+		return false, "config.softwareConfig.airflowConfigOverrides"
+	case sc.SchedulerCount != nil && *sc.SchedulerCount != osc.SchedulerCount:
+		return false, "config.softwareConfig.schedulerCount"
+	}
+	return true, ""
+}
+
+// isSameImageVersion returns true if the observed image version satisfies the
+// desired one. Image versions take the form composer-X.Y.Z-airflow-X.Y.Z, but
+// may be requested using a version prefix such as composer-2-airflow-2 or
+// using "latest" in place of the Cloud Composer version, which Cloud Composer
+// resolves to a specific version.
+func isSameImageVersion(desired, observed string) bool {
+	if desired == observed {
+		return true
+	}
+	d := strings.SplitN(strings.TrimPrefix(desired, "composer-"), "-airflow-", 2)
+	o := strings.SplitN(strings.TrimPrefix(observed, "composer-"), "-airflow-", 2)
+	if len(d) != 2 || len(o) != 2 {
+		return false
+	}
+	return isVersionPrefix(d[0], o[0]) && isVersionPrefix(d[1], o[1])
+}
+
+func isVersionPrefix(desired, observed string) bool {
+	if desired == "latest" {
+		return true
+	}
+	d := strings.Split(desired, ".")
+	o := strings.Split(observed, ".")
+	if len(d) > len(o) {
+		return false
+	}
+	for i := range d {
+		if d[i] != o[i] {
+			return false
+		}
+	}
+	return true
+}
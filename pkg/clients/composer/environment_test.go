@@ -0,0 +1,266 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package composer
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	composer "google.golang.org/api/composer/v1"
+
+	"github.com/crossplane/provider-gcp/apis/composer/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	project = "coolProject"
+	envName = "projects/coolProject/locations/us-cool1/environments/cool-env"
+)
+
+func params(m ...func(*v1alpha1.EnvironmentParameters)) *v1alpha1.EnvironmentParameters {
+	p := &v1alpha1.EnvironmentParameters{
+		Location: "us-cool1",
+		Labels:   map[string]string{"cool": "true"},
+		Config: &v1alpha1.EnvironmentConfig{
+			EnvironmentSize: gcp.StringPtr("ENVIRONMENT_SIZE_SMALL"),
+			NodeConfig: &v1alpha1.NodeConfig{
+				Network:        gcp.StringPtr("projects/coolProject/global/networks/cool-network"),
+				Subnetwork:     gcp.StringPtr("projects/coolProject/regions/us-cool1/subnetworks/cool-subnet"),
+				ServiceAccount: gcp.StringPtr("cool@coolProject.iam.gserviceaccount.com"),
+				IPAllocationPolicy: &v1alpha1.IPAllocationPolicy{
+					ClusterSecondaryRangeName:  gcp.StringPtr("pods"),
+					ServicesSecondaryRangeName: gcp.StringPtr("services"),
+				},
+			},
+			SoftwareConfig: &v1alpha1.SoftwareConfig{
+				ImageVersion:           gcp.StringPtr("composer-2.4.6-airflow-2.6.3"),
+				AirflowConfigOverrides: map[string]string{"core-dags_are_paused_at_creation": "True"},
+				PyPIPackages:           map[string]string{"numpy": ">=1.24"},
+				EnvVariables:           map[string]string{"COOL": "true"},
+			},
+			PrivateEnvironmentConfig: &v1alpha1.PrivateEnvironmentConfig{
+				EnablePrivateEnvironment: gcp.BoolPtr(true),
+				PrivateClusterConfig: &v1alpha1.PrivateClusterConfig{
+					MasterIPv4CIDRBlock: gcp.StringPtr("172.16.0.0/28"),
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func environment(m ...func(*composer.Environment)) *composer.Environment {
+	e := &composer.Environment{
+		Name:   envName,
+		Labels: map[string]string{"cool": "true"},
+		Config: &composer.EnvironmentConfig{
+			EnvironmentSize: "ENVIRONMENT_SIZE_SMALL",
+			NodeConfig: &composer.NodeConfig{
+				Network:        "projects/coolProject/global/networks/cool-network",
+				Subnetwork:     "projects/coolProject/regions/us-cool1/subnetworks/cool-subnet",
+				ServiceAccount: "cool@coolProject.iam.gserviceaccount.com",
+				IpAllocationPolicy: &composer.IPAllocationPolicy{
+					ClusterSecondaryRangeName:  "pods",
+					ServicesSecondaryRangeName: "services",
+				},
+			},
+			SoftwareConfig: &composer.SoftwareConfig{
+				ImageVersion:           "composer-2.4.6-airflow-2.6.3",
+				AirflowConfigOverrides: map[string]string{"core-dags_are_paused_at_creation": "True"},
+				PypiPackages:           map[string]string{"numpy": ">=1.24"},
+				EnvVariables:           map[string]string{"COOL": "true"},
+			},
+			PrivateEnvironmentConfig: &composer.PrivateEnvironmentConfig{
+				EnablePrivateEnvironment: true,
+				PrivateClusterConfig: &composer.PrivateClusterConfig{
+					MasterIpv4CidrBlock: "172.16.0.0/28",
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(e)
+	}
+	return e
+}
+
+func TestGetEnvironmentName(t *testing.T) {
+	if diff := cmp.Diff(envName, GetEnvironmentName(project, *params(), "cool-env")); diff != "" {
+		t.Errorf("GetEnvironmentName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateEnvironment(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.EnvironmentParameters
+		want *composer.Environment
+	}{
+		"FullConversion": {
+			p:    *params(),
+			want: environment(),
+		},
+		"NoConfig": {
+			p:    *params(func(p *v1alpha1.EnvironmentParameters) { p.Config = nil }),
+			want: environment(func(e *composer.Environment) { e.Config = nil }),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateEnvironment(envName, tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateEnvironment(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateEnvironmentObservation(t *testing.T) {
+	e := *environment(func(e *composer.Environment) {
+		e.Uuid = "cool-uuid"
+		e.State = v1alpha1.EnvironmentStateRunning
+		e.Config.AirflowUri = "https://cool.composer.googleusercontent.com"
+		e.Config.DagGcsPrefix = "gs://cool-bucket/dags"
+		e.Config.GkeCluster = "projects/coolProject/locations/us-cool1/clusters/cool-gke"
+	})
+	want := v1alpha1.EnvironmentObservation{
+		Name:         envName,
+		UUID:         "cool-uuid",
+		State:        v1alpha1.EnvironmentStateRunning,
+		AirflowURI:   "https://cool.composer.googleusercontent.com",
+		DAGGCSPrefix: "gs://cool-bucket/dags",
+		GKECluster:   "projects/coolProject/locations/us-cool1/clusters/cool-gke",
+	}
+	if diff := cmp.Diff(want, GenerateEnvironmentObservation(e)); diff != "" {
+		t.Errorf("GenerateEnvironmentObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeEnvironment(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.EnvironmentParameters
+		e    composer.Environment
+		want *v1alpha1.EnvironmentParameters
+	}{
+		"AllFilled": {
+			p:    params(),
+			e:    *environment(),
+			want: params(),
+		},
+		"NoConfig": {
+			p: &v1alpha1.EnvironmentParameters{Location: "us-cool1"},
+			e: *environment(),
+			want: &v1alpha1.EnvironmentParameters{
+				Location: "us-cool1",
+				Config: &v1alpha1.EnvironmentConfig{
+					EnvironmentSize: gcp.StringPtr("ENVIRONMENT_SIZE_SMALL"),
+					SoftwareConfig: &v1alpha1.SoftwareConfig{
+						ImageVersion: gcp.StringPtr("composer-2.4.6-airflow-2.6.3"),
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeEnvironment(tc.p, tc.e)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("LateInitializeEnvironment(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsEnvironmentUpToDate(t *testing.T) {
+	type want struct {
+		upToDate bool
+		mask     string
+	}
+	cases := map[string]struct {
+		p    v1alpha1.EnvironmentParameters
+		e    composer.Environment
+		want want
+	}{
+		"UpToDate": {
+			p:    *params(),
+			e:    *environment(),
+			want: want{upToDate: true},
+		},
+		"LabelsDiffer": {
+			p:    *params(),
+			e:    *environment(func(e *composer.Environment) { e.Labels = nil }),
+			want: want{mask: "labels"},
+		},
+		"EnvironmentSizeDiffers": {
+			p:    *params(),
+			e:    *environment(func(e *composer.Environment) { e.Config.EnvironmentSize = "ENVIRONMENT_SIZE_MEDIUM" }),
+			want: want{mask: "config.environmentSize"},
+		},
+		"ImageVersionAlias": {
+			p: *params(func(p *v1alpha1.EnvironmentParameters) {
+				p.Config.SoftwareConfig.ImageVersion = gcp.StringPtr("composer-2-airflow-2.6")
+			}),
+			e:    *environment(),
+			want: want{upToDate: true},
+		},
+		"ImageVersionLatest": {
+			p: *params(func(p *v1alpha1.EnvironmentParameters) {
+				p.Config.SoftwareConfig.ImageVersion = gcp.StringPtr("composer-latest-airflow-2")
+			}),
+			e:    *environment(),
+			want: want{upToDate: true},
+		},
+		"ImageVersionDiffers": {
+			p: *params(func(p *v1alpha1.EnvironmentParameters) {
+				p.Config.SoftwareConfig.ImageVersion = gcp.StringPtr("composer-2.5.0-airflow-2.6.3")
+			}),
+			e:    *environment(),
+			want: want{mask: "config.softwareConfig.imageVersion"},
+		},
+		"PyPIPackagesDiffer": {
+			p: *params(func(p *v1alpha1.EnvironmentParameters) {
+				p.Config.SoftwareConfig.PyPIPackages["pandas"] = ""
+			}),
+			e:    *environment(),
+			want: want{mask: "config.softwareConfig.pypiPackages"},
+		},
+		"EnvVariablesRemoved": {
+			p: *params(func(p *v1alpha1.EnvironmentParameters) {
+				p.Config.SoftwareConfig.EnvVariables = nil
+			}),
+			e:    *environment(),
+			want: want{mask: "config.softwareConfig.envVariables"},
+		},
+		"AirflowConfigOverridesDiffer": {
+			p: *params(),
+			e: *environment(func(e *composer.Environment) {
+				e.Config.SoftwareConfig.AirflowConfigOverrides = nil
+			}),
+			want: want{mask: "config.softwareConfig.airflowConfigOverrides"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			upToDate, mask := IsEnvironmentUpToDate(tc.p, tc.e)
+			if diff := cmp.Diff(tc.want, want{upToDate: upToDate, mask: mask}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("IsEnvironmentUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"strconv"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+)
+
+const errInvalidMaxConcurrentReconciles = "maximum number of concurrent reconciles of %s must be a positive integer, got %q"
+
+// MaxConcurrentReconciles are the maximum numbers of managed resources that
+// the controllers of each kind of managed resource reconcile concurrently.
+type MaxConcurrentReconciles struct {
+	// Default applies to all kinds of managed resources that don't have a
+	// maximum of their own.
+	Default int
+
+	// Kinds are the maxima of individual kinds of managed resources, keyed
+	// by group kind, e.g. ResourceRecordSet.dns.gcp.crossplane.io.
+	Kinds map[string]int
+}
+
+// For returns the maximum number of managed resources of the supplied group
+// kind that may be reconciled concurrently.
+func (m MaxConcurrentReconciles) For(groupKind string) int {
+	if n, ok := m.Kinds[groupKind]; ok {
+		return n
+	}
+	return m.Default
+}
+
+// ParseMaxConcurrentReconciles returns the supplied default maximum number of
+// concurrent reconciles, overridden by the supplied maxima of individual group
+// kinds.
+func ParseMaxConcurrentReconciles(def int, kinds map[string]string) (MaxConcurrentReconciles, error) {
+	if def < 1 {
+		return MaxConcurrentReconciles{}, errors.Errorf(errInvalidMaxConcurrentReconciles, "all kinds", strconv.Itoa(def))
+	}
+	m := MaxConcurrentReconciles{Default: def, Kinds: make(map[string]int, len(kinds))}
+	for gk, v := range kinds {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return MaxConcurrentReconciles{}, errors.Errorf(errInvalidMaxConcurrentReconciles, gk, v)
+		}
+		m.Kinds[gk] = n
+	}
+	return m, nil
+}
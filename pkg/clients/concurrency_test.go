@@ -0,0 +1,72 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestParseMaxConcurrentReconciles(t *testing.T) {
+	const rrs = "ResourceRecordSet.dns.gcp.crossplane.io"
+
+	type want struct {
+		m   MaxConcurrentReconciles
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		def    int
+		kinds  map[string]string
+		want   want
+	}{
+		"InvalidDefault": {
+			reason: "Should return an error if the default maximum isn't positive",
+			def:    0,
+			want:   want{err: errors.Errorf(errInvalidMaxConcurrentReconciles, "all kinds", "0")},
+		},
+		"InvalidKind": {
+			reason: "Should return an error if the maximum of a kind isn't a positive integer",
+			def:    1,
+			kinds:  map[string]string{rrs: "ten"},
+			want:   want{err: errors.Errorf(errInvalidMaxConcurrentReconciles, rrs, "ten")},
+		},
+		"Valid": {
+			reason: "The maxima of individual kinds should be parsed",
+			def:    1,
+			kinds:  map[string]string{rrs: "10"},
+			want:   want{m: MaxConcurrentReconciles{Default: 1, Kinds: map[string]int{rrs: 10}}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseMaxConcurrentReconciles(tc.def, tc.kinds)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nParseMaxConcurrentReconciles(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.m, got); diff != "" {
+				t.Errorf("\n%s\nParseMaxConcurrentReconciles(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
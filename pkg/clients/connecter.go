@@ -0,0 +1,92 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// A wrappingConnecter is an ExternalConnecter whose external clients are those
+// of another ExternalConnecter, wrapped to change what they do.
+type wrappingConnecter struct {
+	connecter managed.ExternalConnecter
+	wrap      func(managed.ExternalClient) managed.ExternalClient
+}
+
+func (c *wrappingConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	e, err := c.connecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return c.wrap(e), nil
+}
+
+// wrapConnecter wraps the supplied ExternalConnecter with the connecters that
+// NewReconciler uses for all managed resources. The outermost connecter comes
+// first: panics are recovered from and errors are classified whatever the
+// other connecters do, and requests are traced and logged for the reconcile of
+// the managed resource even if they're sent by the supplied connecter.
+// Deletion protection is enforced even for managed resources that are dry run,
+// and dry runs plan what the management policy lets the external client do.
+func wrapConnecter(c managed.ExternalConnecter) managed.ExternalConnecter {
+	c = NewLateInitializationConnecter(c)
+	c = NewManagementPolicyConnecter(c)
+	c = NewDryRunConnecter(c)
+	c = NewDeletionProtectionConnecter(c)
+	c = NewDebugLoggingConnecter(c)
+	c = NewTracingConnecter(c)
+	return NewGuardingConnecter(c)
+}
+
+// A contextConnecter is an ExternalConnecter that connects, and whose external
+// clients are called, with a context derived for the managed resource.
+type contextConnecter struct {
+	connecter managed.ExternalConnecter
+	with      func(context.Context, resource.Managed) context.Context
+}
+
+func (c *contextConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	e, err := c.connecter.Connect(c.with(ctx, mg), mg)
+	if err != nil {
+		return nil, err
+	}
+	return &contextExternal{client: e, with: c.with}, nil
+}
+
+type contextExternal struct {
+	client managed.ExternalClient
+	with   func(context.Context, resource.Managed) context.Context
+}
+
+func (e *contextExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	return e.client.Observe(e.with(ctx, mg), mg)
+}
+
+func (e *contextExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	return e.client.Create(e.with(ctx, mg), mg)
+}
+
+func (e *contextExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return e.client.Update(e.with(ctx, mg), mg)
+}
+
+func (e *contextExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	return e.client.Delete(e.with(ctx, mg), mg)
+}
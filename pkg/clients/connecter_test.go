@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestWrapConnecter(t *testing.T) {
+	observed := managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}
+	c := managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return &managed.ExternalClientFns{
+			ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+				return observed, nil
+			},
+			DeleteFn: func(_ context.Context, _ resource.Managed) error {
+				panic("boom")
+			},
+		}, nil
+	})
+
+	t.Run("ObserveOnlyDryRun", func(t *testing.T) {
+		mg := &protectedManaged{}
+		mg.SetUID(types.UID("observe-only-dry-run"))
+		mg.SetAnnotations(map[string]string{
+			AnnotationKeyManagementPolicy: string(ManagementObserveOnly),
+			AnnotationKeyDryRun:           "true",
+		})
+		e, err := wrapConnecter(c).Connect(context.Background(), mg)
+		if err != nil {
+			t.Fatalf("Connect(...): %s", err)
+		}
+		o, err := e.Observe(context.Background(), mg)
+		if err != nil {
+			t.Fatalf("e.Observe(...): %s", err)
+		}
+		if diff := cmp.Diff(managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, o); diff != "" {
+			t.Errorf("e.Observe(...): ObserveOnly resources should be up to date before they're planned: -want, +got:\n%s", diff)
+		}
+		if _, planned := dryRunPlans.pop(mg.GetUID()); planned {
+			t.Errorf("e.Observe(...): ObserveOnly resources should never be planned to be updated")
+		}
+	})
+
+	t.Run("ProtectedDryRun", func(t *testing.T) {
+		mg := &protectedManaged{protected: true}
+		mg.SetUID(types.UID("protected-dry-run"))
+		mg.SetAnnotations(map[string]string{AnnotationKeyDryRun: "true"})
+		e, err := wrapConnecter(c).Connect(context.Background(), mg)
+		if err != nil {
+			t.Fatalf("Connect(...): %s", err)
+		}
+		err = e.Delete(context.Background(), mg)
+		if diff := cmp.Diff(errors.New(errDeletionProtected), err, test.EquateErrors()); diff != "" {
+			t.Errorf("e.Delete(...): protected resources should not be deleted even if dry run: -want error, +got error:\n%s", diff)
+		}
+		if c, _ := errorClasses.pop(mg.GetUID()); c != ErrorRetryable {
+			t.Errorf("e.Delete(...): want error class %s, got %s", ErrorRetryable, c)
+		}
+	})
+
+	t.Run("Panic", func(t *testing.T) {
+		mg := &protectedManaged{}
+		mg.SetUID(types.UID("panic"))
+		e, err := wrapConnecter(c).Connect(context.Background(), mg)
+		if err != nil {
+			t.Fatalf("Connect(...): %s", err)
+		}
+		if err := e.Delete(context.Background(), mg); err == nil {
+			t.Errorf("e.Delete(...): want error recovered from panic, got nil")
+		}
+		errorClasses.pop(mg.GetUID())
+	})
+}
@@ -0,0 +1,268 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+
+	secretmanager "google.golang.org/api/secretmanager/v1"
+	v1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/v1beta1"
+)
+
+// Annotations that configure where the connection details of a managed
+// resource are published to, in addition to its connection Secret, if any.
+// They take the place of the publishConnectionDetailsTo field of managed
+// resources, which the crossplane-runtime version this provider builds
+// against doesn't have yet.
+const (
+	// AnnotationKeyPublishConnectionDetailsTo is the name of the secret in
+	// the store that the connection details are published to. Connection
+	// details aren't published to a store unless it's set.
+	AnnotationKeyPublishConnectionDetailsTo = "gcp.crossplane.io/publish-connection-details-to"
+
+	// AnnotationKeyConnectionDetailsStore is the name of the StoreConfig of
+	// the store. Defaults to "default".
+	AnnotationKeyConnectionDetailsStore = "gcp.crossplane.io/connection-details-store"
+)
+
+const defaultStoreConfig = "default"
+
+// Error strings.
+const (
+	errGetStoreConfig      = "cannot get StoreConfig"
+	errUnknownStoreType    = "unknown secret store type"
+	errNoStoreScope        = "StoreConfig of type Kubernetes must set a defaultScope"
+	errNewSecretManager    = "cannot create Secret Manager client"
+	errPublishToStore      = "cannot publish connection details to secret store"
+	errUnpublishFromStore  = "cannot unpublish connection details from secret store"
+	errDecodeStoredDetails = "cannot decode connection details stored in Secret Manager"
+	errEncodeStoredDetails = "cannot encode connection details for Secret Manager"
+	errCreateStoredSecret  = "cannot create Secret Manager secret"
+	errAccessStoredSecret  = "cannot access Secret Manager secret"
+	errAddStoredVersion    = "cannot add Secret Manager secret version"
+	errDeleteStoredSecret  = "cannot delete Secret Manager secret"
+	errGetKubeSecret       = "cannot get Kubernetes Secret"
+	errApplyKubeSecret     = "cannot apply Kubernetes Secret"
+	errDeleteKubeSecret    = "cannot delete Kubernetes Secret"
+)
+
+// A secretStore stores the connection details of managed resources in
+// secrets.
+type secretStore interface {
+	// write adds the supplied connection details to the named secret,
+	// keeping any other details it already stores.
+	write(ctx context.Context, name string, cd managed.ConnectionDetails) error
+
+	// delete deletes the named secret, if it exists.
+	delete(ctx context.Context, name string) error
+}
+
+// An ExternalStorePublisher publishes the connection details of managed
+// resources to the store configured by a StoreConfig, e.g. Secret Manager or
+// Vault. Managed resources that aren't annotated with
+// AnnotationKeyPublishConnectionDetailsTo aren't published.
+type ExternalStorePublisher struct {
+	client   client.Client
+	newStore func(ctx context.Context, c client.Client, sc *v1beta1.StoreConfig) (secretStore, error)
+}
+
+// NewExternalStorePublisher returns an ExternalStorePublisher.
+func NewExternalStorePublisher(c client.Client) *ExternalStorePublisher {
+	return &ExternalStorePublisher{client: c, newStore: newSecretStore}
+}
+
+// PublishConnection adds the supplied connection details to the secret of
+// the supplied managed resource, if any.
+func (p *ExternalStorePublisher) PublishConnection(ctx context.Context, mg resource.Managed, cd managed.ConnectionDetails) error {
+	name, ok := mg.GetAnnotations()[AnnotationKeyPublishConnectionDetailsTo]
+	if !ok || len(cd) == 0 {
+		return nil
+	}
+	s, err := p.store(ctx, mg)
+	if err != nil {
+		return errors.Wrap(err, errPublishToStore)
+	}
+	return errors.Wrap(s.write(ctx, name, cd), errPublishToStore)
+}
+
+// UnpublishConnection deletes the secret of the supplied managed resource, if
+// any.
+func (p *ExternalStorePublisher) UnpublishConnection(ctx context.Context, mg resource.Managed, _ managed.ConnectionDetails) error {
+	name, ok := mg.GetAnnotations()[AnnotationKeyPublishConnectionDetailsTo]
+	if !ok {
+		return nil
+	}
+	s, err := p.store(ctx, mg)
+	if err != nil {
+		return errors.Wrap(err, errUnpublishFromStore)
+	}
+	return errors.Wrap(s.delete(ctx, name), errUnpublishFromStore)
+}
+
+func (p *ExternalStorePublisher) store(ctx context.Context, mg resource.Managed) (secretStore, error) {
+	name := defaultStoreConfig
+	if n := mg.GetAnnotations()[AnnotationKeyConnectionDetailsStore]; n != "" {
+		name = n
+	}
+	sc := &v1beta1.StoreConfig{}
+	if err := p.client.Get(ctx, types.NamespacedName{Name: name}, sc); err != nil {
+		return nil, errors.Wrap(err, errGetStoreConfig)
+	}
+	return p.newStore(ctx, p.client, sc)
+}
+
+func newSecretStore(ctx context.Context, c client.Client, sc *v1beta1.StoreConfig) (secretStore, error) {
+	switch sc.Spec.Type {
+	case v1beta1.SecretStoreKubernetes, "":
+		if sc.Spec.DefaultScope == "" {
+			return nil, errors.New(errNoStoreScope)
+		}
+		return &kubernetesStore{client: c, namespace: sc.Spec.DefaultScope}, nil
+	case v1beta1.SecretStoreSecretManager:
+		pc := defaultStoreConfig
+		if ref := sc.Spec.ProviderConfigReference; ref != nil {
+			pc = ref.Name
+		}
+		projectID, opts, err := UseProviderConfigNamed(ctx, c, pc)
+		if err != nil {
+			return nil, err
+		}
+		s, err := secretmanager.NewService(ctx, opts.For("secretmanager")...)
+		if err != nil {
+			return nil, errors.Wrap(err, errNewSecretManager)
+		}
+		if sc.Spec.DefaultScope != "" {
+			projectID = sc.Spec.DefaultScope
+		}
+		return &secretManagerStore{secrets: s.Projects.Secrets, project: projectID}, nil
+	case v1beta1.SecretStoreVault:
+		return newVaultStore(ctx, c, sc)
+	}
+	return nil, errors.Errorf("%s: %s", errUnknownStoreType, sc.Spec.Type)
+}
+
+// A kubernetesStore stores connection details in Kubernetes Secrets of a
+// namespace.
+type kubernetesStore struct {
+	client    client.Client
+	namespace string
+}
+
+func (s *kubernetesStore) write(ctx context.Context, name string, cd managed.ConnectionDetails) error {
+	secret := &v1.Secret{}
+	err := s.client.Get(ctx, types.NamespacedName{Namespace: s.namespace, Name: name}, secret)
+	if resource.IgnoreNotFound(err) != nil {
+		return errors.Wrap(err, errGetKubeSecret)
+	}
+	if kerrors.IsNotFound(err) {
+		secret.SetNamespace(s.namespace)
+		secret.SetName(name)
+		secret.Data = cd
+		return errors.Wrap(s.client.Create(ctx, secret), errApplyKubeSecret)
+	}
+	var changed bool
+	if secret.Data, changed = mergeDetails(secret.Data, cd); !changed {
+		return nil
+	}
+	return errors.Wrap(s.client.Update(ctx, secret), errApplyKubeSecret)
+}
+
+func (s *kubernetesStore) delete(ctx context.Context, name string) error {
+	secret := &v1.Secret{}
+	secret.SetNamespace(s.namespace)
+	secret.SetName(name)
+	return errors.Wrap(resource.IgnoreNotFound(s.client.Delete(ctx, secret)), errDeleteKubeSecret)
+}
+
+// A secretManagerStore stores connection details in Secret Manager secrets
+// of a project. Each secret stores the connection details of a managed
+// resource as a JSON object of base64 encoded values. A new version is only
+// added when the details changed.
+type secretManagerStore struct {
+	secrets *secretmanager.ProjectsSecretsService
+	project string
+}
+
+func (s *secretManagerStore) write(ctx context.Context, name string, cd managed.ConnectionDetails) error {
+	id := "projects/" + s.project + "/secrets/" + name
+	stored := map[string][]byte{}
+	v, err := s.secrets.Versions.Access(id + "/versions/latest").Context(ctx).Do()
+	switch {
+	case IsErrorNotFound(err):
+		// The secret, or its first version, doesn't exist yet.
+		if _, err := s.secrets.Create("projects/"+s.project, &secretmanager.Secret{
+			Replication: &secretmanager.Replication{Automatic: &secretmanager.Automatic{}},
+		}).SecretId(name).Context(ctx).Do(); err != nil && !IsErrorAlreadyExists(err) {
+			return errors.Wrap(err, errCreateStoredSecret)
+		}
+	case err != nil:
+		return errors.Wrap(err, errAccessStoredSecret)
+	default:
+		data, err := base64.StdEncoding.DecodeString(v.Payload.Data)
+		if err != nil {
+			return errors.Wrap(err, errDecodeStoredDetails)
+		}
+		if err := json.Unmarshal(data, &stored); err != nil {
+			return errors.Wrap(err, errDecodeStoredDetails)
+		}
+	}
+	stored, changed := mergeDetails(stored, cd)
+	if !changed {
+		return nil
+	}
+	data, err := json.Marshal(stored)
+	if err != nil {
+		return errors.Wrap(err, errEncodeStoredDetails)
+	}
+	_, err = s.secrets.AddVersion(id, &secretmanager.AddSecretVersionRequest{
+		Payload: &secretmanager.SecretPayload{Data: base64.StdEncoding.EncodeToString(data)},
+	}).Context(ctx).Do()
+	return errors.Wrap(err, errAddStoredVersion)
+}
+
+func (s *secretManagerStore) delete(ctx context.Context, name string) error {
+	_, err := s.secrets.Delete("projects/" + s.project + "/secrets/" + name).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(IsErrorNotFound, err), errDeleteStoredSecret)
+}
+
+// mergeDetails adds the supplied connection details to the stored ones. It
+// returns the merged details, and whether they differ from the stored ones.
+func mergeDetails(stored map[string][]byte, cd managed.ConnectionDetails) (map[string][]byte, bool) {
+	if stored == nil {
+		stored = map[string][]byte{}
+	}
+	changed := false
+	for k, v := range cd {
+		if old, ok := stored[k]; !ok || !bytes.Equal(old, v) {
+			stored[k] = v
+			changed = true
+		}
+	}
+	return stored, changed
+}
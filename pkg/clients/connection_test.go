@@ -0,0 +1,246 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/option"
+	secretmanager "google.golang.org/api/secretmanager/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/v1beta1"
+)
+
+type storeFns struct {
+	writeFn  func(name string, cd managed.ConnectionDetails) error
+	deleteFn func(name string) error
+}
+
+func (s storeFns) write(_ context.Context, name string, cd managed.ConnectionDetails) error {
+	return s.writeFn(name, cd)
+}
+
+func (s storeFns) delete(_ context.Context, name string) error {
+	return s.deleteFn(name)
+}
+
+func TestExternalStorePublisherPublishConnection(t *testing.T) {
+	errBoom := errors.New("boom")
+	cd := managed.ConnectionDetails{"password": []byte("secret")}
+	publishing := func() *fake.Managed {
+		mg := &fake.Managed{}
+		mg.SetAnnotations(map[string]string{AnnotationKeyPublishConnectionDetailsTo: "db", AnnotationKeyConnectionDetailsStore: "vault"})
+		return mg
+	}
+
+	cases := map[string]struct {
+		reason string
+		kube   client.Client
+		mg     *fake.Managed
+		store  secretStore
+		want   error
+	}{
+		"NotPublishing": {
+			reason: "Managed resources that aren't annotated should not be published",
+			mg:     &fake.Managed{},
+		},
+		"GetStoreConfigFailed": {
+			reason: "Should return an error if the StoreConfig can't be read",
+			kube:   &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			mg:     publishing(),
+			want:   errors.Wrap(errors.Wrap(errBoom, errGetStoreConfig), errPublishToStore),
+		},
+		"Published": {
+			reason: "Should write the connection details to the annotated secret of the annotated store",
+			kube: &test.MockClient{MockGet: func(_ context.Context, key client.ObjectKey, _ client.Object) error {
+				if key.Name != "vault" {
+					t.Errorf("Get(...): want StoreConfig vault, got %s", key.Name)
+				}
+				return nil
+			}},
+			mg: publishing(),
+			store: storeFns{writeFn: func(name string, got managed.ConnectionDetails) error {
+				if name != "db" {
+					t.Errorf("write(...): want secret db, got %s", name)
+				}
+				if diff := cmp.Diff(cd, got); diff != "" {
+					t.Errorf("write(...): -want, +got:\n%s", diff)
+				}
+				return nil
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := &ExternalStorePublisher{
+				client: tc.kube,
+				newStore: func(_ context.Context, _ client.Client, _ *v1beta1.StoreConfig) (secretStore, error) {
+					return tc.store, nil
+				},
+			}
+			err := p.PublishConnection(context.Background(), tc.mg, cd)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nPublishConnection(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestKubernetesStoreWrite(t *testing.T) {
+	notFound := kerrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "db")
+
+	cases := map[string]struct {
+		reason string
+		kube   client.Client
+		cd     managed.ConnectionDetails
+	}{
+		"Create": {
+			reason: "The Secret should be created if it doesn't exist",
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(notFound),
+				MockCreate: func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+					if obj.GetNamespace() != "secrets" || obj.GetName() != "db" {
+						t.Errorf("Create(...): want Secret secrets/db, got %s/%s", obj.GetNamespace(), obj.GetName())
+					}
+					return nil
+				},
+			},
+			cd: managed.ConnectionDetails{"password": []byte("secret")},
+		},
+		"Unchanged": {
+			reason: "The Secret should not be updated if it already stores the connection details",
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					obj.(*corev1.Secret).Data = map[string][]byte{"password": []byte("secret"), "username": []byte("root")}
+					return nil
+				}),
+				MockUpdate: func(_ context.Context, _ client.Object, _ ...client.UpdateOption) error {
+					t.Errorf("Update(...): unexpected call")
+					return nil
+				},
+			},
+			cd: managed.ConnectionDetails{"password": []byte("secret")},
+		},
+		"Merged": {
+			reason: "New connection details should be added to those the Secret already stores",
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					obj.(*corev1.Secret).Data = map[string][]byte{"username": []byte("root")}
+					return nil
+				}),
+				MockUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+					want := map[string][]byte{"password": []byte("secret"), "username": []byte("root")}
+					if diff := cmp.Diff(want, obj.(*corev1.Secret).Data); diff != "" {
+						t.Errorf("Update(...): -want, +got:\n%s", diff)
+					}
+					return nil
+				},
+			},
+			cd: managed.ConnectionDetails{"password": []byte("secret")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := &kubernetesStore{client: tc.kube, namespace: "secrets"}
+			if err := s.write(context.Background(), "db", tc.cd); err != nil {
+				t.Errorf("\n%s\nwrite(...): %s", tc.reason, err)
+			}
+		})
+	}
+}
+
+func TestSecretManagerStoreWrite(t *testing.T) {
+	stored := func(cd map[string][]byte) *secretmanager.AccessSecretVersionResponse {
+		data, _ := json.Marshal(cd)
+		return &secretmanager.AccessSecretVersionResponse{Payload: &secretmanager.SecretPayload{Data: base64.StdEncoding.EncodeToString(data)}}
+	}
+
+	cases := map[string]struct {
+		reason   string
+		latest   *secretmanager.AccessSecretVersionResponse
+		cd       managed.ConnectionDetails
+		versions int
+	}{
+		"Unchanged": {
+			reason:   "No version should be added if the latest one stores the connection details",
+			latest:   stored(map[string][]byte{"password": []byte("secret")}),
+			cd:       managed.ConnectionDetails{"password": []byte("secret")},
+			versions: 0,
+		},
+		"Changed": {
+			reason:   "A version should be added if the connection details changed",
+			latest:   stored(map[string][]byte{"password": []byte("old")}),
+			cd:       managed.ConnectionDetails{"password": []byte("secret")},
+			versions: 1,
+		},
+		"NotFound": {
+			reason:   "The secret and its first version should be created if they don't exist",
+			cd:       managed.ConnectionDetails{"password": []byte("secret")},
+			versions: 1,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			versions := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				switch {
+				case strings.HasSuffix(r.URL.Path, "/versions/latest:access"):
+					if tc.latest == nil {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+					_ = json.NewEncoder(w).Encode(tc.latest)
+				case strings.HasSuffix(r.URL.Path, ":addVersion"):
+					versions++
+					_ = json.NewEncoder(w).Encode(&secretmanager.SecretVersion{})
+				default:
+					_ = json.NewEncoder(w).Encode(&secretmanager.Secret{})
+				}
+			}))
+			defer server.Close()
+			sm, _ := secretmanager.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+
+			s := &secretManagerStore{secrets: sm.Projects.Secrets, project: "p"}
+			if err := s.write(context.Background(), "db", tc.cd); err != nil {
+				t.Fatalf("\n%s\nwrite(...): %s", tc.reason, err)
+			}
+			if versions != tc.versions {
+				t.Errorf("\n%s\nwrite(...): want %d versions added, got %d", tc.reason, tc.versions, versions)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"text/template"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// AnnotationKeyConnectionDetailsMapping adds keys to the connection details
+// of a managed resource. It's a JSON object whose keys are the names of the
+// added keys, and whose values are Go templates that render them from the
+// connection details, e.g. {"DB_HOST": "{{ .endpoint }}"}. The connection
+// details keep their original keys.
+const AnnotationKeyConnectionDetailsMapping = "gcp.crossplane.io/connection-details-mapping"
+
+// Error strings.
+const (
+	errParseMapping   = "cannot parse " + AnnotationKeyConnectionDetailsMapping + " annotation"
+	errParseMappedKey = "cannot parse template of mapped connection detail"
+)
+
+// A MappingPublisher publishes the connection details of managed resources
+// with the keys added by their AnnotationKeyConnectionDetailsMapping
+// annotation, if any.
+type MappingPublisher struct {
+	publisher managed.ConnectionPublisher
+}
+
+// NewMappingPublisher returns a MappingPublisher that publishes connection
+// details using the supplied publisher.
+func NewMappingPublisher(p managed.ConnectionPublisher) *MappingPublisher {
+	return &MappingPublisher{publisher: p}
+}
+
+// PublishConnection publishes the supplied connection details and their
+// mapped keys.
+func (p *MappingPublisher) PublishConnection(ctx context.Context, mg resource.Managed, cd managed.ConnectionDetails) error {
+	mapped, err := MapConnectionDetails(mg, cd)
+	if err != nil {
+		return err
+	}
+	return p.publisher.PublishConnection(ctx, mg, mapped)
+}
+
+// UnpublishConnection unpublishes the supplied connection details and their
+// mapped keys.
+func (p *MappingPublisher) UnpublishConnection(ctx context.Context, mg resource.Managed, cd managed.ConnectionDetails) error {
+	mapped, err := MapConnectionDetails(mg, cd)
+	if err != nil {
+		return err
+	}
+	return p.publisher.UnpublishConnection(ctx, mg, mapped)
+}
+
+// MapConnectionDetails returns the supplied connection details with the keys
+// added by the AnnotationKeyConnectionDetailsMapping annotation of the
+// supplied managed resource. Connection details are published incrementally,
+// so a key isn't added unless all the details its template refers to are
+// supplied.
+func MapConnectionDetails(mg resource.Managed, cd managed.ConnectionDetails) (managed.ConnectionDetails, error) {
+	v, ok := mg.GetAnnotations()[AnnotationKeyConnectionDetailsMapping]
+	if !ok || len(cd) == 0 {
+		return cd, nil
+	}
+	mapping := map[string]string{}
+	if err := json.Unmarshal([]byte(v), &mapping); err != nil {
+		return nil, errors.Wrap(err, errParseMapping)
+	}
+	data := make(map[string]string, len(cd))
+	for k, v := range cd {
+		data[k] = string(v)
+	}
+	out := make(managed.ConnectionDetails, len(cd)+len(mapping))
+	for k, v := range cd {
+		out[k] = v
+	}
+	for k, text := range mapping {
+		t, err := template.New(k).Option("missingkey=error").Parse(text)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s %q", errParseMappedKey, k)
+		}
+		b := &bytes.Buffer{}
+		if err := t.Execute(b, data); err != nil {
+			// The details the template refers to weren't supplied.
+			continue
+		}
+		out[k] = b.Bytes()
+	}
+	return out, nil
+}
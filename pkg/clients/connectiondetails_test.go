@@ -0,0 +1,90 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestMapConnectionDetails(t *testing.T) {
+	cd := managed.ConnectionDetails{"endpoint": []byte("10.0.0.2"), "port": []byte("5432")}
+	withMapping := func(m string) *fake.Managed {
+		mg := &fake.Managed{}
+		mg.SetAnnotations(map[string]string{AnnotationKeyConnectionDetailsMapping: m})
+		return mg
+	}
+
+	type want struct {
+		cd  managed.ConnectionDetails
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     *fake.Managed
+		want   want
+	}{
+		"NoMapping": {
+			reason: "Connection details should be unchanged if there is no mapping",
+			mg:     &fake.Managed{},
+			want:   want{cd: cd},
+		},
+		"InvalidMapping": {
+			reason: "An error should be returned if the mapping isn't a JSON object",
+			mg:     withMapping("DB_HOST"),
+			want:   want{err: errors.Wrap(errors.New("invalid character 'D' looking for beginning of value"), errParseMapping)},
+		},
+		"Mapped": {
+			reason: "Keys should be added as rendered by their templates",
+			mg:     withMapping(`{"DB_HOST": "{{ .endpoint }}", "DB_ADDR": "{{ .endpoint }}:{{ .port }}"}`),
+			want: want{cd: managed.ConnectionDetails{
+				"endpoint": []byte("10.0.0.2"),
+				"port":     []byte("5432"),
+				"DB_HOST":  []byte("10.0.0.2"),
+				"DB_ADDR":  []byte("10.0.0.2:5432"),
+			}},
+		},
+		"MissingDetail": {
+			reason: "Keys whose templates refer to details that weren't supplied should not be added",
+			mg:     withMapping(`{"DB_HOST": "{{ .endpoint }}", "DB_PASSWORD": "{{ .password }}"}`),
+			want: want{cd: managed.ConnectionDetails{
+				"endpoint": []byte("10.0.0.2"),
+				"port":     []byte("5432"),
+				"DB_HOST":  []byte("10.0.0.2"),
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := MapConnectionDetails(tc.mg, cd)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nMapConnectionDetails(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.cd, got); diff != "" {
+				t.Errorf("\n%s\nMapConnectionDetails(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"golang.org/x/oauth2/google"
+	v1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/v1beta1"
+)
+
+const errNoSecretRef = "secretRef must be set to use Secret credentials"
+
+// secretCredentials caches the credentials read from Secrets. Credentials
+// cache their access tokens, so reusing them saves us from requesting a new
+// token every time a managed resource is reconciled.
+var secretCredentials = &credentialsCache{entries: map[types.NamespacedName]*cachedCredentials{}}
+
+// cachedCredentials are the credentials read from the keys of a Secret, with
+// the scopes they were requested for, at a resource version of the Secret.
+type cachedCredentials struct {
+	resourceVersion string
+	creds           map[string]*google.Credentials
+}
+
+// A credentialsCache keeps the credentials read from Secrets until the
+// Secrets change or are deleted.
+type credentialsCache struct {
+	mu      sync.Mutex
+	entries map[types.NamespacedName]*cachedCredentials
+}
+
+// get returns the credentials stored in the Secret key referenced by the
+// supplied selector. The Secret is read every time, but the credentials are
+// only rebuilt when its resource version changed, i.e. when it was rotated.
+// The credentials of a Secret are evicted when it changed, so that those of
+// keys and scopes that are no longer used don't pile up, or when it doesn't
+// exist anymore.
+func (cc *credentialsCache) get(ctx context.Context, c client.Client, ref *xpv1.SecretKeySelector, scopes []string) (*google.Credentials, error) {
+	if ref == nil {
+		return nil, errors.New(errNoSecretRef)
+	}
+	nn := types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}
+	s := &v1.Secret{}
+	if err := c.Get(ctx, nn, s); err != nil {
+		if kerrors.IsNotFound(err) {
+			cc.evict(ref)
+		}
+		return nil, errors.Wrap(err, "cannot get credentials secret")
+	}
+	key := ref.Key + "/" + strings.Join(scopes, " ")
+
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	e, ok := cc.entries[nn]
+	if !ok || e.resourceVersion != s.GetResourceVersion() {
+		e = &cachedCredentials{resourceVersion: s.GetResourceVersion(), creds: map[string]*google.Credentials{}}
+		cc.entries[nn] = e
+	}
+	if creds, ok := e.creds[key]; ok {
+		return creds, nil
+	}
+	// The credentials outlive this reconcile, so their token source must not
+	// use its context.
+	creds, err := google.CredentialsFromJSON(context.Background(), s.Data[ref.Key], scopes...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse credentials")
+	}
+	e.creds[key] = creds
+	return creds, nil
+}
+
+// evict the credentials read from the referenced Secret.
+func (cc *credentialsCache) evict(ref *xpv1.SecretKeySelector) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	delete(cc.entries, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace})
+}
+
+// referencedSecrets are the Secrets referenced by the ProviderConfigs that
+// managed resources used so far. Only their events are handled, so that
+// changes to the other Secrets of a cluster don't make every controller list
+// ProviderConfigs.
+var referencedSecrets = &secretRefs{names: map[types.NamespacedName]bool{}}
+
+type secretRefs struct {
+	mu    sync.RWMutex
+	names map[types.NamespacedName]bool
+}
+
+func (r *secretRefs) add(ref *xpv1.SecretKeySelector) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.names[types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}] = true
+}
+
+func (r *secretRefs) has(o client.Object) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.names[types.NamespacedName{Name: o.GetName(), Namespace: o.GetNamespace()}]
+}
+
+// LabelKeyCredentials labels the Secrets that changes are watched for, so
+// that the managed resources using them are reconciled as soon as their
+// credentials are rotated. Managed resources whose Secrets aren't labelled
+// "true" pick up the change the next time they're polled.
+const LabelKeyCredentials = "gcp.crossplane.io/credentials"
+
+const errNewCredentialsCache = "cannot create cache of credential Secrets"
+
+// credentialCaches are the caches of the Secrets labelled with
+// LabelKeyCredentials, one per manager, so that the controllers of a manager
+// share a single watch of them.
+var credentialCaches = &secretCaches{caches: map[ctrl.Manager]cache.Cache{}}
+
+type secretCaches struct {
+	mu     sync.Mutex
+	caches map[ctrl.Manager]cache.Cache
+}
+
+// get returns the cache of the credential Secrets of the supplied manager,
+// creating and adding it to the manager if need be.
+func (sc *secretCaches) get(mgr ctrl.Manager) (cache.Cache, error) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	if c, ok := sc.caches[mgr]; ok {
+		return c, nil
+	}
+	c, err := cache.New(mgr.GetConfig(), cache.Options{
+		Scheme: mgr.GetScheme(),
+		Mapper: mgr.GetRESTMapper(),
+		SelectorsByObject: cache.SelectorsByObject{
+			&v1.Secret{}: {Label: labels.SelectorFromSet(labels.Set{LabelKeyCredentials: "true"})},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errNewCredentialsCache)
+	}
+	if err := mgr.Add(c); err != nil {
+		return nil, errors.Wrap(err, errNewCredentialsCache)
+	}
+	sc.caches[mgr] = c
+	return c, nil
+}
+
+// CredentialSecrets is the source of the events that
+// EnqueueRequestsForCredentials handles. It only emits the events of Secrets
+// that are labelled with LabelKeyCredentials and referenced by the
+// ProviderConfigs managed resources use. Those Secrets are watched through a
+// cache of their own rather than the cache of the supplied manager, which
+// would otherwise watch and cache every Secret of the cluster.
+func CredentialSecrets(mgr ctrl.Manager) source.Source {
+	c, err := credentialCaches.get(mgr)
+	if err != nil {
+		return credentialSecrets{err: err}
+	}
+	return credentialSecrets{SyncingSource: source.NewKindWithCache(&v1.Secret{}, c)}
+}
+
+type credentialSecrets struct {
+	source.SyncingSource
+	err error
+}
+
+func (s credentialSecrets) Start(ctx context.Context, h handler.EventHandler, q workqueue.RateLimitingInterface, prct ...predicate.Predicate) error {
+	if s.err != nil {
+		return s.err
+	}
+	return s.SyncingSource.Start(ctx, h, q, append(prct, predicate.NewPredicateFuncs(referencedSecrets.has))...)
+}
+
+// EnqueueRequestsForCredentials returns an event handler that enqueues a
+// request for every managed resource of the supplied kind that uses a
+// ProviderConfig referencing the Secret of the event, e.g. its credentials.
+// This way managed resources recover as soon as their credentials are
+// rotated, instead of failing until they're polled the next time.
+func EnqueueRequestsForCredentials(c client.Client, of resource.ManagedKind) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(o client.Object) []reconcile.Request {
+		// Errors can't be returned, but they only delay the reconciles until
+		// the next poll.
+		reqs, _ := usersOfSecret(context.Background(), c, of, o)
+		return reqs
+	})
+}
+
+// usersOfSecret returns a request for every managed resource of the supplied
+// kind that uses a ProviderConfig referencing the supplied Secret.
+func usersOfSecret(ctx context.Context, c client.Client, of resource.ManagedKind, s client.Object) ([]reconcile.Request, error) {
+	pcs := &v1beta1.ProviderConfigList{}
+	if err := c.List(ctx, pcs); err != nil {
+		return nil, err
+	}
+	gvk := schema.GroupVersionKind(of)
+	var reqs []reconcile.Request
+	for _, pc := range pcs.Items {
+		if !referencesSecret(pc.Spec, s) {
+			continue
+		}
+		pcus := &v1beta1.ProviderConfigUsageList{}
+		if err := c.List(ctx, pcus, client.MatchingLabels{xpv1.LabelKeyProviderName: pc.GetName()}); err != nil {
+			return nil, err
+		}
+		for _, pcu := range pcus.Items {
+			ref := pcu.GetResourceReference()
+			if ref.APIVersion != gvk.GroupVersion().String() || ref.Kind != gvk.Kind {
+				continue
+			}
+			reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Name: ref.Name}})
+		}
+	}
+	return reqs, nil
+}
+
+// referencesSecret returns true if the supplied ProviderConfigSpec references
+// the supplied Secret.
+func referencesSecret(spec v1beta1.ProviderConfigSpec, s client.Object) bool {
+	refs := []*xpv1.SecretKeySelector{spec.Credentials.SecretRef, spec.CABundleSecretRef}
+	if p := spec.Proxy; p != nil {
+		refs = append(refs, p.CredentialsSecretRef)
+	}
+	for _, ref := range refs {
+		if ref != nil && ref.Name == s.GetName() && ref.Namespace == s.GetNamespace() {
+			return true
+		}
+	}
+	return false
+}
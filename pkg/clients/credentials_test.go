@@ -0,0 +1,262 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/v1beta1"
+)
+
+const authorizedUser = `{"type": "authorized_user", "client_id": "id", "client_secret": "secret", "refresh_token": "token"}`
+
+func TestCredentialsCache(t *testing.T) {
+	resourceVersion := "1"
+	kube := &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			s := obj.(*corev1.Secret)
+			s.SetResourceVersion(resourceVersion)
+			s.Data = map[string][]byte{"key": []byte(authorizedUser)}
+			return nil
+		},
+	}
+	cc := &credentialsCache{entries: map[types.NamespacedName]*cachedCredentials{}}
+	ref := secretRef("creds")
+	scopes := []string{cloudPlatformScope}
+
+	first, err := cc.get(context.Background(), kube, ref, scopes)
+	if err != nil {
+		t.Fatalf("get(...): %s", err)
+	}
+	second, err := cc.get(context.Background(), kube, ref, scopes)
+	if err != nil {
+		t.Fatalf("get(...): %s", err)
+	}
+	if first != second {
+		t.Errorf("get(...): want the credentials to be reused while the Secret doesn't change")
+	}
+
+	resourceVersion = "2"
+	rotated, err := cc.get(context.Background(), kube, ref, scopes)
+	if err != nil {
+		t.Fatalf("get(...): %s", err)
+	}
+	if rotated == first {
+		t.Errorf("get(...): want the credentials to be rebuilt when the Secret changes")
+	}
+}
+
+func TestCredentialsCacheEviction(t *testing.T) {
+	var getErr error
+	resourceVersion := "1"
+	kube := &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			s := obj.(*corev1.Secret)
+			s.SetResourceVersion(resourceVersion)
+			s.Data = map[string][]byte{"key": []byte(authorizedUser)}
+			return getErr
+		},
+	}
+	cc := &credentialsCache{entries: map[types.NamespacedName]*cachedCredentials{}}
+	ref := secretRef("creds")
+	nn := types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}
+
+	if _, err := cc.get(context.Background(), kube, ref, []string{"a"}); err != nil {
+		t.Fatalf("get(...): %s", err)
+	}
+	resourceVersion = "2"
+	if _, err := cc.get(context.Background(), kube, ref, []string{"b"}); err != nil {
+		t.Fatalf("get(...): %s", err)
+	}
+	if got := len(cc.entries[nn].creds); got != 1 {
+		t.Errorf("get(...): want the credentials of a changed Secret to be evicted, got %d cached", got)
+	}
+
+	getErr = kerrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, ref.Name)
+	if _, err := cc.get(context.Background(), kube, ref, []string{"b"}); err == nil {
+		t.Fatalf("get(...): want an error if the Secret doesn't exist")
+	}
+	if _, ok := cc.entries[nn]; ok {
+		t.Errorf("get(...): want the credentials of a deleted Secret to be evicted")
+	}
+}
+
+func TestCredentialsCacheErrors(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason string
+		kube   client.Client
+		ref    *xpv1.SecretKeySelector
+		want   error
+	}{
+		"NoSecretRef": {
+			reason: "Should return an error if no Secret is referenced",
+			want:   errors.New(errNoSecretRef),
+		},
+		"GetSecretFailed": {
+			reason: "Should return an error if the Secret can't be read",
+			kube:   &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			ref:    secretRef("creds"),
+			want:   errors.Wrap(errBoom, "cannot get credentials secret"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cc := &credentialsCache{entries: map[types.NamespacedName]*cachedCredentials{}}
+			_, err := cc.get(context.Background(), tc.kube, tc.ref, nil)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nget(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+
+	kube := secrets(map[string][]byte{"creds": []byte("not JSON")})
+	cc := &credentialsCache{entries: map[types.NamespacedName]*cachedCredentials{}}
+	if _, err := cc.get(context.Background(), kube, secretRef("creds"), nil); err == nil {
+		t.Errorf("get(...): want an error if the Secret doesn't contain credentials")
+	}
+}
+
+func TestUsersOfSecret(t *testing.T) {
+	errBoom := errors.New("boom")
+	of := resource.ManagedKind(schema.GroupVersionKind{Group: "compute.gcp.crossplane.io", Version: "v1beta1", Kind: "Network"})
+	secret := &corev1.Secret{}
+	secret.SetName("creds")
+	secret.SetNamespace("crossplane-system")
+
+	pc := func(name string, spec v1beta1.ProviderConfigSpec) v1beta1.ProviderConfig {
+		p := v1beta1.ProviderConfig{Spec: spec}
+		p.SetName(name)
+		return p
+	}
+	pcu := func(apiVersion, kind, name string) v1beta1.ProviderConfigUsage {
+		u := v1beta1.ProviderConfigUsage{}
+		u.SetResourceReference(xpv1.TypedReference{APIVersion: apiVersion, Kind: kind, Name: name})
+		return u
+	}
+
+	cases := map[string]struct {
+		reason string
+		kube   client.Client
+		want   []reconcile.Request
+		err    error
+	}{
+		"Users": {
+			reason: "Should enqueue the managed resources of the kind that use a ProviderConfig referencing the Secret",
+			kube: &test.MockClient{MockList: func(_ context.Context, obj client.ObjectList, opts ...client.ListOption) error {
+				switch l := obj.(type) {
+				case *v1beta1.ProviderConfigList:
+					l.Items = []v1beta1.ProviderConfig{
+						pc("unrelated", v1beta1.ProviderConfigSpec{CABundleSecretRef: secretRef("ca")}),
+						pc("default", v1beta1.ProviderConfigSpec{Credentials: v1beta1.ProviderCredentials{
+							CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: secretRef("creds")},
+						}}),
+					}
+				case *v1beta1.ProviderConfigUsageList:
+					lo := &client.ListOptions{}
+					lo.ApplyOptions(opts)
+					if lo.LabelSelector.String() != xpv1.LabelKeyProviderName+"=default" {
+						t.Errorf("List(...): unexpected label selector %q", lo.LabelSelector)
+						return nil
+					}
+					l.Items = []v1beta1.ProviderConfigUsage{
+						pcu("compute.gcp.crossplane.io/v1beta1", "Network", "net"),
+						pcu("compute.gcp.crossplane.io/v1beta1", "Subnetwork", "subnet"),
+					}
+				}
+				return nil
+			}},
+			want: []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "net"}}},
+		},
+		"ListFailed": {
+			reason: "Should return an error if the ProviderConfigs can't be listed",
+			kube:   &test.MockClient{MockList: test.NewMockListFn(errBoom)},
+			err:    errBoom,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := usersOfSecret(context.Background(), tc.kube, of, secret)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nusersOfSecret(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nusersOfSecret(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCredentialSecrets(t *testing.T) {
+	// The controllers wait for sources to sync, which only works if the source
+	// promotes the methods of a SyncingSource. They must not inject the cache
+	// of their manager, which would watch every Secret.
+	var _ source.SyncingSource = credentialSecrets{}
+	if _, ok := interface{}(credentialSecrets{}).(inject.Cache); ok {
+		t.Errorf("credentialSecrets should not accept the cache of the manager")
+	}
+
+	referenced := &corev1.Secret{}
+	referenced.SetName("watched-creds")
+	referenced.SetNamespace("crossplane-system")
+	unrelated := &corev1.Secret{}
+	unrelated.SetName("unrelated")
+	unrelated.SetNamespace("crossplane-system")
+
+	if referencedSecrets.has(referenced) {
+		t.Fatalf("has(...): want a Secret that no ProviderConfig references to be filtered")
+	}
+
+	pc := &v1beta1.ProviderConfig{Spec: v1beta1.ProviderConfigSpec{
+		Credentials: v1beta1.ProviderCredentials{
+			Source:                    xpv1.CredentialsSourceSecret,
+			CommonCredentialSelectors: xpv1.CommonCredentialSelectors{SecretRef: secretRef("watched-creds")},
+		},
+	}}
+	// The Secret doesn't exist yet, but must be watched so that managed
+	// resources recover once it's created.
+	kube := &test.MockClient{MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "watched-creds"))}
+	if _, err := providerConfigVersion(context.Background(), kube, pc); err == nil {
+		t.Fatalf("providerConfigVersion(...): want an error if a referenced Secret doesn't exist")
+	}
+
+	if !referencedSecrets.has(referenced) {
+		t.Errorf("has(...): want a Secret referenced by a used ProviderConfig to be watched")
+	}
+	if referencedSecrets.has(unrelated) {
+		t.Errorf("has(...): want a Secret that no ProviderConfig references to be filtered")
+	}
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datacatalog
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	datacatalog "google.golang.org/api/datacatalog/v1"
+
+	"github.com/crossplane/provider-gcp/apis/datacatalog/v1alpha1"
+	iamv1alpha1 "github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	reader = "roles/datacatalog.categoryFineGrainedReader"
+	viewer = "roles/datacatalog.viewer"
+)
+
+func memberParams() v1alpha1.PolicyTagIAMMemberParameters {
+	return v1alpha1.PolicyTagIAMMemberParameters{
+		PolicyTag: gcp.StringPtr(policyTagName),
+		Role:      reader,
+		Member:    gcp.StringPtr("group:analysts@example.com"),
+	}
+}
+
+func TestBindRoleToMember(t *testing.T) {
+	type want struct {
+		changed bool
+		policy  *datacatalog.Policy
+	}
+	cases := map[string]struct {
+		policy *datacatalog.Policy
+		want   want
+	}{
+		"EmptyPolicy": {
+			policy: &datacatalog.Policy{},
+			want: want{
+				changed: true,
+				policy: &datacatalog.Policy{
+					Version:  iamv1alpha1.PolicyVersion,
+					Bindings: []*datacatalog.Binding{{Role: reader, Members: []string{"group:analysts@example.com"}}},
+				},
+			},
+		},
+		"RoleExists": {
+			policy: &datacatalog.Policy{
+				Bindings: []*datacatalog.Binding{{Role: reader, Members: []string{"user:cool@example.com"}}},
+			},
+			want: want{
+				changed: true,
+				policy: &datacatalog.Policy{
+					Version:  iamv1alpha1.PolicyVersion,
+					Bindings: []*datacatalog.Binding{{Role: reader, Members: []string{"user:cool@example.com", "group:analysts@example.com"}}},
+				},
+			},
+		},
+		"AlreadyBound": {
+			policy: &datacatalog.Policy{
+				Bindings: []*datacatalog.Binding{{Role: reader, Members: []string{"group:analysts@example.com"}}},
+			},
+			want: want{
+				changed: false,
+				policy: &datacatalog.Policy{
+					Version:  iamv1alpha1.PolicyVersion,
+					Bindings: []*datacatalog.Binding{{Role: reader, Members: []string{"group:analysts@example.com"}}},
+				},
+			},
+		},
+		"ConditionalBindingIgnored": {
+			policy: &datacatalog.Policy{
+				Bindings: []*datacatalog.Binding{{Role: reader, Members: []string{"group:analysts@example.com"}, Condition: &datacatalog.Expr{Expression: "true"}}},
+			},
+			want: want{
+				changed: true,
+				policy: &datacatalog.Policy{
+					Version: iamv1alpha1.PolicyVersion,
+					Bindings: []*datacatalog.Binding{
+						{Role: reader, Members: []string{"group:analysts@example.com"}, Condition: &datacatalog.Expr{Expression: "true"}},
+						{Role: reader, Members: []string{"group:analysts@example.com"}},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			changed := BindRoleToMember(memberParams(), tc.policy)
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("BindRoleToMember(...): -want changed, +got changed:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.policy, tc.policy); diff != "" {
+				t.Errorf("BindRoleToMember(...): -want policy, +got policy:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUnbindRoleFromMember(t *testing.T) {
+	type want struct {
+		changed bool
+		policy  *datacatalog.Policy
+	}
+	cases := map[string]struct {
+		policy *datacatalog.Policy
+		want   want
+	}{
+		"NotBound": {
+			policy: &datacatalog.Policy{
+				Bindings: []*datacatalog.Binding{{Role: viewer, Members: []string{"group:analysts@example.com"}}},
+			},
+			want: want{
+				changed: false,
+				policy: &datacatalog.Policy{
+					Bindings: []*datacatalog.Binding{{Role: viewer, Members: []string{"group:analysts@example.com"}}},
+				},
+			},
+		},
+		"OtherMembersRemain": {
+			policy: &datacatalog.Policy{
+				Bindings: []*datacatalog.Binding{{Role: reader, Members: []string{"group:analysts@example.com", "user:cool@example.com"}}},
+			},
+			want: want{
+				changed: true,
+				policy: &datacatalog.Policy{
+					Bindings: []*datacatalog.Binding{{Role: reader, Members: []string{"user:cool@example.com"}}},
+				},
+			},
+		},
+		"LastMemberRemovesBinding": {
+			policy: &datacatalog.Policy{
+				Bindings: []*datacatalog.Binding{
+					{Role: viewer, Members: []string{"group:analysts@example.com"}},
+					{Role: reader, Members: []string{"group:analysts@example.com"}},
+				},
+			},
+			want: want{
+				changed: true,
+				policy: &datacatalog.Policy{
+					Bindings: []*datacatalog.Binding{{Role: viewer, Members: []string{"group:analysts@example.com"}}},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			changed := UnbindRoleFromMember(memberParams(), tc.policy)
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("UnbindRoleFromMember(...): -want changed, +got changed:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.policy, tc.policy); diff != "" {
+				t.Errorf("UnbindRoleFromMember(...): -want policy, +got policy:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datacatalog
+
+import (
+	"path"
+
+	datacatalog "google.golang.org/api/datacatalog/v1"
+
+	"github.com/crossplane/provider-gcp/apis/datacatalog/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// PolicyTagUpdateMask is the set of PolicyTag fields that can be updated in
+// place.
+const PolicyTagUpdateMask = "displayName,description,parentPolicyTag"
+
+// GetPolicyTagName builds the fully qualified name of a policy tag.
+func GetPolicyTagName(p v1alpha1.PolicyTagParameters, id string) string {
+	return gcp.StringValue(p.Taxonomy) + "/policyTags/" + id
+}
+
+// GetPolicyTagID returns the server-assigned ID of the supplied policy tag.
+func GetPolicyTagID(t datacatalog.GoogleCloudDatacatalogV1PolicyTag) string {
+	return path.Base(t.Name)
+}
+
+// GeneratePolicyTag produces a PolicyTag that is configured via the given
+// PolicyTagParameters.
+func GeneratePolicyTag(p v1alpha1.PolicyTagParameters) *datacatalog.GoogleCloudDatacatalogV1PolicyTag {
+	return &datacatalog.GoogleCloudDatacatalogV1PolicyTag{
+		DisplayName:     p.DisplayName,
+		Description:     gcp.StringValue(p.Description),
+		ParentPolicyTag: gcp.StringValue(p.ParentPolicyTag),
+	}
+}
+
+// GeneratePolicyTagObservation produces a PolicyTagObservation from the
+// supplied PolicyTag.
+func GeneratePolicyTagObservation(t datacatalog.GoogleCloudDatacatalogV1PolicyTag) v1alpha1.PolicyTagObservation {
+	return v1alpha1.PolicyTagObservation{
+		Name:            t.Name,
+		ChildPolicyTags: t.ChildPolicyTags,
+	}
+}
+
+// LateInitializePolicyTag fills the empty fields of PolicyTagParameters with
+// the values seen in the supplied PolicyTag.
+func LateInitializePolicyTag(p *v1alpha1.PolicyTagParameters, t datacatalog.GoogleCloudDatacatalogV1PolicyTag) {
+	p.Description = gcp.LateInitializeString(p.Description, t.Description)
+}
+
+// IsPolicyTagUpToDate returns true if the supplied PolicyTag matches the
+// fields of the supplied PolicyTagParameters that can be updated in place.
+func IsPolicyTagUpToDate(p v1alpha1.PolicyTagParameters, t datacatalog.GoogleCloudDatacatalogV1PolicyTag) bool {
+	return p.DisplayName == t.DisplayName &&
+		gcp.StringValue(p.Description) == t.Description &&
+		gcp.StringValue(p.ParentPolicyTag) == t.ParentPolicyTag
+}
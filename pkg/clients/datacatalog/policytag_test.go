@@ -0,0 +1,120 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datacatalog
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	datacatalog "google.golang.org/api/datacatalog/v1"
+
+	"github.com/crossplane/provider-gcp/apis/datacatalog/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	policyTagName = taxonomyName + "/policyTags/5678"
+	parentName    = taxonomyName + "/policyTags/1000"
+)
+
+func policyTagParams(m ...func(*v1alpha1.PolicyTagParameters)) *v1alpha1.PolicyTagParameters {
+	p := &v1alpha1.PolicyTagParameters{
+		Taxonomy:        gcp.StringPtr(taxonomyName),
+		DisplayName:     "PII",
+		Description:     gcp.StringPtr("Personally identifiable information"),
+		ParentPolicyTag: gcp.StringPtr(parentName),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func policyTag(m ...func(*datacatalog.GoogleCloudDatacatalogV1PolicyTag)) *datacatalog.GoogleCloudDatacatalogV1PolicyTag {
+	t := &datacatalog.GoogleCloudDatacatalogV1PolicyTag{
+		DisplayName:     "PII",
+		Description:     "Personally identifiable information",
+		ParentPolicyTag: parentName,
+	}
+	for _, f := range m {
+		f(t)
+	}
+	return t
+}
+
+func TestGetPolicyTagName(t *testing.T) {
+	if diff := cmp.Diff(policyTagName, GetPolicyTagName(*policyTagParams(), "5678")); diff != "" {
+		t.Errorf("GetPolicyTagName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGeneratePolicyTag(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.PolicyTagParameters
+		want *datacatalog.GoogleCloudDatacatalogV1PolicyTag
+	}{
+		"Child": {
+			p:    *policyTagParams(),
+			want: policyTag(),
+		},
+		"Root": {
+			p:    *policyTagParams(func(p *v1alpha1.PolicyTagParameters) { p.ParentPolicyTag = nil }),
+			want: policyTag(func(t *datacatalog.GoogleCloudDatacatalogV1PolicyTag) { t.ParentPolicyTag = "" }),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GeneratePolicyTag(tc.p)); diff != "" {
+				t.Errorf("GeneratePolicyTag(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializePolicyTag(t *testing.T) {
+	got := policyTagParams(func(p *v1alpha1.PolicyTagParameters) { p.Description = nil })
+	LateInitializePolicyTag(got, *policyTag())
+	if diff := cmp.Diff(policyTagParams(), got); diff != "" {
+		t.Errorf("LateInitializePolicyTag(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsPolicyTagUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.PolicyTagParameters
+		t    datacatalog.GoogleCloudDatacatalogV1PolicyTag
+		want bool
+	}{
+		"UpToDate": {
+			p:    *policyTagParams(),
+			t:    *policyTag(),
+			want: true,
+		},
+		"Moved": {
+			p:    *policyTagParams(),
+			t:    *policyTag(func(t *datacatalog.GoogleCloudDatacatalogV1PolicyTag) { t.ParentPolicyTag = "" }),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsPolicyTagUpToDate(tc.p, tc.t)); diff != "" {
+				t.Errorf("IsPolicyTagUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datacatalog
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	datacatalog "google.golang.org/api/datacatalog/v1"
+
+	"github.com/crossplane/provider-gcp/apis/datacatalog/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	tagTemplateNameFormat      = "projects/%s/locations/%s/tagTemplates/%s"
+	tagTemplateFieldNameFormat = "%s/fields/%s"
+)
+
+// TagTemplateUpdateMask is the set of TagTemplate fields that can be updated
+// in place. Fields are updated individually.
+const TagTemplateUpdateMask = "displayName,isPubliclyReadable"
+
+const (
+	tagTemplateFieldUpdateMask = "displayName,description,isRequired,order"
+	enumTypeUpdateMask         = "type.enumType"
+)
+
+// TagTemplateFieldChanges are the changes required to bring the fields of a
+// TagTemplate up to date, keyed by field ID.
+type TagTemplateFieldChanges struct {
+	Create map[string]*datacatalog.GoogleCloudDatacatalogV1TagTemplateField
+	Update map[string]*datacatalog.GoogleCloudDatacatalogV1TagTemplateField
+	Delete []string
+}
+
+// Empty returns true if no field has to be changed.
+func (c TagTemplateFieldChanges) Empty() bool {
+	return len(c.Create) == 0 && len(c.Update) == 0 && len(c.Delete) == 0
+}
+
+// GetTagTemplateParent builds the fully qualified name of the parent of a tag
+// template.
+func GetTagTemplateParent(project string, p v1alpha1.TagTemplateParameters) string {
+	return fmt.Sprintf(parentFormat, project, p.Location)
+}
+
+// GetTagTemplateName builds the fully qualified name of a tag template.
+func GetTagTemplateName(project string, p v1alpha1.TagTemplateParameters, id string) string {
+	return fmt.Sprintf(tagTemplateNameFormat, project, p.Location, id)
+}
+
+// GetTagTemplateFieldName builds the fully qualified name of a field of the
+// supplied tag template.
+func GetTagTemplateFieldName(template, id string) string {
+	return fmt.Sprintf(tagTemplateFieldNameFormat, template, id)
+}
+
+// GetTagTemplateFieldUpdateMask returns the update mask used to patch the
+// supplied field.
+func GetTagTemplateFieldUpdateMask(f datacatalog.GoogleCloudDatacatalogV1TagTemplateField) string {
+	if f.Type != nil && f.Type.EnumType != nil {
+		return tagTemplateFieldUpdateMask + "," + enumTypeUpdateMask
+	}
+	return tagTemplateFieldUpdateMask
+}
+
+// GenerateTagTemplate produces a TagTemplate that is configured via the given
+// TagTemplateParameters.
+func GenerateTagTemplate(p v1alpha1.TagTemplateParameters) *datacatalog.GoogleCloudDatacatalogV1TagTemplate {
+	t := &datacatalog.GoogleCloudDatacatalogV1TagTemplate{
+		DisplayName:        gcp.StringValue(p.DisplayName),
+		IsPubliclyReadable: gcp.BoolValue(p.IsPubliclyReadable),
+	}
+	if len(p.Fields) > 0 {
+		t.Fields = make(map[string]datacatalog.GoogleCloudDatacatalogV1TagTemplateField, len(p.Fields))
+		for id, f := range p.Fields {
+			t.Fields[id] = *GenerateTagTemplateField(f)
+		}
+	}
+	return t
+}
+
+// GenerateTagTemplateField produces a TagTemplateField that is configured via
+// the given TagTemplateField parameters.
+func GenerateTagTemplateField(f v1alpha1.TagTemplateField) *datacatalog.GoogleCloudDatacatalogV1TagTemplateField {
+	out := &datacatalog.GoogleCloudDatacatalogV1TagTemplateField{
+		DisplayName: gcp.StringValue(f.DisplayName),
+		Description: gcp.StringValue(f.Description),
+		IsRequired:  gcp.BoolValue(f.IsRequired),
+		Order:       gcp.Int64Value(f.Order),
+		Type:        &datacatalog.GoogleCloudDatacatalogV1FieldType{PrimitiveType: gcp.StringValue(f.Type.PrimitiveType)},
+	}
+	if e := f.Type.EnumType; e != nil {
+		out.Type.EnumType = &datacatalog.GoogleCloudDatacatalogV1FieldTypeEnumType{}
+		for _, v := range e.AllowedValues {
+			out.Type.EnumType.AllowedValues = append(out.Type.EnumType.AllowedValues, &datacatalog.GoogleCloudDatacatalogV1FieldTypeEnumTypeEnumValue{DisplayName: v})
+		}
+	}
+	return out
+}
+
+// GenerateTagTemplateObservation produces a TagTemplateObservation from the
+// supplied TagTemplate.
+func GenerateTagTemplateObservation(t datacatalog.GoogleCloudDatacatalogV1TagTemplate) v1alpha1.TagTemplateObservation {
+	return v1alpha1.TagTemplateObservation{
+		Name: t.Name,
+	}
+}
+
+// LateInitializeTagTemplate fills the empty fields of TagTemplateParameters
+// with the values seen in the supplied TagTemplate.
+func LateInitializeTagTemplate(p *v1alpha1.TagTemplateParameters, t datacatalog.GoogleCloudDatacatalogV1TagTemplate) {
+	p.DisplayName = gcp.LateInitializeString(p.DisplayName, t.DisplayName)
+	p.IsPubliclyReadable = gcp.LateInitializeBool(p.IsPubliclyReadable, t.IsPubliclyReadable)
+}
+
+// GetTagTemplateFieldChanges returns the changes required to make the fields
+// of the supplied TagTemplate match the supplied TagTemplateParameters.
+func GetTagTemplateFieldChanges(p v1alpha1.TagTemplateParameters, t datacatalog.GoogleCloudDatacatalogV1TagTemplate) TagTemplateFieldChanges {
+	c := TagTemplateFieldChanges{
+		Create: map[string]*datacatalog.GoogleCloudDatacatalogV1TagTemplateField{},
+		Update: map[string]*datacatalog.GoogleCloudDatacatalogV1TagTemplateField{},
+	}
+	for id, f := range p.Fields {
+		desired := GenerateTagTemplateField(f)
+		observed, ok := t.Fields[id]
+		if !ok {
+			c.Create[id] = desired
+			continue
+		}
+		if !isTagTemplateFieldUpToDate(*desired, observed) {
+			c.Update[id] = desired
+		}
+	}
+	for id := range t.Fields {
+		if _, ok := p.Fields[id]; !ok {
+			c.Delete = append(c.Delete, id)
+		}
+	}
+	sort.Strings(c.Delete)
+	return c
+}
+
+func isTagTemplateFieldUpToDate(desired, observed datacatalog.GoogleCloudDatacatalogV1TagTemplateField) bool {
+	return cmp.Equal(desired, observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(datacatalog.GoogleCloudDatacatalogV1TagTemplateField{}, "Name"))
+}
+
+// IsTagTemplateUpToDate returns true if the supplied TagTemplate matches the
+// supplied TagTemplateParameters.
+func IsTagTemplateUpToDate(p v1alpha1.TagTemplateParameters, t datacatalog.GoogleCloudDatacatalogV1TagTemplate) bool {
+	return gcp.StringValue(p.DisplayName) == t.DisplayName &&
+		gcp.BoolValue(p.IsPubliclyReadable) == t.IsPubliclyReadable &&
+		GetTagTemplateFieldChanges(p, t).Empty()
+}
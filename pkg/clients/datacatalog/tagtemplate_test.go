@@ -0,0 +1,222 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datacatalog
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	datacatalog "google.golang.org/api/datacatalog/v1"
+
+	"github.com/crossplane/provider-gcp/apis/datacatalog/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const tagTemplateName = "projects/coolProject/locations/us-central1/tagTemplates/data_owner"
+
+func tagTemplateParams(m ...func(*v1alpha1.TagTemplateParameters)) *v1alpha1.TagTemplateParameters {
+	p := &v1alpha1.TagTemplateParameters{
+		Location:           "us-central1",
+		DisplayName:        gcp.StringPtr("Data owner"),
+		IsPubliclyReadable: gcp.BoolPtr(true),
+		Fields: map[string]v1alpha1.TagTemplateField{
+			"owner": {
+				DisplayName: gcp.StringPtr("Owner"),
+				Type:        v1alpha1.FieldType{PrimitiveType: gcp.StringPtr(v1alpha1.FieldTypeString)},
+				IsRequired:  gcp.BoolPtr(true),
+				Order:       gcp.Int64Ptr(2),
+			},
+			"tier": {
+				DisplayName: gcp.StringPtr("Tier"),
+				Description: gcp.StringPtr("How critical the data is"),
+				Type:        v1alpha1.FieldType{EnumType: &v1alpha1.EnumType{AllowedValues: []string{"GOLD", "SILVER"}}},
+			},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func ownerField() datacatalog.GoogleCloudDatacatalogV1TagTemplateField {
+	return datacatalog.GoogleCloudDatacatalogV1TagTemplateField{
+		DisplayName: "Owner",
+		Type:        &datacatalog.GoogleCloudDatacatalogV1FieldType{PrimitiveType: v1alpha1.FieldTypeString},
+		IsRequired:  true,
+		Order:       2,
+	}
+}
+
+func tierField() datacatalog.GoogleCloudDatacatalogV1TagTemplateField {
+	return datacatalog.GoogleCloudDatacatalogV1TagTemplateField{
+		DisplayName: "Tier",
+		Description: "How critical the data is",
+		Type: &datacatalog.GoogleCloudDatacatalogV1FieldType{
+			EnumType: &datacatalog.GoogleCloudDatacatalogV1FieldTypeEnumType{
+				AllowedValues: []*datacatalog.GoogleCloudDatacatalogV1FieldTypeEnumTypeEnumValue{{DisplayName: "GOLD"}, {DisplayName: "SILVER"}},
+			},
+		},
+	}
+}
+
+func tagTemplate(m ...func(*datacatalog.GoogleCloudDatacatalogV1TagTemplate)) *datacatalog.GoogleCloudDatacatalogV1TagTemplate {
+	t := &datacatalog.GoogleCloudDatacatalogV1TagTemplate{
+		DisplayName:        "Data owner",
+		IsPubliclyReadable: true,
+		Fields: map[string]datacatalog.GoogleCloudDatacatalogV1TagTemplateField{
+			"owner": ownerField(),
+			"tier":  tierField(),
+		},
+	}
+	for _, f := range m {
+		f(t)
+	}
+	return t
+}
+
+func TestGetTagTemplateName(t *testing.T) {
+	if diff := cmp.Diff(tagTemplateName, GetTagTemplateName(project, *tagTemplateParams(), "data_owner")); diff != "" {
+		t.Errorf("GetTagTemplateName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetTagTemplateFieldUpdateMask(t *testing.T) {
+	cases := map[string]struct {
+		f    datacatalog.GoogleCloudDatacatalogV1TagTemplateField
+		want string
+	}{
+		"Primitive": {
+			f:    ownerField(),
+			want: "displayName,description,isRequired,order",
+		},
+		"Enum": {
+			f:    tierField(),
+			want: "displayName,description,isRequired,order,type.enumType",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GetTagTemplateFieldUpdateMask(tc.f)); diff != "" {
+				t.Errorf("GetTagTemplateFieldUpdateMask(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateTagTemplate(t *testing.T) {
+	if diff := cmp.Diff(tagTemplate(), GenerateTagTemplate(*tagTemplateParams())); diff != "" {
+		t.Errorf("GenerateTagTemplate(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeTagTemplate(t *testing.T) {
+	got := tagTemplateParams(func(p *v1alpha1.TagTemplateParameters) {
+		p.DisplayName = nil
+		p.IsPubliclyReadable = nil
+	})
+	LateInitializeTagTemplate(got, *tagTemplate())
+	if diff := cmp.Diff(tagTemplateParams(), got); diff != "" {
+		t.Errorf("LateInitializeTagTemplate(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetTagTemplateFieldChanges(t *testing.T) {
+	owner, tier := ownerField(), tierField()
+	tier.Type.EnumType.AllowedValues = append(tier.Type.EnumType.AllowedValues, &datacatalog.GoogleCloudDatacatalogV1FieldTypeEnumTypeEnumValue{DisplayName: "BRONZE"})
+
+	cases := map[string]struct {
+		p    v1alpha1.TagTemplateParameters
+		t    datacatalog.GoogleCloudDatacatalogV1TagTemplate
+		want TagTemplateFieldChanges
+	}{
+		"UpToDate": {
+			p: *tagTemplateParams(),
+			t: *tagTemplate(func(t *datacatalog.GoogleCloudDatacatalogV1TagTemplate) {
+				f := t.Fields["owner"]
+				f.Name = tagTemplateName + "/fields/owner"
+				t.Fields["owner"] = f
+			}),
+			want: TagTemplateFieldChanges{
+				Create: map[string]*datacatalog.GoogleCloudDatacatalogV1TagTemplateField{},
+				Update: map[string]*datacatalog.GoogleCloudDatacatalogV1TagTemplateField{},
+			},
+		},
+		"AllChanges": {
+			p: *tagTemplateParams(func(p *v1alpha1.TagTemplateParameters) {
+				tier := p.Fields["tier"]
+				tier.Type.EnumType.AllowedValues = []string{"GOLD", "SILVER", "BRONZE"}
+				p.Fields["tier"] = tier
+			}),
+			t: *tagTemplate(func(t *datacatalog.GoogleCloudDatacatalogV1TagTemplate) {
+				delete(t.Fields, "owner")
+				t.Fields["steward"] = ownerField()
+			}),
+			want: TagTemplateFieldChanges{
+				Create: map[string]*datacatalog.GoogleCloudDatacatalogV1TagTemplateField{"owner": &owner},
+				Update: map[string]*datacatalog.GoogleCloudDatacatalogV1TagTemplateField{"tier": &tier},
+				Delete: []string{"steward"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetTagTemplateFieldChanges(tc.p, tc.t)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetTagTemplateFieldChanges(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(name == "UpToDate", got.Empty()); diff != "" {
+				t.Errorf("Empty(): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsTagTemplateUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.TagTemplateParameters
+		t    datacatalog.GoogleCloudDatacatalogV1TagTemplate
+		want bool
+	}{
+		"UpToDate": {
+			p:    *tagTemplateParams(),
+			t:    *tagTemplate(),
+			want: true,
+		},
+		"NotPubliclyReadable": {
+			p:    *tagTemplateParams(),
+			t:    *tagTemplate(func(t *datacatalog.GoogleCloudDatacatalogV1TagTemplate) { t.IsPubliclyReadable = false }),
+			want: false,
+		},
+		"FieldChanged": {
+			p: *tagTemplateParams(),
+			t: *tagTemplate(func(t *datacatalog.GoogleCloudDatacatalogV1TagTemplate) {
+				f := t.Fields["owner"]
+				f.IsRequired = false
+				t.Fields["owner"] = f
+			}),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsTagTemplateUpToDate(tc.p, tc.t)); diff != "" {
+				t.Errorf("IsTagTemplateUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
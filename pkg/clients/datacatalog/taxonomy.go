@@ -0,0 +1,93 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datacatalog
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	datacatalog "google.golang.org/api/datacatalog/v1"
+
+	"github.com/crossplane/provider-gcp/apis/datacatalog/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat       = "projects/%s/locations/%s"
+	taxonomyNameFormat = "projects/%s/locations/%s/taxonomies/%s"
+)
+
+// TaxonomyUpdateMask is the set of Taxonomy fields that can be updated in
+// place.
+const TaxonomyUpdateMask = "displayName,description,activatedPolicyTypes"
+
+// GetTaxonomyParent builds the fully qualified name of the parent of a
+// taxonomy.
+func GetTaxonomyParent(project string, p v1alpha1.TaxonomyParameters) string {
+	return fmt.Sprintf(parentFormat, project, p.Location)
+}
+
+// GetTaxonomyName builds the fully qualified name of a taxonomy.
+func GetTaxonomyName(project string, p v1alpha1.TaxonomyParameters, id string) string {
+	return fmt.Sprintf(taxonomyNameFormat, project, p.Location, id)
+}
+
+// GetTaxonomyID returns the server-assigned ID of the supplied taxonomy.
+func GetTaxonomyID(t datacatalog.GoogleCloudDatacatalogV1Taxonomy) string {
+	return path.Base(t.Name)
+}
+
+// GenerateTaxonomy produces a Taxonomy that is configured via the given
+// TaxonomyParameters.
+func GenerateTaxonomy(p v1alpha1.TaxonomyParameters) *datacatalog.GoogleCloudDatacatalogV1Taxonomy {
+	return &datacatalog.GoogleCloudDatacatalogV1Taxonomy{
+		DisplayName:          p.DisplayName,
+		Description:          gcp.StringValue(p.Description),
+		ActivatedPolicyTypes: p.ActivatedPolicyTypes,
+	}
+}
+
+// GenerateTaxonomyObservation produces a TaxonomyObservation from the
+// supplied Taxonomy.
+func GenerateTaxonomyObservation(t datacatalog.GoogleCloudDatacatalogV1Taxonomy) v1alpha1.TaxonomyObservation {
+	o := v1alpha1.TaxonomyObservation{
+		Name:           t.Name,
+		PolicyTagCount: t.PolicyTagCount,
+	}
+	if ts := t.TaxonomyTimestamps; ts != nil {
+		o.CreateTime = ts.CreateTime
+		o.UpdateTime = ts.UpdateTime
+	}
+	return o
+}
+
+// LateInitializeTaxonomy fills the empty fields of TaxonomyParameters with
+// the values seen in the supplied Taxonomy.
+func LateInitializeTaxonomy(p *v1alpha1.TaxonomyParameters, t datacatalog.GoogleCloudDatacatalogV1Taxonomy) {
+	p.Description = gcp.LateInitializeString(p.Description, t.Description)
+	p.ActivatedPolicyTypes = gcp.LateInitializeStringSlice(p.ActivatedPolicyTypes, t.ActivatedPolicyTypes)
+}
+
+// IsTaxonomyUpToDate returns true if the supplied Taxonomy matches the
+// fields of the supplied TaxonomyParameters that can be updated in place.
+func IsTaxonomyUpToDate(p v1alpha1.TaxonomyParameters, t datacatalog.GoogleCloudDatacatalogV1Taxonomy) bool {
+	return p.DisplayName == t.DisplayName &&
+		gcp.StringValue(p.Description) == t.Description &&
+		cmp.Equal(p.ActivatedPolicyTypes, t.ActivatedPolicyTypes, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b }))
+}
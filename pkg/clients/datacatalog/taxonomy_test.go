@@ -0,0 +1,137 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datacatalog
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	datacatalog "google.golang.org/api/datacatalog/v1"
+
+	"github.com/crossplane/provider-gcp/apis/datacatalog/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	project      = "coolProject"
+	taxonomyName = "projects/coolProject/locations/us/taxonomies/1234"
+)
+
+func taxonomyParams(m ...func(*v1alpha1.TaxonomyParameters)) *v1alpha1.TaxonomyParameters {
+	p := &v1alpha1.TaxonomyParameters{
+		Location:             "us",
+		DisplayName:          "Sensitivity",
+		Description:          gcp.StringPtr("Data sensitivity levels"),
+		ActivatedPolicyTypes: []string{v1alpha1.PolicyTypeFineGrainedAccessControl},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func taxonomy(m ...func(*datacatalog.GoogleCloudDatacatalogV1Taxonomy)) *datacatalog.GoogleCloudDatacatalogV1Taxonomy {
+	t := &datacatalog.GoogleCloudDatacatalogV1Taxonomy{
+		DisplayName:          "Sensitivity",
+		Description:          "Data sensitivity levels",
+		ActivatedPolicyTypes: []string{v1alpha1.PolicyTypeFineGrainedAccessControl},
+	}
+	for _, f := range m {
+		f(t)
+	}
+	return t
+}
+
+func TestGetTaxonomyName(t *testing.T) {
+	if diff := cmp.Diff(taxonomyName, GetTaxonomyName(project, *taxonomyParams(), "1234")); diff != "" {
+		t.Errorf("GetTaxonomyName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetTaxonomyID(t *testing.T) {
+	if diff := cmp.Diff("1234", GetTaxonomyID(datacatalog.GoogleCloudDatacatalogV1Taxonomy{Name: taxonomyName})); diff != "" {
+		t.Errorf("GetTaxonomyID(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateTaxonomy(t *testing.T) {
+	if diff := cmp.Diff(taxonomy(), GenerateTaxonomy(*taxonomyParams())); diff != "" {
+		t.Errorf("GenerateTaxonomy(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateTaxonomyObservation(t *testing.T) {
+	tx := *taxonomy(func(t *datacatalog.GoogleCloudDatacatalogV1Taxonomy) {
+		t.Name = taxonomyName
+		t.PolicyTagCount = 3
+		t.TaxonomyTimestamps = &datacatalog.GoogleCloudDatacatalogV1SystemTimestamps{
+			CreateTime: "2021-01-01T00:00:00Z",
+			UpdateTime: "2021-01-02T00:00:00Z",
+		}
+	})
+	want := v1alpha1.TaxonomyObservation{
+		Name:           taxonomyName,
+		PolicyTagCount: 3,
+		CreateTime:     "2021-01-01T00:00:00Z",
+		UpdateTime:     "2021-01-02T00:00:00Z",
+	}
+	if diff := cmp.Diff(want, GenerateTaxonomyObservation(tx)); diff != "" {
+		t.Errorf("GenerateTaxonomyObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeTaxonomy(t *testing.T) {
+	got := taxonomyParams(func(p *v1alpha1.TaxonomyParameters) {
+		p.Description = nil
+		p.ActivatedPolicyTypes = nil
+	})
+	LateInitializeTaxonomy(got, *taxonomy())
+	if diff := cmp.Diff(taxonomyParams(), got); diff != "" {
+		t.Errorf("LateInitializeTaxonomy(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsTaxonomyUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.TaxonomyParameters
+		t    datacatalog.GoogleCloudDatacatalogV1Taxonomy
+		want bool
+	}{
+		"UpToDate": {
+			p:    *taxonomyParams(),
+			t:    *taxonomy(),
+			want: true,
+		},
+		"DisplayNameChanged": {
+			p:    *taxonomyParams(),
+			t:    *taxonomy(func(t *datacatalog.GoogleCloudDatacatalogV1Taxonomy) { t.DisplayName = "Sensitive" }),
+			want: false,
+		},
+		"AccessControlDeactivated": {
+			p:    *taxonomyParams(func(p *v1alpha1.TaxonomyParameters) { p.ActivatedPolicyTypes = []string{} }),
+			t:    *taxonomy(),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsTaxonomyUpToDate(tc.p, tc.t)); diff != "" {
+				t.Errorf("IsTaxonomyUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataflow
+
+import (
+	dataflow "google.golang.org/api/dataflow/v1b3"
+
+	"github.com/crossplane/provider-gcp/apis/dataflow/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// GenerateLaunchTemplateParameters produces the parameters used to launch the
+// classic template of the supplied JobParameters as a job with the supplied
+// name.
+func GenerateLaunchTemplateParameters(name string, p v1alpha1.JobParameters) *dataflow.LaunchTemplateParameters {
+	ltp := &dataflow.LaunchTemplateParameters{
+		JobName:    name,
+		Parameters: p.Parameters,
+	}
+	if e := p.Environment; e != nil {
+		ltp.Environment = &dataflow.RuntimeEnvironment{
+			NumWorkers:            gcp.Int64Value(e.NumWorkers),
+			MaxWorkers:            gcp.Int64Value(e.MaxWorkers),
+			MachineType:           gcp.StringValue(e.MachineType),
+			ServiceAccountEmail:   gcp.StringValue(e.ServiceAccountEmail),
+			TempLocation:          gcp.StringValue(e.TempLocation),
+			Network:               gcp.StringValue(e.Network),
+			Subnetwork:            gcp.StringValue(e.Subnetwork),
+			WorkerRegion:          gcp.StringValue(e.WorkerRegion),
+			WorkerZone:            gcp.StringValue(e.WorkerZone),
+			IpConfiguration:       gcp.StringValue(e.IPConfiguration),
+			KmsKeyName:            gcp.StringValue(e.KMSKeyName),
+			AdditionalExperiments: e.AdditionalExperiments,
+			AdditionalUserLabels:  e.AdditionalUserLabels,
+			EnableStreamingEngine: gcp.BoolValue(e.EnableStreamingEngine),
+		}
+	}
+	return ltp
+}
+
+// GenerateLaunchFlexTemplateRequest produces the request used to launch the
+// flex template of the supplied JobParameters as a job with the supplied
+// name.
+func GenerateLaunchFlexTemplateRequest(name string, p v1alpha1.JobParameters) *dataflow.LaunchFlexTemplateRequest {
+	lp := &dataflow.LaunchFlexTemplateParameter{
+		JobName:              name,
+		ContainerSpecGcsPath: gcp.StringValue(p.ContainerSpecGCSPath),
+		Parameters:           p.Parameters,
+	}
+	if e := p.Environment; e != nil {
+		lp.Environment = &dataflow.FlexTemplateRuntimeEnvironment{
+			NumWorkers:            gcp.Int64Value(e.NumWorkers),
+			MaxWorkers:            gcp.Int64Value(e.MaxWorkers),
+			MachineType:           gcp.StringValue(e.MachineType),
+			ServiceAccountEmail:   gcp.StringValue(e.ServiceAccountEmail),
+			TempLocation:          gcp.StringValue(e.TempLocation),
+			Network:               gcp.StringValue(e.Network),
+			Subnetwork:            gcp.StringValue(e.Subnetwork),
+			WorkerRegion:          gcp.StringValue(e.WorkerRegion),
+			WorkerZone:            gcp.StringValue(e.WorkerZone),
+			IpConfiguration:       gcp.StringValue(e.IPConfiguration),
+			KmsKeyName:            gcp.StringValue(e.KMSKeyName),
+			AdditionalExperiments: e.AdditionalExperiments,
+			AdditionalUserLabels:  e.AdditionalUserLabels,
+			EnableStreamingEngine: gcp.BoolValue(e.EnableStreamingEngine),
+		}
+	}
+	return &dataflow.LaunchFlexTemplateRequest{LaunchParameter: lp}
+}
+
+// GenerateJobObservation produces a JobObservation from the supplied Job.
+func GenerateJobObservation(j dataflow.Job) v1alpha1.JobObservation {
+	o := v1alpha1.JobObservation{
+		Name:             j.Name,
+		Type:             j.Type,
+		CurrentState:     j.CurrentState,
+		CurrentStateTime: j.CurrentStateTime,
+		RequestedState:   j.RequestedState,
+		CreateTime:       j.CreateTime,
+		StartTime:        j.StartTime,
+	}
+	if j.JobMetadata != nil && j.JobMetadata.SdkVersion != nil {
+		o.SDKVersion = j.JobMetadata.SdkVersion.Version
+		o.SDKSupportStatus = j.JobMetadata.SdkVersion.SdkSupportStatus
+	}
+	return o
+}
+
+// LateInitializeJob fills the empty fields of JobParameters with the values
+// seen in the supplied Job.
+func LateInitializeJob(p *v1alpha1.JobParameters, j dataflow.Job) {
+	p.JobName = gcp.LateInitializeString(p.JobName, j.Name)
+}
+
+// IsJobTerminated returns true if the supplied job state is one the job can
+// never leave.
+func IsJobTerminated(state string) bool {
+	switch state {
+	case v1alpha1.JobStateDone, v1alpha1.JobStateFailed, v1alpha1.JobStateCancelled,
+		v1alpha1.JobStateUpdated, v1alpha1.JobStateDrained:
+		return true
+	}
+	return false
+}
+
+// GetDeleteRequestedState returns the state a job must be asked to enter in
+// order to stop it according to the OnDelete policy of the supplied
+// JobParameters.
+func GetDeleteRequestedState(p v1alpha1.JobParameters) string {
+	if gcp.StringValue(p.OnDelete) == v1alpha1.JobOnDeleteDrain {
+		return v1alpha1.JobStateDrained
+	}
+	return v1alpha1.JobStateCancelled
+}
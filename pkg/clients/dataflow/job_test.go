@@ -0,0 +1,193 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataflow
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	dataflow "google.golang.org/api/dataflow/v1b3"
+
+	"github.com/crossplane/provider-gcp/apis/dataflow/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const jobName = "cool-job"
+
+func params(m ...func(*v1alpha1.JobParameters)) *v1alpha1.JobParameters {
+	p := &v1alpha1.JobParameters{
+		Location:        "us-cool1",
+		TemplateGCSPath: gcp.StringPtr("gs://dataflow-templates/latest/Word_Count"),
+		Parameters:      map[string]string{"inputFile": "gs://cool/in.txt", "output": "gs://cool/out"},
+		Environment: &v1alpha1.JobEnvironment{
+			MaxWorkers:            gcp.Int64Ptr(3),
+			MachineType:           gcp.StringPtr("n1-standard-1"),
+			TempLocation:          gcp.StringPtr("gs://cool/tmp"),
+			IPConfiguration:       gcp.StringPtr("WORKER_IP_PRIVATE"),
+			AdditionalUserLabels:  map[string]string{"cool": "true"},
+			EnableStreamingEngine: gcp.BoolPtr(true),
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func TestGenerateLaunchTemplateParameters(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.JobParameters
+		want *dataflow.LaunchTemplateParameters
+	}{
+		"FullConversion": {
+			p: *params(),
+			want: &dataflow.LaunchTemplateParameters{
+				JobName:    jobName,
+				Parameters: map[string]string{"inputFile": "gs://cool/in.txt", "output": "gs://cool/out"},
+				Environment: &dataflow.RuntimeEnvironment{
+					MaxWorkers:            3,
+					MachineType:           "n1-standard-1",
+					TempLocation:          "gs://cool/tmp",
+					IpConfiguration:       "WORKER_IP_PRIVATE",
+					AdditionalUserLabels:  map[string]string{"cool": "true"},
+					EnableStreamingEngine: true,
+				},
+			},
+		},
+		"NoEnvironment": {
+			p: *params(func(p *v1alpha1.JobParameters) {
+				p.Environment = nil
+				p.Parameters = nil
+			}),
+			want: &dataflow.LaunchTemplateParameters{JobName: jobName},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateLaunchTemplateParameters(jobName, tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateLaunchTemplateParameters(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateLaunchFlexTemplateRequest(t *testing.T) {
+	p := params(func(p *v1alpha1.JobParameters) {
+		p.TemplateGCSPath = nil
+		p.ContainerSpecGCSPath = gcp.StringPtr("gs://cool/templates/spec.json")
+	})
+	want := &dataflow.LaunchFlexTemplateRequest{
+		LaunchParameter: &dataflow.LaunchFlexTemplateParameter{
+			JobName:              jobName,
+			ContainerSpecGcsPath: "gs://cool/templates/spec.json",
+			Parameters:           map[string]string{"inputFile": "gs://cool/in.txt", "output": "gs://cool/out"},
+			Environment: &dataflow.FlexTemplateRuntimeEnvironment{
+				MaxWorkers:            3,
+				MachineType:           "n1-standard-1",
+				TempLocation:          "gs://cool/tmp",
+				IpConfiguration:       "WORKER_IP_PRIVATE",
+				AdditionalUserLabels:  map[string]string{"cool": "true"},
+				EnableStreamingEngine: true,
+			},
+		},
+	}
+	if diff := cmp.Diff(want, GenerateLaunchFlexTemplateRequest(jobName, *p)); diff != "" {
+		t.Errorf("GenerateLaunchFlexTemplateRequest(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateJobObservation(t *testing.T) {
+	j := dataflow.Job{
+		Id:               "2021-01-01_00_00_00-123",
+		Name:             jobName,
+		Type:             "JOB_TYPE_BATCH",
+		CurrentState:     v1alpha1.JobStateRunning,
+		CurrentStateTime: "2021-01-01T00:01:00Z",
+		CreateTime:       "2021-01-01T00:00:00Z",
+		JobMetadata: &dataflow.JobMetadata{
+			SdkVersion: &dataflow.SdkVersion{Version: "2.50.0", SdkSupportStatus: "SUPPORTED"},
+		},
+	}
+	want := v1alpha1.JobObservation{
+		Name:             jobName,
+		Type:             "JOB_TYPE_BATCH",
+		CurrentState:     v1alpha1.JobStateRunning,
+		CurrentStateTime: "2021-01-01T00:01:00Z",
+		CreateTime:       "2021-01-01T00:00:00Z",
+		SDKVersion:       "2.50.0",
+		SDKSupportStatus: "SUPPORTED",
+	}
+	if diff := cmp.Diff(want, GenerateJobObservation(j)); diff != "" {
+		t.Errorf("GenerateJobObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeJob(t *testing.T) {
+	p := params()
+	LateInitializeJob(p, dataflow.Job{Name: jobName})
+	want := params(func(p *v1alpha1.JobParameters) { p.JobName = gcp.StringPtr(jobName) })
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("LateInitializeJob(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsJobTerminated(t *testing.T) {
+	cases := map[string]bool{
+		v1alpha1.JobStateRunning:    false,
+		v1alpha1.JobStateDraining:   false,
+		v1alpha1.JobStateCancelling: false,
+		v1alpha1.JobStateDone:       true,
+		v1alpha1.JobStateFailed:     true,
+		v1alpha1.JobStateCancelled:  true,
+		v1alpha1.JobStateDrained:    true,
+	}
+	for state, want := range cases {
+		t.Run(state, func(t *testing.T) {
+			if diff := cmp.Diff(want, IsJobTerminated(state)); diff != "" {
+				t.Errorf("IsJobTerminated(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetDeleteRequestedState(t *testing.T) {
+	cases := map[string]struct {
+		onDelete *string
+		want     string
+	}{
+		"Default": {
+			want: v1alpha1.JobStateCancelled,
+		},
+		"Cancel": {
+			onDelete: gcp.StringPtr(v1alpha1.JobOnDeleteCancel),
+			want:     v1alpha1.JobStateCancelled,
+		},
+		"Drain": {
+			onDelete: gcp.StringPtr(v1alpha1.JobOnDeleteDrain),
+			want:     v1alpha1.JobStateDrained,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetDeleteRequestedState(*params(func(p *v1alpha1.JobParameters) { p.OnDelete = tc.onDelete }))
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetDeleteRequestedState(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
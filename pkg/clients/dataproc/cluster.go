@@ -0,0 +1,229 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataproc
+
+import (
+	"path"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	dataproc "google.golang.org/api/dataproc/v1"
+
+	"github.com/crossplane/provider-gcp/apis/dataproc/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// systemLabelPrefix is the prefix of the labels Dataproc adds to every
+// cluster.
+const systemLabelPrefix = "goog-dataproc-"
+
+// ClusterUpdateMask is the set of Cluster fields that can be updated in
+// place.
+const ClusterUpdateMask = "labels,config.worker_config.num_instances,config.secondary_worker_config.num_instances,config.autoscaling_config.policy_uri"
+
+// GenerateCluster produces a Dataproc Cluster that is configured via the
+// given ClusterParameters.
+func GenerateCluster(project, name string, p v1alpha1.ClusterParameters) *dataproc.Cluster {
+	return &dataproc.Cluster{
+		ProjectId:   project,
+		ClusterName: name,
+		Labels:      p.Labels,
+		Config:      GenerateClusterConfig(p.Config),
+	}
+}
+
+// GenerateClusterConfig produces a Dataproc ClusterConfig from the supplied
+// ClusterConfig.
+func GenerateClusterConfig(cfg v1alpha1.ClusterConfig) *dataproc.ClusterConfig {
+	c := &dataproc.ClusterConfig{
+		ConfigBucket:          gcp.StringValue(cfg.ConfigBucket),
+		TempBucket:            gcp.StringValue(cfg.TempBucket),
+		MasterConfig:          generateInstanceGroupConfig(cfg.MasterConfig),
+		WorkerConfig:          generateInstanceGroupConfig(cfg.WorkerConfig),
+		SecondaryWorkerConfig: generateInstanceGroupConfig(cfg.SecondaryWorkerConfig),
+	}
+	if g := cfg.GCEClusterConfig; g != nil {
+		c.GceClusterConfig = &dataproc.GceClusterConfig{
+			ZoneUri:              gcp.StringValue(g.Zone),
+			NetworkUri:           gcp.StringValue(g.Network),
+			SubnetworkUri:        gcp.StringValue(g.Subnetwork),
+			InternalIpOnly:       gcp.BoolValue(g.InternalIPOnly),
+			ServiceAccount:       gcp.StringValue(g.ServiceAccount),
+			ServiceAccountScopes: g.ServiceAccountScopes,
+			Tags:                 g.Tags,
+			Metadata:             g.Metadata,
+		}
+	}
+	if s := cfg.SoftwareConfig; s != nil {
+		c.SoftwareConfig = &dataproc.SoftwareConfig{
+			ImageVersion:       gcp.StringValue(s.ImageVersion),
+			OptionalComponents: s.OptionalComponents,
+			Properties:         s.Properties,
+		}
+	}
+	for _, a := range cfg.InitializationActions {
+		c.InitializationActions = append(c.InitializationActions, &dataproc.NodeInitializationAction{
+			ExecutableFile:   a.ExecutableFile,
+			ExecutionTimeout: gcp.StringValue(a.ExecutionTimeout),
+		})
+	}
+	if a := cfg.AutoscalingConfig; a != nil {
+		c.AutoscalingConfig = &dataproc.AutoscalingConfig{PolicyUri: a.PolicyURI}
+	}
+	if e := cfg.EndpointConfig; e != nil {
+		c.EndpointConfig = &dataproc.EndpointConfig{EnableHttpPortAccess: e.EnableHTTPPortAccess}
+	}
+	return c
+}
+
+func generateInstanceGroupConfig(in *v1alpha1.InstanceGroupConfig) *dataproc.InstanceGroupConfig {
+	if in == nil {
+		return nil
+	}
+	out := &dataproc.InstanceGroupConfig{
+		NumInstances:   gcp.Int64Value(in.NumInstances),
+		MachineTypeUri: gcp.StringValue(in.MachineType),
+		MinCpuPlatform: gcp.StringValue(in.MinCPUPlatform),
+		ImageUri:       gcp.StringValue(in.ImageURI),
+		Preemptibility: gcp.StringValue(in.Preemptibility),
+	}
+	// Scaling a worker group in to zero instances is meaningful, so we send
+	// the number of instances whenever it is set.
+	if in.NumInstances != nil {
+		out.ForceSendFields = []string{"NumInstances"}
+	}
+	if d := in.DiskConfig; d != nil {
+		out.DiskConfig = &dataproc.DiskConfig{
+			BootDiskType:   gcp.StringValue(d.BootDiskType),
+			BootDiskSizeGb: gcp.Int64Value(d.BootDiskSizeGB),
+			NumLocalSsds:   gcp.Int64Value(d.NumLocalSSDs),
+		}
+	}
+	for _, a := range in.Accelerators {
+		out.Accelerators = append(out.Accelerators, &dataproc.AcceleratorConfig{
+			AcceleratorTypeUri: a.AcceleratorType,
+			AcceleratorCount:   a.AcceleratorCount,
+		})
+	}
+	return out
+}
+
+// GenerateClusterObservation produces a ClusterObservation from the supplied
+// Cluster.
+func GenerateClusterObservation(c dataproc.Cluster) v1alpha1.ClusterObservation {
+	o := v1alpha1.ClusterObservation{ClusterUUID: c.ClusterUuid}
+	if s := c.Status; s != nil {
+		o.State = s.State
+		o.Substate = s.Substate
+		o.StateDetail = s.Detail
+		o.StateStartTime = s.StateStartTime
+	}
+	if c.Config != nil && c.Config.EndpointConfig != nil {
+		o.HTTPPorts = c.Config.EndpointConfig.HttpPorts
+	}
+	return o
+}
+
+// LateInitializeCluster fills the empty fields of ClusterParameters with the
+// values seen in the supplied Cluster.
+func LateInitializeCluster(p *v1alpha1.ClusterParameters, c dataproc.Cluster) {
+	if c.Config == nil {
+		return
+	}
+	cfg := &p.Config
+	cfg.ConfigBucket = gcp.LateInitializeString(cfg.ConfigBucket, c.Config.ConfigBucket)
+	cfg.TempBucket = gcp.LateInitializeString(cfg.TempBucket, c.Config.TempBucket)
+	if g := c.Config.GceClusterConfig; g != nil && g.ZoneUri != "" {
+		if cfg.GCEClusterConfig == nil {
+			cfg.GCEClusterConfig = &v1alpha1.GCEClusterConfig{}
+		}
+		// The zone is reported as a URI even if it was specified by name.
+		cfg.GCEClusterConfig.Zone = gcp.LateInitializeString(cfg.GCEClusterConfig.Zone, path.Base(g.ZoneUri))
+	}
+	if s := c.Config.SoftwareConfig; s != nil && s.ImageVersion != "" {
+		if cfg.SoftwareConfig == nil {
+			cfg.SoftwareConfig = &v1alpha1.SoftwareConfig{}
+		}
+		cfg.SoftwareConfig.ImageVersion = gcp.LateInitializeString(cfg.SoftwareConfig.ImageVersion, s.ImageVersion)
+	}
+	cfg.MasterConfig = lateInitializeNumInstances(cfg.MasterConfig, c.Config.MasterConfig)
+	cfg.WorkerConfig = lateInitializeNumInstances(cfg.WorkerConfig, c.Config.WorkerConfig)
+}
+
+func lateInitializeNumInstances(in *v1alpha1.InstanceGroupConfig, from *dataproc.InstanceGroupConfig) *v1alpha1.InstanceGroupConfig {
+	if from == nil || from.NumInstances == 0 {
+		return in
+	}
+	if in == nil {
+		in = &v1alpha1.InstanceGroupConfig{}
+	}
+	in.NumInstances = gcp.LateInitializeInt64(in.NumInstances, from.NumInstances)
+	return in
+}
+
+// IsClusterUpToDate returns true if the supplied Cluster matches the fields
+// of the supplied ClusterParameters that can be updated in place.
+func IsClusterUpToDate(p v1alpha1.ClusterParameters, c dataproc.Cluster) bool {
+	if !cmp.Equal(p.Labels, userLabels(c.Labels), cmpopts.EquateEmpty()) {
+		return false
+	}
+	cfg := c.Config
+	if cfg == nil {
+		cfg = &dataproc.ClusterConfig{}
+	}
+	observedPolicy := ""
+	if cfg.AutoscalingConfig != nil {
+		observedPolicy = cfg.AutoscalingConfig.PolicyUri
+	}
+	if a := p.Config.AutoscalingConfig; a != nil {
+		// The autoscaler owns the number of workers of an autoscaled
+		// cluster.
+		return isSameResource(a.PolicyURI, observedPolicy)
+	}
+	return observedPolicy == "" &&
+		isNumInstancesUpToDate(p.Config.WorkerConfig, cfg.WorkerConfig) &&
+		isNumInstancesUpToDate(p.Config.SecondaryWorkerConfig, cfg.SecondaryWorkerConfig)
+}
+
+func isNumInstancesUpToDate(in *v1alpha1.InstanceGroupConfig, observed *dataproc.InstanceGroupConfig) bool {
+	if in == nil || in.NumInstances == nil {
+		return true
+	}
+	if observed == nil {
+		return *in.NumInstances == 0
+	}
+	return *in.NumInstances == observed.NumInstances
+}
+
+// userLabels returns the supplied labels without the labels Dataproc adds to
+// every cluster.
+func userLabels(l map[string]string) map[string]string {
+	out := make(map[string]string, len(l))
+	for k, v := range l {
+		if !strings.HasPrefix(k, systemLabelPrefix) {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// isSameResource returns true if the supplied resource names are equal, or
+// if the observed name is the fully qualified form of the desired name.
+func isSameResource(desired, observed string) bool {
+	return desired == observed || strings.HasSuffix(observed, "/"+desired)
+}
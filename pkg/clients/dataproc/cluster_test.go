@@ -0,0 +1,239 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataproc
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	dataproc "google.golang.org/api/dataproc/v1"
+
+	"github.com/crossplane/provider-gcp/apis/dataproc/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	project     = "coolProject"
+	clusterName = "cool-cluster"
+	policy      = "projects/coolProject/regions/us-cool1/autoscalingPolicies/cool-policy"
+	zoneURI     = "https://www.googleapis.com/compute/v1/projects/coolProject/zones/us-cool1-a"
+)
+
+func clusterParams(m ...func(*v1alpha1.ClusterParameters)) *v1alpha1.ClusterParameters {
+	p := &v1alpha1.ClusterParameters{
+		Region: "us-cool1",
+		Labels: map[string]string{"cool": "true"},
+		Config: v1alpha1.ClusterConfig{
+			ConfigBucket: gcp.StringPtr("cool-bucket"),
+			GCEClusterConfig: &v1alpha1.GCEClusterConfig{
+				Zone:           gcp.StringPtr("us-cool1-a"),
+				Subnetwork:     gcp.StringPtr("cool-subnet"),
+				InternalIPOnly: gcp.BoolPtr(true),
+				ServiceAccount: gcp.StringPtr("cool@coolProject.iam.gserviceaccount.com"),
+				Tags:           []string{"dataproc"},
+			},
+			MasterConfig: &v1alpha1.InstanceGroupConfig{
+				NumInstances: gcp.Int64Ptr(1),
+				MachineType:  gcp.StringPtr("n1-standard-4"),
+				DiskConfig:   &v1alpha1.DiskConfig{BootDiskType: gcp.StringPtr("pd-ssd"), BootDiskSizeGB: gcp.Int64Ptr(100)},
+			},
+			WorkerConfig: &v1alpha1.InstanceGroupConfig{
+				NumInstances: gcp.Int64Ptr(2),
+				MachineType:  gcp.StringPtr("n1-standard-4"),
+				Accelerators: []v1alpha1.AcceleratorConfig{{AcceleratorType: "nvidia-tesla-t4", AcceleratorCount: 1}},
+			},
+			SoftwareConfig: &v1alpha1.SoftwareConfig{
+				ImageVersion:       gcp.StringPtr("2.1"),
+				OptionalComponents: []string{"JUPYTER"},
+			},
+			InitializationActions: []v1alpha1.InitializationAction{{ExecutableFile: "gs://cool-bucket/init.sh", ExecutionTimeout: gcp.StringPtr("600s")}},
+			EndpointConfig:        &v1alpha1.EndpointConfig{EnableHTTPPortAccess: true},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func cluster(m ...func(*dataproc.Cluster)) *dataproc.Cluster {
+	c := &dataproc.Cluster{
+		ProjectId:   project,
+		ClusterName: clusterName,
+		Labels:      map[string]string{"cool": "true"},
+		Config: &dataproc.ClusterConfig{
+			ConfigBucket: "cool-bucket",
+			GceClusterConfig: &dataproc.GceClusterConfig{
+				ZoneUri:        "us-cool1-a",
+				SubnetworkUri:  "cool-subnet",
+				InternalIpOnly: true,
+				ServiceAccount: "cool@coolProject.iam.gserviceaccount.com",
+				Tags:           []string{"dataproc"},
+			},
+			MasterConfig: &dataproc.InstanceGroupConfig{
+				NumInstances:    1,
+				MachineTypeUri:  "n1-standard-4",
+				DiskConfig:      &dataproc.DiskConfig{BootDiskType: "pd-ssd", BootDiskSizeGb: 100},
+				ForceSendFields: []string{"NumInstances"},
+			},
+			WorkerConfig: &dataproc.InstanceGroupConfig{
+				NumInstances:    2,
+				MachineTypeUri:  "n1-standard-4",
+				Accelerators:    []*dataproc.AcceleratorConfig{{AcceleratorTypeUri: "nvidia-tesla-t4", AcceleratorCount: 1}},
+				ForceSendFields: []string{"NumInstances"},
+			},
+			SoftwareConfig: &dataproc.SoftwareConfig{
+				ImageVersion:       "2.1",
+				OptionalComponents: []string{"JUPYTER"},
+			},
+			InitializationActions: []*dataproc.NodeInitializationAction{{ExecutableFile: "gs://cool-bucket/init.sh", ExecutionTimeout: "600s"}},
+			EndpointConfig:        &dataproc.EndpointConfig{EnableHttpPortAccess: true},
+		},
+	}
+	for _, f := range m {
+		f(c)
+	}
+	return c
+}
+
+func TestGenerateCluster(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.ClusterParameters
+		want *dataproc.Cluster
+	}{
+		"FullConversion": {
+			p:    *clusterParams(),
+			want: cluster(),
+		},
+		"Autoscaling": {
+			p: *clusterParams(func(p *v1alpha1.ClusterParameters) {
+				p.Config.AutoscalingConfig = &v1alpha1.AutoscalingConfig{PolicyURI: policy}
+			}),
+			want: cluster(func(c *dataproc.Cluster) {
+				c.Config.AutoscalingConfig = &dataproc.AutoscalingConfig{PolicyUri: policy}
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCluster(project, clusterName, tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateCluster(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateClusterObservation(t *testing.T) {
+	c := *cluster(func(c *dataproc.Cluster) {
+		c.ClusterUuid = "cool-uuid"
+		c.Status = &dataproc.ClusterStatus{State: v1alpha1.ClusterStateRunning, StateStartTime: "2021-01-01T00:00:00Z"}
+		c.Config.EndpointConfig.HttpPorts = map[string]string{"YARN ResourceManager": "https://cool.dataproc.googleusercontent.com/yarn/"}
+	})
+	want := v1alpha1.ClusterObservation{
+		ClusterUUID:    "cool-uuid",
+		State:          v1alpha1.ClusterStateRunning,
+		StateStartTime: "2021-01-01T00:00:00Z",
+		HTTPPorts:      map[string]string{"YARN ResourceManager": "https://cool.dataproc.googleusercontent.com/yarn/"},
+	}
+	if diff := cmp.Diff(want, GenerateClusterObservation(c)); diff != "" {
+		t.Errorf("GenerateClusterObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeCluster(t *testing.T) {
+	p := clusterParams(func(p *v1alpha1.ClusterParameters) {
+		p.Config.ConfigBucket = nil
+		p.Config.GCEClusterConfig.Zone = nil
+		p.Config.SoftwareConfig = nil
+		p.Config.WorkerConfig.NumInstances = nil
+	})
+	LateInitializeCluster(p, *cluster(func(c *dataproc.Cluster) {
+		c.Config.GceClusterConfig.ZoneUri = zoneURI
+	}))
+	want := clusterParams(func(p *v1alpha1.ClusterParameters) {
+		p.Config.SoftwareConfig = &v1alpha1.SoftwareConfig{ImageVersion: gcp.StringPtr("2.1")}
+	})
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("LateInitializeCluster(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsClusterUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.ClusterParameters
+		c    dataproc.Cluster
+		want bool
+	}{
+		"UpToDate": {
+			p: *clusterParams(),
+			c: *cluster(func(c *dataproc.Cluster) {
+				c.Labels["goog-dataproc-cluster-name"] = clusterName
+				c.Config.GceClusterConfig.ZoneUri = zoneURI
+			}),
+			want: true,
+		},
+		"LabelsDiffer": {
+			p:    *clusterParams(),
+			c:    *cluster(func(c *dataproc.Cluster) { c.Labels = nil }),
+			want: false,
+		},
+		"WorkersDiffer": {
+			p:    *clusterParams(),
+			c:    *cluster(func(c *dataproc.Cluster) { c.Config.WorkerConfig.NumInstances = 5 }),
+			want: false,
+		},
+		"SecondaryWorkersDiffer": {
+			p: *clusterParams(func(p *v1alpha1.ClusterParameters) {
+				p.Config.SecondaryWorkerConfig = &v1alpha1.InstanceGroupConfig{NumInstances: gcp.Int64Ptr(2)}
+			}),
+			c:    *cluster(),
+			want: false,
+		},
+		"AutoscalingPolicyAdded": {
+			p: *clusterParams(func(p *v1alpha1.ClusterParameters) {
+				p.Config.AutoscalingConfig = &v1alpha1.AutoscalingConfig{PolicyURI: policy}
+			}),
+			c:    *cluster(),
+			want: false,
+		},
+		"AutoscalingPolicyRemoved": {
+			p: *clusterParams(),
+			c: *cluster(func(c *dataproc.Cluster) {
+				c.Config.AutoscalingConfig = &dataproc.AutoscalingConfig{PolicyUri: policy}
+			}),
+			want: false,
+		},
+		"AutoscaledWorkers": {
+			p: *clusterParams(func(p *v1alpha1.ClusterParameters) {
+				p.Config.AutoscalingConfig = &v1alpha1.AutoscalingConfig{PolicyURI: policy}
+			}),
+			c: *cluster(func(c *dataproc.Cluster) {
+				c.Config.AutoscalingConfig = &dataproc.AutoscalingConfig{PolicyUri: "https://dataproc.googleapis.com/v1/" + policy}
+				c.Config.WorkerConfig.NumInstances = 5
+			}),
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsClusterUpToDate(tc.p, tc.c)); diff != "" {
+				t.Errorf("IsClusterUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataproc
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitchellh/copystructure"
+	dataproc "google.golang.org/api/dataproc/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane/provider-gcp/apis/dataproc/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	workflowTemplateNameFormat = "projects/%s/regions/%s/workflowTemplates/%s"
+	regionFormat               = "projects/%s/regions/%s"
+
+	errCheckUpToDate = "unable to determine if external resource is up to date"
+)
+
+// GetWorkflowTemplateParent builds the fully qualified name of the workflow
+// template parent.
+func GetWorkflowTemplateParent(project string, p v1alpha1.WorkflowTemplateParameters) string {
+	return fmt.Sprintf(regionFormat, project, p.Region)
+}
+
+// GetWorkflowTemplateName builds the fully qualified name of the workflow
+// template.
+func GetWorkflowTemplateName(project string, p v1alpha1.WorkflowTemplateParameters, id string) string {
+	return fmt.Sprintf(workflowTemplateNameFormat, project, p.Region, id)
+}
+
+// GenerateWorkflowTemplate overlays the supplied WorkflowTemplateParameters
+// onto the supplied WorkflowTemplate. The version of the supplied
+// WorkflowTemplate is kept, since updates must specify the version they
+// replace.
+func GenerateWorkflowTemplate(id string, p v1alpha1.WorkflowTemplateParameters, t *dataproc.WorkflowTemplate) {
+	t.Id = id
+	t.Labels = p.Labels
+	t.DagTimeout = gcp.StringValue(p.DAGTimeout)
+	t.Placement = &dataproc.WorkflowTemplatePlacement{}
+	if mc := p.Placement.ManagedCluster; mc != nil {
+		t.Placement.ManagedCluster = &dataproc.ManagedCluster{
+			ClusterName: mc.ClusterName,
+			Config:      GenerateClusterConfig(mc.Config),
+			Labels:      mc.Labels,
+		}
+	}
+	if cs := p.Placement.ClusterSelector; cs != nil {
+		t.Placement.ClusterSelector = &dataproc.ClusterSelector{
+			Zone:          gcp.StringValue(cs.Zone),
+			ClusterLabels: cs.ClusterLabels,
+		}
+	}
+	t.Jobs = make([]*dataproc.OrderedJob, len(p.Jobs))
+	for i, j := range p.Jobs {
+		t.Jobs[i] = generateOrderedJob(j)
+	}
+	t.Parameters = nil
+	for _, tp := range p.Parameters {
+		t.Parameters = append(t.Parameters, &dataproc.TemplateParameter{
+			Name:        tp.Name,
+			Fields:      tp.Fields,
+			Description: gcp.StringValue(tp.Description),
+		})
+	}
+}
+
+func generateOrderedJob(in v1alpha1.OrderedJob) *dataproc.OrderedJob {
+	out := &dataproc.OrderedJob{
+		StepId:              in.StepID,
+		PrerequisiteStepIds: in.PrerequisiteStepIDs,
+		Labels:              in.Labels,
+	}
+	if j := in.SparkJob; j != nil {
+		out.SparkJob = &dataproc.SparkJob{
+			MainClass:      gcp.StringValue(j.MainClass),
+			MainJarFileUri: gcp.StringValue(j.MainJarFileURI),
+			Args:           j.Args,
+			JarFileUris:    j.JarFileURIs,
+			FileUris:       j.FileURIs,
+			ArchiveUris:    j.ArchiveURIs,
+			Properties:     j.Properties,
+		}
+	}
+	if j := in.PySparkJob; j != nil {
+		out.PysparkJob = &dataproc.PySparkJob{
+			MainPythonFileUri: j.MainPythonFileURI,
+			Args:              j.Args,
+			PythonFileUris:    j.PythonFileURIs,
+			JarFileUris:       j.JarFileURIs,
+			FileUris:          j.FileURIs,
+			ArchiveUris:       j.ArchiveURIs,
+			Properties:        j.Properties,
+		}
+	}
+	if j := in.HadoopJob; j != nil {
+		out.HadoopJob = &dataproc.HadoopJob{
+			MainClass:      gcp.StringValue(j.MainClass),
+			MainJarFileUri: gcp.StringValue(j.MainJarFileURI),
+			Args:           j.Args,
+			JarFileUris:    j.JarFileURIs,
+			FileUris:       j.FileURIs,
+			ArchiveUris:    j.ArchiveURIs,
+			Properties:     j.Properties,
+		}
+	}
+	if j := in.SparkSQLJob; j != nil {
+		out.SparkSqlJob = &dataproc.SparkSqlJob{
+			QueryFileUri:    gcp.StringValue(j.QueryFileURI),
+			ScriptVariables: j.ScriptVariables,
+			JarFileUris:     j.JarFileURIs,
+			Properties:      j.Properties,
+		}
+		if len(j.Queries) > 0 {
+			out.SparkSqlJob.QueryList = &dataproc.QueryList{Queries: j.Queries}
+		}
+	}
+	return out
+}
+
+// GenerateWorkflowTemplateObservation produces a WorkflowTemplateObservation
+// from the supplied WorkflowTemplate.
+func GenerateWorkflowTemplateObservation(t dataproc.WorkflowTemplate) v1alpha1.WorkflowTemplateObservation {
+	return v1alpha1.WorkflowTemplateObservation{
+		Name:       t.Name,
+		Version:    t.Version,
+		CreateTime: t.CreateTime,
+		UpdateTime: t.UpdateTime,
+	}
+}
+
+// IsWorkflowTemplateUpToDate returns true if the supplied WorkflowTemplate
+// matches the supplied WorkflowTemplateParameters.
+func IsWorkflowTemplateUpToDate(id string, p v1alpha1.WorkflowTemplateParameters, observed *dataproc.WorkflowTemplate) (bool, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return true, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*dataproc.WorkflowTemplate)
+	if !ok {
+		return true, errors.New(errCheckUpToDate)
+	}
+	GenerateWorkflowTemplate(id, p, desired)
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(dataproc.InstanceGroupConfig{}, "ForceSendFields")), nil
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataproc
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	dataproc "google.golang.org/api/dataproc/v1"
+
+	"github.com/crossplane/provider-gcp/apis/dataproc/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	templateID   = "cool-template"
+	templateName = "projects/coolProject/regions/us-cool1/workflowTemplates/cool-template"
+)
+
+func templateParams(m ...func(*v1alpha1.WorkflowTemplateParameters)) *v1alpha1.WorkflowTemplateParameters {
+	p := &v1alpha1.WorkflowTemplateParameters{
+		Region: "us-cool1",
+		Labels: map[string]string{"cool": "true"},
+		Placement: v1alpha1.WorkflowTemplatePlacement{
+			ManagedCluster: &v1alpha1.ManagedCluster{
+				ClusterName: "cool-cluster",
+				Config: v1alpha1.ClusterConfig{
+					WorkerConfig: &v1alpha1.InstanceGroupConfig{NumInstances: gcp.Int64Ptr(2)},
+				},
+			},
+		},
+		Jobs: []v1alpha1.OrderedJob{
+			{
+				StepID: "prepare",
+				SparkSQLJob: &v1alpha1.SparkSQLJob{
+					Queries: []string{"SELECT 1"},
+				},
+			},
+			{
+				StepID:              "compute",
+				PrerequisiteStepIDs: []string{"prepare"},
+				PySparkJob: &v1alpha1.PySparkJob{
+					MainPythonFileURI: "gs://cool-bucket/main.py",
+					Args:              []string{"--cool"},
+				},
+			},
+		},
+		Parameters: []v1alpha1.TemplateParameter{{
+			Name:   "ZONE",
+			Fields: []string{"placement.managedCluster.config.gceClusterConfig.zoneUri"},
+		}},
+		DAGTimeout: gcp.StringPtr("1800s"),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func template(m ...func(*dataproc.WorkflowTemplate)) *dataproc.WorkflowTemplate {
+	t := &dataproc.WorkflowTemplate{
+		Id:     templateID,
+		Labels: map[string]string{"cool": "true"},
+		Placement: &dataproc.WorkflowTemplatePlacement{
+			ManagedCluster: &dataproc.ManagedCluster{
+				ClusterName: "cool-cluster",
+				Config: &dataproc.ClusterConfig{
+					WorkerConfig: &dataproc.InstanceGroupConfig{NumInstances: 2, ForceSendFields: []string{"NumInstances"}},
+				},
+			},
+		},
+		Jobs: []*dataproc.OrderedJob{
+			{
+				StepId:      "prepare",
+				SparkSqlJob: &dataproc.SparkSqlJob{QueryList: &dataproc.QueryList{Queries: []string{"SELECT 1"}}},
+			},
+			{
+				StepId:              "compute",
+				PrerequisiteStepIds: []string{"prepare"},
+				PysparkJob: &dataproc.PySparkJob{
+					MainPythonFileUri: "gs://cool-bucket/main.py",
+					Args:              []string{"--cool"},
+				},
+			},
+		},
+		Parameters: []*dataproc.TemplateParameter{{
+			Name:   "ZONE",
+			Fields: []string{"placement.managedCluster.config.gceClusterConfig.zoneUri"},
+		}},
+		DagTimeout: "1800s",
+	}
+	for _, f := range m {
+		f(t)
+	}
+	return t
+}
+
+func TestGetWorkflowTemplateName(t *testing.T) {
+	if diff := cmp.Diff(templateName, GetWorkflowTemplateName(project, *templateParams(), templateID)); diff != "" {
+		t.Errorf("GetWorkflowTemplateName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateWorkflowTemplate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.WorkflowTemplateParameters
+		t    *dataproc.WorkflowTemplate
+		want *dataproc.WorkflowTemplate
+	}{
+		"FullConversion": {
+			p:    *templateParams(),
+			t:    &dataproc.WorkflowTemplate{},
+			want: template(),
+		},
+		"KeepVersion": {
+			p: *templateParams(),
+			t: &dataproc.WorkflowTemplate{Name: templateName, Version: 3},
+			want: template(func(t *dataproc.WorkflowTemplate) {
+				t.Name = templateName
+				t.Version = 3
+			}),
+		},
+		"ClusterSelector": {
+			p: *templateParams(func(p *v1alpha1.WorkflowTemplateParameters) {
+				p.Placement = v1alpha1.WorkflowTemplatePlacement{
+					ClusterSelector: &v1alpha1.ClusterSelector{ClusterLabels: map[string]string{"env": "cool"}},
+				}
+			}),
+			t: &dataproc.WorkflowTemplate{},
+			want: template(func(t *dataproc.WorkflowTemplate) {
+				t.Placement = &dataproc.WorkflowTemplatePlacement{
+					ClusterSelector: &dataproc.ClusterSelector{ClusterLabels: map[string]string{"env": "cool"}},
+				}
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			GenerateWorkflowTemplate(templateID, tc.p, tc.t)
+			if diff := cmp.Diff(tc.want, tc.t); diff != "" {
+				t.Errorf("GenerateWorkflowTemplate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateWorkflowTemplateObservation(t *testing.T) {
+	tmpl := *template(func(t *dataproc.WorkflowTemplate) {
+		t.Name = templateName
+		t.Version = 2
+		t.CreateTime = "2021-01-01T00:00:00Z"
+	})
+	want := v1alpha1.WorkflowTemplateObservation{
+		Name:       templateName,
+		Version:    2,
+		CreateTime: "2021-01-01T00:00:00Z",
+	}
+	if diff := cmp.Diff(want, GenerateWorkflowTemplateObservation(tmpl)); diff != "" {
+		t.Errorf("GenerateWorkflowTemplateObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsWorkflowTemplateUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.WorkflowTemplateParameters
+		t    *dataproc.WorkflowTemplate
+		want bool
+	}{
+		"UpToDate": {
+			p: *templateParams(),
+			t: template(func(t *dataproc.WorkflowTemplate) {
+				t.Name = templateName
+				t.Version = 2
+				t.Placement.ManagedCluster.Config.WorkerConfig.ForceSendFields = nil
+			}),
+			want: true,
+		},
+		"JobsDiffer": {
+			p: *templateParams(),
+			t: template(func(t *dataproc.WorkflowTemplate) {
+				t.Jobs = t.Jobs[:1]
+			}),
+			want: false,
+		},
+		"DAGTimeoutDiffers": {
+			p:    *templateParams(),
+			t:    template(func(t *dataproc.WorkflowTemplate) { t.DagTimeout = "600s" }),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsWorkflowTemplateUpToDate(templateID, tc.p, tc.t)
+			if err != nil {
+				t.Errorf("IsWorkflowTemplateUpToDate(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsWorkflowTemplateUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
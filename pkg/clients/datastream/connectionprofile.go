@@ -0,0 +1,195 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datastream
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	datastream "google.golang.org/api/datastream/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane/provider-gcp/apis/datastream/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	connectionProfileNameFormat = "projects/%s/locations/%s/connectionProfiles/%s"
+	parentFormat                = "projects/%s/locations/%s"
+
+	errGetSecret   = "cannot get credentials Secret"
+	errNoSecretKey = "credentials Secret has no key %q"
+)
+
+// ConnectionProfileUpdateMask is the set of ConnectionProfile fields that can
+// be updated in place.
+const ConnectionProfileUpdateMask = "displayName,labels"
+
+// Credentials used by a ConnectionProfile to connect to its source.
+type Credentials struct {
+	// Password of the database user.
+	Password string
+
+	// SSHPassword of the SSH tunnel user.
+	SSHPassword string
+
+	// SSHPrivateKey of the SSH tunnel user.
+	SSHPrivateKey string
+}
+
+// GetConnectionProfileParent builds the fully qualified name of the parent of
+// a connection profile.
+func GetConnectionProfileParent(project string, p v1alpha1.ConnectionProfileParameters) string {
+	return fmt.Sprintf(parentFormat, project, p.Location)
+}
+
+// GetConnectionProfileName builds the fully qualified name of a connection
+// profile.
+func GetConnectionProfileName(project string, p v1alpha1.ConnectionProfileParameters, name string) string {
+	return fmt.Sprintf(connectionProfileNameFormat, project, p.Location, name)
+}
+
+// GetCredentials reads the Credentials of the supplied
+// ConnectionProfileParameters from the referenced Secrets.
+func GetCredentials(ctx context.Context, kube client.Reader, p v1alpha1.ConnectionProfileParameters) (Credentials, error) {
+	c := Credentials{}
+	var err error
+	switch {
+	case p.MySQLProfile != nil:
+		c.Password, err = getSecretValue(ctx, kube, &p.MySQLProfile.PasswordSecretRef)
+	case p.PostgreSQLProfile != nil:
+		c.Password, err = getSecretValue(ctx, kube, &p.PostgreSQLProfile.PasswordSecretRef)
+	}
+	if err != nil {
+		return Credentials{}, err
+	}
+	if ssh := p.ForwardSSHConnectivity; ssh != nil {
+		if c.SSHPassword, err = getSecretValue(ctx, kube, ssh.PasswordSecretRef); err != nil {
+			return Credentials{}, err
+		}
+		if c.SSHPrivateKey, err = getSecretValue(ctx, kube, ssh.PrivateKeySecretRef); err != nil {
+			return Credentials{}, err
+		}
+	}
+	return c, nil
+}
+
+func getSecretValue(ctx context.Context, kube client.Reader, ref *xpv1.SecretKeySelector) (string, error) {
+	if ref == nil {
+		return "", nil
+	}
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return "", errors.Wrap(err, errGetSecret)
+	}
+	v, ok := s.Data[ref.Key]
+	if !ok {
+		return "", errors.Errorf(errNoSecretKey, ref.Key)
+	}
+	return string(v), nil
+}
+
+// GenerateConnectionProfile produces a Datastream ConnectionProfile with the
+// supplied fully qualified name and Credentials that is configured via the
+// given ConnectionProfileParameters.
+func GenerateConnectionProfile(name string, p v1alpha1.ConnectionProfileParameters, c Credentials) *datastream.ConnectionProfile {
+	cp := &datastream.ConnectionProfile{
+		Name:        name,
+		DisplayName: gcp.StringValue(p.DisplayName),
+		Labels:      p.Labels,
+	}
+	if m := p.MySQLProfile; m != nil {
+		cp.MysqlProfile = &datastream.MysqlProfile{
+			Hostname: m.Hostname,
+			Port:     gcp.Int64Value(m.Port),
+			Username: m.Username,
+			Password: c.Password,
+		}
+	}
+	if pg := p.PostgreSQLProfile; pg != nil {
+		cp.PostgresqlProfile = &datastream.PostgresqlProfile{
+			Hostname: pg.Hostname,
+			Port:     gcp.Int64Value(pg.Port),
+			Username: pg.Username,
+			Password: c.Password,
+			Database: pg.Database,
+		}
+	}
+	if p.BigQueryProfile != nil {
+		cp.BigqueryProfile = &datastream.BigQueryProfile{}
+	}
+	if g := p.GCSProfile; g != nil {
+		cp.GcsProfile = &datastream.GcsProfile{
+			Bucket:   g.Bucket,
+			RootPath: gcp.StringValue(g.RootPath),
+		}
+	}
+	if p.StaticServiceIPConnectivity != nil {
+		cp.StaticServiceIpConnectivity = &datastream.StaticServiceIpConnectivity{}
+	}
+	if ssh := p.ForwardSSHConnectivity; ssh != nil {
+		cp.ForwardSshConnectivity = &datastream.ForwardSshTunnelConnectivity{
+			Hostname:   ssh.Hostname,
+			Port:       gcp.Int64Value(ssh.Port),
+			Username:   ssh.Username,
+			Password:   c.SSHPassword,
+			PrivateKey: c.SSHPrivateKey,
+		}
+	}
+	if pc := p.PrivateConnectivity; pc != nil {
+		cp.PrivateConnectivity = &datastream.PrivateConnectivity{PrivateConnection: pc.PrivateConnection}
+	}
+	return cp
+}
+
+// GenerateConnectionProfileObservation produces a
+// ConnectionProfileObservation from the supplied ConnectionProfile.
+func GenerateConnectionProfileObservation(cp datastream.ConnectionProfile) v1alpha1.ConnectionProfileObservation {
+	return v1alpha1.ConnectionProfileObservation{
+		Name:       cp.Name,
+		CreateTime: cp.CreateTime,
+		UpdateTime: cp.UpdateTime,
+	}
+}
+
+// LateInitializeConnectionProfile fills the empty fields of
+// ConnectionProfileParameters with the values seen in the supplied
+// ConnectionProfile.
+func LateInitializeConnectionProfile(p *v1alpha1.ConnectionProfileParameters, cp datastream.ConnectionProfile) {
+	p.DisplayName = gcp.LateInitializeString(p.DisplayName, cp.DisplayName)
+	if p.MySQLProfile != nil && cp.MysqlProfile != nil {
+		p.MySQLProfile.Port = gcp.LateInitializeInt64(p.MySQLProfile.Port, cp.MysqlProfile.Port)
+	}
+	if p.PostgreSQLProfile != nil && cp.PostgresqlProfile != nil {
+		p.PostgreSQLProfile.Port = gcp.LateInitializeInt64(p.PostgreSQLProfile.Port, cp.PostgresqlProfile.Port)
+	}
+}
+
+// IsConnectionProfileUpToDate returns true if the supplied ConnectionProfile
+// matches the fields of the supplied ConnectionProfileParameters that can be
+// updated in place.
+func IsConnectionProfileUpToDate(p v1alpha1.ConnectionProfileParameters, cp datastream.ConnectionProfile) bool {
+	return gcp.StringValue(p.DisplayName) == cp.DisplayName &&
+		cmp.Equal(p.Labels, cp.Labels, cmpopts.EquateEmpty())
+}
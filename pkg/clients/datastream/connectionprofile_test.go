@@ -0,0 +1,254 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datastream
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	datastream "google.golang.org/api/datastream/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/datastream/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	project     = "coolProject"
+	profileName = "projects/coolProject/locations/us-cool1/connectionProfiles/cool-profile"
+)
+
+var errBoom = errors.New("boom")
+
+func secretRef(key string) xpv1.SecretKeySelector {
+	return xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Name: "cool-secret", Namespace: "cool-ns"},
+		Key:             key,
+	}
+}
+
+func profileParams(m ...func(*v1alpha1.ConnectionProfileParameters)) *v1alpha1.ConnectionProfileParameters {
+	p := &v1alpha1.ConnectionProfileParameters{
+		Location:    "us-cool1",
+		DisplayName: gcp.StringPtr("Cool profile"),
+		Labels:      map[string]string{"cool": "true"},
+		MySQLProfile: &v1alpha1.MySQLProfile{
+			Hostname:          "10.0.0.1",
+			Port:              gcp.Int64Ptr(3306),
+			Username:          "datastream",
+			PasswordSecretRef: secretRef("password"),
+		},
+		StaticServiceIPConnectivity: &v1alpha1.StaticServiceIPConnectivity{},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func profile(m ...func(*datastream.ConnectionProfile)) *datastream.ConnectionProfile {
+	cp := &datastream.ConnectionProfile{
+		Name:        profileName,
+		DisplayName: "Cool profile",
+		Labels:      map[string]string{"cool": "true"},
+		MysqlProfile: &datastream.MysqlProfile{
+			Hostname: "10.0.0.1",
+			Port:     3306,
+			Username: "datastream",
+			Password: "hunter2",
+		},
+		StaticServiceIpConnectivity: &datastream.StaticServiceIpConnectivity{},
+	}
+	for _, f := range m {
+		f(cp)
+	}
+	return cp
+}
+
+func TestGetConnectionProfileName(t *testing.T) {
+	if diff := cmp.Diff(profileName, GetConnectionProfileName(project, *profileParams(), "cool-profile")); diff != "" {
+		t.Errorf("GetConnectionProfileName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetCredentials(t *testing.T) {
+	type want struct {
+		c   Credentials
+		err error
+	}
+	secrets := func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+		if diff := cmp.Diff(client.ObjectKey{Name: "cool-secret", Namespace: "cool-ns"}, key); diff != "" {
+			t.Errorf("Get(...): -want key, +got key:\n%s", diff)
+		}
+		obj.(*corev1.Secret).Data = map[string][]byte{
+			"password":    []byte("hunter2"),
+			"ssh-key":     []byte("cool-key"),
+		}
+		return nil
+	}
+	cases := map[string]struct {
+		kube client.Reader
+		p    v1alpha1.ConnectionProfileParameters
+		want want
+	}{
+		"MySQL": {
+			kube: &test.MockClient{MockGet: secrets},
+			p:    *profileParams(),
+			want: want{c: Credentials{Password: "hunter2"}},
+		},
+		"PostgreSQLWithSSH": {
+			kube: &test.MockClient{MockGet: secrets},
+			p: *profileParams(func(p *v1alpha1.ConnectionProfileParameters) {
+				p.MySQLProfile = nil
+				p.PostgreSQLProfile = &v1alpha1.PostgreSQLProfile{PasswordSecretRef: secretRef("password")}
+				key := secretRef("ssh-key")
+				p.ForwardSSHConnectivity = &v1alpha1.ForwardSSHConnectivity{PrivateKeySecretRef: &key}
+			}),
+			want: want{c: Credentials{Password: "hunter2", SSHPrivateKey: "cool-key"}},
+		},
+		"Destination": {
+			p: *profileParams(func(p *v1alpha1.ConnectionProfileParameters) {
+				p.MySQLProfile = nil
+				p.BigQueryProfile = &v1alpha1.BigQueryProfile{}
+			}),
+			want: want{c: Credentials{}},
+		},
+		"MissingKey": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+			p:    *profileParams(),
+			want: want{err: errors.Errorf(errNoSecretKey, "password")},
+		},
+		"GetFailed": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			p:    *profileParams(),
+			want: want{err: errors.Wrap(errBoom, errGetSecret)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GetCredentials(context.Background(), tc.kube, tc.p)
+			if diff := cmp.Diff(tc.want.c, got); diff != "" {
+				t.Errorf("GetCredentials(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GetCredentials(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateConnectionProfile(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.ConnectionProfileParameters
+		c    Credentials
+		want *datastream.ConnectionProfile
+	}{
+		"MySQL": {
+			p:    *profileParams(),
+			c:    Credentials{Password: "hunter2"},
+			want: profile(),
+		},
+		"PostgreSQLWithSSH": {
+			p: *profileParams(func(p *v1alpha1.ConnectionProfileParameters) {
+				p.MySQLProfile = nil
+				p.StaticServiceIPConnectivity = nil
+				p.PostgreSQLProfile = &v1alpha1.PostgreSQLProfile{
+					Hostname: "10.0.0.2",
+					Username: "datastream",
+					Database: "cool",
+				}
+				p.ForwardSSHConnectivity = &v1alpha1.ForwardSSHConnectivity{Hostname: "bastion", Username: "tunnel"}
+			}),
+			c: Credentials{Password: "hunter2", SSHPrivateKey: "cool-key"},
+			want: profile(func(cp *datastream.ConnectionProfile) {
+				cp.MysqlProfile = nil
+				cp.StaticServiceIpConnectivity = nil
+				cp.PostgresqlProfile = &datastream.PostgresqlProfile{
+					Hostname: "10.0.0.2",
+					Username: "datastream",
+					Password: "hunter2",
+					Database: "cool",
+				}
+				cp.ForwardSshConnectivity = &datastream.ForwardSshTunnelConnectivity{Hostname: "bastion", Username: "tunnel", PrivateKey: "cool-key"}
+			}),
+		},
+		"GCS": {
+			p: *profileParams(func(p *v1alpha1.ConnectionProfileParameters) {
+				p.MySQLProfile = nil
+				p.StaticServiceIPConnectivity = nil
+				p.GCSProfile = &v1alpha1.GCSProfile{Bucket: "cool-bucket", RootPath: gcp.StringPtr("/cdc")}
+			}),
+			want: profile(func(cp *datastream.ConnectionProfile) {
+				cp.MysqlProfile = nil
+				cp.StaticServiceIpConnectivity = nil
+				cp.GcsProfile = &datastream.GcsProfile{Bucket: "cool-bucket", RootPath: "/cdc"}
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateConnectionProfile(profileName, tc.p, tc.c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateConnectionProfile(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeConnectionProfile(t *testing.T) {
+	p := profileParams(func(p *v1alpha1.ConnectionProfileParameters) {
+		p.DisplayName = nil
+		p.MySQLProfile.Port = nil
+	})
+	LateInitializeConnectionProfile(p, *profile())
+	if diff := cmp.Diff(profileParams(), p); diff != "" {
+		t.Errorf("LateInitializeConnectionProfile(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsConnectionProfileUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		cp   datastream.ConnectionProfile
+		want bool
+	}{
+		"UpToDate": {
+			cp:   *profile(func(cp *datastream.ConnectionProfile) { cp.MysqlProfile.Password = "" }),
+			want: true,
+		},
+		"DisplayNameDiffers": {
+			cp:   *profile(func(cp *datastream.ConnectionProfile) { cp.DisplayName = "Uncool profile" }),
+			want: false,
+		},
+		"LabelsDiffer": {
+			cp:   *profile(func(cp *datastream.ConnectionProfile) { cp.Labels = nil }),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsConnectionProfileUpToDate(*profileParams(), tc.cp)); diff != "" {
+				t.Errorf("IsConnectionProfileUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
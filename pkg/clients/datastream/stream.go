@@ -0,0 +1,216 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datastream
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	datastream "google.golang.org/api/datastream/v1"
+
+	"github.com/crossplane/provider-gcp/apis/datastream/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const streamNameFormat = "projects/%s/locations/%s/streams/%s"
+
+// GetStreamParent builds the fully qualified name of the parent of a stream.
+func GetStreamParent(project string, p v1alpha1.StreamParameters) string {
+	return fmt.Sprintf(parentFormat, project, p.Location)
+}
+
+// GetStreamName builds the fully qualified name of a stream.
+func GetStreamName(project string, p v1alpha1.StreamParameters, name string) string {
+	return fmt.Sprintf(streamNameFormat, project, p.Location, name)
+}
+
+// GenerateStream produces a Datastream Stream with the supplied fully
+// qualified name that is configured via the given StreamParameters. The
+// desired state is not included; streams are always created before they are
+// started.
+func GenerateStream(name string, p v1alpha1.StreamParameters) *datastream.Stream {
+	s := &datastream.Stream{
+		Name:                         name,
+		DisplayName:                  gcp.StringValue(p.DisplayName),
+		Labels:                       p.Labels,
+		CustomerManagedEncryptionKey: gcp.StringValue(p.CustomerManagedEncryptionKey),
+		SourceConfig:                 generateSourceConfig(p.SourceConfig),
+		DestinationConfig:            generateDestinationConfig(p.DestinationConfig),
+	}
+	if b := p.BackfillAll; b != nil {
+		s.BackfillAll = &datastream.BackfillAllStrategy{
+			MysqlExcludedObjects:      generateMySQLRDBMS(b.MySQLExcludedObjects),
+			PostgresqlExcludedObjects: generatePostgreSQLRDBMS(b.PostgreSQLExcludedObjects),
+		}
+	}
+	if p.BackfillNone != nil {
+		s.BackfillNone = &datastream.BackfillNoneStrategy{}
+	}
+	return s
+}
+
+func generateSourceConfig(in v1alpha1.StreamSourceConfig) *datastream.SourceConfig {
+	sc := &datastream.SourceConfig{SourceConnectionProfile: gcp.StringValue(in.SourceConnectionProfile)}
+	if m := in.MySQLSourceConfig; m != nil {
+		sc.MysqlSourceConfig = &datastream.MysqlSourceConfig{
+			IncludeObjects:             generateMySQLRDBMS(m.IncludeObjects),
+			ExcludeObjects:             generateMySQLRDBMS(m.ExcludeObjects),
+			MaxConcurrentBackfillTasks: gcp.Int64Value(m.MaxConcurrentBackfillTasks),
+			MaxConcurrentCdcTasks:      gcp.Int64Value(m.MaxConcurrentCDCTasks),
+		}
+	}
+	if pg := in.PostgreSQLSourceConfig; pg != nil {
+		sc.PostgresqlSourceConfig = &datastream.PostgresqlSourceConfig{
+			IncludeObjects:             generatePostgreSQLRDBMS(pg.IncludeObjects),
+			ExcludeObjects:             generatePostgreSQLRDBMS(pg.ExcludeObjects),
+			ReplicationSlot:            pg.ReplicationSlot,
+			Publication:                pg.Publication,
+			MaxConcurrentBackfillTasks: gcp.Int64Value(pg.MaxConcurrentBackfillTasks),
+		}
+	}
+	return sc
+}
+
+func generateMySQLRDBMS(in *v1alpha1.MySQLRDBMS) *datastream.MysqlRdbms {
+	if in == nil {
+		return nil
+	}
+	out := &datastream.MysqlRdbms{}
+	for _, d := range in.Databases {
+		db := &datastream.MysqlDatabase{Database: d.Database}
+		for _, t := range d.Tables {
+			db.MysqlTables = append(db.MysqlTables, &datastream.MysqlTable{Table: t})
+		}
+		out.MysqlDatabases = append(out.MysqlDatabases, db)
+	}
+	return out
+}
+
+func generatePostgreSQLRDBMS(in *v1alpha1.PostgreSQLRDBMS) *datastream.PostgresqlRdbms {
+	if in == nil {
+		return nil
+	}
+	out := &datastream.PostgresqlRdbms{}
+	for _, s := range in.Schemas {
+		schema := &datastream.PostgresqlSchema{Schema: s.Schema}
+		for _, t := range s.Tables {
+			schema.PostgresqlTables = append(schema.PostgresqlTables, &datastream.PostgresqlTable{Table: t})
+		}
+		out.PostgresqlSchemas = append(out.PostgresqlSchemas, schema)
+	}
+	return out
+}
+
+func generateDestinationConfig(in v1alpha1.StreamDestinationConfig) *datastream.DestinationConfig {
+	dc := &datastream.DestinationConfig{DestinationConnectionProfile: gcp.StringValue(in.DestinationConnectionProfile)}
+	if bq := in.BigQueryDestinationConfig; bq != nil {
+		dc.BigqueryDestinationConfig = &datastream.BigQueryDestinationConfig{
+			DataFreshness: gcp.StringValue(bq.DataFreshness),
+		}
+		if st := bq.SingleTargetDataset; st != nil {
+			dc.BigqueryDestinationConfig.SingleTargetDataset = &datastream.SingleTargetDataset{DatasetId: st.DatasetID}
+		}
+		if sh := bq.SourceHierarchyDatasets; sh != nil {
+			dc.BigqueryDestinationConfig.SourceHierarchyDatasets = &datastream.SourceHierarchyDatasets{
+				DatasetTemplate: &datastream.DatasetTemplate{
+					Location:        sh.Location,
+					DatasetIdPrefix: gcp.StringValue(sh.DatasetIDPrefix),
+					KmsKeyName:      gcp.StringValue(sh.KMSKeyName),
+				},
+			}
+		}
+	}
+	if g := in.GCSDestinationConfig; g != nil {
+		dc.GcsDestinationConfig = &datastream.GcsDestinationConfig{
+			Path:                 gcp.StringValue(g.Path),
+			FileRotationMb:       gcp.Int64Value(g.FileRotationMB),
+			FileRotationInterval: gcp.StringValue(g.FileRotationInterval),
+		}
+		if j := g.JSONFileFormat; j != nil {
+			dc.GcsDestinationConfig.JsonFileFormat = &datastream.JsonFileFormat{
+				SchemaFileFormat: gcp.StringValue(j.SchemaFileFormat),
+				Compression:      gcp.StringValue(j.Compression),
+			}
+		} else {
+			dc.GcsDestinationConfig.AvroFileFormat = &datastream.AvroFileFormat{}
+		}
+	}
+	return dc
+}
+
+// GenerateStreamObservation produces a StreamObservation from the supplied
+// Stream.
+func GenerateStreamObservation(s datastream.Stream) v1alpha1.StreamObservation {
+	o := v1alpha1.StreamObservation{
+		Name:             s.Name,
+		State:            s.State,
+		CreateTime:       s.CreateTime,
+		UpdateTime:       s.UpdateTime,
+		LastRecoveryTime: s.LastRecoveryTime,
+	}
+	for _, e := range s.Errors {
+		if e == nil {
+			continue
+		}
+		o.Errors = append(o.Errors, v1alpha1.StreamError{Reason: e.Reason, Message: e.Message, ErrorTime: e.ErrorTime})
+	}
+	return o
+}
+
+// LateInitializeStream fills the empty fields of StreamParameters with the
+// values seen in the supplied Stream.
+func LateInitializeStream(p *v1alpha1.StreamParameters, s datastream.Stream) {
+	p.DisplayName = gcp.LateInitializeString(p.DisplayName, s.DisplayName)
+}
+
+// GetStreamUpdateMask returns the set of fields of the supplied Stream that
+// differ from the supplied StreamParameters and can be updated in place. It
+// is empty if the Stream is up to date.
+func GetStreamUpdateMask(p v1alpha1.StreamParameters, s datastream.Stream) string {
+	var fields []string
+	if gcp.StringValue(p.DisplayName) != s.DisplayName {
+		fields = append(fields, "displayName")
+	}
+	if !cmp.Equal(p.Labels, s.Labels, cmpopts.EquateEmpty()) {
+		fields = append(fields, "labels")
+	}
+	if !isStateUpToDate(gcp.StringValue(p.DesiredState), s.State) {
+		fields = append(fields, "state")
+	}
+	return strings.Join(fields, ",")
+}
+
+// IsStreamUpToDate returns true if the supplied Stream matches the fields of
+// the supplied StreamParameters that can be updated in place.
+func IsStreamUpToDate(p v1alpha1.StreamParameters, s datastream.Stream) bool {
+	return GetStreamUpdateMask(p, s) == ""
+}
+
+// isStateUpToDate returns true unless the stream can be moved to the desired
+// state. Streams that are transitioning between states, failed or under
+// maintenance are left alone.
+func isStateUpToDate(desired, observed string) bool {
+	switch desired {
+	case v1alpha1.StreamStateRunning:
+		return observed != v1alpha1.StreamStateNotStarted && observed != v1alpha1.StreamStatePaused
+	case v1alpha1.StreamStatePaused:
+		return observed != v1alpha1.StreamStateRunning
+	}
+	return true
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datastream
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	datastream "google.golang.org/api/datastream/v1"
+
+	"github.com/crossplane/provider-gcp/apis/datastream/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	streamName  = "projects/coolProject/locations/us-cool1/streams/cool-stream"
+	destProfile = "projects/coolProject/locations/us-cool1/connectionProfiles/cool-bq"
+)
+
+func streamParams(m ...func(*v1alpha1.StreamParameters)) *v1alpha1.StreamParameters {
+	p := &v1alpha1.StreamParameters{
+		Location:    "us-cool1",
+		DisplayName: gcp.StringPtr("Cool stream"),
+		Labels:      map[string]string{"cool": "true"},
+		SourceConfig: v1alpha1.StreamSourceConfig{
+			SourceConnectionProfile: gcp.StringPtr(profileName),
+			MySQLSourceConfig: &v1alpha1.MySQLSourceConfig{
+				IncludeObjects: &v1alpha1.MySQLRDBMS{
+					Databases: []v1alpha1.MySQLDatabase{{Database: "shop", Tables: []string{"orders", "customers"}}},
+				},
+				MaxConcurrentCDCTasks: gcp.Int64Ptr(5),
+			},
+		},
+		DestinationConfig: v1alpha1.StreamDestinationConfig{
+			DestinationConnectionProfile: gcp.StringPtr(destProfile),
+			BigQueryDestinationConfig: &v1alpha1.BigQueryDestinationConfig{
+				DataFreshness:       gcp.StringPtr("900s"),
+				SingleTargetDataset: &v1alpha1.SingleTargetDataset{DatasetID: "coolProject:shop"},
+			},
+		},
+		BackfillAll: &v1alpha1.BackfillAllStrategy{
+			MySQLExcludedObjects: &v1alpha1.MySQLRDBMS{
+				Databases: []v1alpha1.MySQLDatabase{{Database: "shop", Tables: []string{"customers"}}},
+			},
+		},
+		DesiredState: gcp.StringPtr(v1alpha1.StreamStateRunning),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func stream(m ...func(*datastream.Stream)) *datastream.Stream {
+	s := &datastream.Stream{
+		Name:        streamName,
+		DisplayName: "Cool stream",
+		Labels:      map[string]string{"cool": "true"},
+		SourceConfig: &datastream.SourceConfig{
+			SourceConnectionProfile: profileName,
+			MysqlSourceConfig: &datastream.MysqlSourceConfig{
+				IncludeObjects: &datastream.MysqlRdbms{
+					MysqlDatabases: []*datastream.MysqlDatabase{{
+						Database:    "shop",
+						MysqlTables: []*datastream.MysqlTable{{Table: "orders"}, {Table: "customers"}},
+					}},
+				},
+				MaxConcurrentCdcTasks: 5,
+			},
+		},
+		DestinationConfig: &datastream.DestinationConfig{
+			DestinationConnectionProfile: destProfile,
+			BigqueryDestinationConfig: &datastream.BigQueryDestinationConfig{
+				DataFreshness:       "900s",
+				SingleTargetDataset: &datastream.SingleTargetDataset{DatasetId: "coolProject:shop"},
+			},
+		},
+		BackfillAll: &datastream.BackfillAllStrategy{
+			MysqlExcludedObjects: &datastream.MysqlRdbms{
+				MysqlDatabases: []*datastream.MysqlDatabase{{
+					Database:    "shop",
+					MysqlTables: []*datastream.MysqlTable{{Table: "customers"}},
+				}},
+			},
+		},
+	}
+	for _, f := range m {
+		f(s)
+	}
+	return s
+}
+
+func TestGetStreamName(t *testing.T) {
+	if diff := cmp.Diff(streamName, GetStreamName(project, *streamParams(), "cool-stream")); diff != "" {
+		t.Errorf("GetStreamName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateStream(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.StreamParameters
+		want *datastream.Stream
+	}{
+		"MySQLToBigQuery": {
+			p:    *streamParams(),
+			want: stream(),
+		},
+		"PostgreSQLToGCS": {
+			p: *streamParams(func(p *v1alpha1.StreamParameters) {
+				p.SourceConfig.MySQLSourceConfig = nil
+				p.SourceConfig.PostgreSQLSourceConfig = &v1alpha1.PostgreSQLSourceConfig{
+					IncludeObjects: &v1alpha1.PostgreSQLRDBMS{
+						Schemas: []v1alpha1.PostgreSQLSchema{{Schema: "public"}},
+					},
+					ReplicationSlot: "cool_slot",
+					Publication:     "cool_pub",
+				}
+				p.DestinationConfig.BigQueryDestinationConfig = nil
+				p.DestinationConfig.GCSDestinationConfig = &v1alpha1.GCSDestinationConfig{
+					Path:           gcp.StringPtr("/cdc"),
+					JSONFileFormat: &v1alpha1.JSONFileFormat{Compression: gcp.StringPtr("GZIP")},
+				}
+				p.BackfillAll = nil
+				p.BackfillNone = &v1alpha1.BackfillNoneStrategy{}
+			}),
+			want: stream(func(s *datastream.Stream) {
+				s.SourceConfig.MysqlSourceConfig = nil
+				s.SourceConfig.PostgresqlSourceConfig = &datastream.PostgresqlSourceConfig{
+					IncludeObjects: &datastream.PostgresqlRdbms{
+						PostgresqlSchemas: []*datastream.PostgresqlSchema{{Schema: "public"}},
+					},
+					ReplicationSlot: "cool_slot",
+					Publication:     "cool_pub",
+				}
+				s.DestinationConfig.BigqueryDestinationConfig = nil
+				s.DestinationConfig.GcsDestinationConfig = &datastream.GcsDestinationConfig{
+					Path:           "/cdc",
+					JsonFileFormat: &datastream.JsonFileFormat{Compression: "GZIP"},
+				}
+				s.BackfillAll = nil
+				s.BackfillNone = &datastream.BackfillNoneStrategy{}
+			}),
+		},
+		"AvroByDefault": {
+			p: *streamParams(func(p *v1alpha1.StreamParameters) {
+				p.DestinationConfig.BigQueryDestinationConfig = nil
+				p.DestinationConfig.GCSDestinationConfig = &v1alpha1.GCSDestinationConfig{}
+			}),
+			want: stream(func(s *datastream.Stream) {
+				s.DestinationConfig.BigqueryDestinationConfig = nil
+				s.DestinationConfig.GcsDestinationConfig = &datastream.GcsDestinationConfig{AvroFileFormat: &datastream.AvroFileFormat{}}
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateStream(streamName, tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateStream(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateStreamObservation(t *testing.T) {
+	s := *stream(func(s *datastream.Stream) {
+		s.State = v1alpha1.StreamStateFailed
+		s.Errors = []*datastream.Error{{Reason: "SOURCE_UNREACHABLE", Message: "cannot connect", ErrorTime: "2021-01-01T00:00:00Z"}}
+	})
+	want := v1alpha1.StreamObservation{
+		Name:   streamName,
+		State:  v1alpha1.StreamStateFailed,
+		Errors: []v1alpha1.StreamError{{Reason: "SOURCE_UNREACHABLE", Message: "cannot connect", ErrorTime: "2021-01-01T00:00:00Z"}},
+	}
+	if diff := cmp.Diff(want, GenerateStreamObservation(s)); diff != "" {
+		t.Errorf("GenerateStreamObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetStreamUpdateMask(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.StreamParameters
+		s    datastream.Stream
+		want string
+	}{
+		"UpToDate": {
+			p:    *streamParams(),
+			s:    *stream(func(s *datastream.Stream) { s.State = v1alpha1.StreamStateRunning }),
+			want: "",
+		},
+		"NotStarted": {
+			p:    *streamParams(),
+			s:    *stream(func(s *datastream.Stream) { s.State = v1alpha1.StreamStateNotStarted }),
+			want: "state",
+		},
+		"Starting": {
+			p:    *streamParams(),
+			s:    *stream(func(s *datastream.Stream) { s.State = v1alpha1.StreamStateStarting }),
+			want: "",
+		},
+		"Pause": {
+			p: *streamParams(func(p *v1alpha1.StreamParameters) {
+				p.DesiredState = gcp.StringPtr(v1alpha1.StreamStatePaused)
+			}),
+			s:    *stream(func(s *datastream.Stream) { s.State = v1alpha1.StreamStateRunning }),
+			want: "state",
+		},
+		"StayNotStarted": {
+			p: *streamParams(func(p *v1alpha1.StreamParameters) {
+				p.DesiredState = gcp.StringPtr(v1alpha1.StreamStatePaused)
+			}),
+			s:    *stream(func(s *datastream.Stream) { s.State = v1alpha1.StreamStateNotStarted }),
+			want: "",
+		},
+		"EverythingDiffers": {
+			p: *streamParams(),
+			s: *stream(func(s *datastream.Stream) {
+				s.DisplayName = "Uncool stream"
+				s.Labels = nil
+				s.State = v1alpha1.StreamStatePaused
+			}),
+			want: "displayName,labels,state",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GetStreamUpdateMask(tc.p, tc.s)); diff != "" {
+				t.Errorf("GetStreamUpdateMask(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// apiLog logs the requests sent to GCP APIs, or is nil if API debug logging
+// isn't enabled.
+var apiLog logging.Logger
+
+// redactedQueryParams are the query parameters whose values are credentials.
+var redactedQueryParams = []string{"key", "access_token"}
+
+// EnableAPIDebugLogging logs the method, URL, latency and status code of every
+// request sent to a GCP API with the supplied logger, along with the kind and
+// name of the managed resource whose reconcile sent it. Request and response
+// bodies are never logged, because they may contain secrets such as the
+// passwords of Cloud SQL users.
+func EnableAPIDebugLogging(l logging.Logger) {
+	apiLog = l
+}
+
+type reconcileKey struct{}
+
+type reconcileInfo struct {
+	kind string
+	name string
+	span trace.Span
+}
+
+// withReconcile returns a context that identifies the reconcile of the
+// supplied managed resource to the requests sent with it.
+func withReconcile(ctx context.Context, kind, name string) context.Context {
+	return context.WithValue(ctx, reconcileKey{}, reconcileInfo{kind: kind, name: name})
+}
+
+// NewDebugLoggingConnecter returns an ExternalConnecter that connects, and
+// whose external clients are called, with a context that identifies the
+// reconcile of the managed resource, so that the requests they send to GCP
+// APIs are logged as sent for it.
+func NewDebugLoggingConnecter(c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &contextConnecter{connecter: c, with: func(ctx context.Context, mg resource.Managed) context.Context {
+		if i, ok := inflight.get(mg); ok {
+			return withReconcile(ctx, i.kind, i.name)
+		}
+		return ctx
+	}}
+}
+
+// loggingTransport logs the requests it sends to the API of a GCP service.
+// Every attempt of a retried request is logged.
+type loggingTransport struct {
+	service string
+	log     logging.Logger
+	base    http.RoundTripper
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	rsp, err := t.base.RoundTrip(req)
+	kv := []interface{}{
+		"service", t.service,
+		"method", req.Method,
+		"url", redactURL(req.URL),
+		"latency", time.Since(start).String(),
+	}
+	if r, ok := req.Context().Value(reconcileKey{}).(reconcileInfo); ok {
+		kv = append(kv, "kind", r.kind, "name", r.name)
+	}
+	if err != nil {
+		kv = append(kv, "error", err.Error())
+	} else {
+		kv = append(kv, "status", rsp.StatusCode)
+	}
+	t.log.Info("GCP API request", kv...)
+	return rsp, err
+}
+
+// redactURL returns the supplied URL without any credentials.
+func redactURL(u *url.URL) string {
+	r := *u
+	r.User = nil
+	q := r.Query()
+	for _, p := range redactedQueryParams {
+		if q.Get(p) != "" {
+			q.Set(p, "REDACTED")
+		}
+	}
+	r.RawQuery = q.Encode()
+	return r.String()
+}
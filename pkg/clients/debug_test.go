@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+)
+
+// recordingLogger records the key value pairs it's asked to log at info level.
+type recordingLogger struct {
+	logging.Logger
+	kv map[string]interface{}
+}
+
+func (l *recordingLogger) Info(_ string, kv ...interface{}) {
+	l.kv = map[string]interface{}{}
+	for i := 0; i+1 < len(kv); i += 2 {
+		l.kv[kv[i].(string)] = kv[i+1]
+	}
+}
+
+func TestLoggingTransport(t *testing.T) {
+	badRequest := roundTripperFn(func(_ *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusBadRequest, Body: io.NopCloser(&bytes.Buffer{})}, nil
+	})
+	failed := roundTripperFn(func(_ *http.Request) (*http.Response, error) {
+		return nil, errors.New("boom")
+	})
+	ctx := withReconcile(context.Background(), "Network.compute.gcp.crossplane.io", "example")
+
+	cases := map[string]struct {
+		reason string
+		base   http.RoundTripper
+		ctx    context.Context
+		url    string
+		want   map[string]interface{}
+	}{
+		"StatusCode": {
+			reason: "The status code of a request should be logged along with the managed resource it was sent for",
+			base:   badRequest,
+			ctx:    ctx,
+			url:    "https://compute.googleapis.com/compute/v1/projects/p/global/networks?alt=json",
+			want: map[string]interface{}{
+				"service": "compute",
+				"method":  http.MethodGet,
+				"url":     "https://compute.googleapis.com/compute/v1/projects/p/global/networks?alt=json",
+				"kind":    "Network.compute.gcp.crossplane.io",
+				"name":    "example",
+				"status":  http.StatusBadRequest,
+			},
+		},
+		"Error": {
+			reason: "The error of a request that failed should be logged, and credentials in its URL should be redacted",
+			base:   failed,
+			ctx:    context.Background(),
+			url:    "https://compute.googleapis.com/compute/v1/projects/p/global/networks?key=secret",
+			want: map[string]interface{}{
+				"service": "compute",
+				"method":  http.MethodGet,
+				"url":     "https://compute.googleapis.com/compute/v1/projects/p/global/networks?key=REDACTED",
+				"error":   "boom",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			l := &recordingLogger{}
+			req, _ := http.NewRequestWithContext(tc.ctx, http.MethodGet, tc.url, nil)
+			_, _ = (&loggingTransport{service: "compute", log: l, base: tc.base}).RoundTrip(req)
+			delete(l.kv, "latency")
+			if diff := cmp.Diff(tc.want, l.kv); diff != "" {
+				t.Errorf("\n%s\nRoundTrip(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
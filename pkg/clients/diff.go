@@ -0,0 +1,179 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+)
+
+const errIgnoreFields = "cannot ignore fields"
+
+// Diff returns the paths of the fields whose values differ between the
+// supplied desired and observed state of a GCP resource when compared using
+// the supplied options. Paths use the JSON names of the fields in the GCP API,
+// e.g. routingConfig.routingMode or secondaryIpRanges[1].ipCidrRange. The
+// ForceSendFields and NullFields of GCP API clients only affect how requests
+// are encoded, so they are always ignored.
+func Diff(desired, observed interface{}, opts ...cmp.Option) []string {
+	r := &diffReporter{}
+	cmp.Equal(desired, observed, append(opts, ignoreClientFields(), cmp.Reporter(r))...)
+	return r.diffs
+}
+
+func ignoreClientFields() cmp.Option {
+	return cmp.FilterPath(func(p cmp.Path) bool {
+		sf, ok := p.Last().(cmp.StructField)
+		return ok && (sf.Name() == "ForceSendFields" || sf.Name() == "NullFields")
+	}, cmp.Ignore())
+}
+
+// A diffReporter records the path of each difference cmp reports.
+type diffReporter struct {
+	path  cmp.Path
+	diffs []string
+}
+
+func (r *diffReporter) PushStep(ps cmp.PathStep) {
+	r.path = append(r.path, ps)
+}
+
+func (r *diffReporter) PopStep() {
+	r.path = r.path[:len(r.path)-1]
+}
+
+func (r *diffReporter) Report(rs cmp.Result) {
+	if rs.Equal() {
+		return
+	}
+	p := fieldPath(r.path)
+	for _, d := range r.diffs {
+		if d == p {
+			return
+		}
+	}
+	r.diffs = append(r.diffs, p)
+}
+
+// fieldPath returns the JSON path of the supplied path, omitting any pointer
+// indirections, type assertions, and transformations.
+func fieldPath(p cmp.Path) string {
+	b := &strings.Builder{}
+	for i, ps := range p {
+		switch s := ps.(type) {
+		case cmp.StructField:
+			b.WriteString(".")
+			b.WriteString(jsonName(p.Index(i-1).Type(), s))
+		case cmp.SliceIndex:
+			k := s.Key()
+			if k < 0 {
+				// The element only exists in one of the slices.
+				kx, ky := s.SplitKeys()
+				if k = kx; k < 0 {
+					k = ky
+				}
+			}
+			fmt.Fprintf(b, "[%d]", k)
+		case cmp.MapIndex:
+			fmt.Fprintf(b, "[%v]", s.Key())
+		}
+	}
+	return strings.TrimPrefix(b.String(), ".")
+}
+
+// jsonName returns the JSON name of the supplied field of the supplied struct,
+// falling back to the name of the field if it has none.
+func jsonName(parent reflect.Type, sf cmp.StructField) string {
+	if parent.Kind() != reflect.Struct {
+		return sf.Name()
+	}
+	tag := strings.Split(parent.Field(sf.Index()).Tag.Get("json"), ",")[0]
+	if tag == "" || tag == "-" {
+		return sf.Name()
+	}
+	return tag
+}
+
+// IgnoreFields sets the fields at the supplied paths of the supplied desired
+// state of a GCP resource to their values in the supplied observed state, so
+// that comparing both states ignores any differences in those fields. Paths
+// use the JSON names of the fields in the GCP API, as reported by Diff, e.g.
+// autoscaling.maxNodeCount. Paths can't index into lists or maps; those can
+// only be ignored as a whole. The desired state must be a pointer to a struct.
+func IgnoreFields(desired, observed interface{}, paths []string) error {
+	if len(paths) == 0 {
+		return nil
+	}
+	d, err := toFields(desired)
+	if err != nil {
+		return errors.Wrap(err, errIgnoreFields)
+	}
+	o, err := toFields(observed)
+	if err != nil {
+		return errors.Wrap(err, errIgnoreFields)
+	}
+	for _, p := range paths {
+		copyField(d, o, strings.Split(p, "."))
+	}
+	b, err := json.Marshal(d)
+	if err != nil {
+		return errors.Wrap(err, errIgnoreFields)
+	}
+	// Reset the desired state so that fields that were removed are zeroed.
+	v := reflect.ValueOf(desired).Elem()
+	v.Set(reflect.Zero(v.Type()))
+	return errors.Wrap(json.Unmarshal(b, desired), errIgnoreFields)
+}
+
+func toFields(v interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	m := map[string]interface{}{}
+	return m, json.Unmarshal(b, &m)
+}
+
+// copyField copies the field at the supplied path from the observed to the
+// desired fields, removing it from the desired fields if it isn't observed.
+func copyField(desired, observed map[string]interface{}, path []string) {
+	k := path[0]
+	if len(path) == 1 {
+		if v, ok := observed[k]; ok {
+			desired[k] = v
+			return
+		}
+		delete(desired, k)
+		return
+	}
+	o, _ := observed[k].(map[string]interface{})
+	d, ok := desired[k].(map[string]interface{})
+	if !ok {
+		if o == nil {
+			return
+		}
+		d = map[string]interface{}{}
+		desired[k] = d
+	}
+	copyField(d, o, path[1:])
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	compute "google.golang.org/api/compute/v1"
+)
+
+func TestDiff(t *testing.T) {
+	cases := map[string]struct {
+		reason   string
+		desired  interface{}
+		observed interface{}
+		opts     []cmp.Option
+		want     []string
+	}{
+		"Equal": {
+			reason:   "No paths should be returned if nothing differs",
+			desired:  &compute.Network{Name: "n", Description: "d"},
+			observed: &compute.Network{Name: "n", Description: "d"},
+			want:     nil,
+		},
+		"NestedField": {
+			reason:   "The JSON path of nested fields that differ should be returned",
+			desired:  &compute.Network{Name: "n", RoutingConfig: &compute.NetworkRoutingConfig{RoutingMode: "GLOBAL"}},
+			observed: &compute.Network{Name: "n", RoutingConfig: &compute.NetworkRoutingConfig{RoutingMode: "REGIONAL"}},
+			want:     []string{"routingConfig.routingMode"},
+		},
+		"SliceElements": {
+			reason: "Slice elements that differ or only exist on one side should be identified by their index",
+			desired: &compute.Subnetwork{SecondaryIpRanges: []*compute.SubnetworkSecondaryRange{
+				{RangeName: "a", IpCidrRange: "10.0.0.0/24"},
+				{RangeName: "b", IpCidrRange: "10.0.1.0/24"},
+			}},
+			observed: &compute.Subnetwork{SecondaryIpRanges: []*compute.SubnetworkSecondaryRange{
+				{RangeName: "a", IpCidrRange: "10.0.2.0/24"},
+			}},
+			want: []string{"secondaryIpRanges[0].ipCidrRange", "secondaryIpRanges[1]"},
+		},
+		"MapEntries": {
+			reason:   "Map entries that differ should be identified by their key",
+			desired:  &compute.Address{Labels: map[string]string{"env": "prod", "team": "a"}},
+			observed: &compute.Address{Labels: map[string]string{"env": "dev", "team": "a"}},
+			want:     []string{"labels[env]"},
+		},
+		"ClientFields": {
+			reason:   "ForceSendFields and NullFields should be ignored",
+			desired:  &compute.Network{Name: "n", ForceSendFields: []string{"Description"}, NullFields: []string{"Mtu"}},
+			observed: &compute.Network{Name: "n"},
+			want:     nil,
+		},
+		"Options": {
+			reason:   "The supplied options should be used to compare the desired and observed state",
+			desired:  &compute.Network{Name: "n", Peerings: []*compute.NetworkPeering{}},
+			observed: &compute.Network{Name: "n"},
+			opts:     []cmp.Option{cmpopts.EquateEmpty()},
+			want:     nil,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Diff(tc.desired, tc.observed, tc.opts...)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nDiff(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestIgnoreFields(t *testing.T) {
+	observed := &compute.Network{Name: "n", Description: "observed", RoutingConfig: &compute.NetworkRoutingConfig{RoutingMode: "GLOBAL"}}
+
+	cases := map[string]struct {
+		reason  string
+		desired *compute.Network
+		paths   []string
+		want    *compute.Network
+	}{
+		"NoPaths": {
+			reason:  "The desired state should not be changed if no fields are ignored",
+			desired: &compute.Network{Name: "n", Description: "desired"},
+			want:    &compute.Network{Name: "n", Description: "desired"},
+		},
+		"Field": {
+			reason:  "Ignored fields should be set to their observed value",
+			desired: &compute.Network{Name: "n", Description: "desired", RoutingConfig: &compute.NetworkRoutingConfig{RoutingMode: "REGIONAL"}},
+			paths:   []string{"routingConfig.routingMode"},
+			want:    &compute.Network{Name: "n", Description: "desired", RoutingConfig: &compute.NetworkRoutingConfig{RoutingMode: "GLOBAL"}},
+		},
+		"MissingParent": {
+			reason:  "Ignored fields whose parent is not desired should be set to their observed value",
+			desired: &compute.Network{Name: "n"},
+			paths:   []string{"description", "routingConfig.routingMode"},
+			want:    &compute.Network{Name: "n", Description: "observed", RoutingConfig: &compute.NetworkRoutingConfig{RoutingMode: "GLOBAL"}},
+		},
+		"NotObserved": {
+			reason:  "Ignored fields that are not observed should be removed",
+			desired: &compute.Network{Name: "n", IPv4Range: "10.0.0.0/16"},
+			paths:   []string{"IPv4Range", "mtu"},
+			want:    &compute.Network{Name: "n"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if err := IgnoreFields(tc.desired, observed, tc.paths); err != nil {
+				t.Fatalf("\n%s\nIgnoreFields(...): %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, tc.desired); diff != "" {
+				t.Errorf("\n%s\nIgnoreFields(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	dns "google.golang.org/api/dns/v1"
+
+	computev1beta1 "github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	"github.com/crossplane/provider-gcp/apis/dns/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// GenerateManagedZone generates *dns.ManagedZone instance from
+// ManagedZoneParameters.
+func GenerateManagedZone(name string, in v1alpha1.ManagedZoneParameters, mz *dns.ManagedZone) {
+	mz.Name = name
+	mz.DnsName = in.DNSName
+	mz.Description = gcp.StringValue(in.Description)
+	mz.Labels = in.Labels
+	mz.Visibility = gcp.StringValue(in.Visibility)
+	mz.DnssecConfig = nil
+	mz.PrivateVisibilityConfig = nil
+	mz.ForwardingConfig = nil
+	mz.PeeringConfig = nil
+
+	if in.DNSSECConfig != nil {
+		mz.DnssecConfig = &dns.ManagedZoneDnsSecConfig{
+			State:        gcp.StringValue(in.DNSSECConfig.State),
+			NonExistence: gcp.StringValue(in.DNSSECConfig.NonExistence),
+		}
+		for _, k := range in.DNSSECConfig.DefaultKeySpecs {
+			mz.DnssecConfig.DefaultKeySpecs = append(mz.DnssecConfig.DefaultKeySpecs, &dns.DnsKeySpec{
+				Algorithm: k.Algorithm,
+				KeyLength: k.KeyLength,
+				KeyType:   k.KeyType,
+			})
+		}
+	}
+	if in.PrivateVisibilityConfig != nil {
+		mz.PrivateVisibilityConfig = &dns.ManagedZonePrivateVisibilityConfig{}
+		for _, n := range in.PrivateVisibilityConfig.Networks {
+			mz.PrivateVisibilityConfig.Networks = append(mz.PrivateVisibilityConfig.Networks, &dns.ManagedZonePrivateVisibilityConfigNetwork{
+				NetworkUrl: networkURL(n.NetworkURL),
+			})
+		}
+	}
+	if in.ForwardingConfig != nil {
+		mz.ForwardingConfig = &dns.ManagedZoneForwardingConfig{}
+		for _, t := range in.ForwardingConfig.TargetNameServers {
+			mz.ForwardingConfig.TargetNameServers = append(mz.ForwardingConfig.TargetNameServers, &dns.ManagedZoneForwardingConfigNameServerTarget{
+				Ipv4Address:    t.IPv4Address,
+				ForwardingPath: gcp.StringValue(t.ForwardingPath),
+			})
+		}
+	}
+	if in.PeeringConfig != nil {
+		mz.PeeringConfig = &dns.ManagedZonePeeringConfig{
+			TargetNetwork: &dns.ManagedZonePeeringConfigTargetNetwork{
+				NetworkUrl: networkURL(in.PeeringConfig.TargetNetwork.NetworkURL),
+			},
+		}
+	}
+}
+
+// networkURL returns the full URL of the supplied network, which Cloud DNS
+// requires in place of the partial URL of a Network.
+func networkURL(n *string) string {
+	u := gcp.StringValue(n)
+	if u == "" || strings.HasPrefix(u, "https://") {
+		return u
+	}
+	return computev1beta1.ComputeURIPrefix + u
+}
+
+// GenerateManagedZoneObservation produces ManagedZoneObservation from
+// *dns.ManagedZone.
+func GenerateManagedZoneObservation(mz dns.ManagedZone) v1alpha1.ManagedZoneObservation {
+	return v1alpha1.ManagedZoneObservation{
+		ID:           mz.Id,
+		NameServers:  mz.NameServers,
+		CreationTime: mz.CreationTime,
+	}
+}
+
+// LateInitializeManagedZone fills the empty fields in *ManagedZoneParameters
+// with the values seen in dns.ManagedZone.
+func LateInitializeManagedZone(in *v1alpha1.ManagedZoneParameters, mz dns.ManagedZone) {
+	in.Description = gcp.LateInitializeString(in.Description, mz.Description)
+	in.Labels = gcp.LateInitializeStringMap(in.Labels, mz.Labels)
+	in.Visibility = gcp.LateInitializeString(in.Visibility, mz.Visibility)
+	if in.DNSSECConfig == nil && mz.DnssecConfig != nil && mz.DnssecConfig.State != "" {
+		in.DNSSECConfig = &v1alpha1.ManagedZoneDNSSECConfig{
+			State:        gcp.LateInitializeString(nil, mz.DnssecConfig.State),
+			NonExistence: gcp.LateInitializeString(nil, mz.DnssecConfig.NonExistence),
+		}
+		for _, k := range mz.DnssecConfig.DefaultKeySpecs {
+			in.DNSSECConfig.DefaultKeySpecs = append(in.DNSSECConfig.DefaultKeySpecs, v1alpha1.DNSKeySpec{
+				Algorithm: k.Algorithm,
+				KeyLength: k.KeyLength,
+				KeyType:   k.KeyType,
+			})
+		}
+	}
+}
+
+// IsManagedZoneUpToDate checks whether current state is up-to-date compared
+// to the given set of parameters.
+func IsManagedZoneUpToDate(name string, in *v1alpha1.ManagedZoneParameters, observed *dns.ManagedZone) bool {
+	desired := &dns.ManagedZone{}
+	GenerateManagedZone(name, *in, desired)
+	// Only compare the fields that are managed by the ManagedZoneParameters.
+	actual := &dns.ManagedZone{
+		Name:                    observed.Name,
+		DnsName:                 observed.DnsName,
+		Description:             observed.Description,
+		Labels:                  observed.Labels,
+		Visibility:              observed.Visibility,
+		DnssecConfig:            observed.DnssecConfig,
+		PrivateVisibilityConfig: observed.PrivateVisibilityConfig,
+		ForwardingConfig:        observed.ForwardingConfig,
+		PeeringConfig:           observed.PeeringConfig,
+	}
+	return cmp.Equal(desired, actual, cmpopts.EquateEmpty(), gcp.EquateComputeURLs(),
+		cmpopts.IgnoreFields(dns.ManagedZoneDnsSecConfig{}, "Kind"),
+		cmpopts.IgnoreFields(dns.DnsKeySpec{}, "Kind"),
+		cmpopts.IgnoreFields(dns.ManagedZonePrivateVisibilityConfig{}, "Kind"),
+		cmpopts.IgnoreFields(dns.ManagedZonePrivateVisibilityConfigNetwork{}, "Kind"),
+		cmpopts.IgnoreFields(dns.ManagedZoneForwardingConfig{}, "Kind"),
+		cmpopts.IgnoreFields(dns.ManagedZoneForwardingConfigNameServerTarget{}, "Kind"),
+		cmpopts.IgnoreFields(dns.ManagedZonePeeringConfig{}, "Kind"),
+		cmpopts.IgnoreFields(dns.ManagedZonePeeringConfigTargetNetwork{}, "Kind", "DeactivateTime"),
+	)
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	dns "google.golang.org/api/dns/v1"
+
+	"github.com/crossplane/provider-gcp/apis/dns/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	zoneName    = "example-zone"
+	network     = "projects/coolProject/global/networks/default"
+	fullNetwork = "https://www.googleapis.com/compute/v1/projects/coolProject/global/networks/default"
+)
+
+func zoneParams(m ...func(*v1alpha1.ManagedZoneParameters)) *v1alpha1.ManagedZoneParameters {
+	p := &v1alpha1.ManagedZoneParameters{
+		DNSName:     "example.com.",
+		Description: gcp.StringPtr("Managed by Crossplane"),
+		Labels:      map[string]string{"team": "dns"},
+		Visibility:  gcp.StringPtr(v1alpha1.ManagedZoneVisibilityPrivate),
+		PrivateVisibilityConfig: &v1alpha1.ManagedZonePrivateVisibilityConfig{
+			Networks: []v1alpha1.VPCNetwork{{NetworkURL: gcp.StringPtr(network)}},
+		},
+		ForwardingConfig: &v1alpha1.ManagedZoneForwardingConfig{
+			TargetNameServers: []v1alpha1.ForwardingTarget{{IPv4Address: "10.0.0.2", ForwardingPath: gcp.StringPtr("private")}},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func zone(m ...func(*dns.ManagedZone)) *dns.ManagedZone {
+	z := &dns.ManagedZone{
+		Name:        zoneName,
+		DnsName:     "example.com.",
+		Description: "Managed by Crossplane",
+		Labels:      map[string]string{"team": "dns"},
+		Visibility:  v1alpha1.ManagedZoneVisibilityPrivate,
+		PrivateVisibilityConfig: &dns.ManagedZonePrivateVisibilityConfig{
+			Networks: []*dns.ManagedZonePrivateVisibilityConfigNetwork{{NetworkUrl: fullNetwork}},
+		},
+		ForwardingConfig: &dns.ManagedZoneForwardingConfig{
+			TargetNameServers: []*dns.ManagedZoneForwardingConfigNameServerTarget{{Ipv4Address: "10.0.0.2", ForwardingPath: "private"}},
+		},
+	}
+	for _, f := range m {
+		f(z)
+	}
+	return z
+}
+
+func TestGenerateManagedZone(t *testing.T) {
+	cases := map[string]struct {
+		params v1alpha1.ManagedZoneParameters
+		want   *dns.ManagedZone
+	}{
+		"PrivateZone": {
+			params: *zoneParams(),
+			want:   zone(),
+		},
+		"PeeringZone": {
+			params: *zoneParams(func(p *v1alpha1.ManagedZoneParameters) {
+				p.ForwardingConfig = nil
+				p.PeeringConfig = &v1alpha1.ManagedZonePeeringConfig{
+					TargetNetwork: v1alpha1.VPCNetwork{NetworkURL: gcp.StringPtr(fullNetwork)},
+				}
+			}),
+			want: zone(func(z *dns.ManagedZone) {
+				z.ForwardingConfig = nil
+				z.PeeringConfig = &dns.ManagedZonePeeringConfig{
+					TargetNetwork: &dns.ManagedZonePeeringConfigTargetNetwork{NetworkUrl: fullNetwork},
+				}
+			}),
+		},
+		"PublicZoneWithDNSSEC": {
+			params: v1alpha1.ManagedZoneParameters{
+				DNSName:    "example.com.",
+				Visibility: gcp.StringPtr(v1alpha1.ManagedZoneVisibilityPublic),
+				DNSSECConfig: &v1alpha1.ManagedZoneDNSSECConfig{
+					State:           gcp.StringPtr("on"),
+					DefaultKeySpecs: []v1alpha1.DNSKeySpec{{Algorithm: "rsasha256", KeyLength: 2048, KeyType: "keySigning"}},
+				},
+			},
+			want: &dns.ManagedZone{
+				Name:       zoneName,
+				DnsName:    "example.com.",
+				Visibility: v1alpha1.ManagedZoneVisibilityPublic,
+				DnssecConfig: &dns.ManagedZoneDnsSecConfig{
+					State:           "on",
+					DefaultKeySpecs: []*dns.DnsKeySpec{{Algorithm: "rsasha256", KeyLength: 2048, KeyType: "keySigning"}},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := &dns.ManagedZone{}
+			GenerateManagedZone(zoneName, tc.params, got)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateManagedZone(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateManagedZoneObservation(t *testing.T) {
+	z := *zone(func(z *dns.ManagedZone) {
+		z.Id = 1234
+		z.NameServers = []string{"ns-1.googledomains.com."}
+		z.CreationTime = "2021-01-01T00:00:00Z"
+	})
+	want := v1alpha1.ManagedZoneObservation{
+		ID:           1234,
+		NameServers:  []string{"ns-1.googledomains.com."},
+		CreationTime: "2021-01-01T00:00:00Z",
+	}
+	if diff := cmp.Diff(want, GenerateManagedZoneObservation(z)); diff != "" {
+		t.Errorf("GenerateManagedZoneObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeManagedZone(t *testing.T) {
+	cases := map[string]struct {
+		params *v1alpha1.ManagedZoneParameters
+		zone   dns.ManagedZone
+		want   *v1alpha1.ManagedZoneParameters
+	}{
+		"AllFilledAlready": {
+			params: zoneParams(),
+			zone:   *zone(),
+			want:   zoneParams(),
+		},
+		"SomeFields": {
+			params: zoneParams(func(p *v1alpha1.ManagedZoneParameters) {
+				p.Description = nil
+				p.Labels = nil
+			}),
+			zone: *zone(func(z *dns.ManagedZone) {
+				z.DnssecConfig = &dns.ManagedZoneDnsSecConfig{State: "off"}
+			}),
+			want: zoneParams(func(p *v1alpha1.ManagedZoneParameters) {
+				p.DNSSECConfig = &v1alpha1.ManagedZoneDNSSECConfig{State: gcp.StringPtr("off")}
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeManagedZone(tc.params, tc.zone)
+			if diff := cmp.Diff(tc.want, tc.params); diff != "" {
+				t.Errorf("LateInitializeManagedZone(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsManagedZoneUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		params *v1alpha1.ManagedZoneParameters
+		zone   *dns.ManagedZone
+		want   bool
+	}{
+		"UpToDate": {
+			params: zoneParams(),
+			zone: zone(func(z *dns.ManagedZone) {
+				z.Id = 1234
+				z.Kind = "dns#managedZone"
+				z.PrivateVisibilityConfig.Kind = "dns#managedZonePrivateVisibilityConfig"
+			}),
+			want: true,
+		},
+		"NeedsUpdate": {
+			params: zoneParams(),
+			zone: zone(func(z *dns.ManagedZone) {
+				z.Description = "Something else"
+			}),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsManagedZoneUpToDate(zoneName, tc.params, tc.zone)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsManagedZoneUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	dns "google.golang.org/api/dns/v1"
+
+	"github.com/crossplane/provider-gcp/apis/dns/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// GeneratePolicy generates *dns.Policy instance from PolicyParameters.
+func GeneratePolicy(name string, in v1alpha1.PolicyParameters, p *dns.Policy) {
+	p.Name = name
+	p.Description = gcp.StringValue(in.Description)
+	p.EnableInboundForwarding = gcp.BoolValue(in.EnableInboundForwarding)
+	p.EnableLogging = gcp.BoolValue(in.EnableLogging)
+	p.AlternativeNameServerConfig = nil
+	p.Networks = nil
+
+	if in.AlternativeNameServerConfig != nil {
+		p.AlternativeNameServerConfig = &dns.PolicyAlternativeNameServerConfig{}
+		for _, t := range in.AlternativeNameServerConfig.TargetNameServers {
+			p.AlternativeNameServerConfig.TargetNameServers = append(p.AlternativeNameServerConfig.TargetNameServers, &dns.PolicyAlternativeNameServerConfigTargetNameServer{
+				Ipv4Address:    t.IPv4Address,
+				ForwardingPath: gcp.StringValue(t.ForwardingPath),
+			})
+		}
+	}
+	for _, n := range in.Networks {
+		p.Networks = append(p.Networks, &dns.PolicyNetwork{NetworkUrl: networkURL(n.NetworkURL)})
+	}
+	// Cloud DNS omits false booleans in its responses, so they have to be
+	// sent explicitly in order to turn the features off.
+	p.ForceSendFields = []string{"EnableInboundForwarding", "EnableLogging"}
+}
+
+// GeneratePolicyObservation produces PolicyObservation from *dns.Policy.
+func GeneratePolicyObservation(p dns.Policy) v1alpha1.PolicyObservation {
+	return v1alpha1.PolicyObservation{
+		ID: p.Id,
+	}
+}
+
+// LateInitializePolicy fills the empty fields in *PolicyParameters with the
+// values seen in dns.Policy.
+func LateInitializePolicy(in *v1alpha1.PolicyParameters, p dns.Policy) {
+	in.Description = gcp.LateInitializeString(in.Description, p.Description)
+	in.EnableInboundForwarding = gcp.LateInitializeBool(in.EnableInboundForwarding, p.EnableInboundForwarding)
+	in.EnableLogging = gcp.LateInitializeBool(in.EnableLogging, p.EnableLogging)
+}
+
+// IsPolicyUpToDate checks whether current state is up-to-date compared to the
+// given set of parameters.
+func IsPolicyUpToDate(name string, in *v1alpha1.PolicyParameters, observed *dns.Policy) bool {
+	desired := &dns.Policy{}
+	GeneratePolicy(name, *in, desired)
+	actual := &dns.Policy{
+		Name:                        observed.Name,
+		Description:                 observed.Description,
+		EnableInboundForwarding:     observed.EnableInboundForwarding,
+		EnableLogging:               observed.EnableLogging,
+		AlternativeNameServerConfig: observed.AlternativeNameServerConfig,
+		Networks:                    observed.Networks,
+	}
+	return cmp.Equal(desired, actual, cmpopts.EquateEmpty(), gcp.EquateComputeURLs(),
+		cmpopts.IgnoreFields(dns.Policy{}, "ForceSendFields"),
+		cmpopts.IgnoreFields(dns.PolicyAlternativeNameServerConfig{}, "Kind"),
+		cmpopts.IgnoreFields(dns.PolicyAlternativeNameServerConfigTargetNameServer{}, "Kind"),
+		cmpopts.IgnoreFields(dns.PolicyNetwork{}, "Kind"),
+	)
+}
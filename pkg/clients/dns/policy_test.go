@@ -0,0 +1,145 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	dns "google.golang.org/api/dns/v1"
+
+	"github.com/crossplane/provider-gcp/apis/dns/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const policyName = "example-policy"
+
+func policyParams(m ...func(*v1alpha1.PolicyParameters)) *v1alpha1.PolicyParameters {
+	p := &v1alpha1.PolicyParameters{
+		Description:             gcp.StringPtr("Managed by Crossplane"),
+		EnableInboundForwarding: gcp.BoolPtr(true),
+		AlternativeNameServerConfig: &v1alpha1.PolicyAlternativeNameServerConfig{
+			TargetNameServers: []v1alpha1.ForwardingTarget{{IPv4Address: "10.0.0.2"}},
+		},
+		Networks: []v1alpha1.VPCNetwork{{NetworkURL: gcp.StringPtr(network)}},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func policy(m ...func(*dns.Policy)) *dns.Policy {
+	p := &dns.Policy{
+		Name:                    policyName,
+		Description:             "Managed by Crossplane",
+		EnableInboundForwarding: true,
+		AlternativeNameServerConfig: &dns.PolicyAlternativeNameServerConfig{
+			TargetNameServers: []*dns.PolicyAlternativeNameServerConfigTargetNameServer{{Ipv4Address: "10.0.0.2"}},
+		},
+		Networks:        []*dns.PolicyNetwork{{NetworkUrl: fullNetwork}},
+		ForceSendFields: []string{"EnableInboundForwarding", "EnableLogging"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func TestGeneratePolicy(t *testing.T) {
+	got := &dns.Policy{}
+	GeneratePolicy(policyName, *policyParams(), got)
+	if diff := cmp.Diff(policy(), got); diff != "" {
+		t.Errorf("GeneratePolicy(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGeneratePolicyObservation(t *testing.T) {
+	want := v1alpha1.PolicyObservation{ID: 1234}
+	got := GeneratePolicyObservation(*policy(func(p *dns.Policy) { p.Id = 1234 }))
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GeneratePolicyObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializePolicy(t *testing.T) {
+	cases := map[string]struct {
+		params *v1alpha1.PolicyParameters
+		policy dns.Policy
+		want   *v1alpha1.PolicyParameters
+	}{
+		"AllFilledAlready": {
+			params: policyParams(),
+			policy: *policy(),
+			want:   policyParams(),
+		},
+		"SomeFields": {
+			params: policyParams(func(p *v1alpha1.PolicyParameters) {
+				p.Description = nil
+				p.EnableInboundForwarding = nil
+			}),
+			policy: *policy(func(p *dns.Policy) {
+				p.EnableLogging = true
+			}),
+			want: policyParams(func(p *v1alpha1.PolicyParameters) {
+				p.EnableLogging = gcp.BoolPtr(true)
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializePolicy(tc.params, tc.policy)
+			if diff := cmp.Diff(tc.want, tc.params); diff != "" {
+				t.Errorf("LateInitializePolicy(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsPolicyUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		params *v1alpha1.PolicyParameters
+		policy *dns.Policy
+		want   bool
+	}{
+		"UpToDate": {
+			params: policyParams(),
+			policy: policy(func(p *dns.Policy) {
+				p.Id = 1234
+				p.Kind = "dns#policy"
+				p.ForceSendFields = nil
+				p.Networks[0].Kind = "dns#policyNetwork"
+			}),
+			want: true,
+		},
+		"NeedsUpdate": {
+			params: policyParams(),
+			policy: policy(func(p *dns.Policy) {
+				p.EnableInboundForwarding = false
+			}),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsPolicyUpToDate(policyName, tc.params, tc.policy)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsPolicyUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-gcp/apis/dns/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 )
 
 const (
@@ -54,6 +55,48 @@ func GenerateResourceRecordSet(name string, spec v1alpha1.ResourceRecordSetParam
 	if spec.SignatureRRDatas != nil {
 		rrs.SignatureRrdatas = spec.SignatureRRDatas
 	}
+	rrs.RoutingPolicy = generateRoutingPolicy(spec.RoutingPolicy)
+}
+
+func generateRoutingPolicy(in *v1alpha1.RoutingPolicy) *dns.RRSetRoutingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := &dns.RRSetRoutingPolicy{}
+	if in.Geo != nil {
+		out.Geo = &dns.RRSetRoutingPolicyGeoPolicy{
+			EnableFencing: gcp.BoolValue(in.Geo.EnableFencing),
+		}
+		for _, i := range in.Geo.Items {
+			out.Geo.Items = append(out.Geo.Items, &dns.RRSetRoutingPolicyGeoPolicyGeoPolicyItem{
+				Location: i.Location,
+				Rrdatas:  i.RRDatas,
+			})
+		}
+	}
+	if in.WRR != nil {
+		out.Wrr = &dns.RRSetRoutingPolicyWrrPolicy{}
+		for _, i := range in.WRR.Items {
+			out.Wrr.Items = append(out.Wrr.Items, &dns.RRSetRoutingPolicyWrrPolicyWrrPolicyItem{
+				Weight:  float64(i.Weight),
+				Rrdatas: i.RRDatas,
+			})
+		}
+	}
+	return out
+}
+
+// GenerateChange generates a *dns.Change that atomically replaces the
+// observed ResourceRecordSet with the desired one. Either of them may be nil.
+func GenerateChange(observed, desired *dns.ResourceRecordSet) *dns.Change {
+	c := &dns.Change{}
+	if observed != nil {
+		c.Deletions = []*dns.ResourceRecordSet{observed}
+	}
+	if desired != nil {
+		c.Additions = []*dns.ResourceRecordSet{desired}
+	}
+	return c
 }
 
 // IsUpToDate checks whether current state is up-to-date compared to the given
@@ -68,7 +111,16 @@ func IsUpToDate(name string, spec *v1alpha1.ResourceRecordSetParameters, observe
 		return true, errors.New(errCheckUpToDate)
 	}
 	GenerateResourceRecordSet(name, *spec, desired)
-	return cmp.Equal(desired, observed, cmpopts.EquateEmpty()), nil
+	// The record set was observed by its name, which the API returns fully
+	// qualified, i.e. with a trailing dot that the name may omit.
+	desired.Name = observed.Name
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(dns.RRSetRoutingPolicy{}, "Kind"),
+		cmpopts.IgnoreFields(dns.RRSetRoutingPolicyGeoPolicy{}, "Kind"),
+		cmpopts.IgnoreFields(dns.RRSetRoutingPolicyGeoPolicyGeoPolicyItem{}, "Kind"),
+		cmpopts.IgnoreFields(dns.RRSetRoutingPolicyWrrPolicy{}, "Kind"),
+		cmpopts.IgnoreFields(dns.RRSetRoutingPolicyWrrPolicyWrrPolicyItem{}, "Kind"),
+	), nil
 }
 
 // CustomNameAsExternalName writes the name of the managed resource to
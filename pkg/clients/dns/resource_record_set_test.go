@@ -29,6 +29,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-gcp/apis/dns/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 )
 
 const (
@@ -127,6 +128,54 @@ func TestGenerateResourceRecordSet(t *testing.T) {
 				}),
 			},
 		},
+		"GeoRoutingPolicy": {
+			args: args{
+				name: name,
+				params: *params(func(p *v1alpha1.ResourceRecordSetParameters) {
+					p.RRDatas = nil
+					p.RoutingPolicy = &v1alpha1.RoutingPolicy{
+						Geo: &v1alpha1.GeoPolicy{
+							Items:         []v1alpha1.GeoPolicyItem{{Location: "us-east1", RRDatas: []string{"1.2.3.4"}}},
+							EnableFencing: gcp.BoolPtr(true),
+						},
+					}
+				}),
+			},
+			want: want{
+				resourceRecordSet: resourceRecordSet(func(rrs *dns.ResourceRecordSet) {
+					rrs.Rrdatas = nil
+					rrs.RoutingPolicy = &dns.RRSetRoutingPolicy{
+						Geo: &dns.RRSetRoutingPolicyGeoPolicy{
+							Items:         []*dns.RRSetRoutingPolicyGeoPolicyGeoPolicyItem{{Location: "us-east1", Rrdatas: []string{"1.2.3.4"}}},
+							EnableFencing: true,
+						},
+					}
+				}),
+			},
+		},
+		"WRRRoutingPolicy": {
+			args: args{
+				name: name,
+				params: *params(func(p *v1alpha1.ResourceRecordSetParameters) {
+					p.RRDatas = nil
+					p.RoutingPolicy = &v1alpha1.RoutingPolicy{
+						WRR: &v1alpha1.WRRPolicy{
+							Items: []v1alpha1.WRRPolicyItem{{Weight: 3, RRDatas: []string{"1.2.3.4"}}},
+						},
+					}
+				}),
+			},
+			want: want{
+				resourceRecordSet: resourceRecordSet(func(rrs *dns.ResourceRecordSet) {
+					rrs.Rrdatas = nil
+					rrs.RoutingPolicy = &dns.RRSetRoutingPolicy{
+						Wrr: &dns.RRSetRoutingPolicyWrrPolicy{
+							Items: []*dns.RRSetRoutingPolicyWrrPolicyWrrPolicyItem{{Weight: 3, Rrdatas: []string{"1.2.3.4"}}},
+						},
+					}
+				}),
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -207,6 +256,17 @@ func TestIsUpToDate(t *testing.T) {
 				upToDate: true,
 			},
 		},
+		"FullyQualifiedIsUpToDate": {
+			args: args{
+				params: params(),
+				rrs: resourceRecordSet(func(rrs *dns.ResourceRecordSet) {
+					rrs.Name = "test.rrs."
+				}),
+			},
+			want: want{
+				upToDate: true,
+			},
+		},
 		"NeedsUpdate": {
 			args: args{
 				params: params(),
@@ -218,6 +278,29 @@ func TestIsUpToDate(t *testing.T) {
 				upToDate: false,
 			},
 		},
+		"RoutingPolicyIsUpToDate": {
+			args: args{
+				params: params(func(p *v1alpha1.ResourceRecordSetParameters) {
+					p.RoutingPolicy = &v1alpha1.RoutingPolicy{
+						WRR: &v1alpha1.WRRPolicy{
+							Items: []v1alpha1.WRRPolicyItem{{Weight: 3, RRDatas: []string{"1.2.3.4"}}},
+						},
+					}
+				}),
+				rrs: resourceRecordSet(func(rrs *dns.ResourceRecordSet) {
+					rrs.RoutingPolicy = &dns.RRSetRoutingPolicy{
+						Kind: "dns#rRSetRoutingPolicy",
+						Wrr: &dns.RRSetRoutingPolicyWrrPolicy{
+							Kind:  "dns#rRSetRoutingPolicyWrrPolicy",
+							Items: []*dns.RRSetRoutingPolicyWrrPolicyWrrPolicyItem{{Weight: 3, Rrdatas: []string{"1.2.3.4"}}},
+						},
+					}
+				}),
+			},
+			want: want{
+				upToDate: true,
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -229,6 +312,42 @@ func TestIsUpToDate(t *testing.T) {
 	}
 }
 
+func TestGenerateChange(t *testing.T) {
+	observed := resourceRecordSet()
+	desired := resourceRecordSet(func(rrs *dns.ResourceRecordSet) {
+		rrs.Rrdatas = []string{"5.6.7.8"}
+	})
+	cases := map[string]struct {
+		observed *dns.ResourceRecordSet
+		desired  *dns.ResourceRecordSet
+		want     *dns.Change
+	}{
+		"Create": {
+			desired: desired,
+			want:    &dns.Change{Additions: []*dns.ResourceRecordSet{desired}},
+		},
+		"Replace": {
+			observed: observed,
+			desired:  desired,
+			want: &dns.Change{
+				Deletions: []*dns.ResourceRecordSet{observed},
+				Additions: []*dns.ResourceRecordSet{desired},
+			},
+		},
+		"Delete": {
+			observed: observed,
+			want:     &dns.Change{Deletions: []*dns.ResourceRecordSet{observed}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateChange(tc.observed, tc.desired)); diff != "" {
+				t.Errorf("GenerateChange(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestInitialize(t *testing.T) {
 	type args struct {
 		mg     resource.Managed
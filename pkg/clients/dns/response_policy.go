@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	dns "google.golang.org/api/dns/v1"
+
+	"github.com/crossplane/provider-gcp/apis/dns/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// GenerateResponsePolicy generates *dns.ResponsePolicy instance from
+// ResponsePolicyParameters.
+func GenerateResponsePolicy(name string, in v1alpha1.ResponsePolicyParameters, rp *dns.ResponsePolicy) {
+	rp.ResponsePolicyName = name
+	rp.Description = gcp.StringValue(in.Description)
+	rp.Labels = in.Labels
+	rp.Networks = nil
+	rp.GkeClusters = nil
+
+	for _, n := range in.Networks {
+		rp.Networks = append(rp.Networks, &dns.ResponsePolicyNetwork{NetworkUrl: networkURL(n.NetworkURL)})
+	}
+	for _, c := range in.GKEClusters {
+		rp.GkeClusters = append(rp.GkeClusters, &dns.ResponsePolicyGKECluster{GkeClusterName: c})
+	}
+}
+
+// GenerateResponsePolicyObservation produces ResponsePolicyObservation from
+// *dns.ResponsePolicy.
+func GenerateResponsePolicyObservation(rp dns.ResponsePolicy) v1alpha1.ResponsePolicyObservation {
+	return v1alpha1.ResponsePolicyObservation{
+		ID: rp.Id,
+	}
+}
+
+// LateInitializeResponsePolicy fills the empty fields in
+// *ResponsePolicyParameters with the values seen in dns.ResponsePolicy.
+func LateInitializeResponsePolicy(in *v1alpha1.ResponsePolicyParameters, rp dns.ResponsePolicy) {
+	in.Description = gcp.LateInitializeString(in.Description, rp.Description)
+	in.Labels = gcp.LateInitializeStringMap(in.Labels, rp.Labels)
+}
+
+// IsResponsePolicyUpToDate checks whether current state is up-to-date
+// compared to the given set of parameters.
+func IsResponsePolicyUpToDate(name string, in *v1alpha1.ResponsePolicyParameters, observed *dns.ResponsePolicy) bool {
+	desired := &dns.ResponsePolicy{}
+	GenerateResponsePolicy(name, *in, desired)
+	actual := &dns.ResponsePolicy{
+		ResponsePolicyName: observed.ResponsePolicyName,
+		Description:        observed.Description,
+		Labels:             observed.Labels,
+		Networks:           observed.Networks,
+		GkeClusters:        observed.GkeClusters,
+	}
+	return cmp.Equal(desired, actual, cmpopts.EquateEmpty(), gcp.EquateComputeURLs(),
+		cmpopts.IgnoreFields(dns.ResponsePolicyNetwork{}, "Kind"),
+		cmpopts.IgnoreFields(dns.ResponsePolicyGKECluster{}, "Kind"),
+	)
+}
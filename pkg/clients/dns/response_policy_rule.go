@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	dns "google.golang.org/api/dns/v1"
+
+	"github.com/crossplane/provider-gcp/apis/dns/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// behaviorUnspecified is reported by Cloud DNS for rules that answer with
+// local data.
+const behaviorUnspecified = "behaviorUnspecified"
+
+// GenerateResponsePolicyRule generates *dns.ResponsePolicyRule instance from
+// ResponsePolicyRuleParameters.
+func GenerateResponsePolicyRule(name string, in v1alpha1.ResponsePolicyRuleParameters, r *dns.ResponsePolicyRule) {
+	r.RuleName = name
+	r.DnsName = in.DNSName
+	r.Behavior = gcp.StringValue(in.Behavior)
+	r.LocalData = nil
+
+	if len(in.LocalData) > 0 {
+		r.LocalData = &dns.ResponsePolicyRuleLocalData{}
+		for _, d := range in.LocalData {
+			r.LocalData.LocalDatas = append(r.LocalData.LocalDatas, &dns.ResourceRecordSet{
+				Name:    d.Name,
+				Type:    d.Type,
+				Ttl:     d.TTL,
+				Rrdatas: d.RRDatas,
+			})
+		}
+	}
+}
+
+// IsResponsePolicyRuleUpToDate checks whether current state is up-to-date
+// compared to the given set of parameters.
+func IsResponsePolicyRuleUpToDate(name string, in *v1alpha1.ResponsePolicyRuleParameters, observed *dns.ResponsePolicyRule) bool {
+	desired := &dns.ResponsePolicyRule{}
+	GenerateResponsePolicyRule(name, *in, desired)
+	actual := &dns.ResponsePolicyRule{
+		RuleName:  observed.RuleName,
+		DnsName:   observed.DnsName,
+		Behavior:  observed.Behavior,
+		LocalData: observed.LocalData,
+	}
+	if actual.Behavior == behaviorUnspecified {
+		actual.Behavior = ""
+	}
+	return cmp.Equal(desired, actual, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(dns.ResourceRecordSet{}, "Kind"),
+	)
+}
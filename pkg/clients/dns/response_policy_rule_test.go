@@ -0,0 +1,129 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	dns "google.golang.org/api/dns/v1"
+
+	"github.com/crossplane/provider-gcp/apis/dns/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const ruleName = "example-rule"
+
+func ruleParams(m ...func(*v1alpha1.ResponsePolicyRuleParameters)) *v1alpha1.ResponsePolicyRuleParameters {
+	p := &v1alpha1.ResponsePolicyRuleParameters{
+		ResponsePolicy: responsePolicyName,
+		DNSName:        "www.example.com.",
+		LocalData: []v1alpha1.LocalRecordSet{{
+			Name:    "www.example.com.",
+			Type:    "A",
+			TTL:     300,
+			RRDatas: []string{"10.0.0.1"},
+		}},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func rule(m ...func(*dns.ResponsePolicyRule)) *dns.ResponsePolicyRule {
+	r := &dns.ResponsePolicyRule{
+		RuleName: ruleName,
+		DnsName:  "www.example.com.",
+		LocalData: &dns.ResponsePolicyRuleLocalData{
+			LocalDatas: []*dns.ResourceRecordSet{{
+				Name:    "www.example.com.",
+				Type:    "A",
+				Ttl:     300,
+				Rrdatas: []string{"10.0.0.1"},
+			}},
+		},
+	}
+	for _, f := range m {
+		f(r)
+	}
+	return r
+}
+
+func TestGenerateResponsePolicyRule(t *testing.T) {
+	cases := map[string]struct {
+		params v1alpha1.ResponsePolicyRuleParameters
+		want   *dns.ResponsePolicyRule
+	}{
+		"LocalData": {
+			params: *ruleParams(),
+			want:   rule(),
+		},
+		"Bypass": {
+			params: *ruleParams(func(p *v1alpha1.ResponsePolicyRuleParameters) {
+				p.LocalData = nil
+				p.Behavior = gcp.StringPtr(v1alpha1.ResponsePolicyRuleBehaviorBypass)
+			}),
+			want: rule(func(r *dns.ResponsePolicyRule) {
+				r.LocalData = nil
+				r.Behavior = v1alpha1.ResponsePolicyRuleBehaviorBypass
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := &dns.ResponsePolicyRule{}
+			GenerateResponsePolicyRule(ruleName, tc.params, got)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateResponsePolicyRule(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsResponsePolicyRuleUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		params *v1alpha1.ResponsePolicyRuleParameters
+		rule   *dns.ResponsePolicyRule
+		want   bool
+	}{
+		"UpToDate": {
+			params: ruleParams(),
+			rule: rule(func(r *dns.ResponsePolicyRule) {
+				r.Kind = "dns#responsePolicyRule"
+				r.Behavior = behaviorUnspecified
+				r.LocalData.LocalDatas[0].Kind = "dns#resourceRecordSet"
+			}),
+			want: true,
+		},
+		"NeedsUpdate": {
+			params: ruleParams(),
+			rule: rule(func(r *dns.ResponsePolicyRule) {
+				r.LocalData.LocalDatas[0].Rrdatas = []string{"10.0.0.2"}
+			}),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsResponsePolicyRuleUpToDate(ruleName, tc.params, tc.rule)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsResponsePolicyRuleUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	dns "google.golang.org/api/dns/v1"
+
+	"github.com/crossplane/provider-gcp/apis/dns/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const responsePolicyName = "example-response-policy"
+
+func responsePolicyParams(m ...func(*v1alpha1.ResponsePolicyParameters)) *v1alpha1.ResponsePolicyParameters {
+	p := &v1alpha1.ResponsePolicyParameters{
+		Description: gcp.StringPtr("Managed by Crossplane"),
+		Labels:      map[string]string{"team": "dns"},
+		Networks:    []v1alpha1.VPCNetwork{{NetworkURL: gcp.StringPtr(network)}},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func responsePolicy(m ...func(*dns.ResponsePolicy)) *dns.ResponsePolicy {
+	rp := &dns.ResponsePolicy{
+		ResponsePolicyName: responsePolicyName,
+		Description:        "Managed by Crossplane",
+		Labels:             map[string]string{"team": "dns"},
+		Networks:           []*dns.ResponsePolicyNetwork{{NetworkUrl: fullNetwork}},
+	}
+	for _, f := range m {
+		f(rp)
+	}
+	return rp
+}
+
+func TestGenerateResponsePolicy(t *testing.T) {
+	cases := map[string]struct {
+		params v1alpha1.ResponsePolicyParameters
+		want   *dns.ResponsePolicy
+	}{
+		"Networks": {
+			params: *responsePolicyParams(),
+			want:   responsePolicy(),
+		},
+		"GKEClusters": {
+			params: *responsePolicyParams(func(p *v1alpha1.ResponsePolicyParameters) {
+				p.Networks = nil
+				p.GKEClusters = []string{"projects/coolProject/locations/us-east1/clusters/example"}
+			}),
+			want: responsePolicy(func(rp *dns.ResponsePolicy) {
+				rp.Networks = nil
+				rp.GkeClusters = []*dns.ResponsePolicyGKECluster{{GkeClusterName: "projects/coolProject/locations/us-east1/clusters/example"}}
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := &dns.ResponsePolicy{}
+			GenerateResponsePolicy(responsePolicyName, tc.params, got)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateResponsePolicy(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateResponsePolicyObservation(t *testing.T) {
+	want := v1alpha1.ResponsePolicyObservation{ID: 1234}
+	got := GenerateResponsePolicyObservation(*responsePolicy(func(rp *dns.ResponsePolicy) { rp.Id = 1234 }))
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateResponsePolicyObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeResponsePolicy(t *testing.T) {
+	params := responsePolicyParams(func(p *v1alpha1.ResponsePolicyParameters) {
+		p.Description = nil
+		p.Labels = nil
+	})
+	LateInitializeResponsePolicy(params, *responsePolicy())
+	if diff := cmp.Diff(responsePolicyParams(), params); diff != "" {
+		t.Errorf("LateInitializeResponsePolicy(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsResponsePolicyUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		params *v1alpha1.ResponsePolicyParameters
+		rp     *dns.ResponsePolicy
+		want   bool
+	}{
+		"UpToDate": {
+			params: responsePolicyParams(),
+			rp: responsePolicy(func(rp *dns.ResponsePolicy) {
+				rp.Id = 1234
+				rp.Kind = "dns#responsePolicy"
+				rp.Networks[0].Kind = "dns#responsePolicyNetwork"
+			}),
+			want: true,
+		},
+		"NeedsUpdate": {
+			params: responsePolicyParams(),
+			rp: responsePolicy(func(rp *dns.ResponsePolicy) {
+				rp.Networks = nil
+			}),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsResponsePolicyUpToDate(responsePolicyName, tc.params, tc.rp)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsResponsePolicyUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
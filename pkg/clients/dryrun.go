@@ -0,0 +1,203 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// AnnotationKeyDryRun enables the dry run of a managed resource when set to
+// "true", and disables it when set to "false", regardless of --dry-run.
+const AnnotationKeyDryRun = "gcp.crossplane.io/dry-run"
+
+// TypeDryRun is the type of the condition that reports what the controller
+// would do with the external resource of a managed resource that is dry run.
+const TypeDryRun xpv1.ConditionType = "DryRun"
+
+// Reasons of the DryRun condition.
+const (
+	ReasonWouldCreate    xpv1.ConditionReason = "WouldCreate"
+	ReasonWouldUpdate    xpv1.ConditionReason = "WouldUpdate"
+	ReasonWouldDelete    xpv1.ConditionReason = "WouldDelete"
+	ReasonNoChanges      xpv1.ConditionReason = "NoChanges"
+	ReasonDryRunDisabled xpv1.ConditionReason = "Disabled"
+)
+
+// dryRun is the default of managed resources that aren't annotated with
+// AnnotationKeyDryRun.
+var dryRun bool
+
+// EnableDryRun makes the controllers dry run all managed resources that don't
+// disable it with AnnotationKeyDryRun.
+func EnableDryRun() {
+	dryRun = true
+}
+
+// IsDryRun returns true if the external resource of the supplied object must
+// not be created, updated or deleted.
+func IsDryRun(o metav1.Object) bool {
+	if v, ok := o.GetAnnotations()[AnnotationKeyDryRun]; ok {
+		return v == "true"
+	}
+	return dryRun
+}
+
+// dryRunPlans are set by external clients while they observe a managed
+// resource that is dry run, and recorded as events by the reconciler once
+// it's done reconciling it.
+var dryRunPlans = &plans{entries: map[types.UID]xpv1.Condition{}}
+
+type plans struct {
+	mu      sync.Mutex
+	entries map[types.UID]xpv1.Condition
+}
+
+func (p *plans) set(uid types.UID, c xpv1.Condition) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.entries[uid] = c
+}
+
+func (p *plans) pop(uid types.UID) (xpv1.Condition, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	c, ok := p.entries[uid]
+	delete(p.entries, uid)
+	return c, ok
+}
+
+func dryRunCondition(s corev1.ConditionStatus, r xpv1.ConditionReason, msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDryRun,
+		Status:             s,
+		LastTransitionTime: metav1.Now(),
+		Reason:             r,
+		Message:            msg,
+	}
+}
+
+// plan reports what the controller would do with the external resource of the
+// supplied managed resource in its DryRun condition, and returns an
+// observation that keeps the managed reconciler from doing it. External
+// resources that would be created are reported as existing, and those that
+// would be updated as up to date. Those that would be deleted are reported as
+// is, and not deleted by the external client.
+func plan(mg resource.Managed, o managed.ExternalObservation) managed.ExternalObservation {
+	var c xpv1.Condition
+	switch {
+	case meta.WasDeleted(mg) && o.ResourceExists:
+		c = dryRunCondition(corev1.ConditionTrue, ReasonWouldDelete, "would delete the external resource")
+	case meta.WasDeleted(mg):
+		return o
+	case !o.ResourceExists:
+		c = dryRunCondition(corev1.ConditionTrue, ReasonWouldCreate, "would create the external resource")
+		o.ResourceExists, o.ResourceUpToDate = true, true
+	case !o.ResourceUpToDate:
+		msg := "would update the external resource"
+		if d := diffOf(mg, o); d != "" {
+			msg += ": " + d
+		}
+		c = dryRunCondition(corev1.ConditionTrue, ReasonWouldUpdate, msg)
+		o.ResourceUpToDate = true
+	default:
+		c = dryRunCondition(corev1.ConditionFalse, ReasonNoChanges, "")
+	}
+	mg.SetConditions(c)
+	if c.Status == corev1.ConditionTrue {
+		dryRunPlans.set(mg.GetUID(), c)
+	}
+	return o
+}
+
+// diffOf returns the diff of the supplied observation, or the paths of the
+// fields that differ as reported in status.atProvider.diff by the kinds that
+// have one.
+func diffOf(mg resource.Managed, o managed.ExternalObservation) string {
+	if o.Diff != "" {
+		return o.Diff
+	}
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
+	if err != nil {
+		return ""
+	}
+	paths, _, _ := unstructured.NestedStringSlice(u, "status", "atProvider", "diff")
+	return strings.Join(paths, ", ")
+}
+
+// NewDryRunConnecter returns an ExternalConnecter whose external clients only
+// plan what they would do with the external resources of managed resources
+// that are dry run, and never create, update or delete them.
+func NewDryRunConnecter(c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &wrappingConnecter{connecter: c, wrap: func(e managed.ExternalClient) managed.ExternalClient {
+		return &dryRunExternal{ExternalClient: e}
+	}}
+}
+
+type dryRunExternal struct {
+	managed.ExternalClient
+}
+
+// Observe observes the external resource, and plans what to do with it if the
+// managed resource is dry run. The DryRun condition of managed resources that
+// are no longer dry run is disabled.
+func (e *dryRunExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	if err != nil {
+		return o, err
+	}
+	if IsDryRun(mg) {
+		return plan(mg, o), nil
+	}
+	if mg.GetCondition(TypeDryRun).Status != corev1.ConditionUnknown {
+		mg.SetConditions(dryRunCondition(corev1.ConditionFalse, ReasonDryRunDisabled, ""))
+	}
+	return o, nil
+}
+
+func (e *dryRunExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	if IsDryRun(mg) {
+		return managed.ExternalCreation{}, nil
+	}
+	return e.ExternalClient.Create(ctx, mg)
+}
+
+func (e *dryRunExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if IsDryRun(mg) {
+		return managed.ExternalUpdate{}, nil
+	}
+	return e.ExternalClient.Update(ctx, mg)
+}
+
+func (e *dryRunExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	if IsDryRun(mg) {
+		return nil
+	}
+	return e.ExternalClient.Delete(ctx, mg)
+}
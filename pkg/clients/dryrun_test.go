@@ -0,0 +1,148 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+)
+
+func TestDryRunObserve(t *testing.T) {
+	dryRun := func(enabled string, deleted bool, c ...xpv1.Condition) *fake.Managed {
+		mg := &fake.Managed{}
+		mg.SetUID(types.UID("dry-run"))
+		mg.SetAnnotations(map[string]string{AnnotationKeyDryRun: enabled})
+		if deleted {
+			now := metav1.Now()
+			mg.SetDeletionTimestamp(&now)
+		}
+		mg.SetConditions(c...)
+		return mg
+	}
+	observed := func(o managed.ExternalObservation) managed.ExternalClient {
+		return &managed.ExternalClientFns{ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+			return o, nil
+		}}
+	}
+
+	type want struct {
+		o         managed.ExternalObservation
+		condition xpv1.Condition
+		planned   bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		client managed.ExternalClient
+		mg     *fake.Managed
+		want   want
+	}{
+		"WouldCreate": {
+			reason: "External resources that would be created should be reported as existing and up to date",
+			client: observed(managed.ExternalObservation{ResourceExists: false}),
+			mg:     dryRun("true", false),
+			want: want{
+				o:         managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: dryRunCondition(corev1.ConditionTrue, ReasonWouldCreate, "would create the external resource"),
+				planned:   true,
+			},
+		},
+		"WouldUpdate": {
+			reason: "External resources that would be updated should be reported as up to date",
+			client: observed(managed.ExternalObservation{ResourceExists: true, Diff: "tier"}),
+			mg:     dryRun("true", false),
+			want: want{
+				o:         managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, Diff: "tier"},
+				condition: dryRunCondition(corev1.ConditionTrue, ReasonWouldUpdate, "would update the external resource: tier"),
+				planned:   true,
+			},
+		},
+		"WouldDelete": {
+			reason: "External resources that would be deleted should be reported as is",
+			client: observed(managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}),
+			mg:     dryRun("true", true),
+			want: want{
+				o:         managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: dryRunCondition(corev1.ConditionTrue, ReasonWouldDelete, "would delete the external resource"),
+				planned:   true,
+			},
+		},
+		"NoChanges": {
+			reason: "External resources that are up to date should not be planned",
+			client: observed(managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}),
+			mg:     dryRun("true", false),
+			want: want{
+				o:         managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				condition: dryRunCondition(corev1.ConditionFalse, ReasonNoChanges, ""),
+			},
+		},
+		"Disabled": {
+			reason: "The DryRun condition of resources that are no longer dry run should be disabled",
+			client: observed(managed.ExternalObservation{ResourceExists: false}),
+			mg:     dryRun("false", false, dryRunCondition(corev1.ConditionTrue, ReasonWouldCreate, "would create the external resource")),
+			want: want{
+				o:         managed.ExternalObservation{ResourceExists: false},
+				condition: dryRunCondition(corev1.ConditionFalse, ReasonDryRunDisabled, ""),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &dryRunExternal{ExternalClient: tc.client}
+			o, err := e.Observe(context.Background(), tc.mg)
+			if err != nil {
+				t.Fatalf("\n%s\ne.Observe(...): %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.condition, tc.mg.GetCondition(TypeDryRun), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want condition, +got condition:\n%s", tc.reason, diff)
+			}
+			if _, planned := dryRunPlans.pop(tc.mg.GetUID()); planned != tc.want.planned {
+				t.Errorf("\n%s\ne.Observe(...): want planned %t, got %t", tc.reason, tc.want.planned, planned)
+			}
+		})
+	}
+}
+
+func TestDryRunWrites(t *testing.T) {
+	mg := &fake.Managed{}
+	mg.SetAnnotations(map[string]string{AnnotationKeyDryRun: "true"})
+	e := &dryRunExternal{ExternalClient: &managed.ExternalClientFns{}}
+	if _, err := e.Create(context.Background(), mg); err != nil {
+		t.Errorf("e.Create(...): %s", err)
+	}
+	if _, err := e.Update(context.Background(), mg); err != nil {
+		t.Errorf("e.Update(...): %s", err)
+	}
+	if err := e.Delete(context.Background(), mg); err != nil {
+		t.Errorf("e.Delete(...): %s", err)
+	}
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// An ErrorClass tells how the reconcile of a managed resource that failed with
+// an error should be retried.
+type ErrorClass string
+
+// Error classes.
+const (
+	// ErrorRetryable errors, e.g. server errors or exhausted quota, may go
+	// away by themselves. The managed resource is retried with backoff.
+	ErrorRetryable ErrorClass = "Retryable"
+
+	// ErrorConflict errors, e.g. a concurrent change of the external
+	// resource, go away once it's observed again. The managed resource is
+	// retried with backoff.
+	ErrorConflict ErrorClass = "Conflict"
+
+	// ErrorTerminal errors, e.g. an invalid spec or missing permissions,
+	// only go away once the managed resource or its ProviderConfig is fixed.
+	// The managed resource is only retried after TerminalErrorRequeueAfter,
+	// or once it changes.
+	ErrorTerminal ErrorClass = "Terminal"
+)
+
+// TerminalErrorRequeueAfter is how long the reconcile of a managed resource
+// that failed with a terminal error is retried after, unless its poll interval
+// is longer.
+const TerminalErrorRequeueAfter = 10 * time.Minute
+
+const errPanic = "recovered from panic"
+
+// terminalReasons are the reasons of HTTP 400 responses from the Google API
+// that don't go away until the request is fixed. Other 400 responses, e.g. a
+// resourceNotReady from Compute Engine or a failedPrecondition from GKE while
+// another operation is running, are retried.
+var terminalReasons = map[string]bool{
+	"invalid":          true,
+	"invalidParameter": true,
+	"required":         true,
+}
+
+// rateLimitReasons are the reasons of HTTP 403 responses from the Google API
+// that are caused by exhausted quota rather than missing permissions.
+var rateLimitReasons = map[string]bool{
+	"rateLimitExceeded":     true,
+	"userRateLimitExceeded": true,
+	"quotaExceeded":         true,
+}
+
+// ClassifyError returns the class of the supplied error. Errors that aren't
+// responses from the Google API are considered retryable.
+func ClassifyError(err error) ErrorClass {
+	var googleapiErr *googleapi.Error
+	if errors.As(err, &googleapiErr) {
+		switch googleapiErr.Code {
+		case http.StatusConflict, http.StatusPreconditionFailed:
+			return ErrorConflict
+		case http.StatusBadRequest:
+			if hasReason(googleapiErr, terminalReasons) {
+				return ErrorTerminal
+			}
+		case http.StatusForbidden:
+			if !hasReason(googleapiErr, rateLimitReasons) {
+				return ErrorTerminal
+			}
+		case http.StatusUnauthorized, http.StatusNotImplemented:
+			return ErrorTerminal
+		}
+		return ErrorRetryable
+	}
+	s, ok := status.FromError(errors.Cause(err))
+	if !ok {
+		return ErrorRetryable
+	}
+	switch s.Code() { // nolint:exhaustive // All other codes are retryable.
+	case codes.AlreadyExists, codes.Aborted:
+		return ErrorConflict
+	case codes.InvalidArgument, codes.OutOfRange, codes.PermissionDenied, codes.Unauthenticated, codes.Unimplemented:
+		return ErrorTerminal
+	}
+	return ErrorRetryable
+}
+
+// hasReason returns true if any of the errors of the supplied response has
+// one of the supplied reasons.
+func hasReason(err *googleapi.Error, reasons map[string]bool) bool {
+	for _, e := range err.Errors {
+		if reasons[e.Reason] {
+			return true
+		}
+	}
+	return false
+}
+
+// NewGuardingConnecter returns an ExternalConnecter that recovers from panics
+// of the supplied connecter and its external clients, and classifies the
+// errors they return for the reconciler.
+func NewGuardingConnecter(c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &guardingConnecter{connecter: c}
+}
+
+type guardingConnecter struct {
+	connecter managed.ExternalConnecter
+}
+
+func (c *guardingConnecter) Connect(ctx context.Context, mg resource.Managed) (_ managed.ExternalClient, err error) {
+	defer guard(mg, &err)
+	e, err := c.connecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &guardingExternal{client: e}, nil
+}
+
+type guardingExternal struct {
+	client managed.ExternalClient
+}
+
+func (e *guardingExternal) Observe(ctx context.Context, mg resource.Managed) (_ managed.ExternalObservation, err error) {
+	defer guard(mg, &err)
+	return e.client.Observe(ctx, mg)
+}
+
+func (e *guardingExternal) Create(ctx context.Context, mg resource.Managed) (_ managed.ExternalCreation, err error) {
+	defer guard(mg, &err)
+	return e.client.Create(ctx, mg)
+}
+
+func (e *guardingExternal) Update(ctx context.Context, mg resource.Managed) (_ managed.ExternalUpdate, err error) {
+	defer guard(mg, &err)
+	return e.client.Update(ctx, mg)
+}
+
+func (e *guardingExternal) Delete(ctx context.Context, mg resource.Managed) (err error) {
+	defer guard(mg, &err)
+	return e.client.Delete(ctx, mg)
+}
+
+// guard recovers from a panic of the caller, e.g. caused by a malformed API
+// response, and makes it return an error instead so that the panic doesn't
+// crash the provider. It records the class of the error the caller returns,
+// if any, for the reconciler. It must be deferred.
+func guard(mg resource.Managed, err *error) {
+	if r := recover(); r != nil {
+		*err = errors.Errorf("%s: %v", errPanic, r)
+	}
+	errorClasses.set(mg.GetUID(), *err)
+}
+
+// errorClasses are set by external clients when they return an error, and
+// consumed by the reconciler once it's done reconciling the managed resource.
+var errorClasses = &classes{entries: map[types.UID]ErrorClass{}}
+
+type classes struct {
+	mu      sync.Mutex
+	entries map[types.UID]ErrorClass
+}
+
+func (c *classes) set(uid types.UID, err error) {
+	if err == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[uid] = ClassifyError(err)
+}
+
+func (c *classes) pop(uid types.UID) (ErrorClass, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[uid]
+	delete(c.entries, uid)
+	return e, ok
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestClassifyError(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want ErrorClass
+	}{
+		"Other":              {err: errors.New("boom"), want: ErrorRetryable},
+		"BadRequestInvalid":  {err: errors.Wrap(&googleapi.Error{Code: http.StatusBadRequest, Errors: []googleapi.ErrorItem{{Reason: "invalid"}}}, "cannot create"), want: ErrorTerminal},
+		"BadRequestNotReady": {err: &googleapi.Error{Code: http.StatusBadRequest, Errors: []googleapi.ErrorItem{{Reason: "resourceNotReady"}}}, want: ErrorRetryable},
+		"BadRequestNoReason": {err: &googleapi.Error{Code: http.StatusBadRequest}, want: ErrorRetryable},
+		"Forbidden":          {err: &googleapi.Error{Code: http.StatusForbidden}, want: ErrorTerminal},
+		"ForbiddenRateLimit": {err: &googleapi.Error{Code: http.StatusForbidden, Errors: []googleapi.ErrorItem{{Reason: "rateLimitExceeded"}}}, want: ErrorRetryable},
+		"Conflict":           {err: &googleapi.Error{Code: http.StatusConflict}, want: ErrorConflict},
+		"ServerError":        {err: &googleapi.Error{Code: http.StatusServiceUnavailable}, want: ErrorRetryable},
+		"GRPCInvalid":        {err: errors.Wrap(status.Error(codes.InvalidArgument, "invalid"), "cannot create"), want: ErrorTerminal},
+		"GRPCPrecondition":   {err: status.Error(codes.FailedPrecondition, "operation in progress"), want: ErrorRetryable},
+		"GRPCAborted":        {err: status.Error(codes.Aborted, "aborted"), want: ErrorConflict},
+		"GRPCDeadline":       {err: status.Error(codes.DeadlineExceeded, "slow"), want: ErrorRetryable},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := ClassifyError(tc.err); got != tc.want {
+				t.Errorf("ClassifyError(...): want %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestGuard(t *testing.T) {
+	e := &guardingExternal{client: &managed.ExternalClientFns{
+		ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+			var o *managed.ExternalObservation
+			return *o, nil
+		},
+		CreateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+			return managed.ExternalCreation{}, &googleapi.Error{Code: http.StatusForbidden}
+		},
+	}}
+	mg := &fake.Managed{}
+	mg.SetUID(types.UID("guarded"))
+
+	if _, err := e.Observe(context.Background(), mg); err == nil {
+		t.Errorf("e.Observe(...): want error recovered from panic, got nil")
+	}
+	if c, _ := errorClasses.pop(mg.GetUID()); c != ErrorRetryable {
+		t.Errorf("e.Observe(...): want error class %s, got %s", ErrorRetryable, c)
+	}
+
+	want := &googleapi.Error{Code: http.StatusForbidden}
+	if _, err := e.Create(context.Background(), mg); cmp.Diff(want, err, test.EquateErrors()) != "" {
+		t.Errorf("e.Create(...): want error %s, got %s", want, err)
+	}
+	if c, _ := errorClasses.pop(mg.GetUID()); c != ErrorTerminal {
+		t.Errorf("e.Create(...): want error class %s, got %s", ErrorTerminal, c)
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package essentialcontacts
+
+import (
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	essentialcontacts "google.golang.org/api/essentialcontacts/v1"
+
+	"github.com/crossplane/provider-gcp/apis/essentialcontacts/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectParentPrefix = "projects/"
+	contactsSep         = "/contacts/"
+
+	// ContactUpdateMask is the list of contact fields that can be updated.
+	ContactUpdateMask = "notificationCategorySubscriptions,languageTag"
+)
+
+// GetParent returns the parent of the supplied ContactParameters, falling
+// back to the supplied default project.
+func GetParent(defaultProject string, p v1alpha1.ContactParameters) string {
+	if p.Parent != nil {
+		return *p.Parent
+	}
+	return projectParentPrefix + defaultProject
+}
+
+// GetContactName builds the fully qualified name of a contact.
+func GetContactName(parent, id string) string {
+	return parent + contactsSep + id
+}
+
+// GetContactID extracts the ID of a contact from its fully qualified name.
+func GetContactID(name string) string {
+	return name[strings.LastIndex(name, "/")+1:]
+}
+
+// GenerateContact produces a Contact that is configured via the supplied
+// ContactParameters.
+func GenerateContact(p v1alpha1.ContactParameters) *essentialcontacts.GoogleCloudEssentialcontactsV1Contact {
+	return &essentialcontacts.GoogleCloudEssentialcontactsV1Contact{
+		Email:                             p.Email,
+		NotificationCategorySubscriptions: p.NotificationCategorySubscriptions,
+		LanguageTag:                       gcp.StringValue(p.LanguageTag),
+	}
+}
+
+// GenerateContactObservation produces a ContactObservation from the supplied
+// Contact.
+func GenerateContactObservation(c essentialcontacts.GoogleCloudEssentialcontactsV1Contact) v1alpha1.ContactObservation {
+	return v1alpha1.ContactObservation{
+		Name:            c.Name,
+		ValidationState: c.ValidationState,
+		ValidateTime:    c.ValidateTime,
+	}
+}
+
+// LateInitializeContact fills the empty fields of the supplied
+// ContactParameters with the values of the supplied Contact.
+func LateInitializeContact(p *v1alpha1.ContactParameters, c essentialcontacts.GoogleCloudEssentialcontactsV1Contact) {
+	p.LanguageTag = gcp.LateInitializeString(p.LanguageTag, c.LanguageTag)
+}
+
+// IsContactUpToDate returns true if the supplied Contact matches the supplied
+// ContactParameters. The order of the notification categories is not
+// significant.
+func IsContactUpToDate(p v1alpha1.ContactParameters, c essentialcontacts.GoogleCloudEssentialcontactsV1Contact) bool {
+	if gcp.StringValue(p.LanguageTag) != c.LanguageTag {
+		return false
+	}
+	return cmp.Equal(p.NotificationCategorySubscriptions, c.NotificationCategorySubscriptions,
+		cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b }))
+}
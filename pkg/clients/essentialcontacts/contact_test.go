@@ -0,0 +1,150 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package essentialcontacts
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	essentialcontacts "google.golang.org/api/essentialcontacts/v1"
+
+	"github.com/crossplane/provider-gcp/apis/essentialcontacts/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	project     = "cool-project"
+	contactName = "projects/cool-project/contacts/42"
+)
+
+func params(m ...func(*v1alpha1.ContactParameters)) *v1alpha1.ContactParameters {
+	p := &v1alpha1.ContactParameters{
+		Email: "security@example.com",
+		NotificationCategorySubscriptions: []string{
+			v1alpha1.NotificationCategorySecurity,
+			v1alpha1.NotificationCategoryTechnical,
+		},
+		LanguageTag: gcp.StringPtr("en"),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func contact(m ...func(*essentialcontacts.GoogleCloudEssentialcontactsV1Contact)) *essentialcontacts.GoogleCloudEssentialcontactsV1Contact {
+	c := &essentialcontacts.GoogleCloudEssentialcontactsV1Contact{
+		Name:                              contactName,
+		Email:                             "security@example.com",
+		NotificationCategorySubscriptions: []string{"TECHNICAL", "SECURITY"},
+		LanguageTag:                       "en",
+		ValidationState:                   "VALID",
+		ValidateTime:                      "2021-05-11T08:00:00Z",
+	}
+	for _, f := range m {
+		f(c)
+	}
+	return c
+}
+
+func TestGetContactName(t *testing.T) {
+	if diff := cmp.Diff(contactName, GetContactName(GetParent(project, *params()), "42")); diff != "" {
+		t.Errorf("GetContactName(...): -want, +got:\n%s", diff)
+	}
+	p := params(func(p *v1alpha1.ContactParameters) {
+		p.Parent = gcp.StringPtr("organizations/1234")
+	})
+	if diff := cmp.Diff("organizations/1234/contacts/42", GetContactName(GetParent(project, *p), "42")); diff != "" {
+		t.Errorf("GetContactName(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("42", GetContactID(contactName)); diff != "" {
+		t.Errorf("GetContactID(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateContact(t *testing.T) {
+	want := &essentialcontacts.GoogleCloudEssentialcontactsV1Contact{
+		Email:                             "security@example.com",
+		NotificationCategorySubscriptions: []string{"SECURITY", "TECHNICAL"},
+		LanguageTag:                       "en",
+	}
+	if diff := cmp.Diff(want, GenerateContact(*params())); diff != "" {
+		t.Errorf("GenerateContact(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateContactObservation(t *testing.T) {
+	want := v1alpha1.ContactObservation{
+		Name:            contactName,
+		ValidationState: "VALID",
+		ValidateTime:    "2021-05-11T08:00:00Z",
+	}
+	if diff := cmp.Diff(want, GenerateContactObservation(*contact())); diff != "" {
+		t.Errorf("GenerateContactObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeContact(t *testing.T) {
+	got := params(func(p *v1alpha1.ContactParameters) {
+		p.LanguageTag = nil
+	})
+	LateInitializeContact(got, *contact(func(c *essentialcontacts.GoogleCloudEssentialcontactsV1Contact) {
+		c.LanguageTag = "de"
+	}))
+	want := params(func(p *v1alpha1.ContactParameters) {
+		p.LanguageTag = gcp.StringPtr("de")
+	})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("LateInitializeContact(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsContactUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		params  *v1alpha1.ContactParameters
+		contact *essentialcontacts.GoogleCloudEssentialcontactsV1Contact
+		want    bool
+	}{
+		"UpToDate": {
+			params:  params(),
+			contact: contact(),
+			want:    true,
+		},
+		"CategoriesChanged": {
+			params: params(func(p *v1alpha1.ContactParameters) {
+				p.NotificationCategorySubscriptions = []string{v1alpha1.NotificationCategoryAll}
+			}),
+			contact: contact(),
+			want:    false,
+		},
+		"LanguageChanged": {
+			params: params(func(p *v1alpha1.ContactParameters) {
+				p.LanguageTag = gcp.StringPtr("fr")
+			}),
+			contact: contact(),
+			want:    false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsContactUpToDate(*tc.params, *tc.contact)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsContactUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventarc
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitchellh/copystructure"
+	eventarc "google.golang.org/api/eventarc/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane/provider-gcp/apis/eventarc/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	triggerNameFormat = "projects/%s/locations/%s/triggers/%s"
+	parentFormat      = "projects/%s/locations/%s"
+
+	errCheckUpToDate = "unable to determine if external resource is up to date"
+)
+
+// ConditionCodeOK is the code of a trigger condition that is healthy.
+const ConditionCodeOK = "OK"
+
+// UpdateMask is the set of Trigger fields that can be updated in place.
+const UpdateMask = "eventFilters,destination,serviceAccount,eventDataContentType,labels"
+
+// GetFullyQualifiedParent builds the fully qualified name of the trigger
+// parent.
+func GetFullyQualifiedParent(project string, p v1alpha1.TriggerParameters) string {
+	return fmt.Sprintf(parentFormat, project, p.Location)
+}
+
+// GetFullyQualifiedName builds the fully qualified name of the trigger.
+func GetFullyQualifiedName(project string, p v1alpha1.TriggerParameters, name string) string {
+	return fmt.Sprintf(triggerNameFormat, project, p.Location, name)
+}
+
+// GenerateTrigger overlays the supplied TriggerParameters onto the supplied
+// Eventarc Trigger. Name must be a fully qualified name for the trigger.
+func GenerateTrigger(name string, p v1alpha1.TriggerParameters, t *eventarc.Trigger) {
+	t.Name = name
+	t.EventFilters = make([]*eventarc.EventFilter, len(p.EventFilters))
+	for i, f := range p.EventFilters {
+		t.EventFilters[i] = &eventarc.EventFilter{
+			Attribute: f.Attribute,
+			Value:     f.Value,
+			Operator:  gcp.StringValue(f.Operator),
+		}
+	}
+	t.Destination = generateDestination(p.Destination)
+	if p.ServiceAccount != nil {
+		t.ServiceAccount = *p.ServiceAccount
+	}
+	if p.EventDataContentType != nil {
+		t.EventDataContentType = *p.EventDataContentType
+	}
+	if p.Labels != nil {
+		t.Labels = p.Labels
+	}
+}
+
+func generateDestination(in v1alpha1.Destination) *eventarc.Destination {
+	d := &eventarc.Destination{CloudFunction: gcp.StringValue(in.CloudFunction)}
+	if r := in.CloudRun; r != nil {
+		d.CloudRun = &eventarc.CloudRun{
+			Service: gcp.StringValue(r.Service),
+			Region:  r.Region,
+			Path:    gcp.StringValue(r.Path),
+		}
+	}
+	if g := in.GKE; g != nil {
+		d.Gke = &eventarc.GKE{
+			Cluster:   gcp.StringValue(g.Cluster),
+			Location:  g.Location,
+			Namespace: g.Namespace,
+			Service:   g.Service,
+			Path:      gcp.StringValue(g.Path),
+		}
+	}
+	return d
+}
+
+// GenerateObservation is used to produce an observation object from GCP's
+// Trigger object.
+func GenerateObservation(t eventarc.Trigger) v1alpha1.TriggerObservation {
+	o := v1alpha1.TriggerObservation{
+		Name:       t.Name,
+		UID:        t.Uid,
+		CreateTime: t.CreateTime,
+		UpdateTime: t.UpdateTime,
+	}
+	if t.Transport != nil && t.Transport.Pubsub != nil {
+		o.Transport = &v1alpha1.TransportObservation{
+			Topic:        t.Transport.Pubsub.Topic,
+			Subscription: t.Transport.Pubsub.Subscription,
+		}
+	}
+	if len(t.Conditions) > 0 {
+		o.Conditions = make(map[string]v1alpha1.StateCondition, len(t.Conditions))
+		for k, c := range t.Conditions {
+			o.Conditions[k] = v1alpha1.StateCondition{Code: c.Code, Message: c.Message}
+		}
+	}
+	return o
+}
+
+// IsHealthy returns true if none of the conditions of the supplied observation
+// report a problem.
+func IsHealthy(o v1alpha1.TriggerObservation) bool {
+	for _, c := range o.Conditions {
+		if c.Code != "" && c.Code != ConditionCodeOK {
+			return false
+		}
+	}
+	return true
+}
+
+// LateInitializeSpec fills empty spec fields with the data retrieved from GCP.
+func LateInitializeSpec(p *v1alpha1.TriggerParameters, t eventarc.Trigger) {
+	p.ServiceAccount = gcp.LateInitializeString(p.ServiceAccount, t.ServiceAccount)
+	p.EventDataContentType = gcp.LateInitializeString(p.EventDataContentType, t.EventDataContentType)
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, t.Labels)
+}
+
+// IsUpToDate returns true if the supplied Kubernetes resource does not differ
+// from the supplied GCP resource.
+func IsUpToDate(name string, p *v1alpha1.TriggerParameters, observed *eventarc.Trigger) (bool, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return true, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*eventarc.Trigger)
+	if !ok {
+		return true, errors.New(errCheckUpToDate)
+	}
+	GenerateTrigger(name, *p, desired)
+	// Event filters are matched as a set, so their order is not meaningful.
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b *eventarc.EventFilter) bool { return a.Attribute < b.Attribute })), nil
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventarc
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	eventarc "google.golang.org/api/eventarc/v1"
+
+	"github.com/crossplane/provider-gcp/apis/eventarc/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	fullName = "projects/coolProject/locations/us-cool1/triggers/cool-trigger"
+	email    = "cool@coolProject.iam.gserviceaccount.com"
+)
+
+func params(m ...func(*v1alpha1.TriggerParameters)) *v1alpha1.TriggerParameters {
+	p := &v1alpha1.TriggerParameters{
+		Location: "us-cool1",
+		EventFilters: []v1alpha1.EventFilter{
+			{Attribute: "type", Value: "google.cloud.storage.object.v1.finalized"},
+			{Attribute: "bucket", Value: "cool-bucket"},
+		},
+		Destination: v1alpha1.Destination{
+			CloudRun: &v1alpha1.CloudRunDestination{
+				Service: gcp.StringPtr("cool-service"),
+				Region:  "us-cool1",
+				Path:    gcp.StringPtr("/events"),
+			},
+		},
+		ServiceAccount:       gcp.StringPtr(email),
+		EventDataContentType: gcp.StringPtr("application/json"),
+		Labels:               map[string]string{"cool": "true"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func trigger(m ...func(*eventarc.Trigger)) *eventarc.Trigger {
+	t := &eventarc.Trigger{
+		Name: fullName,
+		EventFilters: []*eventarc.EventFilter{
+			{Attribute: "type", Value: "google.cloud.storage.object.v1.finalized"},
+			{Attribute: "bucket", Value: "cool-bucket"},
+		},
+		Destination: &eventarc.Destination{
+			CloudRun: &eventarc.CloudRun{Service: "cool-service", Region: "us-cool1", Path: "/events"},
+		},
+		ServiceAccount:       email,
+		EventDataContentType: "application/json",
+		Labels:               map[string]string{"cool": "true"},
+	}
+	for _, f := range m {
+		f(t)
+	}
+	return t
+}
+
+func TestGenerateTrigger(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.TriggerParameters
+		want *eventarc.Trigger
+	}{
+		"CloudRun": {
+			p:    *params(),
+			want: trigger(),
+		},
+		"CloudFunction": {
+			p: *params(func(p *v1alpha1.TriggerParameters) {
+				p.Destination = v1alpha1.Destination{CloudFunction: gcp.StringPtr("projects/coolProject/locations/us-cool1/functions/cool-function")}
+			}),
+			want: trigger(func(t *eventarc.Trigger) {
+				t.Destination = &eventarc.Destination{CloudFunction: "projects/coolProject/locations/us-cool1/functions/cool-function"}
+			}),
+		},
+		"GKE": {
+			p: *params(func(p *v1alpha1.TriggerParameters) {
+				p.Destination = v1alpha1.Destination{GKE: &v1alpha1.GKEDestination{
+					Cluster:   gcp.StringPtr("cool-cluster"),
+					Location:  "us-cool1",
+					Namespace: "default",
+					Service:   "cool-service",
+				}}
+			}),
+			want: trigger(func(t *eventarc.Trigger) {
+				t.Destination = &eventarc.Destination{Gke: &eventarc.GKE{
+					Cluster:   "cool-cluster",
+					Location:  "us-cool1",
+					Namespace: "default",
+					Service:   "cool-service",
+				}}
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := &eventarc.Trigger{}
+			GenerateTrigger(fullName, tc.p, got)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateTrigger(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	tr := *trigger(func(t *eventarc.Trigger) {
+		t.Uid = "cool-uid"
+		t.Transport = &eventarc.Transport{Pubsub: &eventarc.Pubsub{
+			Topic:        "projects/coolProject/topics/eventarc-us-cool1-cool-trigger-123",
+			Subscription: "projects/coolProject/subscriptions/eventarc-us-cool1-cool-trigger-sub-123",
+		}}
+		t.Conditions = map[string]eventarc.StateCondition{"destination": {Code: "OK"}}
+	})
+	want := v1alpha1.TriggerObservation{
+		Name: fullName,
+		UID:  "cool-uid",
+		Transport: &v1alpha1.TransportObservation{
+			Topic:        "projects/coolProject/topics/eventarc-us-cool1-cool-trigger-123",
+			Subscription: "projects/coolProject/subscriptions/eventarc-us-cool1-cool-trigger-sub-123",
+		},
+		Conditions: map[string]v1alpha1.StateCondition{"destination": {Code: "OK"}},
+	}
+	if diff := cmp.Diff(want, GenerateObservation(tr)); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsHealthy(t *testing.T) {
+	cases := map[string]struct {
+		o    v1alpha1.TriggerObservation
+		want bool
+	}{
+		"NoConditions": {
+			o:    v1alpha1.TriggerObservation{},
+			want: true,
+		},
+		"AllOK": {
+			o:    v1alpha1.TriggerObservation{Conditions: map[string]v1alpha1.StateCondition{"destination": {Code: ConditionCodeOK}}},
+			want: true,
+		},
+		"DestinationMissing": {
+			o:    v1alpha1.TriggerObservation{Conditions: map[string]v1alpha1.StateCondition{"destination": {Code: "NOT_FOUND", Message: "service not found"}}},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsHealthy(tc.o)); diff != "" {
+				t.Errorf("IsHealthy(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	p := params(func(p *v1alpha1.TriggerParameters) {
+		p.ServiceAccount = nil
+		p.EventDataContentType = nil
+	})
+	LateInitializeSpec(p, *trigger())
+	if diff := cmp.Diff(params(), p); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.TriggerParameters
+		t    *eventarc.Trigger
+		want bool
+	}{
+		"UpToDate": {
+			p: params(),
+			t: trigger(func(t *eventarc.Trigger) {
+				t.Uid = "cool-uid"
+				t.Transport = &eventarc.Transport{Pubsub: &eventarc.Pubsub{Topic: "cool-topic"}}
+			}),
+			want: true,
+		},
+		"FiltersReordered": {
+			p: params(),
+			t: trigger(func(t *eventarc.Trigger) {
+				t.EventFilters[0], t.EventFilters[1] = t.EventFilters[1], t.EventFilters[0]
+			}),
+			want: true,
+		},
+		"FilterDiffers": {
+			p: params(),
+			t: trigger(func(t *eventarc.Trigger) {
+				t.EventFilters[1].Value = "uncool-bucket"
+			}),
+			want: false,
+		},
+		"DestinationDiffers": {
+			p: params(),
+			t: trigger(func(t *eventarc.Trigger) {
+				t.Destination.CloudRun.Path = "/"
+			}),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsUpToDate(fullName, tc.p, tc.t)
+			if err != nil {
+				t.Fatalf("IsUpToDate(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"strings"
+	"text/template"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/v1beta1"
+)
+
+const (
+	errGetProviderConfig    = "cannot get ProviderConfig"
+	errParseNameTemplate    = "cannot parse externalNameTemplate of ProviderConfig %q"
+	errExecuteNameTemplate  = "cannot execute externalNameTemplate of ProviderConfig %q"
+	errUpdateManagedExtName = "cannot update external name of managed resource"
+)
+
+// NormalizeExternalName returns the name of the GCP resource identified by the
+// supplied external name. Some APIs identify resources by their selfLink, e.g.
+// https://www.googleapis.com/compute/v1/projects/p/global/networks/n, or their
+// relative resource name, e.g. projects/p/topics/t, rather than their name.
+// Only the last segment of those is the name of the resource.
+func NormalizeExternalName(name string) string {
+	name = strings.TrimSuffix(strings.TrimSpace(name), "/")
+	return name[strings.LastIndex(name, "/")+1:]
+}
+
+// An ExternalNameTemplateData is passed to the externalNameTemplate of a
+// ProviderConfig to render the external name of a managed resource.
+type ExternalNameTemplateData struct {
+	// Name of the managed resource.
+	Name string
+
+	// Labels of the managed resource.
+	Labels map[string]string
+}
+
+// An ExternalNameInitializer sets the external name of managed resources that
+// don't have one yet to the name rendered by the externalNameTemplate of their
+// ProviderConfig, or to their own name if the ProviderConfig has no template.
+// External names that were set, e.g. to import an existing resource, are
+// normalized instead.
+type ExternalNameInitializer struct {
+	client client.Client
+}
+
+// NewExternalNameInitializer returns an ExternalNameInitializer that updates
+// managed resources using the supplied client. It is the default initializer
+// of the reconcilers returned by NewReconciler.
+func NewExternalNameInitializer(c client.Client) *ExternalNameInitializer {
+	return &ExternalNameInitializer{client: c}
+}
+
+// Initialize the external name of the supplied managed resource.
+func (i *ExternalNameInitializer) Initialize(ctx context.Context, mg resource.Managed) error {
+	if n := meta.GetExternalName(mg); n != "" {
+		if nn := NormalizeExternalName(n); nn != n {
+			meta.SetExternalName(mg, nn)
+			return errors.Wrap(i.client.Update(ctx, mg), errUpdateManagedExtName)
+		}
+		return nil
+	}
+	n, err := i.render(ctx, mg)
+	if err != nil {
+		return err
+	}
+	meta.SetExternalName(mg, n)
+	return errors.Wrap(i.client.Update(ctx, mg), errUpdateManagedExtName)
+}
+
+func (i *ExternalNameInitializer) render(ctx context.Context, mg resource.Managed) (string, error) {
+	ref := mg.GetProviderConfigReference()
+	if ref == nil {
+		return mg.GetName(), nil
+	}
+	pc := &v1beta1.ProviderConfig{}
+	if err := i.client.Get(ctx, types.NamespacedName{Name: ref.Name}, pc); err != nil {
+		return "", errors.Wrap(err, errGetProviderConfig)
+	}
+	if pc.Spec.ExternalNameTemplate == nil {
+		return mg.GetName(), nil
+	}
+	t, err := template.New("externalName").Option("missingkey=error").Parse(*pc.Spec.ExternalNameTemplate)
+	if err != nil {
+		return "", errors.Wrapf(err, errParseNameTemplate, pc.GetName())
+	}
+	b := &strings.Builder{}
+	if err := t.Execute(b, ExternalNameTemplateData{Name: mg.GetName(), Labels: mg.GetLabels()}); err != nil {
+		return "", errors.Wrapf(err, errExecuteNameTemplate, pc.GetName())
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"io"
+	"testing"
+	"text/template"
+
+	"github.com/google/go-cmp/cmp"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/v1beta1"
+)
+
+func TestNormalizeExternalName(t *testing.T) {
+	cases := map[string]string{
+		"my-network": "my-network",
+		"https://www.googleapis.com/compute/v1/projects/p/global/networks/my-network": "my-network",
+		"projects/p/topics/my-topic/": "my-topic",
+	}
+	for in, want := range cases {
+		if got := NormalizeExternalName(in); got != want {
+			t.Errorf("NormalizeExternalName(%q): want %q, got %q", in, want, got)
+		}
+	}
+}
+
+func withTemplate(tmpl *string) test.MockGetFn {
+	return func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+		pc := obj.(*v1beta1.ProviderConfig)
+		pc.SetName(key.Name)
+		pc.Spec.ExternalNameTemplate = tmpl
+		return nil
+	}
+}
+
+func TestExternalNameInitializer(t *testing.T) {
+	errBoom := errors.New("boom")
+	prefixed := "team-a-{{ .Name }}"
+	invalid := "{{ .Name"
+	missing := "{{ .Namespace }}"
+	_, errParse := template.New("externalName").Parse(invalid)
+	errExecute := template.Must(template.New("externalName").Parse(missing)).Execute(io.Discard, ExternalNameTemplateData{})
+
+	managed := func(externalName string) *fake.Managed {
+		mg := &fake.Managed{}
+		mg.SetName("cool")
+		mg.SetProviderConfigReference(&xpv1.Reference{Name: "team-a"})
+		if externalName != "" {
+			meta.SetExternalName(mg, externalName)
+		}
+		return mg
+	}
+
+	type want struct {
+		err          error
+		externalName string
+	}
+
+	cases := map[string]struct {
+		reason string
+		kube   client.Client
+		mg     *fake.Managed
+		want   want
+	}{
+		"ExternalNameSet": {
+			reason: "Resources that have an external name should not be updated",
+			mg:     managed("imported"),
+			want:   want{externalName: "imported"},
+		},
+		"ExternalNameNormalized": {
+			reason: "External names that are selfLinks should be normalized to the name of the resource",
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			mg:     managed("https://www.googleapis.com/compute/v1/projects/p/global/networks/imported"),
+			want:   want{externalName: "imported"},
+		},
+		"GetProviderConfigFailed": {
+			reason: "Should return an error if the ProviderConfig can't be read",
+			kube:   &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			mg:     managed(""),
+			want:   want{err: errors.Wrap(errBoom, errGetProviderConfig)},
+		},
+		"NoTemplate": {
+			reason: "Resources should default to their own name if the ProviderConfig has no template",
+			kube:   &test.MockClient{MockGet: withTemplate(nil), MockUpdate: test.NewMockUpdateFn(nil)},
+			mg:     managed(""),
+			want:   want{externalName: "cool"},
+		},
+		"Template": {
+			reason: "Resources should be named by the template of their ProviderConfig",
+			kube:   &test.MockClient{MockGet: withTemplate(&prefixed), MockUpdate: test.NewMockUpdateFn(nil)},
+			mg:     managed(""),
+			want:   want{externalName: "team-a-cool"},
+		},
+		"InvalidTemplate": {
+			reason: "Should return an error if the template can't be parsed",
+			kube:   &test.MockClient{MockGet: withTemplate(&invalid)},
+			mg:     managed(""),
+			want:   want{err: errors.Wrapf(errParse, errParseNameTemplate, "team-a")},
+		},
+		"TemplateFailed": {
+			reason: "Should return an error if the template can't be executed",
+			kube:   &test.MockClient{MockGet: withTemplate(&missing)},
+			mg:     managed(""),
+			want:   want{err: errors.Wrapf(errExecute, errExecuteNameTemplate, "team-a")},
+		},
+		"UpdateFailed": {
+			reason: "Should return an error if the external name can't be persisted",
+			kube:   &test.MockClient{MockGet: withTemplate(nil), MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:     managed(""),
+			want:   want{err: errors.Wrap(errBoom, errUpdateManagedExtName), externalName: "cool"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := NewExternalNameInitializer(tc.kube).Initialize(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.mg)); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want external name, +got external name:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
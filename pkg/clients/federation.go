@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane/provider-gcp/apis/v1beta1"
+)
+
+const (
+	defaultSubjectTokenType = "urn:ietf:params:oauth:token-type:jwt"
+	defaultTokenURL         = "https://sts.googleapis.com/v1/token"
+	impersonationURLFormat  = "https://iamcredentials.googleapis.com/v1/projects/-/serviceAccounts/%s:generateAccessToken"
+
+	errNoFederation       = "federation must be configured to use Federation credentials"
+	errNoCredentialSource = "exactly one of file and url must be set as credential source"
+)
+
+// externalAccount is the JSON configuration of external account credentials,
+// as generated by gcloud iam workload-identity-pools create-cred-config.
+type externalAccount struct {
+	Type                           string                   `json:"type"`
+	Audience                       string                   `json:"audience"`
+	SubjectTokenType               string                   `json:"subject_token_type"`
+	TokenURL                       string                   `json:"token_url"`
+	ServiceAccountImpersonationURL string                   `json:"service_account_impersonation_url,omitempty"`
+	CredentialSource               externalCredentialSource `json:"credential_source"`
+}
+
+type externalCredentialSource struct {
+	File    string                    `json:"file,omitempty"`
+	URL     string                    `json:"url,omitempty"`
+	Headers map[string]string         `json:"headers,omitempty"`
+	Format  *externalCredentialFormat `json:"format,omitempty"`
+}
+
+type externalCredentialFormat struct {
+	Type                  string `json:"type"`
+	SubjectTokenFieldName string `json:"subject_token_field_name,omitempty"`
+}
+
+// ExternalAccountJSON returns the JSON configuration of the external account
+// credentials that are configured by the supplied FederationConfig.
+func ExternalAccountJSON(fc *v1beta1.FederationConfig) ([]byte, error) {
+	if fc == nil {
+		return nil, errors.New(errNoFederation)
+	}
+	cs := fc.CredentialSource
+	if (cs.File == nil) == (cs.URL == nil) {
+		return nil, errors.New(errNoCredentialSource)
+	}
+	ea := externalAccount{
+		Type:             "external_account",
+		Audience:         fc.Audience,
+		SubjectTokenType: defaultSubjectTokenType,
+		TokenURL:         defaultTokenURL,
+		CredentialSource: externalCredentialSource{
+			File:    StringValue(cs.File),
+			URL:     StringValue(cs.URL),
+			Headers: cs.Headers,
+		},
+	}
+	if fc.SubjectTokenType != nil {
+		ea.SubjectTokenType = *fc.SubjectTokenType
+	}
+	if fc.TokenURL != nil {
+		ea.TokenURL = *fc.TokenURL
+	}
+	if fc.ServiceAccountEmail != nil {
+		ea.ServiceAccountImpersonationURL = fmt.Sprintf(impersonationURLFormat, *fc.ServiceAccountEmail)
+	}
+	if f := cs.Format; f != nil {
+		ea.CredentialSource.Format = &externalCredentialFormat{
+			Type:                  f.Type,
+			SubjectTokenFieldName: StringValue(f.SubjectTokenFieldName),
+		}
+	}
+	return json.Marshal(ea)
+}
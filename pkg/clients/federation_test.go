@@ -0,0 +1,134 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/oauth2/google"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/v1beta1"
+)
+
+const audience = "//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/eks/providers/oidc"
+
+func TestExternalAccountJSON(t *testing.T) {
+	type want struct {
+		ea  *externalAccount
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fc     *v1beta1.FederationConfig
+		want   want
+	}{
+		"NoFederation": {
+			reason: "Should return an error if federation is not configured",
+			want:   want{err: errors.New(errNoFederation)},
+		},
+		"NoCredentialSource": {
+			reason: "Should return an error if neither a file nor a URL is set",
+			fc:     &v1beta1.FederationConfig{Audience: audience},
+			want:   want{err: errors.New(errNoCredentialSource)},
+		},
+		"BothCredentialSources": {
+			reason: "Should return an error if both a file and a URL are set",
+			fc: &v1beta1.FederationConfig{
+				Audience: audience,
+				CredentialSource: v1beta1.FederationCredentialSource{
+					File: StringPtr("/var/run/secrets/token"),
+					URL:  StringPtr("http://169.254.169.254/token"),
+				},
+			},
+			want: want{err: errors.New(errNoCredentialSource)},
+		},
+		"FileSourced": {
+			reason: "Should use the default subject token type and token URL",
+			fc: &v1beta1.FederationConfig{
+				Audience:         audience,
+				CredentialSource: v1beta1.FederationCredentialSource{File: StringPtr("/var/run/secrets/token")},
+			},
+			want: want{ea: &externalAccount{
+				Type:             "external_account",
+				Audience:         audience,
+				SubjectTokenType: defaultSubjectTokenType,
+				TokenURL:         defaultTokenURL,
+				CredentialSource: externalCredentialSource{File: "/var/run/secrets/token"},
+			}},
+		},
+		"URLSourced": {
+			reason: "Should configure a URL-sourced token and service account impersonation",
+			fc: &v1beta1.FederationConfig{
+				Audience:            audience,
+				SubjectTokenType:    StringPtr("urn:ietf:params:oauth:token-type:id_token"),
+				TokenURL:            StringPtr("https://sts.example.com/v1/token"),
+				ServiceAccountEmail: StringPtr("crossplane@example.iam.gserviceaccount.com"),
+				CredentialSource: v1beta1.FederationCredentialSource{
+					URL:     StringPtr("http://localhost:8080/token"),
+					Headers: map[string]string{"Metadata": "True"},
+					Format: &v1beta1.FederationCredentialFormat{
+						Type:                  "json",
+						SubjectTokenFieldName: StringPtr("access_token"),
+					},
+				},
+			},
+			want: want{ea: &externalAccount{
+				Type:                           "external_account",
+				Audience:                       audience,
+				SubjectTokenType:               "urn:ietf:params:oauth:token-type:id_token",
+				TokenURL:                       "https://sts.example.com/v1/token",
+				ServiceAccountImpersonationURL: "https://iamcredentials.googleapis.com/v1/projects/-/serviceAccounts/crossplane@example.iam.gserviceaccount.com:generateAccessToken",
+				CredentialSource: externalCredentialSource{
+					URL:     "http://localhost:8080/token",
+					Headers: map[string]string{"Metadata": "True"},
+					Format:  &externalCredentialFormat{Type: "json", SubjectTokenFieldName: "access_token"},
+				},
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			data, err := ExternalAccountJSON(tc.fc)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("\n%s\nExternalAccountJSON(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if err != nil {
+				return
+			}
+			got := &externalAccount{}
+			if err := json.Unmarshal(data, got); err != nil {
+				t.Fatalf("\n%s\nExternalAccountJSON(...): invalid JSON: %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.ea, got); diff != "" {
+				t.Errorf("\n%s\nExternalAccountJSON(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			// The configuration must be accepted as external account
+			// credentials, which doesn't require reading the token yet.
+			if _, err := google.CredentialsFromJSON(context.Background(), data, cloudPlatformScope); err != nil {
+				t.Errorf("\n%s\nCredentialsFromJSON(...): %s", tc.reason, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filestore
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	file "google.golang.org/api/file/v1"
+
+	"github.com/crossplane/provider-gcp/apis/filestore/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// BackupUpdateMask is the list of backup fields that can be updated with a
+// patch call.
+const BackupUpdateMask = "description,labels"
+
+// GetBackupParent returns the location of the supplied BackupParameters in
+// the form projects/{project}/locations/{location}, falling back to the
+// supplied default project.
+func GetBackupParent(defaultProject string, p v1alpha1.BackupParameters) string {
+	project := defaultProject
+	if p.Project != nil {
+		project = *p.Project
+	}
+	return fmt.Sprintf(parentFormat, project, p.Location)
+}
+
+// GetBackupName builds the fully qualified name of the backup with the
+// supplied ID in the supplied parent.
+func GetBackupName(parent, id string) string {
+	return parent + "/backups/" + id
+}
+
+// GenerateBackup produces a Backup that is configured via the supplied
+// BackupParameters.
+func GenerateBackup(p v1alpha1.BackupParameters) *file.Backup {
+	return &file.Backup{
+		Description:     gcp.StringValue(p.Description),
+		Labels:          p.Labels,
+		SourceInstance:  gcp.StringValue(p.SourceInstance),
+		SourceFileShare: p.SourceFileShare,
+	}
+}
+
+// GenerateBackupObservation produces a BackupObservation from the supplied
+// Backup.
+func GenerateBackupObservation(b file.Backup) v1alpha1.BackupObservation {
+	return v1alpha1.BackupObservation{
+		Name:               b.Name,
+		State:              b.State,
+		CreateTime:         b.CreateTime,
+		CapacityGB:         b.CapacityGb,
+		StorageBytes:       b.StorageBytes,
+		DownloadBytes:      b.DownloadBytes,
+		SourceInstanceTier: b.SourceInstanceTier,
+	}
+}
+
+// LateInitializeBackup fills the empty fields of the supplied
+// BackupParameters with the values seen in the supplied Backup.
+func LateInitializeBackup(p *v1alpha1.BackupParameters, b file.Backup) {
+	p.Description = gcp.LateInitializeString(p.Description, b.Description)
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, b.Labels)
+	p.SourceInstance = gcp.LateInitializeString(p.SourceInstance, b.SourceInstance)
+}
+
+// IsBackupUpToDate returns true if the description and labels of the
+// supplied Backup match the supplied BackupParameters.
+func IsBackupUpToDate(p v1alpha1.BackupParameters, b file.Backup) bool {
+	return gcp.StringValue(p.Description) == b.Description &&
+		cmp.Equal(p.Labels, b.Labels, cmpopts.EquateEmpty())
+}
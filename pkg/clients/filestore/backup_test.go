@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filestore
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	file "google.golang.org/api/file/v1"
+
+	"github.com/crossplane/provider-gcp/apis/filestore/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const backupName = "projects/cool-project/locations/us-central1/backups/nightly"
+
+func backupParams(m ...func(*v1alpha1.BackupParameters)) *v1alpha1.BackupParameters {
+	p := &v1alpha1.BackupParameters{
+		Location:        "us-central1",
+		Description:     gcp.StringPtr("Nightly backup"),
+		Labels:          map[string]string{"team": "platform"},
+		SourceInstance:  gcp.StringPtr(instanceName),
+		SourceFileShare: "vol1",
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func backup(m ...func(*file.Backup)) *file.Backup {
+	b := &file.Backup{
+		Name:               backupName,
+		State:              v1alpha1.BackupStateReady,
+		CreateTime:         "2021-06-01T00:00:00Z",
+		Description:        "Nightly backup",
+		Labels:             map[string]string{"team": "platform"},
+		SourceInstance:     instanceName,
+		SourceFileShare:    "vol1",
+		SourceInstanceTier: "BASIC_HDD",
+		CapacityGb:         1024,
+		StorageBytes:       4096,
+		DownloadBytes:      2048,
+	}
+	for _, f := range m {
+		f(b)
+	}
+	return b
+}
+
+func TestBackupNames(t *testing.T) {
+	parent := GetBackupParent(project, *backupParams())
+	if diff := cmp.Diff(backupName, GetBackupName(parent, "nightly")); diff != "" {
+		t.Errorf("GetBackupName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateBackup(t *testing.T) {
+	want := &file.Backup{
+		Description:     "Nightly backup",
+		Labels:          map[string]string{"team": "platform"},
+		SourceInstance:  instanceName,
+		SourceFileShare: "vol1",
+	}
+	if diff := cmp.Diff(want, GenerateBackup(*backupParams())); diff != "" {
+		t.Errorf("GenerateBackup(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateBackupObservation(t *testing.T) {
+	want := v1alpha1.BackupObservation{
+		Name:               backupName,
+		State:              v1alpha1.BackupStateReady,
+		CreateTime:         "2021-06-01T00:00:00Z",
+		CapacityGB:         1024,
+		StorageBytes:       4096,
+		DownloadBytes:      2048,
+		SourceInstanceTier: "BASIC_HDD",
+	}
+	if diff := cmp.Diff(want, GenerateBackupObservation(*backup())); diff != "" {
+		t.Errorf("GenerateBackupObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeBackup(t *testing.T) {
+	got := backupParams(func(p *v1alpha1.BackupParameters) {
+		p.Description = nil
+		p.Labels = nil
+	})
+	LateInitializeBackup(got, *backup())
+	if diff := cmp.Diff(backupParams(), got); diff != "" {
+		t.Errorf("LateInitializeBackup(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsBackupUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.BackupParameters
+		want bool
+	}{
+		"UpToDate": {
+			p:    backupParams(),
+			want: true,
+		},
+		"DescriptionDiffers": {
+			p: backupParams(func(p *v1alpha1.BackupParameters) { p.Description = gcp.StringPtr("Weekly backup") }),
+		},
+		"LabelsDiffer": {
+			p: backupParams(func(p *v1alpha1.BackupParameters) { p.Labels = nil }),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsBackupUpToDate(*tc.p, *backup()); got != tc.want {
+				t.Errorf("IsBackupUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filestore
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	file "google.golang.org/api/file/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-gcp/apis/filestore/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const parentFormat = "projects/%s/locations/%s"
+
+// InstanceUpdateMask is the list of instance fields that can be updated with
+// a patch call. Only the capacity and the NFS export options of the file
+// share can be changed.
+const InstanceUpdateMask = "description,labels,fileShares"
+
+var ignoreForceSendFields = cmp.FilterPath(func(p cmp.Path) bool {
+	return p.Last().String() == ".ForceSendFields"
+}, cmp.Ignore())
+
+// GetInstanceParent returns the location of the supplied InstanceParameters
+// in the form projects/{project}/locations/{location}, falling back to the
+// supplied default project.
+func GetInstanceParent(defaultProject string, p v1alpha1.InstanceParameters) string {
+	project := defaultProject
+	if p.Project != nil {
+		project = *p.Project
+	}
+	return fmt.Sprintf(parentFormat, project, p.Location)
+}
+
+// GetInstanceName builds the fully qualified name of the instance with the
+// supplied ID in the supplied parent.
+func GetInstanceName(parent, id string) string {
+	return parent + "/instances/" + id
+}
+
+// GenerateInstance produces an Instance that is configured via the supplied
+// InstanceParameters.
+func GenerateInstance(p v1alpha1.InstanceParameters) *file.Instance {
+	i := &file.Instance{
+		Tier:        p.Tier,
+		Description: gcp.StringValue(p.Description),
+		Labels:      p.Labels,
+		KmsKeyName:  gcp.StringValue(p.KMSKeyName),
+		FileShares: []*file.FileShareConfig{{
+			Name:         p.FileShare.Name,
+			CapacityGb:   p.FileShare.CapacityGB,
+			SourceBackup: gcp.StringValue(p.FileShare.SourceBackup),
+		}},
+	}
+	for _, o := range p.FileShare.NFSExportOptions {
+		i.FileShares[0].NfsExportOptions = append(i.FileShares[0].NfsExportOptions, &file.NfsExportOptions{
+			IpRanges:   o.IPRanges,
+			AccessMode: gcp.StringValue(o.AccessMode),
+			SquashMode: gcp.StringValue(o.SquashMode),
+			AnonUid:    gcp.Int64Value(o.AnonUID),
+			AnonGid:    gcp.Int64Value(o.AnonGID),
+		})
+	}
+	for _, n := range p.Networks {
+		i.Networks = append(i.Networks, &file.NetworkConfig{
+			Network:         gcp.StringValue(n.Network),
+			Modes:           n.Modes,
+			ReservedIpRange: gcp.StringValue(n.ReservedIPRange),
+			ConnectMode:     gcp.StringValue(n.ConnectMode),
+		})
+	}
+	return i
+}
+
+// GenerateInstanceObservation produces an InstanceObservation from the
+// supplied Instance.
+func GenerateInstanceObservation(i file.Instance) v1alpha1.InstanceObservation {
+	o := v1alpha1.InstanceObservation{
+		Name:          i.Name,
+		State:         i.State,
+		StatusMessage: i.StatusMessage,
+		CreateTime:    i.CreateTime,
+		Etag:          i.Etag,
+	}
+	if len(i.FileShares) > 0 && i.FileShares[0] != nil {
+		o.CapacityGB = i.FileShares[0].CapacityGb
+	}
+	if len(i.Networks) > 0 && i.Networks[0] != nil {
+		o.IPAddresses = i.Networks[0].IpAddresses
+	}
+	return o
+}
+
+// GetInstanceConnectionDetails returns the address and path an NFS client
+// mounts the file share of the supplied instance from, if the instance has
+// an IP address yet.
+func GetInstanceConnectionDetails(p v1alpha1.InstanceParameters, o v1alpha1.InstanceObservation) managed.ConnectionDetails {
+	if len(o.IPAddresses) == 0 {
+		return managed.ConnectionDetails{}
+	}
+	return managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(o.IPAddresses[0]),
+		v1alpha1.InstanceSecretMountPathKey:       []byte("/" + p.FileShare.Name),
+	}
+}
+
+// LateInitializeInstance fills the empty fields of the supplied
+// InstanceParameters with the values seen in the supplied Instance.
+func LateInitializeInstance(p *v1alpha1.InstanceParameters, i file.Instance) {
+	p.Description = gcp.LateInitializeString(p.Description, i.Description)
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, i.Labels)
+	p.KMSKeyName = gcp.LateInitializeString(p.KMSKeyName, i.KmsKeyName)
+	if len(i.FileShares) > 0 && i.FileShares[0] != nil {
+		lateInitializeNFSExportOptions(&p.FileShare, *i.FileShares[0])
+	}
+	for idx := range p.Networks {
+		if idx >= len(i.Networks) || i.Networks[idx] == nil {
+			break
+		}
+		n := i.Networks[idx]
+		p.Networks[idx].Modes = gcp.LateInitializeStringSlice(p.Networks[idx].Modes, n.Modes)
+		p.Networks[idx].ReservedIPRange = gcp.LateInitializeString(p.Networks[idx].ReservedIPRange, n.ReservedIpRange)
+		p.Networks[idx].ConnectMode = gcp.LateInitializeString(p.Networks[idx].ConnectMode, n.ConnectMode)
+	}
+}
+
+func lateInitializeNFSExportOptions(fs *v1alpha1.FileShareConfig, in file.FileShareConfig) {
+	if len(fs.NFSExportOptions) == 0 {
+		for _, o := range in.NfsExportOptions {
+			if o == nil {
+				continue
+			}
+			fs.NFSExportOptions = append(fs.NFSExportOptions, v1alpha1.NFSExportOptions{
+				IPRanges:   o.IpRanges,
+				AccessMode: gcp.LateInitializeString(nil, o.AccessMode),
+				SquashMode: gcp.LateInitializeString(nil, o.SquashMode),
+				AnonUID:    gcp.LateInitializeInt64(nil, o.AnonUid),
+				AnonGID:    gcp.LateInitializeInt64(nil, o.AnonGid),
+			})
+		}
+		return
+	}
+	for idx := range fs.NFSExportOptions {
+		if idx >= len(in.NfsExportOptions) || in.NfsExportOptions[idx] == nil {
+			break
+		}
+		o := &fs.NFSExportOptions[idx]
+		o.AccessMode = gcp.LateInitializeString(o.AccessMode, in.NfsExportOptions[idx].AccessMode)
+		o.SquashMode = gcp.LateInitializeString(o.SquashMode, in.NfsExportOptions[idx].SquashMode)
+		o.AnonUID = gcp.LateInitializeInt64(o.AnonUID, in.NfsExportOptions[idx].AnonUid)
+		o.AnonGID = gcp.LateInitializeInt64(o.AnonGID, in.NfsExportOptions[idx].AnonGid)
+	}
+}
+
+// IsInstanceUpToDate returns true if the fields of the supplied Instance that
+// can be updated in place match the supplied InstanceParameters.
+func IsInstanceUpToDate(p v1alpha1.InstanceParameters, i file.Instance) bool {
+	desired := GenerateInstance(p)
+	if desired.Description != i.Description {
+		return false
+	}
+	if !cmp.Equal(desired.Labels, i.Labels, cmpopts.EquateEmpty()) {
+		return false
+	}
+	if len(i.FileShares) == 0 || i.FileShares[0] == nil {
+		return false
+	}
+	if desired.FileShares[0].CapacityGb != i.FileShares[0].CapacityGb {
+		return false
+	}
+	return cmp.Equal(desired.FileShares[0].NfsExportOptions, i.FileShares[0].NfsExportOptions, cmpopts.EquateEmpty(), ignoreForceSendFields)
+}
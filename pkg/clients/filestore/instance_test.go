@@ -0,0 +1,218 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filestore
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	file "google.golang.org/api/file/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-gcp/apis/filestore/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	project      = "cool-project"
+	instanceName = "projects/cool-project/locations/us-central1-a/instances/shared"
+)
+
+func instanceParams(m ...func(*v1alpha1.InstanceParameters)) *v1alpha1.InstanceParameters {
+	p := &v1alpha1.InstanceParameters{
+		Location:    "us-central1-a",
+		Tier:        "BASIC_HDD",
+		Description: gcp.StringPtr("Shared volume"),
+		FileShare: v1alpha1.FileShareConfig{
+			Name:       "vol1",
+			CapacityGB: 1024,
+			NFSExportOptions: []v1alpha1.NFSExportOptions{{
+				IPRanges:   []string{"10.0.0.0/24"},
+				AccessMode: gcp.StringPtr("READ_WRITE"),
+				SquashMode: gcp.StringPtr("NO_ROOT_SQUASH"),
+			}},
+		},
+		Networks: []v1alpha1.NetworkConfig{{
+			Network:         gcp.StringPtr("default"),
+			Modes:           []string{"MODE_IPV4"},
+			ReservedIPRange: gcp.StringPtr("10.0.1.0/29"),
+			ConnectMode:     gcp.StringPtr("DIRECT_PEERING"),
+		}},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func instance(m ...func(*file.Instance)) *file.Instance {
+	i := &file.Instance{
+		Name:        instanceName,
+		State:       v1alpha1.InstanceStateReady,
+		CreateTime:  "2021-06-01T00:00:00Z",
+		Etag:        "etag",
+		Tier:        "BASIC_HDD",
+		Description: "Shared volume",
+		FileShares: []*file.FileShareConfig{{
+			Name:       "vol1",
+			CapacityGb: 1024,
+			NfsExportOptions: []*file.NfsExportOptions{{
+				IpRanges:   []string{"10.0.0.0/24"},
+				AccessMode: "READ_WRITE",
+				SquashMode: "NO_ROOT_SQUASH",
+			}},
+		}},
+		Networks: []*file.NetworkConfig{{
+			Network:         "default",
+			Modes:           []string{"MODE_IPV4"},
+			ReservedIpRange: "10.0.1.0/29",
+			ConnectMode:     "DIRECT_PEERING",
+			IpAddresses:     []string{"10.0.1.2"},
+		}},
+	}
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+func TestInstanceNames(t *testing.T) {
+	parent := GetInstanceParent(project, *instanceParams())
+	if diff := cmp.Diff(instanceName, GetInstanceName(parent, "shared")); diff != "" {
+		t.Errorf("GetInstanceName(...): -want, +got:\n%s", diff)
+	}
+	parent = GetInstanceParent(project, *instanceParams(func(p *v1alpha1.InstanceParameters) { p.Project = gcp.StringPtr("other-project") }))
+	if diff := cmp.Diff("projects/other-project/locations/us-central1-a", parent); diff != "" {
+		t.Errorf("GetInstanceParent(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateInstance(t *testing.T) {
+	want := instance(func(i *file.Instance) {
+		i.Name = ""
+		i.State = ""
+		i.CreateTime = ""
+		i.Etag = ""
+		i.Networks[0].IpAddresses = nil
+	})
+	if diff := cmp.Diff(want, GenerateInstance(*instanceParams())); diff != "" {
+		t.Errorf("GenerateInstance(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateInstanceObservation(t *testing.T) {
+	want := v1alpha1.InstanceObservation{
+		Name:        instanceName,
+		State:       v1alpha1.InstanceStateReady,
+		CreateTime:  "2021-06-01T00:00:00Z",
+		Etag:        "etag",
+		CapacityGB:  1024,
+		IPAddresses: []string{"10.0.1.2"},
+	}
+	if diff := cmp.Diff(want, GenerateInstanceObservation(*instance())); diff != "" {
+		t.Errorf("GenerateInstanceObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetInstanceConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		o    v1alpha1.InstanceObservation
+		want managed.ConnectionDetails
+	}{
+		"NoIPAddress": {
+			o:    v1alpha1.InstanceObservation{},
+			want: managed.ConnectionDetails{},
+		},
+		"IPAddress": {
+			o: v1alpha1.InstanceObservation{IPAddresses: []string{"10.0.1.2"}},
+			want: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretEndpointKey: []byte("10.0.1.2"),
+				v1alpha1.InstanceSecretMountPathKey:       []byte("/vol1"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GetInstanceConnectionDetails(*instanceParams(), tc.o)); diff != "" {
+				t.Errorf("GetInstanceConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeInstance(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.InstanceParameters
+		want *v1alpha1.InstanceParameters
+	}{
+		"FillDefaults": {
+			p: instanceParams(func(p *v1alpha1.InstanceParameters) {
+				p.Description = nil
+				p.FileShare.NFSExportOptions[0].AccessMode = nil
+				p.Networks[0].Modes = nil
+				p.Networks[0].ConnectMode = nil
+			}),
+			want: instanceParams(),
+		},
+		"ObservedExportOptions": {
+			p: instanceParams(func(p *v1alpha1.InstanceParameters) {
+				p.FileShare.NFSExportOptions = nil
+			}),
+			want: instanceParams(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeInstance(tc.p, *instance())
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("LateInitializeInstance(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsInstanceUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.InstanceParameters
+		want bool
+	}{
+		"UpToDate": {
+			p:    instanceParams(),
+			want: true,
+		},
+		"CapacityDiffers": {
+			p: instanceParams(func(p *v1alpha1.InstanceParameters) { p.FileShare.CapacityGB = 2048 }),
+		},
+		"ExportOptionsDiffer": {
+			p: instanceParams(func(p *v1alpha1.InstanceParameters) {
+				p.FileShare.NFSExportOptions[0].AccessMode = gcp.StringPtr("READ_ONLY")
+			}),
+		},
+		"LabelsDiffer": {
+			p: instanceParams(func(p *v1alpha1.InstanceParameters) { p.Labels = map[string]string{"team": "platform"} }),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsInstanceUpToDate(*tc.p, *instance()); got != tc.want {
+				t.Errorf("IsInstanceUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firestore
+
+import (
+	"path"
+
+	firestore "google.golang.org/api/firestore/v1"
+
+	"github.com/crossplane/provider-gcp/apis/firestore/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// BackupScheduleUpdateMask is the set of BackupSchedule fields that can be
+// updated in place.
+const BackupScheduleUpdateMask = "retention"
+
+// GetBackupScheduleParent builds the fully qualified name of the database the
+// backup schedule belongs to.
+func GetBackupScheduleParent(project string, p v1alpha1.BackupScheduleParameters) string {
+	return GetDatabaseName(project, gcp.StringValue(p.Database))
+}
+
+// GetBackupScheduleName builds the fully qualified name of the backup
+// schedule.
+func GetBackupScheduleName(project string, p v1alpha1.BackupScheduleParameters, id string) string {
+	return GetBackupScheduleParent(project, p) + "/backupSchedules/" + id
+}
+
+// GetBackupScheduleID returns the server-assigned ID of the supplied backup
+// schedule.
+func GetBackupScheduleID(s firestore.GoogleFirestoreAdminV1BackupSchedule) string {
+	return path.Base(s.Name)
+}
+
+// GenerateBackupSchedule produces a BackupSchedule that is configured via
+// given BackupScheduleParameters.
+func GenerateBackupSchedule(p v1alpha1.BackupScheduleParameters) *firestore.GoogleFirestoreAdminV1BackupSchedule {
+	s := &firestore.GoogleFirestoreAdminV1BackupSchedule{
+		Retention: p.Retention,
+	}
+	if p.DailyRecurrence != nil {
+		s.DailyRecurrence = &firestore.GoogleFirestoreAdminV1DailyRecurrence{}
+	}
+	if p.WeeklyRecurrence != nil {
+		s.WeeklyRecurrence = &firestore.GoogleFirestoreAdminV1WeeklyRecurrence{Day: p.WeeklyRecurrence.Day}
+	}
+	return s
+}
+
+// GenerateBackupScheduleObservation produces a BackupScheduleObservation from
+// the supplied BackupSchedule.
+func GenerateBackupScheduleObservation(s firestore.GoogleFirestoreAdminV1BackupSchedule) v1alpha1.BackupScheduleObservation {
+	return v1alpha1.BackupScheduleObservation{
+		Name:       s.Name,
+		CreateTime: s.CreateTime,
+		UpdateTime: s.UpdateTime,
+	}
+}
+
+// IsBackupScheduleUpToDate returns true if the supplied BackupSchedule matches
+// the fields of the supplied BackupScheduleParameters that can be updated in
+// place.
+func IsBackupScheduleUpToDate(p v1alpha1.BackupScheduleParameters, s firestore.GoogleFirestoreAdminV1BackupSchedule) bool {
+	return p.Retention == s.Retention
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firestore
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	firestore "google.golang.org/api/firestore/v1"
+
+	"github.com/crossplane/provider-gcp/apis/firestore/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+func TestGenerateBackupSchedule(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.BackupScheduleParameters
+		want *firestore.GoogleFirestoreAdminV1BackupSchedule
+	}{
+		"Daily": {
+			p: v1alpha1.BackupScheduleParameters{
+				Database:        gcp.StringPtr(databaseID),
+				Retention:       "604800s",
+				DailyRecurrence: &v1alpha1.DailyRecurrence{},
+			},
+			want: &firestore.GoogleFirestoreAdminV1BackupSchedule{
+				Retention:       "604800s",
+				DailyRecurrence: &firestore.GoogleFirestoreAdminV1DailyRecurrence{},
+			},
+		},
+		"Weekly": {
+			p: v1alpha1.BackupScheduleParameters{
+				Database:         gcp.StringPtr(databaseID),
+				Retention:        "1209600s",
+				WeeklyRecurrence: &v1alpha1.WeeklyRecurrence{Day: "SUNDAY"},
+			},
+			want: &firestore.GoogleFirestoreAdminV1BackupSchedule{
+				Retention:        "1209600s",
+				WeeklyRecurrence: &firestore.GoogleFirestoreAdminV1WeeklyRecurrence{Day: "SUNDAY"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateBackupSchedule(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateBackupSchedule(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetBackupScheduleID(t *testing.T) {
+	s := firestore.GoogleFirestoreAdminV1BackupSchedule{Name: databaseName + "/backupSchedules/abc123"}
+	if diff := cmp.Diff("abc123", GetBackupScheduleID(s)); diff != "" {
+		t.Errorf("GetBackupScheduleID(...): -want, +got:\n%s", diff)
+	}
+	p := v1alpha1.BackupScheduleParameters{Database: gcp.StringPtr(databaseID)}
+	if diff := cmp.Diff(s.Name, GetBackupScheduleName(project, p, "abc123")); diff != "" {
+		t.Errorf("GetBackupScheduleName(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firestore
+
+import (
+	"fmt"
+
+	firestore "google.golang.org/api/firestore/v1"
+
+	"github.com/crossplane/provider-gcp/apis/firestore/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectNameFormat  = "projects/%s"
+	databaseNameFormat = "projects/%s/databases/%s"
+)
+
+// DatabaseUpdateMask is the set of Database fields that can be updated in
+// place.
+const DatabaseUpdateMask = "type,concurrency_mode,app_engine_integration_mode,point_in_time_recovery_enablement,delete_protection_state"
+
+// GetDatabaseParent builds the fully qualified name of the database parent.
+func GetDatabaseParent(project string) string {
+	return fmt.Sprintf(projectNameFormat, project)
+}
+
+// GetDatabaseName builds the fully qualified name of the database.
+func GetDatabaseName(project, name string) string {
+	return fmt.Sprintf(databaseNameFormat, project, name)
+}
+
+// GenerateDatabase produces a Database that is configured via given
+// DatabaseParameters.
+func GenerateDatabase(name string, p v1alpha1.DatabaseParameters) *firestore.GoogleFirestoreAdminV1Database {
+	return &firestore.GoogleFirestoreAdminV1Database{
+		Name:                          name,
+		LocationId:                    p.LocationID,
+		Type:                          p.Type,
+		ConcurrencyMode:               gcp.StringValue(p.ConcurrencyMode),
+		AppEngineIntegrationMode:      gcp.StringValue(p.AppEngineIntegrationMode),
+		PointInTimeRecoveryEnablement: gcp.StringValue(p.PointInTimeRecoveryEnablement),
+		DeleteProtectionState:         gcp.StringValue(p.DeleteProtectionState),
+	}
+}
+
+// GenerateDatabaseObservation produces a DatabaseObservation from the
+// supplied Database.
+func GenerateDatabaseObservation(d firestore.GoogleFirestoreAdminV1Database) v1alpha1.DatabaseObservation {
+	return v1alpha1.DatabaseObservation{
+		Name:                   d.Name,
+		UID:                    d.Uid,
+		CreateTime:             d.CreateTime,
+		UpdateTime:             d.UpdateTime,
+		KeyPrefix:              d.KeyPrefix,
+		VersionRetentionPeriod: d.VersionRetentionPeriod,
+		EarliestVersionTime:    d.EarliestVersionTime,
+		Etag:                   d.Etag,
+	}
+}
+
+// LateInitializeDatabase fills the empty fields of DatabaseParameters with
+// the values seen in the supplied Database.
+func LateInitializeDatabase(p *v1alpha1.DatabaseParameters, d firestore.GoogleFirestoreAdminV1Database) {
+	p.ConcurrencyMode = gcp.LateInitializeString(p.ConcurrencyMode, d.ConcurrencyMode)
+	p.AppEngineIntegrationMode = gcp.LateInitializeString(p.AppEngineIntegrationMode, d.AppEngineIntegrationMode)
+	p.PointInTimeRecoveryEnablement = gcp.LateInitializeString(p.PointInTimeRecoveryEnablement, d.PointInTimeRecoveryEnablement)
+	p.DeleteProtectionState = gcp.LateInitializeString(p.DeleteProtectionState, d.DeleteProtectionState)
+}
+
+// IsDatabaseUpToDate returns true if the supplied Database matches the fields
+// of the supplied DatabaseParameters that can be updated in place.
+func IsDatabaseUpToDate(p v1alpha1.DatabaseParameters, d firestore.GoogleFirestoreAdminV1Database) bool {
+	desired := GenerateDatabase(d.Name, p)
+	switch {
+	case desired.Type != d.Type,
+		desired.ConcurrencyMode != "" && desired.ConcurrencyMode != d.ConcurrencyMode,
+		desired.AppEngineIntegrationMode != "" && desired.AppEngineIntegrationMode != d.AppEngineIntegrationMode,
+		desired.PointInTimeRecoveryEnablement != "" && desired.PointInTimeRecoveryEnablement != d.PointInTimeRecoveryEnablement,
+		desired.DeleteProtectionState != "" && desired.DeleteProtectionState != d.DeleteProtectionState:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firestore
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	firestore "google.golang.org/api/firestore/v1"
+
+	"github.com/crossplane/provider-gcp/apis/firestore/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	project      = "my-project"
+	databaseID   = "my-db"
+	databaseName = "projects/my-project/databases/my-db"
+)
+
+func databaseParams(m ...func(*v1alpha1.DatabaseParameters)) *v1alpha1.DatabaseParameters {
+	p := &v1alpha1.DatabaseParameters{
+		LocationID:                    "nam5",
+		Type:                          v1alpha1.DatabaseTypeNative,
+		ConcurrencyMode:               gcp.StringPtr("PESSIMISTIC"),
+		AppEngineIntegrationMode:      gcp.StringPtr("DISABLED"),
+		PointInTimeRecoveryEnablement: gcp.StringPtr("POINT_IN_TIME_RECOVERY_ENABLED"),
+		DeleteProtectionState:         gcp.StringPtr("DELETE_PROTECTION_ENABLED"),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func database(m ...func(*firestore.GoogleFirestoreAdminV1Database)) *firestore.GoogleFirestoreAdminV1Database {
+	d := &firestore.GoogleFirestoreAdminV1Database{
+		Name:                          databaseName,
+		LocationId:                    "nam5",
+		Type:                          v1alpha1.DatabaseTypeNative,
+		ConcurrencyMode:               "PESSIMISTIC",
+		AppEngineIntegrationMode:      "DISABLED",
+		PointInTimeRecoveryEnablement: "POINT_IN_TIME_RECOVERY_ENABLED",
+		DeleteProtectionState:         "DELETE_PROTECTION_ENABLED",
+	}
+	for _, f := range m {
+		f(d)
+	}
+	return d
+}
+
+func TestGetDatabaseName(t *testing.T) {
+	if diff := cmp.Diff(databaseName, GetDatabaseName(project, databaseID)); diff != "" {
+		t.Errorf("GetDatabaseName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateDatabase(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.DatabaseParameters
+		want *firestore.GoogleFirestoreAdminV1Database
+	}{
+		"FullConversion": {
+			p:    *databaseParams(),
+			want: database(),
+		},
+		"MissingFields": {
+			p: *databaseParams(func(p *v1alpha1.DatabaseParameters) {
+				p.ConcurrencyMode = nil
+				p.DeleteProtectionState = nil
+			}),
+			want: database(func(d *firestore.GoogleFirestoreAdminV1Database) {
+				d.ConcurrencyMode = ""
+				d.DeleteProtectionState = ""
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateDatabase(databaseName, tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateDatabase(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeDatabase(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.DatabaseParameters
+		d    firestore.GoogleFirestoreAdminV1Database
+		want *v1alpha1.DatabaseParameters
+	}{
+		"AllFilled": {
+			p: databaseParams(),
+			d: *database(func(d *firestore.GoogleFirestoreAdminV1Database) {
+				d.ConcurrencyMode = "OPTIMISTIC"
+			}),
+			want: databaseParams(),
+		},
+		"AllEmpty": {
+			p:    &v1alpha1.DatabaseParameters{LocationID: "nam5", Type: v1alpha1.DatabaseTypeNative},
+			d:    *database(),
+			want: databaseParams(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeDatabase(tc.p, tc.d)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("LateInitializeDatabase(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsDatabaseUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.DatabaseParameters
+		d    firestore.GoogleFirestoreAdminV1Database
+		want bool
+	}{
+		"UpToDate": {
+			p: *databaseParams(),
+			d: *database(func(d *firestore.GoogleFirestoreAdminV1Database) {
+				d.Uid = "some-uid"
+			}),
+			want: true,
+		},
+		"PITRDiffers": {
+			p: *databaseParams(),
+			d: *database(func(d *firestore.GoogleFirestoreAdminV1Database) {
+				d.PointInTimeRecoveryEnablement = "POINT_IN_TIME_RECOVERY_DISABLED"
+			}),
+			want: false,
+		},
+		"TypeDiffers": {
+			p: *databaseParams(),
+			d: *database(func(d *firestore.GoogleFirestoreAdminV1Database) {
+				d.Type = v1alpha1.DatabaseTypeDatastore
+			}),
+			want: false,
+		},
+		"OptionalFieldUnset": {
+			p: *databaseParams(func(p *v1alpha1.DatabaseParameters) {
+				p.DeleteProtectionState = nil
+			}),
+			d:    *database(),
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsDatabaseUpToDate(tc.p, tc.d)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsDatabaseUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
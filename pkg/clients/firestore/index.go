@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firestore
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+
+	firestore "google.golang.org/api/firestore/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane/provider-gcp/apis/firestore/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	indexParentFormat = "projects/%s/databases/%s/collectionGroups/%s"
+
+	errNoIndexName = "operation metadata does not contain an index name"
+)
+
+// GetIndexParent builds the fully qualified name of the collection group the
+// index belongs to.
+func GetIndexParent(project string, p v1alpha1.IndexParameters) string {
+	return fmt.Sprintf(indexParentFormat, project, gcp.StringValue(p.Database), p.CollectionGroup)
+}
+
+// GetIndexName builds the fully qualified name of the index.
+func GetIndexName(project string, p v1alpha1.IndexParameters, id string) string {
+	return GetIndexParent(project, p) + "/indexes/" + id
+}
+
+// GenerateIndex produces an Index that is configured via given
+// IndexParameters.
+func GenerateIndex(p v1alpha1.IndexParameters) *firestore.GoogleFirestoreAdminV1Index {
+	i := &firestore.GoogleFirestoreAdminV1Index{
+		QueryScope: p.QueryScope,
+		ApiScope:   gcp.StringValue(p.APIScope),
+		Fields:     make([]*firestore.GoogleFirestoreAdminV1IndexField, len(p.Fields)),
+	}
+	for j, f := range p.Fields {
+		i.Fields[j] = &firestore.GoogleFirestoreAdminV1IndexField{
+			FieldPath:   f.FieldPath,
+			Order:       gcp.StringValue(f.Order),
+			ArrayConfig: gcp.StringValue(f.ArrayConfig),
+		}
+	}
+	return i
+}
+
+// GenerateIndexObservation produces an IndexObservation from the supplied
+// Index.
+func GenerateIndexObservation(i firestore.GoogleFirestoreAdminV1Index) v1alpha1.IndexObservation {
+	return v1alpha1.IndexObservation{
+		Name:  i.Name,
+		State: i.State,
+	}
+}
+
+// LateInitializeIndex fills the empty fields of IndexParameters with the
+// values seen in the supplied Index.
+func LateInitializeIndex(p *v1alpha1.IndexParameters, i firestore.GoogleFirestoreAdminV1Index) {
+	p.APIScope = gcp.LateInitializeString(p.APIScope, i.ApiScope)
+}
+
+// GetIndexID returns the server-assigned ID of the index that is being
+// created by the supplied operation.
+func GetIndexID(op firestore.GoogleLongrunningOperation) (string, error) {
+	md := &firestore.GoogleFirestoreAdminV1IndexOperationMetadata{}
+	if len(op.Metadata) != 0 {
+		if err := json.Unmarshal(op.Metadata, md); err != nil {
+			return "", err
+		}
+	}
+	if md.Index == "" {
+		return "", errors.New(errNoIndexName)
+	}
+	return path.Base(md.Index), nil
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firestore
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	firestore "google.golang.org/api/firestore/v1"
+	"google.golang.org/api/googleapi"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/firestore/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+func indexParams() *v1alpha1.IndexParameters {
+	return &v1alpha1.IndexParameters{
+		Database:        gcp.StringPtr(databaseID),
+		CollectionGroup: "users",
+		QueryScope:      "COLLECTION",
+		Fields: []v1alpha1.IndexField{
+			{FieldPath: "team", Order: gcp.StringPtr("ASCENDING")},
+			{FieldPath: "tags", ArrayConfig: gcp.StringPtr("CONTAINS")},
+		},
+	}
+}
+
+func TestGetIndexName(t *testing.T) {
+	want := "projects/my-project/databases/my-db/collectionGroups/users/indexes/CICAgJim14AK"
+	if diff := cmp.Diff(want, GetIndexName(project, *indexParams(), "CICAgJim14AK")); diff != "" {
+		t.Errorf("GetIndexName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateIndex(t *testing.T) {
+	want := &firestore.GoogleFirestoreAdminV1Index{
+		QueryScope: "COLLECTION",
+		Fields: []*firestore.GoogleFirestoreAdminV1IndexField{
+			{FieldPath: "team", Order: "ASCENDING"},
+			{FieldPath: "tags", ArrayConfig: "CONTAINS"},
+		},
+	}
+	if diff := cmp.Diff(want, GenerateIndex(*indexParams())); diff != "" {
+		t.Errorf("GenerateIndex(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetIndexID(t *testing.T) {
+	type want struct {
+		id  string
+		err error
+	}
+	cases := map[string]struct {
+		op   firestore.GoogleLongrunningOperation
+		want want
+	}{
+		"Success": {
+			op: firestore.GoogleLongrunningOperation{
+				Metadata: googleapi.RawMessage(`{"index":"projects/p/databases/d/collectionGroups/users/indexes/CICAgJim14AK"}`),
+			},
+			want: want{id: "CICAgJim14AK"},
+		},
+		"NoMetadata": {
+			op:   firestore.GoogleLongrunningOperation{},
+			want: want{err: errors.New(errNoIndexName)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			id, err := GetIndexID(tc.op)
+			if diff := cmp.Diff(tc.want.id, id); diff != "" {
+				t.Errorf("GetIndexID(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GetIndexID(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
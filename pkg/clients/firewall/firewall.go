@@ -138,3 +138,18 @@ func IsUpToDate(name string, in *v1alpha1.FirewallParameters, observed *compute.
 	GenerateFirewall(name, *in, desired)
 	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(), gcp.EquateComputeURLs(), cmpopts.IgnoreFields(compute.Firewall{}, "ForceSendFields")), nil
 }
+
+// Diff returns the paths of the fields of the observed Firewall that differ from
+// the given set of parameters, e.g. to explain why it is not up-to-date.
+func Diff(name string, in *v1alpha1.FirewallParameters, observed *compute.Firewall) ([]string, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return nil, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*compute.Firewall)
+	if !ok {
+		return nil, errors.New(errCheckUpToDate)
+	}
+	GenerateFirewall(name, *in, desired)
+	return gcp.Diff(desired, observed, cmpopts.EquateEmpty(), gcp.EquateComputeURLs()), nil
+}
@@ -18,19 +18,27 @@ package gcp
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"net/http"
+	"net/url"
 	"path"
 	"strings"
 
+	"cloud.google.com/go/storage"
 	"github.com/google/go-cmp/cmp"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
 	"google.golang.org/api/googleapi"
 	"google.golang.org/api/option"
+	htransport "google.golang.org/api/transport/http"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
@@ -39,51 +47,258 @@ import (
 	"github.com/crossplane/provider-gcp/apis/v1beta1"
 )
 
+const cloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+
+// ClientOptions are the options to use when constructing GCP API clients.
+type ClientOptions struct {
+	// Credentials used to authenticate to GCP. Ignored if HTTPClient is set.
+	Credentials option.ClientOption
+
+	// HTTPClient that authenticates its requests itself. Requests that fail
+	// with a retryable error, e.g. 429, are retried when it's used.
+	HTTPClient *http.Client
+
+	// RateLimiters of the GCP services, if any. They require HTTPClient.
+	RateLimiters *RateLimiters
+
+	// Endpoints overrides the endpoints of some GCP services, keyed by the
+	// name of the service, e.g. compute.
+	Endpoints map[string]string
+
+	// QuotaProject that is billed for API calls, if any.
+	QuotaProject string
+}
+
+// For returns the options to use when constructing a client of the supplied
+// GCP service, e.g. compute. The supplied defaults, e.g. a regional
+// endpoint, are applied before the endpoint override of the service, if any.
+func (o ClientOptions) For(service string, defaults ...option.ClientOption) []option.ClientOption {
+	creds := o.Credentials
+	if hc := o.HTTPClient; hc != nil {
+		t := hc.Transport
+		if apiLog != nil {
+			t = &loggingTransport{service: service, log: apiLog, base: t}
+		}
+		t = &instrumentedTransport{service: service, base: t}
+		if l := o.RateLimiters.For(service); l != nil {
+			t = &rateLimitedTransport{limiter: l, base: t}
+		}
+		// Retries wait for the rate limiter, too, and are traced one by one.
+		t = &tracingTransport{service: service, base: t}
+		creds = option.WithHTTPClient(&http.Client{Transport: newRetryTransport(t)})
+	}
+	opts := append([]option.ClientOption{creds}, defaults...)
+	if o.QuotaProject != "" {
+		opts = append(opts, option.WithQuotaProject(o.QuotaProject))
+	}
+	if ep, ok := o.Endpoints[service]; ok {
+		opts = append(opts, option.WithEndpoint(ep))
+	}
+	return opts
+}
+
 // GetAuthInfo returns the necessary authentication information that is necessary
 // to use when the controller connects to GCP API in order to reconcile the managed
 // resource.
-func GetAuthInfo(ctx context.Context, c client.Client, mg resource.Managed) (projectID string, opts option.ClientOption, err error) {
+func GetAuthInfo(ctx context.Context, c client.Client, mg resource.Managed) (projectID string, opts ClientOptions, err error) {
 	switch {
 	case mg.GetProviderConfigReference() != nil:
 		return UseProviderConfig(ctx, c, mg)
 	case mg.GetProviderReference() != nil:
 		return UseProvider(ctx, c, mg)
 	default:
-		return "", nil, errors.New("neither providerConfigRef nor providerRef is given")
+		return "", ClientOptions{}, errors.New("neither providerConfigRef nor providerRef is given")
 	}
 }
 
 // UseProvider to return GCP authentication information.
 // Deprecated: Use UseProviderConfig
-func UseProvider(ctx context.Context, c client.Client, mg resource.Managed) (projectID string, opts option.ClientOption, err error) {
+func UseProvider(ctx context.Context, c client.Client, mg resource.Managed) (projectID string, opts ClientOptions, err error) {
 	p := &v1alpha3.Provider{}
 	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderReference().Name}, p); err != nil {
-		return "", nil, err
+		return "", ClientOptions{}, err
 	}
 
 	ref := p.Spec.CredentialsSecretRef
 	s := &v1.Secret{}
 	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
-		return "", nil, err
+		return "", ClientOptions{}, err
 	}
-	return p.Spec.ProjectID, option.WithCredentialsJSON(s.Data[ref.Key]), nil
+	return p.Spec.ProjectID, ClientOptions{Credentials: option.WithCredentialsJSON(s.Data[ref.Key])}, nil
 }
 
-// UseProviderConfig to return GCP authentication information.
-func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed) (projectID string, opts option.ClientOption, err error) {
+// UseProviderConfig to return GCP authentication information. The usage of
+// the ProviderConfig by the supplied managed resource is tracked by the
+// reconciler returned by NewReconciler, not here.
+func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed) (projectID string, opts ClientOptions, err error) {
 	pc := &v1beta1.ProviderConfig{}
-	t := resource.NewProviderConfigUsageTracker(c, &v1beta1.ProviderConfigUsage{})
-	if err := t.Track(ctx, mg); err != nil {
-		return "", nil, err
-	}
 	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
-		return "", nil, err
+		return "", ClientOptions{}, err
 	}
-	data, err := resource.CommonCredentialExtractor(ctx, pc.Spec.Credentials.Source, c, pc.Spec.Credentials.CommonCredentialSelectors)
+	return useProviderConfig(ctx, c, pc)
+}
+
+// UseProviderConfigNamed returns the GCP authentication information of the
+// named ProviderConfig. It is meant for controllers of resources that aren't
+// managed resources, and thus don't track their usage of the ProviderConfig.
+func UseProviderConfigNamed(ctx context.Context, c client.Client, name string) (projectID string, opts ClientOptions, err error) {
+	pc := &v1beta1.ProviderConfig{}
+	if err := c.Get(ctx, types.NamespacedName{Name: name}, pc); err != nil {
+		return "", ClientOptions{}, err
+	}
+	return useProviderConfig(ctx, c, pc)
+}
+
+func useProviderConfig(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig) (projectID string, opts ClientOptions, err error) {
+	return providerConfigClients.get(ctx, c, pc, func() (string, ClientOptions, error) {
+		return newClientOptions(ctx, c, pc)
+	})
+}
+
+// newClientOptions returns the project ID and client options configured by
+// the supplied ProviderConfig. They are cached, so credentials must not use
+// the supplied context beyond this call.
+func newClientOptions(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig) (string, ClientOptions, error) {
+	creds, err := getCredentials(ctx, c, pc.Spec.Credentials)
 	if err != nil {
-		return "", nil, errors.Wrap(err, "cannot get credentials")
+		return "", ClientOptions{}, err
 	}
-	return pc.Spec.ProjectID, option.WithCredentialsJSON(data), nil
+	o := ClientOptions{
+		Credentials:  creds,
+		Endpoints:    getEndpoints(StringValue(pc.Spec.Region), pc.Spec.Endpoints),
+		QuotaProject: StringValue(pc.Spec.QuotaProject),
+		RateLimiters: getRateLimiters(pc.GetName(), pc.Spec.RateLimit),
+	}
+	hopts := []option.ClientOption{creds}
+	if o.QuotaProject != "" {
+		hopts = append(hopts, option.WithQuotaProject(o.QuotaProject))
+	}
+	if o.HTTPClient, err = getHTTPClient(ctx, c, pc.Spec, hopts...); err != nil {
+		return "", ClientOptions{}, err
+	}
+	return pc.Spec.ProjectID, o, nil
+}
+
+// getHTTPClient returns an HTTP client that uses the proxy and trusts the CA
+// bundle of the supplied ProviderConfigSpec, if any, and authenticates its
+// requests according to the supplied options. GCP clients ignore credentials
+// and the quota project when they are given an HTTP client, so the client has
+// to take care of them.
+func getHTTPClient(ctx context.Context, c client.Client, spec v1beta1.ProviderConfigSpec, opts ...option.ClientOption) (*http.Client, error) {
+	// Share the default transport, and thus its connections, unless it has
+	// to be customized.
+	base := http.DefaultTransport
+	if spec.Proxy != nil || spec.CABundleSecretRef != nil {
+		var err error
+		if base, err = getTransport(ctx, c, spec); err != nil {
+			return nil, err
+		}
+	}
+	// The transport outlives this reconcile, so its token source must not
+	// use its context.
+	t, err := htransport.NewTransport(context.Background(), base, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create HTTP transport")
+	}
+	return &http.Client{Transport: t}, nil
+}
+
+// getTransport returns a transport that uses the proxy and trusts the CA
+// bundle of the supplied ProviderConfigSpec, if any.
+func getTransport(ctx context.Context, c client.Client, spec v1beta1.ProviderConfigSpec) (*http.Transport, error) {
+	base := http.DefaultTransport.(*http.Transport).Clone()
+	if p := spec.Proxy; p != nil {
+		u, err := url.Parse(p.URL)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot parse proxy URL")
+		}
+		if p.CredentialsSecretRef != nil {
+			data, err := resource.ExtractSecret(ctx, c, xpv1.CommonCredentialSelectors{SecretRef: p.CredentialsSecretRef})
+			if err != nil {
+				return nil, errors.Wrap(err, "cannot get proxy credentials")
+			}
+			userPassword := strings.SplitN(strings.TrimSpace(string(data)), ":", 2)
+			if len(userPassword) != 2 {
+				return nil, errors.New("proxy credentials are not in the form username:password")
+			}
+			u.User = url.UserPassword(userPassword[0], userPassword[1])
+		}
+		base.Proxy = http.ProxyURL(u)
+	}
+	if spec.CABundleSecretRef != nil {
+		data, err := resource.ExtractSecret(ctx, c, xpv1.CommonCredentialSelectors{SecretRef: spec.CABundleSecretRef})
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot get CA bundle")
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, errors.New("CA bundle does not contain any PEM encoded certificates")
+		}
+		base.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+	return base, nil
+}
+
+func getCredentials(ctx context.Context, c client.Client, pc v1beta1.ProviderCredentials) (option.ClientOption, error) {
+	switch pc.Source {
+	case xpv1.CredentialsSourceInjectedIdentity:
+		creds, err := google.FindDefaultCredentials(context.Background(), getScopes(pc.Scopes)...)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot find default credentials")
+		}
+		return option.WithCredentials(creds), nil
+	case v1beta1.CredentialsSourceFederation:
+		data, err := ExternalAccountJSON(pc.Federation)
+		if err != nil {
+			return nil, err
+		}
+		creds, err := google.CredentialsFromJSON(context.Background(), data, getScopes(pc.Scopes)...)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot configure federated credentials")
+		}
+		return option.WithCredentials(creds), nil
+	case xpv1.CredentialsSourceSecret:
+		creds, err := secretCredentials.get(ctx, c, pc.SecretRef, getScopes(pc.Scopes))
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot get credentials")
+		}
+		return option.WithCredentials(creds), nil
+	case v1beta1.CredentialsSourceAccessToken:
+		// The client options are rebuilt whenever the Secret changes, so a
+		// rotated token is picked up without a restart.
+		data, err := resource.CommonCredentialExtractor(ctx, xpv1.CredentialsSourceSecret, c, pc.CommonCredentialSelectors)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot get access token")
+		}
+		return option.WithTokenSource(AccessTokenSource(data)), nil
+	}
+	data, err := resource.CommonCredentialExtractor(ctx, pc.Source, c, pc.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get credentials")
+	}
+	return option.WithCredentialsJSON(data), nil
+}
+
+// AccessTokenSource returns a TokenSource that always returns the supplied
+// OAuth access token. Surrounding whitespace, e.g. a trailing newline, is
+// ignored.
+func AccessTokenSource(token []byte) oauth2.TokenSource {
+	return oauth2.StaticTokenSource(&oauth2.Token{
+		AccessToken: strings.TrimSpace(string(token)),
+		TokenType:   "Bearer",
+	})
+}
+
+// getScopes returns the supplied scopes, falling back to the cloud-platform
+// scope, which covers all the APIs used by this provider.
+func getScopes(scopes []string) []string {
+	if len(scopes) == 0 {
+		return []string{cloudPlatformScope}
+	}
+	return scopes
 }
 
 // IsErrorNotFoundGRPC gets a value indicating whether the given error represents
@@ -97,13 +312,23 @@ func IsErrorNotFoundGRPC(err error) bool {
 	return ok && grpcErr.GRPCStatus().Code() == codes.NotFound
 }
 
-// IsErrorNotFound gets a value indicating whether the given error represents a "not found" response from the Google API
+// IsErrorNotFound gets a value indicating whether the given error represents a
+// "not found" response from the Google API. It unwraps the supplied error, and
+// recognizes the errors of the HTTP and gRPC clients as well as those the Cloud
+// Storage client returns instead of a "not found" response.
 func IsErrorNotFound(err error) bool {
 	if err == nil {
 		return false
 	}
-	googleapiErr, ok := err.(*googleapi.Error)
-	return ok && googleapiErr.Code == http.StatusNotFound
+	if errors.Is(err, storage.ErrBucketNotExist) || errors.Is(err, storage.ErrObjectNotExist) {
+		return true
+	}
+	var googleapiErr *googleapi.Error
+	if errors.As(err, &googleapiErr) {
+		return googleapiErr.Code == http.StatusNotFound
+	}
+	s, ok := status.FromError(errors.Cause(err))
+	return ok && s.Code() == codes.NotFound
 }
 
 // IsErrorAlreadyExists gets a value indicating whether the given error
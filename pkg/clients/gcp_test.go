@@ -0,0 +1,224 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"cloud.google.com/go/storage"
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/oauth2"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/v1beta1"
+)
+
+func TestClientOptionsFor(t *testing.T) {
+	creds := option.WithAPIKey("key")
+	regional := option.WithEndpoint("https://us-central1-aiplatform.googleapis.com/")
+	psc := option.WithEndpoint("https://aiplatform-psc.p.googleapis.com/")
+	o := ClientOptions{
+		Credentials: creds,
+		Endpoints:   map[string]string{"aiplatform": "https://aiplatform-psc.p.googleapis.com/"},
+	}
+
+	cases := map[string]struct {
+		reason       string
+		service      string
+		quotaProject string
+		defaults     []option.ClientOption
+		want         []option.ClientOption
+	}{
+		"NoOverride": {
+			reason:  "Should only return the credentials if the endpoint of the service is not overridden",
+			service: "compute",
+			want:    []option.ClientOption{creds},
+		},
+		"Override": {
+			reason:  "Should return the endpoint override of the service",
+			service: "aiplatform",
+			want:    []option.ClientOption{creds, psc},
+		},
+		"OverrideDefaults": {
+			reason:   "Should apply the endpoint override after the supplied defaults",
+			service:  "aiplatform",
+			defaults: []option.ClientOption{regional},
+			want:     []option.ClientOption{creds, regional, psc},
+		},
+		"QuotaProject": {
+			reason:       "Should return the quota project",
+			service:      "serviceusage",
+			quotaProject: "billing-project",
+			want:         []option.ClientOption{creds, option.WithQuotaProject("billing-project")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o.QuotaProject = tc.quotaProject
+			got := o.For(tc.service, tc.defaults...)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nFor(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+// secrets returns a client that serves Secrets with the supplied data, keyed by
+// Secret name.
+func secrets(data map[string][]byte) client.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			s := obj.(*corev1.Secret)
+			s.Data = map[string][]byte{"key": data[key.Name]}
+			return nil
+		},
+	}
+}
+
+func secretRef(name string) *xpv1.SecretKeySelector {
+	return &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Name: name, Namespace: "crossplane-system"},
+		Key:             "key",
+	}
+}
+
+func TestGetHTTPClientProxy(t *testing.T) {
+	type request struct {
+		url           string
+		proxyAuth     string
+		authorization string
+		quotaProject  string
+	}
+	got := request{}
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = request{
+			url:           r.URL.String(),
+			proxyAuth:     r.Header.Get("Proxy-Authorization"),
+			authorization: r.Header.Get("Authorization"),
+			quotaProject:  r.Header.Get("X-Goog-User-Project"),
+		}
+	}))
+	defer proxy.Close()
+
+	spec := v1beta1.ProviderConfigSpec{
+		Proxy: &v1beta1.ProxyConfig{URL: proxy.URL, CredentialsSecretRef: secretRef("proxy")},
+	}
+	kube := secrets(map[string][]byte{"proxy": []byte("user:secret\n")})
+	creds := option.WithTokenSource(oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "token"}))
+	hc, err := getHTTPClient(context.Background(), kube, spec, creds, option.WithQuotaProject("billing-project"))
+	if err != nil {
+		t.Fatalf("getHTTPClient(...): %s", err)
+	}
+	rsp, err := hc.Get("http://compute.googleapis.com/compute/v1/projects/example")
+	if err != nil {
+		t.Fatalf("Get(...): %s", err)
+	}
+	_ = rsp.Body.Close()
+
+	want := request{
+		url:           "http://compute.googleapis.com/compute/v1/projects/example",
+		proxyAuth:     "Basic dXNlcjpzZWNyZXQ=",
+		authorization: "Bearer token",
+		quotaProject:  "billing-project",
+	}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(request{})); diff != "" {
+		t.Errorf("proxied request: -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetHTTPClientCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	cases := map[string]struct {
+		reason  string
+		bundle  []byte
+		wantErr bool
+	}{
+		"Trusted": {
+			reason: "Should trust the certificates of the CA bundle",
+			bundle: ca,
+		},
+		"NoCertificates": {
+			reason:  "Should return an error if the CA bundle does not contain any certificates",
+			bundle:  []byte("not a certificate"),
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			spec := v1beta1.ProviderConfigSpec{CABundleSecretRef: secretRef("ca")}
+			kube := secrets(map[string][]byte{"ca": tc.bundle})
+			hc, err := getHTTPClient(context.Background(), kube, spec, option.WithoutAuthentication())
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("\n%s\ngetHTTPClient(...): want error, got nil", tc.reason)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("\n%s\ngetHTTPClient(...): %s", tc.reason, err)
+			}
+			rsp, err := hc.Get(server.URL)
+			if err != nil {
+				t.Fatalf("\n%s\nGet(...): %s", tc.reason, err)
+			}
+			_ = rsp.Body.Close()
+		})
+	}
+}
+
+func TestIsErrorNotFound(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"Nil":            {err: nil, want: false},
+		"Other":          {err: errors.New("boom"), want: false},
+		"Forbidden":      {err: &googleapi.Error{Code: http.StatusForbidden}, want: false},
+		"NotFound":       {err: &googleapi.Error{Code: http.StatusNotFound}, want: true},
+		"WrappedHTTP":    {err: errors.Wrap(&googleapi.Error{Code: http.StatusNotFound}, "cannot get"), want: true},
+		"GRPC":           {err: status.Error(codes.NotFound, "gone"), want: true},
+		"WrappedGRPC":    {err: errors.Wrap(status.Error(codes.NotFound, "gone"), "cannot get"), want: true},
+		"OtherGRPC":      {err: status.Error(codes.PermissionDenied, "denied"), want: false},
+		"BucketNotExist": {err: storage.ErrBucketNotExist, want: true},
+		"ObjectNotExist": {err: errors.Wrap(storage.ErrObjectNotExist, "cannot read"), want: true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsErrorNotFound(tc.err); got != tc.want {
+				t.Errorf("IsErrorNotFound(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -36,6 +36,7 @@ func GenerateGlobalAddress(name string, in v1beta1.GlobalAddressParameters, addr
 	address.AddressType = gcp.StringValue(in.AddressType)
 	address.Description = gcp.StringValue(in.Description)
 	address.IpVersion = gcp.StringValue(in.IPVersion)
+	address.Ipv6EndpointType = gcp.StringValue(in.IPv6EndpointType)
 	address.Name = name
 	address.Network = gcp.StringValue(in.Network)
 	address.PrefixLength = gcp.Int64Value(in.PrefixLength)
@@ -51,6 +52,7 @@ func LateInitializeSpec(p *v1beta1.GlobalAddressParameters, observed compute.Add
 	p.AddressType = gcp.LateInitializeString(p.AddressType, observed.AddressType)
 	p.Description = gcp.LateInitializeString(p.Description, observed.Description)
 	p.IPVersion = gcp.LateInitializeString(p.IPVersion, observed.IpVersion)
+	p.IPv6EndpointType = gcp.LateInitializeString(p.IPv6EndpointType, observed.Ipv6EndpointType)
 	p.Network = gcp.LateInitializeString(p.Network, observed.Network)
 	p.PrefixLength = gcp.LateInitializeInt64(p.PrefixLength, observed.PrefixLength)
 	p.Purpose = gcp.LateInitializeString(p.Purpose, observed.Purpose)
@@ -63,6 +65,7 @@ func GenerateGlobalAddressObservation(observed compute.Address) v1beta1.GlobalAd
 	return v1beta1.GlobalAddressObservation{
 		CreationTimestamp: observed.CreationTimestamp,
 		ID:                observed.Id,
+		LabelFingerprint:  observed.LabelFingerprint,
 		SelfLink:          observed.SelfLink,
 		Status:            observed.Status,
 		Users:             observed.Users,
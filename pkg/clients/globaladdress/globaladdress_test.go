@@ -26,32 +26,35 @@ import (
 )
 
 var (
-	name               = "coolName"
-	description        = "coolDescription"
-	addressIP          = "coolAddress"
-	addressType        = "coolType"
-	ipVersion          = "coolVersion"
-	network            = "coolNetwork"
-	purpose            = "beingCool"
-	subnetwork         = "coolSubnet"
-	prefixLength int64 = 3001
-
-	timestamp        = "coolTime"
-	link             = "coolLink"
-	users            = []string{"coolUser", "coolerUser"}
-	id        uint64 = 3001
+	name                   = "coolName"
+	description            = "coolDescription"
+	addressIP              = "coolAddress"
+	addressType            = "coolType"
+	ipVersion              = "coolVersion"
+	ipv6EndpointType       = "coolEndpoint"
+	network                = "coolNetwork"
+	purpose                = "beingCool"
+	subnetwork             = "coolSubnet"
+	prefixLength     int64 = 3001
+
+	timestamp          = "coolTime"
+	link               = "coolLink"
+	fingerprint        = "coolFingerprint"
+	users              = []string{"coolUser", "coolerUser"}
+	id          uint64 = 3001
 )
 
 func params(m ...func(*v1beta1.GlobalAddressParameters)) *v1beta1.GlobalAddressParameters {
 	o := &v1beta1.GlobalAddressParameters{
-		Address:      &addressIP,
-		AddressType:  &addressType,
-		Description:  &description,
-		IPVersion:    &ipVersion,
-		Network:      &network,
-		PrefixLength: &prefixLength,
-		Purpose:      &purpose,
-		Subnetwork:   &subnetwork,
+		Address:          &addressIP,
+		AddressType:      &addressType,
+		Description:      &description,
+		IPVersion:        &ipVersion,
+		IPv6EndpointType: &ipv6EndpointType,
+		Network:          &network,
+		PrefixLength:     &prefixLength,
+		Purpose:          &purpose,
+		Subnetwork:       &subnetwork,
 	}
 
 	for _, f := range m {
@@ -63,15 +66,16 @@ func params(m ...func(*v1beta1.GlobalAddressParameters)) *v1beta1.GlobalAddressP
 
 func address(m ...func(*compute.Address)) *compute.Address {
 	o := &compute.Address{
-		Address:      addressIP,
-		AddressType:  addressType,
-		Description:  description,
-		IpVersion:    ipVersion,
-		Name:         name,
-		Network:      network,
-		PrefixLength: prefixLength,
-		Purpose:      purpose,
-		Subnetwork:   subnetwork,
+		Address:          addressIP,
+		AddressType:      addressType,
+		Description:      description,
+		IpVersion:        ipVersion,
+		Ipv6EndpointType: ipv6EndpointType,
+		Name:             name,
+		Network:          network,
+		PrefixLength:     prefixLength,
+		Purpose:          purpose,
+		Subnetwork:       subnetwork,
 	}
 
 	for _, f := range m {
@@ -85,6 +89,7 @@ func addOutputFields(n *compute.Address) {
 	n.Status = v1beta1.StatusReserving
 	n.CreationTimestamp = timestamp
 	n.Id = id
+	n.LabelFingerprint = fingerprint
 	n.SelfLink = link
 	n.Users = users
 
@@ -95,6 +100,7 @@ func observation(m ...func(*v1beta1.GlobalAddressObservation)) *v1beta1.GlobalAd
 		Status:            v1beta1.StatusReserving,
 		CreationTimestamp: timestamp,
 		ID:                id,
+		LabelFingerprint:  fingerprint,
 		SelfLink:          link,
 		Users:             users,
 	}
@@ -211,6 +217,15 @@ func TestLateInitializeSpec(t *testing.T) {
 				p.AddressType = &addressType
 			}),
 		},
+		"PartialFilledIPv6EndpointType": {
+			args: args{
+				spec: params(func(p *v1beta1.GlobalAddressParameters) {
+					p.IPv6EndpointType = nil
+				}),
+				in: *address(),
+			},
+			want: params(),
+		},
 	}
 
 	for name, tc := range cases {
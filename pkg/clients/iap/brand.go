@@ -0,0 +1,68 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iap
+
+import (
+	"fmt"
+	"path"
+
+	iap "google.golang.org/api/iap/v1"
+
+	"github.com/crossplane/provider-gcp/apis/iap/v1alpha1"
+)
+
+const parentFormat = "projects/%s"
+
+// GetBrandParent returns the project of the supplied BrandParameters in the
+// form projects/{project}, falling back to the supplied default project.
+func GetBrandParent(defaultProject string, p v1alpha1.BrandParameters) string {
+	project := defaultProject
+	if p.Project != nil {
+		project = *p.Project
+	}
+	return fmt.Sprintf(parentFormat, project)
+}
+
+// GetBrandName builds the fully qualified name of the brand with the
+// supplied ID in the supplied parent.
+func GetBrandName(parent, id string) string {
+	return parent + "/brands/" + id
+}
+
+// GetBrandID returns the ID of the brand with the supplied fully qualified
+// name. Identity-Aware Proxy uses the project number as brand ID.
+func GetBrandID(name string) string {
+	return path.Base(name)
+}
+
+// GenerateBrand produces a Brand that is configured via the supplied
+// BrandParameters.
+func GenerateBrand(p v1alpha1.BrandParameters) *iap.Brand {
+	return &iap.Brand{
+		SupportEmail:     p.SupportEmail,
+		ApplicationTitle: p.ApplicationTitle,
+	}
+}
+
+// GenerateBrandObservation produces a BrandObservation from the supplied
+// Brand.
+func GenerateBrandObservation(b iap.Brand) v1alpha1.BrandObservation {
+	return v1alpha1.BrandObservation{
+		Name:            b.Name,
+		OrgInternalOnly: b.OrgInternalOnly,
+	}
+}
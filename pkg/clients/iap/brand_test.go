@@ -0,0 +1,81 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iap
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	iap "google.golang.org/api/iap/v1"
+
+	"github.com/crossplane/provider-gcp/apis/iap/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	project   = "cool-project"
+	brandID   = "123456789012"
+	brandName = "projects/cool-project/brands/" + brandID
+)
+
+func brandParams() *v1alpha1.BrandParameters {
+	return &v1alpha1.BrandParameters{
+		SupportEmail:     "support@example.com",
+		ApplicationTitle: "Internal apps",
+	}
+}
+
+func TestBrandNames(t *testing.T) {
+	parent := GetBrandParent(project, *brandParams())
+	if diff := cmp.Diff(brandName, GetBrandName(parent, brandID)); diff != "" {
+		t.Errorf("GetBrandName(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(brandID, GetBrandID(brandName)); diff != "" {
+		t.Errorf("GetBrandID(...): -want, +got:\n%s", diff)
+	}
+	p := brandParams()
+	p.Project = gcp.StringPtr("other-project")
+	if diff := cmp.Diff("projects/other-project", GetBrandParent(project, *p)); diff != "" {
+		t.Errorf("GetBrandParent(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateBrand(t *testing.T) {
+	want := &iap.Brand{
+		SupportEmail:     "support@example.com",
+		ApplicationTitle: "Internal apps",
+	}
+	if diff := cmp.Diff(want, GenerateBrand(*brandParams())); diff != "" {
+		t.Errorf("GenerateBrand(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateBrandObservation(t *testing.T) {
+	b := iap.Brand{
+		Name:             brandName,
+		SupportEmail:     "support@example.com",
+		ApplicationTitle: "Internal apps",
+		OrgInternalOnly:  true,
+	}
+	want := v1alpha1.BrandObservation{
+		Name:            brandName,
+		OrgInternalOnly: true,
+	}
+	if diff := cmp.Diff(want, GenerateBrandObservation(b)); diff != "" {
+		t.Errorf("GenerateBrandObservation(...): -want, +got:\n%s", diff)
+	}
+}
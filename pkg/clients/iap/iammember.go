@@ -0,0 +1,92 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iap
+
+import (
+	"fmt"
+
+	iap "google.golang.org/api/iap/v1"
+
+	iamv1alpha1 "github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/iap/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const webBackendServiceResourceFormat = "projects/%s/iap_web/compute/services/%s"
+
+// GetWebBackendServiceResource returns the Identity-Aware Proxy resource of
+// the backend service of the supplied WebBackendServiceIAMMemberParameters,
+// falling back to the supplied default project.
+func GetWebBackendServiceResource(defaultProject string, p v1alpha1.WebBackendServiceIAMMemberParameters) string {
+	project := defaultProject
+	if p.Project != nil {
+		project = *p.Project
+	}
+	return fmt.Sprintf(webBackendServiceResourceFormat, project, p.BackendService)
+}
+
+// BindRoleToMember updates the supplied policy with the role and member of
+// the supplied WebBackendServiceIAMMemberParameters. It returns true if the
+// policy changed.
+func BindRoleToMember(in v1alpha1.WebBackendServiceIAMMemberParameters, p *iap.Policy) bool {
+	p.Version = iamv1alpha1.PolicyVersion
+	member := gcp.StringValue(in.Member)
+	for _, b := range p.Bindings {
+		// Conditional bindings are managed elsewhere, so we never add our
+		// member to one of them.
+		if b.Role != in.Role || b.Condition != nil {
+			continue
+		}
+		for _, m := range b.Members {
+			if m == member {
+				return false
+			}
+		}
+		b.Members = append(b.Members, member)
+		return true
+	}
+	p.Bindings = append(p.Bindings, &iap.Binding{
+		Role:    in.Role,
+		Members: []string{member},
+	})
+	return true
+}
+
+// UnbindRoleFromMember removes the member of the supplied
+// WebBackendServiceIAMMemberParameters from the binding of its role in the
+// supplied policy. It returns true if the policy changed.
+func UnbindRoleFromMember(in v1alpha1.WebBackendServiceIAMMemberParameters, p *iap.Policy) bool {
+	member := gcp.StringValue(in.Member)
+	for i, b := range p.Bindings {
+		if b.Role != in.Role || b.Condition != nil {
+			continue
+		}
+		for j, m := range b.Members {
+			if m != member {
+				continue
+			}
+			b.Members = append(b.Members[:j], b.Members[j+1:]...)
+			// Bindings without members are rejected by the API.
+			if len(b.Members) == 0 {
+				p.Bindings = append(p.Bindings[:i], p.Bindings[i+1:]...)
+			}
+			return true
+		}
+		return false
+	}
+	return false
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iap
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	iap "google.golang.org/api/iap/v1"
+
+	iamv1alpha1 "github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/iap/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	reader = "roles/iap.httpsResourceAccessor"
+	writer = "roles/iap.admin"
+)
+
+func memberParams() v1alpha1.WebBackendServiceIAMMemberParameters {
+	return v1alpha1.WebBackendServiceIAMMemberParameters{
+		BackendService: "internal-app",
+		Role:           reader,
+		Member:         gcp.StringPtr("group:staff@example.com"),
+	}
+}
+
+func TestGetWebBackendServiceResource(t *testing.T) {
+	want := "projects/cool-project/iap_web/compute/services/internal-app"
+	if diff := cmp.Diff(want, GetWebBackendServiceResource(project, memberParams())); diff != "" {
+		t.Errorf("GetWebBackendServiceResource(...): -want, +got:\n%s", diff)
+	}
+	p := memberParams()
+	p.Project = gcp.StringPtr("other-project")
+	want = "projects/other-project/iap_web/compute/services/internal-app"
+	if diff := cmp.Diff(want, GetWebBackendServiceResource(project, p)); diff != "" {
+		t.Errorf("GetWebBackendServiceResource(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestBindRoleToMember(t *testing.T) {
+	type want struct {
+		changed bool
+		policy  *iap.Policy
+	}
+	cases := map[string]struct {
+		policy *iap.Policy
+		want   want
+	}{
+		"EmptyPolicy": {
+			policy: &iap.Policy{},
+			want: want{
+				changed: true,
+				policy: &iap.Policy{
+					Version:  iamv1alpha1.PolicyVersion,
+					Bindings: []*iap.Binding{{Role: reader, Members: []string{"group:staff@example.com"}}},
+				},
+			},
+		},
+		"RoleExists": {
+			policy: &iap.Policy{
+				Bindings: []*iap.Binding{{Role: reader, Members: []string{"user:cool@example.com"}}},
+			},
+			want: want{
+				changed: true,
+				policy: &iap.Policy{
+					Version:  iamv1alpha1.PolicyVersion,
+					Bindings: []*iap.Binding{{Role: reader, Members: []string{"user:cool@example.com", "group:staff@example.com"}}},
+				},
+			},
+		},
+		"AlreadyBound": {
+			policy: &iap.Policy{
+				Bindings: []*iap.Binding{{Role: reader, Members: []string{"group:staff@example.com"}}},
+			},
+			want: want{
+				changed: false,
+				policy: &iap.Policy{
+					Version:  iamv1alpha1.PolicyVersion,
+					Bindings: []*iap.Binding{{Role: reader, Members: []string{"group:staff@example.com"}}},
+				},
+			},
+		},
+		"ConditionalBindingIgnored": {
+			policy: &iap.Policy{
+				Bindings: []*iap.Binding{{Role: reader, Members: []string{"group:staff@example.com"}, Condition: &iap.Expr{Expression: "true"}}},
+			},
+			want: want{
+				changed: true,
+				policy: &iap.Policy{
+					Version: iamv1alpha1.PolicyVersion,
+					Bindings: []*iap.Binding{
+						{Role: reader, Members: []string{"group:staff@example.com"}, Condition: &iap.Expr{Expression: "true"}},
+						{Role: reader, Members: []string{"group:staff@example.com"}},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			changed := BindRoleToMember(memberParams(), tc.policy)
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("BindRoleToMember(...): -want changed, +got changed:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.policy, tc.policy); diff != "" {
+				t.Errorf("BindRoleToMember(...): -want policy, +got policy:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUnbindRoleFromMember(t *testing.T) {
+	type want struct {
+		changed bool
+		policy  *iap.Policy
+	}
+	cases := map[string]struct {
+		policy *iap.Policy
+		want   want
+	}{
+		"NotBound": {
+			policy: &iap.Policy{
+				Bindings: []*iap.Binding{{Role: writer, Members: []string{"group:staff@example.com"}}},
+			},
+			want: want{
+				changed: false,
+				policy: &iap.Policy{
+					Bindings: []*iap.Binding{{Role: writer, Members: []string{"group:staff@example.com"}}},
+				},
+			},
+		},
+		"OtherMembersRemain": {
+			policy: &iap.Policy{
+				Bindings: []*iap.Binding{{Role: reader, Members: []string{"group:staff@example.com", "user:cool@example.com"}}},
+			},
+			want: want{
+				changed: true,
+				policy: &iap.Policy{
+					Bindings: []*iap.Binding{{Role: reader, Members: []string{"user:cool@example.com"}}},
+				},
+			},
+		},
+		"LastMemberRemovesBinding": {
+			policy: &iap.Policy{
+				Bindings: []*iap.Binding{
+					{Role: writer, Members: []string{"group:staff@example.com"}},
+					{Role: reader, Members: []string{"group:staff@example.com"}},
+				},
+			},
+			want: want{
+				changed: true,
+				policy: &iap.Policy{
+					Bindings: []*iap.Binding{{Role: writer, Members: []string{"group:staff@example.com"}}},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			changed := UnbindRoleFromMember(memberParams(), tc.policy)
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("UnbindRoleFromMember(...): -want changed, +got changed:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.policy, tc.policy); diff != "" {
+				t.Errorf("UnbindRoleFromMember(...): -want policy, +got policy:\n%s", diff)
+			}
+		})
+	}
+}
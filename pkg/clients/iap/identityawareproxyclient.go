@@ -0,0 +1,65 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iap
+
+import (
+	"path"
+
+	iap "google.golang.org/api/iap/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-gcp/apis/iap/v1alpha1"
+)
+
+// GetIdentityAwareProxyClientName builds the fully qualified name of the
+// client with the supplied ID in the supplied brand.
+func GetIdentityAwareProxyClientName(brand, id string) string {
+	return brand + "/identityAwareProxyClients/" + id
+}
+
+// GetIdentityAwareProxyClientID returns the ID, i.e. the OAuth client ID, of
+// the client with the supplied fully qualified name.
+func GetIdentityAwareProxyClientID(name string) string {
+	return path.Base(name)
+}
+
+// GenerateIdentityAwareProxyClient produces an IdentityAwareProxyClient that
+// is configured via the supplied IdentityAwareProxyClientParameters.
+func GenerateIdentityAwareProxyClient(p v1alpha1.IdentityAwareProxyClientParameters) *iap.IdentityAwareProxyClient {
+	return &iap.IdentityAwareProxyClient{
+		DisplayName: p.DisplayName,
+	}
+}
+
+// GenerateIdentityAwareProxyClientObservation produces an
+// IdentityAwareProxyClientObservation from the supplied
+// IdentityAwareProxyClient.
+func GenerateIdentityAwareProxyClientObservation(c iap.IdentityAwareProxyClient) v1alpha1.IdentityAwareProxyClientObservation {
+	return v1alpha1.IdentityAwareProxyClientObservation{
+		Name: c.Name,
+	}
+}
+
+// GetIdentityAwareProxyClientConnectionDetails returns the OAuth client ID
+// and secret of the supplied IdentityAwareProxyClient.
+func GetIdentityAwareProxyClientConnectionDetails(c iap.IdentityAwareProxyClient) managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		v1alpha1.IdentityAwareProxyClientSecretClientIDKey:     []byte(GetIdentityAwareProxyClientID(c.Name)),
+		v1alpha1.IdentityAwareProxyClientSecretClientSecretKey: []byte(c.Secret),
+	}
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iap
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	iap "google.golang.org/api/iap/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-gcp/apis/iap/v1alpha1"
+)
+
+const (
+	clientID   = "123456789012-abcdef.apps.googleusercontent.com"
+	clientName = brandName + "/identityAwareProxyClients/" + clientID
+)
+
+func TestIdentityAwareProxyClientNames(t *testing.T) {
+	if diff := cmp.Diff(clientName, GetIdentityAwareProxyClientName(brandName, clientID)); diff != "" {
+		t.Errorf("GetIdentityAwareProxyClientName(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(clientID, GetIdentityAwareProxyClientID(clientName)); diff != "" {
+		t.Errorf("GetIdentityAwareProxyClientID(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateIdentityAwareProxyClient(t *testing.T) {
+	want := &iap.IdentityAwareProxyClient{DisplayName: "dashboard"}
+	got := GenerateIdentityAwareProxyClient(v1alpha1.IdentityAwareProxyClientParameters{DisplayName: "dashboard"})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateIdentityAwareProxyClient(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateIdentityAwareProxyClientObservation(t *testing.T) {
+	c := iap.IdentityAwareProxyClient{Name: clientName, DisplayName: "dashboard", Secret: "s3cr3t"}
+	want := v1alpha1.IdentityAwareProxyClientObservation{Name: clientName}
+	if diff := cmp.Diff(want, GenerateIdentityAwareProxyClientObservation(c)); diff != "" {
+		t.Errorf("GenerateIdentityAwareProxyClientObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetIdentityAwareProxyClientConnectionDetails(t *testing.T) {
+	c := iap.IdentityAwareProxyClient{Name: clientName, DisplayName: "dashboard", Secret: "s3cr3t"}
+	want := managed.ConnectionDetails{
+		v1alpha1.IdentityAwareProxyClientSecretClientIDKey:     []byte(clientID),
+		v1alpha1.IdentityAwareProxyClientSecretClientSecretKey: []byte("s3cr3t"),
+	}
+	if diff := cmp.Diff(want, GetIdentityAwareProxyClientConnectionDetails(c)); diff != "" {
+		t.Errorf("GetIdentityAwareProxyClientConnectionDetails(...): -want, +got:\n%s", diff)
+	}
+}
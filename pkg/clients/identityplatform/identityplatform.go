@@ -0,0 +1,109 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package identityplatform
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	identitytoolkit "google.golang.org/api/identitytoolkit/v2"
+
+	"github.com/crossplane/provider-gcp/apis/identityplatform/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat = "projects/%s"
+
+	// stateEnabled is the state of an enabled second factor provider.
+	stateEnabled = "ENABLED"
+)
+
+func getParent(defaultProject string, project *string) string {
+	if project != nil {
+		return fmt.Sprintf(parentFormat, *project)
+	}
+	return fmt.Sprintf(parentFormat, defaultProject)
+}
+
+// getTOTPProviderConfig returns the configuration of the enabled TOTP
+// provider of the supplied MultiFactorAuthConfig, if any.
+func getTOTPProviderConfig(m *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2MultiFactorAuthConfig) *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2TotpMfaProviderConfig {
+	if m == nil {
+		return nil
+	}
+	for _, pc := range m.ProviderConfigs {
+		if pc != nil && pc.State == stateEnabled && pc.TotpProviderConfig != nil {
+			return pc.TotpProviderConfig
+		}
+	}
+	return nil
+}
+
+func generateMultiFactorAuthConfig(p *v1alpha1.MultiFactorAuthConfig) *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2MultiFactorAuthConfig {
+	if p == nil {
+		return nil
+	}
+	m := &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2MultiFactorAuthConfig{
+		State:            gcp.StringValue(p.State),
+		EnabledProviders: p.EnabledProviders,
+	}
+	if p.TOTP != nil {
+		m.ProviderConfigs = []*identitytoolkit.GoogleCloudIdentitytoolkitAdminV2ProviderConfig{{
+			State: stateEnabled,
+			TotpProviderConfig: &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2TotpMfaProviderConfig{
+				AdjacentIntervals: gcp.Int64Value(p.TOTP.AdjacentIntervals),
+			},
+		}}
+	}
+	return m
+}
+
+func lateInitializeMultiFactorAuthConfig(p *v1alpha1.MultiFactorAuthConfig, m *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2MultiFactorAuthConfig) *v1alpha1.MultiFactorAuthConfig {
+	if m == nil {
+		return p
+	}
+	if p == nil {
+		p = &v1alpha1.MultiFactorAuthConfig{}
+	}
+	p.State = gcp.LateInitializeString(p.State, m.State)
+	p.EnabledProviders = gcp.LateInitializeStringSlice(p.EnabledProviders, m.EnabledProviders)
+	if totp := getTOTPProviderConfig(m); totp != nil {
+		if p.TOTP == nil {
+			p.TOTP = &v1alpha1.TOTPConfig{}
+		}
+		p.TOTP.AdjacentIntervals = gcp.LateInitializeInt64(p.TOTP.AdjacentIntervals, totp.AdjacentIntervals)
+	}
+	return p
+}
+
+// isMultiFactorAuthConfigUpToDate compares only the enabled TOTP provider,
+// since the API keeps reporting providers that have been disabled.
+func isMultiFactorAuthConfigUpToDate(desired, observed *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2MultiFactorAuthConfig) bool {
+	d, o := &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2MultiFactorAuthConfig{}, &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2MultiFactorAuthConfig{}
+	if desired != nil {
+		d = desired
+	}
+	if observed != nil {
+		o = observed
+	}
+	if d.State != o.State || !cmp.Equal(d.EnabledProviders, o.EnabledProviders, cmpopts.EquateEmpty()) {
+		return false
+	}
+	return cmp.Equal(getTOTPProviderConfig(d), getTOTPProviderConfig(o))
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package identityplatform
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	identitytoolkit "google.golang.org/api/identitytoolkit/v2"
+
+	"github.com/crossplane/provider-gcp/apis/identityplatform/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// IdentityPlatformConfigUpdateMask is the list of configuration fields that
+// are managed by an IdentityPlatformConfig. Everything else, e.g. the
+// identity provider and notification settings, is left alone.
+const IdentityPlatformConfigUpdateMask = "authorizedDomains,signIn.allowDuplicateEmails,signIn.anonymous,signIn.email,signIn.phoneNumber,mfa,autodeleteAnonymousUsers,multiTenant.allowTenants"
+
+// GetIdentityPlatformConfigParent returns the project of the supplied
+// IdentityPlatformConfigParameters in the form projects/{project}, falling
+// back to the supplied default project.
+func GetIdentityPlatformConfigParent(defaultProject string, p v1alpha1.IdentityPlatformConfigParameters) string {
+	return getParent(defaultProject, p.Project)
+}
+
+// GetIdentityPlatformConfigName builds the fully qualified name of the
+// configuration of the supplied parent project.
+func GetIdentityPlatformConfigName(parent string) string {
+	return parent + "/config"
+}
+
+// GenerateIdentityPlatformConfig produces a Config that is configured via the
+// supplied IdentityPlatformConfigParameters.
+func GenerateIdentityPlatformConfig(p v1alpha1.IdentityPlatformConfigParameters) *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Config {
+	c := &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Config{
+		AuthorizedDomains:        p.AuthorizedDomains,
+		Mfa:                      generateMultiFactorAuthConfig(p.MFA),
+		AutodeleteAnonymousUsers: gcp.BoolValue(p.AutodeleteAnonymousUsers),
+	}
+	if p.SignIn != nil {
+		c.SignIn = &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2SignInConfig{
+			AllowDuplicateEmails: gcp.BoolValue(p.SignIn.AllowDuplicateEmails),
+		}
+		if p.SignIn.Email != nil {
+			c.SignIn.Email = &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Email{
+				Enabled:          p.SignIn.Email.Enabled,
+				PasswordRequired: gcp.BoolValue(p.SignIn.Email.PasswordRequired),
+			}
+		}
+		if p.SignIn.PhoneNumber != nil {
+			c.SignIn.PhoneNumber = &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2PhoneNumber{
+				Enabled:          p.SignIn.PhoneNumber.Enabled,
+				TestPhoneNumbers: p.SignIn.PhoneNumber.TestPhoneNumbers,
+			}
+		}
+		if p.SignIn.Anonymous != nil {
+			c.SignIn.Anonymous = &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Anonymous{
+				Enabled: p.SignIn.Anonymous.Enabled,
+			}
+		}
+	}
+	if p.MultiTenant != nil {
+		c.MultiTenant = &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2MultiTenantConfig{
+			AllowTenants: p.MultiTenant.AllowTenants,
+		}
+	}
+	return c
+}
+
+// GenerateIdentityPlatformConfigObservation produces an
+// IdentityPlatformConfigObservation from the supplied Config.
+func GenerateIdentityPlatformConfigObservation(c identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Config) v1alpha1.IdentityPlatformConfigObservation {
+	return v1alpha1.IdentityPlatformConfigObservation{
+		Name:    c.Name,
+		Subtype: c.Subtype,
+	}
+}
+
+// LateInitializeIdentityPlatformConfig fills the empty fields of the supplied
+// IdentityPlatformConfigParameters with the values seen in the supplied
+// Config.
+func LateInitializeIdentityPlatformConfig(p *v1alpha1.IdentityPlatformConfigParameters, c identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Config) {
+	p.AuthorizedDomains = gcp.LateInitializeStringSlice(p.AuthorizedDomains, c.AuthorizedDomains)
+	p.AutodeleteAnonymousUsers = gcp.LateInitializeBool(p.AutodeleteAnonymousUsers, c.AutodeleteAnonymousUsers)
+	p.MFA = lateInitializeMultiFactorAuthConfig(p.MFA, c.Mfa)
+	if c.SignIn != nil {
+		if p.SignIn == nil {
+			p.SignIn = &v1alpha1.SignInConfig{}
+		}
+		p.SignIn.AllowDuplicateEmails = gcp.LateInitializeBool(p.SignIn.AllowDuplicateEmails, c.SignIn.AllowDuplicateEmails)
+		if e := c.SignIn.Email; e != nil {
+			if p.SignIn.Email == nil {
+				p.SignIn.Email = &v1alpha1.EmailSignInConfig{Enabled: e.Enabled}
+			}
+			p.SignIn.Email.PasswordRequired = gcp.LateInitializeBool(p.SignIn.Email.PasswordRequired, e.PasswordRequired)
+		}
+		if pn := c.SignIn.PhoneNumber; pn != nil {
+			if p.SignIn.PhoneNumber == nil {
+				p.SignIn.PhoneNumber = &v1alpha1.PhoneNumberSignInConfig{Enabled: pn.Enabled}
+			}
+			p.SignIn.PhoneNumber.TestPhoneNumbers = gcp.LateInitializeStringMap(p.SignIn.PhoneNumber.TestPhoneNumbers, pn.TestPhoneNumbers)
+		}
+		if a := c.SignIn.Anonymous; a != nil && p.SignIn.Anonymous == nil {
+			p.SignIn.Anonymous = &v1alpha1.AnonymousSignInConfig{Enabled: a.Enabled}
+		}
+	}
+	if c.MultiTenant != nil && p.MultiTenant == nil {
+		p.MultiTenant = &v1alpha1.MultiTenantConfig{AllowTenants: c.MultiTenant.AllowTenants}
+	}
+}
+
+// IsIdentityPlatformConfigUpToDate returns true if the fields of the supplied
+// Config that are managed by an IdentityPlatformConfig match the supplied
+// IdentityPlatformConfigParameters.
+func IsIdentityPlatformConfigUpToDate(p v1alpha1.IdentityPlatformConfigParameters, c identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Config) bool {
+	desired := GenerateIdentityPlatformConfig(p)
+	if !cmp.Equal(desired.AuthorizedDomains, c.AuthorizedDomains, cmpopts.EquateEmpty()) ||
+		desired.AutodeleteAnonymousUsers != c.AutodeleteAnonymousUsers {
+		return false
+	}
+	if !cmp.Equal(flattenSignInConfig(desired.SignIn), flattenSignInConfig(c.SignIn), cmpopts.EquateEmpty()) {
+		return false
+	}
+	if allowTenants(desired.MultiTenant) != allowTenants(c.MultiTenant) {
+		return false
+	}
+	return isMultiFactorAuthConfigUpToDate(desired.Mfa, c.Mfa)
+}
+
+// flattenSignInConfig returns a copy of the managed fields of the supplied
+// SignInConfig in which an omitted provider is the same as a disabled one.
+func flattenSignInConfig(s *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2SignInConfig) identitytoolkit.GoogleCloudIdentitytoolkitAdminV2SignInConfig {
+	out := identitytoolkit.GoogleCloudIdentitytoolkitAdminV2SignInConfig{
+		Anonymous:   &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Anonymous{},
+		Email:       &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Email{},
+		PhoneNumber: &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2PhoneNumber{},
+	}
+	if s == nil {
+		return out
+	}
+	out.AllowDuplicateEmails = s.AllowDuplicateEmails
+	if s.Anonymous != nil {
+		out.Anonymous.Enabled = s.Anonymous.Enabled
+	}
+	if s.Email != nil {
+		out.Email.Enabled = s.Email.Enabled
+		out.Email.PasswordRequired = s.Email.PasswordRequired
+	}
+	if s.PhoneNumber != nil {
+		out.PhoneNumber.Enabled = s.PhoneNumber.Enabled
+		out.PhoneNumber.TestPhoneNumbers = s.PhoneNumber.TestPhoneNumbers
+	}
+	return out
+}
+
+func allowTenants(m *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2MultiTenantConfig) bool {
+	return m != nil && m.AllowTenants
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package identityplatform
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	identitytoolkit "google.golang.org/api/identitytoolkit/v2"
+
+	"github.com/crossplane/provider-gcp/apis/identityplatform/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	project    = "cool-project"
+	configName = "projects/cool-project/config"
+)
+
+func configParams(m ...func(*v1alpha1.IdentityPlatformConfigParameters)) *v1alpha1.IdentityPlatformConfigParameters {
+	p := &v1alpha1.IdentityPlatformConfigParameters{
+		AuthorizedDomains: []string{"localhost", "app.example.com"},
+		SignIn: &v1alpha1.SignInConfig{
+			Email:                &v1alpha1.EmailSignInConfig{Enabled: true, PasswordRequired: gcp.BoolPtr(true)},
+			PhoneNumber:          &v1alpha1.PhoneNumberSignInConfig{Enabled: true, TestPhoneNumbers: map[string]string{"+15555550100": "123456"}},
+			Anonymous:            &v1alpha1.AnonymousSignInConfig{Enabled: false},
+			AllowDuplicateEmails: gcp.BoolPtr(true),
+		},
+		MFA: &v1alpha1.MultiFactorAuthConfig{
+			State:            gcp.StringPtr("ENABLED"),
+			EnabledProviders: []string{"PHONE_SMS"},
+			TOTP:             &v1alpha1.TOTPConfig{AdjacentIntervals: gcp.Int64Ptr(3)},
+		},
+		AutodeleteAnonymousUsers: gcp.BoolPtr(true),
+		MultiTenant:              &v1alpha1.MultiTenantConfig{AllowTenants: true},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func config(m ...func(*identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Config)) *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Config {
+	c := &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Config{
+		Name:              configName,
+		Subtype:           "IDENTITY_PLATFORM",
+		AuthorizedDomains: []string{"localhost", "app.example.com"},
+		SignIn: &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2SignInConfig{
+			Email:                &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Email{Enabled: true, PasswordRequired: true},
+			PhoneNumber:          &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2PhoneNumber{Enabled: true, TestPhoneNumbers: map[string]string{"+15555550100": "123456"}},
+			Anonymous:            &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Anonymous{},
+			AllowDuplicateEmails: true,
+		},
+		Mfa: &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2MultiFactorAuthConfig{
+			State:            "ENABLED",
+			EnabledProviders: []string{"PHONE_SMS"},
+			ProviderConfigs: []*identitytoolkit.GoogleCloudIdentitytoolkitAdminV2ProviderConfig{{
+				State:              "ENABLED",
+				TotpProviderConfig: &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2TotpMfaProviderConfig{AdjacentIntervals: 3},
+			}},
+		},
+		AutodeleteAnonymousUsers: true,
+		MultiTenant:              &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2MultiTenantConfig{AllowTenants: true},
+	}
+	for _, f := range m {
+		f(c)
+	}
+	return c
+}
+
+func TestIdentityPlatformConfigNames(t *testing.T) {
+	parent := GetIdentityPlatformConfigParent(project, *configParams())
+	if diff := cmp.Diff(configName, GetIdentityPlatformConfigName(parent)); diff != "" {
+		t.Errorf("GetIdentityPlatformConfigName(...): -want, +got:\n%s", diff)
+	}
+	parent = GetIdentityPlatformConfigParent(project, *configParams(func(p *v1alpha1.IdentityPlatformConfigParameters) {
+		p.Project = gcp.StringPtr("other-project")
+	}))
+	if diff := cmp.Diff("projects/other-project", parent); diff != "" {
+		t.Errorf("GetIdentityPlatformConfigParent(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateIdentityPlatformConfig(t *testing.T) {
+	want := config(func(c *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Config) {
+		c.Name = ""
+		c.Subtype = ""
+	})
+	if diff := cmp.Diff(want, GenerateIdentityPlatformConfig(*configParams())); diff != "" {
+		t.Errorf("GenerateIdentityPlatformConfig(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateIdentityPlatformConfigObservation(t *testing.T) {
+	want := v1alpha1.IdentityPlatformConfigObservation{
+		Name:    configName,
+		Subtype: "IDENTITY_PLATFORM",
+	}
+	if diff := cmp.Diff(want, GenerateIdentityPlatformConfigObservation(*config())); diff != "" {
+		t.Errorf("GenerateIdentityPlatformConfigObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeIdentityPlatformConfig(t *testing.T) {
+	p := &v1alpha1.IdentityPlatformConfigParameters{}
+	LateInitializeIdentityPlatformConfig(p, *config())
+	want := configParams(func(p *v1alpha1.IdentityPlatformConfigParameters) {
+		// LateInitialize doesn't fill in booleans that are false.
+		p.SignIn.Anonymous = &v1alpha1.AnonymousSignInConfig{}
+	})
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("LateInitializeIdentityPlatformConfig(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsIdentityPlatformConfigUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.IdentityPlatformConfigParameters
+		c    *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Config
+		want bool
+	}{
+		"UpToDate": {
+			p:    configParams(),
+			c:    config(),
+			want: true,
+		},
+		"OmittedProviderIsDisabled": {
+			p: configParams(),
+			c: config(func(c *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Config) {
+				c.SignIn.Anonymous = nil
+				c.SignIn.HashConfig = &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2HashConfig{Algorithm: "SCRYPT"}
+			}),
+			want: true,
+		},
+		"DisabledTOTPIsIgnored": {
+			p: configParams(func(p *v1alpha1.IdentityPlatformConfigParameters) { p.MFA.TOTP = nil }),
+			c: config(func(c *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Config) {
+				c.Mfa.ProviderConfigs[0].State = "DISABLED"
+			}),
+			want: true,
+		},
+		"AuthorizedDomainsDiffer": {
+			p: configParams(func(p *v1alpha1.IdentityPlatformConfigParameters) { p.AuthorizedDomains = []string{"localhost"} }),
+			c: config(),
+		},
+		"EmailSignInDiffers": {
+			p: configParams(func(p *v1alpha1.IdentityPlatformConfigParameters) { p.SignIn.Email.Enabled = false }),
+			c: config(),
+		},
+		"MFAStateDiffers": {
+			p: configParams(func(p *v1alpha1.IdentityPlatformConfigParameters) { p.MFA.State = gcp.StringPtr("MANDATORY") }),
+			c: config(),
+		},
+		"TOTPIntervalsDiffer": {
+			p: configParams(func(p *v1alpha1.IdentityPlatformConfigParameters) { p.MFA.TOTP.AdjacentIntervals = gcp.Int64Ptr(5) }),
+			c: config(),
+		},
+		"MultiTenantDiffers": {
+			p: configParams(func(p *v1alpha1.IdentityPlatformConfigParameters) { p.MultiTenant = nil }),
+			c: config(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsIdentityPlatformConfigUpToDate(*tc.p, *tc.c); got != tc.want {
+				t.Errorf("IsIdentityPlatformConfigUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package identityplatform
+
+import (
+	"path"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	identitytoolkit "google.golang.org/api/identitytoolkit/v2"
+
+	"github.com/crossplane/provider-gcp/apis/identityplatform/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// TenantUpdateMask is the list of tenant fields that can be updated with a
+// patch call.
+const TenantUpdateMask = "displayName,allowPasswordSignup,enableEmailLinkSignin,enableAnonymousUser,disableAuth,mfaConfig,testPhoneNumbers"
+
+// GetTenantParent returns the project of the supplied TenantParameters in the
+// form projects/{project}, falling back to the supplied default project.
+func GetTenantParent(defaultProject string, p v1alpha1.TenantParameters) string {
+	return getParent(defaultProject, p.Project)
+}
+
+// GetTenantName builds the fully qualified name of the tenant with the
+// supplied ID in the supplied parent.
+func GetTenantName(parent, id string) string {
+	return parent + "/tenants/" + id
+}
+
+// GetTenantID returns the server-assigned ID of the tenant with the supplied
+// fully qualified name.
+func GetTenantID(name string) string {
+	return path.Base(name)
+}
+
+// GenerateTenant produces a Tenant that is configured via the supplied
+// TenantParameters.
+func GenerateTenant(p v1alpha1.TenantParameters) *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Tenant {
+	return &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Tenant{
+		DisplayName:           p.DisplayName,
+		AllowPasswordSignup:   gcp.BoolValue(p.AllowPasswordSignup),
+		EnableEmailLinkSignin: gcp.BoolValue(p.EnableEmailLinkSignin),
+		EnableAnonymousUser:   gcp.BoolValue(p.EnableAnonymousUser),
+		DisableAuth:           gcp.BoolValue(p.DisableAuth),
+		MfaConfig:             generateMultiFactorAuthConfig(p.MFAConfig),
+		TestPhoneNumbers:      p.TestPhoneNumbers,
+	}
+}
+
+// GenerateTenantObservation produces a TenantObservation from the supplied
+// Tenant.
+func GenerateTenantObservation(t identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Tenant) v1alpha1.TenantObservation {
+	return v1alpha1.TenantObservation{
+		Name: t.Name,
+	}
+}
+
+// LateInitializeTenant fills the empty fields of the supplied
+// TenantParameters with the values seen in the supplied Tenant.
+func LateInitializeTenant(p *v1alpha1.TenantParameters, t identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Tenant) {
+	p.AllowPasswordSignup = gcp.LateInitializeBool(p.AllowPasswordSignup, t.AllowPasswordSignup)
+	p.EnableEmailLinkSignin = gcp.LateInitializeBool(p.EnableEmailLinkSignin, t.EnableEmailLinkSignin)
+	p.EnableAnonymousUser = gcp.LateInitializeBool(p.EnableAnonymousUser, t.EnableAnonymousUser)
+	p.DisableAuth = gcp.LateInitializeBool(p.DisableAuth, t.DisableAuth)
+	p.MFAConfig = lateInitializeMultiFactorAuthConfig(p.MFAConfig, t.MfaConfig)
+	p.TestPhoneNumbers = gcp.LateInitializeStringMap(p.TestPhoneNumbers, t.TestPhoneNumbers)
+}
+
+// IsTenantUpToDate returns true if the supplied Tenant matches the supplied
+// TenantParameters.
+func IsTenantUpToDate(p v1alpha1.TenantParameters, t identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Tenant) bool {
+	desired := GenerateTenant(p)
+	switch {
+	case desired.DisplayName != t.DisplayName,
+		desired.AllowPasswordSignup != t.AllowPasswordSignup,
+		desired.EnableEmailLinkSignin != t.EnableEmailLinkSignin,
+		desired.EnableAnonymousUser != t.EnableAnonymousUser,
+		desired.DisableAuth != t.DisableAuth,
+		!cmp.Equal(desired.TestPhoneNumbers, t.TestPhoneNumbers, cmpopts.EquateEmpty()):
+		return false
+	}
+	return isMultiFactorAuthConfigUpToDate(desired.MfaConfig, t.MfaConfig)
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package identityplatform
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	identitytoolkit "google.golang.org/api/identitytoolkit/v2"
+
+	"github.com/crossplane/provider-gcp/apis/identityplatform/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const tenantName = "projects/cool-project/tenants/customers-a1b2c"
+
+func tenantParams(m ...func(*v1alpha1.TenantParameters)) *v1alpha1.TenantParameters {
+	p := &v1alpha1.TenantParameters{
+		DisplayName:           "customers",
+		AllowPasswordSignup:   gcp.BoolPtr(true),
+		EnableEmailLinkSignin: gcp.BoolPtr(true),
+		EnableAnonymousUser:   gcp.BoolPtr(true),
+		MFAConfig: &v1alpha1.MultiFactorAuthConfig{
+			State:            gcp.StringPtr("ENABLED"),
+			EnabledProviders: []string{"PHONE_SMS"},
+		},
+		TestPhoneNumbers: map[string]string{"+15555550100": "123456"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func tenant(m ...func(*identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Tenant)) *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Tenant {
+	t := &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Tenant{
+		Name:                  tenantName,
+		DisplayName:           "customers",
+		AllowPasswordSignup:   true,
+		EnableEmailLinkSignin: true,
+		EnableAnonymousUser:   true,
+		MfaConfig: &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2MultiFactorAuthConfig{
+			State:            "ENABLED",
+			EnabledProviders: []string{"PHONE_SMS"},
+		},
+		TestPhoneNumbers: map[string]string{"+15555550100": "123456"},
+	}
+	for _, f := range m {
+		f(t)
+	}
+	return t
+}
+
+func TestTenantNames(t *testing.T) {
+	parent := GetTenantParent(project, *tenantParams())
+	if diff := cmp.Diff(tenantName, GetTenantName(parent, "customers-a1b2c")); diff != "" {
+		t.Errorf("GetTenantName(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("customers-a1b2c", GetTenantID(tenantName)); diff != "" {
+		t.Errorf("GetTenantID(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateTenant(t *testing.T) {
+	want := tenant(func(t *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Tenant) { t.Name = "" })
+	if diff := cmp.Diff(want, GenerateTenant(*tenantParams())); diff != "" {
+		t.Errorf("GenerateTenant(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateTenantObservation(t *testing.T) {
+	want := v1alpha1.TenantObservation{Name: tenantName}
+	if diff := cmp.Diff(want, GenerateTenantObservation(*tenant())); diff != "" {
+		t.Errorf("GenerateTenantObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeTenant(t *testing.T) {
+	p := tenantParams(func(p *v1alpha1.TenantParameters) {
+		p.AllowPasswordSignup = nil
+		p.EnableEmailLinkSignin = nil
+		p.EnableAnonymousUser = nil
+		p.MFAConfig = nil
+		p.TestPhoneNumbers = nil
+	})
+	LateInitializeTenant(p, *tenant())
+	if diff := cmp.Diff(tenantParams(), p); diff != "" {
+		t.Errorf("LateInitializeTenant(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsTenantUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.TenantParameters
+		want bool
+	}{
+		"UpToDate": {
+			p:    tenantParams(),
+			want: true,
+		},
+		"DisplayNameDiffers": {
+			p: tenantParams(func(p *v1alpha1.TenantParameters) { p.DisplayName = "partners" }),
+		},
+		"DisableAuthDiffers": {
+			p: tenantParams(func(p *v1alpha1.TenantParameters) { p.DisableAuth = gcp.BoolPtr(true) }),
+		},
+		"MFAConfigDiffers": {
+			p: tenantParams(func(p *v1alpha1.TenantParameters) { p.MFAConfig = nil }),
+		},
+		"TestPhoneNumbersDiffer": {
+			p: tenantParams(func(p *v1alpha1.TenantParameters) { p.TestPhoneNumbers = nil }),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsTenantUpToDate(*tc.p, *tenant()); got != tc.want {
+				t.Errorf("IsTenantUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ids
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	ids "google.golang.org/api/ids/v1"
+
+	"github.com/crossplane/provider-gcp/apis/ids/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const parentFormat = "projects/%s/locations/%s"
+
+// EndpointUpdateMask is the list of endpoint fields that can be updated with
+// a patch call. All other fields of an endpoint are immutable.
+const EndpointUpdateMask = "threatExceptions"
+
+// GetEndpointParent returns the location of the supplied EndpointParameters
+// in the form projects/{project}/locations/{location}, falling back to the
+// supplied default project.
+func GetEndpointParent(defaultProject string, p v1alpha1.EndpointParameters) string {
+	project := defaultProject
+	if p.Project != nil {
+		project = *p.Project
+	}
+	return fmt.Sprintf(parentFormat, project, p.Location)
+}
+
+// GetEndpointName builds the fully qualified name of the endpoint with the
+// supplied ID in the supplied parent.
+func GetEndpointName(parent, id string) string {
+	return parent + "/endpoints/" + id
+}
+
+// GenerateEndpoint produces an Endpoint that is configured via the supplied
+// EndpointParameters.
+func GenerateEndpoint(p v1alpha1.EndpointParameters) *ids.Endpoint {
+	return &ids.Endpoint{
+		Network:          gcp.StringValue(p.Network),
+		Severity:         p.Severity,
+		Description:      gcp.StringValue(p.Description),
+		Labels:           p.Labels,
+		TrafficLogs:      gcp.BoolValue(p.TrafficLogs),
+		ThreatExceptions: p.ThreatExceptions,
+	}
+}
+
+// GenerateEndpointObservation produces an EndpointObservation from the
+// supplied Endpoint.
+func GenerateEndpointObservation(e ids.Endpoint) v1alpha1.EndpointObservation {
+	return v1alpha1.EndpointObservation{
+		Name:                   e.Name,
+		State:                  e.State,
+		CreateTime:             e.CreateTime,
+		UpdateTime:             e.UpdateTime,
+		EndpointForwardingRule: e.EndpointForwardingRule,
+		EndpointIP:             e.EndpointIp,
+	}
+}
+
+// LateInitializeEndpoint fills the empty fields of the supplied
+// EndpointParameters with the values seen in the supplied Endpoint.
+func LateInitializeEndpoint(p *v1alpha1.EndpointParameters, e ids.Endpoint) {
+	p.Network = gcp.LateInitializeString(p.Network, e.Network)
+	p.Description = gcp.LateInitializeString(p.Description, e.Description)
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, e.Labels)
+	p.TrafficLogs = gcp.LateInitializeBool(p.TrafficLogs, e.TrafficLogs)
+}
+
+// IsEndpointUpToDate returns true if the threat exceptions of the supplied
+// Endpoint match the supplied EndpointParameters.
+func IsEndpointUpToDate(p v1alpha1.EndpointParameters, e ids.Endpoint) bool {
+	return cmp.Equal(p.ThreatExceptions, e.ThreatExceptions, cmpopts.EquateEmpty())
+}
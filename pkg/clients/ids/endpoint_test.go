@@ -0,0 +1,147 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ids
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	ids "google.golang.org/api/ids/v1"
+
+	"github.com/crossplane/provider-gcp/apis/ids/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	project      = "cool-project"
+	endpointName = "projects/cool-project/locations/us-central1-a/endpoints/inspect"
+	network      = "projects/cool-project/global/networks/default"
+	rule         = "https://www.googleapis.com/compute/v1/projects/tenant/regions/us-central1/forwardingRules/ids-fr-inspect"
+)
+
+func endpointParams(m ...func(*v1alpha1.EndpointParameters)) *v1alpha1.EndpointParameters {
+	p := &v1alpha1.EndpointParameters{
+		Location:         "us-central1-a",
+		Network:          gcp.StringPtr(network),
+		Severity:         "MEDIUM",
+		Description:      gcp.StringPtr("Inspect production traffic"),
+		Labels:           map[string]string{"team": "security"},
+		TrafficLogs:      gcp.BoolPtr(true),
+		ThreatExceptions: []string{"1001"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func endpoint(m ...func(*ids.Endpoint)) *ids.Endpoint {
+	e := &ids.Endpoint{
+		Name:                   endpointName,
+		State:                  v1alpha1.EndpointStateReady,
+		CreateTime:             "2021-06-01T00:00:00Z",
+		UpdateTime:             "2021-06-02T00:00:00Z",
+		EndpointForwardingRule: rule,
+		EndpointIp:             "10.0.2.3",
+		Network:                network,
+		Severity:               "MEDIUM",
+		Description:            "Inspect production traffic",
+		Labels:                 map[string]string{"team": "security"},
+		TrafficLogs:            true,
+		ThreatExceptions:       []string{"1001"},
+	}
+	for _, f := range m {
+		f(e)
+	}
+	return e
+}
+
+func TestEndpointNames(t *testing.T) {
+	parent := GetEndpointParent(project, *endpointParams())
+	if diff := cmp.Diff(endpointName, GetEndpointName(parent, "inspect")); diff != "" {
+		t.Errorf("GetEndpointName(...): -want, +got:\n%s", diff)
+	}
+	parent = GetEndpointParent(project, *endpointParams(func(p *v1alpha1.EndpointParameters) { p.Project = gcp.StringPtr("other-project") }))
+	if diff := cmp.Diff("projects/other-project/locations/us-central1-a", parent); diff != "" {
+		t.Errorf("GetEndpointParent(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateEndpoint(t *testing.T) {
+	want := endpoint(func(e *ids.Endpoint) {
+		e.Name = ""
+		e.State = ""
+		e.CreateTime = ""
+		e.UpdateTime = ""
+		e.EndpointForwardingRule = ""
+		e.EndpointIp = ""
+	})
+	if diff := cmp.Diff(want, GenerateEndpoint(*endpointParams())); diff != "" {
+		t.Errorf("GenerateEndpoint(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateEndpointObservation(t *testing.T) {
+	want := v1alpha1.EndpointObservation{
+		Name:                   endpointName,
+		State:                  v1alpha1.EndpointStateReady,
+		CreateTime:             "2021-06-01T00:00:00Z",
+		UpdateTime:             "2021-06-02T00:00:00Z",
+		EndpointForwardingRule: rule,
+		EndpointIP:             "10.0.2.3",
+	}
+	if diff := cmp.Diff(want, GenerateEndpointObservation(*endpoint())); diff != "" {
+		t.Errorf("GenerateEndpointObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeEndpoint(t *testing.T) {
+	got := endpointParams(func(p *v1alpha1.EndpointParameters) {
+		p.Description = nil
+		p.Labels = nil
+		p.TrafficLogs = nil
+	})
+	LateInitializeEndpoint(got, *endpoint())
+	if diff := cmp.Diff(endpointParams(), got); diff != "" {
+		t.Errorf("LateInitializeEndpoint(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsEndpointUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.EndpointParameters
+		want bool
+	}{
+		"UpToDate": {
+			p:    endpointParams(),
+			want: true,
+		},
+		"ExceptionAdded": {
+			p: endpointParams(func(p *v1alpha1.EndpointParameters) { p.ThreatExceptions = []string{"1001", "1002"} }),
+		},
+		"ExceptionsRemoved": {
+			p: endpointParams(func(p *v1alpha1.EndpointParameters) { p.ThreatExceptions = nil }),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsEndpointUpToDate(*tc.p, *endpoint()); got != tc.want {
+				t.Errorf("IsEndpointUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/v1beta1"
+)
+
+const errUpdateManagedLabels = "cannot update labels of managed resource"
+
+// A DefaultLabelsInitializer adds the default labels of the ProviderConfig of
+// managed resources to their GCP labels. The labels are added to the spec of
+// the managed resources, so that they are set when the external resource is
+// created and restored when it drifts.
+type DefaultLabelsInitializer struct {
+	client client.Client
+	labels func(mg resource.Managed) *map[string]string
+}
+
+// NewDefaultLabelsInitializer returns a DefaultLabelsInitializer that updates
+// managed resources using the supplied client. The supplied function returns
+// the GCP labels within the spec of a managed resource, or nil if it has
+// none.
+func NewDefaultLabelsInitializer(c client.Client, labels func(mg resource.Managed) *map[string]string) *DefaultLabelsInitializer {
+	return &DefaultLabelsInitializer{client: c, labels: labels}
+}
+
+// Initialize the GCP labels of the supplied managed resource.
+func (i *DefaultLabelsInitializer) Initialize(ctx context.Context, mg resource.Managed) error {
+	labels := i.labels(mg)
+	if labels == nil || mg.GetProviderConfigReference() == nil {
+		return nil
+	}
+	pc := &v1beta1.ProviderConfig{}
+	if err := i.client.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
+		return errors.Wrap(err, errGetProviderConfig)
+	}
+	changed := false
+	for k, v := range pc.Spec.DefaultLabels {
+		if _, ok := (*labels)[k]; ok {
+			continue
+		}
+		if *labels == nil {
+			*labels = map[string]string{}
+		}
+		(*labels)[k] = v
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return errors.Wrap(i.client.Update(ctx, mg), errUpdateManagedLabels)
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/v1beta1"
+)
+
+func TestDefaultLabelsInitializer(t *testing.T) {
+	errBoom := errors.New("boom")
+	withDefaults := test.NewMockGetFn(nil, func(obj client.Object) error {
+		obj.(*v1beta1.ProviderConfig).Spec.DefaultLabels = map[string]string{"env": "prod", "cost-center": "42"}
+		return nil
+	})
+
+	type want struct {
+		err    error
+		labels map[string]string
+	}
+
+	cases := map[string]struct {
+		reason string
+		kube   client.Client
+		labels map[string]string
+		want   want
+	}{
+		"GetProviderConfigFailed": {
+			reason: "Should return an error if the ProviderConfig can't be read",
+			kube:   &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			want:   want{err: errors.Wrap(errBoom, errGetProviderConfig)},
+		},
+		"Added": {
+			reason: "Default labels should be added to resources without labels",
+			kube:   &test.MockClient{MockGet: withDefaults, MockUpdate: test.NewMockUpdateFn(nil)},
+			want:   want{labels: map[string]string{"env": "prod", "cost-center": "42"}},
+		},
+		"ResourceLabelsTakePrecedence": {
+			reason: "Labels that are set by the resource should not be overwritten",
+			kube:   &test.MockClient{MockGet: withDefaults, MockUpdate: test.NewMockUpdateFn(nil)},
+			labels: map[string]string{"env": "dev"},
+			want:   want{labels: map[string]string{"env": "dev", "cost-center": "42"}},
+		},
+		"Unchanged": {
+			reason: "Resources that already have the default labels should not be updated",
+			kube:   &test.MockClient{MockGet: withDefaults, MockUpdate: test.NewMockUpdateFn(errBoom)},
+			labels: map[string]string{"env": "prod", "cost-center": "42"},
+			want:   want{labels: map[string]string{"env": "prod", "cost-center": "42"}},
+		},
+		"UpdateFailed": {
+			reason: "Should return an error if the labels can't be persisted",
+			kube:   &test.MockClient{MockGet: withDefaults, MockUpdate: test.NewMockUpdateFn(errBoom)},
+			want: want{
+				err:    errors.Wrap(errBoom, errUpdateManagedLabels),
+				labels: map[string]string{"env": "prod", "cost-center": "42"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{}
+			mg.SetProviderConfigReference(&xpv1.Reference{Name: "team-a"})
+			labels := tc.labels
+			i := NewDefaultLabelsInitializer(tc.kube, func(_ resource.Managed) *map[string]string { return &labels })
+			err := i.Initialize(context.Background(), mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.labels, labels); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want labels, +got labels:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
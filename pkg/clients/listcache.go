@@ -0,0 +1,109 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"sync"
+	"time"
+)
+
+// lists is shared by the controllers that observe managed resources by
+// listing them. It's nil unless list observation is enabled.
+var lists *ListCache
+
+// EnableListObservation makes the controllers of high-cardinality kinds, e.g.
+// ResourceRecordSets, observe managed resources by listing the external
+// resources instead of getting them one by one. Lists are cached for the
+// supplied TTL, and shared by all managed resources of a kind in the same
+// scope, e.g. a managed zone, which saves API quota at the cost of observing
+// changes a little later.
+func EnableListObservation(ttl time.Duration) {
+	lists = NewListCache(ttl)
+}
+
+// ListObservation returns the cache of lists, or nil if list observation
+// isn't enabled.
+func ListObservation() *ListCache {
+	return lists
+}
+
+// A ListCache caches the external resources returned by list calls for a
+// short time. Lists are keyed by their kind and scope, e.g. the project and
+// managed zone of ResourceRecordSets, and their items by name.
+type ListCache struct {
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[string]*listEntry
+}
+
+type listEntry struct {
+	mu      sync.Mutex
+	expires time.Time
+	items   map[string]interface{}
+}
+
+// NewListCache returns a ListCache that caches lists for the supplied TTL.
+func NewListCache(ttl time.Duration) *ListCache {
+	return &ListCache{ttl: ttl, entries: map[string]*listEntry{}}
+}
+
+// Get returns the named item of the list with the supplied key, and whether
+// it exists. The list is filled by calling list if it wasn't cached yet or
+// expired. Concurrent callers wait for a single call of list. Errors aren't
+// cached. Other lists that expired are removed, so that lists of scopes that
+// are no longer observed, e.g. deleted managed zones, don't pile up.
+func (c *ListCache) Get(key, name string, list func() (map[string]interface{}, error)) (interface{}, bool, error) {
+	c.mu.Lock()
+	now := time.Now()
+	for k, e := range c.entries {
+		// Lists that are being filled or read aren't removed.
+		if k == key || !e.mu.TryLock() {
+			continue
+		}
+		if now.After(e.expires) {
+			delete(c.entries, k)
+		}
+		e.mu.Unlock()
+	}
+	e, ok := c.entries[key]
+	if !ok {
+		e = &listEntry{}
+		c.entries[key] = e
+	}
+	c.mu.Unlock()
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if time.Now().After(e.expires) {
+		items, err := list()
+		if err != nil {
+			return nil, false, err
+		}
+		e.items, e.expires = items, time.Now().Add(c.ttl)
+	}
+	item, ok := e.items[name]
+	return item, ok, nil
+}
+
+// Invalidate drops the list with the supplied key, e.g. after one of its
+// items was created, updated or deleted, so that the change is observed by
+// the next call of Get.
+func (c *ListCache) Invalidate(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestListCache(t *testing.T) {
+	errBoom := errors.New("boom")
+	calls := 0
+	list := func() (map[string]interface{}, error) {
+		calls++
+		return map[string]interface{}{"a": "A"}, nil
+	}
+	c := NewListCache(time.Hour)
+
+	got, ok, err := c.Get("zone", "a", list)
+	if err != nil || !ok || got != "A" {
+		t.Errorf("Get(...): want A, got %v, %t, %v", got, ok, err)
+	}
+	if _, ok, _ := c.Get("zone", "b", list); ok {
+		t.Errorf("Get(...): want items that aren't listed not to exist")
+	}
+	if calls != 1 {
+		t.Errorf("Get(...): want the list to be cached, got %d calls", calls)
+	}
+
+	c.Invalidate("zone")
+	_, _, _ = c.Get("zone", "a", list)
+	if calls != 2 {
+		t.Errorf("Get(...): want the list to be refilled once it was invalidated, got %d calls", calls)
+	}
+
+	_, _, err = NewListCache(time.Hour).Get("zone", "a", func() (map[string]interface{}, error) { return nil, errBoom })
+	if diff := cmp.Diff(errBoom, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Get(...): -want error, +got error:\n%s", diff)
+	}
+}
+
+func TestListCacheExpiry(t *testing.T) {
+	list := func() (map[string]interface{}, error) { return map[string]interface{}{}, nil }
+	c := NewListCache(time.Millisecond)
+
+	_, _, _ = c.Get("deleted-zone", "a", list)
+	time.Sleep(2 * time.Millisecond)
+	_, _, _ = c.Get("zone", "a", list)
+	if _, ok := c.entries["deleted-zone"]; ok {
+		t.Errorf("Get(...): want expired lists to be removed")
+	}
+	if _, ok := c.entries["zone"]; !ok {
+		t.Errorf("Get(...): want the requested list to be cached")
+	}
+}
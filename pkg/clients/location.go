@@ -0,0 +1,106 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	cmpv1beta1 "github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	"github.com/crossplane/provider-gcp/apis/v1beta1"
+)
+
+// Error strings.
+const (
+	errUpdateManagedLocation = "cannot update location of managed resource"
+	errNoRegion              = "no region is set and ProviderConfig %q has no default region"
+	errNoRegionNoPC          = "no region is set and there is no ProviderConfig to default it"
+)
+
+// A DefaultLocationInitializer sets the region and zone of managed resources
+// that don't specify them to the default region and zone of their
+// ProviderConfig. The region and zone are recorded in the spec of the managed
+// resources, so that changing the defaults doesn't affect existing managed
+// resources. Managed resources that specify no region, and whose
+// ProviderConfig has no default region, fail to initialize.
+type DefaultLocationInitializer struct {
+	client   client.Client
+	location func(mg resource.Managed) (region *string, zone **string)
+}
+
+// NewDefaultLocationInitializer returns a DefaultLocationInitializer that
+// updates managed resources using the supplied client. The supplied function
+// returns the region within the spec of a managed resource, or nil if it has
+// none, and its zone, or nil if it has none. The zone is only defaulted if
+// it's in the region of the managed resource.
+func NewDefaultLocationInitializer(c client.Client, location func(mg resource.Managed) (region *string, zone **string)) *DefaultLocationInitializer {
+	return &DefaultLocationInitializer{client: c, location: location}
+}
+
+// Initialize the region and zone of the supplied managed resource.
+func (i *DefaultLocationInitializer) Initialize(ctx context.Context, mg resource.Managed) error {
+	region, zone := i.location(mg)
+	if region == nil {
+		return nil
+	}
+	if *region != "" && (zone == nil || *zone != nil) {
+		return nil
+	}
+	ref := mg.GetProviderConfigReference()
+	if ref == nil {
+		if *region == "" {
+			return errors.New(errNoRegionNoPC)
+		}
+		return nil
+	}
+	pc := &v1beta1.ProviderConfig{}
+	if err := i.client.Get(ctx, types.NamespacedName{Name: ref.Name}, pc); err != nil {
+		return errors.Wrap(err, errGetProviderConfig)
+	}
+	changed := false
+	if *region == "" {
+		// The GCP APIs would be called with an empty region otherwise.
+		r := defaultRegion(pc.Spec)
+		if r == "" {
+			return errors.Errorf(errNoRegion, ref.Name)
+		}
+		*region = r
+		changed = true
+	}
+	if z := StringValue(pc.Spec.DefaultZone); zone != nil && *zone == nil && z != "" && cmpv1beta1.RegionOf(z) == *region {
+		*zone = StringPtr(z)
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return errors.Wrap(i.client.Update(ctx, mg), errUpdateManagedLocation)
+}
+
+// defaultRegion returns the default region of the supplied ProviderConfig
+// spec, which defaults to the region of its default zone.
+func defaultRegion(spec v1beta1.ProviderConfigSpec) string {
+	if spec.DefaultRegion != nil {
+		return *spec.DefaultRegion
+	}
+	return cmpv1beta1.RegionOf(StringValue(spec.DefaultZone))
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/v1beta1"
+)
+
+func TestDefaultLocationInitializer(t *testing.T) {
+	errBoom := errors.New("boom")
+	withDefaults := func(region, zone *string) client.Client {
+		return &test.MockClient{
+			MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+				obj.(*v1beta1.ProviderConfig).Spec.DefaultRegion = region
+				obj.(*v1beta1.ProviderConfig).Spec.DefaultZone = zone
+				return nil
+			}),
+			MockUpdate: test.NewMockUpdateFn(nil),
+		}
+	}
+
+	type want struct {
+		err    error
+		region string
+		zone   *string
+	}
+
+	cases := map[string]struct {
+		reason string
+		kube   client.Client
+		region string
+		zone   *string
+		want   want
+	}{
+		"Specified": {
+			reason: "Resources that specify their region and zone should not be defaulted",
+			kube:   &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			region: "europe-west3",
+			zone:   StringPtr("europe-west3-b"),
+			want:   want{region: "europe-west3", zone: StringPtr("europe-west3-b")},
+		},
+		"GetProviderConfigFailed": {
+			reason: "Should return an error if the ProviderConfig can't be read",
+			kube:   &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			want:   want{err: errors.Wrap(errBoom, errGetProviderConfig)},
+		},
+		"Defaulted": {
+			reason: "The default region and zone should be set on resources that don't specify them",
+			kube:   withDefaults(StringPtr("us-central1"), StringPtr("us-central1-a")),
+			want:   want{region: "us-central1", zone: StringPtr("us-central1-a")},
+		},
+		"RegionOfZone": {
+			reason: "The region should default to the region of the default zone",
+			kube:   withDefaults(nil, StringPtr("us-central1-a")),
+			want:   want{region: "us-central1", zone: StringPtr("us-central1-a")},
+		},
+		"NoDefaultRegion": {
+			reason: "Should return an error if neither the resource nor its ProviderConfig set a region",
+			kube:   withDefaults(nil, nil),
+			want:   want{err: errors.Errorf(errNoRegion, "team-a")},
+		},
+		"ZoneInOtherRegion": {
+			reason: "The default zone should not be set on resources in another region",
+			kube:   withDefaults(StringPtr("us-central1"), StringPtr("us-central1-a")),
+			region: "europe-west3",
+			want:   want{region: "europe-west3"},
+		},
+		"UpdateFailed": {
+			reason: "Should return an error if the location can't be persisted",
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					obj.(*v1beta1.ProviderConfig).Spec.DefaultRegion = StringPtr("us-central1")
+					return nil
+				}),
+				MockUpdate: test.NewMockUpdateFn(errBoom),
+			},
+			want: want{err: errors.Wrap(errBoom, errUpdateManagedLocation), region: "us-central1"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{}
+			mg.SetProviderConfigReference(&xpv1.Reference{Name: "team-a"})
+			region, zone := tc.region, tc.zone
+			i := NewDefaultLocationInitializer(tc.kube, func(_ resource.Managed) (*string, **string) { return &region, &zone })
+			err := i.Initialize(context.Background(), mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.region, region); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want region, +got region:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.zone, zone); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want zone, +got zone:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"fmt"
+	"strings"
+
+	cloudlogging "google.golang.org/api/logging/v2"
+
+	"github.com/crossplane/provider-gcp/apis/logging/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	bucketNameFormat = "%s/locations/%s/buckets/%s"
+
+	// LogBucketUpdateMask is the list of bucket fields that can be updated
+	// with a patch call. The CMEK settings are added by
+	// GetLogBucketUpdateMask when a key is configured.
+	LogBucketUpdateMask = "description,retentionDays,locked,analyticsEnabled"
+)
+
+// GetBucketParent returns the parent of the supplied LogBucketParameters,
+// falling back to the supplied default project.
+func GetBucketParent(defaultProject string, p v1alpha1.LogBucketParameters) string {
+	if p.Parent != nil {
+		return *p.Parent
+	}
+	return projectParentPrefix + defaultProject
+}
+
+// GetBucketLocationName builds the fully qualified name of the location of
+// the supplied LogBucketParameters.
+func GetBucketLocationName(parent string, p v1alpha1.LogBucketParameters) string {
+	return parent + "/locations/" + p.Location
+}
+
+// GetBucketName builds the fully qualified name of the bucket with the
+// supplied ID of the supplied LogBucketParameters.
+func GetBucketName(parent, id string, p v1alpha1.LogBucketParameters) string {
+	return fmt.Sprintf(bucketNameFormat, parent, p.Location, id)
+}
+
+// GetLogBucketUpdateMask returns the fields of a bucket configured via the
+// supplied LogBucketParameters that are updated with a patch call. CMEK can't
+// be disabled once it is enabled, so the CMEK settings are only updated if a
+// key is configured.
+func GetLogBucketUpdateMask(p v1alpha1.LogBucketParameters) string {
+	mask := []string{LogBucketUpdateMask}
+	if p.KmsKeyName != nil {
+		mask = append(mask, "cmekSettings")
+	}
+	return strings.Join(mask, ",")
+}
+
+// GenerateLogBucket produces a LogBucket that is configured via the supplied
+// LogBucketParameters.
+func GenerateLogBucket(p v1alpha1.LogBucketParameters) *cloudlogging.LogBucket {
+	b := &cloudlogging.LogBucket{
+		Description:      gcp.StringValue(p.Description),
+		RetentionDays:    gcp.Int64Value(p.RetentionDays),
+		Locked:           gcp.BoolValue(p.Locked),
+		AnalyticsEnabled: gcp.BoolValue(p.AnalyticsEnabled),
+	}
+	if p.KmsKeyName != nil {
+		b.CmekSettings = &cloudlogging.CmekSettings{KmsKeyName: *p.KmsKeyName}
+	}
+	return b
+}
+
+// GenerateLogBucketObservation produces a LogBucketObservation from the
+// supplied LogBucket.
+func GenerateLogBucketObservation(b cloudlogging.LogBucket) v1alpha1.LogBucketObservation {
+	return v1alpha1.LogBucketObservation{
+		Name:           b.Name,
+		LifecycleState: b.LifecycleState,
+		CreateTime:     b.CreateTime,
+		UpdateTime:     b.UpdateTime,
+	}
+}
+
+// LateInitializeLogBucket fills the empty fields of the supplied
+// LogBucketParameters with the values of the supplied LogBucket.
+func LateInitializeLogBucket(p *v1alpha1.LogBucketParameters, b cloudlogging.LogBucket) {
+	p.Description = gcp.LateInitializeString(p.Description, b.Description)
+	p.RetentionDays = gcp.LateInitializeInt64(p.RetentionDays, b.RetentionDays)
+	p.Locked = gcp.LateInitializeBool(p.Locked, b.Locked)
+	p.AnalyticsEnabled = gcp.LateInitializeBool(p.AnalyticsEnabled, b.AnalyticsEnabled)
+	if b.CmekSettings != nil {
+		p.KmsKeyName = gcp.LateInitializeString(p.KmsKeyName, b.CmekSettings.KmsKeyName)
+	}
+}
+
+// IsLogBucketUpToDate returns true if the supplied LogBucket matches the
+// fields of the supplied LogBucketParameters that can be updated with a patch
+// call.
+func IsLogBucketUpToDate(p v1alpha1.LogBucketParameters, b cloudlogging.LogBucket) bool {
+	kmsKeyName := ""
+	if b.CmekSettings != nil {
+		kmsKeyName = b.CmekSettings.KmsKeyName
+	}
+	return gcp.StringValue(p.Description) == b.Description &&
+		gcp.Int64Value(p.RetentionDays) == b.RetentionDays &&
+		gcp.BoolValue(p.Locked) == b.Locked &&
+		gcp.BoolValue(p.AnalyticsEnabled) == b.AnalyticsEnabled &&
+		(p.KmsKeyName == nil || *p.KmsKeyName == kmsKeyName)
+}
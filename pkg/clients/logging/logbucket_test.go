@@ -0,0 +1,135 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cloudlogging "google.golang.org/api/logging/v2"
+
+	"github.com/crossplane/provider-gcp/apis/logging/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const kmsKey = "projects/cool-project/locations/europe-west1/keyRings/logs/cryptoKeys/logs"
+
+func bucketParams(m ...func(*v1alpha1.LogBucketParameters)) *v1alpha1.LogBucketParameters {
+	p := &v1alpha1.LogBucketParameters{
+		Location:      "europe-west1",
+		Description:   gcp.StringPtr("Audit logs"),
+		RetentionDays: gcp.Int64Ptr(400),
+		KmsKeyName:    gcp.StringPtr(kmsKey),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func bucket(m ...func(*cloudlogging.LogBucket)) *cloudlogging.LogBucket {
+	b := &cloudlogging.LogBucket{
+		Name:           "projects/cool-project/locations/europe-west1/buckets/audit",
+		Description:    "Audit logs",
+		RetentionDays:  400,
+		LifecycleState: v1alpha1.LogBucketStateActive,
+		CmekSettings:   &cloudlogging.CmekSettings{KmsKeyName: kmsKey},
+	}
+	for _, f := range m {
+		f(b)
+	}
+	return b
+}
+
+func TestLogBucketNames(t *testing.T) {
+	parent := GetBucketParent(project, *bucketParams())
+	if diff := cmp.Diff("projects/cool-project", parent); diff != "" {
+		t.Errorf("GetBucketParent(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("projects/cool-project/locations/europe-west1", GetBucketLocationName(parent, *bucketParams())); diff != "" {
+		t.Errorf("GetBucketLocationName(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(bucket().Name, GetBucketName(parent, "audit", *bucketParams())); diff != "" {
+		t.Errorf("GetBucketName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetLogBucketUpdateMask(t *testing.T) {
+	if diff := cmp.Diff(LogBucketUpdateMask+",cmekSettings", GetLogBucketUpdateMask(*bucketParams())); diff != "" {
+		t.Errorf("GetLogBucketUpdateMask(...): -want, +got:\n%s", diff)
+	}
+	p := bucketParams(func(p *v1alpha1.LogBucketParameters) { p.KmsKeyName = nil })
+	if diff := cmp.Diff(LogBucketUpdateMask, GetLogBucketUpdateMask(*p)); diff != "" {
+		t.Errorf("GetLogBucketUpdateMask(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateLogBucket(t *testing.T) {
+	want := bucket(func(b *cloudlogging.LogBucket) {
+		b.Name = ""
+		b.LifecycleState = ""
+	})
+	if diff := cmp.Diff(want, GenerateLogBucket(*bucketParams())); diff != "" {
+		t.Errorf("GenerateLogBucket(...): -want, +got:\n%s", diff)
+	}
+	o := v1alpha1.LogBucketObservation{Name: bucket().Name, LifecycleState: v1alpha1.LogBucketStateActive}
+	if diff := cmp.Diff(o, GenerateLogBucketObservation(*bucket())); diff != "" {
+		t.Errorf("GenerateLogBucketObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeLogBucket(t *testing.T) {
+	got := &v1alpha1.LogBucketParameters{Location: "europe-west1"}
+	LateInitializeLogBucket(got, *bucket())
+	if diff := cmp.Diff(bucketParams(), got); diff != "" {
+		t.Errorf("LateInitializeLogBucket(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsLogBucketUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.LogBucketParameters
+		b    *cloudlogging.LogBucket
+		want bool
+	}{
+		"UpToDate": {
+			p:    bucketParams(),
+			b:    bucket(),
+			want: true,
+		},
+		"KeyNotConfigured": {
+			p:    bucketParams(func(p *v1alpha1.LogBucketParameters) { p.KmsKeyName = nil }),
+			b:    bucket(),
+			want: true,
+		},
+		"RetentionDiffers": {
+			p: bucketParams(),
+			b: bucket(func(b *cloudlogging.LogBucket) { b.RetentionDays = 30 }),
+		},
+		"KeyDiffers": {
+			p: bucketParams(),
+			b: bucket(func(b *cloudlogging.LogBucket) { b.CmekSettings = nil }),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsLogBucketUpToDate(*tc.p, *tc.b); got != tc.want {
+				t.Errorf("IsLogBucketUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
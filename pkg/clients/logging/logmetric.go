@@ -0,0 +1,173 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"strconv"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	cloudlogging "google.golang.org/api/logging/v2"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane/provider-gcp/apis/logging/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	metricsSep = "/metrics/"
+
+	errParseBucketOptions = "cannot parse bucket options"
+)
+
+// GetMetricParent returns the parent of the metrics of the supplied
+// LogMetricParameters, falling back to the supplied default project.
+func GetMetricParent(defaultProject string, p v1alpha1.LogMetricParameters) string {
+	if p.Project != nil {
+		return projectParentPrefix + *p.Project
+	}
+	return projectParentPrefix + defaultProject
+}
+
+// GetMetricName builds the fully qualified name of the metric with the
+// supplied ID of the supplied parent.
+func GetMetricName(parent, id string) string {
+	return parent + metricsSep + id
+}
+
+// GenerateLogMetric produces a LogMetric with the supplied ID that is
+// configured via the supplied LogMetricParameters.
+func GenerateLogMetric(id string, p v1alpha1.LogMetricParameters) (*cloudlogging.LogMetric, error) {
+	m := &cloudlogging.LogMetric{
+		Name:            id,
+		Filter:          p.Filter,
+		Description:     gcp.StringValue(p.Description),
+		Disabled:        gcp.BoolValue(p.Disabled),
+		BucketName:      gcp.StringValue(p.BucketName),
+		ValueExtractor:  gcp.StringValue(p.ValueExtractor),
+		LabelExtractors: p.LabelExtractors,
+	}
+	if d := p.MetricDescriptor; d != nil {
+		m.MetricDescriptor = &cloudlogging.MetricDescriptor{
+			MetricKind:  gcp.StringValue(d.MetricKind),
+			ValueType:   gcp.StringValue(d.ValueType),
+			Unit:        gcp.StringValue(d.Unit),
+			DisplayName: gcp.StringValue(d.DisplayName),
+		}
+		for _, l := range d.Labels {
+			m.MetricDescriptor.Labels = append(m.MetricDescriptor.Labels, &cloudlogging.LabelDescriptor{
+				Key:         l.Key,
+				ValueType:   gcp.StringValue(l.ValueType),
+				Description: gcp.StringValue(l.Description),
+			})
+		}
+	}
+	if p.BucketOptions != nil {
+		o, err := generateBucketOptions(*p.BucketOptions)
+		if err != nil {
+			return nil, errors.Wrap(err, errParseBucketOptions)
+		}
+		m.BucketOptions = o
+	}
+	return m, nil
+}
+
+func generateBucketOptions(b v1alpha1.BucketOptions) (*cloudlogging.BucketOptions, error) { // nolint:gocyclo
+	o := &cloudlogging.BucketOptions{}
+	if l := b.LinearBuckets; l != nil {
+		o.LinearBuckets = &cloudlogging.Linear{NumFiniteBuckets: l.NumFiniteBuckets}
+		var err error
+		if o.LinearBuckets.Width, err = strconv.ParseFloat(l.Width, 64); err != nil {
+			return nil, err
+		}
+		if l.Offset != nil {
+			if o.LinearBuckets.Offset, err = strconv.ParseFloat(*l.Offset, 64); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if e := b.ExponentialBuckets; e != nil {
+		o.ExponentialBuckets = &cloudlogging.Exponential{NumFiniteBuckets: e.NumFiniteBuckets}
+		var err error
+		if o.ExponentialBuckets.GrowthFactor, err = strconv.ParseFloat(e.GrowthFactor, 64); err != nil {
+			return nil, err
+		}
+		if o.ExponentialBuckets.Scale, err = strconv.ParseFloat(e.Scale, 64); err != nil {
+			return nil, err
+		}
+	}
+	if e := b.ExplicitBuckets; e != nil {
+		o.ExplicitBuckets = &cloudlogging.Explicit{}
+		for _, s := range e.Bounds {
+			v, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return nil, err
+			}
+			o.ExplicitBuckets.Bounds = append(o.ExplicitBuckets.Bounds, v)
+		}
+	}
+	return o, nil
+}
+
+// GenerateLogMetricObservation produces a LogMetricObservation from the
+// supplied LogMetric.
+func GenerateLogMetricObservation(m cloudlogging.LogMetric) v1alpha1.LogMetricObservation {
+	o := v1alpha1.LogMetricObservation{
+		CreateTime: m.CreateTime,
+		UpdateTime: m.UpdateTime,
+	}
+	if m.MetricDescriptor != nil {
+		o.MetricType = m.MetricDescriptor.Type
+	}
+	return o
+}
+
+// LateInitializeLogMetric fills the empty fields of the supplied
+// LogMetricParameters with the values of the supplied LogMetric. Cloud
+// Logging describes a metric without descriptor as an INT64 DELTA metric.
+func LateInitializeLogMetric(p *v1alpha1.LogMetricParameters, m cloudlogging.LogMetric) {
+	p.Description = gcp.LateInitializeString(p.Description, m.Description)
+	if m.MetricDescriptor == nil {
+		return
+	}
+	if p.MetricDescriptor == nil {
+		p.MetricDescriptor = &v1alpha1.MetricDescriptor{}
+	}
+	d := p.MetricDescriptor
+	d.MetricKind = gcp.LateInitializeString(d.MetricKind, m.MetricDescriptor.MetricKind)
+	d.ValueType = gcp.LateInitializeString(d.ValueType, m.MetricDescriptor.ValueType)
+	d.Unit = gcp.LateInitializeString(d.Unit, m.MetricDescriptor.Unit)
+	d.DisplayName = gcp.LateInitializeString(d.DisplayName, m.MetricDescriptor.DisplayName)
+}
+
+// IsLogMetricUpToDate returns true if the supplied LogMetric matches the
+// supplied LogMetricParameters.
+func IsLogMetricUpToDate(p v1alpha1.LogMetricParameters, m cloudlogging.LogMetric) (bool, error) {
+	desired, err := GenerateLogMetric(m.Name, p)
+	if err != nil {
+		return false, err
+	}
+	return cmp.Equal(desired, &m, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(cloudlogging.LogMetric{}, "CreateTime", "UpdateTime", "Version", "ServerResponse", "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(cloudlogging.MetricDescriptor{}, "Name", "Type", "Description", "LaunchStage", "Metadata", "MonitoredResourceTypes", "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(cloudlogging.LabelDescriptor{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(cloudlogging.BucketOptions{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(cloudlogging.Linear{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(cloudlogging.Exponential{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(cloudlogging.Explicit{}, "ForceSendFields", "NullFields")), nil
+}
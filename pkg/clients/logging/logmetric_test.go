@@ -0,0 +1,191 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cloudlogging "google.golang.org/api/logging/v2"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/logging/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+func metricParams(m ...func(*v1alpha1.LogMetricParameters)) *v1alpha1.LogMetricParameters {
+	p := &v1alpha1.LogMetricParameters{
+		Filter:         "resource.type=\"http_load_balancer\"",
+		ValueExtractor: gcp.StringPtr("EXTRACT(httpRequest.latency)"),
+		LabelExtractors: map[string]string{
+			"status": "EXTRACT(httpRequest.status)",
+		},
+		MetricDescriptor: &v1alpha1.MetricDescriptor{
+			MetricKind: gcp.StringPtr("DELTA"),
+			ValueType:  gcp.StringPtr("DISTRIBUTION"),
+			Unit:       gcp.StringPtr("s"),
+			Labels:     []v1alpha1.LabelDescriptor{{Key: "status"}},
+		},
+		BucketOptions: &v1alpha1.BucketOptions{
+			ExplicitBuckets: &v1alpha1.ExplicitBuckets{Bounds: []string{"0.1", "0.5", "1"}},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func metric(m ...func(*cloudlogging.LogMetric)) *cloudlogging.LogMetric {
+	lm := &cloudlogging.LogMetric{
+		Name:           "lb-latency",
+		Filter:         "resource.type=\"http_load_balancer\"",
+		ValueExtractor: "EXTRACT(httpRequest.latency)",
+		LabelExtractors: map[string]string{
+			"status": "EXTRACT(httpRequest.status)",
+		},
+		MetricDescriptor: &cloudlogging.MetricDescriptor{
+			MetricKind: "DELTA",
+			ValueType:  "DISTRIBUTION",
+			Unit:       "s",
+			Labels:     []*cloudlogging.LabelDescriptor{{Key: "status"}},
+		},
+		BucketOptions: &cloudlogging.BucketOptions{
+			ExplicitBuckets: &cloudlogging.Explicit{Bounds: []float64{0.1, 0.5, 1}},
+		},
+	}
+	for _, f := range m {
+		f(lm)
+	}
+	return lm
+}
+
+func TestLogMetricNames(t *testing.T) {
+	parent := GetMetricParent(project, *metricParams())
+	if diff := cmp.Diff("projects/cool-project", parent); diff != "" {
+		t.Errorf("GetMetricParent(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("projects/cool-project/metrics/lb-latency", GetMetricName(parent, "lb-latency")); diff != "" {
+		t.Errorf("GetMetricName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateLogMetric(t *testing.T) {
+	type want struct {
+		m   *cloudlogging.LogMetric
+		err error
+	}
+	cases := map[string]struct {
+		p    *v1alpha1.LogMetricParameters
+		want want
+	}{
+		"Distribution": {
+			p:    metricParams(),
+			want: want{m: metric()},
+		},
+		"LinearBuckets": {
+			p: metricParams(func(p *v1alpha1.LogMetricParameters) {
+				p.BucketOptions = &v1alpha1.BucketOptions{LinearBuckets: &v1alpha1.LinearBuckets{
+					NumFiniteBuckets: 10,
+					Width:            "0.5",
+					Offset:           gcp.StringPtr("-1"),
+				}}
+			}),
+			want: want{m: metric(func(m *cloudlogging.LogMetric) {
+				m.BucketOptions = &cloudlogging.BucketOptions{LinearBuckets: &cloudlogging.Linear{
+					NumFiniteBuckets: 10,
+					Width:            0.5,
+					Offset:           -1,
+				}}
+			})},
+		},
+		"InvalidBound": {
+			p: metricParams(func(p *v1alpha1.LogMetricParameters) {
+				p.BucketOptions.ExplicitBuckets.Bounds = []string{"one"}
+			}),
+			want: want{err: errors.Wrap(errors.New(`strconv.ParseFloat: parsing "one": invalid syntax`), errParseBucketOptions)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GenerateLogMetric("lb-latency", *tc.p)
+			if diff := cmp.Diff(tc.want.m, got); diff != "" {
+				t.Errorf("GenerateLogMetric(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GenerateLogMetric(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeLogMetric(t *testing.T) {
+	got := &v1alpha1.LogMetricParameters{Filter: "severity>=ERROR"}
+	LateInitializeLogMetric(got, cloudlogging.LogMetric{
+		Filter:           "severity>=ERROR",
+		MetricDescriptor: &cloudlogging.MetricDescriptor{MetricKind: "DELTA", ValueType: "INT64", Unit: "1"},
+	})
+	want := &v1alpha1.LogMetricParameters{
+		Filter: "severity>=ERROR",
+		MetricDescriptor: &v1alpha1.MetricDescriptor{
+			MetricKind: gcp.StringPtr("DELTA"),
+			ValueType:  gcp.StringPtr("INT64"),
+			Unit:       gcp.StringPtr("1"),
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("LateInitializeLogMetric(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsLogMetricUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		m    *cloudlogging.LogMetric
+		want bool
+	}{
+		"UpToDate": {
+			m: metric(func(m *cloudlogging.LogMetric) {
+				m.MetricDescriptor.Type = "logging.googleapis.com/user/lb-latency"
+				m.CreateTime = "2021-05-11T08:00:00Z"
+			}),
+			want: true,
+		},
+		"FilterDiffers": {
+			m: metric(func(m *cloudlogging.LogMetric) { m.Filter = "severity>=ERROR" }),
+		},
+		"BoundsDiffer": {
+			m: metric(func(m *cloudlogging.LogMetric) { m.BucketOptions.ExplicitBuckets.Bounds = []float64{1} }),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsLogMetricUpToDate(*metricParams(), *tc.m)
+			if err != nil {
+				t.Fatalf("IsLogMetricUpToDate(...): unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("IsLogMetricUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+	o := v1alpha1.LogMetricObservation{MetricType: "logging.googleapis.com/user/lb-latency", CreateTime: "2021-05-11T08:00:00Z"}
+	if diff := cmp.Diff(o, GenerateLogMetricObservation(*cases["UpToDate"].m)); diff != "" {
+		t.Errorf("GenerateLogMetricObservation(...): -want, +got:\n%s", diff)
+	}
+}
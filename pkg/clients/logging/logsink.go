@@ -0,0 +1,116 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	cloudlogging "google.golang.org/api/logging/v2"
+
+	"github.com/crossplane/provider-gcp/apis/logging/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectParentPrefix = "projects/"
+	sinksSep            = "/sinks/"
+	topicFormat         = "pubsub.googleapis.com/projects/%s/topics/%s"
+
+	// LogSinkUpdateMask is the list of sink fields that can be updated with
+	// an update call.
+	LogSinkUpdateMask = "destination,filter,description,disabled,exclusions,includeChildren,bigqueryOptions"
+)
+
+// GetParent returns the parent of the supplied LogSinkParameters, falling
+// back to the supplied default project.
+func GetParent(defaultProject string, p v1alpha1.LogSinkParameters) string {
+	if p.Parent != nil {
+		return *p.Parent
+	}
+	return projectParentPrefix + defaultProject
+}
+
+// GetSinkName builds the fully qualified name of the sink with the supplied
+// ID of the supplied parent.
+func GetSinkName(parent, id string) string {
+	return parent + sinksSep + id
+}
+
+// GetDestination returns the destination of the supplied LogSinkParameters.
+// A Topic is looked up in the supplied default project.
+func GetDestination(defaultProject string, p v1alpha1.LogSinkParameters) string {
+	if p.Destination != nil || p.Topic == nil {
+		return gcp.StringValue(p.Destination)
+	}
+	return fmt.Sprintf(topicFormat, defaultProject, *p.Topic)
+}
+
+// GenerateLogSink produces a LogSink with the supplied ID that is configured
+// via the supplied LogSinkParameters.
+func GenerateLogSink(id, defaultProject string, p v1alpha1.LogSinkParameters) *cloudlogging.LogSink {
+	s := &cloudlogging.LogSink{
+		Name:            id,
+		Destination:     GetDestination(defaultProject, p),
+		Filter:          gcp.StringValue(p.Filter),
+		Description:     gcp.StringValue(p.Description),
+		Disabled:        gcp.BoolValue(p.Disabled),
+		IncludeChildren: gcp.BoolValue(p.IncludeChildren),
+	}
+	for _, e := range p.Exclusions {
+		s.Exclusions = append(s.Exclusions, &cloudlogging.LogExclusion{
+			Name:        e.Name,
+			Description: gcp.StringValue(e.Description),
+			Filter:      e.Filter,
+			Disabled:    gcp.BoolValue(e.Disabled),
+		})
+	}
+	if p.BigQueryOptions != nil {
+		s.BigqueryOptions = &cloudlogging.BigQueryOptions{UsePartitionedTables: p.BigQueryOptions.UsePartitionedTables}
+	}
+	return s
+}
+
+// GenerateLogSinkObservation produces a LogSinkObservation from the supplied
+// LogSink.
+func GenerateLogSinkObservation(s cloudlogging.LogSink) v1alpha1.LogSinkObservation {
+	return v1alpha1.LogSinkObservation{
+		WriterIdentity: s.WriterIdentity,
+		CreateTime:     s.CreateTime,
+		UpdateTime:     s.UpdateTime,
+	}
+}
+
+// LateInitializeLogSink fills the empty fields of the supplied
+// LogSinkParameters with the values of the supplied LogSink.
+func LateInitializeLogSink(p *v1alpha1.LogSinkParameters, s cloudlogging.LogSink) {
+	p.Filter = gcp.LateInitializeString(p.Filter, s.Filter)
+	p.Description = gcp.LateInitializeString(p.Description, s.Description)
+	p.Disabled = gcp.LateInitializeBool(p.Disabled, s.Disabled)
+	p.IncludeChildren = gcp.LateInitializeBool(p.IncludeChildren, s.IncludeChildren)
+}
+
+// IsLogSinkUpToDate returns true if the supplied LogSink matches the
+// supplied LogSinkParameters.
+func IsLogSinkUpToDate(defaultProject string, p v1alpha1.LogSinkParameters, s cloudlogging.LogSink) bool {
+	desired := GenerateLogSink(s.Name, defaultProject, p)
+	return cmp.Equal(desired, &s, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(cloudlogging.LogSink{}, "CreateTime", "UpdateTime", "WriterIdentity", "OutputVersionFormat", "ServerResponse", "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(cloudlogging.LogExclusion{}, "CreateTime", "UpdateTime", "ServerResponse", "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(cloudlogging.BigQueryOptions{}, "UsesTimestampColumnPartitioning", "ForceSendFields", "NullFields"))
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cloudlogging "google.golang.org/api/logging/v2"
+
+	"github.com/crossplane/provider-gcp/apis/logging/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const project = "cool-project"
+
+func params(m ...func(*v1alpha1.LogSinkParameters)) *v1alpha1.LogSinkParameters {
+	p := &v1alpha1.LogSinkParameters{
+		Destination: gcp.StringPtr("storage.googleapis.com/audit-logs"),
+		Filter:      gcp.StringPtr("logName:\"cloudaudit.googleapis.com\""),
+		Exclusions: []v1alpha1.LogExclusion{{
+			Name:   "no-gke",
+			Filter: "resource.type=\"k8s_container\"",
+		}},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func sink(m ...func(*cloudlogging.LogSink)) *cloudlogging.LogSink {
+	s := &cloudlogging.LogSink{
+		Name:        "audit",
+		Destination: "storage.googleapis.com/audit-logs",
+		Filter:      "logName:\"cloudaudit.googleapis.com\"",
+		Exclusions: []*cloudlogging.LogExclusion{{
+			Name:   "no-gke",
+			Filter: "resource.type=\"k8s_container\"",
+		}},
+	}
+	for _, f := range m {
+		f(s)
+	}
+	return s
+}
+
+func TestGetParent(t *testing.T) {
+	if diff := cmp.Diff("projects/"+project, GetParent(project, *params())); diff != "" {
+		t.Errorf("GetParent(...): -want, +got:\n%s", diff)
+	}
+	p := params(func(p *v1alpha1.LogSinkParameters) { p.Parent = gcp.StringPtr("folders/1234") })
+	if diff := cmp.Diff("folders/1234", GetParent(project, *p)); diff != "" {
+		t.Errorf("GetParent(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("folders/1234/sinks/audit", GetSinkName("folders/1234", "audit")); diff != "" {
+		t.Errorf("GetSinkName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetDestination(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.LogSinkParameters
+		want string
+	}{
+		"Destination": {
+			p:    *params(func(p *v1alpha1.LogSinkParameters) { p.Topic = gcp.StringPtr("logs") }),
+			want: "storage.googleapis.com/audit-logs",
+		},
+		"Topic": {
+			p: *params(func(p *v1alpha1.LogSinkParameters) {
+				p.Destination = nil
+				p.Topic = gcp.StringPtr("logs")
+			}),
+			want: "pubsub.googleapis.com/projects/cool-project/topics/logs",
+		},
+		"Neither": {
+			p: *params(func(p *v1alpha1.LogSinkParameters) { p.Destination = nil }),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GetDestination(project, tc.p)); diff != "" {
+				t.Errorf("GetDestination(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateLogSink(t *testing.T) {
+	p := params(func(p *v1alpha1.LogSinkParameters) {
+		p.BigQueryOptions = &v1alpha1.BigQueryOptions{UsePartitionedTables: true}
+	})
+	want := sink(func(s *cloudlogging.LogSink) {
+		s.BigqueryOptions = &cloudlogging.BigQueryOptions{UsePartitionedTables: true}
+	})
+	if diff := cmp.Diff(want, GenerateLogSink("audit", project, *p)); diff != "" {
+		t.Errorf("GenerateLogSink(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeLogSink(t *testing.T) {
+	got := params(func(p *v1alpha1.LogSinkParameters) { p.Filter = nil })
+	LateInitializeLogSink(got, *sink(func(s *cloudlogging.LogSink) { s.Description = "Audit logs" }))
+	want := params(func(p *v1alpha1.LogSinkParameters) { p.Description = gcp.StringPtr("Audit logs") })
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("LateInitializeLogSink(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsLogSinkUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		s    *cloudlogging.LogSink
+		want bool
+	}{
+		"UpToDate": {
+			s: sink(func(s *cloudlogging.LogSink) {
+				s.WriterIdentity = "serviceAccount:p123-456@gcp-sa-logging.iam.gserviceaccount.com"
+				s.CreateTime = "2021-05-11T08:00:00Z"
+			}),
+			want: true,
+		},
+		"FilterDiffers": {
+			s: sink(func(s *cloudlogging.LogSink) { s.Filter = "severity>=ERROR" }),
+		},
+		"ExclusionDisabled": {
+			s: sink(func(s *cloudlogging.LogSink) { s.Exclusions[0].Disabled = true }),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsLogSinkUpToDate(project, *params(), *tc.s); got != tc.want {
+				t.Errorf("IsLogSinkUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestGenerateLogSinkObservation(t *testing.T) {
+	s := sink(func(s *cloudlogging.LogSink) {
+		s.WriterIdentity = "serviceAccount:p123-456@gcp-sa-logging.iam.gserviceaccount.com"
+		s.CreateTime = "2021-05-11T08:00:00Z"
+		s.UpdateTime = "2021-05-12T08:00:00Z"
+	})
+	want := v1alpha1.LogSinkObservation{
+		WriterIdentity: "serviceAccount:p123-456@gcp-sa-logging.iam.gserviceaccount.com",
+		CreateTime:     "2021-05-11T08:00:00Z",
+		UpdateTime:     "2021-05-12T08:00:00Z",
+	}
+	if diff := cmp.Diff(want, GenerateLogSinkObservation(*s)); diff != "" {
+		t.Errorf("GenerateLogSinkObservation(...): -want, +got:\n%s", diff)
+	}
+}
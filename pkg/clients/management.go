@@ -0,0 +1,163 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// AnnotationKeyManagementPolicy is the annotation that sets the management
+// policy of a managed resource.
+const AnnotationKeyManagementPolicy = "crossplane.io/management-policy"
+
+// AnnotationKeyLateInitialization disables the late initialization of the
+// spec of a managed resource when set to LateInitializationDisabled.
+const AnnotationKeyLateInitialization = "gcp.crossplane.io/late-initialization"
+
+// LateInitializationDisabled disables late initialization. Fields that are
+// not set in the spec of a managed resource aren't filled in with the values
+// that GCP defaulted them to. The values are still reported in its status.
+// Controllers still late initialize the spec in memory to compare it with the
+// external resource, but never persist it.
+const LateInitializationDisabled = "Disabled"
+
+// A ManagementPolicy determines what a controller may do with the external
+// resource of a managed resource.
+type ManagementPolicy string
+
+// Management policies.
+const (
+	// ManagementFullControl lets the controller create, update and delete
+	// the external resource. This is the default.
+	ManagementFullControl ManagementPolicy = "FullControl"
+
+	// ManagementObserveOnly lets the controller observe an existing
+	// external resource, but never create, update or delete it.
+	ManagementObserveOnly ManagementPolicy = "ObserveOnly"
+)
+
+// Error strings.
+const (
+	errUnknownManagementPolicy = "unknown management policy"
+	errObserveOnlyNotFound     = "external resource of an ObserveOnly managed resource does not exist"
+	errObserveOnlyCreate       = "cannot create the external resource of an ObserveOnly managed resource"
+	errObserveOnlyUpdate       = "cannot update the external resource of an ObserveOnly managed resource"
+	errObserveOnlyDelete       = "cannot delete the external resource of an ObserveOnly managed resource"
+)
+
+// GetManagementPolicy returns the management policy of the supplied object.
+func GetManagementPolicy(o metav1.Object) ManagementPolicy {
+	p := ManagementPolicy(o.GetAnnotations()[AnnotationKeyManagementPolicy])
+	if p == "" {
+		return ManagementFullControl
+	}
+	return p
+}
+
+// IsLateInitializationDisabled returns true if the spec of the supplied object
+// must not be late initialized.
+func IsLateInitializationDisabled(o metav1.Object) bool {
+	return o.GetAnnotations()[AnnotationKeyLateInitialization] == LateInitializationDisabled
+}
+
+// NewManagementPolicyConnecter returns an ExternalConnecter whose external
+// clients honor the management policy of the managed resources they are
+// called with.
+func NewManagementPolicyConnecter(c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &wrappingConnecter{connecter: c, wrap: func(e managed.ExternalClient) managed.ExternalClient {
+		return &managementPolicyExternal{ExternalClient: e}
+	}}
+}
+
+type managementPolicyExternal struct {
+	managed.ExternalClient
+}
+
+// Observe observes the external resource. ObserveOnly managed resources are
+// always reported as up to date so that they are never updated, and as
+// nonexistent once they are deleted so that their finalizer is removed
+// without deleting the external resource.
+func (e *managementPolicyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	switch GetManagementPolicy(mg) {
+	case ManagementFullControl:
+		return e.ExternalClient.Observe(ctx, mg)
+	case ManagementObserveOnly:
+		if meta.WasDeleted(mg) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		o, err := e.ExternalClient.Observe(ctx, mg)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if !o.ResourceExists {
+			return managed.ExternalObservation{}, errors.New(errObserveOnlyNotFound)
+		}
+		o.ResourceUpToDate = true
+		return o, nil
+	}
+	return managed.ExternalObservation{}, errors.Errorf("%s: %s", errUnknownManagementPolicy, GetManagementPolicy(mg))
+}
+
+func (e *managementPolicyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	if GetManagementPolicy(mg) == ManagementObserveOnly {
+		return managed.ExternalCreation{}, errors.New(errObserveOnlyCreate)
+	}
+	return e.ExternalClient.Create(ctx, mg)
+}
+
+func (e *managementPolicyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if GetManagementPolicy(mg) == ManagementObserveOnly {
+		return managed.ExternalUpdate{}, errors.New(errObserveOnlyUpdate)
+	}
+	return e.ExternalClient.Update(ctx, mg)
+}
+
+func (e *managementPolicyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	if GetManagementPolicy(mg) == ManagementObserveOnly {
+		return errors.New(errObserveOnlyDelete)
+	}
+	return e.ExternalClient.Delete(ctx, mg)
+}
+
+// NewLateInitializationConnecter returns an ExternalConnecter whose external
+// clients never report managed resources whose late initialization is
+// disabled as late initialized, so that their late initialized spec isn't
+// persisted.
+func NewLateInitializationConnecter(c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &wrappingConnecter{connecter: c, wrap: func(e managed.ExternalClient) managed.ExternalClient {
+		return &lateInitializationExternal{ExternalClient: e}
+	}}
+}
+
+type lateInitializationExternal struct {
+	managed.ExternalClient
+}
+
+func (e *lateInitializationExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	if IsLateInitializationDisabled(mg) {
+		o.ResourceLateInitialized = false
+	}
+	return o, err
+}
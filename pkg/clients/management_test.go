@@ -0,0 +1,181 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func withPolicy(p ManagementPolicy, deleted bool) *fake.Managed {
+	mg := &fake.Managed{}
+	if p != "" {
+		mg.SetAnnotations(map[string]string{AnnotationKeyManagementPolicy: string(p)})
+	}
+	if deleted {
+		now := metav1.Now()
+		mg.SetDeletionTimestamp(&now)
+	}
+	return mg
+}
+
+func TestManagementPolicyObserve(t *testing.T) {
+	errBoom := errors.New("boom")
+	exists := managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		client managed.ExternalClient
+		mg     resource.Managed
+		want   want
+	}{
+		"FullControl": {
+			reason: "Resources without a management policy should be observed as is",
+			client: &managed.ExternalClientFns{ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+				return exists, nil
+			}},
+			mg:   withPolicy("", false),
+			want: want{o: exists},
+		},
+		"Unknown": {
+			reason: "Should return an error if the management policy is unknown",
+			mg:     withPolicy("Bogus", false),
+			want:   want{err: errors.Errorf("%s: %s", errUnknownManagementPolicy, "Bogus")},
+		},
+		"ObserveOnlyFailed": {
+			reason: "Should return any error encountered observing an ObserveOnly resource",
+			client: &managed.ExternalClientFns{ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+				return managed.ExternalObservation{}, errBoom
+			}},
+			mg:   withPolicy(ManagementObserveOnly, false),
+			want: want{err: errBoom},
+		},
+		"ObserveOnlyNotFound": {
+			reason: "Should return an error rather than create the external resource of an ObserveOnly resource",
+			client: &managed.ExternalClientFns{ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+				return managed.ExternalObservation{ResourceExists: false}, nil
+			}},
+			mg:   withPolicy(ManagementObserveOnly, false),
+			want: want{err: errors.New(errObserveOnlyNotFound)},
+		},
+		"ObserveOnlyExists": {
+			reason: "Existing ObserveOnly resources should always be reported as up to date",
+			client: &managed.ExternalClientFns{ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+				return exists, nil
+			}},
+			mg:   withPolicy(ManagementObserveOnly, false),
+			want: want{o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"ObserveOnlyDeleted": {
+			reason: "Deleted ObserveOnly resources should be reported as nonexistent without being observed",
+			mg:     withPolicy(ManagementObserveOnly, true),
+			want:   want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &managementPolicyExternal{ExternalClient: tc.client}
+			o, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestManagementPolicyObserveOnlyWrites(t *testing.T) {
+	e := &managementPolicyExternal{ExternalClient: &managed.ExternalClientFns{
+		CreateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+			t.Error("Create must not be called for ObserveOnly resources")
+			return managed.ExternalCreation{}, nil
+		},
+		UpdateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+			t.Error("Update must not be called for ObserveOnly resources")
+			return managed.ExternalUpdate{}, nil
+		},
+		DeleteFn: func(_ context.Context, _ resource.Managed) error {
+			t.Error("Delete must not be called for ObserveOnly resources")
+			return nil
+		},
+	}}
+	mg := withPolicy(ManagementObserveOnly, false)
+
+	if _, err := e.Create(context.Background(), mg); err == nil {
+		t.Error("e.Create(...): expected an error for an ObserveOnly resource")
+	}
+	if _, err := e.Update(context.Background(), mg); err == nil {
+		t.Error("e.Update(...): expected an error for an ObserveOnly resource")
+	}
+	if err := e.Delete(context.Background(), mg); err == nil {
+		t.Error("e.Delete(...): expected an error for an ObserveOnly resource")
+	}
+}
+
+func TestLateInitializationObserve(t *testing.T) {
+	lateInitialized := managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true}
+
+	cases := map[string]struct {
+		reason      string
+		annotations map[string]string
+		want        managed.ExternalObservation
+	}{
+		"Enabled": {
+			reason: "Resources should be reported as late initialized as observed by default",
+			want:   lateInitialized,
+		},
+		"Disabled": {
+			reason:      "Resources whose late initialization is disabled should never be reported as late initialized",
+			annotations: map[string]string{AnnotationKeyLateInitialization: LateInitializationDisabled},
+			want:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &lateInitializationExternal{ExternalClient: &managed.ExternalClientFns{ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+				return lateInitialized, nil
+			}}}
+			mg := &fake.Managed{}
+			mg.SetAnnotations(tc.annotations)
+			o, err := e.Observe(context.Background(), mg)
+			if err != nil {
+				t.Fatalf("\n%s\ne.Observe(...): %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, o); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const metricsNamespace = "provider_gcp"
+
+var (
+	apiRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "api",
+		Name:      "requests_total",
+		Help:      "Total number of requests sent to GCP APIs, by service, HTTP method and status code.",
+	}, []string{"service", "method", "code"})
+
+	apiRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "api",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of requests sent to GCP APIs, by service and HTTP method.",
+		Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+	}, []string{"service", "method"})
+
+	reconcileErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "managed",
+		Name:      "reconcile_errors_total",
+		Help:      "Total number of reconciles of managed resources that failed, by kind.",
+	}, []string{"kind"})
+
+	externalErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "managed",
+		Name:      "external_errors_total",
+		Help:      "Total number of errors returned by external clients of managed resources, by kind and error class.",
+	}, []string{"kind", "class"})
+)
+
+func init() {
+	// The controller manager serves the metrics of this registry on its
+	// metrics endpoint.
+	metrics.Registry.MustRegister(apiRequests, apiRequestDuration, reconcileErrors, externalErrors)
+}
+
+// instrumentedTransport records metrics of the requests it sends to the API of
+// a GCP service. Every attempt of a retried request is recorded, because each
+// of them counts against the API's quota.
+type instrumentedTransport struct {
+	service string
+	base    http.RoundTripper
+}
+
+func (t *instrumentedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	rsp, err := t.base.RoundTrip(req)
+	apiRequestDuration.WithLabelValues(t.service, req.Method).Observe(time.Since(start).Seconds())
+	code := "error"
+	if err == nil {
+		code = strconv.Itoa(rsp.StatusCode)
+	}
+	apiRequests.WithLabelValues(t.service, req.Method, code).Inc()
+	return rsp, err
+}
+
+// errorCountingClient counts the reconcile errors of the managed resources
+// whose status it updates, and marks the span of the reconcile as failed. The
+// managed reconciler doesn't return most errors, but reports them in the Synced
+// condition of the managed resource instead.
+type errorCountingClient struct {
+	client.Client
+	kind string
+}
+
+func (c *errorCountingClient) Status() client.StatusWriter {
+	return &errorCountingStatusWriter{StatusWriter: c.Client.Status(), kind: c.kind}
+}
+
+type errorCountingStatusWriter struct {
+	client.StatusWriter
+	kind string
+}
+
+func (w *errorCountingStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	if c, ok := obj.(resource.Conditioned); ok {
+		if s := c.GetCondition(xpv1.TypeSynced); s.Reason == xpv1.ReasonReconcileError {
+			reconcileErrors.WithLabelValues(w.kind).Inc()
+			trace.SpanFromContext(ctx).SetStatus(codes.Error, s.Message)
+		}
+	}
+	return w.StatusWriter.Update(ctx, obj, opts...)
+}
+
+// errorCountingManager is a manager whose client counts reconcile errors.
+type errorCountingManager struct {
+	ctrl.Manager
+	client client.Client
+}
+
+func (m *errorCountingManager) GetClient() client.Client {
+	return m.client
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestInstrumentedTransport(t *testing.T) {
+	throttled := roundTripperFn(func(_ *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusTooManyRequests, Body: io.NopCloser(&bytes.Buffer{})}, nil
+	})
+	failed := roundTripperFn(func(_ *http.Request) (*http.Response, error) {
+		return nil, errors.New("boom")
+	})
+	req, _ := http.NewRequest(http.MethodGet, "https://example.org", nil)
+
+	before := testutil.ToFloat64(apiRequests.WithLabelValues("compute", http.MethodGet, "429"))
+	_, _ = (&instrumentedTransport{service: "compute", base: throttled}).RoundTrip(req)
+	if got := testutil.ToFloat64(apiRequests.WithLabelValues("compute", http.MethodGet, "429")) - before; got != 1 {
+		t.Errorf("RoundTrip(...): want 1 request with status code 429, got %v", got)
+	}
+
+	before = testutil.ToFloat64(apiRequests.WithLabelValues("compute", http.MethodGet, "error"))
+	_, _ = (&instrumentedTransport{service: "compute", base: failed}).RoundTrip(req)
+	if got := testutil.ToFloat64(apiRequests.WithLabelValues("compute", http.MethodGet, "error")) - before; got != 1 {
+		t.Errorf("RoundTrip(...): want 1 failed request, got %v", got)
+	}
+}
+
+func TestErrorCountingStatusWriter(t *testing.T) {
+	const kind = "Fake.example.org"
+	c := &errorCountingClient{Client: &test.MockClient{MockStatusUpdate: test.NewMockStatusUpdateFn(nil)}, kind: kind}
+
+	cases := map[string]struct {
+		reason    string
+		condition xpv1.Condition
+		want      float64
+	}{
+		"Success": {
+			reason:    "Successful reconciles should not be counted",
+			condition: xpv1.ReconcileSuccess(),
+			want:      0,
+		},
+		"Error": {
+			reason:    "Failed reconciles should be counted",
+			condition: xpv1.ReconcileError(errors.New("boom")),
+			want:      1,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{}
+			mg.SetConditions(tc.condition)
+			before := testutil.ToFloat64(reconcileErrors.WithLabelValues(kind))
+			if err := c.Status().Update(context.Background(), mg); err != nil {
+				t.Fatalf("\n%s\nStatus().Update(...): %s", tc.reason, err)
+			}
+			if got := testutil.ToFloat64(reconcileErrors.WithLabelValues(kind)) - before; got != tc.want {
+				t.Errorf("\n%s\nStatus().Update(...): want %v reconcile errors, got %v", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,278 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"strconv"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	monitoring "google.golang.org/api/monitoring/v3"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane/provider-gcp/apis/monitoring/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	alertPolicySep = "/alertPolicies/"
+
+	errParseThresholdValue = "cannot parse threshold value of condition %q"
+	errParseTriggerPercent = "cannot parse trigger percent of condition %q"
+)
+
+// AlertPolicyUpdateMask is the set of AlertPolicy fields that can be updated
+// in place. Conditions that keep their name are updated in place, the others
+// are replaced.
+const AlertPolicyUpdateMask = "displayName,documentation,userLabels,conditions,combiner,enabled,notificationChannels,severity,alertStrategy"
+
+// GetAlertPolicyParent returns the project of the supplied
+// AlertPolicyParameters in the form projects/{project}, falling back to the
+// supplied default project.
+func GetAlertPolicyParent(defaultProject string, p v1alpha1.AlertPolicyParameters) string {
+	if p.Project != nil {
+		return projectPrefix + *p.Project
+	}
+	return projectPrefix + defaultProject
+}
+
+// GetAlertPolicyName builds the fully qualified name of the policy with the
+// supplied ID in the supplied parent.
+func GetAlertPolicyName(parent, id string) string {
+	return parent + alertPolicySep + id
+}
+
+// GenerateAlertPolicy produces an AlertPolicy that is configured via the
+// supplied AlertPolicyParameters. Conditions are given the names of the
+// observed conditions with the same display name so that they are updated in
+// place.
+func GenerateAlertPolicy(p v1alpha1.AlertPolicyParameters, o v1alpha1.AlertPolicyObservation) (*monitoring.AlertPolicy, error) {
+	a := &monitoring.AlertPolicy{
+		DisplayName:          p.DisplayName,
+		UserLabels:           p.UserLabels,
+		Combiner:             p.Combiner,
+		NotificationChannels: p.NotificationChannels,
+		Severity:             gcp.StringValue(p.Severity),
+	}
+	if d := p.Documentation; d != nil {
+		a.Documentation = &monitoring.Documentation{
+			Content:  gcp.StringValue(d.Content),
+			MimeType: gcp.StringValue(d.MimeType),
+			Subject:  gcp.StringValue(d.Subject),
+		}
+	}
+	if p.Enabled != nil {
+		a.Enabled = *p.Enabled
+		a.ForceSendFields = []string{"Enabled"}
+	}
+	if s := p.AlertStrategy; s != nil {
+		a.AlertStrategy = &monitoring.AlertStrategy{AutoClose: gcp.StringValue(s.AutoClose)}
+	}
+	names := make(map[string]string, len(o.Conditions))
+	for _, c := range o.Conditions {
+		names[c.DisplayName] = c.Name
+	}
+	a.Conditions = make([]*monitoring.Condition, len(p.Conditions))
+	for i, c := range p.Conditions {
+		gc, err := generateCondition(c)
+		if err != nil {
+			return nil, err
+		}
+		gc.Name = names[c.DisplayName]
+		a.Conditions[i] = gc
+	}
+	return a, nil
+}
+
+func generateCondition(c v1alpha1.Condition) (*monitoring.Condition, error) { // nolint:gocyclo
+	gc := &monitoring.Condition{DisplayName: c.DisplayName}
+	if t := c.ConditionThreshold; t != nil {
+		gc.ConditionThreshold = &monitoring.MetricThreshold{
+			Filter:                  t.Filter,
+			Aggregations:            generateAggregations(t.Aggregations),
+			DenominatorFilter:       gcp.StringValue(t.DenominatorFilter),
+			DenominatorAggregations: generateAggregations(t.DenominatorAggregations),
+			Comparison:              t.Comparison,
+			Duration:                t.Duration,
+			EvaluationMissingData:   gcp.StringValue(t.EvaluationMissingData),
+		}
+		if t.ThresholdValue != nil {
+			v, err := strconv.ParseFloat(*t.ThresholdValue, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, errParseThresholdValue, c.DisplayName)
+			}
+			// A threshold of zero is meaningful, so we send it even though
+			// it is the default.
+			gc.ConditionThreshold.ThresholdValue = v
+			gc.ConditionThreshold.ForceSendFields = []string{"ThresholdValue"}
+		}
+		tr, err := generateTrigger(c.DisplayName, t.Trigger)
+		if err != nil {
+			return nil, err
+		}
+		gc.ConditionThreshold.Trigger = tr
+	}
+	if a := c.ConditionAbsent; a != nil {
+		tr, err := generateTrigger(c.DisplayName, a.Trigger)
+		if err != nil {
+			return nil, err
+		}
+		gc.ConditionAbsent = &monitoring.MetricAbsence{
+			Filter:       a.Filter,
+			Aggregations: generateAggregations(a.Aggregations),
+			Duration:     a.Duration,
+			Trigger:      tr,
+		}
+	}
+	if q := c.ConditionMonitoringQueryLanguage; q != nil {
+		tr, err := generateTrigger(c.DisplayName, q.Trigger)
+		if err != nil {
+			return nil, err
+		}
+		gc.ConditionMonitoringQueryLanguage = &monitoring.MonitoringQueryLanguageCondition{
+			Query:                 q.Query,
+			Duration:              q.Duration,
+			Trigger:               tr,
+			EvaluationMissingData: gcp.StringValue(q.EvaluationMissingData),
+		}
+	}
+	if q := c.ConditionPrometheusQueryLanguage; q != nil {
+		gc.ConditionPrometheusQueryLanguage = &monitoring.PrometheusQueryLanguageCondition{
+			Query:              q.Query,
+			Duration:           gcp.StringValue(q.Duration),
+			EvaluationInterval: gcp.StringValue(q.EvaluationInterval),
+			Labels:             q.Labels,
+			RuleGroup:          gcp.StringValue(q.RuleGroup),
+			AlertRule:          gcp.StringValue(q.AlertRule),
+		}
+	}
+	return gc, nil
+}
+
+func generateAggregations(in []v1alpha1.Aggregation) []*monitoring.Aggregation {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]*monitoring.Aggregation, len(in))
+	for i, a := range in {
+		out[i] = &monitoring.Aggregation{
+			AlignmentPeriod:    gcp.StringValue(a.AlignmentPeriod),
+			PerSeriesAligner:   gcp.StringValue(a.PerSeriesAligner),
+			CrossSeriesReducer: gcp.StringValue(a.CrossSeriesReducer),
+			GroupByFields:      a.GroupByFields,
+		}
+	}
+	return out
+}
+
+func generateTrigger(condition string, t *v1alpha1.Trigger) (*monitoring.Trigger, error) {
+	if t == nil {
+		return nil, nil
+	}
+	tr := &monitoring.Trigger{Count: gcp.Int64Value(t.Count)}
+	if t.Percent != nil {
+		v, err := strconv.ParseFloat(*t.Percent, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, errParseTriggerPercent, condition)
+		}
+		tr.Percent = v
+	}
+	return tr, nil
+}
+
+// GenerateAlertPolicyObservation produces an AlertPolicyObservation from the
+// supplied AlertPolicy.
+func GenerateAlertPolicyObservation(a monitoring.AlertPolicy) v1alpha1.AlertPolicyObservation {
+	o := v1alpha1.AlertPolicyObservation{Name: a.Name}
+	for _, c := range a.Conditions {
+		o.Conditions = append(o.Conditions, v1alpha1.ConditionObservation{Name: c.Name, DisplayName: c.DisplayName})
+	}
+	if a.CreationRecord != nil {
+		o.CreationTime = a.CreationRecord.MutateTime
+	}
+	if a.MutationRecord != nil {
+		o.MutationTime = a.MutationRecord.MutateTime
+	}
+	return o
+}
+
+// LateInitializeAlertPolicy fills the empty fields of the supplied
+// AlertPolicyParameters with the values of the supplied AlertPolicy.
+func LateInitializeAlertPolicy(p *v1alpha1.AlertPolicyParameters, a monitoring.AlertPolicy) {
+	if p.Enabled == nil {
+		p.Enabled = gcp.BoolPtr(a.Enabled)
+	}
+	p.Severity = gcp.LateInitializeString(p.Severity, a.Severity)
+	if a.Documentation != nil && p.Documentation != nil {
+		p.Documentation.MimeType = gcp.LateInitializeString(p.Documentation.MimeType, a.Documentation.MimeType)
+	}
+	if a.AlertStrategy != nil && a.AlertStrategy.AutoClose != "" {
+		if p.AlertStrategy == nil {
+			p.AlertStrategy = &v1alpha1.AlertStrategy{}
+		}
+		p.AlertStrategy.AutoClose = gcp.LateInitializeString(p.AlertStrategy.AutoClose, a.AlertStrategy.AutoClose)
+	}
+	// Cloud Monitoring defaults the trigger of a condition to a count of one
+	// time series.
+	observed := make(map[string]*monitoring.Condition, len(a.Conditions))
+	for _, c := range a.Conditions {
+		observed[c.DisplayName] = c
+	}
+	for i := range p.Conditions {
+		c, ok := observed[p.Conditions[i].DisplayName]
+		if !ok {
+			continue
+		}
+		if t := p.Conditions[i].ConditionThreshold; t != nil && c.ConditionThreshold != nil {
+			t.Trigger = lateInitializeTrigger(t.Trigger, c.ConditionThreshold.Trigger)
+		}
+		if t := p.Conditions[i].ConditionAbsent; t != nil && c.ConditionAbsent != nil {
+			t.Trigger = lateInitializeTrigger(t.Trigger, c.ConditionAbsent.Trigger)
+		}
+		if t := p.Conditions[i].ConditionMonitoringQueryLanguage; t != nil && c.ConditionMonitoringQueryLanguage != nil {
+			t.Trigger = lateInitializeTrigger(t.Trigger, c.ConditionMonitoringQueryLanguage.Trigger)
+		}
+	}
+}
+
+func lateInitializeTrigger(t *v1alpha1.Trigger, from *monitoring.Trigger) *v1alpha1.Trigger {
+	if t != nil || from == nil || from.Count == 0 {
+		return t
+	}
+	return &v1alpha1.Trigger{Count: gcp.Int64Ptr(from.Count)}
+}
+
+// IsAlertPolicyUpToDate returns true if the supplied AlertPolicy matches the
+// supplied AlertPolicyParameters.
+func IsAlertPolicyUpToDate(p v1alpha1.AlertPolicyParameters, a monitoring.AlertPolicy) (bool, error) {
+	desired, err := GenerateAlertPolicy(p, v1alpha1.AlertPolicyObservation{})
+	if err != nil {
+		return false, err
+	}
+	return cmp.Equal(desired, &a, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(monitoring.AlertPolicy{}, "Name", "CreationRecord", "MutationRecord", "Validity", "ServerResponse", "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(monitoring.AlertStrategy{}, "NotificationChannelStrategy", "NotificationRateLimit", "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(monitoring.Documentation{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(monitoring.Condition{}, "Name", "ConditionMatchedLog", "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(monitoring.MetricThreshold{}, "ForecastOptions", "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(monitoring.MetricAbsence{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(monitoring.MonitoringQueryLanguageCondition{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(monitoring.PrometheusQueryLanguageCondition{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(monitoring.Aggregation{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(monitoring.Trigger{}, "ForceSendFields", "NullFields")), nil
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	monitoring "google.golang.org/api/monitoring/v3"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/monitoring/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	policyName    = "projects/cool-project/alertPolicies/5678"
+	conditionName = policyName + "/conditions/90"
+)
+
+func policyParams(m ...func(*v1alpha1.AlertPolicyParameters)) *v1alpha1.AlertPolicyParameters {
+	p := &v1alpha1.AlertPolicyParameters{
+		DisplayName: "High error rate",
+		Combiner:    "OR",
+		Enabled:     gcp.BoolPtr(true),
+		Conditions: []v1alpha1.Condition{
+			{
+				DisplayName: "Error rate",
+				ConditionThreshold: &v1alpha1.MetricThreshold{
+					Filter: "metric.type=\"logging.googleapis.com/user/errors\"",
+					Aggregations: []v1alpha1.Aggregation{{
+						AlignmentPeriod:  gcp.StringPtr("60s"),
+						PerSeriesAligner: gcp.StringPtr("ALIGN_RATE"),
+					}},
+					Comparison:     "COMPARISON_GT",
+					ThresholdValue: gcp.StringPtr("0.5"),
+					Duration:       "300s",
+					Trigger:        &v1alpha1.Trigger{Count: gcp.Int64Ptr(1)},
+				},
+			},
+			{
+				DisplayName: "Latency",
+				ConditionPrometheusQueryLanguage: &v1alpha1.PrometheusQueryLanguageCondition{
+					Query: "histogram_quantile(0.99, rate(latency_bucket[5m])) > 1",
+				},
+			},
+		},
+		NotificationChannels: []string{"projects/cool-project/notificationChannels/1234"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func policy(m ...func(*monitoring.AlertPolicy)) *monitoring.AlertPolicy {
+	a := &monitoring.AlertPolicy{
+		Name:        policyName,
+		DisplayName: "High error rate",
+		Combiner:    "OR",
+		Enabled:     true,
+		Conditions: []*monitoring.Condition{
+			{
+				Name:        conditionName,
+				DisplayName: "Error rate",
+				ConditionThreshold: &monitoring.MetricThreshold{
+					Filter: "metric.type=\"logging.googleapis.com/user/errors\"",
+					Aggregations: []*monitoring.Aggregation{{
+						AlignmentPeriod:  "60s",
+						PerSeriesAligner: "ALIGN_RATE",
+					}},
+					Comparison:     "COMPARISON_GT",
+					ThresholdValue: 0.5,
+					Duration:       "300s",
+					Trigger:        &monitoring.Trigger{Count: 1},
+				},
+			},
+			{
+				Name:        policyName + "/conditions/91",
+				DisplayName: "Latency",
+				ConditionPrometheusQueryLanguage: &monitoring.PrometheusQueryLanguageCondition{
+					Query: "histogram_quantile(0.99, rate(latency_bucket[5m])) > 1",
+				},
+			},
+		},
+		NotificationChannels: []string{"projects/cool-project/notificationChannels/1234"},
+		CreationRecord:       &monitoring.MutationRecord{MutateTime: "2021-05-11T08:00:00Z"},
+	}
+	for _, f := range m {
+		f(a)
+	}
+	return a
+}
+
+func TestAlertPolicyNames(t *testing.T) {
+	parent := GetAlertPolicyParent(project, *policyParams(func(p *v1alpha1.AlertPolicyParameters) {
+		p.Project = gcp.StringPtr("other-project")
+	}))
+	if diff := cmp.Diff("projects/other-project", parent); diff != "" {
+		t.Errorf("GetAlertPolicyParent(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("projects/other-project/alertPolicies/5678", GetAlertPolicyName(parent, "5678")); diff != "" {
+		t.Errorf("GetAlertPolicyName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateAlertPolicy(t *testing.T) {
+	type want struct {
+		a   *monitoring.AlertPolicy
+		err error
+	}
+	cases := map[string]struct {
+		p    *v1alpha1.AlertPolicyParameters
+		o    v1alpha1.AlertPolicyObservation
+		want want
+	}{
+		"NewPolicy": {
+			p: policyParams(),
+			want: want{a: policy(func(a *monitoring.AlertPolicy) {
+				a.Name = ""
+				a.CreationRecord = nil
+				a.ForceSendFields = []string{"Enabled"}
+				a.Conditions[0].Name = ""
+				a.Conditions[0].ConditionThreshold.ForceSendFields = []string{"ThresholdValue"}
+				a.Conditions[1].Name = ""
+			})},
+		},
+		"ExistingCondition": {
+			p: policyParams(),
+			o: v1alpha1.AlertPolicyObservation{Conditions: []v1alpha1.ConditionObservation{
+				{Name: conditionName, DisplayName: "Error rate"},
+			}},
+			want: want{a: policy(func(a *monitoring.AlertPolicy) {
+				a.Name = ""
+				a.CreationRecord = nil
+				a.ForceSendFields = []string{"Enabled"}
+				a.Conditions[0].ConditionThreshold.ForceSendFields = []string{"ThresholdValue"}
+				a.Conditions[1].Name = ""
+			})},
+		},
+		"InvalidPercent": {
+			p: policyParams(func(p *v1alpha1.AlertPolicyParameters) {
+				p.Conditions[0].ConditionThreshold.Trigger = &v1alpha1.Trigger{Percent: gcp.StringPtr("half")}
+			}),
+			want: want{err: errors.Wrapf(errors.New(`strconv.ParseFloat: parsing "half": invalid syntax`), errParseTriggerPercent, "Error rate")},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GenerateAlertPolicy(*tc.p, tc.o)
+			if diff := cmp.Diff(tc.want.a, got); diff != "" {
+				t.Errorf("GenerateAlertPolicy(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GenerateAlertPolicy(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateAlertPolicyObservation(t *testing.T) {
+	want := v1alpha1.AlertPolicyObservation{
+		Name: policyName,
+		Conditions: []v1alpha1.ConditionObservation{
+			{Name: conditionName, DisplayName: "Error rate"},
+			{Name: policyName + "/conditions/91", DisplayName: "Latency"},
+		},
+		CreationTime: "2021-05-11T08:00:00Z",
+	}
+	if diff := cmp.Diff(want, GenerateAlertPolicyObservation(*policy())); diff != "" {
+		t.Errorf("GenerateAlertPolicyObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeAlertPolicy(t *testing.T) {
+	got := policyParams(func(p *v1alpha1.AlertPolicyParameters) {
+		p.Enabled = nil
+		p.Conditions[0].ConditionThreshold.Trigger = nil
+	})
+	LateInitializeAlertPolicy(got, *policy(func(a *monitoring.AlertPolicy) {
+		a.AlertStrategy = &monitoring.AlertStrategy{AutoClose: "604800s"}
+	}))
+	want := policyParams(func(p *v1alpha1.AlertPolicyParameters) {
+		p.AlertStrategy = &v1alpha1.AlertStrategy{AutoClose: gcp.StringPtr("604800s")}
+	})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("LateInitializeAlertPolicy(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsAlertPolicyUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		a    *monitoring.AlertPolicy
+		want bool
+	}{
+		"UpToDate": {
+			a:    policy(),
+			want: true,
+		},
+		"ThresholdDiffers": {
+			a: policy(func(a *monitoring.AlertPolicy) { a.Conditions[0].ConditionThreshold.ThresholdValue = 1 }),
+		},
+		"ConditionMissing": {
+			a: policy(func(a *monitoring.AlertPolicy) { a.Conditions = a.Conditions[:1] }),
+		},
+		"ChannelsDiffer": {
+			a: policy(func(a *monitoring.AlertPolicy) { a.NotificationChannels = nil }),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsAlertPolicyUpToDate(*policyParams(), *tc.a)
+			if err != nil {
+				t.Fatalf("IsAlertPolicyUpToDate(...): unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("IsAlertPolicyUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	dashboard "google.golang.org/api/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane/provider-gcp/apis/monitoring/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	dashboardSep = "/dashboards/"
+
+	errGetConfigMap     = "cannot get dashboard ConfigMap"
+	errNoDashboardKey   = "dashboard ConfigMap has no key %q"
+	errParseDashboard   = "cannot parse dashboard JSON"
+	errCompareDashboard = "cannot compare dashboard"
+)
+
+// GetDashboardParent returns the project of the supplied DashboardParameters
+// in the form projects/{project}, falling back to the supplied default
+// project.
+func GetDashboardParent(defaultProject string, p v1alpha1.DashboardParameters) string {
+	if p.Project != nil {
+		return projectPrefix + *p.Project
+	}
+	return projectPrefix + defaultProject
+}
+
+// GetDashboardName builds the fully qualified name of the dashboard with the
+// supplied ID in the supplied parent.
+func GetDashboardName(parent, id string) string {
+	return parent + dashboardSep + id
+}
+
+// GetDashboardJSON returns the JSON representation of the dashboard of the
+// supplied DashboardParameters, reading it from the referenced ConfigMap if
+// it is not inlined.
+func GetDashboardJSON(ctx context.Context, kube client.Reader, p v1alpha1.DashboardParameters) (string, error) {
+	if p.DashboardJSON != nil || p.DashboardJSONConfigMapRef == nil {
+		return gcp.StringValue(p.DashboardJSON), nil
+	}
+	ref := p.DashboardJSONConfigMapRef
+	cm := &corev1.ConfigMap{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, cm); err != nil {
+		return "", errors.Wrap(err, errGetConfigMap)
+	}
+	src, ok := cm.Data[ref.Key]
+	if !ok {
+		return "", errors.Errorf(errNoDashboardKey, ref.Key)
+	}
+	return src, nil
+}
+
+// GenerateDashboard produces a Dashboard from the supplied JSON
+// representation that is configured via the supplied DashboardParameters.
+// The name and etag of the JSON representation are dropped.
+func GenerateDashboard(src string, p v1alpha1.DashboardParameters) (*dashboard.Dashboard, error) {
+	d := &dashboard.Dashboard{}
+	if err := json.Unmarshal([]byte(src), d); err != nil {
+		return nil, errors.Wrap(err, errParseDashboard)
+	}
+	d.Name = ""
+	d.Etag = ""
+	if p.DisplayName != nil {
+		d.DisplayName = *p.DisplayName
+	}
+	return d, nil
+}
+
+// GenerateDashboardObservation produces a DashboardObservation from the
+// supplied Dashboard.
+func GenerateDashboardObservation(d dashboard.Dashboard) v1alpha1.DashboardObservation {
+	return v1alpha1.DashboardObservation{
+		Name: d.Name,
+		Etag: d.Etag,
+	}
+}
+
+// IsDashboardUpToDate returns true if the supplied Dashboard contains
+// everything that is described by the supplied desired Dashboard. Cloud
+// Monitoring fills in defaults for many widget options, so fields that are
+// only present in the observed Dashboard are not considered a difference.
+func IsDashboardUpToDate(desired, observed *dashboard.Dashboard) (bool, error) {
+	want, err := toJSONObject(desired)
+	if err != nil {
+		return false, errors.Wrap(err, errCompareDashboard)
+	}
+	got, err := toJSONObject(observed)
+	if err != nil {
+		return false, errors.Wrap(err, errCompareDashboard)
+	}
+	delete(got, "name")
+	delete(got, "etag")
+	return isSubset(want, got), nil
+}
+
+func toJSONObject(d *dashboard.Dashboard) (map[string]interface{}, error) {
+	b, err := json.Marshal(d)
+	if err != nil {
+		return nil, err
+	}
+	o := map[string]interface{}{}
+	return o, json.Unmarshal(b, &o)
+}
+
+// isSubset returns true if every field of the supplied JSON value want is
+// present with the same value in got. Arrays have to be of the same length.
+func isSubset(want, got interface{}) bool {
+	switch w := want.(type) {
+	case map[string]interface{}:
+		g, ok := got.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range w {
+			if !isSubset(v, g[k]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		g, ok := got.([]interface{})
+		if !ok || len(w) != len(g) {
+			return false
+		}
+		for i := range w {
+			if !isSubset(w[i], g[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(want, got)
+	}
+}
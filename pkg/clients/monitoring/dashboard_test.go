@@ -0,0 +1,218 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	dashboard "google.golang.org/api/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/monitoring/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const dashboardJSON = `{
+  "name": "projects/other/dashboards/old",
+  "etag": "abc",
+  "displayName": "Storefront",
+  "gridLayout": {
+    "columns": "2",
+    "widgets": [{"title": "Latency", "text": {"content": "p99 latency"}}]
+  }
+}`
+
+func dashboardParams(m ...func(*v1alpha1.DashboardParameters)) *v1alpha1.DashboardParameters {
+	p := &v1alpha1.DashboardParameters{
+		DashboardJSONConfigMapRef: &v1alpha1.ConfigMapKeySelector{Name: "cool-cm", Namespace: "cool-ns", Key: "dashboard.json"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func observedDashboard(m ...func(*dashboard.Dashboard)) *dashboard.Dashboard {
+	d := &dashboard.Dashboard{
+		Name:        "projects/cool-project/dashboards/1234",
+		Etag:        "def",
+		DisplayName: "Storefront",
+		GridLayout: &dashboard.GridLayout{
+			Columns: 2,
+			Widgets: []*dashboard.Widget{{Title: "Latency", Text: &dashboard.Text{Content: "p99 latency", Format: "MARKDOWN"}}},
+		},
+	}
+	for _, f := range m {
+		f(d)
+	}
+	return d
+}
+
+func TestDashboardNames(t *testing.T) {
+	parent := GetDashboardParent(project, *dashboardParams())
+	if diff := cmp.Diff("projects/cool-project", parent); diff != "" {
+		t.Errorf("GetDashboardParent(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(observedDashboard().Name, GetDashboardName(parent, "1234")); diff != "" {
+		t.Errorf("GetDashboardName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetDashboardJSON(t *testing.T) {
+	type want struct {
+		json string
+		err  error
+	}
+	cases := map[string]struct {
+		kube client.Reader
+		p    *v1alpha1.DashboardParameters
+		want want
+	}{
+		"Inline": {
+			p:    dashboardParams(func(p *v1alpha1.DashboardParameters) { p.DashboardJSON = gcp.StringPtr("{}") }),
+			want: want{json: "{}"},
+		},
+		"ConfigMap": {
+			kube: &test.MockClient{MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+				if diff := cmp.Diff(client.ObjectKey{Name: "cool-cm", Namespace: "cool-ns"}, key); diff != "" {
+					t.Errorf("Get(...): -want key, +got key:\n%s", diff)
+				}
+				obj.(*corev1.ConfigMap).Data = map[string]string{"dashboard.json": dashboardJSON}
+				return nil
+			}},
+			p:    dashboardParams(),
+			want: want{json: dashboardJSON},
+		},
+		"MissingKey": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+			p:    dashboardParams(),
+			want: want{err: errors.Errorf(errNoDashboardKey, "dashboard.json")},
+		},
+		"GetFailed": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			p:    dashboardParams(),
+			want: want{err: errors.Wrap(errBoom, errGetConfigMap)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GetDashboardJSON(context.Background(), tc.kube, *tc.p)
+			if diff := cmp.Diff(tc.want.json, got); diff != "" {
+				t.Errorf("GetDashboardJSON(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GetDashboardJSON(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateDashboard(t *testing.T) {
+	type want struct {
+		d   *dashboard.Dashboard
+		err error
+	}
+	cases := map[string]struct {
+		src  string
+		p    *v1alpha1.DashboardParameters
+		want want
+	}{
+		"Valid": {
+			src: dashboardJSON,
+			p:   dashboardParams(),
+			want: want{d: observedDashboard(func(d *dashboard.Dashboard) {
+				d.Name = ""
+				d.Etag = ""
+				d.GridLayout.Widgets[0].Text.Format = ""
+			})},
+		},
+		"DisplayNameOverride": {
+			src: dashboardJSON,
+			p:   dashboardParams(func(p *v1alpha1.DashboardParameters) { p.DisplayName = gcp.StringPtr("Checkout") }),
+			want: want{d: observedDashboard(func(d *dashboard.Dashboard) {
+				d.Name = ""
+				d.Etag = ""
+				d.DisplayName = "Checkout"
+				d.GridLayout.Widgets[0].Text.Format = ""
+			})},
+		},
+		"Invalid": {
+			src:  "{",
+			p:    dashboardParams(),
+			want: want{err: errors.Wrap(errors.New("unexpected end of JSON input"), errParseDashboard)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GenerateDashboard(tc.src, *tc.p)
+			if diff := cmp.Diff(tc.want.d, got); diff != "" {
+				t.Errorf("GenerateDashboard(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GenerateDashboard(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateDashboardObservation(t *testing.T) {
+	want := v1alpha1.DashboardObservation{Name: "projects/cool-project/dashboards/1234", Etag: "def"}
+	if diff := cmp.Diff(want, GenerateDashboardObservation(*observedDashboard())); diff != "" {
+		t.Errorf("GenerateDashboardObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsDashboardUpToDate(t *testing.T) {
+	desired, err := GenerateDashboard(dashboardJSON, *dashboardParams())
+	if err != nil {
+		t.Fatalf("GenerateDashboard(...): %v", err)
+	}
+	cases := map[string]struct {
+		d    *dashboard.Dashboard
+		want bool
+	}{
+		"UpToDate": {
+			d:    observedDashboard(),
+			want: true,
+		},
+		"TitleDiffers": {
+			d: observedDashboard(func(d *dashboard.Dashboard) { d.GridLayout.Widgets[0].Title = "Errors" }),
+		},
+		"WidgetAdded": {
+			d: observedDashboard(func(d *dashboard.Dashboard) {
+				d.GridLayout.Widgets = append(d.GridLayout.Widgets, &dashboard.Widget{Title: "Errors"})
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsDashboardUpToDate(desired, tc.d)
+			if err != nil {
+				t.Fatalf("IsDashboardUpToDate(...): %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("IsDashboardUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
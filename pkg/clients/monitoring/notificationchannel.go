@@ -0,0 +1,171 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"context"
+	"path"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	monitoring "google.golang.org/api/monitoring/v3"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane/provider-gcp/apis/monitoring/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectPrefix          = "projects/"
+	notificationChannelSep = "/notificationChannels/"
+
+	errGetSecret   = "cannot get Secret"
+	errNoSecretKey = "Secret has no key %q"
+)
+
+// NotificationChannelUpdateMask is the set of NotificationChannel fields that
+// can be updated in place.
+const NotificationChannelUpdateMask = "displayName,description,labels,userLabels,enabled"
+
+// GetNotificationChannelParent returns the project of the supplied
+// NotificationChannelParameters in the form projects/{project}, falling back
+// to the supplied default project.
+func GetNotificationChannelParent(defaultProject string, p v1alpha1.NotificationChannelParameters) string {
+	if p.Project != nil {
+		return projectPrefix + *p.Project
+	}
+	return projectPrefix + defaultProject
+}
+
+// GetNotificationChannelName builds the fully qualified name of the channel
+// with the supplied ID in the supplied parent.
+func GetNotificationChannelName(parent, id string) string {
+	return parent + notificationChannelSep + id
+}
+
+// GetID returns the ID of the supplied fully qualified resource name, which
+// is assigned by Cloud Monitoring.
+func GetID(name string) string {
+	return path.Base(name)
+}
+
+// GetSensitiveLabels reads the sensitive labels of the supplied
+// NotificationChannelParameters from the referenced Secrets.
+func GetSensitiveLabels(ctx context.Context, kube client.Reader, p v1alpha1.NotificationChannelParameters) (map[string]string, error) {
+	if p.SensitiveLabels == nil {
+		return nil, nil
+	}
+	labels := map[string]string{}
+	refs := map[string]*xpv1.SecretKeySelector{
+		v1alpha1.LabelAuthToken:  p.SensitiveLabels.AuthTokenSecretRef,
+		v1alpha1.LabelPassword:   p.SensitiveLabels.PasswordSecretRef,
+		v1alpha1.LabelServiceKey: p.SensitiveLabels.ServiceKeySecretRef,
+	}
+	for k, ref := range refs {
+		if ref == nil {
+			continue
+		}
+		v, err := getSecretValue(ctx, kube, *ref)
+		if err != nil {
+			return nil, err
+		}
+		labels[k] = v
+	}
+	return labels, nil
+}
+
+func getSecretValue(ctx context.Context, kube client.Reader, ref xpv1.SecretKeySelector) (string, error) {
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return "", errors.Wrap(err, errGetSecret)
+	}
+	v, ok := s.Data[ref.Key]
+	if !ok {
+		return "", errors.Errorf(errNoSecretKey, ref.Key)
+	}
+	return string(v), nil
+}
+
+// GenerateNotificationChannel produces a NotificationChannel that is
+// configured via the supplied NotificationChannelParameters and sensitive
+// labels.
+func GenerateNotificationChannel(p v1alpha1.NotificationChannelParameters, sensitive map[string]string) *monitoring.NotificationChannel {
+	c := &monitoring.NotificationChannel{
+		Type:        p.Type,
+		DisplayName: gcp.StringValue(p.DisplayName),
+		Description: gcp.StringValue(p.Description),
+		UserLabels:  p.UserLabels,
+	}
+	if len(p.Labels)+len(sensitive) > 0 {
+		c.Labels = make(map[string]string, len(p.Labels)+len(sensitive))
+		for k, v := range p.Labels {
+			c.Labels[k] = v
+		}
+		for k, v := range sensitive {
+			c.Labels[k] = v
+		}
+	}
+	if p.Enabled != nil {
+		c.Enabled = *p.Enabled
+		c.ForceSendFields = []string{"Enabled"}
+	}
+	return c
+}
+
+// GenerateNotificationChannelObservation produces a
+// NotificationChannelObservation from the supplied NotificationChannel.
+func GenerateNotificationChannelObservation(c monitoring.NotificationChannel) v1alpha1.NotificationChannelObservation {
+	return v1alpha1.NotificationChannelObservation{
+		Name:               c.Name,
+		VerificationStatus: c.VerificationStatus,
+	}
+}
+
+// LateInitializeNotificationChannel fills the empty fields of the supplied
+// NotificationChannelParameters with the values of the supplied
+// NotificationChannel.
+func LateInitializeNotificationChannel(p *v1alpha1.NotificationChannelParameters, c monitoring.NotificationChannel) {
+	p.DisplayName = gcp.LateInitializeString(p.DisplayName, c.DisplayName)
+	p.Description = gcp.LateInitializeString(p.Description, c.Description)
+	if p.Enabled == nil {
+		p.Enabled = gcp.BoolPtr(c.Enabled)
+	}
+}
+
+// IsNotificationChannelUpToDate returns true if the supplied
+// NotificationChannel matches the supplied NotificationChannelParameters.
+// Sensitive labels are obfuscated by Cloud Monitoring and not compared.
+func IsNotificationChannelUpToDate(p v1alpha1.NotificationChannelParameters, c monitoring.NotificationChannel) bool {
+	labels := map[string]string{}
+	for k, v := range c.Labels {
+		switch k {
+		case v1alpha1.LabelAuthToken, v1alpha1.LabelPassword, v1alpha1.LabelServiceKey:
+			continue
+		}
+		labels[k] = v
+	}
+	return gcp.StringValue(p.DisplayName) == c.DisplayName &&
+		gcp.StringValue(p.Description) == c.Description &&
+		(p.Enabled == nil || *p.Enabled == c.Enabled) &&
+		cmp.Equal(p.Labels, labels, cmpopts.EquateEmpty()) &&
+		cmp.Equal(p.UserLabels, c.UserLabels, cmpopts.EquateEmpty())
+}
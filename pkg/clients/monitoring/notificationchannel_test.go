@@ -0,0 +1,182 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	monitoring "google.golang.org/api/monitoring/v3"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/monitoring/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const project = "cool-project"
+
+var errBoom = errors.New("boom")
+
+func channelParams(m ...func(*v1alpha1.NotificationChannelParameters)) *v1alpha1.NotificationChannelParameters {
+	p := &v1alpha1.NotificationChannelParameters{
+		Type:        "slack",
+		DisplayName: gcp.StringPtr("On-call"),
+		Labels:      map[string]string{"channel_name": "#on-call"},
+		SensitiveLabels: &v1alpha1.SensitiveLabels{
+			AuthTokenSecretRef: &xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Name: "cool-secret", Namespace: "cool-ns"},
+				Key:             "token",
+			},
+		},
+		Enabled: gcp.BoolPtr(true),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func channel(m ...func(*monitoring.NotificationChannel)) *monitoring.NotificationChannel {
+	c := &monitoring.NotificationChannel{
+		Name:        "projects/cool-project/notificationChannels/1234",
+		Type:        "slack",
+		DisplayName: "On-call",
+		Labels:      map[string]string{"channel_name": "#on-call", "auth_token": "**********"},
+		Enabled:     true,
+	}
+	for _, f := range m {
+		f(c)
+	}
+	return c
+}
+
+func TestNotificationChannelNames(t *testing.T) {
+	parent := GetNotificationChannelParent(project, *channelParams())
+	if diff := cmp.Diff("projects/cool-project", parent); diff != "" {
+		t.Errorf("GetNotificationChannelParent(...): -want, +got:\n%s", diff)
+	}
+	name := GetNotificationChannelName(parent, "1234")
+	if diff := cmp.Diff(channel().Name, name); diff != "" {
+		t.Errorf("GetNotificationChannelName(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("1234", GetID(name)); diff != "" {
+		t.Errorf("GetID(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetSensitiveLabels(t *testing.T) {
+	type want struct {
+		labels map[string]string
+		err    error
+	}
+	secret := func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+		if diff := cmp.Diff(client.ObjectKey{Name: "cool-secret", Namespace: "cool-ns"}, key); diff != "" {
+			t.Errorf("Get(...): -want key, +got key:\n%s", diff)
+		}
+		obj.(*corev1.Secret).Data = map[string][]byte{"token": []byte("xoxb-cool")}
+		return nil
+	}
+	cases := map[string]struct {
+		kube client.Reader
+		p    *v1alpha1.NotificationChannelParameters
+		want want
+	}{
+		"AuthToken": {
+			kube: &test.MockClient{MockGet: secret},
+			p:    channelParams(),
+			want: want{labels: map[string]string{v1alpha1.LabelAuthToken: "xoxb-cool"}},
+		},
+		"NoSensitiveLabels": {
+			p: channelParams(func(p *v1alpha1.NotificationChannelParameters) { p.SensitiveLabels = nil }),
+		},
+		"MissingKey": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+			p:    channelParams(),
+			want: want{err: errors.Errorf(errNoSecretKey, "token")},
+		},
+		"GetFailed": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			p:    channelParams(),
+			want: want{err: errors.Wrap(errBoom, errGetSecret)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GetSensitiveLabels(context.Background(), tc.kube, *tc.p)
+			if diff := cmp.Diff(tc.want.labels, got); diff != "" {
+				t.Errorf("GetSensitiveLabels(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GetSensitiveLabels(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateNotificationChannel(t *testing.T) {
+	want := channel(func(c *monitoring.NotificationChannel) {
+		c.Name = ""
+		c.Labels = map[string]string{"channel_name": "#on-call", "auth_token": "xoxb-cool"}
+		c.ForceSendFields = []string{"Enabled"}
+	})
+	got := GenerateNotificationChannel(*channelParams(), map[string]string{v1alpha1.LabelAuthToken: "xoxb-cool"})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateNotificationChannel(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeNotificationChannel(t *testing.T) {
+	got := channelParams(func(p *v1alpha1.NotificationChannelParameters) {
+		p.DisplayName = nil
+		p.Enabled = nil
+	})
+	LateInitializeNotificationChannel(got, *channel())
+	if diff := cmp.Diff(channelParams(), got); diff != "" {
+		t.Errorf("LateInitializeNotificationChannel(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsNotificationChannelUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		c    *monitoring.NotificationChannel
+		want bool
+	}{
+		"UpToDate": {
+			c:    channel(),
+			want: true,
+		},
+		"LabelsDiffer": {
+			c: channel(func(c *monitoring.NotificationChannel) { c.Labels["channel_name"] = "#alerts" }),
+		},
+		"Disabled": {
+			c: channel(func(c *monitoring.NotificationChannel) { c.Enabled = false }),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsNotificationChannelUpToDate(*channelParams(), *tc.c); got != tc.want {
+				t.Errorf("IsNotificationChannelUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
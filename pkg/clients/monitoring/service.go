@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	monitoring "google.golang.org/api/monitoring/v3"
+
+	"github.com/crossplane/provider-gcp/apis/monitoring/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const serviceSep = "/services/"
+
+// ServiceUpdateMask is the set of Service fields that can be updated in
+// place. Everything that identifies the monitored service is immutable.
+const ServiceUpdateMask = "displayName,userLabels"
+
+// GetServiceParent returns the project of the supplied ServiceParameters in
+// the form projects/{project}, falling back to the supplied default project.
+func GetServiceParent(defaultProject string, p v1alpha1.ServiceParameters) string {
+	if p.Project != nil {
+		return projectPrefix + *p.Project
+	}
+	return projectPrefix + defaultProject
+}
+
+// GetServiceName builds the fully qualified name of the service with the
+// supplied ID in the supplied parent.
+func GetServiceName(parent, id string) string {
+	return parent + serviceSep + id
+}
+
+// GenerateService produces a Service that is configured via the supplied
+// ServiceParameters.
+func GenerateService(p v1alpha1.ServiceParameters) *monitoring.MService {
+	s := &monitoring.MService{
+		DisplayName: gcp.StringValue(p.DisplayName),
+		UserLabels:  p.UserLabels,
+	}
+	if p.Custom != nil {
+		s.Custom = &monitoring.Custom{}
+	}
+	if c := p.CloudRun; c != nil {
+		s.CloudRun = &monitoring.CloudRun{
+			ServiceName: gcp.StringValue(c.ServiceName),
+			Location:    c.Location,
+		}
+	}
+	if g := p.GKEService; g != nil {
+		s.GkeService = &monitoring.GkeService{
+			Location:      g.Location,
+			ClusterName:   gcp.StringValue(g.ClusterName),
+			NamespaceName: g.NamespaceName,
+			ServiceName:   g.ServiceName,
+		}
+	}
+	if g := p.GKEWorkload; g != nil {
+		s.GkeWorkload = &monitoring.GkeWorkload{
+			Location:               g.Location,
+			ClusterName:            gcp.StringValue(g.ClusterName),
+			NamespaceName:          g.NamespaceName,
+			TopLevelControllerType: g.TopLevelControllerType,
+			TopLevelControllerName: g.TopLevelControllerName,
+		}
+	}
+	return s
+}
+
+// GenerateServiceObservation produces a ServiceObservation from the supplied
+// Service.
+func GenerateServiceObservation(s monitoring.MService) v1alpha1.ServiceObservation {
+	o := v1alpha1.ServiceObservation{Name: s.Name}
+	if s.Telemetry != nil {
+		o.TelemetryResourceName = s.Telemetry.ResourceName
+	}
+	return o
+}
+
+// LateInitializeService fills the empty fields of the supplied
+// ServiceParameters with the values of the supplied Service.
+func LateInitializeService(p *v1alpha1.ServiceParameters, s monitoring.MService) {
+	p.DisplayName = gcp.LateInitializeString(p.DisplayName, s.DisplayName)
+}
+
+// IsServiceUpToDate returns true if the supplied Service matches the
+// supplied ServiceParameters. Only the fields of ServiceUpdateMask are
+// compared.
+func IsServiceUpToDate(p v1alpha1.ServiceParameters, s monitoring.MService) bool {
+	return gcp.StringValue(p.DisplayName) == s.DisplayName &&
+		cmp.Equal(p.UserLabels, s.UserLabels, cmpopts.EquateEmpty())
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	monitoring "google.golang.org/api/monitoring/v3"
+
+	"github.com/crossplane/provider-gcp/apis/monitoring/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+func serviceParams(m ...func(*v1alpha1.ServiceParameters)) *v1alpha1.ServiceParameters {
+	p := &v1alpha1.ServiceParameters{
+		DisplayName: gcp.StringPtr("Checkout"),
+		GKEService: &v1alpha1.GKEService{
+			GKECluster: v1alpha1.GKECluster{
+				Location:      "europe-west1",
+				ClusterName:   gcp.StringPtr("prod"),
+				NamespaceName: "shop",
+			},
+			ServiceName: "checkout",
+		},
+		UserLabels: map[string]string{"team": "payments"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func service(m ...func(*monitoring.MService)) *monitoring.MService {
+	s := &monitoring.MService{
+		Name:        "projects/cool-project/services/checkout",
+		DisplayName: "Checkout",
+		GkeService: &monitoring.GkeService{
+			ProjectId:     project,
+			Location:      "europe-west1",
+			ClusterName:   "prod",
+			NamespaceName: "shop",
+			ServiceName:   "checkout",
+		},
+		Telemetry:  &monitoring.Telemetry{ResourceName: "//container.googleapis.com/projects/cool-project/locations/europe-west1/clusters/prod/k8s/namespaces/shop/services/checkout"},
+		UserLabels: map[string]string{"team": "payments"},
+	}
+	for _, f := range m {
+		f(s)
+	}
+	return s
+}
+
+func TestServiceNames(t *testing.T) {
+	parent := GetServiceParent(project, *serviceParams())
+	if diff := cmp.Diff("projects/cool-project", parent); diff != "" {
+		t.Errorf("GetServiceParent(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(service().Name, GetServiceName(parent, "checkout")); diff != "" {
+		t.Errorf("GetServiceName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateService(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.ServiceParameters
+		want *monitoring.MService
+	}{
+		"GKEService": {
+			p: serviceParams(),
+			want: &monitoring.MService{
+				DisplayName: "Checkout",
+				GkeService: &monitoring.GkeService{
+					Location:      "europe-west1",
+					ClusterName:   "prod",
+					NamespaceName: "shop",
+					ServiceName:   "checkout",
+				},
+				UserLabels: map[string]string{"team": "payments"},
+			},
+		},
+		"CloudRun": {
+			p: serviceParams(func(p *v1alpha1.ServiceParameters) {
+				p.GKEService = nil
+				p.CloudRun = &v1alpha1.CloudRunService{ServiceName: gcp.StringPtr("checkout"), Location: "europe-west1"}
+			}),
+			want: &monitoring.MService{
+				DisplayName: "Checkout",
+				CloudRun:    &monitoring.CloudRun{ServiceName: "checkout", Location: "europe-west1"},
+				UserLabels:  map[string]string{"team": "payments"},
+			},
+		},
+		"Custom": {
+			p: serviceParams(func(p *v1alpha1.ServiceParameters) {
+				p.GKEService = nil
+				p.Custom = &v1alpha1.CustomService{}
+			}),
+			want: &monitoring.MService{
+				DisplayName: "Checkout",
+				Custom:      &monitoring.Custom{},
+				UserLabels:  map[string]string{"team": "payments"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateService(*tc.p)); diff != "" {
+				t.Errorf("GenerateService(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateServiceObservation(t *testing.T) {
+	want := v1alpha1.ServiceObservation{
+		Name:                  service().Name,
+		TelemetryResourceName: service().Telemetry.ResourceName,
+	}
+	if diff := cmp.Diff(want, GenerateServiceObservation(*service())); diff != "" {
+		t.Errorf("GenerateServiceObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeService(t *testing.T) {
+	got := serviceParams(func(p *v1alpha1.ServiceParameters) { p.DisplayName = nil })
+	LateInitializeService(got, *service())
+	if diff := cmp.Diff(serviceParams(), got); diff != "" {
+		t.Errorf("LateInitializeService(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsServiceUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		s    *monitoring.MService
+		want bool
+	}{
+		"UpToDate": {
+			s:    service(),
+			want: true,
+		},
+		"DisplayNameDiffers": {
+			s: service(func(s *monitoring.MService) { s.DisplayName = "Cart" }),
+		},
+		"UserLabelsDiffer": {
+			s: service(func(s *monitoring.MService) { s.UserLabels = nil }),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsServiceUpToDate(*serviceParams(), *tc.s); got != tc.want {
+				t.Errorf("IsServiceUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
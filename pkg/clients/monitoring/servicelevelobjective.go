@@ -0,0 +1,219 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"strconv"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	monitoring "google.golang.org/api/monitoring/v3"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane/provider-gcp/apis/monitoring/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	serviceLevelObjectiveSep = "/serviceLevelObjectives/"
+
+	errParseGoal      = "cannot parse goal"
+	errParseThreshold = "cannot parse performance threshold"
+	errParseRange     = "cannot parse range"
+)
+
+// ServiceLevelObjectiveUpdateMask is the set of ServiceLevelObjective fields
+// that can be updated in place.
+const ServiceLevelObjectiveUpdateMask = "displayName,goal,rollingPeriod,calendarPeriod,serviceLevelIndicator,userLabels"
+
+// GetServiceLevelObjectiveName builds the fully qualified name of the
+// objective with the supplied ID of the supplied service.
+func GetServiceLevelObjectiveName(service, id string) string {
+	return service + serviceLevelObjectiveSep + id
+}
+
+// GenerateServiceLevelObjective produces a ServiceLevelObjective that is
+// configured via the supplied ServiceLevelObjectiveParameters.
+func GenerateServiceLevelObjective(p v1alpha1.ServiceLevelObjectiveParameters) (*monitoring.ServiceLevelObjective, error) {
+	goal, err := strconv.ParseFloat(p.Goal, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, errParseGoal)
+	}
+	sli, err := generateServiceLevelIndicator(p.ServiceLevelIndicator)
+	if err != nil {
+		return nil, err
+	}
+	return &monitoring.ServiceLevelObjective{
+		DisplayName:           gcp.StringValue(p.DisplayName),
+		Goal:                  goal,
+		RollingPeriod:         gcp.StringValue(p.RollingPeriod),
+		CalendarPeriod:        gcp.StringValue(p.CalendarPeriod),
+		ServiceLevelIndicator: sli,
+		UserLabels:            p.UserLabels,
+	}, nil
+}
+
+func generateServiceLevelIndicator(in v1alpha1.ServiceLevelIndicator) (*monitoring.ServiceLevelIndicator, error) {
+	sli := &monitoring.ServiceLevelIndicator{BasicSli: generateBasicSLI(in.BasicSLI)}
+	var err error
+	if sli.RequestBased, err = generateRequestBasedSLI(in.RequestBased); err != nil {
+		return nil, err
+	}
+	w := in.WindowsBased
+	if w == nil {
+		return sli, nil
+	}
+	sli.WindowsBased = &monitoring.WindowsBasedSli{
+		WindowPeriod:        w.WindowPeriod,
+		GoodBadMetricFilter: gcp.StringValue(w.GoodBadMetricFilter),
+	}
+	if t := w.GoodTotalRatioThreshold; t != nil {
+		threshold, err := strconv.ParseFloat(t.Threshold, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, errParseThreshold)
+		}
+		performance, err := generateRequestBasedSLI(t.Performance)
+		if err != nil {
+			return nil, err
+		}
+		sli.WindowsBased.GoodTotalRatioThreshold = &monitoring.PerformanceThreshold{
+			Performance:         performance,
+			BasicSliPerformance: generateBasicSLI(t.BasicSLIPerformance),
+			Threshold:           threshold,
+		}
+	}
+	if sli.WindowsBased.MetricMeanInRange, err = generateMetricRange(w.MetricMeanInRange); err != nil {
+		return nil, err
+	}
+	if sli.WindowsBased.MetricSumInRange, err = generateMetricRange(w.MetricSumInRange); err != nil {
+		return nil, err
+	}
+	return sli, nil
+}
+
+func generateBasicSLI(in *v1alpha1.BasicSLI) *monitoring.BasicSli {
+	if in == nil {
+		return nil
+	}
+	b := &monitoring.BasicSli{
+		Method:   in.Method,
+		Location: in.Location,
+		Version:  in.Version,
+	}
+	if in.Availability != nil {
+		b.Availability = &monitoring.AvailabilityCriteria{}
+	}
+	if in.Latency != nil {
+		b.Latency = &monitoring.LatencyCriteria{Threshold: in.Latency.Threshold}
+	}
+	return b
+}
+
+func generateRequestBasedSLI(in *v1alpha1.RequestBasedSLI) (*monitoring.RequestBasedSli, error) {
+	if in == nil {
+		return nil, nil
+	}
+	r := &monitoring.RequestBasedSli{}
+	if g := in.GoodTotalRatio; g != nil {
+		r.GoodTotalRatio = &monitoring.TimeSeriesRatio{
+			GoodServiceFilter:  gcp.StringValue(g.GoodServiceFilter),
+			BadServiceFilter:   gcp.StringValue(g.BadServiceFilter),
+			TotalServiceFilter: gcp.StringValue(g.TotalServiceFilter),
+		}
+	}
+	if d := in.DistributionCut; d != nil {
+		rng, err := generateRange(d.Range)
+		if err != nil {
+			return nil, err
+		}
+		r.DistributionCut = &monitoring.DistributionCut{
+			DistributionFilter: d.DistributionFilter,
+			Range:              rng,
+		}
+	}
+	return r, nil
+}
+
+func generateMetricRange(in *v1alpha1.MetricRange) (*monitoring.MetricRange, error) {
+	if in == nil {
+		return nil, nil
+	}
+	rng, err := generateRange(in.Range)
+	if err != nil {
+		return nil, err
+	}
+	return &monitoring.MetricRange{TimeSeries: in.TimeSeries, Range: rng}, nil
+}
+
+func generateRange(in v1alpha1.Range) (*monitoring.GoogleMonitoringV3Range, error) {
+	r := &monitoring.GoogleMonitoringV3Range{}
+	// A bound of zero is meaningful, so we send it even though it is the
+	// default.
+	if in.Min != nil {
+		v, err := strconv.ParseFloat(*in.Min, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, errParseRange)
+		}
+		r.Min = v
+		r.ForceSendFields = append(r.ForceSendFields, "Min")
+	}
+	if in.Max != nil {
+		v, err := strconv.ParseFloat(*in.Max, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, errParseRange)
+		}
+		r.Max = v
+		r.ForceSendFields = append(r.ForceSendFields, "Max")
+	}
+	return r, nil
+}
+
+// GenerateServiceLevelObjectiveObservation produces a
+// ServiceLevelObjectiveObservation from the supplied ServiceLevelObjective.
+func GenerateServiceLevelObjectiveObservation(o monitoring.ServiceLevelObjective) v1alpha1.ServiceLevelObjectiveObservation {
+	return v1alpha1.ServiceLevelObjectiveObservation{Name: o.Name}
+}
+
+// LateInitializeServiceLevelObjective fills the empty fields of the supplied
+// ServiceLevelObjectiveParameters with the values of the supplied
+// ServiceLevelObjective.
+func LateInitializeServiceLevelObjective(p *v1alpha1.ServiceLevelObjectiveParameters, o monitoring.ServiceLevelObjective) {
+	p.DisplayName = gcp.LateInitializeString(p.DisplayName, o.DisplayName)
+}
+
+// IsServiceLevelObjectiveUpToDate returns true if the supplied
+// ServiceLevelObjective matches the supplied
+// ServiceLevelObjectiveParameters.
+func IsServiceLevelObjectiveUpToDate(p v1alpha1.ServiceLevelObjectiveParameters, o monitoring.ServiceLevelObjective) (bool, error) {
+	desired, err := GenerateServiceLevelObjective(p)
+	if err != nil {
+		return false, err
+	}
+	return cmp.Equal(desired, &o, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(monitoring.ServiceLevelObjective{}, "Name", "ServerResponse", "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(monitoring.ServiceLevelIndicator{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(monitoring.BasicSli{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(monitoring.LatencyCriteria{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(monitoring.RequestBasedSli{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(monitoring.TimeSeriesRatio{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(monitoring.DistributionCut{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(monitoring.GoogleMonitoringV3Range{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(monitoring.WindowsBasedSli{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(monitoring.PerformanceThreshold{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(monitoring.MetricRange{}, "ForceSendFields", "NullFields")), nil
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	monitoring "google.golang.org/api/monitoring/v3"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/monitoring/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+func sloParams(m ...func(*v1alpha1.ServiceLevelObjectiveParameters)) *v1alpha1.ServiceLevelObjectiveParameters {
+	p := &v1alpha1.ServiceLevelObjectiveParameters{
+		Service:       gcp.StringPtr("projects/cool-project/services/checkout"),
+		DisplayName:   gcp.StringPtr("99.9% available"),
+		Goal:          "0.999",
+		RollingPeriod: gcp.StringPtr("2419200s"),
+		ServiceLevelIndicator: v1alpha1.ServiceLevelIndicator{
+			RequestBased: &v1alpha1.RequestBasedSLI{
+				GoodTotalRatio: &v1alpha1.TimeSeriesRatio{
+					GoodServiceFilter:  gcp.StringPtr("metric.type=\"custom.googleapis.com/good\""),
+					TotalServiceFilter: gcp.StringPtr("metric.type=\"custom.googleapis.com/total\""),
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func slo(m ...func(*monitoring.ServiceLevelObjective)) *monitoring.ServiceLevelObjective {
+	o := &monitoring.ServiceLevelObjective{
+		Name:          "projects/cool-project/services/checkout/serviceLevelObjectives/availability",
+		DisplayName:   "99.9% available",
+		Goal:          0.999,
+		RollingPeriod: "2419200s",
+		ServiceLevelIndicator: &monitoring.ServiceLevelIndicator{
+			RequestBased: &monitoring.RequestBasedSli{
+				GoodTotalRatio: &monitoring.TimeSeriesRatio{
+					GoodServiceFilter:  "metric.type=\"custom.googleapis.com/good\"",
+					TotalServiceFilter: "metric.type=\"custom.googleapis.com/total\"",
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func TestServiceLevelObjectiveName(t *testing.T) {
+	got := GetServiceLevelObjectiveName("projects/cool-project/services/checkout", "availability")
+	if diff := cmp.Diff(slo().Name, got); diff != "" {
+		t.Errorf("GetServiceLevelObjectiveName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateServiceLevelObjective(t *testing.T) {
+	type want struct {
+		o   *monitoring.ServiceLevelObjective
+		err error
+	}
+	cases := map[string]struct {
+		p    *v1alpha1.ServiceLevelObjectiveParameters
+		want want
+	}{
+		"RequestBased": {
+			p:    sloParams(),
+			want: want{o: slo(func(o *monitoring.ServiceLevelObjective) { o.Name = "" })},
+		},
+		"BasicLatency": {
+			p: sloParams(func(p *v1alpha1.ServiceLevelObjectiveParameters) {
+				p.RollingPeriod = nil
+				p.CalendarPeriod = gcp.StringPtr("WEEK")
+				p.ServiceLevelIndicator = v1alpha1.ServiceLevelIndicator{
+					BasicSLI: &v1alpha1.BasicSLI{Latency: &v1alpha1.LatencyCriteria{Threshold: "0.5s"}},
+				}
+			}),
+			want: want{o: slo(func(o *monitoring.ServiceLevelObjective) {
+				o.Name = ""
+				o.RollingPeriod = ""
+				o.CalendarPeriod = "WEEK"
+				o.ServiceLevelIndicator = &monitoring.ServiceLevelIndicator{
+					BasicSli: &monitoring.BasicSli{Latency: &monitoring.LatencyCriteria{Threshold: "0.5s"}},
+				}
+			})},
+		},
+		"WindowsBased": {
+			p: sloParams(func(p *v1alpha1.ServiceLevelObjectiveParameters) {
+				p.ServiceLevelIndicator = v1alpha1.ServiceLevelIndicator{
+					WindowsBased: &v1alpha1.WindowsBasedSLI{
+						WindowPeriod: "300s",
+						GoodTotalRatioThreshold: &v1alpha1.PerformanceThreshold{
+							BasicSLIPerformance: &v1alpha1.BasicSLI{Availability: &v1alpha1.AvailabilityCriteria{}},
+							Threshold:           "0.95",
+						},
+					},
+				}
+			}),
+			want: want{o: slo(func(o *monitoring.ServiceLevelObjective) {
+				o.Name = ""
+				o.ServiceLevelIndicator = &monitoring.ServiceLevelIndicator{
+					WindowsBased: &monitoring.WindowsBasedSli{
+						WindowPeriod: "300s",
+						GoodTotalRatioThreshold: &monitoring.PerformanceThreshold{
+							BasicSliPerformance: &monitoring.BasicSli{Availability: &monitoring.AvailabilityCriteria{}},
+							Threshold:           0.95,
+						},
+					},
+				}
+			})},
+		},
+		"DistributionCut": {
+			p: sloParams(func(p *v1alpha1.ServiceLevelObjectiveParameters) {
+				p.ServiceLevelIndicator = v1alpha1.ServiceLevelIndicator{
+					RequestBased: &v1alpha1.RequestBasedSLI{
+						DistributionCut: &v1alpha1.DistributionCut{
+							DistributionFilter: "metric.type=\"custom.googleapis.com/latency\"",
+							Range:              v1alpha1.Range{Min: gcp.StringPtr("0"), Max: gcp.StringPtr("500")},
+						},
+					},
+				}
+			}),
+			want: want{o: slo(func(o *monitoring.ServiceLevelObjective) {
+				o.Name = ""
+				o.ServiceLevelIndicator = &monitoring.ServiceLevelIndicator{
+					RequestBased: &monitoring.RequestBasedSli{
+						DistributionCut: &monitoring.DistributionCut{
+							DistributionFilter: "metric.type=\"custom.googleapis.com/latency\"",
+							Range:              &monitoring.GoogleMonitoringV3Range{Min: 0, Max: 500, ForceSendFields: []string{"Min", "Max"}},
+						},
+					},
+				}
+			})},
+		},
+		"InvalidGoal": {
+			p:    sloParams(func(p *v1alpha1.ServiceLevelObjectiveParameters) { p.Goal = "high" }),
+			want: want{err: errors.Wrap(&strconv.NumError{Func: "ParseFloat", Num: "high", Err: strconv.ErrSyntax}, errParseGoal)},
+		},
+		"InvalidRange": {
+			p: sloParams(func(p *v1alpha1.ServiceLevelObjectiveParameters) {
+				p.ServiceLevelIndicator = v1alpha1.ServiceLevelIndicator{
+					WindowsBased: &v1alpha1.WindowsBasedSLI{
+						WindowPeriod:      "60s",
+						MetricMeanInRange: &v1alpha1.MetricRange{TimeSeries: "metric.type=\"custom.googleapis.com/cpu\"", Range: v1alpha1.Range{Max: gcp.StringPtr("lots")}},
+					},
+				}
+			}),
+			want: want{err: errors.Wrap(&strconv.NumError{Func: "ParseFloat", Num: "lots", Err: strconv.ErrSyntax}, errParseRange)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GenerateServiceLevelObjective(*tc.p)
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("GenerateServiceLevelObjective(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GenerateServiceLevelObjective(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeServiceLevelObjective(t *testing.T) {
+	got := sloParams(func(p *v1alpha1.ServiceLevelObjectiveParameters) { p.DisplayName = nil })
+	LateInitializeServiceLevelObjective(got, *slo())
+	if diff := cmp.Diff(sloParams(), got); diff != "" {
+		t.Errorf("LateInitializeServiceLevelObjective(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsServiceLevelObjectiveUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		o    *monitoring.ServiceLevelObjective
+		want bool
+	}{
+		"UpToDate": {
+			o:    slo(),
+			want: true,
+		},
+		"GoalDiffers": {
+			o: slo(func(o *monitoring.ServiceLevelObjective) { o.Goal = 0.99 }),
+		},
+		"IndicatorDiffers": {
+			o: slo(func(o *monitoring.ServiceLevelObjective) {
+				o.ServiceLevelIndicator.RequestBased.GoodTotalRatio.GoodServiceFilter = "metric.type=\"custom.googleapis.com/ok\""
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsServiceLevelObjectiveUpToDate(*sloParams(), *tc.o)
+			if err != nil {
+				t.Fatalf("IsServiceLevelObjectiveUpToDate(...): %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("IsServiceLevelObjectiveUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	monitoring "google.golang.org/api/monitoring/v3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/provider-gcp/apis/monitoring/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const uptimeCheckConfigSep = "/uptimeCheckConfigs/"
+
+// UptimeCheckConfigUpdateMask is the set of UptimeCheckConfig fields that can
+// be updated in place.
+const UptimeCheckConfigUpdateMask = "displayName,httpCheck,tcpCheck,timeout,contentMatchers,selectedRegions,userLabels"
+
+// GetUptimeCheckConfigParent returns the project of the supplied
+// UptimeCheckConfigParameters in the form projects/{project}, falling back to
+// the supplied default project.
+func GetUptimeCheckConfigParent(defaultProject string, p v1alpha1.UptimeCheckConfigParameters) string {
+	if p.Project != nil {
+		return projectPrefix + *p.Project
+	}
+	return projectPrefix + defaultProject
+}
+
+// GetUptimeCheckConfigName builds the fully qualified name of the check with
+// the supplied ID in the supplied parent.
+func GetUptimeCheckConfigName(parent, id string) string {
+	return parent + uptimeCheckConfigSep + id
+}
+
+// GetUptimeCheckPassword reads the basic authentication password of the
+// supplied UptimeCheckConfigParameters from the referenced Secret.
+func GetUptimeCheckPassword(ctx context.Context, kube client.Reader, p v1alpha1.UptimeCheckConfigParameters) (string, error) {
+	if p.HTTPCheck == nil || p.HTTPCheck.AuthInfo == nil {
+		return "", nil
+	}
+	return getSecretValue(ctx, kube, p.HTTPCheck.AuthInfo.PasswordSecretRef)
+}
+
+// GenerateUptimeCheckConfig produces an UptimeCheckConfig that is configured
+// via the supplied UptimeCheckConfigParameters and basic authentication
+// password.
+func GenerateUptimeCheckConfig(p v1alpha1.UptimeCheckConfigParameters, password string) *monitoring.UptimeCheckConfig {
+	u := &monitoring.UptimeCheckConfig{
+		DisplayName: p.DisplayName,
+		MonitoredResource: &monitoring.MonitoredResource{
+			Type:   p.MonitoredResource.Type,
+			Labels: p.MonitoredResource.Labels,
+		},
+		Period:          gcp.StringValue(p.Period),
+		Timeout:         gcp.StringValue(p.Timeout),
+		SelectedRegions: p.SelectedRegions,
+		CheckerType:     gcp.StringValue(p.CheckerType),
+		UserLabels:      p.UserLabels,
+	}
+	if h := p.HTTPCheck; h != nil {
+		u.HttpCheck = &monitoring.HttpCheck{
+			RequestMethod: gcp.StringValue(h.RequestMethod),
+			UseSsl:        gcp.BoolValue(h.UseSSL),
+			ValidateSsl:   gcp.BoolValue(h.ValidateSSL),
+			Path:          gcp.StringValue(h.Path),
+			Port:          gcp.Int64Value(h.Port),
+			Headers:       h.Headers,
+			MaskHeaders:   gcp.BoolValue(h.MaskHeaders),
+			ContentType:   gcp.StringValue(h.ContentType),
+			Body:          gcp.StringValue(h.Body),
+		}
+		if h.AuthInfo != nil {
+			u.HttpCheck.AuthInfo = &monitoring.BasicAuthentication{
+				Username: h.AuthInfo.Username,
+				Password: password,
+			}
+		}
+		for _, c := range h.AcceptedResponseStatusCodes {
+			u.HttpCheck.AcceptedResponseStatusCodes = append(u.HttpCheck.AcceptedResponseStatusCodes, &monitoring.ResponseStatusCode{
+				StatusValue: gcp.Int64Value(c.StatusValue),
+				StatusClass: gcp.StringValue(c.StatusClass),
+			})
+		}
+	}
+	if t := p.TCPCheck; t != nil {
+		u.TcpCheck = &monitoring.TcpCheck{Port: t.Port}
+	}
+	for _, m := range p.ContentMatchers {
+		u.ContentMatchers = append(u.ContentMatchers, &monitoring.ContentMatcher{
+			Content: m.Content,
+			Matcher: gcp.StringValue(m.Matcher),
+		})
+	}
+	return u
+}
+
+// GenerateUptimeCheckConfigObservation produces an
+// UptimeCheckConfigObservation from the supplied UptimeCheckConfig.
+func GenerateUptimeCheckConfigObservation(u monitoring.UptimeCheckConfig) v1alpha1.UptimeCheckConfigObservation {
+	return v1alpha1.UptimeCheckConfigObservation{Name: u.Name}
+}
+
+// LateInitializeUptimeCheckConfig fills the empty fields of the supplied
+// UptimeCheckConfigParameters with the values of the supplied
+// UptimeCheckConfig.
+func LateInitializeUptimeCheckConfig(p *v1alpha1.UptimeCheckConfigParameters, u monitoring.UptimeCheckConfig) {
+	p.Period = gcp.LateInitializeString(p.Period, u.Period)
+	p.Timeout = gcp.LateInitializeString(p.Timeout, u.Timeout)
+	p.CheckerType = gcp.LateInitializeString(p.CheckerType, u.CheckerType)
+	p.SelectedRegions = gcp.LateInitializeStringSlice(p.SelectedRegions, u.SelectedRegions)
+	if h := p.HTTPCheck; h != nil && u.HttpCheck != nil {
+		h.RequestMethod = gcp.LateInitializeString(h.RequestMethod, u.HttpCheck.RequestMethod)
+		h.Path = gcp.LateInitializeString(h.Path, u.HttpCheck.Path)
+		h.Port = gcp.LateInitializeInt64(h.Port, u.HttpCheck.Port)
+		h.ContentType = gcp.LateInitializeString(h.ContentType, u.HttpCheck.ContentType)
+	}
+	for i := range p.ContentMatchers {
+		if i < len(u.ContentMatchers) {
+			p.ContentMatchers[i].Matcher = gcp.LateInitializeString(p.ContentMatchers[i].Matcher, u.ContentMatchers[i].Matcher)
+		}
+	}
+}
+
+// IsUptimeCheckConfigUpToDate returns true if the supplied UptimeCheckConfig
+// matches the supplied UptimeCheckConfigParameters. The password and masked
+// headers are obfuscated by Cloud Monitoring and not compared.
+func IsUptimeCheckConfigUpToDate(p v1alpha1.UptimeCheckConfigParameters, u monitoring.UptimeCheckConfig) bool {
+	desired := GenerateUptimeCheckConfig(p, "")
+	if desired.HttpCheck != nil && desired.HttpCheck.MaskHeaders && u.HttpCheck != nil {
+		desired.HttpCheck.Headers = u.HttpCheck.Headers
+	}
+	return cmp.Equal(desired, &u, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(monitoring.UptimeCheckConfig{}, "Name", "IsInternal", "InternalCheckers", "ResourceGroup", "SyntheticMonitor", "ServerResponse", "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(monitoring.MonitoredResource{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(monitoring.HttpCheck{}, "CustomContentType", "PingConfig", "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(monitoring.BasicAuthentication{}, "Password", "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(monitoring.ResponseStatusCode{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(monitoring.TcpCheck{}, "PingConfig", "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(monitoring.ContentMatcher{}, "JsonPathMatcher", "ForceSendFields", "NullFields"))
+}
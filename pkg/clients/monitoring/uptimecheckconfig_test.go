@@ -0,0 +1,240 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	monitoring "google.golang.org/api/monitoring/v3"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/monitoring/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+func uptimeParams(m ...func(*v1alpha1.UptimeCheckConfigParameters)) *v1alpha1.UptimeCheckConfigParameters {
+	p := &v1alpha1.UptimeCheckConfigParameters{
+		DisplayName: "Storefront",
+		MonitoredResource: v1alpha1.MonitoredResource{
+			Type:   "uptime_url",
+			Labels: map[string]string{"project_id": project, "host": "shop.example.com"},
+		},
+		HTTPCheck: &v1alpha1.HTTPCheck{
+			RequestMethod: gcp.StringPtr("GET"),
+			UseSSL:        gcp.BoolPtr(true),
+			ValidateSSL:   gcp.BoolPtr(true),
+			Path:          gcp.StringPtr("/healthz"),
+			Port:          gcp.Int64Ptr(443),
+			AuthInfo: &v1alpha1.BasicAuthentication{
+				Username: "prober",
+				PasswordSecretRef: xpv1.SecretKeySelector{
+					SecretReference: xpv1.SecretReference{Name: "cool-secret", Namespace: "cool-ns"},
+					Key:             "password",
+				},
+			},
+			AcceptedResponseStatusCodes: []v1alpha1.ResponseStatusCode{{StatusClass: gcp.StringPtr("STATUS_CLASS_2XX")}},
+		},
+		Period:          gcp.StringPtr("60s"),
+		Timeout:         gcp.StringPtr("10s"),
+		ContentMatchers: []v1alpha1.ContentMatcher{{Content: "ok", Matcher: gcp.StringPtr("CONTAINS_STRING")}},
+		SelectedRegions: []string{"EUROPE", "USA"},
+		CheckerType:     gcp.StringPtr("STATIC_IP_CHECKERS"),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func uptime(m ...func(*monitoring.UptimeCheckConfig)) *monitoring.UptimeCheckConfig {
+	u := &monitoring.UptimeCheckConfig{
+		Name:        "projects/cool-project/uptimeCheckConfigs/storefront-1234",
+		DisplayName: "Storefront",
+		MonitoredResource: &monitoring.MonitoredResource{
+			Type:   "uptime_url",
+			Labels: map[string]string{"project_id": project, "host": "shop.example.com"},
+		},
+		HttpCheck: &monitoring.HttpCheck{
+			RequestMethod:               "GET",
+			UseSsl:                      true,
+			ValidateSsl:                 true,
+			Path:                        "/healthz",
+			Port:                        443,
+			AuthInfo:                    &monitoring.BasicAuthentication{Username: "prober", Password: "******"},
+			AcceptedResponseStatusCodes: []*monitoring.ResponseStatusCode{{StatusClass: "STATUS_CLASS_2XX"}},
+		},
+		Period:          "60s",
+		Timeout:         "10s",
+		ContentMatchers: []*monitoring.ContentMatcher{{Content: "ok", Matcher: "CONTAINS_STRING"}},
+		SelectedRegions: []string{"EUROPE", "USA"},
+		CheckerType:     "STATIC_IP_CHECKERS",
+	}
+	for _, f := range m {
+		f(u)
+	}
+	return u
+}
+
+func TestUptimeCheckConfigNames(t *testing.T) {
+	parent := GetUptimeCheckConfigParent(project, *uptimeParams())
+	if diff := cmp.Diff("projects/cool-project", parent); diff != "" {
+		t.Errorf("GetUptimeCheckConfigParent(...): -want, +got:\n%s", diff)
+	}
+	name := GetUptimeCheckConfigName(parent, "storefront-1234")
+	if diff := cmp.Diff(uptime().Name, name); diff != "" {
+		t.Errorf("GetUptimeCheckConfigName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetUptimeCheckPassword(t *testing.T) {
+	type want struct {
+		password string
+		err      error
+	}
+	cases := map[string]struct {
+		kube client.Reader
+		p    *v1alpha1.UptimeCheckConfigParameters
+		want want
+	}{
+		"Password": {
+			kube: &test.MockClient{MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+				obj.(*corev1.Secret).Data = map[string][]byte{"password": []byte("hunter2")}
+				return nil
+			}},
+			p:    uptimeParams(),
+			want: want{password: "hunter2"},
+		},
+		"NoAuthInfo": {
+			p: uptimeParams(func(p *v1alpha1.UptimeCheckConfigParameters) { p.HTTPCheck.AuthInfo = nil }),
+		},
+		"GetFailed": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			p:    uptimeParams(),
+			want: want{err: errors.Wrap(errBoom, errGetSecret)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GetUptimeCheckPassword(context.Background(), tc.kube, *tc.p)
+			if diff := cmp.Diff(tc.want.password, got); diff != "" {
+				t.Errorf("GetUptimeCheckPassword(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GetUptimeCheckPassword(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateUptimeCheckConfig(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.UptimeCheckConfigParameters
+		want *monitoring.UptimeCheckConfig
+	}{
+		"HTTPCheck": {
+			p: uptimeParams(),
+			want: uptime(func(u *monitoring.UptimeCheckConfig) {
+				u.Name = ""
+				u.HttpCheck.AuthInfo.Password = "hunter2"
+			}),
+		},
+		"TCPCheck": {
+			p: uptimeParams(func(p *v1alpha1.UptimeCheckConfigParameters) {
+				p.HTTPCheck = nil
+				p.TCPCheck = &v1alpha1.TCPCheck{Port: 5432}
+				p.ContentMatchers = nil
+			}),
+			want: uptime(func(u *monitoring.UptimeCheckConfig) {
+				u.Name = ""
+				u.HttpCheck = nil
+				u.TcpCheck = &monitoring.TcpCheck{Port: 5432}
+				u.ContentMatchers = nil
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUptimeCheckConfig(*tc.p, "hunter2")
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateUptimeCheckConfig(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeUptimeCheckConfig(t *testing.T) {
+	got := uptimeParams(func(p *v1alpha1.UptimeCheckConfigParameters) {
+		p.Period = nil
+		p.Timeout = nil
+		p.CheckerType = nil
+		p.SelectedRegions = nil
+		p.HTTPCheck.RequestMethod = nil
+		p.HTTPCheck.Port = nil
+		p.ContentMatchers[0].Matcher = nil
+	})
+	LateInitializeUptimeCheckConfig(got, *uptime())
+	if diff := cmp.Diff(uptimeParams(), got); diff != "" {
+		t.Errorf("LateInitializeUptimeCheckConfig(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUptimeCheckConfigUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.UptimeCheckConfigParameters
+		u    *monitoring.UptimeCheckConfig
+		want bool
+	}{
+		"UpToDate": {
+			p:    uptimeParams(),
+			u:    uptime(),
+			want: true,
+		},
+		"MaskedHeaders": {
+			p: uptimeParams(func(p *v1alpha1.UptimeCheckConfigParameters) {
+				p.HTTPCheck.Headers = map[string]string{"X-Token": "secret"}
+				p.HTTPCheck.MaskHeaders = gcp.BoolPtr(true)
+			}),
+			u: uptime(func(u *monitoring.UptimeCheckConfig) {
+				u.HttpCheck.Headers = map[string]string{"X-Token": "******"}
+				u.HttpCheck.MaskHeaders = true
+			}),
+			want: true,
+		},
+		"PathDiffers": {
+			p: uptimeParams(),
+			u: uptime(func(u *monitoring.UptimeCheckConfig) { u.HttpCheck.Path = "/" }),
+		},
+		"RegionsDiffer": {
+			p: uptimeParams(),
+			u: uptime(func(u *monitoring.UptimeCheckConfig) { u.SelectedRegions = []string{"EUROPE"} }),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsUptimeCheckConfigUpToDate(*tc.p, *tc.u); got != tc.want {
+				t.Errorf("IsUptimeCheckConfigUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
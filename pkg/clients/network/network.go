@@ -103,3 +103,18 @@ func IsUpToDate(name string, in *v1beta1.NetworkParameters, observed *compute.Ne
 	}
 	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(), cmpopts.IgnoreFields(compute.Network{}, "ForceSendFields")), false, nil
 }
+
+// Diff returns the paths of the fields of the observed Network that differ from
+// the given set of parameters, e.g. to explain why it is not up-to-date.
+func Diff(name string, in *v1beta1.NetworkParameters, observed *compute.Network) ([]string, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return nil, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*compute.Network)
+	if !ok {
+		return nil, errors.New(errCheckUpToDate)
+	}
+	GenerateNetwork(name, *in, desired)
+	return gcp.Diff(desired, observed, cmpopts.EquateEmpty()), nil
+}
@@ -321,3 +321,44 @@ func TestIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestDiff(t *testing.T) {
+	type args struct {
+		in      *v1beta1.NetworkParameters
+		current *compute.Network
+	}
+	cases := map[string]struct {
+		args args
+		want []string
+	}{
+		"UpToDate": {
+			args: args{
+				in:      params(),
+				current: network(addOutputFields),
+			},
+			want: nil,
+		},
+		"NotUpToDate": {
+			args: args{
+				in: params(func(p *v1beta1.NetworkParameters) {
+					p.Description = nil
+					p.RoutingConfig.RoutingMode = "REGIONAL"
+				}),
+				current: network(addOutputFields),
+			},
+			want: []string{"description", "routingConfig.routingMode"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Diff(testName, tc.args.in, tc.args.current)
+			if err != nil {
+				t.Errorf("Diff(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Diff(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkconnectivity
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	networkconnectivity "google.golang.org/api/networkconnectivity/v1"
+
+	"github.com/crossplane/provider-gcp/apis/networkconnectivity/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat    = "projects/%s/locations/%s"
+	hubParentFormat = "projects/%s/locations/global"
+)
+
+// UpdateMask is the list of hub and spoke fields that can be updated with a
+// patch call. The resources linked by a spoke can't be changed.
+const UpdateMask = "description,labels"
+
+// GetHubParent returns the global location of the supplied HubParameters,
+// falling back to the supplied default project.
+func GetHubParent(defaultProject string, p v1alpha1.HubParameters) string {
+	project := defaultProject
+	if p.Project != nil {
+		project = *p.Project
+	}
+	return fmt.Sprintf(hubParentFormat, project)
+}
+
+// GetHubName builds the fully qualified name of the hub with the supplied ID
+// in the supplied parent.
+func GetHubName(parent, id string) string {
+	return parent + "/hubs/" + id
+}
+
+// GenerateHub produces a Hub that is configured via the supplied
+// HubParameters.
+func GenerateHub(p v1alpha1.HubParameters) *networkconnectivity.Hub {
+	return &networkconnectivity.Hub{
+		Description: gcp.StringValue(p.Description),
+		Labels:      p.Labels,
+	}
+}
+
+// GenerateHubObservation produces a HubObservation from the supplied Hub.
+func GenerateHubObservation(h networkconnectivity.Hub) v1alpha1.HubObservation {
+	o := v1alpha1.HubObservation{
+		Name:       h.Name,
+		UniqueID:   h.UniqueId,
+		State:      h.State,
+		CreateTime: h.CreateTime,
+		UpdateTime: h.UpdateTime,
+	}
+	for _, v := range h.RoutingVpcs {
+		if v == nil {
+			continue
+		}
+		o.RoutingVPCs = append(o.RoutingVPCs, v.Uri)
+	}
+	return o
+}
+
+// LateInitializeHub fills the empty fields of the supplied HubParameters
+// with the values seen in the supplied Hub.
+func LateInitializeHub(p *v1alpha1.HubParameters, h networkconnectivity.Hub) {
+	p.Description = gcp.LateInitializeString(p.Description, h.Description)
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, h.Labels)
+}
+
+// IsHubUpToDate returns true if the description and labels of the supplied
+// Hub match the supplied HubParameters.
+func IsHubUpToDate(p v1alpha1.HubParameters, h networkconnectivity.Hub) bool {
+	return gcp.StringValue(p.Description) == h.Description &&
+		cmp.Equal(p.Labels, h.Labels, cmpopts.EquateEmpty())
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkconnectivity
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	networkconnectivity "google.golang.org/api/networkconnectivity/v1"
+
+	"github.com/crossplane/provider-gcp/apis/networkconnectivity/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	project = "cool-project"
+	hubName = "projects/cool-project/locations/global/hubs/wan"
+)
+
+func hubParams(m ...func(*v1alpha1.HubParameters)) *v1alpha1.HubParameters {
+	p := &v1alpha1.HubParameters{
+		Description: gcp.StringPtr("Global WAN"),
+		Labels:      map[string]string{"team": "network"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func hub(m ...func(*networkconnectivity.Hub)) *networkconnectivity.Hub {
+	h := &networkconnectivity.Hub{
+		Name:        hubName,
+		UniqueId:    "abc-123",
+		State:       v1alpha1.StateActive,
+		CreateTime:  "2021-06-01T00:00:00Z",
+		UpdateTime:  "2021-06-02T00:00:00Z",
+		Description: "Global WAN",
+		Labels:      map[string]string{"team": "network"},
+		RoutingVpcs: []*networkconnectivity.RoutingVPC{{Uri: "https://www.googleapis.com/compute/v1/projects/cool-project/global/networks/default"}},
+	}
+	for _, f := range m {
+		f(h)
+	}
+	return h
+}
+
+func TestHubNames(t *testing.T) {
+	parent := GetHubParent(project, *hubParams())
+	if diff := cmp.Diff(hubName, GetHubName(parent, "wan")); diff != "" {
+		t.Errorf("GetHubName(...): -want, +got:\n%s", diff)
+	}
+	parent = GetHubParent(project, *hubParams(func(p *v1alpha1.HubParameters) { p.Project = gcp.StringPtr("other-project") }))
+	if diff := cmp.Diff("projects/other-project/locations/global", parent); diff != "" {
+		t.Errorf("GetHubParent(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateHub(t *testing.T) {
+	want := &networkconnectivity.Hub{
+		Description: "Global WAN",
+		Labels:      map[string]string{"team": "network"},
+	}
+	if diff := cmp.Diff(want, GenerateHub(*hubParams())); diff != "" {
+		t.Errorf("GenerateHub(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateHubObservation(t *testing.T) {
+	want := v1alpha1.HubObservation{
+		Name:        hubName,
+		UniqueID:    "abc-123",
+		State:       v1alpha1.StateActive,
+		CreateTime:  "2021-06-01T00:00:00Z",
+		UpdateTime:  "2021-06-02T00:00:00Z",
+		RoutingVPCs: []string{"https://www.googleapis.com/compute/v1/projects/cool-project/global/networks/default"},
+	}
+	if diff := cmp.Diff(want, GenerateHubObservation(*hub())); diff != "" {
+		t.Errorf("GenerateHubObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeHub(t *testing.T) {
+	got := &v1alpha1.HubParameters{}
+	LateInitializeHub(got, *hub())
+	if diff := cmp.Diff(hubParams(), got); diff != "" {
+		t.Errorf("LateInitializeHub(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsHubUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.HubParameters
+		want bool
+	}{
+		"UpToDate": {
+			p:    hubParams(),
+			want: true,
+		},
+		"DescriptionDiffers": {
+			p: hubParams(func(p *v1alpha1.HubParameters) { p.Description = gcp.StringPtr("Regional WAN") }),
+		},
+		"LabelsDiffer": {
+			p: hubParams(func(p *v1alpha1.HubParameters) { p.Labels = nil }),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsHubUpToDate(*tc.p, *hub()); got != tc.want {
+				t.Errorf("IsHubUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
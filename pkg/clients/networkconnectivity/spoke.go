@@ -0,0 +1,116 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkconnectivity
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	networkconnectivity "google.golang.org/api/networkconnectivity/v1"
+
+	"github.com/crossplane/provider-gcp/apis/networkconnectivity/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// GetSpokeParent returns the location of the supplied SpokeParameters in
+// the form projects/{project}/locations/{location}, falling back to the
+// supplied default project.
+func GetSpokeParent(defaultProject string, p v1alpha1.SpokeParameters) string {
+	project := defaultProject
+	if p.Project != nil {
+		project = *p.Project
+	}
+	return fmt.Sprintf(parentFormat, project, p.Location)
+}
+
+// GetSpokeName builds the fully qualified name of the spoke with the
+// supplied ID in the supplied parent.
+func GetSpokeName(parent, id string) string {
+	return parent + "/spokes/" + id
+}
+
+// GenerateSpoke produces a Spoke that is configured via the supplied
+// SpokeParameters.
+func GenerateSpoke(p v1alpha1.SpokeParameters) *networkconnectivity.Spoke {
+	s := &networkconnectivity.Spoke{
+		Hub:         gcp.StringValue(p.Hub),
+		Description: gcp.StringValue(p.Description),
+		Labels:      p.Labels,
+	}
+	if l := p.LinkedVPNTunnels; l != nil {
+		s.LinkedVpnTunnels = &networkconnectivity.LinkedVpnTunnels{
+			Uris:                   l.URIs,
+			SiteToSiteDataTransfer: l.SiteToSiteDataTransfer,
+		}
+	}
+	if l := p.LinkedInterconnectAttachments; l != nil {
+		s.LinkedInterconnectAttachments = &networkconnectivity.LinkedInterconnectAttachments{
+			Uris:                   l.URIs,
+			SiteToSiteDataTransfer: l.SiteToSiteDataTransfer,
+		}
+	}
+	if l := p.LinkedRouterApplianceInstances; l != nil {
+		s.LinkedRouterApplianceInstances = &networkconnectivity.LinkedRouterApplianceInstances{
+			SiteToSiteDataTransfer: l.SiteToSiteDataTransfer,
+		}
+		for _, i := range l.Instances {
+			s.LinkedRouterApplianceInstances.Instances = append(s.LinkedRouterApplianceInstances.Instances, &networkconnectivity.RouterApplianceInstance{
+				VirtualMachine: i.VirtualMachine,
+				IpAddress:      i.IPAddress,
+			})
+		}
+	}
+	return s
+}
+
+// GenerateSpokeObservation produces a SpokeObservation from the supplied
+// Spoke.
+func GenerateSpokeObservation(s networkconnectivity.Spoke) v1alpha1.SpokeObservation {
+	o := v1alpha1.SpokeObservation{
+		Name:       s.Name,
+		UniqueID:   s.UniqueId,
+		State:      s.State,
+		SpokeType:  s.SpokeType,
+		CreateTime: s.CreateTime,
+		UpdateTime: s.UpdateTime,
+	}
+	switch {
+	case s.LinkedVpnTunnels != nil:
+		o.VPCNetwork = s.LinkedVpnTunnels.VpcNetwork
+	case s.LinkedInterconnectAttachments != nil:
+		o.VPCNetwork = s.LinkedInterconnectAttachments.VpcNetwork
+	case s.LinkedRouterApplianceInstances != nil:
+		o.VPCNetwork = s.LinkedRouterApplianceInstances.VpcNetwork
+	}
+	return o
+}
+
+// LateInitializeSpoke fills the empty fields of the supplied SpokeParameters
+// with the values seen in the supplied Spoke.
+func LateInitializeSpoke(p *v1alpha1.SpokeParameters, s networkconnectivity.Spoke) {
+	p.Hub = gcp.LateInitializeString(p.Hub, s.Hub)
+	p.Description = gcp.LateInitializeString(p.Description, s.Description)
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, s.Labels)
+}
+
+// IsSpokeUpToDate returns true if the description and labels of the
+// supplied Spoke match the supplied SpokeParameters.
+func IsSpokeUpToDate(p v1alpha1.SpokeParameters, s networkconnectivity.Spoke) bool {
+	return gcp.StringValue(p.Description) == s.Description &&
+		cmp.Equal(p.Labels, s.Labels, cmpopts.EquateEmpty())
+}
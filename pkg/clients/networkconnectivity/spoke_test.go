@@ -0,0 +1,181 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkconnectivity
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	networkconnectivity "google.golang.org/api/networkconnectivity/v1"
+
+	"github.com/crossplane/provider-gcp/apis/networkconnectivity/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	spokeName = "projects/cool-project/locations/us-central1/spokes/branch"
+	tunnel    = "https://www.googleapis.com/compute/v1/projects/cool-project/regions/us-central1/vpnTunnels/branch-0"
+	network   = "https://www.googleapis.com/compute/v1/projects/cool-project/global/networks/default"
+)
+
+func spokeParams(m ...func(*v1alpha1.SpokeParameters)) *v1alpha1.SpokeParameters {
+	p := &v1alpha1.SpokeParameters{
+		Location:    "us-central1",
+		Hub:         gcp.StringPtr(hubName),
+		Description: gcp.StringPtr("Branch office"),
+		LinkedVPNTunnels: &v1alpha1.LinkedResources{
+			URIs:                   []string{tunnel},
+			SiteToSiteDataTransfer: true,
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func spoke(m ...func(*networkconnectivity.Spoke)) *networkconnectivity.Spoke {
+	s := &networkconnectivity.Spoke{
+		Name:        spokeName,
+		UniqueId:    "def-456",
+		State:       v1alpha1.StateActive,
+		SpokeType:   "VPN_TUNNEL",
+		CreateTime:  "2021-06-01T00:00:00Z",
+		Hub:         hubName,
+		Description: "Branch office",
+		LinkedVpnTunnels: &networkconnectivity.LinkedVpnTunnels{
+			Uris:                   []string{tunnel},
+			SiteToSiteDataTransfer: true,
+			VpcNetwork:             network,
+		},
+	}
+	for _, f := range m {
+		f(s)
+	}
+	return s
+}
+
+func TestSpokeNames(t *testing.T) {
+	parent := GetSpokeParent(project, *spokeParams())
+	if diff := cmp.Diff(spokeName, GetSpokeName(parent, "branch")); diff != "" {
+		t.Errorf("GetSpokeName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateSpoke(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.SpokeParameters
+		want *networkconnectivity.Spoke
+	}{
+		"VPNTunnels": {
+			p: spokeParams(),
+			want: &networkconnectivity.Spoke{
+				Hub:         hubName,
+				Description: "Branch office",
+				LinkedVpnTunnels: &networkconnectivity.LinkedVpnTunnels{
+					Uris:                   []string{tunnel},
+					SiteToSiteDataTransfer: true,
+				},
+			},
+		},
+		"InterconnectAttachments": {
+			p: spokeParams(func(p *v1alpha1.SpokeParameters) {
+				p.Description = nil
+				p.LinkedVPNTunnels = nil
+				p.LinkedInterconnectAttachments = &v1alpha1.LinkedResources{URIs: []string{"attachment"}}
+			}),
+			want: &networkconnectivity.Spoke{
+				Hub:                           hubName,
+				LinkedInterconnectAttachments: &networkconnectivity.LinkedInterconnectAttachments{Uris: []string{"attachment"}},
+			},
+		},
+		"RouterApplianceInstances": {
+			p: spokeParams(func(p *v1alpha1.SpokeParameters) {
+				p.Description = nil
+				p.LinkedVPNTunnels = nil
+				p.LinkedRouterApplianceInstances = &v1alpha1.LinkedRouterApplianceInstances{
+					Instances:              []v1alpha1.RouterApplianceInstance{{VirtualMachine: "vm", IPAddress: "10.0.0.2"}},
+					SiteToSiteDataTransfer: true,
+				}
+			}),
+			want: &networkconnectivity.Spoke{
+				Hub: hubName,
+				LinkedRouterApplianceInstances: &networkconnectivity.LinkedRouterApplianceInstances{
+					Instances:              []*networkconnectivity.RouterApplianceInstance{{VirtualMachine: "vm", IpAddress: "10.0.0.2"}},
+					SiteToSiteDataTransfer: true,
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateSpoke(*tc.p)); diff != "" {
+				t.Errorf("GenerateSpoke(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateSpokeObservation(t *testing.T) {
+	want := v1alpha1.SpokeObservation{
+		Name:       spokeName,
+		UniqueID:   "def-456",
+		State:      v1alpha1.StateActive,
+		SpokeType:  "VPN_TUNNEL",
+		VPCNetwork: network,
+		CreateTime: "2021-06-01T00:00:00Z",
+	}
+	if diff := cmp.Diff(want, GenerateSpokeObservation(*spoke())); diff != "" {
+		t.Errorf("GenerateSpokeObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpoke(t *testing.T) {
+	got := spokeParams(func(p *v1alpha1.SpokeParameters) {
+		p.Hub = nil
+		p.Description = nil
+	})
+	LateInitializeSpoke(got, *spoke())
+	if diff := cmp.Diff(spokeParams(), got); diff != "" {
+		t.Errorf("LateInitializeSpoke(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsSpokeUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.SpokeParameters
+		want bool
+	}{
+		"UpToDate": {
+			p:    spokeParams(),
+			want: true,
+		},
+		"DescriptionDiffers": {
+			p: spokeParams(func(p *v1alpha1.SpokeParameters) { p.Description = gcp.StringPtr("Data center") }),
+		},
+		"LabelsDiffer": {
+			p: spokeParams(func(p *v1alpha1.SpokeParameters) { p.Labels = map[string]string{"site": "nyc"} }),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsSpokeUpToDate(*tc.p, *spoke()); got != tc.want {
+				t.Errorf("IsSpokeUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
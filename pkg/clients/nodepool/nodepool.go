@@ -236,15 +236,16 @@ func GenerateObservation(in container.NodePool) v1beta1.NodePoolObservation { //
 
 }
 
-// GenerateNodePoolUpdate produces NodePoolObservation object from *container.NodePool object.
-func GenerateNodePoolUpdate(in *v1beta1.NodePoolParameters) *container.UpdateNodePoolRequest { // nolint:gocyclo
+// GenerateNodePoolUpdate produces an UpdateNodePoolRequest from the supplied
+// *container.NodePool.
+func GenerateNodePoolUpdate(in *container.NodePool) *container.UpdateNodePoolRequest {
 	o := &container.UpdateNodePoolRequest{
 		Locations:   in.Locations,
-		NodeVersion: gcp.StringValue(in.Version),
+		NodeVersion: in.Version,
 	}
 
 	if in.Config != nil {
-		o.ImageType = gcp.StringValue(in.Config.ImageType)
+		o.ImageType = in.Config.ImageType
 
 		if in.Config.WorkloadMetadataConfig != nil {
 			o.WorkloadMetadataConfig = &container.WorkloadMetadataConfig{
@@ -387,31 +388,27 @@ func LateInitializeSpec(spec *v1beta1.NodePoolParameters, in container.NodePool)
 }
 
 // newAutoscalingUpdateFn returns a function that updates the Autoscaling of a node pool.
-func newAutoscalingUpdateFn(in *v1beta1.NodePoolAutoscaling) UpdateFn {
+func newAutoscalingUpdateFn(in *container.NodePoolAutoscaling) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
-		out := &container.NodePool{}
-		GenerateAutoscaling(in, out)
 		update := &container.SetNodePoolAutoscalingRequest{
-			Autoscaling: out.Autoscaling,
+			Autoscaling: in,
 		}
 		return s.Projects.Locations.Clusters.NodePools.SetAutoscaling(name, update).Context(ctx).Do()
 	}
 }
 
 // newManagementUpdateFn returns a function that updates the Management of a node pool.
-func newManagementUpdateFn(in *v1beta1.NodeManagementSpec) UpdateFn {
+func newManagementUpdateFn(in *container.NodeManagement) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
-		out := &container.NodePool{}
-		GenerateManagement(in, out)
 		update := &container.SetNodePoolManagementRequest{
-			Management: out.Management,
+			Management: in,
 		}
 		return s.Projects.Locations.Clusters.NodePools.SetManagement(name, update).Context(ctx).Do()
 	}
 }
 
 // newGeneralUpdateFn returns a function that updates a node pool.
-func newGeneralUpdateFn(in *v1beta1.NodePoolParameters) UpdateFn {
+func newGeneralUpdateFn(in *container.NodePool) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
 		return s.Projects.Locations.Clusters.NodePools.Update(name, GenerateNodePoolUpdate(in)).Context(ctx).Do()
 	}
@@ -426,7 +423,7 @@ type UpdateFn func(context.Context, *container.Service, string) (*container.Oper
 
 // IsUpToDate checks whether current state is up-to-date compared to the given
 // set of parameters.
-func IsUpToDate(name string, in *v1beta1.NodePoolParameters, observed *container.NodePool) (bool, UpdateFn, error) {
+func IsUpToDate(name string, in *v1beta1.NodePoolParameters, observed *container.NodePool, ignore ...string) (bool, UpdateFn, error) {
 	generated, err := copystructure.Copy(observed)
 	if err != nil {
 		return true, noOpUpdate, errors.Wrap(err, errCheckUpToDate)
@@ -436,11 +433,21 @@ func IsUpToDate(name string, in *v1beta1.NodePoolParameters, observed *container
 		return true, noOpUpdate, errors.New(errCheckUpToDate)
 	}
 	GenerateNodePool(name, *in, desired)
+	if err := gcp.IgnoreFields(desired, observed, ignore); err != nil {
+		return true, noOpUpdate, errors.Wrap(err, errCheckUpToDate)
+	}
+	// Updates are generated from the parameters alone, but must not change
+	// the fields that are ignored either.
+	update := &container.NodePool{}
+	GenerateNodePool(name, *in, update)
+	if err := gcp.IgnoreFields(update, observed, ignore); err != nil {
+		return true, noOpUpdate, errors.Wrap(err, errCheckUpToDate)
+	}
 	if !cmp.Equal(desired.Autoscaling, observed.Autoscaling, cmpopts.EquateEmpty()) {
-		return false, newAutoscalingUpdateFn(in.Autoscaling), nil
+		return false, newAutoscalingUpdateFn(update.Autoscaling), nil
 	}
 	if !cmp.Equal(desired.Management, observed.Management, cmpopts.EquateEmpty()) {
-		return false, newManagementUpdateFn(in.Management), nil
+		return false, newManagementUpdateFn(update.Management), nil
 	}
 
 	// TODO(hasheddan): remove manual ignore functions when resolution is
@@ -450,11 +457,33 @@ func IsUpToDate(name string, in *v1beta1.NodePoolParameters, observed *container
 	}), cmpopts.IgnoreMapEntries(func(key, _ string) bool {
 		return key == runtimeKey
 	}), cmp.Comparer(strings.EqualFold)) {
-		return false, newGeneralUpdateFn(in), nil
+		return false, newGeneralUpdateFn(update), nil
 	}
 	return true, noOpUpdate, nil
 }
 
+// Diff returns the paths of the fields of the observed NodePool that differ from
+// the given set of parameters, e.g. to explain why it is not up-to-date.
+func Diff(name string, in *v1beta1.NodePoolParameters, observed *container.NodePool, ignore ...string) ([]string, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return nil, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*container.NodePool)
+	if !ok {
+		return nil, errors.New(errCheckUpToDate)
+	}
+	GenerateNodePool(name, *in, desired)
+	if err := gcp.IgnoreFields(desired, observed, ignore); err != nil {
+		return nil, errors.Wrap(err, errCheckUpToDate)
+	}
+	return gcp.Diff(desired, observed, cmpopts.EquateEmpty(), cmpopts.IgnoreSliceElements(func(c *container.NodeTaint) bool {
+		return c.Key == runtimeKey
+	}), cmpopts.IgnoreMapEntries(func(key, _ string) bool {
+		return key == runtimeKey
+	}), cmp.Comparer(strings.EqualFold)), nil
+}
+
 // GetFullyQualifiedName builds the fully qualified name of the cluster.
 func GetFullyQualifiedName(p v1beta1.NodePoolParameters, name string) string {
 	// Zonal clusters use /zones/ in their path instead of /locations/. We
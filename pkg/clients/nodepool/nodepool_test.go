@@ -17,11 +17,16 @@ limitations under the License.
 package nodepool
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	container "google.golang.org/api/container/v1"
+	"google.golang.org/api/option"
 
 	"github.com/crossplane/provider-gcp/apis/container/v1beta1"
 	"github.com/crossplane/provider-gcp/apis/container/v1beta2"
@@ -584,6 +589,7 @@ func TestIsUpToDate(t *testing.T) {
 		name     string
 		nodePool *container.NodePool
 		params   *v1beta1.NodePoolParameters
+		ignore   []string
 	}
 	type want struct {
 		upToDate bool
@@ -653,6 +659,22 @@ func TestIsUpToDate(t *testing.T) {
 				isErr:    false,
 			},
 		},
+		"UpToDateIgnoreFields": {
+			args: args{
+				name: name,
+				nodePool: nodePool(func(n *container.NodePool) {
+					n.Autoscaling = &container.NodePoolAutoscaling{Enabled: true, MaxNodeCount: 5}
+				}),
+				params: params(func(p *v1beta1.NodePoolParameters) {
+					p.Autoscaling = &v1beta1.NodePoolAutoscaling{Enabled: gcp.BoolPtr(true), MaxNodeCount: gcp.Int64Ptr(3)}
+				}),
+				ignore: []string{"autoscaling.maxNodeCount"},
+			},
+			want: want{
+				upToDate: true,
+				isErr:    false,
+			},
+		},
 		"NeedsUpdate": {
 			args: args{
 				name: name,
@@ -678,7 +700,7 @@ func TestIsUpToDate(t *testing.T) {
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			r, _, err := IsUpToDate(tc.args.name, tc.args.params, tc.args.nodePool)
+			r, _, err := IsUpToDate(tc.args.name, tc.args.params, tc.args.nodePool, tc.args.ignore...)
 			if err != nil && !tc.want.isErr {
 				t.Error("IsUpToDate(...) unexpected error")
 			}
@@ -689,6 +711,39 @@ func TestIsUpToDate(t *testing.T) {
 	}
 }
 
+func TestIsUpToDateUpdate(t *testing.T) {
+	var got container.SetNodePoolAutoscalingRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		_ = json.NewEncoder(w).Encode(&container.Operation{})
+	}))
+	defer srv.Close()
+	s, _ := container.NewService(context.Background(), option.WithEndpoint(srv.URL), option.WithoutAuthentication())
+
+	// Both the ignored maximum node count and whether autoscaling is enabled
+	// differ, but only the latter may be updated.
+	observed := nodePool(func(n *container.NodePool) {
+		n.Autoscaling = &container.NodePoolAutoscaling{Enabled: false, MaxNodeCount: 5}
+	})
+	in := params(func(p *v1beta1.NodePoolParameters) {
+		p.Autoscaling = &v1beta1.NodePoolAutoscaling{Enabled: gcp.BoolPtr(true), MaxNodeCount: gcp.Int64Ptr(3)}
+	})
+	u, fn, err := IsUpToDate(name, in, observed, "autoscaling.maxNodeCount")
+	if err != nil {
+		t.Fatalf("IsUpToDate(...): %s", err)
+	}
+	if u {
+		t.Fatalf("IsUpToDate(...): want not up to date")
+	}
+	if _, err := fn(context.Background(), s, GetFullyQualifiedName(*in, name)); err != nil {
+		t.Fatalf("UpdateFn(...): %s", err)
+	}
+	want := container.SetNodePoolAutoscalingRequest{Autoscaling: &container.NodePoolAutoscaling{Enabled: true, MaxNodeCount: 5}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("UpdateFn(...): ignored fields should not be updated: -want, +got:\n%s", diff)
+	}
+}
+
 func TestGetFullyQualifiedName(t *testing.T) {
 	type args struct {
 		params v1beta1.NodePoolParameters
@@ -0,0 +1,234 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notebooks
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	notebooks "google.golang.org/api/notebooks/v2"
+
+	"github.com/crossplane/provider-gcp/apis/notebooks/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const parentFormat = "projects/%s/locations/%s"
+
+// Workbench instances are configured via the metadata of their VM.
+const (
+	metadataIdleTimeoutSeconds        = "idle-timeout-seconds"
+	metadataPostStartupScript         = "post-startup-script"
+	metadataPostStartupScriptBehavior = "post-startup-script-behavior"
+)
+
+// GetNotebookInstanceParent returns the location of the supplied
+// NotebookInstanceParameters in the form projects/{project}/locations/{zone},
+// falling back to the supplied default project.
+func GetNotebookInstanceParent(defaultProject string, p v1alpha1.NotebookInstanceParameters) string {
+	project := defaultProject
+	if p.Project != nil {
+		project = *p.Project
+	}
+	return fmt.Sprintf(parentFormat, project, p.Location)
+}
+
+// GetNotebookInstanceName builds the fully qualified name of the instance
+// with the supplied ID in the supplied parent.
+func GetNotebookInstanceName(parent, id string) string {
+	return parent + "/instances/" + id
+}
+
+// GenerateNotebookInstance produces an Instance that is configured via the
+// supplied NotebookInstanceParameters.
+func GenerateNotebookInstance(p v1alpha1.NotebookInstanceParameters) *notebooks.Instance {
+	i := &notebooks.Instance{
+		Labels:         p.Labels,
+		InstanceOwners: p.InstanceOwners,
+		GceSetup: &notebooks.GceSetup{
+			MachineType:     p.MachineType,
+			Metadata:        generateMetadata(p),
+			DisablePublicIp: gcp.BoolValue(p.DisablePublicIP),
+			Tags:            p.Tags,
+		},
+	}
+	s := i.GceSetup
+	if p.VMImage != nil {
+		s.VmImage = &notebooks.VmImage{
+			Project: p.VMImage.Project,
+			Family:  gcp.StringValue(p.VMImage.Family),
+			Name:    gcp.StringValue(p.VMImage.Name),
+		}
+	}
+	if p.BootDisk != nil {
+		s.BootDisk = &notebooks.BootDisk{
+			DiskSizeGb: gcp.Int64Value(p.BootDisk.DiskSizeGB),
+			DiskType:   gcp.StringValue(p.BootDisk.DiskType),
+		}
+	}
+	if p.DataDisk != nil {
+		s.DataDisks = []*notebooks.DataDisk{{
+			DiskSizeGb: gcp.Int64Value(p.DataDisk.DiskSizeGB),
+			DiskType:   gcp.StringValue(p.DataDisk.DiskType),
+		}}
+	}
+	if p.Network != nil || p.Subnet != nil {
+		s.NetworkInterfaces = []*notebooks.NetworkInterface{{
+			Network: gcp.StringValue(p.Network),
+			Subnet:  gcp.StringValue(p.Subnet),
+		}}
+	}
+	if p.ServiceAccount != nil {
+		s.ServiceAccounts = []*notebooks.ServiceAccount{{Email: *p.ServiceAccount}}
+	}
+	return i
+}
+
+// GenerateNotebookInstancePatch produces an Instance that updates the fields
+// of the supplied Instance that can be updated in place. The metadata of the
+// supplied Instance is kept, because Workbench adds metadata of its own.
+func GenerateNotebookInstancePatch(p v1alpha1.NotebookInstanceParameters, i notebooks.Instance) *notebooks.Instance {
+	md := map[string]string{}
+	if i.GceSetup != nil {
+		for k, v := range i.GceSetup.Metadata {
+			md[k] = v
+		}
+	}
+	for k, v := range generateMetadata(p) {
+		md[k] = v
+	}
+	return &notebooks.Instance{
+		Labels: p.Labels,
+		GceSetup: &notebooks.GceSetup{
+			MachineType: p.MachineType,
+			Metadata:    md,
+		},
+	}
+}
+
+func generateMetadata(p v1alpha1.NotebookInstanceParameters) map[string]string {
+	md := map[string]string{}
+	for k, v := range p.Metadata {
+		md[k] = v
+	}
+	if p.IdleShutdownTimeoutMinutes != nil {
+		md[metadataIdleTimeoutSeconds] = strconv.FormatInt(*p.IdleShutdownTimeoutMinutes*60, 10)
+	}
+	if p.PostStartupScript != nil {
+		md[metadataPostStartupScript] = *p.PostStartupScript
+	}
+	if p.PostStartupScriptBehavior != nil {
+		md[metadataPostStartupScriptBehavior] = *p.PostStartupScriptBehavior
+	}
+	if len(md) == 0 {
+		return nil
+	}
+	return md
+}
+
+// GenerateNotebookInstanceObservation produces a NotebookInstanceObservation
+// from the supplied Instance.
+func GenerateNotebookInstanceObservation(i notebooks.Instance) v1alpha1.NotebookInstanceObservation {
+	return v1alpha1.NotebookInstanceObservation{
+		Name:        i.Name,
+		ID:          i.Id,
+		State:       i.State,
+		HealthState: i.HealthState,
+		ProxyURI:    i.ProxyUri,
+		Creator:     i.Creator,
+		CreateTime:  i.CreateTime,
+		UpdateTime:  i.UpdateTime,
+	}
+}
+
+// LateInitializeNotebookInstance fills the empty fields of the supplied
+// NotebookInstanceParameters with the values seen in the supplied Instance.
+func LateInitializeNotebookInstance(p *v1alpha1.NotebookInstanceParameters, i notebooks.Instance) {
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, i.Labels)
+	p.InstanceOwners = gcp.LateInitializeStringSlice(p.InstanceOwners, i.InstanceOwners)
+	s := i.GceSetup
+	if s == nil {
+		return
+	}
+	p.DisablePublicIP = gcp.LateInitializeBool(p.DisablePublicIP, s.DisablePublicIp)
+	p.Tags = gcp.LateInitializeStringSlice(p.Tags, s.Tags)
+	if p.VMImage == nil && s.VmImage != nil {
+		p.VMImage = &v1alpha1.VMImage{
+			Project: s.VmImage.Project,
+			Family:  gcp.LateInitializeString(nil, s.VmImage.Family),
+			Name:    gcp.LateInitializeString(nil, s.VmImage.Name),
+		}
+	}
+	if s.BootDisk != nil {
+		p.BootDisk = lateInitializeDisk(p.BootDisk, s.BootDisk.DiskSizeGb, s.BootDisk.DiskType)
+	}
+	if len(s.DataDisks) > 0 && s.DataDisks[0] != nil {
+		p.DataDisk = lateInitializeDisk(p.DataDisk, s.DataDisks[0].DiskSizeGb, s.DataDisks[0].DiskType)
+	}
+	if len(s.NetworkInterfaces) > 0 && s.NetworkInterfaces[0] != nil {
+		p.Network = gcp.LateInitializeString(p.Network, s.NetworkInterfaces[0].Network)
+		p.Subnet = gcp.LateInitializeString(p.Subnet, s.NetworkInterfaces[0].Subnet)
+	}
+	if len(s.ServiceAccounts) > 0 && s.ServiceAccounts[0] != nil {
+		p.ServiceAccount = gcp.LateInitializeString(p.ServiceAccount, s.ServiceAccounts[0].Email)
+	}
+}
+
+func lateInitializeDisk(d *v1alpha1.Disk, sizeGB int64, diskType string) *v1alpha1.Disk {
+	if d == nil {
+		d = &v1alpha1.Disk{}
+	}
+	d.DiskSizeGB = gcp.LateInitializeInt64(d.DiskSizeGB, sizeGB)
+	d.DiskType = gcp.LateInitializeString(d.DiskType, diskType)
+	return d
+}
+
+// GetNotebookInstanceUpdateMask returns the set of fields of the supplied
+// Instance that differ from the supplied NotebookInstanceParameters and can
+// be updated in place. It is empty if the Instance is up to date. Metadata
+// that is not set in the NotebookInstanceParameters is ignored.
+func GetNotebookInstanceUpdateMask(p v1alpha1.NotebookInstanceParameters, i notebooks.Instance) string {
+	s := i.GceSetup
+	if s == nil {
+		s = &notebooks.GceSetup{}
+	}
+	var fields []string
+	if !cmp.Equal(p.Labels, i.Labels, cmpopts.EquateEmpty()) {
+		fields = append(fields, "labels")
+	}
+	// The machine type may be reported as a URL.
+	if p.MachineType != path.Base(s.MachineType) {
+		fields = append(fields, "gceSetup.machineType")
+	}
+	for k, v := range generateMetadata(p) {
+		if s.Metadata[k] != v {
+			fields = append(fields, "gceSetup.metadata")
+			break
+		}
+	}
+	return strings.Join(fields, ",")
+}
+
+// IsNotebookInstanceUpToDate returns true if the supplied Instance matches
+// the fields of the supplied NotebookInstanceParameters that can be updated
+// in place.
+func IsNotebookInstanceUpToDate(p v1alpha1.NotebookInstanceParameters, i notebooks.Instance) bool {
+	return GetNotebookInstanceUpdateMask(p, i) == ""
+}
@@ -0,0 +1,273 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notebooks
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	notebooks "google.golang.org/api/notebooks/v2"
+
+	"github.com/crossplane/provider-gcp/apis/notebooks/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	project      = "cool-project"
+	instanceName = "projects/cool-project/locations/us-central1-a/instances/analysis"
+	network      = "projects/cool-project/global/networks/research"
+	subnet       = "projects/cool-project/regions/us-central1/subnetworks/notebooks"
+	script       = "gs://cool-bucket/setup.sh"
+)
+
+func instanceParams(m ...func(*v1alpha1.NotebookInstanceParameters)) *v1alpha1.NotebookInstanceParameters {
+	p := &v1alpha1.NotebookInstanceParameters{
+		Location:    "us-central1-a",
+		MachineType: "e2-standard-4",
+		Labels:      map[string]string{"team": "research"},
+		VMImage: &v1alpha1.VMImage{
+			Project: "cloud-notebooks-managed",
+			Family:  gcp.StringPtr("workbench-instances"),
+		},
+		BootDisk:                   &v1alpha1.Disk{DiskSizeGB: gcp.Int64Ptr(150), DiskType: gcp.StringPtr("PD_BALANCED")},
+		DataDisk:                   &v1alpha1.Disk{DiskSizeGB: gcp.Int64Ptr(100), DiskType: gcp.StringPtr("PD_SSD")},
+		Network:                    gcp.StringPtr(network),
+		Subnet:                     gcp.StringPtr(subnet),
+		DisablePublicIP:            gcp.BoolPtr(true),
+		Tags:                       []string{"notebooks"},
+		ServiceAccount:             gcp.StringPtr("notebooks@cool-project.iam.gserviceaccount.com"),
+		InstanceOwners:             []string{"ada@example.com"},
+		IdleShutdownTimeoutMinutes: gcp.Int64Ptr(60),
+		PostStartupScript:          gcp.StringPtr(script),
+		PostStartupScriptBehavior:  gcp.StringPtr("run_once"),
+		Metadata:                   map[string]string{"report-system-health": "true"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func instance(m ...func(*notebooks.Instance)) *notebooks.Instance {
+	i := &notebooks.Instance{
+		Name:           instanceName,
+		Id:             "0123-4567",
+		State:          v1alpha1.NotebookInstanceStateActive,
+		HealthState:    "HEALTHY",
+		ProxyUri:       "0123-dot-us-central1.notebooks.googleusercontent.com",
+		Creator:        "ada@example.com",
+		CreateTime:     "2021-06-01T00:00:00Z",
+		UpdateTime:     "2021-06-02T00:00:00Z",
+		Labels:         map[string]string{"team": "research"},
+		InstanceOwners: []string{"ada@example.com"},
+		GceSetup: &notebooks.GceSetup{
+			MachineType: "e2-standard-4",
+			Metadata: map[string]string{
+				"idle-timeout-seconds":         "3600",
+				"post-startup-script":          script,
+				"post-startup-script-behavior": "run_once",
+				"report-system-health":         "true",
+			},
+			VmImage: &notebooks.VmImage{
+				Project: "cloud-notebooks-managed",
+				Family:  "workbench-instances",
+			},
+			BootDisk:  &notebooks.BootDisk{DiskSizeGb: 150, DiskType: "PD_BALANCED"},
+			DataDisks: []*notebooks.DataDisk{{DiskSizeGb: 100, DiskType: "PD_SSD"}},
+			NetworkInterfaces: []*notebooks.NetworkInterface{{
+				Network: network,
+				Subnet:  subnet,
+			}},
+			DisablePublicIp: true,
+			Tags:            []string{"notebooks"},
+			ServiceAccounts: []*notebooks.ServiceAccount{{Email: "notebooks@cool-project.iam.gserviceaccount.com"}},
+		},
+	}
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+func TestNotebookInstanceNames(t *testing.T) {
+	parent := GetNotebookInstanceParent(project, *instanceParams())
+	if diff := cmp.Diff(instanceName, GetNotebookInstanceName(parent, "analysis")); diff != "" {
+		t.Errorf("GetNotebookInstanceName(...): -want, +got:\n%s", diff)
+	}
+	parent = GetNotebookInstanceParent(project, *instanceParams(func(p *v1alpha1.NotebookInstanceParameters) { p.Project = gcp.StringPtr("other-project") }))
+	if diff := cmp.Diff("projects/other-project/locations/us-central1-a", parent); diff != "" {
+		t.Errorf("GetNotebookInstanceParent(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateNotebookInstance(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.NotebookInstanceParameters
+		want *notebooks.Instance
+	}{
+		"Full": {
+			p: instanceParams(),
+			want: instance(func(i *notebooks.Instance) {
+				i.Name = ""
+				i.Id = ""
+				i.State = ""
+				i.HealthState = ""
+				i.ProxyUri = ""
+				i.Creator = ""
+				i.CreateTime = ""
+				i.UpdateTime = ""
+			}),
+		},
+		"Minimal": {
+			p: &v1alpha1.NotebookInstanceParameters{
+				Location:    "us-central1-a",
+				MachineType: "e2-standard-4",
+			},
+			want: &notebooks.Instance{
+				GceSetup: &notebooks.GceSetup{MachineType: "e2-standard-4"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateNotebookInstance(*tc.p)); diff != "" {
+				t.Errorf("GenerateNotebookInstance(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateNotebookInstancePatch(t *testing.T) {
+	existing := instance(func(i *notebooks.Instance) {
+		i.GceSetup.Metadata = map[string]string{
+			"idle-timeout-seconds": "600",
+			"proxy-mode":           "service_account",
+		}
+	})
+	p := instanceParams(func(p *v1alpha1.NotebookInstanceParameters) {
+		p.PostStartupScript = nil
+		p.PostStartupScriptBehavior = nil
+		p.Metadata = nil
+	})
+	want := &notebooks.Instance{
+		Labels: map[string]string{"team": "research"},
+		GceSetup: &notebooks.GceSetup{
+			MachineType: "e2-standard-4",
+			Metadata: map[string]string{
+				"idle-timeout-seconds": "3600",
+				"proxy-mode":           "service_account",
+			},
+		},
+	}
+	if diff := cmp.Diff(want, GenerateNotebookInstancePatch(*p, *existing)); diff != "" {
+		t.Errorf("GenerateNotebookInstancePatch(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateNotebookInstanceObservation(t *testing.T) {
+	want := v1alpha1.NotebookInstanceObservation{
+		Name:        instanceName,
+		ID:          "0123-4567",
+		State:       v1alpha1.NotebookInstanceStateActive,
+		HealthState: "HEALTHY",
+		ProxyURI:    "0123-dot-us-central1.notebooks.googleusercontent.com",
+		Creator:     "ada@example.com",
+		CreateTime:  "2021-06-01T00:00:00Z",
+		UpdateTime:  "2021-06-02T00:00:00Z",
+	}
+	if diff := cmp.Diff(want, GenerateNotebookInstanceObservation(*instance())); diff != "" {
+		t.Errorf("GenerateNotebookInstanceObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeNotebookInstance(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.NotebookInstanceParameters
+		want *v1alpha1.NotebookInstanceParameters
+	}{
+		"FillDefaults": {
+			p: instanceParams(func(p *v1alpha1.NotebookInstanceParameters) {
+				p.Labels = nil
+				p.VMImage = nil
+				p.BootDisk = nil
+				p.DataDisk.DiskType = nil
+				p.Network = nil
+				p.Subnet = nil
+				p.DisablePublicIP = nil
+				p.Tags = nil
+				p.ServiceAccount = nil
+				p.InstanceOwners = nil
+			}),
+			want: instanceParams(),
+		},
+		"KeepSpec": {
+			p: instanceParams(func(p *v1alpha1.NotebookInstanceParameters) {
+				p.VMImage = &v1alpha1.VMImage{Project: "deeplearning-platform-release", Family: gcp.StringPtr("tf-latest-cpu")}
+			}),
+			want: instanceParams(func(p *v1alpha1.NotebookInstanceParameters) {
+				p.VMImage = &v1alpha1.VMImage{Project: "deeplearning-platform-release", Family: gcp.StringPtr("tf-latest-cpu")}
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeNotebookInstance(tc.p, *instance())
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("LateInitializeNotebookInstance(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetNotebookInstanceUpdateMask(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.NotebookInstanceParameters
+		i    *notebooks.Instance
+		want string
+	}{
+		"UpToDate": {
+			p: instanceParams(),
+			i: instance(),
+		},
+		"UnmanagedMetadata": {
+			p: instanceParams(),
+			i: instance(func(i *notebooks.Instance) { i.GceSetup.Metadata["proxy-mode"] = "service_account" }),
+		},
+		"MachineTypeURL": {
+			p: instanceParams(),
+			i: instance(func(i *notebooks.Instance) {
+				i.GceSetup.MachineType = "https://www.googleapis.com/compute/v1/projects/cool-project/zones/us-central1-a/machineTypes/e2-standard-4"
+			}),
+		},
+		"EverythingDiffers": {
+			p: instanceParams(func(p *v1alpha1.NotebookInstanceParameters) {
+				p.Labels = map[string]string{"team": "platform"}
+				p.MachineType = "n1-standard-8"
+				p.IdleShutdownTimeoutMinutes = gcp.Int64Ptr(30)
+			}),
+			i:    instance(),
+			want: "labels,gceSetup.machineType,gceSetup.metadata",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GetNotebookInstanceUpdateMask(*tc.p, *tc.i)); diff != "" {
+				t.Errorf("GetNotebookInstanceUpdateMask(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
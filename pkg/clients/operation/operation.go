@@ -0,0 +1,145 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operation
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	compute "google.golang.org/api/compute/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// ReasonFailed is the reason of the warning events emitted for operations
+// that failed.
+const ReasonFailed event.Reason = "OperationFailed"
+
+// StatusDone is the status of an operation that is complete, whether it
+// succeeded or failed.
+const StatusDone = "DONE"
+
+const (
+	errGetOperation = "cannot get operation"
+	errFmtSelfLink  = "cannot parse operation self link %q"
+	errFmtFailed    = "operation %s failed"
+)
+
+// GenerateOperation returns the observed state of the supplied operation, or
+// nil if there is no operation.
+func GenerateOperation(op *compute.Operation) *v1beta1.Operation {
+	if op == nil {
+		return nil
+	}
+	o := &v1beta1.Operation{
+		SelfLink:      op.SelfLink,
+		OperationType: op.OperationType,
+		Status:        op.Status,
+	}
+	if op.Error != nil {
+		for _, e := range op.Error.Errors {
+			o.Errors = append(o.Errors, v1beta1.OperationError{Code: e.Code, Message: e.Message})
+		}
+	}
+	return o
+}
+
+// Failure returns an error that describes why the supplied operation failed,
+// or nil if it did not (yet) fail.
+func Failure(o *v1beta1.Operation) error {
+	if o == nil || o.Status != StatusDone || len(o.Errors) == 0 {
+		return nil
+	}
+	msgs := make([]string, len(o.Errors))
+	for i, e := range o.Errors {
+		msgs[i] = e.Code
+		if e.Message != "" {
+			msgs[i] += ": " + e.Message
+		}
+	}
+	return errors.Wrapf(errors.New(strings.Join(msgs, "; ")), errFmtFailed, o.OperationType)
+}
+
+// Observe refreshes the supplied operation of the supplied managed resource if
+// it is still running. Compute Engine reports errors such as an exhausted IP
+// range only in the operation of a request, not in the response to the request
+// itself. Observe therefore emits a warning event on the managed resource and
+// returns an error once it observes that the operation failed.
+func Observe(ctx context.Context, s *compute.Service, r event.Recorder, mg resource.Managed, o *v1beta1.Operation) error {
+	if o == nil || o.Status == StatusDone {
+		return nil
+	}
+	op, err := get(ctx, s, o.SelfLink)
+	if gcp.IsErrorNotFound(err) {
+		// Compute Engine eventually deletes operations. We can't tell
+		// whether one that was deleted before we observed its result
+		// failed, so we assume it didn't.
+		o.Status = StatusDone
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, errGetOperation)
+	}
+	*o = *GenerateOperation(op)
+	err = Failure(o)
+	if err != nil {
+		r.Event(mg, event.Warning(ReasonFailed, err))
+	}
+	return err
+}
+
+// get returns the global, regional, or zonal operation with the supplied self
+// link, e.g.
+// https://www.googleapis.com/compute/v1/projects/p/regions/r/operations/o
+func get(ctx context.Context, s *compute.Service, selfLink string) (*compute.Operation, error) {
+	u, err := url.Parse(selfLink)
+	if err != nil {
+		return nil, errors.Wrapf(err, errFmtSelfLink, selfLink)
+	}
+	p := strings.Split(strings.Trim(u.Path, "/"), "/")
+	for i := range p {
+		if p[i] == "projects" {
+			p = p[i+1:]
+			break
+		}
+	}
+	switch {
+	case len(p) == 4 && p[1] == "global" && p[2] == "operations":
+		return s.GlobalOperations.Get(p[0], p[3]).Context(ctx).Do()
+	case len(p) == 5 && p[1] == "regions" && p[3] == "operations":
+		return s.RegionOperations.Get(p[0], p[2], p[4]).Context(ctx).Do()
+	case len(p) == 5 && p[1] == "zones" && p[3] == "operations":
+		return s.ZoneOperations.Get(p[0], p[2], p[4]).Context(ctx).Do()
+	}
+	return nil, errors.Errorf(errFmtSelfLink, selfLink)
+}
+
+// PersistCreation persists the status of the supplied managed resource, which
+// records the operation that creates it. The managed reconciler reverts any
+// changes Create makes to the status of a managed resource once Create
+// returns. Failing to persist the operation only means we can't report its
+// failure, so we don't fail a creation that GCP is already processing.
+func PersistCreation(ctx context.Context, kube client.Client, mg resource.Managed) {
+	_ = kube.Status().Update(ctx, mg)
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+)
+
+const (
+	regionalSelfLink = "https://www.googleapis.com/compute/v1/projects/p/regions/r/operations/o"
+	regionalPath     = "/projects/p/regions/r/operations/o"
+)
+
+type recorder struct {
+	events []event.Event
+}
+
+func (r *recorder) Event(_ runtime.Object, e event.Event) { r.events = append(r.events, e) }
+
+func (r *recorder) WithAnnotations(_ ...string) event.Recorder { return r }
+
+func TestFailure(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		o      *v1beta1.Operation
+		want   error
+	}{
+		"Running": {
+			reason: "An operation that is still running has not failed",
+			o:      &v1beta1.Operation{OperationType: "insert", Status: "RUNNING"},
+			want:   nil,
+		},
+		"Succeeded": {
+			reason: "An operation that is done without errors has not failed",
+			o:      &v1beta1.Operation{OperationType: "insert", Status: StatusDone},
+			want:   nil,
+		},
+		"Failed": {
+			reason: "The error of an operation that failed should include the code and message of each of its errors",
+			o: &v1beta1.Operation{OperationType: "insert", Status: StatusDone, Errors: []v1beta1.OperationError{
+				{Code: "IP_SPACE_EXHAUSTED", Message: "IP space is exhausted."},
+				{Code: "QUOTA_EXCEEDED"},
+			}},
+			want: errors.Wrapf(errors.New("IP_SPACE_EXHAUSTED: IP space is exhausted.; QUOTA_EXCEEDED"), errFmtFailed, "insert"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Failure(tc.o)
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nFailure(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	failed := &compute.Operation{
+		SelfLink:      regionalSelfLink,
+		OperationType: "insert",
+		Status:        StatusDone,
+		Error: &compute.OperationError{Errors: []*compute.OperationErrorErrors{
+			{Code: "IP_SPACE_EXHAUSTED", Message: "IP space is exhausted."},
+		}},
+	}
+
+	type want struct {
+		o      *v1beta1.Operation
+		events int
+		err    error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		o       *v1beta1.Operation
+		want    want
+	}{
+		"NoOperation": {
+			reason: "We should not get an operation if there is none",
+			want:   want{},
+		},
+		"Done": {
+			reason: "We should not get an operation that we already observed to be done",
+			o:      &v1beta1.Operation{SelfLink: regionalSelfLink, Status: StatusDone},
+			want: want{
+				o: &v1beta1.Operation{SelfLink: regionalSelfLink, Status: StatusDone},
+			},
+		},
+		"Running": {
+			reason: "We should refresh an operation that is still running",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(regionalPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{SelfLink: regionalSelfLink, OperationType: "insert", Status: "RUNNING"})
+			}),
+			o: &v1beta1.Operation{SelfLink: regionalSelfLink, Status: "PENDING"},
+			want: want{
+				o: &v1beta1.Operation{SelfLink: regionalSelfLink, OperationType: "insert", Status: "RUNNING"},
+			},
+		},
+		"Failed": {
+			reason: "We should emit an event and return an error when an operation failed",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode(failed)
+			}),
+			o: &v1beta1.Operation{SelfLink: regionalSelfLink, Status: "RUNNING"},
+			want: want{
+				o:      GenerateOperation(failed),
+				events: 1,
+				err:    Failure(GenerateOperation(failed)),
+			},
+		},
+		"NotFound": {
+			reason: "We should assume an operation that no longer exists is done",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			o: &v1beta1.Operation{SelfLink: regionalSelfLink, Status: "RUNNING"},
+			want: want{
+				o: &v1beta1.Operation{SelfLink: regionalSelfLink, Status: StatusDone},
+			},
+		},
+		"GetFailed": {
+			reason: "We should return any error we encounter getting an operation",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			o: &v1beta1.Operation{SelfLink: regionalSelfLink, Status: "RUNNING"},
+			want: want{
+				o:   &v1beta1.Operation{SelfLink: regionalSelfLink, Status: "RUNNING"},
+				err: errors.Wrap(&googleapi.Error{Code: http.StatusBadRequest, Body: "{}\n"}, errGetOperation),
+			},
+		},
+		"InvalidSelfLink": {
+			reason: "We should return an error if we can't tell which operation a self link refers to",
+			o:      &v1beta1.Operation{SelfLink: "https://www.googleapis.com/compute/v1/projects/p", Status: "RUNNING"},
+			want: want{
+				o:   &v1beta1.Operation{SelfLink: "https://www.googleapis.com/compute/v1/projects/p", Status: "RUNNING"},
+				err: errors.Wrap(errors.Errorf(errFmtSelfLink, "https://www.googleapis.com/compute/v1/projects/p"), errGetOperation),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			r := &recorder{}
+
+			err := Observe(context.Background(), s, r, &fake.Managed{}, tc.o)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, tc.o); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want operation, +got operation:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.events, len(r.events)); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want events, +got events:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orgpolicy
+
+import (
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	orgpolicy "google.golang.org/api/orgpolicy/v2"
+
+	"github.com/crossplane/provider-gcp/apis/orgpolicy/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectParentPrefix = "projects/"
+	constraintPrefix    = "constraints/"
+	policiesSep         = "/policies/"
+)
+
+// GetParent returns the parent of the supplied OrgPolicyParameters, falling
+// back to the supplied default project.
+func GetParent(defaultProject string, p v1alpha1.OrgPolicyParameters) string {
+	if p.Parent != nil {
+		return *p.Parent
+	}
+	return projectParentPrefix + defaultProject
+}
+
+// GetPolicyName builds the fully qualified name of the policy of the supplied
+// constraint on the supplied parent. Policies are named after the constraint
+// without its constraints/ prefix.
+func GetPolicyName(parent, constraint string) string {
+	return parent + policiesSep + strings.TrimPrefix(constraint, constraintPrefix)
+}
+
+// GeneratePolicy produces a Policy with the supplied name that is configured
+// via the supplied OrgPolicyParameters.
+func GeneratePolicy(name string, p v1alpha1.OrgPolicyParameters) *orgpolicy.GoogleCloudOrgpolicyV2Policy {
+	spec := &orgpolicy.GoogleCloudOrgpolicyV2PolicySpec{
+		InheritFromParent: gcp.BoolValue(p.InheritFromParent),
+		Reset:             gcp.BoolValue(p.Reset),
+	}
+	for _, r := range p.Rules {
+		rule := &orgpolicy.GoogleCloudOrgpolicyV2PolicySpecPolicyRule{
+			AllowAll: gcp.BoolValue(r.AllowAll),
+			DenyAll:  gcp.BoolValue(r.DenyAll),
+			Enforce:  gcp.BoolValue(r.Enforce),
+		}
+		// A rule that does not enforce a boolean constraint must still say
+		// so explicitly, otherwise the rule is empty and rejected.
+		if r.Enforce != nil {
+			rule.ForceSendFields = []string{"Enforce"}
+		}
+		if r.Values != nil {
+			rule.Values = &orgpolicy.GoogleCloudOrgpolicyV2PolicySpecPolicyRuleStringValues{
+				AllowedValues: r.Values.AllowedValues,
+				DeniedValues:  r.Values.DeniedValues,
+			}
+		}
+		if r.Condition != nil {
+			rule.Condition = &orgpolicy.GoogleTypeExpr{
+				Expression:  r.Condition.Expression,
+				Title:       gcp.StringValue(r.Condition.Title),
+				Description: gcp.StringValue(r.Condition.Description),
+				Location:    gcp.StringValue(r.Condition.Location),
+			}
+		}
+		spec.Rules = append(spec.Rules, rule)
+	}
+	return &orgpolicy.GoogleCloudOrgpolicyV2Policy{
+		Name: name,
+		Spec: spec,
+	}
+}
+
+// GenerateOrgPolicyObservation produces an OrgPolicyObservation from the
+// supplied Policy.
+func GenerateOrgPolicyObservation(pol *orgpolicy.GoogleCloudOrgpolicyV2Policy) v1alpha1.OrgPolicyObservation {
+	o := v1alpha1.OrgPolicyObservation{Name: pol.Name}
+	if pol.Spec != nil {
+		o.Etag = pol.Spec.Etag
+		o.UpdateTime = pol.Spec.UpdateTime
+	}
+	return o
+}
+
+// IsOrgPolicyUpToDate returns true if the spec of the supplied Policy
+// matches the supplied OrgPolicyParameters.
+func IsOrgPolicyUpToDate(p v1alpha1.OrgPolicyParameters, pol *orgpolicy.GoogleCloudOrgpolicyV2Policy) bool {
+	observed := pol.Spec
+	if observed == nil {
+		observed = &orgpolicy.GoogleCloudOrgpolicyV2PolicySpec{}
+	}
+	desired := GeneratePolicy(pol.Name, p).Spec
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(orgpolicy.GoogleCloudOrgpolicyV2PolicySpec{}, "Etag", "UpdateTime", "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(orgpolicy.GoogleCloudOrgpolicyV2PolicySpecPolicyRule{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(orgpolicy.GoogleCloudOrgpolicyV2PolicySpecPolicyRuleStringValues{}, "ForceSendFields", "NullFields"),
+		cmpopts.IgnoreFields(orgpolicy.GoogleTypeExpr{}, "ForceSendFields", "NullFields"))
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orgpolicy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	orgpolicy "google.golang.org/api/orgpolicy/v2"
+
+	"github.com/crossplane/provider-gcp/apis/orgpolicy/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	project    = "cool-project"
+	policyName = "projects/cool-project/policies/compute.vmExternalIpAccess"
+)
+
+func params(m ...func(*v1alpha1.OrgPolicyParameters)) *v1alpha1.OrgPolicyParameters {
+	p := &v1alpha1.OrgPolicyParameters{
+		Constraint: "constraints/compute.vmExternalIpAccess",
+		Rules: []v1alpha1.PolicyRule{
+			{
+				Values: &v1alpha1.StringValues{
+					AllowedValues: []string{"projects/cool-project/zones/europe-west1-b/instances/bastion"},
+				},
+				Condition: &v1alpha1.Expr{
+					Expression: "resource.matchTag('123456789/environment', 'dev')",
+					Title:      gcp.StringPtr("dev"),
+				},
+			},
+			{DenyAll: gcp.BoolPtr(true)},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func policy(m ...func(*orgpolicy.GoogleCloudOrgpolicyV2Policy)) *orgpolicy.GoogleCloudOrgpolicyV2Policy {
+	pol := &orgpolicy.GoogleCloudOrgpolicyV2Policy{
+		Name: policyName,
+		Spec: &orgpolicy.GoogleCloudOrgpolicyV2PolicySpec{
+			Rules: []*orgpolicy.GoogleCloudOrgpolicyV2PolicySpecPolicyRule{
+				{
+					Values: &orgpolicy.GoogleCloudOrgpolicyV2PolicySpecPolicyRuleStringValues{
+						AllowedValues: []string{"projects/cool-project/zones/europe-west1-b/instances/bastion"},
+					},
+					Condition: &orgpolicy.GoogleTypeExpr{
+						Expression: "resource.matchTag('123456789/environment', 'dev')",
+						Title:      "dev",
+					},
+				},
+				{DenyAll: true},
+			},
+		},
+	}
+	for _, f := range m {
+		f(pol)
+	}
+	return pol
+}
+
+func TestGetPolicyName(t *testing.T) {
+	if diff := cmp.Diff(policyName, GetPolicyName(GetParent(project, *params()), params().Constraint)); diff != "" {
+		t.Errorf("GetPolicyName(...): -want, +got:\n%s", diff)
+	}
+	p := params(func(p *v1alpha1.OrgPolicyParameters) {
+		p.Parent = gcp.StringPtr("folders/1234")
+	})
+	if diff := cmp.Diff("folders/1234/policies/compute.vmExternalIpAccess", GetPolicyName(GetParent(project, *p), p.Constraint)); diff != "" {
+		t.Errorf("GetPolicyName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGeneratePolicy(t *testing.T) {
+	cases := map[string]struct {
+		params *v1alpha1.OrgPolicyParameters
+		want   *orgpolicy.GoogleCloudOrgpolicyV2Policy
+	}{
+		"ListConstraint": {
+			params: params(),
+			want:   policy(),
+		},
+		"BooleanConstraintNotEnforced": {
+			params: params(func(p *v1alpha1.OrgPolicyParameters) {
+				p.Constraint = "constraints/iam.disableServiceAccountKeyCreation"
+				p.Rules = []v1alpha1.PolicyRule{{Enforce: gcp.BoolPtr(false)}}
+			}),
+			want: policy(func(pol *orgpolicy.GoogleCloudOrgpolicyV2Policy) {
+				pol.Spec.Rules = []*orgpolicy.GoogleCloudOrgpolicyV2PolicySpecPolicyRule{{ForceSendFields: []string{"Enforce"}}}
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GeneratePolicy(policyName, *tc.params)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GeneratePolicy(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateOrgPolicyObservation(t *testing.T) {
+	pol := policy(func(pol *orgpolicy.GoogleCloudOrgpolicyV2Policy) {
+		pol.Spec.Etag = "BwXkz9fWUtM="
+		pol.Spec.UpdateTime = "2021-05-11T08:00:00Z"
+	})
+	want := v1alpha1.OrgPolicyObservation{
+		Name:       policyName,
+		Etag:       "BwXkz9fWUtM=",
+		UpdateTime: "2021-05-11T08:00:00Z",
+	}
+	if diff := cmp.Diff(want, GenerateOrgPolicyObservation(pol)); diff != "" {
+		t.Errorf("GenerateOrgPolicyObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsOrgPolicyUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		params *v1alpha1.OrgPolicyParameters
+		policy *orgpolicy.GoogleCloudOrgpolicyV2Policy
+		want   bool
+	}{
+		"UpToDate": {
+			params: params(),
+			policy: policy(func(pol *orgpolicy.GoogleCloudOrgpolicyV2Policy) {
+				pol.Spec.Etag = "BwXkz9fWUtM="
+			}),
+			want: true,
+		},
+		"RuleChanged": {
+			params: params(func(p *v1alpha1.OrgPolicyParameters) {
+				p.Rules[1] = v1alpha1.PolicyRule{AllowAll: gcp.BoolPtr(true)}
+			}),
+			policy: policy(),
+			want:   false,
+		},
+		"NoSpec": {
+			params: params(),
+			policy: policy(func(pol *orgpolicy.GoogleCloudOrgpolicyV2Policy) {
+				pol.Spec = nil
+			}),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsOrgPolicyUpToDate(*tc.params, tc.policy)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsOrgPolicyUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
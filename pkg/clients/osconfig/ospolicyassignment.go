@@ -0,0 +1,285 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package osconfig
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	osconfig "google.golang.org/api/osconfig/v1"
+
+	"github.com/crossplane/provider-gcp/apis/osconfig/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// ignoreForceSendFields ignores the ForceSendFields of all API types, which
+// are only set on the desired side of a comparison.
+var ignoreForceSendFields = cmp.FilterPath(func(p cmp.Path) bool {
+	return p.Last().String() == ".ForceSendFields"
+}, cmp.Ignore())
+
+// OSPolicyAssignmentUpdateMask is the set of OSPolicyAssignment fields that
+// can be updated in place.
+const OSPolicyAssignmentUpdateMask = "description,osPolicies,instanceFilter,rollout"
+
+// GetOSPolicyAssignmentParent returns the location of the supplied
+// OSPolicyAssignmentParameters in the form
+// projects/{project}/locations/{location}, falling back to the supplied
+// default project.
+func GetOSPolicyAssignmentParent(defaultProject string, p v1alpha1.OSPolicyAssignmentParameters) string {
+	project := defaultProject
+	if p.Project != nil {
+		project = *p.Project
+	}
+	return fmt.Sprintf("projects/%s/locations/%s", project, p.Location)
+}
+
+// GetOSPolicyAssignmentName builds the fully qualified name of the assignment
+// with the supplied ID in the supplied parent.
+func GetOSPolicyAssignmentName(parent, id string) string {
+	return parent + "/osPolicyAssignments/" + id
+}
+
+// GenerateOSPolicyAssignment produces an OSPolicyAssignment that is
+// configured via the supplied OSPolicyAssignmentParameters.
+func GenerateOSPolicyAssignment(p v1alpha1.OSPolicyAssignmentParameters) *osconfig.OSPolicyAssignment {
+	a := &osconfig.OSPolicyAssignment{
+		Description: gcp.StringValue(p.Description),
+		InstanceFilter: &osconfig.OSPolicyAssignmentInstanceFilter{
+			All:             gcp.BoolValue(p.InstanceFilter.All),
+			InclusionLabels: generateLabelSets(p.InstanceFilter.InclusionLabels),
+			ExclusionLabels: generateLabelSets(p.InstanceFilter.ExclusionLabels),
+		},
+		Rollout: &osconfig.OSPolicyAssignmentRollout{
+			DisruptionBudget: &osconfig.FixedOrPercent{
+				Fixed:   gcp.Int64Value(p.Rollout.DisruptionBudget.Fixed),
+				Percent: gcp.Int64Value(p.Rollout.DisruptionBudget.Percent),
+			},
+			MinWaitDuration: p.Rollout.MinWaitDuration,
+		},
+	}
+	// A disruption budget of zero is meaningful, so we send whichever value
+	// is set even though it is the default.
+	if p.Rollout.DisruptionBudget.Fixed != nil {
+		a.Rollout.DisruptionBudget.ForceSendFields = []string{"Fixed"}
+	}
+	if p.Rollout.DisruptionBudget.Percent != nil {
+		a.Rollout.DisruptionBudget.ForceSendFields = append(a.Rollout.DisruptionBudget.ForceSendFields, "Percent")
+	}
+	for _, inv := range p.InstanceFilter.Inventories {
+		a.InstanceFilter.Inventories = append(a.InstanceFilter.Inventories, &osconfig.OSPolicyAssignmentInstanceFilterInventory{
+			OsShortName: inv.OSShortName,
+			OsVersion:   gcp.StringValue(inv.OSVersion),
+		})
+	}
+	for _, op := range p.OSPolicies {
+		a.OsPolicies = append(a.OsPolicies, generateOSPolicy(op))
+	}
+	return a
+}
+
+func generateLabelSets(in []v1alpha1.LabelSet) []*osconfig.OSPolicyAssignmentLabelSet {
+	var out []*osconfig.OSPolicyAssignmentLabelSet
+	for _, ls := range in {
+		out = append(out, &osconfig.OSPolicyAssignmentLabelSet{Labels: ls.Labels})
+	}
+	return out
+}
+
+func generateOSPolicy(in v1alpha1.OSPolicy) *osconfig.OSPolicy {
+	op := &osconfig.OSPolicy{
+		Id:                        in.ID,
+		Description:               gcp.StringValue(in.Description),
+		Mode:                      in.Mode,
+		AllowNoResourceGroupMatch: gcp.BoolValue(in.AllowNoResourceGroupMatch),
+	}
+	for _, rg := range in.ResourceGroups {
+		g := &osconfig.OSPolicyResourceGroup{}
+		for _, f := range rg.InventoryFilters {
+			g.InventoryFilters = append(g.InventoryFilters, &osconfig.OSPolicyInventoryFilter{
+				OsShortName: f.OSShortName,
+				OsVersion:   gcp.StringValue(f.OSVersion),
+			})
+		}
+		for _, r := range rg.Resources {
+			g.Resources = append(g.Resources, generateResource(r))
+		}
+		op.ResourceGroups = append(op.ResourceGroups, g)
+	}
+	return op
+}
+
+func generateResource(in v1alpha1.Resource) *osconfig.OSPolicyResource {
+	r := &osconfig.OSPolicyResource{
+		Id:         in.ID,
+		Pkg:        generatePackageResource(in.Pkg),
+		Repository: generateRepositoryResource(in.Repository),
+	}
+	if e := in.Exec; e != nil {
+		r.Exec = &osconfig.OSPolicyResourceExecResource{
+			Validate: generateExec(&e.Validate),
+			Enforce:  generateExec(e.Enforce),
+		}
+	}
+	if f := in.File; f != nil {
+		r.File = &osconfig.OSPolicyResourceFileResource{
+			Path:    f.Path,
+			State:   f.State,
+			Content: gcp.StringValue(f.Content),
+			File:    generateFile(f.File),
+		}
+	}
+	return r
+}
+
+func generatePackageResource(in *v1alpha1.PackageResource) *osconfig.OSPolicyResourcePackageResource {
+	if in == nil {
+		return nil
+	}
+	p := &osconfig.OSPolicyResourcePackageResource{DesiredState: in.DesiredState}
+	if in.Apt != nil {
+		p.Apt = &osconfig.OSPolicyResourcePackageResourceAPT{Name: in.Apt.Name}
+	}
+	if in.Yum != nil {
+		p.Yum = &osconfig.OSPolicyResourcePackageResourceYUM{Name: in.Yum.Name}
+	}
+	if in.Zypper != nil {
+		p.Zypper = &osconfig.OSPolicyResourcePackageResourceZypper{Name: in.Zypper.Name}
+	}
+	if in.GooGet != nil {
+		p.Googet = &osconfig.OSPolicyResourcePackageResourceGooGet{Name: in.GooGet.Name}
+	}
+	if in.Deb != nil {
+		p.Deb = &osconfig.OSPolicyResourcePackageResourceDeb{
+			Source:   generateFile(&in.Deb.Source),
+			PullDeps: gcp.BoolValue(in.Deb.PullDeps),
+		}
+	}
+	if in.RPM != nil {
+		p.Rpm = &osconfig.OSPolicyResourcePackageResourceRPM{
+			Source:   generateFile(&in.RPM.Source),
+			PullDeps: gcp.BoolValue(in.RPM.PullDeps),
+		}
+	}
+	if in.MSI != nil {
+		p.Msi = &osconfig.OSPolicyResourcePackageResourceMSI{
+			Source:     generateFile(&in.MSI.Source),
+			Properties: in.MSI.Properties,
+		}
+	}
+	return p
+}
+
+func generateRepositoryResource(in *v1alpha1.RepositoryResource) *osconfig.OSPolicyResourceRepositoryResource {
+	if in == nil {
+		return nil
+	}
+	r := &osconfig.OSPolicyResourceRepositoryResource{}
+	if a := in.Apt; a != nil {
+		r.Apt = &osconfig.OSPolicyResourceRepositoryResourceAptRepository{
+			ArchiveType:  a.ArchiveType,
+			Uri:          a.URI,
+			Distribution: a.Distribution,
+			Components:   a.Components,
+			GpgKey:       gcp.StringValue(a.GPGKey),
+		}
+	}
+	if y := in.Yum; y != nil {
+		r.Yum = &osconfig.OSPolicyResourceRepositoryResourceYumRepository{
+			Id:          y.ID,
+			DisplayName: gcp.StringValue(y.DisplayName),
+			BaseUrl:     y.BaseURL,
+			GpgKeys:     y.GPGKeys,
+		}
+	}
+	if z := in.Zypper; z != nil {
+		r.Zypper = &osconfig.OSPolicyResourceRepositoryResourceZypperRepository{
+			Id:          z.ID,
+			DisplayName: gcp.StringValue(z.DisplayName),
+			BaseUrl:     z.BaseURL,
+			GpgKeys:     z.GPGKeys,
+		}
+	}
+	if g := in.Goo; g != nil {
+		r.Goo = &osconfig.OSPolicyResourceRepositoryResourceGooRepository{Name: g.Name, Url: g.URL}
+	}
+	return r
+}
+
+func generateExec(in *v1alpha1.Exec) *osconfig.OSPolicyResourceExecResourceExec {
+	if in == nil {
+		return nil
+	}
+	return &osconfig.OSPolicyResourceExecResourceExec{
+		File:           generateFile(in.File),
+		Script:         gcp.StringValue(in.Script),
+		Args:           in.Args,
+		Interpreter:    in.Interpreter,
+		OutputFilePath: gcp.StringValue(in.OutputFilePath),
+	}
+}
+
+func generateFile(in *v1alpha1.File) *osconfig.OSPolicyResourceFile {
+	if in == nil {
+		return nil
+	}
+	f := &osconfig.OSPolicyResourceFile{
+		LocalPath:     gcp.StringValue(in.LocalPath),
+		AllowInsecure: gcp.BoolValue(in.AllowInsecure),
+	}
+	if r := in.Remote; r != nil {
+		f.Remote = &osconfig.OSPolicyResourceFileRemote{Uri: r.URI, Sha256Checksum: gcp.StringValue(r.SHA256Checksum)}
+	}
+	if g := in.GCS; g != nil {
+		f.Gcs = &osconfig.OSPolicyResourceFileGcs{Bucket: g.Bucket, Object: g.Object, Generation: gcp.Int64Value(g.Generation)}
+	}
+	return f
+}
+
+// GenerateOSPolicyAssignmentObservation produces an
+// OSPolicyAssignmentObservation from the supplied OSPolicyAssignment.
+func GenerateOSPolicyAssignmentObservation(a osconfig.OSPolicyAssignment) v1alpha1.OSPolicyAssignmentObservation {
+	return v1alpha1.OSPolicyAssignmentObservation{
+		Name:               a.Name,
+		UID:                a.Uid,
+		RevisionID:         a.RevisionId,
+		RevisionCreateTime: a.RevisionCreateTime,
+		RolloutState:       a.RolloutState,
+		Reconciling:        a.Reconciling,
+		Baseline:           a.Baseline,
+	}
+}
+
+// LateInitializeOSPolicyAssignment fills the empty fields of the supplied
+// OSPolicyAssignmentParameters with the values of the supplied
+// OSPolicyAssignment.
+func LateInitializeOSPolicyAssignment(p *v1alpha1.OSPolicyAssignmentParameters, a osconfig.OSPolicyAssignment) {
+	p.Description = gcp.LateInitializeString(p.Description, a.Description)
+	if a.InstanceFilter != nil {
+		p.InstanceFilter.All = gcp.LateInitializeBool(p.InstanceFilter.All, a.InstanceFilter.All)
+	}
+}
+
+// IsOSPolicyAssignmentUpToDate returns true if the supplied
+// OSPolicyAssignment matches the supplied OSPolicyAssignmentParameters.
+func IsOSPolicyAssignmentUpToDate(p v1alpha1.OSPolicyAssignmentParameters, a osconfig.OSPolicyAssignment) bool {
+	return cmp.Equal(GenerateOSPolicyAssignment(p), &a, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(osconfig.OSPolicyAssignment{}, "Name", "Uid", "Etag", "RevisionId", "RevisionCreateTime",
+			"RolloutState", "Reconciling", "Baseline", "Deleted", "ServerResponse"),
+		ignoreForceSendFields)
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package osconfig
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	osconfig "google.golang.org/api/osconfig/v1"
+
+	"github.com/crossplane/provider-gcp/apis/osconfig/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const project = "cool-project"
+
+func params(m ...func(*v1alpha1.OSPolicyAssignmentParameters)) *v1alpha1.OSPolicyAssignmentParameters {
+	p := &v1alpha1.OSPolicyAssignmentParameters{
+		Location:    "us-central1-a",
+		Description: gcp.StringPtr("Install the Ops Agent"),
+		OSPolicies: []v1alpha1.OSPolicy{{
+			ID:   "ops-agent",
+			Mode: "ENFORCEMENT",
+			ResourceGroups: []v1alpha1.ResourceGroup{{
+				InventoryFilters: []v1alpha1.InventoryFilter{{OSShortName: "debian", OSVersion: gcp.StringPtr("11")}},
+				Resources: []v1alpha1.Resource{
+					{
+						ID: "repo",
+						Repository: &v1alpha1.RepositoryResource{Apt: &v1alpha1.AptRepository{
+							ArchiveType:  "DEB",
+							URI:          "https://packages.cloud.google.com/apt",
+							Distribution: "google-cloud-ops-agent-bullseye-2",
+							Components:   []string{"main"},
+							GPGKey:       gcp.StringPtr("https://packages.cloud.google.com/apt/doc/apt-key.gpg"),
+						}},
+					},
+					{
+						ID:  "package",
+						Pkg: &v1alpha1.PackageResource{DesiredState: "INSTALLED", Apt: &v1alpha1.PackageName{Name: "google-cloud-ops-agent"}},
+					},
+					{
+						ID: "running",
+						Exec: &v1alpha1.ExecResource{
+							Validate: v1alpha1.Exec{Script: gcp.StringPtr("systemctl is-active google-cloud-ops-agent && exit 100 || exit 101"), Interpreter: "SHELL"},
+							Enforce:  &v1alpha1.Exec{Script: gcp.StringPtr("systemctl start google-cloud-ops-agent && exit 100"), Interpreter: "SHELL"},
+						},
+					},
+				},
+			}},
+		}},
+		InstanceFilter: v1alpha1.InstanceFilter{
+			InclusionLabels: []v1alpha1.LabelSet{{Labels: map[string]string{"ops-agent": "true"}}},
+		},
+		Rollout: v1alpha1.Rollout{
+			DisruptionBudget: v1alpha1.FixedOrPercent{Percent: gcp.Int64Ptr(10)},
+			MinWaitDuration:  "60s",
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func assignment(m ...func(*osconfig.OSPolicyAssignment)) *osconfig.OSPolicyAssignment {
+	a := &osconfig.OSPolicyAssignment{
+		Name:        "projects/cool-project/locations/us-central1-a/osPolicyAssignments/ops-agent",
+		Description: "Install the Ops Agent",
+		OsPolicies: []*osconfig.OSPolicy{{
+			Id:   "ops-agent",
+			Mode: "ENFORCEMENT",
+			ResourceGroups: []*osconfig.OSPolicyResourceGroup{{
+				InventoryFilters: []*osconfig.OSPolicyInventoryFilter{{OsShortName: "debian", OsVersion: "11"}},
+				Resources: []*osconfig.OSPolicyResource{
+					{
+						Id: "repo",
+						Repository: &osconfig.OSPolicyResourceRepositoryResource{Apt: &osconfig.OSPolicyResourceRepositoryResourceAptRepository{
+							ArchiveType:  "DEB",
+							Uri:          "https://packages.cloud.google.com/apt",
+							Distribution: "google-cloud-ops-agent-bullseye-2",
+							Components:   []string{"main"},
+							GpgKey:       "https://packages.cloud.google.com/apt/doc/apt-key.gpg",
+						}},
+					},
+					{
+						Id: "package",
+						Pkg: &osconfig.OSPolicyResourcePackageResource{
+							DesiredState: "INSTALLED",
+							Apt:          &osconfig.OSPolicyResourcePackageResourceAPT{Name: "google-cloud-ops-agent"},
+						},
+					},
+					{
+						Id: "running",
+						Exec: &osconfig.OSPolicyResourceExecResource{
+							Validate: &osconfig.OSPolicyResourceExecResourceExec{Script: "systemctl is-active google-cloud-ops-agent && exit 100 || exit 101", Interpreter: "SHELL"},
+							Enforce:  &osconfig.OSPolicyResourceExecResourceExec{Script: "systemctl start google-cloud-ops-agent && exit 100", Interpreter: "SHELL"},
+						},
+					},
+				},
+			}},
+		}},
+		InstanceFilter: &osconfig.OSPolicyAssignmentInstanceFilter{
+			InclusionLabels: []*osconfig.OSPolicyAssignmentLabelSet{{Labels: map[string]string{"ops-agent": "true"}}},
+		},
+		Rollout: &osconfig.OSPolicyAssignmentRollout{
+			DisruptionBudget: &osconfig.FixedOrPercent{Percent: 10},
+			MinWaitDuration:  "60s",
+		},
+		Uid:                "abc-123",
+		RevisionId:         "r1",
+		RevisionCreateTime: "2021-06-01T00:00:00Z",
+		RolloutState:       v1alpha1.RolloutStateSucceeded,
+		Etag:               "etag",
+	}
+	for _, f := range m {
+		f(a)
+	}
+	return a
+}
+
+func TestOSPolicyAssignmentNames(t *testing.T) {
+	parent := GetOSPolicyAssignmentParent(project, *params())
+	if diff := cmp.Diff("projects/cool-project/locations/us-central1-a", parent); diff != "" {
+		t.Errorf("GetOSPolicyAssignmentParent(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(assignment().Name, GetOSPolicyAssignmentName(parent, "ops-agent")); diff != "" {
+		t.Errorf("GetOSPolicyAssignmentName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateOSPolicyAssignment(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.OSPolicyAssignmentParameters
+		want *osconfig.OSPolicyAssignment
+	}{
+		"OpsAgent": {
+			p: params(),
+			want: assignment(func(a *osconfig.OSPolicyAssignment) {
+				a.Name = ""
+				a.Uid = ""
+				a.RevisionId = ""
+				a.RevisionCreateTime = ""
+				a.RolloutState = ""
+				a.Etag = ""
+				a.Rollout.DisruptionBudget.ForceSendFields = []string{"Percent"}
+			}),
+		},
+		"Files": {
+			p: params(func(p *v1alpha1.OSPolicyAssignmentParameters) {
+				p.OSPolicies[0].ResourceGroups[0].Resources = []v1alpha1.Resource{
+					{
+						ID: "msi",
+						Pkg: &v1alpha1.PackageResource{
+							DesiredState: "INSTALLED",
+							MSI: &v1alpha1.MSIPackage{Source: v1alpha1.File{
+								GCS: &v1alpha1.GCSFile{Bucket: "cool-bucket", Object: "agent.msi", Generation: gcp.Int64Ptr(42)},
+							}},
+						},
+					},
+					{
+						ID: "config",
+						File: &v1alpha1.FileResource{
+							Path:  "/etc/google-cloud-ops-agent/config.yaml",
+							State: "PRESENT",
+							File:  &v1alpha1.File{Remote: &v1alpha1.RemoteFile{URI: "https://example.org/config.yaml", SHA256Checksum: gcp.StringPtr("abc")}},
+						},
+					},
+				}
+				p.Rollout.DisruptionBudget = v1alpha1.FixedOrPercent{Fixed: gcp.Int64Ptr(0)}
+			}),
+			want: assignment(func(a *osconfig.OSPolicyAssignment) {
+				a.Name = ""
+				a.Uid = ""
+				a.RevisionId = ""
+				a.RevisionCreateTime = ""
+				a.RolloutState = ""
+				a.Etag = ""
+				a.OsPolicies[0].ResourceGroups[0].Resources = []*osconfig.OSPolicyResource{
+					{
+						Id: "msi",
+						Pkg: &osconfig.OSPolicyResourcePackageResource{
+							DesiredState: "INSTALLED",
+							Msi: &osconfig.OSPolicyResourcePackageResourceMSI{Source: &osconfig.OSPolicyResourceFile{
+								Gcs: &osconfig.OSPolicyResourceFileGcs{Bucket: "cool-bucket", Object: "agent.msi", Generation: 42},
+							}},
+						},
+					},
+					{
+						Id: "config",
+						File: &osconfig.OSPolicyResourceFileResource{
+							Path:  "/etc/google-cloud-ops-agent/config.yaml",
+							State: "PRESENT",
+							File:  &osconfig.OSPolicyResourceFile{Remote: &osconfig.OSPolicyResourceFileRemote{Uri: "https://example.org/config.yaml", Sha256Checksum: "abc"}},
+						},
+					},
+				}
+				a.Rollout.DisruptionBudget = &osconfig.FixedOrPercent{ForceSendFields: []string{"Fixed"}}
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateOSPolicyAssignment(*tc.p)); diff != "" {
+				t.Errorf("GenerateOSPolicyAssignment(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateOSPolicyAssignmentObservation(t *testing.T) {
+	want := v1alpha1.OSPolicyAssignmentObservation{
+		Name:               assignment().Name,
+		UID:                "abc-123",
+		RevisionID:         "r1",
+		RevisionCreateTime: "2021-06-01T00:00:00Z",
+		RolloutState:       v1alpha1.RolloutStateSucceeded,
+	}
+	if diff := cmp.Diff(want, GenerateOSPolicyAssignmentObservation(*assignment())); diff != "" {
+		t.Errorf("GenerateOSPolicyAssignmentObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeOSPolicyAssignment(t *testing.T) {
+	got := params(func(p *v1alpha1.OSPolicyAssignmentParameters) { p.Description = nil })
+	LateInitializeOSPolicyAssignment(got, *assignment(func(a *osconfig.OSPolicyAssignment) { a.InstanceFilter.All = true }))
+	want := params(func(p *v1alpha1.OSPolicyAssignmentParameters) { p.InstanceFilter.All = gcp.BoolPtr(true) })
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("LateInitializeOSPolicyAssignment(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsOSPolicyAssignmentUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		a    *osconfig.OSPolicyAssignment
+		want bool
+	}{
+		"UpToDate": {
+			a:    assignment(),
+			want: true,
+		},
+		"PolicyDiffers": {
+			a: assignment(func(a *osconfig.OSPolicyAssignment) { a.OsPolicies[0].Mode = "VALIDATION" }),
+		},
+		"RolloutDiffers": {
+			a: assignment(func(a *osconfig.OSPolicyAssignment) { a.Rollout.DisruptionBudget.Percent = 50 }),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsOSPolicyAssignmentUpToDate(*params(), *tc.a); got != tc.want {
+				t.Errorf("IsOSPolicyAssignmentUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
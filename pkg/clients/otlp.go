@@ -0,0 +1,214 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+)
+
+const errExportSpans = "cannot export spans"
+
+// otlpExporter exports spans to an OTLP/HTTP collector using the JSON
+// encoding of OTLP. We don't use the OTLP exporters of OpenTelemetry, because
+// they require newer versions of our dependencies than we can use.
+type otlpExporter struct {
+	url    string
+	client *http.Client
+}
+
+func newOTLPExporter(endpoint string, insecure bool) *otlpExporter {
+	scheme := "https"
+	if insecure {
+		scheme = "http"
+	}
+	return &otlpExporter{url: fmt.Sprintf("%s://%s/v1/traces", scheme, endpoint), client: &http.Client{}}
+}
+
+// ExportSpans sends the supplied spans to the collector.
+func (e *otlpExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if len(spans) == 0 {
+		return nil
+	}
+	body, err := json.Marshal(otlpRequest(spans))
+	if err != nil {
+		return errors.Wrap(err, errExportSpans)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, errExportSpans)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	rsp, err := e.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, errExportSpans)
+	}
+	defer drain(rsp.Body)
+	if rsp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(rsp.Body, 1<<10))
+		return errors.Errorf("%s: %s: %s", errExportSpans, rsp.Status, bytes.TrimSpace(msg))
+	}
+	return nil
+}
+
+// Shutdown stops the exporter. It has nothing to clean up.
+func (e *otlpExporter) Shutdown(_ context.Context) error {
+	return nil
+}
+
+// The below types are the subset of the JSON encoding of an OTLP
+// ExportTraceServiceRequest that we populate.
+// https://github.com/open-telemetry/opentelemetry-proto/blob/main/opentelemetry/proto/trace/v1/trace.proto
+
+type otlpTraces struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Status            otlpStatus     `json:"status"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+}
+
+// otlpRequest returns the supplied spans grouped by resource and
+// instrumentation library.
+func otlpRequest(spans []sdktrace.ReadOnlySpan) otlpTraces {
+	t := otlpTraces{}
+	resources := map[string]int{}
+	scopes := map[string]int{}
+	for _, s := range spans {
+		rk := s.Resource().Encoded(attribute.DefaultEncoder())
+		ri, ok := resources[rk]
+		if !ok {
+			ri = len(t.ResourceSpans)
+			resources[rk] = ri
+			t.ResourceSpans = append(t.ResourceSpans, otlpResourceSpans{Resource: otlpResource{Attributes: otlpAttributes(s.Resource().Attributes())}})
+		}
+		rs := &t.ResourceSpans[ri]
+
+		lib := s.InstrumentationLibrary()
+		sk := rk + "/" + lib.Name + "@" + lib.Version
+		si, ok := scopes[sk]
+		if !ok {
+			si = len(rs.ScopeSpans)
+			scopes[sk] = si
+			rs.ScopeSpans = append(rs.ScopeSpans, otlpScopeSpans{Scope: otlpScope{Name: lib.Name, Version: lib.Version}})
+		}
+		rs.ScopeSpans[si].Spans = append(rs.ScopeSpans[si].Spans, otlpSpanOf(s))
+	}
+	return t
+}
+
+func otlpSpanOf(s sdktrace.ReadOnlySpan) otlpSpan {
+	o := otlpSpan{
+		TraceID:           s.SpanContext().TraceID().String(),
+		SpanID:            s.SpanContext().SpanID().String(),
+		Name:              s.Name(),
+		Kind:              int(s.SpanKind()),
+		StartTimeUnixNano: strconv.FormatInt(s.StartTime().UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(s.EndTime().UnixNano(), 10),
+		Attributes:        otlpAttributes(s.Attributes()),
+	}
+	if s.Parent().HasSpanID() {
+		o.ParentSpanID = s.Parent().SpanID().String()
+	}
+	// The OTLP status codes differ from those of OpenTelemetry.
+	switch s.Status().Code {
+	case codes.Ok:
+		o.Status = otlpStatus{Code: 1}
+	case codes.Error:
+		o.Status = otlpStatus{Code: 2, Message: s.Status().Description}
+	}
+	return o
+}
+
+func otlpAttributes(kvs []attribute.KeyValue) []otlpKeyValue {
+	out := make([]otlpKeyValue, 0, len(kvs))
+	for _, kv := range kvs {
+		v := otlpValue{}
+		switch kv.Value.Type() {
+		case attribute.BOOL:
+			b := kv.Value.AsBool()
+			v.BoolValue = &b
+		case attribute.INT64:
+			i := strconv.FormatInt(kv.Value.AsInt64(), 10)
+			v.IntValue = &i
+		case attribute.FLOAT64:
+			f := kv.Value.AsFloat64()
+			v.DoubleValue = &f
+		default:
+			// Arrays are encoded as strings for simplicity.
+			s := kv.Value.Emit()
+			v.StringValue = &s
+		}
+		out = append(out, otlpKeyValue{Key: string(kv.Key), Value: v})
+	}
+	return out
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/v1beta1"
+)
+
+// defaultProviderConfig is the name of the ProviderConfig managed resources
+// use when they don't reference another one.
+const defaultProviderConfig = "default"
+
+const (
+	errListPolicies     = "cannot list DefaultProviderConfigPolicies"
+	errPolicySelector   = "cannot parse selector of DefaultProviderConfigPolicy %q"
+	errAmbiguousPolicy  = "DefaultProviderConfigPolicies %q and %q select different ProviderConfigs"
+	errUpdateManagedRef = "cannot update providerConfigRef of managed resource"
+)
+
+// applyDefaultProviderConfigPolicy points managed resources that use the
+// default ProviderConfig to the ProviderConfig selected by a
+// DefaultProviderConfigPolicy, if any. The new reference is persisted so
+// that the resource keeps using the same ProviderConfig even if the policies
+// change later on.
+func applyDefaultProviderConfigPolicy(ctx context.Context, c client.Client, mg resource.Managed) error {
+	ref := mg.GetProviderConfigReference()
+	if ref == nil || ref.Name != defaultProviderConfig {
+		return nil
+	}
+	l := &v1beta1.DefaultProviderConfigPolicyList{}
+	if err := c.List(ctx, l); err != nil {
+		return errors.Wrap(err, errListPolicies)
+	}
+	var selectedBy, name string
+	for _, p := range l.Items {
+		s, err := metav1.LabelSelectorAsSelector(&p.Spec.Selector)
+		if err != nil {
+			return errors.Wrapf(err, errPolicySelector, p.GetName())
+		}
+		if !s.Matches(labels.Set(mg.GetLabels())) {
+			continue
+		}
+		if selectedBy != "" && name != p.Spec.ProviderConfigRef.Name {
+			return errors.Errorf(errAmbiguousPolicy, selectedBy, p.GetName())
+		}
+		selectedBy, name = p.GetName(), p.Spec.ProviderConfigRef.Name
+	}
+	if name == "" || name == defaultProviderConfig {
+		return nil
+	}
+	mg.SetProviderConfigReference(&xpv1.Reference{Name: name})
+	return errors.Wrap(c.Update(ctx, mg), errUpdateManagedRef)
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/v1beta1"
+)
+
+const claimNamespaceLabel = "crossplane.io/claim-namespace"
+
+func policy(name, pc string, matchLabels map[string]string) v1beta1.DefaultProviderConfigPolicy {
+	return v1beta1.DefaultProviderConfigPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1beta1.DefaultProviderConfigPolicySpec{
+			Selector:          metav1.LabelSelector{MatchLabels: matchLabels},
+			ProviderConfigRef: xpv1.Reference{Name: pc},
+		},
+	}
+}
+
+func policies(p ...v1beta1.DefaultProviderConfigPolicy) test.MockListFn {
+	return func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+		obj.(*v1beta1.DefaultProviderConfigPolicyList).Items = p
+		return nil
+	}
+}
+
+func referencing(pc string, l map[string]string) *fake.Managed {
+	mg := &fake.Managed{}
+	mg.SetLabels(l)
+	mg.SetProviderConfigReference(&xpv1.Reference{Name: pc})
+	return mg
+}
+
+func TestApplyDefaultProviderConfigPolicy(t *testing.T) {
+	errBoom := errors.New("boom")
+	teamA := map[string]string{claimNamespaceLabel: "team-a"}
+
+	type want struct {
+		err error
+		ref string
+	}
+
+	cases := map[string]struct {
+		reason string
+		kube   client.Client
+		mg     *fake.Managed
+		want   want
+	}{
+		"NotDefault": {
+			reason: "Resources that reference a ProviderConfig other than the default one should not be changed",
+			mg:     referencing("team-b", teamA),
+			want:   want{ref: "team-b"},
+		},
+		"ListFailed": {
+			reason: "Should return an error if the policies can't be listed",
+			kube:   &test.MockClient{MockList: test.NewMockListFn(errBoom)},
+			mg:     referencing(defaultProviderConfig, teamA),
+			want:   want{err: errors.Wrap(errBoom, errListPolicies), ref: defaultProviderConfig},
+		},
+		"NoMatch": {
+			reason: "Resources that match no policy should keep using the default ProviderConfig",
+			kube:   &test.MockClient{MockList: policies(policy("b", "team-b", map[string]string{claimNamespaceLabel: "team-b"}))},
+			mg:     referencing(defaultProviderConfig, teamA),
+			want:   want{ref: defaultProviderConfig},
+		},
+		"Ambiguous": {
+			reason: "Should return an error if policies select different ProviderConfigs",
+			kube:   &test.MockClient{MockList: policies(policy("a", "team-a", teamA), policy("all", "shared", nil))},
+			mg:     referencing(defaultProviderConfig, teamA),
+			want:   want{err: errors.Errorf(errAmbiguousPolicy, "a", "all"), ref: defaultProviderConfig},
+		},
+		"UpdateFailed": {
+			reason: "Should return an error if the new reference can't be persisted",
+			kube: &test.MockClient{
+				MockList:   policies(policy("a", "team-a", teamA)),
+				MockUpdate: test.NewMockUpdateFn(errBoom),
+			},
+			mg:   referencing(defaultProviderConfig, teamA),
+			want: want{err: errors.Wrap(errBoom, errUpdateManagedRef), ref: "team-a"},
+		},
+		"Matched": {
+			reason: "Resources that match a policy should reference the ProviderConfig it selects",
+			kube: &test.MockClient{
+				MockList:   policies(policy("a", "team-a", teamA), policy("b", "team-b", map[string]string{claimNamespaceLabel: "team-b"})),
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			mg:   referencing(defaultProviderConfig, teamA),
+			want: want{ref: "team-a"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := applyDefaultProviderConfigPolicy(context.Background(), tc.kube, tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\napplyDefaultProviderConfigPolicy(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.ref, tc.mg.GetProviderConfigReference().Name); diff != "" {
+				t.Errorf("\n%s\napplyDefaultProviderConfigPolicy(...): -want providerConfigRef, +got providerConfigRef:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
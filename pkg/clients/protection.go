@@ -0,0 +1,60 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const errDeletionProtected = "refusing to delete the external resource because deletion protection is enabled; set spec.deletionProtection to false, or spec.deletionPolicy to Orphan"
+
+// A DeletionProtector is a managed resource whose external resource may be
+// protected from deletion.
+type DeletionProtector interface {
+	GetDeletionProtection() bool
+}
+
+// IsDeletionProtected returns true if the external resource of the supplied
+// managed resource must not be deleted.
+func IsDeletionProtected(mg resource.Managed) bool {
+	p, ok := mg.(DeletionProtector)
+	return ok && p.GetDeletionProtection()
+}
+
+// NewDeletionProtectionConnecter returns an ExternalConnecter whose external
+// clients refuse to delete external resources that are protected from
+// deletion.
+func NewDeletionProtectionConnecter(c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &wrappingConnecter{connecter: c, wrap: func(e managed.ExternalClient) managed.ExternalClient {
+		return &deletionProtectionExternal{ExternalClient: e}
+	}}
+}
+
+type deletionProtectionExternal struct {
+	managed.ExternalClient
+}
+
+func (e *deletionProtectionExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	if IsDeletionProtected(mg) {
+		return errors.New(errDeletionProtected)
+	}
+	return e.ExternalClient.Delete(ctx, mg)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+type protectedManaged struct {
+	fake.Managed
+	protected bool
+}
+
+func (m *protectedManaged) GetDeletionProtection() bool { return m.protected }
+
+func TestDeletionProtection(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		mg      resource.Managed
+		deleted bool
+		want    error
+	}{
+		"Protected": {
+			reason: "The external resource of a protected managed resource should not be deleted",
+			mg:     &protectedManaged{protected: true},
+			want:   errors.New(errDeletionProtected),
+		},
+		"Unprotected": {
+			reason:  "The external resource of an unprotected managed resource should be deleted",
+			mg:      &protectedManaged{protected: false},
+			deleted: true,
+		},
+		"NotProtectable": {
+			reason:  "The external resource of a managed resource that can't be protected should be deleted",
+			mg:      &fake.Managed{},
+			deleted: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			deleted := false
+			e := &deletionProtectionExternal{ExternalClient: &managed.ExternalClientFns{
+				DeleteFn: func(_ context.Context, _ resource.Managed) error {
+					deleted = true
+					return nil
+				},
+			}}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if deleted != tc.deleted {
+				t.Errorf("\n%s\ne.Delete(...): want deleted %t, got %t", tc.reason, tc.deleted, deleted)
+			}
+		})
+	}
+}
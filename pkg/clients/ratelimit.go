@@ -0,0 +1,117 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"net/http"
+	"sync"
+
+	"golang.org/x/time/rate"
+
+	"github.com/crossplane/provider-gcp/apis/v1beta1"
+)
+
+// rateLimiters are shared by all clients, so that a rate limit applies to all
+// the managed resources that use a ProviderConfig, no matter which controller
+// reconciles them.
+var rateLimiters = &rateLimiterRegistry{limiters: map[string]*rate.Limiter{}}
+
+// A rateLimiterRegistry keeps the rate limiters of ProviderConfigs.
+type rateLimiterRegistry struct {
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+// get returns the rate limiter with the supplied key, updating its limit and
+// burst if they changed since it was last requested.
+func (r *rateLimiterRegistry) get(key string, qps, burst int64) *rate.Limiter {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	l, ok := r.limiters[key]
+	if !ok {
+		l = rate.NewLimiter(rate.Limit(qps), int(burst))
+		r.limiters[key] = l
+		return l
+	}
+	if l.Limit() != rate.Limit(qps) {
+		l.SetLimit(rate.Limit(qps))
+	}
+	if l.Burst() != int(burst) {
+		l.SetBurst(int(burst))
+	}
+	return l
+}
+
+// RateLimiters are the rate limiters of the GCP services used with a
+// ProviderConfig.
+type RateLimiters struct {
+	// Default is shared by all services that don't have a rate limiter of
+	// their own, if any.
+	Default *rate.Limiter
+
+	// Services are the rate limiters of individual GCP services, keyed by
+	// the name of the service, e.g. compute.
+	Services map[string]*rate.Limiter
+}
+
+// For returns the rate limiter of the supplied GCP service, or nil if its API
+// calls aren't limited.
+func (r *RateLimiters) For(service string) *rate.Limiter {
+	if r == nil {
+		return nil
+	}
+	if l, ok := r.Services[service]; ok {
+		return l
+	}
+	return r.Default
+}
+
+// getRateLimiters returns the rate limiters configured by the supplied
+// RateLimitConfig of the ProviderConfig with the supplied name.
+func getRateLimiters(providerConfig string, rl *v1beta1.RateLimitConfig) *RateLimiters {
+	if rl == nil {
+		return nil
+	}
+	r := &RateLimiters{Services: make(map[string]*rate.Limiter, len(rl.Services))}
+	if rl.QPS != nil {
+		r.Default = rateLimiters.get(providerConfig, *rl.QPS, int64Default(rl.Burst, *rl.QPS))
+	}
+	for service, l := range rl.Services {
+		r.Services[service] = rateLimiters.get(providerConfig+"/"+service, l.QPS, int64Default(l.Burst, l.QPS))
+	}
+	return r
+}
+
+func int64Default(v *int64, def int64) int64 {
+	if v == nil {
+		return def
+	}
+	return *v
+}
+
+// rateLimitedTransport waits for its rate limiter before each request.
+type rateLimitedTransport struct {
+	limiter *rate.Limiter
+	base    http.RoundTripper
+}
+
+func (t *rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/time/rate"
+
+	"github.com/crossplane/provider-gcp/apis/v1beta1"
+)
+
+func TestGetRateLimiters(t *testing.T) {
+	type limit struct {
+		QPS   rate.Limit
+		Burst int
+	}
+	get := func(l *rate.Limiter) *limit {
+		if l == nil {
+			return nil
+		}
+		return &limit{QPS: l.Limit(), Burst: l.Burst()}
+	}
+
+	cases := map[string]struct {
+		reason string
+		rl     *v1beta1.RateLimitConfig
+		want   map[string]*limit
+	}{
+		"NoRateLimit": {
+			reason: "Should not limit any service if no rate limit is configured",
+			want:   map[string]*limit{"compute": nil, "container": nil},
+		},
+		"Default": {
+			reason: "Should limit all services with the default rate limit, with a burst of QPS by default",
+			rl:     &v1beta1.RateLimitConfig{QPS: Int64Ptr(10)},
+			want: map[string]*limit{
+				"compute":   {QPS: 10, Burst: 10},
+				"container": {QPS: 10, Burst: 10},
+			},
+		},
+		"Services": {
+			reason: "Should limit services with a rate limit of their own separately",
+			rl: &v1beta1.RateLimitConfig{
+				QPS:      Int64Ptr(10),
+				Burst:    Int64Ptr(20),
+				Services: map[string]v1beta1.RateLimit{"compute": {QPS: 5}},
+			},
+			want: map[string]*limit{
+				"compute":   {QPS: 5, Burst: 5},
+				"container": {QPS: 10, Burst: 20},
+			},
+		},
+		"ServicesOnly": {
+			reason: "Should not limit services without a rate limit if there's no default rate limit",
+			rl: &v1beta1.RateLimitConfig{
+				Services: map[string]v1beta1.RateLimit{"compute": {QPS: 5, Burst: Int64Ptr(1)}},
+			},
+			want: map[string]*limit{
+				"compute":   {QPS: 5, Burst: 1},
+				"container": nil,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := getRateLimiters(name, tc.rl)
+			got := map[string]*limit{}
+			for service := range tc.want {
+				got[service] = get(r.For(service))
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ngetRateLimiters(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestGetRateLimitersShared(t *testing.T) {
+	rl := &v1beta1.RateLimitConfig{QPS: Int64Ptr(10)}
+	a := getRateLimiters("shared", rl).For("compute")
+	b := getRateLimiters("shared", rl).For("container")
+	if a != b {
+		t.Errorf("getRateLimiters(...): want the rate limiter to be shared by all clients of a ProviderConfig")
+	}
+
+	rl.QPS = Int64Ptr(20)
+	c := getRateLimiters("shared", rl).For("compute")
+	if a != c || c.Limit() != 20 || c.Burst() != 20 {
+		t.Errorf("getRateLimiters(...): want the shared rate limiter to be updated, got limit %v and burst %d", c.Limit(), c.Burst())
+	}
+
+	if getRateLimiters("other", rl).For("compute") == a {
+		t.Errorf("getRateLimiters(...): want every ProviderConfig to have its own rate limiter")
+	}
+}
+
+func TestRateLimitedTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	// A limiter without any tokens never allows a request.
+	hc := &http.Client{Transport: &rateLimitedTransport{limiter: rate.NewLimiter(0, 0), base: http.DefaultTransport}}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	if _, err := hc.Do(req); err == nil {
+		t.Errorf("Do(...): want an error if the request can't wait for the rate limiter")
+	}
+
+	hc = &http.Client{Transport: &rateLimitedTransport{limiter: rate.NewLimiter(1, 1), base: http.DefaultTransport}}
+	rsp, err := hc.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Get(...): %s", err)
+	}
+	_ = rsp.Body.Close()
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recaptchaenterprise
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	recaptchaenterprise "google.golang.org/api/recaptchaenterprise/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-gcp/apis/recaptchaenterprise/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const parentFormat = "projects/%s"
+
+// KeyUpdateMask is the list of key fields that are updated with a patch
+// call. WAF settings can't be changed once a key is created.
+const KeyUpdateMask = "displayName,labels,webSettings,androidSettings,iosSettings"
+
+// GetKeyParent returns the project of the supplied KeyParameters in the form
+// projects/{project}, falling back to the supplied default project.
+func GetKeyParent(defaultProject string, p v1alpha1.KeyParameters) string {
+	project := defaultProject
+	if p.Project != nil {
+		project = *p.Project
+	}
+	return fmt.Sprintf(parentFormat, project)
+}
+
+// GetKeyName builds the fully qualified name of the key with the supplied ID
+// in the supplied parent.
+func GetKeyName(parent, id string) string {
+	return parent + "/keys/" + id
+}
+
+// GetKeyID returns the ID, i.e. the site key, of the key with the supplied
+// fully qualified name.
+func GetKeyID(name string) string {
+	return path.Base(name)
+}
+
+// GenerateKey produces a Key that is configured via the supplied
+// KeyParameters.
+func GenerateKey(p v1alpha1.KeyParameters) *recaptchaenterprise.GoogleCloudRecaptchaenterpriseV1Key {
+	k := &recaptchaenterprise.GoogleCloudRecaptchaenterpriseV1Key{
+		DisplayName: p.DisplayName,
+		Labels:      p.Labels,
+	}
+	if w := p.WebSettings; w != nil {
+		k.WebSettings = &recaptchaenterprise.GoogleCloudRecaptchaenterpriseV1WebKeySettings{
+			IntegrationType:             w.IntegrationType,
+			AllowAllDomains:             gcp.BoolValue(w.AllowAllDomains),
+			AllowedDomains:              w.AllowedDomains,
+			AllowAmpTraffic:             gcp.BoolValue(w.AllowAMPTraffic),
+			ChallengeSecurityPreference: gcp.StringValue(w.ChallengeSecurityPreference),
+		}
+	}
+	if a := p.AndroidSettings; a != nil {
+		k.AndroidSettings = &recaptchaenterprise.GoogleCloudRecaptchaenterpriseV1AndroidKeySettings{
+			AllowAllPackageNames:                 gcp.BoolValue(a.AllowAllPackageNames),
+			AllowedPackageNames:                  a.AllowedPackageNames,
+			SupportNonGoogleAppStoreDistribution: gcp.BoolValue(a.SupportNonGoogleAppStoreDistribution),
+		}
+	}
+	if i := p.IOSSettings; i != nil {
+		k.IosSettings = &recaptchaenterprise.GoogleCloudRecaptchaenterpriseV1IOSKeySettings{
+			AllowAllBundleIds: gcp.BoolValue(i.AllowAllBundleIDs),
+			AllowedBundleIds:  i.AllowedBundleIDs,
+		}
+	}
+	if w := p.WAFSettings; w != nil {
+		k.WafSettings = &recaptchaenterprise.GoogleCloudRecaptchaenterpriseV1WafSettings{
+			WafService: w.WAFService,
+			WafFeature: w.WAFFeature,
+		}
+	}
+	return k
+}
+
+// GenerateKeyObservation produces a KeyObservation from the supplied Key.
+func GenerateKeyObservation(k recaptchaenterprise.GoogleCloudRecaptchaenterpriseV1Key) v1alpha1.KeyObservation {
+	return v1alpha1.KeyObservation{
+		Name:       k.Name,
+		CreateTime: k.CreateTime,
+	}
+}
+
+// GetKeyConnectionDetails returns the site key of the supplied key, which
+// frontends embed to load reCAPTCHA.
+func GetKeyConnectionDetails(o v1alpha1.KeyObservation) managed.ConnectionDetails {
+	if o.Name == "" {
+		return managed.ConnectionDetails{}
+	}
+	return managed.ConnectionDetails{
+		v1alpha1.KeySecretSiteKeyKey: []byte(GetKeyID(o.Name)),
+	}
+}
+
+// LateInitializeKey fills the empty fields of the supplied KeyParameters with
+// the values seen in the supplied Key.
+func LateInitializeKey(p *v1alpha1.KeyParameters, k recaptchaenterprise.GoogleCloudRecaptchaenterpriseV1Key) {
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, k.Labels)
+	if w := p.WebSettings; w != nil && k.WebSettings != nil {
+		w.ChallengeSecurityPreference = gcp.LateInitializeString(w.ChallengeSecurityPreference, k.WebSettings.ChallengeSecurityPreference)
+	}
+}
+
+// IsKeyUpToDate returns true if the updatable fields of the supplied Key
+// match the supplied KeyParameters.
+func IsKeyUpToDate(p v1alpha1.KeyParameters, k recaptchaenterprise.GoogleCloudRecaptchaenterpriseV1Key) bool {
+	desired := GenerateKey(p)
+	return cmp.Equal(desired, &k, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(recaptchaenterprise.GoogleCloudRecaptchaenterpriseV1Key{}, "Name", "CreateTime", "TestingOptions", "WafSettings", "ServerResponse"))
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recaptchaenterprise
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	recaptchaenterprise "google.golang.org/api/recaptchaenterprise/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-gcp/apis/recaptchaenterprise/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	project = "cool-project"
+	siteKey = "6LdT4e8aAAAAAKzE7wRplC9gZ0m1mG0pD5Qq6vXy"
+	keyName = "projects/cool-project/keys/" + siteKey
+)
+
+func keyParams(m ...func(*v1alpha1.KeyParameters)) *v1alpha1.KeyParameters {
+	p := &v1alpha1.KeyParameters{
+		DisplayName: "storefront",
+		Labels:      map[string]string{"team": "web"},
+		WebSettings: &v1alpha1.WebKeySettings{
+			IntegrationType:             "SCORE",
+			AllowAllDomains:             gcp.BoolPtr(false),
+			AllowedDomains:              []string{"example.com"},
+			AllowAMPTraffic:             gcp.BoolPtr(false),
+			ChallengeSecurityPreference: gcp.StringPtr("USABILITY"),
+		},
+		WAFSettings: &v1alpha1.WAFSettings{
+			WAFService: "CA",
+			WAFFeature: "SESSION_TOKEN",
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func key(m ...func(*recaptchaenterprise.GoogleCloudRecaptchaenterpriseV1Key)) *recaptchaenterprise.GoogleCloudRecaptchaenterpriseV1Key {
+	k := &recaptchaenterprise.GoogleCloudRecaptchaenterpriseV1Key{
+		Name:        keyName,
+		CreateTime:  "2021-06-01T00:00:00Z",
+		DisplayName: "storefront",
+		Labels:      map[string]string{"team": "web"},
+		WebSettings: &recaptchaenterprise.GoogleCloudRecaptchaenterpriseV1WebKeySettings{
+			IntegrationType:             "SCORE",
+			AllowedDomains:              []string{"example.com"},
+			ChallengeSecurityPreference: "USABILITY",
+		},
+		WafSettings: &recaptchaenterprise.GoogleCloudRecaptchaenterpriseV1WafSettings{
+			WafService: "CA",
+			WafFeature: "SESSION_TOKEN",
+		},
+	}
+	for _, f := range m {
+		f(k)
+	}
+	return k
+}
+
+func TestKeyNames(t *testing.T) {
+	parent := GetKeyParent(project, *keyParams())
+	if diff := cmp.Diff(keyName, GetKeyName(parent, siteKey)); diff != "" {
+		t.Errorf("GetKeyName(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(siteKey, GetKeyID(keyName)); diff != "" {
+		t.Errorf("GetKeyID(...): -want, +got:\n%s", diff)
+	}
+	parent = GetKeyParent(project, *keyParams(func(p *v1alpha1.KeyParameters) { p.Project = gcp.StringPtr("other-project") }))
+	if diff := cmp.Diff("projects/other-project", parent); diff != "" {
+		t.Errorf("GetKeyParent(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateKey(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.KeyParameters
+		want *recaptchaenterprise.GoogleCloudRecaptchaenterpriseV1Key
+	}{
+		"Web": {
+			p: keyParams(),
+			want: key(func(k *recaptchaenterprise.GoogleCloudRecaptchaenterpriseV1Key) {
+				k.Name = ""
+				k.CreateTime = ""
+			}),
+		},
+		"Android": {
+			p: keyParams(func(p *v1alpha1.KeyParameters) {
+				p.WebSettings = nil
+				p.WAFSettings = nil
+				p.AndroidSettings = &v1alpha1.AndroidKeySettings{
+					AllowedPackageNames:                  []string{"com.example.app"},
+					SupportNonGoogleAppStoreDistribution: gcp.BoolPtr(true),
+				}
+			}),
+			want: &recaptchaenterprise.GoogleCloudRecaptchaenterpriseV1Key{
+				DisplayName: "storefront",
+				Labels:      map[string]string{"team": "web"},
+				AndroidSettings: &recaptchaenterprise.GoogleCloudRecaptchaenterpriseV1AndroidKeySettings{
+					AllowedPackageNames:                  []string{"com.example.app"},
+					SupportNonGoogleAppStoreDistribution: true,
+				},
+			},
+		},
+		"IOS": {
+			p: keyParams(func(p *v1alpha1.KeyParameters) {
+				p.WebSettings = nil
+				p.WAFSettings = nil
+				p.IOSSettings = &v1alpha1.IOSKeySettings{AllowedBundleIDs: []string{"com.example.app"}}
+			}),
+			want: &recaptchaenterprise.GoogleCloudRecaptchaenterpriseV1Key{
+				DisplayName: "storefront",
+				Labels:      map[string]string{"team": "web"},
+				IosSettings: &recaptchaenterprise.GoogleCloudRecaptchaenterpriseV1IOSKeySettings{
+					AllowedBundleIds: []string{"com.example.app"},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateKey(*tc.p)); diff != "" {
+				t.Errorf("GenerateKey(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateKeyObservation(t *testing.T) {
+	want := v1alpha1.KeyObservation{
+		Name:       keyName,
+		CreateTime: "2021-06-01T00:00:00Z",
+	}
+	if diff := cmp.Diff(want, GenerateKeyObservation(*key())); diff != "" {
+		t.Errorf("GenerateKeyObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetKeyConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		o    v1alpha1.KeyObservation
+		want managed.ConnectionDetails
+	}{
+		"NotCreated": {
+			o:    v1alpha1.KeyObservation{},
+			want: managed.ConnectionDetails{},
+		},
+		"Created": {
+			o:    v1alpha1.KeyObservation{Name: keyName},
+			want: managed.ConnectionDetails{v1alpha1.KeySecretSiteKeyKey: []byte(siteKey)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GetKeyConnectionDetails(tc.o)); diff != "" {
+				t.Errorf("GetKeyConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeKey(t *testing.T) {
+	got := keyParams(func(p *v1alpha1.KeyParameters) {
+		p.Labels = nil
+		p.WebSettings.ChallengeSecurityPreference = nil
+	})
+	LateInitializeKey(got, *key())
+	if diff := cmp.Diff(keyParams(), got); diff != "" {
+		t.Errorf("LateInitializeKey(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsKeyUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.KeyParameters
+		want bool
+	}{
+		"UpToDate": {
+			p:    keyParams(),
+			want: true,
+		},
+		"DisplayNameChanged": {
+			p: keyParams(func(p *v1alpha1.KeyParameters) { p.DisplayName = "checkout" }),
+		},
+		"DomainAdded": {
+			p: keyParams(func(p *v1alpha1.KeyParameters) { p.WebSettings.AllowedDomains = []string{"example.com", "example.org"} }),
+		},
+		"LabelsRemoved": {
+			p: keyParams(func(p *v1alpha1.KeyParameters) { p.Labels = nil }),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsKeyUpToDate(*tc.p, *key()); got != tc.want {
+				t.Errorf("IsKeyUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
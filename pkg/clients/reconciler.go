@@ -0,0 +1,272 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/v1beta1"
+)
+
+// Annotations honored by all managed resource reconcilers.
+const (
+	// AnnotationKeyReconciliationPaused suspends the reconciliation of a
+	// managed resource when set to "true".
+	AnnotationKeyReconciliationPaused = "crossplane.io/paused"
+
+	// AnnotationKeyPollInterval overrides how often a managed resource is
+	// checked for drift, e.g. "10m".
+	AnnotationKeyPollInterval = "gcp.crossplane.io/poll-interval"
+)
+
+// reconcileTimeout bounds the API calls a reconciler makes before and after
+// the managed reconciler, which has a timeout of its own.
+const reconcileTimeout = 1 * time.Minute
+
+// ReasonReconcilePaused indicates that the reconciliation of a managed
+// resource is paused.
+const ReasonReconcilePaused xpv1.ConditionReason = "ReconcilePaused"
+
+// Error strings.
+const (
+	errUpdateManagedStatus = "cannot update managed resource status"
+	errParsePollInterval   = "cannot parse " + AnnotationKeyPollInterval + " annotation"
+	errTrackUsage          = "cannot track ProviderConfig usage"
+)
+
+// IsPaused returns true if the reconciliation of the supplied object is
+// paused.
+func IsPaused(o metav1.Object) bool {
+	return o.GetAnnotations()[AnnotationKeyReconciliationPaused] == "true"
+}
+
+// GetPollInterval returns the poll interval of the supplied object, or zero if
+// it uses the poll interval of its controller.
+func GetPollInterval(o metav1.Object) (time.Duration, error) {
+	v, ok := o.GetAnnotations()[AnnotationKeyPollInterval]
+	if !ok {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, errors.Wrap(err, errParsePollInterval)
+	}
+	if d <= 0 {
+		return 0, errors.Errorf("%s: poll interval must be positive", errParsePollInterval)
+	}
+	return d, nil
+}
+
+// ReconcilePaused returns a condition that indicates that the reconciliation
+// of a managed resource is paused.
+func ReconcilePaused() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeSynced,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonReconcilePaused,
+	}
+}
+
+// NewReconciler returns a managed resource reconciler that uses the supplied
+// ExternalConnecter, wrapped by the connecters that every managed resource
+// needs, and the supplied options. It doesn't reconcile paused managed resources, and so makes no API
+// calls for them, and polls managed resources as hinted by RequeueAfter or at
+// the interval set by their poll interval annotation, if any. Failed
+// reconciles are counted by kind, and the plans of managed resources that
+// are dry run are recorded as events. Managed resources whose external client
+// failed with a terminal error are retried after TerminalErrorRequeueAfter
+// rather than with backoff.
+// Managed resources that use the default ProviderConfig are pointed to the
+// one selected by a DefaultProviderConfigPolicy, if any, before anything else
+// uses their ProviderConfig. The usage of the ProviderConfig of every managed
+// resource is tracked, even if it is paused or can't connect to GCP yet, so
+// that the ProviderConfig can't be deleted while it is referenced.
+// External names are initialized by an ExternalNameInitializer unless the
+// supplied options include other initializers, and connection details are
+// published to secret stores as well as to connection Secrets, with the keys
+// added by their connection details mapping annotation, unless the supplied
+// options include other connection publishers.
+func NewReconciler(m ctrl.Manager, of resource.ManagedKind, ec managed.ExternalConnecter, o ...managed.ReconcilerOption) reconcile.Reconciler {
+	kind := schema.GroupVersionKind(of).GroupKind().String()
+	c := &errorCountingClient{Client: m.GetClient(), kind: kind}
+	return &reconciler{
+		kind:   kind,
+		client: c,
+		record: event.NewAPIRecorder(m.GetEventRecorderFor(managed.ControllerName(kind))),
+		usage:  resource.NewProviderConfigUsageTracker(m.GetClient(), &v1beta1.ProviderConfigUsage{}),
+		newManaged: func() resource.Managed {
+			return resource.MustCreateObject(schema.GroupVersionKind(of), m.GetScheme()).(resource.Managed)
+		},
+		reconciler: managed.NewReconciler(&errorCountingManager{Manager: m, client: c}, of,
+			append([]managed.ReconcilerOption{
+				managed.WithExternalConnecter(wrapConnecter(ec)),
+				managed.WithInitializers(NewExternalNameInitializer(m.GetClient())),
+				managed.WithConnectionPublishers(NewMappingPublisher(managed.PublisherChain{
+					managed.NewAPISecretPublisher(m.GetClient(), m.GetScheme()),
+					NewExternalStorePublisher(m.GetClient()),
+				})),
+			}, o...)...),
+	}
+}
+
+type reconciler struct {
+	kind       string
+	client     client.Client
+	record     event.Recorder
+	usage      resource.Tracker
+	newManaged func() resource.Managed
+	reconciler reconcile.Reconciler
+}
+
+func (r *reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	ctx, cancel := context.WithTimeout(drainContext{ctx}, reconcileTimeout)
+	defer cancel()
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "Reconcile "+r.kind,
+		trace.WithAttributes(attrKind.String(r.kind), attrName.String(req.Name)))
+	defer span.End()
+	ctx = withReconcile(ctx, r.kind, req.Name)
+
+	mg := r.newManaged()
+	if err := r.client.Get(ctx, req.NamespacedName, mg); err != nil {
+		// The wrapped reconciler handles any error getting the managed
+		// resource.
+		return r.reconciler.Reconcile(ctx, req)
+	}
+	span.SetAttributes(attrExternalName.String(meta.GetExternalName(mg)))
+	inflight.set(mg.GetUID(), reconcileInfo{kind: r.kind, name: req.Name, span: span})
+	defer inflight.delete(mg.GetUID())
+
+	if mg.GetProviderConfigReference() != nil && !meta.WasDeleted(mg) {
+		// The usage of the ProviderConfig that the managed resource will
+		// actually use has to be tracked.
+		if err := applyDefaultProviderConfigPolicy(ctx, r.client, mg); err != nil {
+			mg.SetConditions(xpv1.ReconcileError(err))
+			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, mg), errUpdateManagedStatus)
+		}
+		if err := r.usage.Track(ctx, mg); err != nil {
+			mg.SetConditions(xpv1.ReconcileError(errors.Wrap(err, errTrackUsage)))
+			return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, mg), errUpdateManagedStatus)
+		}
+	}
+
+	if IsPaused(mg) {
+		if mg.GetCondition(xpv1.TypeSynced).Reason == ReasonReconcilePaused {
+			return reconcile.Result{}, nil
+		}
+		mg.SetConditions(ReconcilePaused())
+		return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, mg), errUpdateManagedStatus)
+	}
+
+	poll, err := GetPollInterval(mg)
+	if err != nil {
+		mg.SetConditions(xpv1.ReconcileError(err))
+		return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, mg), errUpdateManagedStatus)
+	}
+
+	result, err := r.reconciler.Reconcile(ctx, req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	if c, ok := dryRunPlans.pop(mg.GetUID()); ok {
+		r.record.Event(mg, event.Normal(event.Reason(c.Reason), c.Message))
+	}
+	// The managed reconciler only requeues after a delay once the external
+	// resource is up to date, i.e. when it's time to poll it again.
+	class, failed := errorClasses.pop(mg.GetUID())
+	if failed {
+		externalErrors.WithLabelValues(r.kind, string(class)).Inc()
+	}
+	hint, ok := requeueHints.pop(mg.GetUID())
+	switch {
+	case failed && class == ErrorTerminal:
+		// Retrying terminal errors with backoff would only hot-loop until
+		// the managed resource is fixed, which requeues it anyway.
+		result = reconcile.Result{RequeueAfter: TerminalErrorRequeueAfter}
+		if poll > TerminalErrorRequeueAfter {
+			result.RequeueAfter = poll
+		}
+	case result.RequeueAfter == 0:
+	case ok:
+		result.RequeueAfter = hint
+	case poll != 0:
+		result.RequeueAfter = poll
+	}
+	return result, err
+}
+
+// inflight tracks the managed resources that are being reconciled. The managed
+// reconciler doesn't pass the context of a reconcile on to external clients,
+// so they look up the span and identity of the reconcile here instead.
+var inflight = &reconciles{entries: map[types.UID]reconcileInfo{}}
+
+type reconciles struct {
+	mu      sync.Mutex
+	entries map[types.UID]reconcileInfo
+}
+
+func (r *reconciles) set(uid types.UID, i reconcileInfo) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries[uid] = i
+}
+
+func (r *reconciles) delete(uid types.UID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.entries, uid)
+}
+
+// get returns the reconcile of the supplied managed resource, if it's being
+// reconciled.
+func (r *reconciles) get(mg resource.Managed) (reconcileInfo, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	i, ok := r.entries[mg.GetUID()]
+	return i, ok
+}
+
+// A drainContext carries the values of its parent context, but isn't
+// cancelled with it.
+type drainContext struct {
+	context.Context
+}
+
+func (drainContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (drainContext) Done() <-chan struct{}       { return nil }
+func (drainContext) Err() error                  { return nil }
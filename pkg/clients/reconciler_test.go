@@ -0,0 +1,320 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/api/googleapi"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/v1beta1"
+)
+
+type reconcilerFn func(ctx context.Context, req reconcile.Request) (reconcile.Result, error)
+
+func (fn reconcilerFn) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	return fn(ctx, req)
+}
+
+func paused(c ...xpv1.Condition) test.ObjectFn {
+	return func(obj client.Object) error {
+		mg := obj.(resource.Managed)
+		mg.SetAnnotations(map[string]string{AnnotationKeyReconciliationPaused: "true"})
+		mg.SetConditions(c...)
+		return nil
+	}
+}
+
+func annotated(k, v string) test.ObjectFn {
+	return func(obj client.Object) error {
+		obj.(resource.Managed).SetAnnotations(map[string]string{k: v})
+		return nil
+	}
+}
+
+func referencingPC(pc string) test.ObjectFn {
+	return func(obj client.Object) error {
+		obj.(resource.Managed).SetProviderConfigReference(&xpv1.Reference{Name: pc})
+		return nil
+	}
+}
+
+func withUID(uid types.UID) test.ObjectFn {
+	return func(obj client.Object) error {
+		obj.SetUID(uid)
+		return nil
+	}
+}
+
+func TestReconcilerReconcile(t *testing.T) {
+	errBoom := errors.New("boom")
+	requeue := reconcile.Result{RequeueAfter: time.Minute}
+	wrapped := reconcilerFn(func(_ context.Context, _ reconcile.Request) (reconcile.Result, error) {
+		return requeue, nil
+	})
+
+	type want struct {
+		result reconcile.Result
+		err    error
+	}
+
+	cases := map[string]struct {
+		reason string
+		kube   client.Client
+		usage  resource.Tracker
+		want   want
+	}{
+		"GetFailed": {
+			reason: "The wrapped reconciler should handle errors getting the managed resource",
+			kube:   &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			want:   want{result: requeue},
+		},
+		"NotPaused": {
+			reason: "Managed resources that aren't paused should be reconciled",
+			kube:   &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+			want:   want{result: requeue},
+		},
+		"AlreadyPaused": {
+			reason: "Managed resources that are already marked as paused should not be updated",
+			kube:   &test.MockClient{MockGet: test.NewMockGetFn(nil, paused(ReconcilePaused()))},
+			want:   want{},
+		},
+		"StatusUpdateFailed": {
+			reason: "Should return an error if the paused condition can't be persisted",
+			kube: &test.MockClient{
+				MockGet:          test.NewMockGetFn(nil, paused()),
+				MockStatusUpdate: test.NewMockStatusUpdateFn(errBoom),
+			},
+			want: want{err: errors.Wrap(errBoom, errUpdateManagedStatus)},
+		},
+		"Paused": {
+			reason: "Paused managed resources should be marked as such instead of being reconciled",
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, paused(xpv1.ReconcileSuccess())),
+				MockStatusUpdate: test.NewMockStatusUpdateFn(nil, func(obj client.Object) error {
+					got := obj.(resource.Managed).GetCondition(xpv1.TypeSynced)
+					if diff := cmp.Diff(ReconcilePaused(), got, cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+						t.Errorf("Status().Update(...): -want, +got:\n%s", diff)
+					}
+					return nil
+				}),
+			},
+			want: want{},
+		},
+		"PollInterval": {
+			reason: "Managed resources should be polled at the interval set by their annotation",
+			kube:   &test.MockClient{MockGet: test.NewMockGetFn(nil, annotated(AnnotationKeyPollInterval, "10m"))},
+			want:   want{result: reconcile.Result{RequeueAfter: 10 * time.Minute}},
+		},
+		"RequeueHint": {
+			reason: "Managed resources should be polled as hinted while they were observed, even if they have a poll interval",
+			kube:   &test.MockClient{MockGet: test.NewMockGetFn(nil, annotated(AnnotationKeyPollInterval, "10m"), withUID("hinted"))},
+			want:   want{result: reconcile.Result{RequeueAfter: 2 * time.Minute}},
+		},
+		"TerminalError": {
+			reason: "Managed resources whose external client failed with a terminal error should not be retried with backoff",
+			kube:   &test.MockClient{MockGet: test.NewMockGetFn(nil, withUID("terminal"))},
+			want:   want{result: reconcile.Result{RequeueAfter: TerminalErrorRequeueAfter}},
+		},
+		"TrackFailed": {
+			reason: "Managed resources whose ProviderConfig usage can't be tracked should not be reconciled",
+			kube: &test.MockClient{
+				MockGet:          test.NewMockGetFn(nil, referencingPC("default")),
+				MockList:         test.NewMockListFn(nil),
+				MockStatusUpdate: test.NewMockStatusUpdateFn(nil),
+			},
+			usage: resource.TrackerFn(func(_ context.Context, _ resource.Managed) error { return errBoom }),
+			want:  want{result: reconcile.Result{Requeue: true}},
+		},
+		"PolicyFailed": {
+			reason: "Managed resources whose DefaultProviderConfigPolicy can't be applied should not be reconciled",
+			kube: &test.MockClient{
+				MockGet:          test.NewMockGetFn(nil, referencingPC("default")),
+				MockList:         test.NewMockListFn(errBoom),
+				MockStatusUpdate: test.NewMockStatusUpdateFn(nil),
+			},
+			usage: resource.TrackerFn(func(_ context.Context, _ resource.Managed) error { return nil }),
+			want:  want{result: reconcile.Result{Requeue: true}},
+		},
+		"PolicyAppliedBeforeTracking": {
+			reason: "The usage of the ProviderConfig selected by a DefaultProviderConfigPolicy should be tracked",
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, referencingPC("default")),
+				MockList: test.NewMockListFn(nil, func(obj client.ObjectList) error {
+					obj.(*v1beta1.DefaultProviderConfigPolicyList).Items = []v1beta1.DefaultProviderConfigPolicy{{
+						Spec: v1beta1.DefaultProviderConfigPolicySpec{ProviderConfigRef: xpv1.Reference{Name: "team"}},
+					}}
+					return nil
+				}),
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			usage: resource.TrackerFn(func(_ context.Context, mg resource.Managed) error {
+				if got := mg.GetProviderConfigReference().Name; got != "team" {
+					t.Errorf("Track(...): want usage of ProviderConfig team tracked, got %s", got)
+				}
+				return nil
+			}),
+			want: want{result: requeue},
+		},
+		"TrackedWhilePaused": {
+			reason: "The ProviderConfig usage of paused managed resources should be tracked too",
+			kube: &test.MockClient{
+				MockGet:          test.NewMockGetFn(nil, referencingPC("default"), paused(ReconcilePaused())),
+				MockList:         test.NewMockListFn(nil),
+				MockStatusUpdate: test.NewMockStatusUpdateFn(nil),
+			},
+			usage: resource.TrackerFn(func(_ context.Context, _ resource.Managed) error { return errBoom }),
+			want:  want{result: reconcile.Result{Requeue: true}},
+		},
+		"InvalidPollInterval": {
+			reason: "Managed resources with an invalid poll interval should not be reconciled",
+			kube: &test.MockClient{
+				MockGet:          test.NewMockGetFn(nil, annotated(AnnotationKeyPollInterval, "-1m")),
+				MockStatusUpdate: test.NewMockStatusUpdateFn(nil),
+			},
+			want: want{},
+		},
+	}
+
+	// The hint is consumed by the first reconcile of the hinted managed
+	// resource.
+	RequeueAfter(&fake.Managed{ObjectMeta: metav1.ObjectMeta{UID: "hinted"}}, 2*time.Minute)
+	errorClasses.set("terminal", &googleapi.Error{Code: http.StatusForbidden})
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &reconciler{
+				client:     tc.kube,
+				usage:      tc.usage,
+				newManaged: func() resource.Managed { return &fake.Managed{} },
+				reconciler: wrapped,
+			}
+			got, err := r.Reconcile(context.Background(), reconcile.Request{})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReconcilerDrain(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r := &reconciler{
+		client:     &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+		newManaged: func() resource.Managed { return &fake.Managed{} },
+		reconciler: reconcilerFn(func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+			return reconcile.Result{}, ctx.Err()
+		}),
+	}
+	if _, err := r.Reconcile(ctx, reconcile.Request{}); err != nil {
+		t.Errorf("r.Reconcile(...): reconciles should not be cancelled when the provider shuts down: %s", err)
+	}
+}
+
+func TestReconcilerExternalRequests(t *testing.T) {
+	l := &recordingLogger{}
+	ok := roundTripperFn(func(_ *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(&bytes.Buffer{})}, nil
+	})
+	hc := &http.Client{Transport: &loggingTransport{service: "compute", log: l, base: &tracingTransport{service: "compute", base: ok}}}
+
+	sr := tracetest.NewSpanRecorder()
+	global := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr)))
+	defer otel.SetTracerProvider(global)
+
+	// The external client sends a request to a GCP API while it observes
+	// the managed resource.
+	c := managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return &managed.ExternalClientFns{
+			ObserveFn: func(ctx context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+				req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://compute.googleapis.com/compute/v1/projects/p/global/networks/n", nil)
+				rsp, err := hc.Do(req)
+				if err != nil {
+					return managed.ExternalObservation{}, err
+				}
+				_ = rsp.Body.Close()
+				return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+			},
+		}, nil
+	})
+	kube := &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			obj.SetName("example")
+			obj.SetUID("example")
+			return nil
+		}),
+		MockUpdate:       test.NewMockUpdateFn(nil),
+		MockStatusUpdate: test.NewMockStatusUpdateFn(nil),
+	}
+	r := &reconciler{
+		kind:       "Managed.fake",
+		client:     kube,
+		newManaged: func() resource.Managed { return &fake.Managed{} },
+		reconciler: managed.NewReconciler(&fake.Manager{Client: kube, Scheme: fake.SchemeWith(&fake.Managed{})},
+			resource.ManagedKind(fake.GVK(&fake.Managed{})),
+			managed.WithExternalConnecter(wrapConnecter(c)),
+			managed.WithInitializers(),
+		),
+	}
+	if _, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "example"}}); err != nil {
+		t.Fatalf("r.Reconcile(...): %s", err)
+	}
+
+	want := map[string]interface{}{"kind": "Managed.fake", "name": "example"}
+	got := map[string]interface{}{"kind": l.kv["kind"], "name": l.kv["name"]}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("r.Reconcile(...): requests should be logged as sent for the managed resource: -want, +got:\n%s", diff)
+	}
+
+	spans := map[string]sdktrace.ReadOnlySpan{}
+	for _, s := range sr.Ended() {
+		spans[s.Name()] = s
+	}
+	parent, child := spans["Reconcile Managed.fake"], spans["compute GET"]
+	if parent == nil || child == nil {
+		t.Fatalf("r.Reconcile(...): want reconcile and request spans, got %v", spans)
+	}
+	if diff := cmp.Diff(parent.SpanContext().SpanID(), child.Parent().SpanID()); diff != "" {
+		t.Errorf("r.Reconcile(...): requests should be traced as children of the reconcile: -want parent, +got parent:\n%s", diff)
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rediscluster
+
+import (
+	"fmt"
+	"strconv"
+
+	redis "google.golang.org/api/redis/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-gcp/apis/cache/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	clusterNameFormat = "projects/%s/locations/%s/clusters/%s"
+	parentFormat      = "projects/%s/locations/%s"
+)
+
+// UpdateMask is the set of Cluster fields that can be updated in place.
+const UpdateMask = "shard_count,replica_count"
+
+// GetFullyQualifiedParent builds the fully qualified name of the cluster
+// parent.
+func GetFullyQualifiedParent(project string, p v1alpha1.RedisClusterParameters) string {
+	return fmt.Sprintf(parentFormat, project, p.Region)
+}
+
+// GetFullyQualifiedName builds the fully qualified name of the cluster.
+func GetFullyQualifiedName(project string, p v1alpha1.RedisClusterParameters, name string) string {
+	return fmt.Sprintf(clusterNameFormat, project, p.Region, name)
+}
+
+// GenerateCluster produces a Redis Cluster that is configured via given
+// RedisClusterParameters.
+func GenerateCluster(name string, p v1alpha1.RedisClusterParameters) *redis.Cluster {
+	c := &redis.Cluster{
+		Name:                  name,
+		ShardCount:            p.ShardCount,
+		ReplicaCount:          gcp.Int64Value(p.ReplicaCount),
+		AuthorizationMode:     gcp.StringValue(p.AuthorizationMode),
+		TransitEncryptionMode: gcp.StringValue(p.TransitEncryptionMode),
+	}
+	// A replica count of zero is meaningful, so we send it whenever it is
+	// set.
+	if p.ReplicaCount != nil {
+		c.ForceSendFields = []string{"ReplicaCount"}
+	}
+	for _, psc := range p.PSCConfigs {
+		c.PscConfigs = append(c.PscConfigs, &redis.PscConfig{Network: gcp.StringValue(psc.Network)})
+	}
+	return c
+}
+
+// GenerateObservation produces a RedisClusterObservation from the supplied
+// Cluster.
+func GenerateObservation(c redis.Cluster) v1alpha1.RedisClusterObservation {
+	o := v1alpha1.RedisClusterObservation{
+		Name:       c.Name,
+		UID:        c.Uid,
+		State:      c.State,
+		SizeGB:     c.SizeGb,
+		CreateTime: c.CreateTime,
+	}
+	for _, e := range c.DiscoveryEndpoints {
+		if e == nil {
+			continue
+		}
+		o.DiscoveryEndpoints = append(o.DiscoveryEndpoints, v1alpha1.DiscoveryEndpoint{Address: e.Address, Port: e.Port})
+	}
+	for _, psc := range c.PscConnections {
+		if psc == nil {
+			continue
+		}
+		o.PSCConnections = append(o.PSCConnections, v1alpha1.PSCConnection{
+			PSCConnectionID: psc.PscConnectionId,
+			Address:         psc.Address,
+			ForwardingRule:  psc.ForwardingRule,
+			ProjectID:       psc.ProjectId,
+			Network:         psc.Network,
+		})
+	}
+	return o
+}
+
+// GetConnectionDetails returns the connection details of the first discovery
+// endpoint of the supplied observation, if any.
+func GetConnectionDetails(o v1alpha1.RedisClusterObservation) managed.ConnectionDetails {
+	if len(o.DiscoveryEndpoints) == 0 {
+		return managed.ConnectionDetails{}
+	}
+	return managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(o.DiscoveryEndpoints[0].Address),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.FormatInt(o.DiscoveryEndpoints[0].Port, 10)),
+	}
+}
+
+// LateInitializeSpec fills the empty fields of RedisClusterParameters with
+// the values seen in the supplied Cluster.
+func LateInitializeSpec(p *v1alpha1.RedisClusterParameters, c redis.Cluster) {
+	p.ReplicaCount = gcp.LateInitializeInt64(p.ReplicaCount, c.ReplicaCount)
+	p.AuthorizationMode = gcp.LateInitializeString(p.AuthorizationMode, c.AuthorizationMode)
+	p.TransitEncryptionMode = gcp.LateInitializeString(p.TransitEncryptionMode, c.TransitEncryptionMode)
+}
+
+// IsUpToDate returns true if the supplied Cluster matches the fields of the
+// supplied RedisClusterParameters that can be updated in place.
+func IsUpToDate(p v1alpha1.RedisClusterParameters, c redis.Cluster) bool {
+	if p.ShardCount != c.ShardCount {
+		return false
+	}
+	if p.ReplicaCount != nil && *p.ReplicaCount != c.ReplicaCount {
+		return false
+	}
+	return true
+}
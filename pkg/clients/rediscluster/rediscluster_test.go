@@ -0,0 +1,187 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rediscluster
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	redis "google.golang.org/api/redis/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-gcp/apis/cache/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	fullName = "projects/coolProject/locations/us-cool1/clusters/cool-cluster"
+	network  = "projects/coolProject/global/networks/default"
+)
+
+func params(m ...func(*v1alpha1.RedisClusterParameters)) *v1alpha1.RedisClusterParameters {
+	p := &v1alpha1.RedisClusterParameters{
+		Region:                "us-cool1",
+		ShardCount:            3,
+		ReplicaCount:          gcp.Int64Ptr(1),
+		PSCConfigs:            []v1alpha1.PSCConfig{{Network: gcp.StringPtr(network)}},
+		AuthorizationMode:     gcp.StringPtr("AUTH_MODE_IAM_AUTH"),
+		TransitEncryptionMode: gcp.StringPtr("TRANSIT_ENCRYPTION_MODE_SERVER_AUTHENTICATION"),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func cluster(m ...func(*redis.Cluster)) *redis.Cluster {
+	c := &redis.Cluster{
+		Name:                  fullName,
+		ShardCount:            3,
+		ReplicaCount:          1,
+		PscConfigs:            []*redis.PscConfig{{Network: network}},
+		AuthorizationMode:     "AUTH_MODE_IAM_AUTH",
+		TransitEncryptionMode: "TRANSIT_ENCRYPTION_MODE_SERVER_AUTHENTICATION",
+		ForceSendFields:       []string{"ReplicaCount"},
+	}
+	for _, f := range m {
+		f(c)
+	}
+	return c
+}
+
+func TestGenerateCluster(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.RedisClusterParameters
+		want *redis.Cluster
+	}{
+		"FullConversion": {
+			p:    *params(),
+			want: cluster(),
+		},
+		"NoReplicaCount": {
+			p: *params(func(p *v1alpha1.RedisClusterParameters) {
+				p.ReplicaCount = nil
+			}),
+			want: cluster(func(c *redis.Cluster) {
+				c.ReplicaCount = 0
+				c.ForceSendFields = nil
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCluster(fullName, tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateCluster(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	c := redis.Cluster{
+		Name:               fullName,
+		State:              v1alpha1.RedisClusterStateActive,
+		SizeGb:             39,
+		DiscoveryEndpoints: []*redis.DiscoveryEndpoint{{Address: "10.0.0.5", Port: 6379}},
+		PscConnections:     []*redis.PscConnection{{PscConnectionId: "123", Address: "10.0.0.5", Network: network}},
+	}
+	want := v1alpha1.RedisClusterObservation{
+		Name:               fullName,
+		State:              v1alpha1.RedisClusterStateActive,
+		SizeGB:             39,
+		DiscoveryEndpoints: []v1alpha1.DiscoveryEndpoint{{Address: "10.0.0.5", Port: 6379}},
+		PSCConnections:     []v1alpha1.PSCConnection{{PSCConnectionID: "123", Address: "10.0.0.5", Network: network}},
+	}
+	if diff := cmp.Diff(want, GenerateObservation(c)); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		o    v1alpha1.RedisClusterObservation
+		want managed.ConnectionDetails
+	}{
+		"NoEndpoints": {
+			o:    v1alpha1.RedisClusterObservation{},
+			want: managed.ConnectionDetails{},
+		},
+		"Endpoint": {
+			o: v1alpha1.RedisClusterObservation{
+				DiscoveryEndpoints: []v1alpha1.DiscoveryEndpoint{{Address: "10.0.0.5", Port: 6379}},
+			},
+			want: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretEndpointKey: []byte("10.0.0.5"),
+				xpv1.ResourceCredentialsSecretPortKey:     []byte("6379"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GetConnectionDetails(tc.o)); diff != "" {
+				t.Errorf("GetConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	p := &v1alpha1.RedisClusterParameters{Region: "us-cool1", ShardCount: 3, PSCConfigs: []v1alpha1.PSCConfig{{Network: gcp.StringPtr(network)}}}
+	LateInitializeSpec(p, *cluster())
+	if diff := cmp.Diff(params(), p); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.RedisClusterParameters
+		c    redis.Cluster
+		want bool
+	}{
+		"UpToDate": {
+			p:    *params(),
+			c:    *cluster(func(c *redis.Cluster) { c.SizeGb = 39 }),
+			want: true,
+		},
+		"ShardCountDiffers": {
+			p:    *params(),
+			c:    *cluster(func(c *redis.Cluster) { c.ShardCount = 5 }),
+			want: false,
+		},
+		"ReplicaCountDiffers": {
+			p:    *params(),
+			c:    *cluster(func(c *redis.Cluster) { c.ReplicaCount = 2 }),
+			want: false,
+		},
+		"ImmutableFieldDiffers": {
+			p:    *params(),
+			c:    *cluster(func(c *redis.Cluster) { c.AuthorizationMode = "AUTH_MODE_DISABLED" }),
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(tc.p, tc.c)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
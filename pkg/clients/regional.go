@@ -0,0 +1,59 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"fmt"
+	"sort"
+)
+
+// regionalEndpointFormats are the base URLs of the regional endpoints of the
+// services that have them, keyed by service name. Regional endpoints
+// guarantee that requests are terminated within the region.
+var regionalEndpointFormats = map[string]string{
+	"cloudkms": "https://cloudkms.%s.rep.googleapis.com/",
+	"logging":  "https://logging.%s.rep.googleapis.com/",
+	"pubsub":   "https://pubsub.%s.rep.googleapis.com/",
+	"storage":  "https://storage.%s.rep.googleapis.com/storage/v1/",
+}
+
+// RegionalServices returns the sorted names of the services that have
+// regional endpoints. All other services use their global endpoint.
+func RegionalServices() []string {
+	s := make([]string, 0, len(regionalEndpointFormats))
+	for name := range regionalEndpointFormats {
+		s = append(s, name)
+	}
+	sort.Strings(s)
+	return s
+}
+
+// getEndpoints returns the endpoints of the services that have a regional
+// endpoint in the supplied region, overridden by the supplied endpoints.
+func getEndpoints(region string, overrides map[string]string) map[string]string {
+	if region == "" {
+		return overrides
+	}
+	e := make(map[string]string, len(regionalEndpointFormats)+len(overrides))
+	for service, f := range regionalEndpointFormats {
+		e[service] = fmt.Sprintf(f, region)
+	}
+	for service, url := range overrides {
+		e[service] = url
+	}
+	return e
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGetEndpoints(t *testing.T) {
+	cases := map[string]struct {
+		reason    string
+		region    string
+		overrides map[string]string
+		want      map[string]string
+	}{
+		"NoRegion": {
+			reason:    "Only the overrides should be used if no region is configured",
+			overrides: map[string]string{"compute": "https://compute.example.com/compute/v1/"},
+			want:      map[string]string{"compute": "https://compute.example.com/compute/v1/"},
+		},
+		"Region": {
+			reason:    "Services with a regional endpoint should use it unless it is overridden",
+			region:    "europe-west3",
+			overrides: map[string]string{"pubsub": "https://pubsub.example.com/"},
+			want: map[string]string{
+				"cloudkms": "https://cloudkms.europe-west3.rep.googleapis.com/",
+				"logging":  "https://logging.europe-west3.rep.googleapis.com/",
+				"pubsub":   "https://pubsub.example.com/",
+				"storage":  "https://storage.europe-west3.rep.googleapis.com/storage/v1/",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := getEndpoints(tc.region, tc.overrides)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ngetEndpoints(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
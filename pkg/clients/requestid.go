@@ -0,0 +1,44 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+)
+
+// AnnotationKeyCreateRequestID is the annotation that holds the request ID of
+// the latest attempt to create the external resource of a managed resource.
+const AnnotationKeyCreateRequestID = "gcp.crossplane.io/create-request-id"
+
+// CreateRequestID returns the request ID to create the external resource of
+// the supplied managed resource with. APIs that support request IDs ignore a
+// request whose ID they have seen recently, so retrying a create that failed,
+// e.g. because it timed out, doesn't result in a conflict if the external
+// resource was created after all. The ID is stored in an annotation of the
+// managed resource, which the managed reconciler persists whether or not the
+// create succeeds, and is reused until a create succeeds.
+func CreateRequestID(o metav1.Object) string {
+	id := o.GetAnnotations()[AnnotationKeyCreateRequestID]
+	if id == "" || !meta.GetExternalCreateSucceeded(o).Before(meta.GetExternalCreateFailed(o)) {
+		id = string(uuid.NewUUID())
+		meta.AddAnnotations(o, map[string]string{AnnotationKeyCreateRequestID: id})
+	}
+	return id
+}
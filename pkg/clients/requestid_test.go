@@ -0,0 +1,43 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+)
+
+func TestCreateRequestID(t *testing.T) {
+	mg := &fake.Managed{}
+	first := CreateRequestID(mg)
+	if first == "" || mg.GetAnnotations()[AnnotationKeyCreateRequestID] != first {
+		t.Fatalf("CreateRequestID(...): want a new request ID to be stored, got %q", first)
+	}
+
+	meta.SetExternalCreateFailed(mg, time.Now())
+	if got := CreateRequestID(mg); got != first {
+		t.Errorf("CreateRequestID(...): want the request ID to be reused after a failed create, got %q", got)
+	}
+
+	meta.SetExternalCreateSucceeded(mg, time.Now().Add(time.Second))
+	if got := CreateRequestID(mg); got == first {
+		t.Errorf("CreateRequestID(...): want a new request ID after a successful create")
+	}
+}
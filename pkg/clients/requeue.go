@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// MinRequeueAfter is the shortest requeue hint. Operations that take longer
+// than expected are observed at this interval until they're done.
+const MinRequeueAfter = 30 * time.Second
+
+// requeueHints are set by external clients while they observe a managed
+// resource, and consumed by the reconciler once it's done reconciling it.
+var requeueHints = &hints{entries: map[types.UID]time.Duration{}}
+
+type hints struct {
+	mu      sync.Mutex
+	entries map[types.UID]time.Duration
+}
+
+func (h *hints) set(uid types.UID, d time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.entries[uid] = d
+}
+
+func (h *hints) pop(uid types.UID) (time.Duration, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	d, ok := h.entries[uid]
+	delete(h.entries, uid)
+	return d, ok
+}
+
+// RequeueAfter hints that the supplied managed resource should be observed
+// again after the supplied duration, e.g. when a long running operation is
+// expected to be done. The hint takes precedence over the poll interval, but
+// is only used if the managed resource is up to date, i.e. if it would be
+// polled.
+func RequeueAfter(o metav1.Object, d time.Duration) {
+	requeueHints.set(o.GetUID(), d)
+}
+
+// RemainingTime returns how long an operation that started at the supplied
+// RFC 3339 time, and that typically takes the supplied duration, is expected
+// to take until it's done. It's never shorter than MinRequeueAfter, which is
+// also returned if the start time can't be parsed.
+func RemainingTime(started string, typical time.Duration) time.Duration {
+	t, err := time.Parse(time.RFC3339, started)
+	if err != nil {
+		return MinRequeueAfter
+	}
+	if d := typical - time.Since(t); d > MinRequeueAfter {
+		return d
+	}
+	return MinRequeueAfter
+}
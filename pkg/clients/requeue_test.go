@@ -0,0 +1,57 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRemainingTime(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		started string
+		want    time.Duration
+	}{
+		"Running": {
+			reason:  "Should return the time until the operation typically is done",
+			started: time.Now().Add(-4 * time.Minute).Format(time.RFC3339),
+			want:    6 * time.Minute,
+		},
+		"Overdue": {
+			reason:  "Should return the minimum if the operation takes longer than usual",
+			started: time.Now().Add(-20 * time.Minute).Format(time.RFC3339),
+			want:    MinRequeueAfter,
+		},
+		"Unparseable": {
+			reason:  "Should return the minimum if the start time is unknown",
+			started: "",
+			want:    MinRequeueAfter,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := RemainingTime(tc.started, 10*time.Minute)
+			// Allow for the time that passed since the start time was
+			// formatted.
+			if d := tc.want - got; d < 0 || d > 2*time.Second {
+				t.Errorf("\n%s\nRemainingTime(...): want %s, got %s", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
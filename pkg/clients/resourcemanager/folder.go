@@ -0,0 +1,83 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcemanager
+
+import (
+	"fmt"
+	"strings"
+
+	cloudresourcemanager "google.golang.org/api/cloudresourcemanager/v3"
+
+	"github.com/crossplane/provider-gcp/apis/resourcemanager/v1alpha1"
+)
+
+const (
+	folderNamePrefix = "folders/"
+
+	// FolderUpdateMask is the list of folder fields that can be updated with
+	// a patch call.
+	FolderUpdateMask = "displayName"
+)
+
+// GetFolderName builds the resource name of a folder from its ID.
+func GetFolderName(id string) string {
+	return fmt.Sprintf("%s%s", folderNamePrefix, id)
+}
+
+// GetFolderID extracts the ID of a folder from its resource name.
+func GetFolderID(name string) string {
+	return strings.TrimPrefix(name, folderNamePrefix)
+}
+
+// GenerateFolder produces a Folder that is configured via the given
+// FolderParameters.
+func GenerateFolder(p v1alpha1.FolderParameters) *cloudresourcemanager.Folder {
+	return &cloudresourcemanager.Folder{
+		Parent:      p.Parent,
+		DisplayName: p.DisplayName,
+	}
+}
+
+// GenerateFolderObservation produces a FolderObservation from the supplied
+// Folder.
+func GenerateFolderObservation(f cloudresourcemanager.Folder) v1alpha1.FolderObservation {
+	return v1alpha1.FolderObservation{
+		Name:       f.Name,
+		State:      f.State,
+		CreateTime: f.CreateTime,
+		UpdateTime: f.UpdateTime,
+		DeleteTime: f.DeleteTime,
+	}
+}
+
+// IsFolderUpToDate returns true if the supplied Folder matches the fields of
+// the supplied FolderParameters that can be updated with a patch call.
+func IsFolderUpToDate(p v1alpha1.FolderParameters, f cloudresourcemanager.Folder) bool {
+	return p.DisplayName == f.DisplayName
+}
+
+// FindFolder returns the active folder with the display name of the supplied
+// FolderParameters, or nil if there is none. Display names are unique among
+// the active folders that share a parent.
+func FindFolder(p v1alpha1.FolderParameters, folders []*cloudresourcemanager.Folder) *cloudresourcemanager.Folder {
+	for _, f := range folders {
+		if f.DisplayName == p.DisplayName && f.State == v1alpha1.FolderStateActive {
+			return f
+		}
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcemanager
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cloudresourcemanager "google.golang.org/api/cloudresourcemanager/v3"
+
+	"github.com/crossplane/provider-gcp/apis/resourcemanager/v1alpha1"
+)
+
+func folderParams(m ...func(*v1alpha1.FolderParameters)) *v1alpha1.FolderParameters {
+	p := &v1alpha1.FolderParameters{
+		Parent:      "organizations/42",
+		DisplayName: "Cool folder",
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func folder(m ...func(*cloudresourcemanager.Folder)) *cloudresourcemanager.Folder {
+	f := &cloudresourcemanager.Folder{
+		Parent:      "organizations/42",
+		DisplayName: "Cool folder",
+	}
+	for _, fn := range m {
+		fn(f)
+	}
+	return f
+}
+
+func TestGetFolderName(t *testing.T) {
+	if diff := cmp.Diff("folders/1234", GetFolderName("1234")); diff != "" {
+		t.Errorf("GetFolderName(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("1234", GetFolderID("folders/1234")); diff != "" {
+		t.Errorf("GetFolderID(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateFolder(t *testing.T) {
+	if diff := cmp.Diff(folder(), GenerateFolder(*folderParams())); diff != "" {
+		t.Errorf("GenerateFolder(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateFolderObservation(t *testing.T) {
+	f := *folder(func(f *cloudresourcemanager.Folder) {
+		f.Name = "folders/1234"
+		f.State = v1alpha1.FolderStateActive
+		f.CreateTime = "2021-01-01T00:00:00Z"
+	})
+	want := v1alpha1.FolderObservation{
+		Name:       "folders/1234",
+		State:      v1alpha1.FolderStateActive,
+		CreateTime: "2021-01-01T00:00:00Z",
+	}
+	if diff := cmp.Diff(want, GenerateFolderObservation(f)); diff != "" {
+		t.Errorf("GenerateFolderObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsFolderUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		params *v1alpha1.FolderParameters
+		obs    *cloudresourcemanager.Folder
+		want   bool
+	}{
+		"UpToDate": {
+			params: folderParams(),
+			obs:    folder(),
+			want:   true,
+		},
+		"ParentIsIgnored": {
+			params: folderParams(func(p *v1alpha1.FolderParameters) {
+				p.Parent = "folders/1"
+			}),
+			obs:  folder(),
+			want: true,
+		},
+		"DisplayNameDiffers": {
+			params: folderParams(),
+			obs: folder(func(f *cloudresourcemanager.Folder) {
+				f.DisplayName = "Other folder"
+			}),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsFolderUpToDate(*tc.params, *tc.obs)); diff != "" {
+				t.Errorf("IsFolderUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindFolder(t *testing.T) {
+	active := folder(func(f *cloudresourcemanager.Folder) {
+		f.Name = "folders/2"
+		f.State = v1alpha1.FolderStateActive
+	})
+	cases := map[string]struct {
+		folders []*cloudresourcemanager.Folder
+		want    *cloudresourcemanager.Folder
+	}{
+		"Found": {
+			folders: []*cloudresourcemanager.Folder{
+				folder(func(f *cloudresourcemanager.Folder) {
+					f.Name = "folders/1"
+					f.DisplayName = "Other folder"
+					f.State = v1alpha1.FolderStateActive
+				}),
+				active,
+			},
+			want: active,
+		},
+		"PendingDeletion": {
+			folders: []*cloudresourcemanager.Folder{
+				folder(func(f *cloudresourcemanager.Folder) {
+					f.Name = "folders/1"
+					f.State = v1alpha1.FolderStateDeleteRequested
+				}),
+			},
+		},
+		"NotFound": {},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, FindFolder(*folderParams(), tc.folders)); diff != "" {
+				t.Errorf("FindFolder(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcemanager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	cloudbilling "google.golang.org/api/cloudbilling/v1"
+	cloudresourcemanager "google.golang.org/api/cloudresourcemanager/v3"
+
+	"github.com/crossplane/provider-gcp/apis/resourcemanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectNameFormat        = "projects/%s"
+	billingAccountNameFormat = "billingAccounts/%s"
+
+	// ProjectUpdateMask is the list of project fields that can be updated
+	// with a patch call.
+	ProjectUpdateMask = "displayName,labels"
+
+	// DefaultNetworkName is the name of the network Compute Engine creates
+	// in new projects.
+	DefaultNetworkName = "default"
+)
+
+// GetProjectName builds the resource name of a project from its ID.
+func GetProjectName(id string) string {
+	return fmt.Sprintf(projectNameFormat, id)
+}
+
+// GetBillingAccountName builds the resource name of a billing account from
+// its ID.
+func GetBillingAccountName(id string) string {
+	return fmt.Sprintf(billingAccountNameFormat, id)
+}
+
+// GenerateProject produces a Project with the supplied ID that is
+// configured via the given ProjectParameters.
+func GenerateProject(id string, p v1alpha1.ProjectParameters) *cloudresourcemanager.Project {
+	return &cloudresourcemanager.Project{
+		ProjectId:   id,
+		Parent:      p.Parent,
+		DisplayName: gcp.StringValue(p.DisplayName),
+		Labels:      p.Labels,
+	}
+}
+
+// GenerateProjectObservation produces a ProjectObservation from the supplied
+// Project.
+func GenerateProjectObservation(p cloudresourcemanager.Project) v1alpha1.ProjectObservation {
+	return v1alpha1.ProjectObservation{
+		Name:       p.Name,
+		State:      p.State,
+		CreateTime: p.CreateTime,
+		UpdateTime: p.UpdateTime,
+		DeleteTime: p.DeleteTime,
+	}
+}
+
+// LateInitializeProject fills the empty fields of ProjectParameters with the
+// values seen in the supplied Project.
+func LateInitializeProject(p *v1alpha1.ProjectParameters, obs cloudresourcemanager.Project) {
+	p.DisplayName = gcp.LateInitializeString(p.DisplayName, obs.DisplayName)
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, obs.Labels)
+}
+
+// IsProjectUpToDate returns true if the supplied Project matches the fields
+// of the supplied ProjectParameters that can be updated with a patch call.
+func IsProjectUpToDate(p v1alpha1.ProjectParameters, obs cloudresourcemanager.Project) bool {
+	return gcp.StringValue(p.DisplayName) == obs.DisplayName &&
+		cmp.Equal(p.Labels, obs.Labels, cmpopts.EquateEmpty())
+}
+
+// GenerateBillingInfo produces the ProjectBillingInfo that links a project
+// to the billing account of the supplied ProjectParameters.
+func GenerateBillingInfo(p v1alpha1.ProjectParameters) *cloudbilling.ProjectBillingInfo {
+	return &cloudbilling.ProjectBillingInfo{
+		BillingAccountName: GetBillingAccountName(gcp.StringValue(p.BillingAccount)),
+	}
+}
+
+// IsBillingInfoUpToDate returns true if the supplied ProjectBillingInfo
+// links the project to the billing account of the supplied
+// ProjectParameters. A project whose billing account is not specified is
+// always considered up to date.
+func IsBillingInfoUpToDate(p v1alpha1.ProjectParameters, obs cloudbilling.ProjectBillingInfo) bool {
+	if p.BillingAccount == nil {
+		return true
+	}
+	return obs.BillingAccountName == GetBillingAccountName(*p.BillingAccount)
+}
+
+// DescribeLiens returns a human readable summary of the supplied liens.
+func DescribeLiens(liens []*cloudresourcemanager.Lien) string {
+	reasons := make([]string, len(liens))
+	for i, l := range liens {
+		reasons[i] = fmt.Sprintf("%s (%s)", l.Name, l.Reason)
+	}
+	return strings.Join(reasons, ", ")
+}
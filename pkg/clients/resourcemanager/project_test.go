@@ -0,0 +1,222 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcemanager
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cloudbilling "google.golang.org/api/cloudbilling/v1"
+	cloudresourcemanager "google.golang.org/api/cloudresourcemanager/v3"
+
+	"github.com/crossplane/provider-gcp/apis/resourcemanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID      = "cool-project"
+	billingAccount = "012345-567890-ABCDEF"
+)
+
+func projectParams(m ...func(*v1alpha1.ProjectParameters)) *v1alpha1.ProjectParameters {
+	p := &v1alpha1.ProjectParameters{
+		Parent:         "folders/1234",
+		DisplayName:    gcp.StringPtr("Cool project"),
+		Labels:         map[string]string{"team": "cool"},
+		BillingAccount: gcp.StringPtr(billingAccount),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func project(m ...func(*cloudresourcemanager.Project)) *cloudresourcemanager.Project {
+	p := &cloudresourcemanager.Project{
+		ProjectId:   projectID,
+		Parent:      "folders/1234",
+		DisplayName: "Cool project",
+		Labels:      map[string]string{"team": "cool"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func TestGetNames(t *testing.T) {
+	if diff := cmp.Diff("projects/cool-project", GetProjectName(projectID)); diff != "" {
+		t.Errorf("GetProjectName(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("billingAccounts/012345-567890-ABCDEF", GetBillingAccountName(billingAccount)); diff != "" {
+		t.Errorf("GetBillingAccountName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateProject(t *testing.T) {
+	if diff := cmp.Diff(project(), GenerateProject(projectID, *projectParams())); diff != "" {
+		t.Errorf("GenerateProject(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateProjectObservation(t *testing.T) {
+	p := *project(func(p *cloudresourcemanager.Project) {
+		p.Name = "projects/42"
+		p.State = v1alpha1.ProjectStateDeleteRequested
+		p.CreateTime = "2021-01-01T00:00:00Z"
+		p.DeleteTime = "2021-02-01T00:00:00Z"
+	})
+	want := v1alpha1.ProjectObservation{
+		Name:       "projects/42",
+		State:      v1alpha1.ProjectStateDeleteRequested,
+		CreateTime: "2021-01-01T00:00:00Z",
+		DeleteTime: "2021-02-01T00:00:00Z",
+	}
+	if diff := cmp.Diff(want, GenerateProjectObservation(p)); diff != "" {
+		t.Errorf("GenerateProjectObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeProject(t *testing.T) {
+	cases := map[string]struct {
+		params *v1alpha1.ProjectParameters
+		obs    *cloudresourcemanager.Project
+		want   *v1alpha1.ProjectParameters
+	}{
+		"AllFilled": {
+			params: projectParams(),
+			obs: project(func(p *cloudresourcemanager.Project) {
+				p.DisplayName = "Other project"
+			}),
+			want: projectParams(),
+		},
+		"AllEmpty": {
+			params: projectParams(func(p *v1alpha1.ProjectParameters) {
+				p.DisplayName = nil
+				p.Labels = nil
+			}),
+			obs:  project(),
+			want: projectParams(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeProject(tc.params, *tc.obs)
+			if diff := cmp.Diff(tc.want, tc.params); diff != "" {
+				t.Errorf("LateInitializeProject(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsProjectUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		params *v1alpha1.ProjectParameters
+		obs    *cloudresourcemanager.Project
+		want   bool
+	}{
+		"UpToDate": {
+			params: projectParams(),
+			obs:    project(),
+			want:   true,
+		},
+		"ParentIsIgnored": {
+			params: projectParams(func(p *v1alpha1.ProjectParameters) {
+				p.Parent = "organizations/42"
+			}),
+			obs:  project(),
+			want: true,
+		},
+		"DisplayNameDiffers": {
+			params: projectParams(),
+			obs: project(func(p *cloudresourcemanager.Project) {
+				p.DisplayName = "Other project"
+			}),
+			want: false,
+		},
+		"LabelsDiffer": {
+			params: projectParams(),
+			obs: project(func(p *cloudresourcemanager.Project) {
+				p.Labels = nil
+			}),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsProjectUpToDate(*tc.params, *tc.obs)); diff != "" {
+				t.Errorf("IsProjectUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateBillingInfo(t *testing.T) {
+	want := &cloudbilling.ProjectBillingInfo{BillingAccountName: "billingAccounts/012345-567890-ABCDEF"}
+	if diff := cmp.Diff(want, GenerateBillingInfo(*projectParams())); diff != "" {
+		t.Errorf("GenerateBillingInfo(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsBillingInfoUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		params *v1alpha1.ProjectParameters
+		obs    cloudbilling.ProjectBillingInfo
+		want   bool
+	}{
+		"UpToDate": {
+			params: projectParams(),
+			obs:    cloudbilling.ProjectBillingInfo{BillingAccountName: "billingAccounts/012345-567890-ABCDEF"},
+			want:   true,
+		},
+		"NotLinked": {
+			params: projectParams(),
+			obs:    cloudbilling.ProjectBillingInfo{},
+			want:   false,
+		},
+		"LinkedToOtherAccount": {
+			params: projectParams(),
+			obs:    cloudbilling.ProjectBillingInfo{BillingAccountName: "billingAccounts/000000-000000-000000"},
+			want:   false,
+		},
+		"BillingAccountNotSpecified": {
+			params: projectParams(func(p *v1alpha1.ProjectParameters) {
+				p.BillingAccount = nil
+			}),
+			obs:  cloudbilling.ProjectBillingInfo{BillingAccountName: "billingAccounts/000000-000000-000000"},
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsBillingInfoUpToDate(*tc.params, tc.obs)); diff != "" {
+				t.Errorf("IsBillingInfoUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDescribeLiens(t *testing.T) {
+	liens := []*cloudresourcemanager.Lien{
+		{Name: "liens/1", Reason: "Holds a shared VPC"},
+		{Name: "liens/2", Reason: "Do not delete"},
+	}
+	want := "liens/1 (Holds a shared VPC), liens/2 (Do not delete)"
+	if diff := cmp.Diff(want, DescribeLiens(liens)); diff != "" {
+		t.Errorf("DescribeLiens(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcemanager
+
+import (
+	"fmt"
+	"strings"
+
+	cloudresourcemanager "google.golang.org/api/cloudresourcemanager/v3"
+
+	"github.com/crossplane/provider-gcp/apis/resourcemanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	tagKeyNamePrefix   = "tagKeys/"
+	tagValueNamePrefix = "tagValues/"
+
+	// TagKeyUpdateMask is the list of tag key fields that can be updated
+	// with a patch call.
+	TagKeyUpdateMask = "description"
+
+	// TagValueUpdateMask is the list of tag value fields that can be updated
+	// with a patch call.
+	TagValueUpdateMask = "description"
+)
+
+// GetTagKeyName builds the resource name of a tag key from its ID.
+func GetTagKeyName(id string) string {
+	return fmt.Sprintf("%s%s", tagKeyNamePrefix, id)
+}
+
+// GetTagKeyID extracts the ID of a tag key from its resource name.
+func GetTagKeyID(name string) string {
+	return strings.TrimPrefix(name, tagKeyNamePrefix)
+}
+
+// GenerateTagKey produces a TagKey that is configured via the given
+// TagKeyParameters.
+func GenerateTagKey(p v1alpha1.TagKeyParameters) *cloudresourcemanager.TagKey {
+	return &cloudresourcemanager.TagKey{
+		Parent:      p.Parent,
+		ShortName:   p.ShortName,
+		Description: gcp.StringValue(p.Description),
+		Purpose:     gcp.StringValue(p.Purpose),
+		PurposeData: p.PurposeData,
+	}
+}
+
+// GenerateTagKeyObservation produces a TagKeyObservation from the supplied
+// TagKey.
+func GenerateTagKeyObservation(k cloudresourcemanager.TagKey) v1alpha1.TagKeyObservation {
+	return v1alpha1.TagKeyObservation{
+		Name:           k.Name,
+		NamespacedName: k.NamespacedName,
+		Etag:           k.Etag,
+		CreateTime:     k.CreateTime,
+		UpdateTime:     k.UpdateTime,
+	}
+}
+
+// LateInitializeTagKey fills the empty fields of the supplied
+// TagKeyParameters with the values of the supplied TagKey.
+func LateInitializeTagKey(p *v1alpha1.TagKeyParameters, k cloudresourcemanager.TagKey) {
+	p.Description = gcp.LateInitializeString(p.Description, k.Description)
+}
+
+// IsTagKeyUpToDate returns true if the supplied TagKey matches the fields of
+// the supplied TagKeyParameters that can be updated with a patch call.
+func IsTagKeyUpToDate(p v1alpha1.TagKeyParameters, k cloudresourcemanager.TagKey) bool {
+	return gcp.StringValue(p.Description) == k.Description
+}
+
+// FindTagKey returns the tag key with the short name of the supplied
+// TagKeyParameters, or nil if there is none. Short names are unique among the
+// tag keys that share a parent.
+func FindTagKey(p v1alpha1.TagKeyParameters, keys []*cloudresourcemanager.TagKey) *cloudresourcemanager.TagKey {
+	for _, k := range keys {
+		if k.ShortName == p.ShortName {
+			return k
+		}
+	}
+	return nil
+}
+
+// GetTagValueName builds the resource name of a tag value from its ID.
+func GetTagValueName(id string) string {
+	return fmt.Sprintf("%s%s", tagValueNamePrefix, id)
+}
+
+// GetTagValueID extracts the ID of a tag value from its resource name.
+func GetTagValueID(name string) string {
+	return strings.TrimPrefix(name, tagValueNamePrefix)
+}
+
+// GenerateTagValue produces a TagValue that is configured via the given
+// TagValueParameters.
+func GenerateTagValue(p v1alpha1.TagValueParameters) *cloudresourcemanager.TagValue {
+	return &cloudresourcemanager.TagValue{
+		Parent:      gcp.StringValue(p.Parent),
+		ShortName:   p.ShortName,
+		Description: gcp.StringValue(p.Description),
+	}
+}
+
+// GenerateTagValueObservation produces a TagValueObservation from the
+// supplied TagValue.
+func GenerateTagValueObservation(v cloudresourcemanager.TagValue) v1alpha1.TagValueObservation {
+	return v1alpha1.TagValueObservation{
+		Name:           v.Name,
+		NamespacedName: v.NamespacedName,
+		Etag:           v.Etag,
+		CreateTime:     v.CreateTime,
+		UpdateTime:     v.UpdateTime,
+	}
+}
+
+// LateInitializeTagValue fills the empty fields of the supplied
+// TagValueParameters with the values of the supplied TagValue.
+func LateInitializeTagValue(p *v1alpha1.TagValueParameters, v cloudresourcemanager.TagValue) {
+	p.Description = gcp.LateInitializeString(p.Description, v.Description)
+}
+
+// IsTagValueUpToDate returns true if the supplied TagValue matches the
+// fields of the supplied TagValueParameters that can be updated with a patch
+// call.
+func IsTagValueUpToDate(p v1alpha1.TagValueParameters, v cloudresourcemanager.TagValue) bool {
+	return gcp.StringValue(p.Description) == v.Description
+}
+
+// FindTagValue returns the tag value with the short name of the supplied
+// TagValueParameters, or nil if there is none. Short names are unique among
+// the values of a tag key.
+func FindTagValue(p v1alpha1.TagValueParameters, values []*cloudresourcemanager.TagValue) *cloudresourcemanager.TagValue {
+	for _, v := range values {
+		if v.ShortName == p.ShortName {
+			return v
+		}
+	}
+	return nil
+}
+
+// GetTagBindingEndpoint returns the Resource Manager endpoint that manages
+// the tag bindings of resources in the supplied location, or an empty string
+// for global resources.
+func GetTagBindingEndpoint(location string) string {
+	if location == "" {
+		return ""
+	}
+	return fmt.Sprintf("https://%s-cloudresourcemanager.googleapis.com/", location)
+}
+
+// GenerateTagBinding produces a TagBinding that is configured via the given
+// TagBindingParameters.
+func GenerateTagBinding(p v1alpha1.TagBindingParameters) *cloudresourcemanager.TagBinding {
+	return &cloudresourcemanager.TagBinding{
+		Parent:   gcp.StringValue(p.Parent),
+		TagValue: gcp.StringValue(p.TagValue),
+	}
+}
+
+// GenerateTagBindingObservation produces a TagBindingObservation from the
+// supplied TagBinding.
+func GenerateTagBindingObservation(b cloudresourcemanager.TagBinding) v1alpha1.TagBindingObservation {
+	return v1alpha1.TagBindingObservation{
+		Name:                   b.Name,
+		TagValueNamespacedName: b.TagValueNamespacedName,
+	}
+}
+
+// FindTagBinding returns the binding of the tag value of the supplied
+// TagBindingParameters, or nil if there is none.
+func FindTagBinding(p v1alpha1.TagBindingParameters, bindings []*cloudresourcemanager.TagBinding) *cloudresourcemanager.TagBinding {
+	for _, b := range bindings {
+		if b.TagValue == gcp.StringValue(p.TagValue) {
+			return b
+		}
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcemanager
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cloudresourcemanager "google.golang.org/api/cloudresourcemanager/v3"
+
+	"github.com/crossplane/provider-gcp/apis/resourcemanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+func tagKeyParams(m ...func(*v1alpha1.TagKeyParameters)) *v1alpha1.TagKeyParameters {
+	p := &v1alpha1.TagKeyParameters{
+		Parent:      "organizations/42",
+		ShortName:   "environment",
+		Description: gcp.StringPtr("Environment of the resource"),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func tagKey(m ...func(*cloudresourcemanager.TagKey)) *cloudresourcemanager.TagKey {
+	k := &cloudresourcemanager.TagKey{
+		Name:           "tagKeys/123",
+		NamespacedName: "42/environment",
+		Parent:         "organizations/42",
+		ShortName:      "environment",
+		Description:    "Environment of the resource",
+		Etag:           "W/\"abc\"",
+		CreateTime:     "2021-05-11T08:00:00Z",
+		UpdateTime:     "2021-05-11T08:00:00Z",
+	}
+	for _, f := range m {
+		f(k)
+	}
+	return k
+}
+
+func tagValueParams(m ...func(*v1alpha1.TagValueParameters)) *v1alpha1.TagValueParameters {
+	p := &v1alpha1.TagValueParameters{
+		Parent:    gcp.StringPtr("tagKeys/123"),
+		ShortName: "production",
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func tagValue(m ...func(*cloudresourcemanager.TagValue)) *cloudresourcemanager.TagValue {
+	v := &cloudresourcemanager.TagValue{
+		Name:           "tagValues/456",
+		NamespacedName: "42/environment/production",
+		Parent:         "tagKeys/123",
+		ShortName:      "production",
+	}
+	for _, f := range m {
+		f(v)
+	}
+	return v
+}
+
+func TestTagKey(t *testing.T) {
+	if diff := cmp.Diff("tagKeys/123", GetTagKeyName("123")); diff != "" {
+		t.Errorf("GetTagKeyName(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("123", GetTagKeyID("tagKeys/123")); diff != "" {
+		t.Errorf("GetTagKeyID(...): -want, +got:\n%s", diff)
+	}
+	want := &cloudresourcemanager.TagKey{
+		Parent:      "organizations/42",
+		ShortName:   "environment",
+		Description: "Environment of the resource",
+	}
+	if diff := cmp.Diff(want, GenerateTagKey(*tagKeyParams())); diff != "" {
+		t.Errorf("GenerateTagKey(...): -want, +got:\n%s", diff)
+	}
+	o := v1alpha1.TagKeyObservation{
+		Name:           "tagKeys/123",
+		NamespacedName: "42/environment",
+		Etag:           "W/\"abc\"",
+		CreateTime:     "2021-05-11T08:00:00Z",
+		UpdateTime:     "2021-05-11T08:00:00Z",
+	}
+	if diff := cmp.Diff(o, GenerateTagKeyObservation(*tagKey())); diff != "" {
+		t.Errorf("GenerateTagKeyObservation(...): -want, +got:\n%s", diff)
+	}
+	other := tagKey(func(k *cloudresourcemanager.TagKey) { k.ShortName = "team" })
+	if diff := cmp.Diff(tagKey(), FindTagKey(*tagKeyParams(), []*cloudresourcemanager.TagKey{other, tagKey()})); diff != "" {
+		t.Errorf("FindTagKey(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeTagKey(t *testing.T) {
+	got := tagKeyParams(func(p *v1alpha1.TagKeyParameters) { p.Description = nil })
+	LateInitializeTagKey(got, *tagKey())
+	if diff := cmp.Diff(tagKeyParams(), got); diff != "" {
+		t.Errorf("LateInitializeTagKey(...): -want, +got:\n%s", diff)
+	}
+	if !IsTagKeyUpToDate(*got, *tagKey()) {
+		t.Errorf("IsTagKeyUpToDate(...): want true, got false")
+	}
+	changed := tagKeyParams(func(p *v1alpha1.TagKeyParameters) { p.Description = gcp.StringPtr("Stage") })
+	if IsTagKeyUpToDate(*changed, *tagKey()) {
+		t.Errorf("IsTagKeyUpToDate(...): want false, got true")
+	}
+}
+
+func TestTagValue(t *testing.T) {
+	if diff := cmp.Diff("tagValues/456", GetTagValueName("456")); diff != "" {
+		t.Errorf("GetTagValueName(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("456", GetTagValueID("tagValues/456")); diff != "" {
+		t.Errorf("GetTagValueID(...): -want, +got:\n%s", diff)
+	}
+	want := &cloudresourcemanager.TagValue{Parent: "tagKeys/123", ShortName: "production"}
+	if diff := cmp.Diff(want, GenerateTagValue(*tagValueParams())); diff != "" {
+		t.Errorf("GenerateTagValue(...): -want, +got:\n%s", diff)
+	}
+	o := v1alpha1.TagValueObservation{Name: "tagValues/456", NamespacedName: "42/environment/production"}
+	if diff := cmp.Diff(o, GenerateTagValueObservation(*tagValue())); diff != "" {
+		t.Errorf("GenerateTagValueObservation(...): -want, +got:\n%s", diff)
+	}
+	if got := FindTagValue(*tagValueParams(), []*cloudresourcemanager.TagValue{tagValue(func(v *cloudresourcemanager.TagValue) { v.ShortName = "staging" })}); got != nil {
+		t.Errorf("FindTagValue(...): want nil, got %v", got)
+	}
+	if !IsTagValueUpToDate(*tagValueParams(), *tagValue()) {
+		t.Errorf("IsTagValueUpToDate(...): want true, got false")
+	}
+}
+
+func TestTagBinding(t *testing.T) {
+	p := v1alpha1.TagBindingParameters{
+		Parent:   gcp.StringPtr("//cloudresourcemanager.googleapis.com/projects/1234"),
+		TagValue: gcp.StringPtr("tagValues/456"),
+	}
+	want := &cloudresourcemanager.TagBinding{
+		Parent:   "//cloudresourcemanager.googleapis.com/projects/1234",
+		TagValue: "tagValues/456",
+	}
+	if diff := cmp.Diff(want, GenerateTagBinding(p)); diff != "" {
+		t.Errorf("GenerateTagBinding(...): -want, +got:\n%s", diff)
+	}
+	b := &cloudresourcemanager.TagBinding{
+		Name:                   "tagBindings/%2F%2Fcloudresourcemanager.googleapis.com%2Fprojects%2F1234/tagValues/456",
+		Parent:                 "//cloudresourcemanager.googleapis.com/projects/1234",
+		TagValue:               "tagValues/456",
+		TagValueNamespacedName: "42/environment/production",
+	}
+	other := &cloudresourcemanager.TagBinding{TagValue: "tagValues/789"}
+	if diff := cmp.Diff(b, FindTagBinding(p, []*cloudresourcemanager.TagBinding{other, b})); diff != "" {
+		t.Errorf("FindTagBinding(...): -want, +got:\n%s", diff)
+	}
+	o := v1alpha1.TagBindingObservation{Name: b.Name, TagValueNamespacedName: "42/environment/production"}
+	if diff := cmp.Diff(o, GenerateTagBindingObservation(*b)); diff != "" {
+		t.Errorf("GenerateTagBindingObservation(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("https://europe-west1-b-cloudresourcemanager.googleapis.com/", GetTagBindingEndpoint("europe-west1-b")); diff != "" {
+		t.Errorf("GetTagBindingEndpoint(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("", GetTagBindingEndpoint("")); diff != "" {
+		t.Errorf("GetTagBindingEndpoint(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Retry defaults. A request is attempted at most six times, waiting 0.5s, 1s,
+// 2s, 4s and 8s (plus jitter) in between, unless the API asks us to wait for
+// a different time.
+const (
+	defaultMaxRetries    = 5
+	defaultInitialDelay  = 500 * time.Millisecond
+	defaultMaxDelay      = 30 * time.Second
+	defaultMaxRetryAfter = time.Minute
+)
+
+// newRetryTransport returns a transport that retries the requests of the
+// supplied transport using the default retry settings.
+func newRetryTransport(base http.RoundTripper) *retryTransport {
+	return &retryTransport{
+		base:          base,
+		maxRetries:    defaultMaxRetries,
+		initialDelay:  defaultInitialDelay,
+		maxDelay:      defaultMaxDelay,
+		maxRetryAfter: defaultMaxRetryAfter,
+		sleep:         sleep,
+	}
+}
+
+// retryTransport retries requests that failed because of quota exhaustion
+// (429) or a server error (5xx) with jittered exponential backoff. Only
+// requests that are safe to send more than once are retried, so that a failed
+// insert that was in fact applied doesn't create a duplicate resource. A delay
+// requested by the API in a Retry-After header takes precedence, but responses
+// that ask for a longer delay than maxRetryAfter are returned as is so that
+// the reconcile isn't blocked for too long.
+type retryTransport struct {
+	base          http.RoundTripper
+	maxRetries    int
+	initialDelay  time.Duration
+	maxDelay      time.Duration
+	maxRetryAfter time.Duration
+	sleep         func(ctx context.Context, d time.Duration) error
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		rsp, err := t.base.RoundTrip(req)
+		if err != nil || !isRetryable(rsp.StatusCode) || !isIdempotent(req) || attempt == t.maxRetries {
+			return rsp, err
+		}
+		// Requests whose body can't be replayed can't be retried.
+		if req.Body != nil && req.GetBody == nil {
+			return rsp, nil
+		}
+		d, ok := retryAfter(rsp.Header.Get("Retry-After"), time.Now())
+		if !ok {
+			d = t.backoff(attempt)
+		}
+		if d > t.maxRetryAfter {
+			return rsp, nil
+		}
+		drain(rsp.Body)
+		if err := t.sleep(req.Context(), d); err != nil {
+			return nil, err
+		}
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// backoff returns the delay before the supplied (zero-based) retry attempt: a
+// random duration between half of and the full exponentially growing delay.
+func (t *retryTransport) backoff(attempt int) time.Duration {
+	d := t.initialDelay << uint(attempt)
+	if d > t.maxDelay || d <= 0 {
+		d = t.maxDelay
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1)) // nolint:gosec // Jitter doesn't need a secure random number.
+}
+
+func isRetryable(code int) bool {
+	switch code {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// isIdempotent returns true if the supplied request can be sent more than once
+// without changing its effect: requests with an idempotent method, and requests
+// that carry a request ID, which GCP APIs use to ignore duplicate requests.
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet,
+		http.MethodHead,
+		http.MethodOptions,
+		http.MethodPut,
+		http.MethodDelete:
+		return true
+	}
+	return req.URL.Query().Get("requestId") != ""
+}
+
+// retryAfter returns the delay requested by the supplied value of a
+// Retry-After header, which is either a number of seconds or an HTTP date.
+func retryAfter(v string, now time.Time) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if s, err := strconv.Atoi(v); err == nil && s >= 0 {
+		return time.Duration(s) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// drain reads and closes the supplied response body so that its connection
+// can be reused.
+func drain(body io.ReadCloser) {
+	_, _ = io.Copy(io.Discard, io.LimitReader(body, 1<<16))
+	_ = body.Close()
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type roundTripperFn func(req *http.Request) (*http.Response, error)
+
+func (fn roundTripperFn) RoundTrip(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
+
+func TestRetryTransport(t *testing.T) {
+	type want struct {
+		codes  []int
+		delays []time.Duration
+		bodies []string
+	}
+
+	cases := map[string]struct {
+		reason     string
+		method     string
+		url        string
+		responses  []int
+		retryAfter string
+		body       io.Reader
+		want       want
+	}{
+		"Success": {
+			reason:    "Successful requests should not be retried",
+			responses: []int{http.StatusOK},
+			want:      want{codes: []int{http.StatusOK}},
+		},
+		"NotRetryable": {
+			reason:    "Requests that failed with a non-retryable error should not be retried",
+			responses: []int{http.StatusNotFound},
+			want:      want{codes: []int{http.StatusNotFound}},
+		},
+		"RetryAfter": {
+			reason:     "Requests should be retried after the delay requested by the API",
+			responses:  []int{http.StatusTooManyRequests, http.StatusOK},
+			retryAfter: "3",
+			want: want{
+				codes:  []int{http.StatusTooManyRequests, http.StatusOK},
+				delays: []time.Duration{3 * time.Second},
+			},
+		},
+		"RetryAfterTooLong": {
+			reason:     "Responses that ask for a long delay should be returned rather than retried",
+			responses:  []int{http.StatusTooManyRequests, http.StatusOK},
+			retryAfter: "3600",
+			want:       want{codes: []int{http.StatusTooManyRequests}},
+		},
+		"Exhausted": {
+			reason:    "Requests should be retried with growing delays until the retries are exhausted",
+			responses: []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK},
+			want: want{
+				codes:  []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable},
+				delays: []time.Duration{time.Second, 2 * time.Second},
+			},
+		},
+		"NotIdempotent": {
+			reason:    "Requests that are not idempotent should not be retried",
+			method:    http.MethodPost,
+			responses: []int{http.StatusServiceUnavailable, http.StatusOK},
+			want:      want{codes: []int{http.StatusServiceUnavailable}},
+		},
+		"RequestID": {
+			reason:    "Requests that carry a request ID should be retried regardless of their method",
+			method:    http.MethodPost,
+			url:       "https://example.org?requestId=cool",
+			responses: []int{http.StatusServiceUnavailable, http.StatusOK},
+			want: want{
+				codes:  []int{http.StatusServiceUnavailable, http.StatusOK},
+				delays: []time.Duration{time.Second},
+			},
+		},
+		"ReplayBody": {
+			reason:    "The body of retried requests should be replayed",
+			method:    http.MethodPut,
+			responses: []int{http.StatusInternalServerError, http.StatusOK},
+			body:      bytes.NewBufferString("cool"),
+			want: want{
+				codes:  []int{http.StatusInternalServerError, http.StatusOK},
+				delays: []time.Duration{time.Second},
+				bodies: []string{"cool", "cool"},
+			},
+		},
+		"UnreplayableBody": {
+			reason:    "Requests whose body can't be replayed should not be retried",
+			method:    http.MethodPut,
+			responses: []int{http.StatusInternalServerError, http.StatusOK},
+			body:      io.MultiReader(bytes.NewBufferString("cool")),
+			want: want{
+				codes:  []int{http.StatusInternalServerError},
+				bodies: []string{"cool"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			base := roundTripperFn(func(req *http.Request) (*http.Response, error) {
+				if req.Body != nil {
+					b, _ := io.ReadAll(req.Body)
+					got.bodies = append(got.bodies, string(b))
+				}
+				code := tc.responses[len(got.codes)]
+				got.codes = append(got.codes, code)
+				h := http.Header{}
+				if tc.retryAfter != "" {
+					h.Set("Retry-After", tc.retryAfter)
+				}
+				return &http.Response{StatusCode: code, Header: h, Body: io.NopCloser(&bytes.Buffer{})}, nil
+			})
+			rt := &retryTransport{
+				base:          base,
+				maxRetries:    2,
+				initialDelay:  2 * time.Second,
+				maxDelay:      4 * time.Second,
+				maxRetryAfter: time.Minute,
+				sleep: func(_ context.Context, d time.Duration) error {
+					got.delays = append(got.delays, d)
+					return nil
+				},
+			}
+			method, url := http.MethodGet, "https://example.org"
+			if tc.method != "" {
+				method = tc.method
+			}
+			if tc.url != "" {
+				url = tc.url
+			}
+			req, _ := http.NewRequest(method, url, tc.body)
+			rsp, err := rt.RoundTrip(req)
+			if err != nil {
+				t.Fatalf("\n%s\nrt.RoundTrip(...): %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.codes[len(tc.want.codes)-1], rsp.StatusCode); diff != "" {
+				t.Errorf("\n%s\nrt.RoundTrip(...): -want status code, +got status code:\n%s", tc.reason, diff)
+			}
+			// Jitter makes backoff delays fall between half and all of the
+			// exponentially growing delay.
+			for i := range got.delays {
+				if i < len(tc.want.delays) && got.delays[i] <= tc.want.delays[i]*2 && got.delays[i] >= tc.want.delays[i] {
+					got.delays[i] = tc.want.delays[i]
+				}
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nrt.RoundTrip(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2021, 9, 1, 12, 0, 0, 0, time.UTC)
+
+	type want struct {
+		d  time.Duration
+		ok bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		value  string
+		want   want
+	}{
+		"Empty": {
+			reason: "A missing header requests no delay",
+			want:   want{},
+		},
+		"Seconds": {
+			reason: "A number of seconds should be parsed",
+			value:  "30",
+			want:   want{d: 30 * time.Second, ok: true},
+		},
+		"Date": {
+			reason: "An HTTP date should be parsed relative to now",
+			value:  now.Add(time.Minute).Format(http.TimeFormat),
+			want:   want{d: time.Minute, ok: true},
+		},
+		"PastDate": {
+			reason: "An HTTP date in the past requests an immediate retry",
+			value:  now.Add(-time.Minute).Format(http.TimeFormat),
+			want:   want{d: 0, ok: true},
+		},
+		"Invalid": {
+			reason: "An invalid value should be ignored",
+			value:  "soon",
+			want:   want{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d, ok := retryAfter(tc.value, now)
+			if diff := cmp.Diff(tc.want, want{d: d, ok: ok}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nretryAfter(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -14,6 +14,8 @@ limitations under the License.
 package router
 
 import (
+	"path"
+
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/mitchellh/copystructure"
@@ -244,5 +246,31 @@ func IsUpToDate(name string, in *v1alpha1.RouterParameters, observed *compute.Ro
 		return true, errors.New(errCheckUpToDate)
 	}
 	GenerateRouter(name, *in, desired)
-	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(), gcp.EquateComputeURLs(), cmpopts.IgnoreFields(compute.Router{}, "ForceSendFields")), nil
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(), gcp.EquateComputeURLs(), equateNatIPs(), cmpopts.IgnoreFields(compute.Router{}, "ForceSendFields")), nil
+}
+
+// Diff returns the paths of the fields of the observed Router that differ from
+// the given set of parameters, e.g. to explain why it is not up-to-date.
+func Diff(name string, in *v1alpha1.RouterParameters, observed *compute.Router) ([]string, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return nil, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*compute.Router)
+	if !ok {
+		return nil, errors.New(errCheckUpToDate)
+	}
+	GenerateRouter(name, *in, desired)
+	return gcp.Diff(desired, observed, cmpopts.EquateEmpty(), gcp.EquateComputeURLs(), equateNatIPs()), nil
+}
+
+// equateNatIPs compares the NAT IPs and drained NAT IPs of NATs regardless of
+// their order, which isn't preserved by the API. NAT IPs are rotated by moving
+// them from the NAT IPs to the drained NAT IPs of a NAT, which is patched in
+// place, and removing them from the drained NAT IPs once they're drained.
+func equateNatIPs() cmp.Option {
+	return cmp.FilterPath(func(p cmp.Path) bool {
+		f, ok := p.Last().(cmp.StructField)
+		return ok && (f.Name() == "NatIps" || f.Name() == "DrainNatIps")
+	}, cmpopts.SortSlices(func(a, b string) bool { return path.Base(a) < path.Base(b) }))
 }
@@ -27,6 +27,8 @@ const (
 	testCreationTimestamp = "10/10/2023"
 	testSelfLink          = "/link/to/self"
 	testRegion            = "us-west1"
+	testNatIP1            = "https://www.googleapis.com/compute/v1/projects/test-project/regions/us-west1/addresses/nat-ip-1"
+	testNatIP2            = "https://www.googleapis.com/compute/v1/projects/test-project/regions/us-west1/addresses/nat-ip-2"
 )
 
 var (
@@ -35,6 +37,7 @@ var (
 	testRoutePriority int64 = 1000
 	testDescription         = "some desc"
 	testAdvertiseMode       = "DEFAULT"
+	testNatName             = "test-nat"
 )
 
 func params(m ...func(*v1alpha1.RouterParameters)) *v1alpha1.RouterParameters {
@@ -261,6 +264,28 @@ func TestIsUpToDate(t *testing.T) {
 			},
 			want: want{upToDate: false, isErr: false},
 		},
+		"UpToDateWithReorderedNatIps": {
+			args: args{
+				in: params(func(p *v1alpha1.RouterParameters) {
+					p.Nats = []*v1alpha1.RouterNat{{Name: &testNatName, NatIps: []string{testNatIP1, testNatIP2}}}
+				}),
+				current: router(func(r *compute.Router) {
+					r.Nats = []*compute.RouterNat{{Name: testNatName, NatIps: []string{testNatIP2, testNatIP1}}}
+				}),
+			},
+			want: want{upToDate: true, isErr: false},
+		},
+		"NotUpToDateWithDrainedNatIps": {
+			args: args{
+				in: params(func(p *v1alpha1.RouterParameters) {
+					p.Nats = []*v1alpha1.RouterNat{{Name: &testNatName, NatIps: []string{testNatIP2}, DrainNatIps: []string{testNatIP1}}}
+				}),
+				current: router(func(r *compute.Router) {
+					r.Nats = []*compute.RouterNat{{Name: testNatName, NatIps: []string{testNatIP1, testNatIP2}}}
+				}),
+			},
+			want: want{upToDate: false, isErr: false},
+		},
 	}
 
 	for name, tc := range cases {
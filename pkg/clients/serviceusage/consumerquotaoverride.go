@@ -0,0 +1,119 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceusage
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	serviceusage "google.golang.org/api/serviceusage/v1beta1"
+
+	"github.com/crossplane/provider-gcp/apis/serviceusage/v1alpha1"
+)
+
+const (
+	quotaLimitNameFormat    = "projects/%s/services/%s/consumerQuotaMetrics/%s/limits/%s"
+	quotaOverrideNameFormat = "%s/consumerOverrides/%s"
+	quotaOverrideNameSep    = "/consumerOverrides/"
+
+	// ConsumerQuotaOverrideUpdateMask is the list of consumer quota override
+	// fields that can be updated with a patch call.
+	ConsumerQuotaOverrideUpdateMask = "overrideValue"
+)
+
+// GetQuotaLimitName builds the fully qualified name of the quota limit of
+// the supplied ConsumerQuotaOverrideParameters, falling back to the supplied
+// default project. The metric and limit are URL encoded, since they contain
+// slashes.
+func GetQuotaLimitName(defaultProject string, p v1alpha1.ConsumerQuotaOverrideParameters) string {
+	project := defaultProject
+	if p.Project != nil {
+		project = *p.Project
+	}
+	return fmt.Sprintf(quotaLimitNameFormat, project, p.Service, url.PathEscape(p.Metric), url.PathEscape(p.Limit))
+}
+
+// GetConsumerQuotaOverrideName builds the fully qualified name of an
+// override of the supplied quota limit from its ID.
+func GetConsumerQuotaOverrideName(limit, id string) string {
+	return fmt.Sprintf(quotaOverrideNameFormat, limit, id)
+}
+
+// GetConsumerQuotaOverrideID extracts the ID of an override from its fully
+// qualified name.
+func GetConsumerQuotaOverrideID(name string) string {
+	if i := strings.LastIndex(name, quotaOverrideNameSep); i >= 0 {
+		return name[i+len(quotaOverrideNameSep):]
+	}
+	return name
+}
+
+// GenerateQuotaOverride produces a QuotaOverride that is configured via the
+// given ConsumerQuotaOverrideParameters.
+func GenerateQuotaOverride(p v1alpha1.ConsumerQuotaOverrideParameters) *serviceusage.QuotaOverride {
+	return &serviceusage.QuotaOverride{
+		Dimensions:    p.Dimensions,
+		OverrideValue: p.OverrideValue,
+		// An override value of 0 blocks the usage of the quota altogether.
+		ForceSendFields: []string{"OverrideValue"},
+	}
+}
+
+// GenerateConsumerQuotaOverrideObservation produces a
+// ConsumerQuotaOverrideObservation from the supplied QuotaOverride.
+func GenerateConsumerQuotaOverrideObservation(o serviceusage.QuotaOverride) v1alpha1.ConsumerQuotaOverrideObservation {
+	return v1alpha1.ConsumerQuotaOverrideObservation{
+		Name: o.Name,
+		Unit: o.Unit,
+	}
+}
+
+// IsConsumerQuotaOverrideUpToDate returns true if the supplied QuotaOverride
+// matches the fields of the supplied ConsumerQuotaOverrideParameters that can
+// be updated with a patch call.
+func IsConsumerQuotaOverrideUpToDate(p v1alpha1.ConsumerQuotaOverrideParameters, o serviceusage.QuotaOverride) bool {
+	return p.OverrideValue == o.OverrideValue
+}
+
+// FindConsumerQuotaOverride returns the override with the supplied ID, or
+// nil if there is none. Without an ID the override for the dimensions of the
+// supplied ConsumerQuotaOverrideParameters is returned, since a quota limit
+// has at most one override per set of dimensions.
+func FindConsumerQuotaOverride(id string, p v1alpha1.ConsumerQuotaOverrideParameters, overrides []*serviceusage.QuotaOverride) *serviceusage.QuotaOverride {
+	for _, o := range overrides {
+		if id != "" && GetConsumerQuotaOverrideID(o.Name) == id {
+			return o
+		}
+		if id == "" && equalDimensions(p.Dimensions, o.Dimensions) {
+			return o
+		}
+	}
+	return nil
+}
+
+func equalDimensions(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if w, ok := b[k]; !ok || w != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceusage
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	serviceusage "google.golang.org/api/serviceusage/v1beta1"
+
+	"github.com/crossplane/provider-gcp/apis/serviceusage/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	quotaLimit    = "projects/cool-project/services/compute.googleapis.com/consumerQuotaMetrics/compute.googleapis.com%2Fcpus/limits/%2Fproject%2Fregion"
+	quotaOverride = "projects/1234/services/compute.googleapis.com/consumerQuotaMetrics/compute.googleapis.com%2Fcpus/limits/%2Fproject%2Fregion/consumerOverrides/Cg1"
+)
+
+func overrideParams(m ...func(*v1alpha1.ConsumerQuotaOverrideParameters)) *v1alpha1.ConsumerQuotaOverrideParameters {
+	p := &v1alpha1.ConsumerQuotaOverrideParameters{
+		Service:       "compute.googleapis.com",
+		Metric:        "compute.googleapis.com/cpus",
+		Limit:         "/project/region",
+		OverrideValue: 48,
+		Dimensions:    map[string]string{"region": "us-central1"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func TestGetQuotaLimitName(t *testing.T) {
+	if diff := cmp.Diff(quotaLimit, GetQuotaLimitName(project, *overrideParams())); diff != "" {
+		t.Errorf("GetQuotaLimitName(...): -want, +got:\n%s", diff)
+	}
+	p := overrideParams(func(p *v1alpha1.ConsumerQuotaOverrideParameters) {
+		p.Project = gcp.StringPtr("other-project")
+	})
+	want := "projects/other-project/services/compute.googleapis.com/consumerQuotaMetrics/compute.googleapis.com%2Fcpus/limits/%2Fproject%2Fregion"
+	if diff := cmp.Diff(want, GetQuotaLimitName(project, *p)); diff != "" {
+		t.Errorf("GetQuotaLimitName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestConsumerQuotaOverrideName(t *testing.T) {
+	if diff := cmp.Diff("Cg1", GetConsumerQuotaOverrideID(quotaOverride)); diff != "" {
+		t.Errorf("GetConsumerQuotaOverrideID(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(quotaLimit+"/consumerOverrides/Cg1", GetConsumerQuotaOverrideName(quotaLimit, "Cg1")); diff != "" {
+		t.Errorf("GetConsumerQuotaOverrideName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateQuotaOverride(t *testing.T) {
+	want := &serviceusage.QuotaOverride{
+		Dimensions:      map[string]string{"region": "us-central1"},
+		OverrideValue:   48,
+		ForceSendFields: []string{"OverrideValue"},
+	}
+	if diff := cmp.Diff(want, GenerateQuotaOverride(*overrideParams())); diff != "" {
+		t.Errorf("GenerateQuotaOverride(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestFindConsumerQuotaOverride(t *testing.T) {
+	global := &serviceusage.QuotaOverride{Name: quotaLimit + "/consumerOverrides/Cg0", OverrideValue: 24}
+	regional := &serviceusage.QuotaOverride{Name: quotaOverride, OverrideValue: 48, Dimensions: map[string]string{"region": "us-central1"}}
+	overrides := []*serviceusage.QuotaOverride{global, regional}
+
+	cases := map[string]struct {
+		id   string
+		p    v1alpha1.ConsumerQuotaOverrideParameters
+		want *serviceusage.QuotaOverride
+	}{
+		"ByID": {
+			id:   "Cg0",
+			p:    *overrideParams(),
+			want: global,
+		},
+		"ByDimensions": {
+			p:    *overrideParams(),
+			want: regional,
+		},
+		"WithoutDimensions": {
+			p:    *overrideParams(func(p *v1alpha1.ConsumerQuotaOverrideParameters) { p.Dimensions = nil }),
+			want: global,
+		},
+		"NotFound": {
+			p: *overrideParams(func(p *v1alpha1.ConsumerQuotaOverrideParameters) {
+				p.Dimensions = map[string]string{"region": "europe-west1"}
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := FindConsumerQuotaOverride(tc.id, tc.p, overrides)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("FindConsumerQuotaOverride(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsConsumerQuotaOverrideUpToDate(t *testing.T) {
+	o := serviceusage.QuotaOverride{Name: quotaOverride, OverrideValue: 48, Unit: "1/{project}/{region}"}
+	if !IsConsumerQuotaOverrideUpToDate(*overrideParams(), o) {
+		t.Errorf("IsConsumerQuotaOverrideUpToDate(...): want true, got false")
+	}
+	o.OverrideValue = 24
+	if IsConsumerQuotaOverrideUpToDate(*overrideParams(), o) {
+		t.Errorf("IsConsumerQuotaOverrideUpToDate(...): want false, got true")
+	}
+	want := v1alpha1.ConsumerQuotaOverrideObservation{Name: quotaOverride, Unit: "1/{project}/{region}"}
+	if diff := cmp.Diff(want, GenerateConsumerQuotaOverrideObservation(o)); diff != "" {
+		t.Errorf("GenerateConsumerQuotaOverrideObservation(...): -want, +got:\n%s", diff)
+	}
+}
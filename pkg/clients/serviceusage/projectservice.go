@@ -0,0 +1,128 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceusage
+
+import (
+	"fmt"
+	"strings"
+
+	serviceusage "google.golang.org/api/serviceusage/v1"
+
+	"github.com/crossplane/provider-gcp/apis/serviceusage/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat      = "projects/%s"
+	serviceNameFormat = "projects/%s/services/%s"
+	serviceNameSep    = "/services/"
+)
+
+// GetProject returns the project of the supplied ProjectServiceParameters,
+// falling back to the supplied default project.
+func GetProject(defaultProject string, p v1alpha1.ProjectServiceParameters) string {
+	if p.Project != nil {
+		return *p.Project
+	}
+	return defaultProject
+}
+
+// GetParent builds the fully qualified name of the parent of the services of
+// a project.
+func GetParent(project string) string {
+	return fmt.Sprintf(parentFormat, project)
+}
+
+// GetServiceName builds the fully qualified name of a service of a project.
+func GetServiceName(project, service string) string {
+	return fmt.Sprintf(serviceNameFormat, project, service)
+}
+
+// GetServiceNames builds the fully qualified names of the services of the
+// supplied ProjectServiceParameters.
+func GetServiceNames(project string, p v1alpha1.ProjectServiceParameters) []string {
+	names := make([]string, len(p.Services))
+	for i, s := range p.Services {
+		names[i] = GetServiceName(project, s)
+	}
+	return names
+}
+
+// GenerateProjectServiceObservation produces a ProjectServiceObservation
+// from the supplied services. Their names are returned with the project
+// number rather than the project ID, so only the service part is kept.
+func GenerateProjectServiceObservation(services []*serviceusage.GoogleApiServiceusageV1Service) v1alpha1.ProjectServiceObservation {
+	o := v1alpha1.ProjectServiceObservation{}
+	for _, s := range services {
+		name := s.Name
+		if i := strings.LastIndex(name, serviceNameSep); i >= 0 {
+			name = name[i+len(serviceNameSep):]
+		}
+		o.Services = append(o.Services, v1alpha1.ServiceObservation{Name: name, State: s.State})
+	}
+	return o
+}
+
+func enabledServices(o v1alpha1.ProjectServiceObservation) map[string]bool {
+	enabled := make(map[string]bool, len(o.Services))
+	for _, s := range o.Services {
+		enabled[s.Name] = s.State == v1alpha1.ServiceStateEnabled
+	}
+	return enabled
+}
+
+// GetServicesToEnable returns the services of the supplied
+// ProjectServiceParameters that are not enabled according to the supplied
+// ProjectServiceObservation.
+func GetServicesToEnable(p v1alpha1.ProjectServiceParameters, o v1alpha1.ProjectServiceObservation) []string {
+	enabled := enabledServices(o)
+	var services []string
+	for _, s := range p.Services {
+		if !enabled[s] {
+			services = append(services, s)
+		}
+	}
+	return services
+}
+
+// GetServicesToDisable returns the services of the supplied
+// ProjectServiceParameters that are enabled according to the supplied
+// ProjectServiceObservation.
+func GetServicesToDisable(p v1alpha1.ProjectServiceParameters, o v1alpha1.ProjectServiceObservation) []string {
+	enabled := enabledServices(o)
+	var services []string
+	for _, s := range p.Services {
+		if enabled[s] {
+			services = append(services, s)
+		}
+	}
+	return services
+}
+
+// GenerateBatchEnableRequest produces a request that enables the supplied
+// services.
+func GenerateBatchEnableRequest(services []string) *serviceusage.BatchEnableServicesRequest {
+	return &serviceusage.BatchEnableServicesRequest{ServiceIds: services}
+}
+
+// GenerateDisableRequest produces a request that disables a service as
+// configured by the supplied ProjectServiceParameters.
+func GenerateDisableRequest(p v1alpha1.ProjectServiceParameters) *serviceusage.DisableServiceRequest {
+	return &serviceusage.DisableServiceRequest{
+		DisableDependentServices: gcp.BoolValue(p.DisableDependentServices),
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceusage
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	serviceusage "google.golang.org/api/serviceusage/v1"
+
+	"github.com/crossplane/provider-gcp/apis/serviceusage/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const project = "cool-project"
+
+func params(m ...func(*v1alpha1.ProjectServiceParameters)) *v1alpha1.ProjectServiceParameters {
+	p := &v1alpha1.ProjectServiceParameters{
+		Services: []string{"compute.googleapis.com", "container.googleapis.com", "sqladmin.googleapis.com"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func observation() v1alpha1.ProjectServiceObservation {
+	return v1alpha1.ProjectServiceObservation{Services: []v1alpha1.ServiceObservation{
+		{Name: "compute.googleapis.com", State: v1alpha1.ServiceStateEnabled},
+		{Name: "container.googleapis.com", State: v1alpha1.ServiceStateDisabled},
+	}}
+}
+
+func TestGetProject(t *testing.T) {
+	if diff := cmp.Diff(project, GetProject(project, *params())); diff != "" {
+		t.Errorf("GetProject(...): -want, +got:\n%s", diff)
+	}
+	p := params(func(p *v1alpha1.ProjectServiceParameters) {
+		p.Project = gcp.StringPtr("other-project")
+	})
+	if diff := cmp.Diff("other-project", GetProject(project, *p)); diff != "" {
+		t.Errorf("GetProject(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetServiceNames(t *testing.T) {
+	if diff := cmp.Diff("projects/cool-project", GetParent(project)); diff != "" {
+		t.Errorf("GetParent(...): -want, +got:\n%s", diff)
+	}
+	want := []string{
+		"projects/cool-project/services/compute.googleapis.com",
+		"projects/cool-project/services/container.googleapis.com",
+		"projects/cool-project/services/sqladmin.googleapis.com",
+	}
+	if diff := cmp.Diff(want, GetServiceNames(project, *params())); diff != "" {
+		t.Errorf("GetServiceNames(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateProjectServiceObservation(t *testing.T) {
+	services := []*serviceusage.GoogleApiServiceusageV1Service{
+		{Name: "projects/42/services/compute.googleapis.com", State: v1alpha1.ServiceStateEnabled},
+		{Name: "projects/42/services/container.googleapis.com", State: v1alpha1.ServiceStateDisabled},
+	}
+	if diff := cmp.Diff(observation(), GenerateProjectServiceObservation(services)); diff != "" {
+		t.Errorf("GenerateProjectServiceObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetServicesToEnable(t *testing.T) {
+	want := []string{"container.googleapis.com", "sqladmin.googleapis.com"}
+	if diff := cmp.Diff(want, GetServicesToEnable(*params(), observation())); diff != "" {
+		t.Errorf("GetServicesToEnable(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetServicesToDisable(t *testing.T) {
+	want := []string{"compute.googleapis.com"}
+	if diff := cmp.Diff(want, GetServicesToDisable(*params(), observation())); diff != "" {
+		t.Errorf("GetServicesToDisable(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateRequests(t *testing.T) {
+	want := &serviceusage.BatchEnableServicesRequest{ServiceIds: []string{"compute.googleapis.com"}}
+	if diff := cmp.Diff(want, GenerateBatchEnableRequest([]string{"compute.googleapis.com"})); diff != "" {
+		t.Errorf("GenerateBatchEnableRequest(...): -want, +got:\n%s", diff)
+	}
+	p := params(func(p *v1alpha1.ProjectServiceParameters) {
+		p.DisableDependentServices = gcp.BoolPtr(true)
+	})
+	if diff := cmp.Diff(&serviceusage.DisableServiceRequest{DisableDependentServices: true}, GenerateDisableRequest(*p)); diff != "" {
+		t.Errorf("GenerateDisableRequest(...): -want, +got:\n%s", diff)
+	}
+}
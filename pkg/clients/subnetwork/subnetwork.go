@@ -132,6 +132,21 @@ func IsUpToDate(name string, in *v1beta1.SubnetworkParameters, observed *compute
 	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(), gcp.EquateComputeURLs(), equateSecondaryRanges()), false, nil
 }
 
+// Diff returns the paths of the fields of the observed Subnetwork that differ from
+// the given set of parameters, e.g. to explain why it is not up-to-date.
+func Diff(name string, in *v1beta1.SubnetworkParameters, observed *compute.Subnetwork) ([]string, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return nil, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*compute.Subnetwork)
+	if !ok {
+		return nil, errors.New(errCheckUpToDate)
+	}
+	GenerateSubnetwork(name, *in, desired)
+	return gcp.Diff(desired, observed, cmpopts.EquateEmpty(), gcp.EquateComputeURLs(), equateSecondaryRanges()), nil
+}
+
 // Two compute.Subnetworks with differently ordered but otherwise identical
 // arrays of secondary ranges should be considered equal.
 func equateSecondaryRanges() cmp.Option {
@@ -0,0 +1,101 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// tracerName is the name of the tracer that creates the spans of reconciles
+// and GCP API requests. Spans are only exported if tracing was set up.
+const tracerName = "github.com/crossplane/provider-gcp"
+
+// Span attributes.
+const (
+	attrKind         = attribute.Key("crossplane.kind")
+	attrName         = attribute.Key("crossplane.name")
+	attrExternalName = attribute.Key("crossplane.external_name")
+	attrService      = attribute.Key("gcp.service")
+)
+
+// SetupTracing exports the spans of reconciles and GCP API requests to the
+// OTLP/HTTP collector at the supplied endpoint, e.g. localhost:4318. It
+// returns a function that flushes any pending spans and stops the export.
+func SetupTracing(endpoint string, insecure bool) func(context.Context) error {
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(newOTLPExporter(endpoint, insecure)),
+		sdktrace.WithResource(sdkresource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String("provider-gcp"))),
+	)
+	otel.SetTracerProvider(tp)
+	return tp.Shutdown
+}
+
+// NewTracingConnecter returns an ExternalConnecter that connects, and whose
+// external clients are called, with the span of the reconcile of the managed
+// resource, so that the requests they send to GCP APIs are traced as part of
+// it.
+func NewTracingConnecter(c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &contextConnecter{connecter: c, with: func(ctx context.Context, mg resource.Managed) context.Context {
+		if i, ok := inflight.get(mg); ok {
+			return trace.ContextWithSpan(ctx, i.span)
+		}
+		return ctx
+	}}
+}
+
+// tracingTransport creates a span for each request it sends to the API of a
+// GCP service. The span is a child of the span of the reconcile that sent the
+// request, if any.
+type tracingTransport struct {
+	service string
+	base    http.RoundTripper
+}
+
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, span := otel.Tracer(tracerName).Start(req.Context(), t.service+" "+req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attrService.String(t.service),
+			semconv.HTTPMethodKey.String(req.Method),
+			semconv.HTTPTargetKey.String(req.URL.Path),
+		))
+	defer span.End()
+
+	rsp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return rsp, err
+	}
+	span.SetAttributes(semconv.HTTPStatusCodeKey.Int(rsp.StatusCode))
+	if rsp.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, http.StatusText(rsp.StatusCode))
+	}
+	return rsp, nil
+}
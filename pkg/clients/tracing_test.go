@@ -0,0 +1,86 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestTracingTransport(t *testing.T) {
+	var got otlpTraces
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/traces" {
+			t.Errorf("collector: want path /v1/traces, got %s", r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("collector: cannot decode request: %s", err)
+		}
+	}))
+	defer collector.Close()
+
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(newOTLPExporter(strings.TrimPrefix(collector.URL, "http://"), true)))
+	defer func() { _ = tp.Shutdown(context.Background()) }()
+
+	// Start a reconcile span and send a request that fails with a 403 from
+	// within it.
+	ctx, reconcile := tp.Tracer(tracerName).Start(context.Background(), "Reconcile Network.compute.gcp.crossplane.io")
+	parent := reconcile.SpanContext().SpanID().String()
+	forbidden := roundTripperFn(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusForbidden, Body: io.NopCloser(&bytes.Buffer{})}, nil
+	})
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://compute.googleapis.com/compute/v1/projects/p/global/networks/n", nil)
+
+	// The transport uses the global tracer provider, which exports nothing
+	// unless tracing is set up.
+	global := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	defer otel.SetTracerProvider(global)
+	if _, err := (&tracingTransport{service: "compute", base: forbidden}).RoundTrip(req); err != nil {
+		t.Fatalf("RoundTrip(...): %s", err)
+	}
+
+	if len(got.ResourceSpans) != 1 || len(got.ResourceSpans[0].ScopeSpans) != 1 || len(got.ResourceSpans[0].ScopeSpans[0].Spans) != 1 {
+		t.Fatalf("collector: want 1 span, got %+v", got)
+	}
+	span := got.ResourceSpans[0].ScopeSpans[0].Spans[0]
+	want := otlpSpan{
+		TraceID:      reconcile.SpanContext().TraceID().String(),
+		SpanID:       span.SpanID,
+		ParentSpanID: parent,
+		Name:         "compute GET",
+		Kind:         3,
+		Status:       otlpStatus{Code: 2, Message: http.StatusText(http.StatusForbidden)},
+	}
+	ignore := func(s otlpSpan) otlpSpan {
+		s.StartTimeUnixNano, s.EndTimeUnixNano, s.Attributes = "", "", nil
+		return s
+	}
+	if diff := cmp.Diff(want, ignore(span)); diff != "" {
+		t.Errorf("collector: -want span, +got span:\n%s", diff)
+	}
+}
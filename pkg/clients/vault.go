@@ -0,0 +1,229 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/v1beta1"
+)
+
+// Error strings.
+const (
+	errNoVaultConfig      = "StoreConfig of type Vault must set vault"
+	errNoVaultToken       = "Vault auth method Token must set token"
+	errUnknownVaultAuth   = "unknown Vault auth method"
+	errExtractVaultToken  = "cannot extract Vault token"
+	errExtractVaultCA     = "cannot extract Vault CA bundle"
+	errParseVaultCA       = "cannot parse Vault CA bundle"
+	errReadVaultSecret    = "cannot read Vault secret"
+	errWriteVaultSecret   = "cannot write Vault secret"
+	errDeleteVaultSecret  = "cannot delete Vault secret"
+	errDecodeVaultSecret  = "cannot decode Vault secret"
+	errEncodeVaultSecret  = "cannot encode Vault secret"
+	errVaultStatusMessage = "Vault responded with"
+)
+
+const vaultTokenHeader = "X-Vault-Token"
+
+func newVaultStore(ctx context.Context, c client.Client, sc *v1beta1.StoreConfig) (secretStore, error) {
+	cfg := sc.Spec.Vault
+	if cfg == nil {
+		return nil, errors.New(errNoVaultConfig)
+	}
+	if cfg.Auth.Method != v1beta1.VaultAuthToken {
+		return nil, errors.Errorf("%s: %s", errUnknownVaultAuth, cfg.Auth.Method)
+	}
+	if cfg.Auth.Token == nil {
+		return nil, errors.New(errNoVaultToken)
+	}
+	token, err := resource.CommonCredentialExtractor(ctx, cfg.Auth.Token.Source, c, cfg.Auth.Token.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errExtractVaultToken)
+	}
+	hc := http.DefaultClient
+	if ca := cfg.CABundle; ca != nil {
+		pem, err := resource.CommonCredentialExtractor(ctx, ca.Source, c, ca.CommonCredentialSelectors)
+		if err != nil {
+			return nil, errors.Wrap(err, errExtractVaultCA)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.New(errParseVaultCA)
+		}
+		hc = &http.Client{Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12},
+		}}
+	}
+	version := v1beta1.VaultKVVersionV2
+	if cfg.Version != nil {
+		version = *cfg.Version
+	}
+	return &vaultStore{
+		client:  hc,
+		server:  strings.TrimSuffix(cfg.Server, "/"),
+		mount:   strings.Trim(cfg.MountPath, "/"),
+		scope:   strings.Trim(sc.Spec.DefaultScope, "/"),
+		version: version,
+		token:   strings.TrimSpace(string(token)),
+	}, nil
+}
+
+// A vaultStore stores connection details in the secrets of a Vault KV
+// secrets engine, under the default scope of its StoreConfig. Each secret
+// stores the connection details of a managed resource as string values. The
+// secret is only written when the details changed, so that version 2 of the
+// engine doesn't keep a new version each time a managed resource is
+// reconciled.
+type vaultStore struct {
+	client  *http.Client
+	server  string
+	mount   string
+	scope   string
+	version v1beta1.VaultKVVersion
+	token   string
+}
+
+// url returns the URL of the supplied API, i.e. "data" or "metadata", of the
+// named secret. Version 1 of the engine has neither.
+func (s *vaultStore) url(api, name string) string {
+	p := path.Join(s.mount, s.scope, name)
+	if s.version == v1beta1.VaultKVVersionV2 {
+		p = path.Join(s.mount, api, s.scope, name)
+	}
+	return s.server + "/v1/" + p
+}
+
+func (s *vaultStore) write(ctx context.Context, name string, cd managed.ConnectionDetails) error {
+	stored, err := s.read(ctx, name)
+	if err != nil {
+		return errors.Wrap(err, errReadVaultSecret)
+	}
+	stored, changed := mergeDetails(stored, cd)
+	if !changed {
+		return nil
+	}
+	data := make(map[string]string, len(stored))
+	for k, v := range stored {
+		data[k] = string(v)
+	}
+	var body interface{} = data
+	if s.version == v1beta1.VaultKVVersionV2 {
+		body = map[string]interface{}{"data": data}
+	}
+	b, err := json.Marshal(body)
+	if err != nil {
+		return errors.Wrap(err, errEncodeVaultSecret)
+	}
+	_, err = s.do(ctx, http.MethodPost, s.url("data", name), b)
+	return errors.Wrap(err, errWriteVaultSecret)
+}
+
+// read returns the connection details stored in the named secret, or nil if
+// the secret doesn't exist.
+func (s *vaultStore) read(ctx context.Context, name string) (map[string][]byte, error) {
+	b, err := s.do(ctx, http.MethodGet, s.url("data", name), nil)
+	if err != nil || b == nil {
+		return nil, err
+	}
+	secret := struct {
+		Data json.RawMessage `json:"data"`
+	}{}
+	if err := json.Unmarshal(b, &secret); err != nil {
+		return nil, errors.Wrap(err, errDecodeVaultSecret)
+	}
+	data := secret.Data
+	if s.version == v1beta1.VaultKVVersionV2 {
+		v2 := struct {
+			Data json.RawMessage `json:"data"`
+		}{}
+		if err := json.Unmarshal(data, &v2); err != nil {
+			return nil, errors.Wrap(err, errDecodeVaultSecret)
+		}
+		data = v2.Data
+	}
+	values := map[string]string{}
+	if len(data) > 0 && string(data) != "null" {
+		if err := json.Unmarshal(data, &values); err != nil {
+			return nil, errors.Wrap(err, errDecodeVaultSecret)
+		}
+	}
+	stored := make(map[string][]byte, len(values))
+	for k, v := range values {
+		stored[k] = []byte(v)
+	}
+	return stored, nil
+}
+
+func (s *vaultStore) delete(ctx context.Context, name string) error {
+	// Deleting the metadata of a version 2 secret deletes all of its versions.
+	_, err := s.do(ctx, http.MethodDelete, s.url("metadata", name), nil)
+	return errors.Wrap(err, errDeleteVaultSecret)
+}
+
+// do sends a request to the Vault API. It returns a nil body and no error if
+// the requested secret doesn't exist.
+func (s *vaultStore) do(ctx context.Context, method, url string, body []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(vaultTokenHeader, s.token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close() //nolint:errcheck
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, nil
+	case resp.StatusCode >= 300:
+		e := struct {
+			Errors []string `json:"errors"`
+		}{}
+		_ = json.Unmarshal(b, &e)
+		if len(e.Errors) > 0 {
+			return nil, errors.Errorf("%s %s: %s", errVaultStatusMessage, resp.Status, strings.Join(e.Errors, "; "))
+		}
+		return nil, errors.Errorf("%s %s", errVaultStatusMessage, resp.Status)
+	case resp.StatusCode == http.StatusNoContent:
+		return []byte{}, nil
+	}
+	return b, nil
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-gcp/apis/v1beta1"
+)
+
+func TestVaultStoreWrite(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		version v1beta1.VaultKVVersion
+		stored  string
+		cd      managed.ConnectionDetails
+		path    string
+		written map[string]interface{}
+	}{
+		"V2Unchanged": {
+			reason:  "The secret should not be written if it stores the connection details",
+			version: v1beta1.VaultKVVersionV2,
+			stored:  `{"data":{"data":{"password":"secret"}}}`,
+			cd:      managed.ConnectionDetails{"password": []byte("secret")},
+			path:    "/v1/secret/data/crossplane/db",
+		},
+		"V2Changed": {
+			reason:  "The merged connection details should be written if they changed",
+			version: v1beta1.VaultKVVersionV2,
+			stored:  `{"data":{"data":{"user":"admin","password":"old"}}}`,
+			cd:      managed.ConnectionDetails{"password": []byte("secret")},
+			path:    "/v1/secret/data/crossplane/db",
+			written: map[string]interface{}{"data": map[string]interface{}{"user": "admin", "password": "secret"}},
+		},
+		"V2NotFound": {
+			reason:  "The secret should be written if it doesn't exist",
+			version: v1beta1.VaultKVVersionV2,
+			cd:      managed.ConnectionDetails{"password": []byte("secret")},
+			path:    "/v1/secret/data/crossplane/db",
+			written: map[string]interface{}{"data": map[string]interface{}{"password": "secret"}},
+		},
+		"V1Changed": {
+			reason:  "Version 1 secrets should be written without a data envelope",
+			version: v1beta1.VaultKVVersionV1,
+			stored:  `{"data":{"password":"old"}}`,
+			cd:      managed.ConnectionDetails{"password": []byte("secret")},
+			path:    "/v1/secret/crossplane/db",
+			written: map[string]interface{}{"password": "secret"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var written map[string]interface{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close() //nolint:errcheck
+				if r.Header.Get(vaultTokenHeader) != "token" {
+					w.WriteHeader(http.StatusForbidden)
+					return
+				}
+				if r.URL.Path != tc.path {
+					t.Errorf("\n%s\nwrite(...): want request to %s, got %s", tc.reason, tc.path, r.URL.Path)
+				}
+				switch r.Method {
+				case http.MethodGet:
+					if tc.stored == "" {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+					_, _ = w.Write([]byte(tc.stored))
+				case http.MethodPost:
+					_ = json.NewDecoder(r.Body).Decode(&written)
+					w.WriteHeader(http.StatusNoContent)
+				}
+			}))
+			defer server.Close()
+
+			s := &vaultStore{client: server.Client(), server: server.URL, mount: "secret", scope: "crossplane", version: tc.version, token: "token"}
+			if err := s.write(context.Background(), "db", tc.cd); err != nil {
+				t.Fatalf("\n%s\nwrite(...): %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.written, written); diff != "" {
+				t.Errorf("\n%s\nwrite(...): -want written, +got written:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestVaultStoreDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		version v1beta1.VaultKVVersion
+		status  int
+		path    string
+		wantErr bool
+	}{
+		"V2": {
+			reason:  "The metadata of version 2 secrets should be deleted, deleting all their versions",
+			version: v1beta1.VaultKVVersionV2,
+			status:  http.StatusNoContent,
+			path:    "/v1/secret/metadata/crossplane/db",
+		},
+		"V1NotFound": {
+			reason:  "Secrets that don't exist should be ignored",
+			version: v1beta1.VaultKVVersionV1,
+			status:  http.StatusNotFound,
+			path:    "/v1/secret/crossplane/db",
+		},
+		"Forbidden": {
+			reason:  "Errors should be returned",
+			version: v1beta1.VaultKVVersionV2,
+			status:  http.StatusForbidden,
+			path:    "/v1/secret/metadata/crossplane/db",
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete || r.URL.Path != tc.path {
+					t.Errorf("\n%s\ndelete(...): want DELETE %s, got %s %s", tc.reason, tc.path, r.Method, r.URL.Path)
+				}
+				w.WriteHeader(tc.status)
+				if tc.status >= 300 {
+					_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+				}
+			}))
+			defer server.Close()
+
+			s := &vaultStore{client: server.Client(), server: server.URL, mount: "secret", scope: "crossplane", version: tc.version, token: "token"}
+			err := s.delete(context.Background(), "db")
+			if (err != nil) != tc.wantErr {
+				t.Errorf("\n%s\ndelete(...): want error %t, got %v", tc.reason, tc.wantErr, err)
+			}
+		})
+	}
+}
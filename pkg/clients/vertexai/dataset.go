@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vertexai
+
+import (
+	"path"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	aiplatform "google.golang.org/api/aiplatform/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane/provider-gcp/apis/vertexai/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const errNoDatasetName = "operation name does not contain a dataset name"
+
+// DatasetUpdateMask is the list of dataset fields that can be updated with a
+// patch call.
+const DatasetUpdateMask = "displayName,description,labels"
+
+// GetDatasetParent returns the location of the supplied DatasetParameters in
+// the form projects/{project}/locations/{location}, falling back to the
+// supplied default project.
+func GetDatasetParent(defaultProject string, p v1alpha1.DatasetParameters) string {
+	return getParent(defaultProject, p.Project, p.Location)
+}
+
+// GetDatasetName builds the fully qualified name of the dataset with the
+// supplied ID in the supplied parent.
+func GetDatasetName(parent, id string) string {
+	return parent + "/datasets/" + id
+}
+
+// GetDatasetID returns the server-assigned ID of the dataset that is being
+// created by the supplied operation. The operations of a dataset are named
+// {dataset}/operations/{operation}.
+func GetDatasetID(op aiplatform.GoogleLongrunningOperation) (string, error) {
+	name := op.Name
+	if i := strings.Index(name, "/operations/"); i >= 0 {
+		name = name[:i]
+	}
+	if !strings.Contains(name, "/datasets/") {
+		return "", errors.New(errNoDatasetName)
+	}
+	return path.Base(name), nil
+}
+
+// GenerateDataset produces a Dataset that is configured via the supplied
+// DatasetParameters.
+func GenerateDataset(p v1alpha1.DatasetParameters) *aiplatform.GoogleCloudAiplatformV1Dataset {
+	return &aiplatform.GoogleCloudAiplatformV1Dataset{
+		DisplayName:       p.DisplayName,
+		Description:       gcp.StringValue(p.Description),
+		Labels:            p.Labels,
+		MetadataSchemaUri: p.MetadataSchemaURI,
+		EncryptionSpec:    generateEncryptionSpec(p.KMSKeyName),
+	}
+}
+
+// GenerateDatasetObservation produces a DatasetObservation from the supplied
+// Dataset.
+func GenerateDatasetObservation(d aiplatform.GoogleCloudAiplatformV1Dataset) v1alpha1.DatasetObservation {
+	return v1alpha1.DatasetObservation{
+		Name:             d.Name,
+		DataItemCount:    d.DataItemCount,
+		MetadataArtifact: d.MetadataArtifact,
+		CreateTime:       d.CreateTime,
+		UpdateTime:       d.UpdateTime,
+		Etag:             d.Etag,
+	}
+}
+
+// LateInitializeDataset fills the empty fields of the supplied
+// DatasetParameters with the values seen in the supplied Dataset.
+func LateInitializeDataset(p *v1alpha1.DatasetParameters, d aiplatform.GoogleCloudAiplatformV1Dataset) {
+	p.Description = gcp.LateInitializeString(p.Description, d.Description)
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, d.Labels)
+	p.KMSKeyName = lateInitializeKMSKeyName(p.KMSKeyName, d.EncryptionSpec)
+}
+
+// IsDatasetUpToDate returns true if the fields of the supplied Dataset that
+// can be updated in place match the supplied DatasetParameters.
+func IsDatasetUpToDate(p v1alpha1.DatasetParameters, d aiplatform.GoogleCloudAiplatformV1Dataset) bool {
+	desired := GenerateDataset(p)
+	if desired.DisplayName != d.DisplayName || desired.Description != d.Description {
+		return false
+	}
+	return cmp.Equal(desired.Labels, d.Labels, cmpopts.EquateEmpty())
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vertexai
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	aiplatform "google.golang.org/api/aiplatform/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/vertexai/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	datasetName   = "projects/cool-project/locations/us-central1/datasets/4567"
+	datasetSchema = "gs://google-cloud-aiplatform/schema/dataset/metadata/image_1.0.0.yaml"
+)
+
+func datasetParams(m ...func(*v1alpha1.DatasetParameters)) *v1alpha1.DatasetParameters {
+	p := &v1alpha1.DatasetParameters{
+		Location:          location,
+		DisplayName:       "Product images",
+		Description:       gcp.StringPtr("Labelled product images"),
+		Labels:            map[string]string{"team": "ml"},
+		MetadataSchemaURI: datasetSchema,
+		KMSKeyName:        gcp.StringPtr(kmsKey),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func dataset(m ...func(*aiplatform.GoogleCloudAiplatformV1Dataset)) *aiplatform.GoogleCloudAiplatformV1Dataset {
+	d := &aiplatform.GoogleCloudAiplatformV1Dataset{
+		Name:              datasetName,
+		CreateTime:        "2021-06-01T00:00:00Z",
+		UpdateTime:        "2021-06-02T00:00:00Z",
+		Etag:              "etag",
+		DataItemCount:     42,
+		MetadataArtifact:  "projects/123/locations/us-central1/metadataStores/default/artifacts/4567",
+		DisplayName:       "Product images",
+		Description:       "Labelled product images",
+		Labels:            map[string]string{"team": "ml"},
+		MetadataSchemaUri: datasetSchema,
+		EncryptionSpec:    &aiplatform.GoogleCloudAiplatformV1EncryptionSpec{KmsKeyName: kmsKey},
+	}
+	for _, f := range m {
+		f(d)
+	}
+	return d
+}
+
+func TestDatasetNames(t *testing.T) {
+	parent := GetDatasetParent(project, *datasetParams())
+	if diff := cmp.Diff(datasetName, GetDatasetName(parent, "4567")); diff != "" {
+		t.Errorf("GetDatasetName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetDatasetID(t *testing.T) {
+	type want struct {
+		id  string
+		err error
+	}
+	cases := map[string]struct {
+		op   aiplatform.GoogleLongrunningOperation
+		want want
+	}{
+		"Success": {
+			op:   aiplatform.GoogleLongrunningOperation{Name: datasetName + "/operations/987"},
+			want: want{id: "4567"},
+		},
+		"NoDatasetName": {
+			op:   aiplatform.GoogleLongrunningOperation{Name: "projects/cool-project/locations/us-central1/operations/987"},
+			want: want{err: errors.New(errNoDatasetName)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			id, err := GetDatasetID(tc.op)
+			if diff := cmp.Diff(tc.want.id, id); diff != "" {
+				t.Errorf("GetDatasetID(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GetDatasetID(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateDataset(t *testing.T) {
+	want := dataset(func(d *aiplatform.GoogleCloudAiplatformV1Dataset) {
+		d.Name = ""
+		d.CreateTime = ""
+		d.UpdateTime = ""
+		d.Etag = ""
+		d.DataItemCount = 0
+		d.MetadataArtifact = ""
+	})
+	if diff := cmp.Diff(want, GenerateDataset(*datasetParams())); diff != "" {
+		t.Errorf("GenerateDataset(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateDatasetObservation(t *testing.T) {
+	want := v1alpha1.DatasetObservation{
+		Name:             datasetName,
+		DataItemCount:    42,
+		MetadataArtifact: "projects/123/locations/us-central1/metadataStores/default/artifacts/4567",
+		CreateTime:       "2021-06-01T00:00:00Z",
+		UpdateTime:       "2021-06-02T00:00:00Z",
+		Etag:             "etag",
+	}
+	if diff := cmp.Diff(want, GenerateDatasetObservation(*dataset())); diff != "" {
+		t.Errorf("GenerateDatasetObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeDataset(t *testing.T) {
+	p := datasetParams(func(p *v1alpha1.DatasetParameters) {
+		p.Description = nil
+		p.Labels = nil
+		p.KMSKeyName = nil
+	})
+	LateInitializeDataset(p, *dataset())
+	if diff := cmp.Diff(datasetParams(), p); diff != "" {
+		t.Errorf("LateInitializeDataset(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsDatasetUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.DatasetParameters
+		want bool
+	}{
+		"UpToDate": {
+			p:    datasetParams(),
+			want: true,
+		},
+		"DescriptionDiffers": {
+			p: datasetParams(func(p *v1alpha1.DatasetParameters) { p.Description = gcp.StringPtr("Product images") }),
+		},
+		"LabelsDiffer": {
+			p: datasetParams(func(p *v1alpha1.DatasetParameters) { p.Labels = nil }),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsDatasetUpToDate(*tc.p, *dataset()); got != tc.want {
+				t.Errorf("IsDatasetUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
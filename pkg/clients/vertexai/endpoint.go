@@ -0,0 +1,112 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vertexai
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	aiplatform "google.golang.org/api/aiplatform/v1"
+
+	"github.com/crossplane/provider-gcp/apis/vertexai/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const endpointUpdateMask = "displayName,description,labels"
+
+// GetEndpointParent returns the location of the supplied EndpointParameters
+// in the form projects/{project}/locations/{location}, falling back to the
+// supplied default project.
+func GetEndpointParent(defaultProject string, p v1alpha1.EndpointParameters) string {
+	return getParent(defaultProject, p.Project, p.Location)
+}
+
+// GetEndpointName builds the fully qualified name of the endpoint with the
+// supplied ID in the supplied parent.
+func GetEndpointName(parent, id string) string {
+	return parent + "/endpoints/" + id
+}
+
+// GetEndpointUpdateMask returns the list of endpoint fields that are updated
+// with a patch call. The traffic split is only updated if it is managed via
+// the supplied EndpointParameters.
+func GetEndpointUpdateMask(p v1alpha1.EndpointParameters) string {
+	if p.TrafficSplit == nil {
+		return endpointUpdateMask
+	}
+	return endpointUpdateMask + ",trafficSplit"
+}
+
+// GenerateEndpoint produces an Endpoint that is configured via the supplied
+// EndpointParameters.
+func GenerateEndpoint(p v1alpha1.EndpointParameters) *aiplatform.GoogleCloudAiplatformV1Endpoint {
+	return &aiplatform.GoogleCloudAiplatformV1Endpoint{
+		DisplayName:    p.DisplayName,
+		Description:    gcp.StringValue(p.Description),
+		Labels:         p.Labels,
+		TrafficSplit:   p.TrafficSplit,
+		Network:        gcp.StringValue(p.Network),
+		EncryptionSpec: generateEncryptionSpec(p.KMSKeyName),
+	}
+}
+
+// GenerateEndpointObservation produces an EndpointObservation from the
+// supplied Endpoint.
+func GenerateEndpointObservation(e aiplatform.GoogleCloudAiplatformV1Endpoint) v1alpha1.EndpointObservation {
+	o := v1alpha1.EndpointObservation{
+		Name:       e.Name,
+		CreateTime: e.CreateTime,
+		UpdateTime: e.UpdateTime,
+		Etag:       e.Etag,
+	}
+	for _, m := range e.DeployedModels {
+		if m == nil {
+			continue
+		}
+		o.DeployedModels = append(o.DeployedModels, v1alpha1.DeployedModel{
+			ID:             m.Id,
+			Model:          m.Model,
+			ModelVersionID: m.ModelVersionId,
+			DisplayName:    m.DisplayName,
+			CreateTime:     m.CreateTime,
+		})
+	}
+	return o
+}
+
+// LateInitializeEndpoint fills the empty fields of the supplied
+// EndpointParameters with the values seen in the supplied Endpoint. The
+// traffic split is not late initialized, because it's usually changed
+// whenever a model is deployed.
+func LateInitializeEndpoint(p *v1alpha1.EndpointParameters, e aiplatform.GoogleCloudAiplatformV1Endpoint) {
+	p.Description = gcp.LateInitializeString(p.Description, e.Description)
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, e.Labels)
+	p.Network = gcp.LateInitializeString(p.Network, e.Network)
+	p.KMSKeyName = lateInitializeKMSKeyName(p.KMSKeyName, e.EncryptionSpec)
+}
+
+// IsEndpointUpToDate returns true if the fields of the supplied Endpoint that
+// can be updated in place match the supplied EndpointParameters.
+func IsEndpointUpToDate(p v1alpha1.EndpointParameters, e aiplatform.GoogleCloudAiplatformV1Endpoint) bool {
+	desired := GenerateEndpoint(p)
+	if desired.DisplayName != e.DisplayName || desired.Description != e.Description {
+		return false
+	}
+	if !cmp.Equal(desired.Labels, e.Labels, cmpopts.EquateEmpty()) {
+		return false
+	}
+	return p.TrafficSplit == nil || cmp.Equal(desired.TrafficSplit, e.TrafficSplit, cmpopts.EquateEmpty())
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vertexai
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	aiplatform "google.golang.org/api/aiplatform/v1"
+
+	"github.com/crossplane/provider-gcp/apis/vertexai/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const endpointName = "projects/cool-project/locations/us-central1/endpoints/churn"
+
+func endpointParams(m ...func(*v1alpha1.EndpointParameters)) *v1alpha1.EndpointParameters {
+	p := &v1alpha1.EndpointParameters{
+		Location:     location,
+		DisplayName:  "Churn prediction",
+		Description:  gcp.StringPtr("Predicts customer churn"),
+		Labels:       map[string]string{"team": "ml"},
+		TrafficSplit: map[string]int64{"1234": 100},
+		KMSKeyName:   gcp.StringPtr(kmsKey),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func endpoint(m ...func(*aiplatform.GoogleCloudAiplatformV1Endpoint)) *aiplatform.GoogleCloudAiplatformV1Endpoint {
+	e := &aiplatform.GoogleCloudAiplatformV1Endpoint{
+		Name:           endpointName,
+		CreateTime:     "2021-06-01T00:00:00Z",
+		UpdateTime:     "2021-06-02T00:00:00Z",
+		Etag:           "etag",
+		DisplayName:    "Churn prediction",
+		Description:    "Predicts customer churn",
+		Labels:         map[string]string{"team": "ml"},
+		TrafficSplit:   map[string]int64{"1234": 100},
+		EncryptionSpec: &aiplatform.GoogleCloudAiplatformV1EncryptionSpec{KmsKeyName: kmsKey},
+		DeployedModels: []*aiplatform.GoogleCloudAiplatformV1DeployedModel{{
+			Id:             "1234",
+			Model:          "projects/cool-project/locations/us-central1/models/churn",
+			ModelVersionId: "2",
+			DisplayName:    "churn-v2",
+			CreateTime:     "2021-06-02T00:00:00Z",
+		}},
+	}
+	for _, f := range m {
+		f(e)
+	}
+	return e
+}
+
+func TestEndpointNames(t *testing.T) {
+	parent := GetEndpointParent(project, *endpointParams())
+	if diff := cmp.Diff(endpointName, GetEndpointName(parent, "churn")); diff != "" {
+		t.Errorf("GetEndpointName(...): -want, +got:\n%s", diff)
+	}
+	parent = GetEndpointParent(project, *endpointParams(func(p *v1alpha1.EndpointParameters) { p.Project = gcp.StringPtr("other-project") }))
+	if diff := cmp.Diff("projects/other-project/locations/us-central1", parent); diff != "" {
+		t.Errorf("GetEndpointParent(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetEndpointUpdateMask(t *testing.T) {
+	if diff := cmp.Diff("displayName,description,labels,trafficSplit", GetEndpointUpdateMask(*endpointParams())); diff != "" {
+		t.Errorf("GetEndpointUpdateMask(...): -want, +got:\n%s", diff)
+	}
+	p := endpointParams(func(p *v1alpha1.EndpointParameters) { p.TrafficSplit = nil })
+	if diff := cmp.Diff("displayName,description,labels", GetEndpointUpdateMask(*p)); diff != "" {
+		t.Errorf("GetEndpointUpdateMask(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateEndpoint(t *testing.T) {
+	want := endpoint(func(e *aiplatform.GoogleCloudAiplatformV1Endpoint) {
+		e.Name = ""
+		e.CreateTime = ""
+		e.UpdateTime = ""
+		e.Etag = ""
+		e.DeployedModels = nil
+	})
+	if diff := cmp.Diff(want, GenerateEndpoint(*endpointParams())); diff != "" {
+		t.Errorf("GenerateEndpoint(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateEndpointObservation(t *testing.T) {
+	want := v1alpha1.EndpointObservation{
+		Name:       endpointName,
+		CreateTime: "2021-06-01T00:00:00Z",
+		UpdateTime: "2021-06-02T00:00:00Z",
+		Etag:       "etag",
+		DeployedModels: []v1alpha1.DeployedModel{{
+			ID:             "1234",
+			Model:          "projects/cool-project/locations/us-central1/models/churn",
+			ModelVersionID: "2",
+			DisplayName:    "churn-v2",
+			CreateTime:     "2021-06-02T00:00:00Z",
+		}},
+	}
+	if diff := cmp.Diff(want, GenerateEndpointObservation(*endpoint())); diff != "" {
+		t.Errorf("GenerateEndpointObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeEndpoint(t *testing.T) {
+	p := endpointParams(func(p *v1alpha1.EndpointParameters) {
+		p.Description = nil
+		p.Labels = nil
+		p.TrafficSplit = nil
+		p.KMSKeyName = nil
+	})
+	want := endpointParams(func(p *v1alpha1.EndpointParameters) { p.TrafficSplit = nil })
+	LateInitializeEndpoint(p, *endpoint())
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("LateInitializeEndpoint(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsEndpointUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.EndpointParameters
+		want bool
+	}{
+		"UpToDate": {
+			p:    endpointParams(),
+			want: true,
+		},
+		"DisplayNameDiffers": {
+			p: endpointParams(func(p *v1alpha1.EndpointParameters) { p.DisplayName = "Churn" }),
+		},
+		"LabelsDiffer": {
+			p: endpointParams(func(p *v1alpha1.EndpointParameters) { p.Labels = map[string]string{"team": "platform"} }),
+		},
+		"TrafficSplitDiffers": {
+			p: endpointParams(func(p *v1alpha1.EndpointParameters) { p.TrafficSplit = map[string]int64{"1234": 50, "5678": 50} }),
+		},
+		"TrafficSplitUnmanaged": {
+			p:    endpointParams(func(p *v1alpha1.EndpointParameters) { p.TrafficSplit = nil }),
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsEndpointUpToDate(*tc.p, *endpoint()); got != tc.want {
+				t.Errorf("IsEndpointUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
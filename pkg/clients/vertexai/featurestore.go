@@ -0,0 +1,125 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vertexai
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	aiplatform "google.golang.org/api/aiplatform/v1"
+
+	"github.com/crossplane/provider-gcp/apis/vertexai/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// FeaturestoreUpdateMask is the list of featurestore fields that can be
+// updated with a patch call.
+const FeaturestoreUpdateMask = "labels,onlineServingConfig.fixedNodeCount,onlineServingConfig.scaling,onlineStorageTtlDays"
+
+var ignoreForceSendFields = cmp.FilterPath(func(p cmp.Path) bool {
+	return p.Last().String() == ".ForceSendFields"
+}, cmp.Ignore())
+
+// GetFeaturestoreParent returns the location of the supplied
+// FeaturestoreParameters in the form projects/{project}/locations/{location},
+// falling back to the supplied default project.
+func GetFeaturestoreParent(defaultProject string, p v1alpha1.FeaturestoreParameters) string {
+	return getParent(defaultProject, p.Project, p.Location)
+}
+
+// GetFeaturestoreName builds the fully qualified name of the featurestore
+// with the supplied ID in the supplied parent.
+func GetFeaturestoreName(parent, id string) string {
+	return parent + "/featurestores/" + id
+}
+
+// GenerateFeaturestore produces a Featurestore that is configured via the
+// supplied FeaturestoreParameters.
+func GenerateFeaturestore(p v1alpha1.FeaturestoreParameters) *aiplatform.GoogleCloudAiplatformV1Featurestore {
+	f := &aiplatform.GoogleCloudAiplatformV1Featurestore{
+		Labels:               p.Labels,
+		OnlineStorageTtlDays: gcp.Int64Value(p.OnlineStorageTTLDays),
+		EncryptionSpec:       generateEncryptionSpec(p.KMSKeyName),
+	}
+	if c := p.OnlineServingConfig; c != nil {
+		f.OnlineServingConfig = &aiplatform.GoogleCloudAiplatformV1FeaturestoreOnlineServingConfig{
+			FixedNodeCount: gcp.Int64Value(c.FixedNodeCount),
+		}
+		if c.Scaling != nil {
+			f.OnlineServingConfig.Scaling = &aiplatform.GoogleCloudAiplatformV1FeaturestoreOnlineServingConfigScaling{
+				MinNodeCount:         c.Scaling.MinNodeCount,
+				MaxNodeCount:         gcp.Int64Value(c.Scaling.MaxNodeCount),
+				CpuUtilizationTarget: gcp.Int64Value(c.Scaling.CPUUtilizationTarget),
+			}
+		}
+	}
+	return f
+}
+
+// GenerateFeaturestoreObservation produces a FeaturestoreObservation from the
+// supplied Featurestore.
+func GenerateFeaturestoreObservation(f aiplatform.GoogleCloudAiplatformV1Featurestore) v1alpha1.FeaturestoreObservation {
+	return v1alpha1.FeaturestoreObservation{
+		Name:       f.Name,
+		State:      f.State,
+		CreateTime: f.CreateTime,
+		UpdateTime: f.UpdateTime,
+		Etag:       f.Etag,
+	}
+}
+
+// LateInitializeFeaturestore fills the empty fields of the supplied
+// FeaturestoreParameters with the values seen in the supplied Featurestore.
+func LateInitializeFeaturestore(p *v1alpha1.FeaturestoreParameters, f aiplatform.GoogleCloudAiplatformV1Featurestore) {
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, f.Labels)
+	p.OnlineStorageTTLDays = gcp.LateInitializeInt64(p.OnlineStorageTTLDays, f.OnlineStorageTtlDays)
+	p.KMSKeyName = lateInitializeKMSKeyName(p.KMSKeyName, f.EncryptionSpec)
+	in := f.OnlineServingConfig
+	if in == nil {
+		return
+	}
+	if p.OnlineServingConfig == nil {
+		p.OnlineServingConfig = &v1alpha1.OnlineServingConfig{}
+	}
+	c := p.OnlineServingConfig
+	if c.Scaling == nil && in.Scaling == nil {
+		c.FixedNodeCount = gcp.LateInitializeInt64(c.FixedNodeCount, in.FixedNodeCount)
+	}
+	if in.Scaling == nil {
+		return
+	}
+	if c.Scaling == nil && c.FixedNodeCount == nil {
+		c.Scaling = &v1alpha1.OnlineServingScaling{MinNodeCount: in.Scaling.MinNodeCount}
+	}
+	if c.Scaling != nil {
+		c.Scaling.MaxNodeCount = gcp.LateInitializeInt64(c.Scaling.MaxNodeCount, in.Scaling.MaxNodeCount)
+		c.Scaling.CPUUtilizationTarget = gcp.LateInitializeInt64(c.Scaling.CPUUtilizationTarget, in.Scaling.CpuUtilizationTarget)
+	}
+}
+
+// IsFeaturestoreUpToDate returns true if the fields of the supplied
+// Featurestore that can be updated in place match the supplied
+// FeaturestoreParameters.
+func IsFeaturestoreUpToDate(p v1alpha1.FeaturestoreParameters, f aiplatform.GoogleCloudAiplatformV1Featurestore) bool {
+	desired := GenerateFeaturestore(p)
+	if !cmp.Equal(desired.Labels, f.Labels, cmpopts.EquateEmpty()) {
+		return false
+	}
+	if p.OnlineStorageTTLDays != nil && desired.OnlineStorageTtlDays != f.OnlineStorageTtlDays {
+		return false
+	}
+	return cmp.Equal(desired.OnlineServingConfig, f.OnlineServingConfig, cmpopts.EquateEmpty(), ignoreForceSendFields)
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vertexai
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	aiplatform "google.golang.org/api/aiplatform/v1"
+
+	"github.com/crossplane/provider-gcp/apis/vertexai/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const featurestoreName = "projects/cool-project/locations/us-central1/featurestores/customers"
+
+func featurestoreParams(m ...func(*v1alpha1.FeaturestoreParameters)) *v1alpha1.FeaturestoreParameters {
+	p := &v1alpha1.FeaturestoreParameters{
+		Location: location,
+		Labels:   map[string]string{"team": "ml"},
+		OnlineServingConfig: &v1alpha1.OnlineServingConfig{
+			Scaling: &v1alpha1.OnlineServingScaling{
+				MinNodeCount:         1,
+				MaxNodeCount:         gcp.Int64Ptr(3),
+				CPUUtilizationTarget: gcp.Int64Ptr(50),
+			},
+		},
+		OnlineStorageTTLDays: gcp.Int64Ptr(30),
+		KMSKeyName:           gcp.StringPtr(kmsKey),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func featurestore(m ...func(*aiplatform.GoogleCloudAiplatformV1Featurestore)) *aiplatform.GoogleCloudAiplatformV1Featurestore {
+	f := &aiplatform.GoogleCloudAiplatformV1Featurestore{
+		Name:       featurestoreName,
+		State:      v1alpha1.FeaturestoreStateStable,
+		CreateTime: "2021-06-01T00:00:00Z",
+		UpdateTime: "2021-06-02T00:00:00Z",
+		Etag:       "etag",
+		Labels:     map[string]string{"team": "ml"},
+		OnlineServingConfig: &aiplatform.GoogleCloudAiplatformV1FeaturestoreOnlineServingConfig{
+			Scaling: &aiplatform.GoogleCloudAiplatformV1FeaturestoreOnlineServingConfigScaling{
+				MinNodeCount:         1,
+				MaxNodeCount:         3,
+				CpuUtilizationTarget: 50,
+			},
+		},
+		OnlineStorageTtlDays: 30,
+		EncryptionSpec:       &aiplatform.GoogleCloudAiplatformV1EncryptionSpec{KmsKeyName: kmsKey},
+	}
+	for _, fn := range m {
+		fn(f)
+	}
+	return f
+}
+
+func TestFeaturestoreNames(t *testing.T) {
+	parent := GetFeaturestoreParent(project, *featurestoreParams())
+	if diff := cmp.Diff(featurestoreName, GetFeaturestoreName(parent, "customers")); diff != "" {
+		t.Errorf("GetFeaturestoreName(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateFeaturestore(t *testing.T) {
+	want := featurestore(func(f *aiplatform.GoogleCloudAiplatformV1Featurestore) {
+		f.Name = ""
+		f.State = ""
+		f.CreateTime = ""
+		f.UpdateTime = ""
+		f.Etag = ""
+	})
+	if diff := cmp.Diff(want, GenerateFeaturestore(*featurestoreParams())); diff != "" {
+		t.Errorf("GenerateFeaturestore(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateFeaturestoreObservation(t *testing.T) {
+	want := v1alpha1.FeaturestoreObservation{
+		Name:       featurestoreName,
+		State:      v1alpha1.FeaturestoreStateStable,
+		CreateTime: "2021-06-01T00:00:00Z",
+		UpdateTime: "2021-06-02T00:00:00Z",
+		Etag:       "etag",
+	}
+	if diff := cmp.Diff(want, GenerateFeaturestoreObservation(*featurestore())); diff != "" {
+		t.Errorf("GenerateFeaturestoreObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeFeaturestore(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.FeaturestoreParameters
+		f    *aiplatform.GoogleCloudAiplatformV1Featurestore
+		want *v1alpha1.FeaturestoreParameters
+	}{
+		"FillDefaults": {
+			p: featurestoreParams(func(p *v1alpha1.FeaturestoreParameters) {
+				p.Labels = nil
+				p.OnlineStorageTTLDays = nil
+				p.KMSKeyName = nil
+				p.OnlineServingConfig.Scaling.MaxNodeCount = nil
+				p.OnlineServingConfig.Scaling.CPUUtilizationTarget = nil
+			}),
+			f:    featurestore(),
+			want: featurestoreParams(),
+		},
+		"ObservedScaling": {
+			p:    featurestoreParams(func(p *v1alpha1.FeaturestoreParameters) { p.OnlineServingConfig = nil }),
+			f:    featurestore(),
+			want: featurestoreParams(),
+		},
+		"ObservedFixedNodeCount": {
+			p: featurestoreParams(func(p *v1alpha1.FeaturestoreParameters) { p.OnlineServingConfig = nil }),
+			f: featurestore(func(f *aiplatform.GoogleCloudAiplatformV1Featurestore) {
+				f.OnlineServingConfig = &aiplatform.GoogleCloudAiplatformV1FeaturestoreOnlineServingConfig{FixedNodeCount: 2}
+			}),
+			want: featurestoreParams(func(p *v1alpha1.FeaturestoreParameters) {
+				p.OnlineServingConfig = &v1alpha1.OnlineServingConfig{FixedNodeCount: gcp.Int64Ptr(2)}
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeFeaturestore(tc.p, *tc.f)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("LateInitializeFeaturestore(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsFeaturestoreUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.FeaturestoreParameters
+		want bool
+	}{
+		"UpToDate": {
+			p:    featurestoreParams(),
+			want: true,
+		},
+		"LabelsDiffer": {
+			p: featurestoreParams(func(p *v1alpha1.FeaturestoreParameters) { p.Labels = map[string]string{"team": "platform"} }),
+		},
+		"TTLDiffers": {
+			p: featurestoreParams(func(p *v1alpha1.FeaturestoreParameters) { p.OnlineStorageTTLDays = gcp.Int64Ptr(7) }),
+		},
+		"ScalingDiffers": {
+			p: featurestoreParams(func(p *v1alpha1.FeaturestoreParameters) { p.OnlineServingConfig.Scaling.MaxNodeCount = gcp.Int64Ptr(5) }),
+		},
+		"FixedNodeCount": {
+			p: featurestoreParams(func(p *v1alpha1.FeaturestoreParameters) {
+				p.OnlineServingConfig = &v1alpha1.OnlineServingConfig{FixedNodeCount: gcp.Int64Ptr(1)}
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsFeaturestoreUpToDate(*tc.p, *featurestore()); got != tc.want {
+				t.Errorf("IsFeaturestoreUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vertexai
+
+import (
+	"fmt"
+
+	aiplatform "google.golang.org/api/aiplatform/v1"
+
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	parentFormat          = "projects/%s/locations/%s"
+	serviceEndpointFormat = "https://%s-aiplatform.googleapis.com/"
+)
+
+// GetServiceEndpoint returns the Vertex AI endpoint that serves the resources
+// in the supplied location. Vertex AI resources are only served by the
+// endpoint of their region.
+func GetServiceEndpoint(location string) string {
+	return fmt.Sprintf(serviceEndpointFormat, location)
+}
+
+func getParent(defaultProject string, project *string, location string) string {
+	if project != nil {
+		defaultProject = *project
+	}
+	return fmt.Sprintf(parentFormat, defaultProject, location)
+}
+
+func generateEncryptionSpec(kmsKeyName *string) *aiplatform.GoogleCloudAiplatformV1EncryptionSpec {
+	if kmsKeyName == nil {
+		return nil
+	}
+	return &aiplatform.GoogleCloudAiplatformV1EncryptionSpec{KmsKeyName: *kmsKeyName}
+}
+
+func lateInitializeKMSKeyName(kmsKeyName *string, from *aiplatform.GoogleCloudAiplatformV1EncryptionSpec) *string {
+	if from == nil {
+		return kmsKeyName
+	}
+	return gcp.LateInitializeString(kmsKeyName, from.KmsKeyName)
+}
@@ -0,0 +1,35 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vertexai
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	project  = "cool-project"
+	location = "us-central1"
+	kmsKey   = "projects/cool-project/locations/us-central1/keyRings/ml/cryptoKeys/vertex"
+)
+
+func TestGetServiceEndpoint(t *testing.T) {
+	if diff := cmp.Diff("https://us-central1-aiplatform.googleapis.com/", GetServiceEndpoint(location)); diff != "" {
+		t.Errorf("GetServiceEndpoint(...): -want, +got:\n%s", diff)
+	}
+}
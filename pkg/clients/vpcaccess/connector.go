@@ -0,0 +1,102 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpcaccess
+
+import (
+	"fmt"
+
+	vpcaccess "google.golang.org/api/vpcaccess/v1"
+
+	"github.com/crossplane/provider-gcp/apis/vpcaccess/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	connectorNameFormat = "projects/%s/locations/%s/connectors/%s"
+	parentFormat        = "projects/%s/locations/%s"
+)
+
+// UpdateMask is the set of Connector fields that can be updated in place.
+const UpdateMask = "minInstances,maxInstances,machineType"
+
+// GetFullyQualifiedParent builds the fully qualified name of the connector
+// parent.
+func GetFullyQualifiedParent(project string, p v1alpha1.ConnectorParameters) string {
+	return fmt.Sprintf(parentFormat, project, p.Location)
+}
+
+// GetFullyQualifiedName builds the fully qualified name of the connector.
+func GetFullyQualifiedName(project string, p v1alpha1.ConnectorParameters, name string) string {
+	return fmt.Sprintf(connectorNameFormat, project, p.Location, name)
+}
+
+// GenerateConnector produces a Connector that is configured via given
+// ConnectorParameters.
+func GenerateConnector(name string, p v1alpha1.ConnectorParameters) *vpcaccess.Connector {
+	c := &vpcaccess.Connector{
+		Name:          name,
+		Network:       gcp.StringValue(p.Network),
+		IpCidrRange:   gcp.StringValue(p.IPCIDRRange),
+		MachineType:   gcp.StringValue(p.MachineType),
+		MinInstances:  gcp.Int64Value(p.MinInstances),
+		MaxInstances:  gcp.Int64Value(p.MaxInstances),
+		MinThroughput: gcp.Int64Value(p.MinThroughput),
+		MaxThroughput: gcp.Int64Value(p.MaxThroughput),
+	}
+	if p.Subnet != nil {
+		c.Subnet = &vpcaccess.Subnet{
+			Name:      gcp.StringValue(p.Subnet.Name),
+			ProjectId: gcp.StringValue(p.Subnet.ProjectID),
+		}
+	}
+	return c
+}
+
+// GenerateObservation produces a ConnectorObservation from the supplied
+// Connector.
+func GenerateObservation(c vpcaccess.Connector) v1alpha1.ConnectorObservation {
+	return v1alpha1.ConnectorObservation{
+		Name:              c.Name,
+		State:             c.State,
+		ConnectedProjects: c.ConnectedProjects,
+	}
+}
+
+// LateInitializeSpec fills the empty fields of ConnectorParameters with the
+// values seen in the supplied Connector.
+func LateInitializeSpec(p *v1alpha1.ConnectorParameters, c vpcaccess.Connector) {
+	p.MachineType = gcp.LateInitializeString(p.MachineType, c.MachineType)
+	p.MinInstances = gcp.LateInitializeInt64(p.MinInstances, c.MinInstances)
+	p.MaxInstances = gcp.LateInitializeInt64(p.MaxInstances, c.MaxInstances)
+	p.MinThroughput = gcp.LateInitializeInt64(p.MinThroughput, c.MinThroughput)
+	p.MaxThroughput = gcp.LateInitializeInt64(p.MaxThroughput, c.MaxThroughput)
+}
+
+// IsUpToDate returns true if the supplied Connector matches the fields of the
+// supplied ConnectorParameters that can be updated in place.
+func IsUpToDate(p v1alpha1.ConnectorParameters, c vpcaccess.Connector) bool {
+	if p.MachineType != nil && *p.MachineType != c.MachineType {
+		return false
+	}
+	if p.MinInstances != nil && *p.MinInstances != c.MinInstances {
+		return false
+	}
+	if p.MaxInstances != nil && *p.MaxInstances != c.MaxInstances {
+		return false
+	}
+	return true
+}
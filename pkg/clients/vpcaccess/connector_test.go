@@ -0,0 +1,168 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpcaccess
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	vpcaccess "google.golang.org/api/vpcaccess/v1"
+
+	"github.com/crossplane/provider-gcp/apis/vpcaccess/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	fullName = "projects/coolProject/locations/us-cool1/connectors/cool-connector"
+)
+
+func params(m ...func(*v1alpha1.ConnectorParameters)) *v1alpha1.ConnectorParameters {
+	p := &v1alpha1.ConnectorParameters{
+		Location:     "us-cool1",
+		Network:      gcp.StringPtr("default"),
+		IPCIDRRange:  gcp.StringPtr("10.8.0.0/28"),
+		MachineType:  gcp.StringPtr("e2-micro"),
+		MinInstances: gcp.Int64Ptr(2),
+		MaxInstances: gcp.Int64Ptr(3),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func connector(m ...func(*vpcaccess.Connector)) *vpcaccess.Connector {
+	c := &vpcaccess.Connector{
+		Name:         fullName,
+		Network:      "default",
+		IpCidrRange:  "10.8.0.0/28",
+		MachineType:  "e2-micro",
+		MinInstances: 2,
+		MaxInstances: 3,
+	}
+	for _, f := range m {
+		f(c)
+	}
+	return c
+}
+
+func TestGenerateConnector(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.ConnectorParameters
+		want *vpcaccess.Connector
+	}{
+		"FullConversion": {
+			p:    *params(),
+			want: connector(),
+		},
+		"Subnet": {
+			p: *params(func(p *v1alpha1.ConnectorParameters) {
+				p.Network = nil
+				p.IPCIDRRange = nil
+				p.Subnet = &v1alpha1.Subnet{Name: gcp.StringPtr("cool-subnet"), ProjectID: gcp.StringPtr("hostProject")}
+			}),
+			want: connector(func(c *vpcaccess.Connector) {
+				c.Network = ""
+				c.IpCidrRange = ""
+				c.Subnet = &vpcaccess.Subnet{Name: "cool-subnet", ProjectId: "hostProject"}
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateConnector(fullName, tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateConnector(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	c := *connector(func(c *vpcaccess.Connector) {
+		c.State = v1alpha1.ConnectorStateReady
+		c.ConnectedProjects = []string{"coolProject"}
+	})
+	want := v1alpha1.ConnectorObservation{
+		Name:              fullName,
+		State:             v1alpha1.ConnectorStateReady,
+		ConnectedProjects: []string{"coolProject"},
+	}
+	if diff := cmp.Diff(want, GenerateObservation(c)); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	p := params(func(p *v1alpha1.ConnectorParameters) {
+		p.MachineType = nil
+		p.MinInstances = nil
+		p.MaxInstances = nil
+	})
+	LateInitializeSpec(p, *connector(func(c *vpcaccess.Connector) {
+		c.MinThroughput = 200
+		c.MaxThroughput = 300
+	}))
+	want := params(func(p *v1alpha1.ConnectorParameters) {
+		p.MinThroughput = gcp.Int64Ptr(200)
+		p.MaxThroughput = gcp.Int64Ptr(300)
+	})
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.ConnectorParameters
+		c    vpcaccess.Connector
+		want bool
+	}{
+		"UpToDate": {
+			p:    *params(),
+			c:    *connector(func(c *vpcaccess.Connector) { c.State = v1alpha1.ConnectorStateReady }),
+			want: true,
+		},
+		"MachineTypeDiffers": {
+			p:    *params(),
+			c:    *connector(func(c *vpcaccess.Connector) { c.MachineType = "f1-micro" }),
+			want: false,
+		},
+		"MinInstancesDiffers": {
+			p:    *params(),
+			c:    *connector(func(c *vpcaccess.Connector) { c.MinInstances = 3 }),
+			want: false,
+		},
+		"MaxInstancesDiffers": {
+			p:    *params(),
+			c:    *connector(func(c *vpcaccess.Connector) { c.MaxInstances = 10 }),
+			want: false,
+		},
+		"ImmutableFieldDiffers": {
+			p:    *params(),
+			c:    *connector(func(c *vpcaccess.Connector) { c.IpCidrRange = "10.9.0.0/28" }),
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(tc.p, tc.c)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
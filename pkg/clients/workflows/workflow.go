@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflows
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitchellh/copystructure"
+	workflows "google.golang.org/api/workflows/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+
+	"github.com/crossplane/provider-gcp/apis/workflows/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	workflowNameFormat = "projects/%s/locations/%s/workflows/%s"
+	parentFormat       = "projects/%s/locations/%s"
+
+	errCheckUpToDate = "unable to determine if external resource is up to date"
+	errGetConfigMap  = "cannot get source ConfigMap"
+	errNoSourceKey   = "source ConfigMap has no key %q"
+)
+
+// UpdateMask is the set of Workflow fields that can be updated in place.
+const UpdateMask = "description,labels,sourceContents,serviceAccount,userEnvVars,callLogLevel"
+
+// GetFullyQualifiedParent builds the fully qualified name of the workflow
+// parent.
+func GetFullyQualifiedParent(project string, p v1alpha1.WorkflowParameters) string {
+	return fmt.Sprintf(parentFormat, project, p.Location)
+}
+
+// GetFullyQualifiedName builds the fully qualified name of the workflow.
+func GetFullyQualifiedName(project string, p v1alpha1.WorkflowParameters, name string) string {
+	return fmt.Sprintf(workflowNameFormat, project, p.Location, name)
+}
+
+// GetSourceContents returns the workflow definition of the supplied
+// WorkflowParameters, reading it from the referenced ConfigMap if it is not
+// inlined.
+func GetSourceContents(ctx context.Context, kube client.Reader, p v1alpha1.WorkflowParameters) (string, error) {
+	if p.SourceContents != nil || p.SourceConfigMapRef == nil {
+		return gcp.StringValue(p.SourceContents), nil
+	}
+	ref := p.SourceConfigMapRef
+	cm := &corev1.ConfigMap{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, cm); err != nil {
+		return "", errors.Wrap(err, errGetConfigMap)
+	}
+	src, ok := cm.Data[ref.Key]
+	if !ok {
+		return "", errors.Errorf(errNoSourceKey, ref.Key)
+	}
+	return src, nil
+}
+
+// GenerateWorkflow overlays the supplied WorkflowParameters onto the supplied
+// Workflow. Name must be a fully qualified name for the workflow, and source
+// is the workflow definition as returned by GetSourceContents.
+func GenerateWorkflow(name, source string, p v1alpha1.WorkflowParameters, w *workflows.Workflow) {
+	w.Name = name
+	w.SourceContents = source
+	if p.Description != nil {
+		w.Description = *p.Description
+	}
+	if p.Labels != nil {
+		w.Labels = p.Labels
+	}
+	if p.ServiceAccount != nil {
+		w.ServiceAccount = *p.ServiceAccount
+	}
+	w.UserEnvVars = p.EnvironmentVariables
+	if p.CallLogLevel != nil {
+		w.CallLogLevel = *p.CallLogLevel
+	}
+}
+
+// GenerateObservation is used to produce an observation object from GCP's
+// Workflow object.
+func GenerateObservation(w workflows.Workflow) v1alpha1.WorkflowObservation {
+	o := v1alpha1.WorkflowObservation{
+		Name:               w.Name,
+		State:              w.State,
+		RevisionID:         w.RevisionId,
+		RevisionCreateTime: w.RevisionCreateTime,
+		CreateTime:         w.CreateTime,
+		UpdateTime:         w.UpdateTime,
+	}
+	if w.StateError != nil {
+		o.StateError = w.StateError.Details
+	}
+	return o
+}
+
+// LateInitializeSpec fills empty spec fields with the data retrieved from GCP.
+func LateInitializeSpec(p *v1alpha1.WorkflowParameters, w workflows.Workflow) {
+	p.Description = gcp.LateInitializeString(p.Description, w.Description)
+	p.Labels = gcp.LateInitializeStringMap(p.Labels, w.Labels)
+	p.ServiceAccount = gcp.LateInitializeString(p.ServiceAccount, w.ServiceAccount)
+	p.CallLogLevel = gcp.LateInitializeString(p.CallLogLevel, w.CallLogLevel)
+}
+
+// IsUpToDate returns true if the supplied Kubernetes resource does not differ
+// from the supplied GCP resource.
+func IsUpToDate(name, source string, p *v1alpha1.WorkflowParameters, observed *workflows.Workflow) (bool, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return true, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*workflows.Workflow)
+	if !ok {
+		return true, errors.New(errCheckUpToDate)
+	}
+	GenerateWorkflow(name, source, *p, desired)
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty()), nil
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflows
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	workflows "google.golang.org/api/workflows/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/workflows/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	fullName = "projects/coolProject/locations/us-cool1/workflows/cool-workflow"
+	email    = "cool@coolProject.iam.gserviceaccount.com"
+	source   = "main:\n  steps:\n    - done:\n        return: cool\n"
+)
+
+var errBoom = errors.New("boom")
+
+func params(m ...func(*v1alpha1.WorkflowParameters)) *v1alpha1.WorkflowParameters {
+	p := &v1alpha1.WorkflowParameters{
+		Location:             "us-cool1",
+		Description:          gcp.StringPtr("so cool"),
+		Labels:               map[string]string{"cool": "true"},
+		SourceContents:       gcp.StringPtr(source),
+		ServiceAccount:       gcp.StringPtr(email),
+		EnvironmentVariables: map[string]string{"COOL": "true"},
+		CallLogLevel:         gcp.StringPtr("LOG_ERRORS_ONLY"),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func workflow(m ...func(*workflows.Workflow)) *workflows.Workflow {
+	w := &workflows.Workflow{
+		Name:           fullName,
+		Description:    "so cool",
+		Labels:         map[string]string{"cool": "true"},
+		SourceContents: source,
+		ServiceAccount: email,
+		UserEnvVars:    map[string]string{"COOL": "true"},
+		CallLogLevel:   "LOG_ERRORS_ONLY",
+	}
+	for _, f := range m {
+		f(w)
+	}
+	return w
+}
+
+func TestGetSourceContents(t *testing.T) {
+	type want struct {
+		source string
+		err    error
+	}
+	ref := &v1alpha1.ConfigMapKeySelector{Name: "cool-cm", Namespace: "cool-ns", Key: "workflow.yaml"}
+	cases := map[string]struct {
+		kube client.Reader
+		p    v1alpha1.WorkflowParameters
+		want want
+	}{
+		"Inline": {
+			p:    *params(),
+			want: want{source: source},
+		},
+		"ConfigMap": {
+			kube: &test.MockClient{MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+				if diff := cmp.Diff(client.ObjectKey{Name: "cool-cm", Namespace: "cool-ns"}, key); diff != "" {
+					t.Errorf("Get(...): -want key, +got key:\n%s", diff)
+				}
+				obj.(*corev1.ConfigMap).Data = map[string]string{"workflow.yaml": source}
+				return nil
+			}},
+			p: *params(func(p *v1alpha1.WorkflowParameters) {
+				p.SourceContents = nil
+				p.SourceConfigMapRef = ref
+			}),
+			want: want{source: source},
+		},
+		"ConfigMapMissingKey": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+			p: *params(func(p *v1alpha1.WorkflowParameters) {
+				p.SourceContents = nil
+				p.SourceConfigMapRef = ref
+			}),
+			want: want{err: errors.Errorf(errNoSourceKey, "workflow.yaml")},
+		},
+		"ConfigMapGetFailed": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			p: *params(func(p *v1alpha1.WorkflowParameters) {
+				p.SourceContents = nil
+				p.SourceConfigMapRef = ref
+			}),
+			want: want{err: errors.Wrap(errBoom, errGetConfigMap)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GetSourceContents(context.Background(), tc.kube, tc.p)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GetSourceContents(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.source, got); diff != "" {
+				t.Errorf("GetSourceContents(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateWorkflow(t *testing.T) {
+	got := &workflows.Workflow{}
+	GenerateWorkflow(fullName, source, *params(), got)
+	if diff := cmp.Diff(workflow(), got); diff != "" {
+		t.Errorf("GenerateWorkflow(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	w := *workflow(func(w *workflows.Workflow) {
+		w.State = v1alpha1.WorkflowStateUnavailable
+		w.StateError = &workflows.StateError{Details: "key is disabled"}
+		w.RevisionId = "000001-abc"
+	})
+	want := v1alpha1.WorkflowObservation{
+		Name:       fullName,
+		State:      v1alpha1.WorkflowStateUnavailable,
+		StateError: "key is disabled",
+		RevisionID: "000001-abc",
+	}
+	if diff := cmp.Diff(want, GenerateObservation(w)); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	p := params(func(p *v1alpha1.WorkflowParameters) {
+		p.ServiceAccount = nil
+		p.CallLogLevel = nil
+	})
+	LateInitializeSpec(p, *workflow())
+	if diff := cmp.Diff(params(), p); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		source string
+		w      *workflows.Workflow
+		want   bool
+	}{
+		"UpToDate": {
+			source: source,
+			w: workflow(func(w *workflows.Workflow) {
+				w.State = v1alpha1.WorkflowStateActive
+				w.RevisionId = "000001-abc"
+			}),
+			want: true,
+		},
+		"SourceDiffers": {
+			source: "main:\n  steps: []\n",
+			w:      workflow(),
+			want:   false,
+		},
+		"EnvVarsDiffer": {
+			source: source,
+			w: workflow(func(w *workflows.Workflow) {
+				w.UserEnvVars = nil
+			}),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsUpToDate(fullName, tc.source, params(), tc.w)
+			if err != nil {
+				t.Fatalf("IsUpToDate(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesscontextmanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	accesscontextmanager "google.golang.org/api/accesscontextmanager/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/accesscontextmanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	acmclient "github.com/crossplane/provider-gcp/pkg/clients/accesscontextmanager"
+)
+
+// Error strings.
+const (
+	errNotAccessLevel      = "managed resource is not an AccessLevel"
+	errGetAccessLevel      = "cannot get AccessLevel"
+	errCreateAccessLevel   = "cannot create AccessLevel"
+	errUpdateAccessLevel   = "cannot update AccessLevel"
+	errDeleteAccessLevel   = "cannot delete AccessLevel"
+	errUpdateAccessLevelCR = "cannot update AccessLevel custom resource"
+)
+
+// SetupAccessLevel adds a controller that reconciles AccessLevels.
+func SetupAccessLevel(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.AccessLevelGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.AccessLevelGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.AccessLevel{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.AccessLevelGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.AccessLevelGroupVersionKind),
+			&accessLevelConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type accessLevelConnector struct {
+	kube client.Client
+}
+
+func (c *accessLevelConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := accesscontextmanager.NewService(ctx, opts.For("accesscontextmanager")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &accessLevelExternal{kube: c.kube, levels: s.AccessPolicies.AccessLevels}, nil
+}
+
+type accessLevelExternal struct {
+	kube   client.Client
+	levels *accesscontextmanager.AccessPoliciesAccessLevelsService
+}
+
+func (e *accessLevelExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.AccessLevel)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAccessLevel)
+	}
+	l, err := e.levels.Get(accessLevelName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetAccessLevel)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	acmclient.LateInitializeAccessLevel(&cr.Spec.ForProvider, *l)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateAccessLevelCR)
+		}
+	}
+	cr.Status.AtProvider = acmclient.GenerateAccessLevelObservation(*l)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: acmclient.IsAccessLevelUpToDate(cr.Spec.ForProvider, *l),
+	}, nil
+}
+
+// Access levels are created by a long-running operation and can't be found
+// until it completes. A create that races an earlier one is rejected because
+// the level already exists, which is what we asked for.
+func (e *accessLevelExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.AccessLevel)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAccessLevel)
+	}
+	cr.SetConditions(xpv1.Creating())
+	parent := acmclient.GetAccessPolicyName(gcp.StringValue(cr.Spec.ForProvider.AccessPolicy))
+	_, err := e.levels.Create(parent, acmclient.GenerateAccessLevel(accessLevelName(cr), cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(resource.Ignore(gcp.IsErrorAlreadyExists, err), errCreateAccessLevel)
+}
+
+func (e *accessLevelExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.AccessLevel)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAccessLevel)
+	}
+	name := accessLevelName(cr)
+	_, err := e.levels.Patch(name, acmclient.GenerateAccessLevel(name, cr.Spec.ForProvider)).UpdateMask(acmclient.AccessLevelUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAccessLevel)
+}
+
+func (e *accessLevelExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.AccessLevel)
+	if !ok {
+		return errors.New(errNotAccessLevel)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.levels.Delete(accessLevelName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteAccessLevel)
+}
+
+func accessLevelName(cr *v1alpha1.AccessLevel) string {
+	return acmclient.GetAccessLevelName(gcp.StringValue(cr.Spec.ForProvider.AccessPolicy), meta.GetExternalName(cr))
+}
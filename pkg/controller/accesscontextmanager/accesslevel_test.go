@@ -0,0 +1,275 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesscontextmanager
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	accesscontextmanager "google.golang.org/api/accesscontextmanager/v1"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/accesscontextmanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const accessLevelPath = "/v1/accessPolicies/1234567890/accessLevels/corp_network"
+
+func newAccessLevel(m ...func(*v1alpha1.AccessLevel)) *v1alpha1.AccessLevel {
+	cr := &v1alpha1.AccessLevel{}
+	meta.SetExternalName(cr, "corp_network")
+	cr.Spec.ForProvider = v1alpha1.AccessLevelParameters{
+		AccessPolicy: gcp.StringPtr(policyID),
+		Title:        "Corporate network",
+		Description:  gcp.StringPtr("Requests from the office"),
+		Basic: &v1alpha1.BasicLevel{
+			CombiningFunction: gcp.StringPtr(v1alpha1.CombiningFunctionAnd),
+			Conditions:        []v1alpha1.Condition{{IPSubnetworks: []string{"192.0.2.0/24"}}},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func accessLevel(subnetwork string) *accesscontextmanager.AccessLevel {
+	return &accesscontextmanager.AccessLevel{
+		Name:        "accessPolicies/1234567890/accessLevels/corp_network",
+		Title:       "Corporate network",
+		Description: "Requests from the office",
+		Basic: &accesscontextmanager.BasicLevel{
+			CombiningFunction: "AND",
+			Conditions:        []*accesscontextmanager.Condition{{IpSubnetworks: []string{subnetwork}}},
+		},
+	}
+}
+
+func TestAccessLevelObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		kube    *test.MockClient
+		mg      resource.Managed
+		want    want
+	}{
+		"NotAccessLevel": {
+			reason: "Should return an error if the resource is not an AccessLevel",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotAccessLevel)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			mg:     newAccessLevel(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(accessLevelPath, r.URL.Path); diff != "" {
+					t.Errorf("path: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			mg:     newAccessLevel(),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetAccessLevel)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if the late initialization update fails",
+			mg:     newAccessLevel(func(cr *v1alpha1.AccessLevel) { cr.Spec.ForProvider.Description = nil }),
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			want:   want{err: errors.Wrap(errBoom, errUpdateAccessLevelCR)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(accessLevel("192.0.2.0/24"))
+			}),
+		},
+		"ResourceUpToDate": {
+			reason: "Should return upToDate as true if the resource is up to date",
+			mg:     newAccessLevel(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(accessLevel("192.0.2.0/24"))
+			}),
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should return upToDate as false if the resource is not up to date",
+			mg:     newAccessLevel(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(accessLevel("198.51.100.0/24"))
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := accesscontextmanager.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := accessLevelExternal{
+				kube:   tc.kube,
+				levels: s.AccessPolicies.AccessLevels,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestAccessLevelWrite(t *testing.T) {
+	create := func(e *accessLevelExternal, mg resource.Managed) error {
+		_, err := e.Create(context.Background(), mg)
+		return err
+	}
+	update := func(e *accessLevelExternal, mg resource.Managed) error {
+		_, err := e.Update(context.Background(), mg)
+		return err
+	}
+	del := func(e *accessLevelExternal, mg resource.Managed) error {
+		return e.Delete(context.Background(), mg)
+	}
+
+	cases := map[string]struct {
+		reason  string
+		method  string
+		path    string
+		status  int
+		call    func(e *accessLevelExternal, mg resource.Managed) error
+		mg      resource.Managed
+		wantErr error
+	}{
+		"CreateNotAccessLevel": {
+			reason:  "Should return an error if the resource is not an AccessLevel",
+			call:    create,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotAccessLevel),
+		},
+		"CreateSuccessful": {
+			reason: "Should succeed if the resource creation doesn't return an error",
+			method: http.MethodPost,
+			path:   "/v1/accessPolicies/1234567890/accessLevels",
+			status: http.StatusOK,
+			call:   create,
+			mg:     newAccessLevel(),
+		},
+		"CreateAlreadyExists": {
+			reason: "Should not return an error if an earlier creation is still in progress",
+			method: http.MethodPost,
+			path:   "/v1/accessPolicies/1234567890/accessLevels",
+			status: http.StatusConflict,
+			call:   create,
+			mg:     newAccessLevel(),
+		},
+		"CreateFailed": {
+			reason:  "Should fail if the resource creation returns an error",
+			method:  http.MethodPost,
+			path:    "/v1/accessPolicies/1234567890/accessLevels",
+			status:  http.StatusBadRequest,
+			call:    create,
+			mg:      newAccessLevel(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateAccessLevel),
+		},
+		"UpdateSuccessful": {
+			reason: "Should succeed if the resource update doesn't return an error",
+			method: http.MethodPatch,
+			path:   accessLevelPath,
+			status: http.StatusOK,
+			call:   update,
+			mg:     newAccessLevel(),
+		},
+		"UpdateFailed": {
+			reason:  "Should fail if the resource update returns an error",
+			method:  http.MethodPatch,
+			path:    accessLevelPath,
+			status:  http.StatusBadRequest,
+			call:    update,
+			mg:      newAccessLevel(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateAccessLevel),
+		},
+		"DeleteAlreadyGone": {
+			reason: "Should not return an error if the resource is already gone",
+			method: http.MethodDelete,
+			path:   accessLevelPath,
+			status: http.StatusNotFound,
+			call:   del,
+			mg:     newAccessLevel(),
+		},
+		"DeleteFailed": {
+			reason:  "Should fail if the resource deletion returns an error",
+			method:  http.MethodDelete,
+			path:    accessLevelPath,
+			status:  http.StatusBadRequest,
+			call:    del,
+			mg:      newAccessLevel(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteAccessLevel),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.path, r.URL.Path); diff != "" {
+					t.Errorf("path: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := accesscontextmanager.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &accessLevelExternal{levels: s.AccessPolicies.AccessLevels}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
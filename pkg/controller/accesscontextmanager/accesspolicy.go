@@ -0,0 +1,170 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesscontextmanager
+
+import (
+	"context"
+	"time"
+
+	accesscontextmanager "google.golang.org/api/accesscontextmanager/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/accesscontextmanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	acmclient "github.com/crossplane/provider-gcp/pkg/clients/accesscontextmanager"
+)
+
+// Error strings.
+const (
+	errNewClient            = "cannot create new Access Context Manager client"
+	errNotAccessPolicy      = "managed resource is not an AccessPolicy"
+	errGetAccessPolicy      = "cannot get AccessPolicy"
+	errListAccessPolicies   = "cannot list AccessPolicies"
+	errCreateAccessPolicy   = "cannot create AccessPolicy"
+	errUpdateAccessPolicy   = "cannot update AccessPolicy"
+	errDeleteAccessPolicy   = "cannot delete AccessPolicy"
+	errUpdateAccessPolicyCR = "cannot update AccessPolicy custom resource"
+)
+
+// SetupAccessPolicy adds a controller that reconciles AccessPolicies.
+func SetupAccessPolicy(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.AccessPolicyGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.AccessPolicyGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.AccessPolicy{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.AccessPolicyGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.AccessPolicyGroupVersionKind),
+			&accessPolicyConnector{kube: mgr.GetClient()},
+			managed.WithInitializers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type accessPolicyConnector struct {
+	kube client.Client
+}
+
+func (c *accessPolicyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := accesscontextmanager.NewService(ctx, opts.For("accesscontextmanager")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &accessPolicyExternal{kube: c.kube, policies: s.AccessPolicies}, nil
+}
+
+type accessPolicyExternal struct {
+	kube     client.Client
+	policies *accesscontextmanager.AccessPoliciesService
+}
+
+func (e *accessPolicyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.AccessPolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAccessPolicy)
+	}
+	existing, err := e.getAccessPolicy(ctx, cr)
+	if err != nil || existing == nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.Status.AtProvider = acmclient.GenerateAccessPolicyObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: acmclient.IsAccessPolicyUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+// getAccessPolicy returns the policy of the supplied AccessPolicy, or nil if
+// it does not exist. Policy IDs are assigned by Access Context Manager and
+// are not returned until the long-running creation completes, so a policy
+// without an external name is looked up by its title.
+func (e *accessPolicyExternal) getAccessPolicy(ctx context.Context, cr *v1alpha1.AccessPolicy) (*accesscontextmanager.AccessPolicy, error) {
+	if id := meta.GetExternalName(cr); id != "" {
+		ap, err := e.policies.Get(acmclient.GetAccessPolicyName(id)).Context(ctx).Do()
+		if gcp.IsErrorNotFound(err) {
+			return nil, nil
+		}
+		return ap, errors.Wrap(err, errGetAccessPolicy)
+	}
+	var policies []*accesscontextmanager.AccessPolicy
+	err := e.policies.List().Parent(cr.Spec.ForProvider.Parent).Pages(ctx, func(r *accesscontextmanager.ListAccessPoliciesResponse) error {
+		policies = append(policies, r.AccessPolicies...)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errListAccessPolicies)
+	}
+	ap := acmclient.FindAccessPolicy(cr.Spec.ForProvider, policies)
+	if ap == nil {
+		return nil, nil
+	}
+	meta.SetExternalName(cr, acmclient.GetID(ap.Name))
+	return ap, errors.Wrap(e.kube.Update(ctx, cr), errUpdateAccessPolicyCR)
+}
+
+func (e *accessPolicyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.AccessPolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAccessPolicy)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	_, err := e.policies.Create(acmclient.GenerateAccessPolicy(cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateAccessPolicy)
+}
+
+func (e *accessPolicyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.AccessPolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAccessPolicy)
+	}
+	name := acmclient.GetAccessPolicyName(meta.GetExternalName(cr))
+	_, err := e.policies.Patch(name, acmclient.GenerateAccessPolicy(cr.Spec.ForProvider)).UpdateMask(acmclient.AccessPolicyUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAccessPolicy)
+}
+
+func (e *accessPolicyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.AccessPolicy)
+	if !ok {
+		return errors.New(errNotAccessPolicy)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.policies.Delete(acmclient.GetAccessPolicyName(meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteAccessPolicy)
+}
@@ -0,0 +1,306 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesscontextmanager
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	accesscontextmanager "google.golang.org/api/accesscontextmanager/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/accesscontextmanager/v1alpha1"
+)
+
+const (
+	policyID   = "1234567890"
+	policyPath = "/v1/accessPolicies/1234567890"
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newAccessPolicy(m ...func(*v1alpha1.AccessPolicy)) *v1alpha1.AccessPolicy {
+	cr := &v1alpha1.AccessPolicy{}
+	meta.SetExternalName(cr, policyID)
+	cr.Spec.ForProvider = v1alpha1.AccessPolicyParameters{
+		Parent: "organizations/4711",
+		Title:  "Cool policy",
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func accessPolicy(title string) *accesscontextmanager.AccessPolicy {
+	return &accesscontextmanager.AccessPolicy{
+		Name:   "accessPolicies/1234567890",
+		Parent: "organizations/4711",
+		Title:  title,
+	}
+}
+
+func TestAccessPolicyObserve(t *testing.T) {
+	type want struct {
+		e            managed.ExternalObservation
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		kube    *test.MockClient
+		mg      resource.Managed
+		want    want
+	}{
+		"NotAccessPolicy": {
+			reason: "Should return an error if the resource is not an AccessPolicy",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotAccessPolicy)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			mg:     newAccessPolicy(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(policyPath, r.URL.Path); diff != "" {
+					t.Errorf("path: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			want: want{externalName: policyID},
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			mg:     newAccessPolicy(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+			want: want{externalName: policyID, err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetAccessPolicy)},
+		},
+		"FoundByTitle": {
+			reason: "Should adopt the policy with the same title if the external name is not yet known",
+			mg:     newAccessPolicy(func(cr *v1alpha1.AccessPolicy) { meta.SetExternalName(cr, "") }),
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("organizations/4711", r.URL.Query().Get("parent")); diff != "" {
+					t.Errorf("parent: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&accesscontextmanager.ListAccessPoliciesResponse{
+					AccessPolicies: []*accesscontextmanager.AccessPolicy{accessPolicy("Cool policy")},
+				})
+			}),
+			want: want{
+				e:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				externalName: policyID,
+			},
+		},
+		"NotFoundByTitle": {
+			reason: "Should report the policy as missing if no policy has the same title",
+			mg:     newAccessPolicy(func(cr *v1alpha1.AccessPolicy) { meta.SetExternalName(cr, "") }),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&accesscontextmanager.ListAccessPoliciesResponse{
+					AccessPolicies: []*accesscontextmanager.AccessPolicy{accessPolicy("Other policy")},
+				})
+			}),
+		},
+		"UpdateExternalNameFail": {
+			reason: "Should return an error if the external name of an adopted policy can't be stored",
+			mg:     newAccessPolicy(func(cr *v1alpha1.AccessPolicy) { meta.SetExternalName(cr, "") }),
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&accesscontextmanager.ListAccessPoliciesResponse{
+					AccessPolicies: []*accesscontextmanager.AccessPolicy{accessPolicy("Cool policy")},
+				})
+			}),
+			want: want{externalName: policyID, err: errors.Wrap(errBoom, errUpdateAccessPolicyCR)},
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should return upToDate as false if the resource is not up to date",
+			mg:     newAccessPolicy(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(accessPolicy("Old policy"))
+			}),
+			want: want{
+				e:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				externalName: policyID,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := accesscontextmanager.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := accessPolicyExternal{
+				kube:     tc.kube,
+				policies: s.AccessPolicies,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.AccessPolicy); ok {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want external name, +got external name:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestAccessPolicyWrite(t *testing.T) {
+	create := func(e *accessPolicyExternal, mg resource.Managed) error {
+		_, err := e.Create(context.Background(), mg)
+		return err
+	}
+	update := func(e *accessPolicyExternal, mg resource.Managed) error {
+		_, err := e.Update(context.Background(), mg)
+		return err
+	}
+	del := func(e *accessPolicyExternal, mg resource.Managed) error {
+		return e.Delete(context.Background(), mg)
+	}
+
+	cases := map[string]struct {
+		reason  string
+		method  string
+		path    string
+		status  int
+		call    func(e *accessPolicyExternal, mg resource.Managed) error
+		mg      resource.Managed
+		wantErr error
+	}{
+		"CreateNotAccessPolicy": {
+			reason:  "Should return an error if the resource is not an AccessPolicy",
+			call:    create,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotAccessPolicy),
+		},
+		"CreateSuccessful": {
+			reason: "Should succeed if the resource creation doesn't return an error",
+			method: http.MethodPost,
+			path:   "/v1/accessPolicies",
+			status: http.StatusOK,
+			call:   create,
+			mg:     newAccessPolicy(func(cr *v1alpha1.AccessPolicy) { meta.SetExternalName(cr, "") }),
+		},
+		"CreateFailed": {
+			reason:  "Should fail if the resource creation returns an error",
+			method:  http.MethodPost,
+			path:    "/v1/accessPolicies",
+			status:  http.StatusBadRequest,
+			call:    create,
+			mg:      newAccessPolicy(func(cr *v1alpha1.AccessPolicy) { meta.SetExternalName(cr, "") }),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateAccessPolicy),
+		},
+		"UpdateSuccessful": {
+			reason: "Should succeed if the resource update doesn't return an error",
+			method: http.MethodPatch,
+			path:   policyPath,
+			status: http.StatusOK,
+			call:   update,
+			mg:     newAccessPolicy(),
+		},
+		"UpdateFailed": {
+			reason:  "Should fail if the resource update returns an error",
+			method:  http.MethodPatch,
+			path:    policyPath,
+			status:  http.StatusBadRequest,
+			call:    update,
+			mg:      newAccessPolicy(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateAccessPolicy),
+		},
+		"DeleteAlreadyGone": {
+			reason: "Should not return an error if the resource is already gone",
+			method: http.MethodDelete,
+			path:   policyPath,
+			status: http.StatusNotFound,
+			call:   del,
+			mg:     newAccessPolicy(),
+		},
+		"DeleteFailed": {
+			reason:  "Should fail if the resource deletion returns an error",
+			method:  http.MethodDelete,
+			path:    policyPath,
+			status:  http.StatusBadRequest,
+			call:    del,
+			mg:      newAccessPolicy(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteAccessPolicy),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.path, r.URL.Path); diff != "" {
+					t.Errorf("path: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := accesscontextmanager.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &accessPolicyExternal{policies: s.AccessPolicies}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesscontextmanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	accesscontextmanager "google.golang.org/api/accesscontextmanager/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/accesscontextmanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	acmclient "github.com/crossplane/provider-gcp/pkg/clients/accesscontextmanager"
+)
+
+// Error strings.
+const (
+	errNotServicePerimeter      = "managed resource is not a ServicePerimeter"
+	errGetServicePerimeter      = "cannot get ServicePerimeter"
+	errCreateServicePerimeter   = "cannot create ServicePerimeter"
+	errUpdateServicePerimeter   = "cannot update ServicePerimeter"
+	errDeleteServicePerimeter   = "cannot delete ServicePerimeter"
+	errUpdateServicePerimeterCR = "cannot update ServicePerimeter custom resource"
+)
+
+// SetupServicePerimeter adds a controller that reconciles ServicePerimeters.
+func SetupServicePerimeter(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.ServicePerimeterGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.ServicePerimeterGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.ServicePerimeter{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.ServicePerimeterGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ServicePerimeterGroupVersionKind),
+			&servicePerimeterConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type servicePerimeterConnector struct {
+	kube client.Client
+}
+
+func (c *servicePerimeterConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := accesscontextmanager.NewService(ctx, opts.For("accesscontextmanager")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &servicePerimeterExternal{kube: c.kube, perimeters: s.AccessPolicies.ServicePerimeters}, nil
+}
+
+type servicePerimeterExternal struct {
+	kube       client.Client
+	perimeters *accesscontextmanager.AccessPoliciesServicePerimetersService
+}
+
+func (e *servicePerimeterExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ServicePerimeter)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotServicePerimeter)
+	}
+	l, err := e.perimeters.Get(servicePerimeterName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetServicePerimeter)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	acmclient.LateInitializeServicePerimeter(&cr.Spec.ForProvider, *l)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateServicePerimeterCR)
+		}
+	}
+	cr.Status.AtProvider = acmclient.GenerateServicePerimeterObservation(*l)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: acmclient.IsServicePerimeterUpToDate(cr.Spec.ForProvider, *l),
+	}, nil
+}
+
+// Like access levels, service perimeters are created by a long-running
+// operation, so a create that races an earlier one is not an error.
+func (e *servicePerimeterExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ServicePerimeter)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotServicePerimeter)
+	}
+	cr.SetConditions(xpv1.Creating())
+	parent := acmclient.GetAccessPolicyName(gcp.StringValue(cr.Spec.ForProvider.AccessPolicy))
+	_, err := e.perimeters.Create(parent, acmclient.GenerateServicePerimeter(servicePerimeterName(cr), cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(resource.Ignore(gcp.IsErrorAlreadyExists, err), errCreateServicePerimeter)
+}
+
+func (e *servicePerimeterExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ServicePerimeter)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotServicePerimeter)
+	}
+	name := servicePerimeterName(cr)
+	_, err := e.perimeters.Patch(name, acmclient.GenerateServicePerimeter(name, cr.Spec.ForProvider)).UpdateMask(acmclient.ServicePerimeterUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateServicePerimeter)
+}
+
+func (e *servicePerimeterExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ServicePerimeter)
+	if !ok {
+		return errors.New(errNotServicePerimeter)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.perimeters.Delete(servicePerimeterName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteServicePerimeter)
+}
+
+func servicePerimeterName(cr *v1alpha1.ServicePerimeter) string {
+	return acmclient.GetServicePerimeterName(gcp.StringValue(cr.Spec.ForProvider.AccessPolicy), meta.GetExternalName(cr))
+}
@@ -0,0 +1,277 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesscontextmanager
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	accesscontextmanager "google.golang.org/api/accesscontextmanager/v1"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/accesscontextmanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const servicePerimeterPath = "/v1/accessPolicies/1234567890/servicePerimeters/team_a"
+
+func newServicePerimeter(m ...func(*v1alpha1.ServicePerimeter)) *v1alpha1.ServicePerimeter {
+	cr := &v1alpha1.ServicePerimeter{}
+	meta.SetExternalName(cr, "team_a")
+	cr.Spec.ForProvider = v1alpha1.ServicePerimeterParameters{
+		AccessPolicy:  gcp.StringPtr(policyID),
+		Title:         "Team A",
+		Description:   gcp.StringPtr("Projects of team A"),
+		PerimeterType: gcp.StringPtr(v1alpha1.PerimeterTypeRegular),
+		Status: &v1alpha1.ServicePerimeterConfig{
+			Resources:          []string{"projects/111"},
+			RestrictedServices: []string{"storage.googleapis.com"},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func servicePerimeter(service string) *accesscontextmanager.ServicePerimeter {
+	return &accesscontextmanager.ServicePerimeter{
+		Name:          "accessPolicies/1234567890/servicePerimeters/team_a",
+		Title:         "Team A",
+		Description:   "Projects of team A",
+		PerimeterType: "PERIMETER_TYPE_REGULAR",
+		Status: &accesscontextmanager.ServicePerimeterConfig{
+			Resources:          []string{"projects/111"},
+			RestrictedServices: []string{service},
+		},
+	}
+}
+
+func TestServicePerimeterObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		kube    *test.MockClient
+		mg      resource.Managed
+		want    want
+	}{
+		"NotServicePerimeter": {
+			reason: "Should return an error if the resource is not a ServicePerimeter",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotServicePerimeter)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			mg:     newServicePerimeter(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(servicePerimeterPath, r.URL.Path); diff != "" {
+					t.Errorf("path: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			mg:     newServicePerimeter(),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetServicePerimeter)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if the late initialization update fails",
+			mg:     newServicePerimeter(func(cr *v1alpha1.ServicePerimeter) { cr.Spec.ForProvider.PerimeterType = nil }),
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			want:   want{err: errors.Wrap(errBoom, errUpdateServicePerimeterCR)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(servicePerimeter("storage.googleapis.com"))
+			}),
+		},
+		"ResourceUpToDate": {
+			reason: "Should return upToDate as true if the resource is up to date",
+			mg:     newServicePerimeter(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(servicePerimeter("storage.googleapis.com"))
+			}),
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should return upToDate as false if the resource is not up to date",
+			mg:     newServicePerimeter(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(servicePerimeter("bigquery.googleapis.com"))
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := accesscontextmanager.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := servicePerimeterExternal{
+				kube:       tc.kube,
+				perimeters: s.AccessPolicies.ServicePerimeters,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestServicePerimeterWrite(t *testing.T) {
+	create := func(e *servicePerimeterExternal, mg resource.Managed) error {
+		_, err := e.Create(context.Background(), mg)
+		return err
+	}
+	update := func(e *servicePerimeterExternal, mg resource.Managed) error {
+		_, err := e.Update(context.Background(), mg)
+		return err
+	}
+	del := func(e *servicePerimeterExternal, mg resource.Managed) error {
+		return e.Delete(context.Background(), mg)
+	}
+
+	cases := map[string]struct {
+		reason  string
+		method  string
+		path    string
+		status  int
+		call    func(e *servicePerimeterExternal, mg resource.Managed) error
+		mg      resource.Managed
+		wantErr error
+	}{
+		"CreateNotServicePerimeter": {
+			reason:  "Should return an error if the resource is not a ServicePerimeter",
+			call:    create,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotServicePerimeter),
+		},
+		"CreateSuccessful": {
+			reason: "Should succeed if the resource creation doesn't return an error",
+			method: http.MethodPost,
+			path:   "/v1/accessPolicies/1234567890/servicePerimeters",
+			status: http.StatusOK,
+			call:   create,
+			mg:     newServicePerimeter(),
+		},
+		"CreateAlreadyExists": {
+			reason: "Should not return an error if an earlier creation is still in progress",
+			method: http.MethodPost,
+			path:   "/v1/accessPolicies/1234567890/servicePerimeters",
+			status: http.StatusConflict,
+			call:   create,
+			mg:     newServicePerimeter(),
+		},
+		"CreateFailed": {
+			reason:  "Should fail if the resource creation returns an error",
+			method:  http.MethodPost,
+			path:    "/v1/accessPolicies/1234567890/servicePerimeters",
+			status:  http.StatusBadRequest,
+			call:    create,
+			mg:      newServicePerimeter(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateServicePerimeter),
+		},
+		"UpdateSuccessful": {
+			reason: "Should succeed if the resource update doesn't return an error",
+			method: http.MethodPatch,
+			path:   servicePerimeterPath,
+			status: http.StatusOK,
+			call:   update,
+			mg:     newServicePerimeter(),
+		},
+		"UpdateFailed": {
+			reason:  "Should fail if the resource update returns an error",
+			method:  http.MethodPatch,
+			path:    servicePerimeterPath,
+			status:  http.StatusBadRequest,
+			call:    update,
+			mg:      newServicePerimeter(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateServicePerimeter),
+		},
+		"DeleteAlreadyGone": {
+			reason: "Should not return an error if the resource is already gone",
+			method: http.MethodDelete,
+			path:   servicePerimeterPath,
+			status: http.StatusNotFound,
+			call:   del,
+			mg:     newServicePerimeter(),
+		},
+		"DeleteFailed": {
+			reason:  "Should fail if the resource deletion returns an error",
+			method:  http.MethodDelete,
+			path:    servicePerimeterPath,
+			status:  http.StatusBadRequest,
+			call:    del,
+			mg:      newServicePerimeter(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteServicePerimeter),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.path, r.URL.Path); diff != "" {
+					t.Errorf("path: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := accesscontextmanager.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &servicePerimeterExternal{perimeters: s.AccessPolicies.ServicePerimeters}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alloydb
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	alloydb "google.golang.org/api/alloydb/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/password"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/alloydb/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	alloydbclient "github.com/crossplane/provider-gcp/pkg/clients/alloydb"
+)
+
+// Error strings.
+const (
+	errNewClient        = "cannot create new AlloyDB client"
+	errNotCluster       = "managed resource is not an AlloyDB Cluster"
+	errGetCluster       = "cannot get AlloyDB Cluster"
+	errCreateCluster    = "cannot create AlloyDB Cluster"
+	errUpdateCluster    = "cannot update AlloyDB Cluster"
+	errDeleteCluster    = "cannot delete AlloyDB Cluster"
+	errUpdateClusterCR  = "cannot update AlloyDB Cluster custom resource"
+	errGeneratePassword = "cannot generate initial user password"
+)
+
+// SetupCluster adds a controller that reconciles AlloyDB Clusters.
+func SetupCluster(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.ClusterGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.ClusterGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Cluster{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.ClusterGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ClusterGroupVersionKind),
+			&clusterConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type clusterConnector struct {
+	kube client.Client
+}
+
+func (c *clusterConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := alloydb.NewService(ctx, opts.For("alloydb")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &clusterExternal{kube: c.kube, clusters: s.Projects.Locations.Clusters, projectID: projectID}, nil
+}
+
+type clusterExternal struct {
+	kube      client.Client
+	clusters  *alloydb.ProjectsLocationsClustersService
+	projectID string
+}
+
+func (e *clusterExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Cluster)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCluster)
+	}
+	c, err := e.clusters.Get(alloydbclient.GetClusterName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetCluster)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	alloydbclient.LateInitializeCluster(&cr.Spec.ForProvider, *c)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateClusterCR)
+		}
+	}
+	cr.Status.AtProvider = alloydbclient.GenerateClusterObservation(*c)
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.ClusterStateReady:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1alpha1.ClusterStateCreating, v1alpha1.ClusterStateBootstrapping:
+		cr.Status.SetConditions(xpv1.Creating())
+	case v1alpha1.ClusterStateDeleting:
+		cr.Status.SetConditions(xpv1.Deleting())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: alloydbclient.IsClusterUpToDate(cr.Spec.ForProvider, *c),
+		ConnectionDetails: managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretUserKey: []byte(initialUser(cr.Spec.ForProvider)),
+		},
+	}, nil
+}
+
+func (e *clusterExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Cluster)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCluster)
+	}
+	cr.SetConditions(xpv1.Creating())
+	pw, err := password.Generate()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGeneratePassword)
+	}
+	c := alloydbclient.GenerateCluster(alloydbclient.GetClusterName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr)), cr.Spec.ForProvider)
+	c.InitialUser = &alloydb.UserPassword{User: initialUser(cr.Spec.ForProvider), Password: pw}
+	if _, err := e.clusters.Create(alloydbclient.GetClusterParent(e.projectID, cr.Spec.ForProvider), c).ClusterId(meta.GetExternalName(cr)).RequestId(gcp.CreateRequestID(cr)).Context(ctx).Do(); err != nil {
+		// We don't want to publish the generated password if we didn't
+		// actually create a new cluster.
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateCluster)
+	}
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretUserKey:     []byte(initialUser(cr.Spec.ForProvider)),
+			xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+		},
+	}, nil
+}
+
+func (e *clusterExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Cluster)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCluster)
+	}
+	// AlloyDB rejects updates while a cluster operation is in progress.
+	if cr.Status.AtProvider.Reconciling {
+		return managed.ExternalUpdate{}, nil
+	}
+	name := alloydbclient.GetClusterName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+	_, err := e.clusters.Patch(name, alloydbclient.GenerateCluster(name, cr.Spec.ForProvider)).UpdateMask(alloydbclient.ClusterUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCluster)
+}
+
+func (e *clusterExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Cluster)
+	if !ok {
+		return errors.New(errNotCluster)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.clusters.Delete(alloydbclient.GetClusterName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteCluster)
+}
+
+func initialUser(p v1alpha1.ClusterParameters) string {
+	if p.InitialUser != nil {
+		return *p.InitialUser
+	}
+	return v1alpha1.DefaultInitialUser
+}
@@ -0,0 +1,446 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alloydb
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	alloydb "google.golang.org/api/alloydb/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/alloydb/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID = "myproject-id-1234"
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+type clusterOption func(*v1alpha1.Cluster)
+
+func newCluster(opts ...clusterOption) *v1alpha1.Cluster {
+	c := &v1alpha1.Cluster{}
+	c.Spec.ForProvider.Location = "us-central1"
+	for _, f := range opts {
+		f(c)
+	}
+	return c
+}
+
+func withDisplayName(s string) clusterOption {
+	return func(c *v1alpha1.Cluster) {
+		c.Spec.ForProvider.DisplayName = gcp.StringPtr(s)
+	}
+}
+
+func withReconciling() clusterOption {
+	return func(c *v1alpha1.Cluster) {
+		c.Status.AtProvider.Reconciling = true
+	}
+}
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func TestClusterObserve(t *testing.T) {
+	type args struct {
+		mg resource.Managed
+	}
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		kube    client.Client
+		args    args
+		want    want
+	}{
+		"NotCluster": {
+			reason: "Should return an error if the resource is not a Cluster",
+			args: args{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errNotCluster),
+			},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			args: args{
+				mg: newCluster(),
+			},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&alloydb.Cluster{})
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			args: args{
+				mg: newCluster(),
+			},
+			want: want{
+				err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetCluster),
+			},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(&alloydb.Cluster{})
+			}),
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if the late initialization update fails",
+			args: args{
+				mg: newCluster(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateClusterCR),
+			},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&alloydb.Cluster{DisplayName: "late"})
+			}),
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(errBoom),
+			},
+		},
+		"ResourceUpToDate": {
+			reason: "Should return upToDate as true if the resource is up to date",
+			args: args{
+				mg: newCluster(withDisplayName("mine")),
+			},
+			want: want{
+				e: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretUserKey: []byte(v1alpha1.DefaultInitialUser),
+					},
+				},
+			},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&alloydb.Cluster{DisplayName: "mine", State: v1alpha1.ClusterStateReady})
+			}),
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should return upToDate as false if the resource is not up to date",
+			args: args{
+				mg: newCluster(withDisplayName("mine")),
+			},
+			want: want{
+				e: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretUserKey: []byte(v1alpha1.DefaultInitialUser),
+					},
+				},
+			},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&alloydb.Cluster{DisplayName: "theirs", State: v1alpha1.ClusterStateReady})
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := alloydb.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := clusterExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				clusters:  s.Projects.Locations.Clusters,
+			}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestClusterCreate(t *testing.T) {
+	type args struct {
+		mg resource.Managed
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		args    args
+		want    want
+	}{
+		"NotCluster": {
+			reason: "Should return an error if the resource is not a Cluster",
+			args: args{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errNotCluster),
+			},
+		},
+		"Successful": {
+			reason: "Should succeed if the resource creation doesn't return an error",
+			args: args{
+				mg: newCluster(),
+			},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&alloydb.Operation{})
+			}),
+		},
+		"Failed": {
+			reason: "Should fail if the resource creation returns an error",
+			args: args{
+				mg: newCluster(),
+			},
+			want: want{
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateCluster),
+			},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&alloydb.Operation{})
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := alloydb.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := clusterExternal{
+				projectID: projectID,
+				clusters:  s.Projects.Locations.Clusters,
+			}
+			got, err := e.Create(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if err == nil && len(got.ConnectionDetails[xpv1.ResourceCredentialsSecretPasswordKey]) == 0 {
+				t.Errorf("\n%s\nCreate(...): expected a generated password in the connection details", tc.reason)
+			}
+		})
+	}
+}
+
+func TestClusterUpdate(t *testing.T) {
+	type args struct {
+		mg resource.Managed
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		args    args
+		want    want
+	}{
+		"NotCluster": {
+			reason: "Should return an error if the resource is not a Cluster",
+			args: args{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errNotCluster),
+			},
+		},
+		"Reconciling": {
+			reason: "Should not call the API while an operation is in progress",
+			args: args{
+				mg: newCluster(withReconciling()),
+			},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected %s request", r.Method)
+			}),
+		},
+		"Successful": {
+			reason: "Should succeed if the resource update doesn't return an error",
+			args: args{
+				mg: newCluster(),
+			},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&alloydb.Operation{})
+			}),
+		},
+		"Failed": {
+			reason: "Should fail if the resource update returns an error",
+			args: args{
+				mg: newCluster(),
+			},
+			want: want{
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateCluster),
+			},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&alloydb.Operation{})
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := alloydb.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := clusterExternal{
+				projectID: projectID,
+				clusters:  s.Projects.Locations.Clusters,
+			}
+			_, err := e.Update(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestClusterDelete(t *testing.T) {
+	type args struct {
+		mg resource.Managed
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		args    args
+		want    want
+	}{
+		"NotCluster": {
+			reason: "Should return an error if the resource is not a Cluster",
+			args: args{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errNotCluster),
+			},
+		},
+		"Successful": {
+			reason: "Should succeed if the resource deletion doesn't return an error",
+			args: args{
+				mg: newCluster(),
+			},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&alloydb.Operation{})
+			}),
+		},
+		"AlreadyGone": {
+			reason: "Should not return an error if the resource is already gone",
+			args: args{
+				mg: newCluster(),
+			},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&alloydb.Operation{})
+			}),
+		},
+		"Failed": {
+			reason: "Should fail if the resource deletion returns an error",
+			args: args{
+				mg: newCluster(),
+			},
+			want: want{
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteCluster),
+			},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&alloydb.Operation{})
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := alloydb.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := clusterExternal{
+				projectID: projectID,
+				clusters:  s.Projects.Locations.Clusters,
+			}
+			err := e.Delete(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alloydb
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	alloydb "google.golang.org/api/alloydb/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/alloydb/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	alloydbclient "github.com/crossplane/provider-gcp/pkg/clients/alloydb"
+)
+
+// Error strings.
+const (
+	errNotInstance      = "managed resource is not an AlloyDB Instance"
+	errGetInstance      = "cannot get AlloyDB Instance"
+	errCreateInstance   = "cannot create AlloyDB Instance"
+	errUpdateInstance   = "cannot update AlloyDB Instance"
+	errDeleteInstance   = "cannot delete AlloyDB Instance"
+	errUpdateInstanceCR = "cannot update AlloyDB Instance custom resource"
+)
+
+// SetupInstance adds a controller that reconciles AlloyDB Instances.
+func SetupInstance(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.InstanceGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.InstanceGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Instance{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.InstanceGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.InstanceGroupVersionKind),
+			&instanceConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type instanceConnector struct {
+	kube client.Client
+}
+
+func (c *instanceConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := alloydb.NewService(ctx, opts.For("alloydb")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &instanceExternal{kube: c.kube, instances: s.Projects.Locations.Clusters.Instances}, nil
+}
+
+type instanceExternal struct {
+	kube      client.Client
+	instances *alloydb.ProjectsLocationsClustersInstancesService
+}
+
+func (e *instanceExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Instance)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotInstance)
+	}
+	i, err := e.instances.Get(alloydbclient.GetInstanceName(cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetInstance)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	alloydbclient.LateInitializeInstance(&cr.Spec.ForProvider, *i)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateInstanceCR)
+		}
+	}
+	cr.Status.AtProvider = alloydbclient.GenerateInstanceObservation(*i)
+	conn := managed.ConnectionDetails{}
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.InstanceStateReady:
+		cr.Status.SetConditions(xpv1.Available())
+		conn[xpv1.ResourceCredentialsSecretEndpointKey] = []byte(cr.Status.AtProvider.IPAddress)
+		conn[xpv1.ResourceCredentialsSecretPortKey] = []byte(v1alpha1.PostgreSQLPort)
+	case v1alpha1.InstanceStateCreating, v1alpha1.InstanceStateBootstrapping:
+		cr.Status.SetConditions(xpv1.Creating())
+	case v1alpha1.InstanceStateDeleting:
+		cr.Status.SetConditions(xpv1.Deleting())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  alloydbclient.IsInstanceUpToDate(cr.Spec.ForProvider, *i),
+		ConnectionDetails: conn,
+	}, nil
+}
+
+func (e *instanceExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Instance)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotInstance)
+	}
+	cr.SetConditions(xpv1.Creating())
+	i := alloydbclient.GenerateInstance(alloydbclient.GetInstanceName(cr.Spec.ForProvider, meta.GetExternalName(cr)), cr.Spec.ForProvider)
+	_, err := e.instances.Create(gcp.StringValue(cr.Spec.ForProvider.Cluster), i).InstanceId(meta.GetExternalName(cr)).RequestId(gcp.CreateRequestID(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateInstance)
+}
+
+func (e *instanceExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Instance)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotInstance)
+	}
+	// AlloyDB rejects updates while an instance operation is in progress.
+	if cr.Status.AtProvider.Reconciling {
+		return managed.ExternalUpdate{}, nil
+	}
+	name := alloydbclient.GetInstanceName(cr.Spec.ForProvider, meta.GetExternalName(cr))
+	_, err := e.instances.Patch(name, alloydbclient.GenerateInstance(name, cr.Spec.ForProvider)).UpdateMask(alloydbclient.InstanceUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateInstance)
+}
+
+func (e *instanceExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Instance)
+	if !ok {
+		return errors.New(errNotInstance)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.instances.Delete(alloydbclient.GetInstanceName(cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteInstance)
+}
@@ -0,0 +1,399 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alloydb
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	alloydb "google.golang.org/api/alloydb/v1"
+	"google.golang.org/api/option"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/alloydb/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+type instanceOption func(*v1alpha1.Instance)
+
+func newInstance(opts ...instanceOption) *v1alpha1.Instance {
+	i := &v1alpha1.Instance{}
+	i.Spec.ForProvider.Cluster = gcp.StringPtr("projects/p/locations/l/clusters/c")
+	i.Spec.ForProvider.InstanceType = v1alpha1.InstanceTypePrimary
+	for _, f := range opts {
+		f(i)
+	}
+	return i
+}
+
+func withInstanceDisplayName(s string) instanceOption {
+	return func(i *v1alpha1.Instance) {
+		i.Spec.ForProvider.DisplayName = gcp.StringPtr(s)
+	}
+}
+
+func TestInstanceObserve(t *testing.T) {
+	type args struct {
+		mg resource.Managed
+	}
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		kube    client.Client
+		args    args
+		want    want
+	}{
+		"NotInstance": {
+			reason: "Should return an error if the resource is not an Instance",
+			args: args{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errNotInstance),
+			},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			args: args{
+				mg: newInstance(),
+			},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&alloydb.Instance{})
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			args: args{
+				mg: newInstance(),
+			},
+			want: want{
+				err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetInstance),
+			},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(&alloydb.Instance{})
+			}),
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if the late initialization update fails",
+			args: args{
+				mg: newInstance(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateInstanceCR),
+			},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&alloydb.Instance{GceZone: "us-central1-a"})
+			}),
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(errBoom),
+			},
+		},
+		"Ready": {
+			reason: "Should publish the endpoint when the instance is ready",
+			args: args{
+				mg: newInstance(withInstanceDisplayName("primary")),
+			},
+			want: want{
+				e: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte("10.0.0.2"),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte(v1alpha1.PostgreSQLPort),
+					},
+				},
+			},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&alloydb.Instance{
+					DisplayName: "primary",
+					State:       v1alpha1.InstanceStateReady,
+					IpAddress:   "10.0.0.2",
+				})
+			}),
+		},
+		"Creating": {
+			reason: "Should not publish the endpoint before the instance is ready",
+			args: args{
+				mg: newInstance(withInstanceDisplayName("primary")),
+			},
+			want: want{
+				e: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&alloydb.Instance{
+					DisplayName: "other",
+					State:       v1alpha1.InstanceStateCreating,
+				})
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := alloydb.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := instanceExternal{
+				kube:      tc.kube,
+				instances: s.Projects.Locations.Clusters.Instances,
+			}
+			got, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestInstanceCreate(t *testing.T) {
+	type args struct {
+		mg resource.Managed
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		args    args
+		want    want
+	}{
+		"NotInstance": {
+			reason: "Should return an error if the resource is not an Instance",
+			args: args{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errNotInstance),
+			},
+		},
+		"Successful": {
+			reason: "Should succeed if the resource creation doesn't return an error",
+			args: args{
+				mg: newInstance(),
+			},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&alloydb.Operation{})
+			}),
+		},
+		"Failed": {
+			reason: "Should fail if the resource creation returns an error",
+			args: args{
+				mg: newInstance(),
+			},
+			want: want{
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateInstance),
+			},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&alloydb.Operation{})
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := alloydb.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := instanceExternal{
+				instances: s.Projects.Locations.Clusters.Instances,
+			}
+			_, err := e.Create(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestInstanceUpdate(t *testing.T) {
+	type args struct {
+		mg resource.Managed
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		args    args
+		want    want
+	}{
+		"NotInstance": {
+			reason: "Should return an error if the resource is not an Instance",
+			args: args{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errNotInstance),
+			},
+		},
+		"Successful": {
+			reason: "Should succeed if the resource update doesn't return an error",
+			args: args{
+				mg: newInstance(),
+			},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&alloydb.Operation{})
+			}),
+		},
+		"Failed": {
+			reason: "Should fail if the resource update returns an error",
+			args: args{
+				mg: newInstance(),
+			},
+			want: want{
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateInstance),
+			},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&alloydb.Operation{})
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := alloydb.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := instanceExternal{
+				instances: s.Projects.Locations.Clusters.Instances,
+			}
+			_, err := e.Update(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestInstanceDelete(t *testing.T) {
+	type args struct {
+		mg resource.Managed
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		args    args
+		want    want
+	}{
+		"NotInstance": {
+			reason: "Should return an error if the resource is not an Instance",
+			args: args{
+				mg: unexpectedObject,
+			},
+			want: want{
+				err: errors.New(errNotInstance),
+			},
+		},
+		"Successful": {
+			reason: "Should succeed if the resource deletion doesn't return an error",
+			args: args{
+				mg: newInstance(),
+			},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&alloydb.Operation{})
+			}),
+		},
+		"AlreadyGone": {
+			reason: "Should not return an error if the resource is already gone",
+			args: args{
+				mg: newInstance(),
+			},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&alloydb.Operation{})
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := alloydb.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := instanceExternal{
+				instances: s.Projects.Locations.Clusters.Instances,
+			}
+			err := e.Delete(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
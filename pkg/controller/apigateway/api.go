@@ -0,0 +1,165 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	apigateway "google.golang.org/api/apigateway/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/apigateway/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	apigatewayclient "github.com/crossplane/provider-gcp/pkg/clients/apigateway"
+)
+
+// Error strings.
+const (
+	errNewClient   = "cannot create new API Gateway client"
+	errNotAPI      = "managed resource is not an API Gateway API"
+	errGetAPI      = "cannot get API Gateway API"
+	errCreateAPI   = "cannot create API Gateway API"
+	errUpdateAPI   = "cannot update API Gateway API"
+	errDeleteAPI   = "cannot delete API Gateway API"
+	errUpdateAPICR = "cannot update API Gateway API custom resource"
+)
+
+// SetupAPI adds a controller that reconciles API Gateway APIs.
+func SetupAPI(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.APIGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.APIGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.API{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.APIGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.APIGroupVersionKind),
+			&apiConnector{kube: mgr.GetClient()},
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type apiConnector struct {
+	kube client.Client
+}
+
+func (c *apiConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := apigateway.NewService(ctx, opts.For("apigateway")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &apiExternal{kube: c.kube, apis: s.Projects.Locations.Apis, projectID: projectID}, nil
+}
+
+type apiExternal struct {
+	kube      client.Client
+	apis      *apigateway.ProjectsLocationsApisService
+	projectID string
+}
+
+func (e *apiExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.API)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAPI)
+	}
+	existing, err := e.apis.Get(apigatewayclient.GetAPIName(e.projectID, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetAPI)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	apigatewayclient.LateInitializeAPI(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateAPICR)
+		}
+	}
+	cr.Status.AtProvider = apigatewayclient.GenerateAPIObservation(*existing)
+	setConditions(cr, cr.Status.AtProvider.State)
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: apigatewayclient.IsAPIUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *apiExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.API)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAPI)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	a := apigatewayclient.GenerateAPI(apigatewayclient.GetAPIName(e.projectID, meta.GetExternalName(cr)), cr.Spec.ForProvider)
+	_, err := e.apis.Create(apigatewayclient.GetAPIParent(e.projectID), a).ApiId(meta.GetExternalName(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateAPI)
+}
+
+func (e *apiExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.API)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAPI)
+	}
+	fqn := apigatewayclient.GetAPIName(e.projectID, meta.GetExternalName(cr))
+	_, err := e.apis.Patch(fqn, apigatewayclient.GenerateAPI(fqn, cr.Spec.ForProvider)).UpdateMask(apigatewayclient.APIUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAPI)
+}
+
+func (e *apiExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.API)
+	if !ok {
+		return errors.New(errNotAPI)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.apis.Delete(apigatewayclient.GetAPIName(e.projectID, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteAPI)
+}
+
+// setConditions sets the conditions of the supplied API Gateway resource
+// according to the supplied state.
+func setConditions(cr resource.Managed, state string) {
+	switch state {
+	case v1alpha1.StateActive, v1alpha1.StateUpdating:
+		cr.SetConditions(xpv1.Available())
+	case v1alpha1.StateCreating, v1alpha1.StateActivating:
+		cr.SetConditions(xpv1.Creating())
+	case v1alpha1.StateDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+}
@@ -0,0 +1,259 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apigateway "google.golang.org/api/apigateway/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/apigateway/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID = "myproject-id-1234"
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newAPI() *v1alpha1.API {
+	a := &v1alpha1.API{}
+	meta.SetExternalName(a, "my-api")
+	a.Spec.ForProvider = v1alpha1.APIParameters{
+		DisplayName: gcp.StringPtr("My API"),
+	}
+	return a
+}
+
+func TestAPIObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotAPI": {
+			reason: "Should return an error if the resource is not an API",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotAPI)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			mg:     newAPI(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			mg:     newAPI(),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetAPI)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(&apigateway.ApigatewayApi{})
+			}),
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if the late initialization update fails",
+			mg:     newAPI(),
+			want:   want{err: errors.Wrap(errBoom, errUpdateAPICR)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&apigateway.ApigatewayApi{ManagedService: "my-api.apigateway.myproject-id-1234.cloud.goog"})
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should return upToDate as true if the resource is up to date",
+			mg:     newAPI(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&apigateway.ApigatewayApi{DisplayName: "My API"})
+			}),
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should return upToDate as false if the resource is not up to date",
+			mg:     newAPI(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&apigateway.ApigatewayApi{DisplayName: "Your API"})
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := apigateway.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := apiExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				apis:      s.Projects.Locations.Apis,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func createAPI(e *apiExternal, mg resource.Managed) error {
+	_, err := e.Create(context.Background(), mg)
+	return err
+}
+
+func updateAPI(e *apiExternal, mg resource.Managed) error {
+	_, err := e.Update(context.Background(), mg)
+	return err
+}
+
+func deleteAPI(e *apiExternal, mg resource.Managed) error {
+	return e.Delete(context.Background(), mg)
+}
+
+func TestAPICreateUpdateDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		method  string
+		status  int
+		call    func(e *apiExternal, mg resource.Managed) error
+		mg      resource.Managed
+		wantErr error
+	}{
+		"CreateNotAPI": {
+			reason:  "Should return an error if the resource is not an API",
+			call:    createAPI,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotAPI),
+		},
+		"CreateSuccessful": {
+			reason: "Should succeed if the resource creation doesn't return an error",
+			method: http.MethodPost,
+			status: http.StatusOK,
+			call:   createAPI,
+			mg:     newAPI(),
+		},
+		"CreateFailed": {
+			reason:  "Should fail if the resource creation returns an error",
+			method:  http.MethodPost,
+			status:  http.StatusBadRequest,
+			call:    createAPI,
+			mg:      newAPI(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateAPI),
+		},
+		"UpdateSuccessful": {
+			reason: "Should succeed if the resource update doesn't return an error",
+			method: http.MethodPatch,
+			status: http.StatusOK,
+			call:   updateAPI,
+			mg:     newAPI(),
+		},
+		"UpdateFailed": {
+			reason:  "Should fail if the resource update returns an error",
+			method:  http.MethodPatch,
+			status:  http.StatusBadRequest,
+			call:    updateAPI,
+			mg:      newAPI(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateAPI),
+		},
+		"DeleteAlreadyGone": {
+			reason: "Should not return an error if the resource is already gone",
+			method: http.MethodDelete,
+			status: http.StatusNotFound,
+			call:   deleteAPI,
+			mg:     newAPI(),
+		},
+		"DeleteFailed": {
+			reason:  "Should fail if the resource deletion returns an error",
+			method:  http.MethodDelete,
+			status:  http.StatusBadRequest,
+			call:    deleteAPI,
+			mg:      newAPI(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteAPI),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&apigateway.ApigatewayOperation{})
+			}))
+			defer server.Close()
+			s, _ := apigateway.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &apiExternal{
+				projectID: projectID,
+				apis:      s.Projects.Locations.Apis,
+			}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	apigateway "google.golang.org/api/apigateway/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/apigateway/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	apigatewayclient "github.com/crossplane/provider-gcp/pkg/clients/apigateway"
+)
+
+// Error strings.
+const (
+	errNotAPIConfig      = "managed resource is not an API Gateway APIConfig"
+	errGetAPIConfig      = "cannot get API Gateway APIConfig"
+	errCreateAPIConfig   = "cannot create API Gateway APIConfig"
+	errUpdateAPIConfig   = "cannot update API Gateway APIConfig"
+	errDeleteAPIConfig   = "cannot delete API Gateway APIConfig"
+	errUpdateAPIConfigCR = "cannot update API Gateway APIConfig custom resource"
+	errGetDocuments      = "cannot get API Gateway APIConfig OpenAPI documents"
+)
+
+// SetupAPIConfig adds a controller that reconciles API Gateway APIConfigs.
+func SetupAPIConfig(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.APIConfigGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.APIConfigGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.APIConfig{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.APIConfigGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.APIConfigGroupVersionKind),
+			&apiConfigConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type apiConfigConnector struct {
+	kube client.Client
+}
+
+func (c *apiConfigConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := apigateway.NewService(ctx, opts.For("apigateway")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &apiConfigExternal{kube: c.kube, configs: s.Projects.Locations.Apis.Configs, projectID: projectID}, nil
+}
+
+type apiConfigExternal struct {
+	kube      client.Client
+	configs   *apigateway.ProjectsLocationsApisConfigsService
+	projectID string
+}
+
+func (e *apiConfigExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.APIConfig)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAPIConfig)
+	}
+	existing, err := e.configs.Get(apigatewayclient.GetAPIConfigName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetAPIConfig)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	apigatewayclient.LateInitializeAPIConfig(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateAPIConfigCR)
+		}
+	}
+	cr.Status.AtProvider = apigatewayclient.GenerateAPIConfigObservation(*existing)
+	setConditions(cr, cr.Status.AtProvider.State)
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: apigatewayclient.IsAPIConfigUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *apiConfigExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.APIConfig)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAPIConfig)
+	}
+	docs, err := apigatewayclient.GetOpenAPIDocuments(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetDocuments)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	c := apigatewayclient.GenerateAPIConfig(apigatewayclient.GetAPIConfigName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr)), docs, cr.Spec.ForProvider)
+	_, err = e.configs.Create(apigatewayclient.GetAPIConfigParent(e.projectID, cr.Spec.ForProvider), c).ApiConfigId(meta.GetExternalName(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateAPIConfig)
+}
+
+func (e *apiConfigExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.APIConfig)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAPIConfig)
+	}
+	// Only the display name and labels can be updated, so the documents are
+	// not sent.
+	fqn := apigatewayclient.GetAPIConfigName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+	_, err := e.configs.Patch(fqn, apigatewayclient.GenerateAPIConfig(fqn, nil, cr.Spec.ForProvider)).UpdateMask(apigatewayclient.APIConfigUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAPIConfig)
+}
+
+func (e *apiConfigExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.APIConfig)
+	if !ok {
+		return errors.New(errNotAPIConfig)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.configs.Delete(apigatewayclient.GetAPIConfigName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteAPIConfig)
+}
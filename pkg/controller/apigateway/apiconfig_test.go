@@ -0,0 +1,246 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apigateway "google.golang.org/api/apigateway/v1"
+	"google.golang.org/api/option"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/apigateway/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+func newAPIConfig() *v1alpha1.APIConfig {
+	c := &v1alpha1.APIConfig{}
+	meta.SetExternalName(c, "my-config")
+	c.Spec.ForProvider = v1alpha1.APIConfigParameters{
+		API:                   gcp.StringPtr("my-api"),
+		GatewayServiceAccount: gcp.StringPtr("my-sa@myproject-id-1234.iam.gserviceaccount.com"),
+		OpenAPIDocuments: []v1alpha1.OpenAPIDocument{{
+			Path:     "openapi.yaml",
+			Contents: gcp.StringPtr("swagger: '2.0'"),
+		}},
+	}
+	return c
+}
+
+func TestAPIConfigObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotAPIConfig": {
+			reason: "Should return an error if the resource is not an APIConfig",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotAPIConfig)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			mg:     newAPIConfig(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			mg:     newAPIConfig(),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetAPIConfig)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(&apigateway.ApigatewayApiConfig{})
+			}),
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if the late initialization update fails",
+			mg:     newAPIConfig(),
+			want:   want{err: errors.Wrap(errBoom, errUpdateAPIConfigCR)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&apigateway.ApigatewayApiConfig{DisplayName: "My config"})
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should return upToDate as true if the resource is up to date",
+			mg:     newAPIConfig(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&apigateway.ApigatewayApiConfig{GatewayServiceAccount: "my-sa@myproject-id-1234.iam.gserviceaccount.com"})
+			}),
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should return upToDate as false if the resource is not up to date",
+			mg:     newAPIConfig(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&apigateway.ApigatewayApiConfig{GatewayServiceAccount: "my-sa@myproject-id-1234.iam.gserviceaccount.com", Labels: map[string]string{"my": "label"}})
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := apigateway.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := apiConfigExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				configs:   s.Projects.Locations.Apis.Configs,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func createAPIConfig(e *apiConfigExternal, mg resource.Managed) error {
+	_, err := e.Create(context.Background(), mg)
+	return err
+}
+
+func updateAPIConfig(e *apiConfigExternal, mg resource.Managed) error {
+	_, err := e.Update(context.Background(), mg)
+	return err
+}
+
+func deleteAPIConfig(e *apiConfigExternal, mg resource.Managed) error {
+	return e.Delete(context.Background(), mg)
+}
+
+func TestAPIConfigCreateUpdateDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		method  string
+		status  int
+		call    func(e *apiConfigExternal, mg resource.Managed) error
+		mg      resource.Managed
+		wantErr error
+	}{
+		"CreateNotAPIConfig": {
+			reason:  "Should return an error if the resource is not an APIConfig",
+			call:    createAPIConfig,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotAPIConfig),
+		},
+		"CreateSuccessful": {
+			reason: "Should succeed if the resource creation doesn't return an error",
+			method: http.MethodPost,
+			status: http.StatusOK,
+			call:   createAPIConfig,
+			mg:     newAPIConfig(),
+		},
+		"CreateFailed": {
+			reason:  "Should fail if the resource creation returns an error",
+			method:  http.MethodPost,
+			status:  http.StatusBadRequest,
+			call:    createAPIConfig,
+			mg:      newAPIConfig(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateAPIConfig),
+		},
+		"UpdateSuccessful": {
+			reason: "Should succeed if the resource update doesn't return an error",
+			method: http.MethodPatch,
+			status: http.StatusOK,
+			call:   updateAPIConfig,
+			mg:     newAPIConfig(),
+		},
+		"UpdateFailed": {
+			reason:  "Should fail if the resource update returns an error",
+			method:  http.MethodPatch,
+			status:  http.StatusBadRequest,
+			call:    updateAPIConfig,
+			mg:      newAPIConfig(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateAPIConfig),
+		},
+		"DeleteAlreadyGone": {
+			reason: "Should not return an error if the resource is already gone",
+			method: http.MethodDelete,
+			status: http.StatusNotFound,
+			call:   deleteAPIConfig,
+			mg:     newAPIConfig(),
+		},
+		"DeleteFailed": {
+			reason:  "Should fail if the resource deletion returns an error",
+			method:  http.MethodDelete,
+			status:  http.StatusBadRequest,
+			call:    deleteAPIConfig,
+			mg:      newAPIConfig(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteAPIConfig),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&apigateway.ApigatewayOperation{})
+			}))
+			defer server.Close()
+			s, _ := apigateway.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &apiConfigExternal{
+				projectID: projectID,
+				configs:   s.Projects.Locations.Apis.Configs,
+			}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
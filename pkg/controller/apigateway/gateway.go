@@ -0,0 +1,155 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	apigateway "google.golang.org/api/apigateway/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/apigateway/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	apigatewayclient "github.com/crossplane/provider-gcp/pkg/clients/apigateway"
+)
+
+// Error strings.
+const (
+	errNotGateway      = "managed resource is not an API Gateway Gateway"
+	errGetGateway      = "cannot get API Gateway Gateway"
+	errCreateGateway   = "cannot create API Gateway Gateway"
+	errUpdateGateway   = "cannot update API Gateway Gateway"
+	errDeleteGateway   = "cannot delete API Gateway Gateway"
+	errUpdateGatewayCR = "cannot update API Gateway Gateway custom resource"
+)
+
+// SetupGateway adds a controller that reconciles API Gateway Gateways.
+func SetupGateway(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.GatewayGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.GatewayGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Gateway{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.GatewayGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.GatewayGroupVersionKind),
+			&gatewayConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type gatewayConnector struct {
+	kube client.Client
+}
+
+func (c *gatewayConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := apigateway.NewService(ctx, opts.For("apigateway")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &gatewayExternal{kube: c.kube, gateways: s.Projects.Locations.Gateways, projectID: projectID}, nil
+}
+
+type gatewayExternal struct {
+	kube      client.Client
+	gateways  *apigateway.ProjectsLocationsGatewaysService
+	projectID string
+}
+
+func (e *gatewayExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Gateway)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotGateway)
+	}
+	existing, err := e.gateways.Get(apigatewayclient.GetGatewayName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetGateway)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	apigatewayclient.LateInitializeGateway(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateGatewayCR)
+		}
+	}
+	cr.Status.AtProvider = apigatewayclient.GenerateGatewayObservation(*existing)
+	setConditions(cr, cr.Status.AtProvider.State)
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  apigatewayclient.IsGatewayUpToDate(cr.Spec.ForProvider, *existing),
+		ConnectionDetails: apigatewayclient.GetGatewayConnectionDetails(cr.Status.AtProvider),
+	}, nil
+}
+
+func (e *gatewayExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Gateway)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotGateway)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	g := apigatewayclient.GenerateGateway(apigatewayclient.GetGatewayName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr)), cr.Spec.ForProvider)
+	_, err := e.gateways.Create(apigatewayclient.GetGatewayParent(e.projectID, cr.Spec.ForProvider), g).GatewayId(meta.GetExternalName(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateGateway)
+}
+
+func (e *gatewayExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Gateway)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotGateway)
+	}
+	// The gateway rejects updates while it is rolling out a new config.
+	if cr.Status.AtProvider.State == v1alpha1.StateUpdating {
+		return managed.ExternalUpdate{}, nil
+	}
+	fqn := apigatewayclient.GetGatewayName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+	_, err := e.gateways.Patch(fqn, apigatewayclient.GenerateGateway(fqn, cr.Spec.ForProvider)).UpdateMask(apigatewayclient.GatewayUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateGateway)
+}
+
+func (e *gatewayExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Gateway)
+	if !ok {
+		return errors.New(errNotGateway)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.gateways.Delete(apigatewayclient.GetGatewayName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteGateway)
+}
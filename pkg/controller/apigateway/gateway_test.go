@@ -0,0 +1,254 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apigateway "google.golang.org/api/apigateway/v1"
+	"google.golang.org/api/option"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/apigateway/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	apiConfigName = "projects/myproject-id-1234/locations/global/apis/my-api/configs/my-config"
+	hostname      = "my-gateway-abc123.uc.gateway.dev"
+)
+
+func newGateway() *v1alpha1.Gateway {
+	g := &v1alpha1.Gateway{}
+	meta.SetExternalName(g, "my-gateway")
+	g.Spec.ForProvider = v1alpha1.GatewayParameters{
+		Location:  "us-central1",
+		APIConfig: gcp.StringPtr(apiConfigName),
+	}
+	return g
+}
+
+func TestGatewayObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotGateway": {
+			reason: "Should return an error if the resource is not a Gateway",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotGateway)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			mg:     newGateway(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			mg:     newGateway(),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetGateway)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(&apigateway.ApigatewayGateway{})
+			}),
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if the late initialization update fails",
+			mg:     newGateway(),
+			want:   want{err: errors.Wrap(errBoom, errUpdateGatewayCR)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&apigateway.ApigatewayGateway{DisplayName: "My gateway"})
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should return upToDate as true if the resource is up to date",
+			mg:     newGateway(),
+			want: want{e: managed.ExternalObservation{
+				ResourceExists:   true,
+				ResourceUpToDate: true,
+				ConnectionDetails: managed.ConnectionDetails{
+					xpv1.ResourceCredentialsSecretEndpointKey: []byte(hostname),
+				},
+			}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&apigateway.ApigatewayGateway{ApiConfig: apiConfigName, DefaultHostname: hostname})
+			}),
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should return upToDate as false if the resource is not up to date",
+			mg:     newGateway(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&apigateway.ApigatewayGateway{ApiConfig: apiConfigName + "-old"})
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := apigateway.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := gatewayExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				gateways:  s.Projects.Locations.Gateways,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func createGateway(e *gatewayExternal, mg resource.Managed) error {
+	_, err := e.Create(context.Background(), mg)
+	return err
+}
+
+func updateGateway(e *gatewayExternal, mg resource.Managed) error {
+	_, err := e.Update(context.Background(), mg)
+	return err
+}
+
+func deleteGateway(e *gatewayExternal, mg resource.Managed) error {
+	return e.Delete(context.Background(), mg)
+}
+
+func TestGatewayCreateUpdateDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		method  string
+		status  int
+		call    func(e *gatewayExternal, mg resource.Managed) error
+		mg      resource.Managed
+		wantErr error
+	}{
+		"CreateNotGateway": {
+			reason:  "Should return an error if the resource is not a Gateway",
+			call:    createGateway,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotGateway),
+		},
+		"CreateSuccessful": {
+			reason: "Should succeed if the resource creation doesn't return an error",
+			method: http.MethodPost,
+			status: http.StatusOK,
+			call:   createGateway,
+			mg:     newGateway(),
+		},
+		"CreateFailed": {
+			reason:  "Should fail if the resource creation returns an error",
+			method:  http.MethodPost,
+			status:  http.StatusBadRequest,
+			call:    createGateway,
+			mg:      newGateway(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateGateway),
+		},
+		"UpdateSuccessful": {
+			reason: "Should succeed if the resource update doesn't return an error",
+			method: http.MethodPatch,
+			status: http.StatusOK,
+			call:   updateGateway,
+			mg:     newGateway(),
+		},
+		"UpdateFailed": {
+			reason:  "Should fail if the resource update returns an error",
+			method:  http.MethodPatch,
+			status:  http.StatusBadRequest,
+			call:    updateGateway,
+			mg:      newGateway(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateGateway),
+		},
+		"DeleteAlreadyGone": {
+			reason: "Should not return an error if the resource is already gone",
+			method: http.MethodDelete,
+			status: http.StatusNotFound,
+			call:   deleteGateway,
+			mg:     newGateway(),
+		},
+		"DeleteFailed": {
+			reason:  "Should fail if the resource deletion returns an error",
+			method:  http.MethodDelete,
+			status:  http.StatusBadRequest,
+			call:    deleteGateway,
+			mg:      newGateway(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteGateway),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&apigateway.ApigatewayOperation{})
+			}))
+			defer server.Close()
+			s, _ := apigateway.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &gatewayExternal{
+				projectID: projectID,
+				gateways:  s.Projects.Locations.Gateways,
+			}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"context"
+	"time"
+
+	apigee "google.golang.org/api/apigee/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/apigee/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	apigeeclient "github.com/crossplane/provider-gcp/pkg/clients/apigee"
+)
+
+// Error strings.
+const (
+	errNotEnvGroup    = "managed resource is not an Apigee EnvironmentGroup"
+	errGetEnvGroup    = "cannot get Apigee EnvironmentGroup"
+	errCreateEnvGroup = "cannot create Apigee EnvironmentGroup"
+	errUpdateEnvGroup = "cannot update Apigee EnvironmentGroup"
+	errDeleteEnvGroup = "cannot delete Apigee EnvironmentGroup"
+)
+
+// SetupEnvGroup adds a controller that reconciles Apigee EnvironmentGroups.
+func SetupEnvGroup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.EnvGroupGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.EnvGroupGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.EnvGroup{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.EnvGroupGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.EnvGroupGroupVersionKind),
+			&envGroupConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type envGroupConnector struct {
+	kube client.Client
+}
+
+func (c *envGroupConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := apigee.NewService(ctx, opts.For("apigee")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &envGroupExternal{groups: s.Organizations.Envgroups, projectID: projectID}, nil
+}
+
+type envGroupExternal struct {
+	groups    *apigee.OrganizationsEnvgroupsService
+	projectID string
+}
+
+func (e *envGroupExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.EnvGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotEnvGroup)
+	}
+	existing, err := e.groups.Get(apigeeclient.GetEnvGroupName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetEnvGroup)
+	}
+	cr.Status.AtProvider = apigeeclient.GenerateEnvGroupObservation(*existing)
+	switch existing.State {
+	case v1alpha1.StateActive, v1alpha1.StateUpdating:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1alpha1.StateCreating:
+		cr.Status.SetConditions(xpv1.Creating())
+	case v1alpha1.StateDeleting:
+		cr.Status.SetConditions(xpv1.Deleting())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: apigeeclient.IsEnvGroupUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *envGroupExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.EnvGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotEnvGroup)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	_, err := e.groups.Create(apigeeclient.GetEnvGroupParent(e.projectID, cr.Spec.ForProvider), apigeeclient.GenerateEnvGroup(meta.GetExternalName(cr), cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateEnvGroup)
+}
+
+func (e *envGroupExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.EnvGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotEnvGroup)
+	}
+	name := meta.GetExternalName(cr)
+	_, err := e.groups.Patch(apigeeclient.GetEnvGroupName(e.projectID, cr.Spec.ForProvider, name), apigeeclient.GenerateEnvGroup(name, cr.Spec.ForProvider)).UpdateMask(apigeeclient.EnvGroupUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateEnvGroup)
+}
+
+func (e *envGroupExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.EnvGroup)
+	if !ok {
+		return errors.New(errNotEnvGroup)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.groups.Delete(apigeeclient.GetEnvGroupName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteEnvGroup)
+}
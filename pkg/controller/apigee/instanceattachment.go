@@ -0,0 +1,148 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"context"
+	"time"
+
+	apigee "google.golang.org/api/apigee/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/apigee/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	apigeeclient "github.com/crossplane/provider-gcp/pkg/clients/apigee"
+)
+
+// Error strings.
+const (
+	errNotInstanceAttachment    = "managed resource is not an Apigee InstanceAttachment"
+	errListInstanceAttachments  = "cannot list Apigee InstanceAttachments"
+	errCreateInstanceAttachment = "cannot create Apigee InstanceAttachment"
+	errDeleteInstanceAttachment = "cannot delete Apigee InstanceAttachment"
+)
+
+// SetupInstanceAttachment adds a controller that reconciles Apigee
+// InstanceAttachments.
+func SetupInstanceAttachment(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.InstanceAttachmentGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.InstanceAttachmentGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.InstanceAttachment{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.InstanceAttachmentGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.InstanceAttachmentGroupVersionKind),
+			&instanceAttachmentConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type instanceAttachmentConnector struct {
+	kube client.Client
+}
+
+func (c *instanceAttachmentConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := apigee.NewService(ctx, opts.For("apigee")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &instanceAttachmentExternal{attachments: s.Organizations.Instances.Attachments, projectID: projectID}, nil
+}
+
+type instanceAttachmentExternal struct {
+	attachments *apigee.OrganizationsInstancesAttachmentsService
+	projectID   string
+}
+
+// Attachment IDs are generated by the server, so the attachment of an
+// environment is looked up by listing the attachments of the instance.
+func (e *instanceAttachmentExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.InstanceAttachment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotInstanceAttachment)
+	}
+	var existing []*apigee.GoogleCloudApigeeV1InstanceAttachment
+	err := e.attachments.List(apigeeclient.GetInstanceName(e.projectID, cr.Spec.ForProvider)).Pages(ctx, func(r *apigee.GoogleCloudApigeeV1ListInstanceAttachmentsResponse) error {
+		existing = append(existing, r.Attachments...)
+		return nil
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errListInstanceAttachments)
+	}
+	a := apigeeclient.FindInstanceAttachment(cr.Spec.ForProvider, existing)
+	if a == nil {
+		return managed.ExternalObservation{}, nil
+	}
+	cr.Status.AtProvider = apigeeclient.GenerateInstanceAttachmentObservation(*a)
+	cr.Status.SetConditions(xpv1.Available())
+	// All fields of an attachment are immutable.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *instanceAttachmentExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.InstanceAttachment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotInstanceAttachment)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	_, err := e.attachments.Create(apigeeclient.GetInstanceName(e.projectID, cr.Spec.ForProvider), apigeeclient.GenerateInstanceAttachment(cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateInstanceAttachment)
+}
+
+func (e *instanceAttachmentExternal) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *instanceAttachmentExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.InstanceAttachment)
+	if !ok {
+		return errors.New(errNotInstanceAttachment)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	// The attachment ID is recorded in the status by Observe, which always
+	// runs before Delete.
+	if cr.Status.AtProvider.Name == "" {
+		return nil
+	}
+	_, err := e.attachments.Delete(apigeeclient.GetInstanceAttachmentName(e.projectID, cr.Spec.ForProvider, cr.Status.AtProvider.Name)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteInstanceAttachment)
+}
@@ -0,0 +1,251 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apigee "google.golang.org/api/apigee/v1"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/apigee/v1alpha1"
+)
+
+func newInstanceAttachment() *v1alpha1.InstanceAttachment {
+	cr := &v1alpha1.InstanceAttachment{}
+	cr.Spec.ForProvider = v1alpha1.InstanceAttachmentParameters{
+		Instance:    "eval-instance",
+		Environment: "dev",
+	}
+	return cr
+}
+
+func TestInstanceAttachmentObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		id  string
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotInstanceAttachment": {
+			reason: "Should return an error if the resource is not an InstanceAttachment",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotInstanceAttachment)},
+		},
+		"InstanceNotFound": {
+			reason: "Should not return an error if the instance does not exist",
+			mg:     newInstanceAttachment(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/v1/organizations/myproject-id-1234/instances/eval-instance/attachments", r.URL.Path); diff != "" {
+					t.Errorf("path: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"ListFailed": {
+			reason: "Should return an error if listing the attachments fails",
+			mg:     newInstanceAttachment(),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errListInstanceAttachments)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(&apigee.GoogleCloudApigeeV1ListInstanceAttachmentsResponse{})
+			}),
+		},
+		"AttachmentNotFound": {
+			reason: "Should report the resource as missing if no attachment matches the environment",
+			mg:     newInstanceAttachment(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&apigee.GoogleCloudApigeeV1ListInstanceAttachmentsResponse{
+					Attachments: []*apigee.GoogleCloudApigeeV1InstanceAttachment{{Name: "1", Environment: "prod"}},
+				})
+			}),
+		},
+		"AttachmentFound": {
+			reason: "Should record the attachment ID if an attachment matches the environment",
+			mg:     newInstanceAttachment(),
+			want: want{
+				e:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				id: "2",
+			},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&apigee.GoogleCloudApigeeV1ListInstanceAttachmentsResponse{
+					Attachments: []*apigee.GoogleCloudApigeeV1InstanceAttachment{
+						{Name: "1", Environment: "prod"},
+						{Name: "2", Environment: "dev"},
+					},
+				})
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := apigee.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := instanceAttachmentExternal{
+				projectID:   projectID,
+				attachments: s.Organizations.Instances.Attachments,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.InstanceAttachment); ok {
+				if diff := cmp.Diff(tc.want.id, cr.Status.AtProvider.Name); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want ID, +got ID:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestInstanceAttachmentCreate(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		status  int
+		mg      resource.Managed
+		wantErr error
+	}{
+		"NotInstanceAttachment": {
+			reason:  "Should return an error if the resource is not an InstanceAttachment",
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotInstanceAttachment),
+		},
+		"Successful": {
+			reason: "Should succeed if the resource creation doesn't return an error",
+			status: http.StatusOK,
+			mg:     newInstanceAttachment(),
+		},
+		"Failed": {
+			reason:  "Should fail if the resource creation returns an error",
+			status:  http.StatusBadRequest,
+			mg:      newInstanceAttachment(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateInstanceAttachment),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&apigee.GoogleLongrunningOperation{})
+			}))
+			defer server.Close()
+			s, _ := apigee.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := instanceAttachmentExternal{
+				projectID:   projectID,
+				attachments: s.Organizations.Instances.Attachments,
+			}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestInstanceAttachmentDelete(t *testing.T) {
+	observed := func() *v1alpha1.InstanceAttachment {
+		cr := newInstanceAttachment()
+		cr.Status.AtProvider.Name = "2"
+		return cr
+	}
+
+	cases := map[string]struct {
+		reason  string
+		status  int
+		mg      resource.Managed
+		wantErr error
+	}{
+		"NotInstanceAttachment": {
+			reason:  "Should return an error if the resource is not an InstanceAttachment",
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotInstanceAttachment),
+		},
+		"NotObserved": {
+			reason: "Should not call the API if the attachment ID is unknown",
+			mg:     newInstanceAttachment(),
+		},
+		"AlreadyGone": {
+			reason: "Should not return an error if the resource is already gone",
+			status: http.StatusNotFound,
+			mg:     observed(),
+		},
+		"Failed": {
+			reason:  "Should fail if the resource deletion returns an error",
+			status:  http.StatusBadRequest,
+			mg:      observed(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteInstanceAttachment),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if tc.status == 0 {
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				if diff := cmp.Diff("/v1/organizations/myproject-id-1234/instances/eval-instance/attachments/2", r.URL.Path); diff != "" {
+					t.Errorf("path: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&apigee.GoogleLongrunningOperation{})
+			}))
+			defer server.Close()
+			s, _ := apigee.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := instanceAttachmentExternal{
+				projectID:   projectID,
+				attachments: s.Organizations.Instances.Attachments,
+			}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	apigee "google.golang.org/api/apigee/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/apigee/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	apigeeclient "github.com/crossplane/provider-gcp/pkg/clients/apigee"
+)
+
+// Error strings.
+const (
+	errNewClient            = "cannot create new Apigee client"
+	errNotOrganization      = "managed resource is not an Apigee Organization"
+	errGetOrganization      = "cannot get Apigee Organization"
+	errCreateOrganization   = "cannot create Apigee Organization"
+	errUpdateOrganization   = "cannot update Apigee Organization"
+	errDeleteOrganization   = "cannot delete Apigee Organization"
+	errUpdateOrganizationCR = "cannot update Apigee Organization custom resource"
+)
+
+// SetupOrganization adds a controller that reconciles Apigee Organizations.
+func SetupOrganization(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.OrganizationGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.OrganizationGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Organization{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.OrganizationGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.OrganizationGroupVersionKind),
+			&organizationConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type organizationConnector struct {
+	kube client.Client
+}
+
+func (c *organizationConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := apigee.NewService(ctx, opts.For("apigee")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &organizationExternal{kube: c.kube, orgs: s.Organizations, projectID: projectID}, nil
+}
+
+type organizationExternal struct {
+	kube      client.Client
+	orgs      *apigee.OrganizationsService
+	projectID string
+}
+
+func (e *organizationExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Organization)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotOrganization)
+	}
+	existing, err := e.orgs.Get(apigeeclient.GetOrganizationName(e.projectID)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetOrganization)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	apigeeclient.LateInitializeOrganization(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateOrganizationCR)
+		}
+	}
+	cr.Status.AtProvider = apigeeclient.GenerateOrganizationObservation(*existing)
+	switch existing.State {
+	case v1alpha1.StateActive, v1alpha1.StateUpdating:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1alpha1.StateCreating:
+		cr.Status.SetConditions(xpv1.Creating())
+	case v1alpha1.StateDeleting:
+		cr.Status.SetConditions(xpv1.Deleting())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: apigeeclient.IsOrganizationUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *organizationExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Organization)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotOrganization)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	_, err := e.orgs.Create(apigeeclient.GenerateOrganization(cr.Spec.ForProvider)).Parent(apigeeclient.GetOrganizationParent(e.projectID)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateOrganization)
+}
+
+func (e *organizationExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Organization)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotOrganization)
+	}
+	_, err := e.orgs.Update(apigeeclient.GetOrganizationName(e.projectID), apigeeclient.GenerateOrganization(cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateOrganization)
+}
+
+func (e *organizationExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Organization)
+	if !ok {
+		return errors.New(errNotOrganization)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.orgs.Delete(apigeeclient.GetOrganizationName(e.projectID)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteOrganization)
+}
@@ -0,0 +1,278 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigee
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apigee "google.golang.org/api/apigee/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/apigee/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID = "myproject-id-1234"
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newOrganization() *v1alpha1.Organization {
+	cr := &v1alpha1.Organization{}
+	cr.Spec.ForProvider = v1alpha1.OrganizationParameters{
+		DisplayName:     gcp.StringPtr("My org"),
+		AnalyticsRegion: "us-central1",
+		RuntimeType:     v1alpha1.RuntimeTypeCloud,
+	}
+	return cr
+}
+
+func TestOrganizationObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotOrganization": {
+			reason: "Should return an error if the resource is not a Organization",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotOrganization)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			mg:     newOrganization(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff("/v1/organizations/myproject-id-1234", r.URL.Path); diff != "" {
+					t.Errorf("path: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			mg:     newOrganization(),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetOrganization)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(&apigee.GoogleCloudApigeeV1Organization{})
+			}),
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if the late initialization update fails",
+			mg: func() resource.Managed {
+				cr := newOrganization()
+				cr.Spec.ForProvider.DisplayName = nil
+				return cr
+			}(),
+			want: want{err: errors.Wrap(errBoom, errUpdateOrganizationCR)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&apigee.GoogleCloudApigeeV1Organization{DisplayName: "late"})
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should return upToDate as true if the resource is up to date",
+			mg:     newOrganization(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&apigee.GoogleCloudApigeeV1Organization{DisplayName: "My org", AnalyticsRegion: "us-central1", RuntimeType: v1alpha1.RuntimeTypeCloud, State: v1alpha1.StateActive})
+			}),
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should return upToDate as false if the resource is not up to date",
+			mg:     newOrganization(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&apigee.GoogleCloudApigeeV1Organization{DisplayName: "Old org", AnalyticsRegion: "us-central1", RuntimeType: v1alpha1.RuntimeTypeCloud, State: v1alpha1.StateActive})
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := apigee.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := organizationExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				orgs:      s.Organizations,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func createOrganization(e *organizationExternal, mg resource.Managed) error {
+	_, err := e.Create(context.Background(), mg)
+	return err
+}
+
+func updateOrganization(e *organizationExternal, mg resource.Managed) error {
+	_, err := e.Update(context.Background(), mg)
+	return err
+}
+
+func deleteOrganization(e *organizationExternal, mg resource.Managed) error {
+	return e.Delete(context.Background(), mg)
+}
+
+func TestOrganizationCreateUpdateDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		method  string
+		status  int
+		call    func(e *organizationExternal, mg resource.Managed) error
+		mg      resource.Managed
+		wantErr error
+	}{
+		"CreateNotOrganization": {
+			reason:  "Should return an error if the resource is not a Organization",
+			call:    createOrganization,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotOrganization),
+		},
+		"CreateSuccessful": {
+			reason: "Should succeed if the resource creation doesn't return an error",
+			method: http.MethodPost,
+			status: http.StatusOK,
+			call:   createOrganization,
+			mg:     newOrganization(),
+		},
+		"CreateFailed": {
+			reason:  "Should fail if the resource creation returns an error",
+			method:  http.MethodPost,
+			status:  http.StatusBadRequest,
+			call:    createOrganization,
+			mg:      newOrganization(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateOrganization),
+		},
+		"UpdateNotOrganization": {
+			reason:  "Should return an error if the resource is not a Organization",
+			call:    updateOrganization,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotOrganization),
+		},
+		"UpdateSuccessful": {
+			reason: "Should succeed if the resource update doesn't return an error",
+			method: http.MethodPut,
+			status: http.StatusOK,
+			call:   updateOrganization,
+			mg:     newOrganization(),
+		},
+		"UpdateFailed": {
+			reason:  "Should fail if the resource update returns an error",
+			method:  http.MethodPut,
+			status:  http.StatusBadRequest,
+			call:    updateOrganization,
+			mg:      newOrganization(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateOrganization),
+		},
+		"DeleteNotOrganization": {
+			reason:  "Should return an error if the resource is not a Organization",
+			call:    deleteOrganization,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotOrganization),
+		},
+		"DeleteAlreadyGone": {
+			reason: "Should not return an error if the resource is already gone",
+			method: http.MethodDelete,
+			status: http.StatusNotFound,
+			call:   deleteOrganization,
+			mg:     newOrganization(),
+		},
+		"DeleteFailed": {
+			reason:  "Should fail if the resource deletion returns an error",
+			method:  http.MethodDelete,
+			status:  http.StatusBadRequest,
+			call:    deleteOrganization,
+			mg:      newOrganization(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteOrganization),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&apigee.GoogleLongrunningOperation{})
+			}))
+			defer server.Close()
+			s, _ := apigee.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &organizationExternal{
+				projectID: projectID,
+				orgs:      s.Organizations,
+			}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appengine
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	appengine "google.golang.org/api/appengine/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/appengine/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	appengineclient "github.com/crossplane/provider-gcp/pkg/clients/appengine"
+)
+
+// Error strings.
+const (
+	errNewClient           = "cannot create new App Engine client"
+	errNotApplication      = "managed resource is not an App Engine Application"
+	errGetApplication      = "cannot get App Engine Application"
+	errCreateApplication   = "cannot create App Engine Application"
+	errUpdateApplication   = "cannot update App Engine Application"
+	errUpdateApplicationCR = "cannot update App Engine Application custom resource"
+)
+
+// SetupApplication adds a controller that reconciles App Engine Applications.
+func SetupApplication(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.ApplicationGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.ApplicationGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Application{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.ApplicationGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ApplicationGroupVersionKind),
+			&applicationConnector{kube: mgr.GetClient()},
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type applicationConnector struct {
+	kube client.Client
+}
+
+func (c *applicationConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := appengine.NewService(ctx, opts.For("appengine")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &applicationExternal{kube: c.kube, apps: s.Apps, projectID: projectID}, nil
+}
+
+type applicationExternal struct {
+	kube      client.Client
+	apps      *appengine.AppsService
+	projectID string
+}
+
+func (e *applicationExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Application)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotApplication)
+	}
+	existing, err := e.apps.Get(e.projectID).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetApplication)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	appengineclient.LateInitializeApplication(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateApplicationCR)
+		}
+	}
+	cr.Status.AtProvider = appengineclient.GenerateApplicationObservation(*existing)
+	switch cr.Status.AtProvider.ServingStatus {
+	case v1alpha1.ServingStatusServing:
+		cr.Status.SetConditions(xpv1.Available())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: appengineclient.IsApplicationUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *applicationExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Application)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotApplication)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	_, err := e.apps.Create(appengineclient.GenerateApplication(e.projectID, cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateApplication)
+}
+
+func (e *applicationExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Application)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotApplication)
+	}
+	_, err := e.apps.Patch(e.projectID, appengineclient.GenerateApplication(e.projectID, cr.Spec.ForProvider)).UpdateMask(appengineclient.ApplicationUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateApplication)
+}
+
+// Delete does nothing, since App Engine applications cannot be deleted. The
+// application keeps serving until it is disabled via spec.forProvider.
+func (e *applicationExternal) Delete(_ context.Context, mg resource.Managed) error {
+	if _, ok := mg.(*v1alpha1.Application); !ok {
+		return errors.New(errNotApplication)
+	}
+	return nil
+}
@@ -0,0 +1,267 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appengine
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	appengine "google.golang.org/api/appengine/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/appengine/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID = "myproject-id-1234"
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newApplication() *v1alpha1.Application {
+	a := &v1alpha1.Application{}
+	a.Spec.ForProvider = v1alpha1.ApplicationParameters{
+		LocationID:    "us-central",
+		DatabaseType:  gcp.StringPtr("CLOUD_FIRESTORE"),
+		AuthDomain:    gcp.StringPtr("gmail.com"),
+		ServingStatus: gcp.StringPtr(v1alpha1.ServingStatusServing),
+	}
+	return a
+}
+
+func observedApplication() *appengine.Application {
+	return &appengine.Application{
+		Name:            "apps/" + projectID,
+		Id:              projectID,
+		LocationId:      "us-central",
+		DatabaseType:    "CLOUD_FIRESTORE",
+		AuthDomain:      "gmail.com",
+		ServingStatus:   v1alpha1.ServingStatusServing,
+		DefaultHostname: projectID + ".uc.r.appspot.com",
+	}
+}
+
+func TestApplicationObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotApplication": {
+			reason: "Should return an error if the resource is not a Application",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotApplication)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			mg:     newApplication(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&appengine.Application{})
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			mg:     newApplication(),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetApplication)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(&appengine.Application{})
+			}),
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if the late initialization update fails",
+			mg:     newApplication(),
+			want:   want{err: errors.Wrap(errBoom, errUpdateApplicationCR)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				o := observedApplication()
+				o.FeatureSettings = &appengine.FeatureSettings{SplitHealthChecks: true}
+				_ = json.NewEncoder(w).Encode(o)
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should return upToDate as true if the resource is up to date",
+			mg:     newApplication(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedApplication())
+			}),
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should return upToDate as false if the resource is not up to date",
+			mg:     newApplication(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				o := observedApplication()
+				o.ServingStatus = v1alpha1.ServingStatusUserDisabled
+				_ = json.NewEncoder(w).Encode(o)
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := appengine.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := applicationExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				apps:      s.Apps,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func createApplication(e *applicationExternal, mg resource.Managed) error {
+	_, err := e.Create(context.Background(), mg)
+	return err
+}
+
+func updateApplication(e *applicationExternal, mg resource.Managed) error {
+	_, err := e.Update(context.Background(), mg)
+	return err
+}
+
+func deleteApplication(e *applicationExternal, mg resource.Managed) error {
+	return e.Delete(context.Background(), mg)
+}
+
+func TestApplicationCreateUpdateDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		method  string
+		status  int
+		call    func(e *applicationExternal, mg resource.Managed) error
+		mg      resource.Managed
+		wantErr error
+	}{
+		"CreateNotApplication": {
+			reason:  "Should return an error if the resource is not a Application",
+			call:    createApplication,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotApplication),
+		},
+		"CreateSuccessful": {
+			reason: "Should succeed if the resource creation doesn't return an error",
+			method: http.MethodPost,
+			status: http.StatusOK,
+			call:   createApplication,
+			mg:     newApplication(),
+		},
+		"CreateFailed": {
+			reason:  "Should fail if the resource creation returns an error",
+			method:  http.MethodPost,
+			status:  http.StatusBadRequest,
+			call:    createApplication,
+			mg:      newApplication(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateApplication),
+		},
+		"UpdateSuccessful": {
+			reason: "Should succeed if the resource update doesn't return an error",
+			method: http.MethodPatch,
+			status: http.StatusOK,
+			call:   updateApplication,
+			mg:     newApplication(),
+		},
+		"UpdateFailed": {
+			reason:  "Should fail if the resource update returns an error",
+			method:  http.MethodPatch,
+			status:  http.StatusBadRequest,
+			call:    updateApplication,
+			mg:      newApplication(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateApplication),
+		},
+		"DeleteIsNoop": {
+			reason: "Should not call the API since applications cannot be deleted",
+			call:   deleteApplication,
+			mg:     newApplication(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&appengine.Operation{})
+			}))
+			defer server.Close()
+			s, _ := appengine.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &applicationExternal{
+				projectID: projectID,
+				apps:      s.Apps,
+			}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appengine
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	appengine "google.golang.org/api/appengine/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/appengine/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	appengineclient "github.com/crossplane/provider-gcp/pkg/clients/appengine"
+)
+
+// Error strings.
+const (
+	errNotDomainMapping      = "managed resource is not an App Engine DomainMapping"
+	errGetDomainMapping      = "cannot get App Engine DomainMapping"
+	errCreateDomainMapping   = "cannot create App Engine DomainMapping"
+	errUpdateDomainMapping   = "cannot update App Engine DomainMapping"
+	errDeleteDomainMapping   = "cannot delete App Engine DomainMapping"
+	errUpdateDomainMappingCR = "cannot update App Engine DomainMapping custom resource"
+)
+
+// SetupDomainMapping adds a controller that reconciles App Engine DomainMappings.
+func SetupDomainMapping(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.DomainMappingGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.DomainMappingGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.DomainMapping{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.DomainMappingGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.DomainMappingGroupVersionKind),
+			&domainMappingConnector{kube: mgr.GetClient()},
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type domainMappingConnector struct {
+	kube client.Client
+}
+
+func (c *domainMappingConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := appengine.NewService(ctx, opts.For("appengine")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &domainMappingExternal{kube: c.kube, domainMappings: s.Apps.DomainMappings, projectID: projectID}, nil
+}
+
+type domainMappingExternal struct {
+	kube           client.Client
+	domainMappings *appengine.AppsDomainMappingsService
+	projectID      string
+}
+
+func (e *domainMappingExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.DomainMapping)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDomainMapping)
+	}
+	existing, err := e.domainMappings.Get(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetDomainMapping)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	appengineclient.LateInitializeDomainMapping(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateDomainMappingCR)
+		}
+	}
+	cr.Status.AtProvider = appengineclient.GenerateDomainMappingObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: appengineclient.IsDomainMappingUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *domainMappingExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.DomainMapping)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDomainMapping)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	_, err := e.domainMappings.Create(e.projectID, appengineclient.GenerateDomainMapping(meta.GetExternalName(cr), cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateDomainMapping)
+}
+
+func (e *domainMappingExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.DomainMapping)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDomainMapping)
+	}
+	domain := meta.GetExternalName(cr)
+	_, err := e.domainMappings.Patch(e.projectID, domain, appengineclient.GenerateDomainMapping(domain, cr.Spec.ForProvider)).
+		UpdateMask(appengineclient.GetDomainMappingUpdateMask(cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateDomainMapping)
+}
+
+func (e *domainMappingExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.DomainMapping)
+	if !ok {
+		return errors.New(errNotDomainMapping)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.domainMappings.Delete(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteDomainMapping)
+}
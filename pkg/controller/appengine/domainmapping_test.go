@@ -0,0 +1,257 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appengine
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	appengine "google.golang.org/api/appengine/v1"
+	"google.golang.org/api/option"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/appengine/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+func newDomainMapping() *v1alpha1.DomainMapping {
+	dm := &v1alpha1.DomainMapping{}
+	meta.SetExternalName(dm, "www.example.com")
+	dm.Spec.ForProvider = v1alpha1.DomainMappingParameters{
+		SSLSettings: &v1alpha1.SSLSettings{SSLManagementType: gcp.StringPtr("AUTOMATIC")},
+	}
+	return dm
+}
+
+func observedDomainMapping() *appengine.DomainMapping {
+	return &appengine.DomainMapping{
+		Name:            "apps/" + projectID + "/domainMappings/www.example.com",
+		Id:              "www.example.com",
+		ResourceRecords: []*appengine.ResourceRecord{{Name: "www", Type: "CNAME", Rrdata: "ghs.googlehosted.com."}},
+		SslSettings:     &appengine.SslSettings{SslManagementType: "AUTOMATIC", CertificateId: "12345"},
+	}
+}
+
+func TestDomainMappingObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotDomainMapping": {
+			reason: "Should return an error if the resource is not a DomainMapping",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotDomainMapping)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			mg:     newDomainMapping(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&appengine.DomainMapping{})
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			mg:     newDomainMapping(),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetDomainMapping)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(&appengine.DomainMapping{})
+			}),
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if the late initialization update fails",
+			mg: func() resource.Managed {
+				dm := newDomainMapping()
+				dm.Spec.ForProvider.SSLSettings = nil
+				return dm
+			}(),
+			want: want{err: errors.Wrap(errBoom, errUpdateDomainMappingCR)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedDomainMapping())
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should return upToDate as true if the resource is up to date",
+			mg:     newDomainMapping(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedDomainMapping())
+			}),
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should return upToDate as false if the resource is not up to date",
+			mg:     newDomainMapping(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				o := observedDomainMapping()
+				o.SslSettings.SslManagementType = "MANUAL"
+				_ = json.NewEncoder(w).Encode(o)
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := appengine.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := domainMappingExternal{
+				kube:           tc.kube,
+				projectID:      projectID,
+				domainMappings: s.Apps.DomainMappings,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func createDomainMapping(e *domainMappingExternal, mg resource.Managed) error {
+	_, err := e.Create(context.Background(), mg)
+	return err
+}
+
+func updateDomainMapping(e *domainMappingExternal, mg resource.Managed) error {
+	_, err := e.Update(context.Background(), mg)
+	return err
+}
+
+func deleteDomainMapping(e *domainMappingExternal, mg resource.Managed) error {
+	return e.Delete(context.Background(), mg)
+}
+
+func TestDomainMappingCreateUpdateDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		method  string
+		status  int
+		call    func(e *domainMappingExternal, mg resource.Managed) error
+		mg      resource.Managed
+		wantErr error
+	}{
+		"CreateNotDomainMapping": {
+			reason:  "Should return an error if the resource is not a DomainMapping",
+			call:    createDomainMapping,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotDomainMapping),
+		},
+		"CreateSuccessful": {
+			reason: "Should succeed if the resource creation doesn't return an error",
+			method: http.MethodPost,
+			status: http.StatusOK,
+			call:   createDomainMapping,
+			mg:     newDomainMapping(),
+		},
+		"CreateFailed": {
+			reason:  "Should fail if the resource creation returns an error",
+			method:  http.MethodPost,
+			status:  http.StatusBadRequest,
+			call:    createDomainMapping,
+			mg:      newDomainMapping(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateDomainMapping),
+		},
+		"UpdateSuccessful": {
+			reason: "Should succeed if the resource update doesn't return an error",
+			method: http.MethodPatch,
+			status: http.StatusOK,
+			call:   updateDomainMapping,
+			mg:     newDomainMapping(),
+		},
+		"UpdateFailed": {
+			reason:  "Should fail if the resource update returns an error",
+			method:  http.MethodPatch,
+			status:  http.StatusBadRequest,
+			call:    updateDomainMapping,
+			mg:      newDomainMapping(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateDomainMapping),
+		},
+		"DeleteAlreadyGone": {
+			reason: "Should not return an error if the resource is already gone",
+			method: http.MethodDelete,
+			status: http.StatusNotFound,
+			call:   deleteDomainMapping,
+			mg:     newDomainMapping(),
+		},
+		"DeleteFailed": {
+			reason:  "Should fail if the resource deletion returns an error",
+			method:  http.MethodDelete,
+			status:  http.StatusBadRequest,
+			call:    deleteDomainMapping,
+			mg:      newDomainMapping(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteDomainMapping),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&appengine.Operation{})
+			}))
+			defer server.Close()
+			s, _ := appengine.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &domainMappingExternal{
+				projectID:      projectID,
+				domainMappings: s.Apps.DomainMappings,
+			}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
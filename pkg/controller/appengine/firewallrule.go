@@ -0,0 +1,146 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appengine
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	appengine "google.golang.org/api/appengine/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/appengine/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	appengineclient "github.com/crossplane/provider-gcp/pkg/clients/appengine"
+)
+
+// Error strings.
+const (
+	errNotFirewallRule      = "managed resource is not an App Engine FirewallRule"
+	errGetFirewallRule      = "cannot get App Engine FirewallRule"
+	errCreateFirewallRule   = "cannot create App Engine FirewallRule"
+	errUpdateFirewallRule   = "cannot update App Engine FirewallRule"
+	errDeleteFirewallRule   = "cannot delete App Engine FirewallRule"
+	errUpdateFirewallRuleCR = "cannot update App Engine FirewallRule custom resource"
+)
+
+// SetupFirewallRule adds a controller that reconciles App Engine FirewallRules.
+func SetupFirewallRule(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.FirewallRuleGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.FirewallRuleGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.FirewallRule{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.FirewallRuleGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.FirewallRuleGroupVersionKind),
+			&firewallRuleConnector{kube: mgr.GetClient()},
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type firewallRuleConnector struct {
+	kube client.Client
+}
+
+func (c *firewallRuleConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := appengine.NewService(ctx, opts.For("appengine")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &firewallRuleExternal{kube: c.kube, rules: s.Apps.Firewall.IngressRules, projectID: projectID}, nil
+}
+
+type firewallRuleExternal struct {
+	kube      client.Client
+	rules     *appengine.AppsFirewallIngressRulesService
+	projectID string
+}
+
+func (e *firewallRuleExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.FirewallRule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotFirewallRule)
+	}
+	existing, err := e.rules.Get(e.projectID, appengineclient.GetFirewallRuleID(cr.Spec.ForProvider)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetFirewallRule)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	appengineclient.LateInitializeFirewallRule(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateFirewallRuleCR)
+		}
+	}
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: appengineclient.IsFirewallRuleUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *firewallRuleExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.FirewallRule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotFirewallRule)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	_, err := e.rules.Create(e.projectID, appengineclient.GenerateFirewallRule(cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateFirewallRule)
+}
+
+func (e *firewallRuleExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.FirewallRule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotFirewallRule)
+	}
+	_, err := e.rules.Patch(e.projectID, appengineclient.GetFirewallRuleID(cr.Spec.ForProvider), appengineclient.GenerateFirewallRule(cr.Spec.ForProvider)).
+		UpdateMask(appengineclient.FirewallRuleUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFirewallRule)
+}
+
+func (e *firewallRuleExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.FirewallRule)
+	if !ok {
+		return errors.New(errNotFirewallRule)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.rules.Delete(e.projectID, appengineclient.GetFirewallRuleID(cr.Spec.ForProvider)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteFirewallRule)
+}
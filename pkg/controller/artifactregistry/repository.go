@@ -0,0 +1,155 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package artifactregistry
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	artifactregistry "google.golang.org/api/artifactregistry/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/artifactregistry/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	arclient "github.com/crossplane/provider-gcp/pkg/clients/artifactregistry"
+)
+
+// Error strings.
+const (
+	errNewClient          = "cannot create new Artifact Registry client"
+	errNotRepository      = "managed resource is not a Repository"
+	errGetRepository      = "cannot get Repository"
+	errCreateRepository   = "cannot create Repository"
+	errUpdateRepository   = "cannot update Repository"
+	errDeleteRepository   = "cannot delete Repository"
+	errUpdateRepositoryCR = "cannot update Repository custom resource"
+)
+
+// SetupRepository adds a controller that reconciles Repositories.
+func SetupRepository(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.RepositoryGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.RepositoryGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Repository{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.RepositoryGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.RepositoryGroupVersionKind),
+			&repositoryConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type repositoryConnector struct {
+	kube client.Client
+}
+
+func (c *repositoryConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := artifactregistry.NewService(ctx, opts.For("artifactregistry")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &repositoryExternal{kube: c.kube, repositories: s.Projects.Locations.Repositories, projectID: projectID}, nil
+}
+
+type repositoryExternal struct {
+	kube         client.Client
+	repositories *artifactregistry.ProjectsLocationsRepositoriesService
+	projectID    string
+}
+
+func (e *repositoryExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Repository)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRepository)
+	}
+	existing, err := e.repositories.Get(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetRepository)
+	}
+	current := cr.Spec.ForProvider.DeepCopy()
+	arclient.LateInitializeRepository(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateRepositoryCR)
+		}
+	}
+	cr.Status.AtProvider = arclient.GenerateRepositoryObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: arclient.IsRepositoryUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *repositoryExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Repository)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRepository)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.repositories.Create(arclient.GetRepositoryParent(e.projectID, cr.Spec.ForProvider), arclient.GenerateRepository(cr.Spec.ForProvider)).
+		RepositoryId(meta.GetExternalName(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateRepository)
+}
+
+func (e *repositoryExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Repository)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRepository)
+	}
+	_, err := e.repositories.Patch(e.name(cr), arclient.GenerateRepository(cr.Spec.ForProvider)).
+		UpdateMask(arclient.GetRepositoryUpdateMask(cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateRepository)
+}
+
+func (e *repositoryExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Repository)
+	if !ok {
+		return errors.New(errNotRepository)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.repositories.Delete(e.name(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteRepository)
+}
+
+func (e *repositoryExternal) name(cr *v1alpha1.Repository) string {
+	return arclient.GetRepositoryName(arclient.GetRepositoryParent(e.projectID, cr.Spec.ForProvider), meta.GetExternalName(cr))
+}
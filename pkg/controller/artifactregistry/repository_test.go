@@ -0,0 +1,287 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package artifactregistry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	artifactregistry "google.golang.org/api/artifactregistry/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/artifactregistry/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID      = "myproject-id-1234"
+	repositoryName = "projects/myproject-id-1234/locations/us-central1/repositories/images"
+	repositoryPath = "/v1/" + repositoryName
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newRepository(m ...func(*v1alpha1.Repository)) *v1alpha1.Repository {
+	cr := &v1alpha1.Repository{}
+	meta.SetExternalName(cr, "images")
+	cr.Spec.ForProvider = v1alpha1.RepositoryParameters{
+		Location:     "us-central1",
+		Format:       "DOCKER",
+		Mode:         gcp.StringPtr(v1alpha1.RepositoryModeStandard),
+		Description:  gcp.StringPtr("Container images"),
+		DockerConfig: &v1alpha1.DockerRepositoryConfig{ImmutableTags: gcp.BoolPtr(true)},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func repository(m ...func(*artifactregistry.Repository)) *artifactregistry.Repository {
+	r := &artifactregistry.Repository{
+		Name:         repositoryName,
+		Format:       "DOCKER",
+		Mode:         v1alpha1.RepositoryModeStandard,
+		Description:  "Container images",
+		DockerConfig: &artifactregistry.DockerRepositoryConfig{ImmutableTags: true},
+		CreateTime:   "2021-06-01T00:00:00Z",
+		SizeBytes:    1024,
+	}
+	for _, f := range m {
+		f(r)
+	}
+	return r
+}
+
+func TestRepositoryObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		obs v1alpha1.RepositoryObservation
+		err error
+	}
+
+	obs := v1alpha1.RepositoryObservation{
+		Name:       repositoryName,
+		CreateTime: "2021-06-01T00:00:00Z",
+		SizeBytes:  1024,
+	}
+	cases := map[string]struct {
+		reason     string
+		status     int
+		repository *artifactregistry.Repository
+		kube       *test.MockClient
+		mg         resource.Managed
+		want       want
+	}{
+		"NotRepository": {
+			reason: "Should return an error if the resource is not a Repository",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotRepository)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the repository does not exist",
+			status: http.StatusNotFound,
+			mg:     newRepository(),
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the repository fails",
+			status: http.StatusBadRequest,
+			mg:     newRepository(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetRepository)},
+		},
+		"LateInitFailed": {
+			reason:     "Should return an error if the late initialized spec can't be saved",
+			status:     http.StatusOK,
+			repository: repository(),
+			kube:       &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:         newRepository(func(cr *v1alpha1.Repository) { cr.Spec.ForProvider.Mode = nil }),
+			want:       want{err: errors.Wrap(errBoom, errUpdateRepositoryCR)},
+		},
+		"ResourceUpToDate": {
+			reason:     "Should report the observation of an up to date repository",
+			status:     http.StatusOK,
+			repository: repository(),
+			mg:         newRepository(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				obs: obs,
+			},
+		},
+		"NeedsUpdate": {
+			reason:     "Should return upToDate as false if tag immutability differs",
+			status:     http.StatusOK,
+			repository: repository(func(r *artifactregistry.Repository) { r.DockerConfig = nil }),
+			mg:         newRepository(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				obs: obs,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet+" "+repositoryPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.repository == nil {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.repository)
+			}))
+			defer server.Close()
+			s, _ := artifactregistry.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &repositoryExternal{kube: tc.kube, repositories: s.Projects.Locations.Repositories, projectID: projectID}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Repository); ok && err == nil {
+				if diff := cmp.Diff(tc.want.obs, cr.Status.AtProvider); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want observation, +got observation:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestRepositoryWrite(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		method string
+		path   string
+		query  string
+		status int
+		call   func(*repositoryExternal, resource.Managed) error
+		want   error
+	}{
+		"CreateSuccessful": {
+			reason: "Should create the repository with the external name as ID",
+			method: http.MethodPost,
+			path:   "/v1/projects/myproject-id-1234/locations/us-central1/repositories",
+			query:  "images",
+			status: http.StatusOK,
+			call: func(e *repositoryExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+		},
+		"CreateFailed": {
+			reason: "Should return an error if creating the repository fails",
+			method: http.MethodPost,
+			path:   "/v1/projects/myproject-id-1234/locations/us-central1/repositories",
+			query:  "images",
+			status: http.StatusBadRequest,
+			call: func(e *repositoryExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateRepository),
+		},
+		"UpdateSuccessful": {
+			reason: "Should patch the repository",
+			method: http.MethodPatch,
+			path:   repositoryPath,
+			status: http.StatusOK,
+			call: func(e *repositoryExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+		},
+		"UpdateFailed": {
+			reason: "Should return an error if patching the repository fails",
+			method: http.MethodPatch,
+			path:   repositoryPath,
+			status: http.StatusBadRequest,
+			call: func(e *repositoryExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateRepository),
+		},
+		"DeleteNotFound": {
+			reason: "Should not return an error if the repository is already gone",
+			method: http.MethodDelete,
+			path:   repositoryPath,
+			status: http.StatusNotFound,
+			call: func(e *repositoryExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+		},
+		"DeleteFailed": {
+			reason: "Should return an error if deleting the repository fails",
+			method: http.MethodDelete,
+			path:   repositoryPath,
+			status: http.StatusBadRequest,
+			call: func(e *repositoryExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteRepository),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method+" "+tc.path, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.query, r.URL.Query().Get("repositoryId")); diff != "" {
+					t.Errorf("repositoryId: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := artifactregistry.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			err := tc.call(&repositoryExternal{repositories: s.Projects.Locations.Repositories, projectID: projectID}, newRepository())
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package artifactregistry
+
+import (
+	"context"
+	"time"
+
+	artifactregistry "google.golang.org/api/artifactregistry/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/artifactregistry/v1alpha1"
+	iamv1alpha1 "github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	arclient "github.com/crossplane/provider-gcp/pkg/clients/artifactregistry"
+)
+
+// Error strings.
+const (
+	errNotRepositoryIAMMember = "managed resource is not a RepositoryIAMMember"
+	errGetPolicy              = "cannot get Repository IAM policy"
+	errSetPolicy              = "cannot set Repository IAM policy"
+)
+
+// SetupRepositoryIAMMember adds a controller that reconciles
+// RepositoryIAMMembers.
+func SetupRepositoryIAMMember(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.RepositoryIAMMemberGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.RepositoryIAMMemberGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.RepositoryIAMMember{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.RepositoryIAMMemberGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.RepositoryIAMMemberGroupVersionKind),
+			&repositoryIAMMemberConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type repositoryIAMMemberConnector struct {
+	kube client.Client
+}
+
+func (c *repositoryIAMMemberConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := artifactregistry.NewService(ctx, opts.For("artifactregistry")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &repositoryIAMMemberExternal{repositories: s.Projects.Locations.Repositories}, nil
+}
+
+type repositoryIAMMemberExternal struct {
+	repositories *artifactregistry.ProjectsLocationsRepositoriesService
+}
+
+func (e *repositoryIAMMemberExternal) getPolicy(ctx context.Context, cr *v1alpha1.RepositoryIAMMember) (*artifactregistry.Policy, error) {
+	return e.repositories.GetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Repository)).OptionsRequestedPolicyVersion(iamv1alpha1.PolicyVersion).Context(ctx).Do()
+}
+
+func (e *repositoryIAMMemberExternal) setPolicy(ctx context.Context, cr *v1alpha1.RepositoryIAMMember, p *artifactregistry.Policy) error {
+	_, err := e.repositories.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Repository), &artifactregistry.SetIamPolicyRequest{Policy: p}).Context(ctx).Do()
+	return errors.Wrap(err, errSetPolicy)
+}
+
+func (e *repositoryIAMMemberExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.RepositoryIAMMember)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRepositoryIAMMember)
+	}
+	p, err := e.getPolicy(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetPolicy)
+	}
+	if arclient.BindRoleToMember(cr.Spec.ForProvider, p) {
+		return managed.ExternalObservation{}, nil
+	}
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *repositoryIAMMemberExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.RepositoryIAMMember)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRepositoryIAMMember)
+	}
+	p, err := e.getPolicy(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetPolicy)
+	}
+	if !arclient.BindRoleToMember(cr.Spec.ForProvider, p) {
+		return managed.ExternalCreation{}, nil
+	}
+	return managed.ExternalCreation{}, e.setPolicy(ctx, cr, p)
+}
+
+func (e *repositoryIAMMemberExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	// Role and member are immutable, so there is never anything to update.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *repositoryIAMMemberExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.RepositoryIAMMember)
+	if !ok {
+		return errors.New(errNotRepositoryIAMMember)
+	}
+	p, err := e.getPolicy(ctx, cr)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetPolicy)
+	}
+	if !arclient.UnbindRoleFromMember(cr.Spec.ForProvider, p) {
+		return nil
+	}
+	return e.setPolicy(ctx, cr, p)
+}
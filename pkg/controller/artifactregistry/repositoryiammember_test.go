@@ -0,0 +1,222 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package artifactregistry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	artifactregistry "google.golang.org/api/artifactregistry/v1"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/artifactregistry/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const readerRole = "roles/artifactregistry.reader"
+
+func newRepositoryIAMMember() *v1alpha1.RepositoryIAMMember {
+	m := &v1alpha1.RepositoryIAMMember{}
+	m.Spec.ForProvider = v1alpha1.RepositoryIAMMemberParameters{
+		Repository: gcp.StringPtr(repositoryName),
+		Role:       readerRole,
+		Member:     gcp.StringPtr("allUsers"),
+	}
+	return m
+}
+
+func policyHandler(t *testing.T, policy *artifactregistry.Policy, getStatus, setStatus int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		status := getStatus
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPost:
+			status = setStatus
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+		w.WriteHeader(status)
+		if status != http.StatusOK {
+			_ = json.NewEncoder(w).Encode(&artifactregistry.Policy{})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(policy)
+	})
+}
+
+func TestRepositoryIAMMemberObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotRepositoryIAMMember": {
+			reason: "Should return an error if the resource is not a RepositoryIAMMember",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotRepositoryIAMMember)},
+		},
+		"RepositoryNotFound": {
+			reason:  "Should report the member as missing if the repository does not exist",
+			mg:      newRepositoryIAMMember(),
+			handler: policyHandler(t, &artifactregistry.Policy{}, http.StatusNotFound, http.StatusOK),
+		},
+		"GetPolicyFailed": {
+			reason:  "Should return an error if the policy cannot be read",
+			mg:      newRepositoryIAMMember(),
+			handler: policyHandler(t, &artifactregistry.Policy{}, http.StatusBadRequest, http.StatusOK),
+			want:    want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetPolicy)},
+		},
+		"NotBound": {
+			reason:  "Should report the member as missing if it is not bound to the role",
+			mg:      newRepositoryIAMMember(),
+			handler: policyHandler(t, &artifactregistry.Policy{}, http.StatusOK, http.StatusOK),
+		},
+		"Bound": {
+			reason: "Should report the member as existing if it is bound to the role",
+			mg:     newRepositoryIAMMember(),
+			handler: policyHandler(t, &artifactregistry.Policy{
+				Bindings: []*artifactregistry.Binding{{Role: readerRole, Members: []string{"allUsers"}}},
+			}, http.StatusOK, http.StatusOK),
+			want: want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := artifactregistry.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := repositoryIAMMemberExternal{repositories: s.Projects.Locations.Repositories}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRepositoryIAMMemberCreate(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		mg      resource.Managed
+		wantErr error
+	}{
+		"NotRepositoryIAMMember": {
+			reason:  "Should return an error if the resource is not a RepositoryIAMMember",
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotRepositoryIAMMember),
+		},
+		"GetPolicyFailed": {
+			reason:  "Should return an error if the policy cannot be read",
+			mg:      newRepositoryIAMMember(),
+			handler: policyHandler(t, &artifactregistry.Policy{}, http.StatusBadRequest, http.StatusOK),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errGetPolicy),
+		},
+		"SetPolicyFailed": {
+			reason:  "Should return an error if the policy cannot be written",
+			mg:      newRepositoryIAMMember(),
+			handler: policyHandler(t, &artifactregistry.Policy{}, http.StatusOK, http.StatusBadRequest),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errSetPolicy),
+		},
+		"Successful": {
+			reason:  "Should succeed if the policy is written",
+			mg:      newRepositoryIAMMember(),
+			handler: policyHandler(t, &artifactregistry.Policy{}, http.StatusOK, http.StatusOK),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := artifactregistry.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := repositoryIAMMemberExternal{repositories: s.Projects.Locations.Repositories}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRepositoryIAMMemberDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		mg      resource.Managed
+		wantErr error
+	}{
+		"NotRepositoryIAMMember": {
+			reason:  "Should return an error if the resource is not a RepositoryIAMMember",
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotRepositoryIAMMember),
+		},
+		"RepositoryGone": {
+			reason:  "Should not return an error if the repository is already gone",
+			mg:      newRepositoryIAMMember(),
+			handler: policyHandler(t, &artifactregistry.Policy{}, http.StatusNotFound, http.StatusOK),
+		},
+		"SetPolicyFailed": {
+			reason: "Should return an error if the policy cannot be written",
+			mg:     newRepositoryIAMMember(),
+			handler: policyHandler(t, &artifactregistry.Policy{
+				Bindings: []*artifactregistry.Binding{{Role: readerRole, Members: []string{"allUsers"}}},
+			}, http.StatusOK, http.StatusBadRequest),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errSetPolicy),
+		},
+		"Successful": {
+			reason: "Should succeed if the policy is written",
+			mg:     newRepositoryIAMMember(),
+			handler: policyHandler(t, &artifactregistry.Policy{
+				Bindings: []*artifactregistry.Binding{{Role: readerRole, Members: []string{"allUsers"}}},
+			}, http.StatusOK, http.StatusOK),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := artifactregistry.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := repositoryIAMMemberExternal{repositories: s.Projects.Locations.Repositories}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package billingbudgets
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	billingbudgets "google.golang.org/api/billingbudgets/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/billingbudgets/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	bbclient "github.com/crossplane/provider-gcp/pkg/clients/billingbudgets"
+)
+
+// Error strings.
+const (
+	errNewClient      = "cannot create new Cloud Billing Budget client"
+	errNotBudget      = "managed resource is not a Budget"
+	errGetBudget      = "cannot get Budget"
+	errCreateBudget   = "cannot create Budget"
+	errUpdateBudget   = "cannot update Budget"
+	errDeleteBudget   = "cannot delete Budget"
+	errUpdateBudgetCR = "cannot update Budget custom resource"
+)
+
+// SetupBudget adds a controller that reconciles Budgets.
+func SetupBudget(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.BudgetGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.BudgetGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Budget{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.BudgetGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.BudgetGroupVersionKind),
+			&budgetConnector{kube: mgr.GetClient()},
+			managed.WithInitializers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type budgetConnector struct {
+	kube client.Client
+}
+
+func (c *budgetConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := billingbudgets.NewService(ctx, opts.For("billingbudgets")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &budgetExternal{kube: c.kube, budgets: s.BillingAccounts.Budgets, projectID: projectID}, nil
+}
+
+type budgetExternal struct {
+	kube      client.Client
+	budgets   *billingbudgets.BillingAccountsBudgetsService
+	projectID string
+}
+
+func (e *budgetExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Budget)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBudget)
+	}
+	// Budget IDs are assigned by Cloud Billing, so until we've created the
+	// budget we have nothing to look up.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	b, err := e.budgets.Get(bbclient.GetBudgetName(cr.Spec.ForProvider.BillingAccount, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetBudget)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	bbclient.LateInitializeBudget(&cr.Spec.ForProvider, *b)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateBudgetCR)
+		}
+	}
+	cr.Status.AtProvider = bbclient.GenerateBudgetObservation(*b)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: bbclient.IsBudgetUpToDate(e.projectID, cr.Spec.ForProvider, *b),
+	}, nil
+}
+
+func (e *budgetExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Budget)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBudget)
+	}
+	cr.SetConditions(xpv1.Creating())
+	b, err := e.budgets.Create(bbclient.GetBudgetParent(cr.Spec.ForProvider.BillingAccount), bbclient.GenerateBudget(e.projectID, cr.Spec.ForProvider)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateBudget)
+	}
+	meta.SetExternalName(cr, bbclient.GetBudgetID(b.Name))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *budgetExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Budget)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBudget)
+	}
+	name := bbclient.GetBudgetName(cr.Spec.ForProvider.BillingAccount, meta.GetExternalName(cr))
+	_, err := e.budgets.Patch(name, bbclient.GenerateBudget(e.projectID, cr.Spec.ForProvider)).UpdateMask(bbclient.BudgetUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateBudget)
+}
+
+func (e *budgetExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Budget)
+	if !ok {
+		return errors.New(errNotBudget)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.budgets.Delete(bbclient.GetBudgetName(cr.Spec.ForProvider.BillingAccount, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteBudget)
+}
@@ -0,0 +1,351 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package billingbudgets
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	billingbudgets "google.golang.org/api/billingbudgets/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/billingbudgets/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID      = "myproject-id-1234"
+	billingAccount = "012345-567890-ABCDEF"
+	budgetID       = "cool-budget"
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newBudget(m ...func(*v1alpha1.Budget)) *v1alpha1.Budget {
+	cr := &v1alpha1.Budget{}
+	meta.SetExternalName(cr, budgetID)
+	cr.Spec.ForProvider = v1alpha1.BudgetParameters{
+		BillingAccount: billingAccount,
+		DisplayName:    gcp.StringPtr("Cool budget"),
+		Amount: v1alpha1.BudgetAmount{
+			SpecifiedAmount: &v1alpha1.Money{CurrencyCode: gcp.StringPtr("EUR"), Units: 100},
+		},
+		ThresholdRules: []v1alpha1.ThresholdRule{{ThresholdPercent: 90, SpendBasis: gcp.StringPtr("CURRENT_SPEND")}},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func budget(m ...func(*billingbudgets.GoogleCloudBillingBudgetsV1Budget)) *billingbudgets.GoogleCloudBillingBudgetsV1Budget {
+	b := &billingbudgets.GoogleCloudBillingBudgetsV1Budget{
+		Name:        "billingAccounts/012345-567890-ABCDEF/budgets/cool-budget",
+		DisplayName: "Cool budget",
+		Amount: &billingbudgets.GoogleCloudBillingBudgetsV1BudgetAmount{
+			SpecifiedAmount: &billingbudgets.GoogleTypeMoney{CurrencyCode: "EUR", Units: 100},
+		},
+		ThresholdRules: []*billingbudgets.GoogleCloudBillingBudgetsV1ThresholdRule{{ThresholdPercent: 0.9, SpendBasis: "CURRENT_SPEND"}},
+	}
+	for _, f := range m {
+		f(b)
+	}
+	return b
+}
+
+func TestBudgetObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		kube    *test.MockClient
+		mg      resource.Managed
+		want    want
+	}{
+		"NotBudget": {
+			reason: "Should return an error if the resource is not a Budget",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotBudget)},
+		},
+		"NotYetCreated": {
+			reason: "Should report a budget without external name as missing",
+			mg:     newBudget(func(cr *v1alpha1.Budget) { meta.SetExternalName(cr, "") }),
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			mg:     newBudget(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/v1/billingAccounts/012345-567890-ABCDEF/budgets/cool-budget", r.URL.Path); diff != "" {
+					t.Errorf("path: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			mg:     newBudget(),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetBudget)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if the late initialization update fails",
+			mg:     newBudget(func(cr *v1alpha1.Budget) { cr.Spec.ForProvider.DisplayName = nil }),
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			want:   want{err: errors.Wrap(errBoom, errUpdateBudgetCR)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(budget())
+			}),
+		},
+		"ResourceUpToDate": {
+			reason: "Should return upToDate as true if the resource is up to date",
+			mg:     newBudget(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(budget())
+			}),
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should return upToDate as false if the resource is not up to date",
+			mg:     newBudget(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(budget(func(b *billingbudgets.GoogleCloudBillingBudgetsV1Budget) {
+					b.Amount.SpecifiedAmount.Units = 200
+				}))
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := billingbudgets.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := budgetExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				budgets:   s.BillingAccounts.Budgets,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestBudgetCreate(t *testing.T) {
+	type want struct {
+		e            managed.ExternalCreation
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		status int
+		mg     resource.Managed
+		want   want
+	}{
+		"NotBudget": {
+			reason: "Should return an error if the resource is not a Budget",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotBudget)},
+		},
+		"Successful": {
+			reason: "Should record the ID of the created budget",
+			status: http.StatusOK,
+			mg:     newBudget(func(cr *v1alpha1.Budget) { meta.SetExternalName(cr, "") }),
+			want: want{
+				e:            managed.ExternalCreation{ExternalNameAssigned: true},
+				externalName: budgetID,
+			},
+		},
+		"Failed": {
+			reason: "Should fail if the resource creation returns an error",
+			status: http.StatusBadRequest,
+			mg:     newBudget(func(cr *v1alpha1.Budget) { meta.SetExternalName(cr, "") }),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateBudget)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff("/v1/billingAccounts/012345-567890-ABCDEF/budgets", r.URL.Path); diff != "" {
+					t.Errorf("path: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.status != http.StatusOK {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(budget())
+			}))
+			defer server.Close()
+			s, _ := billingbudgets.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := budgetExternal{
+				projectID: projectID,
+				budgets:   s.BillingAccounts.Budgets,
+			}
+			got, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Budget); ok {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+					t.Errorf("\n%s\nCreate(...): -want external name, +got external name:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestBudgetUpdateDelete(t *testing.T) {
+	update := func(e *budgetExternal, mg resource.Managed) error {
+		_, err := e.Update(context.Background(), mg)
+		return err
+	}
+	del := func(e *budgetExternal, mg resource.Managed) error {
+		return e.Delete(context.Background(), mg)
+	}
+
+	cases := map[string]struct {
+		reason  string
+		method  string
+		status  int
+		call    func(e *budgetExternal, mg resource.Managed) error
+		mg      resource.Managed
+		wantErr error
+	}{
+		"UpdateNotBudget": {
+			reason:  "Should return an error if the resource is not a Budget",
+			call:    update,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotBudget),
+		},
+		"UpdateSuccessful": {
+			reason: "Should succeed if the resource update doesn't return an error",
+			method: http.MethodPatch,
+			status: http.StatusOK,
+			call:   update,
+			mg:     newBudget(),
+		},
+		"UpdateFailed": {
+			reason:  "Should fail if the resource update returns an error",
+			method:  http.MethodPatch,
+			status:  http.StatusBadRequest,
+			call:    update,
+			mg:      newBudget(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateBudget),
+		},
+		"DeleteNotBudget": {
+			reason:  "Should return an error if the resource is not a Budget",
+			call:    del,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotBudget),
+		},
+		"DeleteAlreadyGone": {
+			reason: "Should not return an error if the resource is already gone",
+			method: http.MethodDelete,
+			status: http.StatusNotFound,
+			call:   del,
+			mg:     newBudget(),
+		},
+		"DeleteFailed": {
+			reason:  "Should fail if the resource deletion returns an error",
+			method:  http.MethodDelete,
+			status:  http.StatusBadRequest,
+			call:    del,
+			mg:      newBudget(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteBudget),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff("/v1/billingAccounts/012345-567890-ABCDEF/budgets/cool-budget", r.URL.Path); diff != "" {
+					t.Errorf("path: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := billingbudgets.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &budgetExternal{
+				projectID: projectID,
+				budgets:   s.BillingAccounts.Budgets,
+			}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binaryauthorization
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	binaryauthorization "google.golang.org/api/binaryauthorization/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/binaryauthorization/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	baclient "github.com/crossplane/provider-gcp/pkg/clients/binaryauthorization"
+)
+
+// Error strings.
+const (
+	errNewClient        = "cannot create new Binary Authorization client"
+	errNotAttestor      = "managed resource is not a Attestor"
+	errGetAttestor      = "cannot get Attestor"
+	errCreateAttestor   = "cannot create Attestor"
+	errUpdateAttestor   = "cannot update Attestor"
+	errDeleteAttestor   = "cannot delete Attestor"
+	errUpdateAttestorCR = "cannot update Attestor custom resource"
+)
+
+// SetupAttestor adds a controller that reconciles Attestors.
+func SetupAttestor(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.AttestorGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.AttestorGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Attestor{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.AttestorGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.AttestorGroupVersionKind),
+			&attestorConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type attestorConnector struct {
+	kube client.Client
+}
+
+func (c *attestorConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := binaryauthorization.NewService(ctx, opts.For("binaryauthorization")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &attestorExternal{kube: c.kube, attestors: s.Projects.Attestors, projectID: projectID}, nil
+}
+
+type attestorExternal struct {
+	kube      client.Client
+	attestors *binaryauthorization.ProjectsAttestorsService
+	projectID string
+}
+
+func (e *attestorExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Attestor)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAttestor)
+	}
+	existing, err := e.attestors.Get(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetAttestor)
+	}
+	current := cr.Spec.ForProvider.DeepCopy()
+	baclient.LateInitializeAttestor(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateAttestorCR)
+		}
+	}
+	cr.Status.AtProvider = baclient.GenerateAttestorObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: baclient.IsAttestorUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *attestorExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Attestor)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAttestor)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.attestors.Create(baclient.GetAttestorParent(e.projectID, cr.Spec.ForProvider), baclient.GenerateAttestor(cr.Spec.ForProvider)).
+		AttestorId(meta.GetExternalName(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateAttestor)
+}
+
+func (e *attestorExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Attestor)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAttestor)
+	}
+	_, err := e.attestors.Update(e.name(cr), baclient.GenerateAttestor(cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAttestor)
+}
+
+func (e *attestorExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Attestor)
+	if !ok {
+		return errors.New(errNotAttestor)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.attestors.Delete(e.name(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteAttestor)
+}
+
+func (e *attestorExternal) name(cr *v1alpha1.Attestor) string {
+	return baclient.GetAttestorName(baclient.GetAttestorParent(e.projectID, cr.Spec.ForProvider), meta.GetExternalName(cr))
+}
@@ -0,0 +1,296 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binaryauthorization
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	binaryauthorization "google.golang.org/api/binaryauthorization/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/binaryauthorization/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID    = "myproject-id-1234"
+	attestorName = "projects/myproject-id-1234/attestors/built-by-cloud-build"
+	attestorPath = "/v1/" + attestorName
+	notes        = "projects/myproject-id-1234/notes/built-by-cloud-build"
+	pgpKey       = "-----BEGIN PGP PUBLIC KEY BLOCK-----"
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newAttestor(m ...func(*v1alpha1.Attestor)) *v1alpha1.Attestor {
+	cr := &v1alpha1.Attestor{}
+	meta.SetExternalName(cr, "built-by-cloud-build")
+	cr.Spec.ForProvider = v1alpha1.AttestorParameters{
+		Description: gcp.StringPtr("Images built by Cloud Build"),
+		UserOwnedGrafeasNote: v1alpha1.UserOwnedGrafeasNote{
+			NoteReference: notes,
+			PublicKeys: []v1alpha1.AttestorPublicKey{{
+				ID:                       gcp.StringPtr("ABCDEF0123456789"),
+				ASCIIArmoredPGPPublicKey: gcp.StringPtr(pgpKey),
+			}},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func attestor(m ...func(*binaryauthorization.Attestor)) *binaryauthorization.Attestor {
+	a := &binaryauthorization.Attestor{
+		Name:        attestorName,
+		UpdateTime:  "2021-06-01T00:00:00Z",
+		Description: "Images built by Cloud Build",
+		UserOwnedGrafeasNote: &binaryauthorization.UserOwnedGrafeasNote{
+			NoteReference:                 notes,
+			DelegationServiceAccountEmail: "service-123@gcp-sa-binaryauthorization.iam.gserviceaccount.com",
+			PublicKeys: []*binaryauthorization.AttestorPublicKey{{
+				Id:                       "ABCDEF0123456789",
+				AsciiArmoredPgpPublicKey: pgpKey,
+			}},
+		},
+	}
+	for _, f := range m {
+		f(a)
+	}
+	return a
+}
+
+func TestAttestorObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		obs v1alpha1.AttestorObservation
+		err error
+	}
+
+	obs := v1alpha1.AttestorObservation{
+		Name:                          attestorName,
+		UpdateTime:                    "2021-06-01T00:00:00Z",
+		DelegationServiceAccountEmail: "service-123@gcp-sa-binaryauthorization.iam.gserviceaccount.com",
+	}
+	cases := map[string]struct {
+		reason   string
+		status   int
+		attestor *binaryauthorization.Attestor
+		kube     *test.MockClient
+		mg       resource.Managed
+		want     want
+	}{
+		"NotAttestor": {
+			reason: "Should return an error if the resource is not an Attestor",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotAttestor)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the attestor does not exist",
+			status: http.StatusNotFound,
+			mg:     newAttestor(),
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the attestor fails",
+			status: http.StatusBadRequest,
+			mg:     newAttestor(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetAttestor)},
+		},
+		"LateInitFailed": {
+			reason:   "Should return an error if the late initialized spec can't be saved",
+			status:   http.StatusOK,
+			attestor: attestor(),
+			kube:     &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:       newAttestor(func(cr *v1alpha1.Attestor) { cr.Spec.ForProvider.UserOwnedGrafeasNote.PublicKeys[0].ID = nil }),
+			want:     want{err: errors.Wrap(errBoom, errUpdateAttestorCR)},
+		},
+		"ResourceUpToDate": {
+			reason:   "Should report the observation of an up to date attestor",
+			status:   http.StatusOK,
+			attestor: attestor(),
+			mg:       newAttestor(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				obs: obs,
+			},
+		},
+		"NeedsUpdate": {
+			reason:   "Should return upToDate as false if the public keys differ",
+			status:   http.StatusOK,
+			attestor: attestor(func(a *binaryauthorization.Attestor) { a.UserOwnedGrafeasNote.PublicKeys = nil }),
+			mg:       newAttestor(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				obs: obs,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet+" "+attestorPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.attestor == nil {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.attestor)
+			}))
+			defer server.Close()
+			s, _ := binaryauthorization.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &attestorExternal{kube: tc.kube, attestors: s.Projects.Attestors, projectID: projectID}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Attestor); ok && err == nil {
+				if diff := cmp.Diff(tc.want.obs, cr.Status.AtProvider); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want observation, +got observation:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestAttestorWrite(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		method string
+		path   string
+		query  string
+		status int
+		call   func(*attestorExternal, resource.Managed) error
+		want   error
+	}{
+		"CreateSuccessful": {
+			reason: "Should create the attestor with the external name as ID",
+			method: http.MethodPost,
+			path:   "/v1/projects/myproject-id-1234/attestors",
+			query:  "built-by-cloud-build",
+			status: http.StatusOK,
+			call: func(e *attestorExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+		},
+		"CreateFailed": {
+			reason: "Should return an error if creating the attestor fails",
+			method: http.MethodPost,
+			path:   "/v1/projects/myproject-id-1234/attestors",
+			query:  "built-by-cloud-build",
+			status: http.StatusBadRequest,
+			call: func(e *attestorExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateAttestor),
+		},
+		"UpdateSuccessful": {
+			reason: "Should update the attestor",
+			method: http.MethodPut,
+			path:   attestorPath,
+			status: http.StatusOK,
+			call: func(e *attestorExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+		},
+		"UpdateFailed": {
+			reason: "Should return an error if updating the attestor fails",
+			method: http.MethodPut,
+			path:   attestorPath,
+			status: http.StatusBadRequest,
+			call: func(e *attestorExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateAttestor),
+		},
+		"DeleteNotFound": {
+			reason: "Should not return an error if the attestor is already gone",
+			method: http.MethodDelete,
+			path:   attestorPath,
+			status: http.StatusNotFound,
+			call: func(e *attestorExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+		},
+		"DeleteFailed": {
+			reason: "Should return an error if deleting the attestor fails",
+			method: http.MethodDelete,
+			path:   attestorPath,
+			status: http.StatusBadRequest,
+			call: func(e *attestorExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteAttestor),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method+" "+tc.path, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.query, r.URL.Query().Get("attestorId")); diff != "" {
+					t.Errorf("attestorId: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := binaryauthorization.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			err := tc.call(&attestorExternal{attestors: s.Projects.Attestors, projectID: projectID}, newAttestor())
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
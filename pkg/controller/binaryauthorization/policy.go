@@ -0,0 +1,161 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binaryauthorization
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	binaryauthorization "google.golang.org/api/binaryauthorization/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/binaryauthorization/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	baclient "github.com/crossplane/provider-gcp/pkg/clients/binaryauthorization"
+)
+
+// Error strings.
+const (
+	errNotPolicy      = "managed resource is not a Policy"
+	errGetPolicy      = "cannot get Policy"
+	errCreatePolicy   = "cannot create Policy"
+	errUpdatePolicy   = "cannot update Policy"
+	errDeletePolicy   = "cannot delete Policy"
+	errUpdatePolicyCR = "cannot update Policy custom resource"
+)
+
+// SetupPolicy adds a controller that reconciles Policies.
+func SetupPolicy(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.PolicyGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.PolicyGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Policy{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.PolicyGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.PolicyGroupVersionKind),
+			&policyConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type policyConnector struct {
+	kube client.Client
+}
+
+func (c *policyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := binaryauthorization.NewService(ctx, opts.For("binaryauthorization")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &policyExternal{kube: c.kube, projects: s.Projects, projectID: projectID}, nil
+}
+
+type policyExternal struct {
+	kube      client.Client
+	projects  *binaryauthorization.ProjectsService
+	projectID string
+}
+
+func (e *policyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Policy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPolicy)
+	}
+	existing, err := e.projects.GetPolicy(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetPolicy)
+	}
+	// Every project has a policy, so we consider ours gone once it has been
+	// reset to the default policy during deletion.
+	if meta.WasDeleted(cr) && baclient.IsDefaultPolicy(*existing) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	current := cr.Spec.ForProvider.DeepCopy()
+	baclient.LateInitializePolicy(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdatePolicyCR)
+		}
+	}
+	cr.Status.AtProvider = baclient.GeneratePolicyObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: baclient.IsPolicyUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+// Create replaces the policy of the project, since there is no way to create
+// another one.
+func (e *policyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Policy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPolicy)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.projects.UpdatePolicy(e.name(cr), baclient.GeneratePolicy(cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreatePolicy)
+}
+
+func (e *policyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Policy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPolicy)
+	}
+	_, err := e.projects.UpdatePolicy(e.name(cr), baclient.GeneratePolicy(cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdatePolicy)
+}
+
+// Delete resets the policy of the project to the default policy, since
+// policies can't be deleted.
+func (e *policyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Policy)
+	if !ok {
+		return errors.New(errNotPolicy)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.projects.UpdatePolicy(e.name(cr), baclient.GenerateDefaultPolicy()).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeletePolicy)
+}
+
+func (e *policyExternal) name(cr *v1alpha1.Policy) string {
+	return baclient.GetPolicyName(e.projectID, cr.Spec.ForProvider)
+}
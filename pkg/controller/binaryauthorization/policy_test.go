@@ -0,0 +1,269 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binaryauthorization
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	binaryauthorization "google.golang.org/api/binaryauthorization/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/binaryauthorization/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	baclient "github.com/crossplane/provider-gcp/pkg/clients/binaryauthorization"
+)
+
+const policyPath = "/v1/projects/myproject-id-1234/policy"
+
+func newPolicy(m ...func(*v1alpha1.Policy)) *v1alpha1.Policy {
+	cr := &v1alpha1.Policy{}
+	cr.Spec.ForProvider = v1alpha1.PolicyParameters{
+		Description:                gcp.StringPtr("Only attested images"),
+		GlobalPolicyEvaluationMode: gcp.StringPtr("ENABLE"),
+		DefaultAdmissionRule: v1alpha1.AdmissionRule{
+			EvaluationMode:        "REQUIRE_ATTESTATION",
+			EnforcementMode:       "ENFORCED_BLOCK_AND_AUDIT_LOG",
+			RequireAttestationsBy: []string{attestorName},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func policy(m ...func(*binaryauthorization.Policy)) *binaryauthorization.Policy {
+	p := &binaryauthorization.Policy{
+		Name:                       "projects/myproject-id-1234/policy",
+		UpdateTime:                 "2021-06-01T00:00:00Z",
+		Description:                "Only attested images",
+		GlobalPolicyEvaluationMode: "ENABLE",
+		DefaultAdmissionRule: &binaryauthorization.AdmissionRule{
+			EvaluationMode:        "REQUIRE_ATTESTATION",
+			EnforcementMode:       "ENFORCED_BLOCK_AND_AUDIT_LOG",
+			RequireAttestationsBy: []string{attestorName},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func TestPolicyObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		obs v1alpha1.PolicyObservation
+		err error
+	}
+
+	obs := v1alpha1.PolicyObservation{
+		Name:       "projects/myproject-id-1234/policy",
+		UpdateTime: "2021-06-01T00:00:00Z",
+	}
+	deleted := func(cr *v1alpha1.Policy) {
+		now := metav1.Now()
+		cr.SetDeletionTimestamp(&now)
+	}
+	cases := map[string]struct {
+		reason string
+		status int
+		policy *binaryauthorization.Policy
+		kube   *test.MockClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotPolicy": {
+			reason: "Should return an error if the resource is not a Policy",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotPolicy)},
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the policy fails",
+			status: http.StatusBadRequest,
+			mg:     newPolicy(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetPolicy)},
+		},
+		"LateInitFailed": {
+			reason: "Should return an error if the late initialized spec can't be saved",
+			status: http.StatusOK,
+			policy: policy(),
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:     newPolicy(func(cr *v1alpha1.Policy) { cr.Spec.ForProvider.Description = nil }),
+			want:   want{err: errors.Wrap(errBoom, errUpdatePolicyCR)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should report the observation of an up to date policy",
+			status: http.StatusOK,
+			policy: policy(),
+			mg:     newPolicy(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				obs: obs,
+			},
+		},
+		"DefaultPolicy": {
+			reason: "Should report the default policy as a policy that needs an update",
+			status: http.StatusOK,
+			policy: baclient.GenerateDefaultPolicy(),
+			mg:     newPolicy(),
+			want: want{
+				e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"Deleting": {
+			reason: "Should report a policy that is being deleted as existing until it has been reset",
+			status: http.StatusOK,
+			policy: policy(),
+			mg:     newPolicy(deleted),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				obs: obs,
+			},
+		},
+		"Deleted": {
+			reason: "Should report a policy that has been reset to the default policy as gone",
+			status: http.StatusOK,
+			policy: baclient.GenerateDefaultPolicy(),
+			mg:     newPolicy(deleted),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet+" "+policyPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.policy == nil {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.policy)
+			}))
+			defer server.Close()
+			s, _ := binaryauthorization.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			kube := tc.kube
+			if kube == nil {
+				kube = &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}
+			}
+			e := &policyExternal{kube: kube, projects: s.Projects, projectID: projectID}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Policy); ok && err == nil {
+				if diff := cmp.Diff(tc.want.obs, cr.Status.AtProvider); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want observation, +got observation:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestPolicyWrite(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		status int
+		call   func(*policyExternal, resource.Managed) error
+		body   *binaryauthorization.Policy
+		want   error
+	}{
+		"CreateSuccessful": {
+			reason: "Should replace the policy of the project",
+			status: http.StatusOK,
+			call: func(e *policyExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+			body: policy(func(p *binaryauthorization.Policy) {
+				p.Name = ""
+				p.UpdateTime = ""
+			}),
+		},
+		"UpdateFailed": {
+			reason: "Should return an error if updating the policy fails",
+			status: http.StatusBadRequest,
+			call: func(e *policyExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			body: policy(func(p *binaryauthorization.Policy) {
+				p.Name = ""
+				p.UpdateTime = ""
+			}),
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdatePolicy),
+		},
+		"DeleteSuccessful": {
+			reason: "Should reset the policy to the default policy",
+			status: http.StatusOK,
+			call: func(e *policyExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			body: baclient.GenerateDefaultPolicy(),
+		},
+		"DeleteFailed": {
+			reason: "Should return an error if resetting the policy fails",
+			status: http.StatusBadRequest,
+			call: func(e *policyExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			body: baclient.GenerateDefaultPolicy(),
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeletePolicy),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPut+" "+policyPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				got := &binaryauthorization.Policy{}
+				_ = json.NewDecoder(r.Body).Decode(got)
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.body, got); diff != "" {
+					t.Errorf("body: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := binaryauthorization.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			err := tc.call(&policyExternal{projects: s.Projects, projectID: projectID}, newPolicy())
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
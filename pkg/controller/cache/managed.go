@@ -58,23 +58,35 @@ const (
 
 // SetupCloudMemorystoreInstance adds a controller that reconciles
 // CloudMemorystoreInstances.
-func SetupCloudMemorystoreInstance(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+func SetupCloudMemorystoreInstance(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
 	name := managed.ControllerName(v1beta1.CloudMemorystoreInstanceGroupKind)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: mcr.For(v1beta1.CloudMemorystoreInstanceGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
 		}).
 		For(&v1beta1.CloudMemorystoreInstance{}).
-		Complete(managed.NewReconciler(mgr,
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1beta1.CloudMemorystoreInstanceGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.CloudMemorystoreInstanceGroupVersionKind),
-			managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+			&connecter{client: mgr.GetClient()},
+			managed.WithInitializers(gcp.NewExternalNameInitializer(mgr.GetClient()), gcp.NewDefaultLocationInitializer(mgr.GetClient(), instanceLocation)),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
 
+// instanceLocation returns the region and zone of a CloudMemorystoreInstance.
+func instanceLocation(mg resource.Managed) (*string, **string) {
+	cr, ok := mg.(*v1beta1.CloudMemorystoreInstance)
+	if !ok {
+		return nil, nil
+	}
+	return &cr.Spec.ForProvider.Region, &cr.Spec.ForProvider.LocationID
+}
+
 type connecter struct {
 	client client.Client
 }
@@ -84,7 +96,7 @@ func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	s, err := redis.NewService(ctx, opts)
+	s, err := redis.NewService(ctx, opts.For("redis")...)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
@@ -112,7 +124,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 	currentSpec := cr.Spec.ForProvider.DeepCopy()
 	cloudmemorystore.LateInitializeSpec(&cr.Spec.ForProvider, *existing)
-	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
 		if err := e.kube.Update(ctx, cr); err != nil {
 			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCR)
 		}
@@ -0,0 +1,166 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	redis "google.golang.org/api/redis/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/cache/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/rediscluster"
+)
+
+// Error strings.
+const (
+	errNotRedisCluster    = "managed resource is not a RedisCluster"
+	errUpdateClusterCR    = "cannot update RedisCluster custom resource"
+	errGetRedisCluster    = "cannot get Redis cluster"
+	errCreateRedisCluster = "cannot create Redis cluster"
+	errUpdateRedisCluster = "cannot update Redis cluster"
+	errDeleteRedisCluster = "cannot delete Redis cluster"
+)
+
+// SetupRedisCluster adds a controller that reconciles RedisClusters.
+func SetupRedisCluster(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.RedisClusterGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.RedisClusterGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.RedisCluster{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.RedisClusterGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.RedisClusterGroupVersionKind),
+			&clusterConnecter{client: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type clusterConnecter struct {
+	client client.Client
+}
+
+func (c *clusterConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := redis.NewService(ctx, opts.For("redis")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &clusterExternal{clusters: s.Projects.Locations.Clusters, projectID: projectID, kube: c.client}, nil
+}
+
+type clusterExternal struct {
+	kube      client.Client
+	clusters  *redis.ProjectsLocationsClustersService
+	projectID string
+}
+
+func (e *clusterExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.RedisCluster)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRedisCluster)
+	}
+	existing, err := e.clusters.Get(rediscluster.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetRedisCluster)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	rediscluster.LateInitializeSpec(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateClusterCR)
+		}
+	}
+	cr.Status.AtProvider = rediscluster.GenerateObservation(*existing)
+	conn := managed.ConnectionDetails{}
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.RedisClusterStateActive:
+		cr.Status.SetConditions(xpv1.Available())
+		conn = rediscluster.GetConnectionDetails(cr.Status.AtProvider)
+	case v1alpha1.RedisClusterStateCreating:
+		cr.Status.SetConditions(xpv1.Creating())
+	case v1alpha1.RedisClusterStateDeleting:
+		cr.Status.SetConditions(xpv1.Deleting())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  rediscluster.IsUpToDate(cr.Spec.ForProvider, *existing),
+		ConnectionDetails: conn,
+	}, nil
+}
+
+func (e *clusterExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.RedisCluster)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRedisCluster)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	c := rediscluster.GenerateCluster(rediscluster.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr)), cr.Spec.ForProvider)
+	_, err := e.clusters.Create(rediscluster.GetFullyQualifiedParent(e.projectID, cr.Spec.ForProvider), c).ClusterId(meta.GetExternalName(cr)).RequestId(gcp.CreateRequestID(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateRedisCluster)
+}
+
+func (e *clusterExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.RedisCluster)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRedisCluster)
+	}
+	// Scaling is rejected while another update is still in progress.
+	if cr.Status.AtProvider.State == v1alpha1.RedisClusterStateUpdating {
+		return managed.ExternalUpdate{}, nil
+	}
+	fqn := rediscluster.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+	_, err := e.clusters.Patch(fqn, rediscluster.GenerateCluster(fqn, cr.Spec.ForProvider)).UpdateMask(rediscluster.UpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateRedisCluster)
+}
+
+func (e *clusterExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.RedisCluster)
+	if !ok {
+		return errors.New(errNotRedisCluster)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.clusters.Delete(rediscluster.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteRedisCluster)
+}
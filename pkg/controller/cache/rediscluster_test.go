@@ -0,0 +1,327 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/option"
+	redis "google.golang.org/api/redis/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/cache/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	redisClusterName = "cool-cluster"
+	pscNetwork       = "projects/coolProject/global/networks/default"
+)
+
+var errBoom = errors.New("boom")
+
+var _ managed.ExternalClient = &clusterExternal{}
+var _ managed.ExternalConnecter = &clusterConnecter{}
+
+type redisClusterModifier func(*v1alpha1.RedisCluster)
+
+func withClusterState(s string) redisClusterModifier {
+	return func(c *v1alpha1.RedisCluster) { c.Status.AtProvider.State = s }
+}
+
+func redisCluster(m ...redisClusterModifier) *v1alpha1.RedisCluster {
+	c := &v1alpha1.RedisCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: redisClusterName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: redisClusterName,
+			},
+		},
+		Spec: v1alpha1.RedisClusterSpec{
+			ForProvider: v1alpha1.RedisClusterParameters{
+				Region:     region,
+				ShardCount: 3,
+				PSCConfigs: []v1alpha1.PSCConfig{{Network: gcp.StringPtr(pscNetwork)}},
+			},
+		},
+	}
+	for _, f := range m {
+		f(c)
+	}
+	return c
+}
+
+func TestRedisClusterObserve(t *testing.T) {
+	type want struct {
+		observation managed.ExternalObservation
+		err         error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotRedisCluster": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}),
+			mg:      &strange{},
+			want:    want{err: errors.New(errNotRedisCluster)},
+		},
+		"DoesNotExist": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: redisCluster(),
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+			}),
+			mg:   redisCluster(),
+			want: want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetRedisCluster)},
+		},
+		"LateInitFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&redis.Cluster{ShardCount: 3, ReplicaCount: 1})
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:   redisCluster(),
+			want: want{err: errors.Wrap(errBoom, errUpdateClusterCR)},
+		},
+		"Active": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&redis.Cluster{
+					State:              v1alpha1.RedisClusterStateActive,
+					ShardCount:         3,
+					DiscoveryEndpoints: []*redis.DiscoveryEndpoint{{Address: host, Port: port}},
+				})
+			}),
+			mg: redisCluster(),
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(host),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(port)),
+					},
+				},
+			},
+		},
+		"CreatingNeedsScaling": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&redis.Cluster{
+					State:      v1alpha1.RedisClusterStateCreating,
+					ShardCount: 1,
+				})
+			}),
+			mg: redisCluster(),
+			want: want{
+				observation: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := redis.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := clusterExternal{
+				kube:      tc.kube,
+				projectID: project,
+				clusters:  s.Projects.Locations.Clusters,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.observation, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRedisClusterCreate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(redisClusterName, r.URL.Query().Get("clusterId")); diff != "" {
+					t.Errorf("clusterId: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&redis.Operation{})
+			}),
+			mg: redisCluster(),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+			}),
+			mg:   redisCluster(),
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateRedisCluster),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := redis.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := clusterExternal{projectID: project, clusters: s.Projects.Locations.Clusters}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRedisClusterUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&redis.Operation{})
+			}),
+			mg: redisCluster(withClusterState(v1alpha1.RedisClusterStateActive)),
+		},
+		"UpdateInProgress": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected %s request", r.Method)
+			}),
+			mg: redisCluster(withClusterState(v1alpha1.RedisClusterStateUpdating)),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+			}),
+			mg:   redisCluster(withClusterState(v1alpha1.RedisClusterStateActive)),
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateRedisCluster),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := redis.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := clusterExternal{projectID: project, clusters: s.Projects.Locations.Clusters}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRedisClusterDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&redis.Operation{})
+			}),
+			mg: redisCluster(),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: redisCluster(),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+			}),
+			mg:   redisCluster(),
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteRedisCluster),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := redis.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := clusterExternal{projectID: project, clusters: s.Projects.Locations.Clusters}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
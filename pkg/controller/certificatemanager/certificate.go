@@ -0,0 +1,161 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificatemanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	certificatemanager "google.golang.org/api/certificatemanager/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/certificatemanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	cmclient "github.com/crossplane/provider-gcp/pkg/clients/certificatemanager"
+)
+
+// Error strings.
+const (
+	errNewClient           = "cannot create new Certificate Manager client"
+	errNotCertificate      = "managed resource is not a Certificate Manager Certificate"
+	errGetCertificate      = "cannot get Certificate Manager Certificate"
+	errGetPrivateKey       = "cannot get Certificate Manager Certificate private key"
+	errCreateCertificate   = "cannot create Certificate Manager Certificate"
+	errUpdateCertificate   = "cannot update Certificate Manager Certificate"
+	errDeleteCertificate   = "cannot delete Certificate Manager Certificate"
+	errUpdateCertificateCR = "cannot update Certificate Manager Certificate custom resource"
+)
+
+// SetupCertificate adds a controller that reconciles Certificate Manager
+// Certificates.
+func SetupCertificate(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.CertificateGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.CertificateGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Certificate{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.CertificateGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.CertificateGroupVersionKind),
+			&certificateConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type certificateConnector struct {
+	kube client.Client
+}
+
+func (c *certificateConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := certificatemanager.NewService(ctx, opts.For("certificatemanager")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &certificateExternal{kube: c.kube, certificates: s.Projects.Locations.Certificates, projectID: projectID}, nil
+}
+
+type certificateExternal struct {
+	kube         client.Client
+	certificates *certificatemanager.ProjectsLocationsCertificatesService
+	projectID    string
+}
+
+func (e *certificateExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Certificate)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCertificate)
+	}
+	existing, err := e.certificates.Get(cmclient.GetCertificateName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetCertificate)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	cmclient.LateInitializeCertificate(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCertificateCR)
+		}
+	}
+	cr.Status.AtProvider = cmclient.GenerateCertificateObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: cmclient.IsCertificateUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *certificateExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Certificate)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCertificate)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	key, err := cmclient.GetPrivateKey(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetPrivateKey)
+	}
+	name := meta.GetExternalName(cr)
+	c := cmclient.GenerateCertificate(cmclient.GetCertificateName(e.projectID, cr.Spec.ForProvider, name), cr.Spec.ForProvider, key)
+	_, err = e.certificates.Create(cmclient.GetCertificateParent(e.projectID, cr.Spec.ForProvider), c).CertificateId(name).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateCertificate)
+}
+
+func (e *certificateExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Certificate)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCertificate)
+	}
+	// Only the description and labels are updated, so there is no need to
+	// send the private key again.
+	name := cmclient.GetCertificateName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+	c := cmclient.GenerateCertificate(name, cr.Spec.ForProvider, "")
+	_, err := e.certificates.Patch(name, c).UpdateMask(cmclient.UpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCertificate)
+}
+
+func (e *certificateExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Certificate)
+	if !ok {
+		return errors.New(errNotCertificate)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.certificates.Delete(cmclient.GetCertificateName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteCertificate)
+}
@@ -0,0 +1,335 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificatemanager
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	certificatemanager "google.golang.org/api/certificatemanager/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/certificatemanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID = "myproject-id-1234"
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newCertificate() *v1alpha1.Certificate {
+	cr := &v1alpha1.Certificate{}
+	meta.SetExternalName(cr, "my-certificate")
+	cr.Spec.ForProvider = v1alpha1.CertificateParameters{
+		Location:    "global",
+		Description: gcp.StringPtr("My certificate"),
+		Labels:      map[string]string{"env": "dev"},
+		Scope:       gcp.StringPtr(v1alpha1.CertificateScopeDefault),
+		Managed: &v1alpha1.ManagedCertificate{
+			Domains: []string{"example.com"},
+		},
+	}
+	return cr
+}
+
+func TestCertificateObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotCertificate": {
+			reason: "Should return an error if the resource is not a Certificate",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotCertificate)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			mg:     newCertificate(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			mg:     newCertificate(),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetCertificate)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(&certificatemanager.Certificate{})
+			}),
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if the late initialization update fails",
+			mg: func() resource.Managed {
+				cr := newCertificate()
+				cr.Spec.ForProvider.Description = nil
+				return cr
+			}(),
+			want: want{err: errors.Wrap(errBoom, errUpdateCertificateCR)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&certificatemanager.Certificate{Description: "my-certificate"})
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should return upToDate as true if the resource is up to date",
+			mg:     newCertificate(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&certificatemanager.Certificate{
+					Description: "My certificate",
+					Labels:      map[string]string{"env": "dev"},
+					Scope:       v1alpha1.CertificateScopeDefault,
+					Managed:     &certificatemanager.ManagedCertificate{Domains: []string{"example.com"}, State: "ACTIVE"},
+				})
+			}),
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should return upToDate as false if the resource is not up to date",
+			mg:     newCertificate(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&certificatemanager.Certificate{
+					Description: "My certificate",
+					Scope:       v1alpha1.CertificateScopeDefault,
+				})
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := certificatemanager.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := certificateExternal{
+				kube:         tc.kube,
+				projectID:    projectID,
+				certificates: s.Projects.Locations.Certificates,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCertificateCreate(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		kube    client.Client
+		status  int
+		mg      resource.Managed
+		wantErr error
+	}{
+		"NotCertificate": {
+			reason:  "Should return an error if the resource is not a Certificate",
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotCertificate),
+		},
+		"GetPrivateKeyFailed": {
+			reason: "Should return an error if the private key of a self-managed certificate cannot be read",
+			kube:   &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			mg: func() resource.Managed {
+				c := newCertificate()
+				c.Spec.ForProvider.Managed = nil
+				c.Spec.ForProvider.SelfManaged = &v1alpha1.SelfManagedCertificate{
+					PEMCertificate: "-----BEGIN CERTIFICATE-----",
+					PEMPrivateKeySecretRef: xpv1.SecretKeySelector{
+						SecretReference: xpv1.SecretReference{Name: "tls", Namespace: "crossplane-system"},
+						Key:             "tls.key",
+					},
+				}
+				return c
+			}(),
+			wantErr: errors.Wrap(errors.Wrap(errBoom, "cannot get private key Secret"), errGetPrivateKey),
+		},
+		"CreateSuccessful": {
+			reason: "Should succeed if the resource creation doesn't return an error",
+			status: http.StatusOK,
+			mg:     newCertificate(),
+		},
+		"CreateFailed": {
+			reason:  "Should fail if the resource creation returns an error",
+			status:  http.StatusBadRequest,
+			mg:      newCertificate(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateCertificate),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff("my-certificate", r.URL.Query().Get("certificateId")); diff != "" {
+					t.Errorf("certificateId: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&certificatemanager.Operation{})
+			}))
+			defer server.Close()
+			s, _ := certificatemanager.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &certificateExternal{
+				kube:         tc.kube,
+				projectID:    projectID,
+				certificates: s.Projects.Locations.Certificates,
+			}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func updateCertificate(e *certificateExternal, mg resource.Managed) error {
+	_, err := e.Update(context.Background(), mg)
+	return err
+}
+
+func deleteCertificate(e *certificateExternal, mg resource.Managed) error {
+	return e.Delete(context.Background(), mg)
+}
+
+func TestCertificateUpdateDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		method  string
+		status  int
+		call    func(e *certificateExternal, mg resource.Managed) error
+		mg      resource.Managed
+		wantErr error
+	}{
+		"UpdateNotCertificate": {
+			reason:  "Should return an error if the resource is not a Certificate",
+			call:    updateCertificate,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotCertificate),
+		},
+		"UpdateSuccessful": {
+			reason: "Should succeed if the resource update doesn't return an error",
+			method: http.MethodPatch,
+			status: http.StatusOK,
+			call:   updateCertificate,
+			mg:     newCertificate(),
+		},
+		"UpdateFailed": {
+			reason:  "Should fail if the resource update returns an error",
+			method:  http.MethodPatch,
+			status:  http.StatusBadRequest,
+			call:    updateCertificate,
+			mg:      newCertificate(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateCertificate),
+		},
+		"DeleteNotCertificate": {
+			reason:  "Should return an error if the resource is not a Certificate",
+			call:    deleteCertificate,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotCertificate),
+		},
+		"DeleteAlreadyGone": {
+			reason: "Should not return an error if the resource is already gone",
+			method: http.MethodDelete,
+			status: http.StatusNotFound,
+			call:   deleteCertificate,
+			mg:     newCertificate(),
+		},
+		"DeleteFailed": {
+			reason:  "Should fail if the resource deletion returns an error",
+			method:  http.MethodDelete,
+			status:  http.StatusBadRequest,
+			call:    deleteCertificate,
+			mg:      newCertificate(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteCertificate),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&certificatemanager.Operation{})
+			}))
+			defer server.Close()
+			s, _ := certificatemanager.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &certificateExternal{
+				projectID:    projectID,
+				certificates: s.Projects.Locations.Certificates,
+			}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificatemanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	certificatemanager "google.golang.org/api/certificatemanager/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/certificatemanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	cmclient "github.com/crossplane/provider-gcp/pkg/clients/certificatemanager"
+)
+
+// Error strings.
+const (
+	errNotCertificateMap      = "managed resource is not a Certificate Manager CertificateMap"
+	errGetCertificateMap      = "cannot get Certificate Manager CertificateMap"
+	errCreateCertificateMap   = "cannot create Certificate Manager CertificateMap"
+	errUpdateCertificateMap   = "cannot update Certificate Manager CertificateMap"
+	errDeleteCertificateMap   = "cannot delete Certificate Manager CertificateMap"
+	errUpdateCertificateMapCR = "cannot update Certificate Manager CertificateMap custom resource"
+)
+
+// SetupCertificateMap adds a controller that reconciles Certificate Manager
+// CertificateMaps.
+func SetupCertificateMap(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.CertificateMapGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.CertificateMapGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.CertificateMap{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.CertificateMapGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.CertificateMapGroupVersionKind),
+			&certificateMapConnector{kube: mgr.GetClient()},
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type certificateMapConnector struct {
+	kube client.Client
+}
+
+func (c *certificateMapConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := certificatemanager.NewService(ctx, opts.For("certificatemanager")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &certificateMapExternal{kube: c.kube, maps: s.Projects.Locations.CertificateMaps, projectID: projectID}, nil
+}
+
+type certificateMapExternal struct {
+	kube      client.Client
+	maps      *certificatemanager.ProjectsLocationsCertificateMapsService
+	projectID string
+}
+
+func (e *certificateMapExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CertificateMap)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCertificateMap)
+	}
+	existing, err := e.maps.Get(cmclient.GetCertificateMapName(e.projectID, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetCertificateMap)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	cmclient.LateInitializeCertificateMap(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCertificateMapCR)
+		}
+	}
+	cr.Status.AtProvider = cmclient.GenerateCertificateMapObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: cmclient.IsCertificateMapUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *certificateMapExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CertificateMap)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCertificateMap)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	name := meta.GetExternalName(cr)
+	obj := cmclient.GenerateCertificateMap(cmclient.GetCertificateMapName(e.projectID, name), cr.Spec.ForProvider)
+	_, err := e.maps.Create(cmclient.GetCertificateMapParent(e.projectID), obj).CertificateMapId(name).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateCertificateMap)
+}
+
+func (e *certificateMapExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CertificateMap)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCertificateMap)
+	}
+	name := cmclient.GetCertificateMapName(e.projectID, meta.GetExternalName(cr))
+	obj := cmclient.GenerateCertificateMap(name, cr.Spec.ForProvider)
+	_, err := e.maps.Patch(name, obj).UpdateMask(cmclient.UpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCertificateMap)
+}
+
+func (e *certificateMapExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CertificateMap)
+	if !ok {
+		return errors.New(errNotCertificateMap)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.maps.Delete(cmclient.GetCertificateMapName(e.projectID, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteCertificateMap)
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificatemanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	certificatemanager "google.golang.org/api/certificatemanager/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/certificatemanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	cmclient "github.com/crossplane/provider-gcp/pkg/clients/certificatemanager"
+)
+
+// Error strings.
+const (
+	errNotCertificateMapEntry      = "managed resource is not a Certificate Manager CertificateMapEntry"
+	errGetCertificateMapEntry      = "cannot get Certificate Manager CertificateMapEntry"
+	errCreateCertificateMapEntry   = "cannot create Certificate Manager CertificateMapEntry"
+	errUpdateCertificateMapEntry   = "cannot update Certificate Manager CertificateMapEntry"
+	errDeleteCertificateMapEntry   = "cannot delete Certificate Manager CertificateMapEntry"
+	errUpdateCertificateMapEntryCR = "cannot update Certificate Manager CertificateMapEntry custom resource"
+)
+
+// SetupCertificateMapEntry adds a controller that reconciles Certificate Manager
+// CertificateMapEntries.
+func SetupCertificateMapEntry(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.CertificateMapEntryGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.CertificateMapEntryGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.CertificateMapEntry{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.CertificateMapEntryGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.CertificateMapEntryGroupVersionKind),
+			&certificateMapEntryConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type certificateMapEntryConnector struct {
+	kube client.Client
+}
+
+func (c *certificateMapEntryConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := certificatemanager.NewService(ctx, opts.For("certificatemanager")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &certificateMapEntryExternal{kube: c.kube, entries: s.Projects.Locations.CertificateMaps.CertificateMapEntries, projectID: projectID}, nil
+}
+
+type certificateMapEntryExternal struct {
+	kube      client.Client
+	entries   *certificatemanager.ProjectsLocationsCertificateMapsCertificateMapEntriesService
+	projectID string
+}
+
+func (e *certificateMapEntryExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CertificateMapEntry)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCertificateMapEntry)
+	}
+	existing, err := e.entries.Get(cmclient.GetCertificateMapEntryName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetCertificateMapEntry)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	cmclient.LateInitializeCertificateMapEntry(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateCertificateMapEntryCR)
+		}
+	}
+	cr.Status.AtProvider = cmclient.GenerateCertificateMapEntryObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: cmclient.IsCertificateMapEntryUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *certificateMapEntryExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CertificateMapEntry)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCertificateMapEntry)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	name := meta.GetExternalName(cr)
+	obj := cmclient.GenerateCertificateMapEntry(cmclient.GetCertificateMapEntryName(e.projectID, cr.Spec.ForProvider, name), cr.Spec.ForProvider)
+	_, err := e.entries.Create(cmclient.GetCertificateMapEntryParent(e.projectID, cr.Spec.ForProvider), obj).CertificateMapEntryId(name).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateCertificateMapEntry)
+}
+
+func (e *certificateMapEntryExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CertificateMapEntry)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCertificateMapEntry)
+	}
+	name := cmclient.GetCertificateMapEntryName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+	obj := cmclient.GenerateCertificateMapEntry(name, cr.Spec.ForProvider)
+	_, err := e.entries.Patch(name, obj).UpdateMask(cmclient.CertificateMapEntryUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCertificateMapEntry)
+}
+
+func (e *certificateMapEntryExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CertificateMapEntry)
+	if !ok {
+		return errors.New(errNotCertificateMapEntry)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.entries.Delete(cmclient.GetCertificateMapEntryName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteCertificateMapEntry)
+}
@@ -0,0 +1,296 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificatemanager
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	certificatemanager "google.golang.org/api/certificatemanager/v1"
+	"google.golang.org/api/option"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/certificatemanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+func newCertificateMapEntry() *v1alpha1.CertificateMapEntry {
+	cr := &v1alpha1.CertificateMapEntry{}
+	meta.SetExternalName(cr, "my-entry")
+	cr.Spec.ForProvider = v1alpha1.CertificateMapEntryParameters{
+		CertificateMap: "my-map",
+		Description:    gcp.StringPtr("My entry"),
+		Labels:         map[string]string{"env": "dev"},
+		Hostname:       gcp.StringPtr("www.example.com"),
+		Certificates:   []string{"projects/myproject-id-1234/locations/global/certificates/my-certificate"},
+	}
+	return cr
+}
+
+func TestCertificateMapEntryObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotCertificateMapEntry": {
+			reason: "Should return an error if the resource is not a CertificateMapEntry",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotCertificateMapEntry)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			mg:     newCertificateMapEntry(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			mg:     newCertificateMapEntry(),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetCertificateMapEntry)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(&certificatemanager.CertificateMapEntry{})
+			}),
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if the late initialization update fails",
+			mg: func() resource.Managed {
+				cr := newCertificateMapEntry()
+				cr.Spec.ForProvider.Description = nil
+				return cr
+			}(),
+			want: want{err: errors.Wrap(errBoom, errUpdateCertificateMapEntryCR)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&certificatemanager.CertificateMapEntry{Description: "my-entry"})
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should return upToDate as true if the resource is up to date",
+			mg:     newCertificateMapEntry(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&certificatemanager.CertificateMapEntry{
+					Description:  "My entry",
+					Labels:       map[string]string{"env": "dev"},
+					Hostname:     "www.example.com",
+					Certificates: []string{"projects/myproject-id-1234/locations/global/certificates/my-certificate"},
+				})
+			}),
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should return upToDate as false if the resource is not up to date",
+			mg:     newCertificateMapEntry(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&certificatemanager.CertificateMapEntry{
+					Description: "My entry",
+					Labels:      map[string]string{"env": "dev"},
+					Hostname:    "www.example.com",
+				})
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := certificatemanager.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := certificateMapEntryExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				entries:   s.Projects.Locations.CertificateMaps.CertificateMapEntries,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCertificateMapEntryCreate(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		status  int
+		mg      resource.Managed
+		wantErr error
+	}{
+		"NotCertificateMapEntry": {
+			reason:  "Should return an error if the resource is not a CertificateMapEntry",
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotCertificateMapEntry),
+		},
+		"CreateSuccessful": {
+			reason: "Should succeed if the resource creation doesn't return an error",
+			status: http.StatusOK,
+			mg:     newCertificateMapEntry(),
+		},
+		"CreateFailed": {
+			reason:  "Should fail if the resource creation returns an error",
+			status:  http.StatusBadRequest,
+			mg:      newCertificateMapEntry(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateCertificateMapEntry),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff("my-entry", r.URL.Query().Get("certificateMapEntryId")); diff != "" {
+					t.Errorf("certificateMapEntryId: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&certificatemanager.Operation{})
+			}))
+			defer server.Close()
+			s, _ := certificatemanager.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &certificateMapEntryExternal{
+				projectID: projectID,
+				entries:   s.Projects.Locations.CertificateMaps.CertificateMapEntries,
+			}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func updateCertificateMapEntry(e *certificateMapEntryExternal, mg resource.Managed) error {
+	_, err := e.Update(context.Background(), mg)
+	return err
+}
+
+func deleteCertificateMapEntry(e *certificateMapEntryExternal, mg resource.Managed) error {
+	return e.Delete(context.Background(), mg)
+}
+
+func TestCertificateMapEntryUpdateDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		method  string
+		status  int
+		call    func(e *certificateMapEntryExternal, mg resource.Managed) error
+		mg      resource.Managed
+		wantErr error
+	}{
+		"UpdateNotCertificateMapEntry": {
+			reason:  "Should return an error if the resource is not a CertificateMapEntry",
+			call:    updateCertificateMapEntry,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotCertificateMapEntry),
+		},
+		"UpdateSuccessful": {
+			reason: "Should succeed if the resource update doesn't return an error",
+			method: http.MethodPatch,
+			status: http.StatusOK,
+			call:   updateCertificateMapEntry,
+			mg:     newCertificateMapEntry(),
+		},
+		"UpdateFailed": {
+			reason:  "Should fail if the resource update returns an error",
+			method:  http.MethodPatch,
+			status:  http.StatusBadRequest,
+			call:    updateCertificateMapEntry,
+			mg:      newCertificateMapEntry(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateCertificateMapEntry),
+		},
+		"DeleteNotCertificateMapEntry": {
+			reason:  "Should return an error if the resource is not a CertificateMapEntry",
+			call:    deleteCertificateMapEntry,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotCertificateMapEntry),
+		},
+		"DeleteAlreadyGone": {
+			reason: "Should not return an error if the resource is already gone",
+			method: http.MethodDelete,
+			status: http.StatusNotFound,
+			call:   deleteCertificateMapEntry,
+			mg:     newCertificateMapEntry(),
+		},
+		"DeleteFailed": {
+			reason:  "Should fail if the resource deletion returns an error",
+			method:  http.MethodDelete,
+			status:  http.StatusBadRequest,
+			call:    deleteCertificateMapEntry,
+			mg:      newCertificateMapEntry(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteCertificateMapEntry),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&certificatemanager.Operation{})
+			}))
+			defer server.Close()
+			s, _ := certificatemanager.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &certificateMapEntryExternal{
+				projectID: projectID,
+				entries:   s.Projects.Locations.CertificateMaps.CertificateMapEntries,
+			}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
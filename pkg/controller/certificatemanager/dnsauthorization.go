@@ -0,0 +1,152 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificatemanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	certificatemanager "google.golang.org/api/certificatemanager/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/certificatemanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	cmclient "github.com/crossplane/provider-gcp/pkg/clients/certificatemanager"
+)
+
+// Error strings.
+const (
+	errNotDNSAuthorization      = "managed resource is not a Certificate Manager DNSAuthorization"
+	errGetDNSAuthorization      = "cannot get Certificate Manager DNSAuthorization"
+	errCreateDNSAuthorization   = "cannot create Certificate Manager DNSAuthorization"
+	errUpdateDNSAuthorization   = "cannot update Certificate Manager DNSAuthorization"
+	errDeleteDNSAuthorization   = "cannot delete Certificate Manager DNSAuthorization"
+	errUpdateDNSAuthorizationCR = "cannot update Certificate Manager DNSAuthorization custom resource"
+)
+
+// SetupDNSAuthorization adds a controller that reconciles Certificate Manager
+// DNSAuthorizations.
+func SetupDNSAuthorization(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.DNSAuthorizationGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.DNSAuthorizationGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.DNSAuthorization{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.DNSAuthorizationGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.DNSAuthorizationGroupVersionKind),
+			&dnsAuthorizationConnector{kube: mgr.GetClient()},
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type dnsAuthorizationConnector struct {
+	kube client.Client
+}
+
+func (c *dnsAuthorizationConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := certificatemanager.NewService(ctx, opts.For("certificatemanager")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &dnsAuthorizationExternal{kube: c.kube, authorizations: s.Projects.Locations.DnsAuthorizations, projectID: projectID}, nil
+}
+
+type dnsAuthorizationExternal struct {
+	kube           client.Client
+	authorizations *certificatemanager.ProjectsLocationsDnsAuthorizationsService
+	projectID      string
+}
+
+func (e *dnsAuthorizationExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.DNSAuthorization)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDNSAuthorization)
+	}
+	existing, err := e.authorizations.Get(cmclient.GetDNSAuthorizationName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetDNSAuthorization)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	cmclient.LateInitializeDNSAuthorization(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateDNSAuthorizationCR)
+		}
+	}
+	cr.Status.AtProvider = cmclient.GenerateDNSAuthorizationObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: cmclient.IsDNSAuthorizationUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *dnsAuthorizationExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.DNSAuthorization)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDNSAuthorization)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	name := meta.GetExternalName(cr)
+	obj := cmclient.GenerateDNSAuthorization(cmclient.GetDNSAuthorizationName(e.projectID, cr.Spec.ForProvider, name), cr.Spec.ForProvider)
+	_, err := e.authorizations.Create(cmclient.GetDNSAuthorizationParent(e.projectID, cr.Spec.ForProvider), obj).DnsAuthorizationId(name).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateDNSAuthorization)
+}
+
+func (e *dnsAuthorizationExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.DNSAuthorization)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDNSAuthorization)
+	}
+	name := cmclient.GetDNSAuthorizationName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+	obj := cmclient.GenerateDNSAuthorization(name, cr.Spec.ForProvider)
+	_, err := e.authorizations.Patch(name, obj).UpdateMask(cmclient.UpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateDNSAuthorization)
+}
+
+func (e *dnsAuthorizationExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.DNSAuthorization)
+	if !ok {
+		return errors.New(errNotDNSAuthorization)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.authorizations.Delete(cmclient.GetDNSAuthorizationName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteDNSAuthorization)
+}
@@ -0,0 +1,294 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificatemanager
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	certificatemanager "google.golang.org/api/certificatemanager/v1"
+	"google.golang.org/api/option"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/certificatemanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+func newDNSAuthorization() *v1alpha1.DNSAuthorization {
+	cr := &v1alpha1.DNSAuthorization{}
+	meta.SetExternalName(cr, "my-authorization")
+	cr.Spec.ForProvider = v1alpha1.DNSAuthorizationParameters{
+		Location:    "global",
+		Domain:      "example.com",
+		Description: gcp.StringPtr("My authorization"),
+		Labels:      map[string]string{"env": "dev"},
+	}
+	return cr
+}
+
+func TestDNSAuthorizationObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotDNSAuthorization": {
+			reason: "Should return an error if the resource is not a DNSAuthorization",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotDNSAuthorization)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			mg:     newDNSAuthorization(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			mg:     newDNSAuthorization(),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetDNSAuthorization)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(&certificatemanager.DnsAuthorization{})
+			}),
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if the late initialization update fails",
+			mg: func() resource.Managed {
+				cr := newDNSAuthorization()
+				cr.Spec.ForProvider.Description = nil
+				return cr
+			}(),
+			want: want{err: errors.Wrap(errBoom, errUpdateDNSAuthorizationCR)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&certificatemanager.DnsAuthorization{Description: "my-authorization"})
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should return upToDate as true if the resource is up to date",
+			mg:     newDNSAuthorization(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&certificatemanager.DnsAuthorization{
+					Domain:      "example.com",
+					Description: "My authorization",
+					Labels:      map[string]string{"env": "dev"},
+				})
+			}),
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should return upToDate as false if the resource is not up to date",
+			mg:     newDNSAuthorization(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&certificatemanager.DnsAuthorization{
+					Domain:      "example.com",
+					Description: "Old authorization",
+					Labels:      map[string]string{"env": "dev"},
+				})
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := certificatemanager.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := dnsAuthorizationExternal{
+				kube:           tc.kube,
+				projectID:      projectID,
+				authorizations: s.Projects.Locations.DnsAuthorizations,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDNSAuthorizationCreate(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		status  int
+		mg      resource.Managed
+		wantErr error
+	}{
+		"NotDNSAuthorization": {
+			reason:  "Should return an error if the resource is not a DNSAuthorization",
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotDNSAuthorization),
+		},
+		"CreateSuccessful": {
+			reason: "Should succeed if the resource creation doesn't return an error",
+			status: http.StatusOK,
+			mg:     newDNSAuthorization(),
+		},
+		"CreateFailed": {
+			reason:  "Should fail if the resource creation returns an error",
+			status:  http.StatusBadRequest,
+			mg:      newDNSAuthorization(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateDNSAuthorization),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff("my-authorization", r.URL.Query().Get("dnsAuthorizationId")); diff != "" {
+					t.Errorf("dnsAuthorizationId: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&certificatemanager.Operation{})
+			}))
+			defer server.Close()
+			s, _ := certificatemanager.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &dnsAuthorizationExternal{
+				projectID:      projectID,
+				authorizations: s.Projects.Locations.DnsAuthorizations,
+			}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func updateDNSAuthorization(e *dnsAuthorizationExternal, mg resource.Managed) error {
+	_, err := e.Update(context.Background(), mg)
+	return err
+}
+
+func deleteDNSAuthorization(e *dnsAuthorizationExternal, mg resource.Managed) error {
+	return e.Delete(context.Background(), mg)
+}
+
+func TestDNSAuthorizationUpdateDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		method  string
+		status  int
+		call    func(e *dnsAuthorizationExternal, mg resource.Managed) error
+		mg      resource.Managed
+		wantErr error
+	}{
+		"UpdateNotDNSAuthorization": {
+			reason:  "Should return an error if the resource is not a DNSAuthorization",
+			call:    updateDNSAuthorization,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotDNSAuthorization),
+		},
+		"UpdateSuccessful": {
+			reason: "Should succeed if the resource update doesn't return an error",
+			method: http.MethodPatch,
+			status: http.StatusOK,
+			call:   updateDNSAuthorization,
+			mg:     newDNSAuthorization(),
+		},
+		"UpdateFailed": {
+			reason:  "Should fail if the resource update returns an error",
+			method:  http.MethodPatch,
+			status:  http.StatusBadRequest,
+			call:    updateDNSAuthorization,
+			mg:      newDNSAuthorization(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateDNSAuthorization),
+		},
+		"DeleteNotDNSAuthorization": {
+			reason:  "Should return an error if the resource is not a DNSAuthorization",
+			call:    deleteDNSAuthorization,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotDNSAuthorization),
+		},
+		"DeleteAlreadyGone": {
+			reason: "Should not return an error if the resource is already gone",
+			method: http.MethodDelete,
+			status: http.StatusNotFound,
+			call:   deleteDNSAuthorization,
+			mg:     newDNSAuthorization(),
+		},
+		"DeleteFailed": {
+			reason:  "Should fail if the resource deletion returns an error",
+			method:  http.MethodDelete,
+			status:  http.StatusBadRequest,
+			call:    deleteDNSAuthorization,
+			mg:      newDNSAuthorization(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteDNSAuthorization),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&certificatemanager.Operation{})
+			}))
+			defer server.Close()
+			s, _ := certificatemanager.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &dnsAuthorizationExternal{
+				projectID:      projectID,
+				authorizations: s.Projects.Locations.DnsAuthorizations,
+			}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
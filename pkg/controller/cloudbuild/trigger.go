@@ -0,0 +1,163 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudbuild
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	cloudbuild "google.golang.org/api/cloudbuild/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/cloudbuild/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	cbclient "github.com/crossplane/provider-gcp/pkg/clients/cloudbuild"
+)
+
+// Error strings.
+const (
+	errNewClient       = "cannot create new Cloud Build client"
+	errNotTrigger      = "managed resource is not a Trigger"
+	errGetTrigger      = "cannot get Trigger"
+	errCreateTrigger   = "cannot create Trigger"
+	errUpdateTrigger   = "cannot update Trigger"
+	errDeleteTrigger   = "cannot delete Trigger"
+	errUpdateTriggerCR = "cannot update Trigger custom resource"
+)
+
+// SetupTrigger adds a controller that reconciles Triggers.
+func SetupTrigger(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.TriggerGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.TriggerGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Trigger{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.TriggerGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.TriggerGroupVersionKind),
+			&triggerConnector{kube: mgr.GetClient()},
+			managed.WithInitializers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type triggerConnector struct {
+	kube client.Client
+}
+
+func (c *triggerConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := cloudbuild.NewService(ctx, opts.For("cloudbuild")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &triggerExternal{kube: c.kube, triggers: s.Projects.Locations.Triggers, projectID: projectID}, nil
+}
+
+type triggerExternal struct {
+	kube      client.Client
+	triggers  *cloudbuild.ProjectsLocationsTriggersService
+	projectID string
+}
+
+func (e *triggerExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Trigger)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTrigger)
+	}
+	// Trigger IDs are assigned by Cloud Build, so until we've created the
+	// trigger we have nothing to look up.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	existing, err := e.triggers.Get(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetTrigger)
+	}
+	current := cr.Spec.ForProvider.DeepCopy()
+	cbclient.LateInitializeTrigger(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateTriggerCR)
+		}
+	}
+	cr.Status.AtProvider = cbclient.GenerateTriggerObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: cbclient.IsTriggerUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *triggerExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Trigger)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTrigger)
+	}
+	cr.SetConditions(xpv1.Creating())
+	t, err := e.triggers.Create(cbclient.GetTriggerParent(e.projectID, cr.Spec.ForProvider), cbclient.GenerateTrigger(cr.Spec.ForProvider)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateTrigger)
+	}
+	meta.SetExternalName(cr, t.Id)
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *triggerExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Trigger)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotTrigger)
+	}
+	_, err := e.triggers.Patch(e.name(cr), cbclient.GenerateTrigger(cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTrigger)
+}
+
+func (e *triggerExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Trigger)
+	if !ok {
+		return errors.New(errNotTrigger)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.triggers.Delete(e.name(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteTrigger)
+}
+
+func (e *triggerExternal) name(cr *v1alpha1.Trigger) string {
+	return cbclient.GetTriggerName(cbclient.GetTriggerParent(e.projectID, cr.Spec.ForProvider), meta.GetExternalName(cr))
+}
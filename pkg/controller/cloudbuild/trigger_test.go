@@ -0,0 +1,336 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudbuild
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cloudbuild "google.golang.org/api/cloudbuild/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/cloudbuild/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID     = "myproject-id-1234"
+	triggerID     = "0123-4567"
+	triggerParent = "projects/myproject-id-1234/locations/global"
+	triggerPath   = "/v1/" + triggerParent + "/triggers/" + triggerID
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newTrigger(m ...func(*v1alpha1.Trigger)) *v1alpha1.Trigger {
+	cr := &v1alpha1.Trigger{}
+	meta.SetExternalName(cr, triggerID)
+	cr.Spec.ForProvider = v1alpha1.TriggerParameters{
+		Location:    "global",
+		Name:        "deploy",
+		Description: gcp.StringPtr("Deploy on push to main"),
+		GitHub: &v1alpha1.GitHubEventsConfig{
+			Owner: "crossplane",
+			Name:  "provider-gcp",
+			Push:  &v1alpha1.PushFilter{Branch: gcp.StringPtr("^main$")},
+		},
+		Filename: gcp.StringPtr("cloudbuild.yaml"),
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func buildTrigger(m ...func(*cloudbuild.BuildTrigger)) *cloudbuild.BuildTrigger {
+	t := &cloudbuild.BuildTrigger{
+		Id:           triggerID,
+		ResourceName: triggerParent + "/triggers/deploy",
+		CreateTime:   "2021-06-01T00:00:00Z",
+		Name:         "deploy",
+		Description:  "Deploy on push to main",
+		Github: &cloudbuild.GitHubEventsConfig{
+			Owner: "crossplane",
+			Name:  "provider-gcp",
+			Push:  &cloudbuild.PushFilter{Branch: "^main$"},
+		},
+		Filename: "cloudbuild.yaml",
+	}
+	for _, f := range m {
+		f(t)
+	}
+	return t
+}
+
+func TestTriggerObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		obs v1alpha1.TriggerObservation
+		err error
+	}
+
+	obs := v1alpha1.TriggerObservation{
+		ID:           triggerID,
+		ResourceName: triggerParent + "/triggers/deploy",
+		CreateTime:   "2021-06-01T00:00:00Z",
+	}
+	cases := map[string]struct {
+		reason  string
+		status  int
+		trigger *cloudbuild.BuildTrigger
+		kube    *test.MockClient
+		mg      resource.Managed
+		want    want
+	}{
+		"NotTrigger": {
+			reason: "Should return an error if the resource is not a Trigger",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotTrigger)},
+		},
+		"NoExternalName": {
+			reason: "Should report that the trigger does not exist until it has been assigned an ID",
+			mg:     newTrigger(func(cr *v1alpha1.Trigger) { meta.SetExternalName(cr, "") }),
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the trigger does not exist",
+			status: http.StatusNotFound,
+			mg:     newTrigger(),
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the trigger fails",
+			status: http.StatusBadRequest,
+			mg:     newTrigger(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetTrigger)},
+		},
+		"LateInitFailed": {
+			reason:  "Should return an error if the late initialized spec can't be saved",
+			status:  http.StatusOK,
+			trigger: buildTrigger(),
+			kube:    &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:      newTrigger(func(cr *v1alpha1.Trigger) { cr.Spec.ForProvider.Description = nil }),
+			want:    want{err: errors.Wrap(errBoom, errUpdateTriggerCR)},
+		},
+		"ResourceUpToDate": {
+			reason:  "Should report the observation of an up to date trigger",
+			status:  http.StatusOK,
+			trigger: buildTrigger(),
+			mg:      newTrigger(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				obs: obs,
+			},
+		},
+		"NeedsUpdate": {
+			reason:  "Should return upToDate as false if the build file differs",
+			status:  http.StatusOK,
+			trigger: buildTrigger(func(t *cloudbuild.BuildTrigger) { t.Filename = "build/cloudbuild.yaml" }),
+			mg:      newTrigger(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				obs: obs,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet+" "+triggerPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.trigger == nil {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.trigger)
+			}))
+			defer server.Close()
+			s, _ := cloudbuild.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &triggerExternal{kube: tc.kube, triggers: s.Projects.Locations.Triggers, projectID: projectID}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Trigger); ok && err == nil {
+				if diff := cmp.Diff(tc.want.obs, cr.Status.AtProvider); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want observation, +got observation:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestTriggerCreate(t *testing.T) {
+	type want struct {
+		e            managed.ExternalCreation
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		status int
+		mg     resource.Managed
+		want   want
+	}{
+		"NotTrigger": {
+			reason: "Should return an error if the resource is not a Trigger",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotTrigger)},
+		},
+		"CreateSuccessful": {
+			reason: "Should record the ID Cloud Build assigned as the external name",
+			status: http.StatusOK,
+			mg:     newTrigger(func(cr *v1alpha1.Trigger) { meta.SetExternalName(cr, "") }),
+			want: want{
+				e:            managed.ExternalCreation{ExternalNameAssigned: true},
+				externalName: triggerID,
+			},
+		},
+		"CreateFailed": {
+			reason: "Should return an error if creating the trigger fails",
+			status: http.StatusBadRequest,
+			mg:     newTrigger(func(cr *v1alpha1.Trigger) { meta.SetExternalName(cr, "") }),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateTrigger)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost+" /v1/"+triggerParent+"/triggers", r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.status != http.StatusOK {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(buildTrigger())
+			}))
+			defer server.Close()
+			s, _ := cloudbuild.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &triggerExternal{triggers: s.Projects.Locations.Triggers, projectID: projectID}
+			got, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Trigger); ok && err == nil {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+					t.Errorf("\n%s\nCreate(...): -want external name, +got external name:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestTriggerUpdateDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		method string
+		status int
+		call   func(*triggerExternal, resource.Managed) error
+		want   error
+	}{
+		"UpdateSuccessful": {
+			reason: "Should patch the trigger",
+			method: http.MethodPatch,
+			status: http.StatusOK,
+			call: func(e *triggerExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+		},
+		"UpdateFailed": {
+			reason: "Should return an error if patching the trigger fails",
+			method: http.MethodPatch,
+			status: http.StatusBadRequest,
+			call: func(e *triggerExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateTrigger),
+		},
+		"DeleteNotFound": {
+			reason: "Should not return an error if the trigger is already gone",
+			method: http.MethodDelete,
+			status: http.StatusNotFound,
+			call: func(e *triggerExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+		},
+		"DeleteFailed": {
+			reason: "Should return an error if deleting the trigger fails",
+			method: http.MethodDelete,
+			status: http.StatusBadRequest,
+			call: func(e *triggerExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteTrigger),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method+" "+triggerPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := cloudbuild.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			err := tc.call(&triggerExternal{triggers: s.Projects.Locations.Triggers, projectID: projectID}, newTrigger())
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudbuild
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	cloudbuild "google.golang.org/api/cloudbuild/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/cloudbuild/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	cbclient "github.com/crossplane/provider-gcp/pkg/clients/cloudbuild"
+)
+
+// Error strings.
+const (
+	errNotWorkerPool      = "managed resource is not a WorkerPool"
+	errGetWorkerPool      = "cannot get WorkerPool"
+	errCreateWorkerPool   = "cannot create WorkerPool"
+	errUpdateWorkerPool   = "cannot update WorkerPool"
+	errDeleteWorkerPool   = "cannot delete WorkerPool"
+	errUpdateWorkerPoolCR = "cannot update WorkerPool custom resource"
+)
+
+// SetupWorkerPool adds a controller that reconciles WorkerPools.
+func SetupWorkerPool(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.WorkerPoolGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.WorkerPoolGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.WorkerPool{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.WorkerPoolGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.WorkerPoolGroupVersionKind),
+			&workerPoolConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type workerPoolConnector struct {
+	kube client.Client
+}
+
+func (c *workerPoolConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := cloudbuild.NewService(ctx, opts.For("cloudbuild")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &workerPoolExternal{kube: c.kube, workerPools: s.Projects.Locations.WorkerPools, projectID: projectID}, nil
+}
+
+type workerPoolExternal struct {
+	kube        client.Client
+	workerPools *cloudbuild.ProjectsLocationsWorkerPoolsService
+	projectID   string
+}
+
+func (e *workerPoolExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.WorkerPool)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotWorkerPool)
+	}
+	existing, err := e.workerPools.Get(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetWorkerPool)
+	}
+	current := cr.Spec.ForProvider.DeepCopy()
+	cbclient.LateInitializeWorkerPool(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateWorkerPoolCR)
+		}
+	}
+	cr.Status.AtProvider = cbclient.GenerateWorkerPoolObservation(*existing)
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.WorkerPoolStateRunning, v1alpha1.WorkerPoolStateUpdating:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1alpha1.WorkerPoolStateCreating:
+		cr.Status.SetConditions(xpv1.Creating())
+	case v1alpha1.WorkerPoolStateDeleting:
+		cr.Status.SetConditions(xpv1.Deleting())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: cbclient.IsWorkerPoolUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *workerPoolExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.WorkerPool)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotWorkerPool)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.workerPools.Create(cbclient.GetWorkerPoolParent(e.projectID, cr.Spec.ForProvider), cbclient.GenerateWorkerPool(cr.Spec.ForProvider)).
+		WorkerPoolId(meta.GetExternalName(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateWorkerPool)
+}
+
+func (e *workerPoolExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.WorkerPool)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotWorkerPool)
+	}
+	_, err := e.workerPools.Patch(e.name(cr), cbclient.GenerateWorkerPool(cr.Spec.ForProvider)).
+		UpdateMask(cbclient.WorkerPoolUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateWorkerPool)
+}
+
+func (e *workerPoolExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.WorkerPool)
+	if !ok {
+		return errors.New(errNotWorkerPool)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.workerPools.Delete(e.name(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteWorkerPool)
+}
+
+func (e *workerPoolExternal) name(cr *v1alpha1.WorkerPool) string {
+	return cbclient.GetWorkerPoolName(cbclient.GetWorkerPoolParent(e.projectID, cr.Spec.ForProvider), meta.GetExternalName(cr))
+}
@@ -0,0 +1,281 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudbuild
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cloudbuild "google.golang.org/api/cloudbuild/v1"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/cloudbuild/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	workerPoolName = "projects/myproject-id-1234/locations/us-central1/workerPools/private"
+	workerPoolPath = "/v1/" + workerPoolName
+)
+
+func newWorkerPool(m ...func(*v1alpha1.WorkerPool)) *v1alpha1.WorkerPool {
+	cr := &v1alpha1.WorkerPool{}
+	meta.SetExternalName(cr, "private")
+	cr.Spec.ForProvider = v1alpha1.WorkerPoolParameters{
+		Location:    "us-central1",
+		DisplayName: gcp.StringPtr("Private builds"),
+		WorkerConfig: &v1alpha1.WorkerConfig{
+			MachineType: gcp.StringPtr("e2-standard-4"),
+			DiskSizeGB:  gcp.Int64Ptr(100),
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func workerPool(m ...func(*cloudbuild.WorkerPool)) *cloudbuild.WorkerPool {
+	wp := &cloudbuild.WorkerPool{
+		Name:        workerPoolName,
+		Uid:         "abc-123",
+		State:       v1alpha1.WorkerPoolStateRunning,
+		DisplayName: "Private builds",
+		PrivatePoolV1Config: &cloudbuild.PrivatePoolV1Config{
+			WorkerConfig: &cloudbuild.WorkerConfig{MachineType: "e2-standard-4", DiskSizeGb: 100},
+		},
+	}
+	for _, f := range m {
+		f(wp)
+	}
+	return wp
+}
+
+func TestWorkerPoolObserve(t *testing.T) {
+	type want struct {
+		e    managed.ExternalObservation
+		cond xpv1.Condition
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason string
+		status int
+		pool   *cloudbuild.WorkerPool
+		kube   *test.MockClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotWorkerPool": {
+			reason: "Should return an error if the resource is not a WorkerPool",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotWorkerPool)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the worker pool does not exist",
+			status: http.StatusNotFound,
+			mg:     newWorkerPool(),
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the worker pool fails",
+			status: http.StatusBadRequest,
+			mg:     newWorkerPool(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetWorkerPool)},
+		},
+		"LateInitFailed": {
+			reason: "Should return an error if the late initialized spec can't be saved",
+			status: http.StatusOK,
+			pool:   workerPool(),
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:     newWorkerPool(func(cr *v1alpha1.WorkerPool) { cr.Spec.ForProvider.DisplayName = nil }),
+			want:   want{err: errors.Wrap(errBoom, errUpdateWorkerPoolCR)},
+		},
+		"Running": {
+			reason: "Should report a running, up to date worker pool as available",
+			status: http.StatusOK,
+			pool:   workerPool(),
+			mg:     newWorkerPool(),
+			want: want{
+				e:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Available(),
+			},
+		},
+		"Creating": {
+			reason: "Should report a worker pool that is being created as creating",
+			status: http.StatusOK,
+			pool:   workerPool(func(wp *cloudbuild.WorkerPool) { wp.State = v1alpha1.WorkerPoolStateCreating }),
+			mg:     newWorkerPool(),
+			want: want{
+				e:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Creating(),
+			},
+		},
+		"NeedsUpdate": {
+			reason: "Should return upToDate as false if the machine type differs",
+			status: http.StatusOK,
+			pool: workerPool(func(wp *cloudbuild.WorkerPool) {
+				wp.PrivatePoolV1Config.WorkerConfig.MachineType = "e2-standard-8"
+			}),
+			mg: newWorkerPool(),
+			want: want{
+				e:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				cond: xpv1.Available(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet+" "+workerPoolPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.pool == nil {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.pool)
+			}))
+			defer server.Close()
+			s, _ := cloudbuild.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &workerPoolExternal{kube: tc.kube, workerPools: s.Projects.Locations.WorkerPools, projectID: projectID}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.WorkerPool); ok && got.ResourceExists {
+				if diff := cmp.Diff(tc.want.cond, cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want condition, +got condition:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestWorkerPoolWrite(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		method string
+		path   string
+		query  string
+		status int
+		call   func(*workerPoolExternal, resource.Managed) error
+		want   error
+	}{
+		"CreateSuccessful": {
+			reason: "Should create the worker pool with the external name as ID",
+			method: http.MethodPost,
+			path:   "/v1/projects/myproject-id-1234/locations/us-central1/workerPools",
+			query:  "private",
+			status: http.StatusOK,
+			call: func(e *workerPoolExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+		},
+		"CreateFailed": {
+			reason: "Should return an error if creating the worker pool fails",
+			method: http.MethodPost,
+			path:   "/v1/projects/myproject-id-1234/locations/us-central1/workerPools",
+			query:  "private",
+			status: http.StatusBadRequest,
+			call: func(e *workerPoolExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateWorkerPool),
+		},
+		"UpdateSuccessful": {
+			reason: "Should patch the worker pool",
+			method: http.MethodPatch,
+			path:   workerPoolPath,
+			status: http.StatusOK,
+			call: func(e *workerPoolExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+		},
+		"UpdateFailed": {
+			reason: "Should return an error if patching the worker pool fails",
+			method: http.MethodPatch,
+			path:   workerPoolPath,
+			status: http.StatusBadRequest,
+			call: func(e *workerPoolExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateWorkerPool),
+		},
+		"DeleteNotFound": {
+			reason: "Should not return an error if the worker pool is already gone",
+			method: http.MethodDelete,
+			path:   workerPoolPath,
+			status: http.StatusNotFound,
+			call: func(e *workerPoolExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+		},
+		"DeleteFailed": {
+			reason: "Should return an error if deleting the worker pool fails",
+			method: http.MethodDelete,
+			path:   workerPoolPath,
+			status: http.StatusBadRequest,
+			call: func(e *workerPoolExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteWorkerPool),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method+" "+tc.path, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.query, r.URL.Query().Get("workerPoolId")); diff != "" {
+					t.Errorf("workerPoolId: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := cloudbuild.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			err := tc.call(&workerPoolExternal{workerPools: s.Projects.Locations.WorkerPools, projectID: projectID}, newWorkerPool())
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
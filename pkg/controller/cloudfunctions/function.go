@@ -0,0 +1,178 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudfunctions
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	cloudfunctions "google.golang.org/api/cloudfunctions/v2"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/cloudfunctions/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	cloudfunctionsclient "github.com/crossplane/provider-gcp/pkg/clients/cloudfunctions"
+)
+
+// Error strings.
+const (
+	errNewClient        = "cannot create new Cloud Functions Service"
+	errNotFunction      = "managed resource is not a Cloud Function"
+	errGetFunction      = "cannot get Cloud Function"
+	errCreateFunction   = "cannot create Cloud Function"
+	errUpdateFunction   = "cannot update Cloud Function"
+	errDeleteFunction   = "cannot delete Cloud Function"
+	errUpdateFunctionCR = "cannot update Cloud Function custom resource"
+)
+
+// SetupFunction adds a controller that reconciles Cloud Functions.
+func SetupFunction(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.FunctionGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.FunctionGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Function{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.FunctionGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.FunctionGroupVersionKind),
+			&functionConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type functionConnector struct {
+	kube client.Client
+}
+
+func (c *functionConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := cloudfunctions.NewService(ctx, opts.For("cloudfunctions")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &functionExternal{kube: c.kube, functions: s.Projects.Locations.Functions, projectID: projectID}, nil
+}
+
+type functionExternal struct {
+	kube      client.Client
+	functions *cloudfunctions.ProjectsLocationsFunctionsService
+	projectID string
+}
+
+func (e *functionExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
+	cr, ok := mg.(*v1alpha1.Function)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotFunction)
+	}
+	name := cloudfunctionsclient.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+	f, err := e.functions.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetFunction)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	cloudfunctionsclient.LateInitializeSpec(&cr.Spec.ForProvider, *f)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateFunctionCR)
+		}
+	}
+	cr.Status.AtProvider = cloudfunctionsclient.GenerateObservation(*f)
+	conn := managed.ConnectionDetails{}
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.FunctionStateActive:
+		cr.Status.SetConditions(xpv1.Available())
+		if f.Url != "" {
+			conn[xpv1.ResourceCredentialsSecretEndpointKey] = []byte(f.Url)
+		}
+	case v1alpha1.FunctionStateDeploying:
+		cr.Status.SetConditions(xpv1.Creating())
+	case v1alpha1.FunctionStateDeleting:
+		cr.Status.SetConditions(xpv1.Deleting())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+	// A function cannot be patched while it is being deployed, so we report
+	// it as up to date until the deployment finishes.
+	upToDate := true
+	if cr.Status.AtProvider.State != v1alpha1.FunctionStateDeploying {
+		upToDate, err = cloudfunctionsclient.IsUpToDate(name, &cr.Spec.ForProvider, f)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: conn,
+	}, nil
+}
+
+func (e *functionExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Function)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotFunction)
+	}
+	cr.SetConditions(xpv1.Creating())
+	f := &cloudfunctions.Function{}
+	cloudfunctionsclient.GenerateFunction(cloudfunctionsclient.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr)), cr.Spec.ForProvider, f)
+	_, err := e.functions.Create(cloudfunctionsclient.GetFullyQualifiedParent(e.projectID, cr.Spec.ForProvider), f).FunctionId(meta.GetExternalName(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateFunction)
+}
+
+func (e *functionExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Function)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotFunction)
+	}
+	name := cloudfunctionsclient.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+	f := &cloudfunctions.Function{}
+	cloudfunctionsclient.GenerateFunction(name, cr.Spec.ForProvider, f)
+	_, err := e.functions.Patch(name, f).UpdateMask(cloudfunctionsclient.UpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFunction)
+}
+
+func (e *functionExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Function)
+	if !ok {
+		return errors.New(errNotFunction)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.functions.Delete(cloudfunctionsclient.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteFunction)
+}
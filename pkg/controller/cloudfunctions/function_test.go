@@ -0,0 +1,314 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudfunctions
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cloudfunctions "google.golang.org/api/cloudfunctions/v2"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/cloudfunctions/v1alpha1"
+)
+
+const (
+	projectID   = "myproject-id-1234"
+	functionURL = "https://my-function-abc123-uc.a.run.app"
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newFunction() *v1alpha1.Function {
+	f := &v1alpha1.Function{}
+	meta.SetExternalName(f, "my-function")
+	f.Spec.ForProvider = v1alpha1.FunctionParameters{
+		Location: "us-central1",
+		BuildConfig: v1alpha1.BuildConfig{
+			Runtime:    "go121",
+			EntryPoint: "Hello",
+			Source: v1alpha1.Source{
+				StorageSource: &v1alpha1.StorageSource{Bucket: "my-bucket", Object: "function.zip"},
+			},
+		},
+	}
+	return f
+}
+
+func observedFunction(state string) *cloudfunctions.Function {
+	return &cloudfunctions.Function{
+		Name:  "projects/" + projectID + "/locations/us-central1/functions/my-function",
+		State: state,
+		Url:   functionURL,
+		BuildConfig: &cloudfunctions.BuildConfig{
+			Runtime:    "go121",
+			EntryPoint: "Hello",
+			Source: &cloudfunctions.Source{
+				StorageSource: &cloudfunctions.StorageSource{Bucket: "my-bucket", Object: "function.zip"},
+			},
+		},
+	}
+}
+
+func TestFunctionObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotFunction": {
+			reason: "Should return an error if the resource is not a Function",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotFunction)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			mg:     newFunction(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			mg:     newFunction(),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetFunction)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(&cloudfunctions.Function{})
+			}),
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if the late initialization update fails",
+			mg:     newFunction(),
+			want:   want{err: errors.Wrap(errBoom, errUpdateFunctionCR)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				f := observedFunction(v1alpha1.FunctionStateActive)
+				f.Description = "late"
+				_ = json.NewEncoder(w).Encode(f)
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+		},
+		"Active": {
+			reason: "Should publish the function URL if the function is active and up to date",
+			mg:     newFunction(),
+			want: want{e: managed.ExternalObservation{
+				ResourceExists:   true,
+				ResourceUpToDate: true,
+				ConnectionDetails: managed.ConnectionDetails{
+					xpv1.ResourceCredentialsSecretEndpointKey: []byte(functionURL),
+				},
+			}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedFunction(v1alpha1.FunctionStateActive))
+			}),
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should return upToDate as false if the resource is not up to date",
+			mg:     newFunction(),
+			want: want{e: managed.ExternalObservation{
+				ResourceExists:   true,
+				ResourceUpToDate: false,
+				ConnectionDetails: managed.ConnectionDetails{
+					xpv1.ResourceCredentialsSecretEndpointKey: []byte(functionURL),
+				},
+			}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				f := observedFunction(v1alpha1.FunctionStateActive)
+				f.BuildConfig.EntryPoint = "Goodbye"
+				_ = json.NewEncoder(w).Encode(f)
+			}),
+		},
+		"Deploying": {
+			reason: "Should report a deploying function as up to date without connection details",
+			mg:     newFunction(),
+			want: want{e: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  true,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				f := observedFunction(v1alpha1.FunctionStateDeploying)
+				f.BuildConfig.EntryPoint = "Goodbye"
+				_ = json.NewEncoder(w).Encode(f)
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := cloudfunctions.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := functionExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				functions: s.Projects.Locations.Functions,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func createFunction(e *functionExternal, mg resource.Managed) error {
+	_, err := e.Create(context.Background(), mg)
+	return err
+}
+
+func updateFunction(e *functionExternal, mg resource.Managed) error {
+	_, err := e.Update(context.Background(), mg)
+	return err
+}
+
+func deleteFunction(e *functionExternal, mg resource.Managed) error {
+	return e.Delete(context.Background(), mg)
+}
+
+func TestFunctionCreateUpdateDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		method  string
+		status  int
+		call    func(e *functionExternal, mg resource.Managed) error
+		mg      resource.Managed
+		wantErr error
+	}{
+		"CreateNotFunction": {
+			reason:  "Should return an error if the resource is not a Function",
+			call:    createFunction,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotFunction),
+		},
+		"CreateSuccessful": {
+			reason: "Should succeed if the resource creation doesn't return an error",
+			method: http.MethodPost,
+			status: http.StatusOK,
+			call:   createFunction,
+			mg:     newFunction(),
+		},
+		"CreateFailed": {
+			reason:  "Should fail if the resource creation returns an error",
+			method:  http.MethodPost,
+			status:  http.StatusBadRequest,
+			call:    createFunction,
+			mg:      newFunction(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateFunction),
+		},
+		"UpdateSuccessful": {
+			reason: "Should succeed if the resource update doesn't return an error",
+			method: http.MethodPatch,
+			status: http.StatusOK,
+			call:   updateFunction,
+			mg:     newFunction(),
+		},
+		"UpdateFailed": {
+			reason:  "Should fail if the resource update returns an error",
+			method:  http.MethodPatch,
+			status:  http.StatusBadRequest,
+			call:    updateFunction,
+			mg:      newFunction(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateFunction),
+		},
+		"DeleteAlreadyGone": {
+			reason: "Should not return an error if the resource is already gone",
+			method: http.MethodDelete,
+			status: http.StatusNotFound,
+			call:   deleteFunction,
+			mg:     newFunction(),
+		},
+		"DeleteFailed": {
+			reason:  "Should fail if the resource deletion returns an error",
+			method:  http.MethodDelete,
+			status:  http.StatusBadRequest,
+			call:    deleteFunction,
+			mg:      newFunction(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteFunction),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&cloudfunctions.Operation{})
+			}))
+			defer server.Close()
+			s, _ := cloudfunctions.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &functionExternal{
+				projectID: projectID,
+				functions: s.Projects.Locations.Functions,
+			}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudrun
+
+import (
+	"context"
+	"time"
+
+	run "google.golang.org/api/run/v2"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/cloudrun/v1alpha1"
+	iamv1alpha1 "github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	cloudrunclient "github.com/crossplane/provider-gcp/pkg/clients/cloudrun"
+)
+
+// Error strings.
+const (
+	errNotServiceIAMMember = "managed resource is not a CloudRunServiceIAMMember"
+	errGetPolicy           = "cannot get Cloud Run Service IAM policy"
+	errSetPolicy           = "cannot set Cloud Run Service IAM policy"
+)
+
+// SetupCloudRunServiceIAMMember adds a controller that reconciles
+// CloudRunServiceIAMMembers.
+func SetupCloudRunServiceIAMMember(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.CloudRunServiceIAMMemberGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.CloudRunServiceIAMMemberGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.CloudRunServiceIAMMember{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.CloudRunServiceIAMMemberGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.CloudRunServiceIAMMemberGroupVersionKind),
+			&serviceIAMMemberConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type serviceIAMMemberConnector struct {
+	kube client.Client
+}
+
+func (c *serviceIAMMemberConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := run.NewService(ctx, opts.For("run")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &serviceIAMMemberExternal{services: s.Projects.Locations.Services}, nil
+}
+
+type serviceIAMMemberExternal struct {
+	services *run.ProjectsLocationsServicesService
+}
+
+func (e *serviceIAMMemberExternal) getPolicy(ctx context.Context, cr *v1alpha1.CloudRunServiceIAMMember) (*run.GoogleIamV1Policy, error) {
+	return e.services.GetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Service)).OptionsRequestedPolicyVersion(iamv1alpha1.PolicyVersion).Context(ctx).Do()
+}
+
+func (e *serviceIAMMemberExternal) setPolicy(ctx context.Context, cr *v1alpha1.CloudRunServiceIAMMember, p *run.GoogleIamV1Policy) error {
+	_, err := e.services.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Service), &run.GoogleIamV1SetIamPolicyRequest{Policy: p}).Context(ctx).Do()
+	return errors.Wrap(err, errSetPolicy)
+}
+
+func (e *serviceIAMMemberExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CloudRunServiceIAMMember)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotServiceIAMMember)
+	}
+	p, err := e.getPolicy(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetPolicy)
+	}
+	if cloudrunclient.BindRoleToMember(cr.Spec.ForProvider, p) {
+		return managed.ExternalObservation{}, nil
+	}
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *serviceIAMMemberExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CloudRunServiceIAMMember)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotServiceIAMMember)
+	}
+	p, err := e.getPolicy(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetPolicy)
+	}
+	if !cloudrunclient.BindRoleToMember(cr.Spec.ForProvider, p) {
+		return managed.ExternalCreation{}, nil
+	}
+	return managed.ExternalCreation{}, e.setPolicy(ctx, cr, p)
+}
+
+func (e *serviceIAMMemberExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	// Role and member are immutable, so there is never anything to update.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *serviceIAMMemberExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CloudRunServiceIAMMember)
+	if !ok {
+		return errors.New(errNotServiceIAMMember)
+	}
+	p, err := e.getPolicy(ctx, cr)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetPolicy)
+	}
+	if !cloudrunclient.UnbindRoleFromMember(cr.Spec.ForProvider, p) {
+		return nil
+	}
+	return e.setPolicy(ctx, cr, p)
+}
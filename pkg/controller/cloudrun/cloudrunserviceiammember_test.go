@@ -0,0 +1,222 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudrun
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/option"
+	run "google.golang.org/api/run/v2"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/cloudrun/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const invokerRole = "roles/run.invoker"
+
+func newServiceIAMMember() *v1alpha1.CloudRunServiceIAMMember {
+	m := &v1alpha1.CloudRunServiceIAMMember{}
+	m.Spec.ForProvider = v1alpha1.CloudRunServiceIAMMemberParameters{
+		Service: gcp.StringPtr("projects/" + projectID + "/locations/us-central1/services/my-service"),
+		Role:    invokerRole,
+		Member:  gcp.StringPtr("allUsers"),
+	}
+	return m
+}
+
+func policyHandler(t *testing.T, policy *run.GoogleIamV1Policy, getStatus, setStatus int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		status := getStatus
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPost:
+			status = setStatus
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+		w.WriteHeader(status)
+		if status != http.StatusOK {
+			_ = json.NewEncoder(w).Encode(&run.GoogleIamV1Policy{})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(policy)
+	})
+}
+
+func TestServiceIAMMemberObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotServiceIAMMember": {
+			reason: "Should return an error if the resource is not a CloudRunServiceIAMMember",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotServiceIAMMember)},
+		},
+		"ServiceNotFound": {
+			reason:  "Should report the member as missing if the service does not exist",
+			mg:      newServiceIAMMember(),
+			handler: policyHandler(t, &run.GoogleIamV1Policy{}, http.StatusNotFound, http.StatusOK),
+		},
+		"GetPolicyFailed": {
+			reason:  "Should return an error if the policy cannot be read",
+			mg:      newServiceIAMMember(),
+			handler: policyHandler(t, &run.GoogleIamV1Policy{}, http.StatusBadRequest, http.StatusOK),
+			want:    want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetPolicy)},
+		},
+		"NotBound": {
+			reason:  "Should report the member as missing if it is not bound to the role",
+			mg:      newServiceIAMMember(),
+			handler: policyHandler(t, &run.GoogleIamV1Policy{}, http.StatusOK, http.StatusOK),
+		},
+		"Bound": {
+			reason: "Should report the member as existing if it is bound to the role",
+			mg:     newServiceIAMMember(),
+			handler: policyHandler(t, &run.GoogleIamV1Policy{
+				Bindings: []*run.GoogleIamV1Binding{{Role: invokerRole, Members: []string{"allUsers"}}},
+			}, http.StatusOK, http.StatusOK),
+			want: want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := run.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := serviceIAMMemberExternal{services: s.Projects.Locations.Services}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestServiceIAMMemberCreate(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		mg      resource.Managed
+		wantErr error
+	}{
+		"NotServiceIAMMember": {
+			reason:  "Should return an error if the resource is not a CloudRunServiceIAMMember",
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotServiceIAMMember),
+		},
+		"GetPolicyFailed": {
+			reason:  "Should return an error if the policy cannot be read",
+			mg:      newServiceIAMMember(),
+			handler: policyHandler(t, &run.GoogleIamV1Policy{}, http.StatusBadRequest, http.StatusOK),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errGetPolicy),
+		},
+		"SetPolicyFailed": {
+			reason:  "Should return an error if the policy cannot be written",
+			mg:      newServiceIAMMember(),
+			handler: policyHandler(t, &run.GoogleIamV1Policy{}, http.StatusOK, http.StatusBadRequest),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errSetPolicy),
+		},
+		"Successful": {
+			reason:  "Should succeed if the policy is written",
+			mg:      newServiceIAMMember(),
+			handler: policyHandler(t, &run.GoogleIamV1Policy{}, http.StatusOK, http.StatusOK),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := run.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := serviceIAMMemberExternal{services: s.Projects.Locations.Services}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestServiceIAMMemberDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		mg      resource.Managed
+		wantErr error
+	}{
+		"NotServiceIAMMember": {
+			reason:  "Should return an error if the resource is not a CloudRunServiceIAMMember",
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotServiceIAMMember),
+		},
+		"ServiceGone": {
+			reason:  "Should not return an error if the service is already gone",
+			mg:      newServiceIAMMember(),
+			handler: policyHandler(t, &run.GoogleIamV1Policy{}, http.StatusNotFound, http.StatusOK),
+		},
+		"SetPolicyFailed": {
+			reason: "Should return an error if the policy cannot be written",
+			mg:     newServiceIAMMember(),
+			handler: policyHandler(t, &run.GoogleIamV1Policy{
+				Bindings: []*run.GoogleIamV1Binding{{Role: invokerRole, Members: []string{"allUsers"}}},
+			}, http.StatusOK, http.StatusBadRequest),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errSetPolicy),
+		},
+		"Successful": {
+			reason: "Should succeed if the policy is written",
+			mg:     newServiceIAMMember(),
+			handler: policyHandler(t, &run.GoogleIamV1Policy{
+				Bindings: []*run.GoogleIamV1Binding{{Role: invokerRole, Members: []string{"allUsers"}}},
+			}, http.StatusOK, http.StatusOK),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := run.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := serviceIAMMemberExternal{services: s.Projects.Locations.Services}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
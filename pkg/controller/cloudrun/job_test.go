@@ -0,0 +1,270 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudrun
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/option"
+	run "google.golang.org/api/run/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/cloudrun/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+func newJob() *v1alpha1.Job {
+	j := &v1alpha1.Job{}
+	meta.SetExternalName(j, "my-job")
+	j.Spec.ForProvider = v1alpha1.JobParameters{
+		Location: "us-central1",
+		Template: v1alpha1.ExecutionTemplate{
+			TaskCount: gcp.Int64Ptr(1),
+			Template: v1alpha1.TaskTemplate{
+				Containers: []v1alpha1.Container{{Image: image}},
+			},
+		},
+	}
+	return j
+}
+
+func observedJob(state string) *run.GoogleCloudRunV2Job {
+	return &run.GoogleCloudRunV2Job{
+		Name:              "projects/" + projectID + "/locations/us-central1/jobs/my-job",
+		TerminalCondition: &run.GoogleCloudRunV2Condition{Type: "Ready", State: state},
+		Template: &run.GoogleCloudRunV2ExecutionTemplate{
+			TaskCount: 1,
+			Template: &run.GoogleCloudRunV2TaskTemplate{
+				Containers: []*run.GoogleCloudRunV2Container{{Image: image}},
+			},
+		},
+	}
+}
+
+func TestJobObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotJob": {
+			reason: "Should return an error if the resource is not a Job",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotJob)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			mg:     newJob(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			mg:     newJob(),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetJob)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(&run.GoogleCloudRunV2Job{})
+			}),
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if the late initialization update fails",
+			mg:     newJob(),
+			want:   want{err: errors.Wrap(errBoom, errUpdateJobCR)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				j := observedJob(v1alpha1.ConditionStateSucceeded)
+				j.Template.Template.MaxRetries = 3
+				_ = json.NewEncoder(w).Encode(j)
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should return upToDate as true if the resource is up to date",
+			mg:     newJob(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedJob(v1alpha1.ConditionStateSucceeded))
+			}),
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should return upToDate as false if the resource is not up to date",
+			mg:     newJob(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				j := observedJob(v1alpha1.ConditionStateSucceeded)
+				j.Template.Template.Containers[0].Image = "gcr.io/my-project/other"
+				_ = json.NewEncoder(w).Encode(j)
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := run.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := jobExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				jobs:      s.Projects.Locations.Jobs,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func createJob(e *jobExternal, mg resource.Managed) error {
+	_, err := e.Create(context.Background(), mg)
+	return err
+}
+
+func updateJob(e *jobExternal, mg resource.Managed) error {
+	_, err := e.Update(context.Background(), mg)
+	return err
+}
+
+func deleteJob(e *jobExternal, mg resource.Managed) error {
+	return e.Delete(context.Background(), mg)
+}
+
+func TestJobCreateUpdateDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		method  string
+		status  int
+		call    func(e *jobExternal, mg resource.Managed) error
+		mg      resource.Managed
+		wantErr error
+	}{
+		"CreateNotJob": {
+			reason:  "Should return an error if the resource is not a Job",
+			call:    createJob,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotJob),
+		},
+		"CreateSuccessful": {
+			reason: "Should succeed if the resource creation doesn't return an error",
+			method: http.MethodPost,
+			status: http.StatusOK,
+			call:   createJob,
+			mg:     newJob(),
+		},
+		"CreateFailed": {
+			reason:  "Should fail if the resource creation returns an error",
+			method:  http.MethodPost,
+			status:  http.StatusBadRequest,
+			call:    createJob,
+			mg:      newJob(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateJob),
+		},
+		"UpdateSuccessful": {
+			reason: "Should succeed if the resource update doesn't return an error",
+			method: http.MethodPatch,
+			status: http.StatusOK,
+			call:   updateJob,
+			mg:     newJob(),
+		},
+		"UpdateFailed": {
+			reason:  "Should fail if the resource update returns an error",
+			method:  http.MethodPatch,
+			status:  http.StatusBadRequest,
+			call:    updateJob,
+			mg:      newJob(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateJob),
+		},
+		"DeleteAlreadyGone": {
+			reason: "Should not return an error if the resource is already gone",
+			method: http.MethodDelete,
+			status: http.StatusNotFound,
+			call:   deleteJob,
+			mg:     newJob(),
+		},
+		"DeleteFailed": {
+			reason:  "Should fail if the resource deletion returns an error",
+			method:  http.MethodDelete,
+			status:  http.StatusBadRequest,
+			call:    deleteJob,
+			mg:      newJob(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteJob),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				// Updates read the current job before patching it.
+				if tc.method == http.MethodPatch && r.Method == http.MethodGet {
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(observedJob(v1alpha1.ConditionStateSucceeded))
+					return
+				}
+				if diff := cmp.Diff(tc.method, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&run.GoogleLongrunningOperation{})
+			}))
+			defer server.Close()
+			s, _ := run.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &jobExternal{
+				projectID: projectID,
+				jobs:      s.Projects.Locations.Jobs,
+			}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudrun
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	run "google.golang.org/api/run/v2"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/cloudrun/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	cloudrunclient "github.com/crossplane/provider-gcp/pkg/clients/cloudrun"
+)
+
+// Error strings.
+const (
+	errNewClient       = "cannot create new Cloud Run client"
+	errNotService      = "managed resource is not a Cloud Run Service"
+	errGetService      = "cannot get Cloud Run Service"
+	errCreateService   = "cannot create Cloud Run Service"
+	errUpdateService   = "cannot update Cloud Run Service"
+	errDeleteService   = "cannot delete Cloud Run Service"
+	errUpdateServiceCR = "cannot update Cloud Run Service custom resource"
+)
+
+// SetupService adds a controller that reconciles Cloud Run Services.
+func SetupService(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.ServiceGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.ServiceGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Service{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.ServiceGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ServiceGroupVersionKind),
+			&serviceConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+// conditionFor returns the Crossplane condition that corresponds to the
+// supplied terminal condition of a Cloud Run resource.
+func conditionFor(c v1alpha1.Condition) xpv1.Condition {
+	switch c.State {
+	case v1alpha1.ConditionStateSucceeded:
+		return xpv1.Available()
+	case v1alpha1.ConditionStatePending, v1alpha1.ConditionStateReconciling:
+		return xpv1.Creating()
+	default:
+		return xpv1.Unavailable()
+	}
+}
+
+type serviceConnector struct {
+	kube client.Client
+}
+
+func (c *serviceConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := run.NewService(ctx, opts.For("run")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &serviceExternal{kube: c.kube, services: s.Projects.Locations.Services, projectID: projectID}, nil
+}
+
+type serviceExternal struct {
+	kube      client.Client
+	services  *run.ProjectsLocationsServicesService
+	projectID string
+}
+
+func (e *serviceExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Service)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotService)
+	}
+	name := cloudrunclient.GetServiceName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+	s, err := e.services.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetService)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	cloudrunclient.LateInitializeService(&cr.Spec.ForProvider, *s)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateServiceCR)
+		}
+	}
+	cr.Status.AtProvider = cloudrunclient.GenerateServiceObservation(*s)
+	cr.Status.SetConditions(conditionFor(cr.Status.AtProvider.TerminalCondition))
+	conn := managed.ConnectionDetails{}
+	if s.Uri != "" {
+		conn[xpv1.ResourceCredentialsSecretEndpointKey] = []byte(s.Uri)
+	}
+	// Changes are rejected while a rollout is in progress, so we report the
+	// service as up to date until the rollout finishes.
+	upToDate := true
+	if !s.Reconciling {
+		upToDate, err = cloudrunclient.IsServiceUpToDate(name, &cr.Spec.ForProvider, s)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: conn,
+	}, nil
+}
+
+func (e *serviceExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Service)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotService)
+	}
+	cr.SetConditions(xpv1.Creating())
+	// The name of a service must be empty when it is created.
+	s := &run.GoogleCloudRunV2Service{}
+	cloudrunclient.GenerateService("", cr.Spec.ForProvider, s)
+	_, err := e.services.Create(cloudrunclient.GetParent(e.projectID, cr.Spec.ForProvider.Location), s).ServiceId(meta.GetExternalName(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateService)
+}
+
+func (e *serviceExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Service)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotService)
+	}
+	// Patching replaces the whole service, so we apply our changes on top of
+	// the observed service rather than sending only what we know about.
+	name := cloudrunclient.GetServiceName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+	s, err := e.services.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetService)
+	}
+	cloudrunclient.GenerateService(name, cr.Spec.ForProvider, s)
+	_, err = e.services.Patch(name, s).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateService)
+}
+
+func (e *serviceExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Service)
+	if !ok {
+		return errors.New(errNotService)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.services.Delete(cloudrunclient.GetServiceName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteService)
+}
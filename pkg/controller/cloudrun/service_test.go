@@ -0,0 +1,316 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudrun
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	run "google.golang.org/api/run/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/cloudrun/v1alpha1"
+)
+
+const (
+	projectID  = "myproject-id-1234"
+	image      = "us-docker.pkg.dev/cloudrun/container/hello"
+	serviceURI = "https://my-service-abc123-uc.a.run.app"
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newService() *v1alpha1.Service {
+	s := &v1alpha1.Service{}
+	meta.SetExternalName(s, "my-service")
+	s.Spec.ForProvider = v1alpha1.ServiceParameters{
+		Location: "us-central1",
+		Template: v1alpha1.RevisionTemplate{
+			Containers: []v1alpha1.Container{{Image: image}},
+		},
+	}
+	return s
+}
+
+func observedService(state string) *run.GoogleCloudRunV2Service {
+	return &run.GoogleCloudRunV2Service{
+		Name:              "projects/" + projectID + "/locations/us-central1/services/my-service",
+		Uri:               serviceURI,
+		TerminalCondition: &run.GoogleCloudRunV2Condition{Type: "Ready", State: state},
+		Template: &run.GoogleCloudRunV2RevisionTemplate{
+			Containers: []*run.GoogleCloudRunV2Container{{Image: image}},
+		},
+	}
+}
+
+func TestServiceObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotService": {
+			reason: "Should return an error if the resource is not a Service",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotService)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			mg:     newService(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			mg:     newService(),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetService)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(&run.GoogleCloudRunV2Service{})
+			}),
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if the late initialization update fails",
+			mg:     newService(),
+			want:   want{err: errors.Wrap(errBoom, errUpdateServiceCR)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				s := observedService(v1alpha1.ConditionStateSucceeded)
+				s.Ingress = "INGRESS_TRAFFIC_ALL"
+				_ = json.NewEncoder(w).Encode(s)
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+		},
+		"Ready": {
+			reason: "Should publish the service URI if the service is ready and up to date",
+			mg:     newService(),
+			want: want{e: managed.ExternalObservation{
+				ResourceExists:   true,
+				ResourceUpToDate: true,
+				ConnectionDetails: managed.ConnectionDetails{
+					xpv1.ResourceCredentialsSecretEndpointKey: []byte(serviceURI),
+				},
+			}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedService(v1alpha1.ConditionStateSucceeded))
+			}),
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should return upToDate as false if the resource is not up to date",
+			mg:     newService(),
+			want: want{e: managed.ExternalObservation{
+				ResourceExists:   true,
+				ResourceUpToDate: false,
+				ConnectionDetails: managed.ConnectionDetails{
+					xpv1.ResourceCredentialsSecretEndpointKey: []byte(serviceURI),
+				},
+			}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				s := observedService(v1alpha1.ConditionStateSucceeded)
+				s.Template.Containers[0].Image = "gcr.io/my-project/other"
+				_ = json.NewEncoder(w).Encode(s)
+			}),
+		},
+		"Reconciling": {
+			reason: "Should report a service that is rolling out as up to date",
+			mg:     newService(),
+			want: want{e: managed.ExternalObservation{
+				ResourceExists:   true,
+				ResourceUpToDate: true,
+				ConnectionDetails: managed.ConnectionDetails{
+					xpv1.ResourceCredentialsSecretEndpointKey: []byte(serviceURI),
+				},
+			}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				s := observedService(v1alpha1.ConditionStateReconciling)
+				s.Reconciling = true
+				s.Template.Containers[0].Image = "gcr.io/my-project/other"
+				_ = json.NewEncoder(w).Encode(s)
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := run.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := serviceExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				services:  s.Projects.Locations.Services,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func createService(e *serviceExternal, mg resource.Managed) error {
+	_, err := e.Create(context.Background(), mg)
+	return err
+}
+
+func updateService(e *serviceExternal, mg resource.Managed) error {
+	_, err := e.Update(context.Background(), mg)
+	return err
+}
+
+func deleteService(e *serviceExternal, mg resource.Managed) error {
+	return e.Delete(context.Background(), mg)
+}
+
+func TestServiceCreateUpdateDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		method  string
+		status  int
+		call    func(e *serviceExternal, mg resource.Managed) error
+		mg      resource.Managed
+		wantErr error
+	}{
+		"CreateNotService": {
+			reason:  "Should return an error if the resource is not a Service",
+			call:    createService,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotService),
+		},
+		"CreateSuccessful": {
+			reason: "Should succeed if the resource creation doesn't return an error",
+			method: http.MethodPost,
+			status: http.StatusOK,
+			call:   createService,
+			mg:     newService(),
+		},
+		"CreateFailed": {
+			reason:  "Should fail if the resource creation returns an error",
+			method:  http.MethodPost,
+			status:  http.StatusBadRequest,
+			call:    createService,
+			mg:      newService(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateService),
+		},
+		"UpdateSuccessful": {
+			reason: "Should succeed if the resource update doesn't return an error",
+			method: http.MethodPatch,
+			status: http.StatusOK,
+			call:   updateService,
+			mg:     newService(),
+		},
+		"UpdateFailed": {
+			reason:  "Should fail if the resource update returns an error",
+			method:  http.MethodPatch,
+			status:  http.StatusBadRequest,
+			call:    updateService,
+			mg:      newService(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateService),
+		},
+		"DeleteAlreadyGone": {
+			reason: "Should not return an error if the resource is already gone",
+			method: http.MethodDelete,
+			status: http.StatusNotFound,
+			call:   deleteService,
+			mg:     newService(),
+		},
+		"DeleteFailed": {
+			reason:  "Should fail if the resource deletion returns an error",
+			method:  http.MethodDelete,
+			status:  http.StatusBadRequest,
+			call:    deleteService,
+			mg:      newService(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteService),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				// Updates read the current service before patching it.
+				if tc.method == http.MethodPatch && r.Method == http.MethodGet {
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(observedService(v1alpha1.ConditionStateSucceeded))
+					return
+				}
+				if diff := cmp.Diff(tc.method, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&run.GoogleLongrunningOperation{})
+			}))
+			defer server.Close()
+			s, _ := run.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &serviceExternal{
+				projectID: projectID,
+				services:  s.Projects.Locations.Services,
+			}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudscheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	cloudscheduler "google.golang.org/api/cloudscheduler/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/cloudscheduler/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	jobclient "github.com/crossplane/provider-gcp/pkg/clients/cloudscheduler"
+)
+
+// Error strings.
+const (
+	errNewClient     = "cannot create new Cloud Scheduler client"
+	errNotJob        = "managed resource is not a Cloud Scheduler Job"
+	errGetJob        = "cannot get Cloud Scheduler Job"
+	errCreateJob     = "cannot create Cloud Scheduler Job"
+	errUpdateJob     = "cannot update Cloud Scheduler Job"
+	errDeleteJob     = "cannot delete Cloud Scheduler Job"
+	errUpdateJobCR   = "cannot update Cloud Scheduler Job custom resource"
+	errCheckUpToDate = "cannot determine if Cloud Scheduler Job is up to date"
+)
+
+// SetupJob adds a controller that reconciles Cloud Scheduler Jobs.
+func SetupJob(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.JobGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.JobGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Job{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.JobGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.JobGroupVersionKind),
+			&jobConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type jobConnector struct {
+	kube client.Client
+}
+
+func (c *jobConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := cloudscheduler.NewService(ctx, opts.For("cloudscheduler")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &jobExternal{kube: c.kube, jobs: s.Projects.Locations.Jobs, projectID: projectID}, nil
+}
+
+type jobExternal struct {
+	kube      client.Client
+	jobs      *cloudscheduler.ProjectsLocationsJobsService
+	projectID string
+}
+
+func (e *jobExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Job)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotJob)
+	}
+	fqn := jobclient.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+	existing, err := e.jobs.Get(fqn).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetJob)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	jobclient.LateInitializeSpec(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateJobCR)
+		}
+	}
+	cr.Status.AtProvider = jobclient.GenerateObservation(*existing)
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.JobStateEnabled, v1alpha1.JobStatePaused:
+		cr.Status.SetConditions(xpv1.Available())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+	upToDate, err := jobclient.IsUpToDate(fqn, e.projectID, &cr.Spec.ForProvider, existing)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckUpToDate)
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *jobExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Job)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotJob)
+	}
+	j := &cloudscheduler.Job{}
+	jobclient.GenerateJob(jobclient.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr)), e.projectID, cr.Spec.ForProvider, j)
+	_, err := e.jobs.Create(jobclient.GetFullyQualifiedParent(e.projectID, cr.Spec.ForProvider), j).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateJob)
+}
+
+func (e *jobExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Job)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotJob)
+	}
+	fqn := jobclient.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+	j := &cloudscheduler.Job{}
+	jobclient.GenerateJob(fqn, e.projectID, cr.Spec.ForProvider, j)
+	_, err := e.jobs.Patch(fqn, j).UpdateMask(jobclient.UpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateJob)
+}
+
+func (e *jobExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Job)
+	if !ok {
+		return errors.New(errNotJob)
+	}
+	_, err := e.jobs.Delete(jobclient.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteJob)
+}
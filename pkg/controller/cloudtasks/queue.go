@@ -0,0 +1,180 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudtasks
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	cloudtasks "google.golang.org/api/cloudtasks/v2"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/cloudtasks/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	queueclient "github.com/crossplane/provider-gcp/pkg/clients/cloudtasks"
+)
+
+// Error strings.
+const (
+	errNewClient     = "cannot create new Cloud Tasks client"
+	errNotQueue      = "managed resource is not a Cloud Tasks Queue"
+	errGetQueue      = "cannot get Cloud Tasks Queue"
+	errCreateQueue   = "cannot create Cloud Tasks Queue"
+	errUpdateQueue   = "cannot update Cloud Tasks Queue"
+	errDeleteQueue   = "cannot delete Cloud Tasks Queue"
+	errUpdateQueueCR = "cannot update Cloud Tasks Queue custom resource"
+	errCheckUpToDate = "cannot determine if Cloud Tasks Queue is up to date"
+	errPauseQueue    = "cannot pause Cloud Tasks Queue"
+	errResumeQueue   = "cannot resume Cloud Tasks Queue"
+)
+
+// SetupQueue adds a controller that reconciles Cloud Tasks Queues.
+func SetupQueue(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.QueueGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.QueueGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Queue{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.QueueGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.QueueGroupVersionKind),
+			&queueConnector{kube: mgr.GetClient()},
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type queueConnector struct {
+	kube client.Client
+}
+
+func (c *queueConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := cloudtasks.NewService(ctx, opts.For("cloudtasks")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &queueExternal{kube: c.kube, queues: s.Projects.Locations.Queues, projectID: projectID}, nil
+}
+
+type queueExternal struct {
+	kube      client.Client
+	queues    *cloudtasks.ProjectsLocationsQueuesService
+	projectID string
+}
+
+func (e *queueExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Queue)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotQueue)
+	}
+	fqn := queueclient.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+	existing, err := e.queues.Get(fqn).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetQueue)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	queueclient.LateInitializeSpec(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateQueueCR)
+		}
+	}
+	cr.Status.AtProvider = queueclient.GenerateObservation(*existing)
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.QueueStateRunning, v1alpha1.QueueStatePaused:
+		cr.Status.SetConditions(xpv1.Available())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+	upToDate, err := queueclient.IsUpToDate(fqn, &cr.Spec.ForProvider, existing)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckUpToDate)
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *queueExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Queue)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotQueue)
+	}
+	q := &cloudtasks.Queue{}
+	if err := queueclient.GenerateQueue(queueclient.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr)), cr.Spec.ForProvider, q); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateQueue)
+	}
+	_, err := e.queues.Create(queueclient.GetFullyQualifiedParent(e.projectID, cr.Spec.ForProvider), q).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateQueue)
+}
+
+func (e *queueExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Queue)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotQueue)
+	}
+	fqn := queueclient.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+	q := &cloudtasks.Queue{}
+	if err := queueclient.GenerateQueue(fqn, cr.Spec.ForProvider, q); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateQueue)
+	}
+	if _, err := e.queues.Patch(fqn, q).UpdateMask(queueclient.UpdateMask).Context(ctx).Do(); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateQueue)
+	}
+	// The state of a queue cannot be patched, it is changed by pausing or
+	// resuming the queue.
+	if queueclient.IsStateUpToDate(&cr.Spec.ForProvider, cr.Status.AtProvider.State) {
+		return managed.ExternalUpdate{}, nil
+	}
+	if gcp.StringValue(cr.Spec.ForProvider.DesiredState) == v1alpha1.QueueStatePaused {
+		_, err := e.queues.Pause(fqn, &cloudtasks.PauseQueueRequest{}).Context(ctx).Do()
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPauseQueue)
+	}
+	_, err := e.queues.Resume(fqn, &cloudtasks.ResumeQueueRequest{}).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errResumeQueue)
+}
+
+func (e *queueExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Queue)
+	if !ok {
+		return errors.New(errNotQueue)
+	}
+	_, err := e.queues.Delete(queueclient.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteQueue)
+}
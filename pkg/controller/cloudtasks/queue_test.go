@@ -0,0 +1,361 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudtasks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cloudtasks "google.golang.org/api/cloudtasks/v2"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/cloudtasks/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID = "myproject-id-1234"
+	queueName = "projects/myproject-id-1234/locations/europe-west1/queues/my-queue"
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newQueue() *v1alpha1.Queue {
+	q := &v1alpha1.Queue{}
+	meta.SetExternalName(q, "my-queue")
+	q.Spec.ForProvider = v1alpha1.QueueParameters{
+		Location:     "europe-west1",
+		DesiredState: gcp.StringPtr(v1alpha1.QueueStateRunning),
+		RateLimits: &v1alpha1.RateLimits{
+			MaxDispatchesPerSecond:  gcp.StringPtr("500"),
+			MaxConcurrentDispatches: gcp.Int64Ptr(1000),
+		},
+	}
+	q.Status.AtProvider.State = v1alpha1.QueueStateRunning
+	return q
+}
+
+func observedQueue() *cloudtasks.Queue {
+	return &cloudtasks.Queue{
+		Name:  queueName,
+		State: v1alpha1.QueueStateRunning,
+		RateLimits: &cloudtasks.RateLimits{
+			MaxDispatchesPerSecond:  500,
+			MaxConcurrentDispatches: 1000,
+			MaxBurstSize:            100,
+		},
+	}
+}
+
+func TestQueueObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotQueue": {
+			reason: "Should return an error if the resource is not a Queue",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotQueue)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			mg:     newQueue(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&cloudtasks.Queue{})
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			mg:     newQueue(),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetQueue)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(&cloudtasks.Queue{})
+			}),
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if the late initialization update fails",
+			mg:     newQueue(),
+			want:   want{err: errors.Wrap(errBoom, errUpdateQueueCR)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				q := observedQueue()
+				q.RetryConfig = &cloudtasks.RetryConfig{MaxAttempts: 100}
+				_ = json.NewEncoder(w).Encode(q)
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should return upToDate as true if the resource is up to date",
+			mg:     newQueue(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedQueue())
+			}),
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should return upToDate as false if the resource is not up to date",
+			mg:     newQueue(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				q := observedQueue()
+				q.State = v1alpha1.QueueStatePaused
+				_ = json.NewEncoder(w).Encode(q)
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := cloudtasks.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := queueExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				queues:    s.Projects.Locations.Queues,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func createQueue(e *queueExternal, mg resource.Managed) error {
+	_, err := e.Create(context.Background(), mg)
+	return err
+}
+
+func updateQueue(e *queueExternal, mg resource.Managed) error {
+	_, err := e.Update(context.Background(), mg)
+	return err
+}
+
+func deleteQueue(e *queueExternal, mg resource.Managed) error {
+	return e.Delete(context.Background(), mg)
+}
+
+func TestQueueCreateUpdateDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		method  string
+		status  int
+		call    func(e *queueExternal, mg resource.Managed) error
+		mg      resource.Managed
+		wantErr error
+	}{
+		"CreateNotQueue": {
+			reason:  "Should return an error if the resource is not a Queue",
+			call:    createQueue,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotQueue),
+		},
+		"CreateSuccessful": {
+			reason: "Should succeed if the resource creation doesn't return an error",
+			method: http.MethodPost,
+			status: http.StatusOK,
+			call:   createQueue,
+			mg:     newQueue(),
+		},
+		"CreateFailed": {
+			reason:  "Should fail if the resource creation returns an error",
+			method:  http.MethodPost,
+			status:  http.StatusBadRequest,
+			call:    createQueue,
+			mg:      newQueue(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateQueue),
+		},
+		"UpdateSuccessful": {
+			reason: "Should succeed if the resource update doesn't return an error",
+			method: http.MethodPatch,
+			status: http.StatusOK,
+			call:   updateQueue,
+			mg:     newQueue(),
+		},
+		"UpdateFailed": {
+			reason:  "Should fail if the resource update returns an error",
+			method:  http.MethodPatch,
+			status:  http.StatusBadRequest,
+			call:    updateQueue,
+			mg:      newQueue(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateQueue),
+		},
+		"DeleteAlreadyGone": {
+			reason: "Should not return an error if the resource is already gone",
+			method: http.MethodDelete,
+			status: http.StatusNotFound,
+			call:   deleteQueue,
+			mg:     newQueue(),
+		},
+		"DeleteFailed": {
+			reason:  "Should fail if the resource deletion returns an error",
+			method:  http.MethodDelete,
+			status:  http.StatusBadRequest,
+			call:    deleteQueue,
+			mg:      newQueue(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteQueue),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&cloudtasks.Queue{})
+			}))
+			defer server.Close()
+			s, _ := cloudtasks.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &queueExternal{
+				projectID: projectID,
+				queues:    s.Projects.Locations.Queues,
+			}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestQueueUpdateState(t *testing.T) {
+	type want struct {
+		paths []string
+		err   error
+	}
+	cases := map[string]struct {
+		reason  string
+		state   string
+		desired string
+		status  int
+		want    want
+	}{
+		"AlreadyRunning": {
+			reason:  "Should only patch the queue if it is already in the desired state",
+			state:   v1alpha1.QueueStateRunning,
+			desired: v1alpha1.QueueStateRunning,
+			status:  http.StatusOK,
+			want:    want{paths: []string{"/v2/" + queueName}},
+		},
+		"Pause": {
+			reason:  "Should pause a running queue that is desired to be paused",
+			state:   v1alpha1.QueueStateRunning,
+			desired: v1alpha1.QueueStatePaused,
+			status:  http.StatusOK,
+			want:    want{paths: []string{"/v2/" + queueName, "/v2/" + queueName + ":pause"}},
+		},
+		"Resume": {
+			reason:  "Should resume a paused queue that is desired to be running",
+			state:   v1alpha1.QueueStatePaused,
+			desired: v1alpha1.QueueStateRunning,
+			status:  http.StatusOK,
+			want:    want{paths: []string{"/v2/" + queueName, "/v2/" + queueName + ":resume"}},
+		},
+		"PauseFailed": {
+			reason:  "Should fail if the queue cannot be paused",
+			state:   v1alpha1.QueueStateRunning,
+			desired: v1alpha1.QueueStatePaused,
+			status:  http.StatusBadRequest,
+			want: want{
+				paths: []string{"/v2/" + queueName, "/v2/" + queueName + ":pause"},
+				err:   errors.Wrap(gError(http.StatusBadRequest, ""), errPauseQueue),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var paths []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				paths = append(paths, r.URL.Path)
+				if r.Method == http.MethodPatch {
+					w.WriteHeader(http.StatusOK)
+				} else {
+					w.WriteHeader(tc.status)
+				}
+				_ = json.NewEncoder(w).Encode(&cloudtasks.Queue{})
+			}))
+			defer server.Close()
+			s, _ := cloudtasks.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &queueExternal{
+				projectID: projectID,
+				queues:    s.Projects.Locations.Queues,
+			}
+			cr := newQueue()
+			cr.Spec.ForProvider.DesiredState = gcp.StringPtr(tc.desired)
+			cr.Status.AtProvider.State = tc.state
+			_, err := e.Update(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.paths, paths); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want paths, +got paths:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package composer
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	composer "google.golang.org/api/composer/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/composer/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	environmentclient "github.com/crossplane/provider-gcp/pkg/clients/composer"
+)
+
+// Error strings.
+const (
+	errNewClient           = "cannot create new Cloud Composer client"
+	errNotEnvironment      = "managed resource is not a Cloud Composer Environment"
+	errGetEnvironment      = "cannot get Cloud Composer Environment"
+	errCreateEnvironment   = "cannot create Cloud Composer Environment"
+	errUpdateEnvironment   = "cannot update Cloud Composer Environment"
+	errDeleteEnvironment   = "cannot delete Cloud Composer Environment"
+	errUpdateEnvironmentCR = "cannot update Cloud Composer Environment custom resource"
+)
+
+// SetupEnvironment adds a controller that reconciles Cloud Composer
+// Environments.
+func SetupEnvironment(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.EnvironmentGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.EnvironmentGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Environment{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.EnvironmentGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.EnvironmentGroupVersionKind),
+			&environmentConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type environmentConnector struct {
+	kube client.Client
+}
+
+func (c *environmentConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := composer.NewService(ctx, opts.For("composer")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &environmentExternal{kube: c.kube, environments: s.Projects.Locations.Environments, projectID: projectID}, nil
+}
+
+type environmentExternal struct {
+	kube         client.Client
+	environments *composer.ProjectsLocationsEnvironmentsService
+	projectID    string
+}
+
+func (e *environmentExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Environment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotEnvironment)
+	}
+	existing, err := e.environments.Get(environmentclient.GetEnvironmentName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetEnvironment)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	environmentclient.LateInitializeEnvironment(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateEnvironmentCR)
+		}
+	}
+	cr.Status.AtProvider = environmentclient.GenerateEnvironmentObservation(*existing)
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.EnvironmentStateRunning, v1alpha1.EnvironmentStateUpdating:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1alpha1.EnvironmentStateCreating:
+		cr.Status.SetConditions(xpv1.Creating())
+	case v1alpha1.EnvironmentStateDeleting:
+		cr.Status.SetConditions(xpv1.Deleting())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+	upToDate, _ := environmentclient.IsEnvironmentUpToDate(cr.Spec.ForProvider, *existing)
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *environmentExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Environment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotEnvironment)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	env := environmentclient.GenerateEnvironment(environmentclient.GetEnvironmentName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr)), cr.Spec.ForProvider)
+	_, err := e.environments.Create(environmentclient.GetEnvironmentParent(e.projectID, cr.Spec.ForProvider), env).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateEnvironment)
+}
+
+func (e *environmentExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Environment)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotEnvironment)
+	}
+	// Cloud Composer rejects updates while another one is in progress.
+	if cr.Status.AtProvider.State != v1alpha1.EnvironmentStateRunning {
+		return managed.ExternalUpdate{}, nil
+	}
+	name := environmentclient.GetEnvironmentName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+
+	// We have to get the environment again here to determine which field to
+	// update.
+	existing, err := e.environments.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetEnvironment)
+	}
+	upToDate, mask := environmentclient.IsEnvironmentUpToDate(cr.Spec.ForProvider, *existing)
+	if upToDate {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.environments.Patch(name, environmentclient.GenerateEnvironment(name, cr.Spec.ForProvider)).UpdateMask(mask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateEnvironment)
+}
+
+func (e *environmentExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Environment)
+	if !ok {
+		return errors.New(errNotEnvironment)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.environments.Delete(environmentclient.GetEnvironmentName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteEnvironment)
+}
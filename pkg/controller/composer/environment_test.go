@@ -0,0 +1,340 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package composer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	composer "google.golang.org/api/composer/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/composer/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID = "myproject-id-1234"
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newEnvironment() *v1alpha1.Environment {
+	e := &v1alpha1.Environment{}
+	meta.SetExternalName(e, "my-environment")
+	e.Spec.ForProvider = v1alpha1.EnvironmentParameters{
+		Location: "us-central1",
+		Labels:   map[string]string{"env": "dev"},
+		Config: &v1alpha1.EnvironmentConfig{
+			EnvironmentSize: gcp.StringPtr("ENVIRONMENT_SIZE_SMALL"),
+			SoftwareConfig: &v1alpha1.SoftwareConfig{
+				ImageVersion: gcp.StringPtr("composer-2.4.6-airflow-2.6.3"),
+				PyPIPackages: map[string]string{"numpy": ""},
+			},
+		},
+	}
+	e.Status.AtProvider.State = v1alpha1.EnvironmentStateRunning
+	return e
+}
+
+func TestEnvironmentObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotEnvironment": {
+			reason: "Should return an error if the resource is not an Environment",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotEnvironment)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			mg:     newEnvironment(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			mg:     newEnvironment(),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetEnvironment)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(&composer.Environment{})
+			}),
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if the late initialization update fails",
+			mg:     newEnvironment(),
+			want:   want{err: errors.Wrap(errBoom, errUpdateEnvironmentCR)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&composer.Environment{Config: &composer.EnvironmentConfig{EnvironmentSize: "ENVIRONMENT_SIZE_SMALL", NodeCount: 3}})
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should return upToDate as true if the resource is up to date",
+			mg:     newEnvironment(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&composer.Environment{
+					Labels: map[string]string{"env": "dev"},
+					Config: &composer.EnvironmentConfig{
+						EnvironmentSize: "ENVIRONMENT_SIZE_SMALL",
+						SoftwareConfig: &composer.SoftwareConfig{
+							ImageVersion: "composer-2.4.6-airflow-2.6.3",
+							PypiPackages: map[string]string{"numpy": ""},
+						},
+					},
+				})
+			}),
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should return upToDate as false if the resource is not up to date",
+			mg:     newEnvironment(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&composer.Environment{
+					Labels: map[string]string{"env": "dev"},
+					Config: &composer.EnvironmentConfig{
+						EnvironmentSize: "ENVIRONMENT_SIZE_SMALL",
+						SoftwareConfig: &composer.SoftwareConfig{
+							ImageVersion: "composer-2.4.6-airflow-2.6.3",
+							PypiPackages: map[string]string{"numpy": "", "pandas": ""},
+						},
+					},
+				})
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := composer.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := environmentExternal{
+				kube:         tc.kube,
+				projectID:    projectID,
+				environments: s.Projects.Locations.Environments,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func createEnvironment(e *environmentExternal, mg resource.Managed) error {
+	_, err := e.Create(context.Background(), mg)
+	return err
+}
+
+func deleteEnvironment(e *environmentExternal, mg resource.Managed) error {
+	return e.Delete(context.Background(), mg)
+}
+
+func TestEnvironmentCreateDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		method  string
+		status  int
+		call    func(e *environmentExternal, mg resource.Managed) error
+		mg      resource.Managed
+		wantErr error
+	}{
+		"CreateNotEnvironment": {
+			reason:  "Should return an error if the resource is not an Environment",
+			call:    createEnvironment,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotEnvironment),
+		},
+		"CreateSuccessful": {
+			reason: "Should succeed if the resource creation doesn't return an error",
+			method: http.MethodPost,
+			status: http.StatusOK,
+			call:   createEnvironment,
+			mg:     newEnvironment(),
+		},
+		"CreateFailed": {
+			reason:  "Should fail if the resource creation returns an error",
+			method:  http.MethodPost,
+			status:  http.StatusBadRequest,
+			call:    createEnvironment,
+			mg:      newEnvironment(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateEnvironment),
+		},
+		"DeleteAlreadyGone": {
+			reason: "Should not return an error if the resource is already gone",
+			method: http.MethodDelete,
+			status: http.StatusNotFound,
+			call:   deleteEnvironment,
+			mg:     newEnvironment(),
+		},
+		"DeleteFailed": {
+			reason:  "Should fail if the resource deletion returns an error",
+			method:  http.MethodDelete,
+			status:  http.StatusBadRequest,
+			call:    deleteEnvironment,
+			mg:      newEnvironment(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteEnvironment),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&composer.Operation{})
+			}))
+			defer server.Close()
+			s, _ := composer.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &environmentExternal{
+				projectID:    projectID,
+				environments: s.Projects.Locations.Environments,
+			}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestEnvironmentUpdate(t *testing.T) {
+	cases := map[string]struct {
+		reason   string
+		mg       resource.Managed
+		observed *composer.Environment
+		status   int
+		mask     string
+		wantErr  error
+	}{
+		"NotEnvironment": {
+			reason:  "Should return an error if the resource is not an Environment",
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotEnvironment),
+		},
+		"NotRunning": {
+			reason: "Should not call the API if the environment is not running",
+			mg: func() resource.Managed {
+				e := newEnvironment()
+				e.Status.AtProvider.State = v1alpha1.EnvironmentStateUpdating
+				return e
+			}(),
+		},
+		"UpdateSuccessful": {
+			reason: "Should update only the first field that differs",
+			mg:     newEnvironment(),
+			observed: &composer.Environment{
+				Labels: map[string]string{"env": "dev"},
+				Config: &composer.EnvironmentConfig{EnvironmentSize: "ENVIRONMENT_SIZE_MEDIUM"},
+			},
+			status: http.StatusOK,
+			mask:   "config.environmentSize",
+		},
+		"UpdateFailed": {
+			reason:   "Should fail if the resource update returns an error",
+			mg:       newEnvironment(),
+			observed: &composer.Environment{},
+			status:   http.StatusBadRequest,
+			mask:     "labels",
+			wantErr:  errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateEnvironment),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				switch r.Method {
+				case http.MethodGet:
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(tc.observed)
+				case http.MethodPatch:
+					if diff := cmp.Diff(tc.mask, r.URL.Query().Get("updateMask")); diff != "" {
+						t.Errorf("updateMask: -want, +got:\n%s", diff)
+					}
+					w.WriteHeader(tc.status)
+					_ = json.NewEncoder(w).Encode(&composer.Operation{})
+				default:
+					t.Errorf("unexpected method %s", r.Method)
+				}
+			}))
+			defer server.Close()
+			s, _ := composer.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &environmentExternal{
+				projectID:    projectID,
+				environments: s.Projects.Locations.Environments,
+			}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
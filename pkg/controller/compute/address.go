@@ -0,0 +1,171 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/address"
+	"github.com/crossplane/provider-gcp/pkg/clients/operation"
+)
+
+// Error strings.
+const (
+	errNotAddress                   = "managed resource is not an Address"
+	errManagedRegionalAddressUpdate = "cannot update managed Address resource"
+)
+
+// SetupAddress adds a controller that reconciles Address managed resources.
+func SetupAddress(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.AddressGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.AddressGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Address{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.AddressGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.AddressGroupVersionKind),
+			&addressConnector{kube: mgr.GetClient(), record: event.NewAPIRecorder(mgr.GetEventRecorderFor(name))},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type addressConnector struct {
+	kube   client.Client
+	record event.Recorder
+}
+
+func (c *addressConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts.For("compute")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &addressExternal{kube: c.kube, Service: s, projectID: projectID, record: c.record}, nil
+}
+
+type addressExternal struct {
+	kube      client.Client
+	projectID string
+	*compute.Service
+	record event.Recorder
+}
+
+func (e *addressExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Address)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAddress)
+	}
+	if err := operation.Observe(ctx, e.Service, e.record, cr, cr.Status.LastOperation); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	observed, err := e.Addresses.Get(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetAddress)
+	}
+
+	// Addresses are always up to date because they can't be updated.
+	eo := managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	address.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return eo, errors.Wrap(err, errManagedRegionalAddressUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = address.GenerateAddressObservation(*observed)
+
+	// A promoted ephemeral IP address is IN_USE as soon as it is reserved.
+	switch cr.Status.AtProvider.Status {
+	case v1beta1.StatusReserving:
+		cr.SetConditions(xpv1.Creating())
+	case v1beta1.StatusInUse, v1beta1.StatusReserved:
+		cr.SetConditions(xpv1.Available())
+	}
+
+	return eo, nil
+}
+
+func (e *addressExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Address)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAddress)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	a := &compute.Address{}
+	address.GenerateAddress(meta.GetExternalName(cr), cr.Spec.ForProvider, a)
+	op, err := e.Addresses.Insert(e.projectID, cr.Spec.ForProvider.Region, a).RequestId(gcp.CreateRequestID(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateAddress)
+	}
+	cr.Status.LastOperation = operation.GenerateOperation(op)
+	operation.PersistCreation(ctx, e.kube, cr)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *addressExternal) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	// Addresses cannot be updated.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *addressExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Address)
+	if !ok {
+		return errors.New(errNotAddress)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	op, err := e.Addresses.Delete(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteAddress)
+	}
+	cr.Status.LastOperation = operation.GenerateOperation(op)
+	return nil
+}
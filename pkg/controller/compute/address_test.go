@@ -0,0 +1,371 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	"github.com/crossplane/provider-gcp/pkg/clients/address"
+)
+
+const (
+	testAddressName   = "test-address"
+	testAddressRegion = "us-central1"
+	testEphemeralIP   = "203.0.113.10"
+	testInstance      = "projects/test-project/zones/us-central1-a/instances/test-instance"
+)
+
+var _ managed.ExternalConnecter = &addressConnector{}
+var _ managed.ExternalClient = &addressExternal{}
+
+type regionalAddressModifier func(*v1alpha1.Address)
+
+func regionalAddressWithConditions(c ...xpv1.Condition) regionalAddressModifier {
+	return func(i *v1alpha1.Address) { i.Status.SetConditions(c...) }
+}
+
+func regionalAddressWithLastOperation(o *v1beta1.Operation) regionalAddressModifier {
+	return func(i *v1alpha1.Address) { i.Status.LastOperation = o }
+}
+
+func regionalAddressWithObservation(o v1alpha1.AddressObservation) regionalAddressModifier {
+	return func(i *v1alpha1.Address) { i.Status.AtProvider = o }
+}
+
+func regionalAddressObj(im ...regionalAddressModifier) *v1alpha1.Address {
+	ip := testEphemeralIP
+	i := &v1alpha1.Address{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testAddressName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testAddressName,
+			},
+		},
+		Spec: v1alpha1.AddressSpec{
+			ForProvider: v1alpha1.AddressParameters{
+				Region:  testAddressRegion,
+				Address: &ip,
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func TestAddressObserve(t *testing.T) {
+	type args struct {
+		mg resource.Managed
+	}
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    client.Client
+		args    args
+		want    want
+	}{
+		"NotAddress": {
+			args: args{
+				mg: &v1beta1.GlobalAddress{},
+			},
+			want: want{
+				mg:  &v1beta1.GlobalAddress{},
+				err: errors.New(errNotAddress),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.Address{})
+			}),
+			args: args{
+				mg: regionalAddressObj(),
+			},
+			want: want{
+				mg: regionalAddressObj(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Address{})
+			}),
+			args: args{
+				mg: regionalAddressObj(),
+			},
+			want: want{
+				mg:  regionalAddressObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetAddress),
+			},
+		},
+		"Promoted": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if !strings.HasSuffix(r.URL.Path, "/regions/"+testAddressRegion+"/addresses/"+testAddressName) {
+					t.Errorf("r: unexpected path %q", r.URL.Path)
+				}
+				a := &compute.Address{}
+				address.GenerateAddress(testAddressName, regionalAddressObj().Spec.ForProvider, a)
+				a.Status = v1beta1.StatusInUse
+				a.Users = []string{testInstance}
+				_ = json.NewEncoder(w).Encode(a)
+			}),
+			kube: &test.MockClient{
+				MockUpdate: func(_ context.Context, _ client.Object, _ ...client.UpdateOption) error {
+					t.Errorf("Update(...): the spec is unchanged and must not be updated")
+					return nil
+				},
+			},
+			args: args{
+				mg: regionalAddressObj(),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				mg: regionalAddressObj(
+					regionalAddressWithConditions(xpv1.Available()),
+					regionalAddressWithObservation(v1alpha1.AddressObservation{
+						Status: v1beta1.StatusInUse,
+						Users:  []string{testInstance},
+					}),
+				),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := addressExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				Service:   s,
+			}
+			obs, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAddressCreate(t *testing.T) {
+	type args struct {
+		mg resource.Managed
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    client.Client
+		args    args
+		want    want
+	}{
+		"NotAddress": {
+			args: args{
+				mg: &v1beta1.GlobalAddress{},
+			},
+			want: want{
+				mg:  &v1beta1.GlobalAddress{},
+				err: errors.New(errNotAddress),
+			},
+		},
+		"PromoteEphemeralIP": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if !strings.HasSuffix(r.URL.Path, "/regions/"+testAddressRegion+"/addresses") {
+					t.Errorf("r: unexpected path %q", r.URL.Path)
+				}
+				a := &compute.Address{}
+				if err := json.NewDecoder(r.Body).Decode(a); err != nil {
+					t.Errorf("r: cannot decode body: %s", err)
+				}
+				_ = r.Body.Close()
+				want := &compute.Address{Name: testAddressName, Address: testEphemeralIP}
+				if diff := cmp.Diff(want, a); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(testOperation)
+			}),
+			kube: &test.MockClient{MockStatusUpdate: test.NewMockStatusUpdateFn(nil)},
+			args: args{
+				mg: regionalAddressObj(),
+			},
+			want: want{
+				mg: regionalAddressObj(regionalAddressWithConditions(xpv1.Creating()), regionalAddressWithLastOperation(testOperationStatus)),
+			},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			args: args{
+				mg: regionalAddressObj(),
+			},
+			want: want{
+				mg:  regionalAddressObj(regionalAddressWithConditions(xpv1.Creating())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateAddress),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := addressExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				Service:   s,
+			}
+			_, err := e.Create(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, ignoreRequestID); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAddressDelete(t *testing.T) {
+	type args struct {
+		mg resource.Managed
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		args    args
+		want    want
+	}{
+		"NotAddress": {
+			args: args{
+				mg: &v1beta1.GlobalAddress{},
+			},
+			want: want{
+				mg:  &v1beta1.GlobalAddress{},
+				err: errors.New(errNotAddress),
+			},
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if !strings.HasSuffix(r.URL.Path, "/regions/"+testAddressRegion+"/addresses/"+testAddressName) {
+					t.Errorf("r: unexpected path %q", r.URL.Path)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			args: args{
+				mg: regionalAddressObj(),
+			},
+			want: want{
+				mg: regionalAddressObj(regionalAddressWithConditions(xpv1.Deleting()), regionalAddressWithLastOperation(&v1beta1.Operation{})),
+			},
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			args: args{
+				mg: regionalAddressObj(),
+			},
+			want: want{
+				mg: regionalAddressObj(regionalAddressWithConditions(xpv1.Deleting())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := addressExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			err := e.Delete(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/compute/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/backendbucket"
+	"github.com/crossplane/provider-gcp/pkg/clients/operation"
+)
+
+const (
+	// Error strings.
+	errNotBackendBucket = "managed resource is not a BackendBucket resource"
+	errGetBackendBucket = "cannot get GCP BackendBucket"
+
+	errBackendBucketUpdateFailed  = "update of BackendBucket resource has failed"
+	errBackendBucketCreateFailed  = "creation of BackendBucket resource has failed"
+	errBackendBucketDeleteFailed  = "deletion of BackendBucket resource has failed"
+	errCheckBackendBucketUpToDate = "cannot determine if GCP BackendBucket is up to date"
+	errAddSignedURLKey            = "cannot add signed URL key %q to BackendBucket"
+	errDeleteSignedURLKey         = "cannot delete signed URL key %q from BackendBucket"
+)
+
+// SetupBackendBucket adds a controller that reconciles BackendBucket managed
+// resources.
+func SetupBackendBucket(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.BackendBucketGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.BackendBucketGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.BackendBucket{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.BackendBucketGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.BackendBucketGroupVersionKind),
+			&backendBucketConnector{kube: mgr.GetClient(), record: event.NewAPIRecorder(mgr.GetEventRecorderFor(name))},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithPollInterval(poll),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type backendBucketConnector struct {
+	kube   client.Client
+	record event.Recorder
+}
+
+func (c *backendBucketConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts.For("compute")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &backendBucketExternal{Service: s, kube: c.kube, projectID: projectID, record: c.record}, nil
+}
+
+type backendBucketExternal struct {
+	kube client.Client
+	*compute.Service
+	projectID string
+	record    event.Recorder
+}
+
+func (c *backendBucketExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BackendBucket)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBackendBucket)
+	}
+	if err := operation.Observe(ctx, c.Service, c.record, cr, cr.Status.LastOperation); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	observed, err := c.BackendBuckets.Get(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetBackendBucket)
+	}
+
+	lateInitialized := false
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	backendbucket.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		lateInitialized = true
+	}
+
+	cr.Status.AtProvider = backendbucket.GenerateBackendBucketObservation(*observed)
+
+	cr.Status.SetConditions(xpv1.Available())
+
+	u, err := backendbucket.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, observed)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckBackendBucketUpToDate)
+	}
+	if !u {
+		cr.Status.AtProvider.Diff, err = backendbucket.Diff(meta.GetExternalName(cr), &cr.Spec.ForProvider, observed)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errCheckBackendBucketUpToDate)
+		}
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceLateInitialized: lateInitialized,
+		ResourceUpToDate:        u && backendbucket.AreSignedURLKeysUpToDate(cr.Spec.ForProvider, *observed),
+	}, nil
+}
+
+func (c *backendBucketExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.BackendBucket)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBackendBucket)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	// Signed URL keys can only be added once the BackendBucket exists. They
+	// are reported as not up to date by the next observation and added by
+	// Update.
+	bb := &compute.BackendBucket{}
+	backendbucket.GenerateBackendBucket(meta.GetExternalName(cr), cr.Spec.ForProvider, bb)
+	op, err := c.BackendBuckets.Insert(c.projectID, bb).
+		RequestId(gcp.CreateRequestID(cr)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errBackendBucketCreateFailed)
+	}
+	cr.Status.LastOperation = operation.GenerateOperation(op)
+	operation.PersistCreation(ctx, c.kube, cr)
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *backendBucketExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.BackendBucket)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBackendBucket)
+	}
+	name := meta.GetExternalName(cr)
+
+	observed, err := c.BackendBuckets.Get(c.projectID, name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetBackendBucket)
+	}
+
+	upToDate, err := backendbucket.IsUpToDate(name, &cr.Spec.ForProvider, observed)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errCheckBackendBucketUpToDate)
+	}
+	if !upToDate {
+		bb := &compute.BackendBucket{}
+		backendbucket.GenerateBackendBucket(name, cr.Spec.ForProvider, bb)
+		op, err := c.BackendBuckets.Patch(c.projectID, name, bb).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errBackendBucketUpdateFailed)
+		}
+		cr.Status.LastOperation = operation.GenerateOperation(op)
+	}
+
+	for _, k := range backendbucket.SignedURLKeysToDelete(cr.Spec.ForProvider, *observed) {
+		op, err := c.BackendBuckets.DeleteSignedUrlKey(c.projectID, name, k).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrapf(err, errDeleteSignedURLKey, k)
+		}
+		cr.Status.LastOperation = operation.GenerateOperation(op)
+	}
+	for _, k := range backendbucket.SignedURLKeysToAdd(cr.Spec.ForProvider, *observed) {
+		key, err := backendbucket.GenerateSignedURLKey(ctx, c.kube, k)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrapf(err, errAddSignedURLKey, k.KeyName)
+		}
+		op, err := c.BackendBuckets.AddSignedUrlKey(c.projectID, name, key).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrapf(err, errAddSignedURLKey, k.KeyName)
+		}
+		cr.Status.LastOperation = operation.GenerateOperation(op)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *backendBucketExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.BackendBucket)
+	if !ok {
+		return errors.New(errNotBackendBucket)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	op, err := c.BackendBuckets.Delete(c.projectID, meta.GetExternalName(cr)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errBackendBucketDeleteFailed)
+	}
+	cr.Status.LastOperation = operation.GenerateOperation(op)
+	return nil
+}
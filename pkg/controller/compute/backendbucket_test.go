@@ -0,0 +1,397 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/backendbucket"
+)
+
+var _ managed.ExternalConnecter = &backendBucketConnector{}
+var _ managed.ExternalClient = &backendBucketExternal{}
+
+const (
+	testBackendBucketName = "test-backend-bucket"
+)
+
+type backendBucketModifier func(*v1alpha1.BackendBucket)
+
+func backendBucketWithConditions(c ...xpv1.Condition) backendBucketModifier {
+	return func(i *v1alpha1.BackendBucket) { i.Status.SetConditions(c...) }
+}
+
+func backendBucketWithKeys(names ...string) backendBucketModifier {
+	return func(i *v1alpha1.BackendBucket) {
+		for _, n := range names {
+			i.Spec.ForProvider.SignedURLKeys = append(i.Spec.ForProvider.SignedURLKeys, v1alpha1.SignedURLKey{
+				KeyName: n,
+				KeyValueSecretRef: xpv1.SecretKeySelector{
+					SecretReference: xpv1.SecretReference{Name: "cdn-keys", Namespace: "crossplane-system"},
+					Key:             n,
+				},
+			})
+		}
+	}
+}
+
+func backendBucketObj(im ...backendBucketModifier) *v1alpha1.BackendBucket {
+	i := &v1alpha1.BackendBucket{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       testBackendBucketName,
+			Finalizers: []string{},
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testBackendBucketName,
+			},
+		},
+		Spec: v1alpha1.BackendBucketSpec{
+			ForProvider: v1alpha1.BackendBucketParameters{
+				BucketName: gcp.StringPtr("test-bucket"),
+				EnableCDN:  gcp.BoolPtr(true),
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func backendBucketResponse(w http.ResponseWriter, keys ...string) {
+	bb := &compute.BackendBucket{}
+	backendbucket.GenerateBackendBucket(testBackendBucketName, backendBucketObj().Spec.ForProvider, bb)
+	if len(keys) > 0 {
+		bb.CdnPolicy = &compute.BackendBucketCdnPolicy{SignedUrlKeyNames: keys}
+	}
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(bb)
+}
+
+func TestBackendBucketObserve(t *testing.T) {
+	type args struct {
+		mg resource.Managed
+	}
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		args    args
+		want    want
+	}{
+		"NotBackendBucket": {
+			args: args{
+				mg: &v1beta1.Subnetwork{},
+			},
+			want: want{
+				mg:  &v1beta1.Subnetwork{},
+				err: errors.New(errNotBackendBucket),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.BackendBucket{})
+			}),
+			args: args{
+				mg: backendBucketObj(),
+			},
+			want: want{
+				mg: backendBucketObj(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.BackendBucket{})
+			}),
+			args: args{
+				mg: backendBucketObj(),
+			},
+			want: want{
+				mg:  backendBucketObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetBackendBucket),
+			},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				backendBucketResponse(w)
+			}),
+			args: args{
+				mg: backendBucketObj(),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				mg: backendBucketObj(backendBucketWithConditions(xpv1.Available())),
+			},
+		},
+		"SignedURLKeyMissing": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				backendBucketResponse(w)
+			}),
+			args: args{
+				mg: backendBucketObj(backendBucketWithKeys("key-1")),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+				mg: backendBucketObj(backendBucketWithKeys("key-1"), backendBucketWithConditions(xpv1.Available())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := backendBucketExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			obs, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBackendBucketCreate(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		mg     resource.Managed
+		err    error
+	}{
+		"NotBackendBucket": {
+			mg:  &v1beta1.Subnetwork{},
+			err: errors.New(errNotBackendBucket),
+		},
+		"Successful": {
+			status: http.StatusOK,
+			mg:     backendBucketObj(),
+		},
+		"Failed": {
+			status: http.StatusBadRequest,
+			mg:     backendBucketObj(),
+			err:    errors.Wrap(gError(http.StatusBadRequest, ""), errBackendBucketCreateFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := backendBucketExternal{
+				kube:      &test.MockClient{MockStatusUpdate: test.NewMockStatusUpdateFn(nil)},
+				projectID: projectID,
+				Service:   s,
+			}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBackendBucketUpdate(t *testing.T) {
+	secret := test.NewMockGetFn(nil, func(obj client.Object) error {
+		obj.(*corev1.Secret).Data = map[string][]byte{"key-2": []byte("c2VjcmV0")}
+		return nil
+	})
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		err     error
+	}{
+		"NotBackendBucket": {
+			mg:  &v1beta1.Subnetwork{},
+			err: errors.New(errNotBackendBucket),
+		},
+		"RotateSignedURLKey": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer func() { _ = r.Body.Close() }()
+				switch {
+				case r.Method == http.MethodGet:
+					backendBucketResponse(w, "key-1")
+					return
+				case strings.HasSuffix(r.URL.Path, "/deleteSignedUrlKey"):
+					if diff := cmp.Diff("key-1", r.URL.Query().Get("keyName")); diff != "" {
+						t.Errorf("keyName: -want, +got:\n%s", diff)
+					}
+				case strings.HasSuffix(r.URL.Path, "/addSignedUrlKey"):
+					k := &compute.SignedUrlKey{}
+					_ = json.NewDecoder(r.Body).Decode(k)
+					if diff := cmp.Diff(&compute.SignedUrlKey{KeyName: "key-2", KeyValue: "c2VjcmV0"}, k); diff != "" {
+						t.Errorf("key: -want, +got:\n%s", diff)
+					}
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			kube: &test.MockClient{MockGet: secret},
+			mg:   backendBucketObj(backendBucketWithKeys("key-2")),
+		},
+		"GetSignedURLKeyFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				backendBucketResponse(w)
+			}),
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			mg:   backendBucketObj(backendBucketWithKeys("key-2")),
+			err:  errors.Wrapf(errors.Wrap(errBoom, "cannot get signed URL key Secret"), errAddSignedURLKey, "key-2"),
+		},
+		"PatchFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if r.Method == http.MethodGet {
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(&compute.BackendBucket{})
+					return
+				}
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			mg:  backendBucketObj(),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errBackendBucketUpdateFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := backendBucketExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				Service:   s,
+			}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBackendBucketDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		mg     resource.Managed
+		err    error
+	}{
+		"NotBackendBucket": {
+			mg:  &v1beta1.Subnetwork{},
+			err: errors.New(errNotBackendBucket),
+		},
+		"Successful": {
+			status: http.StatusOK,
+			mg:     backendBucketObj(),
+		},
+		"AlreadyGone": {
+			status: http.StatusNotFound,
+			mg:     backendBucketObj(),
+		},
+		"Failed": {
+			status: http.StatusBadRequest,
+			mg:     backendBucketObj(),
+			err:    errors.Wrap(gError(http.StatusBadRequest, ""), errBackendBucketDeleteFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := backendBucketExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -39,6 +39,7 @@ import (
 	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
 	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 	"github.com/crossplane/provider-gcp/pkg/clients/firewall"
+	"github.com/crossplane/provider-gcp/pkg/clients/operation"
 )
 
 const (
@@ -54,18 +55,20 @@ const (
 
 // SetupFirewall adds a controller that reconciles Firewall managed
 // resources.
-func SetupFirewall(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+func SetupFirewall(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
 	name := managed.ControllerName(v1alpha1.FirewallGroupKind)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: mcr.For(v1alpha1.FirewallGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
 		}).
 		For(&v1alpha1.Firewall{}).
-		Complete(managed.NewReconciler(mgr,
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.FirewallGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.FirewallGroupVersionKind),
-			managed.WithExternalConnecter(&firewallConnector{kube: mgr.GetClient()}),
+			&firewallConnector{kube: mgr.GetClient(), record: event.NewAPIRecorder(mgr.GetEventRecorderFor(name))},
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithPollInterval(poll),
@@ -73,7 +76,8 @@ func SetupFirewall(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter,
 }
 
 type firewallConnector struct {
-	kube client.Client
+	kube   client.Client
+	record event.Recorder
 }
 
 func (c *firewallConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -81,17 +85,18 @@ func (c *firewallConnector) Connect(ctx context.Context, mg resource.Managed) (m
 	if err != nil {
 		return nil, err
 	}
-	s, err := compute.NewService(ctx, opts)
+	s, err := compute.NewService(ctx, opts.For("compute")...)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
-	return &firewallExternal{Service: s, kube: c.kube, projectID: projectID}, nil
+	return &firewallExternal{Service: s, kube: c.kube, projectID: projectID, record: c.record}, nil
 }
 
 type firewallExternal struct {
 	kube client.Client
 	*compute.Service
 	projectID string
+	record    event.Recorder
 }
 
 func (c *firewallExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -99,6 +104,9 @@ func (c *firewallExternal) Observe(ctx context.Context, mg resource.Managed) (ma
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errNotFirewall)
 	}
+	if err := operation.Observe(ctx, c.Service, c.record, cr, cr.Status.LastOperation); err != nil {
+		return managed.ExternalObservation{}, err
+	}
 	observed, err := c.Firewalls.Get(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetFirewall)
@@ -119,6 +127,12 @@ func (c *firewallExternal) Observe(ctx context.Context, mg resource.Managed) (ma
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errCheckFirewallUpToDate)
 	}
+	if !u {
+		cr.Status.AtProvider.Diff, err = firewall.Diff(meta.GetExternalName(cr), &cr.Spec.ForProvider, observed)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errCheckFirewallUpToDate)
+		}
+	}
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
@@ -135,10 +149,16 @@ func (c *firewallExternal) Create(ctx context.Context, mg resource.Managed) (man
 
 	fw := &compute.Firewall{}
 	firewall.GenerateFirewall(meta.GetExternalName(cr), cr.Spec.ForProvider, fw)
-	_, err := c.Firewalls.Insert(c.projectID, fw).
+	op, err := c.Firewalls.Insert(c.projectID, fw).
+		RequestId(gcp.CreateRequestID(cr)).
 		Context(ctx).
 		Do()
-	return managed.ExternalCreation{}, errors.Wrap(err, errFirewallCreateFailed)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errFirewallCreateFailed)
+	}
+	cr.Status.LastOperation = operation.GenerateOperation(op)
+	operation.PersistCreation(ctx, c.kube, cr)
+	return managed.ExternalCreation{}, nil
 }
 
 func (c *firewallExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -163,10 +183,14 @@ func (c *firewallExternal) Update(ctx context.Context, mg resource.Managed) (man
 	fw := &compute.Firewall{}
 	firewall.GenerateFirewall(meta.GetExternalName(cr), cr.Spec.ForProvider, fw)
 
-	_, err = c.Firewalls.Patch(c.projectID, meta.GetExternalName(cr), fw).
+	op, err := c.Firewalls.Patch(c.projectID, meta.GetExternalName(cr), fw).
 		Context(ctx).
 		Do()
-	return managed.ExternalUpdate{}, errors.Wrap(err, errFirewallUpdateFailed)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errFirewallUpdateFailed)
+	}
+	cr.Status.LastOperation = operation.GenerateOperation(op)
+	return managed.ExternalUpdate{}, nil
 }
 
 func (c *firewallExternal) Delete(ctx context.Context, mg resource.Managed) error {
@@ -176,8 +200,12 @@ func (c *firewallExternal) Delete(ctx context.Context, mg resource.Managed) erro
 	}
 
 	cr.Status.SetConditions(xpv1.Deleting())
-	_, err := c.Firewalls.Delete(c.projectID, meta.GetExternalName(cr)).
+	op, err := c.Firewalls.Delete(c.projectID, meta.GetExternalName(cr)).
 		Context(ctx).
 		Do()
-	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errFirewallDeleteFailed)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errFirewallDeleteFailed)
+	}
+	cr.Status.LastOperation = operation.GenerateOperation(op)
+	return nil
 }
@@ -55,6 +55,10 @@ func firewallWithConditions(c ...xpv1.Condition) firewallModifier {
 	return func(i *v1alpha1.Firewall) { i.Status.SetConditions(c...) }
 }
 
+func firewallWithLastOperation(o *v1beta1.Operation) firewallModifier {
+	return func(i *v1alpha1.Firewall) { i.Status.LastOperation = o }
+}
+
 func firewallWithDescription(d string) firewallModifier {
 	return func(i *v1alpha1.Firewall) { i.Spec.ForProvider.Description = &d }
 }
@@ -234,13 +238,14 @@ func TestFirewallCreate(t *testing.T) {
 				}
 				w.WriteHeader(http.StatusOK)
 				_ = r.Body.Close()
-				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+				_ = json.NewEncoder(w).Encode(testOperation)
 			}),
+			kube: &test.MockClient{MockStatusUpdate: test.NewMockStatusUpdateFn(nil)},
 			args: args{
 				mg: firewallObj(),
 			},
 			want: want{
-				mg:  firewallObj(),
+				mg:  firewallObj(firewallWithLastOperation(testOperationStatus)),
 				cre: managed.ExternalCreation{},
 				err: nil,
 			},
@@ -295,7 +300,7 @@ func TestFirewallCreate(t *testing.T) {
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
 			}
-			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, ignoreRequestID); diff != "" {
 				t.Errorf("Create(...): -want, +got:\n%s", diff)
 			}
 		})
@@ -340,7 +345,7 @@ func TestFirewallDelete(t *testing.T) {
 				mg: firewallObj(),
 			},
 			want: want{
-				mg:  firewallObj(firewallWithConditions(xpv1.Deleting())),
+				mg:  firewallObj(firewallWithConditions(xpv1.Deleting()), firewallWithLastOperation(&v1beta1.Operation{})),
 				err: nil,
 			},
 		},
@@ -449,7 +454,7 @@ func TestFirewallUpdate(t *testing.T) {
 				mg: firewallObj(firewallWithDescription("a new description")),
 			},
 			want: want{
-				mg:  firewallObj(firewallWithDescription("a new description")),
+				mg:  firewallObj(firewallWithDescription("a new description"), firewallWithLastOperation(&v1beta1.Operation{})),
 				err: nil,
 			},
 		},
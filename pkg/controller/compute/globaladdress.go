@@ -39,6 +39,7 @@ import (
 	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
 	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 	"github.com/crossplane/provider-gcp/pkg/clients/globaladdress"
+	"github.com/crossplane/provider-gcp/pkg/clients/operation"
 )
 
 // Error strings.
@@ -52,18 +53,20 @@ const (
 
 // SetupGlobalAddress adds a controller that reconciles
 // GlobalAddress managed resources.
-func SetupGlobalAddress(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+func SetupGlobalAddress(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
 	name := managed.ControllerName(v1beta1.GlobalAddressGroupKind)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: mcr.For(v1beta1.GlobalAddressGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
 		}).
 		For(&v1beta1.GlobalAddress{}).
-		Complete(managed.NewReconciler(mgr,
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1beta1.GlobalAddressGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.GlobalAddressGroupVersionKind),
-			managed.WithExternalConnecter(&gaConnector{kube: mgr.GetClient()}),
+			&gaConnector{kube: mgr.GetClient(), record: event.NewAPIRecorder(mgr.GetEventRecorderFor(name))},
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
 			managed.WithPollInterval(poll),
@@ -72,7 +75,8 @@ func SetupGlobalAddress(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLim
 }
 
 type gaConnector struct {
-	kube client.Client
+	kube   client.Client
+	record event.Recorder
 }
 
 func (c *gaConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -80,17 +84,18 @@ func (c *gaConnector) Connect(ctx context.Context, mg resource.Managed) (managed
 	if err != nil {
 		return nil, err
 	}
-	s, err := compute.NewService(ctx, opts)
+	s, err := compute.NewService(ctx, opts.For("compute")...)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
-	return &gaExternal{kube: c.kube, Service: s, projectID: projectID}, errors.Wrap(err, errNewClient)
+	return &gaExternal{kube: c.kube, Service: s, projectID: projectID, record: c.record}, errors.Wrap(err, errNewClient)
 }
 
 type gaExternal struct {
 	kube      client.Client
 	projectID string
 	*compute.Service
+	record event.Recorder
 }
 
 func (e *gaExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -98,6 +103,9 @@ func (e *gaExternal) Observe(ctx context.Context, mg resource.Managed) (managed.
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errNotGlobalAddress)
 	}
+	if err := operation.Observe(ctx, e.Service, e.record, cr, cr.Status.LastOperation); err != nil {
+		return managed.ExternalObservation{}, err
+	}
 	observed, err := e.GlobalAddresses.Get(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetAddress)
@@ -108,7 +116,7 @@ func (e *gaExternal) Observe(ctx context.Context, mg resource.Managed) (managed.
 
 	currentSpec := cr.Spec.ForProvider.DeepCopy()
 	globaladdress.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
-	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
 		if err := e.kube.Update(ctx, cr); err != nil {
 			return eo, errors.Wrap(err, errManagedAddressUpdate)
 		}
@@ -135,8 +143,13 @@ func (e *gaExternal) Create(ctx context.Context, mg resource.Managed) (managed.E
 	cr.Status.SetConditions(xpv1.Creating())
 	address := &compute.Address{}
 	globaladdress.GenerateGlobalAddress(meta.GetExternalName(cr), cr.Spec.ForProvider, address)
-	_, err := e.GlobalAddresses.Insert(e.projectID, address).Context(ctx).Do()
-	return managed.ExternalCreation{}, errors.Wrap(err, errCreateAddress)
+	op, err := e.GlobalAddresses.Insert(e.projectID, address).RequestId(gcp.CreateRequestID(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateAddress)
+	}
+	cr.Status.LastOperation = operation.GenerateOperation(op)
+	operation.PersistCreation(ctx, e.kube, cr)
+	return managed.ExternalCreation{}, nil
 }
 
 func (e *gaExternal) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
@@ -151,6 +164,10 @@ func (e *gaExternal) Delete(ctx context.Context, mg resource.Managed) error {
 	}
 
 	cr.Status.SetConditions(xpv1.Deleting())
-	_, err := e.GlobalAddresses.Delete(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
-	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteAddress)
+	op, err := e.GlobalAddresses.Delete(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteAddress)
+	}
+	cr.Status.LastOperation = operation.GenerateOperation(op)
+	return nil
 }
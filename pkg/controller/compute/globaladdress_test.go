@@ -58,6 +58,10 @@ func addressWithConditions(c ...xpv1.Condition) addressModifier {
 	return func(i *v1beta1.GlobalAddress) { i.Status.SetConditions(c...) }
 }
 
+func addressWithLastOperation(o *v1beta1.Operation) addressModifier {
+	return func(i *v1beta1.GlobalAddress) { i.Status.LastOperation = o }
+}
+
 func addressWithDescription(d string) addressModifier {
 	return func(i *v1beta1.GlobalAddress) { i.Spec.ForProvider.Description = &d }
 }
@@ -302,13 +306,14 @@ func TestGlobalAddressCreate(t *testing.T) {
 				}
 				w.WriteHeader(http.StatusOK)
 				_ = r.Body.Close()
-				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+				_ = json.NewEncoder(w).Encode(testOperation)
 			}),
+			kube: &test.MockClient{MockStatusUpdate: test.NewMockStatusUpdateFn(nil)},
 			args: args{
 				mg: addressObj(),
 			},
 			want: want{
-				mg:  addressObj(addressWithConditions(xpv1.Creating())),
+				mg:  addressObj(addressWithConditions(xpv1.Creating()), addressWithLastOperation(testOperationStatus)),
 				cre: managed.ExternalCreation{},
 				err: nil,
 			},
@@ -363,7 +368,7 @@ func TestGlobalAddressCreate(t *testing.T) {
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
 			}
-			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, ignoreRequestID); diff != "" {
 				t.Errorf("Create(...): -want, +got:\n%s", diff)
 			}
 		})
@@ -408,7 +413,7 @@ func TestGlobalAddressDelete(t *testing.T) {
 				mg: addressObj(),
 			},
 			want: want{
-				mg:  addressObj(addressWithConditions(xpv1.Deleting())),
+				mg:  addressObj(addressWithConditions(xpv1.Deleting()), addressWithLastOperation(&v1beta1.Operation{})),
 				err: nil,
 			},
 		},
@@ -0,0 +1,198 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/api/compute/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// LabelKeyImport is the label of managed resources that were created by an
+// Import. Its value is the name of the Import.
+const LabelKeyImport = "compute.gcp.crossplane.io/import"
+
+const (
+	errGetImport          = "cannot get Import"
+	errUpdateImportStatus = "cannot update Import status"
+	errListImports        = "cannot list GCP resources to import"
+	errUnknownImportKind  = "unknown kind of resources to import"
+	errCreateImported     = "cannot create managed resource %q"
+)
+
+// SetupImport adds a controller that creates ObserveOnly managed resources for
+// the existing GCP resources selected by Imports. The resources are listed
+// again at the supplied poll interval.
+func SetupImport(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := "import/" + v1alpha1.ImportGroupKind
+
+	r := &importReconciler{
+		client:  mgr.GetClient(),
+		log:     l.WithValues("controller", name),
+		poll:    poll,
+		connect: connectImport(mgr.GetClient()),
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.ImportGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Import{}).
+		Complete(r)
+}
+
+func connectImport(kube client.Client) func(context.Context, *v1alpha1.Import) (string, *compute.Service, error) {
+	return func(ctx context.Context, i *v1alpha1.Import) (string, *compute.Service, error) {
+		projectID, opts, err := gcp.UseProviderConfigNamed(ctx, kube, i.Spec.ProviderConfigReference.Name)
+		if err != nil {
+			return "", nil, err
+		}
+		s, err := compute.NewService(ctx, opts.For("compute")...)
+		return projectID, s, errors.Wrap(err, errNewClient)
+	}
+}
+
+type importReconciler struct {
+	client  client.Client
+	log     logging.Logger
+	poll    time.Duration
+	connect func(ctx context.Context, i *v1alpha1.Import) (projectID string, s *compute.Service, err error)
+}
+
+// Reconcile an Import by creating a managed resource for each GCP resource it
+// selects that doesn't have one yet.
+func (r *importReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+	log.Debug("Reconciling")
+
+	i := &v1alpha1.Import{}
+	if err := r.client.Get(ctx, req.NamespacedName, i); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetImport)
+	}
+	if meta.WasDeleted(i) {
+		return reconcile.Result{}, nil
+	}
+	if i.Spec.ProviderConfigReference == nil {
+		i.Spec.ProviderConfigReference = &xpv1.Reference{Name: "default"}
+	}
+
+	n, err := r.importResources(ctx, i)
+	if err != nil {
+		log.Debug("Cannot import resources", "error", err)
+		i.SetConditions(xpv1.ReconcileError(err))
+		return reconcile.Result{Requeue: true}, errors.Wrap(r.client.Status().Update(ctx, i), errUpdateImportStatus)
+	}
+	i.Status.Imported = n
+	i.SetConditions(xpv1.ReconcileSuccess())
+	return reconcile.Result{RequeueAfter: r.poll}, errors.Wrap(r.client.Status().Update(ctx, i), errUpdateImportStatus)
+}
+
+func (r *importReconciler) importResources(ctx context.Context, i *v1alpha1.Import) (int, error) {
+	projectID, s, err := r.connect(ctx, i)
+	if err != nil {
+		return 0, err
+	}
+	mgs, err := listImported(ctx, s, projectID, i.Spec)
+	if err != nil {
+		return 0, err
+	}
+	for _, mg := range mgs {
+		mg.SetName(i.Spec.NamePrefix + meta.GetExternalName(mg))
+		meta.AddAnnotations(mg, map[string]string{gcp.AnnotationKeyManagementPolicy: string(gcp.ManagementObserveOnly)})
+		meta.AddLabels(mg, map[string]string{LabelKeyImport: i.GetName()})
+		mg.SetProviderConfigReference(i.Spec.ProviderConfigReference.DeepCopy())
+		if err := r.client.Create(ctx, mg); resource.Ignore(kerrors.IsAlreadyExists, err) != nil {
+			return 0, errors.Wrapf(err, errCreateImported, mg.GetName())
+		}
+	}
+	return len(mgs), nil
+}
+
+// listImported returns a managed resource for each GCP resource selected by
+// the supplied ImportSpec. Their external names are set; the required fields
+// of their spec, if any, are set from the GCP resource. Other fields are late
+// initialized when the managed resources are observed. Subnetworks that have
+// the same name in different regions share a managed resource name, so only
+// one of them is imported unless the filter selects a single region.
+func listImported(ctx context.Context, s *compute.Service, projectID string, spec v1alpha1.ImportSpec) ([]resource.Managed, error) {
+	var mgs []resource.Managed
+	imported := func(mg resource.Managed, name string) {
+		meta.SetExternalName(mg, name)
+		mgs = append(mgs, mg)
+	}
+
+	var err error
+	switch spec.Kind {
+	case v1alpha1.ImportedKindFirewall:
+		err = s.Firewalls.List(projectID).Filter(spec.Filter).Pages(ctx, func(l *compute.FirewallList) error {
+			for _, fw := range l.Items {
+				imported(&v1alpha1.Firewall{}, fw.Name)
+			}
+			return nil
+		})
+	case v1alpha1.ImportedKindGlobalAddress:
+		err = s.GlobalAddresses.List(projectID).Filter(spec.Filter).Pages(ctx, func(l *compute.AddressList) error {
+			for _, a := range l.Items {
+				imported(&v1beta1.GlobalAddress{}, a.Name)
+			}
+			return nil
+		})
+	case v1alpha1.ImportedKindNetwork:
+		err = s.Networks.List(projectID).Filter(spec.Filter).Pages(ctx, func(l *compute.NetworkList) error {
+			for _, n := range l.Items {
+				imported(&v1beta1.Network{}, n.Name)
+			}
+			return nil
+		})
+	case v1alpha1.ImportedKindSubnetwork:
+		err = s.Subnetworks.AggregatedList(projectID).Filter(spec.Filter).Pages(ctx, func(l *compute.SubnetworkAggregatedList) error {
+			for _, sl := range l.Items {
+				for _, sn := range sl.Subnetworks {
+					imported(&v1beta1.Subnetwork{Spec: v1beta1.SubnetworkSpec{ForProvider: v1beta1.SubnetworkParameters{
+						IPCidrRange: sn.IpCidrRange,
+						Network:     gcp.StringPtr(sn.Network),
+						Region:      gcp.NormalizeExternalName(sn.Region),
+					}}}, sn.Name)
+				}
+			}
+			return nil
+		})
+	default:
+		return nil, errors.Errorf("%s: %s", errUnknownImportKind, spec.Kind)
+	}
+	return mgs, errors.Wrap(err, errListImports)
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+func TestImportReconcile(t *testing.T) {
+	errBoom := errors.New("boom")
+	poll := time.Minute
+
+	getImport := func(spec v1alpha1.ImportSpec) test.MockGetFn {
+		return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			i := obj.(*v1alpha1.Import)
+			i.SetName("allow-rules")
+			i.Spec = spec
+			return nil
+		}
+	}
+	firewalls := func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("filter"); got != `name = "allow-*"` {
+			t.Errorf("List(...): want filter, got %q", got)
+		}
+		_ = json.NewEncoder(w).Encode(&compute.FirewallList{Items: []*compute.Firewall{{Name: "allow-ssh"}, {Name: "allow-http"}}})
+	}
+
+	type want struct {
+		result   reconcile.Result
+		err      error
+		status   *v1alpha1.ImportStatus
+		imported []string
+	}
+
+	cases := map[string]struct {
+		reason     string
+		get        test.MockGetFn
+		handler    http.HandlerFunc
+		connectErr error
+		createErr  map[string]error
+		want       want
+	}{
+		"GetFailed": {
+			reason: "Should return an error if the Import can't be read",
+			get:    test.NewMockGetFn(errBoom),
+			want:   want{err: errors.Wrap(errBoom, errGetImport)},
+		},
+		"ConnectFailed": {
+			reason:     "Should report an error if the Compute Engine API can't be reached",
+			get:        getImport(v1alpha1.ImportSpec{Kind: v1alpha1.ImportedKindFirewall}),
+			connectErr: errBoom,
+			want: want{
+				result: reconcile.Result{Requeue: true},
+				status: &v1alpha1.ImportStatus{ConditionedStatus: *xpv1.NewConditionedStatus(xpv1.ReconcileError(errBoom))},
+			},
+		},
+		"CreateFailed": {
+			reason:    "Should report an error if a managed resource can't be created",
+			get:       getImport(v1alpha1.ImportSpec{Kind: v1alpha1.ImportedKindFirewall, Filter: `name = "allow-*"`}),
+			handler:   firewalls,
+			createErr: map[string]error{"allow-http": errBoom},
+			want: want{
+				result:   reconcile.Result{Requeue: true},
+				status:   &v1alpha1.ImportStatus{ConditionedStatus: *xpv1.NewConditionedStatus(xpv1.ReconcileError(errors.Wrapf(errBoom, errCreateImported, "allow-http")))},
+				imported: []string{"allow-ssh"},
+			},
+		},
+		"Imported": {
+			reason:  "A managed resource should be created for each selected resource that doesn't have one",
+			get:     getImport(v1alpha1.ImportSpec{Kind: v1alpha1.ImportedKindFirewall, Filter: `name = "allow-*"`, NamePrefix: "import-"}),
+			handler: firewalls,
+			createErr: map[string]error{
+				"import-allow-ssh": kerrors.NewAlreadyExists(schema.GroupResource{}, "import-allow-ssh"),
+			},
+			want: want{
+				result:   reconcile.Result{RequeueAfter: poll},
+				status:   &v1alpha1.ImportStatus{ConditionedStatus: *xpv1.NewConditionedStatus(xpv1.ReconcileSuccess()), Imported: 2},
+				imported: []string{"import-allow-http"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+
+			var imported []string
+			var status *v1alpha1.ImportStatus
+			kube := &test.MockClient{
+				MockGet: tc.get,
+				MockCreate: func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+					mg := obj.(resource.Managed)
+					if err := tc.createErr[mg.GetName()]; err != nil {
+						return err
+					}
+					if gcp.GetManagementPolicy(mg) != gcp.ManagementObserveOnly {
+						t.Errorf("Create(...): want ObserveOnly, got %s", gcp.GetManagementPolicy(mg))
+					}
+					if mg.GetLabels()[LabelKeyImport] != "allow-rules" || mg.GetProviderConfigReference().Name != "default" {
+						t.Errorf("Create(...): want label and providerConfigRef of Import, got %v and %v", mg.GetLabels(), mg.GetProviderConfigReference())
+					}
+					imported = append(imported, mg.GetName())
+					return nil
+				},
+				MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+					status = &obj.(*v1alpha1.Import).Status
+					return nil
+				},
+			}
+			r := &importReconciler{
+				client: kube,
+				log:    logging.NewNopLogger(),
+				poll:   poll,
+				connect: func(_ context.Context, _ *v1alpha1.Import) (string, *compute.Service, error) {
+					return "project", s, tc.connectErr
+				},
+			}
+
+			got, err := r.Reconcile(context.Background(), reconcile.Request{})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want result, +got result:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want status, +got status:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.imported, imported); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want imported, +got imported:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestListImportedSubnetworks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(&compute.SubnetworkAggregatedList{Items: map[string]compute.SubnetworksScopedList{
+			"regions/us-central1": {Subnetworks: []*compute.Subnetwork{{
+				Name:        "default",
+				IpCidrRange: "10.128.0.0/20",
+				Network:     "https://www.googleapis.com/compute/v1/projects/project/global/networks/default",
+				Region:      "https://www.googleapis.com/compute/v1/projects/project/regions/us-central1",
+			}}},
+		}})
+	}))
+	defer server.Close()
+	s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+
+	got, err := listImported(context.Background(), s, "project", v1alpha1.ImportSpec{Kind: v1alpha1.ImportedKindSubnetwork})
+	if err != nil {
+		t.Fatalf("listImported(...): %s", err)
+	}
+	want := &v1beta1.Subnetwork{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{meta.AnnotationKeyExternalName: "default"}},
+		Spec: v1beta1.SubnetworkSpec{ForProvider: v1beta1.SubnetworkParameters{
+			IPCidrRange: "10.128.0.0/20",
+			Network:     gcp.StringPtr("https://www.googleapis.com/compute/v1/projects/project/global/networks/default"),
+			Region:      "us-central1",
+		}},
+	}
+	if len(got) != 1 {
+		t.Fatalf("listImported(...): want 1 subnetwork, got %d", len(got))
+	}
+	if diff := cmp.Diff(want, got[0]); diff != "" {
+		t.Errorf("listImported(...): -want, +got:\n%s", diff)
+	}
+}
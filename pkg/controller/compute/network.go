@@ -39,6 +39,7 @@ import (
 	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
 	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 	"github.com/crossplane/provider-gcp/pkg/clients/network"
+	"github.com/crossplane/provider-gcp/pkg/clients/operation"
 )
 
 const (
@@ -56,18 +57,20 @@ const (
 
 // SetupNetwork adds a controller that reconciles Network managed
 // resources.
-func SetupNetwork(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+func SetupNetwork(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
 	name := managed.ControllerName(v1beta1.NetworkGroupKind)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: mcr.For(v1beta1.NetworkGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
 		}).
 		For(&v1beta1.Network{}).
-		Complete(managed.NewReconciler(mgr,
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1beta1.NetworkGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.NetworkGroupVersionKind),
-			managed.WithExternalConnecter(&networkConnector{kube: mgr.GetClient()}),
+			&networkConnector{kube: mgr.GetClient(), record: event.NewAPIRecorder(mgr.GetEventRecorderFor(name))},
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
 			managed.WithPollInterval(poll),
@@ -76,7 +79,8 @@ func SetupNetwork(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter,
 }
 
 type networkConnector struct {
-	kube client.Client
+	kube   client.Client
+	record event.Recorder
 }
 
 func (c *networkConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -84,17 +88,18 @@ func (c *networkConnector) Connect(ctx context.Context, mg resource.Managed) (ma
 	if err != nil {
 		return nil, err
 	}
-	s, err := compute.NewService(ctx, opts)
+	s, err := compute.NewService(ctx, opts.For("compute")...)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
-	return &networkExternal{Service: s, kube: c.kube, projectID: projectID}, nil
+	return &networkExternal{Service: s, kube: c.kube, projectID: projectID, record: c.record}, nil
 }
 
 type networkExternal struct {
 	kube client.Client
 	*compute.Service
 	projectID string
+	record    event.Recorder
 }
 
 func (c *networkExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -102,6 +107,9 @@ func (c *networkExternal) Observe(ctx context.Context, mg resource.Managed) (man
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errNotNetwork)
 	}
+	if err := operation.Observe(ctx, c.Service, c.record, cr, cr.Status.LastOperation); err != nil {
+		return managed.ExternalObservation{}, err
+	}
 	observed, err := c.Networks.Get(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetNetwork)
@@ -109,7 +117,7 @@ func (c *networkExternal) Observe(ctx context.Context, mg resource.Managed) (man
 
 	currentSpec := cr.Spec.ForProvider.DeepCopy()
 	network.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
-	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
 		if err := c.kube.Update(ctx, cr); err != nil {
 			return managed.ExternalObservation{}, errors.Wrap(err, errManagedNetworkUpdate)
 		}
@@ -123,6 +131,12 @@ func (c *networkExternal) Observe(ctx context.Context, mg resource.Managed) (man
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errCheckNetworkUpToDate)
 	}
+	if !u {
+		cr.Status.AtProvider.Diff, err = network.Diff(meta.GetExternalName(cr), &cr.Spec.ForProvider, observed)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errCheckNetworkUpToDate)
+		}
+	}
 
 	return managed.ExternalObservation{
 		ResourceExists:   true,
@@ -140,10 +154,16 @@ func (c *networkExternal) Create(ctx context.Context, mg resource.Managed) (mana
 
 	net := &compute.Network{}
 	network.GenerateNetwork(meta.GetExternalName(cr), cr.Spec.ForProvider, net)
-	_, err := c.Networks.Insert(c.projectID, net).
+	op, err := c.Networks.Insert(c.projectID, net).
+		RequestId(gcp.CreateRequestID(cr)).
 		Context(ctx).
 		Do()
-	return managed.ExternalCreation{}, errors.Wrap(err, errNetworkCreateFailed)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errNetworkCreateFailed)
+	}
+	cr.Status.LastOperation = operation.GenerateOperation(op)
+	operation.PersistCreation(ctx, c.kube, cr)
+	return managed.ExternalCreation{}, nil
 }
 
 func (c *networkExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -165,8 +185,12 @@ func (c *networkExternal) Update(ctx context.Context, mg resource.Managed) (mana
 		return managed.ExternalUpdate{}, nil
 	}
 	if switchToCustom {
-		_, err := c.Networks.SwitchToCustomMode(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
-		return managed.ExternalUpdate{}, errors.Wrap(err, errNetworkUpdateFailed)
+		op, err := c.Networks.SwitchToCustomMode(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errNetworkUpdateFailed)
+		}
+		cr.Status.LastOperation = operation.GenerateOperation(op)
+		return managed.ExternalUpdate{}, nil
 	}
 
 	net := &compute.Network{}
@@ -174,10 +198,14 @@ func (c *networkExternal) Update(ctx context.Context, mg resource.Managed) (mana
 
 	// NOTE(muvaf): All parameters except routing config are
 	// immutable.
-	_, err = c.Networks.Patch(c.projectID, meta.GetExternalName(cr), net).
+	op, err := c.Networks.Patch(c.projectID, meta.GetExternalName(cr), net).
 		Context(ctx).
 		Do()
-	return managed.ExternalUpdate{}, errors.Wrap(err, errNetworkUpdateFailed)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errNetworkUpdateFailed)
+	}
+	cr.Status.LastOperation = operation.GenerateOperation(op)
+	return managed.ExternalUpdate{}, nil
 }
 
 func (c *networkExternal) Delete(ctx context.Context, mg resource.Managed) error {
@@ -187,8 +215,12 @@ func (c *networkExternal) Delete(ctx context.Context, mg resource.Managed) error
 	}
 
 	cr.Status.SetConditions(xpv1.Deleting())
-	_, err := c.Networks.Delete(c.projectID, meta.GetExternalName(cr)).
+	op, err := c.Networks.Delete(c.projectID, meta.GetExternalName(cr)).
 		Context(ctx).
 		Do()
-	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errNetworkDeleteFailed)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errNetworkDeleteFailed)
+	}
+	cr.Status.LastOperation = operation.GenerateOperation(op)
+	return nil
 }
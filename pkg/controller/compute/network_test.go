@@ -25,6 +25,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"google.golang.org/api/compute/v1"
 	"google.golang.org/api/googleapi"
 	"google.golang.org/api/option"
@@ -39,6 +40,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 	"github.com/crossplane/provider-gcp/pkg/clients/network"
 )
 
@@ -48,6 +50,22 @@ const (
 	projectID = "myproject-id-1234"
 )
 
+var (
+	testOperation = &compute.Operation{
+		SelfLink:      "https://www.googleapis.com/compute/v1/projects/" + projectID + "/global/operations/operation-1",
+		OperationType: "insert",
+		Status:        "PENDING",
+	}
+	testOperationStatus = &v1beta1.Operation{
+		SelfLink:      testOperation.SelfLink,
+		OperationType: testOperation.OperationType,
+		Status:        testOperation.Status,
+	}
+
+	// Request IDs are random.
+	ignoreRequestID = cmpopts.IgnoreMapEntries(func(k, _ string) bool { return k == gcp.AnnotationKeyCreateRequestID })
+)
+
 var _ managed.ExternalConnecter = &networkConnector{}
 var _ managed.ExternalClient = &networkExternal{}
 
@@ -65,6 +83,10 @@ func networkWithConditions(c ...xpv1.Condition) networkModifier {
 	return func(i *v1beta1.Network) { i.Status.SetConditions(c...) }
 }
 
+func networkWithLastOperation(o *v1beta1.Operation) networkModifier {
+	return func(i *v1beta1.Network) { i.Status.LastOperation = o }
+}
+
 func networkWithDescription(d string) networkModifier {
 	return func(i *v1beta1.Network) { i.Spec.ForProvider.Description = &d }
 }
@@ -257,6 +279,9 @@ func TestNetworkCreate(t *testing.T) {
 				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
 					t.Errorf("r: -want, +got:\n%s", diff)
 				}
+				if r.URL.Query().Get("requestId") == "" {
+					t.Errorf("r: want a request ID")
+				}
 				i := &compute.Network{}
 				b, err := ioutil.ReadAll(r.Body)
 				if diff := cmp.Diff(err, nil); diff != "" {
@@ -268,13 +293,14 @@ func TestNetworkCreate(t *testing.T) {
 				}
 				w.WriteHeader(http.StatusOK)
 				_ = r.Body.Close()
-				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+				_ = json.NewEncoder(w).Encode(testOperation)
 			}),
+			kube: &test.MockClient{MockStatusUpdate: test.NewMockStatusUpdateFn(nil)},
 			args: args{
 				mg: networkObj(),
 			},
 			want: want{
-				mg:  networkObj(networkWithConditions(xpv1.Creating())),
+				mg:  networkObj(networkWithConditions(xpv1.Creating()), networkWithLastOperation(testOperationStatus)),
 				cre: managed.ExternalCreation{},
 				err: nil,
 			},
@@ -329,7 +355,7 @@ func TestNetworkCreate(t *testing.T) {
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
 			}
-			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, ignoreRequestID); diff != "" {
 				t.Errorf("Create(...): -want, +got:\n%s", diff)
 			}
 		})
@@ -374,7 +400,7 @@ func TestNetworkDelete(t *testing.T) {
 				mg: networkObj(),
 			},
 			want: want{
-				mg:  networkObj(networkWithConditions(xpv1.Deleting())),
+				mg:  networkObj(networkWithConditions(xpv1.Deleting()), networkWithLastOperation(&v1beta1.Operation{})),
 				err: nil,
 			},
 		},
@@ -485,7 +511,7 @@ func TestNetworkUpdate(t *testing.T) {
 				mg: networkObj(networkWithDescription("a new description")),
 			},
 			want: want{
-				mg:  networkObj(networkWithDescription("a new description")),
+				mg:  networkObj(networkWithDescription("a new description"), networkWithLastOperation(&v1beta1.Operation{})),
 				err: nil,
 			},
 		},
@@ -517,7 +543,7 @@ func TestNetworkUpdate(t *testing.T) {
 			want: want{
 				mg: networkObj(func(n *v1beta1.Network) {
 					n.Spec.ForProvider.AutoCreateSubnetworks = &falseVal
-				}),
+				}, networkWithLastOperation(&v1beta1.Operation{})),
 				err: nil,
 			},
 		},
@@ -35,6 +35,7 @@ import (
 
 	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
 	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/operation"
 	"github.com/crossplane/provider-gcp/pkg/clients/router"
 )
 
@@ -52,26 +53,39 @@ const (
 
 // SetupRouter adds a controller that reconciles Router managed
 // resources.
-func SetupRouter(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+func SetupRouter(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
 	name := managed.ControllerName(v1alpha1.RouterGroupKind)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: mcr.For(v1alpha1.RouterGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
 		}).
 		For(&v1alpha1.Router{}).
-		Complete(managed.NewReconciler(mgr,
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.RouterGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.RouterGroupVersionKind),
-			managed.WithExternalConnecter(&routerConnector{kube: mgr.GetClient()}),
+			&routerConnector{kube: mgr.GetClient(), record: event.NewAPIRecorder(mgr.GetEventRecorderFor(name))},
+			managed.WithInitializers(gcp.NewExternalNameInitializer(mgr.GetClient()), gcp.NewDefaultLocationInitializer(mgr.GetClient(), routerLocation)),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithPollInterval(poll),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
 
+// routerLocation returns the region of a Router.
+func routerLocation(mg resource.Managed) (*string, **string) {
+	cr, ok := mg.(*v1alpha1.Router)
+	if !ok {
+		return nil, nil
+	}
+	return &cr.Spec.ForProvider.Region, nil
+}
+
 type routerConnector struct {
-	kube client.Client
+	kube   client.Client
+	record event.Recorder
 }
 
 func (c *routerConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -79,17 +93,18 @@ func (c *routerConnector) Connect(ctx context.Context, mg resource.Managed) (man
 	if err != nil {
 		return nil, err
 	}
-	s, err := compute.NewService(ctx, opts)
+	s, err := compute.NewService(ctx, opts.For("compute")...)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
-	return &routerExternal{Service: s, kube: c.kube, projectID: projectID}, nil
+	return &routerExternal{Service: s, kube: c.kube, projectID: projectID, record: c.record}, nil
 }
 
 type routerExternal struct {
 	kube client.Client
 	*compute.Service
 	projectID string
+	record    event.Recorder
 }
 
 func (c *routerExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -97,6 +112,9 @@ func (c *routerExternal) Observe(ctx context.Context, mg resource.Managed) (mana
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errNotRouter)
 	}
+	if err := operation.Observe(ctx, c.Service, c.record, cr, cr.Status.LastOperation); err != nil {
+		return managed.ExternalObservation{}, err
+	}
 	observed, err := c.Routers.Get(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetRouter)
@@ -104,7 +122,7 @@ func (c *routerExternal) Observe(ctx context.Context, mg resource.Managed) (mana
 
 	currentSpec := cr.Spec.ForProvider.DeepCopy()
 	router.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
-	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
 		if err := c.kube.Update(ctx, cr); err != nil {
 			return managed.ExternalObservation{}, errors.Wrap(err, errManagedRouterUpdate)
 		}
@@ -118,6 +136,12 @@ func (c *routerExternal) Observe(ctx context.Context, mg resource.Managed) (mana
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errCheckRouterUpToDate)
 	}
+	if !u {
+		cr.Status.AtProvider.Diff, err = router.Diff(meta.GetExternalName(cr), &cr.Spec.ForProvider, observed)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errCheckRouterUpToDate)
+		}
+	}
 
 	return managed.ExternalObservation{
 		ResourceExists:   true,
@@ -133,10 +157,20 @@ func (c *routerExternal) Create(ctx context.Context, mg resource.Managed) (manag
 
 	rt := &compute.Router{}
 	router.GenerateRouter(meta.GetExternalName(cr), cr.Spec.ForProvider, rt)
-	_, err := c.Routers.Insert(c.projectID, cr.Spec.ForProvider.Region, rt).
+	// NAT IPs can only be drained from the NATs of an existing router.
+	for _, n := range rt.Nats {
+		n.DrainNatIps = nil
+	}
+	op, err := c.Routers.Insert(c.projectID, cr.Spec.ForProvider.Region, rt).
+		RequestId(gcp.CreateRequestID(cr)).
 		Context(ctx).
 		Do()
-	return managed.ExternalCreation{}, errors.Wrap(err, errRouterCreateFailed)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errRouterCreateFailed)
+	}
+	cr.Status.LastOperation = operation.GenerateOperation(op)
+	operation.PersistCreation(ctx, c.kube, cr)
+	return managed.ExternalCreation{}, nil
 }
 
 func (c *routerExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -161,10 +195,14 @@ func (c *routerExternal) Update(ctx context.Context, mg resource.Managed) (manag
 	rt := &compute.Router{}
 	router.GenerateRouter(meta.GetExternalName(cr), cr.Spec.ForProvider, rt)
 
-	_, err = c.Routers.Patch(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), rt).
+	op, err := c.Routers.Patch(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), rt).
 		Context(ctx).
 		Do()
-	return managed.ExternalUpdate{}, errors.Wrap(err, errRouterUpdateFailed)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRouterUpdateFailed)
+	}
+	cr.Status.LastOperation = operation.GenerateOperation(op)
+	return managed.ExternalUpdate{}, nil
 }
 
 func (c *routerExternal) Delete(ctx context.Context, mg resource.Managed) error {
@@ -174,8 +212,12 @@ func (c *routerExternal) Delete(ctx context.Context, mg resource.Managed) error
 	}
 
 	cr.Status.SetConditions(xpv1.Deleting())
-	_, err := c.Routers.Delete(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).
+	op, err := c.Routers.Delete(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).
 		Context(ctx).
 		Do()
-	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errRouterDeleteFailed)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errRouterDeleteFailed)
+	}
+	cr.Status.LastOperation = operation.GenerateOperation(op)
+	return nil
 }
@@ -52,6 +52,10 @@ func routerWithConditions(c ...xpv1.Condition) routerModifier {
 	return func(i *v1alpha1.Router) { i.Status.SetConditions(c...) }
 }
 
+func routerWithLastOperation(o *v1beta1.Operation) routerModifier {
+	return func(i *v1alpha1.Router) { i.Status.LastOperation = o }
+}
+
 func routerWithDescription(d string) routerModifier {
 	return func(i *v1alpha1.Router) { i.Spec.ForProvider.Description = &d }
 }
@@ -255,13 +259,14 @@ func TestRouterCreate(t *testing.T) {
 				}
 				w.WriteHeader(http.StatusOK)
 				_ = r.Body.Close()
-				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+				_ = json.NewEncoder(w).Encode(testOperation)
 			}),
+			kube: &test.MockClient{MockStatusUpdate: test.NewMockStatusUpdateFn(nil)},
 			args: args{
 				mg: routerObj(),
 			},
 			want: want{
-				mg:  routerObj(),
+				mg:  routerObj(routerWithLastOperation(testOperationStatus)),
 				cre: managed.ExternalCreation{},
 				err: nil,
 			},
@@ -316,7 +321,7 @@ func TestRouterCreate(t *testing.T) {
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
 			}
-			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, ignoreRequestID); diff != "" {
 				t.Errorf("Create(...): -want, +got:\n%s", diff)
 			}
 		})
@@ -361,7 +366,7 @@ func TestRouterDelete(t *testing.T) {
 				mg: routerObj(),
 			},
 			want: want{
-				mg:  routerObj(routerWithConditions(xpv1.Deleting())),
+				mg:  routerObj(routerWithConditions(xpv1.Deleting()), routerWithLastOperation(&v1beta1.Operation{})),
 				err: nil,
 			},
 		},
@@ -470,7 +475,7 @@ func TestRouterUpdate(t *testing.T) {
 				mg: routerObj(routerWithDescription("a new description")),
 			},
 			want: want{
-				mg:  routerObj(routerWithDescription("a new description")),
+				mg:  routerObj(routerWithDescription("a new description"), routerWithLastOperation(&v1beta1.Operation{})),
 				err: nil,
 			},
 		},
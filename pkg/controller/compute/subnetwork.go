@@ -38,6 +38,7 @@ import (
 
 	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
 	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/operation"
 	"github.com/crossplane/provider-gcp/pkg/clients/subnetwork"
 )
 
@@ -56,18 +57,21 @@ const (
 
 // SetupSubnetwork adds a controller that reconciles Subnetwork
 // managed resources.
-func SetupSubnetwork(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+func SetupSubnetwork(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
 	name := managed.ControllerName(v1beta1.SubnetworkGroupKind)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: mcr.For(v1beta1.SubnetworkGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
 		}).
 		For(&v1beta1.Subnetwork{}).
-		Complete(managed.NewReconciler(mgr,
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1beta1.SubnetworkGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.SubnetworkGroupVersionKind),
-			managed.WithExternalConnecter(&subnetworkConnector{kube: mgr.GetClient()}),
+			&subnetworkConnector{kube: mgr.GetClient(), record: event.NewAPIRecorder(mgr.GetEventRecorderFor(name))},
+			managed.WithInitializers(gcp.NewExternalNameInitializer(mgr.GetClient()), gcp.NewDefaultLocationInitializer(mgr.GetClient(), subnetworkLocation)),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
 			managed.WithPollInterval(poll),
@@ -75,8 +79,18 @@ func SetupSubnetwork(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimite
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
 
+// subnetworkLocation returns the region of a Subnetwork.
+func subnetworkLocation(mg resource.Managed) (*string, **string) {
+	cr, ok := mg.(*v1beta1.Subnetwork)
+	if !ok {
+		return nil, nil
+	}
+	return &cr.Spec.ForProvider.Region, nil
+}
+
 type subnetworkConnector struct {
-	kube client.Client
+	kube   client.Client
+	record event.Recorder
 }
 
 func (c *subnetworkConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -84,17 +98,18 @@ func (c *subnetworkConnector) Connect(ctx context.Context, mg resource.Managed)
 	if err != nil {
 		return nil, err
 	}
-	s, err := googlecompute.NewService(ctx, opts)
+	s, err := googlecompute.NewService(ctx, opts.For("compute")...)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
-	return &subnetworkExternal{Service: s, kube: c.kube, projectID: projectID}, nil
+	return &subnetworkExternal{Service: s, kube: c.kube, projectID: projectID, record: c.record}, nil
 }
 
 type subnetworkExternal struct {
 	kube client.Client
 	*googlecompute.Service
 	projectID string
+	record    event.Recorder
 }
 
 func (c *subnetworkExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -102,6 +117,9 @@ func (c *subnetworkExternal) Observe(ctx context.Context, mg resource.Managed) (
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errNotSubnetwork)
 	}
+	if err := operation.Observe(ctx, c.Service, c.record, cr, cr.Status.LastOperation); err != nil {
+		return managed.ExternalObservation{}, err
+	}
 	observed, err := c.Subnetworks.Get(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetSubnetwork)
@@ -109,7 +127,7 @@ func (c *subnetworkExternal) Observe(ctx context.Context, mg resource.Managed) (
 
 	currentSpec := cr.Spec.ForProvider.DeepCopy()
 	subnetwork.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
-	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
 		if err := c.kube.Update(ctx, cr); err != nil {
 			return managed.ExternalObservation{}, errors.Wrap(err, errManagedSubnetworkUpdate)
 		}
@@ -121,6 +139,12 @@ func (c *subnetworkExternal) Observe(ctx context.Context, mg resource.Managed) (
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errCheckSubnetworkUpToDate)
 	}
+	if !u {
+		cr.Status.AtProvider.Diff, err = subnetwork.Diff(meta.GetExternalName(cr), &cr.Spec.ForProvider, observed)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errCheckSubnetworkUpToDate)
+		}
+	}
 
 	cr.Status.SetConditions(xpv1.Available())
 	return managed.ExternalObservation{
@@ -139,10 +163,16 @@ func (c *subnetworkExternal) Create(ctx context.Context, mg resource.Managed) (m
 
 	subnet := &googlecompute.Subnetwork{}
 	subnetwork.GenerateSubnetwork(meta.GetExternalName(cr), cr.Spec.ForProvider, subnet)
-	_, err := c.Subnetworks.Insert(c.projectID, cr.Spec.ForProvider.Region, subnet).
+	op, err := c.Subnetworks.Insert(c.projectID, cr.Spec.ForProvider.Region, subnet).
+		RequestId(gcp.CreateRequestID(cr)).
 		Context(ctx).
 		Do()
-	return managed.ExternalCreation{}, errors.Wrap(err, errCreateSubnetworkFailed)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateSubnetworkFailed)
+	}
+	cr.Status.LastOperation = operation.GenerateOperation(op)
+	operation.PersistCreation(ctx, c.kube, cr)
+	return managed.ExternalCreation{}, nil
 }
 
 func (c *subnetworkExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -165,13 +195,21 @@ func (c *subnetworkExternal) Update(ctx context.Context, mg resource.Managed) (m
 	}
 	if privateAccess {
 		update := &googlecompute.SubnetworksSetPrivateIpGoogleAccessRequest{PrivateIpGoogleAccess: *cr.Spec.ForProvider.PrivateIPGoogleAccess}
-		_, err = c.Subnetworks.SetPrivateIpGoogleAccess(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), update).Context(ctx).Do()
-		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSubnetworkPAFailed)
+		op, err := c.Subnetworks.SetPrivateIpGoogleAccess(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), update).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSubnetworkPAFailed)
+		}
+		cr.Status.LastOperation = operation.GenerateOperation(op)
+		return managed.ExternalUpdate{}, nil
 	}
 
 	subnetUpdate := subnetwork.GenerateSubnetworkForUpdate(*cr, meta.GetExternalName(cr))
-	_, err = c.Subnetworks.Patch(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), subnetUpdate).Context(ctx).Do()
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSubnetworkFailed)
+	op, err := c.Subnetworks.Patch(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr), subnetUpdate).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSubnetworkFailed)
+	}
+	cr.Status.LastOperation = operation.GenerateOperation(op)
+	return managed.ExternalUpdate{}, nil
 }
 
 func (c *subnetworkExternal) Delete(ctx context.Context, mg resource.Managed) error {
@@ -181,6 +219,10 @@ func (c *subnetworkExternal) Delete(ctx context.Context, mg resource.Managed) er
 	}
 
 	cr.Status.SetConditions(xpv1.Deleting())
-	_, err := c.Subnetworks.Delete(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
-	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteSubnetworkFailed)
+	op, err := c.Subnetworks.Delete(c.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteSubnetworkFailed)
+	}
+	cr.Status.LastOperation = operation.GenerateOperation(op)
+	return nil
 }
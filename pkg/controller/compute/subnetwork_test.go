@@ -32,12 +32,14 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	"github.com/crossplane/provider-gcp/pkg/clients/operation"
 	"github.com/crossplane/provider-gcp/pkg/clients/subnetwork"
 )
 
@@ -54,6 +56,10 @@ func subnetworkWithConditions(c ...xpv1.Condition) subnetworkModifier {
 	return func(i *v1beta1.Subnetwork) { i.Status.SetConditions(c...) }
 }
 
+func subnetworkWithLastOperation(o *v1beta1.Operation) subnetworkModifier {
+	return func(i *v1beta1.Subnetwork) { i.Status.LastOperation = o }
+}
+
 func subnetworkWithDescription(d string) subnetworkModifier {
 	return func(i *v1beta1.Subnetwork) { i.Spec.ForProvider.Description = &d }
 }
@@ -84,6 +90,17 @@ func subnetworkObj(im ...subnetworkModifier) *v1beta1.Subnetwork {
 }
 
 func TestSubnetworkObserve(t *testing.T) {
+	regionalOperation := &v1beta1.Operation{
+		SelfLink: "https://www.googleapis.com/compute/v1/projects/" + projectID + "/regions/us-central1/operations/operation-1",
+		Status:   "RUNNING",
+	}
+	failedOperation := &v1beta1.Operation{
+		SelfLink:      regionalOperation.SelfLink,
+		OperationType: "insert",
+		Status:        operation.StatusDone,
+		Errors:        []v1beta1.OperationError{{Code: "IP_SPACE_EXHAUSTED", Message: "IP space of the network is exhausted."}},
+	}
+
 	type args struct {
 		mg resource.Managed
 	}
@@ -126,6 +143,29 @@ func TestSubnetworkObserve(t *testing.T) {
 				err: nil,
 			},
 		},
+		"OperationFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/projects/"+projectID+"/regions/us-central1/operations/operation-1", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&compute.Operation{
+					SelfLink:      regionalOperation.SelfLink,
+					OperationType: "insert",
+					Status:        "DONE",
+					Error: &compute.OperationError{Errors: []*compute.OperationErrorErrors{
+						{Code: "IP_SPACE_EXHAUSTED", Message: "IP space of the network is exhausted."},
+					}},
+				})
+			}),
+			args: args{
+				mg: subnetworkObj(subnetworkWithLastOperation(regionalOperation)),
+			},
+			want: want{
+				mg:  subnetworkObj(subnetworkWithLastOperation(failedOperation)),
+				err: operation.Failure(failedOperation),
+			},
+		},
 		"GetFailed": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				_ = r.Body.Close()
@@ -203,6 +243,7 @@ func TestSubnetworkObserve(t *testing.T) {
 				kube:      tc.kube,
 				projectID: projectID,
 				Service:   s,
+				record:    event.NewNopRecorder(),
 			}
 			obs, err := e.Observe(context.Background(), tc.args.mg)
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -261,13 +302,14 @@ func TestSubnetworkCreate(t *testing.T) {
 				}
 				w.WriteHeader(http.StatusOK)
 				_ = r.Body.Close()
-				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+				_ = json.NewEncoder(w).Encode(testOperation)
 			}),
+			kube: &test.MockClient{MockStatusUpdate: test.NewMockStatusUpdateFn(nil)},
 			args: args{
 				mg: subnetworkObj(),
 			},
 			want: want{
-				mg:  subnetworkObj(subnetworkWithConditions(xpv1.Creating())),
+				mg:  subnetworkObj(subnetworkWithConditions(xpv1.Creating()), subnetworkWithLastOperation(testOperationStatus)),
 				cre: managed.ExternalCreation{},
 				err: nil,
 			},
@@ -322,7 +364,7 @@ func TestSubnetworkCreate(t *testing.T) {
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
 			}
-			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg, ignoreRequestID); diff != "" {
 				t.Errorf("Create(...): -want, +got:\n%s", diff)
 			}
 		})
@@ -367,7 +409,7 @@ func TestSubnetworkDelete(t *testing.T) {
 				mg: subnetworkObj(),
 			},
 			want: want{
-				mg:  subnetworkObj(subnetworkWithConditions(xpv1.Deleting())),
+				mg:  subnetworkObj(subnetworkWithConditions(xpv1.Deleting()), subnetworkWithLastOperation(&v1beta1.Operation{})),
 				err: nil,
 			},
 		},
@@ -501,7 +543,7 @@ func TestSubnetworkUpdate(t *testing.T) {
 				mg: subnetworkObj(subnetworkWithDescription("a new description")),
 			},
 			want: want{
-				mg:  subnetworkObj(subnetworkWithDescription("a new description")),
+				mg:  subnetworkObj(subnetworkWithDescription("a new description"), subnetworkWithLastOperation(&v1beta1.Operation{})),
 				err: nil,
 			},
 		},
@@ -529,7 +571,7 @@ func TestSubnetworkUpdate(t *testing.T) {
 				mg: subnetworkObj(subnetworkWithPrivateAccess(true)),
 			},
 			want: want{
-				mg:  subnetworkObj(subnetworkWithPrivateAccess(true)),
+				mg:  subnetworkObj(subnetworkWithPrivateAccess(true), subnetworkWithLastOperation(&v1beta1.Operation{})),
 				err: nil,
 			},
 		},
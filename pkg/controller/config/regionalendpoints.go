@@ -0,0 +1,128 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	errGetPC        = "cannot get ProviderConfig"
+	errUpdateStatus = "cannot update ProviderConfig status"
+)
+
+// Condition types and reasons.
+const (
+	TypeRegionalEndpoints xpv1.ConditionType = "RegionalEndpoints"
+
+	ReasonRegionalEndpoints xpv1.ConditionReason = "RegionalEndpoints"
+	ReasonGlobalEndpoints   xpv1.ConditionReason = "GlobalEndpoints"
+)
+
+// RegionalEndpoints returns a condition that indicates which services use
+// regional endpoints and which fall back to their global endpoint.
+func RegionalEndpoints(spec v1beta1.ProviderConfigSpec) xpv1.Condition {
+	c := xpv1.Condition{
+		Type:               TypeRegionalEndpoints,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonGlobalEndpoints,
+	}
+	region := gcp.StringValue(spec.Region)
+	if region == "" {
+		c.Message = "No region is configured; all services use their global endpoints"
+		return c
+	}
+	regional := make([]string, 0)
+	for _, s := range gcp.RegionalServices() {
+		if _, ok := spec.Endpoints[s]; !ok {
+			regional = append(regional, s)
+		}
+	}
+	if len(regional) == 0 {
+		c.Message = fmt.Sprintf("All services with an endpoint in %s use an endpoint override; all other services use their global endpoints", region)
+		return c
+	}
+	c.Status = corev1.ConditionTrue
+	c.Reason = ReasonRegionalEndpoints
+	c.Message = fmt.Sprintf("Services %s use their endpoints in %s; all other services have no regional endpoint and use their global endpoints", strings.Join(regional, ", "), region)
+	return c
+}
+
+// SetupRegionalEndpoints adds a controller that reports which services use
+// the regional endpoints of the region of a ProviderConfig.
+func SetupRegionalEndpoints(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := "regionalendpoints/" + strings.ToLower(v1beta1.ProviderConfigGroupKind)
+
+	r := &regionalEndpointsReconciler{
+		client: mgr.GetClient(),
+		log:    l.WithValues("controller", name),
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1beta1.ProviderConfig{}).
+		Complete(r)
+}
+
+type regionalEndpointsReconciler struct {
+	client client.Client
+	log    logging.Logger
+}
+
+// Reconcile a ProviderConfig by setting its RegionalEndpoints condition.
+func (r *regionalEndpointsReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+	log.Debug("Reconciling")
+
+	pc := &v1beta1.ProviderConfig{}
+	if err := r.client.Get(ctx, req.NamespacedName, pc); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetPC)
+	}
+	if meta.WasDeleted(pc) {
+		return reconcile.Result{}, nil
+	}
+	c := RegionalEndpoints(pc.Spec)
+	if pc.GetCondition(TypeRegionalEndpoints).Equal(c) {
+		return reconcile.Result{}, nil
+	}
+	pc.SetConditions(c)
+	return reconcile.Result{}, errors.Wrap(r.client.Status().Update(ctx, pc), errUpdateStatus)
+}
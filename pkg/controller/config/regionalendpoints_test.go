@@ -0,0 +1,117 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+func TestReconcile(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		err       error
+		condition *xpv1.Condition
+	}
+
+	cases := map[string]struct {
+		reason string
+		spec   v1beta1.ProviderConfigSpec
+		getErr error
+		want   want
+	}{
+		"GetFailed": {
+			reason: "Should return an error if the ProviderConfig can't be read",
+			getErr: errBoom,
+			want:   want{err: errors.Wrap(errBoom, errGetPC)},
+		},
+		"NoRegion": {
+			reason: "All services should use their global endpoints if no region is configured",
+			want: want{condition: &xpv1.Condition{
+				Type:    TypeRegionalEndpoints,
+				Status:  corev1.ConditionFalse,
+				Reason:  ReasonGlobalEndpoints,
+				Message: "No region is configured; all services use their global endpoints",
+			}},
+		},
+		"AllOverridden": {
+			reason: "Services whose endpoints are overridden should not be reported as regional",
+			spec: v1beta1.ProviderConfigSpec{
+				Region:    gcp.StringPtr("europe-west3"),
+				Endpoints: map[string]string{"cloudkms": "a", "logging": "b", "pubsub": "c", "storage": "d"},
+			},
+			want: want{condition: &xpv1.Condition{
+				Type:    TypeRegionalEndpoints,
+				Status:  corev1.ConditionFalse,
+				Reason:  ReasonGlobalEndpoints,
+				Message: "All services with an endpoint in europe-west3 use an endpoint override; all other services use their global endpoints",
+			}},
+		},
+		"Region": {
+			reason: "The services that use regional endpoints should be reported",
+			spec: v1beta1.ProviderConfigSpec{
+				Region:    gcp.StringPtr("europe-west3"),
+				Endpoints: map[string]string{"storage": "https://storage.example.com/storage/v1/"},
+			},
+			want: want{condition: &xpv1.Condition{
+				Type:    TypeRegionalEndpoints,
+				Status:  corev1.ConditionTrue,
+				Reason:  ReasonRegionalEndpoints,
+				Message: "Services cloudkms, logging, pubsub use their endpoints in europe-west3; all other services have no regional endpoint and use their global endpoints",
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got *xpv1.Condition
+			kube := &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					obj.(*v1beta1.ProviderConfig).Spec = tc.spec
+					return tc.getErr
+				},
+				MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+					c := obj.(*v1beta1.ProviderConfig).GetCondition(TypeRegionalEndpoints)
+					got = &c
+					return nil
+				},
+			}
+			r := &regionalEndpointsReconciler{client: kube, log: logging.NewNopLogger()}
+			_, err := r.Reconcile(context.Background(), reconcile.Request{})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.condition, got, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want condition, +got condition:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -54,26 +54,42 @@ const (
 	errCheckClusterUpToDate = "cannot determine if GKE cluster is up to date"
 )
 
+// clusterCreationTime is how long the creation of a GKE cluster typically
+// takes. Clusters that are being created are observed again once it's over.
+const clusterCreationTime = 5 * time.Minute
+
 // SetupCluster adds a controller that reconciles Cluster
 // managed resources.
-func SetupCluster(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+func SetupCluster(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
 	name := managed.ControllerName(v1beta2.ClusterGroupKind)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: mcr.For(v1beta2.ClusterGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
 		}).
 		For(&v1beta2.Cluster{}).
-		Complete(managed.NewReconciler(mgr,
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1beta2.ClusterGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
 			resource.ManagedKind(v1beta2.ClusterGroupVersionKind),
-			managed.WithExternalConnecter(&clusterConnector{kube: mgr.GetClient()}),
+			&clusterConnector{kube: mgr.GetClient()},
+			managed.WithInitializers(gcp.NewExternalNameInitializer(mgr.GetClient()), gcp.NewDefaultLabelsInitializer(mgr.GetClient(), clusterLabels)),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
 
+// clusterLabels returns the resource labels of a Cluster.
+func clusterLabels(mg resource.Managed) *map[string]string {
+	cr, ok := mg.(*v1beta2.Cluster)
+	if !ok {
+		return nil
+	}
+	return &cr.Spec.ForProvider.ResourceLabels
+}
+
 type clusterConnector struct {
 	kube client.Client
 }
@@ -83,7 +99,7 @@ func (c *clusterConnector) Connect(ctx context.Context, mg resource.Managed) (ma
 	if err != nil {
 		return nil, err
 	}
-	s, err := container.NewService(ctx, opts)
+	s, err := container.NewService(ctx, opts.For("container")...)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
@@ -110,7 +126,7 @@ func (e *clusterExternal) Observe(ctx context.Context, mg resource.Managed) (man
 	cr.Status.AtProvider = gke.GenerateObservation(*existing)
 	currentSpec := cr.Spec.ForProvider.DeepCopy()
 	gke.LateInitializeSpec(&cr.Spec.ForProvider, *existing)
-	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
 		if err := e.kube.Update(ctx, cr); err != nil {
 			return managed.ExternalObservation{}, errors.Wrap(err, errManagedUpdateFailed)
 		}
@@ -121,14 +137,21 @@ func (e *clusterExternal) Observe(ctx context.Context, mg resource.Managed) (man
 		cr.Status.SetConditions(xpv1.Available())
 	case v1beta2.ClusterStateProvisioning:
 		cr.Status.SetConditions(xpv1.Creating())
+		gcp.RequeueAfter(cr, gcp.RemainingTime(existing.CreateTime, clusterCreationTime))
 	case v1beta2.ClusterStateUnspecified, v1beta2.ClusterStateDegraded, v1beta2.ClusterStateError:
 		cr.Status.SetConditions(xpv1.Unavailable())
 	}
 
-	u, _, err := gke.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, existing)
+	u, _, err := gke.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, existing, cr.Spec.IgnoreFields...)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errCheckClusterUpToDate)
 	}
+	if !u {
+		cr.Status.AtProvider.Diff, err = gke.Diff(meta.GetExternalName(cr), &cr.Spec.ForProvider, existing, cr.Spec.IgnoreFields...)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errCheckClusterUpToDate)
+		}
+	}
 
 	return managed.ExternalObservation{
 		ResourceExists:    true,
@@ -185,7 +208,7 @@ func (e *clusterExternal) Update(ctx context.Context, mg resource.Managed) (mana
 		return managed.ExternalUpdate{}, errors.Wrap(err, errGetCluster)
 	}
 
-	u, fn, err := gke.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, existing)
+	u, fn, err := gke.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, existing, cr.Spec.IgnoreFields...)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errCheckClusterUpToDate)
 	}
@@ -235,6 +258,7 @@ func connectionDetails(cluster *container.Cluster) managed.ConnectionDetails {
 		xpv1.ResourceCredentialsSecretClientCertKey: config.AuthInfos[cluster.Name].ClientCertificateData,
 		xpv1.ResourceCredentialsSecretClientKeyKey:  config.AuthInfos[cluster.Name].ClientKeyData,
 		xpv1.ResourceCredentialsSecretKubeconfigKey: rawConfig,
+		v1beta2.ConnectionSecretClusterNameKey:      []byte(cluster.Name),
 	}
 	return cd
 }
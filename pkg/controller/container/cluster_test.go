@@ -884,6 +884,7 @@ users:
 				xpv1.ResourceCredentialsSecretClientCertKey: clientCert,
 				xpv1.ResourceCredentialsSecretClientKeyKey:  clientKey,
 				xpv1.ResourceCredentialsSecretKubeconfigKey: []byte(rawConfig),
+				v1beta2.ConnectionSecretClusterNameKey:      []byte(name),
 			},
 		},
 		"Empty": {
@@ -54,18 +54,20 @@ const (
 
 // SetupNodePool adds a controller that reconciles NodePool managed
 // resources.
-func SetupNodePool(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+func SetupNodePool(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
 	name := managed.ControllerName(v1beta1.NodePoolGroupKind)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: mcr.For(v1beta1.NodePoolGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
 		}).
 		For(&v1beta1.NodePool{}).
-		Complete(managed.NewReconciler(mgr,
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1beta1.NodePoolGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.NodePoolGroupVersionKind),
-			managed.WithExternalConnecter(&nodePoolConnector{kube: mgr.GetClient()}),
+			&nodePoolConnector{kube: mgr.GetClient()},
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l),
@@ -81,7 +83,7 @@ func (c *nodePoolConnector) Connect(ctx context.Context, mg resource.Managed) (m
 	if err != nil {
 		return nil, err
 	}
-	s, err := container.NewService(ctx, opts)
+	s, err := container.NewService(ctx, opts.For("container")...)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
@@ -108,7 +110,7 @@ func (e *nodePoolExternal) Observe(ctx context.Context, mg resource.Managed) (ma
 	cr.Status.AtProvider = np.GenerateObservation(*existing)
 	currentSpec := cr.Spec.ForProvider.DeepCopy()
 	np.LateInitializeSpec(&cr.Spec.ForProvider, *existing)
-	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
 		if err := e.kube.Update(ctx, cr); err != nil {
 			return managed.ExternalObservation{}, errors.Wrap(err, errManagedNodePoolUpdateFailed)
 		}
@@ -123,10 +125,16 @@ func (e *nodePoolExternal) Observe(ctx context.Context, mg resource.Managed) (ma
 		cr.Status.SetConditions(xpv1.Unavailable())
 	}
 
-	u, _, err := np.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, existing)
+	u, _, err := np.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, existing, cr.Spec.IgnoreFields...)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errCheckNodePoolUpToDate)
 	}
+	if !u {
+		cr.Status.AtProvider.Diff, err = np.Diff(meta.GetExternalName(cr), &cr.Spec.ForProvider, existing, cr.Spec.IgnoreFields...)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errCheckNodePoolUpToDate)
+		}
+	}
 
 	return managed.ExternalObservation{
 		ResourceExists:   true,
@@ -175,7 +183,7 @@ func (e *nodePoolExternal) Update(ctx context.Context, mg resource.Managed) (man
 		return managed.ExternalUpdate{}, errors.Wrap(err, errGetNodePool)
 	}
 
-	u, fn, err := np.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, existing)
+	u, fn, err := np.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, existing, cr.Spec.IgnoreFields...)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errCheckNodePoolUpToDate)
 	}
@@ -57,15 +57,20 @@ const (
 	errCheckUpToDate    = "cannot determine if CloudSQL instance is up to date"
 )
 
+// cloudsqlCreationTime is how long the creation of a CloudSQL instance
+// typically takes. Instances that are being created are observed again once
+// it's over.
+const cloudsqlCreationTime = 10 * time.Minute
+
 // SetupCloudSQLInstance adds a controller that reconciles
 // CloudSQLInstance managed resources.
-func SetupCloudSQLInstance(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+func SetupCloudSQLInstance(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
 	name := managed.ControllerName(v1beta1.CloudSQLInstanceGroupKind)
 
-	r := managed.NewReconciler(mgr,
+	r := gcp.NewReconciler(mgr,
 		resource.ManagedKind(v1beta1.CloudSQLInstanceGroupVersionKind),
-		managed.WithExternalConnecter(&cloudsqlConnector{kube: mgr.GetClient()}),
-		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &cloudsqlTagger{kube: mgr.GetClient()}),
+		&cloudsqlConnector{kube: mgr.GetClient()},
+		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), gcp.NewExternalNameInitializer(mgr.GetClient()), &cloudsqlTagger{kube: mgr.GetClient()}, gcp.NewDefaultLabelsInitializer(mgr.GetClient(), cloudsqlLabels), gcp.NewDefaultLocationInitializer(mgr.GetClient(), cloudsqlLocation)),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithPollInterval(poll),
 		managed.WithLogger(l.WithValues("controller", name)),
@@ -74,9 +79,11 @@ func SetupCloudSQLInstance(mgr ctrl.Manager, l logging.Logger, rl workqueue.Rate
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: mcr.For(v1beta1.CloudSQLInstanceGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
 		}).
 		For(&v1beta1.CloudSQLInstance{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1beta1.CloudSQLInstanceGroupVersionKind))).
 		Complete(r)
 }
 
@@ -89,7 +96,7 @@ func (c *cloudsqlConnector) Connect(ctx context.Context, mg resource.Managed) (m
 	if err != nil {
 		return nil, err
 	}
-	s, err := sqladmin.NewService(ctx, opts)
+	s, err := sqladmin.NewService(ctx, opts.For("sqladmin")...)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
@@ -115,7 +122,7 @@ func (c *cloudsqlExternal) Observe(ctx context.Context, mg resource.Managed) (ma
 	cloudsql.LateInitializeSpec(&cr.Spec.ForProvider, *instance)
 	// TODO(muvaf): reflection in production code might cause performance bottlenecks. Generating comparison
 	// methods would make more sense.
-	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
 		if err := c.kube.Update(ctx, cr); err != nil {
 			return managed.ExternalObservation{}, errors.Wrap(err, errManagedUpdateFailed)
 		}
@@ -126,6 +133,7 @@ func (c *cloudsqlExternal) Observe(ctx context.Context, mg resource.Managed) (ma
 		cr.Status.SetConditions(xpv1.Available())
 	case v1beta1.StateCreating:
 		cr.Status.SetConditions(xpv1.Creating())
+		gcp.RequeueAfter(cr, gcp.RemainingTime(instance.CreateTime, cloudsqlCreationTime))
 	case v1beta1.StateCreationFailed, v1beta1.StateSuspended, v1beta1.StateMaintenance, v1beta1.StateUnknownState:
 		cr.Status.SetConditions(xpv1.Unavailable())
 	}
@@ -134,9 +142,19 @@ func (c *cloudsqlExternal) Observe(ctx context.Context, mg resource.Managed) (ma
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errCheckUpToDate)
 	}
+	protected := isDeletionProtectionUpToDate(cr, instance)
+	if !upToDate || !protected {
+		cr.Status.AtProvider.Diff, err = cloudsql.Diff(meta.GetExternalName(cr), &cr.Spec.ForProvider, instance)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errCheckUpToDate)
+		}
+		if !protected {
+			cr.Status.AtProvider.Diff = append(cr.Status.AtProvider.Diff, "settings.deletionProtectionEnabled")
+		}
+	}
 	return managed.ExternalObservation{
 		ResourceExists:    true,
-		ResourceUpToDate:  upToDate,
+		ResourceUpToDate:  upToDate && protected,
 		ConnectionDetails: getConnectionDetails(cr, instance),
 	}, nil
 }
@@ -149,6 +167,7 @@ func (c *cloudsqlExternal) Create(ctx context.Context, mg resource.Managed) (man
 	cr.SetConditions(xpv1.Creating())
 	instance := &sqladmin.DatabaseInstance{}
 	cloudsql.GenerateDatabaseInstance(meta.GetExternalName(cr), cr.Spec.ForProvider, instance)
+	setDeletionProtection(cr, instance)
 	pw, err := password.Generate()
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errGeneratePassword)
@@ -167,6 +186,11 @@ func (c *cloudsqlExternal) Create(ctx context.Context, mg resource.Managed) (man
 	cd := managed.ConnectionDetails{
 		xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
 	}
+	if gcp.BoolValue(cr.Spec.PublishDSN) {
+		if dsn := cloudsql.ProxyDSN(cr.Spec.ForProvider, pw); dsn != "" {
+			cd[v1beta1.CloudSQLSecretDSNKey] = []byte(dsn)
+		}
+	}
 	return managed.ExternalCreation{ConnectionDetails: cd}, nil
 }
 
@@ -180,6 +204,7 @@ func (c *cloudsqlExternal) Update(ctx context.Context, mg resource.Managed) (man
 	}
 	instance := &sqladmin.DatabaseInstance{}
 	cloudsql.GenerateDatabaseInstance(meta.GetExternalName(cr), cr.Spec.ForProvider, instance)
+	setDeletionProtection(cr, instance)
 	// TODO(muvaf): the returned operation handle could help us not to send Patch
 	// request aggressively.
 	_, err := c.db.Patch(c.projectID, meta.GetExternalName(cr), instance).Context(ctx).Do()
@@ -193,10 +218,27 @@ func (c *cloudsqlExternal) Delete(ctx context.Context, mg resource.Managed) erro
 	}
 	cr.SetConditions(xpv1.Deleting())
 	_, err := c.db.Delete(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
-	if gcp.IsErrorNotFound(err) {
-		return nil
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteFailed)
+}
+
+// setDeletionProtection makes the native deletion protection of the supplied
+// instance match that of the CloudSQLInstance, if the latter is specified.
+func setDeletionProtection(cr *v1beta1.CloudSQLInstance, instance *sqladmin.DatabaseInstance) {
+	if cr.Spec.DeletionProtection == nil {
+		return
+	}
+	instance.Settings.DeletionProtectionEnabled = *cr.Spec.DeletionProtection
+	instance.Settings.ForceSendFields = append(instance.Settings.ForceSendFields, "DeletionProtectionEnabled")
+}
+
+// isDeletionProtectionUpToDate returns false if the CloudSQLInstance specifies
+// whether it is protected from deletion, and the native deletion protection of
+// the supplied instance differs.
+func isDeletionProtectionUpToDate(cr *v1beta1.CloudSQLInstance, instance *sqladmin.DatabaseInstance) bool {
+	if cr.Spec.DeletionProtection == nil || instance.Settings == nil {
+		return true
 	}
-	return errors.Wrap(err, errDeleteFailed)
+	return instance.Settings.DeletionProtectionEnabled == *cr.Spec.DeletionProtection
 }
 
 func getConnectionDetails(cr *v1beta1.CloudSQLInstance, instance *sqladmin.DatabaseInstance) managed.ConnectionDetails {
@@ -229,6 +271,24 @@ func getConnectionDetails(cr *v1beta1.CloudSQLInstance, instance *sqladmin.Datab
 	return m
 }
 
+// cloudsqlLabels returns the user labels of a CloudSQLInstance.
+func cloudsqlLabels(mg resource.Managed) *map[string]string {
+	cr, ok := mg.(*v1beta1.CloudSQLInstance)
+	if !ok {
+		return nil
+	}
+	return &cr.Spec.ForProvider.Settings.UserLabels
+}
+
+// cloudsqlLocation returns the region of a CloudSQLInstance.
+func cloudsqlLocation(mg resource.Managed) (*string, **string) {
+	cr, ok := mg.(*v1beta1.CloudSQLInstance)
+	if !ok {
+		return nil, nil
+	}
+	return &cr.Spec.ForProvider.Region, nil
+}
+
 type cloudsqlTagger struct {
 	kube client.Client
 }
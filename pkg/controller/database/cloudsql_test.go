@@ -22,6 +22,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -39,6 +40,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-gcp/apis/database/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 	"github.com/crossplane/provider-gcp/pkg/clients/cloudsql"
 )
 
@@ -94,6 +96,24 @@ func withBackupConfigurationStartTime(h string) instanceModifier {
 	}
 }
 
+func withLateInitializationDisabled() instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) {
+		meta.AddAnnotations(i, map[string]string{gcp.AnnotationKeyLateInitialization: gcp.LateInitializationDisabled})
+	}
+}
+
+func withDeletionProtection(p bool) instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) { i.Spec.DeletionProtection = &p }
+}
+
+func withPublishDSN(p bool) instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) { i.Spec.PublishDSN = &p }
+}
+
+func withDiff(paths ...string) instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) { i.Status.AtProvider.Diff = paths }
+}
+
 func instance(im ...instanceModifier) *v1beta1.CloudSQLInstance {
 	i := &v1beta1.CloudSQLInstance{
 		ObjectMeta: metav1.ObjectMeta{
@@ -221,6 +241,98 @@ func TestObserve(t *testing.T) {
 				err: errors.Wrap(errBoom, errManagedUpdateFailed),
 			},
 		},
+		"LateInitializationDisabled": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				instance := instance(withBackupConfigurationStartTime("22:00"))
+				db := &sqladmin.DatabaseInstance{}
+				cloudsql.GenerateDatabaseInstance(meta.GetExternalName(instance), instance.Spec.ForProvider, db)
+				db.State = v1beta1.StateRunnable
+				_ = json.NewEncoder(w).Encode(db)
+			}),
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil, func(_ client.Object) error {
+					t.Errorf("Update(...): the spec of a managed resource whose late initialization is disabled should not be persisted")
+					return nil
+				}),
+			},
+			args: args{
+				mg: instance(withLateInitializationDisabled()),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connDetails("", ""),
+				},
+				mg: instance(
+					withLateInitializationDisabled(),
+					withBackupConfigurationStartTime("22:00"),
+					withProviderState(v1beta1.StateRunnable),
+					withConditions(xpv1.Available())),
+			},
+		},
+		"NotUpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				instance := instance(withBackupConfigurationStartTime("23:00"))
+				db := &sqladmin.DatabaseInstance{}
+				cloudsql.GenerateDatabaseInstance(meta.GetExternalName(instance), instance.Spec.ForProvider, db)
+				db.State = v1beta1.StateCreating
+				_ = json.NewEncoder(w).Encode(db)
+			}),
+			args: args{
+				mg: instance(withBackupConfigurationStartTime("22:00")),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connDetails("", ""),
+				},
+				mg: instance(
+					withBackupConfigurationStartTime("22:00"),
+					withProviderState(v1beta1.StateCreating),
+					withConditions(xpv1.Creating()),
+					withDiff("settings.backupConfiguration.startTime")),
+			},
+		},
+		"DeletionProtectionNotUpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				db := &sqladmin.DatabaseInstance{}
+				cloudsql.GenerateDatabaseInstance(meta.GetExternalName(instance()), instance().Spec.ForProvider, db)
+				db.State = v1beta1.StateRunnable
+				_ = json.NewEncoder(w).Encode(db)
+			}),
+			args: args{
+				mg: instance(withDeletionProtection(true)),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connDetails("", ""),
+				},
+				mg: instance(
+					withDeletionProtection(true),
+					withProviderState(v1beta1.StateRunnable),
+					withConditions(xpv1.Available()),
+					withDiff("settings.deletionProtectionEnabled")),
+			},
+		},
 		"Creating": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				_ = r.Body.Close()
@@ -384,6 +496,24 @@ func TestCreate(t *testing.T) {
 				err: nil,
 			},
 		},
+		"SuccessfulWithDSN": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}),
+			args: args{
+				mg: instance(withPublishDSN(true)),
+			},
+			want: want{
+				mg: instance(withPublishDSN(true), withConditions(xpv1.Creating())),
+				cre: managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{
+					xpv1.ResourceCredentialsSecretPasswordKey: []byte(wantRandom),
+					v1beta1.CloudSQLSecretDSNKey:              []byte(wantRandom),
+				}},
+				err: nil,
+			},
+		},
 		"AlreadyExists": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				_ = r.Body.Close()
@@ -446,17 +576,23 @@ func TestCreate(t *testing.T) {
 				// equal if one has the special password value wantRandom and
 				// the other has a non-zero password string. If neither has the
 				// special password value it falls back to default compare
-				// semantics.
+				// semantics. The DSN, if any, must contain the password.
 
 				av := string(a[xpv1.ResourceCredentialsSecretPasswordKey])
 				bv := string(b[xpv1.ResourceCredentialsSecretPasswordKey])
+				random := func(want, got managed.ConnectionDetails, pw string) bool {
+					if want[v1beta1.CloudSQLSecretDSNKey] == nil {
+						return got[v1beta1.CloudSQLSecretDSNKey] == nil && len(pw) > 0
+					}
+					return len(pw) > 0 && strings.Contains(string(got[v1beta1.CloudSQLSecretDSNKey]), pw)
+				}
 
 				if av == wantRandom {
-					return len(bv) > 0
+					return random(a, b, bv)
 				}
 
 				if bv == wantRandom {
-					return len(av) > 0
+					return random(b, a, av)
 				}
 
 				return cmp.Equal(a, b)
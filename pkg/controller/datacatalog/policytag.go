@@ -0,0 +1,158 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datacatalog
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	datacatalog "google.golang.org/api/datacatalog/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/datacatalog/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	datacatalogclient "github.com/crossplane/provider-gcp/pkg/clients/datacatalog"
+)
+
+// Error strings.
+const (
+	errNotPolicyTag      = "managed resource is not a Data Catalog PolicyTag"
+	errGetPolicyTag      = "cannot get Data Catalog PolicyTag"
+	errCreatePolicyTag   = "cannot create Data Catalog PolicyTag"
+	errUpdatePolicyTag   = "cannot update Data Catalog PolicyTag"
+	errDeletePolicyTag   = "cannot delete Data Catalog PolicyTag"
+	errUpdatePolicyTagCR = "cannot update Data Catalog PolicyTag custom resource"
+)
+
+// SetupPolicyTag adds a controller that reconciles Data Catalog PolicyTags.
+func SetupPolicyTag(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.PolicyTagGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.PolicyTagGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.PolicyTag{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.PolicyTagGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.PolicyTagGroupVersionKind),
+			&policyTagConnector{kube: mgr.GetClient()},
+			managed.WithInitializers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type policyTagConnector struct {
+	kube client.Client
+}
+
+func (c *policyTagConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := datacatalog.NewService(ctx, opts.For("datacatalog")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &policyTagExternal{kube: c.kube, policyTags: s.Projects.Locations.Taxonomies.PolicyTags}, nil
+}
+
+type policyTagExternal struct {
+	kube       client.Client
+	policyTags *datacatalog.ProjectsLocationsTaxonomiesPolicyTagsService
+}
+
+func (e *policyTagExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.PolicyTag)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPolicyTag)
+	}
+	// Policy tag IDs are assigned by Data Catalog, so until we've created the
+	// policy tag we have nothing to look up.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	existing, err := e.policyTags.Get(datacatalogclient.GetPolicyTagName(cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetPolicyTag)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	datacatalogclient.LateInitializePolicyTag(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdatePolicyTagCR)
+		}
+	}
+	cr.Status.AtProvider = datacatalogclient.GeneratePolicyTagObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: datacatalogclient.IsPolicyTagUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *policyTagExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.PolicyTag)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPolicyTag)
+	}
+	cr.SetConditions(xpv1.Creating())
+	t, err := e.policyTags.Create(gcp.StringValue(cr.Spec.ForProvider.Taxonomy), datacatalogclient.GeneratePolicyTag(cr.Spec.ForProvider)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreatePolicyTag)
+	}
+	meta.SetExternalName(cr, datacatalogclient.GetPolicyTagID(*t))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *policyTagExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.PolicyTag)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPolicyTag)
+	}
+	name := datacatalogclient.GetPolicyTagName(cr.Spec.ForProvider, meta.GetExternalName(cr))
+	_, err := e.policyTags.Patch(name, datacatalogclient.GeneratePolicyTag(cr.Spec.ForProvider)).UpdateMask(datacatalogclient.PolicyTagUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdatePolicyTag)
+}
+
+func (e *policyTagExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.PolicyTag)
+	if !ok {
+		return errors.New(errNotPolicyTag)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.policyTags.Delete(datacatalogclient.GetPolicyTagName(cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeletePolicyTag)
+}
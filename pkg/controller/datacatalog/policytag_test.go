@@ -0,0 +1,270 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datacatalog
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	datacatalog "google.golang.org/api/datacatalog/v1"
+	"google.golang.org/api/option"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/datacatalog/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const taxonomyName = "projects/" + projectID + "/locations/us/taxonomies/1234"
+
+func newPolicyTag(externalName string) *v1alpha1.PolicyTag {
+	t := &v1alpha1.PolicyTag{}
+	meta.SetExternalName(t, externalName)
+	t.Spec.ForProvider = v1alpha1.PolicyTagParameters{
+		Taxonomy:    gcp.StringPtr(taxonomyName),
+		DisplayName: "PII",
+		Description: gcp.StringPtr("Personally identifiable information"),
+	}
+	return t
+}
+
+func TestPolicyTagObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotPolicyTag": {
+			reason: "Should return an error if the resource is not a PolicyTag",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotPolicyTag)},
+		},
+		"NoExternalName": {
+			reason: "Should report the policy tag as missing if it has not been assigned an ID",
+			mg:     newPolicyTag(""),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected %s request", r.Method)
+			}),
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			mg:     newPolicyTag("5678"),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			mg:     newPolicyTag("5678"),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetPolicyTag)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(&datacatalog.GoogleCloudDatacatalogV1PolicyTag{})
+			}),
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if the late initialization update fails",
+			mg: func() resource.Managed {
+				t := newPolicyTag("5678")
+				t.Spec.ForProvider.Description = nil
+				return t
+			}(),
+			want: want{err: errors.Wrap(errBoom, errUpdatePolicyTagCR)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&datacatalog.GoogleCloudDatacatalogV1PolicyTag{Description: "PII"})
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should return upToDate as true if the resource is up to date",
+			mg:     newPolicyTag("5678"),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&datacatalog.GoogleCloudDatacatalogV1PolicyTag{
+					DisplayName: "PII",
+					Description: "Personally identifiable information",
+				})
+			}),
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should return upToDate as false if the resource is not up to date",
+			mg:     newPolicyTag("5678"),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&datacatalog.GoogleCloudDatacatalogV1PolicyTag{
+					DisplayName:     "PII",
+					Description:     "Personally identifiable information",
+					ParentPolicyTag: taxonomyName + "/policyTags/1000",
+				})
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := datacatalog.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := policyTagExternal{
+				kube:       tc.kube,
+				policyTags: s.Projects.Locations.Taxonomies.PolicyTags,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestPolicyTagCreate(t *testing.T) {
+	cr := newPolicyTag("")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(&datacatalog.GoogleCloudDatacatalogV1PolicyTag{
+			Name: taxonomyName + "/policyTags/5678",
+		})
+	}))
+	defer server.Close()
+	s, _ := datacatalog.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := policyTagExternal{
+		policyTags: s.Projects.Locations.Taxonomies.PolicyTags,
+	}
+	got, err := e.Create(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Create(...): unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(managed.ExternalCreation{ExternalNameAssigned: true}, got); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("5678", meta.GetExternalName(cr)); diff != "" {
+		t.Errorf("Create(...): -want external name, +got external name:\n%s", diff)
+	}
+}
+
+func updatePolicyTag(e *policyTagExternal, mg resource.Managed) error {
+	_, err := e.Update(context.Background(), mg)
+	return err
+}
+
+func deletePolicyTag(e *policyTagExternal, mg resource.Managed) error {
+	return e.Delete(context.Background(), mg)
+}
+
+func TestPolicyTagUpdateDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		method  string
+		status  int
+		call    func(e *policyTagExternal, mg resource.Managed) error
+		mg      resource.Managed
+		wantErr error
+	}{
+		"UpdateNotPolicyTag": {
+			reason:  "Should return an error if the resource is not a PolicyTag",
+			call:    updatePolicyTag,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotPolicyTag),
+		},
+		"UpdateSuccessful": {
+			reason: "Should succeed if the resource update doesn't return an error",
+			method: http.MethodPatch,
+			status: http.StatusOK,
+			call:   updatePolicyTag,
+			mg:     newPolicyTag("5678"),
+		},
+		"UpdateFailed": {
+			reason:  "Should fail if the resource update returns an error",
+			method:  http.MethodPatch,
+			status:  http.StatusBadRequest,
+			call:    updatePolicyTag,
+			mg:      newPolicyTag("5678"),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdatePolicyTag),
+		},
+		"DeleteAlreadyGone": {
+			reason: "Should not return an error if the resource is already gone",
+			method: http.MethodDelete,
+			status: http.StatusNotFound,
+			call:   deletePolicyTag,
+			mg:     newPolicyTag("5678"),
+		},
+		"DeleteFailed": {
+			reason:  "Should fail if the resource deletion returns an error",
+			method:  http.MethodDelete,
+			status:  http.StatusBadRequest,
+			call:    deletePolicyTag,
+			mg:      newPolicyTag("5678"),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errDeletePolicyTag),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&datacatalog.GoogleCloudDatacatalogV1PolicyTag{})
+			}))
+			defer server.Close()
+			s, _ := datacatalog.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &policyTagExternal{
+				policyTags: s.Projects.Locations.Taxonomies.PolicyTags,
+			}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datacatalog
+
+import (
+	"context"
+	"time"
+
+	datacatalog "google.golang.org/api/datacatalog/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/datacatalog/v1alpha1"
+	iamv1alpha1 "github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	datacatalogclient "github.com/crossplane/provider-gcp/pkg/clients/datacatalog"
+)
+
+// Error strings.
+const (
+	errNotPolicyTagIAMMember = "managed resource is not a Data Catalog PolicyTagIAMMember"
+	errGetPolicy             = "cannot get Data Catalog PolicyTag IAM policy"
+	errSetPolicy             = "cannot set Data Catalog PolicyTag IAM policy"
+)
+
+// SetupPolicyTagIAMMember adds a controller that reconciles Data Catalog
+// PolicyTagIAMMembers.
+func SetupPolicyTagIAMMember(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.PolicyTagIAMMemberGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.PolicyTagIAMMemberGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.PolicyTagIAMMember{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.PolicyTagIAMMemberGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.PolicyTagIAMMemberGroupVersionKind),
+			&policyTagIAMMemberConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type policyTagIAMMemberConnector struct {
+	kube client.Client
+}
+
+func (c *policyTagIAMMemberConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := datacatalog.NewService(ctx, opts.For("datacatalog")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &policyTagIAMMemberExternal{policyTags: s.Projects.Locations.Taxonomies.PolicyTags}, nil
+}
+
+type policyTagIAMMemberExternal struct {
+	policyTags *datacatalog.ProjectsLocationsTaxonomiesPolicyTagsService
+}
+
+func (e *policyTagIAMMemberExternal) getPolicy(ctx context.Context, cr *v1alpha1.PolicyTagIAMMember) (*datacatalog.Policy, error) {
+	req := &datacatalog.GetIamPolicyRequest{
+		Options: &datacatalog.GetPolicyOptions{RequestedPolicyVersion: iamv1alpha1.PolicyVersion},
+	}
+	return e.policyTags.GetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.PolicyTag), req).Context(ctx).Do()
+}
+
+func (e *policyTagIAMMemberExternal) setPolicy(ctx context.Context, cr *v1alpha1.PolicyTagIAMMember, p *datacatalog.Policy) error {
+	_, err := e.policyTags.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.PolicyTag), &datacatalog.SetIamPolicyRequest{Policy: p}).Context(ctx).Do()
+	return errors.Wrap(err, errSetPolicy)
+}
+
+func (e *policyTagIAMMemberExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.PolicyTagIAMMember)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPolicyTagIAMMember)
+	}
+	p, err := e.getPolicy(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetPolicy)
+	}
+	if datacatalogclient.BindRoleToMember(cr.Spec.ForProvider, p) {
+		return managed.ExternalObservation{}, nil
+	}
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *policyTagIAMMemberExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.PolicyTagIAMMember)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPolicyTagIAMMember)
+	}
+	p, err := e.getPolicy(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetPolicy)
+	}
+	if !datacatalogclient.BindRoleToMember(cr.Spec.ForProvider, p) {
+		return managed.ExternalCreation{}, nil
+	}
+	return managed.ExternalCreation{}, e.setPolicy(ctx, cr, p)
+}
+
+func (e *policyTagIAMMemberExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	// Role and member are immutable, so there is never anything to update.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *policyTagIAMMemberExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.PolicyTagIAMMember)
+	if !ok {
+		return errors.New(errNotPolicyTagIAMMember)
+	}
+	p, err := e.getPolicy(ctx, cr)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetPolicy)
+	}
+	if !datacatalogclient.UnbindRoleFromMember(cr.Spec.ForProvider, p) {
+		return nil
+	}
+	return e.setPolicy(ctx, cr, p)
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datacatalog
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	datacatalog "google.golang.org/api/datacatalog/v1"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/datacatalog/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const readerRole = "roles/datacatalog.categoryFineGrainedReader"
+
+func newPolicyTagIAMMember() *v1alpha1.PolicyTagIAMMember {
+	m := &v1alpha1.PolicyTagIAMMember{}
+	m.Spec.ForProvider = v1alpha1.PolicyTagIAMMemberParameters{
+		PolicyTag: gcp.StringPtr("projects/" + projectID + "/locations/us/taxonomies/1234/policyTags/5678"),
+		Role:      readerRole,
+		Member:    gcp.StringPtr("group:analysts@example.com"),
+	}
+	return m
+}
+
+func policyHandler(t *testing.T, policy *datacatalog.Policy, getStatus, setStatus int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		// Data Catalog reads and writes IAM policies with POST requests.
+		if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		status := getStatus
+		if strings.HasSuffix(r.URL.Path, ":setIamPolicy") {
+			status = setStatus
+		}
+		w.WriteHeader(status)
+		if status != http.StatusOK {
+			_ = json.NewEncoder(w).Encode(&datacatalog.Policy{})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(policy)
+	})
+}
+
+func TestPolicyTagIAMMemberObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotPolicyTagIAMMember": {
+			reason: "Should return an error if the resource is not a PolicyTagIAMMember",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotPolicyTagIAMMember)},
+		},
+		"PolicyTagNotFound": {
+			reason:  "Should report the member as missing if the policy tag does not exist",
+			mg:      newPolicyTagIAMMember(),
+			handler: policyHandler(t, &datacatalog.Policy{}, http.StatusNotFound, http.StatusOK),
+		},
+		"GetPolicyFailed": {
+			reason:  "Should return an error if the policy cannot be read",
+			mg:      newPolicyTagIAMMember(),
+			handler: policyHandler(t, &datacatalog.Policy{}, http.StatusBadRequest, http.StatusOK),
+			want:    want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetPolicy)},
+		},
+		"NotBound": {
+			reason:  "Should report the member as missing if it is not bound to the role",
+			mg:      newPolicyTagIAMMember(),
+			handler: policyHandler(t, &datacatalog.Policy{}, http.StatusOK, http.StatusOK),
+		},
+		"Bound": {
+			reason: "Should report the member as existing if it is bound to the role",
+			mg:     newPolicyTagIAMMember(),
+			handler: policyHandler(t, &datacatalog.Policy{
+				Bindings: []*datacatalog.Binding{{Role: readerRole, Members: []string{"group:analysts@example.com"}}},
+			}, http.StatusOK, http.StatusOK),
+			want: want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := datacatalog.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := policyTagIAMMemberExternal{policyTags: s.Projects.Locations.Taxonomies.PolicyTags}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestPolicyTagIAMMemberCreate(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		mg      resource.Managed
+		wantErr error
+	}{
+		"NotPolicyTagIAMMember": {
+			reason:  "Should return an error if the resource is not a PolicyTagIAMMember",
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotPolicyTagIAMMember),
+		},
+		"GetPolicyFailed": {
+			reason:  "Should return an error if the policy cannot be read",
+			mg:      newPolicyTagIAMMember(),
+			handler: policyHandler(t, &datacatalog.Policy{}, http.StatusBadRequest, http.StatusOK),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errGetPolicy),
+		},
+		"SetPolicyFailed": {
+			reason:  "Should return an error if the policy cannot be written",
+			mg:      newPolicyTagIAMMember(),
+			handler: policyHandler(t, &datacatalog.Policy{}, http.StatusOK, http.StatusBadRequest),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errSetPolicy),
+		},
+		"Successful": {
+			reason:  "Should succeed if the policy is written",
+			mg:      newPolicyTagIAMMember(),
+			handler: policyHandler(t, &datacatalog.Policy{}, http.StatusOK, http.StatusOK),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := datacatalog.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := policyTagIAMMemberExternal{policyTags: s.Projects.Locations.Taxonomies.PolicyTags}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestPolicyTagIAMMemberDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		mg      resource.Managed
+		wantErr error
+	}{
+		"NotPolicyTagIAMMember": {
+			reason:  "Should return an error if the resource is not a PolicyTagIAMMember",
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotPolicyTagIAMMember),
+		},
+		"PolicyTagGone": {
+			reason:  "Should not return an error if the policy tag is already gone",
+			mg:      newPolicyTagIAMMember(),
+			handler: policyHandler(t, &datacatalog.Policy{}, http.StatusNotFound, http.StatusOK),
+		},
+		"SetPolicyFailed": {
+			reason: "Should return an error if the policy cannot be written",
+			mg:     newPolicyTagIAMMember(),
+			handler: policyHandler(t, &datacatalog.Policy{
+				Bindings: []*datacatalog.Binding{{Role: readerRole, Members: []string{"group:analysts@example.com"}}},
+			}, http.StatusOK, http.StatusBadRequest),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errSetPolicy),
+		},
+		"Successful": {
+			reason: "Should succeed if the policy is written",
+			mg:     newPolicyTagIAMMember(),
+			handler: policyHandler(t, &datacatalog.Policy{
+				Bindings: []*datacatalog.Binding{{Role: readerRole, Members: []string{"group:analysts@example.com"}}},
+			}, http.StatusOK, http.StatusOK),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := datacatalog.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := policyTagIAMMemberExternal{policyTags: s.Projects.Locations.Taxonomies.PolicyTags}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
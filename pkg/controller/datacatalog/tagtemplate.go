@@ -0,0 +1,189 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datacatalog
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	datacatalog "google.golang.org/api/datacatalog/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/datacatalog/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	datacatalogclient "github.com/crossplane/provider-gcp/pkg/clients/datacatalog"
+)
+
+// Error strings.
+const (
+	errNotTagTemplate         = "managed resource is not a Data Catalog TagTemplate"
+	errGetTagTemplate         = "cannot get Data Catalog TagTemplate"
+	errCreateTagTemplate      = "cannot create Data Catalog TagTemplate"
+	errUpdateTagTemplate      = "cannot update Data Catalog TagTemplate"
+	errDeleteTagTemplate      = "cannot delete Data Catalog TagTemplate"
+	errUpdateTagTemplateCR    = "cannot update Data Catalog TagTemplate custom resource"
+	errCreateTagTemplateField = "cannot create Data Catalog TagTemplate field %q"
+	errUpdateTagTemplateField = "cannot update Data Catalog TagTemplate field %q"
+	errDeleteTagTemplateField = "cannot delete Data Catalog TagTemplate field %q"
+)
+
+// SetupTagTemplate adds a controller that reconciles Data Catalog
+// TagTemplates.
+func SetupTagTemplate(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.TagTemplateGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.TagTemplateGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.TagTemplate{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.TagTemplateGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.TagTemplateGroupVersionKind),
+			&tagTemplateConnector{kube: mgr.GetClient()},
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type tagTemplateConnector struct {
+	kube client.Client
+}
+
+func (c *tagTemplateConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := datacatalog.NewService(ctx, opts.For("datacatalog")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &tagTemplateExternal{kube: c.kube, templates: s.Projects.Locations.TagTemplates, projectID: projectID}, nil
+}
+
+type tagTemplateExternal struct {
+	kube      client.Client
+	templates *datacatalog.ProjectsLocationsTagTemplatesService
+	projectID string
+}
+
+func (e *tagTemplateExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.TagTemplate)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTagTemplate)
+	}
+	existing, err := e.templates.Get(datacatalogclient.GetTagTemplateName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetTagTemplate)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	datacatalogclient.LateInitializeTagTemplate(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateTagTemplateCR)
+		}
+	}
+	cr.Status.AtProvider = datacatalogclient.GenerateTagTemplateObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: datacatalogclient.IsTagTemplateUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *tagTemplateExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.TagTemplate)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTagTemplate)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.templates.Create(datacatalogclient.GetTagTemplateParent(e.projectID, cr.Spec.ForProvider), datacatalogclient.GenerateTagTemplate(cr.Spec.ForProvider)).
+		TagTemplateId(meta.GetExternalName(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateTagTemplate)
+}
+
+func (e *tagTemplateExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.TagTemplate)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotTagTemplate)
+	}
+	name := datacatalogclient.GetTagTemplateName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+
+	// We have to get the tag template again here to determine which fields
+	// to update, because fields are managed through their own API.
+	existing, err := e.templates.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetTagTemplate)
+	}
+	desired := datacatalogclient.GenerateTagTemplate(cr.Spec.ForProvider)
+	if desired.DisplayName != existing.DisplayName || desired.IsPubliclyReadable != existing.IsPubliclyReadable {
+		// Fields can't be updated through the tag template itself.
+		desired.Fields = nil
+		if _, err := e.templates.Patch(name, desired).UpdateMask(datacatalogclient.TagTemplateUpdateMask).Context(ctx).Do(); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTagTemplate)
+		}
+	}
+	changes := datacatalogclient.GetTagTemplateFieldChanges(cr.Spec.ForProvider, *existing)
+	for id, f := range changes.Create {
+		if _, err := e.templates.Fields.Create(name, f).TagTemplateFieldId(id).Context(ctx).Do(); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrapf(err, errCreateTagTemplateField, id)
+		}
+	}
+	for id, f := range changes.Update {
+		fn := datacatalogclient.GetTagTemplateFieldName(name, id)
+		if _, err := e.templates.Fields.Patch(fn, f).UpdateMask(datacatalogclient.GetTagTemplateFieldUpdateMask(*f)).Context(ctx).Do(); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrapf(err, errUpdateTagTemplateField, id)
+		}
+	}
+	for _, id := range changes.Delete {
+		// Force is required to delete fields that are in use by tags. Their
+		// values are removed from those tags.
+		_, err := e.templates.Fields.Delete(datacatalogclient.GetTagTemplateFieldName(name, id)).Force(true).Context(ctx).Do()
+		if resource.Ignore(gcp.IsErrorNotFound, err) != nil {
+			return managed.ExternalUpdate{}, errors.Wrapf(err, errDeleteTagTemplateField, id)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *tagTemplateExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.TagTemplate)
+	if !ok {
+		return errors.New(errNotTagTemplate)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	// Force is required to delete tag templates that are in use. All tags
+	// created from the template are deleted with it.
+	_, err := e.templates.Delete(datacatalogclient.GetTagTemplateName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Force(true).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteTagTemplate)
+}
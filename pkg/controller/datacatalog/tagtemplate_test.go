@@ -0,0 +1,352 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datacatalog
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	datacatalog "google.golang.org/api/datacatalog/v1"
+	"google.golang.org/api/option"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/datacatalog/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const tagTemplatePath = "/v1/projects/" + projectID + "/locations/us-central1/tagTemplates/data_owner"
+
+func newTagTemplate() *v1alpha1.TagTemplate {
+	t := &v1alpha1.TagTemplate{}
+	meta.SetExternalName(t, "data_owner")
+	t.Spec.ForProvider = v1alpha1.TagTemplateParameters{
+		Location:           "us-central1",
+		DisplayName:        gcp.StringPtr("Data owner"),
+		IsPubliclyReadable: gcp.BoolPtr(false),
+		Fields: map[string]v1alpha1.TagTemplateField{
+			"owner": {
+				DisplayName: gcp.StringPtr("Owner"),
+				Type:        v1alpha1.FieldType{PrimitiveType: gcp.StringPtr(v1alpha1.FieldTypeString)},
+			},
+		},
+	}
+	return t
+}
+
+func ownerField() datacatalog.GoogleCloudDatacatalogV1TagTemplateField {
+	return datacatalog.GoogleCloudDatacatalogV1TagTemplateField{
+		DisplayName: "Owner",
+		Type:        &datacatalog.GoogleCloudDatacatalogV1FieldType{PrimitiveType: v1alpha1.FieldTypeString},
+	}
+}
+
+func TestTagTemplateObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotTagTemplate": {
+			reason: "Should return an error if the resource is not a TagTemplate",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotTagTemplate)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			mg:     newTagTemplate(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			mg:     newTagTemplate(),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetTagTemplate)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(&datacatalog.GoogleCloudDatacatalogV1TagTemplate{})
+			}),
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if the late initialization update fails",
+			mg: func() resource.Managed {
+				t := newTagTemplate()
+				t.Spec.ForProvider.DisplayName = nil
+				return t
+			}(),
+			want: want{err: errors.Wrap(errBoom, errUpdateTagTemplateCR)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&datacatalog.GoogleCloudDatacatalogV1TagTemplate{DisplayName: "Data owner"})
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should return upToDate as true if the resource is up to date",
+			mg:     newTagTemplate(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&datacatalog.GoogleCloudDatacatalogV1TagTemplate{
+					DisplayName: "Data owner",
+					Fields:      map[string]datacatalog.GoogleCloudDatacatalogV1TagTemplateField{"owner": ownerField()},
+				})
+			}),
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should return upToDate as false if a field is missing",
+			mg:     newTagTemplate(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&datacatalog.GoogleCloudDatacatalogV1TagTemplate{
+					DisplayName: "Data owner",
+					Fields:      map[string]datacatalog.GoogleCloudDatacatalogV1TagTemplateField{"steward": ownerField()},
+				})
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := datacatalog.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := tagTemplateExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				templates: s.Projects.Locations.TagTemplates,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func createTagTemplate(e *tagTemplateExternal, mg resource.Managed) error {
+	_, err := e.Create(context.Background(), mg)
+	return err
+}
+
+func deleteTagTemplate(e *tagTemplateExternal, mg resource.Managed) error {
+	return e.Delete(context.Background(), mg)
+}
+
+func TestTagTemplateCreateDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		method  string
+		status  int
+		call    func(e *tagTemplateExternal, mg resource.Managed) error
+		mg      resource.Managed
+		wantErr error
+	}{
+		"CreateNotTagTemplate": {
+			reason:  "Should return an error if the resource is not a TagTemplate",
+			call:    createTagTemplate,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotTagTemplate),
+		},
+		"CreateSuccessful": {
+			reason: "Should succeed if the resource creation doesn't return an error",
+			method: http.MethodPost,
+			status: http.StatusOK,
+			call:   createTagTemplate,
+			mg:     newTagTemplate(),
+		},
+		"CreateFailed": {
+			reason:  "Should fail if the resource creation returns an error",
+			method:  http.MethodPost,
+			status:  http.StatusBadRequest,
+			call:    createTagTemplate,
+			mg:      newTagTemplate(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateTagTemplate),
+		},
+		"DeleteAlreadyGone": {
+			reason: "Should not return an error if the resource is already gone",
+			method: http.MethodDelete,
+			status: http.StatusNotFound,
+			call:   deleteTagTemplate,
+			mg:     newTagTemplate(),
+		},
+		"DeleteFailed": {
+			reason:  "Should fail if the resource deletion returns an error",
+			method:  http.MethodDelete,
+			status:  http.StatusBadRequest,
+			call:    deleteTagTemplate,
+			mg:      newTagTemplate(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteTagTemplate),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&datacatalog.GoogleCloudDatacatalogV1TagTemplate{})
+			}))
+			defer server.Close()
+			s, _ := datacatalog.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &tagTemplateExternal{
+				projectID: projectID,
+				templates: s.Projects.Locations.TagTemplates,
+			}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestTagTemplateUpdate(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason   string
+		mg       resource.Managed
+		observed *datacatalog.GoogleCloudDatacatalogV1TagTemplate
+		status   int
+		want     want
+	}{
+		"NotTagTemplate": {
+			reason: "Should return an error if the resource is not a TagTemplate",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotTagTemplate)},
+		},
+		"UpdateTemplate": {
+			reason: "Should only patch the template if its fields are up to date",
+			mg:     newTagTemplate(),
+			observed: &datacatalog.GoogleCloudDatacatalogV1TagTemplate{
+				DisplayName: "Owner",
+				Fields:      map[string]datacatalog.GoogleCloudDatacatalogV1TagTemplateField{"owner": ownerField()},
+			},
+			status: http.StatusOK,
+			want: want{calls: []string{
+				"PATCH " + tagTemplatePath + " displayName,isPubliclyReadable",
+			}},
+		},
+		"UpdateFields": {
+			reason: "Should create, update and delete fields that differ",
+			mg: func() resource.Managed {
+				t := newTagTemplate()
+				t.Spec.ForProvider.Fields["tier"] = v1alpha1.TagTemplateField{
+					Type: v1alpha1.FieldType{EnumType: &v1alpha1.EnumType{AllowedValues: []string{"GOLD"}}},
+				}
+				t.Spec.ForProvider.Fields["owner"] = v1alpha1.TagTemplateField{
+					DisplayName: gcp.StringPtr("Data owner"),
+					Type:        v1alpha1.FieldType{PrimitiveType: gcp.StringPtr(v1alpha1.FieldTypeString)},
+				}
+				return t
+			}(),
+			observed: &datacatalog.GoogleCloudDatacatalogV1TagTemplate{
+				DisplayName: "Data owner",
+				Fields: map[string]datacatalog.GoogleCloudDatacatalogV1TagTemplateField{
+					"owner":   ownerField(),
+					"steward": ownerField(),
+				},
+			},
+			status: http.StatusOK,
+			want: want{calls: []string{
+				"DELETE " + tagTemplatePath + "/fields/steward ",
+				"PATCH " + tagTemplatePath + "/fields/owner displayName,description,isRequired,order",
+				"POST " + tagTemplatePath + "/fields ",
+			}},
+		},
+		"UpdateFailed": {
+			reason: "Should fail if the template update returns an error",
+			mg:     newTagTemplate(),
+			observed: &datacatalog.GoogleCloudDatacatalogV1TagTemplate{
+				Fields: map[string]datacatalog.GoogleCloudDatacatalogV1TagTemplateField{"owner": ownerField()},
+			},
+			status: http.StatusBadRequest,
+			want: want{
+				calls: []string{"PATCH " + tagTemplatePath + " displayName,isPubliclyReadable"},
+				err:   errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateTagTemplate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if r.Method == http.MethodGet {
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(tc.observed)
+					return
+				}
+				calls = append(calls, r.Method+" "+r.URL.Path+" "+r.URL.Query().Get("updateMask"))
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&datacatalog.GoogleCloudDatacatalogV1TagTemplateField{})
+			}))
+			defer server.Close()
+			s, _ := datacatalog.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &tagTemplateExternal{
+				projectID: projectID,
+				templates: s.Projects.Locations.TagTemplates,
+			}
+			_, err := e.Update(context.Background(), tc.mg)
+			sort.Strings(calls)
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want calls, +got calls:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
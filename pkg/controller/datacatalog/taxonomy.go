@@ -0,0 +1,159 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datacatalog
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	datacatalog "google.golang.org/api/datacatalog/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/datacatalog/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	datacatalogclient "github.com/crossplane/provider-gcp/pkg/clients/datacatalog"
+)
+
+// Error strings.
+const (
+	errNewClient        = "cannot create new Data Catalog client"
+	errNotTaxonomy      = "managed resource is not a Data Catalog Taxonomy"
+	errGetTaxonomy      = "cannot get Data Catalog Taxonomy"
+	errCreateTaxonomy   = "cannot create Data Catalog Taxonomy"
+	errUpdateTaxonomy   = "cannot update Data Catalog Taxonomy"
+	errDeleteTaxonomy   = "cannot delete Data Catalog Taxonomy"
+	errUpdateTaxonomyCR = "cannot update Data Catalog Taxonomy custom resource"
+)
+
+// SetupTaxonomy adds a controller that reconciles Data Catalog Taxonomies.
+func SetupTaxonomy(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.TaxonomyGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.TaxonomyGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Taxonomy{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.TaxonomyGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.TaxonomyGroupVersionKind),
+			&taxonomyConnector{kube: mgr.GetClient()},
+			managed.WithInitializers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type taxonomyConnector struct {
+	kube client.Client
+}
+
+func (c *taxonomyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := datacatalog.NewService(ctx, opts.For("datacatalog")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &taxonomyExternal{kube: c.kube, taxonomies: s.Projects.Locations.Taxonomies, projectID: projectID}, nil
+}
+
+type taxonomyExternal struct {
+	kube       client.Client
+	taxonomies *datacatalog.ProjectsLocationsTaxonomiesService
+	projectID  string
+}
+
+func (e *taxonomyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Taxonomy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTaxonomy)
+	}
+	// Taxonomy IDs are assigned by Data Catalog, so until we've created the
+	// taxonomy we have nothing to look up.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	existing, err := e.taxonomies.Get(datacatalogclient.GetTaxonomyName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetTaxonomy)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	datacatalogclient.LateInitializeTaxonomy(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateTaxonomyCR)
+		}
+	}
+	cr.Status.AtProvider = datacatalogclient.GenerateTaxonomyObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: datacatalogclient.IsTaxonomyUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *taxonomyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Taxonomy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTaxonomy)
+	}
+	cr.SetConditions(xpv1.Creating())
+	t, err := e.taxonomies.Create(datacatalogclient.GetTaxonomyParent(e.projectID, cr.Spec.ForProvider), datacatalogclient.GenerateTaxonomy(cr.Spec.ForProvider)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateTaxonomy)
+	}
+	meta.SetExternalName(cr, datacatalogclient.GetTaxonomyID(*t))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *taxonomyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Taxonomy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotTaxonomy)
+	}
+	name := datacatalogclient.GetTaxonomyName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+	_, err := e.taxonomies.Patch(name, datacatalogclient.GenerateTaxonomy(cr.Spec.ForProvider)).UpdateMask(datacatalogclient.TaxonomyUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTaxonomy)
+}
+
+func (e *taxonomyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Taxonomy)
+	if !ok {
+		return errors.New(errNotTaxonomy)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.taxonomies.Delete(datacatalogclient.GetTaxonomyName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteTaxonomy)
+}
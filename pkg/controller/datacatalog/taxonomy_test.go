@@ -0,0 +1,291 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datacatalog
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	datacatalog "google.golang.org/api/datacatalog/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/datacatalog/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID = "myproject-id-1234"
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newTaxonomy(externalName string) *v1alpha1.Taxonomy {
+	t := &v1alpha1.Taxonomy{}
+	meta.SetExternalName(t, externalName)
+	t.Spec.ForProvider = v1alpha1.TaxonomyParameters{
+		Location:             "us",
+		DisplayName:          "Sensitivity",
+		Description:          gcp.StringPtr("Data sensitivity levels"),
+		ActivatedPolicyTypes: []string{v1alpha1.PolicyTypeFineGrainedAccessControl},
+	}
+	return t
+}
+
+func TestTaxonomyObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotTaxonomy": {
+			reason: "Should return an error if the resource is not a Taxonomy",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotTaxonomy)},
+		},
+		"NoExternalName": {
+			reason: "Should report the taxonomy as missing if it has not been assigned an ID",
+			mg:     newTaxonomy(""),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected %s request", r.Method)
+			}),
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			mg:     newTaxonomy("1234"),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			mg:     newTaxonomy("1234"),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetTaxonomy)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(&datacatalog.GoogleCloudDatacatalogV1Taxonomy{})
+			}),
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if the late initialization update fails",
+			mg: func() resource.Managed {
+				t := newTaxonomy("1234")
+				t.Spec.ForProvider.Description = nil
+				return t
+			}(),
+			want: want{err: errors.Wrap(errBoom, errUpdateTaxonomyCR)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&datacatalog.GoogleCloudDatacatalogV1Taxonomy{Description: "Levels"})
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should return upToDate as true if the resource is up to date",
+			mg:     newTaxonomy("1234"),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&datacatalog.GoogleCloudDatacatalogV1Taxonomy{
+					DisplayName:          "Sensitivity",
+					Description:          "Data sensitivity levels",
+					ActivatedPolicyTypes: []string{v1alpha1.PolicyTypeFineGrainedAccessControl},
+				})
+			}),
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should return upToDate as false if the resource is not up to date",
+			mg:     newTaxonomy("1234"),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&datacatalog.GoogleCloudDatacatalogV1Taxonomy{
+					DisplayName:          "Sensitivity",
+					Description:          "Data sensitivity levels",
+					ActivatedPolicyTypes: []string{v1alpha1.PolicyTypeFineGrainedAccessControl, "POLICY_TYPE_UNSPECIFIED"},
+				})
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := datacatalog.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := taxonomyExternal{
+				kube:       tc.kube,
+				projectID:  projectID,
+				taxonomies: s.Projects.Locations.Taxonomies,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestTaxonomyCreate(t *testing.T) {
+	cr := newTaxonomy("")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(&datacatalog.GoogleCloudDatacatalogV1Taxonomy{
+			Name: "projects/" + projectID + "/locations/us/taxonomies/1234",
+		})
+	}))
+	defer server.Close()
+	s, _ := datacatalog.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := taxonomyExternal{
+		projectID:  projectID,
+		taxonomies: s.Projects.Locations.Taxonomies,
+	}
+	got, err := e.Create(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Create(...): unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(managed.ExternalCreation{ExternalNameAssigned: true}, got); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("1234", meta.GetExternalName(cr)); diff != "" {
+		t.Errorf("Create(...): -want external name, +got external name:\n%s", diff)
+	}
+}
+
+func updateTaxonomy(e *taxonomyExternal, mg resource.Managed) error {
+	_, err := e.Update(context.Background(), mg)
+	return err
+}
+
+func deleteTaxonomy(e *taxonomyExternal, mg resource.Managed) error {
+	return e.Delete(context.Background(), mg)
+}
+
+func TestTaxonomyUpdateDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		method  string
+		status  int
+		call    func(e *taxonomyExternal, mg resource.Managed) error
+		mg      resource.Managed
+		wantErr error
+	}{
+		"UpdateNotTaxonomy": {
+			reason:  "Should return an error if the resource is not a Taxonomy",
+			call:    updateTaxonomy,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotTaxonomy),
+		},
+		"UpdateSuccessful": {
+			reason: "Should succeed if the resource update doesn't return an error",
+			method: http.MethodPatch,
+			status: http.StatusOK,
+			call:   updateTaxonomy,
+			mg:     newTaxonomy("1234"),
+		},
+		"UpdateFailed": {
+			reason:  "Should fail if the resource update returns an error",
+			method:  http.MethodPatch,
+			status:  http.StatusBadRequest,
+			call:    updateTaxonomy,
+			mg:      newTaxonomy("1234"),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateTaxonomy),
+		},
+		"DeleteAlreadyGone": {
+			reason: "Should not return an error if the resource is already gone",
+			method: http.MethodDelete,
+			status: http.StatusNotFound,
+			call:   deleteTaxonomy,
+			mg:     newTaxonomy("1234"),
+		},
+		"DeleteFailed": {
+			reason:  "Should fail if the resource deletion returns an error",
+			method:  http.MethodDelete,
+			status:  http.StatusBadRequest,
+			call:    deleteTaxonomy,
+			mg:      newTaxonomy("1234"),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteTaxonomy),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&datacatalog.GoogleCloudDatacatalogV1Taxonomy{})
+			}))
+			defer server.Close()
+			s, _ := datacatalog.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &taxonomyExternal{
+				projectID:  projectID,
+				taxonomies: s.Projects.Locations.Taxonomies,
+			}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataflow
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	dataflow "google.golang.org/api/dataflow/v1b3"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/dataflow/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	jobclient "github.com/crossplane/provider-gcp/pkg/clients/dataflow"
+)
+
+// Error strings.
+const (
+	errNewClient     = "cannot create new Dataflow client"
+	errNotJob        = "managed resource is not a Dataflow Job"
+	errGetJob        = "cannot get Dataflow Job"
+	errLaunchJob     = "cannot launch Dataflow Job"
+	errStopJob       = "cannot stop Dataflow Job"
+	errUpdateJobCR   = "cannot update Dataflow Job custom resource"
+	errNoTemplate    = "one of templateGcsPath or containerSpecGcsPath must be set"
+	errManyTemplates = "only one of templateGcsPath or containerSpecGcsPath may be set"
+)
+
+// SetupJob adds a controller that reconciles Dataflow Jobs.
+func SetupJob(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.JobGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.JobGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Job{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.JobGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.JobGroupVersionKind),
+			&jobConnector{kube: mgr.GetClient()},
+			managed.WithInitializers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type jobConnector struct {
+	kube client.Client
+}
+
+func (c *jobConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := dataflow.NewService(ctx, opts.For("dataflow")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &jobExternal{kube: c.kube, locations: s.Projects.Locations, projectID: projectID}, nil
+}
+
+type jobExternal struct {
+	kube      client.Client
+	locations *dataflow.ProjectsLocationsService
+	projectID string
+}
+
+func (e *jobExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Job)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotJob)
+	}
+	// Job IDs are assigned by Dataflow when the job is launched, so until
+	// we've launched the job we have nothing to look up.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	existing, err := e.locations.Jobs.Get(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetJob)
+	}
+	cr.Status.AtProvider = jobclient.GenerateJobObservation(*existing)
+	// Dataflow keeps stopped jobs around for a while, so a job that has been
+	// cancelled or drained following our request is considered gone.
+	if meta.WasDeleted(cr) && jobclient.IsJobTerminated(existing.CurrentState) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	jobclient.LateInitializeJob(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateJobCR)
+		}
+	}
+	switch cr.Status.AtProvider.CurrentState {
+	case v1alpha1.JobStateRunning, v1alpha1.JobStateDone:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1alpha1.JobStatePending, v1alpha1.JobStateQueued, v1alpha1.JobStateStopped:
+		cr.Status.SetConditions(xpv1.Creating())
+	case v1alpha1.JobStateDraining, v1alpha1.JobStateCancelling, v1alpha1.JobStateResourceCleanup:
+		cr.Status.SetConditions(xpv1.Deleting())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+	// Jobs cannot be changed once launched.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *jobExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Job)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotJob)
+	}
+	p := cr.Spec.ForProvider
+	name := cr.GetName()
+	if p.JobName != nil {
+		name = *p.JobName
+	}
+	var job *dataflow.Job
+	switch {
+	case p.TemplateGCSPath != nil && p.ContainerSpecGCSPath != nil:
+		return managed.ExternalCreation{}, errors.New(errManyTemplates)
+	case p.TemplateGCSPath != nil:
+		cr.SetConditions(xpv1.Creating())
+		rsp, err := e.locations.Templates.Launch(e.projectID, p.Location, jobclient.GenerateLaunchTemplateParameters(name, p)).
+			GcsPath(*p.TemplateGCSPath).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errLaunchJob)
+		}
+		job = rsp.Job
+	case p.ContainerSpecGCSPath != nil:
+		cr.SetConditions(xpv1.Creating())
+		rsp, err := e.locations.FlexTemplates.Launch(e.projectID, p.Location, jobclient.GenerateLaunchFlexTemplateRequest(name, p)).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errLaunchJob)
+		}
+		job = rsp.Job
+	default:
+		return managed.ExternalCreation{}, errors.New(errNoTemplate)
+	}
+	if job == nil {
+		return managed.ExternalCreation{}, nil
+	}
+	meta.SetExternalName(cr, job.Id)
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *jobExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *jobExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Job)
+	if !ok {
+		return errors.New(errNotJob)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	if jobclient.IsJobTerminated(cr.Status.AtProvider.CurrentState) {
+		return nil
+	}
+	j := &dataflow.Job{RequestedState: jobclient.GetDeleteRequestedState(cr.Spec.ForProvider)}
+	_, err := e.locations.Jobs.Update(e.projectID, cr.Spec.ForProvider.Location, meta.GetExternalName(cr), j).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errStopJob)
+}
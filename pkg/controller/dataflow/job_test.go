@@ -0,0 +1,363 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataflow
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	dataflow "google.golang.org/api/dataflow/v1b3"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/dataflow/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID = "myproject-id-1234"
+	jobID     = "2021-01-01_00_00_00-1234"
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+type jobModifier func(*v1alpha1.Job)
+
+func withExternalName(n string) jobModifier {
+	return func(j *v1alpha1.Job) { meta.SetExternalName(j, n) }
+}
+
+func newJob(m ...jobModifier) *v1alpha1.Job {
+	j := &v1alpha1.Job{}
+	j.SetName("my-job")
+	meta.SetExternalName(j, jobID)
+	j.Spec.ForProvider = v1alpha1.JobParameters{
+		Location:        "us-central1",
+		JobName:         gcp.StringPtr("my-job"),
+		TemplateGCSPath: gcp.StringPtr("gs://dataflow-templates/latest/Word_Count"),
+		Parameters:      map[string]string{"inputFile": "gs://my-bucket/in.txt"},
+	}
+	for _, f := range m {
+		f(j)
+	}
+	return j
+}
+
+func TestJobObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotJob": {
+			reason: "Should return an error if the resource is not a Job",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotJob)},
+		},
+		"NotLaunched": {
+			reason: "Should not call the API if the job has no ID yet",
+			mg:     newJob(withExternalName("")),
+			want:   want{e: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			mg:     newJob(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			mg:     newJob(),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetJob)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(&dataflow.Job{})
+			}),
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if the late initialization update fails",
+			mg: newJob(func(j *v1alpha1.Job) {
+				j.Spec.ForProvider.JobName = nil
+			}),
+			want: want{err: errors.Wrap(errBoom, errUpdateJobCR)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&dataflow.Job{Id: jobID, Name: "my-job", CurrentState: v1alpha1.JobStateRunning})
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+		},
+		"Running": {
+			reason: "Should report a running job as existing and up to date",
+			mg:     newJob(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&dataflow.Job{Id: jobID, Name: "my-job", CurrentState: v1alpha1.JobStateRunning})
+			}),
+		},
+		"StoppedWhileDeleting": {
+			reason: "Should report a stopped job as gone once the resource is deleted",
+			mg: newJob(func(j *v1alpha1.Job) {
+				now := metav1.Now()
+				j.SetDeletionTimestamp(&now)
+			}),
+			want: want{e: managed.ExternalObservation{ResourceExists: false}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&dataflow.Job{Id: jobID, Name: "my-job", CurrentState: v1alpha1.JobStateCancelled})
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dataflow.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := jobExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				locations: s.Projects.Locations,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestJobCreate(t *testing.T) {
+	type want struct {
+		e            managed.ExternalCreation
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		path   string
+		status int
+		mg     resource.Managed
+		want   want
+	}{
+		"NotJob": {
+			reason: "Should return an error if the resource is not a Job",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotJob)},
+		},
+		"NoTemplate": {
+			reason: "Should return an error if no template is specified",
+			mg: newJob(withExternalName(""), func(j *v1alpha1.Job) {
+				j.Spec.ForProvider.TemplateGCSPath = nil
+			}),
+			want: want{err: errors.New(errNoTemplate)},
+		},
+		"ManyTemplates": {
+			reason: "Should return an error if both kinds of template are specified",
+			mg: newJob(withExternalName(""), func(j *v1alpha1.Job) {
+				j.Spec.ForProvider.ContainerSpecGCSPath = gcp.StringPtr("gs://my-bucket/spec.json")
+			}),
+			want: want{err: errors.New(errManyTemplates)},
+		},
+		"ClassicTemplate": {
+			reason: "Should launch a classic template and record the job ID",
+			path:   "/v1b3/projects/" + projectID + "/locations/us-central1/templates:launch",
+			status: http.StatusOK,
+			mg:     newJob(withExternalName("")),
+			want: want{
+				e:            managed.ExternalCreation{ExternalNameAssigned: true},
+				externalName: jobID,
+			},
+		},
+		"FlexTemplate": {
+			reason: "Should launch a flex template and record the job ID",
+			path:   "/v1b3/projects/" + projectID + "/locations/us-central1/flexTemplates:launch",
+			status: http.StatusOK,
+			mg: newJob(withExternalName(""), func(j *v1alpha1.Job) {
+				j.Spec.ForProvider.TemplateGCSPath = nil
+				j.Spec.ForProvider.ContainerSpecGCSPath = gcp.StringPtr("gs://my-bucket/spec.json")
+			}),
+			want: want{
+				e:            managed.ExternalCreation{ExternalNameAssigned: true},
+				externalName: jobID,
+			},
+		},
+		"LaunchFailed": {
+			reason: "Should fail if the launch returns an error",
+			path:   "/v1b3/projects/" + projectID + "/locations/us-central1/templates:launch",
+			status: http.StatusBadRequest,
+			mg:     newJob(withExternalName("")),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errLaunchJob)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.path, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.status != http.StatusOK {
+					_ = json.NewEncoder(w).Encode(&dataflow.LaunchTemplateResponse{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(&dataflow.LaunchTemplateResponse{Job: &dataflow.Job{Id: jobID}})
+			}))
+			defer server.Close()
+			s, _ := dataflow.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &jobExternal{
+				projectID: projectID,
+				locations: s.Projects.Locations,
+			}
+			got, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if tc.want.externalName == "" {
+				return
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.mg)); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want external name, +got external name:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestJobDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason         string
+		status         int
+		mg             resource.Managed
+		requestedState string
+		wantErr        error
+	}{
+		"NotJob": {
+			reason:  "Should return an error if the resource is not a Job",
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotJob),
+		},
+		"Cancel": {
+			reason:         "Should cancel the job by default",
+			status:         http.StatusOK,
+			mg:             newJob(),
+			requestedState: v1alpha1.JobStateCancelled,
+		},
+		"Drain": {
+			reason: "Should drain the job if requested",
+			status: http.StatusOK,
+			mg: newJob(func(j *v1alpha1.Job) {
+				j.Spec.ForProvider.OnDelete = gcp.StringPtr(v1alpha1.JobOnDeleteDrain)
+			}),
+			requestedState: v1alpha1.JobStateDrained,
+		},
+		"AlreadyStopped": {
+			reason: "Should not call the API if the job has already stopped",
+			mg: newJob(func(j *v1alpha1.Job) {
+				j.Status.AtProvider.CurrentState = v1alpha1.JobStateDone
+			}),
+		},
+		"AlreadyGone": {
+			reason:         "Should not return an error if the job is already gone",
+			status:         http.StatusNotFound,
+			mg:             newJob(),
+			requestedState: v1alpha1.JobStateCancelled,
+		},
+		"StopFailed": {
+			reason:         "Should fail if stopping the job returns an error",
+			status:         http.StatusBadRequest,
+			mg:             newJob(),
+			requestedState: v1alpha1.JobStateCancelled,
+			wantErr:        errors.Wrap(gError(http.StatusBadRequest, ""), errStopJob),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				j := &dataflow.Job{}
+				_ = json.NewDecoder(r.Body).Decode(j)
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.requestedState, j.RequestedState); diff != "" {
+					t.Errorf("RequestedState: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&dataflow.Job{})
+			}))
+			defer server.Close()
+			s, _ := dataflow.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &jobExternal{
+				projectID: projectID,
+				locations: s.Projects.Locations,
+			}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataproc
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	dataproc "google.golang.org/api/dataproc/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/dataproc/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	dataprocclient "github.com/crossplane/provider-gcp/pkg/clients/dataproc"
+)
+
+// Error strings.
+const (
+	errNewClient       = "cannot create new Dataproc client"
+	errNotCluster      = "managed resource is not a Dataproc Cluster"
+	errGetCluster      = "cannot get Dataproc Cluster"
+	errCreateCluster   = "cannot create Dataproc Cluster"
+	errUpdateCluster   = "cannot update Dataproc Cluster"
+	errDeleteCluster   = "cannot delete Dataproc Cluster"
+	errUpdateClusterCR = "cannot update Dataproc Cluster custom resource"
+)
+
+// SetupCluster adds a controller that reconciles Dataproc Clusters.
+func SetupCluster(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.ClusterGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.ClusterGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Cluster{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.ClusterGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ClusterGroupVersionKind),
+			&clusterConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type clusterConnector struct {
+	kube client.Client
+}
+
+func (c *clusterConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := dataproc.NewService(ctx, opts.For("dataproc")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &clusterExternal{kube: c.kube, clusters: s.Projects.Regions.Clusters, projectID: projectID}, nil
+}
+
+type clusterExternal struct {
+	kube      client.Client
+	clusters  *dataproc.ProjectsRegionsClustersService
+	projectID string
+}
+
+func (e *clusterExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Cluster)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCluster)
+	}
+	existing, err := e.clusters.Get(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetCluster)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	dataprocclient.LateInitializeCluster(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateClusterCR)
+		}
+	}
+	cr.Status.AtProvider = dataprocclient.GenerateClusterObservation(*existing)
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.ClusterStateRunning, v1alpha1.ClusterStateUpdating:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1alpha1.ClusterStateCreating, v1alpha1.ClusterStateStarting:
+		cr.Status.SetConditions(xpv1.Creating())
+	case v1alpha1.ClusterStateDeleting:
+		cr.Status.SetConditions(xpv1.Deleting())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: dataprocclient.IsClusterUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *clusterExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Cluster)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCluster)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	c := dataprocclient.GenerateCluster(e.projectID, meta.GetExternalName(cr), cr.Spec.ForProvider)
+	_, err := e.clusters.Create(e.projectID, cr.Spec.ForProvider.Region, c).RequestId(gcp.CreateRequestID(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateCluster)
+}
+
+func (e *clusterExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Cluster)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCluster)
+	}
+	// Dataproc only accepts updates for running clusters.
+	if cr.Status.AtProvider.State != v1alpha1.ClusterStateRunning {
+		return managed.ExternalUpdate{}, nil
+	}
+	name := meta.GetExternalName(cr)
+	call := e.clusters.Patch(e.projectID, cr.Spec.ForProvider.Region, name, dataprocclient.GenerateCluster(e.projectID, name, cr.Spec.ForProvider)).
+		UpdateMask(dataprocclient.ClusterUpdateMask)
+	if cr.Spec.ForProvider.GracefulDecommissionTimeout != nil {
+		call = call.GracefulDecommissionTimeout(*cr.Spec.ForProvider.GracefulDecommissionTimeout)
+	}
+	_, err := call.Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCluster)
+}
+
+func (e *clusterExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Cluster)
+	if !ok {
+		return errors.New(errNotCluster)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.clusters.Delete(e.projectID, cr.Spec.ForProvider.Region, meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteCluster)
+}
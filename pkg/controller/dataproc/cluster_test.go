@@ -0,0 +1,286 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataproc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	dataproc "google.golang.org/api/dataproc/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/dataproc/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID = "myproject-id-1234"
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newCluster() *v1alpha1.Cluster {
+	c := &v1alpha1.Cluster{}
+	meta.SetExternalName(c, "my-cluster")
+	c.Spec.ForProvider = v1alpha1.ClusterParameters{
+		Region: "us-central1",
+		Config: v1alpha1.ClusterConfig{
+			ConfigBucket: gcp.StringPtr("my-bucket"),
+			MasterConfig: &v1alpha1.InstanceGroupConfig{NumInstances: gcp.Int64Ptr(1)},
+			WorkerConfig: &v1alpha1.InstanceGroupConfig{NumInstances: gcp.Int64Ptr(2)},
+		},
+	}
+	c.Status.AtProvider.State = v1alpha1.ClusterStateRunning
+	return c
+}
+
+func TestClusterObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotCluster": {
+			reason: "Should return an error if the resource is not a Cluster",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotCluster)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			mg:     newCluster(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			mg:     newCluster(),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetCluster)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(&dataproc.Cluster{})
+			}),
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if the late initialization update fails",
+			mg:     newCluster(),
+			want:   want{err: errors.Wrap(errBoom, errUpdateClusterCR)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&dataproc.Cluster{Config: &dataproc.ClusterConfig{TempBucket: "my-temp-bucket"}})
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should return upToDate as true if the resource is up to date",
+			mg:     newCluster(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&dataproc.Cluster{
+					Config: &dataproc.ClusterConfig{
+						ConfigBucket: "my-bucket",
+						MasterConfig: &dataproc.InstanceGroupConfig{NumInstances: 1},
+						WorkerConfig: &dataproc.InstanceGroupConfig{NumInstances: 2},
+					},
+				})
+			}),
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should return upToDate as false if the resource is not up to date",
+			mg:     newCluster(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&dataproc.Cluster{
+					Config: &dataproc.ClusterConfig{
+						ConfigBucket: "my-bucket",
+						MasterConfig: &dataproc.InstanceGroupConfig{NumInstances: 1},
+						WorkerConfig: &dataproc.InstanceGroupConfig{NumInstances: 5},
+					},
+				})
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dataproc.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := clusterExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				clusters:  s.Projects.Regions.Clusters,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func createCluster(e *clusterExternal, mg resource.Managed) error {
+	_, err := e.Create(context.Background(), mg)
+	return err
+}
+
+func updateCluster(e *clusterExternal, mg resource.Managed) error {
+	_, err := e.Update(context.Background(), mg)
+	return err
+}
+
+func deleteCluster(e *clusterExternal, mg resource.Managed) error {
+	return e.Delete(context.Background(), mg)
+}
+
+func TestClusterCreateUpdateDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		method  string
+		status  int
+		call    func(e *clusterExternal, mg resource.Managed) error
+		mg      resource.Managed
+		wantErr error
+	}{
+		"CreateNotCluster": {
+			reason:  "Should return an error if the resource is not a Cluster",
+			call:    createCluster,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotCluster),
+		},
+		"CreateSuccessful": {
+			reason: "Should succeed if the resource creation doesn't return an error",
+			method: http.MethodPost,
+			status: http.StatusOK,
+			call:   createCluster,
+			mg:     newCluster(),
+		},
+		"CreateFailed": {
+			reason:  "Should fail if the resource creation returns an error",
+			method:  http.MethodPost,
+			status:  http.StatusBadRequest,
+			call:    createCluster,
+			mg:      newCluster(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateCluster),
+		},
+		"UpdateSuccessful": {
+			reason: "Should succeed if the resource update doesn't return an error",
+			method: http.MethodPatch,
+			status: http.StatusOK,
+			call:   updateCluster,
+			mg:     newCluster(),
+		},
+		"UpdateSkippedWhileNotRunning": {
+			reason: "Should not call the API if the cluster is not running",
+			call:   updateCluster,
+			mg: func() resource.Managed {
+				c := newCluster()
+				c.Status.AtProvider.State = v1alpha1.ClusterStateUpdating
+				return c
+			}(),
+		},
+		"UpdateFailed": {
+			reason:  "Should fail if the resource update returns an error",
+			method:  http.MethodPatch,
+			status:  http.StatusBadRequest,
+			call:    updateCluster,
+			mg:      newCluster(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateCluster),
+		},
+		"DeleteAlreadyGone": {
+			reason: "Should not return an error if the resource is already gone",
+			method: http.MethodDelete,
+			status: http.StatusNotFound,
+			call:   deleteCluster,
+			mg:     newCluster(),
+		},
+		"DeleteFailed": {
+			reason:  "Should fail if the resource deletion returns an error",
+			method:  http.MethodDelete,
+			status:  http.StatusBadRequest,
+			call:    deleteCluster,
+			mg:      newCluster(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteCluster),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&dataproc.Operation{})
+			}))
+			defer server.Close()
+			s, _ := dataproc.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &clusterExternal{
+				projectID: projectID,
+				clusters:  s.Projects.Regions.Clusters,
+			}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataproc
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	dataproc "google.golang.org/api/dataproc/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/dataproc/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	dataprocclient "github.com/crossplane/provider-gcp/pkg/clients/dataproc"
+)
+
+// Error strings.
+const (
+	errNotWorkflowTemplate      = "managed resource is not a Dataproc WorkflowTemplate"
+	errGetWorkflowTemplate      = "cannot get Dataproc WorkflowTemplate"
+	errCreateWorkflowTemplate   = "cannot create Dataproc WorkflowTemplate"
+	errUpdateWorkflowTemplate   = "cannot update Dataproc WorkflowTemplate"
+	errDeleteWorkflowTemplate   = "cannot delete Dataproc WorkflowTemplate"
+	errUpdateWorkflowTemplateCR = "cannot update Dataproc WorkflowTemplate custom resource"
+	errCheckWorkflowTemplate    = "cannot determine if Dataproc WorkflowTemplate is up to date"
+)
+
+// SetupWorkflowTemplate adds a controller that reconciles Dataproc
+// WorkflowTemplates.
+func SetupWorkflowTemplate(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.WorkflowTemplateGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.WorkflowTemplateGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.WorkflowTemplate{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.WorkflowTemplateGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.WorkflowTemplateGroupVersionKind),
+			&workflowTemplateConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type workflowTemplateConnector struct {
+	kube client.Client
+}
+
+func (c *workflowTemplateConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := dataproc.NewService(ctx, opts.For("dataproc")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &workflowTemplateExternal{kube: c.kube, templates: s.Projects.Regions.WorkflowTemplates, projectID: projectID}, nil
+}
+
+type workflowTemplateExternal struct {
+	kube      client.Client
+	templates *dataproc.ProjectsRegionsWorkflowTemplatesService
+	projectID string
+}
+
+func (e *workflowTemplateExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.WorkflowTemplate)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotWorkflowTemplate)
+	}
+	existing, err := e.templates.Get(dataprocclient.GetWorkflowTemplateName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetWorkflowTemplate)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	if existing.DagTimeout != "" {
+		cr.Spec.ForProvider.DAGTimeout = gcp.LateInitializeString(cr.Spec.ForProvider.DAGTimeout, existing.DagTimeout)
+	}
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateWorkflowTemplateCR)
+		}
+	}
+	cr.Status.AtProvider = dataprocclient.GenerateWorkflowTemplateObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	upToDate, err := dataprocclient.IsWorkflowTemplateUpToDate(meta.GetExternalName(cr), cr.Spec.ForProvider, existing)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckWorkflowTemplate)
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *workflowTemplateExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.WorkflowTemplate)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotWorkflowTemplate)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	t := &dataproc.WorkflowTemplate{}
+	dataprocclient.GenerateWorkflowTemplate(meta.GetExternalName(cr), cr.Spec.ForProvider, t)
+	_, err := e.templates.Create(dataprocclient.GetWorkflowTemplateParent(e.projectID, cr.Spec.ForProvider), t).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateWorkflowTemplate)
+}
+
+func (e *workflowTemplateExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.WorkflowTemplate)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotWorkflowTemplate)
+	}
+	name := dataprocclient.GetWorkflowTemplateName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+	// Updates must carry the current version so that concurrent changes are
+	// rejected rather than overwritten.
+	t := &dataproc.WorkflowTemplate{Name: name, Version: cr.Status.AtProvider.Version}
+	dataprocclient.GenerateWorkflowTemplate(meta.GetExternalName(cr), cr.Spec.ForProvider, t)
+	_, err := e.templates.Update(name, t).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateWorkflowTemplate)
+}
+
+func (e *workflowTemplateExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.WorkflowTemplate)
+	if !ok {
+		return errors.New(errNotWorkflowTemplate)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.templates.Delete(dataprocclient.GetWorkflowTemplateName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteWorkflowTemplate)
+}
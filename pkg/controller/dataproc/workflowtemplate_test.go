@@ -0,0 +1,261 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataproc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	dataproc "google.golang.org/api/dataproc/v1"
+	"google.golang.org/api/option"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/dataproc/v1alpha1"
+)
+
+func newWorkflowTemplate() *v1alpha1.WorkflowTemplate {
+	t := &v1alpha1.WorkflowTemplate{}
+	meta.SetExternalName(t, "my-template")
+	t.Spec.ForProvider = v1alpha1.WorkflowTemplateParameters{
+		Region: "us-central1",
+		Placement: v1alpha1.WorkflowTemplatePlacement{
+			ClusterSelector: &v1alpha1.ClusterSelector{ClusterLabels: map[string]string{"env": "dev"}},
+		},
+		Jobs: []v1alpha1.OrderedJob{{
+			StepID:      "step",
+			SparkSQLJob: &v1alpha1.SparkSQLJob{Queries: []string{"SELECT 1"}},
+		}},
+	}
+	return t
+}
+
+func TestWorkflowTemplateObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotWorkflowTemplate": {
+			reason: "Should return an error if the resource is not a WorkflowTemplate",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotWorkflowTemplate)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			mg:     newWorkflowTemplate(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			mg:     newWorkflowTemplate(),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetWorkflowTemplate)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(&dataproc.WorkflowTemplate{})
+			}),
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if the late initialization update fails",
+			mg:     newWorkflowTemplate(),
+			want:   want{err: errors.Wrap(errBoom, errUpdateWorkflowTemplateCR)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&dataproc.WorkflowTemplate{DagTimeout: "600s"})
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should return upToDate as true if the resource is up to date",
+			mg:     newWorkflowTemplate(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&dataproc.WorkflowTemplate{
+					Id:      "my-template",
+					Version: 1,
+					Placement: &dataproc.WorkflowTemplatePlacement{
+						ClusterSelector: &dataproc.ClusterSelector{ClusterLabels: map[string]string{"env": "dev"}},
+					},
+					Jobs: []*dataproc.OrderedJob{{StepId: "step", SparkSqlJob: &dataproc.SparkSqlJob{QueryList: &dataproc.QueryList{Queries: []string{"SELECT 1"}}}}},
+				})
+			}),
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should return upToDate as false if the resource is not up to date",
+			mg:     newWorkflowTemplate(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&dataproc.WorkflowTemplate{
+					Id:      "my-template",
+					Version: 1,
+					Placement: &dataproc.WorkflowTemplatePlacement{
+						ClusterSelector: &dataproc.ClusterSelector{ClusterLabels: map[string]string{"env": "dev"}},
+					},
+					Jobs: []*dataproc.OrderedJob{{StepId: "step", SparkSqlJob: &dataproc.SparkSqlJob{QueryList: &dataproc.QueryList{Queries: []string{"SELECT 2"}}}}},
+				})
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dataproc.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := workflowTemplateExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				templates: s.Projects.Regions.WorkflowTemplates,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func createWorkflowTemplate(e *workflowTemplateExternal, mg resource.Managed) error {
+	_, err := e.Create(context.Background(), mg)
+	return err
+}
+
+func updateWorkflowTemplate(e *workflowTemplateExternal, mg resource.Managed) error {
+	_, err := e.Update(context.Background(), mg)
+	return err
+}
+
+func deleteWorkflowTemplate(e *workflowTemplateExternal, mg resource.Managed) error {
+	return e.Delete(context.Background(), mg)
+}
+
+func TestWorkflowTemplateCreateUpdateDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		method  string
+		status  int
+		call    func(e *workflowTemplateExternal, mg resource.Managed) error
+		mg      resource.Managed
+		wantErr error
+	}{
+		"CreateNotWorkflowTemplate": {
+			reason:  "Should return an error if the resource is not a WorkflowTemplate",
+			call:    createWorkflowTemplate,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotWorkflowTemplate),
+		},
+		"CreateSuccessful": {
+			reason: "Should succeed if the resource creation doesn't return an error",
+			method: http.MethodPost,
+			status: http.StatusOK,
+			call:   createWorkflowTemplate,
+			mg:     newWorkflowTemplate(),
+		},
+		"CreateFailed": {
+			reason:  "Should fail if the resource creation returns an error",
+			method:  http.MethodPost,
+			status:  http.StatusBadRequest,
+			call:    createWorkflowTemplate,
+			mg:      newWorkflowTemplate(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateWorkflowTemplate),
+		},
+		"UpdateSuccessful": {
+			reason: "Should succeed if the resource update doesn't return an error",
+			method: http.MethodPut,
+			status: http.StatusOK,
+			call:   updateWorkflowTemplate,
+			mg:     newWorkflowTemplate(),
+		},
+		"UpdateFailed": {
+			reason:  "Should fail if the resource update returns an error",
+			method:  http.MethodPut,
+			status:  http.StatusBadRequest,
+			call:    updateWorkflowTemplate,
+			mg:      newWorkflowTemplate(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateWorkflowTemplate),
+		},
+		"DeleteAlreadyGone": {
+			reason: "Should not return an error if the resource is already gone",
+			method: http.MethodDelete,
+			status: http.StatusNotFound,
+			call:   deleteWorkflowTemplate,
+			mg:     newWorkflowTemplate(),
+		},
+		"DeleteFailed": {
+			reason:  "Should fail if the resource deletion returns an error",
+			method:  http.MethodDelete,
+			status:  http.StatusBadRequest,
+			call:    deleteWorkflowTemplate,
+			mg:      newWorkflowTemplate(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteWorkflowTemplate),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&dataproc.Operation{})
+			}))
+			defer server.Close()
+			s, _ := dataproc.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &workflowTemplateExternal{
+				projectID: projectID,
+				templates: s.Projects.Regions.WorkflowTemplates,
+			}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
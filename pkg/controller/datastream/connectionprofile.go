@@ -0,0 +1,160 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datastream
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	datastream "google.golang.org/api/datastream/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/datastream/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	datastreamclient "github.com/crossplane/provider-gcp/pkg/clients/datastream"
+)
+
+// Error strings.
+const (
+	errNewClient                 = "cannot create new Datastream client"
+	errNotConnectionProfile      = "managed resource is not a Datastream ConnectionProfile"
+	errGetConnectionProfile      = "cannot get Datastream ConnectionProfile"
+	errGetCredentials            = "cannot get Datastream ConnectionProfile credentials"
+	errCreateConnectionProfile   = "cannot create Datastream ConnectionProfile"
+	errUpdateConnectionProfile   = "cannot update Datastream ConnectionProfile"
+	errDeleteConnectionProfile   = "cannot delete Datastream ConnectionProfile"
+	errUpdateConnectionProfileCR = "cannot update Datastream ConnectionProfile custom resource"
+)
+
+// SetupConnectionProfile adds a controller that reconciles Datastream
+// ConnectionProfiles.
+func SetupConnectionProfile(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.ConnectionProfileGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.ConnectionProfileGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.ConnectionProfile{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.ConnectionProfileGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ConnectionProfileGroupVersionKind),
+			&connectionProfileConnector{kube: mgr.GetClient()},
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connectionProfileConnector struct {
+	kube client.Client
+}
+
+func (c *connectionProfileConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := datastream.NewService(ctx, opts.For("datastream")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &connectionProfileExternal{kube: c.kube, profiles: s.Projects.Locations.ConnectionProfiles, projectID: projectID}, nil
+}
+
+type connectionProfileExternal struct {
+	kube      client.Client
+	profiles  *datastream.ProjectsLocationsConnectionProfilesService
+	projectID string
+}
+
+func (e *connectionProfileExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ConnectionProfile)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotConnectionProfile)
+	}
+	existing, err := e.profiles.Get(datastreamclient.GetConnectionProfileName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetConnectionProfile)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	datastreamclient.LateInitializeConnectionProfile(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateConnectionProfileCR)
+		}
+	}
+	cr.Status.AtProvider = datastreamclient.GenerateConnectionProfileObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: datastreamclient.IsConnectionProfileUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *connectionProfileExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ConnectionProfile)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotConnectionProfile)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	creds, err := datastreamclient.GetCredentials(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetCredentials)
+	}
+	name := meta.GetExternalName(cr)
+	cp := datastreamclient.GenerateConnectionProfile(datastreamclient.GetConnectionProfileName(e.projectID, cr.Spec.ForProvider, name), cr.Spec.ForProvider, creds)
+	_, err = e.profiles.Create(datastreamclient.GetConnectionProfileParent(e.projectID, cr.Spec.ForProvider), cp).ConnectionProfileId(name).RequestId(gcp.CreateRequestID(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateConnectionProfile)
+}
+
+func (e *connectionProfileExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ConnectionProfile)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotConnectionProfile)
+	}
+	// Only the display name and labels are updated, so there is no need to
+	// send the credentials again.
+	name := datastreamclient.GetConnectionProfileName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+	cp := datastreamclient.GenerateConnectionProfile(name, cr.Spec.ForProvider, datastreamclient.Credentials{})
+	_, err := e.profiles.Patch(name, cp).UpdateMask(datastreamclient.ConnectionProfileUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateConnectionProfile)
+}
+
+func (e *connectionProfileExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ConnectionProfile)
+	if !ok {
+		return errors.New(errNotConnectionProfile)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.profiles.Delete(datastreamclient.GetConnectionProfileName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteConnectionProfile)
+}
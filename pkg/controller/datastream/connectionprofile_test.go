@@ -0,0 +1,343 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datastream
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	datastream "google.golang.org/api/datastream/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/datastream/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID = "myproject-id-1234"
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newConnectionProfile() *v1alpha1.ConnectionProfile {
+	cp := &v1alpha1.ConnectionProfile{}
+	meta.SetExternalName(cp, "my-profile")
+	cp.Spec.ForProvider = v1alpha1.ConnectionProfileParameters{
+		Location:    "us-central1",
+		DisplayName: gcp.StringPtr("My profile"),
+		Labels:      map[string]string{"env": "dev"},
+		MySQLProfile: &v1alpha1.MySQLProfile{
+			Hostname: "10.0.0.2",
+			Port:     gcp.Int64Ptr(3306),
+			Username: "datastream",
+			PasswordSecretRef: xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Name: "mysql", Namespace: "crossplane-system"},
+				Key:             "password",
+			},
+		},
+		StaticServiceIPConnectivity: &v1alpha1.StaticServiceIPConnectivity{},
+	}
+	return cp
+}
+
+func TestConnectionProfileObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotConnectionProfile": {
+			reason: "Should return an error if the resource is not a ConnectionProfile",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotConnectionProfile)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			mg:     newConnectionProfile(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			mg:     newConnectionProfile(),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetConnectionProfile)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(&datastream.ConnectionProfile{})
+			}),
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if the late initialization update fails",
+			mg: func() resource.Managed {
+				cp := newConnectionProfile()
+				cp.Spec.ForProvider.DisplayName = nil
+				return cp
+			}(),
+			want: want{err: errors.Wrap(errBoom, errUpdateConnectionProfileCR)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&datastream.ConnectionProfile{DisplayName: "my-profile"})
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should return upToDate as true if the resource is up to date",
+			mg:     newConnectionProfile(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&datastream.ConnectionProfile{
+					DisplayName:  "My profile",
+					Labels:       map[string]string{"env": "dev"},
+					MysqlProfile: &datastream.MysqlProfile{Hostname: "10.0.0.2", Port: 3306, Username: "datastream"},
+				})
+			}),
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should return upToDate as false if the resource is not up to date",
+			mg:     newConnectionProfile(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&datastream.ConnectionProfile{
+					DisplayName:  "My profile",
+					MysqlProfile: &datastream.MysqlProfile{Hostname: "10.0.0.2", Port: 3306, Username: "datastream"},
+				})
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := datastream.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := connectionProfileExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				profiles:  s.Projects.Locations.ConnectionProfiles,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestConnectionProfileCreate(t *testing.T) {
+	secret := test.NewMockGetFn(nil, func(obj client.Object) error {
+		s := obj.(*corev1.Secret)
+		s.Data = map[string][]byte{"password": []byte("s3cr3t")}
+		return nil
+	})
+
+	cases := map[string]struct {
+		reason  string
+		kube    client.Client
+		status  int
+		mg      resource.Managed
+		wantErr error
+	}{
+		"NotConnectionProfile": {
+			reason:  "Should return an error if the resource is not a ConnectionProfile",
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotConnectionProfile),
+		},
+		"GetCredentialsFailed": {
+			reason:  "Should return an error if the credentials cannot be read",
+			kube:    &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			mg:      newConnectionProfile(),
+			wantErr: errors.Wrap(errors.Wrap(errBoom, "cannot get credentials Secret"), errGetCredentials),
+		},
+		"CreateSuccessful": {
+			reason: "Should succeed if the resource creation doesn't return an error",
+			kube:   &test.MockClient{MockGet: secret},
+			status: http.StatusOK,
+			mg:     newConnectionProfile(),
+		},
+		"CreateFailed": {
+			reason:  "Should fail if the resource creation returns an error",
+			kube:    &test.MockClient{MockGet: secret},
+			status:  http.StatusBadRequest,
+			mg:      newConnectionProfile(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateConnectionProfile),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff("my-profile", r.URL.Query().Get("connectionProfileId")); diff != "" {
+					t.Errorf("connectionProfileId: -want, +got:\n%s", diff)
+				}
+				cp := &datastream.ConnectionProfile{}
+				_ = json.NewDecoder(r.Body).Decode(cp)
+				_ = r.Body.Close()
+				if diff := cmp.Diff("s3cr3t", cp.MysqlProfile.Password); diff != "" {
+					t.Errorf("password: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&datastream.Operation{})
+			}))
+			defer server.Close()
+			s, _ := datastream.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &connectionProfileExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				profiles:  s.Projects.Locations.ConnectionProfiles,
+			}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func updateConnectionProfile(e *connectionProfileExternal, mg resource.Managed) error {
+	_, err := e.Update(context.Background(), mg)
+	return err
+}
+
+func deleteConnectionProfile(e *connectionProfileExternal, mg resource.Managed) error {
+	return e.Delete(context.Background(), mg)
+}
+
+func TestConnectionProfileUpdateDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		method  string
+		status  int
+		call    func(e *connectionProfileExternal, mg resource.Managed) error
+		mg      resource.Managed
+		wantErr error
+	}{
+		"UpdateNotConnectionProfile": {
+			reason:  "Should return an error if the resource is not a ConnectionProfile",
+			call:    updateConnectionProfile,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotConnectionProfile),
+		},
+		"UpdateSuccessful": {
+			reason: "Should succeed if the resource update doesn't return an error",
+			method: http.MethodPatch,
+			status: http.StatusOK,
+			call:   updateConnectionProfile,
+			mg:     newConnectionProfile(),
+		},
+		"UpdateFailed": {
+			reason:  "Should fail if the resource update returns an error",
+			method:  http.MethodPatch,
+			status:  http.StatusBadRequest,
+			call:    updateConnectionProfile,
+			mg:      newConnectionProfile(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateConnectionProfile),
+		},
+		"DeleteNotConnectionProfile": {
+			reason:  "Should return an error if the resource is not a ConnectionProfile",
+			call:    deleteConnectionProfile,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotConnectionProfile),
+		},
+		"DeleteAlreadyGone": {
+			reason: "Should not return an error if the resource is already gone",
+			method: http.MethodDelete,
+			status: http.StatusNotFound,
+			call:   deleteConnectionProfile,
+			mg:     newConnectionProfile(),
+		},
+		"DeleteFailed": {
+			reason:  "Should fail if the resource deletion returns an error",
+			method:  http.MethodDelete,
+			status:  http.StatusBadRequest,
+			call:    deleteConnectionProfile,
+			mg:      newConnectionProfile(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteConnectionProfile),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&datastream.Operation{})
+			}))
+			defer server.Close()
+			s, _ := datastream.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &connectionProfileExternal{
+				projectID: projectID,
+				profiles:  s.Projects.Locations.ConnectionProfiles,
+			}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datastream
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	datastream "google.golang.org/api/datastream/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/datastream/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	datastreamclient "github.com/crossplane/provider-gcp/pkg/clients/datastream"
+)
+
+// Error strings.
+const (
+	errNotStream      = "managed resource is not a Datastream Stream"
+	errGetStream      = "cannot get Datastream Stream"
+	errCreateStream   = "cannot create Datastream Stream"
+	errUpdateStream   = "cannot update Datastream Stream"
+	errDeleteStream   = "cannot delete Datastream Stream"
+	errUpdateStreamCR = "cannot update Datastream Stream custom resource"
+)
+
+// SetupStream adds a controller that reconciles Datastream Streams.
+func SetupStream(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.StreamGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.StreamGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Stream{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.StreamGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.StreamGroupVersionKind),
+			&streamConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type streamConnector struct {
+	kube client.Client
+}
+
+func (c *streamConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := datastream.NewService(ctx, opts.For("datastream")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &streamExternal{kube: c.kube, streams: s.Projects.Locations.Streams, projectID: projectID}, nil
+}
+
+type streamExternal struct {
+	kube      client.Client
+	streams   *datastream.ProjectsLocationsStreamsService
+	projectID string
+}
+
+func (e *streamExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Stream)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotStream)
+	}
+	existing, err := e.streams.Get(datastreamclient.GetStreamName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetStream)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	datastreamclient.LateInitializeStream(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateStreamCR)
+		}
+	}
+	cr.Status.AtProvider = datastreamclient.GenerateStreamObservation(*existing)
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.StreamStateRunning, v1alpha1.StreamStatePaused, v1alpha1.StreamStateDraining, v1alpha1.StreamStateMaintenance:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1alpha1.StreamStateNotStarted, v1alpha1.StreamStateStarting:
+		cr.Status.SetConditions(xpv1.Creating())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: datastreamclient.IsStreamUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *streamExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Stream)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotStream)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	name := meta.GetExternalName(cr)
+	s := datastreamclient.GenerateStream(datastreamclient.GetStreamName(e.projectID, cr.Spec.ForProvider, name), cr.Spec.ForProvider)
+	_, err := e.streams.Create(datastreamclient.GetStreamParent(e.projectID, cr.Spec.ForProvider), s).StreamId(name).RequestId(gcp.CreateRequestID(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateStream)
+}
+
+func (e *streamExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Stream)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotStream)
+	}
+	name := datastreamclient.GetStreamName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+
+	// We have to get the stream again here to determine which fields to
+	// update.
+	existing, err := e.streams.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetStream)
+	}
+	mask := datastreamclient.GetStreamUpdateMask(cr.Spec.ForProvider, *existing)
+	if mask == "" {
+		return managed.ExternalUpdate{}, nil
+	}
+	s := datastreamclient.GenerateStream(name, cr.Spec.ForProvider)
+	if strings.Contains(mask, "state") {
+		s.State = gcp.StringValue(cr.Spec.ForProvider.DesiredState)
+	}
+	_, err = e.streams.Patch(name, s).UpdateMask(mask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateStream)
+}
+
+func (e *streamExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Stream)
+	if !ok {
+		return errors.New(errNotStream)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.streams.Delete(datastreamclient.GetStreamName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteStream)
+}
@@ -0,0 +1,341 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datastream
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	datastream "google.golang.org/api/datastream/v1"
+	"google.golang.org/api/option"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/datastream/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+func newStream() *v1alpha1.Stream {
+	s := &v1alpha1.Stream{}
+	meta.SetExternalName(s, "my-stream")
+	s.Spec.ForProvider = v1alpha1.StreamParameters{
+		Location:    "us-central1",
+		DisplayName: gcp.StringPtr("My stream"),
+		Labels:      map[string]string{"env": "dev"},
+		SourceConfig: v1alpha1.StreamSourceConfig{
+			SourceConnectionProfile: gcp.StringPtr("projects/myproject-id-1234/locations/us-central1/connectionProfiles/mysql"),
+			MySQLSourceConfig:       &v1alpha1.MySQLSourceConfig{},
+		},
+		DestinationConfig: v1alpha1.StreamDestinationConfig{
+			DestinationConnectionProfile: gcp.StringPtr("projects/myproject-id-1234/locations/us-central1/connectionProfiles/bigquery"),
+			BigQueryDestinationConfig: &v1alpha1.BigQueryDestinationConfig{
+				SingleTargetDataset: &v1alpha1.SingleTargetDataset{DatasetID: "myproject-id-1234:shop"},
+			},
+		},
+		BackfillNone: &v1alpha1.BackfillNoneStrategy{},
+		DesiredState: gcp.StringPtr(v1alpha1.StreamStateRunning),
+	}
+	return s
+}
+
+func TestStreamObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotStream": {
+			reason: "Should return an error if the resource is not a Stream",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotStream)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			mg:     newStream(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			mg:     newStream(),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetStream)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(&datastream.Stream{})
+			}),
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if the late initialization update fails",
+			mg: func() resource.Managed {
+				s := newStream()
+				s.Spec.ForProvider.DisplayName = nil
+				return s
+			}(),
+			want: want{err: errors.Wrap(errBoom, errUpdateStreamCR)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&datastream.Stream{DisplayName: "my-stream"})
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should return upToDate as true if the resource is up to date",
+			mg:     newStream(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&datastream.Stream{
+					DisplayName: "My stream",
+					Labels:      map[string]string{"env": "dev"},
+					State:       v1alpha1.StreamStateRunning,
+				})
+			}),
+		},
+		"ResourceNotStarted": {
+			reason: "Should return upToDate as false if the stream has not been started yet",
+			mg:     newStream(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&datastream.Stream{
+					DisplayName: "My stream",
+					Labels:      map[string]string{"env": "dev"},
+					State:       v1alpha1.StreamStateNotStarted,
+				})
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := datastream.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := streamExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				streams:   s.Projects.Locations.Streams,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func createStream(e *streamExternal, mg resource.Managed) error {
+	_, err := e.Create(context.Background(), mg)
+	return err
+}
+
+func deleteStream(e *streamExternal, mg resource.Managed) error {
+	return e.Delete(context.Background(), mg)
+}
+
+func TestStreamCreateDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		method  string
+		status  int
+		call    func(e *streamExternal, mg resource.Managed) error
+		mg      resource.Managed
+		wantErr error
+	}{
+		"CreateNotStream": {
+			reason:  "Should return an error if the resource is not a Stream",
+			call:    createStream,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotStream),
+		},
+		"CreateSuccessful": {
+			reason: "Should succeed if the resource creation doesn't return an error",
+			method: http.MethodPost,
+			status: http.StatusOK,
+			call:   createStream,
+			mg:     newStream(),
+		},
+		"CreateFailed": {
+			reason:  "Should fail if the resource creation returns an error",
+			method:  http.MethodPost,
+			status:  http.StatusBadRequest,
+			call:    createStream,
+			mg:      newStream(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateStream),
+		},
+		"DeleteAlreadyGone": {
+			reason: "Should not return an error if the resource is already gone",
+			method: http.MethodDelete,
+			status: http.StatusNotFound,
+			call:   deleteStream,
+			mg:     newStream(),
+		},
+		"DeleteFailed": {
+			reason:  "Should fail if the resource deletion returns an error",
+			method:  http.MethodDelete,
+			status:  http.StatusBadRequest,
+			call:    deleteStream,
+			mg:      newStream(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteStream),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&datastream.Operation{})
+			}))
+			defer server.Close()
+			s, _ := datastream.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &streamExternal{
+				projectID: projectID,
+				streams:   s.Projects.Locations.Streams,
+			}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestStreamUpdate(t *testing.T) {
+	cases := map[string]struct {
+		reason   string
+		mg       resource.Managed
+		observed *datastream.Stream
+		status   int
+		mask     string
+		state    string
+		wantErr  error
+	}{
+		"NotStream": {
+			reason:  "Should return an error if the resource is not a Stream",
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotStream),
+		},
+		"UpToDate": {
+			reason: "Should not patch the stream if nothing differs",
+			mg:     newStream(),
+			observed: &datastream.Stream{
+				DisplayName: "My stream",
+				Labels:      map[string]string{"env": "dev"},
+				State:       v1alpha1.StreamStateStarting,
+			},
+		},
+		"StartStream": {
+			reason: "Should request the desired state if the stream has not been started",
+			mg:     newStream(),
+			observed: &datastream.Stream{
+				DisplayName: "My stream",
+				Labels:      map[string]string{"env": "dev"},
+				State:       v1alpha1.StreamStateNotStarted,
+			},
+			status: http.StatusOK,
+			mask:   "state",
+			state:  v1alpha1.StreamStateRunning,
+		},
+		"UpdateLabels": {
+			reason: "Should not request a state change if only the labels differ",
+			mg:     newStream(),
+			observed: &datastream.Stream{
+				DisplayName: "My stream",
+				State:       v1alpha1.StreamStateRunning,
+			},
+			status: http.StatusOK,
+			mask:   "labels",
+		},
+		"UpdateFailed": {
+			reason:   "Should fail if the resource update returns an error",
+			mg:       newStream(),
+			observed: &datastream.Stream{State: v1alpha1.StreamStateRunning},
+			status:   http.StatusBadRequest,
+			mask:     "displayName,labels",
+			wantErr:  errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateStream),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodGet:
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(tc.observed)
+				case http.MethodPatch:
+					s := &datastream.Stream{}
+					_ = json.NewDecoder(r.Body).Decode(s)
+					_ = r.Body.Close()
+					if diff := cmp.Diff(tc.mask, r.URL.Query().Get("updateMask")); diff != "" {
+						t.Errorf("updateMask: -want, +got:\n%s", diff)
+					}
+					if diff := cmp.Diff(tc.state, s.State); diff != "" {
+						t.Errorf("state: -want, +got:\n%s", diff)
+					}
+					w.WriteHeader(tc.status)
+					_ = json.NewEncoder(w).Encode(&datastream.Operation{})
+				default:
+					t.Errorf("unexpected method %s", r.Method)
+				}
+			}))
+			defer server.Close()
+			s, _ := datastream.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &streamExternal{
+				projectID: projectID,
+				streams:   s.Projects.Locations.Streams,
+			}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	dns "google.golang.org/api/dns/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/dns/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	rrsClient "github.com/crossplane/provider-gcp/pkg/clients/dns"
+)
+
+const (
+	errNotManagedZone      = "managed resource is not a ManagedZone custom resource"
+	errGetManagedZone      = "cannot get the ManagedZone"
+	errCreateManagedZone   = "cannot create the ManagedZone"
+	errUpdateManagedZone   = "cannot update the ManagedZone"
+	errDeleteManagedZone   = "cannot delete the ManagedZone"
+	errLateInitManagedZone = "cannot update ManagedZone custom resource"
+)
+
+// SetupManagedZone adds a controller that reconciles ManagedZone managed
+// resources.
+func SetupManagedZone(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.ManagedZoneGroupKind)
+
+	r := gcp.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ManagedZoneGroupVersionKind),
+		&managedZoneConnector{kube: mgr.GetClient()},
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(poll),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.ManagedZoneGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.ManagedZone{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.ManagedZoneGroupVersionKind))).
+		Complete(r)
+}
+
+type managedZoneConnector struct {
+	kube client.Client
+}
+
+func (c *managedZoneConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	d, err := dns.NewService(ctx, opts.For("dns")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &managedZoneExternal{
+		kube:      c.kube,
+		zones:     d.ManagedZones,
+		projectID: projectID,
+	}, nil
+}
+
+type managedZoneExternal struct {
+	kube      client.Client
+	zones     *dns.ManagedZonesService
+	projectID string
+}
+
+func (e *managedZoneExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ManagedZone)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotManagedZone)
+	}
+
+	mz, err := e.zones.Get(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetManagedZone)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	rrsClient.LateInitializeManagedZone(&cr.Spec.ForProvider, *mz)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errLateInitManagedZone)
+		}
+	}
+
+	cr.Status.AtProvider = rrsClient.GenerateManagedZoneObservation(*mz)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: rrsClient.IsManagedZoneUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, mz),
+	}, nil
+}
+
+func (e *managedZoneExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ManagedZone)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotManagedZone)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	mz := &dns.ManagedZone{}
+	rrsClient.GenerateManagedZone(meta.GetExternalName(cr), cr.Spec.ForProvider, mz)
+	_, err := e.zones.Create(e.projectID, mz).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateManagedZone)
+}
+
+func (e *managedZoneExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ManagedZone)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotManagedZone)
+	}
+
+	mz := &dns.ManagedZone{}
+	rrsClient.GenerateManagedZone(meta.GetExternalName(cr), cr.Spec.ForProvider, mz)
+	_, err := e.zones.Patch(e.projectID, meta.GetExternalName(cr), mz).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateManagedZone)
+}
+
+func (e *managedZoneExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ManagedZone)
+	if !ok {
+		return errors.New(errNotManagedZone)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	err := e.zones.Delete(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteManagedZone)
+}
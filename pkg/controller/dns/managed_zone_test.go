@@ -0,0 +1,380 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	dns "google.golang.org/api/dns/v1"
+	"google.golang.org/api/option"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/dns/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const zoneName = "example-zone"
+
+type managedZoneOption func(*v1alpha1.ManagedZone)
+
+func newManagedZone(opts ...managedZoneOption) *v1alpha1.ManagedZone {
+	mz := &v1alpha1.ManagedZone{
+		Spec: v1alpha1.ManagedZoneSpec{
+			ForProvider: v1alpha1.ManagedZoneParameters{
+				DNSName:     "example.com.",
+				Description: gcp.StringPtr("Managed by Crossplane"),
+				Visibility:  gcp.StringPtr(v1alpha1.ManagedZoneVisibilityPublic),
+			},
+		},
+	}
+	meta.SetExternalName(mz, zoneName)
+	for _, f := range opts {
+		f(mz)
+	}
+	return mz
+}
+
+func withDescription(d string) managedZoneOption {
+	return func(mz *v1alpha1.ManagedZone) {
+		mz.Spec.ForProvider.Description = gcp.StringPtr(d)
+	}
+}
+
+func withManagedZoneObservation(o v1alpha1.ManagedZoneObservation) managedZoneOption {
+	return func(mz *v1alpha1.ManagedZone) {
+		mz.Status.AtProvider = o
+	}
+}
+
+func withManagedZoneConditions(c ...xpv1.Condition) managedZoneOption {
+	return func(mz *v1alpha1.ManagedZone) {
+		mz.Status.SetConditions(c...)
+	}
+}
+
+func observedZone() *dns.ManagedZone {
+	return &dns.ManagedZone{
+		Name:        zoneName,
+		DnsName:     "example.com.",
+		Description: "Managed by Crossplane",
+		Visibility:  v1alpha1.ManagedZoneVisibilityPublic,
+		Id:          1234,
+		NameServers: []string{"ns-1.googledomains.com."},
+	}
+}
+
+func TestManagedZoneObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotManagedZone": {
+			mg: unexpectedObject,
+			want: want{
+				mg:  unexpectedObject,
+				err: errors.New(errNotManagedZone),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&dns.ManagedZone{})
+			}),
+			mg: newManagedZone(),
+			want: want{
+				mg: newManagedZone(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&dns.ManagedZone{})
+			}),
+			mg: newManagedZone(),
+			want: want{
+				mg:  newManagedZone(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetManagedZone),
+			},
+		},
+		"LateInitFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				z := observedZone()
+				z.Labels = map[string]string{"team": "dns"}
+				_ = json.NewEncoder(w).Encode(z)
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:   newManagedZone(),
+			want: want{
+				mg: newManagedZone(func(mz *v1alpha1.ManagedZone) {
+					mz.Spec.ForProvider.Labels = map[string]string{"team": "dns"}
+				}),
+				err: errors.Wrap(errBoom, errLateInitManagedZone),
+			},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(observedZone())
+			}),
+			mg: newManagedZone(),
+			want: want{
+				mg: newManagedZone(
+					withManagedZoneObservation(v1alpha1.ManagedZoneObservation{ID: 1234, NameServers: []string{"ns-1.googledomains.com."}}),
+					withManagedZoneConditions(xpv1.Available()),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotUpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(observedZone())
+			}),
+			mg: newManagedZone(withDescription("Something else")),
+			want: want{
+				mg: newManagedZone(
+					withDescription("Something else"),
+					withManagedZoneObservation(v1alpha1.ManagedZoneObservation{ID: 1234, NameServers: []string{"ns-1.googledomains.com."}}),
+					withManagedZoneConditions(xpv1.Available()),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dns.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := managedZoneExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				zones:     s.ManagedZones,
+			}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestManagedZoneCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotManagedZone": {
+			mg: unexpectedObject,
+			want: want{
+				mg:  unexpectedObject,
+				err: errors.New(errNotManagedZone),
+			},
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				mz := &dns.ManagedZone{}
+				_ = json.NewDecoder(r.Body).Decode(mz)
+				_ = r.Body.Close()
+				if diff := cmp.Diff(zoneName, mz.Name); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(mz)
+			}),
+			mg: newManagedZone(),
+			want: want{
+				mg: newManagedZone(withManagedZoneConditions(xpv1.Creating())),
+			},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&dns.ManagedZone{})
+			}),
+			mg: newManagedZone(),
+			want: want{
+				mg:  newManagedZone(withManagedZoneConditions(xpv1.Creating())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateManagedZone),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dns.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := managedZoneExternal{
+				projectID: projectID,
+				zones:     s.ManagedZones,
+			}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestManagedZoneUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"NotManagedZone": {
+			mg:  unexpectedObject,
+			err: errors.New(errNotManagedZone),
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&dns.Operation{})
+			}),
+			mg: newManagedZone(),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&dns.Operation{})
+			}),
+			mg:  newManagedZone(),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateManagedZone),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dns.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := managedZoneExternal{
+				projectID: projectID,
+				zones:     s.ManagedZones,
+			}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestManagedZoneDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"NotManagedZone": {
+			mg:  unexpectedObject,
+			err: errors.New(errNotManagedZone),
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}),
+			mg: newManagedZone(),
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: newManagedZone(),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&dns.ManagedZone{})
+			}),
+			mg:  newManagedZone(),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteManagedZone),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dns.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := managedZoneExternal{
+				projectID: projectID,
+				zones:     s.ManagedZones,
+			}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
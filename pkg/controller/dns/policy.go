@@ -0,0 +1,166 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	dns "google.golang.org/api/dns/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/dns/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	rrsClient "github.com/crossplane/provider-gcp/pkg/clients/dns"
+)
+
+const (
+	errNotPolicy      = "managed resource is not a Policy custom resource"
+	errGetPolicy      = "cannot get the Policy"
+	errCreatePolicy   = "cannot create the Policy"
+	errUpdatePolicy   = "cannot update the Policy"
+	errDeletePolicy   = "cannot delete the Policy"
+	errLateInitPolicy = "cannot update Policy custom resource"
+)
+
+// SetupPolicy adds a controller that reconciles Policy managed
+// resources.
+func SetupPolicy(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.PolicyGroupKind)
+
+	r := gcp.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.PolicyGroupVersionKind),
+		&policyConnector{kube: mgr.GetClient()},
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(poll),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.PolicyGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Policy{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.PolicyGroupVersionKind))).
+		Complete(r)
+}
+
+type policyConnector struct {
+	kube client.Client
+}
+
+func (c *policyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	d, err := dns.NewService(ctx, opts.For("dns")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &policyExternal{
+		kube:      c.kube,
+		policies:  d.Policies,
+		projectID: projectID,
+	}, nil
+}
+
+type policyExternal struct {
+	kube      client.Client
+	policies  *dns.PoliciesService
+	projectID string
+}
+
+func (e *policyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Policy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPolicy)
+	}
+
+	p, err := e.policies.Get(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetPolicy)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	rrsClient.LateInitializePolicy(&cr.Spec.ForProvider, *p)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errLateInitPolicy)
+		}
+	}
+
+	cr.Status.AtProvider = rrsClient.GeneratePolicyObservation(*p)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: rrsClient.IsPolicyUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, p),
+	}, nil
+}
+
+func (e *policyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Policy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPolicy)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	p := &dns.Policy{}
+	rrsClient.GeneratePolicy(meta.GetExternalName(cr), cr.Spec.ForProvider, p)
+	_, err := e.policies.Create(e.projectID, p).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreatePolicy)
+}
+
+func (e *policyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Policy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPolicy)
+	}
+
+	p := &dns.Policy{}
+	rrsClient.GeneratePolicy(meta.GetExternalName(cr), cr.Spec.ForProvider, p)
+	_, err := e.policies.Patch(e.projectID, meta.GetExternalName(cr), p).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdatePolicy)
+}
+
+func (e *policyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Policy)
+	if !ok {
+		return errors.New(errNotPolicy)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	err := e.policies.Delete(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeletePolicy)
+}
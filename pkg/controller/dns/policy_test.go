@@ -0,0 +1,375 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	dns "google.golang.org/api/dns/v1"
+	"google.golang.org/api/option"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/dns/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const policyName = "example-policy"
+
+type policyOption func(*v1alpha1.Policy)
+
+func newPolicy(opts ...policyOption) *v1alpha1.Policy {
+	p := &v1alpha1.Policy{
+		Spec: v1alpha1.PolicySpec{
+			ForProvider: v1alpha1.PolicyParameters{
+				Description:             gcp.StringPtr("Managed by Crossplane"),
+				EnableInboundForwarding: gcp.BoolPtr(true),
+			},
+		},
+	}
+	meta.SetExternalName(p, policyName)
+	for _, f := range opts {
+		f(p)
+	}
+	return p
+}
+
+func withPolicyObservation(o v1alpha1.PolicyObservation) policyOption {
+	return func(p *v1alpha1.Policy) {
+		p.Status.AtProvider = o
+	}
+}
+
+func withPolicyConditions(c ...xpv1.Condition) policyOption {
+	return func(p *v1alpha1.Policy) {
+		p.Status.SetConditions(c...)
+	}
+}
+
+func observedPolicy() *dns.Policy {
+	return &dns.Policy{
+		Name:                    policyName,
+		Description:             "Managed by Crossplane",
+		EnableInboundForwarding: true,
+		Id:                      1234,
+	}
+}
+
+func TestPolicyObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotPolicy": {
+			mg: unexpectedObject,
+			want: want{
+				mg:  unexpectedObject,
+				err: errors.New(errNotPolicy),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&dns.Policy{})
+			}),
+			mg: newPolicy(),
+			want: want{
+				mg: newPolicy(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&dns.Policy{})
+			}),
+			mg: newPolicy(),
+			want: want{
+				mg:  newPolicy(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetPolicy),
+			},
+		},
+		"LateInitFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				p := observedPolicy()
+				p.EnableLogging = true
+				_ = json.NewEncoder(w).Encode(p)
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:   newPolicy(),
+			want: want{
+				mg: newPolicy(func(p *v1alpha1.Policy) {
+					p.Spec.ForProvider.EnableLogging = gcp.BoolPtr(true)
+				}),
+				err: errors.Wrap(errBoom, errLateInitPolicy),
+			},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(observedPolicy())
+			}),
+			mg: newPolicy(),
+			want: want{
+				mg: newPolicy(
+					withPolicyObservation(v1alpha1.PolicyObservation{ID: 1234}),
+					withPolicyConditions(xpv1.Available()),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotUpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(observedPolicy())
+			}),
+			mg: newPolicy(func(p *v1alpha1.Policy) {
+				p.Spec.ForProvider.EnableInboundForwarding = gcp.BoolPtr(false)
+			}),
+			want: want{
+				mg: newPolicy(
+					func(p *v1alpha1.Policy) {
+						p.Spec.ForProvider.EnableInboundForwarding = gcp.BoolPtr(false)
+					},
+					withPolicyObservation(v1alpha1.PolicyObservation{ID: 1234}),
+					withPolicyConditions(xpv1.Available()),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dns.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := policyExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				policies:  s.Policies,
+			}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotPolicy": {
+			mg: unexpectedObject,
+			want: want{
+				mg:  unexpectedObject,
+				err: errors.New(errNotPolicy),
+			},
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				p := &dns.Policy{}
+				_ = json.NewDecoder(r.Body).Decode(p)
+				_ = r.Body.Close()
+				if diff := cmp.Diff(policyName, p.Name); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(p)
+			}),
+			mg: newPolicy(),
+			want: want{
+				mg: newPolicy(withPolicyConditions(xpv1.Creating())),
+			},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&dns.Policy{})
+			}),
+			mg: newPolicy(),
+			want: want{
+				mg:  newPolicy(withPolicyConditions(xpv1.Creating())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreatePolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dns.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := policyExternal{
+				projectID: projectID,
+				policies:  s.Policies,
+			}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"NotPolicy": {
+			mg:  unexpectedObject,
+			err: errors.New(errNotPolicy),
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&dns.Operation{})
+			}),
+			mg: newPolicy(),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&dns.Operation{})
+			}),
+			mg:  newPolicy(),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdatePolicy),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dns.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := policyExternal{
+				projectID: projectID,
+				policies:  s.Policies,
+			}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"NotPolicy": {
+			mg:  unexpectedObject,
+			err: errors.New(errNotPolicy),
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}),
+			mg: newPolicy(),
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: newPolicy(),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&dns.Policy{})
+			}),
+			mg:  newPolicy(),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errDeletePolicy),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dns.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := policyExternal{
+				projectID: projectID,
+				policies:  s.Policies,
+			}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,10 +18,13 @@ package dns
 
 import (
 	"context"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	dns "google.golang.org/api/dns/v1"
+	"google.golang.org/api/googleapi"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -45,6 +48,7 @@ const (
 	errNewClient            = "cannot create new DNS Service"
 	errNotResourceRecordSet = "managed resource is not a ResourceRecordSet custom resource"
 	errCannotCreate         = "cannot create new ResourceRecordSet"
+	errCannotUpdate         = "cannot update ResourceRecordSet"
 	errCannotDelete         = "cannot delete new ResourceRecordSet"
 	errGetFailed            = "cannot get the ResourceRecordSet"
 	errManagedUpdateFailed  = "cannot update ResourceRecordSet custom resource"
@@ -53,16 +57,14 @@ const (
 
 // SetupResourceRecordSet adds a controller that reconciles
 // ResourceRecordSet managed resources.
-func SetupResourceRecordSet(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+func SetupResourceRecordSet(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
 	name := managed.ControllerName(v1alpha1.ResourceRecordSetGroupKind)
 
-	r := managed.NewReconciler(mgr,
+	r := gcp.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.ResourceRecordSetGroupVersionKind),
-		managed.WithExternalConnecter(
-			&connector{
-				kube: mgr.GetClient(),
-			},
-		),
+		&connector{
+			kube: mgr.GetClient(),
+		},
 		managed.WithInitializers(
 			rrsClient.NewCustomNameAsExternalName(mgr.GetClient()),
 		),
@@ -79,9 +81,11 @@ func SetupResourceRecordSet(mgr ctrl.Manager, l logging.Logger, rl workqueue.Rat
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: mcr.For(v1alpha1.ResourceRecordSetGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
 		}).
 		For(&v1alpha1.ResourceRecordSet{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.ResourceRecordSetGroupVersionKind))).
 		Complete(r)
 }
 
@@ -94,13 +98,15 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	d, err := dns.NewService(ctx, opts)
+	d, err := dns.NewService(ctx, opts.For("dns")...)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
 	return &external{
 		kube:      c.kube,
 		dns:       d.ResourceRecordSets,
+		changes:   d.Changes,
+		lists:     gcp.ListObservation(),
 		projectID: projectID,
 	}, nil
 }
@@ -108,21 +114,64 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 type external struct {
 	kube      client.Client
 	dns       *dns.ResourceRecordSetsService
+	changes   *dns.ChangesService
+	lists     *gcp.ListCache
 	projectID string
 }
 
+// get returns the observed record set of the supplied ResourceRecordSet. The
+// record sets of its managed zone are listed and cached if list observation
+// is enabled.
+func (e *external) get(ctx context.Context, cr *v1alpha1.ResourceRecordSet) (*dns.ResourceRecordSet, error) {
+	zone := cr.Spec.ForProvider.ManagedZone
+	if e.lists == nil {
+		return e.dns.Get(e.projectID, zone, meta.GetExternalName(cr), cr.Spec.ForProvider.Type).Context(ctx).Do()
+	}
+	rrs, ok, err := e.lists.Get(e.listKey(cr), recordSetKey(meta.GetExternalName(cr), cr.Spec.ForProvider.Type), func() (map[string]interface{}, error) {
+		items := map[string]interface{}{}
+		err := e.dns.List(e.projectID, zone).Pages(ctx, func(l *dns.ResourceRecordSetsListResponse) error {
+			for _, rrs := range l.Rrsets {
+				items[recordSetKey(rrs.Name, rrs.Type)] = rrs
+			}
+			return nil
+		})
+		return items, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, &googleapi.Error{Code: http.StatusNotFound, Message: "record set not found in managed zone " + zone}
+	}
+	return rrs.(*dns.ResourceRecordSet), nil
+}
+
+// recordSetKey returns the key of the record set with the supplied name and
+// type in a cached list. Listed record sets have fully qualified names, i.e.
+// with a trailing dot, which external names may omit.
+func recordSetKey(name, typ string) string {
+	return strings.TrimSuffix(name, ".") + "/" + typ
+}
+
+// invalidate drops the cached record sets of the managed zone of the supplied
+// ResourceRecordSet, if any, so that its changes are observed right away.
+func (e *external) invalidate(cr *v1alpha1.ResourceRecordSet) {
+	if e.lists != nil {
+		e.lists.Invalidate(e.listKey(cr))
+	}
+}
+
+func (e *external) listKey(cr *v1alpha1.ResourceRecordSet) string {
+	return "ResourceRecordSet/" + e.projectID + "/" + cr.Spec.ForProvider.ManagedZone
+}
+
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
 	cr, ok := mg.(*v1alpha1.ResourceRecordSet)
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errNotResourceRecordSet)
 	}
 
-	rrs, err := e.dns.Get(
-		e.projectID,
-		cr.Spec.ForProvider.ManagedZone,
-		meta.GetExternalName(cr),
-		cr.Spec.ForProvider.Type,
-	).Context(ctx).Do()
+	rrs, err := e.get(ctx, cr)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(
 			resource.Ignore(gcp.IsErrorNotFound, err),
@@ -133,7 +182,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	lateInit := false
 	currentSpec := cr.Spec.ForProvider.DeepCopy()
 	rrsClient.LateInitializeSpec(&cr.Spec.ForProvider, *rrs)
-	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
 		if err := e.kube.Update(ctx, cr); err != nil {
 			return managed.ExternalObservation{}, errors.Wrap(err, errManagedUpdateFailed)
 		}
@@ -165,14 +214,15 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		args,
 	)
 
-	_, err := e.dns.Create(
+	_, err := e.changes.Create(
 		e.projectID,
 		cr.Spec.ForProvider.ManagedZone,
-		args,
+		rrsClient.GenerateChange(nil, args),
 	).Context(ctx).Do()
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCannotCreate)
 	}
+	e.invalidate(cr)
 	cr.SetConditions(xpv1.Creating())
 	return managed.ExternalCreation{}, nil
 }
@@ -183,20 +233,28 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errNotResourceRecordSet)
 	}
 
-	args := &dns.ResourceRecordSet{}
-	rrsClient.GenerateResourceRecordSet(meta.GetExternalName(cr), cr.Spec.ForProvider, args)
-
-	_, err := e.dns.Patch(
+	// A change only applies if its deletions exactly match the current
+	// record set, so we replace what we observe right now.
+	observed, err := e.dns.Get(
 		e.projectID,
 		cr.Spec.ForProvider.ManagedZone,
 		meta.GetExternalName(cr),
 		cr.Spec.ForProvider.Type,
-		args,
 	).Context(ctx).Do()
 	if err != nil {
-		return managed.ExternalUpdate{}, err
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetFailed)
 	}
-	return managed.ExternalUpdate{}, nil
+
+	args := &dns.ResourceRecordSet{}
+	rrsClient.GenerateResourceRecordSet(meta.GetExternalName(cr), cr.Spec.ForProvider, args)
+
+	_, err = e.changes.Create(
+		e.projectID,
+		cr.Spec.ForProvider.ManagedZone,
+		rrsClient.GenerateChange(observed, args),
+	).Context(ctx).Do()
+	e.invalidate(cr)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errCannotUpdate)
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -205,12 +263,22 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 		return errors.New(errNotResourceRecordSet)
 	}
 
-	_, err := e.dns.Delete(
+	observed, err := e.dns.Get(
 		e.projectID,
 		cr.Spec.ForProvider.ManagedZone,
 		meta.GetExternalName(cr),
 		cr.Spec.ForProvider.Type,
 	).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetFailed)
+	}
+
+	_, err = e.changes.Create(
+		e.projectID,
+		cr.Spec.ForProvider.ManagedZone,
+		rrsClient.GenerateChange(observed, nil),
+	).Context(ctx).Do()
+	e.invalidate(cr)
 	if gcp.IsErrorNotFound(err) {
 		return nil
 	}
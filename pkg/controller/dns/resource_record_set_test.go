@@ -22,6 +22,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	dns "google.golang.org/api/dns/v1"
@@ -36,6 +37,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-gcp/apis/dns/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 	rrsClient "github.com/crossplane/provider-gcp/pkg/clients/dns"
 )
 
@@ -66,6 +68,13 @@ func withSignature(s string) rrsOption {
 	}
 }
 
+func withName(name, typ string) rrsOption {
+	return func(r *v1alpha1.ResourceRecordSet) {
+		meta.SetExternalName(r, name)
+		r.Spec.ForProvider.Type = typ
+	}
+}
+
 func gError(code int, message string) *googleapi.Error {
 	return &googleapi.Error{
 		Code:    code,
@@ -87,6 +96,7 @@ func TestObserve(t *testing.T) {
 		reason  string
 		handler http.Handler
 		kube    client.Client
+		lists   *gcp.ListCache
 		args    args
 		want    want
 	}{
@@ -209,6 +219,60 @@ func TestObserve(t *testing.T) {
 				_ = json.NewEncoder(w).Encode(&dns.ResourceRecordSet{Kind: "dns#resourceRecordSet"})
 			}),
 		},
+		"ListedUpToDate": {
+			reason: "Should observe the resource by listing the record sets of its managed zone if list observation is enabled",
+			args: args{
+				newRrs(),
+			},
+			lists: gcp.NewListCache(time.Minute),
+			want: want{
+				e: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&dns.ResourceRecordSetsListResponse{Rrsets: []*dns.ResourceRecordSet{{Kind: "dns#resourceRecordSet"}}})
+			}),
+		},
+		"ListedFullyQualified": {
+			reason: "Should find listed record sets by their fully qualified name if the external name has no trailing dot",
+			args: args{
+				newRrs(withName("www.example.com", "A")),
+			},
+			lists: gcp.NewListCache(time.Minute),
+			want: want{
+				e: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&dns.ResourceRecordSetsListResponse{Rrsets: []*dns.ResourceRecordSet{{Kind: "dns#resourceRecordSet", Name: "www.example.com.", Type: "A"}}})
+			}),
+		},
+		"ListedNotFound": {
+			reason: "Should not return an error if the resource isn't listed in its managed zone",
+			args: args{
+				newRrs(),
+			},
+			lists: gcp.NewListCache(time.Minute),
+			want: want{
+				e: managed.ExternalObservation{},
+			},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&dns.ResourceRecordSetsListResponse{})
+			}),
+		},
 		"ResourceNotUpToDate": {
 			reason: "Should return upToDate as false if the resource is not up to date",
 			args: args{
@@ -241,6 +305,8 @@ func TestObserve(t *testing.T) {
 				kube:      tc.kube,
 				projectID: projectID,
 				dns:       s.ResourceRecordSets,
+				changes:   s.Changes,
+				lists:     tc.lists,
 			}
 			got, err := e.Observe(context.Background(), tc.args.mg)
 			if diff := cmp.Diff(tc.want.e, got); diff != "" {
@@ -324,6 +390,7 @@ func TestCreate(t *testing.T) {
 			e := external{
 				projectID: projectID,
 				dns:       s.ResourceRecordSets,
+				changes:   s.Changes,
 			}
 			got, err := e.Create(context.Background(), tc.args.mg)
 			if diff := cmp.Diff(tc.want.e, got); diff != "" {
@@ -361,40 +428,74 @@ func TestUpdate(t *testing.T) {
 				err: errors.New(errNotResourceRecordSet),
 			},
 		},
-		"Successful": {
-			reason: "Should succeed if the resource update doesn't return an error",
+		"GetFailed": {
+			reason: "Should fail if the current resource cannot be retrieved",
 			args: args{
 				mg: newRrs(),
 			},
 			want: want{
 				e:   managed.ExternalUpdate{},
-				err: nil,
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetFailed),
 			},
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				_ = r.Body.Close()
-				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
 					t.Errorf("r: -want, +got:\n%s", diff)
 				}
-				w.WriteHeader(http.StatusOK)
+				w.WriteHeader(http.StatusBadRequest)
 				_ = json.NewEncoder(w).Encode(&dns.ResourceRecordSet{})
 			}),
 		},
+		"Successful": {
+			reason: "Should succeed if the change doesn't return an error",
+			args: args{
+				mg: newRrs(withSignature("new")),
+			},
+			want: want{
+				e:   managed.ExternalUpdate{},
+				err: nil,
+			},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodGet {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(&dns.ResourceRecordSet{SignatureRrdatas: []string{"old"}})
+					return
+				}
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				c := &dns.Change{}
+				_ = json.NewDecoder(r.Body).Decode(c)
+				_ = r.Body.Close()
+				if len(c.Deletions) != 1 || len(c.Additions) != 1 {
+					t.Errorf("r: want one deletion and one addition, got %d and %d", len(c.Deletions), len(c.Additions))
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&dns.Change{})
+			}),
+		},
 		"Failed": {
-			reason: "Should fail if the resource update returns an error",
+			reason: "Should fail if the change returns an error",
 			args: args{
 				mg: newRrs(),
 			},
 			want: want{
 				e:   managed.ExternalUpdate{},
-				err: gError(http.StatusBadRequest, ""),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCannotUpdate),
 			},
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				_ = r.Body.Close()
-				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+				if r.Method == http.MethodGet {
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(&dns.ResourceRecordSet{})
+					return
+				}
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
 					t.Errorf("r: -want, +got:\n%s", diff)
 				}
 				w.WriteHeader(http.StatusBadRequest)
-				_ = json.NewEncoder(w).Encode(&dns.ResourceRecordSet{})
+				_ = json.NewEncoder(w).Encode(&dns.Change{})
 			}),
 		},
 	}
@@ -407,6 +508,7 @@ func TestUpdate(t *testing.T) {
 			e := external{
 				projectID: projectID,
 				dns:       s.ResourceRecordSets,
+				changes:   s.Changes,
 			}
 			got, err := e.Update(context.Background(), tc.args.mg)
 			if diff := cmp.Diff(tc.want.e, got); diff != "" {
@@ -443,7 +545,7 @@ func TestDelete(t *testing.T) {
 			},
 		},
 		"Successful": {
-			reason: "Should succeed if the resource update doesn't return an error",
+			reason: "Should succeed if the change doesn't return an error",
 			args: args{
 				mg: newRrs(),
 			},
@@ -451,16 +553,27 @@ func TestDelete(t *testing.T) {
 				err: nil,
 			},
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				_ = r.Body.Close()
-				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+				if r.Method == http.MethodGet {
+					_ = r.Body.Close()
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(&dns.ResourceRecordSet{})
+					return
+				}
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
 					t.Errorf("r: -want, +got:\n%s", diff)
 				}
+				c := &dns.Change{}
+				_ = json.NewDecoder(r.Body).Decode(c)
+				_ = r.Body.Close()
+				if len(c.Deletions) != 1 || len(c.Additions) != 0 {
+					t.Errorf("r: want one deletion and no additions, got %d and %d", len(c.Deletions), len(c.Additions))
+				}
 				w.WriteHeader(http.StatusOK)
-				_ = json.NewEncoder(w).Encode(&dns.ResourceRecordSet{})
+				_ = json.NewEncoder(w).Encode(&dns.Change{})
 			}),
 		},
 		"Failed": {
-			reason: "Should fail if the resource update returns an error",
+			reason: "Should fail if the change returns an error",
 			args: args{
 				mg: newRrs(),
 			},
@@ -469,11 +582,13 @@ func TestDelete(t *testing.T) {
 			},
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				_ = r.Body.Close()
-				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
-					t.Errorf("r: -want, +got:\n%s", diff)
+				if r.Method == http.MethodGet {
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(&dns.ResourceRecordSet{})
+					return
 				}
 				w.WriteHeader(http.StatusBadRequest)
-				_ = json.NewEncoder(w).Encode(&dns.ResourceRecordSet{})
+				_ = json.NewEncoder(w).Encode(&dns.Change{})
 			}),
 		},
 		"NotFound": {
@@ -486,7 +601,7 @@ func TestDelete(t *testing.T) {
 			},
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				_ = r.Body.Close()
-				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
 					t.Errorf("r: -want, +got:\n%s", diff)
 				}
 				w.WriteHeader(http.StatusNotFound)
@@ -503,6 +618,7 @@ func TestDelete(t *testing.T) {
 			e := external{
 				projectID: projectID,
 				dns:       s.ResourceRecordSets,
+				changes:   s.Changes,
 			}
 			err := e.Delete(context.Background(), tc.args.mg)
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -0,0 +1,166 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	dns "google.golang.org/api/dns/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/dns/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	rrsClient "github.com/crossplane/provider-gcp/pkg/clients/dns"
+)
+
+const (
+	errNotResponsePolicy      = "managed resource is not a ResponsePolicy custom resource"
+	errGetResponsePolicy      = "cannot get the ResponsePolicy"
+	errCreateResponsePolicy   = "cannot create the ResponsePolicy"
+	errUpdateResponsePolicy   = "cannot update the ResponsePolicy"
+	errDeleteResponsePolicy   = "cannot delete the ResponsePolicy"
+	errLateInitResponsePolicy = "cannot update ResponsePolicy custom resource"
+)
+
+// SetupResponsePolicy adds a controller that reconciles ResponsePolicy managed
+// resources.
+func SetupResponsePolicy(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.ResponsePolicyGroupKind)
+
+	r := gcp.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ResponsePolicyGroupVersionKind),
+		&responsePolicyConnector{kube: mgr.GetClient()},
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(poll),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.ResponsePolicyGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.ResponsePolicy{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.ResponsePolicyGroupVersionKind))).
+		Complete(r)
+}
+
+type responsePolicyConnector struct {
+	kube client.Client
+}
+
+func (c *responsePolicyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	d, err := dns.NewService(ctx, opts.For("dns")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &responsePolicyExternal{
+		kube:             c.kube,
+		responsePolicies: d.ResponsePolicies,
+		projectID:        projectID,
+	}, nil
+}
+
+type responsePolicyExternal struct {
+	kube             client.Client
+	responsePolicies *dns.ResponsePoliciesService
+	projectID        string
+}
+
+func (e *responsePolicyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ResponsePolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotResponsePolicy)
+	}
+
+	rp, err := e.responsePolicies.Get(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetResponsePolicy)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	rrsClient.LateInitializeResponsePolicy(&cr.Spec.ForProvider, *rp)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errLateInitResponsePolicy)
+		}
+	}
+
+	cr.Status.AtProvider = rrsClient.GenerateResponsePolicyObservation(*rp)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: rrsClient.IsResponsePolicyUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, rp),
+	}, nil
+}
+
+func (e *responsePolicyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ResponsePolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotResponsePolicy)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	rp := &dns.ResponsePolicy{}
+	rrsClient.GenerateResponsePolicy(meta.GetExternalName(cr), cr.Spec.ForProvider, rp)
+	_, err := e.responsePolicies.Create(e.projectID, rp).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateResponsePolicy)
+}
+
+func (e *responsePolicyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ResponsePolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotResponsePolicy)
+	}
+
+	rp := &dns.ResponsePolicy{}
+	rrsClient.GenerateResponsePolicy(meta.GetExternalName(cr), cr.Spec.ForProvider, rp)
+	_, err := e.responsePolicies.Patch(e.projectID, meta.GetExternalName(cr), rp).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateResponsePolicy)
+}
+
+func (e *responsePolicyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ResponsePolicy)
+	if !ok {
+		return errors.New(errNotResponsePolicy)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	err := e.responsePolicies.Delete(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteResponsePolicy)
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+	"time"
+
+	dns "google.golang.org/api/dns/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/dns/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	rrsClient "github.com/crossplane/provider-gcp/pkg/clients/dns"
+)
+
+const (
+	errNotResponsePolicyRule    = "managed resource is not a ResponsePolicyRule custom resource"
+	errGetResponsePolicyRule    = "cannot get the ResponsePolicyRule"
+	errCreateResponsePolicyRule = "cannot create the ResponsePolicyRule"
+	errUpdateResponsePolicyRule = "cannot update the ResponsePolicyRule"
+	errDeleteResponsePolicyRule = "cannot delete the ResponsePolicyRule"
+)
+
+// SetupResponsePolicyRule adds a controller that reconciles
+// ResponsePolicyRule managed resources.
+func SetupResponsePolicyRule(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.ResponsePolicyRuleGroupKind)
+
+	r := gcp.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ResponsePolicyRuleGroupVersionKind),
+		&responsePolicyRuleConnector{kube: mgr.GetClient()},
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(poll),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.ResponsePolicyRuleGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.ResponsePolicyRule{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.ResponsePolicyRuleGroupVersionKind))).
+		Complete(r)
+}
+
+type responsePolicyRuleConnector struct {
+	kube client.Client
+}
+
+func (c *responsePolicyRuleConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	d, err := dns.NewService(ctx, opts.For("dns")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &responsePolicyRuleExternal{
+		rules:     d.ResponsePolicyRules,
+		projectID: projectID,
+	}, nil
+}
+
+type responsePolicyRuleExternal struct {
+	rules     *dns.ResponsePolicyRulesService
+	projectID string
+}
+
+func (e *responsePolicyRuleExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ResponsePolicyRule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotResponsePolicyRule)
+	}
+
+	r, err := e.rules.Get(e.projectID, cr.Spec.ForProvider.ResponsePolicy, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetResponsePolicyRule)
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: rrsClient.IsResponsePolicyRuleUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, r),
+	}, nil
+}
+
+func (e *responsePolicyRuleExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ResponsePolicyRule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotResponsePolicyRule)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	r := &dns.ResponsePolicyRule{}
+	rrsClient.GenerateResponsePolicyRule(meta.GetExternalName(cr), cr.Spec.ForProvider, r)
+	_, err := e.rules.Create(e.projectID, cr.Spec.ForProvider.ResponsePolicy, r).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateResponsePolicyRule)
+}
+
+func (e *responsePolicyRuleExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ResponsePolicyRule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotResponsePolicyRule)
+	}
+
+	r := &dns.ResponsePolicyRule{}
+	rrsClient.GenerateResponsePolicyRule(meta.GetExternalName(cr), cr.Spec.ForProvider, r)
+	_, err := e.rules.Update(e.projectID, cr.Spec.ForProvider.ResponsePolicy, meta.GetExternalName(cr), r).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateResponsePolicyRule)
+}
+
+func (e *responsePolicyRuleExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ResponsePolicyRule)
+	if !ok {
+		return errors.New(errNotResponsePolicyRule)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	err := e.rules.Delete(e.projectID, cr.Spec.ForProvider.ResponsePolicy, meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteResponsePolicyRule)
+}
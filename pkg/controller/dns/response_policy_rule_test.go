@@ -0,0 +1,324 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	dns "google.golang.org/api/dns/v1"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/dns/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const ruleName = "example-rule"
+
+type responsePolicyRuleOption func(*v1alpha1.ResponsePolicyRule)
+
+func newResponsePolicyRule(opts ...responsePolicyRuleOption) *v1alpha1.ResponsePolicyRule {
+	r := &v1alpha1.ResponsePolicyRule{
+		Spec: v1alpha1.ResponsePolicyRuleSpec{
+			ForProvider: v1alpha1.ResponsePolicyRuleParameters{
+				ResponsePolicy: responsePolicyName,
+				DNSName:        "www.example.com.",
+				Behavior:       gcp.StringPtr(v1alpha1.ResponsePolicyRuleBehaviorBypass),
+			},
+		},
+	}
+	meta.SetExternalName(r, ruleName)
+	for _, f := range opts {
+		f(r)
+	}
+	return r
+}
+
+func withResponsePolicyRuleConditions(c ...xpv1.Condition) responsePolicyRuleOption {
+	return func(r *v1alpha1.ResponsePolicyRule) {
+		r.Status.SetConditions(c...)
+	}
+}
+
+func observedResponsePolicyRule() *dns.ResponsePolicyRule {
+	return &dns.ResponsePolicyRule{
+		RuleName: ruleName,
+		DnsName:  "www.example.com.",
+		Behavior: v1alpha1.ResponsePolicyRuleBehaviorBypass,
+	}
+}
+
+func TestResponsePolicyRuleObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotResponsePolicyRule": {
+			mg: unexpectedObject,
+			want: want{
+				mg:  unexpectedObject,
+				err: errors.New(errNotResponsePolicyRule),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&dns.ResponsePolicyRule{})
+			}),
+			mg: newResponsePolicyRule(),
+			want: want{
+				mg: newResponsePolicyRule(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&dns.ResponsePolicyRule{})
+			}),
+			mg: newResponsePolicyRule(),
+			want: want{
+				mg:  newResponsePolicyRule(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetResponsePolicyRule),
+			},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(observedResponsePolicyRule())
+			}),
+			mg: newResponsePolicyRule(),
+			want: want{
+				mg:  newResponsePolicyRule(withResponsePolicyRuleConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotUpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				rule := observedResponsePolicyRule()
+				rule.DnsName = "api.example.com."
+				_ = json.NewEncoder(w).Encode(rule)
+			}),
+			mg: newResponsePolicyRule(),
+			want: want{
+				mg:  newResponsePolicyRule(withResponsePolicyRuleConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dns.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := responsePolicyRuleExternal{
+				projectID: projectID,
+				rules:     s.ResponsePolicyRules,
+			}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResponsePolicyRuleCreate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"NotResponsePolicyRule": {
+			mg:  unexpectedObject,
+			err: errors.New(errNotResponsePolicyRule),
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				rule := &dns.ResponsePolicyRule{}
+				_ = json.NewDecoder(r.Body).Decode(rule)
+				_ = r.Body.Close()
+				if diff := cmp.Diff(observedResponsePolicyRule(), rule); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(rule)
+			}),
+			mg: newResponsePolicyRule(),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&dns.ResponsePolicyRule{})
+			}),
+			mg:  newResponsePolicyRule(),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateResponsePolicyRule),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dns.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := responsePolicyRuleExternal{
+				projectID: projectID,
+				rules:     s.ResponsePolicyRules,
+			}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResponsePolicyRuleUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"NotResponsePolicyRule": {
+			mg:  unexpectedObject,
+			err: errors.New(errNotResponsePolicyRule),
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&dns.ResponsePolicyRulesUpdateResponse{})
+			}),
+			mg: newResponsePolicyRule(),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&dns.ResponsePolicyRulesUpdateResponse{})
+			}),
+			mg:  newResponsePolicyRule(),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateResponsePolicyRule),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dns.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := responsePolicyRuleExternal{
+				projectID: projectID,
+				rules:     s.ResponsePolicyRules,
+			}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResponsePolicyRuleDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"NotResponsePolicyRule": {
+			mg:  unexpectedObject,
+			err: errors.New(errNotResponsePolicyRule),
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}),
+			mg: newResponsePolicyRule(),
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: newResponsePolicyRule(),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&dns.ResponsePolicyRule{})
+			}),
+			mg:  newResponsePolicyRule(),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteResponsePolicyRule),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dns.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := responsePolicyRuleExternal{
+				projectID: projectID,
+				rules:     s.ResponsePolicyRules,
+			}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
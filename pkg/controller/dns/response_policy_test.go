@@ -0,0 +1,373 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	dns "google.golang.org/api/dns/v1"
+	"google.golang.org/api/option"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/dns/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const responsePolicyName = "example-response-policy"
+
+type responsePolicyOption func(*v1alpha1.ResponsePolicy)
+
+func newResponsePolicy(opts ...responsePolicyOption) *v1alpha1.ResponsePolicy {
+	rp := &v1alpha1.ResponsePolicy{
+		Spec: v1alpha1.ResponsePolicySpec{
+			ForProvider: v1alpha1.ResponsePolicyParameters{
+				Description: gcp.StringPtr("Managed by Crossplane"),
+			},
+		},
+	}
+	meta.SetExternalName(rp, responsePolicyName)
+	for _, f := range opts {
+		f(rp)
+	}
+	return rp
+}
+
+func withResponsePolicyObservation(o v1alpha1.ResponsePolicyObservation) responsePolicyOption {
+	return func(rp *v1alpha1.ResponsePolicy) {
+		rp.Status.AtProvider = o
+	}
+}
+
+func withResponsePolicyConditions(c ...xpv1.Condition) responsePolicyOption {
+	return func(rp *v1alpha1.ResponsePolicy) {
+		rp.Status.SetConditions(c...)
+	}
+}
+
+func observedResponsePolicy() *dns.ResponsePolicy {
+	return &dns.ResponsePolicy{
+		ResponsePolicyName: responsePolicyName,
+		Description:        "Managed by Crossplane",
+		Id:                 1234,
+	}
+}
+
+func TestResponsePolicyObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotResponsePolicy": {
+			mg: unexpectedObject,
+			want: want{
+				mg:  unexpectedObject,
+				err: errors.New(errNotResponsePolicy),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&dns.ResponsePolicy{})
+			}),
+			mg: newResponsePolicy(),
+			want: want{
+				mg: newResponsePolicy(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&dns.ResponsePolicy{})
+			}),
+			mg: newResponsePolicy(),
+			want: want{
+				mg:  newResponsePolicy(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetResponsePolicy),
+			},
+		},
+		"LateInitFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				rp := observedResponsePolicy()
+				rp.Labels = map[string]string{"team": "dns"}
+				_ = json.NewEncoder(w).Encode(rp)
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:   newResponsePolicy(),
+			want: want{
+				mg: newResponsePolicy(func(rp *v1alpha1.ResponsePolicy) {
+					rp.Spec.ForProvider.Labels = map[string]string{"team": "dns"}
+				}),
+				err: errors.Wrap(errBoom, errLateInitResponsePolicy),
+			},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(observedResponsePolicy())
+			}),
+			mg: newResponsePolicy(),
+			want: want{
+				mg: newResponsePolicy(
+					withResponsePolicyObservation(v1alpha1.ResponsePolicyObservation{ID: 1234}),
+					withResponsePolicyConditions(xpv1.Available()),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotUpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(observedResponsePolicy())
+			}),
+			mg: newResponsePolicy(func(rp *v1alpha1.ResponsePolicy) {
+				rp.Spec.ForProvider.Description = gcp.StringPtr("Something else")
+			}),
+			want: want{
+				mg: newResponsePolicy(
+					func(rp *v1alpha1.ResponsePolicy) {
+						rp.Spec.ForProvider.Description = gcp.StringPtr("Something else")
+					},
+					withResponsePolicyObservation(v1alpha1.ResponsePolicyObservation{ID: 1234}),
+					withResponsePolicyConditions(xpv1.Available()),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dns.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := responsePolicyExternal{
+				kube:             tc.kube,
+				projectID:        projectID,
+				responsePolicies: s.ResponsePolicies,
+			}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResponsePolicyCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotResponsePolicy": {
+			mg: unexpectedObject,
+			want: want{
+				mg:  unexpectedObject,
+				err: errors.New(errNotResponsePolicy),
+			},
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				rp := &dns.ResponsePolicy{}
+				_ = json.NewDecoder(r.Body).Decode(rp)
+				_ = r.Body.Close()
+				if diff := cmp.Diff(responsePolicyName, rp.ResponsePolicyName); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(rp)
+			}),
+			mg: newResponsePolicy(),
+			want: want{
+				mg: newResponsePolicy(withResponsePolicyConditions(xpv1.Creating())),
+			},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&dns.ResponsePolicy{})
+			}),
+			mg: newResponsePolicy(),
+			want: want{
+				mg:  newResponsePolicy(withResponsePolicyConditions(xpv1.Creating())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateResponsePolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dns.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := responsePolicyExternal{
+				projectID:        projectID,
+				responsePolicies: s.ResponsePolicies,
+			}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResponsePolicyUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"NotResponsePolicy": {
+			mg:  unexpectedObject,
+			err: errors.New(errNotResponsePolicy),
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&dns.Operation{})
+			}),
+			mg: newResponsePolicy(),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&dns.Operation{})
+			}),
+			mg:  newResponsePolicy(),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateResponsePolicy),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dns.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := responsePolicyExternal{
+				projectID:        projectID,
+				responsePolicies: s.ResponsePolicies,
+			}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResponsePolicyDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"NotResponsePolicy": {
+			mg:  unexpectedObject,
+			err: errors.New(errNotResponsePolicy),
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}),
+			mg: newResponsePolicy(),
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: newResponsePolicy(),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&dns.ResponsePolicy{})
+			}),
+			mg:  newResponsePolicy(),
+			err: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteResponsePolicy),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := dns.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := responsePolicyExternal{
+				projectID:        projectID,
+				responsePolicies: s.ResponsePolicies,
+			}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
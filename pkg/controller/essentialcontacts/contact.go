@@ -0,0 +1,167 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package essentialcontacts
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	essentialcontacts "google.golang.org/api/essentialcontacts/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/essentialcontacts/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	ecclient "github.com/crossplane/provider-gcp/pkg/clients/essentialcontacts"
+)
+
+// Error strings.
+const (
+	errNewClient       = "cannot create new Essential Contacts client"
+	errNotContact      = "managed resource is not a Contact"
+	errGetContact      = "cannot get Contact"
+	errCreateContact   = "cannot create Contact"
+	errUpdateContact   = "cannot update Contact"
+	errDeleteContact   = "cannot delete Contact"
+	errUpdateContactCR = "cannot update Contact custom resource"
+)
+
+// SetupContact adds a controller that reconciles Contacts.
+func SetupContact(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.ContactGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.ContactGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Contact{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.ContactGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ContactGroupVersionKind),
+			&contactConnector{kube: mgr.GetClient()},
+			managed.WithInitializers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type contactConnector struct {
+	kube client.Client
+}
+
+func (c *contactConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := essentialcontacts.NewService(ctx, opts.For("essentialcontacts")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	// The project, folder and organization contact services only differ in
+	// the parents they are documented for. Their requests are built from the
+	// full parent or contact name, so the project service serves all of them.
+	return &contactExternal{kube: c.kube, contacts: s.Projects.Contacts, projectID: projectID}, nil
+}
+
+type contactExternal struct {
+	kube      client.Client
+	contacts  *essentialcontacts.ProjectsContactsService
+	projectID string
+}
+
+func (e *contactExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Contact)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotContact)
+	}
+	// Contact IDs are assigned by Google Cloud, so until we've created the
+	// contact we have nothing to look up.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	c, err := e.contacts.Get(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetContact)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	ecclient.LateInitializeContact(&cr.Spec.ForProvider, *c)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateContactCR)
+		}
+	}
+	cr.Status.AtProvider = ecclient.GenerateContactObservation(*c)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ecclient.IsContactUpToDate(cr.Spec.ForProvider, *c),
+	}, nil
+}
+
+func (e *contactExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Contact)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotContact)
+	}
+	cr.SetConditions(xpv1.Creating())
+	parent := ecclient.GetParent(e.projectID, cr.Spec.ForProvider)
+	c, err := e.contacts.Create(parent, ecclient.GenerateContact(cr.Spec.ForProvider)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateContact)
+	}
+	meta.SetExternalName(cr, ecclient.GetContactID(c.Name))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *contactExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Contact)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotContact)
+	}
+	_, err := e.contacts.Patch(e.name(cr), ecclient.GenerateContact(cr.Spec.ForProvider)).UpdateMask(ecclient.ContactUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateContact)
+}
+
+func (e *contactExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Contact)
+	if !ok {
+		return errors.New(errNotContact)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.contacts.Delete(e.name(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteContact)
+}
+
+func (e *contactExternal) name(cr *v1alpha1.Contact) string {
+	return ecclient.GetContactName(ecclient.GetParent(e.projectID, cr.Spec.ForProvider), meta.GetExternalName(cr))
+}
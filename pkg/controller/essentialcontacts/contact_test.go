@@ -0,0 +1,347 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package essentialcontacts
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	essentialcontacts "google.golang.org/api/essentialcontacts/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/essentialcontacts/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID   = "myproject-id-1234"
+	contactID   = "42"
+	contactPath = "/v1/projects/myproject-id-1234/contacts/42"
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newContact(m ...func(*v1alpha1.Contact)) *v1alpha1.Contact {
+	cr := &v1alpha1.Contact{}
+	meta.SetExternalName(cr, contactID)
+	cr.Spec.ForProvider = v1alpha1.ContactParameters{
+		Email:                             "security@example.com",
+		NotificationCategorySubscriptions: []string{v1alpha1.NotificationCategorySecurity},
+		LanguageTag:                       gcp.StringPtr("en"),
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func contact(m ...func(*essentialcontacts.GoogleCloudEssentialcontactsV1Contact)) *essentialcontacts.GoogleCloudEssentialcontactsV1Contact {
+	c := &essentialcontacts.GoogleCloudEssentialcontactsV1Contact{
+		Name:                              "projects/1234567890/contacts/42",
+		Email:                             "security@example.com",
+		NotificationCategorySubscriptions: []string{"SECURITY"},
+		LanguageTag:                       "en",
+		ValidationState:                   "VALID",
+	}
+	for _, f := range m {
+		f(c)
+	}
+	return c
+}
+
+func TestContactObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		kube    *test.MockClient
+		mg      resource.Managed
+		want    want
+	}{
+		"NotContact": {
+			reason: "Should return an error if the resource is not a Contact",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotContact)},
+		},
+		"NotYetCreated": {
+			reason: "Should report a contact without external name as missing",
+			mg:     newContact(func(cr *v1alpha1.Contact) { meta.SetExternalName(cr, "") }),
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			mg:     newContact(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(contactPath, r.URL.Path); diff != "" {
+					t.Errorf("path: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			mg:     newContact(),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetContact)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if the late initialization update fails",
+			mg:     newContact(func(cr *v1alpha1.Contact) { cr.Spec.ForProvider.LanguageTag = nil }),
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			want:   want{err: errors.Wrap(errBoom, errUpdateContactCR)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(contact())
+			}),
+		},
+		"ResourceUpToDate": {
+			reason: "Should return upToDate as true if the resource is up to date",
+			mg:     newContact(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(contact())
+			}),
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should return upToDate as false if the resource is not up to date",
+			mg:     newContact(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(contact(func(c *essentialcontacts.GoogleCloudEssentialcontactsV1Contact) {
+					c.NotificationCategorySubscriptions = []string{"ALL"}
+				}))
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := essentialcontacts.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := contactExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				contacts:  s.Projects.Contacts,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestContactCreate(t *testing.T) {
+	type want struct {
+		e            managed.ExternalCreation
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		status int
+		mg     resource.Managed
+		want   want
+	}{
+		"NotContact": {
+			reason: "Should return an error if the resource is not a Contact",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotContact)},
+		},
+		"Successful": {
+			reason: "Should record the ID of the created contact",
+			status: http.StatusOK,
+			mg:     newContact(func(cr *v1alpha1.Contact) { meta.SetExternalName(cr, "") }),
+			want: want{
+				e:            managed.ExternalCreation{ExternalNameAssigned: true},
+				externalName: contactID,
+			},
+		},
+		"Failed": {
+			reason: "Should fail if the resource creation returns an error",
+			status: http.StatusBadRequest,
+			mg:     newContact(func(cr *v1alpha1.Contact) { meta.SetExternalName(cr, "") }),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateContact)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff("/v1/projects/myproject-id-1234/contacts", r.URL.Path); diff != "" {
+					t.Errorf("path: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.status != http.StatusOK {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(contact())
+			}))
+			defer server.Close()
+			s, _ := essentialcontacts.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := contactExternal{
+				projectID: projectID,
+				contacts:  s.Projects.Contacts,
+			}
+			got, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Contact); ok {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+					t.Errorf("\n%s\nCreate(...): -want external name, +got external name:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestContactUpdateDelete(t *testing.T) {
+	update := func(e *contactExternal, mg resource.Managed) error {
+		_, err := e.Update(context.Background(), mg)
+		return err
+	}
+	del := func(e *contactExternal, mg resource.Managed) error {
+		return e.Delete(context.Background(), mg)
+	}
+
+	cases := map[string]struct {
+		reason  string
+		method  string
+		status  int
+		call    func(e *contactExternal, mg resource.Managed) error
+		mg      resource.Managed
+		wantErr error
+	}{
+		"UpdateNotContact": {
+			reason:  "Should return an error if the resource is not a Contact",
+			call:    update,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotContact),
+		},
+		"UpdateSuccessful": {
+			reason: "Should succeed if the resource update doesn't return an error",
+			method: http.MethodPatch,
+			status: http.StatusOK,
+			call:   update,
+			mg:     newContact(),
+		},
+		"UpdateFailed": {
+			reason:  "Should fail if the resource update returns an error",
+			method:  http.MethodPatch,
+			status:  http.StatusBadRequest,
+			call:    update,
+			mg:      newContact(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateContact),
+		},
+		"DeleteNotContact": {
+			reason:  "Should return an error if the resource is not a Contact",
+			call:    del,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotContact),
+		},
+		"DeleteAlreadyGone": {
+			reason: "Should not return an error if the resource is already gone",
+			method: http.MethodDelete,
+			status: http.StatusNotFound,
+			call:   del,
+			mg:     newContact(),
+		},
+		"DeleteFailed": {
+			reason:  "Should fail if the resource deletion returns an error",
+			method:  http.MethodDelete,
+			status:  http.StatusBadRequest,
+			call:    del,
+			mg:      newContact(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteContact),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(contactPath, r.URL.Path); diff != "" {
+					t.Errorf("path: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := essentialcontacts.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &contactExternal{
+				projectID: projectID,
+				contacts:  s.Projects.Contacts,
+			}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventarc
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	eventarc "google.golang.org/api/eventarc/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/eventarc/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	triggerclient "github.com/crossplane/provider-gcp/pkg/clients/eventarc"
+)
+
+// Error strings.
+const (
+	errNewClient       = "cannot create new Eventarc client"
+	errNotTrigger      = "managed resource is not an Eventarc Trigger"
+	errGetTrigger      = "cannot get Eventarc Trigger"
+	errCreateTrigger   = "cannot create Eventarc Trigger"
+	errUpdateTrigger   = "cannot update Eventarc Trigger"
+	errDeleteTrigger   = "cannot delete Eventarc Trigger"
+	errUpdateTriggerCR = "cannot update Eventarc Trigger custom resource"
+	errCheckUpToDate   = "cannot determine if Eventarc Trigger is up to date"
+)
+
+// SetupTrigger adds a controller that reconciles Eventarc Triggers.
+func SetupTrigger(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.TriggerGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.TriggerGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Trigger{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.TriggerGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.TriggerGroupVersionKind),
+			&triggerConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type triggerConnector struct {
+	kube client.Client
+}
+
+func (c *triggerConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := eventarc.NewService(ctx, opts.For("eventarc")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &triggerExternal{kube: c.kube, triggers: s.Projects.Locations.Triggers, projectID: projectID}, nil
+}
+
+type triggerExternal struct {
+	kube      client.Client
+	triggers  *eventarc.ProjectsLocationsTriggersService
+	projectID string
+}
+
+func (e *triggerExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Trigger)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTrigger)
+	}
+	fqn := triggerclient.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+	existing, err := e.triggers.Get(fqn).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetTrigger)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	triggerclient.LateInitializeSpec(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateTriggerCR)
+		}
+	}
+	cr.Status.AtProvider = triggerclient.GenerateObservation(*existing)
+	// Triggers have no lifecycle state, but report problems with their
+	// destination or transport as conditions.
+	if triggerclient.IsHealthy(cr.Status.AtProvider) {
+		cr.Status.SetConditions(xpv1.Available())
+	} else {
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+	upToDate, err := triggerclient.IsUpToDate(fqn, &cr.Spec.ForProvider, existing)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckUpToDate)
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *triggerExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Trigger)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTrigger)
+	}
+	t := &eventarc.Trigger{}
+	triggerclient.GenerateTrigger(triggerclient.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr)), cr.Spec.ForProvider, t)
+	_, err := e.triggers.Create(triggerclient.GetFullyQualifiedParent(e.projectID, cr.Spec.ForProvider), t).TriggerId(meta.GetExternalName(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateTrigger)
+}
+
+func (e *triggerExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Trigger)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotTrigger)
+	}
+	fqn := triggerclient.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+	t := &eventarc.Trigger{}
+	triggerclient.GenerateTrigger(fqn, cr.Spec.ForProvider, t)
+	_, err := e.triggers.Patch(fqn, t).UpdateMask(triggerclient.UpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTrigger)
+}
+
+func (e *triggerExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Trigger)
+	if !ok {
+		return errors.New(errNotTrigger)
+	}
+	_, err := e.triggers.Delete(triggerclient.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteTrigger)
+}
@@ -0,0 +1,282 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventarc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	eventarc "google.golang.org/api/eventarc/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/eventarc/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID   = "myproject-id-1234"
+	triggerName = "projects/myproject-id-1234/locations/europe-west1/triggers/my-trigger"
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newTrigger() *v1alpha1.Trigger {
+	t := &v1alpha1.Trigger{}
+	meta.SetExternalName(t, "my-trigger")
+	t.Spec.ForProvider = v1alpha1.TriggerParameters{
+		Location:     "europe-west1",
+		EventFilters: []v1alpha1.EventFilter{{Attribute: "type", Value: "google.cloud.pubsub.topic.v1.messagePublished"}},
+		Destination: v1alpha1.Destination{
+			CloudRun: &v1alpha1.CloudRunDestination{Service: gcp.StringPtr("my-service"), Region: "europe-west1"},
+		},
+		ServiceAccount:       gcp.StringPtr("trigger@myproject-id-1234.iam.gserviceaccount.com"),
+		EventDataContentType: gcp.StringPtr("application/json"),
+	}
+	return t
+}
+
+func observedTrigger() *eventarc.Trigger {
+	return &eventarc.Trigger{
+		Name:                 triggerName,
+		EventFilters:         []*eventarc.EventFilter{{Attribute: "type", Value: "google.cloud.pubsub.topic.v1.messagePublished"}},
+		Destination:          &eventarc.Destination{CloudRun: &eventarc.CloudRun{Service: "my-service", Region: "europe-west1"}},
+		ServiceAccount:       "trigger@myproject-id-1234.iam.gserviceaccount.com",
+		EventDataContentType: "application/json",
+		Transport:            &eventarc.Transport{Pubsub: &eventarc.Pubsub{Topic: "projects/myproject-id-1234/topics/eventarc-europe-west1-my-trigger-123"}},
+	}
+}
+
+func TestTriggerObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotTrigger": {
+			reason: "Should return an error if the resource is not a Trigger",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotTrigger)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			mg:     newTrigger(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&eventarc.Trigger{})
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			mg:     newTrigger(),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetTrigger)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(&eventarc.Trigger{})
+			}),
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if the late initialization update fails",
+			mg:     newTrigger(),
+			want:   want{err: errors.Wrap(errBoom, errUpdateTriggerCR)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				tr := observedTrigger()
+				tr.Labels = map[string]string{"goog-managed": "true"}
+				_ = json.NewEncoder(w).Encode(tr)
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should return upToDate as true if the resource is up to date",
+			mg:     newTrigger(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedTrigger())
+			}),
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should return upToDate as false if the resource is not up to date",
+			mg:     newTrigger(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				tr := observedTrigger()
+				tr.Destination.CloudRun.Path = "/events"
+				_ = json.NewEncoder(w).Encode(tr)
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := eventarc.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := triggerExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				triggers:  s.Projects.Locations.Triggers,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func createTrigger(e *triggerExternal, mg resource.Managed) error {
+	_, err := e.Create(context.Background(), mg)
+	return err
+}
+
+func updateTrigger(e *triggerExternal, mg resource.Managed) error {
+	_, err := e.Update(context.Background(), mg)
+	return err
+}
+
+func deleteTrigger(e *triggerExternal, mg resource.Managed) error {
+	return e.Delete(context.Background(), mg)
+}
+
+func TestTriggerCreateUpdateDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		method  string
+		status  int
+		call    func(e *triggerExternal, mg resource.Managed) error
+		mg      resource.Managed
+		wantErr error
+	}{
+		"CreateNotTrigger": {
+			reason:  "Should return an error if the resource is not a Trigger",
+			call:    createTrigger,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotTrigger),
+		},
+		"CreateSuccessful": {
+			reason: "Should succeed if the resource creation doesn't return an error",
+			method: http.MethodPost,
+			status: http.StatusOK,
+			call:   createTrigger,
+			mg:     newTrigger(),
+		},
+		"CreateFailed": {
+			reason:  "Should fail if the resource creation returns an error",
+			method:  http.MethodPost,
+			status:  http.StatusBadRequest,
+			call:    createTrigger,
+			mg:      newTrigger(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateTrigger),
+		},
+		"UpdateSuccessful": {
+			reason: "Should succeed if the resource update doesn't return an error",
+			method: http.MethodPatch,
+			status: http.StatusOK,
+			call:   updateTrigger,
+			mg:     newTrigger(),
+		},
+		"UpdateFailed": {
+			reason:  "Should fail if the resource update returns an error",
+			method:  http.MethodPatch,
+			status:  http.StatusBadRequest,
+			call:    updateTrigger,
+			mg:      newTrigger(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateTrigger),
+		},
+		"DeleteAlreadyGone": {
+			reason: "Should not return an error if the resource is already gone",
+			method: http.MethodDelete,
+			status: http.StatusNotFound,
+			call:   deleteTrigger,
+			mg:     newTrigger(),
+		},
+		"DeleteFailed": {
+			reason:  "Should fail if the resource deletion returns an error",
+			method:  http.MethodDelete,
+			status:  http.StatusBadRequest,
+			call:    deleteTrigger,
+			mg:      newTrigger(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteTrigger),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&eventarc.GoogleLongrunningOperation{})
+			}))
+			defer server.Close()
+			s, _ := eventarc.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &triggerExternal{
+				projectID: projectID,
+				triggers:  s.Projects.Locations.Triggers,
+			}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
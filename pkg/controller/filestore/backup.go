@@ -0,0 +1,163 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filestore
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	file "google.golang.org/api/file/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/filestore/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	fsclient "github.com/crossplane/provider-gcp/pkg/clients/filestore"
+)
+
+// Error strings.
+const (
+	errNotBackup      = "managed resource is not a Filestore Backup"
+	errGetBackup      = "cannot get Filestore backup"
+	errCreateBackup   = "cannot create Filestore backup"
+	errUpdateBackup   = "cannot update Filestore backup"
+	errDeleteBackup   = "cannot delete Filestore backup"
+	errUpdateBackupCR = "cannot update Filestore Backup custom resource"
+)
+
+// SetupBackup adds a controller that reconciles Filestore Backups.
+func SetupBackup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.BackupGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.BackupGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Backup{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.BackupGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.BackupGroupVersionKind),
+			&backupConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type backupConnector struct {
+	kube client.Client
+}
+
+func (c *backupConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := file.NewService(ctx, opts.For("file")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &backupExternal{kube: c.kube, backups: s.Projects.Locations.Backups, projectID: projectID}, nil
+}
+
+type backupExternal struct {
+	kube      client.Client
+	backups   *file.ProjectsLocationsBackupsService
+	projectID string
+}
+
+func (e *backupExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Backup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBackup)
+	}
+	existing, err := e.backups.Get(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetBackup)
+	}
+	current := cr.Spec.ForProvider.DeepCopy()
+	fsclient.LateInitializeBackup(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateBackupCR)
+		}
+	}
+	cr.Status.AtProvider = fsclient.GenerateBackupObservation(*existing)
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.BackupStateReady:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1alpha1.BackupStateCreating, v1alpha1.BackupStateFinalizing:
+		cr.Status.SetConditions(xpv1.Creating())
+	case v1alpha1.BackupStateDeleting:
+		cr.Status.SetConditions(xpv1.Deleting())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: fsclient.IsBackupUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *backupExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Backup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBackup)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.backups.Create(fsclient.GetBackupParent(e.projectID, cr.Spec.ForProvider), fsclient.GenerateBackup(cr.Spec.ForProvider)).
+		BackupId(meta.GetExternalName(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateBackup)
+}
+
+func (e *backupExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Backup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBackup)
+	}
+	_, err := e.backups.Patch(e.name(cr), fsclient.GenerateBackup(cr.Spec.ForProvider)).
+		UpdateMask(fsclient.BackupUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateBackup)
+}
+
+func (e *backupExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Backup)
+	if !ok {
+		return errors.New(errNotBackup)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.backups.Delete(e.name(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteBackup)
+}
+
+func (e *backupExternal) name(cr *v1alpha1.Backup) string {
+	return fsclient.GetBackupName(fsclient.GetBackupParent(e.projectID, cr.Spec.ForProvider), meta.GetExternalName(cr))
+}
@@ -0,0 +1,276 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filestore
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	file "google.golang.org/api/file/v1"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/filestore/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	backupName = "projects/myproject-id-1234/locations/us-central1/backups/nightly"
+	backupPath = "/v1/" + backupName
+)
+
+func newBackup(m ...func(*v1alpha1.Backup)) *v1alpha1.Backup {
+	cr := &v1alpha1.Backup{}
+	meta.SetExternalName(cr, "nightly")
+	cr.Spec.ForProvider = v1alpha1.BackupParameters{
+		Location:        "us-central1",
+		Description:     gcp.StringPtr("Nightly backup"),
+		SourceInstance:  gcp.StringPtr(instanceName),
+		SourceFileShare: "vol1",
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func backup(m ...func(*file.Backup)) *file.Backup {
+	b := &file.Backup{
+		Name:            backupName,
+		State:           v1alpha1.BackupStateReady,
+		Description:     "Nightly backup",
+		SourceInstance:  instanceName,
+		SourceFileShare: "vol1",
+		CapacityGb:      1024,
+	}
+	for _, f := range m {
+		f(b)
+	}
+	return b
+}
+
+func TestBackupObserve(t *testing.T) {
+	type want struct {
+		e    managed.ExternalObservation
+		cond xpv1.Condition
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason string
+		status int
+		backup *file.Backup
+		kube   *test.MockClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotBackup": {
+			reason: "Should return an error if the resource is not a Backup",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotBackup)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the backup does not exist",
+			status: http.StatusNotFound,
+			mg:     newBackup(),
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the backup fails",
+			status: http.StatusBadRequest,
+			mg:     newBackup(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetBackup)},
+		},
+		"LateInitFailed": {
+			reason: "Should return an error if the late initialized spec can't be saved",
+			status: http.StatusOK,
+			backup: backup(),
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:     newBackup(func(cr *v1alpha1.Backup) { cr.Spec.ForProvider.Description = nil }),
+			want:   want{err: errors.Wrap(errBoom, errUpdateBackupCR)},
+		},
+		"Ready": {
+			reason: "Should report a ready, up to date backup as available",
+			status: http.StatusOK,
+			backup: backup(),
+			mg:     newBackup(),
+			want: want{
+				e:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Available(),
+			},
+		},
+		"Finalizing": {
+			reason: "Should report a backup that is still being taken as creating",
+			status: http.StatusOK,
+			backup: backup(func(b *file.Backup) { b.State = v1alpha1.BackupStateFinalizing }),
+			mg:     newBackup(),
+			want: want{
+				e:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Creating(),
+			},
+		},
+		"NeedsUpdate": {
+			reason: "Should return upToDate as false if the description differs",
+			status: http.StatusOK,
+			backup: backup(func(b *file.Backup) { b.Description = "Weekly backup" }),
+			mg:     newBackup(),
+			want: want{
+				e:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				cond: xpv1.Available(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet+" "+backupPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.backup == nil {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.backup)
+			}))
+			defer server.Close()
+			s, _ := file.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &backupExternal{kube: tc.kube, backups: s.Projects.Locations.Backups, projectID: projectID}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Backup); ok && got.ResourceExists {
+				if diff := cmp.Diff(tc.want.cond, cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want condition, +got condition:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestBackupWrite(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		method string
+		path   string
+		query  string
+		status int
+		call   func(*backupExternal, resource.Managed) error
+		want   error
+	}{
+		"CreateSuccessful": {
+			reason: "Should create the backup with the external name as ID",
+			method: http.MethodPost,
+			path:   "/v1/projects/myproject-id-1234/locations/us-central1/backups",
+			query:  "nightly",
+			status: http.StatusOK,
+			call: func(e *backupExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+		},
+		"CreateFailed": {
+			reason: "Should return an error if creating the backup fails",
+			method: http.MethodPost,
+			path:   "/v1/projects/myproject-id-1234/locations/us-central1/backups",
+			query:  "nightly",
+			status: http.StatusBadRequest,
+			call: func(e *backupExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateBackup),
+		},
+		"UpdateSuccessful": {
+			reason: "Should patch the backup",
+			method: http.MethodPatch,
+			path:   backupPath,
+			status: http.StatusOK,
+			call: func(e *backupExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+		},
+		"UpdateFailed": {
+			reason: "Should return an error if patching the backup fails",
+			method: http.MethodPatch,
+			path:   backupPath,
+			status: http.StatusBadRequest,
+			call: func(e *backupExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateBackup),
+		},
+		"DeleteNotFound": {
+			reason: "Should not return an error if the backup is already gone",
+			method: http.MethodDelete,
+			path:   backupPath,
+			status: http.StatusNotFound,
+			call: func(e *backupExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+		},
+		"DeleteFailed": {
+			reason: "Should return an error if deleting the backup fails",
+			method: http.MethodDelete,
+			path:   backupPath,
+			status: http.StatusBadRequest,
+			call: func(e *backupExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteBackup),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method+" "+tc.path, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.query, r.URL.Query().Get("backupId")); diff != "" {
+					t.Errorf("backupId: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := file.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			err := tc.call(&backupExternal{backups: s.Projects.Locations.Backups, projectID: projectID}, newBackup())
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
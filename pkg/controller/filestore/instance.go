@@ -0,0 +1,178 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filestore
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	file "google.golang.org/api/file/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/filestore/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	fsclient "github.com/crossplane/provider-gcp/pkg/clients/filestore"
+)
+
+// Error strings.
+const (
+	errNewClient        = "cannot create new Filestore client"
+	errNotInstance      = "managed resource is not a Filestore Instance"
+	errGetInstance      = "cannot get Filestore instance"
+	errCreateInstance   = "cannot create Filestore instance"
+	errUpdateInstance   = "cannot update Filestore instance"
+	errDeleteInstance   = "cannot delete Filestore instance"
+	errUpdateInstanceCR = "cannot update Filestore Instance custom resource"
+	errShrinkFileShare  = "cannot decrease the capacity of a file share"
+)
+
+// SetupInstance adds a controller that reconciles Filestore Instances.
+func SetupInstance(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.InstanceGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.InstanceGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Instance{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.InstanceGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.InstanceGroupVersionKind),
+			&instanceConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type instanceConnector struct {
+	kube client.Client
+}
+
+func (c *instanceConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := file.NewService(ctx, opts.For("file")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &instanceExternal{kube: c.kube, instances: s.Projects.Locations.Instances, projectID: projectID}, nil
+}
+
+type instanceExternal struct {
+	kube      client.Client
+	instances *file.ProjectsLocationsInstancesService
+	projectID string
+}
+
+func (e *instanceExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Instance)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotInstance)
+	}
+	existing, err := e.instances.Get(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetInstance)
+	}
+	current := cr.Spec.ForProvider.DeepCopy()
+	fsclient.LateInitializeInstance(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateInstanceCR)
+		}
+	}
+	cr.Status.AtProvider = fsclient.GenerateInstanceObservation(*existing)
+	conn := managed.ConnectionDetails{}
+	switch cr.Status.AtProvider.State {
+	// A repairing instance keeps serving its file share.
+	case v1alpha1.InstanceStateReady, v1alpha1.InstanceStateRepairing:
+		cr.Status.SetConditions(xpv1.Available())
+		conn = fsclient.GetInstanceConnectionDetails(cr.Spec.ForProvider, cr.Status.AtProvider)
+	case v1alpha1.InstanceStateCreating, v1alpha1.InstanceStateRestoring:
+		cr.Status.SetConditions(xpv1.Creating())
+	case v1alpha1.InstanceStateDeleting:
+		cr.Status.SetConditions(xpv1.Deleting())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  fsclient.IsInstanceUpToDate(cr.Spec.ForProvider, *existing),
+		ConnectionDetails: conn,
+	}, nil
+}
+
+func (e *instanceExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Instance)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotInstance)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.instances.Create(fsclient.GetInstanceParent(e.projectID, cr.Spec.ForProvider), fsclient.GenerateInstance(cr.Spec.ForProvider)).
+		InstanceId(meta.GetExternalName(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateInstance)
+}
+
+func (e *instanceExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Instance)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotInstance)
+	}
+	// Changes are rejected while the instance is still being worked on.
+	if cr.Status.AtProvider.State == v1alpha1.InstanceStateRepairing {
+		return managed.ExternalUpdate{}, nil
+	}
+	if cr.Spec.ForProvider.FileShare.CapacityGB < cr.Status.AtProvider.CapacityGB {
+		return managed.ExternalUpdate{}, errors.New(errShrinkFileShare)
+	}
+	i := fsclient.GenerateInstance(cr.Spec.ForProvider)
+	// The source backup is only honoured when the file share is created.
+	i.FileShares[0].SourceBackup = ""
+	_, err := e.instances.Patch(e.name(cr), i).UpdateMask(fsclient.InstanceUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateInstance)
+}
+
+func (e *instanceExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Instance)
+	if !ok {
+		return errors.New(errNotInstance)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.instances.Delete(e.name(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteInstance)
+}
+
+func (e *instanceExternal) name(cr *v1alpha1.Instance) string {
+	return fsclient.GetInstanceName(fsclient.GetInstanceParent(e.projectID, cr.Spec.ForProvider), meta.GetExternalName(cr))
+}
@@ -0,0 +1,354 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filestore
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	file "google.golang.org/api/file/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/filestore/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID    = "myproject-id-1234"
+	instanceName = "projects/myproject-id-1234/locations/us-central1-a/instances/shared"
+	instancePath = "/v1/" + instanceName
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newInstance(m ...func(*v1alpha1.Instance)) *v1alpha1.Instance {
+	cr := &v1alpha1.Instance{}
+	meta.SetExternalName(cr, "shared")
+	cr.Spec.ForProvider = v1alpha1.InstanceParameters{
+		Location:    "us-central1-a",
+		Tier:        "BASIC_HDD",
+		Description: gcp.StringPtr("Shared volume"),
+		FileShare: v1alpha1.FileShareConfig{
+			Name:       "vol1",
+			CapacityGB: 1024,
+		},
+		Networks: []v1alpha1.NetworkConfig{{
+			Network:     gcp.StringPtr("default"),
+			Modes:       []string{"MODE_IPV4"},
+			ConnectMode: gcp.StringPtr("DIRECT_PEERING"),
+		}},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func instance(m ...func(*file.Instance)) *file.Instance {
+	i := &file.Instance{
+		Name:        instanceName,
+		State:       v1alpha1.InstanceStateReady,
+		Tier:        "BASIC_HDD",
+		Description: "Shared volume",
+		FileShares:  []*file.FileShareConfig{{Name: "vol1", CapacityGb: 1024}},
+		Networks: []*file.NetworkConfig{{
+			Network:     "default",
+			Modes:       []string{"MODE_IPV4"},
+			ConnectMode: "DIRECT_PEERING",
+			IpAddresses: []string{"10.0.1.2"},
+		}},
+	}
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+func TestInstanceObserve(t *testing.T) {
+	type want struct {
+		e    managed.ExternalObservation
+		cond xpv1.Condition
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason   string
+		status   int
+		instance *file.Instance
+		kube     *test.MockClient
+		mg       resource.Managed
+		want     want
+	}{
+		"NotInstance": {
+			reason: "Should return an error if the resource is not an Instance",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotInstance)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the instance does not exist",
+			status: http.StatusNotFound,
+			mg:     newInstance(),
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the instance fails",
+			status: http.StatusBadRequest,
+			mg:     newInstance(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetInstance)},
+		},
+		"LateInitFailed": {
+			reason:   "Should return an error if the late initialized spec can't be saved",
+			status:   http.StatusOK,
+			instance: instance(),
+			kube:     &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:       newInstance(func(cr *v1alpha1.Instance) { cr.Spec.ForProvider.Description = nil }),
+			want:     want{err: errors.Wrap(errBoom, errUpdateInstanceCR)},
+		},
+		"Ready": {
+			reason:   "Should publish the mount address of a ready instance",
+			status:   http.StatusOK,
+			instance: instance(),
+			mg:       newInstance(),
+			want: want{
+				e: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte("10.0.1.2"),
+						v1alpha1.InstanceSecretMountPathKey:       []byte("/vol1"),
+					},
+				},
+				cond: xpv1.Available(),
+			},
+		},
+		"Creating": {
+			reason: "Should not publish connection details of an instance that is being created",
+			status: http.StatusOK,
+			instance: instance(func(i *file.Instance) {
+				i.State = v1alpha1.InstanceStateCreating
+				i.Networks[0].IpAddresses = nil
+			}),
+			mg: newInstance(),
+			want: want{
+				e: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				cond: xpv1.Creating(),
+			},
+		},
+		"NeedsResize": {
+			reason:   "Should return upToDate as false if the capacity of the file share differs",
+			status:   http.StatusOK,
+			instance: instance(),
+			mg:       newInstance(func(cr *v1alpha1.Instance) { cr.Spec.ForProvider.FileShare.CapacityGB = 2048 }),
+			want: want{
+				e: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte("10.0.1.2"),
+						v1alpha1.InstanceSecretMountPathKey:       []byte("/vol1"),
+					},
+				},
+				cond: xpv1.Available(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet+" "+instancePath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.instance == nil {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.instance)
+			}))
+			defer server.Close()
+			s, _ := file.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &instanceExternal{kube: tc.kube, instances: s.Projects.Locations.Instances, projectID: projectID}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Instance); ok && got.ResourceExists {
+				if diff := cmp.Diff(tc.want.cond, cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want condition, +got condition:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestInstanceWrite(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		method string
+		path   string
+		query  string
+		status int
+		mg     *v1alpha1.Instance
+		call   func(*instanceExternal, resource.Managed) error
+		want   error
+	}{
+		"CreateSuccessful": {
+			reason: "Should create the instance with the external name as ID",
+			method: http.MethodPost,
+			path:   "/v1/projects/myproject-id-1234/locations/us-central1-a/instances",
+			query:  "shared",
+			status: http.StatusOK,
+			call: func(e *instanceExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+		},
+		"CreateFailed": {
+			reason: "Should return an error if creating the instance fails",
+			method: http.MethodPost,
+			path:   "/v1/projects/myproject-id-1234/locations/us-central1-a/instances",
+			query:  "shared",
+			status: http.StatusBadRequest,
+			call: func(e *instanceExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateInstance),
+		},
+		"UpdateSuccessful": {
+			reason: "Should patch the instance",
+			method: http.MethodPatch,
+			path:   instancePath,
+			status: http.StatusOK,
+			call: func(e *instanceExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+		},
+		"UpdateFailed": {
+			reason: "Should return an error if patching the instance fails",
+			method: http.MethodPatch,
+			path:   instancePath,
+			status: http.StatusBadRequest,
+			call: func(e *instanceExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateInstance),
+		},
+		"UpdateWhileRepairing": {
+			reason: "Should not patch an instance that is being worked on",
+			mg: newInstance(func(cr *v1alpha1.Instance) {
+				cr.Status.AtProvider.State = v1alpha1.InstanceStateRepairing
+			}),
+			call: func(e *instanceExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+		},
+		"UpdateShrink": {
+			reason: "Should refuse to decrease the capacity of the file share",
+			mg: newInstance(func(cr *v1alpha1.Instance) {
+				cr.Status.AtProvider.CapacityGB = 2048
+			}),
+			call: func(e *instanceExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: errors.New(errShrinkFileShare),
+		},
+		"DeleteNotFound": {
+			reason: "Should not return an error if the instance is already gone",
+			method: http.MethodDelete,
+			path:   instancePath,
+			status: http.StatusNotFound,
+			call: func(e *instanceExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+		},
+		"DeleteFailed": {
+			reason: "Should return an error if deleting the instance fails",
+			method: http.MethodDelete,
+			path:   instancePath,
+			status: http.StatusBadRequest,
+			call: func(e *instanceExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteInstance),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if tc.method == "" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				if diff := cmp.Diff(tc.method+" "+tc.path, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.query, r.URL.Query().Get("instanceId")); diff != "" {
+					t.Errorf("instanceId: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := file.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			mg := tc.mg
+			if mg == nil {
+				mg = newInstance()
+			}
+			err := tc.call(&instanceExternal{instances: s.Projects.Locations.Instances, projectID: projectID}, mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+)
+
+// GroupProviderConfig is the name of the API group of ProviderConfigs. Its
+// controllers account for the usage of ProviderConfigs.
+const GroupProviderConfig = "gcp"
+
+const errUnknownGroup = "unknown API group %q, must be one of %s"
+
+// Groups returns the names of the API groups that have controllers, without
+// their .gcp.crossplane.io suffix, in alphabetical order.
+func Groups() []string {
+	seen := map[string]bool{}
+	groups := make([]string, 0, len(setups))
+	for _, m := range []map[string][]setupFn{setups, alphaSetups} {
+		for g := range m {
+			if !seen[g] {
+				seen[g] = true
+				groups = append(groups, g)
+			}
+		}
+	}
+	sort.Strings(groups)
+	return groups
+}
+
+// A Filter enables the controllers of some API groups, e.g. to shard the
+// controllers of a provider across deployments.
+type Filter struct {
+	allow map[string]bool
+	deny  map[string]bool
+
+	// EnableAlpha enables the experimental controllers of the enabled API
+	// groups.
+	EnableAlpha bool
+}
+
+// ParseFilter returns a filter that enables the controllers of the supplied
+// API groups, e.g. compute or database. Groups prefixed with a dash are
+// disabled, e.g. -compute. All groups that aren't disabled are enabled if no
+// group is enabled explicitly.
+func ParseFilter(groups []string) (Filter, error) {
+	f := Filter{allow: map[string]bool{}, deny: map[string]bool{}}
+	for _, g := range groups {
+		m := f.allow
+		if strings.HasPrefix(g, "-") {
+			g, m = strings.TrimPrefix(g, "-"), f.deny
+		}
+		if !known(g) {
+			return Filter{}, errors.Errorf(errUnknownGroup, g, strings.Join(append(Groups(), GroupProviderConfig), ", "))
+		}
+		m[g] = true
+	}
+	return f, nil
+}
+
+// Enabled returns true if the controllers of the supplied API group are
+// enabled.
+func (f Filter) Enabled(group string) bool {
+	if f.deny[group] {
+		return false
+	}
+	return len(f.allow) == 0 || f.allow[group]
+}
+
+func known(group string) bool {
+	_, ok := setups[group]
+	_, alpha := alphaSetups[group]
+	return ok || alpha || group == GroupProviderConfig
+}
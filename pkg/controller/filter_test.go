@@ -0,0 +1,139 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestFilter(t *testing.T) {
+	type want struct {
+		err     error
+		enabled []string
+	}
+
+	cases := map[string]struct {
+		reason string
+		groups []string
+		want   want
+	}{
+		"All": {
+			reason: "All groups should be enabled by default",
+			want:   want{enabled: append(Groups(), GroupProviderConfig)},
+		},
+		"Allow": {
+			reason: "Only the allowed groups should be enabled",
+			groups: []string{"compute", "gcp"},
+			want:   want{enabled: []string{"compute", "gcp"}},
+		},
+		"AllowAndDeny": {
+			reason: "Denied groups should be disabled even if they are allowed",
+			groups: []string{"compute", "database", "-database"},
+			want:   want{enabled: []string{"compute"}},
+		},
+		"Unknown": {
+			reason: "Should return an error if a group is unknown",
+			groups: []string{"-compute", "bogus"},
+			want:   want{err: errors.Errorf(errUnknownGroup, "bogus", strings.Join(append(Groups(), GroupProviderConfig), ", "))},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			f, err := ParseFilter(tc.groups)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nParseFilter(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if err != nil {
+				return
+			}
+			var enabled []string
+			for _, g := range append(Groups(), GroupProviderConfig) {
+				if f.Enabled(g) {
+					enabled = append(enabled, g)
+				}
+			}
+			if diff := cmp.Diff(tc.want.enabled, enabled); diff != "" {
+				t.Errorf("\n%s\nEnabled(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestFilterSetups(t *testing.T) {
+	names := func(fns []setupFn) []string {
+		var n []string
+		for _, fn := range fns {
+			n = append(n, runtime.FuncForPC(reflect.ValueOf(fn).Pointer()).Name())
+		}
+		return n
+	}
+
+	cases := map[string]struct {
+		reason string
+		f      Filter
+		group  string
+		want   []string
+	}{
+		"AlphaDisabled": {
+			reason: "Only the controllers of stable kinds should be set up if alpha resources are disabled",
+			f:      Filter{},
+			group:  "compute",
+			want:   names(setups["compute"]),
+		},
+		"AlphaOnlyGroupDisabled": {
+			reason: "No controllers of a group with only experimental kinds should be set up if alpha resources are disabled",
+			f:      Filter{},
+			group:  "alloydb",
+			want:   nil,
+		},
+		"AlphaEnabled": {
+			reason: "The controllers of experimental kinds should be set up if alpha resources are enabled",
+			f:      Filter{EnableAlpha: true},
+			group:  "compute",
+			want:   append(names(setups["compute"]), names(alphaSetups["compute"])...),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, names(tc.f.setups(tc.group))); diff != "" {
+				t.Errorf("\n%s\nsetups(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+
+	// No experimental controller may be set up unless alpha resources are
+	// enabled, whatever group it belongs to.
+	for _, g := range Groups() {
+		for _, n := range names(Filter{}.setups(g)) {
+			for _, a := range names(alphaSetups[g]) {
+				if n == a {
+					t.Errorf("setups(%q): experimental controller %s is set up with alpha resources disabled", g, n)
+				}
+			}
+		}
+	}
+}
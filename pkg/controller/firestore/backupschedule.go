@@ -0,0 +1,150 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firestore
+
+import (
+	"context"
+	"time"
+
+	firestore "google.golang.org/api/firestore/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/firestore/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	firestoreclient "github.com/crossplane/provider-gcp/pkg/clients/firestore"
+)
+
+// Error strings.
+const (
+	errNotBackupSchedule    = "managed resource is not a Firestore BackupSchedule"
+	errGetBackupSchedule    = "cannot get Firestore BackupSchedule"
+	errCreateBackupSchedule = "cannot create Firestore BackupSchedule"
+	errUpdateBackupSchedule = "cannot update Firestore BackupSchedule"
+	errDeleteBackupSchedule = "cannot delete Firestore BackupSchedule"
+)
+
+// SetupBackupSchedule adds a controller that reconciles Firestore
+// BackupSchedules.
+func SetupBackupSchedule(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.BackupScheduleGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.BackupScheduleGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.BackupSchedule{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.BackupScheduleGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.BackupScheduleGroupVersionKind),
+			&backupScheduleConnector{kube: mgr.GetClient()},
+			managed.WithInitializers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type backupScheduleConnector struct {
+	kube client.Client
+}
+
+func (c *backupScheduleConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := firestore.NewService(ctx, opts.For("firestore")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &backupScheduleExternal{schedules: s.Projects.Databases.BackupSchedules, projectID: projectID}, nil
+}
+
+type backupScheduleExternal struct {
+	schedules *firestore.ProjectsDatabasesBackupSchedulesService
+	projectID string
+}
+
+func (e *backupScheduleExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BackupSchedule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBackupSchedule)
+	}
+	// Backup schedule IDs are assigned by Firestore, so until we've created
+	// the schedule we have nothing to look up.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	s, err := e.schedules.Get(firestoreclient.GetBackupScheduleName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetBackupSchedule)
+	}
+	cr.Status.AtProvider = firestoreclient.GenerateBackupScheduleObservation(*s)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: firestoreclient.IsBackupScheduleUpToDate(cr.Spec.ForProvider, *s),
+	}, nil
+}
+
+func (e *backupScheduleExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.BackupSchedule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBackupSchedule)
+	}
+	cr.SetConditions(xpv1.Creating())
+	s, err := e.schedules.Create(firestoreclient.GetBackupScheduleParent(e.projectID, cr.Spec.ForProvider), firestoreclient.GenerateBackupSchedule(cr.Spec.ForProvider)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateBackupSchedule)
+	}
+	meta.SetExternalName(cr, firestoreclient.GetBackupScheduleID(*s))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *backupScheduleExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.BackupSchedule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBackupSchedule)
+	}
+	name := firestoreclient.GetBackupScheduleName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+	_, err := e.schedules.Patch(name, firestoreclient.GenerateBackupSchedule(cr.Spec.ForProvider)).UpdateMask(firestoreclient.BackupScheduleUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateBackupSchedule)
+}
+
+func (e *backupScheduleExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.BackupSchedule)
+	if !ok {
+		return errors.New(errNotBackupSchedule)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.schedules.Delete(firestoreclient.GetBackupScheduleName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteBackupSchedule)
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firestore
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	firestore "google.golang.org/api/firestore/v1"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/firestore/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+func newBackupSchedule(externalName string) *v1alpha1.BackupSchedule {
+	s := &v1alpha1.BackupSchedule{}
+	meta.SetExternalName(s, externalName)
+	s.Spec.ForProvider = v1alpha1.BackupScheduleParameters{
+		Database:        gcp.StringPtr("my-db"),
+		Retention:       "604800s",
+		DailyRecurrence: &v1alpha1.DailyRecurrence{},
+	}
+	return s
+}
+
+func TestBackupScheduleObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotBackupSchedule": {
+			reason: "Should return an error if the resource is not a BackupSchedule",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotBackupSchedule)},
+		},
+		"NoExternalName": {
+			reason: "Should report the schedule as missing if it has not been assigned an ID",
+			mg:     newBackupSchedule(""),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected %s request", r.Method)
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			mg:     newBackupSchedule("abc123"),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetBackupSchedule)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(&firestore.GoogleFirestoreAdminV1BackupSchedule{})
+			}),
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should return upToDate as false if the retention differs",
+			mg:     newBackupSchedule("abc123"),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&firestore.GoogleFirestoreAdminV1BackupSchedule{Retention: "86400s"})
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := firestore.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := backupScheduleExternal{
+				projectID: projectID,
+				schedules: s.Projects.Databases.BackupSchedules,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestBackupScheduleCreate(t *testing.T) {
+	cr := newBackupSchedule("")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(&firestore.GoogleFirestoreAdminV1BackupSchedule{
+			Name: "projects/p/databases/my-db/backupSchedules/abc123",
+		})
+	}))
+	defer server.Close()
+	s, _ := firestore.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := backupScheduleExternal{
+		projectID: projectID,
+		schedules: s.Projects.Databases.BackupSchedules,
+	}
+	got, err := e.Create(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Create(...): unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(managed.ExternalCreation{ExternalNameAssigned: true}, got); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("abc123", meta.GetExternalName(cr)); diff != "" {
+		t.Errorf("Create(...): -want external name, +got external name:\n%s", diff)
+	}
+}
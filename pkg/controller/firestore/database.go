@@ -0,0 +1,150 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firestore
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	firestore "google.golang.org/api/firestore/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/firestore/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	firestoreclient "github.com/crossplane/provider-gcp/pkg/clients/firestore"
+)
+
+// Error strings.
+const (
+	errNewClient        = "cannot create new Firestore Service"
+	errNotDatabase      = "managed resource is not a Firestore Database"
+	errGetDatabase      = "cannot get Firestore Database"
+	errCreateDatabase   = "cannot create Firestore Database"
+	errUpdateDatabase   = "cannot update Firestore Database"
+	errDeleteDatabase   = "cannot delete Firestore Database"
+	errUpdateDatabaseCR = "cannot update Firestore Database custom resource"
+)
+
+// SetupDatabase adds a controller that reconciles Firestore Databases.
+func SetupDatabase(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.DatabaseGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.DatabaseGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Database{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.DatabaseGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.DatabaseGroupVersionKind),
+			&databaseConnector{kube: mgr.GetClient()},
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type databaseConnector struct {
+	kube client.Client
+}
+
+func (c *databaseConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := firestore.NewService(ctx, opts.For("firestore")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &databaseExternal{kube: c.kube, databases: s.Projects.Databases, projectID: projectID}, nil
+}
+
+type databaseExternal struct {
+	kube      client.Client
+	databases *firestore.ProjectsDatabasesService
+	projectID string
+}
+
+func (e *databaseExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Database)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDatabase)
+	}
+	d, err := e.databases.Get(firestoreclient.GetDatabaseName(e.projectID, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetDatabase)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	firestoreclient.LateInitializeDatabase(&cr.Spec.ForProvider, *d)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateDatabaseCR)
+		}
+	}
+	cr.Status.AtProvider = firestoreclient.GenerateDatabaseObservation(*d)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: firestoreclient.IsDatabaseUpToDate(cr.Spec.ForProvider, *d),
+	}, nil
+}
+
+func (e *databaseExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Database)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDatabase)
+	}
+	cr.SetConditions(xpv1.Creating())
+	d := firestoreclient.GenerateDatabase("", cr.Spec.ForProvider)
+	_, err := e.databases.Create(firestoreclient.GetDatabaseParent(e.projectID), d).DatabaseId(meta.GetExternalName(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateDatabase)
+}
+
+func (e *databaseExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Database)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDatabase)
+	}
+	name := firestoreclient.GetDatabaseName(e.projectID, meta.GetExternalName(cr))
+	_, err := e.databases.Patch(name, firestoreclient.GenerateDatabase(name, cr.Spec.ForProvider)).UpdateMask(firestoreclient.DatabaseUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateDatabase)
+}
+
+func (e *databaseExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Database)
+	if !ok {
+		return errors.New(errNotDatabase)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.databases.Delete(firestoreclient.GetDatabaseName(e.projectID, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteDatabase)
+}
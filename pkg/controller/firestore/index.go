@@ -0,0 +1,165 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firestore
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	firestore "google.golang.org/api/firestore/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/firestore/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	firestoreclient "github.com/crossplane/provider-gcp/pkg/clients/firestore"
+)
+
+// Error strings.
+const (
+	errNotIndex      = "managed resource is not a Firestore Index"
+	errGetIndex      = "cannot get Firestore Index"
+	errCreateIndex   = "cannot create Firestore Index"
+	errDeleteIndex   = "cannot delete Firestore Index"
+	errUpdateIndexCR = "cannot update Firestore Index custom resource"
+)
+
+// SetupIndex adds a controller that reconciles Firestore Indexes.
+func SetupIndex(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.IndexGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.IndexGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Index{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.IndexGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.IndexGroupVersionKind),
+			&indexConnector{kube: mgr.GetClient()},
+			managed.WithInitializers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type indexConnector struct {
+	kube client.Client
+}
+
+func (c *indexConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := firestore.NewService(ctx, opts.For("firestore")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &indexExternal{kube: c.kube, indexes: s.Projects.Databases.CollectionGroups.Indexes, projectID: projectID}, nil
+}
+
+type indexExternal struct {
+	kube      client.Client
+	indexes   *firestore.ProjectsDatabasesCollectionGroupsIndexesService
+	projectID string
+}
+
+func (e *indexExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Index)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotIndex)
+	}
+	// Index IDs are assigned by Firestore, so until we've created the index
+	// we have nothing to look up.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	i, err := e.indexes.Get(firestoreclient.GetIndexName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetIndex)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	firestoreclient.LateInitializeIndex(&cr.Spec.ForProvider, *i)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateIndexCR)
+		}
+	}
+	cr.Status.AtProvider = firestoreclient.GenerateIndexObservation(*i)
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.IndexStateReady:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1alpha1.IndexStateCreating:
+		cr.Status.SetConditions(xpv1.Creating())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+	// Indexes can not be updated; every field is immutable.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *indexExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Index)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotIndex)
+	}
+	cr.SetConditions(xpv1.Creating())
+	op, err := e.indexes.Create(firestoreclient.GetIndexParent(e.projectID, cr.Spec.ForProvider), firestoreclient.GenerateIndex(cr.Spec.ForProvider)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateIndex)
+	}
+	id, err := firestoreclient.GetIndexID(*op)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateIndex)
+	}
+	meta.SetExternalName(cr, id)
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *indexExternal) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	// Firestore does not provide an API to update indexes.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *indexExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Index)
+	if !ok {
+		return errors.New(errNotIndex)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.indexes.Delete(firestoreclient.GetIndexName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteIndex)
+}
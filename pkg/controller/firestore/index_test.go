@@ -0,0 +1,195 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firestore
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	firestore "google.golang.org/api/firestore/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/firestore/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+func newIndex(externalName string) *v1alpha1.Index {
+	i := &v1alpha1.Index{}
+	meta.SetExternalName(i, externalName)
+	i.Spec.ForProvider = v1alpha1.IndexParameters{
+		Database:        gcp.StringPtr("my-db"),
+		CollectionGroup: "users",
+		QueryScope:      "COLLECTION",
+		APIScope:        gcp.StringPtr("ANY_API"),
+		Fields: []v1alpha1.IndexField{
+			{FieldPath: "team", Order: gcp.StringPtr("ASCENDING")},
+			{FieldPath: "age", Order: gcp.StringPtr("DESCENDING")},
+		},
+	}
+	return i
+}
+
+func TestIndexObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotIndex": {
+			reason: "Should return an error if the resource is not an Index",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotIndex)},
+		},
+		"NoExternalName": {
+			reason: "Should report the index as missing if it has not been assigned an ID",
+			mg:     newIndex(""),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected %s request", r.Method)
+			}),
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			mg:     newIndex("CICAgJim14AK"),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"Ready": {
+			reason: "Should report an existing index as up to date",
+			mg:     newIndex("CICAgJim14AK"),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&firestore.GoogleFirestoreAdminV1Index{ApiScope: "ANY_API", State: v1alpha1.IndexStateReady})
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := firestore.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := indexExternal{
+				projectID: projectID,
+				indexes:   s.Projects.Databases.CollectionGroups.Indexes,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestIndexCreate(t *testing.T) {
+	type want struct {
+		e            managed.ExternalCreation
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotIndex": {
+			reason: "Should return an error if the resource is not an Index",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotIndex)},
+		},
+		"Successful": {
+			reason: "Should set the external name to the server-assigned index ID",
+			mg:     newIndex(""),
+			want: want{
+				e:            managed.ExternalCreation{ExternalNameAssigned: true},
+				externalName: "CICAgJim14AK",
+			},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&firestore.GoogleLongrunningOperation{
+					Metadata: googleapi.RawMessage(`{"index":"projects/p/databases/my-db/collectionGroups/users/indexes/CICAgJim14AK"}`),
+				})
+			}),
+		},
+		"Failed": {
+			reason: "Should fail if the resource creation returns an error",
+			mg:     newIndex(""),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateIndex)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&firestore.GoogleLongrunningOperation{})
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := firestore.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := indexExternal{
+				projectID: projectID,
+				indexes:   s.Projects.Databases.CollectionGroups.Indexes,
+			}
+			got, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if tc.mg != nil {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.mg)); diff != "" {
+					t.Errorf("\n%s\nCreate(...): -want external name, +got external name:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
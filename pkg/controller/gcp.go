@@ -19,54 +19,345 @@ package controller
 import (
 	"time"
 
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 
+	computev1alpha1 "github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	computev1beta1 "github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	containerv1beta2 "github.com/crossplane/provider-gcp/apis/container/v1beta2"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/controller/accesscontextmanager"
+	"github.com/crossplane/provider-gcp/pkg/controller/alloydb"
+	"github.com/crossplane/provider-gcp/pkg/controller/apigateway"
+	"github.com/crossplane/provider-gcp/pkg/controller/apigee"
+	"github.com/crossplane/provider-gcp/pkg/controller/appengine"
+	"github.com/crossplane/provider-gcp/pkg/controller/artifactregistry"
+	"github.com/crossplane/provider-gcp/pkg/controller/billingbudgets"
+	"github.com/crossplane/provider-gcp/pkg/controller/binaryauthorization"
 	"github.com/crossplane/provider-gcp/pkg/controller/cache"
+	"github.com/crossplane/provider-gcp/pkg/controller/certificatemanager"
+	"github.com/crossplane/provider-gcp/pkg/controller/cloudbuild"
+	"github.com/crossplane/provider-gcp/pkg/controller/cloudfunctions"
+	"github.com/crossplane/provider-gcp/pkg/controller/cloudrun"
+	"github.com/crossplane/provider-gcp/pkg/controller/cloudscheduler"
+	"github.com/crossplane/provider-gcp/pkg/controller/cloudtasks"
+	"github.com/crossplane/provider-gcp/pkg/controller/composer"
 	"github.com/crossplane/provider-gcp/pkg/controller/compute"
 	"github.com/crossplane/provider-gcp/pkg/controller/config"
 	"github.com/crossplane/provider-gcp/pkg/controller/container"
 	"github.com/crossplane/provider-gcp/pkg/controller/database"
+	"github.com/crossplane/provider-gcp/pkg/controller/datacatalog"
+	"github.com/crossplane/provider-gcp/pkg/controller/dataflow"
+	"github.com/crossplane/provider-gcp/pkg/controller/dataproc"
+	"github.com/crossplane/provider-gcp/pkg/controller/datastream"
 	"github.com/crossplane/provider-gcp/pkg/controller/dns"
+	"github.com/crossplane/provider-gcp/pkg/controller/essentialcontacts"
+	"github.com/crossplane/provider-gcp/pkg/controller/eventarc"
+	"github.com/crossplane/provider-gcp/pkg/controller/filestore"
+	"github.com/crossplane/provider-gcp/pkg/controller/firestore"
 	"github.com/crossplane/provider-gcp/pkg/controller/iam"
+	"github.com/crossplane/provider-gcp/pkg/controller/iap"
+	"github.com/crossplane/provider-gcp/pkg/controller/identityplatform"
+	"github.com/crossplane/provider-gcp/pkg/controller/ids"
 	"github.com/crossplane/provider-gcp/pkg/controller/kms"
+	cloudlogging "github.com/crossplane/provider-gcp/pkg/controller/logging"
+	"github.com/crossplane/provider-gcp/pkg/controller/monitoring"
+	"github.com/crossplane/provider-gcp/pkg/controller/networkconnectivity"
+	"github.com/crossplane/provider-gcp/pkg/controller/notebooks"
+	"github.com/crossplane/provider-gcp/pkg/controller/orgpolicy"
+	"github.com/crossplane/provider-gcp/pkg/controller/osconfig"
 	"github.com/crossplane/provider-gcp/pkg/controller/pubsub"
+	"github.com/crossplane/provider-gcp/pkg/controller/recaptchaenterprise"
+	"github.com/crossplane/provider-gcp/pkg/controller/resourcemanager"
 	"github.com/crossplane/provider-gcp/pkg/controller/servicenetworking"
+	"github.com/crossplane/provider-gcp/pkg/controller/serviceusage"
 	"github.com/crossplane/provider-gcp/pkg/controller/storage"
+	"github.com/crossplane/provider-gcp/pkg/controller/vertexai"
+	"github.com/crossplane/provider-gcp/pkg/controller/vpcaccess"
+	"github.com/crossplane/provider-gcp/pkg/controller/workflows"
 )
 
-// Setup creates all GCP controllers with the supplied logger and adds them to
-// the supplied manager.
-func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
-	for _, setup := range []func(ctrl.Manager, logging.Logger, workqueue.RateLimiter, time.Duration) error{
+// A setupFn adds the controller of a kind of managed resource to a manager.
+type setupFn func(ctrl.Manager, logging.Logger, workqueue.RateLimiter, time.Duration, gcp.MaxConcurrentReconciles) error
+
+// setups are the setup functions of the controllers of each API group, keyed
+// by the name of the group without its .gcp.crossplane.io suffix.
+var setups = map[string][]setupFn{
+	"cache": {
 		cache.SetupCloudMemorystoreInstance,
+	},
+	"compute": {
 		compute.SetupGlobalAddress,
 		compute.SetupNetwork,
 		compute.SetupSubnetwork,
 		compute.SetupFirewall,
 		compute.SetupRouter,
+	},
+	"container": {
 		container.SetupCluster,
 		container.SetupNodePool,
+	},
+	"database": {
 		database.SetupCloudSQLInstance,
+	},
+	"dns": {
 		dns.SetupResourceRecordSet,
+	},
+	"iam": {
 		iam.SetupServiceAccount,
 		iam.SetupServiceAccountKey,
 		iam.SetupServiceAccountPolicy,
+	},
+	"kms": {
 		kms.SetupKeyRing,
 		kms.SetupCryptoKey,
 		kms.SetupCryptoKeyPolicy,
+	},
+	"pubsub": {
 		pubsub.SetupSubscription,
 		pubsub.SetupTopic,
+	},
+	"servicenetworking": {
 		servicenetworking.SetupConnection,
+	},
+	"storage": {
 		storage.SetupBucket,
 		storage.SetupBucketPolicy,
 		storage.SetupBucketPolicyMember,
+	},
+}
+
+// alphaSetups are the setup functions of the experimental controllers of each
+// API group, such as those of the v1alpha1 kinds of the alloydb group. They
+// are only set up if the filter enables alpha resources. The kinds they
+// reconcile may change in backward incompatible ways, or be removed. Their
+// CRDs are installed with the package either way.
+var alphaSetups = map[string][]setupFn{
+	"accesscontextmanager": {
+		accesscontextmanager.SetupAccessLevel,
+		accesscontextmanager.SetupAccessPolicy,
+		accesscontextmanager.SetupServicePerimeter,
+	},
+	"alloydb": {
+		alloydb.SetupCluster,
+		alloydb.SetupInstance,
+	},
+	"apigateway": {
+		apigateway.SetupAPI,
+		apigateway.SetupAPIConfig,
+		apigateway.SetupGateway,
+	},
+	"apigee": {
+		apigee.SetupEnvGroup,
+		apigee.SetupEnvironment,
+		apigee.SetupInstanceAttachment,
+		apigee.SetupOrganization,
+	},
+	"appengine": {
+		appengine.SetupApplication,
+		appengine.SetupDomainMapping,
+		appengine.SetupFirewallRule,
+	},
+	"artifactregistry": {
+		artifactregistry.SetupRepository,
+		artifactregistry.SetupRepositoryIAMMember,
+	},
+	"billingbudgets": {
+		billingbudgets.SetupBudget,
+	},
+	"binaryauthorization": {
+		binaryauthorization.SetupAttestor,
+		binaryauthorization.SetupPolicy,
+	},
+	"cache": {
+		cache.SetupRedisCluster,
+	},
+	"certificatemanager": {
+		certificatemanager.SetupCertificate,
+		certificatemanager.SetupCertificateMap,
+		certificatemanager.SetupCertificateMapEntry,
+		certificatemanager.SetupDNSAuthorization,
+	},
+	"cloudbuild": {
+		cloudbuild.SetupTrigger,
+		cloudbuild.SetupWorkerPool,
+	},
+	"cloudfunctions": {
+		cloudfunctions.SetupFunction,
+	},
+	"cloudrun": {
+		cloudrun.SetupService,
+		cloudrun.SetupJob,
+		cloudrun.SetupCloudRunServiceIAMMember,
+	},
+	"cloudscheduler": {
+		cloudscheduler.SetupJob,
+	},
+	"cloudtasks": {
+		cloudtasks.SetupQueue,
+	},
+	"composer": {
+		composer.SetupEnvironment,
+	},
+	"compute": {
+		compute.SetupAddress,
+		compute.SetupBackendBucket,
+		compute.SetupImport,
+	},
+	"datacatalog": {
+		datacatalog.SetupPolicyTag,
+		datacatalog.SetupPolicyTagIAMMember,
+		datacatalog.SetupTagTemplate,
+		datacatalog.SetupTaxonomy,
+	},
+	"dataflow": {
+		dataflow.SetupJob,
+	},
+	"dataproc": {
+		dataproc.SetupCluster,
+		dataproc.SetupWorkflowTemplate,
+	},
+	"datastream": {
+		datastream.SetupConnectionProfile,
+		datastream.SetupStream,
+	},
+	"dns": {
+		dns.SetupManagedZone,
+		dns.SetupPolicy,
+		dns.SetupResponsePolicy,
+		dns.SetupResponsePolicyRule,
+	},
+	"essentialcontacts": {
+		essentialcontacts.SetupContact,
+	},
+	"eventarc": {
+		eventarc.SetupTrigger,
+	},
+	"filestore": {
+		filestore.SetupInstance,
+		filestore.SetupBackup,
+	},
+	"firestore": {
+		firestore.SetupDatabase,
+		firestore.SetupIndex,
+		firestore.SetupBackupSchedule,
+	},
+	"iap": {
+		iap.SetupBrand,
+		iap.SetupIdentityAwareProxyClient,
+		iap.SetupWebBackendServiceIAMMember,
+	},
+	"identityplatform": {
+		identityplatform.SetupIdentityPlatformConfig,
+		identityplatform.SetupTenant,
+	},
+	"ids": {
+		ids.SetupEndpoint,
+	},
+	"logging": {
+		cloudlogging.SetupLogBucket,
+		cloudlogging.SetupLogMetric,
+		cloudlogging.SetupLogSink,
+	},
+	"monitoring": {
+		monitoring.SetupAlertPolicy,
+		monitoring.SetupDashboard,
+		monitoring.SetupNotificationChannel,
+		monitoring.SetupService,
+		monitoring.SetupServiceLevelObjective,
+		monitoring.SetupUptimeCheckConfig,
+	},
+	"networkconnectivity": {
+		networkconnectivity.SetupHub,
+		networkconnectivity.SetupSpoke,
+	},
+	"notebooks": {
+		notebooks.SetupNotebookInstance,
+	},
+	"orgpolicy": {
+		orgpolicy.SetupOrgPolicy,
+	},
+	"osconfig": {
+		osconfig.SetupOSPolicyAssignment,
+	},
+	"recaptchaenterprise": {
+		recaptchaenterprise.SetupKey,
+	},
+	"resourcemanager": {
+		resourcemanager.SetupFolder,
+		resourcemanager.SetupProject,
+		resourcemanager.SetupTagBinding,
+		resourcemanager.SetupTagKey,
+		resourcemanager.SetupTagValue,
+	},
+	"serviceusage": {
+		serviceusage.SetupConsumerQuotaOverride,
+		serviceusage.SetupProjectService,
+	},
+	"vertexai": {
+		vertexai.SetupDataset,
+		vertexai.SetupEndpoint,
+		vertexai.SetupFeaturestore,
+	},
+	"vpcaccess": {
+		vpcaccess.SetupConnector,
+	},
+	"workflows": {
+		workflows.SetupWorkflow,
+	},
+}
+
+// Setup creates the GCP controllers of the API groups enabled by the supplied
+// filter with the supplied logger and adds them to the supplied manager.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles, f Filter) error {
+	for _, group := range Groups() {
+		if !f.Enabled(group) {
+			continue
+		}
+		for _, setup := range f.setups(group) {
+			if err := setup(mgr, l, rl, poll, mcr); err != nil {
+				return err
+			}
+		}
+	}
+	if !f.Enabled(GroupProviderConfig) {
+		return nil
+	}
+	if err := config.Setup(mgr, l, rl); err != nil {
+		return err
+	}
+	return config.SetupRegionalEndpoints(mgr, l, rl)
+}
+
+// setups returns the setup functions of the controllers of the supplied API
+// group that the filter enables.
+func (f Filter) setups(group string) []setupFn {
+	s := append([]setupFn{}, setups[group]...)
+	if f.EnableAlpha {
+		s = append(s, alphaSetups[group]...)
+	}
+	return s
+}
+
+// SetupWebhooks adds the webhooks of the GCP APIs to the supplied manager. The
+// conversion webhooks of APIs that are served in more than one version are
+// registered for the hub version that the other versions are converted to and
+// from. The defaulting webhooks set defaults that the schemas of the APIs can't
+// express, e.g. the region of a zone. They're optional, so that the provider
+// runs on Crossplane versions that don't provide webhook TLS certificates.
+func SetupWebhooks(mgr ctrl.Manager) error {
+	for _, o := range []runtime.Object{
+		&computev1alpha1.Router{},
+		&computev1beta1.Network{},
+		&computev1beta1.Subnetwork{},
+		&containerv1beta2.Cluster{},
 	} {
-		if err := setup(mgr, l, rl, poll); err != nil {
+		if err := ctrl.NewWebhookManagedBy(mgr).For(o).Complete(); err != nil {
 			return err
 		}
 	}
-	return config.Setup(mgr, l, rl)
+	return nil
 }
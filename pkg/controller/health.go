@@ -0,0 +1,56 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+)
+
+const errCacheNotSynced = "informer caches have not synced yet"
+
+// SetupHealthChecks adds the checks of the /healthz and /readyz endpoints to
+// the supplied manager. The provider is healthy while it serves requests, and
+// ready once its informer caches have synced. Readiness doesn't depend on
+// whether the provider was elected leader, so that standby replicas count as
+// available, e.g. during rolling updates or for a PodDisruptionBudget.
+func SetupHealthChecks(mgr ctrl.Manager) error {
+	if err := mgr.AddHealthzCheck("ping", healthz.Ping); err != nil {
+		return err
+	}
+	return mgr.AddReadyzCheck("informers", cacheSynced(mgr.GetCache()))
+}
+
+// cacheSynced returns a checker that fails until the supplied cache has
+// synced.
+func cacheSynced(c cache.Cache) healthz.Checker {
+	return func(req *http.Request) error {
+		ctx, cancel := context.WithTimeout(req.Context(), time.Second)
+		defer cancel()
+		if !c.WaitForCacheSync(ctx) {
+			return errors.New(errCacheNotSynced)
+		}
+		return nil
+	}
+}
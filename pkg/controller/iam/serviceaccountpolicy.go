@@ -48,18 +48,20 @@ const (
 )
 
 // SetupServiceAccountPolicy adds a controller that reconciles ServiceAccountPolicys.
-func SetupServiceAccountPolicy(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+func SetupServiceAccountPolicy(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
 	name := managed.ControllerName(v1alpha1.ServiceAccountPolicyGroupKind)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: mcr.For(v1alpha1.ServiceAccountPolicyGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
 		}).
 		For(&v1alpha1.ServiceAccountPolicy{}).
-		Complete(managed.NewReconciler(mgr,
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.ServiceAccountPolicyGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.ServiceAccountPolicyGroupVersionKind),
-			managed.WithExternalConnecter(&serviceAccountPolicyConnecter{client: mgr.GetClient()}),
+			&serviceAccountPolicyConnecter{client: mgr.GetClient()},
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -76,7 +78,7 @@ func (c *serviceAccountPolicyConnecter) Connect(ctx context.Context, mg resource
 	if err != nil {
 		return nil, err
 	}
-	s, err := iamv1.NewService(ctx, opts)
+	s, err := iamv1.NewService(ctx, opts.For("iam")...)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
@@ -169,10 +171,8 @@ func (e *serviceAccountPolicyExternal) Delete(ctx context.Context, mg resource.M
 	if !ok {
 		return errors.New(errNotServiceAccountPolicy)
 	}
+	// There is no policy to empty if the service account is gone.
 	req := &iamv1.SetIamPolicyRequest{Policy: &iamv1.Policy{}}
-	if _, err := e.serviceaccountspolicy.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.ServiceAccount), req).
-		Context(ctx).Do(); err != nil {
-		return errors.Wrap(err, errSetPolicy)
-	}
-	return nil
+	_, err := e.serviceaccountspolicy.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.ServiceAccount), req).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errSetPolicy)
 }
@@ -0,0 +1,150 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iap
+
+import (
+	"context"
+	"time"
+
+	iap "google.golang.org/api/iap/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/iap/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	iapclient "github.com/crossplane/provider-gcp/pkg/clients/iap"
+)
+
+// Error strings.
+const (
+	errNewClient   = "cannot create new Identity-Aware Proxy client"
+	errNotBrand    = "managed resource is not a Brand"
+	errGetBrand    = "cannot get Brand"
+	errCreateBrand = "cannot create Brand"
+)
+
+// SetupBrand adds a controller that reconciles Brands.
+func SetupBrand(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.BrandGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.BrandGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Brand{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.BrandGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.BrandGroupVersionKind),
+			&brandConnector{kube: mgr.GetClient()},
+			managed.WithInitializers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type brandConnector struct {
+	kube client.Client
+}
+
+func (c *brandConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := iap.NewService(ctx, opts.For("iap")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &brandExternal{brands: s.Projects.Brands, projectID: projectID}, nil
+}
+
+type brandExternal struct {
+	brands    *iap.ProjectsBrandsService
+	projectID string
+}
+
+func (e *brandExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Brand)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBrand)
+	}
+	// Brand IDs are assigned by Identity-Aware Proxy, so until we've created
+	// the brand we have nothing to look up.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	existing, err := e.brands.Get(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetBrand)
+	}
+	cr.Status.AtProvider = iapclient.GenerateBrandObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	// All fields of a brand are immutable.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *brandExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Brand)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBrand)
+	}
+	cr.SetConditions(xpv1.Creating())
+	b, err := e.brands.Create(iapclient.GetBrandParent(e.projectID, cr.Spec.ForProvider), iapclient.GenerateBrand(cr.Spec.ForProvider)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateBrand)
+	}
+	meta.SetExternalName(cr, iapclient.GetBrandID(b.Name))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *brandExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	// All fields of a brand are immutable, so there is never anything to
+	// update.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *brandExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Brand)
+	if !ok {
+		return errors.New(errNotBrand)
+	}
+	// Brands can't be deleted through the API. They are only removed along
+	// with their project, so we just let go of the brand.
+	cr.SetConditions(xpv1.Deleting())
+	return nil
+}
+
+func (e *brandExternal) name(cr *v1alpha1.Brand) string {
+	return iapclient.GetBrandName(iapclient.GetBrandParent(e.projectID, cr.Spec.ForProvider), meta.GetExternalName(cr))
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iap
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	iap "google.golang.org/api/iap/v1"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/iap/v1alpha1"
+)
+
+const (
+	projectID   = "myproject-id-1234"
+	brandID     = "123456789012"
+	brandParent = "projects/myproject-id-1234"
+	brandName   = brandParent + "/brands/" + brandID
+)
+
+var unexpectedObject resource.Managed
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newBrand(m ...func(*v1alpha1.Brand)) *v1alpha1.Brand {
+	cr := &v1alpha1.Brand{}
+	meta.SetExternalName(cr, brandID)
+	cr.Spec.ForProvider = v1alpha1.BrandParameters{
+		SupportEmail:     "support@example.com",
+		ApplicationTitle: "Internal apps",
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func brand() *iap.Brand {
+	return &iap.Brand{
+		Name:             brandName,
+		SupportEmail:     "support@example.com",
+		ApplicationTitle: "Internal apps",
+		OrgInternalOnly:  true,
+	}
+}
+
+func TestBrandObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		obs v1alpha1.BrandObservation
+		err error
+	}
+
+	obs := v1alpha1.BrandObservation{
+		Name:            brandName,
+		OrgInternalOnly: true,
+	}
+	cases := map[string]struct {
+		reason string
+		status int
+		brand  *iap.Brand
+		mg     resource.Managed
+		want   want
+	}{
+		"NotBrand": {
+			reason: "Should return an error if the resource is not a Brand",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotBrand)},
+		},
+		"NoExternalName": {
+			reason: "Should report that the brand does not exist until it has been assigned an ID",
+			mg:     newBrand(func(cr *v1alpha1.Brand) { meta.SetExternalName(cr, "") }),
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the brand does not exist",
+			status: http.StatusNotFound,
+			mg:     newBrand(),
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the brand fails",
+			status: http.StatusBadRequest,
+			mg:     newBrand(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetBrand)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should report the observation of an existing brand",
+			status: http.StatusOK,
+			brand:  brand(),
+			mg:     newBrand(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				obs: obs,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet+" /v1/"+brandName, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.brand == nil {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.brand)
+			}))
+			defer server.Close()
+			s, _ := iap.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &brandExternal{brands: s.Projects.Brands, projectID: projectID}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Brand); ok && err == nil {
+				if diff := cmp.Diff(tc.want.obs, cr.Status.AtProvider); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want observation, +got observation:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestBrandCreate(t *testing.T) {
+	type want struct {
+		e            managed.ExternalCreation
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		status int
+		mg     resource.Managed
+		want   want
+	}{
+		"NotBrand": {
+			reason: "Should return an error if the resource is not a Brand",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotBrand)},
+		},
+		"CreateSuccessful": {
+			reason: "Should record the ID Identity-Aware Proxy assigned as the external name",
+			status: http.StatusOK,
+			mg:     newBrand(func(cr *v1alpha1.Brand) { meta.SetExternalName(cr, "") }),
+			want: want{
+				e:            managed.ExternalCreation{ExternalNameAssigned: true},
+				externalName: brandID,
+			},
+		},
+		"CreateFailed": {
+			reason: "Should return an error if creating the brand fails",
+			status: http.StatusBadRequest,
+			mg:     newBrand(func(cr *v1alpha1.Brand) { meta.SetExternalName(cr, "") }),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateBrand)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost+" /v1/"+brandParent+"/brands", r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.status != http.StatusOK {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(brand())
+			}))
+			defer server.Close()
+			s, _ := iap.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &brandExternal{brands: s.Projects.Brands, projectID: projectID}
+			got, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Brand); ok && err == nil {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+					t.Errorf("\n%s\nCreate(...): -want external name, +got external name:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestBrandDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   error
+	}{
+		"NotBrand": {
+			reason: "Should return an error if the resource is not a Brand",
+			mg:     unexpectedObject,
+			want:   errors.New(errNotBrand),
+		},
+		"Successful": {
+			reason: "Should not call the API, since brands can't be deleted",
+			mg:     newBrand(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}))
+			defer server.Close()
+			s, _ := iap.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			err := (&brandExternal{brands: s.Projects.Brands, projectID: projectID}).Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iap
+
+import (
+	"context"
+	"time"
+
+	iap "google.golang.org/api/iap/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/iap/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	iapclient "github.com/crossplane/provider-gcp/pkg/clients/iap"
+)
+
+// Error strings.
+const (
+	errNotIdentityAwareProxyClient    = "managed resource is not an IdentityAwareProxyClient"
+	errGetIdentityAwareProxyClient    = "cannot get IdentityAwareProxyClient"
+	errCreateIdentityAwareProxyClient = "cannot create IdentityAwareProxyClient"
+	errDeleteIdentityAwareProxyClient = "cannot delete IdentityAwareProxyClient"
+)
+
+// SetupIdentityAwareProxyClient adds a controller that reconciles
+// IdentityAwareProxyClients.
+func SetupIdentityAwareProxyClient(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.IdentityAwareProxyClientGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.IdentityAwareProxyClientGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.IdentityAwareProxyClient{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.IdentityAwareProxyClientGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.IdentityAwareProxyClientGroupVersionKind),
+			&clientConnector{kube: mgr.GetClient()},
+			managed.WithInitializers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type clientConnector struct {
+	kube client.Client
+}
+
+func (c *clientConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := iap.NewService(ctx, opts.For("iap")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &clientExternal{clients: s.Projects.Brands.IdentityAwareProxyClients}, nil
+}
+
+type clientExternal struct {
+	clients *iap.ProjectsBrandsIdentityAwareProxyClientsService
+}
+
+func (e *clientExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.IdentityAwareProxyClient)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotIdentityAwareProxyClient)
+	}
+	// Client IDs are assigned by Identity-Aware Proxy, so until we've created
+	// the client we have nothing to look up.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	existing, err := e.clients.Get(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetIdentityAwareProxyClient)
+	}
+	cr.Status.AtProvider = iapclient.GenerateIdentityAwareProxyClientObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	// All fields of a client are immutable.
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: iapclient.GetIdentityAwareProxyClientConnectionDetails(*existing),
+	}, nil
+}
+
+func (e *clientExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.IdentityAwareProxyClient)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotIdentityAwareProxyClient)
+	}
+	cr.SetConditions(xpv1.Creating())
+	c, err := e.clients.Create(gcp.StringValue(cr.Spec.ForProvider.Brand), iapclient.GenerateIdentityAwareProxyClient(cr.Spec.ForProvider)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateIdentityAwareProxyClient)
+	}
+	meta.SetExternalName(cr, iapclient.GetIdentityAwareProxyClientID(c.Name))
+	return managed.ExternalCreation{
+		ExternalNameAssigned: true,
+		ConnectionDetails:    iapclient.GetIdentityAwareProxyClientConnectionDetails(*c),
+	}, nil
+}
+
+func (e *clientExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	// All fields of a client are immutable, so there is never anything to
+	// update.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *clientExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.IdentityAwareProxyClient)
+	if !ok {
+		return errors.New(errNotIdentityAwareProxyClient)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.clients.Delete(e.name(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteIdentityAwareProxyClient)
+}
+
+func (e *clientExternal) name(cr *v1alpha1.IdentityAwareProxyClient) string {
+	return iapclient.GetIdentityAwareProxyClientName(gcp.StringValue(cr.Spec.ForProvider.Brand), meta.GetExternalName(cr))
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iap
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	iap "google.golang.org/api/iap/v1"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/iap/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	clientID   = "123456789012-abcdef.apps.googleusercontent.com"
+	clientName = brandName + "/identityAwareProxyClients/" + clientID
+	clientPath = "/v1/" + clientName
+)
+
+func newIdentityAwareProxyClient(m ...func(*v1alpha1.IdentityAwareProxyClient)) *v1alpha1.IdentityAwareProxyClient {
+	cr := &v1alpha1.IdentityAwareProxyClient{}
+	meta.SetExternalName(cr, clientID)
+	cr.Spec.ForProvider = v1alpha1.IdentityAwareProxyClientParameters{
+		Brand:       gcp.StringPtr(brandName),
+		DisplayName: "dashboard",
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func identityAwareProxyClient() *iap.IdentityAwareProxyClient {
+	return &iap.IdentityAwareProxyClient{
+		Name:        clientName,
+		DisplayName: "dashboard",
+		Secret:      "s3cr3t",
+	}
+}
+
+func TestIdentityAwareProxyClientObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		obs v1alpha1.IdentityAwareProxyClientObservation
+		err error
+	}
+
+	conn := managed.ConnectionDetails{
+		v1alpha1.IdentityAwareProxyClientSecretClientIDKey:     []byte(clientID),
+		v1alpha1.IdentityAwareProxyClientSecretClientSecretKey: []byte("s3cr3t"),
+	}
+	cases := map[string]struct {
+		reason string
+		status int
+		client *iap.IdentityAwareProxyClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotIdentityAwareProxyClient": {
+			reason: "Should return an error if the resource is not an IdentityAwareProxyClient",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotIdentityAwareProxyClient)},
+		},
+		"NoExternalName": {
+			reason: "Should report that the client does not exist until it has been assigned an ID",
+			mg:     newIdentityAwareProxyClient(func(cr *v1alpha1.IdentityAwareProxyClient) { meta.SetExternalName(cr, "") }),
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the client does not exist",
+			status: http.StatusNotFound,
+			mg:     newIdentityAwareProxyClient(),
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the client fails",
+			status: http.StatusBadRequest,
+			mg:     newIdentityAwareProxyClient(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetIdentityAwareProxyClient)},
+		},
+		"ResourceExists": {
+			reason: "Should report the observation of an existing client along with its credentials",
+			status: http.StatusOK,
+			client: identityAwareProxyClient(),
+			mg:     newIdentityAwareProxyClient(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: conn},
+				obs: v1alpha1.IdentityAwareProxyClientObservation{Name: clientName},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet+" "+clientPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.client == nil {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.client)
+			}))
+			defer server.Close()
+			s, _ := iap.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &clientExternal{clients: s.Projects.Brands.IdentityAwareProxyClients}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.IdentityAwareProxyClient); ok && err == nil {
+				if diff := cmp.Diff(tc.want.obs, cr.Status.AtProvider); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want observation, +got observation:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestIdentityAwareProxyClientCreate(t *testing.T) {
+	type want struct {
+		e            managed.ExternalCreation
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		status int
+		mg     resource.Managed
+		want   want
+	}{
+		"NotIdentityAwareProxyClient": {
+			reason: "Should return an error if the resource is not an IdentityAwareProxyClient",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotIdentityAwareProxyClient)},
+		},
+		"CreateSuccessful": {
+			reason: "Should record the client ID as the external name and publish the credentials",
+			status: http.StatusOK,
+			mg:     newIdentityAwareProxyClient(func(cr *v1alpha1.IdentityAwareProxyClient) { meta.SetExternalName(cr, "") }),
+			want: want{
+				e: managed.ExternalCreation{
+					ExternalNameAssigned: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1alpha1.IdentityAwareProxyClientSecretClientIDKey:     []byte(clientID),
+						v1alpha1.IdentityAwareProxyClientSecretClientSecretKey: []byte("s3cr3t"),
+					},
+				},
+				externalName: clientID,
+			},
+		},
+		"CreateFailed": {
+			reason: "Should return an error if creating the client fails",
+			status: http.StatusBadRequest,
+			mg:     newIdentityAwareProxyClient(func(cr *v1alpha1.IdentityAwareProxyClient) { meta.SetExternalName(cr, "") }),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateIdentityAwareProxyClient)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost+" /v1/"+brandName+"/identityAwareProxyClients", r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.status != http.StatusOK {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(identityAwareProxyClient())
+			}))
+			defer server.Close()
+			s, _ := iap.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &clientExternal{clients: s.Projects.Brands.IdentityAwareProxyClients}
+			got, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.IdentityAwareProxyClient); ok && err == nil {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+					t.Errorf("\n%s\nCreate(...): -want external name, +got external name:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestIdentityAwareProxyClientDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		status int
+		want   error
+	}{
+		"DeleteSuccessful": {
+			reason: "Should delete the client",
+			status: http.StatusOK,
+		},
+		"DeleteNotFound": {
+			reason: "Should not return an error if the client is already gone",
+			status: http.StatusNotFound,
+		},
+		"DeleteFailed": {
+			reason: "Should return an error if deleting the client fails",
+			status: http.StatusBadRequest,
+			want:   errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteIdentityAwareProxyClient),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete+" "+clientPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := iap.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			err := (&clientExternal{clients: s.Projects.Brands.IdentityAwareProxyClients}).Delete(context.Background(), newIdentityAwareProxyClient())
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
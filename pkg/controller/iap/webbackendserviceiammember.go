@@ -0,0 +1,155 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iap
+
+import (
+	"context"
+	"time"
+
+	iap "google.golang.org/api/iap/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	iamv1alpha1 "github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/iap/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	iapclient "github.com/crossplane/provider-gcp/pkg/clients/iap"
+)
+
+// Error strings.
+const (
+	errNotWebBackendServiceIAMMember = "managed resource is not a WebBackendServiceIAMMember"
+	errGetPolicy                     = "cannot get backend service IAP IAM policy"
+	errSetPolicy                     = "cannot set backend service IAP IAM policy"
+)
+
+// SetupWebBackendServiceIAMMember adds a controller that reconciles
+// WebBackendServiceIAMMembers.
+func SetupWebBackendServiceIAMMember(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.WebBackendServiceIAMMemberGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.WebBackendServiceIAMMemberGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.WebBackendServiceIAMMember{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.WebBackendServiceIAMMemberGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.WebBackendServiceIAMMemberGroupVersionKind),
+			&webBackendServiceIAMMemberConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type webBackendServiceIAMMemberConnector struct {
+	kube client.Client
+}
+
+func (c *webBackendServiceIAMMemberConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := iap.NewService(ctx, opts.For("iap")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &webBackendServiceIAMMemberExternal{iap: s.V1, projectID: projectID}, nil
+}
+
+type webBackendServiceIAMMemberExternal struct {
+	iap       *iap.V1Service
+	projectID string
+}
+
+func (e *webBackendServiceIAMMemberExternal) getPolicy(ctx context.Context, cr *v1alpha1.WebBackendServiceIAMMember) (*iap.Policy, error) {
+	rq := &iap.GetIamPolicyRequest{Options: &iap.GetPolicyOptions{RequestedPolicyVersion: iamv1alpha1.PolicyVersion}}
+	return e.iap.GetIamPolicy(iapclient.GetWebBackendServiceResource(e.projectID, cr.Spec.ForProvider), rq).Context(ctx).Do()
+}
+
+func (e *webBackendServiceIAMMemberExternal) setPolicy(ctx context.Context, cr *v1alpha1.WebBackendServiceIAMMember, p *iap.Policy) error {
+	_, err := e.iap.SetIamPolicy(iapclient.GetWebBackendServiceResource(e.projectID, cr.Spec.ForProvider), &iap.SetIamPolicyRequest{Policy: p}).Context(ctx).Do()
+	return errors.Wrap(err, errSetPolicy)
+}
+
+func (e *webBackendServiceIAMMemberExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.WebBackendServiceIAMMember)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotWebBackendServiceIAMMember)
+	}
+	p, err := e.getPolicy(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetPolicy)
+	}
+	if iapclient.BindRoleToMember(cr.Spec.ForProvider, p) {
+		return managed.ExternalObservation{}, nil
+	}
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *webBackendServiceIAMMemberExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.WebBackendServiceIAMMember)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotWebBackendServiceIAMMember)
+	}
+	p, err := e.getPolicy(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetPolicy)
+	}
+	if !iapclient.BindRoleToMember(cr.Spec.ForProvider, p) {
+		return managed.ExternalCreation{}, nil
+	}
+	return managed.ExternalCreation{}, e.setPolicy(ctx, cr, p)
+}
+
+func (e *webBackendServiceIAMMemberExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	// Role and member are immutable, so there is never anything to update.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *webBackendServiceIAMMemberExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.WebBackendServiceIAMMember)
+	if !ok {
+		return errors.New(errNotWebBackendServiceIAMMember)
+	}
+	p, err := e.getPolicy(ctx, cr)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetPolicy)
+	}
+	if !iapclient.UnbindRoleFromMember(cr.Spec.ForProvider, p) {
+		return nil
+	}
+	return e.setPolicy(ctx, cr, p)
+}
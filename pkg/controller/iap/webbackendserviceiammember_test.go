@@ -0,0 +1,230 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iap
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	iap "google.golang.org/api/iap/v1"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/iap/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	accessorRole = "roles/iap.httpsResourceAccessor"
+	staff        = "group:staff@example.com"
+	servicePath  = "/v1/projects/myproject-id-1234/iap_web/compute/services/internal-app"
+)
+
+func newWebBackendServiceIAMMember() *v1alpha1.WebBackendServiceIAMMember {
+	m := &v1alpha1.WebBackendServiceIAMMember{}
+	m.Spec.ForProvider = v1alpha1.WebBackendServiceIAMMemberParameters{
+		BackendService: "internal-app",
+		Role:           accessorRole,
+		Member:         gcp.StringPtr(staff),
+	}
+	return m
+}
+
+func policyHandler(t *testing.T, policy *iap.Policy, getStatus, setStatus int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		if !strings.HasPrefix(r.URL.Path, servicePath+":") {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		status := getStatus
+		switch r.Method + " " + strings.TrimPrefix(r.URL.Path, servicePath) {
+		case http.MethodPost + " :getIamPolicy":
+		case http.MethodPost + " :setIamPolicy":
+			status = setStatus
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(status)
+		if status != http.StatusOK {
+			_ = json.NewEncoder(w).Encode(&iap.Policy{})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(policy)
+	})
+}
+
+func TestWebBackendServiceIAMMemberObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotWebBackendServiceIAMMember": {
+			reason: "Should return an error if the resource is not a WebBackendServiceIAMMember",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotWebBackendServiceIAMMember)},
+		},
+		"BackendServiceNotFound": {
+			reason:  "Should report the member as missing if the backend service does not exist",
+			mg:      newWebBackendServiceIAMMember(),
+			handler: policyHandler(t, &iap.Policy{}, http.StatusNotFound, http.StatusOK),
+		},
+		"GetPolicyFailed": {
+			reason:  "Should return an error if the policy cannot be read",
+			mg:      newWebBackendServiceIAMMember(),
+			handler: policyHandler(t, &iap.Policy{}, http.StatusBadRequest, http.StatusOK),
+			want:    want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetPolicy)},
+		},
+		"NotBound": {
+			reason:  "Should report the member as missing if it is not bound to the role",
+			mg:      newWebBackendServiceIAMMember(),
+			handler: policyHandler(t, &iap.Policy{}, http.StatusOK, http.StatusOK),
+		},
+		"Bound": {
+			reason: "Should report the member as existing if it is bound to the role",
+			mg:     newWebBackendServiceIAMMember(),
+			handler: policyHandler(t, &iap.Policy{
+				Bindings: []*iap.Binding{{Role: accessorRole, Members: []string{staff}}},
+			}, http.StatusOK, http.StatusOK),
+			want: want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := iap.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := webBackendServiceIAMMemberExternal{iap: s.V1, projectID: projectID}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestWebBackendServiceIAMMemberCreate(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		mg      resource.Managed
+		wantErr error
+	}{
+		"NotWebBackendServiceIAMMember": {
+			reason:  "Should return an error if the resource is not a WebBackendServiceIAMMember",
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotWebBackendServiceIAMMember),
+		},
+		"GetPolicyFailed": {
+			reason:  "Should return an error if the policy cannot be read",
+			mg:      newWebBackendServiceIAMMember(),
+			handler: policyHandler(t, &iap.Policy{}, http.StatusBadRequest, http.StatusOK),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errGetPolicy),
+		},
+		"SetPolicyFailed": {
+			reason:  "Should return an error if the policy cannot be written",
+			mg:      newWebBackendServiceIAMMember(),
+			handler: policyHandler(t, &iap.Policy{}, http.StatusOK, http.StatusBadRequest),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errSetPolicy),
+		},
+		"Successful": {
+			reason:  "Should succeed if the policy is written",
+			mg:      newWebBackendServiceIAMMember(),
+			handler: policyHandler(t, &iap.Policy{}, http.StatusOK, http.StatusOK),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := iap.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := webBackendServiceIAMMemberExternal{iap: s.V1, projectID: projectID}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestWebBackendServiceIAMMemberDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		mg      resource.Managed
+		wantErr error
+	}{
+		"NotWebBackendServiceIAMMember": {
+			reason:  "Should return an error if the resource is not a WebBackendServiceIAMMember",
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotWebBackendServiceIAMMember),
+		},
+		"BackendServiceGone": {
+			reason:  "Should not return an error if the backend service is already gone",
+			mg:      newWebBackendServiceIAMMember(),
+			handler: policyHandler(t, &iap.Policy{}, http.StatusNotFound, http.StatusOK),
+		},
+		"SetPolicyFailed": {
+			reason: "Should return an error if the policy cannot be written",
+			mg:     newWebBackendServiceIAMMember(),
+			handler: policyHandler(t, &iap.Policy{
+				Bindings: []*iap.Binding{{Role: accessorRole, Members: []string{staff}}},
+			}, http.StatusOK, http.StatusBadRequest),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errSetPolicy),
+		},
+		"Successful": {
+			reason: "Should succeed if the policy is written",
+			mg:     newWebBackendServiceIAMMember(),
+			handler: policyHandler(t, &iap.Policy{
+				Bindings: []*iap.Binding{{Role: accessorRole, Members: []string{staff}}},
+			}, http.StatusOK, http.StatusOK),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := iap.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := webBackendServiceIAMMemberExternal{iap: s.V1, projectID: projectID}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package identityplatform
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	identitytoolkit "google.golang.org/api/identitytoolkit/v2"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/identityplatform/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	ipclient "github.com/crossplane/provider-gcp/pkg/clients/identityplatform"
+)
+
+// Error strings.
+const (
+	errNewClient      = "cannot create new Identity Platform client"
+	errNotConfig      = "managed resource is not an IdentityPlatformConfig"
+	errGetConfig      = "cannot get Identity Platform configuration"
+	errInitialize     = "cannot initialize Identity Platform"
+	errCreateConfig   = "cannot create Identity Platform configuration"
+	errUpdateConfig   = "cannot update Identity Platform configuration"
+	errUpdateConfigCR = "cannot update IdentityPlatformConfig custom resource"
+)
+
+// SetupIdentityPlatformConfig adds a controller that reconciles
+// IdentityPlatformConfigs.
+func SetupIdentityPlatformConfig(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.IdentityPlatformConfigGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.IdentityPlatformConfigGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.IdentityPlatformConfig{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.IdentityPlatformConfigGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.IdentityPlatformConfigGroupVersionKind),
+			&configConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type configConnector struct {
+	kube client.Client
+}
+
+func (c *configConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := identitytoolkit.NewService(ctx, opts.For("identitytoolkit")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &configExternal{kube: c.kube, projects: s.Projects, platform: s.Projects.IdentityPlatform, projectID: projectID}, nil
+}
+
+type configExternal struct {
+	kube      client.Client
+	projects  *identitytoolkit.ProjectsService
+	platform  *identitytoolkit.ProjectsIdentityPlatformService
+	projectID string
+}
+
+func (e *configExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.IdentityPlatformConfig)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotConfig)
+	}
+	// The configuration lives as long as Identity Platform is enabled in the
+	// project. Resetting it would break sign-in for every app of the
+	// project, so we consider it gone as soon as it is being deleted.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	existing, err := e.projects.GetConfig(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetConfig)
+	}
+	current := cr.Spec.ForProvider.DeepCopy()
+	ipclient.LateInitializeIdentityPlatformConfig(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateConfigCR)
+		}
+	}
+	cr.Status.AtProvider = ipclient.GenerateIdentityPlatformConfigObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ipclient.IsIdentityPlatformConfigUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+// Create enables Identity Platform in the project, which creates its
+// configuration, and then applies the desired configuration.
+func (e *configExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.IdentityPlatformConfig)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotConfig)
+	}
+	cr.SetConditions(xpv1.Creating())
+	parent := ipclient.GetIdentityPlatformConfigParent(e.projectID, cr.Spec.ForProvider)
+	req := &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2InitializeIdentityPlatformRequest{}
+	if _, err := e.platform.InitializeAuth(parent, req).Context(ctx).Do(); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errInitialize)
+	}
+	_, err := e.projects.UpdateConfig(e.name(cr), ipclient.GenerateIdentityPlatformConfig(cr.Spec.ForProvider)).
+		UpdateMask(ipclient.IdentityPlatformConfigUpdateMask).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateConfig)
+}
+
+func (e *configExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.IdentityPlatformConfig)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotConfig)
+	}
+	_, err := e.projects.UpdateConfig(e.name(cr), ipclient.GenerateIdentityPlatformConfig(cr.Spec.ForProvider)).
+		UpdateMask(ipclient.IdentityPlatformConfigUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateConfig)
+}
+
+// Delete leaves the configuration as it is. Identity Platform can't be
+// disabled through the API, so we just let go of the configuration.
+func (e *configExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.IdentityPlatformConfig)
+	if !ok {
+		return errors.New(errNotConfig)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	return nil
+}
+
+func (e *configExternal) name(cr *v1alpha1.IdentityPlatformConfig) string {
+	return ipclient.GetIdentityPlatformConfigName(ipclient.GetIdentityPlatformConfigParent(e.projectID, cr.Spec.ForProvider))
+}
@@ -0,0 +1,315 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package identityplatform
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	identitytoolkit "google.golang.org/api/identitytoolkit/v2"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/identityplatform/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	ipclient "github.com/crossplane/provider-gcp/pkg/clients/identityplatform"
+)
+
+const (
+	projectID      = "myproject-id-1234"
+	configName     = "projects/myproject-id-1234/config"
+	configPath     = "/v2/" + configName
+	initializePath = "/v2/projects/myproject-id-1234/identityPlatform:initializeAuth"
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newConfig(m ...func(*v1alpha1.IdentityPlatformConfig)) *v1alpha1.IdentityPlatformConfig {
+	cr := &v1alpha1.IdentityPlatformConfig{}
+	cr.Spec.ForProvider = v1alpha1.IdentityPlatformConfigParameters{
+		AuthorizedDomains: []string{"localhost", "app.example.com"},
+		SignIn: &v1alpha1.SignInConfig{
+			Email: &v1alpha1.EmailSignInConfig{Enabled: true, PasswordRequired: gcp.BoolPtr(true)},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func config(m ...func(*identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Config)) *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Config {
+	c := &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Config{
+		Name:              configName,
+		Subtype:           "IDENTITY_PLATFORM",
+		AuthorizedDomains: []string{"localhost", "app.example.com"},
+		SignIn: &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2SignInConfig{
+			Email: &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Email{Enabled: true, PasswordRequired: true},
+		},
+	}
+	for _, f := range m {
+		f(c)
+	}
+	return c
+}
+
+func TestIdentityPlatformConfigObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		obs v1alpha1.IdentityPlatformConfigObservation
+		err error
+	}
+
+	obs := v1alpha1.IdentityPlatformConfigObservation{
+		Name:    configName,
+		Subtype: "IDENTITY_PLATFORM",
+	}
+	cases := map[string]struct {
+		reason string
+		status int
+		config *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Config
+		kube   *test.MockClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotConfig": {
+			reason: "Should return an error if the resource is not an IdentityPlatformConfig",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotConfig)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if Identity Platform is not enabled",
+			status: http.StatusNotFound,
+			mg:     newConfig(),
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the configuration fails",
+			status: http.StatusBadRequest,
+			mg:     newConfig(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetConfig)},
+		},
+		"LateInitFailed": {
+			reason: "Should return an error if the late initialized spec can't be saved",
+			status: http.StatusOK,
+			config: config(),
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:     newConfig(func(cr *v1alpha1.IdentityPlatformConfig) { cr.Spec.ForProvider.AuthorizedDomains = nil }),
+			want:   want{err: errors.Wrap(errBoom, errUpdateConfigCR)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should report the observation of an up to date configuration",
+			status: http.StatusOK,
+			config: config(),
+			mg:     newConfig(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				obs: obs,
+			},
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should report a configuration that differs from the spec as not up to date",
+			status: http.StatusOK,
+			config: config(func(c *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Config) {
+				c.SignIn.Email.PasswordRequired = false
+			}),
+			mg: newConfig(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				obs: obs,
+			},
+		},
+		"Deleted": {
+			reason: "Should report a configuration that is being deleted as gone without looking it up",
+			status: http.StatusBadRequest,
+			mg: newConfig(func(cr *v1alpha1.IdentityPlatformConfig) {
+				now := metav1.Now()
+				cr.SetDeletionTimestamp(&now)
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet+" "+configPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.config == nil {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.config)
+			}))
+			defer server.Close()
+			s, _ := identitytoolkit.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			kube := tc.kube
+			if kube == nil {
+				kube = &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}
+			}
+			e := &configExternal{kube: kube, projects: s.Projects, platform: s.Projects.IdentityPlatform, projectID: projectID}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.IdentityPlatformConfig); ok && err == nil {
+				if diff := cmp.Diff(tc.want.obs, cr.Status.AtProvider); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want observation, +got observation:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestIdentityPlatformConfigWrite(t *testing.T) {
+	type request struct {
+		method string
+		path   string
+		status int
+	}
+
+	updateMask := ipclient.IdentityPlatformConfigUpdateMask
+	cases := map[string]struct {
+		reason   string
+		requests []request
+		call     func(*configExternal, resource.Managed) error
+		want     error
+	}{
+		"CreateSuccessful": {
+			reason: "Should enable Identity Platform and then update its configuration",
+			requests: []request{
+				{method: http.MethodPost, path: initializePath, status: http.StatusOK},
+				{method: http.MethodPatch, path: configPath, status: http.StatusOK},
+			},
+			call: func(e *configExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+		},
+		"InitializeFailed": {
+			reason: "Should return an error if Identity Platform can't be enabled",
+			requests: []request{
+				{method: http.MethodPost, path: initializePath, status: http.StatusBadRequest},
+			},
+			call: func(e *configExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errInitialize),
+		},
+		"CreateFailed": {
+			reason: "Should return an error if the configuration can't be updated after enabling Identity Platform",
+			requests: []request{
+				{method: http.MethodPost, path: initializePath, status: http.StatusOK},
+				{method: http.MethodPatch, path: configPath, status: http.StatusBadRequest},
+			},
+			call: func(e *configExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateConfig),
+		},
+		"UpdateSuccessful": {
+			reason: "Should update the configuration",
+			requests: []request{
+				{method: http.MethodPatch, path: configPath, status: http.StatusOK},
+			},
+			call: func(e *configExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+		},
+		"UpdateFailed": {
+			reason: "Should return an error if updating the configuration fails",
+			requests: []request{
+				{method: http.MethodPatch, path: configPath, status: http.StatusBadRequest},
+			},
+			call: func(e *configExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateConfig),
+		},
+		"DeleteSuccessful": {
+			reason: "Should leave the configuration alone",
+			call: func(e *configExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			i := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if i >= len(tc.requests) {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				req := tc.requests[i]
+				i++
+				if diff := cmp.Diff(req.method+" "+req.path, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if r.Method == http.MethodPatch {
+					if diff := cmp.Diff(updateMask, r.URL.Query().Get("updateMask")); diff != "" {
+						t.Errorf("updateMask: -want, +got:\n%s", diff)
+					}
+				}
+				w.WriteHeader(req.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := identitytoolkit.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &configExternal{projects: s.Projects, platform: s.Projects.IdentityPlatform, projectID: projectID}
+			err := tc.call(e, newConfig())
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(len(tc.requests), i); diff != "" {
+				t.Errorf("\n%s\nrequests: -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package identityplatform
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	identitytoolkit "google.golang.org/api/identitytoolkit/v2"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/identityplatform/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	ipclient "github.com/crossplane/provider-gcp/pkg/clients/identityplatform"
+)
+
+// Error strings.
+const (
+	errNotTenant      = "managed resource is not a Tenant"
+	errGetTenant      = "cannot get Tenant"
+	errCreateTenant   = "cannot create Tenant"
+	errUpdateTenant   = "cannot update Tenant"
+	errDeleteTenant   = "cannot delete Tenant"
+	errUpdateTenantCR = "cannot update Tenant custom resource"
+)
+
+// SetupTenant adds a controller that reconciles Tenants.
+func SetupTenant(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.TenantGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.TenantGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Tenant{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.TenantGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.TenantGroupVersionKind),
+			&tenantConnector{kube: mgr.GetClient()},
+			managed.WithInitializers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type tenantConnector struct {
+	kube client.Client
+}
+
+func (c *tenantConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := identitytoolkit.NewService(ctx, opts.For("identitytoolkit")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &tenantExternal{kube: c.kube, tenants: s.Projects.Tenants, projectID: projectID}, nil
+}
+
+type tenantExternal struct {
+	kube      client.Client
+	tenants   *identitytoolkit.ProjectsTenantsService
+	projectID string
+}
+
+func (e *tenantExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Tenant)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTenant)
+	}
+	// Tenant IDs are assigned by Identity Platform, so until we've created
+	// the tenant we have nothing to look up.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	existing, err := e.tenants.Get(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetTenant)
+	}
+	current := cr.Spec.ForProvider.DeepCopy()
+	ipclient.LateInitializeTenant(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateTenantCR)
+		}
+	}
+	cr.Status.AtProvider = ipclient.GenerateTenantObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ipclient.IsTenantUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *tenantExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Tenant)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTenant)
+	}
+	cr.SetConditions(xpv1.Creating())
+	t, err := e.tenants.Create(ipclient.GetTenantParent(e.projectID, cr.Spec.ForProvider), ipclient.GenerateTenant(cr.Spec.ForProvider)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateTenant)
+	}
+	meta.SetExternalName(cr, ipclient.GetTenantID(t.Name))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *tenantExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Tenant)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotTenant)
+	}
+	_, err := e.tenants.Patch(e.name(cr), ipclient.GenerateTenant(cr.Spec.ForProvider)).
+		UpdateMask(ipclient.TenantUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTenant)
+}
+
+func (e *tenantExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Tenant)
+	if !ok {
+		return errors.New(errNotTenant)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.tenants.Delete(e.name(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteTenant)
+}
+
+func (e *tenantExternal) name(cr *v1alpha1.Tenant) string {
+	return ipclient.GetTenantName(ipclient.GetTenantParent(e.projectID, cr.Spec.ForProvider), meta.GetExternalName(cr))
+}
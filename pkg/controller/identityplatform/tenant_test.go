@@ -0,0 +1,307 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package identityplatform
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	identitytoolkit "google.golang.org/api/identitytoolkit/v2"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/identityplatform/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	ipclient "github.com/crossplane/provider-gcp/pkg/clients/identityplatform"
+)
+
+const (
+	tenantID   = "customers-a1b2c"
+	tenantName = "projects/myproject-id-1234/tenants/" + tenantID
+	tenantPath = "/v2/" + tenantName
+)
+
+func newTenant(m ...func(*v1alpha1.Tenant)) *v1alpha1.Tenant {
+	cr := &v1alpha1.Tenant{}
+	meta.SetExternalName(cr, tenantID)
+	cr.Spec.ForProvider = v1alpha1.TenantParameters{
+		DisplayName:         "customers",
+		AllowPasswordSignup: gcp.BoolPtr(true),
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func tenant(m ...func(*identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Tenant)) *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Tenant {
+	t := &identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Tenant{
+		Name:                tenantName,
+		DisplayName:         "customers",
+		AllowPasswordSignup: true,
+	}
+	for _, f := range m {
+		f(t)
+	}
+	return t
+}
+
+func TestTenantObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		obs v1alpha1.TenantObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		status int
+		tenant *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Tenant
+		kube   *test.MockClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotTenant": {
+			reason: "Should return an error if the resource is not a Tenant",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotTenant)},
+		},
+		"NoExternalName": {
+			reason: "Should report a tenant without an external name as not existing",
+			status: http.StatusBadRequest,
+			mg:     newTenant(func(cr *v1alpha1.Tenant) { meta.SetExternalName(cr, "") }),
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the tenant does not exist",
+			status: http.StatusNotFound,
+			mg:     newTenant(),
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the tenant fails",
+			status: http.StatusBadRequest,
+			mg:     newTenant(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetTenant)},
+		},
+		"LateInitFailed": {
+			reason: "Should return an error if the late initialized spec can't be saved",
+			status: http.StatusOK,
+			tenant: tenant(func(t *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Tenant) { t.EnableEmailLinkSignin = true }),
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:     newTenant(),
+			want:   want{err: errors.Wrap(errBoom, errUpdateTenantCR)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should report the observation of an up to date tenant",
+			status: http.StatusOK,
+			tenant: tenant(),
+			mg:     newTenant(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				obs: v1alpha1.TenantObservation{Name: tenantName},
+			},
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should report a tenant that differs from the spec as not up to date",
+			status: http.StatusOK,
+			tenant: tenant(func(t *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Tenant) { t.DisplayName = "partners" }),
+			mg:     newTenant(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				obs: v1alpha1.TenantObservation{Name: tenantName},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet+" "+tenantPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.tenant == nil {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.tenant)
+			}))
+			defer server.Close()
+			s, _ := identitytoolkit.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			kube := tc.kube
+			if kube == nil {
+				kube = &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}
+			}
+			e := &tenantExternal{kube: kube, tenants: s.Projects.Tenants, projectID: projectID}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Tenant); ok && err == nil {
+				if diff := cmp.Diff(tc.want.obs, cr.Status.AtProvider); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want observation, +got observation:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestTenantCreate(t *testing.T) {
+	type want struct {
+		e            managed.ExternalCreation
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		status int
+		tenant *identitytoolkit.GoogleCloudIdentitytoolkitAdminV2Tenant
+		want   want
+	}{
+		"CreateSuccessful": {
+			reason: "Should use the server-assigned tenant ID as external name",
+			status: http.StatusOK,
+			tenant: tenant(),
+			want: want{
+				e:            managed.ExternalCreation{ExternalNameAssigned: true},
+				externalName: tenantID,
+			},
+		},
+		"CreateFailed": {
+			reason: "Should return an error if creating the tenant fails",
+			status: http.StatusBadRequest,
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateTenant)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost+" /v2/projects/myproject-id-1234/tenants", r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.tenant == nil {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.tenant)
+			}))
+			defer server.Close()
+			s, _ := identitytoolkit.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			cr := newTenant(func(cr *v1alpha1.Tenant) { meta.SetExternalName(cr, "") })
+			got, err := (&tenantExternal{tenants: s.Projects.Tenants, projectID: projectID}).Create(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want external name, +got external name:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestTenantWrite(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		method string
+		query  map[string]string
+		status int
+		call   func(*tenantExternal, resource.Managed) error
+		want   error
+	}{
+		"UpdateSuccessful": {
+			reason: "Should patch the tenant",
+			method: http.MethodPatch,
+			query:  map[string]string{"updateMask": ipclient.TenantUpdateMask},
+			status: http.StatusOK,
+			call: func(e *tenantExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+		},
+		"UpdateFailed": {
+			reason: "Should return an error if patching the tenant fails",
+			method: http.MethodPatch,
+			query:  map[string]string{"updateMask": ipclient.TenantUpdateMask},
+			status: http.StatusBadRequest,
+			call: func(e *tenantExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateTenant),
+		},
+		"DeleteNotFound": {
+			reason: "Should not return an error if the tenant is already gone",
+			method: http.MethodDelete,
+			status: http.StatusNotFound,
+			call: func(e *tenantExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+		},
+		"DeleteFailed": {
+			reason: "Should return an error if deleting the tenant fails",
+			method: http.MethodDelete,
+			status: http.StatusBadRequest,
+			call: func(e *tenantExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteTenant),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method+" "+tenantPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				for k, v := range tc.query {
+					if diff := cmp.Diff(v, r.URL.Query().Get(k)); diff != "" {
+						t.Errorf("%s: -want, +got:\n%s", k, diff)
+					}
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := identitytoolkit.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			err := tc.call(&tenantExternal{tenants: s.Projects.Tenants, projectID: projectID}, newTenant())
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,294 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ids
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	ids "google.golang.org/api/ids/v1"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/ids/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID    = "myproject-id-1234"
+	endpointName = "projects/myproject-id-1234/locations/us-central1-a/endpoints/inspect"
+	endpointPath = "/v1/" + endpointName
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newEndpoint(m ...func(*v1alpha1.Endpoint)) *v1alpha1.Endpoint {
+	cr := &v1alpha1.Endpoint{}
+	meta.SetExternalName(cr, "inspect")
+	cr.Spec.ForProvider = v1alpha1.EndpointParameters{
+		Location:         "us-central1-a",
+		Network:          gcp.StringPtr("projects/myproject-id-1234/global/networks/default"),
+		Severity:         "MEDIUM",
+		Description:      gcp.StringPtr("Inspect production traffic"),
+		ThreatExceptions: []string{"1001"},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func endpoint(m ...func(*ids.Endpoint)) *ids.Endpoint {
+	e := &ids.Endpoint{
+		Name:                   endpointName,
+		State:                  v1alpha1.EndpointStateReady,
+		EndpointForwardingRule: "https://www.googleapis.com/compute/v1/projects/tenant/regions/us-central1/forwardingRules/ids-fr-inspect",
+		EndpointIp:             "10.0.2.3",
+		Network:                "projects/myproject-id-1234/global/networks/default",
+		Severity:               "MEDIUM",
+		Description:            "Inspect production traffic",
+		ThreatExceptions:       []string{"1001"},
+	}
+	for _, f := range m {
+		f(e)
+	}
+	return e
+}
+
+func TestEndpointObserve(t *testing.T) {
+	type want struct {
+		e    managed.ExternalObservation
+		cond xpv1.Condition
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason   string
+		status   int
+		endpoint *ids.Endpoint
+		kube     *test.MockClient
+		mg       resource.Managed
+		want     want
+	}{
+		"NotEndpoint": {
+			reason: "Should return an error if the resource is not an Endpoint",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotEndpoint)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the endpoint does not exist",
+			status: http.StatusNotFound,
+			mg:     newEndpoint(),
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the endpoint fails",
+			status: http.StatusBadRequest,
+			mg:     newEndpoint(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetEndpoint)},
+		},
+		"LateInitFailed": {
+			reason:   "Should return an error if the late initialized spec can't be saved",
+			status:   http.StatusOK,
+			endpoint: endpoint(),
+			kube:     &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:       newEndpoint(func(cr *v1alpha1.Endpoint) { cr.Spec.ForProvider.Description = nil }),
+			want:     want{err: errors.Wrap(errBoom, errUpdateEndpointCR)},
+		},
+		"Ready": {
+			reason:   "Should report a ready, up to date endpoint as available",
+			status:   http.StatusOK,
+			endpoint: endpoint(),
+			mg:       newEndpoint(),
+			want: want{
+				e:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Available(),
+			},
+		},
+		"Creating": {
+			reason:   "Should report an endpoint that is being created as creating",
+			status:   http.StatusOK,
+			endpoint: endpoint(func(e *ids.Endpoint) { e.State = v1alpha1.EndpointStateCreating }),
+			mg:       newEndpoint(),
+			want: want{
+				e:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Creating(),
+			},
+		},
+		"NeedsUpdate": {
+			reason:   "Should return upToDate as false if the threat exceptions differ",
+			status:   http.StatusOK,
+			endpoint: endpoint(func(e *ids.Endpoint) { e.ThreatExceptions = nil }),
+			mg:       newEndpoint(),
+			want: want{
+				e:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				cond: xpv1.Available(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet+" "+endpointPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.endpoint == nil {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.endpoint)
+			}))
+			defer server.Close()
+			s, _ := ids.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &endpointExternal{kube: tc.kube, endpoints: s.Projects.Locations.Endpoints, projectID: projectID}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Endpoint); ok && got.ResourceExists {
+				if diff := cmp.Diff(tc.want.cond, cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want condition, +got condition:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestEndpointWrite(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		method string
+		path   string
+		query  string
+		status int
+		call   func(*endpointExternal, resource.Managed) error
+		want   error
+	}{
+		"CreateSuccessful": {
+			reason: "Should create the endpoint with the external name as ID",
+			method: http.MethodPost,
+			path:   "/v1/projects/myproject-id-1234/locations/us-central1-a/endpoints",
+			query:  "inspect",
+			status: http.StatusOK,
+			call: func(e *endpointExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+		},
+		"CreateFailed": {
+			reason: "Should return an error if creating the endpoint fails",
+			method: http.MethodPost,
+			path:   "/v1/projects/myproject-id-1234/locations/us-central1-a/endpoints",
+			query:  "inspect",
+			status: http.StatusBadRequest,
+			call: func(e *endpointExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateEndpoint),
+		},
+		"UpdateSuccessful": {
+			reason: "Should patch the endpoint",
+			method: http.MethodPatch,
+			path:   endpointPath,
+			status: http.StatusOK,
+			call: func(e *endpointExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+		},
+		"UpdateFailed": {
+			reason: "Should return an error if patching the endpoint fails",
+			method: http.MethodPatch,
+			path:   endpointPath,
+			status: http.StatusBadRequest,
+			call: func(e *endpointExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateEndpoint),
+		},
+		"DeleteNotFound": {
+			reason: "Should not return an error if the endpoint is already gone",
+			method: http.MethodDelete,
+			path:   endpointPath,
+			status: http.StatusNotFound,
+			call: func(e *endpointExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+		},
+		"DeleteFailed": {
+			reason: "Should return an error if deleting the endpoint fails",
+			method: http.MethodDelete,
+			path:   endpointPath,
+			status: http.StatusBadRequest,
+			call: func(e *endpointExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteEndpoint),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method+" "+tc.path, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.query, r.URL.Query().Get("endpointId")); diff != "" {
+					t.Errorf("endpointId: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := ids.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			err := tc.call(&endpointExternal{endpoints: s.Projects.Locations.Endpoints, projectID: projectID}, newEndpoint())
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
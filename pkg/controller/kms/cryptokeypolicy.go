@@ -47,18 +47,20 @@ const (
 )
 
 // SetupCryptoKeyPolicy adds a controller that reconciles CryptoKeyPolicys.
-func SetupCryptoKeyPolicy(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+func SetupCryptoKeyPolicy(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
 	name := managed.ControllerName(v1alpha1.CryptoKeyPolicyGroupKind)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: mcr.For(v1alpha1.CryptoKeyPolicyGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
 		}).
 		For(&v1alpha1.CryptoKeyPolicy{}).
-		Complete(managed.NewReconciler(mgr,
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.CryptoKeyPolicyGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.CryptoKeyPolicyGroupVersionKind),
-			managed.WithExternalConnecter(&cryptoKeyPolicyConnecter{client: mgr.GetClient()}),
+			&cryptoKeyPolicyConnecter{client: mgr.GetClient()},
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -75,7 +77,7 @@ func (c *cryptoKeyPolicyConnecter) Connect(ctx context.Context, mg resource.Mana
 	if err != nil {
 		return nil, err
 	}
-	s, err := kmsv1.NewService(ctx, opts)
+	s, err := kmsv1.NewService(ctx, opts.For("cloudkms")...)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
@@ -169,10 +171,8 @@ func (e *cryptoKeyPolicyExternal) Delete(ctx context.Context, mg resource.Manage
 	if !ok {
 		return errors.New(errNotCryptoKeyPolicy)
 	}
+	// There is no policy to empty if the crypto key is gone.
 	req := &kmsv1.SetIamPolicyRequest{Policy: &kmsv1.Policy{}}
-	if _, err := e.cryptokeyspolicy.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.CryptoKey), req).
-		Context(ctx).Do(); err != nil {
-		return errors.Wrap(err, errSetPolicy)
-	}
-	return nil
+	_, err := e.cryptokeyspolicy.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.CryptoKey), req).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errSetPolicy)
 }
@@ -0,0 +1,174 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	cloudlogging "google.golang.org/api/logging/v2"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/logging/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	lgclient "github.com/crossplane/provider-gcp/pkg/clients/logging"
+)
+
+// Error strings.
+const (
+	errNotLogBucket      = "managed resource is not a LogBucket"
+	errGetLogBucket      = "cannot get LogBucket"
+	errCreateLogBucket   = "cannot create LogBucket"
+	errUpdateLogBucket   = "cannot update LogBucket"
+	errUndeleteLogBucket = "cannot undelete LogBucket"
+	errDeleteLogBucket   = "cannot delete LogBucket"
+	errUpdateLogBucketCR = "cannot update LogBucket custom resource"
+)
+
+// SetupLogBucket adds a controller that reconciles LogBuckets.
+func SetupLogBucket(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.LogBucketGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.LogBucketGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.LogBucket{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.LogBucketGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.LogBucketGroupVersionKind),
+			&logBucketConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type logBucketConnector struct {
+	kube client.Client
+}
+
+func (c *logBucketConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := cloudlogging.NewService(ctx, opts.For("logging")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	// Like the sinks service, the top level buckets service accepts project
+	// and folder parents alike.
+	return &logBucketExternal{kube: c.kube, buckets: s.Locations.Buckets, projectID: projectID}, nil
+}
+
+type logBucketExternal struct {
+	kube      client.Client
+	buckets   *cloudlogging.LocationsBucketsService
+	projectID string
+}
+
+func (e *logBucketExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.LogBucket)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotLogBucket)
+	}
+	existing, err := e.buckets.Get(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetLogBucket)
+	}
+	// Deleted buckets are kept around for a grace period during which their
+	// ID can't be reused. Such a bucket is gone as far as a deletion is
+	// concerned, but it has to be restored if it's still desired.
+	if existing.LifecycleState == v1alpha1.LogBucketStateDeleteRequested && meta.WasDeleted(cr) {
+		return managed.ExternalObservation{}, nil
+	}
+	current := cr.Spec.ForProvider.DeepCopy()
+	lgclient.LateInitializeLogBucket(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateLogBucketCR)
+		}
+	}
+	cr.Status.AtProvider = lgclient.GenerateLogBucketObservation(*existing)
+	if existing.LifecycleState != v1alpha1.LogBucketStateActive {
+		cr.Status.SetConditions(xpv1.Unavailable())
+	} else {
+		cr.Status.SetConditions(xpv1.Available())
+	}
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: existing.LifecycleState != v1alpha1.LogBucketStateDeleteRequested &&
+			lgclient.IsLogBucketUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *logBucketExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.LogBucket)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotLogBucket)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	location := lgclient.GetBucketLocationName(lgclient.GetBucketParent(e.projectID, cr.Spec.ForProvider), cr.Spec.ForProvider)
+	_, err := e.buckets.Create(location, lgclient.GenerateLogBucket(cr.Spec.ForProvider)).
+		BucketId(meta.GetExternalName(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateLogBucket)
+}
+
+func (e *logBucketExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.LogBucket)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotLogBucket)
+	}
+	if cr.Status.AtProvider.LifecycleState == v1alpha1.LogBucketStateDeleteRequested {
+		if _, err := e.buckets.Undelete(e.name(cr), &cloudlogging.UndeleteBucketRequest{}).Context(ctx).Do(); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUndeleteLogBucket)
+		}
+	}
+	_, err := e.buckets.Patch(e.name(cr), lgclient.GenerateLogBucket(cr.Spec.ForProvider)).
+		UpdateMask(lgclient.GetLogBucketUpdateMask(cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateLogBucket)
+}
+
+func (e *logBucketExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.LogBucket)
+	if !ok {
+		return errors.New(errNotLogBucket)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.buckets.Delete(e.name(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteLogBucket)
+}
+
+func (e *logBucketExternal) name(cr *v1alpha1.LogBucket) string {
+	return lgclient.GetBucketName(lgclient.GetBucketParent(e.projectID, cr.Spec.ForProvider), meta.GetExternalName(cr), cr.Spec.ForProvider)
+}
@@ -0,0 +1,293 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cloudlogging "google.golang.org/api/logging/v2"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/logging/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	bucketName = "projects/myproject-id-1234/locations/global/buckets/audit"
+	bucketPath = "/v2/" + bucketName
+)
+
+func newLogBucket(m ...func(*v1alpha1.LogBucket)) *v1alpha1.LogBucket {
+	cr := &v1alpha1.LogBucket{}
+	meta.SetExternalName(cr, "audit")
+	cr.Spec.ForProvider = v1alpha1.LogBucketParameters{
+		Location:      "global",
+		Description:   gcp.StringPtr("Audit logs"),
+		RetentionDays: gcp.Int64Ptr(400),
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func bucket(state string, retention int64) *cloudlogging.LogBucket {
+	return &cloudlogging.LogBucket{
+		Name:           bucketName,
+		Description:    "Audit logs",
+		RetentionDays:  retention,
+		LifecycleState: state,
+	}
+}
+
+func TestLogBucketObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		obs v1alpha1.LogBucketObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		status int
+		bucket *cloudlogging.LogBucket
+		kube   *test.MockClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotLogBucket": {
+			reason: "Should return an error if the resource is not a LogBucket",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotLogBucket)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the bucket does not exist",
+			status: http.StatusNotFound,
+			mg:     newLogBucket(),
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the bucket fails",
+			status: http.StatusBadRequest,
+			mg:     newLogBucket(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetLogBucket)},
+		},
+		"LateInitFailed": {
+			reason: "Should return an error if the late initialized spec can't be saved",
+			status: http.StatusOK,
+			bucket: bucket(v1alpha1.LogBucketStateActive, 400),
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:     newLogBucket(func(cr *v1alpha1.LogBucket) { cr.Spec.ForProvider.RetentionDays = nil }),
+			want:   want{err: errors.Wrap(errBoom, errUpdateLogBucketCR)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should report an active bucket matching the spec as up to date",
+			status: http.StatusOK,
+			bucket: bucket(v1alpha1.LogBucketStateActive, 400),
+			mg:     newLogBucket(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				obs: v1alpha1.LogBucketObservation{Name: bucketName, LifecycleState: v1alpha1.LogBucketStateActive},
+			},
+		},
+		"NeedsUpdate": {
+			reason: "Should return upToDate as false if the retention differs",
+			status: http.StatusOK,
+			bucket: bucket(v1alpha1.LogBucketStateActive, 30),
+			mg:     newLogBucket(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				obs: v1alpha1.LogBucketObservation{Name: bucketName, LifecycleState: v1alpha1.LogBucketStateActive},
+			},
+		},
+		"NeedsUndelete": {
+			reason: "Should return upToDate as false if a desired bucket was deleted",
+			status: http.StatusOK,
+			bucket: bucket(v1alpha1.LogBucketStateDeleteRequested, 400),
+			mg:     newLogBucket(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				obs: v1alpha1.LogBucketObservation{Name: bucketName, LifecycleState: v1alpha1.LogBucketStateDeleteRequested},
+			},
+		},
+		"DeleteRequested": {
+			reason: "Should report a deleted bucket as gone if the resource is being deleted",
+			status: http.StatusOK,
+			bucket: bucket(v1alpha1.LogBucketStateDeleteRequested, 400),
+			mg: newLogBucket(func(cr *v1alpha1.LogBucket) {
+				now := metav1.Now()
+				cr.SetDeletionTimestamp(&now)
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet+" "+bucketPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.bucket == nil {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.bucket)
+			}))
+			defer server.Close()
+			s, _ := cloudlogging.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &logBucketExternal{kube: tc.kube, buckets: s.Locations.Buckets, projectID: projectID}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.LogBucket); ok && err == nil {
+				if diff := cmp.Diff(tc.want.obs, cr.Status.AtProvider); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want observation, +got observation:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestLogBucketWrite(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		method string
+		path   string
+		status int
+		mg     resource.Managed
+		call   func(*logBucketExternal, resource.Managed) error
+		want   error
+	}{
+		"CreateSuccessful": {
+			reason: "Should create the bucket in its location",
+			method: http.MethodPost,
+			path:   "/v2/projects/myproject-id-1234/locations/global/buckets",
+			status: http.StatusOK,
+			mg:     newLogBucket(),
+			call: func(e *logBucketExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+		},
+		"CreateFailed": {
+			reason: "Should return an error if creating the bucket fails",
+			method: http.MethodPost,
+			path:   "/v2/folders/1234/locations/global/buckets",
+			status: http.StatusConflict,
+			mg:     newLogBucket(func(cr *v1alpha1.LogBucket) { cr.Spec.ForProvider.Parent = gcp.StringPtr("folders/1234") }),
+			call: func(e *logBucketExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusConflict, ""), errCreateLogBucket),
+		},
+		"UpdateSuccessful": {
+			reason: "Should patch the bucket",
+			method: http.MethodPatch,
+			path:   bucketPath,
+			status: http.StatusOK,
+			mg:     newLogBucket(),
+			call: func(e *logBucketExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+		},
+		"UpdateFailed": {
+			reason: "Should return an error if patching the bucket fails",
+			method: http.MethodPatch,
+			path:   bucketPath,
+			status: http.StatusBadRequest,
+			mg:     newLogBucket(),
+			call: func(e *logBucketExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateLogBucket),
+		},
+		"UndeleteFailed": {
+			reason: "Should return an error if restoring a deleted bucket fails",
+			method: http.MethodPost,
+			path:   bucketPath + ":undelete",
+			status: http.StatusBadRequest,
+			mg: newLogBucket(func(cr *v1alpha1.LogBucket) {
+				cr.Status.AtProvider.LifecycleState = v1alpha1.LogBucketStateDeleteRequested
+			}),
+			call: func(e *logBucketExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUndeleteLogBucket),
+		},
+		"DeleteNotFound": {
+			reason: "Should not return an error if the bucket is already gone",
+			method: http.MethodDelete,
+			path:   bucketPath,
+			status: http.StatusNotFound,
+			mg:     newLogBucket(),
+			call: func(e *logBucketExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+		},
+		"DeleteFailed": {
+			reason: "Should return an error if deleting the bucket fails",
+			method: http.MethodDelete,
+			path:   bucketPath,
+			status: http.StatusBadRequest,
+			mg:     newLogBucket(),
+			call: func(e *logBucketExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteLogBucket),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method+" "+tc.path, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := cloudlogging.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			err := tc.call(&logBucketExternal{buckets: s.Locations.Buckets, projectID: projectID}, tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
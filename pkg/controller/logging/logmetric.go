@@ -0,0 +1,166 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	cloudlogging "google.golang.org/api/logging/v2"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/logging/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	lgclient "github.com/crossplane/provider-gcp/pkg/clients/logging"
+)
+
+// Error strings.
+const (
+	errNotLogMetric      = "managed resource is not a LogMetric"
+	errGetLogMetric      = "cannot get LogMetric"
+	errCreateLogMetric   = "cannot create LogMetric"
+	errUpdateLogMetric   = "cannot update LogMetric"
+	errDeleteLogMetric   = "cannot delete LogMetric"
+	errUpdateLogMetricCR = "cannot update LogMetric custom resource"
+	errCompareLogMetric  = "cannot compare LogMetric"
+	errGenerateLogMetric = "cannot generate LogMetric"
+)
+
+// SetupLogMetric adds a controller that reconciles LogMetrics.
+func SetupLogMetric(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.LogMetricGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.LogMetricGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.LogMetric{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.LogMetricGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.LogMetricGroupVersionKind),
+			&logMetricConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type logMetricConnector struct {
+	kube client.Client
+}
+
+func (c *logMetricConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := cloudlogging.NewService(ctx, opts.For("logging")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &logMetricExternal{kube: c.kube, metrics: s.Projects.Metrics, projectID: projectID}, nil
+}
+
+type logMetricExternal struct {
+	kube      client.Client
+	metrics   *cloudlogging.ProjectsMetricsService
+	projectID string
+}
+
+func (e *logMetricExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.LogMetric)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotLogMetric)
+	}
+	existing, err := e.metrics.Get(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetLogMetric)
+	}
+	current := cr.Spec.ForProvider.DeepCopy()
+	lgclient.LateInitializeLogMetric(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateLogMetricCR)
+		}
+	}
+	cr.Status.AtProvider = lgclient.GenerateLogMetricObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	upToDate, err := lgclient.IsLogMetricUpToDate(cr.Spec.ForProvider, *existing)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCompareLogMetric)
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *logMetricExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.LogMetric)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotLogMetric)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	m, err := lgclient.GenerateLogMetric(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGenerateLogMetric)
+	}
+	_, err = e.metrics.Create(lgclient.GetMetricParent(e.projectID, cr.Spec.ForProvider), m).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateLogMetric)
+}
+
+func (e *logMetricExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.LogMetric)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotLogMetric)
+	}
+	m, err := lgclient.GenerateLogMetric(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGenerateLogMetric)
+	}
+	_, err = e.metrics.Update(e.name(cr), m).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateLogMetric)
+}
+
+func (e *logMetricExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.LogMetric)
+	if !ok {
+		return errors.New(errNotLogMetric)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.metrics.Delete(e.name(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteLogMetric)
+}
+
+func (e *logMetricExternal) name(cr *v1alpha1.LogMetric) string {
+	return lgclient.GetMetricName(lgclient.GetMetricParent(e.projectID, cr.Spec.ForProvider), meta.GetExternalName(cr))
+}
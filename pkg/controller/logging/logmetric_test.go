@@ -0,0 +1,280 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cloudlogging "google.golang.org/api/logging/v2"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/logging/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	metricPath = "/v2/projects/myproject-id-1234/metrics/errors"
+	metricType = "logging.googleapis.com/user/errors"
+)
+
+func newLogMetric(m ...func(*v1alpha1.LogMetric)) *v1alpha1.LogMetric {
+	cr := &v1alpha1.LogMetric{}
+	meta.SetExternalName(cr, "errors")
+	cr.Spec.ForProvider = v1alpha1.LogMetricParameters{
+		Filter:      "severity>=ERROR",
+		Description: gcp.StringPtr("Error count"),
+		MetricDescriptor: &v1alpha1.MetricDescriptor{
+			MetricKind: gcp.StringPtr("DELTA"),
+			ValueType:  gcp.StringPtr("INT64"),
+			Unit:       gcp.StringPtr("1"),
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func metric(filter string) *cloudlogging.LogMetric {
+	return &cloudlogging.LogMetric{
+		Name:        "errors",
+		Filter:      filter,
+		Description: "Error count",
+		MetricDescriptor: &cloudlogging.MetricDescriptor{
+			Type:       metricType,
+			MetricKind: "DELTA",
+			ValueType:  "INT64",
+			Unit:       "1",
+		},
+	}
+}
+
+func TestLogMetricObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		obs v1alpha1.LogMetricObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		status int
+		metric *cloudlogging.LogMetric
+		kube   *test.MockClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotLogMetric": {
+			reason: "Should return an error if the resource is not a LogMetric",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotLogMetric)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the metric does not exist",
+			status: http.StatusNotFound,
+			mg:     newLogMetric(),
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the metric fails",
+			status: http.StatusBadRequest,
+			mg:     newLogMetric(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetLogMetric)},
+		},
+		"LateInitFailed": {
+			reason: "Should return an error if the late initialized spec can't be saved",
+			status: http.StatusOK,
+			metric: metric("severity>=ERROR"),
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:     newLogMetric(func(cr *v1alpha1.LogMetric) { cr.Spec.ForProvider.Description = nil }),
+			want:   want{err: errors.Wrap(errBoom, errUpdateLogMetricCR)},
+		},
+		"InvalidSpec": {
+			reason: "Should return an error if the metric can't be compared with the spec",
+			status: http.StatusOK,
+			metric: metric("severity>=ERROR"),
+			mg: newLogMetric(func(cr *v1alpha1.LogMetric) {
+				cr.Spec.ForProvider.BucketOptions = &v1alpha1.BucketOptions{
+					ExplicitBuckets: &v1alpha1.ExplicitBuckets{Bounds: []string{"one"}},
+				}
+			}),
+			want: want{err: errors.Wrap(errors.Wrap(errors.New(`strconv.ParseFloat: parsing "one": invalid syntax`),
+				"cannot parse bucket options"), errCompareLogMetric)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should report the metric type of an up to date metric",
+			status: http.StatusOK,
+			metric: metric("severity>=ERROR"),
+			mg:     newLogMetric(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				obs: v1alpha1.LogMetricObservation{MetricType: metricType},
+			},
+		},
+		"NeedsUpdate": {
+			reason: "Should return upToDate as false if the filter differs",
+			status: http.StatusOK,
+			metric: metric("severity>=WARNING"),
+			mg:     newLogMetric(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				obs: v1alpha1.LogMetricObservation{MetricType: metricType},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet+" "+metricPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.metric == nil {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.metric)
+			}))
+			defer server.Close()
+			s, _ := cloudlogging.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &logMetricExternal{kube: tc.kube, metrics: s.Projects.Metrics, projectID: projectID}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.LogMetric); ok && err == nil {
+				if diff := cmp.Diff(tc.want.obs, cr.Status.AtProvider); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want observation, +got observation:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestLogMetricWrite(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		method string
+		path   string
+		status int
+		mg     resource.Managed
+		call   func(*logMetricExternal, resource.Managed) error
+		want   error
+	}{
+		"CreateSuccessful": {
+			reason: "Should create the metric in its project",
+			method: http.MethodPost,
+			path:   "/v2/projects/myproject-id-1234/metrics",
+			status: http.StatusOK,
+			mg:     newLogMetric(),
+			call: func(e *logMetricExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+		},
+		"CreateFailed": {
+			reason: "Should return an error if creating the metric fails",
+			method: http.MethodPost,
+			path:   "/v2/projects/other-project/metrics",
+			status: http.StatusConflict,
+			mg:     newLogMetric(func(cr *v1alpha1.LogMetric) { cr.Spec.ForProvider.Project = gcp.StringPtr("other-project") }),
+			call: func(e *logMetricExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusConflict, ""), errCreateLogMetric),
+		},
+		"UpdateSuccessful": {
+			reason: "Should update the metric",
+			method: http.MethodPut,
+			path:   metricPath,
+			status: http.StatusOK,
+			mg:     newLogMetric(),
+			call: func(e *logMetricExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+		},
+		"UpdateFailed": {
+			reason: "Should return an error if updating the metric fails",
+			method: http.MethodPut,
+			path:   metricPath,
+			status: http.StatusBadRequest,
+			mg:     newLogMetric(),
+			call: func(e *logMetricExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateLogMetric),
+		},
+		"DeleteNotFound": {
+			reason: "Should not return an error if the metric is already gone",
+			method: http.MethodDelete,
+			path:   metricPath,
+			status: http.StatusNotFound,
+			mg:     newLogMetric(),
+			call: func(e *logMetricExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+		},
+		"DeleteFailed": {
+			reason: "Should return an error if deleting the metric fails",
+			method: http.MethodDelete,
+			path:   metricPath,
+			status: http.StatusBadRequest,
+			mg:     newLogMetric(),
+			call: func(e *logMetricExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteLogMetric),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method+" "+tc.path, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := cloudlogging.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			err := tc.call(&logMetricExternal{metrics: s.Projects.Metrics, projectID: projectID}, tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	cloudlogging "google.golang.org/api/logging/v2"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/logging/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	lgclient "github.com/crossplane/provider-gcp/pkg/clients/logging"
+)
+
+// Error strings.
+const (
+	errNewClient       = "cannot create new Cloud Logging client"
+	errNotLogSink      = "managed resource is not a LogSink"
+	errGetLogSink      = "cannot get LogSink"
+	errCreateLogSink   = "cannot create LogSink"
+	errUpdateLogSink   = "cannot update LogSink"
+	errDeleteLogSink   = "cannot delete LogSink"
+	errUpdateLogSinkCR = "cannot update LogSink custom resource"
+)
+
+// SetupLogSink adds a controller that reconciles LogSinks.
+func SetupLogSink(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.LogSinkGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.LogSinkGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.LogSink{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.LogSinkGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.LogSinkGroupVersionKind),
+			&logSinkConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type logSinkConnector struct {
+	kube client.Client
+}
+
+func (c *logSinkConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := cloudlogging.NewService(ctx, opts.For("logging")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	// The sinks service is documented for any parent, so it serves project,
+	// folder and organization sinks alike.
+	return &logSinkExternal{kube: c.kube, sinks: s.Sinks, projectID: projectID}, nil
+}
+
+type logSinkExternal struct {
+	kube      client.Client
+	sinks     *cloudlogging.SinksService
+	projectID string
+}
+
+func (e *logSinkExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.LogSink)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotLogSink)
+	}
+	existing, err := e.sinks.Get(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetLogSink)
+	}
+	current := cr.Spec.ForProvider.DeepCopy()
+	lgclient.LateInitializeLogSink(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateLogSinkCR)
+		}
+	}
+	cr.Status.AtProvider = lgclient.GenerateLogSinkObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: lgclient.IsLogSinkUpToDate(e.projectID, cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *logSinkExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.LogSink)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotLogSink)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	parent := lgclient.GetParent(e.projectID, cr.Spec.ForProvider)
+	s := lgclient.GenerateLogSink(meta.GetExternalName(cr), e.projectID, cr.Spec.ForProvider)
+	_, err := e.sinks.Create(parent, s).UniqueWriterIdentity(gcp.BoolValue(cr.Spec.ForProvider.UniqueWriterIdentity)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateLogSink)
+}
+
+func (e *logSinkExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.LogSink)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotLogSink)
+	}
+	s := lgclient.GenerateLogSink(meta.GetExternalName(cr), e.projectID, cr.Spec.ForProvider)
+	_, err := e.sinks.Update(e.name(cr), s).UpdateMask(lgclient.LogSinkUpdateMask).
+		UniqueWriterIdentity(gcp.BoolValue(cr.Spec.ForProvider.UniqueWriterIdentity)).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateLogSink)
+}
+
+func (e *logSinkExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.LogSink)
+	if !ok {
+		return errors.New(errNotLogSink)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.sinks.Delete(e.name(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteLogSink)
+}
+
+func (e *logSinkExternal) name(cr *v1alpha1.LogSink) string {
+	return lgclient.GetSinkName(lgclient.GetParent(e.projectID, cr.Spec.ForProvider), meta.GetExternalName(cr))
+}
@@ -0,0 +1,275 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	cloudlogging "google.golang.org/api/logging/v2"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/logging/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID      = "myproject-id-1234"
+	sinkPath       = "/v2/projects/myproject-id-1234/sinks/audit"
+	writerIdentity = "serviceAccount:p123-456@gcp-sa-logging.iam.gserviceaccount.com"
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newLogSink(m ...func(*v1alpha1.LogSink)) *v1alpha1.LogSink {
+	cr := &v1alpha1.LogSink{}
+	meta.SetExternalName(cr, "audit")
+	cr.Spec.ForProvider = v1alpha1.LogSinkParameters{
+		Topic:       gcp.StringPtr("audit-logs"),
+		Filter:      gcp.StringPtr("logName:\"cloudaudit.googleapis.com\""),
+		Description: gcp.StringPtr("Audit logs"),
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func sink(filter string) *cloudlogging.LogSink {
+	return &cloudlogging.LogSink{
+		Name:           "audit",
+		Destination:    "pubsub.googleapis.com/projects/myproject-id-1234/topics/audit-logs",
+		Filter:         filter,
+		Description:    "Audit logs",
+		WriterIdentity: writerIdentity,
+	}
+}
+
+func TestLogSinkObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		obs v1alpha1.LogSinkObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		status int
+		sink   *cloudlogging.LogSink
+		kube   *test.MockClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotLogSink": {
+			reason: "Should return an error if the resource is not a LogSink",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotLogSink)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the sink does not exist",
+			status: http.StatusNotFound,
+			mg:     newLogSink(),
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the sink fails",
+			status: http.StatusBadRequest,
+			mg:     newLogSink(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetLogSink)},
+		},
+		"LateInitFailed": {
+			reason: "Should return an error if the late initialized spec can't be saved",
+			status: http.StatusOK,
+			sink:   sink("logName:\"cloudaudit.googleapis.com\""),
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:     newLogSink(func(cr *v1alpha1.LogSink) { cr.Spec.ForProvider.Description = nil }),
+			want:   want{err: errors.Wrap(errBoom, errUpdateLogSinkCR)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should report the writer identity of an up to date sink",
+			status: http.StatusOK,
+			sink:   sink("logName:\"cloudaudit.googleapis.com\""),
+			mg:     newLogSink(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				obs: v1alpha1.LogSinkObservation{WriterIdentity: writerIdentity},
+			},
+		},
+		"NeedsUpdate": {
+			reason: "Should return upToDate as false if the filter differs",
+			status: http.StatusOK,
+			sink:   sink("severity>=ERROR"),
+			mg:     newLogSink(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				obs: v1alpha1.LogSinkObservation{WriterIdentity: writerIdentity},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet+" "+sinkPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.sink == nil {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.sink)
+			}))
+			defer server.Close()
+			s, _ := cloudlogging.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &logSinkExternal{kube: tc.kube, sinks: s.Sinks, projectID: projectID}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.LogSink); ok && err == nil {
+				if diff := cmp.Diff(tc.want.obs, cr.Status.AtProvider); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want observation, +got observation:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestLogSinkWrite(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		method string
+		path   string
+		status int
+		mg     resource.Managed
+		call   func(*logSinkExternal, resource.Managed) error
+		want   error
+	}{
+		"CreateSuccessful": {
+			reason: "Should create the sink in its parent",
+			method: http.MethodPost,
+			path:   "/v2/projects/myproject-id-1234/sinks",
+			status: http.StatusOK,
+			mg:     newLogSink(),
+			call: func(e *logSinkExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+		},
+		"CreateFailed": {
+			reason: "Should return an error if creating the sink fails",
+			method: http.MethodPost,
+			path:   "/v2/folders/1234/sinks",
+			status: http.StatusConflict,
+			mg:     newLogSink(func(cr *v1alpha1.LogSink) { cr.Spec.ForProvider.Parent = gcp.StringPtr("folders/1234") }),
+			call: func(e *logSinkExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusConflict, ""), errCreateLogSink),
+		},
+		"UpdateSuccessful": {
+			reason: "Should update the sink",
+			method: http.MethodPut,
+			path:   sinkPath,
+			status: http.StatusOK,
+			mg:     newLogSink(),
+			call: func(e *logSinkExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+		},
+		"UpdateFailed": {
+			reason: "Should return an error if updating the sink fails",
+			method: http.MethodPut,
+			path:   sinkPath,
+			status: http.StatusBadRequest,
+			mg:     newLogSink(),
+			call: func(e *logSinkExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateLogSink),
+		},
+		"DeleteNotFound": {
+			reason: "Should not return an error if the sink is already gone",
+			method: http.MethodDelete,
+			path:   sinkPath,
+			status: http.StatusNotFound,
+			mg:     newLogSink(),
+			call: func(e *logSinkExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+		},
+		"DeleteFailed": {
+			reason: "Should return an error if deleting the sink fails",
+			method: http.MethodDelete,
+			path:   sinkPath,
+			status: http.StatusBadRequest,
+			mg:     newLogSink(),
+			call: func(e *logSinkExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteLogSink),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method+" "+tc.path, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := cloudlogging.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			err := tc.call(&logSinkExternal{sinks: s.Sinks, projectID: projectID}, tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	monitoring "google.golang.org/api/monitoring/v3"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/monitoring/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	mclient "github.com/crossplane/provider-gcp/pkg/clients/monitoring"
+)
+
+// Error strings.
+const (
+	errNotAlertPolicy      = "managed resource is not an AlertPolicy"
+	errGetAlertPolicy      = "cannot get AlertPolicy"
+	errCreateAlertPolicy   = "cannot create AlertPolicy"
+	errUpdateAlertPolicy   = "cannot update AlertPolicy"
+	errDeleteAlertPolicy   = "cannot delete AlertPolicy"
+	errUpdateAlertPolicyCR = "cannot update AlertPolicy custom resource"
+	errCompareAlertPolicy  = "cannot compare AlertPolicy"
+	errGenerateAlertPolicy = "cannot generate AlertPolicy"
+)
+
+// SetupAlertPolicy adds a controller that reconciles AlertPolicies.
+func SetupAlertPolicy(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.AlertPolicyGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.AlertPolicyGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.AlertPolicy{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.AlertPolicyGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.AlertPolicyGroupVersionKind),
+			&alertPolicyConnector{kube: mgr.GetClient()},
+			managed.WithInitializers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type alertPolicyConnector struct {
+	kube client.Client
+}
+
+func (c *alertPolicyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := monitoring.NewService(ctx, opts.For("monitoring")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &alertPolicyExternal{kube: c.kube, policies: s.Projects.AlertPolicies, projectID: projectID}, nil
+}
+
+type alertPolicyExternal struct {
+	kube      client.Client
+	policies  *monitoring.ProjectsAlertPoliciesService
+	projectID string
+}
+
+func (e *alertPolicyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.AlertPolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAlertPolicy)
+	}
+	// Policy IDs are assigned by Cloud Monitoring, so until we've created
+	// the policy we have nothing to look up.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	existing, err := e.policies.Get(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetAlertPolicy)
+	}
+	current := cr.Spec.ForProvider.DeepCopy()
+	mclient.LateInitializeAlertPolicy(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateAlertPolicyCR)
+		}
+	}
+	cr.Status.AtProvider = mclient.GenerateAlertPolicyObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	upToDate, err := mclient.IsAlertPolicyUpToDate(cr.Spec.ForProvider, *existing)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCompareAlertPolicy)
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *alertPolicyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.AlertPolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAlertPolicy)
+	}
+	cr.SetConditions(xpv1.Creating())
+	p, err := mclient.GenerateAlertPolicy(cr.Spec.ForProvider, v1alpha1.AlertPolicyObservation{})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGenerateAlertPolicy)
+	}
+	a, err := e.policies.Create(mclient.GetAlertPolicyParent(e.projectID, cr.Spec.ForProvider), p).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateAlertPolicy)
+	}
+	meta.SetExternalName(cr, mclient.GetID(a.Name))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *alertPolicyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.AlertPolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAlertPolicy)
+	}
+	// Passing the observed conditions lets Cloud Monitoring update existing
+	// conditions in place rather than replacing them.
+	p, err := mclient.GenerateAlertPolicy(cr.Spec.ForProvider, cr.Status.AtProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGenerateAlertPolicy)
+	}
+	_, err = e.policies.Patch(e.name(cr), p).UpdateMask(mclient.AlertPolicyUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAlertPolicy)
+}
+
+func (e *alertPolicyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.AlertPolicy)
+	if !ok {
+		return errors.New(errNotAlertPolicy)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.policies.Delete(e.name(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteAlertPolicy)
+}
+
+func (e *alertPolicyExternal) name(cr *v1alpha1.AlertPolicy) string {
+	return mclient.GetAlertPolicyName(mclient.GetAlertPolicyParent(e.projectID, cr.Spec.ForProvider), meta.GetExternalName(cr))
+}
@@ -0,0 +1,355 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	monitoring "google.golang.org/api/monitoring/v3"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/monitoring/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	policyName    = "projects/myproject-id-1234/alertPolicies/5678"
+	policyPath    = "/v3/" + policyName
+	conditionName = policyName + "/conditions/90"
+)
+
+func newAlertPolicy(m ...func(*v1alpha1.AlertPolicy)) *v1alpha1.AlertPolicy {
+	cr := &v1alpha1.AlertPolicy{}
+	meta.SetExternalName(cr, "5678")
+	cr.Spec.ForProvider = v1alpha1.AlertPolicyParameters{
+		DisplayName: "High error rate",
+		Combiner:    "OR",
+		Enabled:     gcp.BoolPtr(true),
+		Conditions: []v1alpha1.Condition{{
+			DisplayName: "Error rate",
+			ConditionMonitoringQueryLanguage: &v1alpha1.MonitoringQueryLanguageCondition{
+				Query:    "fetch gce_instance | metric 'compute.googleapis.com/instance/cpu/utilization' | condition val() > 0.9",
+				Duration: "300s",
+			},
+		}},
+		NotificationChannels: []string{channelName},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func policy(duration string) *monitoring.AlertPolicy {
+	return &monitoring.AlertPolicy{
+		Name:        policyName,
+		DisplayName: "High error rate",
+		Combiner:    "OR",
+		Enabled:     true,
+		Conditions: []*monitoring.Condition{{
+			Name:        conditionName,
+			DisplayName: "Error rate",
+			ConditionMonitoringQueryLanguage: &monitoring.MonitoringQueryLanguageCondition{
+				Query:    "fetch gce_instance | metric 'compute.googleapis.com/instance/cpu/utilization' | condition val() > 0.9",
+				Duration: duration,
+			},
+		}},
+		NotificationChannels: []string{channelName},
+	}
+}
+
+func TestAlertPolicyObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		obs v1alpha1.AlertPolicyObservation
+		err error
+	}
+	obs := v1alpha1.AlertPolicyObservation{
+		Name:       policyName,
+		Conditions: []v1alpha1.ConditionObservation{{Name: conditionName, DisplayName: "Error rate"}},
+	}
+
+	cases := map[string]struct {
+		reason string
+		status int
+		policy *monitoring.AlertPolicy
+		kube   *test.MockClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotAlertPolicy": {
+			reason: "Should return an error if the resource is not an AlertPolicy",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotAlertPolicy)},
+		},
+		"NoExternalName": {
+			reason: "Should report a policy without external name as not existing",
+			mg:     newAlertPolicy(func(cr *v1alpha1.AlertPolicy) { meta.SetExternalName(cr, "") }),
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the policy does not exist",
+			status: http.StatusNotFound,
+			mg:     newAlertPolicy(),
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the policy fails",
+			status: http.StatusBadRequest,
+			mg:     newAlertPolicy(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetAlertPolicy)},
+		},
+		"LateInitFailed": {
+			reason: "Should return an error if the late initialized spec can't be saved",
+			status: http.StatusOK,
+			policy: policy("300s"),
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:     newAlertPolicy(func(cr *v1alpha1.AlertPolicy) { cr.Spec.ForProvider.Enabled = nil }),
+			want:   want{err: errors.Wrap(errBoom, errUpdateAlertPolicyCR)},
+		},
+		"CompareFailed": {
+			reason: "Should return an error if the spec can't be compared with the policy",
+			status: http.StatusOK,
+			policy: policy("300s"),
+			mg: newAlertPolicy(func(cr *v1alpha1.AlertPolicy) {
+				cr.Spec.ForProvider.Conditions[0].ConditionMonitoringQueryLanguage.Trigger = &v1alpha1.Trigger{Percent: gcp.StringPtr("all")}
+			}),
+			want: want{err: errors.Wrap(errors.Wrap(errors.New(`strconv.ParseFloat: parsing "all": invalid syntax`),
+				`cannot parse trigger percent of condition "Error rate"`), errCompareAlertPolicy)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should report the conditions of an up to date policy",
+			status: http.StatusOK,
+			policy: policy("300s"),
+			mg:     newAlertPolicy(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				obs: obs,
+			},
+		},
+		"NeedsUpdate": {
+			reason: "Should return upToDate as false if a condition differs",
+			status: http.StatusOK,
+			policy: policy("60s"),
+			mg:     newAlertPolicy(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				obs: obs,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet+" "+policyPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.policy == nil {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.policy)
+			}))
+			defer server.Close()
+			s, _ := monitoring.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &alertPolicyExternal{kube: tc.kube, policies: s.Projects.AlertPolicies, projectID: projectID}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.AlertPolicy); ok && err == nil {
+				if diff := cmp.Diff(tc.want.obs, cr.Status.AtProvider); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want observation, +got observation:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestAlertPolicyCreate(t *testing.T) {
+	type want struct {
+		e            managed.ExternalCreation
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		status int
+		mg     resource.Managed
+		want   want
+	}{
+		"NotAlertPolicy": {
+			reason: "Should return an error if the resource is not an AlertPolicy",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotAlertPolicy)},
+		},
+		"Successful": {
+			reason: "Should record the ID of the created policy",
+			status: http.StatusOK,
+			mg:     newAlertPolicy(func(cr *v1alpha1.AlertPolicy) { meta.SetExternalName(cr, "") }),
+			want: want{
+				e:            managed.ExternalCreation{ExternalNameAssigned: true},
+				externalName: "5678",
+			},
+		},
+		"Failed": {
+			reason: "Should return an error if creating the policy fails",
+			status: http.StatusBadRequest,
+			mg:     newAlertPolicy(func(cr *v1alpha1.AlertPolicy) { meta.SetExternalName(cr, "") }),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateAlertPolicy)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost+" /v3/projects/myproject-id-1234/alertPolicies", r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.status != http.StatusOK {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(policy("300s"))
+			}))
+			defer server.Close()
+			s, _ := monitoring.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &alertPolicyExternal{policies: s.Projects.AlertPolicies, projectID: projectID}
+			got, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if err == nil {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.mg)); diff != "" {
+					t.Errorf("\n%s\nCreate(...): -want external name, +got external name:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestAlertPolicyUpdate(t *testing.T) {
+	cases := map[string]struct {
+		reason    string
+		status    int
+		mg        resource.Managed
+		condition string
+		want      error
+	}{
+		"InPlace": {
+			reason: "Should keep the name of an observed condition so that it is updated in place",
+			status: http.StatusOK,
+			mg: newAlertPolicy(func(cr *v1alpha1.AlertPolicy) {
+				cr.Status.AtProvider.Conditions = []v1alpha1.ConditionObservation{{Name: conditionName, DisplayName: "Error rate"}}
+			}),
+			condition: conditionName,
+		},
+		"Failed": {
+			reason: "Should return an error if patching the policy fails",
+			status: http.StatusBadRequest,
+			mg:     newAlertPolicy(),
+			want:   errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateAlertPolicy),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPatch+" "+policyPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				a := &monitoring.AlertPolicy{}
+				_ = json.NewDecoder(r.Body).Decode(a)
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.condition, a.Conditions[0].Name); diff != "" {
+					t.Errorf("\n%s\nPatch(...): -want condition name, +got condition name:\n%s", tc.reason, diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := monitoring.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &alertPolicyExternal{policies: s.Projects.AlertPolicies, projectID: projectID}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestAlertPolicyDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		status int
+		mg     resource.Managed
+		want   error
+	}{
+		"NotFound": {
+			reason: "Should not return an error if the policy is already gone",
+			status: http.StatusNotFound,
+			mg:     newAlertPolicy(),
+		},
+		"Failed": {
+			reason: "Should return an error if deleting the policy fails",
+			status: http.StatusBadRequest,
+			mg:     newAlertPolicy(),
+			want:   errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteAlertPolicy),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete+" "+policyPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := monitoring.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &alertPolicyExternal{policies: s.Projects.AlertPolicies, projectID: projectID}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
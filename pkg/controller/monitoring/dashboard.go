@@ -0,0 +1,180 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"context"
+	"time"
+
+	dashboard "google.golang.org/api/monitoring/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/monitoring/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	mclient "github.com/crossplane/provider-gcp/pkg/clients/monitoring"
+)
+
+// Error strings.
+const (
+	errNotDashboard    = "managed resource is not a Dashboard"
+	errGetDashboard    = "cannot get Dashboard"
+	errCreateDashboard = "cannot create Dashboard"
+	errUpdateDashboard = "cannot update Dashboard"
+	errDeleteDashboard = "cannot delete Dashboard"
+)
+
+// SetupDashboard adds a controller that reconciles Dashboards.
+func SetupDashboard(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.DashboardGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.DashboardGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Dashboard{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.DashboardGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.DashboardGroupVersionKind),
+			&dashboardConnector{kube: mgr.GetClient()},
+			managed.WithInitializers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type dashboardConnector struct {
+	kube client.Client
+}
+
+func (c *dashboardConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := dashboard.NewService(ctx, opts.For("monitoring")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &dashboardExternal{kube: c.kube, dashboards: s.Projects.Dashboards, projectID: projectID}, nil
+}
+
+type dashboardExternal struct {
+	kube       client.Client
+	dashboards *dashboard.ProjectsDashboardsService
+	projectID  string
+}
+
+func (e *dashboardExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Dashboard)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDashboard)
+	}
+	// Dashboard IDs are assigned by Cloud Monitoring, so until we've created
+	// the dashboard we have nothing to look up.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	existing, err := e.dashboards.Get(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetDashboard)
+	}
+	cr.Status.AtProvider = mclient.GenerateDashboardObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	desired, err := e.desired(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	upToDate, err := mclient.IsDashboardUpToDate(desired, existing)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *dashboardExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Dashboard)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDashboard)
+	}
+	cr.SetConditions(xpv1.Creating())
+	desired, err := e.desired(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	d, err := e.dashboards.Create(mclient.GetDashboardParent(e.projectID, cr.Spec.ForProvider), desired).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateDashboard)
+	}
+	meta.SetExternalName(cr, mclient.GetID(d.Name))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *dashboardExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Dashboard)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDashboard)
+	}
+	desired, err := e.desired(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	// Cloud Monitoring rejects updates that don't carry the etag of the
+	// dashboard they're based on.
+	desired.Etag = cr.Status.AtProvider.Etag
+	_, err = e.dashboards.Patch(e.name(cr), desired).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateDashboard)
+}
+
+func (e *dashboardExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Dashboard)
+	if !ok {
+		return errors.New(errNotDashboard)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.dashboards.Delete(e.name(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteDashboard)
+}
+
+func (e *dashboardExternal) desired(ctx context.Context, cr *v1alpha1.Dashboard) (*dashboard.Dashboard, error) {
+	src, err := mclient.GetDashboardJSON(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return nil, err
+	}
+	return mclient.GenerateDashboard(src, cr.Spec.ForProvider)
+}
+
+func (e *dashboardExternal) name(cr *v1alpha1.Dashboard) string {
+	return mclient.GetDashboardName(mclient.GetDashboardParent(e.projectID, cr.Spec.ForProvider), meta.GetExternalName(cr))
+}
@@ -0,0 +1,321 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	dashboard "google.golang.org/api/monitoring/v1"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/monitoring/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	dashboardName = "projects/myproject-id-1234/dashboards/1234"
+	dashboardPath = "/v1/" + dashboardName
+	dashboardJSON = `{"displayName": "Storefront", "mosaicLayout": {"columns": 12}}`
+)
+
+func newDashboard(m ...func(*v1alpha1.Dashboard)) *v1alpha1.Dashboard {
+	cr := &v1alpha1.Dashboard{}
+	meta.SetExternalName(cr, "1234")
+	cr.Spec.ForProvider = v1alpha1.DashboardParameters{
+		DashboardJSON: gcp.StringPtr(dashboardJSON),
+	}
+	cr.Status.AtProvider.Etag = "abc"
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observedDashboard(columns int64) *dashboard.Dashboard {
+	return &dashboard.Dashboard{
+		Name:         dashboardName,
+		Etag:         "def",
+		DisplayName:  "Storefront",
+		MosaicLayout: &dashboard.MosaicLayout{Columns: columns},
+	}
+}
+
+func TestDashboardObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		obs v1alpha1.DashboardObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason    string
+		status    int
+		dashboard *dashboard.Dashboard
+		mg        resource.Managed
+		want      want
+	}{
+		"NotDashboard": {
+			reason: "Should return an error if the resource is not a Dashboard",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotDashboard)},
+		},
+		"NoExternalName": {
+			reason: "Should report a dashboard without external name as not existing",
+			mg:     newDashboard(func(cr *v1alpha1.Dashboard) { meta.SetExternalName(cr, "") }),
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the dashboard does not exist",
+			status: http.StatusNotFound,
+			mg:     newDashboard(),
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the dashboard fails",
+			status: http.StatusBadRequest,
+			mg:     newDashboard(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetDashboard)},
+		},
+		"ResourceUpToDate": {
+			reason:    "Should report an up to date dashboard as available",
+			status:    http.StatusOK,
+			dashboard: observedDashboard(12),
+			mg:        newDashboard(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				obs: v1alpha1.DashboardObservation{Name: dashboardName, Etag: "def"},
+			},
+		},
+		"NeedsUpdate": {
+			reason:    "Should return upToDate as false if the layout differs",
+			status:    http.StatusOK,
+			dashboard: observedDashboard(6),
+			mg:        newDashboard(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				obs: v1alpha1.DashboardObservation{Name: dashboardName, Etag: "def"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet+" "+dashboardPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.dashboard == nil {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.dashboard)
+			}))
+			defer server.Close()
+			s, _ := dashboard.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &dashboardExternal{dashboards: s.Projects.Dashboards, projectID: projectID}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Dashboard); ok && err == nil && tc.dashboard != nil {
+				if diff := cmp.Diff(tc.want.obs, cr.Status.AtProvider); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want observation, +got observation:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestDashboardCreate(t *testing.T) {
+	type want struct {
+		e            managed.ExternalCreation
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		status int
+		kube   *test.MockClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotDashboard": {
+			reason: "Should return an error if the resource is not a Dashboard",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotDashboard)},
+		},
+		"Successful": {
+			reason: "Should record the ID of the created dashboard",
+			status: http.StatusOK,
+			mg:     newDashboard(func(cr *v1alpha1.Dashboard) { meta.SetExternalName(cr, "") }),
+			want: want{
+				e:            managed.ExternalCreation{ExternalNameAssigned: true},
+				externalName: "1234",
+			},
+		},
+		"ConfigMapFailed": {
+			reason: "Should return an error if the dashboard ConfigMap can't be read",
+			kube:   &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			mg: newDashboard(func(cr *v1alpha1.Dashboard) {
+				meta.SetExternalName(cr, "")
+				cr.Spec.ForProvider.DashboardJSON = nil
+				cr.Spec.ForProvider.DashboardJSONConfigMapRef = &v1alpha1.ConfigMapKeySelector{Name: "cool-cm", Namespace: "cool-ns", Key: "dashboard.json"}
+			}),
+			want: want{err: errors.Wrap(errBoom, "cannot get dashboard ConfigMap")},
+		},
+		"Failed": {
+			reason: "Should return an error if creating the dashboard fails",
+			status: http.StatusBadRequest,
+			mg:     newDashboard(func(cr *v1alpha1.Dashboard) { meta.SetExternalName(cr, "") }),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateDashboard)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost+" /v1/projects/myproject-id-1234/dashboards", r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.status != http.StatusOK {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(observedDashboard(12))
+			}))
+			defer server.Close()
+			s, _ := dashboard.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &dashboardExternal{kube: tc.kube, dashboards: s.Projects.Dashboards, projectID: projectID}
+			got, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if err == nil {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.mg)); diff != "" {
+					t.Errorf("\n%s\nCreate(...): -want external name, +got external name:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestDashboardUpdate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		status int
+		mg     resource.Managed
+		want   error
+	}{
+		"Successful": {
+			reason: "Should patch the dashboard with the observed etag",
+			status: http.StatusOK,
+			mg:     newDashboard(),
+		},
+		"Failed": {
+			reason: "Should return an error if patching the dashboard fails",
+			status: http.StatusBadRequest,
+			mg:     newDashboard(),
+			want:   errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateDashboard),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPatch+" "+dashboardPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				d := &dashboard.Dashboard{}
+				_ = json.NewDecoder(r.Body).Decode(d)
+				_ = r.Body.Close()
+				if diff := cmp.Diff("abc", d.Etag); diff != "" {
+					t.Errorf("etag: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := dashboard.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &dashboardExternal{dashboards: s.Projects.Dashboards, projectID: projectID}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDashboardDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		status int
+		mg     resource.Managed
+		want   error
+	}{
+		"NotFound": {
+			reason: "Should not return an error if the dashboard is already gone",
+			status: http.StatusNotFound,
+			mg:     newDashboard(),
+		},
+		"Failed": {
+			reason: "Should return an error if deleting the dashboard fails",
+			status: http.StatusBadRequest,
+			mg:     newDashboard(),
+			want:   errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteDashboard),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete+" "+dashboardPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := dashboard.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &dashboardExternal{dashboards: s.Projects.Dashboards, projectID: projectID}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	monitoring "google.golang.org/api/monitoring/v3"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/monitoring/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	mclient "github.com/crossplane/provider-gcp/pkg/clients/monitoring"
+)
+
+// Error strings.
+const (
+	errNewClient                   = "cannot create new Cloud Monitoring client"
+	errNotNotificationChannel      = "managed resource is not a NotificationChannel"
+	errGetNotificationChannel      = "cannot get NotificationChannel"
+	errCreateNotificationChannel   = "cannot create NotificationChannel"
+	errUpdateNotificationChannel   = "cannot update NotificationChannel"
+	errDeleteNotificationChannel   = "cannot delete NotificationChannel"
+	errUpdateNotificationChannelCR = "cannot update NotificationChannel custom resource"
+)
+
+// SetupNotificationChannel adds a controller that reconciles
+// NotificationChannels.
+func SetupNotificationChannel(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.NotificationChannelGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.NotificationChannelGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.NotificationChannel{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.NotificationChannelGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.NotificationChannelGroupVersionKind),
+			&notificationChannelConnector{kube: mgr.GetClient()},
+			managed.WithInitializers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type notificationChannelConnector struct {
+	kube client.Client
+}
+
+func (c *notificationChannelConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := monitoring.NewService(ctx, opts.For("monitoring")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &notificationChannelExternal{kube: c.kube, channels: s.Projects.NotificationChannels, projectID: projectID}, nil
+}
+
+type notificationChannelExternal struct {
+	kube      client.Client
+	channels  *monitoring.ProjectsNotificationChannelsService
+	projectID string
+}
+
+func (e *notificationChannelExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.NotificationChannel)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotNotificationChannel)
+	}
+	// Channel IDs are assigned by Cloud Monitoring, so until we've created
+	// the channel we have nothing to look up.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	existing, err := e.channels.Get(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetNotificationChannel)
+	}
+	current := cr.Spec.ForProvider.DeepCopy()
+	mclient.LateInitializeNotificationChannel(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateNotificationChannelCR)
+		}
+	}
+	cr.Status.AtProvider = mclient.GenerateNotificationChannelObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: mclient.IsNotificationChannelUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *notificationChannelExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.NotificationChannel)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotNotificationChannel)
+	}
+	cr.SetConditions(xpv1.Creating())
+	sensitive, err := mclient.GetSensitiveLabels(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	c, err := e.channels.Create(mclient.GetNotificationChannelParent(e.projectID, cr.Spec.ForProvider),
+		mclient.GenerateNotificationChannel(cr.Spec.ForProvider, sensitive)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateNotificationChannel)
+	}
+	meta.SetExternalName(cr, mclient.GetID(c.Name))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *notificationChannelExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.NotificationChannel)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotNotificationChannel)
+	}
+	// The labels are replaced as a whole, so the sensitive ones have to be
+	// sent again.
+	sensitive, err := mclient.GetSensitiveLabels(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	_, err = e.channels.Patch(e.name(cr), mclient.GenerateNotificationChannel(cr.Spec.ForProvider, sensitive)).
+		UpdateMask(mclient.NotificationChannelUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateNotificationChannel)
+}
+
+func (e *notificationChannelExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.NotificationChannel)
+	if !ok {
+		return errors.New(errNotNotificationChannel)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.channels.Delete(e.name(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteNotificationChannel)
+}
+
+func (e *notificationChannelExternal) name(cr *v1alpha1.NotificationChannel) string {
+	return mclient.GetNotificationChannelName(mclient.GetNotificationChannelParent(e.projectID, cr.Spec.ForProvider), meta.GetExternalName(cr))
+}
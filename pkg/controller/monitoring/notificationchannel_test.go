@@ -0,0 +1,334 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	monitoring "google.golang.org/api/monitoring/v3"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/monitoring/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID   = "myproject-id-1234"
+	channelName = "projects/myproject-id-1234/notificationChannels/1234"
+	channelPath = "/v3/" + channelName
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newNotificationChannel(m ...func(*v1alpha1.NotificationChannel)) *v1alpha1.NotificationChannel {
+	cr := &v1alpha1.NotificationChannel{}
+	meta.SetExternalName(cr, "1234")
+	cr.Spec.ForProvider = v1alpha1.NotificationChannelParameters{
+		Type:        "email",
+		DisplayName: gcp.StringPtr("On-call"),
+		Labels:      map[string]string{"email_address": "oncall@example.org"},
+		Enabled:     gcp.BoolPtr(true),
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func channel(address string) *monitoring.NotificationChannel {
+	return &monitoring.NotificationChannel{
+		Name:               channelName,
+		Type:               "email",
+		DisplayName:        "On-call",
+		Labels:             map[string]string{"email_address": address},
+		Enabled:            true,
+		VerificationStatus: "VERIFIED",
+	}
+}
+
+func TestNotificationChannelObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		obs v1alpha1.NotificationChannelObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		status  int
+		channel *monitoring.NotificationChannel
+		kube    *test.MockClient
+		mg      resource.Managed
+		want    want
+	}{
+		"NotNotificationChannel": {
+			reason: "Should return an error if the resource is not a NotificationChannel",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotNotificationChannel)},
+		},
+		"NoExternalName": {
+			reason: "Should report a channel without external name as not existing",
+			mg:     newNotificationChannel(func(cr *v1alpha1.NotificationChannel) { meta.SetExternalName(cr, "") }),
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the channel does not exist",
+			status: http.StatusNotFound,
+			mg:     newNotificationChannel(),
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the channel fails",
+			status: http.StatusBadRequest,
+			mg:     newNotificationChannel(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetNotificationChannel)},
+		},
+		"LateInitFailed": {
+			reason:  "Should return an error if the late initialized spec can't be saved",
+			status:  http.StatusOK,
+			channel: channel("oncall@example.org"),
+			kube:    &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:      newNotificationChannel(func(cr *v1alpha1.NotificationChannel) { cr.Spec.ForProvider.Enabled = nil }),
+			want:    want{err: errors.Wrap(errBoom, errUpdateNotificationChannelCR)},
+		},
+		"ResourceUpToDate": {
+			reason:  "Should report the verification status of an up to date channel",
+			status:  http.StatusOK,
+			channel: channel("oncall@example.org"),
+			mg:      newNotificationChannel(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				obs: v1alpha1.NotificationChannelObservation{Name: channelName, VerificationStatus: "VERIFIED"},
+			},
+		},
+		"NeedsUpdate": {
+			reason:  "Should return upToDate as false if the labels differ",
+			status:  http.StatusOK,
+			channel: channel("alerts@example.org"),
+			mg:      newNotificationChannel(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				obs: v1alpha1.NotificationChannelObservation{Name: channelName, VerificationStatus: "VERIFIED"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet+" "+channelPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.channel == nil {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.channel)
+			}))
+			defer server.Close()
+			s, _ := monitoring.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &notificationChannelExternal{kube: tc.kube, channels: s.Projects.NotificationChannels, projectID: projectID}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.NotificationChannel); ok && err == nil {
+				if diff := cmp.Diff(tc.want.obs, cr.Status.AtProvider); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want observation, +got observation:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestNotificationChannelCreate(t *testing.T) {
+	type want struct {
+		e            managed.ExternalCreation
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		status int
+		kube   *test.MockClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotNotificationChannel": {
+			reason: "Should return an error if the resource is not a NotificationChannel",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotNotificationChannel)},
+		},
+		"Successful": {
+			reason: "Should record the ID of the created channel",
+			status: http.StatusOK,
+			mg:     newNotificationChannel(func(cr *v1alpha1.NotificationChannel) { meta.SetExternalName(cr, "") }),
+			want: want{
+				e:            managed.ExternalCreation{ExternalNameAssigned: true},
+				externalName: "1234",
+			},
+		},
+		"SecretFailed": {
+			reason: "Should return an error if a sensitive label can't be read",
+			kube:   &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			mg: newNotificationChannel(func(cr *v1alpha1.NotificationChannel) {
+				meta.SetExternalName(cr, "")
+				cr.Spec.ForProvider.SensitiveLabels = &v1alpha1.SensitiveLabels{
+					ServiceKeySecretRef: &xpv1.SecretKeySelector{Key: "key"},
+				}
+			}),
+			want: want{err: errors.Wrap(errBoom, "cannot get Secret")},
+		},
+		"Failed": {
+			reason: "Should return an error if creating the channel fails",
+			status: http.StatusBadRequest,
+			mg:     newNotificationChannel(func(cr *v1alpha1.NotificationChannel) { meta.SetExternalName(cr, "") }),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateNotificationChannel)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost+" /v3/projects/myproject-id-1234/notificationChannels", r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.status != http.StatusOK {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(channel("oncall@example.org"))
+			}))
+			defer server.Close()
+			s, _ := monitoring.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &notificationChannelExternal{kube: tc.kube, channels: s.Projects.NotificationChannels, projectID: projectID}
+			got, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if err == nil {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.mg)); diff != "" {
+					t.Errorf("\n%s\nCreate(...): -want external name, +got external name:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestNotificationChannelWrite(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		method string
+		status int
+		mg     resource.Managed
+		call   func(*notificationChannelExternal, resource.Managed) error
+		want   error
+	}{
+		"UpdateSuccessful": {
+			reason: "Should patch the channel",
+			method: http.MethodPatch,
+			status: http.StatusOK,
+			mg:     newNotificationChannel(),
+			call: func(e *notificationChannelExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+		},
+		"UpdateFailed": {
+			reason: "Should return an error if patching the channel fails",
+			method: http.MethodPatch,
+			status: http.StatusBadRequest,
+			mg:     newNotificationChannel(),
+			call: func(e *notificationChannelExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateNotificationChannel),
+		},
+		"DeleteNotFound": {
+			reason: "Should not return an error if the channel is already gone",
+			method: http.MethodDelete,
+			status: http.StatusNotFound,
+			mg:     newNotificationChannel(),
+			call: func(e *notificationChannelExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+		},
+		"DeleteFailed": {
+			reason: "Should return an error if deleting the channel fails",
+			method: http.MethodDelete,
+			status: http.StatusBadRequest,
+			mg:     newNotificationChannel(),
+			call: func(e *notificationChannelExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteNotificationChannel),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method+" "+channelPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := monitoring.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			err := tc.call(&notificationChannelExternal{channels: s.Projects.NotificationChannels, projectID: projectID}, tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	monitoring "google.golang.org/api/monitoring/v3"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/monitoring/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	serviceName = "projects/myproject-id-1234/services/checkout"
+	servicePath = "/v3/" + serviceName
+)
+
+func newService(m ...func(*v1alpha1.Service)) *v1alpha1.Service {
+	cr := &v1alpha1.Service{}
+	meta.SetExternalName(cr, "checkout")
+	cr.Spec.ForProvider = v1alpha1.ServiceParameters{
+		DisplayName: gcp.StringPtr("Checkout"),
+		CloudRun:    &v1alpha1.CloudRunService{ServiceName: gcp.StringPtr("checkout"), Location: "europe-west1"},
+		UserLabels:  map[string]string{"team": "payments"},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func monitoredService(team string) *monitoring.MService {
+	return &monitoring.MService{
+		Name:        serviceName,
+		DisplayName: "Checkout",
+		CloudRun:    &monitoring.CloudRun{ServiceName: "checkout", Location: "europe-west1"},
+		Telemetry:   &monitoring.Telemetry{ResourceName: "//run.googleapis.com/projects/myproject-id-1234/locations/europe-west1/services/checkout"},
+		UserLabels:  map[string]string{"team": team},
+	}
+}
+
+func TestServiceObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		obs v1alpha1.ServiceObservation
+		err error
+	}
+
+	obs := v1alpha1.ServiceObservation{
+		Name:                  serviceName,
+		TelemetryResourceName: "//run.googleapis.com/projects/myproject-id-1234/locations/europe-west1/services/checkout",
+	}
+	cases := map[string]struct {
+		reason  string
+		status  int
+		service *monitoring.MService
+		kube    *test.MockClient
+		mg      resource.Managed
+		want    want
+	}{
+		"NotService": {
+			reason: "Should return an error if the resource is not a Service",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotService)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the service does not exist",
+			status: http.StatusNotFound,
+			mg:     newService(),
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the service fails",
+			status: http.StatusBadRequest,
+			mg:     newService(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetService)},
+		},
+		"LateInitFailed": {
+			reason:  "Should return an error if the late initialized spec can't be saved",
+			status:  http.StatusOK,
+			service: monitoredService("payments"),
+			kube:    &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:      newService(func(cr *v1alpha1.Service) { cr.Spec.ForProvider.DisplayName = nil }),
+			want:    want{err: errors.Wrap(errBoom, errUpdateServiceCR)},
+		},
+		"ResourceUpToDate": {
+			reason:  "Should report the telemetry of an up to date service",
+			status:  http.StatusOK,
+			service: monitoredService("payments"),
+			mg:      newService(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				obs: obs,
+			},
+		},
+		"NeedsUpdate": {
+			reason:  "Should return upToDate as false if the user labels differ",
+			status:  http.StatusOK,
+			service: monitoredService("checkout"),
+			mg:      newService(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				obs: obs,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet+" "+servicePath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.service == nil {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.service)
+			}))
+			defer server.Close()
+			s, _ := monitoring.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &serviceExternal{kube: tc.kube, services: s.Services, projectID: projectID}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Service); ok && err == nil {
+				if diff := cmp.Diff(tc.want.obs, cr.Status.AtProvider); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want observation, +got observation:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestServiceWrite(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		method string
+		path   string
+		query  string
+		status int
+		call   func(*serviceExternal, resource.Managed) error
+		want   error
+	}{
+		"CreateSuccessful": {
+			reason: "Should create the service with the external name as ID",
+			method: http.MethodPost,
+			path:   "/v3/projects/myproject-id-1234/services",
+			query:  "checkout",
+			status: http.StatusOK,
+			call: func(e *serviceExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+		},
+		"CreateFailed": {
+			reason: "Should return an error if creating the service fails",
+			method: http.MethodPost,
+			path:   "/v3/projects/myproject-id-1234/services",
+			query:  "checkout",
+			status: http.StatusBadRequest,
+			call: func(e *serviceExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateService),
+		},
+		"UpdateSuccessful": {
+			reason: "Should patch the service",
+			method: http.MethodPatch,
+			path:   servicePath,
+			status: http.StatusOK,
+			call: func(e *serviceExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+		},
+		"UpdateFailed": {
+			reason: "Should return an error if patching the service fails",
+			method: http.MethodPatch,
+			path:   servicePath,
+			status: http.StatusBadRequest,
+			call: func(e *serviceExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateService),
+		},
+		"DeleteNotFound": {
+			reason: "Should not return an error if the service is already gone",
+			method: http.MethodDelete,
+			path:   servicePath,
+			status: http.StatusNotFound,
+			call: func(e *serviceExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+		},
+		"DeleteFailed": {
+			reason: "Should return an error if deleting the service fails",
+			method: http.MethodDelete,
+			path:   servicePath,
+			status: http.StatusBadRequest,
+			call: func(e *serviceExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteService),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method+" "+tc.path, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.query, r.URL.Query().Get("serviceId")); diff != "" {
+					t.Errorf("serviceId: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := monitoring.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			err := tc.call(&serviceExternal{services: s.Services, projectID: projectID}, newService())
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
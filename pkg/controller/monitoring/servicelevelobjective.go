@@ -0,0 +1,167 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	monitoring "google.golang.org/api/monitoring/v3"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/monitoring/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	mclient "github.com/crossplane/provider-gcp/pkg/clients/monitoring"
+)
+
+// Error strings.
+const (
+	errNotServiceLevelObjective      = "managed resource is not a ServiceLevelObjective"
+	errGetServiceLevelObjective      = "cannot get ServiceLevelObjective"
+	errCreateServiceLevelObjective   = "cannot create ServiceLevelObjective"
+	errUpdateServiceLevelObjective   = "cannot update ServiceLevelObjective"
+	errDeleteServiceLevelObjective   = "cannot delete ServiceLevelObjective"
+	errUpdateServiceLevelObjectiveCR = "cannot update ServiceLevelObjective custom resource"
+	errCompareServiceLevelObjective  = "cannot compare ServiceLevelObjective"
+	errGenerateServiceLevelObjective = "cannot generate ServiceLevelObjective"
+)
+
+// SetupServiceLevelObjective adds a controller that reconciles
+// ServiceLevelObjectives.
+func SetupServiceLevelObjective(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.ServiceLevelObjectiveGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.ServiceLevelObjectiveGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.ServiceLevelObjective{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.ServiceLevelObjectiveGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ServiceLevelObjectiveGroupVersionKind),
+			&serviceLevelObjectiveConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type serviceLevelObjectiveConnector struct {
+	kube client.Client
+}
+
+func (c *serviceLevelObjectiveConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := monitoring.NewService(ctx, opts.For("monitoring")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &serviceLevelObjectiveExternal{kube: c.kube, objectives: s.Services.ServiceLevelObjectives}, nil
+}
+
+type serviceLevelObjectiveExternal struct {
+	kube       client.Client
+	objectives *monitoring.ServicesServiceLevelObjectivesService
+}
+
+func (e *serviceLevelObjectiveExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ServiceLevelObjective)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotServiceLevelObjective)
+	}
+	existing, err := e.objectives.Get(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetServiceLevelObjective)
+	}
+	current := cr.Spec.ForProvider.DeepCopy()
+	mclient.LateInitializeServiceLevelObjective(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateServiceLevelObjectiveCR)
+		}
+	}
+	cr.Status.AtProvider = mclient.GenerateServiceLevelObjectiveObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	upToDate, err := mclient.IsServiceLevelObjectiveUpToDate(cr.Spec.ForProvider, *existing)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCompareServiceLevelObjective)
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *serviceLevelObjectiveExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ServiceLevelObjective)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotServiceLevelObjective)
+	}
+	cr.SetConditions(xpv1.Creating())
+	o, err := mclient.GenerateServiceLevelObjective(cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGenerateServiceLevelObjective)
+	}
+	_, err = e.objectives.Create(gcp.StringValue(cr.Spec.ForProvider.Service), o).
+		ServiceLevelObjectiveId(meta.GetExternalName(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateServiceLevelObjective)
+}
+
+func (e *serviceLevelObjectiveExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ServiceLevelObjective)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotServiceLevelObjective)
+	}
+	o, err := mclient.GenerateServiceLevelObjective(cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGenerateServiceLevelObjective)
+	}
+	_, err = e.objectives.Patch(e.name(cr), o).UpdateMask(mclient.ServiceLevelObjectiveUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateServiceLevelObjective)
+}
+
+func (e *serviceLevelObjectiveExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ServiceLevelObjective)
+	if !ok {
+		return errors.New(errNotServiceLevelObjective)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.objectives.Delete(e.name(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteServiceLevelObjective)
+}
+
+func (e *serviceLevelObjectiveExternal) name(cr *v1alpha1.ServiceLevelObjective) string {
+	return mclient.GetServiceLevelObjectiveName(gcp.StringValue(cr.Spec.ForProvider.Service), meta.GetExternalName(cr))
+}
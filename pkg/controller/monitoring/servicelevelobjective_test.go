@@ -0,0 +1,267 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	monitoring "google.golang.org/api/monitoring/v3"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/monitoring/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	sloName = serviceName + "/serviceLevelObjectives/availability"
+	sloPath = "/v3/" + sloName
+)
+
+func newServiceLevelObjective(m ...func(*v1alpha1.ServiceLevelObjective)) *v1alpha1.ServiceLevelObjective {
+	cr := &v1alpha1.ServiceLevelObjective{}
+	meta.SetExternalName(cr, "availability")
+	cr.Spec.ForProvider = v1alpha1.ServiceLevelObjectiveParameters{
+		Service:        gcp.StringPtr(serviceName),
+		DisplayName:    gcp.StringPtr("99.9% available"),
+		Goal:           "0.999",
+		CalendarPeriod: gcp.StringPtr("MONTH"),
+		ServiceLevelIndicator: v1alpha1.ServiceLevelIndicator{
+			BasicSLI: &v1alpha1.BasicSLI{Availability: &v1alpha1.AvailabilityCriteria{}},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func objective(goal float64) *monitoring.ServiceLevelObjective {
+	return &monitoring.ServiceLevelObjective{
+		Name:           sloName,
+		DisplayName:    "99.9% available",
+		Goal:           goal,
+		CalendarPeriod: "MONTH",
+		ServiceLevelIndicator: &monitoring.ServiceLevelIndicator{
+			BasicSli: &monitoring.BasicSli{Availability: &monitoring.AvailabilityCriteria{}},
+		},
+	}
+}
+
+func TestServiceLevelObjectiveObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		obs v1alpha1.ServiceLevelObjectiveObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason    string
+		status    int
+		objective *monitoring.ServiceLevelObjective
+		kube      *test.MockClient
+		mg        resource.Managed
+		want      want
+	}{
+		"NotServiceLevelObjective": {
+			reason: "Should return an error if the resource is not a ServiceLevelObjective",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotServiceLevelObjective)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the objective does not exist",
+			status: http.StatusNotFound,
+			mg:     newServiceLevelObjective(),
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the objective fails",
+			status: http.StatusBadRequest,
+			mg:     newServiceLevelObjective(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetServiceLevelObjective)},
+		},
+		"LateInitFailed": {
+			reason:    "Should return an error if the late initialized spec can't be saved",
+			status:    http.StatusOK,
+			objective: objective(0.999),
+			kube:      &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:        newServiceLevelObjective(func(cr *v1alpha1.ServiceLevelObjective) { cr.Spec.ForProvider.DisplayName = nil }),
+			want:      want{err: errors.Wrap(errBoom, errUpdateServiceLevelObjectiveCR)},
+		},
+		"CompareFailed": {
+			reason:    "Should return an error if the objective can't be compared",
+			status:    http.StatusOK,
+			objective: objective(0.999),
+			mg:        newServiceLevelObjective(func(cr *v1alpha1.ServiceLevelObjective) { cr.Spec.ForProvider.Goal = "high" }),
+			want: want{err: errors.Wrap(errors.Wrap(errors.New(`strconv.ParseFloat: parsing "high": invalid syntax`), "cannot parse goal"),
+				errCompareServiceLevelObjective)},
+		},
+		"ResourceUpToDate": {
+			reason:    "Should report an up to date objective as available",
+			status:    http.StatusOK,
+			objective: objective(0.999),
+			mg:        newServiceLevelObjective(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				obs: v1alpha1.ServiceLevelObjectiveObservation{Name: sloName},
+			},
+		},
+		"NeedsUpdate": {
+			reason:    "Should return upToDate as false if the goal differs",
+			status:    http.StatusOK,
+			objective: objective(0.99),
+			mg:        newServiceLevelObjective(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				obs: v1alpha1.ServiceLevelObjectiveObservation{Name: sloName},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet+" "+sloPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.objective == nil {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.objective)
+			}))
+			defer server.Close()
+			s, _ := monitoring.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &serviceLevelObjectiveExternal{kube: tc.kube, objectives: s.Services.ServiceLevelObjectives}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.ServiceLevelObjective); ok && err == nil {
+				if diff := cmp.Diff(tc.want.obs, cr.Status.AtProvider); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want observation, +got observation:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestServiceLevelObjectiveWrite(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		method string
+		path   string
+		status int
+		call   func(*serviceLevelObjectiveExternal, resource.Managed) error
+		want   error
+	}{
+		"CreateSuccessful": {
+			reason: "Should create the objective on the referenced service",
+			method: http.MethodPost,
+			path:   "/v3/" + serviceName + "/serviceLevelObjectives",
+			status: http.StatusOK,
+			call: func(e *serviceLevelObjectiveExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+		},
+		"CreateFailed": {
+			reason: "Should return an error if creating the objective fails",
+			method: http.MethodPost,
+			path:   "/v3/" + serviceName + "/serviceLevelObjectives",
+			status: http.StatusBadRequest,
+			call: func(e *serviceLevelObjectiveExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateServiceLevelObjective),
+		},
+		"UpdateSuccessful": {
+			reason: "Should patch the objective",
+			method: http.MethodPatch,
+			path:   sloPath,
+			status: http.StatusOK,
+			call: func(e *serviceLevelObjectiveExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+		},
+		"UpdateFailed": {
+			reason: "Should return an error if patching the objective fails",
+			method: http.MethodPatch,
+			path:   sloPath,
+			status: http.StatusBadRequest,
+			call: func(e *serviceLevelObjectiveExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateServiceLevelObjective),
+		},
+		"DeleteNotFound": {
+			reason: "Should not return an error if the objective is already gone",
+			method: http.MethodDelete,
+			path:   sloPath,
+			status: http.StatusNotFound,
+			call: func(e *serviceLevelObjectiveExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+		},
+		"DeleteFailed": {
+			reason: "Should return an error if deleting the objective fails",
+			method: http.MethodDelete,
+			path:   sloPath,
+			status: http.StatusBadRequest,
+			call: func(e *serviceLevelObjectiveExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteServiceLevelObjective),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method+" "+tc.path, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := monitoring.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			err := tc.call(&serviceLevelObjectiveExternal{objectives: s.Services.ServiceLevelObjectives}, newServiceLevelObjective())
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	monitoring "google.golang.org/api/monitoring/v3"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/monitoring/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	mclient "github.com/crossplane/provider-gcp/pkg/clients/monitoring"
+)
+
+// Error strings.
+const (
+	errNotUptimeCheckConfig      = "managed resource is not an UptimeCheckConfig"
+	errGetUptimeCheckConfig      = "cannot get UptimeCheckConfig"
+	errCreateUptimeCheckConfig   = "cannot create UptimeCheckConfig"
+	errUpdateUptimeCheckConfig   = "cannot update UptimeCheckConfig"
+	errDeleteUptimeCheckConfig   = "cannot delete UptimeCheckConfig"
+	errUpdateUptimeCheckConfigCR = "cannot update UptimeCheckConfig custom resource"
+)
+
+// SetupUptimeCheckConfig adds a controller that reconciles
+// UptimeCheckConfigs.
+func SetupUptimeCheckConfig(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.UptimeCheckConfigGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.UptimeCheckConfigGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.UptimeCheckConfig{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.UptimeCheckConfigGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.UptimeCheckConfigGroupVersionKind),
+			&uptimeCheckConfigConnector{kube: mgr.GetClient()},
+			managed.WithInitializers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type uptimeCheckConfigConnector struct {
+	kube client.Client
+}
+
+func (c *uptimeCheckConfigConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := monitoring.NewService(ctx, opts.For("monitoring")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &uptimeCheckConfigExternal{kube: c.kube, checks: s.Projects.UptimeCheckConfigs, projectID: projectID}, nil
+}
+
+type uptimeCheckConfigExternal struct {
+	kube      client.Client
+	checks    *monitoring.ProjectsUptimeCheckConfigsService
+	projectID string
+}
+
+func (e *uptimeCheckConfigExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.UptimeCheckConfig)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotUptimeCheckConfig)
+	}
+	// Check IDs are derived from the display name by Cloud Monitoring, so
+	// until we've created the check we have nothing to look up.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	existing, err := e.checks.Get(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetUptimeCheckConfig)
+	}
+	current := cr.Spec.ForProvider.DeepCopy()
+	mclient.LateInitializeUptimeCheckConfig(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateUptimeCheckConfigCR)
+		}
+	}
+	cr.Status.AtProvider = mclient.GenerateUptimeCheckConfigObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: mclient.IsUptimeCheckConfigUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *uptimeCheckConfigExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.UptimeCheckConfig)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotUptimeCheckConfig)
+	}
+	cr.SetConditions(xpv1.Creating())
+	password, err := mclient.GetUptimeCheckPassword(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	u, err := e.checks.Create(mclient.GetUptimeCheckConfigParent(e.projectID, cr.Spec.ForProvider),
+		mclient.GenerateUptimeCheckConfig(cr.Spec.ForProvider, password)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateUptimeCheckConfig)
+	}
+	meta.SetExternalName(cr, mclient.GetID(u.Name))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *uptimeCheckConfigExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.UptimeCheckConfig)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotUptimeCheckConfig)
+	}
+	password, err := mclient.GetUptimeCheckPassword(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	_, err = e.checks.Patch(e.name(cr), mclient.GenerateUptimeCheckConfig(cr.Spec.ForProvider, password)).
+		UpdateMask(mclient.UptimeCheckConfigUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateUptimeCheckConfig)
+}
+
+func (e *uptimeCheckConfigExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.UptimeCheckConfig)
+	if !ok {
+		return errors.New(errNotUptimeCheckConfig)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.checks.Delete(e.name(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteUptimeCheckConfig)
+}
+
+func (e *uptimeCheckConfigExternal) name(cr *v1alpha1.UptimeCheckConfig) string {
+	return mclient.GetUptimeCheckConfigName(mclient.GetUptimeCheckConfigParent(e.projectID, cr.Spec.ForProvider), meta.GetExternalName(cr))
+}
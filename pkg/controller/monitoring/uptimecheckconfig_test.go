@@ -0,0 +1,343 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	monitoring "google.golang.org/api/monitoring/v3"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/monitoring/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	checkName = "projects/myproject-id-1234/uptimeCheckConfigs/storefront-1234"
+	checkPath = "/v3/" + checkName
+)
+
+func newUptimeCheckConfig(m ...func(*v1alpha1.UptimeCheckConfig)) *v1alpha1.UptimeCheckConfig {
+	cr := &v1alpha1.UptimeCheckConfig{}
+	meta.SetExternalName(cr, "storefront-1234")
+	cr.Spec.ForProvider = v1alpha1.UptimeCheckConfigParameters{
+		DisplayName: "Storefront",
+		MonitoredResource: v1alpha1.MonitoredResource{
+			Type:   "uptime_url",
+			Labels: map[string]string{"project_id": projectID, "host": "shop.example.com"},
+		},
+		HTTPCheck: &v1alpha1.HTTPCheck{
+			RequestMethod: gcp.StringPtr("GET"),
+			Path:          gcp.StringPtr("/healthz"),
+			Port:          gcp.Int64Ptr(443),
+			UseSSL:        gcp.BoolPtr(true),
+			ContentType:   gcp.StringPtr("TYPE_UNSPECIFIED"),
+		},
+		Period:          gcp.StringPtr("60s"),
+		Timeout:         gcp.StringPtr("10s"),
+		SelectedRegions: []string{"EUROPE", "USA"},
+		CheckerType:     gcp.StringPtr("STATIC_IP_CHECKERS"),
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func uptimeCheck(path string) *monitoring.UptimeCheckConfig {
+	return &monitoring.UptimeCheckConfig{
+		Name:        checkName,
+		DisplayName: "Storefront",
+		MonitoredResource: &monitoring.MonitoredResource{
+			Type:   "uptime_url",
+			Labels: map[string]string{"project_id": projectID, "host": "shop.example.com"},
+		},
+		HttpCheck: &monitoring.HttpCheck{
+			RequestMethod: "GET",
+			Path:          path,
+			Port:          443,
+			UseSsl:        true,
+			ContentType:   "TYPE_UNSPECIFIED",
+		},
+		Period:          "60s",
+		Timeout:         "10s",
+		SelectedRegions: []string{"EUROPE", "USA"},
+		CheckerType:     "STATIC_IP_CHECKERS",
+	}
+}
+
+func TestUptimeCheckConfigObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		obs v1alpha1.UptimeCheckConfigObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		status int
+		check  *monitoring.UptimeCheckConfig
+		kube   *test.MockClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotUptimeCheckConfig": {
+			reason: "Should return an error if the resource is not an UptimeCheckConfig",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotUptimeCheckConfig)},
+		},
+		"NoExternalName": {
+			reason: "Should report a check without external name as not existing",
+			mg:     newUptimeCheckConfig(func(cr *v1alpha1.UptimeCheckConfig) { meta.SetExternalName(cr, "") }),
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the check does not exist",
+			status: http.StatusNotFound,
+			mg:     newUptimeCheckConfig(),
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the check fails",
+			status: http.StatusBadRequest,
+			mg:     newUptimeCheckConfig(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetUptimeCheckConfig)},
+		},
+		"LateInitFailed": {
+			reason: "Should return an error if the late initialized spec can't be saved",
+			status: http.StatusOK,
+			check:  uptimeCheck("/healthz"),
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:     newUptimeCheckConfig(func(cr *v1alpha1.UptimeCheckConfig) { cr.Spec.ForProvider.Timeout = nil }),
+			want:   want{err: errors.Wrap(errBoom, errUpdateUptimeCheckConfigCR)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should report an up to date check as available",
+			status: http.StatusOK,
+			check:  uptimeCheck("/healthz"),
+			mg:     newUptimeCheckConfig(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				obs: v1alpha1.UptimeCheckConfigObservation{Name: checkName},
+			},
+		},
+		"NeedsUpdate": {
+			reason: "Should return upToDate as false if the path differs",
+			status: http.StatusOK,
+			check:  uptimeCheck("/"),
+			mg:     newUptimeCheckConfig(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				obs: v1alpha1.UptimeCheckConfigObservation{Name: checkName},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet+" "+checkPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.check == nil {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.check)
+			}))
+			defer server.Close()
+			s, _ := monitoring.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &uptimeCheckConfigExternal{kube: tc.kube, checks: s.Projects.UptimeCheckConfigs, projectID: projectID}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.UptimeCheckConfig); ok && err == nil {
+				if diff := cmp.Diff(tc.want.obs, cr.Status.AtProvider); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want observation, +got observation:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestUptimeCheckConfigCreate(t *testing.T) {
+	type want struct {
+		e            managed.ExternalCreation
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		status int
+		kube   *test.MockClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotUptimeCheckConfig": {
+			reason: "Should return an error if the resource is not an UptimeCheckConfig",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotUptimeCheckConfig)},
+		},
+		"Successful": {
+			reason: "Should record the ID of the created check",
+			status: http.StatusOK,
+			mg:     newUptimeCheckConfig(func(cr *v1alpha1.UptimeCheckConfig) { meta.SetExternalName(cr, "") }),
+			want: want{
+				e:            managed.ExternalCreation{ExternalNameAssigned: true},
+				externalName: "storefront-1234",
+			},
+		},
+		"PasswordFailed": {
+			reason: "Should return an error if the password can't be read",
+			kube:   &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			mg: newUptimeCheckConfig(func(cr *v1alpha1.UptimeCheckConfig) {
+				meta.SetExternalName(cr, "")
+				cr.Spec.ForProvider.HTTPCheck.AuthInfo = &v1alpha1.BasicAuthentication{
+					Username:          "prober",
+					PasswordSecretRef: xpv1.SecretKeySelector{Key: "password"},
+				}
+			}),
+			want: want{err: errors.Wrap(errBoom, "cannot get Secret")},
+		},
+		"Failed": {
+			reason: "Should return an error if creating the check fails",
+			status: http.StatusBadRequest,
+			mg:     newUptimeCheckConfig(func(cr *v1alpha1.UptimeCheckConfig) { meta.SetExternalName(cr, "") }),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateUptimeCheckConfig)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost+" /v3/projects/myproject-id-1234/uptimeCheckConfigs", r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.status != http.StatusOK {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(uptimeCheck("/healthz"))
+			}))
+			defer server.Close()
+			s, _ := monitoring.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &uptimeCheckConfigExternal{kube: tc.kube, checks: s.Projects.UptimeCheckConfigs, projectID: projectID}
+			got, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if err == nil {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.mg)); diff != "" {
+					t.Errorf("\n%s\nCreate(...): -want external name, +got external name:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestUptimeCheckConfigWrite(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		method string
+		status int
+		mg     resource.Managed
+		call   func(*uptimeCheckConfigExternal, resource.Managed) error
+		want   error
+	}{
+		"UpdateSuccessful": {
+			reason: "Should patch the check",
+			method: http.MethodPatch,
+			status: http.StatusOK,
+			mg:     newUptimeCheckConfig(),
+			call: func(e *uptimeCheckConfigExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+		},
+		"UpdateFailed": {
+			reason: "Should return an error if patching the check fails",
+			method: http.MethodPatch,
+			status: http.StatusBadRequest,
+			mg:     newUptimeCheckConfig(),
+			call: func(e *uptimeCheckConfigExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateUptimeCheckConfig),
+		},
+		"DeleteNotFound": {
+			reason: "Should not return an error if the check is already gone",
+			method: http.MethodDelete,
+			status: http.StatusNotFound,
+			mg:     newUptimeCheckConfig(),
+			call: func(e *uptimeCheckConfigExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+		},
+		"DeleteFailed": {
+			reason: "Should return an error if deleting the check fails",
+			method: http.MethodDelete,
+			status: http.StatusBadRequest,
+			mg:     newUptimeCheckConfig(),
+			call: func(e *uptimeCheckConfigExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteUptimeCheckConfig),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method+" "+checkPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := monitoring.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			err := tc.call(&uptimeCheckConfigExternal{checks: s.Projects.UptimeCheckConfigs, projectID: projectID}, tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
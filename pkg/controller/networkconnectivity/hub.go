@@ -0,0 +1,164 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkconnectivity
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	networkconnectivity "google.golang.org/api/networkconnectivity/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/networkconnectivity/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	ncclient "github.com/crossplane/provider-gcp/pkg/clients/networkconnectivity"
+)
+
+// Error strings.
+const (
+	errNewClient   = "cannot create new Network Connectivity client"
+	errNotHub      = "managed resource is not a Hub"
+	errGetHub      = "cannot get Hub"
+	errCreateHub   = "cannot create Hub"
+	errUpdateHub   = "cannot update Hub"
+	errDeleteHub   = "cannot delete Hub"
+	errUpdateHubCR = "cannot update Hub custom resource"
+)
+
+// SetupHub adds a controller that reconciles Hubs.
+func SetupHub(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.HubGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.HubGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Hub{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.HubGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.HubGroupVersionKind),
+			&hubConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hubConnector struct {
+	kube client.Client
+}
+
+func (c *hubConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := networkconnectivity.NewService(ctx, opts.For("networkconnectivity")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &hubExternal{kube: c.kube, hubs: s.Projects.Locations.Global.Hubs, projectID: projectID}, nil
+}
+
+type hubExternal struct {
+	kube      client.Client
+	hubs      *networkconnectivity.ProjectsLocationsGlobalHubsService
+	projectID string
+}
+
+func (e *hubExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Hub)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotHub)
+	}
+	existing, err := e.hubs.Get(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetHub)
+	}
+	current := cr.Spec.ForProvider.DeepCopy()
+	ncclient.LateInitializeHub(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateHubCR)
+		}
+	}
+	cr.Status.AtProvider = ncclient.GenerateHubObservation(*existing)
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.StateActive, v1alpha1.StateUpdating:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1alpha1.StateCreating:
+		cr.Status.SetConditions(xpv1.Creating())
+	case v1alpha1.StateDeleting:
+		cr.Status.SetConditions(xpv1.Deleting())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ncclient.IsHubUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *hubExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Hub)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotHub)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.hubs.Create(ncclient.GetHubParent(e.projectID, cr.Spec.ForProvider), ncclient.GenerateHub(cr.Spec.ForProvider)).
+		HubId(meta.GetExternalName(cr)).RequestId(gcp.CreateRequestID(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateHub)
+}
+
+func (e *hubExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Hub)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotHub)
+	}
+	_, err := e.hubs.Patch(e.name(cr), ncclient.GenerateHub(cr.Spec.ForProvider)).
+		UpdateMask(ncclient.UpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateHub)
+}
+
+func (e *hubExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Hub)
+	if !ok {
+		return errors.New(errNotHub)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.hubs.Delete(e.name(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteHub)
+}
+
+func (e *hubExternal) name(cr *v1alpha1.Hub) string {
+	return ncclient.GetHubName(ncclient.GetHubParent(e.projectID, cr.Spec.ForProvider), meta.GetExternalName(cr))
+}
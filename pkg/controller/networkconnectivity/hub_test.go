@@ -0,0 +1,285 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkconnectivity
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	networkconnectivity "google.golang.org/api/networkconnectivity/v1"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/networkconnectivity/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID = "myproject-id-1234"
+	hubName   = "projects/myproject-id-1234/locations/global/hubs/wan"
+	hubPath   = "/v1/" + hubName
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newHub(m ...func(*v1alpha1.Hub)) *v1alpha1.Hub {
+	cr := &v1alpha1.Hub{}
+	meta.SetExternalName(cr, "wan")
+	cr.Spec.ForProvider = v1alpha1.HubParameters{
+		Description: gcp.StringPtr("Global WAN"),
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func apiHub(m ...func(*networkconnectivity.Hub)) *networkconnectivity.Hub {
+	h := &networkconnectivity.Hub{
+		Name:        hubName,
+		State:       v1alpha1.StateActive,
+		Description: "Global WAN",
+	}
+	for _, f := range m {
+		f(h)
+	}
+	return h
+}
+
+func TestHubObserve(t *testing.T) {
+	type want struct {
+		e    managed.ExternalObservation
+		cond xpv1.Condition
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason string
+		status int
+		hub    *networkconnectivity.Hub
+		kube   *test.MockClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotHub": {
+			reason: "Should return an error if the resource is not a Hub",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotHub)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the hub does not exist",
+			status: http.StatusNotFound,
+			mg:     newHub(),
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the hub fails",
+			status: http.StatusBadRequest,
+			mg:     newHub(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetHub)},
+		},
+		"LateInitFailed": {
+			reason: "Should return an error if the late initialized spec can't be saved",
+			status: http.StatusOK,
+			hub:    apiHub(),
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:     newHub(func(cr *v1alpha1.Hub) { cr.Spec.ForProvider.Description = nil }),
+			want:   want{err: errors.Wrap(errBoom, errUpdateHubCR)},
+		},
+		"Ready": {
+			reason: "Should report an active, up to date hub as available",
+			status: http.StatusOK,
+			hub:    apiHub(),
+			mg:     newHub(),
+			want: want{
+				e:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Available(),
+			},
+		},
+		"Creating": {
+			reason: "Should report a hub that is being created as creating",
+			status: http.StatusOK,
+			hub:    apiHub(func(r *networkconnectivity.Hub) { r.State = v1alpha1.StateCreating }),
+			mg:     newHub(),
+			want: want{
+				e:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Creating(),
+			},
+		},
+		"NeedsUpdate": {
+			reason: "Should return upToDate as false if the description differs",
+			status: http.StatusOK,
+			hub:    apiHub(func(r *networkconnectivity.Hub) { r.Description = "Changed" }),
+			mg:     newHub(),
+			want: want{
+				e:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				cond: xpv1.Available(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet+" "+hubPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.hub == nil {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.hub)
+			}))
+			defer server.Close()
+			s, _ := networkconnectivity.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &hubExternal{kube: tc.kube, hubs: s.Projects.Locations.Global.Hubs, projectID: projectID}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Hub); ok && got.ResourceExists {
+				if diff := cmp.Diff(tc.want.cond, cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want condition, +got condition:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestHubWrite(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		method string
+		path   string
+		query  string
+		status int
+		call   func(*hubExternal, resource.Managed) error
+		want   error
+	}{
+		"CreateSuccessful": {
+			reason: "Should create the hub with the external name as ID",
+			method: http.MethodPost,
+			path:   "/v1/projects/myproject-id-1234/locations/global/hubs",
+			query:  "wan",
+			status: http.StatusOK,
+			call: func(e *hubExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+		},
+		"CreateFailed": {
+			reason: "Should return an error if creating the hub fails",
+			method: http.MethodPost,
+			path:   "/v1/projects/myproject-id-1234/locations/global/hubs",
+			query:  "wan",
+			status: http.StatusBadRequest,
+			call: func(e *hubExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateHub),
+		},
+		"UpdateSuccessful": {
+			reason: "Should patch the hub",
+			method: http.MethodPatch,
+			path:   hubPath,
+			status: http.StatusOK,
+			call: func(e *hubExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+		},
+		"UpdateFailed": {
+			reason: "Should return an error if patching the hub fails",
+			method: http.MethodPatch,
+			path:   hubPath,
+			status: http.StatusBadRequest,
+			call: func(e *hubExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateHub),
+		},
+		"DeleteNotFound": {
+			reason: "Should not return an error if the hub is already gone",
+			method: http.MethodDelete,
+			path:   hubPath,
+			status: http.StatusNotFound,
+			call: func(e *hubExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+		},
+		"DeleteFailed": {
+			reason: "Should return an error if deleting the hub fails",
+			method: http.MethodDelete,
+			path:   hubPath,
+			status: http.StatusBadRequest,
+			call: func(e *hubExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteHub),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method+" "+tc.path, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.query, r.URL.Query().Get("hubId")); diff != "" {
+					t.Errorf("hubId: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := networkconnectivity.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			err := tc.call(&hubExternal{hubs: s.Projects.Locations.Global.Hubs, projectID: projectID}, newHub())
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkconnectivity
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	networkconnectivity "google.golang.org/api/networkconnectivity/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/networkconnectivity/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	ncclient "github.com/crossplane/provider-gcp/pkg/clients/networkconnectivity"
+)
+
+// Error strings.
+const (
+	errNotSpoke      = "managed resource is not a Spoke"
+	errGetSpoke      = "cannot get Spoke"
+	errCreateSpoke   = "cannot create Spoke"
+	errUpdateSpoke   = "cannot update Spoke"
+	errDeleteSpoke   = "cannot delete Spoke"
+	errUpdateSpokeCR = "cannot update Spoke custom resource"
+)
+
+// SetupSpoke adds a controller that reconciles Spokes.
+func SetupSpoke(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.SpokeGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.SpokeGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Spoke{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.SpokeGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.SpokeGroupVersionKind),
+			&spokeConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type spokeConnector struct {
+	kube client.Client
+}
+
+func (c *spokeConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := networkconnectivity.NewService(ctx, opts.For("networkconnectivity")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &spokeExternal{kube: c.kube, spokes: s.Projects.Locations.Spokes, projectID: projectID}, nil
+}
+
+type spokeExternal struct {
+	kube      client.Client
+	spokes    *networkconnectivity.ProjectsLocationsSpokesService
+	projectID string
+}
+
+func (e *spokeExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Spoke)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSpoke)
+	}
+	existing, err := e.spokes.Get(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetSpoke)
+	}
+	current := cr.Spec.ForProvider.DeepCopy()
+	ncclient.LateInitializeSpoke(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateSpokeCR)
+		}
+	}
+	cr.Status.AtProvider = ncclient.GenerateSpokeObservation(*existing)
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.StateActive, v1alpha1.StateUpdating:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1alpha1.StateCreating:
+		cr.Status.SetConditions(xpv1.Creating())
+	case v1alpha1.StateDeleting:
+		cr.Status.SetConditions(xpv1.Deleting())
+	default:
+		// Spokes that are attached to a hub of another project stay
+		// inactive until the hub administrator accepts them.
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ncclient.IsSpokeUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *spokeExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Spoke)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSpoke)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.spokes.Create(ncclient.GetSpokeParent(e.projectID, cr.Spec.ForProvider), ncclient.GenerateSpoke(cr.Spec.ForProvider)).
+		SpokeId(meta.GetExternalName(cr)).RequestId(gcp.CreateRequestID(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateSpoke)
+}
+
+func (e *spokeExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Spoke)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSpoke)
+	}
+	_, err := e.spokes.Patch(e.name(cr), ncclient.GenerateSpoke(cr.Spec.ForProvider)).
+		UpdateMask(ncclient.UpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSpoke)
+}
+
+func (e *spokeExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Spoke)
+	if !ok {
+		return errors.New(errNotSpoke)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.spokes.Delete(e.name(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteSpoke)
+}
+
+func (e *spokeExternal) name(cr *v1alpha1.Spoke) string {
+	return ncclient.GetSpokeName(ncclient.GetSpokeParent(e.projectID, cr.Spec.ForProvider), meta.GetExternalName(cr))
+}
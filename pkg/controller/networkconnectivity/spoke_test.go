@@ -0,0 +1,291 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkconnectivity
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	networkconnectivity "google.golang.org/api/networkconnectivity/v1"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/networkconnectivity/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	spokeName = "projects/myproject-id-1234/locations/us-central1/spokes/branch"
+	spokePath = "/v1/" + spokeName
+)
+
+func newSpoke(m ...func(*v1alpha1.Spoke)) *v1alpha1.Spoke {
+	cr := &v1alpha1.Spoke{}
+	meta.SetExternalName(cr, "branch")
+	cr.Spec.ForProvider = v1alpha1.SpokeParameters{
+		Location:    "us-central1",
+		Hub:         gcp.StringPtr(hubName),
+		Description: gcp.StringPtr("Branch office"),
+		LinkedVPNTunnels: &v1alpha1.LinkedResources{
+			URIs:                   []string{"https://www.googleapis.com/compute/v1/projects/myproject-id-1234/regions/us-central1/vpnTunnels/branch-0"},
+			SiteToSiteDataTransfer: true,
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func apiSpoke(m ...func(*networkconnectivity.Spoke)) *networkconnectivity.Spoke {
+	s := &networkconnectivity.Spoke{
+		Name:        spokeName,
+		State:       v1alpha1.StateActive,
+		Hub:         hubName,
+		Description: "Branch office",
+		LinkedVpnTunnels: &networkconnectivity.LinkedVpnTunnels{
+			Uris:                   []string{"https://www.googleapis.com/compute/v1/projects/myproject-id-1234/regions/us-central1/vpnTunnels/branch-0"},
+			SiteToSiteDataTransfer: true,
+		},
+	}
+	for _, f := range m {
+		f(s)
+	}
+	return s
+}
+
+func TestSpokeObserve(t *testing.T) {
+	type want struct {
+		e    managed.ExternalObservation
+		cond xpv1.Condition
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason string
+		status int
+		spoke  *networkconnectivity.Spoke
+		kube   *test.MockClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotSpoke": {
+			reason: "Should return an error if the resource is not a Spoke",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotSpoke)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the spoke does not exist",
+			status: http.StatusNotFound,
+			mg:     newSpoke(),
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the spoke fails",
+			status: http.StatusBadRequest,
+			mg:     newSpoke(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetSpoke)},
+		},
+		"LateInitFailed": {
+			reason: "Should return an error if the late initialized spec can't be saved",
+			status: http.StatusOK,
+			spoke:  apiSpoke(),
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:     newSpoke(func(cr *v1alpha1.Spoke) { cr.Spec.ForProvider.Description = nil }),
+			want:   want{err: errors.Wrap(errBoom, errUpdateSpokeCR)},
+		},
+		"Ready": {
+			reason: "Should report an active, up to date spoke as available",
+			status: http.StatusOK,
+			spoke:  apiSpoke(),
+			mg:     newSpoke(),
+			want: want{
+				e:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Available(),
+			},
+		},
+		"Creating": {
+			reason: "Should report a spoke that is being created as creating",
+			status: http.StatusOK,
+			spoke:  apiSpoke(func(r *networkconnectivity.Spoke) { r.State = v1alpha1.StateCreating }),
+			mg:     newSpoke(),
+			want: want{
+				e:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Creating(),
+			},
+		},
+		"Inactive": {
+			reason: "Should report a spoke that awaits acceptance by the hub administrator as unavailable",
+			status: http.StatusOK,
+			spoke:  apiSpoke(func(r *networkconnectivity.Spoke) { r.State = v1alpha1.StateInactive }),
+			mg:     newSpoke(),
+			want: want{
+				e:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Unavailable(),
+			},
+		},
+		"NeedsUpdate": {
+			reason: "Should return upToDate as false if the description differs",
+			status: http.StatusOK,
+			spoke:  apiSpoke(func(r *networkconnectivity.Spoke) { r.Description = "Changed" }),
+			mg:     newSpoke(),
+			want: want{
+				e:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				cond: xpv1.Available(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet+" "+spokePath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.spoke == nil {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.spoke)
+			}))
+			defer server.Close()
+			s, _ := networkconnectivity.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &spokeExternal{kube: tc.kube, spokes: s.Projects.Locations.Spokes, projectID: projectID}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Spoke); ok && got.ResourceExists {
+				if diff := cmp.Diff(tc.want.cond, cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want condition, +got condition:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestSpokeWrite(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		method string
+		path   string
+		query  string
+		status int
+		call   func(*spokeExternal, resource.Managed) error
+		want   error
+	}{
+		"CreateSuccessful": {
+			reason: "Should create the spoke with the external name as ID",
+			method: http.MethodPost,
+			path:   "/v1/projects/myproject-id-1234/locations/us-central1/spokes",
+			query:  "branch",
+			status: http.StatusOK,
+			call: func(e *spokeExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+		},
+		"CreateFailed": {
+			reason: "Should return an error if creating the spoke fails",
+			method: http.MethodPost,
+			path:   "/v1/projects/myproject-id-1234/locations/us-central1/spokes",
+			query:  "branch",
+			status: http.StatusBadRequest,
+			call: func(e *spokeExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateSpoke),
+		},
+		"UpdateSuccessful": {
+			reason: "Should patch the spoke",
+			method: http.MethodPatch,
+			path:   spokePath,
+			status: http.StatusOK,
+			call: func(e *spokeExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+		},
+		"UpdateFailed": {
+			reason: "Should return an error if patching the spoke fails",
+			method: http.MethodPatch,
+			path:   spokePath,
+			status: http.StatusBadRequest,
+			call: func(e *spokeExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateSpoke),
+		},
+		"DeleteNotFound": {
+			reason: "Should not return an error if the spoke is already gone",
+			method: http.MethodDelete,
+			path:   spokePath,
+			status: http.StatusNotFound,
+			call: func(e *spokeExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+		},
+		"DeleteFailed": {
+			reason: "Should return an error if deleting the spoke fails",
+			method: http.MethodDelete,
+			path:   spokePath,
+			status: http.StatusBadRequest,
+			call: func(e *spokeExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteSpoke),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method+" "+tc.path, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.query, r.URL.Query().Get("spokeId")); diff != "" {
+					t.Errorf("spokeId: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := networkconnectivity.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			err := tc.call(&spokeExternal{spokes: s.Projects.Locations.Spokes, projectID: projectID}, newSpoke())
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
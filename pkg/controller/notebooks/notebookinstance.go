@@ -0,0 +1,176 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notebooks
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	notebooks "google.golang.org/api/notebooks/v2"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/notebooks/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	nbclient "github.com/crossplane/provider-gcp/pkg/clients/notebooks"
+)
+
+// Error strings.
+const (
+	errNewClient        = "cannot create new Notebooks client"
+	errNotInstance      = "managed resource is not a NotebookInstance"
+	errGetInstance      = "cannot get Workbench instance"
+	errCreateInstance   = "cannot create Workbench instance"
+	errUpdateInstance   = "cannot update Workbench instance"
+	errDeleteInstance   = "cannot delete Workbench instance"
+	errUpdateInstanceCR = "cannot update NotebookInstance custom resource"
+)
+
+// SetupNotebookInstance adds a controller that reconciles NotebookInstances.
+func SetupNotebookInstance(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.NotebookInstanceGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.NotebookInstanceGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.NotebookInstance{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.NotebookInstanceGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.NotebookInstanceGroupVersionKind),
+			&instanceConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type instanceConnector struct {
+	kube client.Client
+}
+
+func (c *instanceConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := notebooks.NewService(ctx, opts.For("notebooks")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &instanceExternal{kube: c.kube, instances: s.Projects.Locations.Instances, projectID: projectID}, nil
+}
+
+type instanceExternal struct {
+	kube      client.Client
+	instances *notebooks.ProjectsLocationsInstancesService
+	projectID string
+}
+
+func (e *instanceExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.NotebookInstance)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotInstance)
+	}
+	existing, err := e.instances.Get(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetInstance)
+	}
+	current := cr.Spec.ForProvider.DeepCopy()
+	nbclient.LateInitializeNotebookInstance(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateInstanceCR)
+		}
+	}
+	cr.Status.AtProvider = nbclient.GenerateNotebookInstanceObservation(*existing)
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.NotebookInstanceStateActive:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1alpha1.NotebookInstanceStateStarting, v1alpha1.NotebookInstanceStateProvisioning, v1alpha1.NotebookInstanceStateInitializing:
+		cr.Status.SetConditions(xpv1.Creating())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: nbclient.IsNotebookInstanceUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *instanceExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.NotebookInstance)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotInstance)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.instances.Create(nbclient.GetNotebookInstanceParent(e.projectID, cr.Spec.ForProvider), nbclient.GenerateNotebookInstance(cr.Spec.ForProvider)).
+		InstanceId(meta.GetExternalName(cr)).RequestId(gcp.CreateRequestID(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateInstance)
+}
+
+// Update changes the labels, machine type and metadata of the instance. The
+// machine type can only be changed while the instance is stopped, so the
+// update is rejected by Workbench until then.
+func (e *instanceExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.NotebookInstance)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotInstance)
+	}
+
+	// We have to get the instance again here to determine which fields to
+	// update, and to keep the metadata Workbench added to it.
+	existing, err := e.instances.Get(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetInstance)
+	}
+	mask := nbclient.GetNotebookInstanceUpdateMask(cr.Spec.ForProvider, *existing)
+	if mask == "" {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.instances.Patch(e.name(cr), nbclient.GenerateNotebookInstancePatch(cr.Spec.ForProvider, *existing)).
+		UpdateMask(mask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateInstance)
+}
+
+func (e *instanceExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.NotebookInstance)
+	if !ok {
+		return errors.New(errNotInstance)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.instances.Delete(e.name(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteInstance)
+}
+
+func (e *instanceExternal) name(cr *v1alpha1.NotebookInstance) string {
+	return nbclient.GetNotebookInstanceName(nbclient.GetNotebookInstanceParent(e.projectID, cr.Spec.ForProvider), meta.GetExternalName(cr))
+}
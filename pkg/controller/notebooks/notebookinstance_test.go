@@ -0,0 +1,365 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notebooks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	notebooks "google.golang.org/api/notebooks/v2"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/notebooks/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID    = "myproject-id-1234"
+	instanceName = "projects/myproject-id-1234/locations/us-central1-a/instances/analysis"
+	instancePath = "/v2/" + instanceName
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newInstance(m ...func(*v1alpha1.NotebookInstance)) *v1alpha1.NotebookInstance {
+	cr := &v1alpha1.NotebookInstance{}
+	meta.SetExternalName(cr, "analysis")
+	cr.Spec.ForProvider = v1alpha1.NotebookInstanceParameters{
+		Location:                   "us-central1-a",
+		MachineType:                "e2-standard-4",
+		Labels:                     map[string]string{"team": "research"},
+		IdleShutdownTimeoutMinutes: gcp.Int64Ptr(60),
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func instance(m ...func(*notebooks.Instance)) *notebooks.Instance {
+	i := &notebooks.Instance{
+		Name:   instanceName,
+		State:  v1alpha1.NotebookInstanceStateActive,
+		Labels: map[string]string{"team": "research"},
+		GceSetup: &notebooks.GceSetup{
+			MachineType: "e2-standard-4",
+			Metadata: map[string]string{
+				"idle-timeout-seconds": "3600",
+				"proxy-mode":           "service_account",
+			},
+		},
+	}
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+func TestNotebookInstanceObserve(t *testing.T) {
+	type want struct {
+		e    managed.ExternalObservation
+		cond xpv1.Condition
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason   string
+		status   int
+		instance *notebooks.Instance
+		kube     *test.MockClient
+		mg       resource.Managed
+		want     want
+	}{
+		"NotInstance": {
+			reason: "Should return an error if the resource is not a NotebookInstance",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotInstance)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the instance does not exist",
+			status: http.StatusNotFound,
+			mg:     newInstance(),
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the instance fails",
+			status: http.StatusBadRequest,
+			mg:     newInstance(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetInstance)},
+		},
+		"LateInitFailed": {
+			reason:   "Should return an error if the late initialized spec can't be saved",
+			status:   http.StatusOK,
+			instance: instance(),
+			kube:     &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:       newInstance(func(cr *v1alpha1.NotebookInstance) { cr.Spec.ForProvider.Labels = nil }),
+			want:     want{err: errors.Wrap(errBoom, errUpdateInstanceCR)},
+		},
+		"Active": {
+			reason:   "Should report an active instance as available",
+			status:   http.StatusOK,
+			instance: instance(),
+			mg:       newInstance(),
+			want: want{
+				e:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Available(),
+			},
+		},
+		"Provisioning": {
+			reason:   "Should report a provisioning instance as creating",
+			status:   http.StatusOK,
+			instance: instance(func(i *notebooks.Instance) { i.State = v1alpha1.NotebookInstanceStateProvisioning }),
+			mg:       newInstance(),
+			want: want{
+				e:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Creating(),
+			},
+		},
+		"StoppedNeedsUpdate": {
+			reason:   "Should report a stopped instance as unavailable and note a changed idle timeout",
+			status:   http.StatusOK,
+			instance: instance(func(i *notebooks.Instance) { i.State = v1alpha1.NotebookInstanceStateStopped }),
+			mg: newInstance(func(cr *v1alpha1.NotebookInstance) {
+				cr.Spec.ForProvider.IdleShutdownTimeoutMinutes = gcp.Int64Ptr(30)
+			}),
+			want: want{
+				e:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				cond: xpv1.Unavailable(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet+" "+instancePath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.instance == nil {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.instance)
+			}))
+			defer server.Close()
+			s, _ := notebooks.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &instanceExternal{kube: tc.kube, instances: s.Projects.Locations.Instances, projectID: projectID}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.NotebookInstance); ok && got.ResourceExists {
+				if diff := cmp.Diff(tc.want.cond, cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want condition, +got condition:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestNotebookInstanceUpdate(t *testing.T) {
+	cases := map[string]struct {
+		reason    string
+		getStatus int
+		instance  *notebooks.Instance
+		patch     bool
+		mask      string
+		body      *notebooks.Instance
+		mg        *v1alpha1.NotebookInstance
+		want      error
+	}{
+		"GetFailed": {
+			reason:    "Should return an error if getting the instance fails",
+			getStatus: http.StatusBadRequest,
+			want:      errors.Wrap(gError(http.StatusBadRequest, ""), errGetInstance),
+		},
+		"UpToDate": {
+			reason:    "Should not patch an up to date instance",
+			getStatus: http.StatusOK,
+			instance:  instance(),
+		},
+		"PatchMetadata": {
+			reason:    "Should patch the metadata of the instance and keep the metadata added by Workbench",
+			getStatus: http.StatusOK,
+			instance:  instance(),
+			patch:     true,
+			mask:      "gceSetup.metadata",
+			body: &notebooks.Instance{
+				Labels: map[string]string{"team": "research"},
+				GceSetup: &notebooks.GceSetup{
+					MachineType: "e2-standard-4",
+					Metadata: map[string]string{
+						"idle-timeout-seconds": "1800",
+						"proxy-mode":           "service_account",
+					},
+				},
+			},
+			mg: newInstance(func(cr *v1alpha1.NotebookInstance) {
+				cr.Spec.ForProvider.IdleShutdownTimeoutMinutes = gcp.Int64Ptr(30)
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodGet:
+					_ = r.Body.Close()
+					w.WriteHeader(tc.getStatus)
+					if tc.instance == nil {
+						_ = json.NewEncoder(w).Encode(struct{}{})
+						return
+					}
+					_ = json.NewEncoder(w).Encode(tc.instance)
+				case http.MethodPatch:
+					if !tc.patch {
+						t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					}
+					if diff := cmp.Diff(tc.mask, r.URL.Query().Get("updateMask")); diff != "" {
+						t.Errorf("updateMask: -want, +got:\n%s", diff)
+					}
+					got := &notebooks.Instance{}
+					_ = json.NewDecoder(r.Body).Decode(got)
+					_ = r.Body.Close()
+					if diff := cmp.Diff(tc.body, got); diff != "" {
+						t.Errorf("body: -want, +got:\n%s", diff)
+					}
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(struct{}{})
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+			}))
+			defer server.Close()
+			s, _ := notebooks.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			mg := tc.mg
+			if mg == nil {
+				mg = newInstance()
+			}
+			e := &instanceExternal{instances: s.Projects.Locations.Instances, projectID: projectID}
+			_, err := e.Update(context.Background(), mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestNotebookInstanceWrite(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		method string
+		path   string
+		query  string
+		status int
+		call   func(*instanceExternal, resource.Managed) error
+		want   error
+	}{
+		"CreateSuccessful": {
+			reason: "Should create the instance with the external name as ID",
+			method: http.MethodPost,
+			path:   "/v2/projects/myproject-id-1234/locations/us-central1-a/instances",
+			query:  "analysis",
+			status: http.StatusOK,
+			call: func(e *instanceExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+		},
+		"CreateFailed": {
+			reason: "Should return an error if creating the instance fails",
+			method: http.MethodPost,
+			path:   "/v2/projects/myproject-id-1234/locations/us-central1-a/instances",
+			query:  "analysis",
+			status: http.StatusBadRequest,
+			call: func(e *instanceExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateInstance),
+		},
+		"DeleteNotFound": {
+			reason: "Should not return an error if the instance is already gone",
+			method: http.MethodDelete,
+			path:   instancePath,
+			status: http.StatusNotFound,
+			call: func(e *instanceExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+		},
+		"DeleteFailed": {
+			reason: "Should return an error if deleting the instance fails",
+			method: http.MethodDelete,
+			path:   instancePath,
+			status: http.StatusBadRequest,
+			call: func(e *instanceExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteInstance),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method+" "+tc.path, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.query, r.URL.Query().Get("instanceId")); diff != "" {
+					t.Errorf("instanceId: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := notebooks.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			err := tc.call(&instanceExternal{instances: s.Projects.Locations.Instances, projectID: projectID}, newInstance())
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
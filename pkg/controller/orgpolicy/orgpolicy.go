@@ -0,0 +1,148 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orgpolicy
+
+import (
+	"context"
+	"time"
+
+	orgpolicy "google.golang.org/api/orgpolicy/v2"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/orgpolicy/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	opclient "github.com/crossplane/provider-gcp/pkg/clients/orgpolicy"
+)
+
+// Error strings.
+const (
+	errNewClient    = "cannot create new Organization Policy client"
+	errNotOrgPolicy = "managed resource is not an OrgPolicy"
+	errGetPolicy    = "cannot get policy"
+	errCreatePolicy = "cannot create policy"
+	errUpdatePolicy = "cannot update policy"
+	errDeletePolicy = "cannot delete policy"
+)
+
+// SetupOrgPolicy adds a controller that reconciles OrgPolicies.
+func SetupOrgPolicy(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.OrgPolicyGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.OrgPolicyGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.OrgPolicy{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.OrgPolicyGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.OrgPolicyGroupVersionKind),
+			&orgPolicyConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type orgPolicyConnector struct {
+	kube client.Client
+}
+
+func (c *orgPolicyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := orgpolicy.NewService(ctx, opts.For("orgpolicy")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	// The project, folder and organization policy services only differ in
+	// the parents they are documented for. Their requests are built from the
+	// full policy name, so the project service serves all of them.
+	return &orgPolicyExternal{policies: s.Projects.Policies, projectID: projectID}, nil
+}
+
+type orgPolicyExternal struct {
+	policies  *orgpolicy.ProjectsPoliciesService
+	projectID string
+}
+
+func (e *orgPolicyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.OrgPolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotOrgPolicy)
+	}
+	pol, err := e.policies.Get(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetPolicy)
+	}
+	cr.Status.AtProvider = opclient.GenerateOrgPolicyObservation(pol)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: opclient.IsOrgPolicyUpToDate(cr.Spec.ForProvider, pol),
+	}, nil
+}
+
+func (e *orgPolicyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.OrgPolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotOrgPolicy)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	parent := opclient.GetParent(e.projectID, cr.Spec.ForProvider)
+	_, err := e.policies.Create(parent, opclient.GeneratePolicy(e.name(cr), cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreatePolicy)
+}
+
+func (e *orgPolicyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.OrgPolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotOrgPolicy)
+	}
+	name := e.name(cr)
+	_, err := e.policies.Patch(name, opclient.GeneratePolicy(name, cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdatePolicy)
+}
+
+// Deleting a policy restores the behavior inherited from the parent.
+func (e *orgPolicyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.OrgPolicy)
+	if !ok {
+		return errors.New(errNotOrgPolicy)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.policies.Delete(e.name(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeletePolicy)
+}
+
+func (e *orgPolicyExternal) name(cr *v1alpha1.OrgPolicy) string {
+	return opclient.GetPolicyName(opclient.GetParent(e.projectID, cr.Spec.ForProvider), cr.Spec.ForProvider.Constraint)
+}
@@ -0,0 +1,291 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orgpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	orgpolicy "google.golang.org/api/orgpolicy/v2"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/orgpolicy/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID  = "myproject-id-1234"
+	policyPath = "/v2/folders/1234/policies/iam.disableServiceAccountKeyCreation"
+)
+
+var (
+	unexpectedObject resource.Managed
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newOrgPolicy(m ...func(*v1alpha1.OrgPolicy)) *v1alpha1.OrgPolicy {
+	cr := &v1alpha1.OrgPolicy{}
+	cr.Spec.ForProvider = v1alpha1.OrgPolicyParameters{
+		Parent:     gcp.StringPtr("folders/1234"),
+		Constraint: "constraints/iam.disableServiceAccountKeyCreation",
+		Rules:      []v1alpha1.PolicyRule{{Enforce: gcp.BoolPtr(true)}},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func policy(enforce bool) *orgpolicy.GoogleCloudOrgpolicyV2Policy {
+	return &orgpolicy.GoogleCloudOrgpolicyV2Policy{
+		Name: "folders/1234/policies/iam.disableServiceAccountKeyCreation",
+		Spec: &orgpolicy.GoogleCloudOrgpolicyV2PolicySpec{
+			Etag:  "BwXkz9fWUtM=",
+			Rules: []*orgpolicy.GoogleCloudOrgpolicyV2PolicySpecPolicyRule{{Enforce: enforce}},
+		},
+	}
+}
+
+func TestOrgPolicyObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotOrgPolicy": {
+			reason: "Should return an error if the resource is not an OrgPolicy",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotOrgPolicy)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			mg:     newOrgPolicy(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(policyPath, r.URL.Path); diff != "" {
+					t.Errorf("path: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"DefaultParent": {
+			reason: "Should default the parent to the project of the provider config",
+			mg: newOrgPolicy(func(cr *v1alpha1.OrgPolicy) {
+				cr.Spec.ForProvider.Parent = nil
+			}),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/v2/projects/myproject-id-1234/policies/iam.disableServiceAccountKeyCreation", r.URL.Path); diff != "" {
+					t.Errorf("path: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			mg:     newOrgPolicy(),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetPolicy)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+		},
+		"ResourceUpToDate": {
+			reason: "Should return upToDate as true if the resource is up to date",
+			mg:     newOrgPolicy(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(policy(true))
+			}),
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should return upToDate as false if the resource is not up to date",
+			mg:     newOrgPolicy(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(policy(false))
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := orgpolicy.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := orgPolicyExternal{
+				projectID: projectID,
+				policies:  s.Projects.Policies,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestOrgPolicyWrite(t *testing.T) {
+	create := func(e *orgPolicyExternal, mg resource.Managed) error {
+		_, err := e.Create(context.Background(), mg)
+		return err
+	}
+	update := func(e *orgPolicyExternal, mg resource.Managed) error {
+		_, err := e.Update(context.Background(), mg)
+		return err
+	}
+	del := func(e *orgPolicyExternal, mg resource.Managed) error {
+		return e.Delete(context.Background(), mg)
+	}
+
+	cases := map[string]struct {
+		reason  string
+		method  string
+		path    string
+		status  int
+		call    func(e *orgPolicyExternal, mg resource.Managed) error
+		mg      resource.Managed
+		wantErr error
+	}{
+		"CreateNotOrgPolicy": {
+			reason:  "Should return an error if the resource is not an OrgPolicy",
+			call:    create,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotOrgPolicy),
+		},
+		"CreateSuccessful": {
+			reason: "Should create the policy on its parent",
+			method: http.MethodPost,
+			path:   "/v2/folders/1234/policies",
+			status: http.StatusOK,
+			call:   create,
+			mg:     newOrgPolicy(),
+		},
+		"CreateFailed": {
+			reason:  "Should fail if the resource creation returns an error",
+			method:  http.MethodPost,
+			path:    "/v2/folders/1234/policies",
+			status:  http.StatusBadRequest,
+			call:    create,
+			mg:      newOrgPolicy(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errCreatePolicy),
+		},
+		"UpdateNotOrgPolicy": {
+			reason:  "Should return an error if the resource is not an OrgPolicy",
+			call:    update,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotOrgPolicy),
+		},
+		"UpdateSuccessful": {
+			reason: "Should succeed if the resource update doesn't return an error",
+			method: http.MethodPatch,
+			path:   policyPath,
+			status: http.StatusOK,
+			call:   update,
+			mg:     newOrgPolicy(),
+		},
+		"UpdateFailed": {
+			reason:  "Should fail if the resource update returns an error",
+			method:  http.MethodPatch,
+			path:    policyPath,
+			status:  http.StatusBadRequest,
+			call:    update,
+			mg:      newOrgPolicy(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdatePolicy),
+		},
+		"DeleteNotOrgPolicy": {
+			reason:  "Should return an error if the resource is not an OrgPolicy",
+			call:    del,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotOrgPolicy),
+		},
+		"DeleteAlreadyGone": {
+			reason: "Should not return an error if the resource is already gone",
+			method: http.MethodDelete,
+			path:   policyPath,
+			status: http.StatusNotFound,
+			call:   del,
+			mg:     newOrgPolicy(),
+		},
+		"DeleteFailed": {
+			reason:  "Should fail if the resource deletion returns an error",
+			method:  http.MethodDelete,
+			path:    policyPath,
+			status:  http.StatusBadRequest,
+			call:    del,
+			mg:      newOrgPolicy(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errDeletePolicy),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.path, r.URL.Path); diff != "" {
+					t.Errorf("path: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := orgpolicy.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &orgPolicyExternal{
+				projectID: projectID,
+				policies:  s.Projects.Policies,
+			}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
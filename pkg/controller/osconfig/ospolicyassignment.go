@@ -0,0 +1,172 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package osconfig
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	osconfig "google.golang.org/api/osconfig/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/osconfig/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	osclient "github.com/crossplane/provider-gcp/pkg/clients/osconfig"
+)
+
+// Error strings.
+const (
+	errNewClient          = "cannot create new OS Config client"
+	errNotAssignment      = "managed resource is not an OSPolicyAssignment"
+	errGetAssignment      = "cannot get OSPolicyAssignment"
+	errCreateAssignment   = "cannot create OSPolicyAssignment"
+	errUpdateAssignment   = "cannot update OSPolicyAssignment"
+	errDeleteAssignment   = "cannot delete OSPolicyAssignment"
+	errUpdateAssignmentCR = "cannot update OSPolicyAssignment custom resource"
+)
+
+// SetupOSPolicyAssignment adds a controller that reconciles
+// OSPolicyAssignments.
+func SetupOSPolicyAssignment(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.OSPolicyAssignmentGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.OSPolicyAssignmentGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.OSPolicyAssignment{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.OSPolicyAssignmentGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.OSPolicyAssignmentGroupVersionKind),
+			&assignmentConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type assignmentConnector struct {
+	kube client.Client
+}
+
+func (c *assignmentConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := osconfig.NewService(ctx, opts.For("osconfig")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &assignmentExternal{kube: c.kube, assignments: s.Projects.Locations.OsPolicyAssignments, projectID: projectID}, nil
+}
+
+type assignmentExternal struct {
+	kube        client.Client
+	assignments *osconfig.ProjectsLocationsOsPolicyAssignmentsService
+	projectID   string
+}
+
+func (e *assignmentExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.OSPolicyAssignment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAssignment)
+	}
+	existing, err := e.assignments.Get(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetAssignment)
+	}
+	current := cr.Spec.ForProvider.DeepCopy()
+	osclient.LateInitializeOSPolicyAssignment(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateAssignmentCR)
+		}
+	}
+	cr.Status.AtProvider = osclient.GenerateOSPolicyAssignmentObservation(*existing)
+	switch cr.Status.AtProvider.RolloutState {
+	case v1alpha1.RolloutStateSucceeded:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1alpha1.RolloutStateInProgress:
+		cr.Status.SetConditions(xpv1.Creating())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+	// A new revision can't be submitted until the current one has rolled
+	// out, so we consider the assignment up to date while it's reconciling.
+	upToDate := true
+	if !existing.Reconciling {
+		upToDate = osclient.IsOSPolicyAssignmentUpToDate(cr.Spec.ForProvider, *existing)
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *assignmentExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.OSPolicyAssignment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotAssignment)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.assignments.Create(osclient.GetOSPolicyAssignmentParent(e.projectID, cr.Spec.ForProvider), osclient.GenerateOSPolicyAssignment(cr.Spec.ForProvider)).
+		OsPolicyAssignmentId(meta.GetExternalName(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateAssignment)
+}
+
+func (e *assignmentExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.OSPolicyAssignment)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotAssignment)
+	}
+	if cr.Status.AtProvider.Reconciling {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err := e.assignments.Patch(e.name(cr), osclient.GenerateOSPolicyAssignment(cr.Spec.ForProvider)).
+		UpdateMask(osclient.OSPolicyAssignmentUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateAssignment)
+}
+
+func (e *assignmentExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.OSPolicyAssignment)
+	if !ok {
+		return errors.New(errNotAssignment)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.assignments.Delete(e.name(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteAssignment)
+}
+
+func (e *assignmentExternal) name(cr *v1alpha1.OSPolicyAssignment) string {
+	return osclient.GetOSPolicyAssignmentName(osclient.GetOSPolicyAssignmentParent(e.projectID, cr.Spec.ForProvider), meta.GetExternalName(cr))
+}
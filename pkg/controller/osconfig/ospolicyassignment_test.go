@@ -0,0 +1,350 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package osconfig
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	osconfig "google.golang.org/api/osconfig/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/osconfig/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID      = "myproject-id-1234"
+	assignmentName = "projects/myproject-id-1234/locations/us-central1-a/osPolicyAssignments/ops-agent"
+	assignmentPath = "/v1/" + assignmentName
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newAssignment(m ...func(*v1alpha1.OSPolicyAssignment)) *v1alpha1.OSPolicyAssignment {
+	cr := &v1alpha1.OSPolicyAssignment{}
+	meta.SetExternalName(cr, "ops-agent")
+	cr.Spec.ForProvider = v1alpha1.OSPolicyAssignmentParameters{
+		Location:    "us-central1-a",
+		Description: gcp.StringPtr("Install the Ops Agent"),
+		OSPolicies: []v1alpha1.OSPolicy{{
+			ID:   "ops-agent",
+			Mode: "ENFORCEMENT",
+			ResourceGroups: []v1alpha1.ResourceGroup{{
+				Resources: []v1alpha1.Resource{{
+					ID:  "package",
+					Pkg: &v1alpha1.PackageResource{DesiredState: "INSTALLED", Apt: &v1alpha1.PackageName{Name: "google-cloud-ops-agent"}},
+				}},
+			}},
+		}},
+		InstanceFilter: v1alpha1.InstanceFilter{All: gcp.BoolPtr(true)},
+		Rollout: v1alpha1.Rollout{
+			DisruptionBudget: v1alpha1.FixedOrPercent{Percent: gcp.Int64Ptr(10)},
+			MinWaitDuration:  "60s",
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func policyAssignment(m ...func(*osconfig.OSPolicyAssignment)) *osconfig.OSPolicyAssignment {
+	a := &osconfig.OSPolicyAssignment{
+		Name:        assignmentName,
+		Description: "Install the Ops Agent",
+		OsPolicies: []*osconfig.OSPolicy{{
+			Id:   "ops-agent",
+			Mode: "ENFORCEMENT",
+			ResourceGroups: []*osconfig.OSPolicyResourceGroup{{
+				Resources: []*osconfig.OSPolicyResource{{
+					Id: "package",
+					Pkg: &osconfig.OSPolicyResourcePackageResource{
+						DesiredState: "INSTALLED",
+						Apt:          &osconfig.OSPolicyResourcePackageResourceAPT{Name: "google-cloud-ops-agent"},
+					},
+				}},
+			}},
+		}},
+		InstanceFilter: &osconfig.OSPolicyAssignmentInstanceFilter{All: true},
+		Rollout: &osconfig.OSPolicyAssignmentRollout{
+			DisruptionBudget: &osconfig.FixedOrPercent{Percent: 10},
+			MinWaitDuration:  "60s",
+		},
+		Uid:          "abc-123",
+		RevisionId:   "r1",
+		RolloutState: v1alpha1.RolloutStateSucceeded,
+	}
+	for _, f := range m {
+		f(a)
+	}
+	return a
+}
+
+func TestOSPolicyAssignmentObserve(t *testing.T) {
+	type want struct {
+		e    managed.ExternalObservation
+		cond xpv1.Condition
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason     string
+		status     int
+		assignment *osconfig.OSPolicyAssignment
+		kube       *test.MockClient
+		mg         resource.Managed
+		want       want
+	}{
+		"NotOSPolicyAssignment": {
+			reason: "Should return an error if the resource is not an OSPolicyAssignment",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotAssignment)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the assignment does not exist",
+			status: http.StatusNotFound,
+			mg:     newAssignment(),
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the assignment fails",
+			status: http.StatusBadRequest,
+			mg:     newAssignment(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetAssignment)},
+		},
+		"LateInitFailed": {
+			reason:     "Should return an error if the late initialized spec can't be saved",
+			status:     http.StatusOK,
+			assignment: policyAssignment(),
+			kube:       &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:         newAssignment(func(cr *v1alpha1.OSPolicyAssignment) { cr.Spec.ForProvider.Description = nil }),
+			want:       want{err: errors.Wrap(errBoom, errUpdateAssignmentCR)},
+		},
+		"ResourceUpToDate": {
+			reason:     "Should report a rolled out assignment as available",
+			status:     http.StatusOK,
+			assignment: policyAssignment(),
+			mg:         newAssignment(),
+			want: want{
+				e:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Available(),
+			},
+		},
+		"NeedsUpdate": {
+			reason:     "Should return upToDate as false if the policies differ",
+			status:     http.StatusOK,
+			assignment: policyAssignment(func(a *osconfig.OSPolicyAssignment) { a.OsPolicies[0].Mode = "VALIDATION" }),
+			mg:         newAssignment(),
+			want: want{
+				e:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				cond: xpv1.Available(),
+			},
+		},
+		"RolloutInProgress": {
+			reason: "Should consider an assignment that is still rolling out up to date",
+			status: http.StatusOK,
+			assignment: policyAssignment(func(a *osconfig.OSPolicyAssignment) {
+				a.OsPolicies[0].Mode = "VALIDATION"
+				a.RolloutState = v1alpha1.RolloutStateInProgress
+				a.Reconciling = true
+			}),
+			mg: newAssignment(),
+			want: want{
+				e:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Creating(),
+			},
+		},
+		"RolloutCancelled": {
+			reason: "Should report an assignment whose rollout was cancelled as unavailable",
+			status: http.StatusOK,
+			assignment: policyAssignment(func(a *osconfig.OSPolicyAssignment) {
+				a.RolloutState = v1alpha1.RolloutStateCancelled
+			}),
+			mg: newAssignment(),
+			want: want{
+				e:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Unavailable(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet+" "+assignmentPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.assignment == nil {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.assignment)
+			}))
+			defer server.Close()
+			s, _ := osconfig.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &assignmentExternal{kube: tc.kube, assignments: s.Projects.Locations.OsPolicyAssignments, projectID: projectID}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.OSPolicyAssignment); ok && err == nil && got.ResourceExists {
+				if diff := cmp.Diff(tc.want.cond, cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want condition, +got condition:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestOSPolicyAssignmentWrite(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		method string
+		path   string
+		query  string
+		status int
+		call   func(*assignmentExternal, resource.Managed) error
+		want   error
+	}{
+		"CreateSuccessful": {
+			reason: "Should create the assignment with the external name as ID",
+			method: http.MethodPost,
+			path:   "/v1/projects/myproject-id-1234/locations/us-central1-a/osPolicyAssignments",
+			query:  "ops-agent",
+			status: http.StatusOK,
+			call: func(e *assignmentExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+		},
+		"CreateFailed": {
+			reason: "Should return an error if creating the assignment fails",
+			method: http.MethodPost,
+			path:   "/v1/projects/myproject-id-1234/locations/us-central1-a/osPolicyAssignments",
+			query:  "ops-agent",
+			status: http.StatusBadRequest,
+			call: func(e *assignmentExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateAssignment),
+		},
+		"UpdateSuccessful": {
+			reason: "Should patch the assignment",
+			method: http.MethodPatch,
+			path:   assignmentPath,
+			status: http.StatusOK,
+			call: func(e *assignmentExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+		},
+		"UpdateFailed": {
+			reason: "Should return an error if patching the assignment fails",
+			method: http.MethodPatch,
+			path:   assignmentPath,
+			status: http.StatusBadRequest,
+			call: func(e *assignmentExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateAssignment),
+		},
+		"DeleteNotFound": {
+			reason: "Should not return an error if the assignment is already gone",
+			method: http.MethodDelete,
+			path:   assignmentPath,
+			status: http.StatusNotFound,
+			call: func(e *assignmentExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+		},
+		"DeleteFailed": {
+			reason: "Should return an error if deleting the assignment fails",
+			method: http.MethodDelete,
+			path:   assignmentPath,
+			status: http.StatusBadRequest,
+			call: func(e *assignmentExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteAssignment),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method+" "+tc.path, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.query, r.URL.Query().Get("osPolicyAssignmentId")); diff != "" {
+					t.Errorf("osPolicyAssignmentId: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := osconfig.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			err := tc.call(&assignmentExternal{assignments: s.Projects.Locations.OsPolicyAssignments, projectID: projectID}, newAssignment())
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestOSPolicyAssignmentUpdateWhileReconciling(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+	s, _ := osconfig.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := &assignmentExternal{assignments: s.Projects.Locations.OsPolicyAssignments, projectID: projectID}
+	cr := newAssignment(func(cr *v1alpha1.OSPolicyAssignment) { cr.Status.AtProvider.Reconciling = true })
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Errorf("Update(...): unexpected error: %v", err)
+	}
+}
@@ -51,18 +51,20 @@ const (
 )
 
 // SetupSubscription adds a controller that reconciles Subscriptions.
-func SetupSubscription(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+func SetupSubscription(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
 	name := managed.ControllerName(v1alpha1.SubscriptionGroupKind)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: mcr.For(v1alpha1.SubscriptionGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
 		}).
 		For(&v1alpha1.Subscription{}).
-		Complete(managed.NewReconciler(mgr,
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.SubscriptionGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.SubscriptionGroupVersionKind),
-			managed.WithExternalConnecter(&subscriptionConnector{client: mgr.GetClient()}),
+			&subscriptionConnector{client: mgr.GetClient()},
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -79,7 +81,7 @@ func (c *subscriptionConnector) Connect(ctx context.Context, mg resource.Managed
 		return nil, err
 	}
 
-	s, err := pubsub.NewService(ctx, opts)
+	s, err := pubsub.NewService(ctx, opts.For("pubsub")...)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
@@ -108,7 +110,7 @@ func (e *subscriptionExternal) Observe(ctx context.Context, mg resource.Managed)
 	currentSpec := cr.Spec.ForProvider.DeepCopy()
 	subscription.LateInitialize(&cr.Spec.ForProvider, *s)
 
-	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
 		if err := e.client.Update(ctx, cr); err != nil {
 			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateSubscription)
 		}
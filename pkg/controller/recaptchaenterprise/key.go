@@ -0,0 +1,164 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recaptchaenterprise
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	recaptchaenterprise "google.golang.org/api/recaptchaenterprise/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/recaptchaenterprise/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	reclient "github.com/crossplane/provider-gcp/pkg/clients/recaptchaenterprise"
+)
+
+// Error strings.
+const (
+	errNewClient   = "cannot create new reCAPTCHA Enterprise client"
+	errNotKey      = "managed resource is not a Key"
+	errGetKey      = "cannot get Key"
+	errCreateKey   = "cannot create Key"
+	errUpdateKey   = "cannot update Key"
+	errDeleteKey   = "cannot delete Key"
+	errUpdateKeyCR = "cannot update Key custom resource"
+)
+
+// SetupKey adds a controller that reconciles reCAPTCHA Enterprise Keys.
+func SetupKey(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.KeyGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.KeyGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Key{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.KeyGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.KeyGroupVersionKind),
+			&keyConnector{kube: mgr.GetClient()},
+			managed.WithInitializers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type keyConnector struct {
+	kube client.Client
+}
+
+func (c *keyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := recaptchaenterprise.NewService(ctx, opts.For("recaptchaenterprise")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &keyExternal{kube: c.kube, keys: s.Projects.Keys, projectID: projectID}, nil
+}
+
+type keyExternal struct {
+	kube      client.Client
+	keys      *recaptchaenterprise.ProjectsKeysService
+	projectID string
+}
+
+func (e *keyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Key)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotKey)
+	}
+	// Key IDs are assigned by reCAPTCHA Enterprise, so until we've created
+	// the key we have nothing to look up.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	existing, err := e.keys.Get(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetKey)
+	}
+	current := cr.Spec.ForProvider.DeepCopy()
+	reclient.LateInitializeKey(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateKeyCR)
+		}
+	}
+	cr.Status.AtProvider = reclient.GenerateKeyObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  reclient.IsKeyUpToDate(cr.Spec.ForProvider, *existing),
+		ConnectionDetails: reclient.GetKeyConnectionDetails(cr.Status.AtProvider),
+	}, nil
+}
+
+func (e *keyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Key)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotKey)
+	}
+	cr.SetConditions(xpv1.Creating())
+	k, err := e.keys.Create(reclient.GetKeyParent(e.projectID, cr.Spec.ForProvider), reclient.GenerateKey(cr.Spec.ForProvider)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateKey)
+	}
+	meta.SetExternalName(cr, reclient.GetKeyID(k.Name))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *keyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Key)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotKey)
+	}
+	_, err := e.keys.Patch(e.name(cr), reclient.GenerateKey(cr.Spec.ForProvider)).UpdateMask(reclient.KeyUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateKey)
+}
+
+func (e *keyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Key)
+	if !ok {
+		return errors.New(errNotKey)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.keys.Delete(e.name(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteKey)
+}
+
+func (e *keyExternal) name(cr *v1alpha1.Key) string {
+	return reclient.GetKeyName(reclient.GetKeyParent(e.projectID, cr.Spec.ForProvider), meta.GetExternalName(cr))
+}
@@ -0,0 +1,331 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recaptchaenterprise
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	recaptchaenterprise "google.golang.org/api/recaptchaenterprise/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/recaptchaenterprise/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID = "myproject-id-1234"
+	siteKey   = "6LdT4e8aAAAAAKzE7wRplC9gZ0m1mG0pD5Qq6vXy"
+	keyParent = "projects/myproject-id-1234"
+	keyName   = keyParent + "/keys/" + siteKey
+	keyPath   = "/v1/" + keyName
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newKey(m ...func(*v1alpha1.Key)) *v1alpha1.Key {
+	cr := &v1alpha1.Key{}
+	meta.SetExternalName(cr, siteKey)
+	cr.Spec.ForProvider = v1alpha1.KeyParameters{
+		DisplayName: "storefront",
+		WebSettings: &v1alpha1.WebKeySettings{
+			IntegrationType:             "SCORE",
+			AllowedDomains:              []string{"example.com"},
+			ChallengeSecurityPreference: gcp.StringPtr("USABILITY"),
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func key(m ...func(*recaptchaenterprise.GoogleCloudRecaptchaenterpriseV1Key)) *recaptchaenterprise.GoogleCloudRecaptchaenterpriseV1Key {
+	k := &recaptchaenterprise.GoogleCloudRecaptchaenterpriseV1Key{
+		Name:        keyName,
+		CreateTime:  "2021-06-01T00:00:00Z",
+		DisplayName: "storefront",
+		WebSettings: &recaptchaenterprise.GoogleCloudRecaptchaenterpriseV1WebKeySettings{
+			IntegrationType:             "SCORE",
+			AllowedDomains:              []string{"example.com"},
+			ChallengeSecurityPreference: "USABILITY",
+		},
+	}
+	for _, f := range m {
+		f(k)
+	}
+	return k
+}
+
+func TestKeyObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		obs v1alpha1.KeyObservation
+		err error
+	}
+
+	obs := v1alpha1.KeyObservation{
+		Name:       keyName,
+		CreateTime: "2021-06-01T00:00:00Z",
+	}
+	conn := managed.ConnectionDetails{v1alpha1.KeySecretSiteKeyKey: []byte(siteKey)}
+	cases := map[string]struct {
+		reason string
+		status int
+		key    *recaptchaenterprise.GoogleCloudRecaptchaenterpriseV1Key
+		kube   *test.MockClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotKey": {
+			reason: "Should return an error if the resource is not a Key",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotKey)},
+		},
+		"NoExternalName": {
+			reason: "Should report that the key does not exist until it has been assigned an ID",
+			mg:     newKey(func(cr *v1alpha1.Key) { meta.SetExternalName(cr, "") }),
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the key does not exist",
+			status: http.StatusNotFound,
+			mg:     newKey(),
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the key fails",
+			status: http.StatusBadRequest,
+			mg:     newKey(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetKey)},
+		},
+		"LateInitFailed": {
+			reason: "Should return an error if the late initialized spec can't be saved",
+			status: http.StatusOK,
+			key:    key(),
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:     newKey(func(cr *v1alpha1.Key) { cr.Spec.ForProvider.WebSettings.ChallengeSecurityPreference = nil }),
+			want:   want{err: errors.Wrap(errBoom, errUpdateKeyCR)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should report the observation of an up to date key along with its site key",
+			status: http.StatusOK,
+			key:    key(),
+			mg:     newKey(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: conn},
+				obs: obs,
+			},
+		},
+		"NeedsUpdate": {
+			reason: "Should return upToDate as false if the allowed domains differ",
+			status: http.StatusOK,
+			key:    key(func(k *recaptchaenterprise.GoogleCloudRecaptchaenterpriseV1Key) { k.WebSettings.AllowedDomains = nil }),
+			mg:     newKey(),
+			want: want{
+				e:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: conn},
+				obs: obs,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet+" "+keyPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.key == nil {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.key)
+			}))
+			defer server.Close()
+			s, _ := recaptchaenterprise.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &keyExternal{kube: tc.kube, keys: s.Projects.Keys, projectID: projectID}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Key); ok && err == nil {
+				if diff := cmp.Diff(tc.want.obs, cr.Status.AtProvider); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want observation, +got observation:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestKeyCreate(t *testing.T) {
+	type want struct {
+		e            managed.ExternalCreation
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		status int
+		mg     resource.Managed
+		want   want
+	}{
+		"NotKey": {
+			reason: "Should return an error if the resource is not a Key",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotKey)},
+		},
+		"CreateSuccessful": {
+			reason: "Should record the site key reCAPTCHA Enterprise assigned as the external name",
+			status: http.StatusOK,
+			mg:     newKey(func(cr *v1alpha1.Key) { meta.SetExternalName(cr, "") }),
+			want: want{
+				e:            managed.ExternalCreation{ExternalNameAssigned: true},
+				externalName: siteKey,
+			},
+		},
+		"CreateFailed": {
+			reason: "Should return an error if creating the key fails",
+			status: http.StatusBadRequest,
+			mg:     newKey(func(cr *v1alpha1.Key) { meta.SetExternalName(cr, "") }),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateKey)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost+" /v1/"+keyParent+"/keys", r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.status != http.StatusOK {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(key())
+			}))
+			defer server.Close()
+			s, _ := recaptchaenterprise.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &keyExternal{keys: s.Projects.Keys, projectID: projectID}
+			got, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Key); ok && err == nil {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+					t.Errorf("\n%s\nCreate(...): -want external name, +got external name:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestKeyUpdateDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		method string
+		status int
+		call   func(*keyExternal, resource.Managed) error
+		want   error
+	}{
+		"UpdateSuccessful": {
+			reason: "Should patch the key",
+			method: http.MethodPatch,
+			status: http.StatusOK,
+			call: func(e *keyExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+		},
+		"UpdateFailed": {
+			reason: "Should return an error if patching the key fails",
+			method: http.MethodPatch,
+			status: http.StatusBadRequest,
+			call: func(e *keyExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateKey),
+		},
+		"DeleteNotFound": {
+			reason: "Should not return an error if the key is already gone",
+			method: http.MethodDelete,
+			status: http.StatusNotFound,
+			call: func(e *keyExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+		},
+		"DeleteFailed": {
+			reason: "Should return an error if deleting the key fails",
+			method: http.MethodDelete,
+			status: http.StatusBadRequest,
+			call: func(e *keyExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteKey),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method+" "+keyPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := recaptchaenterprise.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			err := tc.call(&keyExternal{keys: s.Projects.Keys, projectID: projectID}, newKey())
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcemanager
+
+import (
+	"context"
+	"time"
+
+	cloudresourcemanager "google.golang.org/api/cloudresourcemanager/v3"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/resourcemanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	rmclient "github.com/crossplane/provider-gcp/pkg/clients/resourcemanager"
+)
+
+// Error strings.
+const (
+	errNotFolder          = "managed resource is not a Folder"
+	errGetFolder          = "cannot get Folder"
+	errListFolders        = "cannot list Folders"
+	errCreateFolder       = "cannot create Folder"
+	errUpdateFolder       = "cannot update Folder"
+	errMoveFolder         = "cannot move Folder"
+	errUndeleteFolder     = "cannot undelete Folder"
+	errDeleteFolder       = "cannot delete Folder"
+	errUpdateFolderCR     = "cannot update Folder custom resource"
+	errListFolderChildren = "cannot list the children of Folder"
+	errFolderNotEmpty     = "cannot delete Folder while it contains folders or projects"
+)
+
+// SetupFolder adds a controller that reconciles Folders.
+func SetupFolder(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.FolderGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.FolderGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Folder{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.FolderGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.FolderGroupVersionKind),
+			&folderConnector{kube: mgr.GetClient()},
+			managed.WithInitializers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type folderConnector struct {
+	kube client.Client
+}
+
+func (c *folderConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := cloudresourcemanager.NewService(ctx, opts.For("cloudresourcemanager")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &folderExternal{kube: c.kube, folders: s.Folders, projects: s.Projects}, nil
+}
+
+type folderExternal struct {
+	kube     client.Client
+	folders  *cloudresourcemanager.FoldersService
+	projects *cloudresourcemanager.ProjectsService
+}
+
+func (e *folderExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Folder)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotFolder)
+	}
+	existing, err := e.getFolder(ctx, cr)
+	if err != nil || existing == nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.Status.AtProvider = rmclient.GenerateFolderObservation(*existing)
+
+	// Like projects, deleted folders stay pending deletion for 30 days.
+	if existing.State == v1alpha1.FolderStateDeleteRequested {
+		if meta.WasDeleted(cr) {
+			return managed.ExternalObservation{}, nil
+		}
+		cr.Status.SetConditions(xpv1.Unavailable())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+	}
+
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: existing.Parent == cr.Spec.ForProvider.Parent && rmclient.IsFolderUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+// Like projects, folders that do not exist are answered with 403, which only
+// means that the folder is gone if it was never observed or was last observed
+// pending deletion.
+func isFolderGone(cr *v1alpha1.Folder, err error) bool {
+	if gcp.IsErrorNotFound(err) {
+		return true
+	}
+	s := cr.Status.AtProvider.State
+	return gcp.IsErrorForbidden(err) && (s == "" || s == v1alpha1.FolderStateDeleteRequested)
+}
+
+// getFolder returns the folder of the supplied Folder, or nil if it does not
+// exist. Folder IDs are assigned by Resource Manager and are not returned
+// until the long-running creation completes, so a folder without an external
+// name is looked up by its display name, which is unique among its siblings.
+func (e *folderExternal) getFolder(ctx context.Context, cr *v1alpha1.Folder) (*cloudresourcemanager.Folder, error) {
+	if id := meta.GetExternalName(cr); id != "" {
+		f, err := e.folders.Get(rmclient.GetFolderName(id)).Context(ctx).Do()
+		if isFolderGone(cr, err) {
+			return nil, nil
+		}
+		return f, errors.Wrap(err, errGetFolder)
+	}
+	var folders []*cloudresourcemanager.Folder
+	err := e.folders.List().Parent(cr.Spec.ForProvider.Parent).Pages(ctx, func(r *cloudresourcemanager.ListFoldersResponse) error {
+		folders = append(folders, r.Folders...)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errListFolders)
+	}
+	f := rmclient.FindFolder(cr.Spec.ForProvider, folders)
+	if f == nil {
+		return nil, nil
+	}
+	meta.SetExternalName(cr, rmclient.GetFolderID(f.Name))
+	return f, errors.Wrap(e.kube.Update(ctx, cr), errUpdateFolderCR)
+}
+
+func (e *folderExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Folder)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotFolder)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	_, err := e.folders.Create(rmclient.GenerateFolder(cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateFolder)
+}
+
+func (e *folderExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Folder)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotFolder)
+	}
+	name := rmclient.GetFolderName(meta.GetExternalName(cr))
+	existing, err := e.folders.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetFolder)
+	}
+
+	if existing.State == v1alpha1.FolderStateDeleteRequested {
+		_, err := e.folders.Undelete(name, &cloudresourcemanager.UndeleteFolderRequest{}).Context(ctx).Do()
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUndeleteFolder)
+	}
+
+	if existing.Parent != cr.Spec.ForProvider.Parent {
+		req := &cloudresourcemanager.MoveFolderRequest{DestinationParent: cr.Spec.ForProvider.Parent}
+		if _, err := e.folders.Move(name, req).Context(ctx).Do(); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errMoveFolder)
+		}
+	}
+
+	if !rmclient.IsFolderUpToDate(cr.Spec.ForProvider, *existing) {
+		f := rmclient.GenerateFolder(cr.Spec.ForProvider)
+		if _, err := e.folders.Patch(name, f).UpdateMask(rmclient.FolderUpdateMask).Context(ctx).Do(); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFolder)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+// Folders that still contain active folders or projects are not deleted, so
+// that removing a Folder never takes down the hierarchy below it.
+func (e *folderExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Folder)
+	if !ok {
+		return errors.New(errNotFolder)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	name := rmclient.GetFolderName(meta.GetExternalName(cr))
+	folders, err := e.folders.List().Parent(name).PageSize(1).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(err, errListFolderChildren)
+	}
+	projects, err := e.projects.List().Parent(name).PageSize(1).Context(ctx).Do()
+	if err != nil {
+		return errors.Wrap(err, errListFolderChildren)
+	}
+	if len(folders.Folders) > 0 || len(projects.Projects) > 0 {
+		return errors.New(errFolderNotEmpty)
+	}
+	_, err = e.folders.Delete(name).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteFolder)
+}
@@ -0,0 +1,494 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcemanager
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cloudresourcemanager "google.golang.org/api/cloudresourcemanager/v3"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/resourcemanager/v1alpha1"
+)
+
+const folderID = "1234"
+
+func newFolder(m ...func(*v1alpha1.Folder)) *v1alpha1.Folder {
+	cr := &v1alpha1.Folder{}
+	meta.SetExternalName(cr, folderID)
+	cr.Spec.ForProvider = v1alpha1.FolderParameters{
+		Parent:      "organizations/42",
+		DisplayName: "Cool folder",
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observedFolder(m ...func(*cloudresourcemanager.Folder)) *cloudresourcemanager.Folder {
+	f := &cloudresourcemanager.Folder{
+		Name:        "folders/1234",
+		Parent:      "organizations/42",
+		DisplayName: "Cool folder",
+		State:       v1alpha1.FolderStateActive,
+	}
+	for _, fn := range m {
+		fn(f)
+	}
+	return f
+}
+
+// fakeFolderAPI serves the Resource Manager calls made by the Folder
+// controller and records every request it gets.
+type fakeFolderAPI struct {
+	folder   *cloudresourcemanager.Folder
+	siblings []*cloudresourcemanager.Folder
+	children []*cloudresourcemanager.Folder
+	projects []*cloudresourcemanager.Project
+
+	// fail is the request, in the form "METHOD path", that fails.
+	fail   string
+	status int
+
+	calls []string
+}
+
+func (f *fakeFolderAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	_ = r.Body.Close()
+	call := r.Method + " " + r.URL.Path
+	f.calls = append(f.calls, call)
+	if call == f.fail {
+		w.WriteHeader(f.status)
+		_ = json.NewEncoder(w).Encode(struct{}{})
+		return
+	}
+	var body interface{} = struct{}{}
+	switch call {
+	case "GET /v3/folders/1234":
+		if f.folder == nil {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		body = f.folder
+	case "GET /v3/folders":
+		if r.URL.Query().Get("parent") == "folders/1234" {
+			body = &cloudresourcemanager.ListFoldersResponse{Folders: f.children}
+			break
+		}
+		body = &cloudresourcemanager.ListFoldersResponse{Folders: f.siblings}
+	case "GET /v3/projects":
+		body = &cloudresourcemanager.ListProjectsResponse{Projects: f.projects}
+	}
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func newFolderExternal(t *testing.T, server *httptest.Server, kube *test.MockClient) *folderExternal {
+	t.Helper()
+	s, _ := cloudresourcemanager.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	return &folderExternal{kube: kube, folders: s.Folders, projects: s.Projects}
+}
+
+func TestFolderObserve(t *testing.T) {
+	type want struct {
+		e            managed.ExternalObservation
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		api    *fakeFolderAPI
+		kube   *test.MockClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotFolder": {
+			reason: "Should return an error if the resource is not a Folder",
+			api:    &fakeFolderAPI{},
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotFolder)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if a folder that was never observed is not visible",
+			api:    &fakeFolderAPI{},
+			mg:     newFolder(),
+			want:   want{externalName: folderID},
+		},
+		"ResourceForbidden": {
+			reason: "Should return an error if a folder that was observed before is not visible",
+			api:    &fakeFolderAPI{fail: "GET /v3/folders/1234", status: http.StatusForbidden},
+			mg: newFolder(func(cr *v1alpha1.Folder) {
+				cr.Status.AtProvider.State = v1alpha1.FolderStateActive
+			}),
+			want: want{
+				externalName: folderID,
+				err:          errors.Wrap(gError(http.StatusForbidden, ""), errGetFolder),
+			},
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the folder fails",
+			api:    &fakeFolderAPI{fail: "GET /v3/folders/1234", status: http.StatusInternalServerError},
+			mg:     newFolder(),
+			want: want{
+				externalName: folderID,
+				err:          errors.Wrap(gError(http.StatusInternalServerError, ""), errGetFolder),
+			},
+		},
+		"NotYetCreated": {
+			reason: "Should report a folder without external name as missing if no sibling has its display name",
+			api:    &fakeFolderAPI{siblings: []*cloudresourcemanager.Folder{observedFolder(func(f *cloudresourcemanager.Folder) { f.DisplayName = "Other folder" })}},
+			mg:     newFolder(func(cr *v1alpha1.Folder) { meta.SetExternalName(cr, "") }),
+		},
+		"ListFailed": {
+			reason: "Should return an error if listing the siblings of a folder without external name fails",
+			api:    &fakeFolderAPI{fail: "GET /v3/folders", status: http.StatusBadRequest},
+			mg:     newFolder(func(cr *v1alpha1.Folder) { meta.SetExternalName(cr, "") }),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errListFolders)},
+		},
+		"FoundByDisplayName": {
+			reason: "Should record the ID of a folder that was found by its display name",
+			api:    &fakeFolderAPI{siblings: []*cloudresourcemanager.Folder{observedFolder()}},
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			mg:     newFolder(func(cr *v1alpha1.Folder) { meta.SetExternalName(cr, "") }),
+			want: want{
+				e:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				externalName: folderID,
+			},
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if recording the ID of a folder fails",
+			api:    &fakeFolderAPI{siblings: []*cloudresourcemanager.Folder{observedFolder()}},
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:     newFolder(func(cr *v1alpha1.Folder) { meta.SetExternalName(cr, "") }),
+			want: want{
+				externalName: folderID,
+				err:          errors.Wrap(errBoom, errUpdateFolderCR),
+			},
+		},
+		"DeleteRequestedByUs": {
+			reason: "Should report a folder pending deletion as gone if the resource is being deleted",
+			api: &fakeFolderAPI{folder: observedFolder(func(f *cloudresourcemanager.Folder) {
+				f.State = v1alpha1.FolderStateDeleteRequested
+			})},
+			mg: newFolder(func(cr *v1alpha1.Folder) {
+				now := metav1.Now()
+				cr.SetDeletionTimestamp(&now)
+			}),
+			want: want{externalName: folderID},
+		},
+		"DeleteRequestedOutOfBand": {
+			reason: "Should report a folder pending deletion as outdated if the resource is not being deleted",
+			api: &fakeFolderAPI{folder: observedFolder(func(f *cloudresourcemanager.Folder) {
+				f.State = v1alpha1.FolderStateDeleteRequested
+			})},
+			mg: newFolder(),
+			want: want{
+				e:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				externalName: folderID,
+			},
+		},
+		"ResourceUpToDate": {
+			reason: "Should return upToDate as true if the resource is up to date",
+			api:    &fakeFolderAPI{folder: observedFolder()},
+			mg:     newFolder(),
+			want: want{
+				e:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				externalName: folderID,
+			},
+		},
+		"ParentDiffers": {
+			reason: "Should return upToDate as false if the folder has to be moved",
+			api:    &fakeFolderAPI{folder: observedFolder(func(f *cloudresourcemanager.Folder) { f.Parent = "folders/1" })},
+			mg:     newFolder(),
+			want: want{
+				e:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				externalName: folderID,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.api)
+			defer server.Close()
+			e := newFolderExternal(t, server, tc.kube)
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Folder); ok {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want external name, +got external name:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestFolderCreate(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		api     *fakeFolderAPI
+		mg      resource.Managed
+		wantErr error
+	}{
+		"NotFolder": {
+			reason:  "Should return an error if the resource is not a Folder",
+			api:     &fakeFolderAPI{},
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotFolder),
+		},
+		"Successful": {
+			reason: "Should succeed if the folder creation doesn't return an error",
+			api:    &fakeFolderAPI{},
+			mg:     newFolder(),
+		},
+		"Failed": {
+			reason:  "Should fail if the folder creation returns an error",
+			api:     &fakeFolderAPI{fail: "POST /v3/folders", status: http.StatusBadRequest},
+			mg:      newFolder(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateFolder),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.api)
+			defer server.Close()
+			e := newFolderExternal(t, server, nil)
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestFolderUpdate(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason string
+		api    *fakeFolderAPI
+		mg     resource.Managed
+		want   want
+	}{
+		"NotFolder": {
+			reason: "Should return an error if the resource is not a Folder",
+			api:    &fakeFolderAPI{},
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotFolder)},
+		},
+		"Undelete": {
+			reason: "Should only restore a folder that is pending deletion",
+			api: &fakeFolderAPI{folder: observedFolder(func(f *cloudresourcemanager.Folder) {
+				f.State = v1alpha1.FolderStateDeleteRequested
+				f.Parent = "folders/1"
+			})},
+			mg: newFolder(),
+			want: want{calls: []string{
+				"GET /v3/folders/1234",
+				"POST /v3/folders/1234:undelete",
+			}},
+		},
+		"MoveAndRename": {
+			reason: "Should move a folder to its new parent and patch its display name",
+			api: &fakeFolderAPI{folder: observedFolder(func(f *cloudresourcemanager.Folder) {
+				f.Parent = "folders/1"
+				f.DisplayName = "Other folder"
+			})},
+			mg: newFolder(),
+			want: want{calls: []string{
+				"GET /v3/folders/1234",
+				"POST /v3/folders/1234:move",
+				"PATCH /v3/folders/1234",
+			}},
+		},
+		"MoveFailed": {
+			reason: "Should return an error if moving the folder fails",
+			api: &fakeFolderAPI{
+				folder: observedFolder(func(f *cloudresourcemanager.Folder) { f.Parent = "folders/1" }),
+				fail:   "POST /v3/folders/1234:move",
+				status: http.StatusBadRequest,
+			},
+			mg: newFolder(),
+			want: want{
+				calls: []string{
+					"GET /v3/folders/1234",
+					"POST /v3/folders/1234:move",
+				},
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errMoveFolder),
+			},
+		},
+		"PatchFailed": {
+			reason: "Should return an error if patching the folder fails",
+			api: &fakeFolderAPI{
+				folder: observedFolder(func(f *cloudresourcemanager.Folder) { f.DisplayName = "Other folder" }),
+				fail:   "PATCH /v3/folders/1234",
+				status: http.StatusBadRequest,
+			},
+			mg: newFolder(),
+			want: want{
+				calls: []string{
+					"GET /v3/folders/1234",
+					"PATCH /v3/folders/1234",
+				},
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateFolder),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.api)
+			defer server.Close()
+			e := newFolderExternal(t, server, nil)
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, tc.api.calls); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want calls, +got calls:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestFolderDelete(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason string
+		api    *fakeFolderAPI
+		mg     resource.Managed
+		want   want
+	}{
+		"NotFolder": {
+			reason: "Should return an error if the resource is not a Folder",
+			api:    &fakeFolderAPI{},
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotFolder)},
+		},
+		"Successful": {
+			reason: "Should delete an empty folder",
+			api:    &fakeFolderAPI{},
+			mg:     newFolder(),
+			want: want{calls: []string{
+				"GET /v3/folders",
+				"GET /v3/projects",
+				"DELETE /v3/folders/1234",
+			}},
+		},
+		"ContainsFolders": {
+			reason: "Should not delete a folder that contains folders",
+			api:    &fakeFolderAPI{children: []*cloudresourcemanager.Folder{{Name: "folders/5678"}}},
+			mg:     newFolder(),
+			want: want{
+				calls: []string{
+					"GET /v3/folders",
+					"GET /v3/projects",
+				},
+				err: errors.New(errFolderNotEmpty),
+			},
+		},
+		"ContainsProjects": {
+			reason: "Should not delete a folder that contains projects",
+			api:    &fakeFolderAPI{projects: []*cloudresourcemanager.Project{{Name: "projects/42"}}},
+			mg:     newFolder(),
+			want: want{
+				calls: []string{
+					"GET /v3/folders",
+					"GET /v3/projects",
+				},
+				err: errors.New(errFolderNotEmpty),
+			},
+		},
+		"ListChildrenFailed": {
+			reason: "Should return an error if listing the children of the folder fails",
+			api:    &fakeFolderAPI{fail: "GET /v3/projects", status: http.StatusBadRequest},
+			mg:     newFolder(),
+			want: want{
+				calls: []string{
+					"GET /v3/folders",
+					"GET /v3/projects",
+				},
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errListFolderChildren),
+			},
+		},
+		"AlreadyGone": {
+			reason: "Should not return an error if the folder is already gone",
+			api:    &fakeFolderAPI{fail: "DELETE /v3/folders/1234", status: http.StatusNotFound},
+			mg:     newFolder(),
+			want: want{calls: []string{
+				"GET /v3/folders",
+				"GET /v3/projects",
+				"DELETE /v3/folders/1234",
+			}},
+		},
+		"Failed": {
+			reason: "Should return an error if deleting the folder fails",
+			api:    &fakeFolderAPI{fail: "DELETE /v3/folders/1234", status: http.StatusBadRequest},
+			mg:     newFolder(),
+			want: want{
+				calls: []string{
+					"GET /v3/folders",
+					"GET /v3/projects",
+					"DELETE /v3/folders/1234",
+				},
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteFolder),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.api)
+			defer server.Close()
+			e := newFolderExternal(t, server, nil)
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, tc.api.calls); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want calls, +got calls:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
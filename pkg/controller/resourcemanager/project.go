@@ -0,0 +1,334 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcemanager
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	cloudbilling "google.golang.org/api/cloudbilling/v1"
+	cloudresourcemanager "google.golang.org/api/cloudresourcemanager/v3"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/resourcemanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	rmclient "github.com/crossplane/provider-gcp/pkg/clients/resourcemanager"
+)
+
+// Error strings.
+const (
+	errNewClient             = "cannot create new GCP client"
+	errNotProject            = "managed resource is not a Project"
+	errGetProject            = "cannot get Project"
+	errCreateProject         = "cannot create Project"
+	errUpdateProject         = "cannot update Project"
+	errMoveProject           = "cannot move Project"
+	errUndeleteProject       = "cannot undelete Project"
+	errDeleteProject         = "cannot delete Project"
+	errUpdateProjectCR       = "cannot update Project custom resource"
+	errGetBillingInfo        = "cannot get billing info of Project"
+	errUpdateBillingInfo     = "cannot update billing info of Project"
+	errListLiens             = "cannot list liens of Project"
+	errProjectHasLiens       = "cannot delete Project while it has liens: %s"
+	errGetDefaultNetwork     = "cannot get default network of Project"
+	errListDefaultFirewalls  = "cannot list firewall rules of the default network of Project"
+	errDeleteDefaultFirewall = "cannot delete firewall rule of the default network of Project"
+	errDeleteDefaultNetwork  = "cannot delete default network of Project"
+)
+
+// reasonAccessNotConfigured is the reason of the errors that Compute Engine
+// returns for projects it is not enabled in.
+const reasonAccessNotConfigured = "accessNotConfigured"
+
+// SetupProject adds a controller that reconciles Projects.
+func SetupProject(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.ProjectGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.ProjectGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Project{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.ProjectGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ProjectGroupVersionKind),
+			&projectConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type projectConnector struct {
+	kube client.Client
+}
+
+func (c *projectConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	rm, err := cloudresourcemanager.NewService(ctx, opts.For("cloudresourcemanager")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	b, err := cloudbilling.NewService(ctx, opts.For("cloudbilling")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	cs, err := compute.NewService(ctx, opts.For("compute")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &projectExternal{kube: c.kube, projects: rm.Projects, liens: rm.Liens, billing: b.Projects, compute: cs}, nil
+}
+
+type projectExternal struct {
+	kube     client.Client
+	projects *cloudresourcemanager.ProjectsService
+	liens    *cloudresourcemanager.LiensService
+	billing  *cloudbilling.ProjectsService
+	compute  *compute.Service
+}
+
+// Resource Manager answers requests for projects that do not exist, or that
+// the caller cannot see, with 403 rather than 404. A 403 only means that the
+// project is gone if it was never observed, in which case creating it reports
+// any missing permissions, or if it was last observed pending deletion, in
+// which case it has since been purged. Otherwise the caller lost access to it.
+func isProjectGone(cr *v1alpha1.Project, err error) bool {
+	if gcp.IsErrorNotFound(err) {
+		return true
+	}
+	s := cr.Status.AtProvider.State
+	return gcp.IsErrorForbidden(err) && (s == "" || s == v1alpha1.ProjectStateDeleteRequested)
+}
+
+// Compute Engine answers with 403 when its API is not enabled in a project,
+// which also means that the project has no default network.
+func isErrorAPIDisabled(err error) bool {
+	var gErr *googleapi.Error
+	if !errors.As(err, &gErr) || gErr.Code != http.StatusForbidden {
+		return false
+	}
+	for _, e := range gErr.Errors {
+		if e.Reason == reasonAccessNotConfigured {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *projectExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Project)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotProject)
+	}
+	id := meta.GetExternalName(cr)
+	existing, err := e.projects.Get(rmclient.GetProjectName(id)).Context(ctx).Do()
+	if err != nil {
+		if isProjectGone(cr, err) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetProject)
+	}
+	cr.Status.AtProvider = rmclient.GenerateProjectObservation(*existing)
+
+	// A deleted project stays pending deletion for 30 days before it is
+	// purged, during which its ID cannot be reused. If we asked for the
+	// deletion the project is as good as gone. Otherwise it was deleted out
+	// of band and is restored by the next update.
+	if existing.State == v1alpha1.ProjectStateDeleteRequested {
+		if meta.WasDeleted(cr) {
+			return managed.ExternalObservation{}, nil
+		}
+		cr.Status.SetConditions(xpv1.Unavailable())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	rmclient.LateInitializeProject(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateProjectCR)
+		}
+	}
+	upToDate := existing.Parent == cr.Spec.ForProvider.Parent && rmclient.IsProjectUpToDate(cr.Spec.ForProvider, *existing)
+
+	if cr.Spec.ForProvider.BillingAccount != nil {
+		info, err := e.billing.GetBillingInfo(rmclient.GetProjectName(id)).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetBillingInfo)
+		}
+		cr.Status.AtProvider.BillingEnabled = info.BillingEnabled
+		upToDate = upToDate && rmclient.IsBillingInfoUpToDate(cr.Spec.ForProvider, *info)
+	}
+
+	if cr.Spec.ForProvider.AutoCreateNetwork != nil && !*cr.Spec.ForProvider.AutoCreateNetwork {
+		n, err := e.getDefaultNetwork(ctx, id)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		upToDate = upToDate && n == nil
+	}
+
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *projectExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Project)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotProject)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	_, err := e.projects.Create(rmclient.GenerateProject(meta.GetExternalName(cr), cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateProject)
+}
+
+func (e *projectExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Project)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotProject)
+	}
+	id := meta.GetExternalName(cr)
+	name := rmclient.GetProjectName(id)
+	existing, err := e.projects.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetProject)
+	}
+
+	// The remaining fields can only be changed once the project is active
+	// again, which is checked by the next observation.
+	if existing.State == v1alpha1.ProjectStateDeleteRequested {
+		_, err := e.projects.Undelete(name, &cloudresourcemanager.UndeleteProjectRequest{}).Context(ctx).Do()
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUndeleteProject)
+	}
+
+	if existing.Parent != cr.Spec.ForProvider.Parent {
+		req := &cloudresourcemanager.MoveProjectRequest{DestinationParent: cr.Spec.ForProvider.Parent}
+		if _, err := e.projects.Move(name, req).Context(ctx).Do(); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errMoveProject)
+		}
+	}
+
+	if !rmclient.IsProjectUpToDate(cr.Spec.ForProvider, *existing) {
+		p := rmclient.GenerateProject(id, cr.Spec.ForProvider)
+		if _, err := e.projects.Patch(name, p).UpdateMask(rmclient.ProjectUpdateMask).Context(ctx).Do(); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateProject)
+		}
+	}
+
+	if cr.Spec.ForProvider.BillingAccount != nil {
+		info, err := e.billing.GetBillingInfo(name).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errGetBillingInfo)
+		}
+		if !rmclient.IsBillingInfoUpToDate(cr.Spec.ForProvider, *info) {
+			if _, err := e.billing.UpdateBillingInfo(name, rmclient.GenerateBillingInfo(cr.Spec.ForProvider)).Context(ctx).Do(); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateBillingInfo)
+			}
+		}
+	}
+
+	if cr.Spec.ForProvider.AutoCreateNetwork != nil && !*cr.Spec.ForProvider.AutoCreateNetwork {
+		return managed.ExternalUpdate{}, e.deleteDefaultNetwork(ctx, id)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+// Projects that still have liens cannot be deleted. The liens are reported
+// rather than removed, since they are usually held by other services that
+// depend on the project.
+func (e *projectExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Project)
+	if !ok {
+		return errors.New(errNotProject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	name := rmclient.GetProjectName(meta.GetExternalName(cr))
+	var liens []*cloudresourcemanager.Lien
+	err := e.liens.List().Parent(name).Pages(ctx, func(r *cloudresourcemanager.ListLiensResponse) error {
+		liens = append(liens, r.Liens...)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, errListLiens)
+	}
+	if len(liens) > 0 {
+		return errors.Errorf(errProjectHasLiens, rmclient.DescribeLiens(liens))
+	}
+	_, err = e.projects.Delete(name).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteProject)
+}
+
+// getDefaultNetwork returns the default network of the supplied project, or
+// nil if the project does not have one.
+func (e *projectExternal) getDefaultNetwork(ctx context.Context, project string) (*compute.Network, error) {
+	n, err := e.compute.Networks.Get(project, rmclient.DefaultNetworkName).Context(ctx).Do()
+	if gcp.IsErrorNotFound(err) || isErrorAPIDisabled(err) {
+		return nil, nil
+	}
+	return n, errors.Wrap(err, errGetDefaultNetwork)
+}
+
+// deleteDefaultNetwork deletes the default network of the supplied project
+// along with its firewall rules. The network cannot be deleted until the
+// deletion of its firewall rules completes, so this may take a few calls.
+func (e *projectExternal) deleteDefaultNetwork(ctx context.Context, project string) error {
+	n, err := e.getDefaultNetwork(ctx, project)
+	if err != nil || n == nil {
+		return err
+	}
+	var firewalls []*compute.Firewall
+	err = e.compute.Firewalls.List(project).Filter(fmt.Sprintf("network = %q", n.SelfLink)).Pages(ctx, func(l *compute.FirewallList) error {
+		firewalls = append(firewalls, l.Items...)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, errListDefaultFirewalls)
+	}
+	for _, f := range firewalls {
+		if _, err := e.compute.Firewalls.Delete(project, f.Name).Context(ctx).Do(); resource.Ignore(gcp.IsErrorNotFound, err) != nil {
+			return errors.Wrap(err, errDeleteDefaultFirewall)
+		}
+	}
+	_, err = e.compute.Networks.Delete(project, n.Name).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteDefaultNetwork)
+}
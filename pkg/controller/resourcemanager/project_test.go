@@ -0,0 +1,601 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcemanager
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cloudbilling "google.golang.org/api/cloudbilling/v1"
+	cloudresourcemanager "google.golang.org/api/cloudresourcemanager/v3"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/resourcemanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID      = "cool-project"
+	billingAccount = "012345-567890-ABCDEF"
+	selfLink       = "https://www.googleapis.com/compute/v1/projects/cool-project/global/networks/default"
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newProject(m ...func(*v1alpha1.Project)) *v1alpha1.Project {
+	cr := &v1alpha1.Project{}
+	meta.SetExternalName(cr, projectID)
+	cr.Spec.ForProvider = v1alpha1.ProjectParameters{
+		Parent:            "folders/1234",
+		DisplayName:       gcp.StringPtr("Cool project"),
+		Labels:            map[string]string{"team": "cool"},
+		BillingAccount:    gcp.StringPtr(billingAccount),
+		AutoCreateNetwork: gcp.BoolPtr(false),
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observedProject(m ...func(*cloudresourcemanager.Project)) *cloudresourcemanager.Project {
+	p := &cloudresourcemanager.Project{
+		Name:        "projects/42",
+		ProjectId:   projectID,
+		Parent:      "folders/1234",
+		DisplayName: "Cool project",
+		Labels:      map[string]string{"team": "cool"},
+		State:       v1alpha1.ProjectStateActive,
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func linked() *cloudbilling.ProjectBillingInfo {
+	return &cloudbilling.ProjectBillingInfo{BillingAccountName: "billingAccounts/" + billingAccount, BillingEnabled: true}
+}
+
+// fakeAPI serves the Resource Manager, Cloud Billing and Compute Engine
+// calls made by the Project controller and records every request it gets.
+type fakeAPI struct {
+	project   *cloudresourcemanager.Project
+	billing   *cloudbilling.ProjectBillingInfo
+	network   *compute.Network
+	firewalls []*compute.Firewall
+	liens     []*cloudresourcemanager.Lien
+
+	// fail is the request, in the form "METHOD path", that fails.
+	fail   string
+	status int
+
+	calls []string
+}
+
+func (f *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	_ = r.Body.Close()
+	call := r.Method + " " + r.URL.Path
+	f.calls = append(f.calls, call)
+	if call == f.fail {
+		w.WriteHeader(f.status)
+		_ = json.NewEncoder(w).Encode(struct{}{})
+		return
+	}
+	var body interface{} = struct{}{}
+	switch call {
+	case "GET /v3/projects/cool-project":
+		if f.project == nil {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		body = f.project
+	case "GET /v1/projects/cool-project/billingInfo":
+		body = f.billing
+	case "GET /projects/cool-project/global/networks/default":
+		if f.network == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		body = f.network
+	case "GET /projects/cool-project/global/firewalls":
+		body = &compute.FirewallList{Items: f.firewalls}
+	case "GET /v3/liens":
+		body = &cloudresourcemanager.ListLiensResponse{Liens: f.liens}
+	}
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func newExternal(t *testing.T, server *httptest.Server, kube *test.MockClient) *projectExternal {
+	t.Helper()
+	opts := []option.ClientOption{option.WithEndpoint(server.URL), option.WithoutAuthentication()}
+	rm, _ := cloudresourcemanager.NewService(context.Background(), opts...)
+	b, _ := cloudbilling.NewService(context.Background(), opts...)
+	cs, _ := compute.NewService(context.Background(), opts...)
+	return &projectExternal{kube: kube, projects: rm.Projects, liens: rm.Liens, billing: b.Projects, compute: cs}
+}
+
+func TestProjectObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		api    *fakeAPI
+		kube   *test.MockClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotProject": {
+			reason: "Should return an error if the resource is not a Project",
+			api:    &fakeAPI{},
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotProject)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if a project that was never observed is not visible",
+			api:    &fakeAPI{},
+			mg:     newProject(),
+		},
+		"ResourcePurged": {
+			reason: "Should not return an error if a project that was last observed pending deletion is not visible",
+			api:    &fakeAPI{},
+			mg: newProject(func(cr *v1alpha1.Project) {
+				cr.Status.AtProvider.State = v1alpha1.ProjectStateDeleteRequested
+			}),
+		},
+		"ResourceForbidden": {
+			reason: "Should return an error if a project that was observed before is not visible",
+			api:    &fakeAPI{},
+			mg: newProject(func(cr *v1alpha1.Project) {
+				cr.Status.AtProvider.State = v1alpha1.ProjectStateActive
+			}),
+			want: want{err: errors.Wrap(&googleapi.Error{Code: http.StatusForbidden}, errGetProject)},
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the project fails",
+			api:    &fakeAPI{fail: "GET /v3/projects/cool-project", status: http.StatusInternalServerError},
+			mg:     newProject(),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetProject)},
+		},
+		"DeleteRequestedByUs": {
+			reason: "Should report a project pending deletion as gone if the resource is being deleted",
+			api: &fakeAPI{project: observedProject(func(p *cloudresourcemanager.Project) {
+				p.State = v1alpha1.ProjectStateDeleteRequested
+			})},
+			mg: newProject(func(cr *v1alpha1.Project) {
+				now := metav1.Now()
+				cr.SetDeletionTimestamp(&now)
+			}),
+		},
+		"DeleteRequestedOutOfBand": {
+			reason: "Should report a project pending deletion as outdated if the resource is not being deleted",
+			api: &fakeAPI{project: observedProject(func(p *cloudresourcemanager.Project) {
+				p.State = v1alpha1.ProjectStateDeleteRequested
+			})},
+			mg:   newProject(),
+			want: want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if the late initialization update fails",
+			api:    &fakeAPI{project: observedProject(), billing: linked()},
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg: newProject(func(cr *v1alpha1.Project) {
+				cr.Spec.ForProvider.DisplayName = nil
+			}),
+			want: want{err: errors.Wrap(errBoom, errUpdateProjectCR)},
+		},
+		"GetBillingInfoFailed": {
+			reason: "Should return an error if getting the billing info fails",
+			api:    &fakeAPI{project: observedProject(), fail: "GET /v1/projects/cool-project/billingInfo", status: http.StatusBadRequest},
+			mg:     newProject(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetBillingInfo)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should return upToDate as true if the resource is up to date",
+			api:    &fakeAPI{project: observedProject(), billing: linked()},
+			mg:     newProject(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+		"ParentDiffers": {
+			reason: "Should return upToDate as false if the project has to be moved",
+			api: &fakeAPI{billing: linked(), project: observedProject(func(p *cloudresourcemanager.Project) {
+				p.Parent = "organizations/42"
+			})},
+			mg:   newProject(),
+			want: want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		"BillingAccountDiffers": {
+			reason: "Should return upToDate as false if the project is not linked to the billing account",
+			api:    &fakeAPI{project: observedProject(), billing: &cloudbilling.ProjectBillingInfo{}},
+			mg:     newProject(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		"DefaultNetworkExists": {
+			reason: "Should return upToDate as false if the default network has to be deleted",
+			api:    &fakeAPI{project: observedProject(), billing: linked(), network: &compute.Network{Name: "default"}},
+			mg:     newProject(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+		},
+		"DefaultNetworkForbidden": {
+			reason: "Should return an error if the default network can't be read",
+			api:    &fakeAPI{project: observedProject(), billing: linked(), fail: "GET /projects/cool-project/global/networks/default", status: http.StatusForbidden},
+			mg:     newProject(),
+			want:   want{err: errors.Wrap(gError(http.StatusForbidden, ""), errGetDefaultNetwork)},
+		},
+		"DefaultNetworkKept": {
+			reason: "Should not look for the default network if it should be kept",
+			api:    &fakeAPI{project: observedProject(), billing: linked(), network: &compute.Network{Name: "default"}},
+			mg: newProject(func(cr *v1alpha1.Project) {
+				cr.Spec.ForProvider.AutoCreateNetwork = gcp.BoolPtr(true)
+			}),
+			want: want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.api)
+			defer server.Close()
+			e := newExternal(t, server, tc.kube)
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestProjectCreate(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		api     *fakeAPI
+		mg      resource.Managed
+		wantErr error
+	}{
+		"NotProject": {
+			reason:  "Should return an error if the resource is not a Project",
+			api:     &fakeAPI{},
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotProject),
+		},
+		"Successful": {
+			reason: "Should succeed if the project creation doesn't return an error",
+			api:    &fakeAPI{},
+			mg:     newProject(),
+		},
+		"Failed": {
+			reason:  "Should fail if the project creation returns an error",
+			api:     &fakeAPI{fail: "POST /v3/projects", status: http.StatusConflict},
+			mg:      newProject(),
+			wantErr: errors.Wrap(gError(http.StatusConflict, ""), errCreateProject),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.api)
+			defer server.Close()
+			e := newExternal(t, server, nil)
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestProjectUpdate(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason string
+		api    *fakeAPI
+		mg     resource.Managed
+		want   want
+	}{
+		"NotProject": {
+			reason: "Should return an error if the resource is not a Project",
+			api:    &fakeAPI{},
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotProject)},
+		},
+		"Undelete": {
+			reason: "Should only restore a project that is pending deletion",
+			api: &fakeAPI{project: observedProject(func(p *cloudresourcemanager.Project) {
+				p.State = v1alpha1.ProjectStateDeleteRequested
+				p.DisplayName = "Other project"
+			})},
+			mg: newProject(),
+			want: want{calls: []string{
+				"GET /v3/projects/cool-project",
+				"POST /v3/projects/cool-project:undelete",
+			}},
+		},
+		"UndeleteFailed": {
+			reason: "Should return an error if restoring the project fails",
+			api: &fakeAPI{
+				project: observedProject(func(p *cloudresourcemanager.Project) {
+					p.State = v1alpha1.ProjectStateDeleteRequested
+				}),
+				fail:   "POST /v3/projects/cool-project:undelete",
+				status: http.StatusBadRequest,
+			},
+			mg: newProject(),
+			want: want{
+				calls: []string{
+					"GET /v3/projects/cool-project",
+					"POST /v3/projects/cool-project:undelete",
+				},
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errUndeleteProject),
+			},
+		},
+		"UpdateAll": {
+			reason: "Should move and patch the project, link its billing account and delete its default network",
+			api: &fakeAPI{
+				project: observedProject(func(p *cloudresourcemanager.Project) {
+					p.Parent = "organizations/42"
+					p.DisplayName = "Other project"
+				}),
+				billing:   &cloudbilling.ProjectBillingInfo{},
+				network:   &compute.Network{Name: "default", SelfLink: selfLink},
+				firewalls: []*compute.Firewall{{Name: "default-allow-ssh"}},
+			},
+			mg: newProject(),
+			want: want{calls: []string{
+				"GET /v3/projects/cool-project",
+				"POST /v3/projects/cool-project:move",
+				"PATCH /v3/projects/cool-project",
+				"GET /v1/projects/cool-project/billingInfo",
+				"PUT /v1/projects/cool-project/billingInfo",
+				"GET /projects/cool-project/global/networks/default",
+				"GET /projects/cool-project/global/firewalls",
+				"DELETE /projects/cool-project/global/firewalls/default-allow-ssh",
+				"DELETE /projects/cool-project/global/networks/default",
+			}},
+		},
+		"PatchFailed": {
+			reason: "Should return an error if patching the project fails",
+			api: &fakeAPI{
+				project: observedProject(func(p *cloudresourcemanager.Project) {
+					p.DisplayName = "Other project"
+				}),
+				fail:   "PATCH /v3/projects/cool-project",
+				status: http.StatusBadRequest,
+			},
+			mg: newProject(),
+			want: want{
+				calls: []string{
+					"GET /v3/projects/cool-project",
+					"PATCH /v3/projects/cool-project",
+				},
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateProject),
+			},
+		},
+		"UpdateBillingInfoFailed": {
+			reason: "Should return an error if linking the billing account fails",
+			api: &fakeAPI{
+				project: observedProject(),
+				billing: &cloudbilling.ProjectBillingInfo{},
+				fail:    "PUT /v1/projects/cool-project/billingInfo",
+				status:  http.StatusForbidden,
+			},
+			mg: newProject(),
+			want: want{
+				calls: []string{
+					"GET /v3/projects/cool-project",
+					"GET /v1/projects/cool-project/billingInfo",
+					"PUT /v1/projects/cool-project/billingInfo",
+				},
+				err: errors.Wrap(gError(http.StatusForbidden, ""), errUpdateBillingInfo),
+			},
+		},
+		"DeleteDefaultNetworkFailed": {
+			reason: "Should return an error if deleting the default network fails",
+			api: &fakeAPI{
+				project: observedProject(),
+				billing: linked(),
+				network: &compute.Network{Name: "default", SelfLink: selfLink},
+				fail:    "DELETE /projects/cool-project/global/networks/default",
+				status:  http.StatusBadRequest,
+			},
+			mg: newProject(),
+			want: want{
+				calls: []string{
+					"GET /v3/projects/cool-project",
+					"GET /v1/projects/cool-project/billingInfo",
+					"GET /projects/cool-project/global/networks/default",
+					"GET /projects/cool-project/global/firewalls",
+					"DELETE /projects/cool-project/global/networks/default",
+				},
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteDefaultNetwork),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.api)
+			defer server.Close()
+			e := newExternal(t, server, nil)
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, tc.api.calls); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want calls, +got calls:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestProjectDelete(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason string
+		api    *fakeAPI
+		mg     resource.Managed
+		want   want
+	}{
+		"NotProject": {
+			reason: "Should return an error if the resource is not a Project",
+			api:    &fakeAPI{},
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotProject)},
+		},
+		"Successful": {
+			reason: "Should delete a project without liens",
+			api:    &fakeAPI{},
+			mg:     newProject(),
+			want: want{calls: []string{
+				"GET /v3/liens",
+				"DELETE /v3/projects/cool-project",
+			}},
+		},
+		"HasLiens": {
+			reason: "Should not delete a project with liens",
+			api:    &fakeAPI{liens: []*cloudresourcemanager.Lien{{Name: "liens/1", Reason: "Holds a shared VPC"}}},
+			mg:     newProject(),
+			want: want{
+				calls: []string{"GET /v3/liens"},
+				err:   errors.Errorf(errProjectHasLiens, "liens/1 (Holds a shared VPC)"),
+			},
+		},
+		"ListLiensFailed": {
+			reason: "Should return an error if listing the liens fails",
+			api:    &fakeAPI{fail: "GET /v3/liens", status: http.StatusBadRequest},
+			mg:     newProject(),
+			want: want{
+				calls: []string{"GET /v3/liens"},
+				err:   errors.Wrap(gError(http.StatusBadRequest, ""), errListLiens),
+			},
+		},
+		"AlreadyGone": {
+			reason: "Should not return an error if the project is already gone",
+			api:    &fakeAPI{fail: "DELETE /v3/projects/cool-project", status: http.StatusNotFound},
+			mg:     newProject(),
+			want: want{calls: []string{
+				"GET /v3/liens",
+				"DELETE /v3/projects/cool-project",
+			}},
+		},
+		"Forbidden": {
+			reason: "Should return an error if the project can't be deleted for lack of permissions",
+			api:    &fakeAPI{fail: "DELETE /v3/projects/cool-project", status: http.StatusForbidden},
+			mg:     newProject(),
+			want: want{
+				calls: []string{
+					"GET /v3/liens",
+					"DELETE /v3/projects/cool-project",
+				},
+				err: errors.Wrap(gError(http.StatusForbidden, ""), errDeleteProject),
+			},
+		},
+		"Failed": {
+			reason: "Should return an error if deleting the project fails",
+			api:    &fakeAPI{fail: "DELETE /v3/projects/cool-project", status: http.StatusBadRequest},
+			mg:     newProject(),
+			want: want{
+				calls: []string{
+					"GET /v3/liens",
+					"DELETE /v3/projects/cool-project",
+				},
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteProject),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.api)
+			defer server.Close()
+			e := newExternal(t, server, nil)
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, tc.api.calls); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want calls, +got calls:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestIsErrorAPIDisabled(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"APIDisabled": {
+			err:  &googleapi.Error{Code: http.StatusForbidden, Errors: []googleapi.ErrorItem{{Reason: reasonAccessNotConfigured}}},
+			want: true,
+		},
+		"PermissionDenied": {
+			err:  &googleapi.Error{Code: http.StatusForbidden, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}},
+			want: false,
+		},
+		"NotGoogleAPIError": {
+			err:  errBoom,
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := isErrorAPIDisabled(tc.err); got != tc.want {
+				t.Errorf("isErrorAPIDisabled(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcemanager
+
+import (
+	"context"
+	"time"
+
+	cloudresourcemanager "google.golang.org/api/cloudresourcemanager/v3"
+	"google.golang.org/api/option"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/resourcemanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	rmclient "github.com/crossplane/provider-gcp/pkg/clients/resourcemanager"
+)
+
+// Error strings.
+const (
+	errNotTagBinding    = "managed resource is not a TagBinding"
+	errListTagBindings  = "cannot list TagBindings"
+	errCreateTagBinding = "cannot create TagBinding"
+	errDeleteTagBinding = "cannot delete TagBinding"
+)
+
+// SetupTagBinding adds a controller that reconciles TagBindings.
+func SetupTagBinding(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.TagBindingGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.TagBindingGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.TagBinding{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.TagBindingGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.TagBindingGroupVersionKind),
+			&tagBindingConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type tagBindingConnector struct {
+	kube client.Client
+}
+
+// Connect builds a client for the global Resource Manager endpoint, unless
+// the bound resource is zonal or regional. Tag bindings of those resources
+// are only served by the endpoint of their location.
+func (c *tagBindingConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.TagBinding)
+	if !ok {
+		return nil, errors.New(errNotTagBinding)
+	}
+	_, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	var o []option.ClientOption
+	if ep := rmclient.GetTagBindingEndpoint(gcp.StringValue(cr.Spec.ForProvider.Location)); ep != "" {
+		o = append(o, option.WithEndpoint(ep))
+	}
+	s, err := cloudresourcemanager.NewService(ctx, opts.For("cloudresourcemanager", o...)...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &tagBindingExternal{tagBindings: s.TagBindings}, nil
+}
+
+type tagBindingExternal struct {
+	tagBindings *cloudresourcemanager.TagBindingsService
+}
+
+// Tag bindings can't be updated, and are named after their parent and tag
+// value, so they are looked up among the bindings of their parent rather than
+// by an external name.
+func (e *tagBindingExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.TagBinding)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTagBinding)
+	}
+	var bindings []*cloudresourcemanager.TagBinding
+	err := e.tagBindings.List().Parent(gcp.StringValue(cr.Spec.ForProvider.Parent)).Pages(ctx, func(r *cloudresourcemanager.ListTagBindingsResponse) error {
+		bindings = append(bindings, r.TagBindings...)
+		return nil
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errListTagBindings)
+	}
+	b := rmclient.FindTagBinding(cr.Spec.ForProvider, bindings)
+	if b == nil {
+		return managed.ExternalObservation{}, nil
+	}
+	cr.Status.AtProvider = rmclient.GenerateTagBindingObservation(*b)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
+
+func (e *tagBindingExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.TagBinding)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTagBinding)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	_, err := e.tagBindings.Create(rmclient.GenerateTagBinding(cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateTagBinding)
+}
+
+func (e *tagBindingExternal) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *tagBindingExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.TagBinding)
+	if !ok {
+		return errors.New(errNotTagBinding)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.tagBindings.Delete(cr.Status.AtProvider.Name).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteTagBinding)
+}
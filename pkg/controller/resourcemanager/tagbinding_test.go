@@ -0,0 +1,201 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcemanager
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cloudresourcemanager "google.golang.org/api/cloudresourcemanager/v3"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/resourcemanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const tagBindingName = "tagBindings/%2F%2Fcloudresourcemanager.googleapis.com%2Fprojects%2F1234/tagValues/456"
+
+func newTagBinding(m ...func(*v1alpha1.TagBinding)) *v1alpha1.TagBinding {
+	cr := &v1alpha1.TagBinding{}
+	cr.Spec.ForProvider = v1alpha1.TagBindingParameters{
+		Parent:   gcp.StringPtr("//cloudresourcemanager.googleapis.com/projects/1234"),
+		TagValue: gcp.StringPtr("tagValues/456"),
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observedTagBinding() *cloudresourcemanager.TagBinding {
+	return &cloudresourcemanager.TagBinding{
+		Name:                   tagBindingName,
+		Parent:                 "//cloudresourcemanager.googleapis.com/projects/1234",
+		TagValue:               "tagValues/456",
+		TagValueNamespacedName: "42/environment/production",
+	}
+}
+
+func TestTagBindingObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		obs v1alpha1.TagBindingObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		api    *fakeTagAPI
+		mg     resource.Managed
+		want   want
+	}{
+		"NotTagBinding": {
+			reason: "Should return an error if the resource is not a TagBinding",
+			api:    &fakeTagAPI{},
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotTagBinding)},
+		},
+		"ListFailed": {
+			reason: "Should return an error if listing the bindings of the parent fails",
+			api:    &fakeTagAPI{fail: "GET /v3/tagBindings", status: http.StatusBadRequest},
+			mg:     newTagBinding(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errListTagBindings)},
+		},
+		"ResourceNotFound": {
+			reason: "Should report the binding as missing if its parent has no binding of the tag value",
+			api: &fakeTagAPI{bindings: []*cloudresourcemanager.TagBinding{{
+				Name:     "tagBindings/other",
+				TagValue: "tagValues/789",
+			}}},
+			mg: newTagBinding(),
+		},
+		"ResourceExists": {
+			reason: "Should report an existing binding as up to date",
+			api:    &fakeTagAPI{bindings: []*cloudresourcemanager.TagBinding{observedTagBinding()}},
+			mg:     newTagBinding(),
+			want: want{
+				e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				obs: v1alpha1.TagBindingObservation{
+					Name:                   tagBindingName,
+					TagValueNamespacedName: "42/environment/production",
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.api)
+			defer server.Close()
+			e := &tagBindingExternal{tagBindings: newTagService(t, server).TagBindings}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.TagBinding); ok {
+				if diff := cmp.Diff(tc.want.obs, cr.Status.AtProvider); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want observation, +got observation:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestTagBindingWrite(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason string
+		api    *fakeTagAPI
+		mg     resource.Managed
+		call   func(*tagBindingExternal, resource.Managed) error
+		want   want
+	}{
+		"CreateSuccessful": {
+			reason: "Should create the tag binding",
+			api:    &fakeTagAPI{},
+			mg:     newTagBinding(),
+			call: func(e *tagBindingExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+			want: want{calls: []string{"POST /v3/tagBindings"}},
+		},
+		"CreateFailed": {
+			reason: "Should return an error if creating the tag binding fails",
+			api:    &fakeTagAPI{fail: "POST /v3/tagBindings", status: http.StatusBadRequest},
+			mg:     newTagBinding(),
+			call: func(e *tagBindingExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+			want: want{
+				calls: []string{"POST /v3/tagBindings"},
+				err:   errors.Wrap(gError(http.StatusBadRequest, ""), errCreateTagBinding),
+			},
+		},
+		"DeleteSuccessful": {
+			reason: "Should delete the tag binding by the name it was observed with",
+			api:    &fakeTagAPI{},
+			mg: newTagBinding(func(cr *v1alpha1.TagBinding) {
+				cr.Status.AtProvider.Name = tagBindingName
+			}),
+			call: func(e *tagBindingExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: want{calls: []string{"DELETE /v3/" + tagBindingName}},
+		},
+		"DeleteNotFound": {
+			reason: "Should not return an error if the tag binding is already gone",
+			api:    &fakeTagAPI{fail: "DELETE /v3/" + tagBindingName, status: http.StatusNotFound},
+			mg: newTagBinding(func(cr *v1alpha1.TagBinding) {
+				cr.Status.AtProvider.Name = tagBindingName
+			}),
+			call: func(e *tagBindingExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: want{calls: []string{"DELETE /v3/" + tagBindingName}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.api)
+			defer server.Close()
+			err := tc.call(&tagBindingExternal{tagBindings: newTagService(t, server).TagBindings}, tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, tc.api.calls); diff != "" {
+				t.Errorf("\n%s\n-want calls, +got calls:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcemanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	cloudresourcemanager "google.golang.org/api/cloudresourcemanager/v3"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/resourcemanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	rmclient "github.com/crossplane/provider-gcp/pkg/clients/resourcemanager"
+)
+
+// Error strings.
+const (
+	errNotTagKey      = "managed resource is not a TagKey"
+	errGetTagKey      = "cannot get TagKey"
+	errListTagKeys    = "cannot list TagKeys"
+	errCreateTagKey   = "cannot create TagKey"
+	errUpdateTagKey   = "cannot update TagKey"
+	errDeleteTagKey   = "cannot delete TagKey"
+	errUpdateTagKeyCR = "cannot update TagKey custom resource"
+)
+
+// SetupTagKey adds a controller that reconciles TagKeys.
+func SetupTagKey(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.TagKeyGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.TagKeyGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.TagKey{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.TagKeyGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.TagKeyGroupVersionKind),
+			&tagKeyConnector{kube: mgr.GetClient()},
+			managed.WithInitializers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type tagKeyConnector struct {
+	kube client.Client
+}
+
+func (c *tagKeyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := cloudresourcemanager.NewService(ctx, opts.For("cloudresourcemanager")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &tagKeyExternal{kube: c.kube, tagKeys: s.TagKeys}, nil
+}
+
+type tagKeyExternal struct {
+	kube    client.Client
+	tagKeys *cloudresourcemanager.TagKeysService
+}
+
+func (e *tagKeyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.TagKey)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTagKey)
+	}
+	existing, err := e.getTagKey(ctx, cr)
+	if err != nil || existing == nil {
+		return managed.ExternalObservation{}, err
+	}
+	current := cr.Spec.ForProvider.DeepCopy()
+	rmclient.LateInitializeTagKey(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateTagKeyCR)
+		}
+	}
+	cr.Status.AtProvider = rmclient.GenerateTagKeyObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: rmclient.IsTagKeyUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+// getTagKey returns the tag key of the supplied TagKey, or nil if it does not
+// exist. Tag key IDs are assigned by Resource Manager and are not returned
+// until the long-running creation completes, so a tag key without an external
+// name is looked up by its short name.
+func (e *tagKeyExternal) getTagKey(ctx context.Context, cr *v1alpha1.TagKey) (*cloudresourcemanager.TagKey, error) {
+	if id := meta.GetExternalName(cr); id != "" {
+		k, err := e.tagKeys.Get(rmclient.GetTagKeyName(id)).Context(ctx).Do()
+		// A 403 only means that a tag key is gone if it was never
+		// observed. Otherwise the caller lost access to it.
+		if gcp.IsErrorNotFound(err) || (gcp.IsErrorForbidden(err) && cr.Status.AtProvider.Name == "") {
+			return nil, nil
+		}
+		return k, errors.Wrap(err, errGetTagKey)
+	}
+	var keys []*cloudresourcemanager.TagKey
+	err := e.tagKeys.List().Parent(cr.Spec.ForProvider.Parent).Pages(ctx, func(r *cloudresourcemanager.ListTagKeysResponse) error {
+		keys = append(keys, r.TagKeys...)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errListTagKeys)
+	}
+	k := rmclient.FindTagKey(cr.Spec.ForProvider, keys)
+	if k == nil {
+		return nil, nil
+	}
+	meta.SetExternalName(cr, rmclient.GetTagKeyID(k.Name))
+	return k, errors.Wrap(e.kube.Update(ctx, cr), errUpdateTagKeyCR)
+}
+
+func (e *tagKeyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.TagKey)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTagKey)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	_, err := e.tagKeys.Create(rmclient.GenerateTagKey(cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateTagKey)
+}
+
+func (e *tagKeyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.TagKey)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotTagKey)
+	}
+	name := rmclient.GetTagKeyName(meta.GetExternalName(cr))
+	_, err := e.tagKeys.Patch(name, rmclient.GenerateTagKey(cr.Spec.ForProvider)).UpdateMask(rmclient.TagKeyUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTagKey)
+}
+
+func (e *tagKeyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.TagKey)
+	if !ok {
+		return errors.New(errNotTagKey)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.tagKeys.Delete(rmclient.GetTagKeyName(meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteTagKey)
+}
@@ -0,0 +1,316 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcemanager
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cloudresourcemanager "google.golang.org/api/cloudresourcemanager/v3"
+	"google.golang.org/api/option"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/resourcemanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const tagKeyID = "123"
+
+func newTagKey(m ...func(*v1alpha1.TagKey)) *v1alpha1.TagKey {
+	cr := &v1alpha1.TagKey{}
+	meta.SetExternalName(cr, tagKeyID)
+	cr.Spec.ForProvider = v1alpha1.TagKeyParameters{
+		Parent:      "organizations/42",
+		ShortName:   "environment",
+		Description: gcp.StringPtr("Environment of the resource"),
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observedTagKey(m ...func(*cloudresourcemanager.TagKey)) *cloudresourcemanager.TagKey {
+	k := &cloudresourcemanager.TagKey{
+		Name:        "tagKeys/123",
+		Parent:      "organizations/42",
+		ShortName:   "environment",
+		Description: "Environment of the resource",
+	}
+	for _, f := range m {
+		f(k)
+	}
+	return k
+}
+
+// fakeTagAPI serves the Resource Manager calls made by the tag controllers
+// and records every request it gets.
+type fakeTagAPI struct {
+	tagKey    *cloudresourcemanager.TagKey
+	tagKeys   []*cloudresourcemanager.TagKey
+	tagValue  *cloudresourcemanager.TagValue
+	tagValues []*cloudresourcemanager.TagValue
+	bindings  []*cloudresourcemanager.TagBinding
+
+	// fail is the request, in the form "METHOD path", that fails.
+	fail   string
+	status int
+
+	calls []string
+}
+
+func (f *fakeTagAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	_ = r.Body.Close()
+	call := r.Method + " " + r.URL.Path
+	f.calls = append(f.calls, call)
+	if call == f.fail {
+		w.WriteHeader(f.status)
+		_ = json.NewEncoder(w).Encode(struct{}{})
+		return
+	}
+	var body interface{} = struct{}{}
+	switch call {
+	case "GET /v3/tagKeys/123":
+		if f.tagKey == nil {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		body = f.tagKey
+	case "GET /v3/tagKeys":
+		body = &cloudresourcemanager.ListTagKeysResponse{TagKeys: f.tagKeys}
+	case "GET /v3/tagValues/456":
+		if f.tagValue == nil {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		body = f.tagValue
+	case "GET /v3/tagValues":
+		body = &cloudresourcemanager.ListTagValuesResponse{TagValues: f.tagValues}
+	case "GET /v3/tagBindings":
+		body = &cloudresourcemanager.ListTagBindingsResponse{TagBindings: f.bindings}
+	}
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func newTagService(t *testing.T, server *httptest.Server) *cloudresourcemanager.Service {
+	t.Helper()
+	s, _ := cloudresourcemanager.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	return s
+}
+
+func TestTagKeyObserve(t *testing.T) {
+	type want struct {
+		e            managed.ExternalObservation
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		api    *fakeTagAPI
+		kube   *test.MockClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotTagKey": {
+			reason: "Should return an error if the resource is not a TagKey",
+			api:    &fakeTagAPI{},
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotTagKey)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if a tag key that was never observed is not visible",
+			api:    &fakeTagAPI{},
+			mg:     newTagKey(),
+			want:   want{externalName: tagKeyID},
+		},
+		"ResourceForbidden": {
+			reason: "Should return an error if a tag key that was observed before is not visible",
+			api:    &fakeTagAPI{fail: "GET /v3/tagKeys/123", status: http.StatusForbidden},
+			mg: newTagKey(func(cr *v1alpha1.TagKey) {
+				cr.Status.AtProvider.Name = "tagKeys/123"
+			}),
+			want: want{
+				externalName: tagKeyID,
+				err:          errors.Wrap(gError(http.StatusForbidden, ""), errGetTagKey),
+			},
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the tag key fails",
+			api:    &fakeTagAPI{fail: "GET /v3/tagKeys/123", status: http.StatusInternalServerError},
+			mg:     newTagKey(),
+			want: want{
+				externalName: tagKeyID,
+				err:          errors.Wrap(gError(http.StatusInternalServerError, ""), errGetTagKey),
+			},
+		},
+		"NotYetCreated": {
+			reason: "Should report a tag key without external name as missing if no key of its parent has its short name",
+			api:    &fakeTagAPI{tagKeys: []*cloudresourcemanager.TagKey{observedTagKey(func(k *cloudresourcemanager.TagKey) { k.ShortName = "team" })}},
+			mg:     newTagKey(func(cr *v1alpha1.TagKey) { meta.SetExternalName(cr, "") }),
+		},
+		"ListFailed": {
+			reason: "Should return an error if listing the keys of the parent fails",
+			api:    &fakeTagAPI{fail: "GET /v3/tagKeys", status: http.StatusBadRequest},
+			mg:     newTagKey(func(cr *v1alpha1.TagKey) { meta.SetExternalName(cr, "") }),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errListTagKeys)},
+		},
+		"FoundByShortName": {
+			reason: "Should record the ID of a tag key that was found by its short name",
+			api:    &fakeTagAPI{tagKeys: []*cloudresourcemanager.TagKey{observedTagKey()}},
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			mg:     newTagKey(func(cr *v1alpha1.TagKey) { meta.SetExternalName(cr, "") }),
+			want: want{
+				e:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				externalName: tagKeyID,
+			},
+		},
+		"LateInitFailed": {
+			reason: "Should return an error if the late initialized spec can't be saved",
+			api:    &fakeTagAPI{tagKey: observedTagKey()},
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:     newTagKey(func(cr *v1alpha1.TagKey) { cr.Spec.ForProvider.Description = nil }),
+			want: want{
+				externalName: tagKeyID,
+				err:          errors.Wrap(errBoom, errUpdateTagKeyCR),
+			},
+		},
+		"NeedsUpdate": {
+			reason: "Should return upToDate as false if the description differs",
+			api:    &fakeTagAPI{tagKey: observedTagKey(func(k *cloudresourcemanager.TagKey) { k.Description = "Stage" })},
+			mg:     newTagKey(),
+			want: want{
+				e:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				externalName: tagKeyID,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.api)
+			defer server.Close()
+			e := &tagKeyExternal{kube: tc.kube, tagKeys: newTagService(t, server).TagKeys}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.TagKey); ok {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want external name, +got external name:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestTagKeyWrite(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason string
+		api    *fakeTagAPI
+		mg     resource.Managed
+		call   func(*tagKeyExternal, resource.Managed) error
+		want   want
+	}{
+		"CreateNotTagKey": {
+			reason: "Should return an error if the resource is not a TagKey",
+			api:    &fakeTagAPI{},
+			mg:     unexpectedObject,
+			call: func(e *tagKeyExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+			want: want{err: errors.New(errNotTagKey)},
+		},
+		"CreateFailed": {
+			reason: "Should return an error if creating the tag key fails",
+			api:    &fakeTagAPI{fail: "POST /v3/tagKeys", status: http.StatusConflict},
+			mg:     newTagKey(func(cr *v1alpha1.TagKey) { meta.SetExternalName(cr, "") }),
+			call: func(e *tagKeyExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+			want: want{
+				calls: []string{"POST /v3/tagKeys"},
+				err:   errors.Wrap(gError(http.StatusConflict, ""), errCreateTagKey),
+			},
+		},
+		"UpdateSuccessful": {
+			reason: "Should patch the description of the tag key",
+			api:    &fakeTagAPI{},
+			mg:     newTagKey(),
+			call: func(e *tagKeyExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: want{calls: []string{"PATCH /v3/tagKeys/123"}},
+		},
+		"DeleteNotFound": {
+			reason: "Should not return an error if the tag key is already gone",
+			api:    &fakeTagAPI{fail: "DELETE /v3/tagKeys/123", status: http.StatusNotFound},
+			mg:     newTagKey(),
+			call: func(e *tagKeyExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: want{calls: []string{"DELETE /v3/tagKeys/123"}},
+		},
+		"DeleteFailed": {
+			reason: "Should return an error if deleting the tag key fails",
+			api:    &fakeTagAPI{fail: "DELETE /v3/tagKeys/123", status: http.StatusBadRequest},
+			mg:     newTagKey(),
+			call: func(e *tagKeyExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: want{
+				calls: []string{"DELETE /v3/tagKeys/123"},
+				err:   errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteTagKey),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.api)
+			defer server.Close()
+			err := tc.call(&tagKeyExternal{tagKeys: newTagService(t, server).TagKeys}, tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, tc.api.calls); diff != "" {
+				t.Errorf("\n%s\n-want calls, +got calls:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcemanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	cloudresourcemanager "google.golang.org/api/cloudresourcemanager/v3"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/resourcemanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	rmclient "github.com/crossplane/provider-gcp/pkg/clients/resourcemanager"
+)
+
+// Error strings.
+const (
+	errNotTagValue      = "managed resource is not a TagValue"
+	errGetTagValue      = "cannot get TagValue"
+	errListTagValues    = "cannot list TagValues"
+	errCreateTagValue   = "cannot create TagValue"
+	errUpdateTagValue   = "cannot update TagValue"
+	errDeleteTagValue   = "cannot delete TagValue"
+	errUpdateTagValueCR = "cannot update TagValue custom resource"
+)
+
+// SetupTagValue adds a controller that reconciles TagValues.
+func SetupTagValue(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.TagValueGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.TagValueGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.TagValue{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.TagValueGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.TagValueGroupVersionKind),
+			&tagValueConnector{kube: mgr.GetClient()},
+			managed.WithInitializers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type tagValueConnector struct {
+	kube client.Client
+}
+
+func (c *tagValueConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := cloudresourcemanager.NewService(ctx, opts.For("cloudresourcemanager")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &tagValueExternal{kube: c.kube, tagValues: s.TagValues}, nil
+}
+
+type tagValueExternal struct {
+	kube      client.Client
+	tagValues *cloudresourcemanager.TagValuesService
+}
+
+func (e *tagValueExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.TagValue)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTagValue)
+	}
+	existing, err := e.getTagValue(ctx, cr)
+	if err != nil || existing == nil {
+		return managed.ExternalObservation{}, err
+	}
+	current := cr.Spec.ForProvider.DeepCopy()
+	rmclient.LateInitializeTagValue(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateTagValueCR)
+		}
+	}
+	cr.Status.AtProvider = rmclient.GenerateTagValueObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: rmclient.IsTagValueUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+// getTagValue returns the tag value of the supplied TagValue, or nil if it
+// does not exist. Like tag keys, a tag value without an external name is
+// looked up by its short name among the values of its tag key.
+func (e *tagValueExternal) getTagValue(ctx context.Context, cr *v1alpha1.TagValue) (*cloudresourcemanager.TagValue, error) {
+	if id := meta.GetExternalName(cr); id != "" {
+		v, err := e.tagValues.Get(rmclient.GetTagValueName(id)).Context(ctx).Do()
+		// A 403 only means that a tag value is gone if it was never
+		// observed. Otherwise the caller lost access to it.
+		if gcp.IsErrorNotFound(err) || (gcp.IsErrorForbidden(err) && cr.Status.AtProvider.Name == "") {
+			return nil, nil
+		}
+		return v, errors.Wrap(err, errGetTagValue)
+	}
+	var values []*cloudresourcemanager.TagValue
+	err := e.tagValues.List().Parent(gcp.StringValue(cr.Spec.ForProvider.Parent)).Pages(ctx, func(r *cloudresourcemanager.ListTagValuesResponse) error {
+		values = append(values, r.TagValues...)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errListTagValues)
+	}
+	v := rmclient.FindTagValue(cr.Spec.ForProvider, values)
+	if v == nil {
+		return nil, nil
+	}
+	meta.SetExternalName(cr, rmclient.GetTagValueID(v.Name))
+	return v, errors.Wrap(e.kube.Update(ctx, cr), errUpdateTagValueCR)
+}
+
+func (e *tagValueExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.TagValue)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTagValue)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	_, err := e.tagValues.Create(rmclient.GenerateTagValue(cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateTagValue)
+}
+
+func (e *tagValueExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.TagValue)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotTagValue)
+	}
+	name := rmclient.GetTagValueName(meta.GetExternalName(cr))
+	_, err := e.tagValues.Patch(name, rmclient.GenerateTagValue(cr.Spec.ForProvider)).UpdateMask(rmclient.TagValueUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTagValue)
+}
+
+func (e *tagValueExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.TagValue)
+	if !ok {
+		return errors.New(errNotTagValue)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.tagValues.Delete(rmclient.GetTagValueName(meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteTagValue)
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcemanager
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cloudresourcemanager "google.golang.org/api/cloudresourcemanager/v3"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/resourcemanager/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const tagValueID = "456"
+
+func newTagValue(m ...func(*v1alpha1.TagValue)) *v1alpha1.TagValue {
+	cr := &v1alpha1.TagValue{}
+	meta.SetExternalName(cr, tagValueID)
+	cr.Spec.ForProvider = v1alpha1.TagValueParameters{
+		Parent:      gcp.StringPtr("tagKeys/123"),
+		ShortName:   "production",
+		Description: gcp.StringPtr("Production workloads"),
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observedTagValue(m ...func(*cloudresourcemanager.TagValue)) *cloudresourcemanager.TagValue {
+	v := &cloudresourcemanager.TagValue{
+		Name:        "tagValues/456",
+		Parent:      "tagKeys/123",
+		ShortName:   "production",
+		Description: "Production workloads",
+	}
+	for _, f := range m {
+		f(v)
+	}
+	return v
+}
+
+func TestTagValueObserve(t *testing.T) {
+	type want struct {
+		e            managed.ExternalObservation
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		api    *fakeTagAPI
+		kube   *test.MockClient
+		mg     resource.Managed
+		want   want
+	}{
+		"NotTagValue": {
+			reason: "Should return an error if the resource is not a TagValue",
+			api:    &fakeTagAPI{},
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotTagValue)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if a tag value that was never observed is not visible",
+			api:    &fakeTagAPI{},
+			mg:     newTagValue(),
+			want:   want{externalName: tagValueID},
+		},
+		"ResourceForbidden": {
+			reason: "Should return an error if a tag value that was observed before is not visible",
+			api:    &fakeTagAPI{fail: "GET /v3/tagValues/456", status: http.StatusForbidden},
+			mg: newTagValue(func(cr *v1alpha1.TagValue) {
+				cr.Status.AtProvider.Name = "tagValues/456"
+			}),
+			want: want{
+				externalName: tagValueID,
+				err:          errors.Wrap(gError(http.StatusForbidden, ""), errGetTagValue),
+			},
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the tag value fails",
+			api:    &fakeTagAPI{fail: "GET /v3/tagValues/456", status: http.StatusInternalServerError},
+			mg:     newTagValue(),
+			want: want{
+				externalName: tagValueID,
+				err:          errors.Wrap(gError(http.StatusInternalServerError, ""), errGetTagValue),
+			},
+		},
+		"ListFailed": {
+			reason: "Should return an error if listing the values of the tag key fails",
+			api:    &fakeTagAPI{fail: "GET /v3/tagValues", status: http.StatusBadRequest},
+			mg:     newTagValue(func(cr *v1alpha1.TagValue) { meta.SetExternalName(cr, "") }),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errListTagValues)},
+		},
+		"FoundByShortName": {
+			reason: "Should record the ID of a tag value that was found by its short name",
+			api:    &fakeTagAPI{tagValues: []*cloudresourcemanager.TagValue{observedTagValue()}},
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			mg:     newTagValue(func(cr *v1alpha1.TagValue) { meta.SetExternalName(cr, "") }),
+			want: want{
+				e:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				externalName: tagValueID,
+			},
+		},
+		"NeedsUpdate": {
+			reason: "Should return upToDate as false if the description differs",
+			api:    &fakeTagAPI{tagValue: observedTagValue(func(v *cloudresourcemanager.TagValue) { v.Description = "Prod" })},
+			mg:     newTagValue(),
+			want: want{
+				e:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				externalName: tagValueID,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.api)
+			defer server.Close()
+			e := &tagValueExternal{kube: tc.kube, tagValues: newTagService(t, server).TagValues}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.TagValue); ok {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want external name, +got external name:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestTagValueWrite(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason string
+		api    *fakeTagAPI
+		mg     resource.Managed
+		call   func(*tagValueExternal, resource.Managed) error
+		want   want
+	}{
+		"CreateSuccessful": {
+			reason: "Should create the tag value",
+			api:    &fakeTagAPI{},
+			mg:     newTagValue(func(cr *v1alpha1.TagValue) { meta.SetExternalName(cr, "") }),
+			call: func(e *tagValueExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+			want: want{calls: []string{"POST /v3/tagValues"}},
+		},
+		"UpdateFailed": {
+			reason: "Should return an error if patching the tag value fails",
+			api:    &fakeTagAPI{fail: "PATCH /v3/tagValues/456", status: http.StatusBadRequest},
+			mg:     newTagValue(),
+			call: func(e *tagValueExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: want{
+				calls: []string{"PATCH /v3/tagValues/456"},
+				err:   errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateTagValue),
+			},
+		},
+		"DeleteNotTagValue": {
+			reason: "Should return an error if the resource is not a TagValue",
+			api:    &fakeTagAPI{},
+			mg:     unexpectedObject,
+			call: func(e *tagValueExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: want{err: errors.New(errNotTagValue)},
+		},
+		"DeleteSuccessful": {
+			reason: "Should delete the tag value",
+			api:    &fakeTagAPI{},
+			mg:     newTagValue(),
+			call: func(e *tagValueExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: want{calls: []string{"DELETE /v3/tagValues/456"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.api)
+			defer server.Close()
+			err := tc.call(&tagValueExternal{tagValues: newTagService(t, server).TagValues}, tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, tc.api.calls); diff != "" {
+				t.Errorf("\n%s\n-want calls, +got calls:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceusage
+
+import (
+	"context"
+	"time"
+
+	serviceusage "google.golang.org/api/serviceusage/v1beta1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/serviceusage/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	suclient "github.com/crossplane/provider-gcp/pkg/clients/serviceusage"
+)
+
+// Error strings.
+const (
+	errNotConsumerQuotaOverride      = "managed resource is not a ConsumerQuotaOverride"
+	errListConsumerQuotaOverrides    = "cannot list ConsumerQuotaOverrides"
+	errCreateConsumerQuotaOverride   = "cannot create ConsumerQuotaOverride"
+	errUpdateConsumerQuotaOverride   = "cannot update ConsumerQuotaOverride"
+	errDeleteConsumerQuotaOverride   = "cannot delete ConsumerQuotaOverride"
+	errUpdateConsumerQuotaOverrideCR = "cannot update ConsumerQuotaOverride custom resource"
+)
+
+// SetupConsumerQuotaOverride adds a controller that reconciles
+// ConsumerQuotaOverrides.
+func SetupConsumerQuotaOverride(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.ConsumerQuotaOverrideGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.ConsumerQuotaOverrideGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.ConsumerQuotaOverride{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.ConsumerQuotaOverrideGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ConsumerQuotaOverrideGroupVersionKind),
+			&consumerQuotaOverrideConnector{kube: mgr.GetClient()},
+			managed.WithInitializers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type consumerQuotaOverrideConnector struct {
+	kube client.Client
+}
+
+func (c *consumerQuotaOverrideConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := serviceusage.NewService(ctx, opts.For("serviceusage")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &consumerQuotaOverrideExternal{kube: c.kube, overrides: s.Services.ConsumerQuotaMetrics.Limits.ConsumerOverrides, projectID: projectID}, nil
+}
+
+type consumerQuotaOverrideExternal struct {
+	kube      client.Client
+	overrides *serviceusage.ServicesConsumerQuotaMetricsLimitsConsumerOverridesService
+	projectID string
+}
+
+// Service Usage has no call to get a single override, so the overrides of
+// the quota limit are listed. Override IDs are assigned by Service Usage, so
+// an override without an external name is looked up by its dimensions.
+func (e *consumerQuotaOverrideExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ConsumerQuotaOverride)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotConsumerQuotaOverride)
+	}
+	var overrides []*serviceusage.QuotaOverride
+	limit := suclient.GetQuotaLimitName(e.projectID, cr.Spec.ForProvider)
+	err := e.overrides.List(limit).Pages(ctx, func(r *serviceusage.ListConsumerOverridesResponse) error {
+		overrides = append(overrides, r.Overrides...)
+		return nil
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errListConsumerQuotaOverrides)
+	}
+	existing := suclient.FindConsumerQuotaOverride(meta.GetExternalName(cr), cr.Spec.ForProvider, overrides)
+	if existing == nil {
+		return managed.ExternalObservation{}, nil
+	}
+	if meta.GetExternalName(cr) == "" {
+		meta.SetExternalName(cr, suclient.GetConsumerQuotaOverrideID(existing.Name))
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateConsumerQuotaOverrideCR)
+		}
+	}
+	cr.Status.AtProvider = suclient.GenerateConsumerQuotaOverrideObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: suclient.IsConsumerQuotaOverrideUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *consumerQuotaOverrideExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ConsumerQuotaOverride)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotConsumerQuotaOverride)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	limit := suclient.GetQuotaLimitName(e.projectID, cr.Spec.ForProvider)
+	_, err := e.overrides.Create(limit, suclient.GenerateQuotaOverride(cr.Spec.ForProvider)).
+		Force(gcp.BoolValue(cr.Spec.ForProvider.Force)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateConsumerQuotaOverride)
+}
+
+func (e *consumerQuotaOverrideExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ConsumerQuotaOverride)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotConsumerQuotaOverride)
+	}
+	_, err := e.overrides.Patch(e.name(cr), suclient.GenerateQuotaOverride(cr.Spec.ForProvider)).
+		UpdateMask(suclient.ConsumerQuotaOverrideUpdateMask).
+		Force(gcp.BoolValue(cr.Spec.ForProvider.Force)).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateConsumerQuotaOverride)
+}
+
+func (e *consumerQuotaOverrideExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ConsumerQuotaOverride)
+	if !ok {
+		return errors.New(errNotConsumerQuotaOverride)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.overrides.Delete(e.name(cr)).Force(gcp.BoolValue(cr.Spec.ForProvider.Force)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteConsumerQuotaOverride)
+}
+
+func (e *consumerQuotaOverrideExternal) name(cr *v1alpha1.ConsumerQuotaOverride) string {
+	limit := suclient.GetQuotaLimitName(e.projectID, cr.Spec.ForProvider)
+	return suclient.GetConsumerQuotaOverrideName(limit, meta.GetExternalName(cr))
+}
@@ -0,0 +1,284 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceusage
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/option"
+	serviceusage "google.golang.org/api/serviceusage/v1beta1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/serviceusage/v1alpha1"
+)
+
+const (
+	overrideID    = "Cg1"
+	overridesPath = "/v1beta1/projects/myproject-id-1234/services/compute.googleapis.com/consumerQuotaMetrics/compute.googleapis.com%2Fcpus/limits/%2Fproject%2Fregion/consumerOverrides"
+)
+
+var errBoom = errors.New("boom")
+
+func newConsumerQuotaOverride(m ...func(*v1alpha1.ConsumerQuotaOverride)) *v1alpha1.ConsumerQuotaOverride {
+	cr := &v1alpha1.ConsumerQuotaOverride{}
+	meta.SetExternalName(cr, overrideID)
+	cr.Spec.ForProvider = v1alpha1.ConsumerQuotaOverrideParameters{
+		Service:       "compute.googleapis.com",
+		Metric:        "compute.googleapis.com/cpus",
+		Limit:         "/project/region",
+		OverrideValue: 48,
+		Dimensions:    map[string]string{"region": "us-central1"},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func quotaOverride(value int64) *serviceusage.QuotaOverride {
+	return &serviceusage.QuotaOverride{
+		Name:          "projects/42/services/compute.googleapis.com/consumerQuotaMetrics/compute.googleapis.com%2Fcpus/limits/%2Fproject%2Fregion/consumerOverrides/" + overrideID,
+		OverrideValue: value,
+		Dimensions:    map[string]string{"region": "us-central1"},
+		Unit:          "1/{project}/{region}",
+	}
+}
+
+func TestConsumerQuotaOverrideObserve(t *testing.T) {
+	type want struct {
+		e            managed.ExternalObservation
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason    string
+		status    int
+		overrides []*serviceusage.QuotaOverride
+		kube      *test.MockClient
+		mg        resource.Managed
+		want      want
+	}{
+		"NotConsumerQuotaOverride": {
+			reason: "Should return an error if the resource is not a ConsumerQuotaOverride",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotConsumerQuotaOverride)},
+		},
+		"ListFailed": {
+			reason: "Should return an error if listing the overrides fails",
+			status: http.StatusBadRequest,
+			mg:     newConsumerQuotaOverride(),
+			want: want{
+				externalName: overrideID,
+				err:          errors.Wrap(gError(http.StatusBadRequest, ""), errListConsumerQuotaOverrides),
+			},
+		},
+		"ResourceNotFound": {
+			reason: "Should report an override that is not among the overrides of the limit as missing",
+			status: http.StatusOK,
+			mg:     newConsumerQuotaOverride(),
+			want:   want{externalName: overrideID},
+		},
+		"FoundByDimensions": {
+			reason:    "Should record the ID of an override that was found by its dimensions",
+			status:    http.StatusOK,
+			overrides: []*serviceusage.QuotaOverride{quotaOverride(48)},
+			kube:      &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			mg:        newConsumerQuotaOverride(func(cr *v1alpha1.ConsumerQuotaOverride) { meta.SetExternalName(cr, "") }),
+			want: want{
+				e:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				externalName: overrideID,
+			},
+		},
+		"UpdateResourceSpecFail": {
+			reason:    "Should return an error if recording the ID of an override fails",
+			status:    http.StatusOK,
+			overrides: []*serviceusage.QuotaOverride{quotaOverride(48)},
+			kube:      &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:        newConsumerQuotaOverride(func(cr *v1alpha1.ConsumerQuotaOverride) { meta.SetExternalName(cr, "") }),
+			want: want{
+				externalName: overrideID,
+				err:          errors.Wrap(errBoom, errUpdateConsumerQuotaOverrideCR),
+			},
+		},
+		"NeedsUpdate": {
+			reason:    "Should return upToDate as false if the override value differs",
+			status:    http.StatusOK,
+			overrides: []*serviceusage.QuotaOverride{quotaOverride(24)},
+			mg:        newConsumerQuotaOverride(),
+			want: want{
+				e:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				externalName: overrideID,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if r.Method != http.MethodGet || r.URL.Path != overridesPath {
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				w.WriteHeader(tc.status)
+				if tc.status != http.StatusOK {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(&serviceusage.ListConsumerOverridesResponse{Overrides: tc.overrides})
+			}))
+			defer server.Close()
+			s, _ := serviceusage.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &consumerQuotaOverrideExternal{kube: tc.kube, overrides: s.Services.ConsumerQuotaMetrics.Limits.ConsumerOverrides, projectID: projectID}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.ConsumerQuotaOverride); ok {
+				if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want external name, +got external name:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestConsumerQuotaOverrideWrite(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		method string
+		path   string
+		force  string
+		status int
+		mg     resource.Managed
+		call   func(*consumerQuotaOverrideExternal, resource.Managed) error
+		want   error
+	}{
+		"CreateSuccessful": {
+			reason: "Should create the override for the quota limit",
+			method: http.MethodPost,
+			path:   overridesPath,
+			force:  "false",
+			status: http.StatusOK,
+			mg:     newConsumerQuotaOverride(func(cr *v1alpha1.ConsumerQuotaOverride) { meta.SetExternalName(cr, "") }),
+			call: func(e *consumerQuotaOverrideExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+		},
+		"CreateFailed": {
+			reason: "Should return an error if creating the override fails",
+			method: http.MethodPost,
+			path:   overridesPath,
+			force:  "false",
+			status: http.StatusBadRequest,
+			mg:     newConsumerQuotaOverride(func(cr *v1alpha1.ConsumerQuotaOverride) { meta.SetExternalName(cr, "") }),
+			call: func(e *consumerQuotaOverrideExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateConsumerQuotaOverride),
+		},
+		"UpdateForced": {
+			reason: "Should patch the override value, forcing it if requested",
+			method: http.MethodPatch,
+			path:   overridesPath + "/" + overrideID,
+			force:  "true",
+			status: http.StatusOK,
+			mg: newConsumerQuotaOverride(func(cr *v1alpha1.ConsumerQuotaOverride) {
+				force := true
+				cr.Spec.ForProvider.Force = &force
+			}),
+			call: func(e *consumerQuotaOverrideExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+		},
+		"UpdateFailed": {
+			reason: "Should return an error if patching the override fails",
+			method: http.MethodPatch,
+			path:   overridesPath + "/" + overrideID,
+			force:  "false",
+			status: http.StatusBadRequest,
+			mg:     newConsumerQuotaOverride(),
+			call: func(e *consumerQuotaOverrideExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateConsumerQuotaOverride),
+		},
+		"DeleteNotFound": {
+			reason: "Should not return an error if the override is already gone",
+			method: http.MethodDelete,
+			path:   overridesPath + "/" + overrideID,
+			force:  "false",
+			status: http.StatusNotFound,
+			mg:     newConsumerQuotaOverride(),
+			call: func(e *consumerQuotaOverrideExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+		},
+		"DeleteFailed": {
+			reason: "Should return an error if deleting the override fails",
+			method: http.MethodDelete,
+			path:   overridesPath + "/" + overrideID,
+			force:  "false",
+			status: http.StatusBadRequest,
+			mg:     newConsumerQuotaOverride(),
+			call: func(e *consumerQuotaOverrideExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteConsumerQuotaOverride),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method+" "+tc.path, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(tc.force, r.URL.Query().Get("force")); diff != "" {
+					t.Errorf("force: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := serviceusage.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &consumerQuotaOverrideExternal{overrides: s.Services.ConsumerQuotaMetrics.Limits.ConsumerOverrides, projectID: projectID}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
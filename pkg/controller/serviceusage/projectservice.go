@@ -0,0 +1,159 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceusage
+
+import (
+	"context"
+	"time"
+
+	serviceusage "google.golang.org/api/serviceusage/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/serviceusage/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	suclient "github.com/crossplane/provider-gcp/pkg/clients/serviceusage"
+)
+
+// Error strings.
+const (
+	errNewClient         = "cannot create new Service Usage client"
+	errNotProjectService = "managed resource is not a ProjectService"
+	errGetServices       = "cannot get services"
+	errEnableServices    = "cannot enable services"
+	errDisableService    = "cannot disable service"
+)
+
+// SetupProjectService adds a controller that reconciles ProjectServices.
+func SetupProjectService(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.ProjectServiceGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.ProjectServiceGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.ProjectService{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.ProjectServiceGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ProjectServiceGroupVersionKind),
+			&projectServiceConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type projectServiceConnector struct {
+	kube client.Client
+}
+
+func (c *projectServiceConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := serviceusage.NewService(ctx, opts.For("serviceusage")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &projectServiceExternal{services: s.Services, projectID: projectID}, nil
+}
+
+type projectServiceExternal struct {
+	services  *serviceusage.ServicesService
+	projectID string
+}
+
+// A ProjectService exists as long as any of its services is enabled.
+func (e *projectServiceExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectService)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotProjectService)
+	}
+	project := suclient.GetProject(e.projectID, cr.Spec.ForProvider)
+	rsp, err := e.services.BatchGet(suclient.GetParent(project)).Names(suclient.GetServiceNames(project, cr.Spec.ForProvider)...).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetServices)
+	}
+	cr.Status.AtProvider = suclient.GenerateProjectServiceObservation(rsp.Services)
+	if len(suclient.GetServicesToDisable(cr.Spec.ForProvider, cr.Status.AtProvider)) == 0 {
+		return managed.ExternalObservation{}, nil
+	}
+	upToDate := len(suclient.GetServicesToEnable(cr.Spec.ForProvider, cr.Status.AtProvider)) == 0
+	if upToDate {
+		cr.Status.SetConditions(xpv1.Available())
+	} else {
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *projectServiceExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ProjectService)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotProjectService)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	return managed.ExternalCreation{}, e.enable(ctx, cr, cr.Spec.ForProvider.Services)
+}
+
+func (e *projectServiceExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ProjectService)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotProjectService)
+	}
+	return managed.ExternalUpdate{}, e.enable(ctx, cr, suclient.GetServicesToEnable(cr.Spec.ForProvider, cr.Status.AtProvider))
+}
+
+func (e *projectServiceExternal) enable(ctx context.Context, cr *v1alpha1.ProjectService, services []string) error {
+	parent := suclient.GetParent(suclient.GetProject(e.projectID, cr.Spec.ForProvider))
+	_, err := e.services.BatchEnable(parent, suclient.GenerateBatchEnableRequest(services)).Context(ctx).Do()
+	return errors.Wrap(err, errEnableServices)
+}
+
+// Service Usage has no batch call to disable services, so they are disabled
+// one at a time.
+func (e *projectServiceExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ProjectService)
+	if !ok {
+		return errors.New(errNotProjectService)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	project := suclient.GetProject(e.projectID, cr.Spec.ForProvider)
+	for _, s := range suclient.GetServicesToDisable(cr.Spec.ForProvider, cr.Status.AtProvider) {
+		_, err := e.services.Disable(suclient.GetServiceName(project, s), suclient.GenerateDisableRequest(cr.Spec.ForProvider)).Context(ctx).Do()
+		if err := resource.Ignore(gcp.IsErrorNotFound, err); err != nil {
+			return errors.Wrapf(err, "%s %s", errDisableService, s)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,335 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceusage
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	serviceusage "google.golang.org/api/serviceusage/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/serviceusage/v1alpha1"
+)
+
+const (
+	projectID = "myproject-id-1234"
+)
+
+var (
+	unexpectedObject resource.Managed
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newProjectService(states ...string) *v1alpha1.ProjectService {
+	cr := &v1alpha1.ProjectService{}
+	cr.Spec.ForProvider = v1alpha1.ProjectServiceParameters{
+		Services: []string{"compute.googleapis.com", "container.googleapis.com"},
+	}
+	for i, s := range states {
+		cr.Status.AtProvider.Services = append(cr.Status.AtProvider.Services, v1alpha1.ServiceObservation{
+			Name:  cr.Spec.ForProvider.Services[i],
+			State: s,
+		})
+	}
+	return cr
+}
+
+func services(states ...string) *serviceusage.BatchGetServicesResponse {
+	names := []string{"compute.googleapis.com", "container.googleapis.com"}
+	rsp := &serviceusage.BatchGetServicesResponse{}
+	for i, s := range states {
+		rsp.Services = append(rsp.Services, &serviceusage.GoogleApiServiceusageV1Service{
+			Name:  "projects/42/services/" + names[i],
+			State: s,
+		})
+	}
+	return rsp
+}
+
+func TestProjectServiceObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotProjectService": {
+			reason: "Should return an error if the resource is not a ProjectService",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotProjectService)},
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the services fails",
+			mg:     newProjectService(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetServices)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}),
+		},
+		"NoneEnabled": {
+			reason: "Should report the resource as missing if none of its services is enabled",
+			mg:     newProjectService(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/v1/projects/myproject-id-1234/services:batchGet", r.URL.Path); diff != "" {
+					t.Errorf("path: -want, +got:\n%s", diff)
+				}
+				want := []string{
+					"projects/myproject-id-1234/services/compute.googleapis.com",
+					"projects/myproject-id-1234/services/container.googleapis.com",
+				}
+				if diff := cmp.Diff(want, r.URL.Query()["names"]); diff != "" {
+					t.Errorf("names: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(services(v1alpha1.ServiceStateDisabled, v1alpha1.ServiceStateDisabled))
+			}),
+		},
+		"SomeEnabled": {
+			reason: "Should report the resource as outdated if some of its services are not enabled",
+			mg:     newProjectService(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(services(v1alpha1.ServiceStateEnabled, v1alpha1.ServiceStateDisabled))
+			}),
+		},
+		"AllEnabled": {
+			reason: "Should report the resource as up to date if all of its services are enabled",
+			mg:     newProjectService(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(services(v1alpha1.ServiceStateEnabled, v1alpha1.ServiceStateEnabled))
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := serviceusage.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := projectServiceExternal{
+				projectID: projectID,
+				services:  s.Services,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestProjectServiceCreateUpdate(t *testing.T) {
+	type want struct {
+		services []string
+		err      error
+	}
+
+	cases := map[string]struct {
+		reason string
+		status int
+		call   func(e *projectServiceExternal, mg resource.Managed) error
+		mg     resource.Managed
+		want   want
+	}{
+		"CreateNotProjectService": {
+			reason: "Should return an error if the resource is not a ProjectService",
+			call: func(e *projectServiceExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+			mg:   unexpectedObject,
+			want: want{err: errors.New(errNotProjectService)},
+		},
+		"CreateSuccessful": {
+			reason: "Should enable all services when creating the resource",
+			status: http.StatusOK,
+			call: func(e *projectServiceExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+			mg:   newProjectService(),
+			want: want{services: []string{"compute.googleapis.com", "container.googleapis.com"}},
+		},
+		"CreateFailed": {
+			reason: "Should return an error if enabling the services fails",
+			status: http.StatusBadRequest,
+			call: func(e *projectServiceExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+			mg: newProjectService(),
+			want: want{
+				services: []string{"compute.googleapis.com", "container.googleapis.com"},
+				err:      errors.Wrap(gError(http.StatusBadRequest, ""), errEnableServices),
+			},
+		},
+		"UpdateNotProjectService": {
+			reason: "Should return an error if the resource is not a ProjectService",
+			call: func(e *projectServiceExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			mg:   unexpectedObject,
+			want: want{err: errors.New(errNotProjectService)},
+		},
+		"UpdateSuccessful": {
+			reason: "Should only enable the services that are not enabled",
+			status: http.StatusOK,
+			call: func(e *projectServiceExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			mg:   newProjectService(v1alpha1.ServiceStateEnabled, v1alpha1.ServiceStateDisabled),
+			want: want{services: []string{"container.googleapis.com"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				if diff := cmp.Diff("/v1/projects/myproject-id-1234/services:batchEnable", r.URL.Path); diff != "" {
+					t.Errorf("path: -want, +got:\n%s", diff)
+				}
+				req := &serviceusage.BatchEnableServicesRequest{}
+				_ = json.NewDecoder(r.Body).Decode(req)
+				if diff := cmp.Diff(tc.want.services, req.ServiceIds); diff != "" {
+					t.Errorf("services: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&serviceusage.Operation{})
+			}))
+			defer server.Close()
+			s, _ := serviceusage.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &projectServiceExternal{
+				projectID: projectID,
+				services:  s.Services,
+			}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestProjectServiceDelete(t *testing.T) {
+	type want struct {
+		paths []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason string
+		status int
+		mg     resource.Managed
+		want   want
+	}{
+		"NotProjectService": {
+			reason: "Should return an error if the resource is not a ProjectService",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotProjectService)},
+		},
+		"Successful": {
+			reason: "Should only disable the services that are enabled",
+			status: http.StatusOK,
+			mg:     newProjectService(v1alpha1.ServiceStateDisabled, v1alpha1.ServiceStateEnabled),
+			want: want{paths: []string{
+				"/v1/projects/myproject-id-1234/services/container.googleapis.com:disable",
+			}},
+		},
+		"AlreadyGone": {
+			reason: "Should not return an error if a service is already gone",
+			status: http.StatusNotFound,
+			mg:     newProjectService(v1alpha1.ServiceStateEnabled, v1alpha1.ServiceStateEnabled),
+			want: want{paths: []string{
+				"/v1/projects/myproject-id-1234/services/compute.googleapis.com:disable",
+				"/v1/projects/myproject-id-1234/services/container.googleapis.com:disable",
+			}},
+		},
+		"Failed": {
+			reason: "Should return an error if disabling a service fails",
+			status: http.StatusBadRequest,
+			mg:     newProjectService(v1alpha1.ServiceStateEnabled, v1alpha1.ServiceStateEnabled),
+			want: want{
+				paths: []string{
+					"/v1/projects/myproject-id-1234/services/compute.googleapis.com:disable",
+				},
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errDisableService+" compute.googleapis.com"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var paths []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				paths = append(paths, r.URL.Path)
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&serviceusage.Operation{})
+			}))
+			defer server.Close()
+			s, _ := serviceusage.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &projectServiceExternal{
+				projectID: projectID,
+				services:  s.Services,
+			}
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.paths, paths); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want paths, +got paths:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
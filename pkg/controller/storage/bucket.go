@@ -53,18 +53,21 @@ const (
 )
 
 // SetupBucket adds a controller that reconciles Buckets.
-func SetupBucket(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+func SetupBucket(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
 	name := managed.ControllerName(v1alpha3.BucketGroupKind)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: mcr.For(v1alpha3.BucketGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
 		}).
 		For(&v1alpha3.Bucket{}).
-		Complete(managed.NewReconciler(mgr,
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha3.BucketGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha3.BucketGroupVersionKind),
-			managed.WithExternalConnecter(&connecter{client: mgr.GetClient()}),
+			&connecter{client: mgr.GetClient()},
+			managed.WithInitializers(gcp.NewExternalNameInitializer(mgr.GetClient()), gcp.NewDefaultLabelsInitializer(mgr.GetClient(), bucketLabels)),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -93,6 +96,15 @@ type BucketHandler interface {
 	Delete(context.Context) error
 }
 
+// bucketLabels returns the labels of a Bucket.
+func bucketLabels(mg resource.Managed) *map[string]string {
+	cr, ok := mg.(*v1alpha3.Bucket)
+	if !ok {
+		return nil
+	}
+	return &cr.Spec.Labels
+}
+
 type connecter struct {
 	client client.Client
 }
@@ -104,7 +116,7 @@ func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, err
 	}
 
-	s, err := storage.NewClient(ctx, opts)
+	s, err := storage.NewClient(ctx, opts.For("storage")...)
 	if err != nil {
 		return nil, err
 	}
@@ -125,14 +137,8 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 
 	a, err := e.handle.Bucket(meta.GetExternalName(cr)).Attrs(ctx)
-	// NOTE(negz): The storage client appears to intercept the typical GCP API
-	// error that we check for with gcp.IsErrorNotFound and return this error
-	// instead, but only when getting bucket attributes.
-	if err == storage.ErrBucketNotExist {
-		return managed.ExternalObservation{ResourceExists: false}, nil
-	}
 	if err != nil {
-		return managed.ExternalObservation{}, errors.Wrap(err, errAttrs)
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errAttrs)
 	}
 
 	proposed := cr.Spec.BucketSpecAttrs.DeepCopy()
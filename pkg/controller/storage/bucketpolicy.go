@@ -48,18 +48,20 @@ const (
 )
 
 // SetupBucketPolicy adds a controller that reconciles BucketPolicys.
-func SetupBucketPolicy(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+func SetupBucketPolicy(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
 	name := managed.ControllerName(v1alpha1.BucketPolicyGroupKind)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: mcr.For(v1alpha1.BucketPolicyGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
 		}).
 		For(&v1alpha1.BucketPolicy{}).
-		Complete(managed.NewReconciler(mgr,
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.BucketPolicyGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.BucketPolicyGroupVersionKind),
-			managed.WithExternalConnecter(&bucketPolicyConnecter{client: mgr.GetClient()}),
+			&bucketPolicyConnecter{client: mgr.GetClient()},
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -76,7 +78,7 @@ func (c *bucketPolicyConnecter) Connect(ctx context.Context, mg resource.Managed
 	if err != nil {
 		return nil, err
 	}
-	s, err := storage.NewService(ctx, opts)
+	s, err := storage.NewService(ctx, opts.For("storage")...)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
@@ -165,9 +167,7 @@ func (e *bucketPolicyExternal) Delete(ctx context.Context, mg resource.Managed)
 	if !ok {
 		return errors.New(errNotBucketPolicy)
 	}
-	if _, err := e.bucketpolicy.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket), &storage.Policy{}).
-		Context(ctx).Do(); err != nil {
-		return errors.Wrap(err, errSetPolicy)
-	}
-	return nil
+	// There is no policy to empty if the bucket is gone.
+	_, err := e.bucketpolicy.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket), &storage.Policy{}).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errSetPolicy)
 }
@@ -674,6 +674,23 @@ func TestBucketPolicyDelete(t *testing.T) {
 					bpWithExternalNameAnnotation(bpMetadataName)),
 			},
 		},
+		"BucketNotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&storagev1.Policy{})
+			}),
+			args: args{
+				ctx: context.Background(),
+				mg: BucketPolicy(
+					bpWithName(bpMetadataName),
+					bpWithExternalNameAnnotation(bpMetadataName)),
+			},
+			want: want{
+				mg: BucketPolicy(
+					bpWithName(bpMetadataName),
+					bpWithExternalNameAnnotation(bpMetadataName)),
+			},
+		},
 		"CreateFailed": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				w.WriteHeader(http.StatusInternalServerError)
@@ -45,18 +45,20 @@ const (
 )
 
 // SetupBucketPolicyMember adds a controller that reconciles BucketPolicyMembers.
-func SetupBucketPolicyMember(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+func SetupBucketPolicyMember(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
 	name := managed.ControllerName(v1alpha1.BucketPolicyMemberGroupKind)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(controller.Options{
-			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+			MaxConcurrentReconciles: mcr.For(v1alpha1.BucketPolicyMemberGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
 		}).
 		For(&v1alpha1.BucketPolicyMember{}).
-		Complete(managed.NewReconciler(mgr,
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.BucketPolicyMemberGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.BucketPolicyMemberGroupVersionKind),
-			managed.WithExternalConnecter(&bucketPolicyMemberConnecter{client: mgr.GetClient()}),
+			&bucketPolicyMemberConnecter{client: mgr.GetClient()},
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -73,7 +75,7 @@ func (c *bucketPolicyMemberConnecter) Connect(ctx context.Context, mg resource.M
 	if err != nil {
 		return nil, err
 	}
-	s, err := storage.NewService(ctx, opts)
+	s, err := storage.NewService(ctx, opts.For("storage")...)
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
@@ -141,19 +143,16 @@ func (e *bucketPolicyMemberExternal) Delete(ctx context.Context, mg resource.Man
 	if !ok {
 		return errors.New(errNotBucketPolicyMember)
 	}
+	// There is no member to unbind if the bucket is gone.
 	instance, err := e.bucketpolicy.GetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket)).OptionsRequestedPolicyVersion(iamv1alpha1.PolicyVersion).Context(ctx).Do()
 	if err != nil {
-		return errors.Wrap(err, errGetPolicy)
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetPolicy)
 	}
 
 	changed := bucketpolicy.UnbindRoleFromMember(cr.Spec.ForProvider, instance)
 	if !changed {
 		return nil
 	}
-	if _, err := e.bucketpolicy.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket), instance).
-		Context(ctx).Do(); err != nil {
-		return errors.Wrap(err, errSetPolicy)
-	}
-
-	return nil
+	_, err = e.bucketpolicy.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.Bucket), instance).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errSetPolicy)
 }
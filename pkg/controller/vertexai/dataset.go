@@ -0,0 +1,171 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vertexai
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	aiplatform "google.golang.org/api/aiplatform/v1"
+	"google.golang.org/api/option"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/vertexai/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	vaclient "github.com/crossplane/provider-gcp/pkg/clients/vertexai"
+)
+
+// Error strings.
+const (
+	errNotDataset      = "managed resource is not a Vertex AI Dataset"
+	errGetDataset      = "cannot get Vertex AI dataset"
+	errCreateDataset   = "cannot create Vertex AI dataset"
+	errUpdateDataset   = "cannot update Vertex AI dataset"
+	errDeleteDataset   = "cannot delete Vertex AI dataset"
+	errUpdateDatasetCR = "cannot update Vertex AI Dataset custom resource"
+)
+
+// SetupDataset adds a controller that reconciles Vertex AI Datasets.
+func SetupDataset(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.DatasetGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.DatasetGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Dataset{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.DatasetGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.DatasetGroupVersionKind),
+			&datasetConnector{kube: mgr.GetClient()},
+			managed.WithInitializers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type datasetConnector struct {
+	kube client.Client
+}
+
+func (c *datasetConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Dataset)
+	if !ok {
+		return nil, errors.New(errNotDataset)
+	}
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := aiplatform.NewService(ctx, opts.For("aiplatform", option.WithEndpoint(vaclient.GetServiceEndpoint(cr.Spec.ForProvider.Location)))...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &datasetExternal{kube: c.kube, datasets: s.Projects.Locations.Datasets, projectID: projectID}, nil
+}
+
+type datasetExternal struct {
+	kube      client.Client
+	datasets  *aiplatform.ProjectsLocationsDatasetsService
+	projectID string
+}
+
+func (e *datasetExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Dataset)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDataset)
+	}
+	// Dataset IDs are assigned by Vertex AI, so until we've created the
+	// dataset we have nothing to look up.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	existing, err := e.datasets.Get(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetDataset)
+	}
+	current := cr.Spec.ForProvider.DeepCopy()
+	vaclient.LateInitializeDataset(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateDatasetCR)
+		}
+	}
+	cr.Status.AtProvider = vaclient.GenerateDatasetObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: vaclient.IsDatasetUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *datasetExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Dataset)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDataset)
+	}
+	cr.SetConditions(xpv1.Creating())
+	op, err := e.datasets.Create(vaclient.GetDatasetParent(e.projectID, cr.Spec.ForProvider), vaclient.GenerateDataset(cr.Spec.ForProvider)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateDataset)
+	}
+	id, err := vaclient.GetDatasetID(*op)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateDataset)
+	}
+	meta.SetExternalName(cr, id)
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *datasetExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Dataset)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDataset)
+	}
+	_, err := e.datasets.Patch(e.name(cr), vaclient.GenerateDataset(cr.Spec.ForProvider)).UpdateMask(vaclient.DatasetUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateDataset)
+}
+
+func (e *datasetExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Dataset)
+	if !ok {
+		return errors.New(errNotDataset)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.datasets.Delete(e.name(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteDataset)
+}
+
+func (e *datasetExternal) name(cr *v1alpha1.Dataset) string {
+	return vaclient.GetDatasetName(vaclient.GetDatasetParent(e.projectID, cr.Spec.ForProvider), meta.GetExternalName(cr))
+}
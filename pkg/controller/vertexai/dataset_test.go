@@ -0,0 +1,308 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vertexai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	aiplatform "google.golang.org/api/aiplatform/v1"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/vertexai/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	datasetName   = "projects/myproject-id-1234/locations/us-central1/datasets/4567"
+	datasetPath   = "/v1/" + datasetName
+	datasetSchema = "gs://google-cloud-aiplatform/schema/dataset/metadata/image_1.0.0.yaml"
+)
+
+func newDataset(m ...func(*v1alpha1.Dataset)) *v1alpha1.Dataset {
+	cr := &v1alpha1.Dataset{}
+	meta.SetExternalName(cr, "4567")
+	cr.Spec.ForProvider = v1alpha1.DatasetParameters{
+		Location:          "us-central1",
+		DisplayName:       "Product images",
+		Description:       gcp.StringPtr("Labelled product images"),
+		MetadataSchemaURI: datasetSchema,
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func dataset(m ...func(*aiplatform.GoogleCloudAiplatformV1Dataset)) *aiplatform.GoogleCloudAiplatformV1Dataset {
+	d := &aiplatform.GoogleCloudAiplatformV1Dataset{
+		Name:              datasetName,
+		DisplayName:       "Product images",
+		Description:       "Labelled product images",
+		MetadataSchemaUri: datasetSchema,
+	}
+	for _, f := range m {
+		f(d)
+	}
+	return d
+}
+
+func TestDatasetObserve(t *testing.T) {
+	type want struct {
+		e    managed.ExternalObservation
+		cond xpv1.Condition
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		status  int
+		dataset *aiplatform.GoogleCloudAiplatformV1Dataset
+		kube    *test.MockClient
+		mg      resource.Managed
+		want    want
+	}{
+		"NotDataset": {
+			reason: "Should return an error if the resource is not a Dataset",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotDataset)},
+		},
+		"NoExternalName": {
+			reason: "Should report a dataset without an external name as not existing",
+			mg:     newDataset(func(cr *v1alpha1.Dataset) { meta.SetExternalName(cr, "") }),
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the dataset does not exist",
+			status: http.StatusNotFound,
+			mg:     newDataset(),
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the dataset fails",
+			status: http.StatusBadRequest,
+			mg:     newDataset(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetDataset)},
+		},
+		"LateInitFailed": {
+			reason:  "Should return an error if the late initialized spec can't be saved",
+			status:  http.StatusOK,
+			dataset: dataset(),
+			kube:    &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:      newDataset(func(cr *v1alpha1.Dataset) { cr.Spec.ForProvider.Description = nil }),
+			want:    want{err: errors.Wrap(errBoom, errUpdateDatasetCR)},
+		},
+		"ResourceUpToDate": {
+			reason:  "Should report an existing dataset as available",
+			status:  http.StatusOK,
+			dataset: dataset(),
+			mg:      newDataset(),
+			want: want{
+				e:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Available(),
+			},
+		},
+		"NeedsUpdate": {
+			reason:  "Should return upToDate as false if the display name differs",
+			status:  http.StatusOK,
+			dataset: dataset(func(d *aiplatform.GoogleCloudAiplatformV1Dataset) { d.DisplayName = "Images" }),
+			mg:      newDataset(),
+			want: want{
+				e:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				cond: xpv1.Available(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet+" "+datasetPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.dataset == nil {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.dataset)
+			}))
+			defer server.Close()
+			s, _ := aiplatform.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &datasetExternal{kube: tc.kube, datasets: s.Projects.Locations.Datasets, projectID: projectID}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Dataset); ok && got.ResourceExists {
+				if diff := cmp.Diff(tc.want.cond, cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want condition, +got condition:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestDatasetCreate(t *testing.T) {
+	type want struct {
+		c            managed.ExternalCreation
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		status int
+		op     *aiplatform.GoogleLongrunningOperation
+		want   want
+	}{
+		"Successful": {
+			reason: "Should set the external name to the ID assigned by Vertex AI",
+			status: http.StatusOK,
+			op:     &aiplatform.GoogleLongrunningOperation{Name: datasetName + "/operations/987"},
+			want: want{
+				c:            managed.ExternalCreation{ExternalNameAssigned: true},
+				externalName: "4567",
+			},
+		},
+		"NoDatasetName": {
+			reason: "Should return an error if the ID of the dataset can't be determined",
+			status: http.StatusOK,
+			op:     &aiplatform.GoogleLongrunningOperation{Name: "projects/myproject-id-1234/locations/us-central1/operations/987"},
+			want: want{
+				err: errors.Wrap(errors.New("operation name does not contain a dataset name"), errCreateDataset),
+			},
+		},
+		"CreateFailed": {
+			reason: "Should return an error if creating the dataset fails",
+			status: http.StatusBadRequest,
+			want: want{
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateDataset),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPost+" /v1/projects/myproject-id-1234/locations/us-central1/datasets", r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.op == nil {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.op)
+			}))
+			defer server.Close()
+			s, _ := aiplatform.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			cr := newDataset(func(cr *v1alpha1.Dataset) { meta.SetExternalName(cr, "") })
+			e := &datasetExternal{datasets: s.Projects.Locations.Datasets, projectID: projectID}
+			got, err := e.Create(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.c, got); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want external name, +got external name:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDatasetWrite(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		method string
+		status int
+		call   func(*datasetExternal, resource.Managed) error
+		want   error
+	}{
+		"UpdateSuccessful": {
+			reason: "Should patch the dataset",
+			method: http.MethodPatch,
+			status: http.StatusOK,
+			call: func(e *datasetExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+		},
+		"UpdateFailed": {
+			reason: "Should return an error if patching the dataset fails",
+			method: http.MethodPatch,
+			status: http.StatusBadRequest,
+			call: func(e *datasetExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateDataset),
+		},
+		"DeleteNotFound": {
+			reason: "Should not return an error if the dataset is already gone",
+			method: http.MethodDelete,
+			status: http.StatusNotFound,
+			call: func(e *datasetExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+		},
+		"DeleteFailed": {
+			reason: "Should return an error if deleting the dataset fails",
+			method: http.MethodDelete,
+			status: http.StatusBadRequest,
+			call: func(e *datasetExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteDataset),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method+" "+datasetPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := aiplatform.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			err := tc.call(&datasetExternal{datasets: s.Projects.Locations.Datasets, projectID: projectID}, newDataset())
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
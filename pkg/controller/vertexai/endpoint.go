@@ -0,0 +1,166 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vertexai
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	aiplatform "google.golang.org/api/aiplatform/v1"
+	"google.golang.org/api/option"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/vertexai/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	vaclient "github.com/crossplane/provider-gcp/pkg/clients/vertexai"
+)
+
+// Error strings.
+const (
+	errNewClient        = "cannot create new Vertex AI client"
+	errNotEndpoint      = "managed resource is not a Vertex AI Endpoint"
+	errGetEndpoint      = "cannot get Vertex AI endpoint"
+	errCreateEndpoint   = "cannot create Vertex AI endpoint"
+	errUpdateEndpoint   = "cannot update Vertex AI endpoint"
+	errDeleteEndpoint   = "cannot delete Vertex AI endpoint"
+	errUpdateEndpointCR = "cannot update Vertex AI Endpoint custom resource"
+)
+
+// SetupEndpoint adds a controller that reconciles Vertex AI Endpoints.
+func SetupEndpoint(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.EndpointGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.EndpointGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Endpoint{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.EndpointGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.EndpointGroupVersionKind),
+			&endpointConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type endpointConnector struct {
+	kube client.Client
+}
+
+func (c *endpointConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Endpoint)
+	if !ok {
+		return nil, errors.New(errNotEndpoint)
+	}
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := aiplatform.NewService(ctx, opts.For("aiplatform", option.WithEndpoint(vaclient.GetServiceEndpoint(cr.Spec.ForProvider.Location)))...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &endpointExternal{kube: c.kube, endpoints: s.Projects.Locations.Endpoints, projectID: projectID}, nil
+}
+
+type endpointExternal struct {
+	kube      client.Client
+	endpoints *aiplatform.ProjectsLocationsEndpointsService
+	projectID string
+}
+
+func (e *endpointExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Endpoint)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotEndpoint)
+	}
+	existing, err := e.endpoints.Get(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetEndpoint)
+	}
+	current := cr.Spec.ForProvider.DeepCopy()
+	vaclient.LateInitializeEndpoint(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateEndpointCR)
+		}
+	}
+	cr.Status.AtProvider = vaclient.GenerateEndpointObservation(*existing)
+	cr.Status.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: vaclient.IsEndpointUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *endpointExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Endpoint)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotEndpoint)
+	}
+	cr.SetConditions(xpv1.Creating())
+	ep := vaclient.GenerateEndpoint(cr.Spec.ForProvider)
+	// Traffic can only be split between deployed models, and a new endpoint
+	// has none.
+	ep.TrafficSplit = nil
+	_, err := e.endpoints.Create(vaclient.GetEndpointParent(e.projectID, cr.Spec.ForProvider), ep).
+		EndpointId(meta.GetExternalName(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateEndpoint)
+}
+
+func (e *endpointExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Endpoint)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotEndpoint)
+	}
+	_, err := e.endpoints.Patch(e.name(cr), vaclient.GenerateEndpoint(cr.Spec.ForProvider)).
+		UpdateMask(vaclient.GetEndpointUpdateMask(cr.Spec.ForProvider)).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateEndpoint)
+}
+
+// Delete fails while models are deployed to the endpoint. They have to be
+// undeployed by whoever deployed them.
+func (e *endpointExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Endpoint)
+	if !ok {
+		return errors.New(errNotEndpoint)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.endpoints.Delete(e.name(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteEndpoint)
+}
+
+func (e *endpointExternal) name(cr *v1alpha1.Endpoint) string {
+	return vaclient.GetEndpointName(vaclient.GetEndpointParent(e.projectID, cr.Spec.ForProvider), meta.GetExternalName(cr))
+}
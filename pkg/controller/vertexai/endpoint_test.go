@@ -0,0 +1,307 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vertexai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	aiplatform "google.golang.org/api/aiplatform/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/vertexai/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID    = "myproject-id-1234"
+	endpointName = "projects/myproject-id-1234/locations/us-central1/endpoints/churn"
+	endpointPath = "/v1/" + endpointName
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newEndpoint(m ...func(*v1alpha1.Endpoint)) *v1alpha1.Endpoint {
+	cr := &v1alpha1.Endpoint{}
+	meta.SetExternalName(cr, "churn")
+	cr.Spec.ForProvider = v1alpha1.EndpointParameters{
+		Location:     "us-central1",
+		DisplayName:  "Churn prediction",
+		Description:  gcp.StringPtr("Predicts customer churn"),
+		TrafficSplit: map[string]int64{"1234": 100},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func endpoint(m ...func(*aiplatform.GoogleCloudAiplatformV1Endpoint)) *aiplatform.GoogleCloudAiplatformV1Endpoint {
+	e := &aiplatform.GoogleCloudAiplatformV1Endpoint{
+		Name:         endpointName,
+		DisplayName:  "Churn prediction",
+		Description:  "Predicts customer churn",
+		TrafficSplit: map[string]int64{"1234": 100},
+		DeployedModels: []*aiplatform.GoogleCloudAiplatformV1DeployedModel{{
+			Id:    "1234",
+			Model: "projects/myproject-id-1234/locations/us-central1/models/churn",
+		}},
+	}
+	for _, f := range m {
+		f(e)
+	}
+	return e
+}
+
+func TestEndpointObserve(t *testing.T) {
+	type want struct {
+		e    managed.ExternalObservation
+		cond xpv1.Condition
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason   string
+		status   int
+		endpoint *aiplatform.GoogleCloudAiplatformV1Endpoint
+		kube     *test.MockClient
+		mg       resource.Managed
+		want     want
+	}{
+		"NotEndpoint": {
+			reason: "Should return an error if the resource is not an Endpoint",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotEndpoint)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the endpoint does not exist",
+			status: http.StatusNotFound,
+			mg:     newEndpoint(),
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the endpoint fails",
+			status: http.StatusBadRequest,
+			mg:     newEndpoint(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetEndpoint)},
+		},
+		"LateInitFailed": {
+			reason:   "Should return an error if the late initialized spec can't be saved",
+			status:   http.StatusOK,
+			endpoint: endpoint(),
+			kube:     &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:       newEndpoint(func(cr *v1alpha1.Endpoint) { cr.Spec.ForProvider.Description = nil }),
+			want:     want{err: errors.Wrap(errBoom, errUpdateEndpointCR)},
+		},
+		"ResourceUpToDate": {
+			reason:   "Should report an existing endpoint as available",
+			status:   http.StatusOK,
+			endpoint: endpoint(),
+			mg:       newEndpoint(),
+			want: want{
+				e:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Available(),
+			},
+		},
+		"NeedsTrafficSplit": {
+			reason:   "Should return upToDate as false if the traffic split differs",
+			status:   http.StatusOK,
+			endpoint: endpoint(func(e *aiplatform.GoogleCloudAiplatformV1Endpoint) { e.TrafficSplit = nil }),
+			mg:       newEndpoint(),
+			want: want{
+				e:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				cond: xpv1.Available(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet+" "+endpointPath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.endpoint == nil {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.endpoint)
+			}))
+			defer server.Close()
+			s, _ := aiplatform.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &endpointExternal{kube: tc.kube, endpoints: s.Projects.Locations.Endpoints, projectID: projectID}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Endpoint); ok && got.ResourceExists {
+				if diff := cmp.Diff(tc.want.cond, cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want condition, +got condition:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestEndpointWrite(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		method string
+		path   string
+		query  map[string]string
+		body   *aiplatform.GoogleCloudAiplatformV1Endpoint
+		status int
+		mg     *v1alpha1.Endpoint
+		call   func(*endpointExternal, resource.Managed) error
+		want   error
+	}{
+		"CreateSuccessful": {
+			reason: "Should create the endpoint with the external name as ID and without a traffic split",
+			method: http.MethodPost,
+			path:   "/v1/projects/myproject-id-1234/locations/us-central1/endpoints",
+			query:  map[string]string{"endpointId": "churn"},
+			body: &aiplatform.GoogleCloudAiplatformV1Endpoint{
+				DisplayName: "Churn prediction",
+				Description: "Predicts customer churn",
+			},
+			status: http.StatusOK,
+			call: func(e *endpointExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+		},
+		"CreateFailed": {
+			reason: "Should return an error if creating the endpoint fails",
+			method: http.MethodPost,
+			path:   "/v1/projects/myproject-id-1234/locations/us-central1/endpoints",
+			query:  map[string]string{"endpointId": "churn"},
+			status: http.StatusBadRequest,
+			call: func(e *endpointExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateEndpoint),
+		},
+		"UpdateSuccessful": {
+			reason: "Should patch the traffic split of the endpoint",
+			method: http.MethodPatch,
+			path:   endpointPath,
+			query:  map[string]string{"updateMask": "displayName,description,labels,trafficSplit"},
+			status: http.StatusOK,
+			call: func(e *endpointExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+		},
+		"UpdateFailed": {
+			reason: "Should return an error if patching the endpoint fails",
+			method: http.MethodPatch,
+			path:   endpointPath,
+			query:  map[string]string{"updateMask": "displayName,description,labels,trafficSplit"},
+			status: http.StatusBadRequest,
+			call: func(e *endpointExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateEndpoint),
+		},
+		"DeleteNotFound": {
+			reason: "Should not return an error if the endpoint is already gone",
+			method: http.MethodDelete,
+			path:   endpointPath,
+			status: http.StatusNotFound,
+			call: func(e *endpointExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+		},
+		"DeleteFailed": {
+			reason: "Should return an error if deleting the endpoint fails",
+			method: http.MethodDelete,
+			path:   endpointPath,
+			status: http.StatusBadRequest,
+			call: func(e *endpointExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteEndpoint),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got := &aiplatform.GoogleCloudAiplatformV1Endpoint{}
+				_ = json.NewDecoder(r.Body).Decode(got)
+				_ = r.Body.Close()
+				if tc.method == "" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				if diff := cmp.Diff(tc.method+" "+tc.path, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				for k, v := range tc.query {
+					if diff := cmp.Diff(v, r.URL.Query().Get(k)); diff != "" {
+						t.Errorf("%s: -want, +got:\n%s", k, diff)
+					}
+				}
+				if tc.body != nil {
+					if diff := cmp.Diff(tc.body, got); diff != "" {
+						t.Errorf("body: -want, +got:\n%s", diff)
+					}
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := aiplatform.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			mg := tc.mg
+			if mg == nil {
+				mg = newEndpoint()
+			}
+			err := tc.call(&endpointExternal{endpoints: s.Projects.Locations.Endpoints, projectID: projectID}, mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vertexai
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	aiplatform "google.golang.org/api/aiplatform/v1"
+	"google.golang.org/api/option"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/vertexai/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	vaclient "github.com/crossplane/provider-gcp/pkg/clients/vertexai"
+)
+
+// Error strings.
+const (
+	errNotFeaturestore      = "managed resource is not a Vertex AI Featurestore"
+	errGetFeaturestore      = "cannot get Vertex AI featurestore"
+	errCreateFeaturestore   = "cannot create Vertex AI featurestore"
+	errUpdateFeaturestore   = "cannot update Vertex AI featurestore"
+	errDeleteFeaturestore   = "cannot delete Vertex AI featurestore"
+	errUpdateFeaturestoreCR = "cannot update Vertex AI Featurestore custom resource"
+)
+
+// SetupFeaturestore adds a controller that reconciles Vertex AI Featurestores.
+func SetupFeaturestore(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.FeaturestoreGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.FeaturestoreGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Featurestore{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.FeaturestoreGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.FeaturestoreGroupVersionKind),
+			&featurestoreConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type featurestoreConnector struct {
+	kube client.Client
+}
+
+func (c *featurestoreConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Featurestore)
+	if !ok {
+		return nil, errors.New(errNotFeaturestore)
+	}
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := aiplatform.NewService(ctx, opts.For("aiplatform", option.WithEndpoint(vaclient.GetServiceEndpoint(cr.Spec.ForProvider.Location)))...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &featurestoreExternal{kube: c.kube, featurestores: s.Projects.Locations.Featurestores, projectID: projectID}, nil
+}
+
+type featurestoreExternal struct {
+	kube          client.Client
+	featurestores *aiplatform.ProjectsLocationsFeaturestoresService
+	projectID     string
+}
+
+func (e *featurestoreExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Featurestore)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotFeaturestore)
+	}
+	existing, err := e.featurestores.Get(e.name(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetFeaturestore)
+	}
+	current := cr.Spec.ForProvider.DeepCopy()
+	vaclient.LateInitializeFeaturestore(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateFeaturestoreCR)
+		}
+	}
+	cr.Status.AtProvider = vaclient.GenerateFeaturestoreObservation(*existing)
+	switch cr.Status.AtProvider.State {
+	// An updating featurestore keeps serving features.
+	case v1alpha1.FeaturestoreStateStable, v1alpha1.FeaturestoreStateUpdating:
+		cr.Status.SetConditions(xpv1.Available())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: vaclient.IsFeaturestoreUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *featurestoreExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Featurestore)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotFeaturestore)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.featurestores.Create(vaclient.GetFeaturestoreParent(e.projectID, cr.Spec.ForProvider), vaclient.GenerateFeaturestore(cr.Spec.ForProvider)).
+		FeaturestoreId(meta.GetExternalName(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateFeaturestore)
+}
+
+func (e *featurestoreExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Featurestore)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotFeaturestore)
+	}
+	// Changes are rejected while a previous update is still being applied.
+	if cr.Status.AtProvider.State == v1alpha1.FeaturestoreStateUpdating {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err := e.featurestores.Patch(e.name(cr), vaclient.GenerateFeaturestore(cr.Spec.ForProvider)).UpdateMask(vaclient.FeaturestoreUpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFeaturestore)
+}
+
+// Delete fails while the featurestore contains entity types. We don't force
+// the deletion, because that would delete the feature values they hold.
+func (e *featurestoreExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Featurestore)
+	if !ok {
+		return errors.New(errNotFeaturestore)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.featurestores.Delete(e.name(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteFeaturestore)
+}
+
+func (e *featurestoreExternal) name(cr *v1alpha1.Featurestore) string {
+	return vaclient.GetFeaturestoreName(vaclient.GetFeaturestoreParent(e.projectID, cr.Spec.ForProvider), meta.GetExternalName(cr))
+}
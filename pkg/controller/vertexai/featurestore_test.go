@@ -0,0 +1,302 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vertexai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	aiplatform "google.golang.org/api/aiplatform/v1"
+	"google.golang.org/api/option"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/vertexai/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	featurestoreName = "projects/myproject-id-1234/locations/us-central1/featurestores/customers"
+	featurestorePath = "/v1/" + featurestoreName
+)
+
+func newFeaturestore(m ...func(*v1alpha1.Featurestore)) *v1alpha1.Featurestore {
+	cr := &v1alpha1.Featurestore{}
+	meta.SetExternalName(cr, "customers")
+	cr.Spec.ForProvider = v1alpha1.FeaturestoreParameters{
+		Location: "us-central1",
+		OnlineServingConfig: &v1alpha1.OnlineServingConfig{
+			FixedNodeCount: gcp.Int64Ptr(1),
+		},
+		OnlineStorageTTLDays: gcp.Int64Ptr(30),
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func featurestore(m ...func(*aiplatform.GoogleCloudAiplatformV1Featurestore)) *aiplatform.GoogleCloudAiplatformV1Featurestore {
+	f := &aiplatform.GoogleCloudAiplatformV1Featurestore{
+		Name:  featurestoreName,
+		State: v1alpha1.FeaturestoreStateStable,
+		OnlineServingConfig: &aiplatform.GoogleCloudAiplatformV1FeaturestoreOnlineServingConfig{
+			FixedNodeCount: 1,
+		},
+		OnlineStorageTtlDays: 30,
+	}
+	for _, fn := range m {
+		fn(f)
+	}
+	return f
+}
+
+func TestFeaturestoreObserve(t *testing.T) {
+	type want struct {
+		e    managed.ExternalObservation
+		cond xpv1.Condition
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason       string
+		status       int
+		featurestore *aiplatform.GoogleCloudAiplatformV1Featurestore
+		kube         *test.MockClient
+		mg           resource.Managed
+		want         want
+	}{
+		"NotFeaturestore": {
+			reason: "Should return an error if the resource is not a Featurestore",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotFeaturestore)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the featurestore does not exist",
+			status: http.StatusNotFound,
+			mg:     newFeaturestore(),
+		},
+		"GetFailed": {
+			reason: "Should return an error if getting the featurestore fails",
+			status: http.StatusBadRequest,
+			mg:     newFeaturestore(),
+			want:   want{err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetFeaturestore)},
+		},
+		"LateInitFailed": {
+			reason:       "Should return an error if the late initialized spec can't be saved",
+			status:       http.StatusOK,
+			featurestore: featurestore(),
+			kube:         &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:           newFeaturestore(func(cr *v1alpha1.Featurestore) { cr.Spec.ForProvider.OnlineStorageTTLDays = nil }),
+			want:         want{err: errors.Wrap(errBoom, errUpdateFeaturestoreCR)},
+		},
+		"Stable": {
+			reason:       "Should report a stable featurestore as available",
+			status:       http.StatusOK,
+			featurestore: featurestore(),
+			mg:           newFeaturestore(),
+			want: want{
+				e:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Available(),
+			},
+		},
+		"Updating": {
+			reason: "Should report an updating featurestore as available",
+			status: http.StatusOK,
+			featurestore: featurestore(func(f *aiplatform.GoogleCloudAiplatformV1Featurestore) {
+				f.State = v1alpha1.FeaturestoreStateUpdating
+			}),
+			mg: newFeaturestore(),
+			want: want{
+				e:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cond: xpv1.Available(),
+			},
+		},
+		"NeedsScaling": {
+			reason: "Should return upToDate as false if the number of online serving nodes differs",
+			status: http.StatusOK,
+			featurestore: featurestore(func(f *aiplatform.GoogleCloudAiplatformV1Featurestore) {
+				f.OnlineServingConfig.FixedNodeCount = 2
+			}),
+			mg: newFeaturestore(),
+			want: want{
+				e:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				cond: xpv1.Available(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet+" "+featurestorePath, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				if tc.featurestore == nil {
+					_ = json.NewEncoder(w).Encode(struct{}{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(tc.featurestore)
+			}))
+			defer server.Close()
+			s, _ := aiplatform.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &featurestoreExternal{kube: tc.kube, featurestores: s.Projects.Locations.Featurestores, projectID: projectID}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if cr, ok := tc.mg.(*v1alpha1.Featurestore); ok && got.ResourceExists {
+				if diff := cmp.Diff(tc.want.cond, cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+					t.Errorf("\n%s\nObserve(...): -want condition, +got condition:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestFeaturestoreWrite(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		method string
+		path   string
+		query  map[string]string
+		status int
+		mg     *v1alpha1.Featurestore
+		call   func(*featurestoreExternal, resource.Managed) error
+		want   error
+	}{
+		"CreateSuccessful": {
+			reason: "Should create the featurestore with the external name as ID",
+			method: http.MethodPost,
+			path:   "/v1/projects/myproject-id-1234/locations/us-central1/featurestores",
+			query:  map[string]string{"featurestoreId": "customers"},
+			status: http.StatusOK,
+			call: func(e *featurestoreExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+		},
+		"CreateFailed": {
+			reason: "Should return an error if creating the featurestore fails",
+			method: http.MethodPost,
+			path:   "/v1/projects/myproject-id-1234/locations/us-central1/featurestores",
+			query:  map[string]string{"featurestoreId": "customers"},
+			status: http.StatusBadRequest,
+			call: func(e *featurestoreExternal, mg resource.Managed) error {
+				_, err := e.Create(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateFeaturestore),
+		},
+		"UpdateSuccessful": {
+			reason: "Should patch the featurestore",
+			method: http.MethodPatch,
+			path:   featurestorePath,
+			query:  map[string]string{"updateMask": "labels,onlineServingConfig.fixedNodeCount,onlineServingConfig.scaling,onlineStorageTtlDays"},
+			status: http.StatusOK,
+			call: func(e *featurestoreExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+		},
+		"UpdateFailed": {
+			reason: "Should return an error if patching the featurestore fails",
+			method: http.MethodPatch,
+			path:   featurestorePath,
+			status: http.StatusBadRequest,
+			call: func(e *featurestoreExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateFeaturestore),
+		},
+		"UpdateWhileUpdating": {
+			reason: "Should not patch a featurestore while a previous update is applied",
+			mg: newFeaturestore(func(cr *v1alpha1.Featurestore) {
+				cr.Status.AtProvider.State = v1alpha1.FeaturestoreStateUpdating
+			}),
+			call: func(e *featurestoreExternal, mg resource.Managed) error {
+				_, err := e.Update(context.Background(), mg)
+				return err
+			},
+		},
+		"DeleteNotFound": {
+			reason: "Should not return an error if the featurestore is already gone",
+			method: http.MethodDelete,
+			path:   featurestorePath,
+			status: http.StatusNotFound,
+			call: func(e *featurestoreExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+		},
+		"DeleteFailed": {
+			reason: "Should return an error if deleting the featurestore fails",
+			method: http.MethodDelete,
+			path:   featurestorePath,
+			status: http.StatusBadRequest,
+			call: func(e *featurestoreExternal, mg resource.Managed) error {
+				return e.Delete(context.Background(), mg)
+			},
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteFeaturestore),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if tc.method == "" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				if diff := cmp.Diff(tc.method+" "+tc.path, r.Method+" "+r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				for k, v := range tc.query {
+					if diff := cmp.Diff(v, r.URL.Query().Get(k)); diff != "" {
+						t.Errorf("%s: -want, +got:\n%s", k, diff)
+					}
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(struct{}{})
+			}))
+			defer server.Close()
+			s, _ := aiplatform.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			mg := tc.mg
+			if mg == nil {
+				mg = newFeaturestore()
+			}
+			err := tc.call(&featurestoreExternal{featurestores: s.Projects.Locations.Featurestores, projectID: projectID}, mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
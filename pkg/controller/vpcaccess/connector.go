@@ -0,0 +1,165 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpcaccess
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	vpcaccess "google.golang.org/api/vpcaccess/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/vpcaccess/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	connectorclient "github.com/crossplane/provider-gcp/pkg/clients/vpcaccess"
+)
+
+// Error strings.
+const (
+	errNewClient         = "cannot create new Serverless VPC Access client"
+	errNotConnector      = "managed resource is not a Serverless VPC Access Connector"
+	errGetConnector      = "cannot get Serverless VPC Access Connector"
+	errCreateConnector   = "cannot create Serverless VPC Access Connector"
+	errUpdateConnector   = "cannot update Serverless VPC Access Connector"
+	errDeleteConnector   = "cannot delete Serverless VPC Access Connector"
+	errUpdateConnectorCR = "cannot update Serverless VPC Access Connector custom resource"
+)
+
+// SetupConnector adds a controller that reconciles Serverless VPC Access
+// Connectors.
+func SetupConnector(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.ConnectorGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.ConnectorGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Connector{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.ConnectorGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ConnectorGroupVersionKind),
+			&connectorConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connectorConnector struct {
+	kube client.Client
+}
+
+func (c *connectorConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := vpcaccess.NewService(ctx, opts.For("vpcaccess")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &connectorExternal{kube: c.kube, connectors: s.Projects.Locations.Connectors, projectID: projectID}, nil
+}
+
+type connectorExternal struct {
+	kube       client.Client
+	connectors *vpcaccess.ProjectsLocationsConnectorsService
+	projectID  string
+}
+
+func (e *connectorExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Connector)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotConnector)
+	}
+	existing, err := e.connectors.Get(connectorclient.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetConnector)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	connectorclient.LateInitializeSpec(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateConnectorCR)
+		}
+	}
+	cr.Status.AtProvider = connectorclient.GenerateObservation(*existing)
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.ConnectorStateReady, v1alpha1.ConnectorStateUpdating:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1alpha1.ConnectorStateCreating:
+		cr.Status.SetConditions(xpv1.Creating())
+	case v1alpha1.ConnectorStateDeleting:
+		cr.Status.SetConditions(xpv1.Deleting())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: connectorclient.IsUpToDate(cr.Spec.ForProvider, *existing),
+	}, nil
+}
+
+func (e *connectorExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Connector)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotConnector)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	c := connectorclient.GenerateConnector(connectorclient.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr)), cr.Spec.ForProvider)
+	_, err := e.connectors.Create(connectorclient.GetFullyQualifiedParent(e.projectID, cr.Spec.ForProvider), c).ConnectorId(meta.GetExternalName(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateConnector)
+}
+
+func (e *connectorExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Connector)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotConnector)
+	}
+	// The connector rejects updates while another operation is in progress.
+	if cr.Status.AtProvider.State == v1alpha1.ConnectorStateUpdating {
+		return managed.ExternalUpdate{}, nil
+	}
+	fqn := connectorclient.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+	_, err := e.connectors.Patch(fqn, connectorclient.GenerateConnector(fqn, cr.Spec.ForProvider)).UpdateMask(connectorclient.UpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateConnector)
+}
+
+func (e *connectorExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Connector)
+	if !ok {
+		return errors.New(errNotConnector)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.connectors.Delete(connectorclient.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteConnector)
+}
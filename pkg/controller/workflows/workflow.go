@@ -0,0 +1,176 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflows
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	workflows "google.golang.org/api/workflows/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/workflows/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	workflowclient "github.com/crossplane/provider-gcp/pkg/clients/workflows"
+)
+
+// Error strings.
+const (
+	errNewClient        = "cannot create new Workflows client"
+	errNotWorkflow      = "managed resource is not a Workflow"
+	errGetWorkflow      = "cannot get Workflow"
+	errCreateWorkflow   = "cannot create Workflow"
+	errUpdateWorkflow   = "cannot update Workflow"
+	errDeleteWorkflow   = "cannot delete Workflow"
+	errUpdateWorkflowCR = "cannot update Workflow custom resource"
+	errCheckUpToDate    = "cannot determine if Workflow is up to date"
+	errGetSource        = "cannot get Workflow source"
+)
+
+// SetupWorkflow adds a controller that reconciles Workflows.
+func SetupWorkflow(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration, mcr gcp.MaxConcurrentReconciles) error {
+	name := managed.ControllerName(v1alpha1.WorkflowGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: mcr.For(v1alpha1.WorkflowGroupKind),
+			RateLimiter:             ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Workflow{}).
+		Watches(gcp.CredentialSecrets(mgr), gcp.EnqueueRequestsForCredentials(mgr.GetClient(), resource.ManagedKind(v1alpha1.WorkflowGroupVersionKind))).
+		Complete(gcp.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.WorkflowGroupVersionKind),
+			&workflowConnector{kube: mgr.GetClient()},
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type workflowConnector struct {
+	kube client.Client
+}
+
+func (c *workflowConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := workflows.NewService(ctx, opts.For("workflows")...)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &workflowExternal{kube: c.kube, workflows: s.Projects.Locations.Workflows, projectID: projectID}, nil
+}
+
+type workflowExternal struct {
+	kube      client.Client
+	workflows *workflows.ProjectsLocationsWorkflowsService
+	projectID string
+}
+
+func (e *workflowExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Workflow)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotWorkflow)
+	}
+	fqn := workflowclient.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+	existing, err := e.workflows.Get(fqn).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetWorkflow)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	workflowclient.LateInitializeSpec(&cr.Spec.ForProvider, *existing)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) && !gcp.IsLateInitializationDisabled(cr) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errUpdateWorkflowCR)
+		}
+	}
+	cr.Status.AtProvider = workflowclient.GenerateObservation(*existing)
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.WorkflowStateActive:
+		cr.Status.SetConditions(xpv1.Available())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+	src, err := workflowclient.GetSourceContents(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetSource)
+	}
+	upToDate, err := workflowclient.IsUpToDate(fqn, src, &cr.Spec.ForProvider, existing)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckUpToDate)
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *workflowExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Workflow)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotWorkflow)
+	}
+	src, err := workflowclient.GetSourceContents(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetSource)
+	}
+	w := &workflows.Workflow{}
+	workflowclient.GenerateWorkflow(workflowclient.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr)), src, cr.Spec.ForProvider, w)
+	_, err = e.workflows.Create(workflowclient.GetFullyQualifiedParent(e.projectID, cr.Spec.ForProvider), w).WorkflowId(meta.GetExternalName(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateWorkflow)
+}
+
+func (e *workflowExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Workflow)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotWorkflow)
+	}
+	fqn := workflowclient.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+	src, err := workflowclient.GetSourceContents(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetSource)
+	}
+	w := &workflows.Workflow{}
+	workflowclient.GenerateWorkflow(fqn, src, cr.Spec.ForProvider, w)
+	_, err = e.workflows.Patch(fqn, w).UpdateMask(workflowclient.UpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateWorkflow)
+}
+
+func (e *workflowExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Workflow)
+	if !ok {
+		return errors.New(errNotWorkflow)
+	}
+	_, err := e.workflows.Delete(workflowclient.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteWorkflow)
+}
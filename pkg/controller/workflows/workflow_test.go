@@ -0,0 +1,280 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflows
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	workflows "google.golang.org/api/workflows/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/workflows/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	projectID    = "myproject-id-1234"
+	workflowName = "projects/myproject-id-1234/locations/europe-west1/workflows/my-workflow"
+	source       = "main:\n  steps:\n    - done:\n        return: ok\n"
+)
+
+var (
+	unexpectedObject resource.Managed
+	errBoom          = errors.New("boom")
+)
+
+func gError(code int, message string) *googleapi.Error {
+	return &googleapi.Error{
+		Code:    code,
+		Body:    "{}\n",
+		Message: message,
+	}
+}
+
+func newWorkflow() *v1alpha1.Workflow {
+	w := &v1alpha1.Workflow{}
+	meta.SetExternalName(w, "my-workflow")
+	w.Spec.ForProvider = v1alpha1.WorkflowParameters{
+		Location:       "europe-west1",
+		SourceContents: gcp.StringPtr(source),
+		ServiceAccount: gcp.StringPtr("workflow@myproject-id-1234.iam.gserviceaccount.com"),
+		CallLogLevel:   gcp.StringPtr("LOG_ERRORS_ONLY"),
+	}
+	return w
+}
+
+func observedWorkflow() *workflows.Workflow {
+	return &workflows.Workflow{
+		Name:           workflowName,
+		State:          v1alpha1.WorkflowStateActive,
+		RevisionId:     "000001-abc",
+		SourceContents: source,
+		ServiceAccount: "workflow@myproject-id-1234.iam.gserviceaccount.com",
+		CallLogLevel:   "LOG_ERRORS_ONLY",
+	}
+}
+
+func TestWorkflowObserve(t *testing.T) {
+	type want struct {
+		e   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NotWorkflow": {
+			reason: "Should return an error if the resource is not a Workflow",
+			mg:     unexpectedObject,
+			want:   want{err: errors.New(errNotWorkflow)},
+		},
+		"ResourceNotFound": {
+			reason: "Should not return an error if the API response is 404",
+			mg:     newWorkflow(),
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&workflows.Workflow{})
+			}),
+		},
+		"InternalError": {
+			reason: "Should return an error if the error is different than 404",
+			mg:     newWorkflow(),
+			want:   want{err: errors.Wrap(gError(http.StatusInternalServerError, ""), errGetWorkflow)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusInternalServerError)
+				_ = json.NewEncoder(w).Encode(&workflows.Workflow{})
+			}),
+		},
+		"UpdateResourceSpecFail": {
+			reason: "Should return an error if the late initialization update fails",
+			mg:     newWorkflow(),
+			want:   want{err: errors.Wrap(errBoom, errUpdateWorkflowCR)},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				wf := observedWorkflow()
+				wf.Description = "late"
+				_ = json.NewEncoder(w).Encode(wf)
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+		},
+		"ResourceUpToDate": {
+			reason: "Should return upToDate as true if the resource is up to date",
+			mg:     newWorkflow(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedWorkflow())
+			}),
+		},
+		"ResourceNotUpToDate": {
+			reason: "Should return upToDate as false if the resource is not up to date",
+			mg:     newWorkflow(),
+			want:   want{e: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}},
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				wf := observedWorkflow()
+				wf.SourceContents = "main:\n  steps: []\n"
+				_ = json.NewEncoder(w).Encode(wf)
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := workflows.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := workflowExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				workflows: s.Projects.Locations.Workflows,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.e, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func createWorkflow(e *workflowExternal, mg resource.Managed) error {
+	_, err := e.Create(context.Background(), mg)
+	return err
+}
+
+func updateWorkflow(e *workflowExternal, mg resource.Managed) error {
+	_, err := e.Update(context.Background(), mg)
+	return err
+}
+
+func deleteWorkflow(e *workflowExternal, mg resource.Managed) error {
+	return e.Delete(context.Background(), mg)
+}
+
+func TestWorkflowCreateUpdateDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		method  string
+		status  int
+		call    func(e *workflowExternal, mg resource.Managed) error
+		mg      resource.Managed
+		wantErr error
+	}{
+		"CreateNotWorkflow": {
+			reason:  "Should return an error if the resource is not a Workflow",
+			call:    createWorkflow,
+			mg:      unexpectedObject,
+			wantErr: errors.New(errNotWorkflow),
+		},
+		"CreateSuccessful": {
+			reason: "Should succeed if the resource creation doesn't return an error",
+			method: http.MethodPost,
+			status: http.StatusOK,
+			call:   createWorkflow,
+			mg:     newWorkflow(),
+		},
+		"CreateFailed": {
+			reason:  "Should fail if the resource creation returns an error",
+			method:  http.MethodPost,
+			status:  http.StatusBadRequest,
+			call:    createWorkflow,
+			mg:      newWorkflow(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateWorkflow),
+		},
+		"UpdateSuccessful": {
+			reason: "Should succeed if the resource update doesn't return an error",
+			method: http.MethodPatch,
+			status: http.StatusOK,
+			call:   updateWorkflow,
+			mg:     newWorkflow(),
+		},
+		"UpdateFailed": {
+			reason:  "Should fail if the resource update returns an error",
+			method:  http.MethodPatch,
+			status:  http.StatusBadRequest,
+			call:    updateWorkflow,
+			mg:      newWorkflow(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateWorkflow),
+		},
+		"DeleteAlreadyGone": {
+			reason: "Should not return an error if the resource is already gone",
+			method: http.MethodDelete,
+			status: http.StatusNotFound,
+			call:   deleteWorkflow,
+			mg:     newWorkflow(),
+		},
+		"DeleteFailed": {
+			reason:  "Should fail if the resource deletion returns an error",
+			method:  http.MethodDelete,
+			status:  http.StatusBadRequest,
+			call:    deleteWorkflow,
+			mg:      newWorkflow(),
+			wantErr: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteWorkflow),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(tc.method, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&workflows.Operation{})
+			}))
+			defer server.Close()
+			s, _ := workflows.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := &workflowExternal{
+				projectID: projectID,
+				workflows: s.Projects.Locations.Workflows,
+			}
+			err := tc.call(e, tc.mg)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n-want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}